@@ -138,6 +138,9 @@ gardenClientConnection:
   {{- if .Values.config.gardenClientConnection.kubeconfig }}
   kubeconfig: /etc/gardenlet/kubeconfig-garden/kubeconfig
   {{- end }}
+  {{- if .Values.config.gardenClientConnection.tokenFile }}
+  tokenFile: {{ .Values.config.gardenClientConnection.tokenFile }}
+  {{- end }}
 seedClientConnection:
   {{- with .Values.config.seedClientConnection.acceptContentTypes }}
   acceptContentTypes: {{ . | quote }}
@@ -216,6 +219,10 @@ controllers:
     {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     {{- end }}
+    {{- if .Values.config.controllers.shoot.imageVerification }}
+    imageVerification:
+{{ toYaml .Values.config.controllers.shoot.imageVerification | indent 6 }}
+    {{- end }}
   shootCare:
     concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -234,17 +241,74 @@ controllers:
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 4 }}
     {{- end }}
     webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+    {{- if .Values.config.controllers.shootCare.adaptiveSyncPeriod }}
+    adaptiveSyncPeriod:
+{{ toYaml .Values.config.controllers.shootCare.adaptiveSyncPeriod | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootCare.etcdMemberRemediation }}
+    etcdMemberRemediation:
+{{ toYaml .Values.config.controllers.shootCare.etcdMemberRemediation | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootCare.remediation }}
+    remediation:
+{{ toYaml .Values.config.controllers.shootCare.remediation | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootCare.healthChecks }}
+    healthChecks:
+{{ toYaml .Values.config.controllers.shootCare.healthChecks | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootCare.hibernationPreChecks }}
+    hibernationPreChecks:
+{{ toYaml .Values.config.controllers.shootCare.hibernationPreChecks | indent 4 }}
+    {{- end }}
   seedCare:
     syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
     conditionThresholds:
     {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 4 }}
     {{- end }}
+    {{- if .Values.config.controllers.seedCare.adaptiveSyncPeriod }}
+    adaptiveSyncPeriod:
+{{ toYaml .Values.config.controllers.seedCare.adaptiveSyncPeriod | indent 6 }}
+    {{- end }}
+  {{- if .Values.config.controllers.seedCapacity }}
+  seedCapacity:
+    {{- if .Values.config.controllers.seedCapacity.syncPeriod }}
+    syncPeriod: {{ .Values.config.controllers.seedCapacity.syncPeriod }}
+    {{- end }}
+    {{- if .Values.config.controllers.seedCapacity.loadBalancerQuota }}
+    loadBalancerQuota: {{ .Values.config.controllers.seedCapacity.loadBalancerQuota }}
+    {{- end }}
+  {{- end }}
   {{- if .Values.config.controllers.shootState }}
   shootState:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
   {{- end }}
+  {{- if .Values.config.controllers.shootResourceUsage }}
+  shootResourceUsage:
+    {{- if .Values.config.controllers.shootResourceUsage.concurrentSyncs }}
+    concurrentSyncs: {{ .Values.config.controllers.shootResourceUsage.concurrentSyncs }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootResourceUsage.syncPeriod }}
+    syncPeriod: {{ .Values.config.controllers.shootResourceUsage.syncPeriod }}
+    {{- end }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootConformanceTest }}
+  shootConformanceTest:
+    {{- if .Values.config.controllers.shootConformanceTest.concurrentSyncs }}
+    concurrentSyncs: {{ .Values.config.controllers.shootConformanceTest.concurrentSyncs }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootConformanceTest.focus }}
+    focus: {{ .Values.config.controllers.shootConformanceTest.focus | quote }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootConformanceTest.skip }}
+    skip: {{ .Values.config.controllers.shootConformanceTest.skip | quote }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootConformanceTest.timeout }}
+    timeout: {{ .Values.config.controllers.shootConformanceTest.timeout }}
+    {{- end }}
+  {{- end }}
   {{- if .Values.config.controllers.managedSeed }}
   managedSeed:
     concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -256,6 +320,9 @@ controllers:
     {{- if .Values.config.controllers.managedSeed.jitterUpdates }}
     jitterUpdates: {{ .Values.config.controllers.managedSeed.jitterUpdates }}
     {{- end }}
+    {{- if .Values.config.controllers.managedSeed.driftDetectionPeriod }}
+    driftDetectionPeriod: {{ .Values.config.controllers.managedSeed.driftDetectionPeriod }}
+    {{- end }}
   {{- end }}
   {{- if .Values.config.controllers.networkPolicy }}
   networkPolicy:
@@ -338,6 +405,18 @@ etcdConfig:
 exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers }}
 {{- end }}
+{{- if .Values.config.podSecurity }}
+podSecurity:
+{{ toYaml .Values.config.podSecurity | indent 2 }}
+{{- end }}
+{{- if .Values.config.artifactCache }}
+artifactCache:
+{{ toYaml .Values.config.artifactCache | indent 2 }}
+{{- end }}
+{{- if .Values.config.certificateService }}
+certificateService:
+{{ toYaml .Values.config.certificateService | indent 2 }}
+{{- end }}
 {{- if .Values.nodeToleration }}
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
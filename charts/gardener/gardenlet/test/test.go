@@ -206,10 +206,18 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Verbs:     []string{"get", "list", "watch", "patch", "update"},
 			},
 			{
-				APIGroups:     []string{""},
-				Resources:     []string{"persistentvolumeclaims"},
-				ResourceNames: []string{"vali-vali-0"},
-				Verbs:         []string{"delete"},
+				APIGroups: []string{""},
+				Resources: []string{"persistentvolumeclaims"},
+				ResourceNames: []string{
+					"vali-vali-0",
+					"main-etcd-etcd-main-0",
+					"main-etcd-etcd-main-1",
+					"main-etcd-etcd-main-2",
+					"etcd-events-etcd-events-0",
+					"etcd-events-etcd-events-1",
+					"etcd-events-etcd-events-2",
+				},
+				Verbs: []string{"delete"},
 			},
 			{
 				APIGroups: []string{"admissionregistration.k8s.io"},
@@ -723,6 +731,9 @@ func ComputeExpectedGardenletConfiguration(
 				SyncJitterPeriod: &metav1.Duration{
 					Duration: 300000000000,
 				},
+				DriftDetectionPeriod: &metav1.Duration{
+					Duration: 10 * time.Minute,
+				},
 			},
 			ShootCare: &gardenletv1alpha1.ShootCareControllerConfiguration{
 				ConcurrentSyncs: &five,
@@ -781,10 +792,25 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 			},
+			SeedCapacity: &gardenletv1alpha1.SeedCapacityControllerConfiguration{
+				SyncPeriod: &metav1.Duration{
+					Duration: 5 * time.Minute,
+				},
+			},
 			ShootState: &gardenletv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootResourceUsage: &gardenletv1alpha1.ShootResourceUsageControllerConfiguration{
+				ConcurrentSyncs: &five,
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			ShootConformanceTest: &gardenletv1alpha1.ShootConformanceTestControllerConfiguration{
+				ConcurrentSyncs: &five,
+				Focus:           ptr.To(`\[Conformance\]`),
+				Skip:            ptr.To(`\[Serial\]|\[Disruptive\]|\[Slow\]`),
+				Timeout:         &metav1.Duration{Duration: 2 * time.Hour},
+			},
 			TokenRequestor: &gardenletv1alpha1.TokenRequestorControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
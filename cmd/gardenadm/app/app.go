@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/controlplane"
+)
+
+// Name is a const for the name of this component.
+const Name = "gardenadm"
+
+// NewCommand creates a new cobra.Command for running gardenadm.
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: Name + " bootstraps and manages the control plane nodes of autonomous shoot clusters",
+		Args:  cobra.NoArgs,
+	}
+
+	verflag.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(getJoinCommand())
+	cmd.AddCommand(getUploadCertsCommand())
+	return cmd
+}
+
+func getJoinCommand() *cobra.Command {
+	opts := &joinOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "Join the node as an additional control plane node to an existing cluster",
+		Long: `Join the node as an additional control plane node to an existing cluster.
+
+The certificates shared by all control plane nodes must have been uploaded with 'gardenadm upload-certs' on an
+existing control plane node before. The node is added as learner member to the etcd cluster and promoted to a voting
+member once it is in sync with the leader. If etcd does not become healthy, the member is removed again. Afterwards, the
+remaining control plane components are started. Each step is gated on the health of the previous one.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := utils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+
+			c, err := newClient(opts.kubeconfig)
+			if err != nil {
+				return err
+			}
+
+			return controlplane.JoinControlPlane(cmd.Context(), log, afero.Afero{Fs: afero.NewOsFs()}, c, newEtcdMembers, controlplane.JoinOptions{
+				NodeName:         opts.nodeName,
+				NodeAddress:      opts.nodeAddress,
+				ControlPlaneNode: opts.controlPlaneNode,
+				CertificateKey:   opts.certificateKey,
+				PKIDir:           opts.pkiDir,
+				StaticPodPath:    opts.staticPodPath,
+				PollInterval:     5 * time.Second,
+				Timeout:          opts.timeout,
+			})
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+	return cmd
+}
+
+func getUploadCertsCommand() *cobra.Command {
+	opts := &uploadCertsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upload-certs",
+		Short: "Upload the certificates shared by all control plane nodes to the cluster",
+		Long: `Upload the certificates shared by all control plane nodes to the cluster.
+
+The certificates are encrypted with the given certificate key (a new key is generated and printed if none is given) and
+stored in a secret in the kube-system namespace. The same key must be passed to 'gardenadm join --control-plane'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := utils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+
+			c, err := newClient(opts.kubeconfig)
+			if err != nil {
+				return err
+			}
+
+			certificateKey := opts.certificateKey
+			if len(certificateKey) == 0 {
+				if certificateKey, err = controlplane.GenerateCertificateKey(); err != nil {
+					return err
+				}
+			}
+
+			log.Info("Uploading shared control plane certificates", "pkiDir", opts.pkiDir)
+			if err := controlplane.UploadCertificates(cmd.Context(), afero.Afero{Fs: afero.NewOsFs()}, c, opts.pkiDir, certificateKey); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Certificate key: %s\n", certificateKey)
+			return nil
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+	return cmd
+}
+
+func newClient(kubeconfig string) (client.Client, error) {
+	restConfig, err := kubernetes.RESTConfigFromKubeconfigFile(kubeconfig, kubernetes.AuthTokenFile, kubernetes.AuthClientCertificate, kubernetes.AuthClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting REST config from kubeconfig: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.ShootScheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client: %w", err)
+	}
+	return c, nil
+}
+
+func newEtcdMembers(endpoints []string, tlsConfig *tls.Config) (controlplane.EtcdMembers, io.Closer, error) {
+	c, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		TLS:         tlsConfig,
+		DialTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, c, nil
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent"
+)
+
+const (
+	defaultPKIDir        = "/etc/kubernetes/pki"
+	defaultStaticPodPath = "/etc/kubernetes/manifests"
+)
+
+type commonOptions struct {
+	kubeconfig     string
+	pkiDir         string
+	certificateKey string
+	logLevel       string
+	logFormat      string
+}
+
+func (o *commonOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", o.kubeconfig, "Path to the kubeconfig for the existing cluster.")
+	fs.StringVar(&o.pkiDir, "pki-dir", defaultPKIDir, "Directory containing the certificates and keys of the control plane.")
+	fs.StringVar(&o.certificateKey, "certificate-key", o.certificateKey, "Hex-encoded key for encrypting the certificates shared by all control plane nodes.")
+	fs.StringVar(&o.logLevel, "log-level", logger.InfoLevel, "The level/severity for the logs. Must be one of [info,debug,error]")
+	fs.StringVar(&o.logFormat, "log-format", logger.FormatText, "The format for the logs. Must be one of [json,text]")
+}
+
+func (o *commonOptions) validate() error {
+	if len(o.kubeconfig) == 0 {
+		return fmt.Errorf("missing kubeconfig")
+	}
+	if len(o.pkiDir) == 0 {
+		return fmt.Errorf("missing PKI directory")
+	}
+	return nil
+}
+
+func (o *commonOptions) LogConfig() (string, string) {
+	return o.logLevel, o.logFormat
+}
+
+type uploadCertsOptions struct {
+	commonOptions
+}
+
+var _ utils.Options = &uploadCertsOptions{}
+
+func (o *uploadCertsOptions) Complete() error { return nil }
+
+func (o *uploadCertsOptions) Validate() error { return o.validate() }
+
+type joinOptions struct {
+	commonOptions
+
+	controlPlane     bool
+	nodeName         string
+	nodeAddress      string
+	controlPlaneNode string
+	staticPodPath    string
+	timeout          time.Duration
+}
+
+var _ utils.Options = &joinOptions{}
+
+func (o *joinOptions) addFlags(fs *pflag.FlagSet) {
+	o.commonOptions.addFlags(fs)
+	fs.BoolVar(&o.controlPlane, "control-plane", false, "Join the node as an additional control plane node.")
+	fs.StringVar(&o.nodeName, "node-name", o.nodeName, "Name of the joining node, defaults to the hostname.")
+	fs.StringVar(&o.nodeAddress, "node-address", o.nodeAddress, "Address of the joining node which is advertised to the other control plane nodes.")
+	fs.StringVar(&o.controlPlaneNode, "control-plane-node", o.controlPlaneNode, "Name of the existing control plane node whose static pods are used as template. Defaults to any existing control plane node.")
+	fs.StringVar(&o.staticPodPath, "static-pod-path", defaultStaticPodPath, "Directory from which the kubelet reads static pod manifests.")
+	fs.DurationVar(&o.timeout, "timeout", 10*time.Minute, "Maximum duration to wait for the etcd member and each control plane component to become healthy.")
+}
+
+func (o *joinOptions) Complete() error {
+	if len(o.nodeName) == 0 {
+		hostName, err := nodeagent.GetHostName()
+		if err != nil {
+			return fmt.Errorf("failed fetching hostname: %w", err)
+		}
+		o.nodeName = hostName
+	}
+	return nil
+}
+
+func (o *joinOptions) Validate() error {
+	if !o.controlPlane {
+		return fmt.Errorf("only joining control plane nodes is supported, worker nodes are joined by gardener-node-agent (use --control-plane)")
+	}
+	if err := o.validate(); err != nil {
+		return err
+	}
+	if len(o.certificateKey) == 0 {
+		return fmt.Errorf("missing certificate key")
+	}
+	if net.ParseIP(o.nodeAddress) == nil {
+		return fmt.Errorf("node address %q is not a valid IP address", o.nodeAddress)
+	}
+	if len(o.staticPodPath) == 0 {
+		return fmt.Errorf("missing static pod path")
+	}
+	if o.timeout <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+	return nil
+}
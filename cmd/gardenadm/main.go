@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/gardener/gardener/cmd/gardenadm/app"
+	"github.com/gardener/gardener/cmd/utils"
+)
+
+func main() {
+	utils.DeduplicateWarnings()
+
+	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -50,6 +51,9 @@ func NewCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			log, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
+				if errors.Is(err, utils.ErrConfigPrinted) {
+					return nil
+				}
 				return err
 			}
 			return run(cmd.Context(), log, opts.config)
@@ -58,6 +62,7 @@ func NewCommand() *cobra.Command {
 
 	flags := cmd.Flags()
 	verflag.AddFlags(flags)
+	utils.AddValidateConfigFlag(flags)
 	opts.addFlags(flags)
 
 	return cmd
@@ -21,7 +21,10 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 )
 
-var configDecoder runtime.Decoder
+var (
+	configCodecs  serializer.CodecFactory
+	configDecoder runtime.Decoder
+)
 
 func init() {
 	configScheme := runtime.NewScheme()
@@ -30,7 +33,8 @@ func init() {
 		controllermanagerv1alpha1.AddToScheme,
 	)
 	utilruntime.Must(schemeBuilder.AddToScheme(configScheme))
-	configDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+	configCodecs = serializer.NewCodecFactory(configScheme)
+	configDecoder = configCodecs.UniversalDecoder()
 }
 
 type options struct {
@@ -74,3 +78,7 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) EffectiveConfig() ([]byte, error) {
+	return utils.EncodeConfig(configCodecs, o.config, controllermanagerv1alpha1.SchemeGroupVersion)
+}
@@ -6,7 +6,9 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -44,6 +46,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // Name is a const for the name of this component.
@@ -60,6 +63,9 @@ func NewCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			log, err := utils.InitRun(cmd, opts, Name)
 			if err != nil {
+				if errors.Is(err, utils.ErrConfigPrinted) {
+					return nil
+				}
 				return err
 			}
 			return run(cmd.Context(), log, opts.config)
@@ -68,6 +74,7 @@ func NewCommand() *cobra.Command {
 
 	flags := cmd.Flags()
 	verflag.AddFlags(flags)
+	utils.AddValidateConfigFlag(flags)
 	opts.addFlags(flags)
 
 	return cmd
@@ -88,7 +95,9 @@ func run(ctx context.Context, log logr.Logger, cfg *config.OperatorConfiguration
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.FlowHandlers)
+		flow.DefaultRecorder.SetEnabled(true)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
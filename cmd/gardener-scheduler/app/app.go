@@ -6,6 +6,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -53,6 +54,9 @@ func NewCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			log, err := cmdutils.InitRun(cmd, opts, Name)
 			if err != nil {
+				if errors.Is(err, cmdutils.ErrConfigPrinted) {
+					return nil
+				}
 				return err
 			}
 			return run(cmd.Context(), log, opts.config)
@@ -61,6 +65,7 @@ func NewCommand() *cobra.Command {
 
 	flags := cmd.Flags()
 	verflag.AddFlags(flags)
+	cmdutils.AddValidateConfigFlag(flags)
 	opts.addFlags(flags)
 
 	return cmd
@@ -131,7 +136,7 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 	}
 
 	log.Info("Adding controllers to manager")
-	if err := controller.AddToManager(mgr, cfg); err != nil {
+	if err := controller.AddToManager(ctx, mgr, cfg); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
 
@@ -20,7 +20,10 @@ import (
 	schedulervalidation "github.com/gardener/gardener/pkg/scheduler/apis/config/validation"
 )
 
-var configDecoder runtime.Decoder
+var (
+	configCodecs  serializer.CodecFactory
+	configDecoder runtime.Decoder
+)
 
 func init() {
 	configScheme := runtime.NewScheme()
@@ -29,7 +32,8 @@ func init() {
 		schedulerv1alpha1.AddToScheme,
 	)
 	utilruntime.Must(schemeBuilder.AddToScheme(configScheme))
-	configDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+	configCodecs = serializer.NewCodecFactory(configScheme)
+	configDecoder = configCodecs.UniversalDecoder()
 }
 
 type options struct {
@@ -73,3 +77,7 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) EffectiveConfig() ([]byte, error) {
+	return utils.EncodeConfig(configCodecs, o.config, schedulerv1alpha1.SchemeGroupVersion)
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/cmd/utils"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/shootimporter"
+)
+
+// Name is a const for the name of this component.
+const Name = "gardener-shoot-importer"
+
+// NewCommand creates a new cobra.Command for running gardener-shoot-importer.
+func NewCommand() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "Import an existing cluster as Shoot",
+		Long: `Import an existing, externally created cluster as Shoot, i.e., Gardener takes over the management of its
+infrastructure and machines without recreating them.
+
+The Shoot is created with the shoot.gardener.cloud/import annotation and the given states of the existing infrastructure
+and machines are stored in its ShootState. Afterwards, the import is triggered via the shoots/import subresource and
+gardenlet restores the Shoot based on the ShootState. The ShootImport feature gate must be enabled in
+gardener-apiserver and gardenlet.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := utils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+
+			c, err := newClient(opts.kubeconfig)
+			if err != nil {
+				return err
+			}
+
+			shoot, err := readShoot(opts.shootFile)
+			if err != nil {
+				return err
+			}
+
+			importOpts := shootimporter.Options{
+				Shoot:        shoot,
+				Wait:         opts.wait,
+				PollInterval: 10 * time.Second,
+				Timeout:      opts.timeout,
+			}
+			if importOpts.InfrastructureState, err = readOptionalFile(opts.infrastructureState); err != nil {
+				return err
+			}
+			if importOpts.WorkerState, err = readOptionalFile(opts.workerState); err != nil {
+				return err
+			}
+
+			return shootimporter.Import(cmd.Context(), log, c, importOpts)
+		},
+	}
+
+	verflag.AddFlags(cmd.Flags())
+	opts.addFlags(cmd.Flags())
+	return cmd
+}
+
+func newClient(kubeconfig string) (client.Client, error) {
+	restConfig, err := kubernetes.RESTConfigFromKubeconfigFile(kubeconfig, kubernetes.AuthTokenFile, kubernetes.AuthClientCertificate, kubernetes.AuthClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting REST config from kubeconfig: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client: %w", err)
+	}
+	return c, nil
+}
+
+func readShoot(path string) (*gardencorev1beta1.Shoot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading shoot manifest: %w", err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), data, shoot); err != nil {
+		return nil, fmt.Errorf("failed decoding shoot manifest: %w", err)
+	}
+	return shoot, nil
+}
+
+func readOptionalFile(path string) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+type options struct {
+	kubeconfig          string
+	shootFile           string
+	infrastructureState string
+	workerState         string
+	wait                bool
+	timeout             time.Duration
+	logLevel            string
+	logFormat           string
+}
+
+var _ utils.Options = &options{}
+
+func (o *options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", o.kubeconfig, "Path to the kubeconfig for the garden cluster.")
+	fs.StringVar(&o.shootFile, "shoot", o.shootFile, "Path to the manifest of the Shoot which adopts the existing cluster. Its .spec.seedName must be set.")
+	fs.StringVar(&o.infrastructureState, "infrastructure-state", o.infrastructureState, "Path to the state of the existing infrastructure in the format of the provider extension. If not set, the provider extension discovers the infrastructure itself.")
+	fs.StringVar(&o.workerState, "worker-state", o.workerState, "Path to the state of the existing machines in the format of the provider extension. If not set, the provider extension discovers the machines itself.")
+	fs.BoolVar(&o.wait, "wait", true, "Wait until gardenlet finished the import.")
+	fs.DurationVar(&o.timeout, "timeout", 30*time.Minute, "Maximum duration to wait for the import.")
+	fs.StringVar(&o.logLevel, "log-level", logger.InfoLevel, "The level/severity for the logs. Must be one of [info,debug,error]")
+	fs.StringVar(&o.logFormat, "log-format", logger.FormatText, "The format for the logs. Must be one of [json,text]")
+}
+
+func (o *options) Complete() error { return nil }
+
+func (o *options) Validate() error {
+	if len(o.kubeconfig) == 0 {
+		return fmt.Errorf("missing kubeconfig")
+	}
+	if len(o.shootFile) == 0 {
+		return fmt.Errorf("missing shoot manifest")
+	}
+	if o.wait && o.timeout <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+	return nil
+}
+
+func (o *options) LogConfig() (string, string) {
+	return o.logLevel, o.logFormat
+}
@@ -6,7 +6,9 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -63,10 +65,12 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/controller"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 // Name is a const for the name of this component.
@@ -83,21 +87,33 @@ func NewCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			log, err := cmdutils.InitRun(cmd, opts, Name)
 			if err != nil {
+				if errors.Is(err, cmdutils.ErrConfigPrinted) {
+					return nil
+				}
 				return err
 			}
+			reloader := &configReloader{
+				log:        log.WithName("config-reloader"),
+				configFile: opts.configFile,
+				config:     opts.config.DeepCopy(),
+				logLevel:   opts.logLevel,
+				settings:   reload.NewSettings(opts.config),
+			}
+
 			ctx, cancel := context.WithCancel(cmd.Context())
-			return run(ctx, cancel, log, opts.config)
+			return run(ctx, cancel, log, opts.config, reloader)
 		},
 	}
 
 	flags := cmd.Flags()
 	verflag.AddFlags(flags)
+	cmdutils.AddValidateConfigFlag(flags)
 	opts.addFlags(flags)
 
 	return cmd
 }
 
-func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.GardenletConfiguration) error {
+func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.GardenletConfiguration, reloader *configReloader) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
@@ -112,10 +128,16 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 	if err != nil {
 		return err
 	}
+	if features.DefaultFeatureGate.Enabled(features.PerTargetClientRateLimiting) {
+		seedRESTConfig.RateLimiter = kubernetes.NewRateLimiter("seed", seedRESTConfig.QPS, seedRESTConfig.Burst)
+	}
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.FlowHandlers)
+		flow.DefaultRecorder.SetEnabled(true)
+		maps.Copy(extraHandlers, routes.ShootStateHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -175,6 +197,11 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
+	log.Info("Adding config reloader to manager")
+	if err := mgr.Add(reloader); err != nil {
+		return fmt.Errorf("failed adding config reloader to manager: %w", err)
+	}
+
 	log.Info("Adding runnables to manager for bootstrapping")
 	kubeconfigBootstrapResult := &bootstrappers.KubeconfigBootstrapResult{}
 
@@ -199,6 +226,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 				config:                    cfg,
 				healthManager:             healthManager,
 				kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+				settings:                  reloader.settings,
 			},
 		},
 	}); err != nil {
@@ -215,16 +243,25 @@ type garden struct {
 	config                    *config.GardenletConfiguration
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	settings                  *reload.Settings
 }
 
 func (g *garden) Start(ctx context.Context) error {
 	log := g.mgr.GetLogger()
 
+	var allowedFields []string
+	if g.config.GardenClientConnection.TokenFile != nil {
+		allowedFields = append(allowedFields, kubernetes.AuthTokenFile)
+	}
+
 	log.Info("Getting rest config for garden")
-	gardenRESTConfig, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&g.config.GardenClientConnection.ClientConnectionConfiguration, g.kubeconfigBootstrapResult.Kubeconfig)
+	gardenRESTConfig, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&g.config.GardenClientConnection.ClientConnectionConfiguration, g.kubeconfigBootstrapResult.Kubeconfig, allowedFields...)
 	if err != nil {
 		return err
 	}
+	if features.DefaultFeatureGate.Enabled(features.PerTargetClientRateLimiting) {
+		gardenRESTConfig.RateLimiter = kubernetes.NewRateLimiter("garden", gardenRESTConfig.QPS, gardenRESTConfig.Burst)
+	}
 
 	log.Info("Setting up cluster object for garden")
 	gardenCluster, err := cluster.New(gardenRESTConfig, func(opts *cluster.Options) {
@@ -249,6 +286,11 @@ func (g *garden) Start(ctx context.Context) error {
 				&gardencorev1beta1.Shoot{}: {
 					Label: labels.SelectorFromSet(labels.Set{v1beta1constants.LabelPrefixSeedName + g.config.SeedConfig.SeedTemplate.Name: "true"}),
 				},
+				// The seed name labels of BackupEntries are maintained by gardener-apiserver for both `.spec.seedName` and
+				// `.status.seedName`, hence gardenlet still sees the BackupEntries which are migrated away from its seed.
+				&gardencorev1beta1.BackupEntry{}: {
+					Label: labels.SelectorFromSet(labels.Set{v1beta1constants.LabelPrefixSeedName + g.config.SeedConfig.SeedTemplate.Name: "true"}),
+				},
 				&operationsv1alpha1.Bastion{}: {
 					Field: fields.SelectorFromSet(fields.Set{operations.BastionSeedName: g.config.SeedConfig.SeedTemplate.Name}),
 				},
@@ -277,6 +319,7 @@ func (g *garden) Start(ctx context.Context) error {
 					&certificatesv1.CertificateSigningRequest{}: kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &certificatesv1.CertificateSigningRequest{}),
 					&gardencorev1.ControllerDeployment{}:        kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1.ControllerDeployment{}),
 					&gardencorev1beta1.CloudProfile{}:           kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.CloudProfile{}),
+					&gardencorev1beta1.ClusterAuditPolicy{}:     kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ClusterAuditPolicy{}),
 					&gardencorev1beta1.ExposureClass{}:          kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.ExposureClass{}),
 					&gardencorev1beta1.InternalSecret{}:         kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.InternalSecret{}),
 					&gardencorev1beta1.Project{}:                kubernetes.SingleObjectCacheFunc(log, kubernetes.GardenScheme, &gardencorev1beta1.Project{}),
@@ -347,6 +390,10 @@ func (g *garden) Start(ctx context.Context) error {
 		return fmt.Errorf("failed waiting for cache to be synced")
 	}
 
+	if g.config.Debugging != nil && g.config.Debugging.EnableProfiling {
+		shootstate.DefaultDiffHandler.SetClients(gardenCluster.GetAPIReader(), g.mgr.GetClient())
+	}
+
 	log.Info("Registering Seed object in garden cluster")
 	if err := g.registerSeed(ctx, gardenCluster.GetClient()); err != nil {
 		return err
@@ -372,6 +419,7 @@ func (g *garden) Start(ctx context.Context) error {
 		WithGardenClient(gardenCluster.GetClient()).
 		WithSeedClient(g.mgr.GetClient()).
 		WithClientConnectionConfig(&g.config.ShootClientConnection.ClientConnectionConfiguration).
+		WithPerTargetRateLimiting(features.DefaultFeatureGate.Enabled(features.PerTargetClientRateLimiting)).
 		Build(log)
 	if err != nil {
 		return fmt.Errorf("failed to build shoot ClientMap: %w", err)
@@ -408,6 +456,7 @@ func (g *garden) Start(ctx context.Context) error {
 		shootClientMap,
 		g.config,
 		g.healthManager,
+		g.settings,
 	); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
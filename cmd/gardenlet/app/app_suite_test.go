@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
+)
+
+func TestApp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Command Gardenlet App Suite")
+}
+
+var _ = BeforeSuite(func() {
+	gardenletfeatures.RegisterFeatureGates()
+})
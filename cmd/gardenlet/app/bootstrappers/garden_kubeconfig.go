@@ -10,6 +10,9 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -46,8 +49,15 @@ type KubeconfigBootstrapResult struct {
 
 // Start starts the garden kubeconfig bootstrap process. It either uses the provided bootstrap kubeconfig with a
 // bootstrap token to create a CertificateSigningRequest for retrieving a client certificate, or it returns the already
-// existing kubeconfig (stored in the seed cluster as secret).
+// existing kubeconfig (stored in the seed cluster as secret). If a token file is configured, it returns a kubeconfig
+// using this token file instead.
 func (g *GardenKubeconfig) Start(ctx context.Context) (err error) {
+	if tokenFile := g.Config.GardenClientConnection.TokenFile; tokenFile != nil {
+		g.Log.Info("Token file given in the configuration under `.gardenClientConnection.tokenFile`. Skipping the kubeconfig bootstrap process and certificate rotation", "tokenFile", *tokenFile)
+		g.Result.Kubeconfig, err = g.tokenFileKubeconfig(*tokenFile)
+		return err
+	}
+
 	if g.Config.GardenClientConnection.KubeconfigSecret != nil {
 		g.Result.Kubeconfig, g.Result.CSRName, g.Result.SeedName, err = g.getOrBootstrapKubeconfig(ctx)
 		if err != nil {
@@ -87,6 +97,26 @@ var (
 	NewClientFromBytes = kubernetes.NewClientFromBytes
 )
 
+func (g *GardenKubeconfig) tokenFileKubeconfig(tokenFile string) ([]byte, error) {
+	if g.Config.GardenClientConnection.GardenClusterAddress == nil {
+		return nil, errors.New("the configuration file needs to specify the Garden API Server address under `.gardenClientConnection.gardenClusterAddress` when using a token file")
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		"garden",
+		clientcmdv1.Cluster{
+			Server:                   *g.Config.GardenClientConnection.GardenClusterAddress,
+			CertificateAuthorityData: g.Config.GardenClientConnection.GardenClusterCACert,
+		},
+		clientcmdv1.AuthInfo{TokenFile: tokenFile},
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed generating garden kubeconfig for token file: %w", err)
+	}
+
+	return kubeconfig, nil
+}
+
 // getOrBootstrapKubeconfig retrieves an already existing kubeconfig for the Garden cluster from the Seed or bootstraps a new one
 func (g *GardenKubeconfig) getOrBootstrapKubeconfig(
 	ctx context.Context,
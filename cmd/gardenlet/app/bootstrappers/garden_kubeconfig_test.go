@@ -14,6 +14,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -64,6 +66,33 @@ var _ = Describe("GardenKubeconfig", func() {
 	})
 
 	Describe("#Start", func() {
+		Context("when tokenFile is set", func() {
+			BeforeEach(func() {
+				runner.Config.GardenClientConnection.TokenFile = ptr.To("/var/run/secrets/gardener.cloud/garden/token")
+				runner.Config.GardenClientConnection.KubeconfigSecret = nil
+			})
+
+			It("should return an error because .gardenClientConnection.gardenClusterAddress is nil", func() {
+				Expect(runner.Start(ctx)).To(MatchError(ContainSubstring("needs to specify the Garden API Server address")))
+				Expect(result.Kubeconfig).To(BeNil())
+			})
+
+			It("should return a kubeconfig using the token file", func() {
+				runner.Config.GardenClientConnection.GardenClusterAddress = ptr.To("https://api.garden.example.com")
+				runner.Config.GardenClientConnection.GardenClusterCACert = []byte("ca")
+
+				Expect(runner.Start(ctx)).To(Succeed())
+
+				kubeconfig, err := clientcmd.Load(result.Kubeconfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeconfig.Clusters["garden"].Server).To(Equal("https://api.garden.example.com"))
+				Expect(kubeconfig.Clusters["garden"].CertificateAuthorityData).To(Equal([]byte("ca")))
+				Expect(kubeconfig.AuthInfos["garden"].TokenFile).To(Equal("/var/run/secrets/gardener.cloud/garden/token"))
+				Expect(kubernetes.ValidateConfigWithAllowList(*kubeconfig, []string{kubernetes.AuthTokenFile})).To(Succeed())
+				Expect(result.CSRName).To(BeEmpty())
+			})
+		})
+
 		Context("when kubeconfigSecret is nil", func() {
 			BeforeEach(func() {
 				runner.Config.GardenClientConnection.KubeconfigSecret = nil
@@ -9,6 +9,7 @@ import (
 	"os"
 
 	"github.com/spf13/pflag"
+	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -20,9 +21,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/validation"
+	"github.com/gardener/gardener/pkg/logger"
 )
 
-var configDecoder runtime.Decoder
+var (
+	configCodecs  serializer.CodecFactory
+	configDecoder runtime.Decoder
+)
 
 func init() {
 	configScheme := runtime.NewScheme()
@@ -33,12 +38,14 @@ func init() {
 		gardencorev1beta1.AddToScheme,
 	)
 	utilruntime.Must(schemeBuilder.AddToScheme(configScheme))
-	configDecoder = serializer.NewCodecFactory(configScheme).UniversalDecoder()
+	configCodecs = serializer.NewCodecFactory(configScheme)
+	configDecoder = configCodecs.UniversalDecoder()
 }
 
 type options struct {
 	configFile string
 	config     *config.GardenletConfiguration
+	logLevel   zap.AtomicLevel
 }
 
 var _ utils.Options = &options{}
@@ -52,15 +59,16 @@ func (o *options) Complete() error {
 		return fmt.Errorf("missing config file")
 	}
 
-	data, err := os.ReadFile(o.configFile)
-	if err != nil {
-		return fmt.Errorf("error reading config file: %w", err)
+	var err error
+	if o.config, err = loadConfig(o.configFile); err != nil {
+		return err
 	}
 
-	o.config = &config.GardenletConfiguration{}
-	if err = runtime.DecodeInto(configDecoder, data, o.config); err != nil {
-		return fmt.Errorf("error decoding config: %w", err)
+	zapLevel, err := logger.ZapLevel(o.config.LogLevel)
+	if err != nil {
+		return err
 	}
+	o.logLevel = zap.NewAtomicLevelAt(zapLevel)
 
 	// Set feature gates immediately after decoding the config.
 	// Feature gates might influence the next steps, e.g., validating the config.
@@ -77,3 +85,25 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) AtomicLogLevel() zap.AtomicLevel {
+	return o.logLevel
+}
+
+func loadConfig(configFile string) (*config.GardenletConfiguration, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	cfg := &config.GardenletConfiguration{}
+	if err = runtime.DecodeInto(configDecoder, data, cfg); err != nil {
+		return nil, fmt.Errorf("error decoding config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func (o *options) EffectiveConfig() ([]byte, error) {
+	return utils.EncodeConfig(configCodecs, o.config, gardenletv1alpha1.SchemeGroupVersion)
+}
@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenletvalidation "github.com/gardener/gardener/pkg/gardenlet/apis/config/validation"
+	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// configReloader reloads the configuration file whenever it changes or the process receives a SIGHUP signal. The
+// following settings are applied without a restart:
+//   - the log level,
+//   - the concurrency of the shoot and shoot care controllers (up to their number of workers),
+//   - the feature gates which are only evaluated during reconciliations (see `features.GetReloadableFeatures`),
+//   - the monitoring settings, which take effect with the next reconciliation of the seed and the shoots.
+//
+// Changes of all other fields (e.g., the sync periods of controllers, other feature gates, the seed configuration) are
+// rejected, i.e., the current values are kept and an error is logged. These settings are consumed once when the
+// controllers are added to the manager, hence applying them at runtime would require re-creating the controllers and
+// their watches.
+type configReloader struct {
+	log        logr.Logger
+	configFile string
+	config     *config.GardenletConfiguration
+	logLevel   zap.AtomicLevel
+	settings   *reload.Settings
+}
+
+// NeedLeaderElection returns false since the configuration must be reloaded by all gardenlet instances.
+func (r *configReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the configuration file and reloads it until the context is cancelled.
+func (r *configReloader) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed creating file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Watch the directory instead of the file since files in mounted ConfigMaps are replaced via symlinks.
+	if err := watcher.Add(filepath.Dir(r.configFile)); err != nil {
+		return fmt.Errorf("failed watching directory of config file: %w", err)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-signals:
+			r.log.Info("Received SIGHUP, reloading configuration")
+			r.reload()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) {
+				r.reload()
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			r.log.Error(err, "Failed watching config file")
+		}
+	}
+}
+
+func (r *configReloader) reload() {
+	newConfig, err := loadConfig(r.configFile)
+	if err != nil {
+		r.log.Error(err, "Failed reloading configuration, keeping current configuration")
+		return
+	}
+
+	if errs := gardenletvalidation.ValidateGardenletConfiguration(newConfig, nil, false); len(errs) > 0 {
+		r.log.Error(errs.ToAggregate(), "Reloaded configuration is invalid, keeping current configuration")
+		return
+	}
+
+	if err := r.settings.Validate(newConfig); err != nil {
+		r.log.Error(err, "Reloaded configuration cannot be applied without a restart, keeping current configuration")
+		return
+	}
+
+	if newConfig.LogLevel != r.config.LogLevel {
+		zapLevel, err := logger.ZapLevel(newConfig.LogLevel)
+		if err != nil {
+			r.log.Error(err, "Failed applying log level")
+			return
+		}
+
+		r.logLevel.SetLevel(zapLevel)
+		r.log.Info("Applied new log level", "logLevel", newConfig.LogLevel)
+	}
+
+	if changedFeatureGates := reloadableFeatureGateChanges(newConfig.FeatureGates); len(changedFeatureGates) > 0 {
+		if err := features.DefaultFeatureGate.SetFromMap(changedFeatureGates); err != nil {
+			r.log.Error(err, "Failed applying feature gates")
+			return
+		}
+		r.log.Info("Applied new feature gates", "featureGates", changedFeatureGates)
+	}
+
+	if err := r.settings.Apply(newConfig); err != nil {
+		r.log.Error(err, "Failed applying controller concurrency and monitoring settings")
+		return
+	}
+
+	if fields := fieldsRequiringRestart(r.config, newConfig); len(fields) > 0 {
+		r.log.Error(fmt.Errorf("fields %s cannot be changed without a restart", strings.Join(fields, ", ")),
+			"Rejected configuration changes, keeping the current values until gardenlet is restarted", "fields", fields)
+	}
+
+	r.config = withReloadableFields(r.config, newConfig)
+}
+
+// reloadableFeatureGateChanges returns the reloadable feature gates whose values differ from the current values of the
+// feature gates. Feature gates which are not set in the given map are reset to their defaults.
+func reloadableFeatureGateChanges(featureGates map[string]bool) map[string]bool {
+	changed := map[string]bool{}
+	for _, feature := range gardenletfeatures.GetReloadableFeatures() {
+		enabled, ok := featureGates[string(feature)]
+		if !ok {
+			enabled = features.AllFeatureGates[feature].Default
+		}
+
+		if enabled != features.DefaultFeatureGate.Enabled(feature) {
+			changed[string(feature)] = enabled
+		}
+	}
+	return changed
+}
+
+// fieldsRequiringRestart returns the names of the top-level configuration fields which differ between the given
+// configurations and which cannot be applied without a restart.
+func fieldsRequiringRestart(oldConfig, newConfig *config.GardenletConfiguration) []string {
+	var (
+		oldValue = reflect.ValueOf(*withoutReloadableFields(oldConfig))
+		newValue = reflect.ValueOf(*withoutReloadableFields(newConfig))
+		fields   []string
+	)
+
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		if field.Anonymous {
+			continue
+		}
+
+		if !apiequality.Semantic.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			fields = append(fields, field.Name)
+		}
+	}
+
+	return fields
+}
+
+// withReloadableFields returns a copy of the current configuration in which the fields which can be applied without a
+// restart are taken from the new configuration.
+func withReloadableFields(currentConfig, newConfig *config.GardenletConfiguration) *config.GardenletConfiguration {
+	out := currentConfig.DeepCopy()
+	out.LogLevel = newConfig.LogLevel
+	out.Monitoring = newConfig.Monitoring.DeepCopy()
+
+	for _, feature := range gardenletfeatures.GetReloadableFeatures() {
+		delete(out.FeatureGates, string(feature))
+		if enabled, ok := newConfig.FeatureGates[string(feature)]; ok {
+			if out.FeatureGates == nil {
+				out.FeatureGates = map[string]bool{}
+			}
+			out.FeatureGates[string(feature)] = enabled
+		}
+	}
+
+	if out.Controllers != nil && newConfig.Controllers != nil {
+		if out.Controllers.Shoot != nil && newConfig.Controllers.Shoot != nil {
+			out.Controllers.Shoot.ConcurrentSyncs = newConfig.Controllers.Shoot.ConcurrentSyncs
+		}
+		if out.Controllers.ShootCare != nil && newConfig.Controllers.ShootCare != nil {
+			out.Controllers.ShootCare.ConcurrentSyncs = newConfig.Controllers.ShootCare.ConcurrentSyncs
+		}
+	}
+
+	return out
+}
+
+func withoutReloadableFields(cfg *config.GardenletConfiguration) *config.GardenletConfiguration {
+	out := cfg.DeepCopy()
+	out.LogLevel = ""
+	out.Monitoring = nil
+
+	for _, feature := range gardenletfeatures.GetReloadableFeatures() {
+		delete(out.FeatureGates, string(feature))
+	}
+
+	if out.Controllers != nil {
+		if out.Controllers.Shoot != nil {
+			out.Controllers.Shoot.ConcurrentSyncs = nil
+		}
+		if out.Controllers.ShootCare != nil {
+			out.Controllers.ShootCare.ConcurrentSyncs = nil
+		}
+	}
+
+	return out
+}
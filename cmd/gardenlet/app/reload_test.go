@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("configReloader", func() {
+	const configTemplate = `apiVersion: gardenlet.config.gardener.cloud/v1alpha1
+kind: GardenletConfiguration
+logLevel: %s
+featureGates:
+  DefaultSeccompProfile: %t
+  HVPA: %t
+controllers:
+  shoot:
+    concurrentSyncs: %d
+monitoring:
+  shoot:
+    remoteWrite:
+      url: %s
+seedConfig:
+  metadata:
+    name: local
+  spec:
+    provider:
+      type: local
+      region: local
+      zones:
+      - "0"
+    ingress:
+      domain: ingress.local.seed.local.gardener.cloud
+      controller:
+        kind: nginx
+    networks:
+      pods: 10.1.0.0/16
+      services: 10.2.0.0/16
+    dns:
+      provider:
+        type: local
+        secretRef:
+          name: dns
+          namespace: garden
+`
+
+	type configValues struct {
+		logLevel        string
+		seccompProfile  bool
+		hvpa            bool
+		concurrentSyncs int
+		remoteWriteURL  string
+	}
+
+	var (
+		configFile string
+		values     configValues
+		reloader   *configReloader
+	)
+
+	writeConfig := func() {
+		Expect(os.WriteFile(configFile, []byte(fmt.Sprintf(configTemplate, values.logLevel, values.seccompProfile, values.hvpa, values.concurrentSyncs, values.remoteWriteURL)), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.DefaultSeccompProfile, false))
+		DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, false))
+
+		configFile = filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		values = configValues{logLevel: "info", concurrentSyncs: 20, remoteWriteURL: "https://remote-write.example.com"}
+		writeConfig()
+
+		cfg, err := loadConfig(configFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		reloader = &configReloader{
+			log:        logr.Discard(),
+			configFile: configFile,
+			config:     cfg,
+			logLevel:   zap.NewAtomicLevelAt(zapcore.InfoLevel),
+			settings:   reload.NewSettings(cfg),
+		}
+	})
+
+	Describe("#reload", func() {
+		It("should apply a changed log level", func() {
+			values.logLevel = "debug"
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.logLevel.Level()).To(Equal(zapcore.DebugLevel))
+			Expect(reloader.config.LogLevel).To(Equal("debug"))
+		})
+
+		It("should apply a changed concurrency of the shoot controller", func() {
+			values.concurrentSyncs = 30
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.settings.ShootConcurrency.Limit()).To(Equal(30))
+			Expect(reloader.config.Controllers.Shoot.ConcurrentSyncs).To(Equal(ptr.To(30)))
+		})
+
+		It("should apply changed monitoring settings", func() {
+			values.remoteWriteURL = "https://other.example.com"
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.settings.Monitoring().Shoot.RemoteWrite.URL).To(Equal("https://other.example.com"))
+			Expect(reloader.config.Monitoring.Shoot.RemoteWrite.URL).To(Equal("https://other.example.com"))
+		})
+
+		It("should apply a changed reloadable feature gate", func() {
+			values.seccompProfile = true
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(features.DefaultFeatureGate.Enabled(features.DefaultSeccompProfile)).To(BeTrue())
+			Expect(reloader.config.FeatureGates).To(HaveKeyWithValue("DefaultSeccompProfile", true))
+		})
+
+		It("should keep the current configuration if the file cannot be loaded", func() {
+			Expect(os.WriteFile(configFile, []byte("{"), 0600)).To(Succeed())
+
+			reloader.reload()
+
+			Expect(reloader.logLevel.Level()).To(Equal(zapcore.InfoLevel))
+			Expect(reloader.config.LogLevel).To(Equal("info"))
+		})
+
+		It("should keep the current configuration if the reloaded configuration is invalid", func() {
+			values.logLevel = "foo"
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.logLevel.Level()).To(Equal(zapcore.InfoLevel))
+			Expect(reloader.config.LogLevel).To(Equal("info"))
+		})
+
+		It("should keep the current configuration if the concurrency exceeds the number of workers", func() {
+			values.logLevel = "debug"
+			values.concurrentSyncs = reload.MaxConcurrentSyncs + 1
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.logLevel.Level()).To(Equal(zapcore.InfoLevel))
+			Expect(reloader.settings.ShootConcurrency.Limit()).To(Equal(20))
+			Expect(reloader.config.Controllers.Shoot.ConcurrentSyncs).To(Equal(ptr.To(20)))
+		})
+
+		It("should apply the reloadable changes but reject the other changes", func() {
+			values.logLevel = "debug"
+			values.seccompProfile = true
+			values.hvpa = true
+			writeConfig()
+
+			reloader.reload()
+
+			Expect(reloader.logLevel.Level()).To(Equal(zapcore.DebugLevel))
+			Expect(reloader.config.LogLevel).To(Equal("debug"))
+			Expect(features.DefaultFeatureGate.Enabled(features.DefaultSeccompProfile)).To(BeTrue())
+			Expect(features.DefaultFeatureGate.Enabled(features.HVPA)).To(BeFalse())
+			Expect(reloader.config.FeatureGates).To(And(
+				HaveKeyWithValue("DefaultSeccompProfile", true),
+				HaveKeyWithValue("HVPA", false),
+			))
+		})
+	})
+
+	Describe("#fieldsRequiringRestart", func() {
+		It("should not report changes of the reloadable fields", func() {
+			oldConfig := &config.GardenletConfiguration{
+				LogLevel:     "info",
+				FeatureGates: map[string]bool{"DefaultSeccompProfile": false},
+				Controllers: &config.GardenletControllerConfiguration{
+					Shoot:     &config.ShootControllerConfiguration{ConcurrentSyncs: ptr.To(20)},
+					ShootCare: &config.ShootCareControllerConfiguration{ConcurrentSyncs: ptr.To(5)},
+				},
+			}
+			newConfig := &config.GardenletConfiguration{
+				LogLevel:     "debug",
+				FeatureGates: map[string]bool{"DefaultSeccompProfile": true, "RuntimeSecurity": true},
+				Controllers: &config.GardenletControllerConfiguration{
+					Shoot:     &config.ShootControllerConfiguration{ConcurrentSyncs: ptr.To(30)},
+					ShootCare: &config.ShootCareControllerConfiguration{ConcurrentSyncs: ptr.To(10)},
+				},
+				Monitoring: &config.MonitoringConfig{Shoot: &config.ShootMonitoringConfig{}},
+			}
+
+			Expect(fieldsRequiringRestart(oldConfig, newConfig)).To(BeEmpty())
+		})
+
+		It("should report all other changed fields", func() {
+			oldConfig := &config.GardenletConfiguration{
+				LogLevel:     "info",
+				FeatureGates: map[string]bool{"Foo": true},
+			}
+			newConfig := &config.GardenletConfiguration{
+				LogLevel:     "debug",
+				FeatureGates: map[string]bool{"Foo": false},
+				Controllers: &config.GardenletControllerConfiguration{
+					Shoot: &config.ShootControllerConfiguration{RespectSyncPeriodOverwrite: ptr.To(true)},
+				},
+			}
+
+			Expect(fieldsRequiringRestart(oldConfig, newConfig)).To(HaveExactElements("Controllers", "FeatureGates"))
+		})
+	})
+})
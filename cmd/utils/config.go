@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+const validateConfigFlagName = "validate-config"
+
+// ConfigOptions is implemented by Options which load a component configuration file.
+type ConfigOptions interface {
+	// EffectiveConfig returns the effective component configuration (i.e., including defaults) encoded as YAML.
+	EffectiveConfig() ([]byte, error)
+}
+
+// AddValidateConfigFlag adds the --validate-config flag to the given flag set.
+func AddValidateConfigFlag(fs *pflag.FlagSet) {
+	fs.Bool(validateConfigFlagName, false, "Validate the configuration file, print the effective configuration and exit.")
+}
+
+// ErrConfigPrinted is returned by InitRun if the effective configuration was printed because the --validate-config
+// flag was set. Commands must exit successfully without running the component in this case.
+var ErrConfigPrinted = errors.New("effective configuration printed")
+
+// PrintConfigIfRequested prints the effective configuration if the --validate-config flag was set and returns true in
+// this case. It must only be called after the options have been completed and validated successfully.
+func PrintConfigIfRequested(cmd *cobra.Command, opts Options) (bool, error) {
+	if cmd.Flags().Lookup(validateConfigFlagName) == nil {
+		return false, nil
+	}
+
+	validateConfig, err := cmd.Flags().GetBool(validateConfigFlagName)
+	if err != nil {
+		return false, fmt.Errorf("failed reading --%s flag: %w", validateConfigFlagName, err)
+	}
+	if !validateConfig {
+		return false, nil
+	}
+
+	configOptions, ok := opts.(ConfigOptions)
+	if !ok {
+		return false, fmt.Errorf("--%s is not supported by this component", validateConfigFlagName)
+	}
+
+	config, err := configOptions.EffectiveConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed encoding effective configuration: %w", err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), string(config))
+	return true, nil
+}
+
+// EncodeConfig encodes the given configuration object as YAML in the given version.
+func EncodeConfig(codecs serializer.CodecFactory, obj runtime.Object, gv schema.GroupVersion) ([]byte, error) {
+	info, ok := runtime.SerializerInfoForMediaType(codecs.SupportedMediaTypes(), runtime.ContentTypeYAML)
+	if !ok {
+		return nil, fmt.Errorf("unable to locate encoder for media type %q", runtime.ContentTypeYAML)
+	}
+
+	return runtime.Encode(codecs.EncoderForVersion(info.Serializer, gv), obj)
+}
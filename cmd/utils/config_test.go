@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	. "github.com/gardener/gardener/cmd/utils"
+)
+
+type fakeOptions struct{}
+
+func (fakeOptions) Complete() error                  { return nil }
+func (fakeOptions) Validate() error                  { return nil }
+func (fakeOptions) LogConfig() (string, string)      { return "info", "json" }
+func (fakeOptions) EffectiveConfig() ([]byte, error) { return []byte("foo: bar\n"), nil }
+
+type fakeOptionsWithoutConfig struct{}
+
+func (fakeOptionsWithoutConfig) Complete() error             { return nil }
+func (fakeOptionsWithoutConfig) Validate() error             { return nil }
+func (fakeOptionsWithoutConfig) LogConfig() (string, string) { return "info", "json" }
+
+var _ = Describe("Config", func() {
+	var (
+		cmd *cobra.Command
+		out *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		cmd = &cobra.Command{}
+		cmd.SetOut(out)
+	})
+
+	Describe("#PrintConfigIfRequested", func() {
+		It("should do nothing if the flag is not defined", func() {
+			Expect(PrintConfigIfRequested(cmd, fakeOptions{})).To(BeFalse())
+			Expect(out.String()).To(BeEmpty())
+		})
+
+		It("should do nothing if the flag is not set", func() {
+			AddValidateConfigFlag(cmd.Flags())
+
+			Expect(PrintConfigIfRequested(cmd, fakeOptions{})).To(BeFalse())
+			Expect(out.String()).To(BeEmpty())
+		})
+
+		It("should print the effective configuration if the flag is set", func() {
+			AddValidateConfigFlag(cmd.Flags())
+			Expect(cmd.Flags().Set("validate-config", "true")).To(Succeed())
+
+			Expect(PrintConfigIfRequested(cmd, fakeOptions{})).To(BeTrue())
+			Expect(out.String()).To(Equal("foo: bar\n"))
+		})
+
+		It("should fail if the component does not support printing its configuration", func() {
+			AddValidateConfigFlag(cmd.Flags())
+			Expect(cmd.Flags().Set("validate-config", "true")).To(Succeed())
+
+			printed, err := PrintConfigIfRequested(cmd, fakeOptionsWithoutConfig{})
+			Expect(err).To(MatchError(ContainSubstring("not supported")))
+			Expect(printed).To(BeFalse())
+		})
+
+		It("should fail if the flag has an unexpected type", func() {
+			cmd.Flags().String("validate-config", "", "")
+
+			printed, err := PrintConfigIfRequested(cmd, fakeOptions{})
+			Expect(err).To(MatchError(ContainSubstring("failed reading --validate-config flag")))
+			Expect(printed).To(BeFalse())
+		})
+	})
+
+	Describe("#InitRun", func() {
+		It("should return ErrConfigPrinted if the effective configuration was printed", func() {
+			AddValidateConfigFlag(cmd.Flags())
+			Expect(cmd.Flags().Set("validate-config", "true")).To(Succeed())
+
+			_, err := InitRun(cmd, fakeOptions{}, "test")
+			Expect(err).To(MatchError(ErrConfigPrinted))
+			Expect(out.String()).To(Equal("foo: bar\n"))
+		})
+	})
+})
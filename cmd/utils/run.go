@@ -10,10 +10,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"go.uber.org/zap"
 	"k8s.io/component-base/version"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/klog/v2"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -28,8 +30,15 @@ type Options interface {
 	LogConfig() (logLevel, logFormat string)
 }
 
+// AtomicLogLevelOptions is implemented by Options which support changing the log level at runtime.
+type AtomicLogLevelOptions interface {
+	// AtomicLogLevel returns the level of the logger which can be changed at runtime.
+	AtomicLogLevel() zap.AtomicLevel
+}
+
 // InitRun initializes the run command by completing and validating the options, creating and settings a logger,
-// printing all command line flags, and configuring command settings.
+// printing all command line flags, and configuring command settings. It returns ErrConfigPrinted if only the effective
+// configuration was requested.
 func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error) {
 	verflag.PrintAndExitIfRequested()
 
@@ -41,8 +50,19 @@ func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error)
 		return logr.Discard(), err
 	}
 
+	if printed, err := PrintConfigIfRequested(cmd, opts); err != nil {
+		return logr.Discard(), err
+	} else if printed {
+		return logr.Discard(), ErrConfigPrinted
+	}
+
 	logLevel, logFormat := opts.LogConfig()
-	log, err := logger.NewZapLogger(logLevel, logFormat)
+	var additionalOpts []logzap.Opts
+	if o, ok := opts.(AtomicLogLevelOptions); ok {
+		additionalOpts = append(additionalOpts, logzap.Level(o.AtomicLogLevel()))
+	}
+
+	log, err := logger.NewZapLogger(logLevel, logFormat, additionalOpts...)
 	if err != nil {
 		return logr.Discard(), fmt.Errorf("error instantiating zap logger: %w", err)
 	}
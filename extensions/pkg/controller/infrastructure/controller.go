@@ -33,6 +33,8 @@ type AddArgs struct {
 	Actuator Actuator
 	// ConfigValidator is an Infrastructure config validator.
 	ConfigValidator ConfigValidator
+	// Importer is an optional Infrastructure importer which adopts the existing infrastructure of imported shoots.
+	Importer Importer
 	// ControllerOptions are the controller options used for creating a controller.
 	// The options.Reconciler is always overridden with a reconciler created from the
 	// given actuator.
@@ -62,7 +64,7 @@ func DefaultPredicates(ctx context.Context, mgr manager.Manager, ignoreOperation
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(ctx context.Context, mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ConfigValidator, args.KnownCodes)
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ConfigValidator, args.Importer, args.KnownCodes)
 	return add(ctx, mgr, args)
 }
 
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Importer adopts the existing resources of clusters which are imported into Gardener as shoots.
+type Importer interface {
+	// Import discovers the existing resources in the cloud provider which belong to the given Infrastructure and
+	// stores them in its state, so that they are adopted by the subsequent restoration instead of being recreated.
+	// It is only called while restoring Infrastructures of shoots with the `shoot.gardener.cloud/import` annotation.
+	Import(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error
+}
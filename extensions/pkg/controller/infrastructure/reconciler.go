@@ -30,6 +30,7 @@ import (
 type reconciler struct {
 	actuator        Actuator
 	configValidator ConfigValidator
+	importer        Importer
 	knownCodes      map[gardencorev1beta1.ErrorCode]func(string) bool
 
 	client        client.Client
@@ -39,13 +40,14 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // infrastructure resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator, configValidator ConfigValidator, knownCodes map[gardencorev1beta1.ErrorCode]func(string) bool) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, configValidator ConfigValidator, importer Importer, knownCodes map[gardencorev1beta1.ErrorCode]func(string) bool) reconcile.Reconciler {
 	return reconcilerutils.OperationAnnotationWrapper(
 		mgr,
 		func() client.Object { return &extensionsv1alpha1.Infrastructure{} },
 		&reconciler{
 			actuator:        actuator,
 			configValidator: configValidator,
+			importer:        importer,
 			knownCodes:      knownCodes,
 			client:          mgr.GetClient(),
 			reader:          mgr.GetAPIReader(),
@@ -226,6 +228,14 @@ func (r *reconciler) restore(
 		return reconcile.Result{}, err
 	}
 
+	if r.importer != nil && v1beta1helper.IsShootImport(cluster.Shoot) {
+		log.Info("Importing the existing Infrastructure")
+		if err := r.importer.Import(ctx, log, infrastructure, cluster); err != nil {
+			_ = r.statusUpdater.Error(ctx, log, infrastructure, reconcilerutils.ReconcileErrCauseOrErr(err), gardencorev1beta1.LastOperationTypeRestore, "Error importing Infrastructure")
+			return reconcilerutils.ReconcileErr(err)
+		}
+	}
+
 	if err := r.actuator.Restore(ctx, log, infrastructure, cluster); err != nil {
 		_ = r.statusUpdater.Error(ctx, log, infrastructure, reconcilerutils.ReconcileErrCauseOrErr(err), gardencorev1beta1.LastOperationTypeRestore, "Error restoring Infrastructure")
 		return reconcilerutils.ReconcileErr(err)
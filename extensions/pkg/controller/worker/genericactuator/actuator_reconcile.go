@@ -28,6 +28,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
@@ -93,6 +94,11 @@ func (a *genericActuator) Reconcile(ctx context.Context, log logr.Logger, worker
 
 	// Wait until all generated machine deployments are healthy/available.
 	if err := a.waitUntilWantedMachineDeploymentsAvailable(ctx, log, cluster, worker, existingMachineDeployments, existingMachineClassNames, wantedMachineDeployments); err != nil {
+		// Machine deployments which are rolled out progressively are soaking, hence check again after the soak period.
+		if requeueAfterErr := (&reconcilerutils.RequeueAfterError{}); errors.As(err, &requeueAfterErr) {
+			return requeueAfterErr
+		}
+
 		// check if the machine-controller-manager is stuck
 		isStuck, msg, err2 := a.IsMachineControllerStuck(ctx, worker)
 		if err2 != nil {
@@ -229,6 +235,15 @@ func deployMachineDeployments(
 			}
 		}
 
+		var (
+			pool    = findWorkerPoolForMachineDeployment(worker, deployment.Labels)
+			rollout *canaryRollout
+		)
+
+		if !extensionscontroller.IsHibernationEnabled(cluster) {
+			rollout = computeCanaryRollout(existingMachineDeployment, deployment.ClassName, pool, deployment.MaxSurge, deployment.MaxUnavailable, replicas, time.Now())
+		}
+
 		machineDeployment := &machinev1alpha1.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      deployment.Name,
@@ -240,6 +255,9 @@ func deployMachineDeployments(
 			for k, v := range deployment.ClusterAutoscalerAnnotations {
 				metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, k, v)
 			}
+			if pool != nil && pool.KubernetesVersion != nil {
+				metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, annotationKubernetesVersion, *pool.KubernetesVersion)
+			}
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
 				Replicas:        replicas,
 				MinReadySeconds: 500,
@@ -275,6 +293,10 @@ func deployMachineDeployments(
 					},
 				},
 			}
+			applyCanaryRollout(machineDeployment, rollout)
+			if rollout != nil {
+				log.Info("Machine deployment is rolled out progressively", "machineDeploymentName", machineDeployment.Name, "state", rollout.message)
+			}
 			log.Info("Deploying machine deployment", "machineDeploymentName", machineDeployment.Name, "replicas", machineDeployment.Spec.Replicas)
 			return nil
 		}); err != nil {
@@ -296,7 +318,11 @@ func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context
 	log.Info("Waiting until wanted machine deployments are available")
 
 	return retryutils.UntilTimeout(ctx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
-		var numHealthyDeployments, numUpdated, numAvailable, numUnavailable, numDesired, numberOfAwakeMachines int32
+		var (
+			numHealthyDeployments, numUpdated, numAvailable, numUnavailable, numDesired, numberOfAwakeMachines int32
+			canaryMessages                                                                                     []string
+			canarySoakingUntil                                                                                 *time.Time
+		)
 
 		// Get the list of all machine deployments
 		machineDeployments := &machinev1alpha1.MachineDeploymentList{}
@@ -343,6 +369,19 @@ func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context
 			alreadyExistingMachineDeployment := alreadyExistingMachineDeploymentNames.Has(wantedDeployment.Name)
 			newMachineClass := !alreadyExistingMachineClassNames.Has(wantedDeployment.ClassName)
 
+			// Progress the rollout of machine deployments which are rolled out progressively, i.e., pause it after each canary
+			// step and continue once the soak period has passed and all machines are healthy.
+			rollout, err := progressCanaryRollout(ctx, a.seedClient, &deployment, wantedDeployment.ClassName, findWorkerPoolForMachineDeployment(worker, wantedDeployment.Labels), wantedDeployment.MaxSurge, wantedDeployment.MaxUnavailable, time.Now())
+			if err != nil {
+				return retryutils.SevereError(fmt.Errorf("failed progressing the canary rollout of machine deployment %s: %w", client.ObjectKeyFromObject(&deployment), err))
+			}
+			if rollout != nil && rollout.paused {
+				canaryMessages = append(canaryMessages, fmt.Sprintf("%s: %s", deployment.Name, rollout.message))
+				if rollout.soakingUntil != nil && (canarySoakingUntil == nil || rollout.soakingUntil.Before(*canarySoakingUntil)) {
+					canarySoakingUntil = rollout.soakingUntil
+				}
+			}
+
 			if alreadyExistingMachineDeployment && newMachineClass {
 				log.Info("Machine deployment is performing a rolling update", "machineDeployment", &deployment)
 				// Already existing machine deployments with a rolling update should have > 1 machine sets
@@ -379,6 +418,15 @@ func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context
 				return retryutils.Ok()
 			}
 
+			if len(canaryMessages) > 0 {
+				msg = fmt.Sprintf("Waiting for the canary rollout of machine deployments (%s)...", strings.Join(canaryMessages, ", "))
+				if canarySoakingUntil != nil {
+					log.Info(msg) //nolint:logcheck
+					return retryutils.SevereError(&reconcilerutils.RequeueAfterError{RequeueAfter: time.Until(*canarySoakingUntil), Cause: errors.New(msg)})
+				}
+				break
+			}
+
 			if numUnavailable == 0 && numAvailable == numDesired && numUpdated < numberOfAwakeMachines {
 				msg = fmt.Sprintf("Waiting until all old machines are drained and terminated. Waiting for %d machine(s)...", numberOfAwakeMachines-numUpdated)
 				break
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// annotationKubernetesVersion is the annotation on MachineDeployments containing the Kubernetes version of the
+	// worker pool they were last deployed for. It is used to detect Kubernetes minor version upgrades.
+	annotationKubernetesVersion = "worker.gardener.cloud/kubernetes-version"
+	// annotationCanaryMachineClass is the annotation on MachineDeployments containing the name of the machine class
+	// which is rolled out progressively.
+	annotationCanaryMachineClass = "worker.gardener.cloud/canary-machine-class"
+	// annotationCanaryStep is the annotation on MachineDeployments containing the index of the current canary step.
+	annotationCanaryStep = "worker.gardener.cloud/canary-step"
+	// annotationCanaryStepReachedAt is the annotation on MachineDeployments containing the time at which the machines
+	// of the current canary step were rolled.
+	annotationCanaryStepReachedAt = "worker.gardener.cloud/canary-step-reached-at"
+)
+
+var canaryAnnotations = []string{annotationCanaryMachineClass, annotationCanaryStep, annotationCanaryStepReachedAt}
+
+// canaryRollout is the desired state of a machine deployment which is rolled out progressively.
+type canaryRollout struct {
+	// annotations are the canary annotations of the machine deployment.
+	annotations map[string]string
+	// paused specifies whether the rollout of the machine deployment is paused.
+	paused bool
+	// maxSurge is the maximum number of machines created during the rollout.
+	maxSurge intstr.IntOrString
+	// maxUnavailable is the maximum number of machines that can be unavailable during the rollout.
+	maxUnavailable intstr.IntOrString
+	// soakingUntil is the time until which the machines of the current canary step soak.
+	soakingUntil *time.Time
+	// message describes the state of the rollout.
+	message string
+}
+
+// computeCanaryRollout computes the desired state of the given machine deployment if it is rolled out progressively,
+// i.e., if the worker pool has a rolling update configuration and its Kubernetes minor version is upgraded. The
+// machines are rolled in the configured canary steps. After each step, the rollout is paused until the soak period
+// has passed and all machines are healthy. It returns nil if the machine deployment is not rolled out progressively.
+func computeCanaryRollout(
+	existing *machinev1alpha1.MachineDeployment,
+	className string,
+	pool *extensionsv1alpha1.WorkerPool,
+	maxSurge, maxUnavailable intstr.IntOrString,
+	replicas int32,
+	now time.Time,
+) *canaryRollout {
+	if existing == nil || pool == nil || pool.RollingUpdate == nil || len(pool.RollingUpdate.CanarySteps) == 0 || replicas == 0 {
+		return nil
+	}
+
+	var (
+		rollingUpdate   = pool.RollingUpdate
+		step            int
+		stepReachedAt   *time.Time
+		classIsDeployed = existing.Spec.Template.Spec.Class.Name == className
+	)
+
+	if existing.Annotations[annotationCanaryMachineClass] == className {
+		step, _ = strconv.Atoi(existing.Annotations[annotationCanaryStep])
+		if t, err := time.Parse(time.RFC3339, existing.Annotations[annotationCanaryStepReachedAt]); err == nil {
+			stepReachedAt = &t
+		}
+	} else if classIsDeployed || !isMinorVersionUpgrade(existing.Annotations[annotationKubernetesVersion], pool.KubernetesVersion) {
+		return nil
+	}
+
+	rollout := &canaryRollout{
+		annotations:    map[string]string{annotationCanaryMachineClass: className, annotationCanaryStep: strconv.Itoa(step)},
+		maxSurge:       ptr.Deref(rollingUpdate.MaxSurge, maxSurge),
+		maxUnavailable: ptr.Deref(rollingUpdate.MaxUnavailable, maxUnavailable),
+	}
+
+	if step >= len(rollingUpdate.CanarySteps) {
+		rollout.maxSurge, rollout.maxUnavailable = maxSurge, maxUnavailable
+		rollout.message = "all canary steps completed"
+		return rollout
+	}
+
+	var (
+		stepDescription = fmt.Sprintf("canary step %d/%d", step+1, len(rollingUpdate.CanarySteps))
+		target          = canaryStepReplicas(rollingUpdate.CanarySteps[step], replicas)
+		updated         int32
+	)
+
+	// The updated replicas of the machine deployment only refer to the new machine class once it has been deployed.
+	if classIsDeployed {
+		updated = existing.Status.UpdatedReplicas
+	}
+
+	if updated < target {
+		rollout.message = fmt.Sprintf("rolling %s (%d/%d machine(s) updated)", stepDescription, updated, target)
+		return rollout
+	}
+
+	rollout.paused = true
+	if stepReachedAt == nil {
+		stepReachedAt = &now
+	}
+	rollout.annotations[annotationCanaryStepReachedAt] = stepReachedAt.UTC().Format(time.RFC3339)
+
+	if soakingUntil := stepReachedAt.Add(ptr.Deref(rollingUpdate.SoakPeriod, metav1.Duration{}).Duration); now.Before(soakingUntil) {
+		rollout.soakingUntil = &soakingUntil
+		rollout.message = fmt.Sprintf("%s rolled, soaking until %s", stepDescription, soakingUntil.UTC().Format(time.RFC3339))
+		return rollout
+	}
+
+	if !machineDeploymentHealthy(existing, replicas) {
+		rollout.message = fmt.Sprintf("%s rolled, waiting until all machines are healthy before continuing", stepDescription)
+		return rollout
+	}
+
+	// The machines of the current step are healthy, hence continue with the next step.
+	rollout.paused = false
+	rollout.annotations = map[string]string{annotationCanaryMachineClass: className, annotationCanaryStep: strconv.Itoa(step + 1)}
+	rollout.message = fmt.Sprintf("%s completed", stepDescription)
+	if step+1 == len(rollingUpdate.CanarySteps) {
+		rollout.maxSurge, rollout.maxUnavailable = maxSurge, maxUnavailable
+	}
+
+	return rollout
+}
+
+// applyCanaryRollout applies the given canary rollout to the machine deployment. If the rollout is nil, the canary
+// annotations are removed and the machine deployment is not paused.
+func applyCanaryRollout(machineDeployment *machinev1alpha1.MachineDeployment, rollout *canaryRollout) {
+	for _, key := range canaryAnnotations {
+		delete(machineDeployment.Annotations, key)
+	}
+
+	if rollout == nil {
+		machineDeployment.Spec.Paused = false
+		return
+	}
+
+	for key, value := range rollout.annotations {
+		metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, key, value)
+	}
+	machineDeployment.Spec.Paused = rollout.paused
+	if machineDeployment.Spec.Strategy.RollingUpdate == nil {
+		machineDeployment.Spec.Strategy.RollingUpdate = &machinev1alpha1.RollingUpdateMachineDeployment{}
+	}
+	machineDeployment.Spec.Strategy.RollingUpdate.MaxSurge = &rollout.maxSurge
+	machineDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = &rollout.maxUnavailable
+}
+
+// progressCanaryRollout computes the canary rollout of the given machine deployment and patches it accordingly.
+func progressCanaryRollout(
+	ctx context.Context,
+	c client.Client,
+	machineDeployment *machinev1alpha1.MachineDeployment,
+	className string,
+	pool *extensionsv1alpha1.WorkerPool,
+	maxSurge, maxUnavailable intstr.IntOrString,
+	now time.Time,
+) (*canaryRollout, error) {
+	rollout := computeCanaryRollout(machineDeployment, className, pool, maxSurge, maxUnavailable, machineDeployment.Spec.Replicas, now)
+	if rollout == nil {
+		return nil, nil
+	}
+
+	patch := client.MergeFrom(machineDeployment.DeepCopy())
+	applyCanaryRollout(machineDeployment, rollout)
+	return rollout, c.Patch(ctx, machineDeployment, patch)
+}
+
+// findWorkerPoolForMachineDeployment returns the worker pool of the given wanted machine deployment based on its node
+// labels.
+func findWorkerPoolForMachineDeployment(worker *extensionsv1alpha1.Worker, labels map[string]string) *extensionsv1alpha1.WorkerPool {
+	poolName, ok := labels[v1beta1constants.LabelWorkerPool]
+	if !ok {
+		return nil
+	}
+
+	for i, pool := range worker.Spec.Pools {
+		if pool.Name == poolName {
+			return &worker.Spec.Pools[i]
+		}
+	}
+	return nil
+}
+
+// canaryStepReplicas returns the number of machines to be rolled for the given canary step. Percentages are rounded up
+// and at least one machine is rolled.
+func canaryStepReplicas(step intstr.IntOrString, replicas int32) int32 {
+	value, err := intstr.GetScaledValueFromIntOrPercent(&step, int(replicas), true)
+	if err != nil {
+		return replicas
+	}
+	return min(max(int32(value), 1), replicas)
+}
+
+// machineDeploymentHealthy returns true if all machines of the given machine deployment are available.
+func machineDeploymentHealthy(machineDeployment *machinev1alpha1.MachineDeployment, replicas int32) bool {
+	return machineDeployment.Status.UnavailableReplicas == 0 &&
+		machineDeployment.Status.AvailableReplicas >= replicas &&
+		len(machineDeployment.Status.FailedMachines) == 0
+}
+
+// isMinorVersionUpgrade returns true if the new Kubernetes version has a higher minor version than the old one.
+func isMinorVersionUpgrade(oldVersion string, newVersion *string) bool {
+	if oldVersion == "" || newVersion == nil {
+		return false
+	}
+
+	oldSemver, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return false
+	}
+	newSemver, err := semver.NewVersion(*newVersion)
+	if err != nil {
+		return false
+	}
+
+	return newSemver.Major() > oldSemver.Major() || (newSemver.Major() == oldSemver.Major() && newSemver.Minor() > oldSemver.Minor())
+}
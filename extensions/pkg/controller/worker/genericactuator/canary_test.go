@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericactuator
+
+import (
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Canary rollout", func() {
+	const (
+		oldClassName = "class-old"
+		newClassName = "class-new"
+	)
+
+	var (
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		maxSurge       = intstr.FromInt32(3)
+		maxUnavailable = intstr.FromInt32(1)
+
+		pool     *extensionsv1alpha1.WorkerPool
+		existing *machinev1alpha1.MachineDeployment
+	)
+
+	BeforeEach(func() {
+		pool = &extensionsv1alpha1.WorkerPool{
+			Name:              "pool",
+			KubernetesVersion: ptr.To("1.31.1"),
+			RollingUpdate: &gardencorev1beta1.WorkerRollingUpdate{
+				MaxSurge:       ptr.To(intstr.FromInt32(1)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				CanarySteps:    []intstr.IntOrString{intstr.FromInt32(1), intstr.FromString("50%")},
+				SoakPeriod:     &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		}
+
+		existing = &machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pool-z1",
+				Annotations: map[string]string{annotationKubernetesVersion: "1.30.5"},
+			},
+			Spec: machinev1alpha1.MachineDeploymentSpec{
+				Replicas: 4,
+				Template: machinev1alpha1.MachineTemplateSpec{Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: oldClassName}}},
+			},
+			Status: machinev1alpha1.MachineDeploymentStatus{UpdatedReplicas: 4, AvailableReplicas: 4},
+		}
+	})
+
+	Describe("#computeCanaryRollout", func() {
+		It("should return nil if the worker pool has no rolling update configuration", func() {
+			pool.RollingUpdate = nil
+
+			Expect(computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(BeNil())
+		})
+
+		It("should return nil if the machine deployment does not exist yet", func() {
+			Expect(computeCanaryRollout(nil, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(BeNil())
+		})
+
+		It("should return nil if the Kubernetes minor version is not upgraded", func() {
+			existing.Annotations[annotationKubernetesVersion] = "1.31.0"
+
+			Expect(computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(BeNil())
+		})
+
+		It("should return nil if the machine class does not change", func() {
+			Expect(computeCanaryRollout(existing, oldClassName, pool, maxSurge, maxUnavailable, 4, now)).To(BeNil())
+		})
+
+		It("should start the canary rollout with the canary surge settings", func() {
+			Expect(computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(Equal(&canaryRollout{
+				annotations:    map[string]string{annotationCanaryMachineClass: newClassName, annotationCanaryStep: "0"},
+				maxSurge:       intstr.FromInt32(1),
+				maxUnavailable: intstr.FromInt32(0),
+				message:        "rolling canary step 1/2 (0/1 machine(s) updated)",
+			}))
+		})
+
+		Context("canary rollout in progress", func() {
+			BeforeEach(func() {
+				existing.Annotations[annotationKubernetesVersion] = "1.31.1"
+				existing.Annotations[annotationCanaryMachineClass] = newClassName
+				existing.Annotations[annotationCanaryStep] = "0"
+				existing.Spec.Template.Spec.Class.Name = newClassName
+				existing.Status.UpdatedReplicas = 0
+			})
+
+			It("should continue rolling until the canary step is reached", func() {
+				rollout := computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)
+
+				Expect(rollout.paused).To(BeFalse())
+				Expect(rollout.annotations).To(Equal(map[string]string{annotationCanaryMachineClass: newClassName, annotationCanaryStep: "0"}))
+			})
+
+			It("should pause the rollout once the canary step is reached", func() {
+				existing.Status.UpdatedReplicas = 1
+
+				Expect(computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(Equal(&canaryRollout{
+					annotations: map[string]string{
+						annotationCanaryMachineClass:  newClassName,
+						annotationCanaryStep:          "0",
+						annotationCanaryStepReachedAt: "2024-01-01T12:00:00Z",
+					},
+					paused:         true,
+					maxSurge:       intstr.FromInt32(1),
+					maxUnavailable: intstr.FromInt32(0),
+					soakingUntil:   ptr.To(now.Add(10 * time.Minute)),
+					message:        "canary step 1/2 rolled, soaking until 2024-01-01T12:10:00Z",
+				}))
+			})
+
+			It("should keep the rollout paused until all machines are healthy", func() {
+				existing.Annotations[annotationCanaryStepReachedAt] = "2024-01-01T11:45:00Z"
+				existing.Status.UpdatedReplicas = 1
+				existing.Status.UnavailableReplicas = 1
+
+				rollout := computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)
+
+				Expect(rollout.paused).To(BeTrue())
+				Expect(rollout.soakingUntil).To(BeNil())
+				Expect(rollout.message).To(Equal("canary step 1/2 rolled, waiting until all machines are healthy before continuing"))
+			})
+
+			It("should continue with the next step after the soak period if all machines are healthy", func() {
+				existing.Annotations[annotationCanaryStepReachedAt] = "2024-01-01T11:45:00Z"
+				existing.Status.UpdatedReplicas = 1
+
+				Expect(computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)).To(Equal(&canaryRollout{
+					annotations:    map[string]string{annotationCanaryMachineClass: newClassName, annotationCanaryStep: "1"},
+					maxSurge:       intstr.FromInt32(1),
+					maxUnavailable: intstr.FromInt32(0),
+					message:        "canary step 1/2 completed",
+				}))
+			})
+
+			It("should use the surge settings of the worker pool after the last step", func() {
+				existing.Annotations[annotationCanaryStep] = "1"
+				existing.Annotations[annotationCanaryStepReachedAt] = "2024-01-01T11:45:00Z"
+				existing.Status.UpdatedReplicas = 2
+
+				rollout := computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)
+
+				Expect(rollout.paused).To(BeFalse())
+				Expect(rollout.annotations).To(HaveKeyWithValue(annotationCanaryStep, "2"))
+				Expect(rollout.maxSurge).To(Equal(maxSurge))
+				Expect(rollout.maxUnavailable).To(Equal(maxUnavailable))
+
+				existing.Annotations[annotationCanaryStep] = "2"
+				delete(existing.Annotations, annotationCanaryStepReachedAt)
+
+				rollout = computeCanaryRollout(existing, newClassName, pool, maxSurge, maxUnavailable, 4, now)
+
+				Expect(rollout.paused).To(BeFalse())
+				Expect(rollout.maxSurge).To(Equal(maxSurge))
+				Expect(rollout.maxUnavailable).To(Equal(maxUnavailable))
+				Expect(rollout.message).To(Equal("all canary steps completed"))
+			})
+		})
+	})
+
+	Describe("#applyCanaryRollout", func() {
+		It("should set the canary annotations and the rollout settings", func() {
+			applyCanaryRollout(existing, &canaryRollout{
+				annotations:    map[string]string{annotationCanaryMachineClass: newClassName, annotationCanaryStep: "1"},
+				paused:         true,
+				maxSurge:       intstr.FromInt32(1),
+				maxUnavailable: intstr.FromInt32(0),
+			})
+
+			Expect(existing.Annotations).To(Equal(map[string]string{
+				annotationKubernetesVersion:  "1.30.5",
+				annotationCanaryMachineClass: newClassName,
+				annotationCanaryStep:         "1",
+			}))
+			Expect(existing.Spec.Paused).To(BeTrue())
+			Expect(existing.Spec.Strategy.RollingUpdate.MaxSurge).To(PointTo(Equal(intstr.FromInt32(1))))
+			Expect(existing.Spec.Strategy.RollingUpdate.MaxUnavailable).To(PointTo(Equal(intstr.FromInt32(0))))
+		})
+
+		It("should remove the canary annotations and unpause the machine deployment", func() {
+			existing.Annotations[annotationCanaryMachineClass] = newClassName
+			existing.Annotations[annotationCanaryStep] = "1"
+			existing.Annotations[annotationCanaryStepReachedAt] = "2024-01-01T11:45:00Z"
+			existing.Spec.Paused = true
+
+			applyCanaryRollout(existing, nil)
+
+			Expect(existing.Annotations).To(Equal(map[string]string{annotationKubernetesVersion: "1.30.5"}))
+			Expect(existing.Spec.Paused).To(BeFalse())
+		})
+	})
+
+	DescribeTable("#canaryStepReplicas",
+		func(step intstr.IntOrString, replicas, expected int32) {
+			Expect(canaryStepReplicas(step, replicas)).To(Equal(expected))
+		},
+
+		Entry("number", intstr.FromInt32(2), int32(10), int32(2)),
+		Entry("number larger than replicas", intstr.FromInt32(20), int32(10), int32(10)),
+		Entry("percentage rounded up", intstr.FromString("25%"), int32(10), int32(3)),
+		Entry("percentage with at least one machine", intstr.FromString("1%"), int32(10), int32(1)),
+	)
+
+	DescribeTable("#isMinorVersionUpgrade",
+		func(oldVersion string, newVersion *string, expected bool) {
+			Expect(isMinorVersionUpgrade(oldVersion, newVersion)).To(Equal(expected))
+		},
+
+		Entry("minor upgrade", "1.30.5", ptr.To("1.31.0"), true),
+		Entry("major upgrade", "1.30.5", ptr.To("2.0.0"), true),
+		Entry("patch upgrade", "1.30.5", ptr.To("1.30.6"), false),
+		Entry("same version", "1.30.5", ptr.To("1.30.5"), false),
+		Entry("unknown old version", "", ptr.To("1.31.0"), false),
+		Entry("unknown new version", "1.30.5", nil, false),
+	)
+})
@@ -148,6 +148,10 @@ func WorkerPoolHashV1(pool extensionsv1alpha1.WorkerPool, cluster *extensionscon
 		}
 	}
 
+	if networking := cluster.Shoot.Status.Networking; networking != nil && networking.DualStackMigrationInitiationTime != nil {
+		data = append(data, networking.DualStackMigrationInitiationTime.Time.String())
+	}
+
 	if helper.IsNodeLocalDNSEnabled(cluster.Shoot.Spec.SystemComponents) {
 		data = append(data, "node-local-dns")
 	}
@@ -276,6 +276,10 @@ var _ = Describe("Machines", func() {
 				c.Shoot.Status.Credentials.Rotation.ServiceAccountKey = credentialStatusWithInitiatedRotation
 			})
 
+			It("when a migration to dual-stack networking is triggered", func() {
+				c.Shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{DualStackMigrationInitiationTime: &metav1.Time{Time: lastCARotationInitiation.Add(time.Hour)}}
+			})
+
 			It("when enabling node local dns via specification", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}
 			})
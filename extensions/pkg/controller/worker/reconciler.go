@@ -27,6 +27,7 @@ import (
 
 type reconciler struct {
 	actuator Actuator
+	importer Importer
 
 	client        client.Client
 	reader        client.Reader
@@ -35,12 +36,13 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+func NewReconciler(mgr manager.Manager, actuator Actuator, importer Importer) reconcile.Reconciler {
 	return reconcilerutils.OperationAnnotationWrapper(
 		mgr,
 		func() client.Object { return &extensionsv1alpha1.Worker{} },
 		&reconciler{
 			actuator:      actuator,
+			importer:      importer,
 			client:        mgr.GetClient(),
 			reader:        mgr.GetAPIReader(),
 			statusUpdater: extensionscontroller.NewStatusUpdater(mgr.GetClient()),
@@ -224,6 +226,14 @@ func (r *reconciler) restore(
 		return reconcile.Result{}, err
 	}
 
+	if r.importer != nil && v1beta1helper.IsShootImport(cluster.Shoot) {
+		log.Info("Importing the existing worker")
+		if err := r.importer.Import(ctx, log, worker, cluster); err != nil {
+			_ = r.statusUpdater.Error(ctx, log, worker, err, gardencorev1beta1.LastOperationTypeRestore, "Error importing Worker")
+			return reconcilerutils.ReconcileErr(err)
+		}
+	}
+
 	log.Info("Starting the restoration of worker")
 	if err := r.actuator.Restore(ctx, log, worker, cluster); err != nil {
 		_ = r.statusUpdater.Error(ctx, log, worker, err, gardencorev1beta1.LastOperationTypeRestore, "Error restoring Worker")
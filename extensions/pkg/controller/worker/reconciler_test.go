@@ -104,7 +104,7 @@ var _ = Describe("Worker Reconcile", func() {
 		apiReader := mockclient.NewMockReader(ctrl)
 		mgr.EXPECT().GetClient().Return(t.fields.client).AnyTimes()
 		mgr.EXPECT().GetAPIReader().Return(apiReader).AnyTimes()
-		reconciler := worker.NewReconciler(mgr, t.fields.actuator(ctrl))
+		reconciler := worker.NewReconciler(mgr, t.fields.actuator(ctrl), nil)
 
 		got, err := reconciler.Reconcile(ctx, t.args.request)
 		Expect(err != nil).To(Equal(t.wantErr))
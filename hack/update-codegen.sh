@@ -29,6 +29,8 @@ AVAILABLE_CODEGEN_OPTIONS=(
   "shootresourcereservation_groups"
   "shoottolerationrestriction_groups"
   "shootdnsrewriting_groups"
+  "shootipam_groups"
+  "shootdeletionhook_groups"
   "provider_local_groups"
   "extensions_config_groups"
   "nodeagent_groups"
@@ -468,6 +470,50 @@ shootdnsrewriting_groups() {
 }
 export -f shootdnsrewriting_groups
 
+shootipam_groups() {
+  echo "Generating API groups for plugin/pkg/shoot/ipam/apis/shootipam"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis \
+    "shootipam:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis \
+    "shootipam:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam,github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,k8s.io/component-base/config,k8s.io/component-base/config/v1alpha1 \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f shootipam_groups
+
+shootdeletionhook_groups() {
+  echo "Generating API groups for plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    deepcopy,defaulter \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis \
+    "shootdeletionhook:v1alpha1" \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+
+  bash "${CODE_GEN_DIR}"/generate-internal-groups.sh \
+    conversion \
+    github.com/gardener/gardener/pkg/client/componentconfig \
+    github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis \
+    github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis \
+    "shootdeletionhook:v1alpha1" \
+    --extra-peer-dirs=github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook,github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook/v1alpha1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/conversion,k8s.io/apimachinery/pkg/runtime,k8s.io/component-base/config,k8s.io/component-base/config/v1alpha1 \
+    -h "${PROJECT_ROOT}/hack/LICENSE_BOILERPLATE.txt"
+}
+export -f shootdeletionhook_groups
+
 shootresourcereservation_groups() {
   echo "Generating API groups for plugin/pkg/shoot/resourcereservation/apis/shootresourcereservation"
 
@@ -15,6 +15,8 @@ const (
 	ContainerImageNameApiserverProxy = "apiserver-proxy"
 	// ContainerImageNameApiserverProxySidecar is a constant for an image in the image vector with name 'apiserver-proxy-sidecar'.
 	ContainerImageNameApiserverProxySidecar = "apiserver-proxy-sidecar"
+	// ContainerImageNameArtifactCache is a constant for an image in the image vector with name 'artifact-cache'.
+	ContainerImageNameArtifactCache = "artifact-cache"
 	// ContainerImageNameBlackboxExporter is a constant for an image in the image vector with name 'blackbox-exporter'.
 	ContainerImageNameBlackboxExporter = "blackbox-exporter"
 	// ContainerImageNameCertManagement is a constant for an image in the image vector with name 'cert-management'.
@@ -25,6 +27,8 @@ const (
 	ContainerImageNameClusterProportionalAutoscaler = "cluster-proportional-autoscaler"
 	// ContainerImageNameConfigmapReloader is a constant for an image in the image vector with name 'configmap-reloader'.
 	ContainerImageNameConfigmapReloader = "configmap-reloader"
+	// ContainerImageNameConformance is a constant for an image in the image vector with name 'conformance'.
+	ContainerImageNameConformance = "conformance"
 	// ContainerImageNameCoredns is a constant for an image in the image vector with name 'coredns'.
 	ContainerImageNameCoredns = "coredns"
 	// ContainerImageNameCortex is a constant for an image in the image vector with name 'cortex'.
@@ -37,6 +41,8 @@ const (
 	ContainerImageNameEventLogger = "event-logger"
 	// ContainerImageNameExtAuthzServer is a constant for an image in the image vector with name 'ext-authz-server'.
 	ContainerImageNameExtAuthzServer = "ext-authz-server"
+	// ContainerImageNameFalco is a constant for an image in the image vector with name 'falco'.
+	ContainerImageNameFalco = "falco"
 	// ContainerImageNameFluentBit is a constant for an image in the image vector with name 'fluent-bit'.
 	ContainerImageNameFluentBit = "fluent-bit"
 	// ContainerImageNameFluentBitPluginInstaller is a constant for an image in the image vector with name 'fluent-bit-plugin-installer'.
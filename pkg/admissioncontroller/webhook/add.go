@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/resourcesize"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/seedrestriction"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/statictokenkubeconfigsecret"
 	seedauthorizer "github.com/gardener/gardener/pkg/admissioncontroller/webhook/auth/seed"
 )
 
@@ -87,5 +88,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", admissionpluginsecret.HandlerName, err)
 	}
 
+	if err := (&statictokenkubeconfigsecret.Handler{
+		Logger: mgr.GetLogger().WithName("webhook").WithName(statictokenkubeconfigsecret.HandlerName),
+		Client: mgr.GetClient(),
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", statictokenkubeconfigsecret.HandlerName, err)
+	}
+
 	return nil
 }
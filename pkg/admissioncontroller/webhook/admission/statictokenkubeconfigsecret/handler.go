@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package statictokenkubeconfigsecret
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener/pkg/admissioncontroller/metrics"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// metricReasonStaticTokenKubeconfigDisabled is a metric reason value for a reason when a static token kubeconfig
+// secret was rejected because the static token kubeconfig is disabled for the shoot.
+const metricReasonStaticTokenKubeconfigDisabled = "Static Token Kubeconfig Disabled"
+
+// Handler denies creating or updating the legacy `<shoot-name>.kubeconfig` secret in project namespaces if the static
+// token kubeconfig is disabled for the respective shoot. Such secrets are removed by gardenlet and must not be
+// re-introduced, the `shoots/adminkubeconfig` subresource has to be used instead.
+type Handler struct {
+	Logger logr.Logger
+	Client client.Reader
+}
+
+// ValidateCreate performs the check.
+func (h *Handler) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, h.handle(ctx, obj)
+}
+
+// ValidateUpdate performs the check.
+func (h *Handler) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, h.handle(ctx, newObj)
+}
+
+// ValidateDelete returns nil (not implemented by this handler).
+func (h *Handler) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (h *Handler) handle(ctx context.Context, obj runtime.Object) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected *corev1.Secret but got %T", obj))
+	}
+
+	shootName, ok := strings.CutSuffix(secret.Name, "."+gardenerutils.ShootProjectSecretSuffixKubeconfig)
+	if !ok || secret.DeletionTimestamp != nil {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Namespace: secret.Namespace, Name: shootName}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get shoot %s/%s: %w", secret.Namespace, shootName, err))
+	}
+
+	if ptr.Deref(shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig, true) {
+		return nil
+	}
+
+	h.Logger.Info("Rejected static token kubeconfig secret",
+		"namespace", secret.Namespace,
+		"name", secret.Name,
+		"username", req.UserInfo.Username,
+	)
+
+	metrics.RejectedResources.WithLabelValues(
+		string(req.Operation),
+		req.Kind.Kind,
+		req.Namespace,
+		metricReasonStaticTokenKubeconfigDisabled,
+	).Inc()
+
+	return apierrors.NewForbidden(corev1.Resource("secrets"), secret.Name, fmt.Errorf("static token kubeconfig is disabled for shoot %q, use the shoots/adminkubeconfig subresource instead", shootName))
+}
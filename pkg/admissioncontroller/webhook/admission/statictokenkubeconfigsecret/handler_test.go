@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package statictokenkubeconfigsecret_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/statictokenkubeconfigsecret"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Handler", func() {
+	const namespace = "garden-foo"
+
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		handler    *Handler
+
+		secret *corev1.Secret
+		shoot  *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctx = admission.NewContextWithRequest(context.TODO(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: namespace,
+			Name:      "bar.kubeconfig",
+		}})
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		handler = &Handler{Logger: logr.Discard(), Client: fakeClient}
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bar.kubeconfig", Namespace: namespace}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{EnableStaticTokenKubeconfig: ptr.To(false)},
+			},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	Describe("#ValidateCreate", func() {
+		It("should deny the secret if the static token kubeconfig is disabled for the shoot", func() {
+			warnings, err := handler.ValidateCreate(ctx, secret)
+			Expect(warnings).To(BeNil())
+			Expect(err).To(And(
+				BeForbiddenError(),
+				MatchError(ContainSubstring(`static token kubeconfig is disabled for shoot "bar"`)),
+			))
+		})
+
+		It("should allow the secret if the static token kubeconfig is enabled for the shoot", func() {
+			shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(true)
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(handler.ValidateCreate(ctx, secret)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should allow the secret if the shoot does not exist", func() {
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+			Expect(handler.ValidateCreate(ctx, secret)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should allow secrets which are not static token kubeconfig secrets", func() {
+			secret.Name = "bar.ca-cluster"
+
+			Expect(handler.ValidateCreate(ctx, secret)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should return an error if the object is not a secret", func() {
+			Expect(handler.ValidateCreate(ctx, &corev1.ConfigMap{})).Error().To(BeBadRequestError())
+		})
+	})
+
+	Describe("#ValidateUpdate", func() {
+		It("should deny the secret if the static token kubeconfig is disabled for the shoot", func() {
+			Expect(handler.ValidateUpdate(ctx, secret, secret)).Error().To(BeForbiddenError())
+		})
+
+		It("should allow the secret if it is being deleted", func() {
+			secret.DeletionTimestamp = &metav1.Time{}
+
+			Expect(handler.ValidateUpdate(ctx, secret, secret)).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#ValidateDelete", func() {
+		It("should allow deleting the secret", func() {
+			Expect(handler.ValidateDelete(ctx, secret)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
@@ -56,6 +56,7 @@ var (
 	certificateSigningRequestResource = certificatesv1.Resource("certificatesigningrequests")
 	cloudProfileResource              = gardencorev1beta1.Resource("cloudprofiles")
 	namespacedCloudProfileResource    = gardencorev1beta1.Resource("namespacedcloudprofiles")
+	clusterAuditPolicyResource        = gardencorev1beta1.Resource("clusterauditpolicies")
 	clusterRoleBindingResource        = rbacv1.Resource("clusterrolebindings")
 	configMapResource                 = corev1.Resource("configmaps")
 	controllerDeploymentResource      = gardencorev1beta1.Resource("controllerdeployments")
@@ -139,6 +140,8 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			}
 
 			return a.authorizeClusterRoleBinding(requestLog, seedName, attrs)
+		case clusterAuditPolicyResource:
+			return a.authorizeRead(requestLog, seedName, graph.VertexTypeClusterAuditPolicy, attrs)
 		case configMapResource:
 			return a.authorizeConfigMap(requestLog, seedName, attrs)
 		case controllerDeploymentResource:
@@ -190,7 +193,11 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 		case secretResource:
 			return a.authorizeSecret(requestLog, seedName, attrs)
 		case workloadIdentityResource:
-			return a.authorizeRead(requestLog, seedName, graph.VertexTypeWorkloadIdentity, attrs)
+			if userType == seedidentity.UserTypeExtension {
+				return a.authorizeRead(requestLog, seedName, graph.VertexTypeWorkloadIdentity, attrs)
+			}
+
+			return a.authorizeWorkloadIdentity(requestLog, seedName, attrs)
 		case seedResource:
 			return a.authorize(requestLog, seedName, graph.VertexTypeSeed, attrs,
 				nil,
@@ -315,6 +322,19 @@ func (a *authorizer) authorizeSecret(log logr.Logger, seedName string, attrs aut
 	)
 }
 
+func (a *authorizer) authorizeWorkloadIdentity(log logr.Logger, seedName string, attrs auth.Attributes) (auth.Decision, string, error) {
+	// Allow gardenlet to request tokens for workload identities used by resources assigned to its seed.
+	if attrs.GetSubresource() == "token" {
+		return a.authorize(log, seedName, graph.VertexTypeWorkloadIdentity, attrs,
+			[]string{"create"},
+			nil,
+			[]string{"token"},
+		)
+	}
+
+	return a.authorizeRead(log, seedName, graph.VertexTypeWorkloadIdentity, attrs)
+}
+
 func (a *authorizer) authorizeConfigMap(log logr.Logger, seedName string, attrs auth.Attributes) (auth.Decision, string, error) {
 	return a.authorize(log, seedName, graph.VertexTypeConfigMap, attrs,
 		[]string{"get", "patch", "update", "delete", "list", "watch"},
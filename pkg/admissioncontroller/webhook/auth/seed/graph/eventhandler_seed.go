@@ -43,7 +43,8 @@ func (g *graph) setupSeedWatch(ctx context.Context, informer cache.Informer) err
 			}
 
 			if !v1beta1helper.SeedBackupSecretRefEqual(oldSeed.Spec.Backup, newSeed.Spec.Backup) ||
-				!seedDNSProviderSecretRefEqual(oldSeed.Spec.DNS.Provider, newSeed.Spec.DNS.Provider) {
+				!seedDNSProviderSecretRefEqual(oldSeed.Spec.DNS.Provider, newSeed.Spec.DNS.Provider) ||
+				v1beta1helper.SeedSettingClusterAuditPolicyName(oldSeed.Spec.Settings) != v1beta1helper.SeedSettingClusterAuditPolicyName(newSeed.Spec.Settings) {
 				g.handleSeedCreateOrUpdate(newSeed)
 			}
 
@@ -85,6 +86,7 @@ func (g *graph) handleSeedCreateOrUpdate(seed *gardencorev1beta1.Seed) {
 	g.deleteAllIncomingEdges(VertexTypeNamespace, VertexTypeSeed, "", seed.Name)
 	g.deleteAllIncomingEdges(VertexTypeLease, VertexTypeSeed, "", seed.Name)
 	g.deleteAllIncomingEdges(VertexTypeConfigMap, VertexTypeSeed, "", seed.Name)
+	g.deleteAllIncomingEdges(VertexTypeClusterAuditPolicy, VertexTypeSeed, "", seed.Name)
 
 	seedVertex := g.getOrCreateVertex(VertexTypeSeed, "", seed.Name)
 	namespaceVertex := g.getOrCreateVertex(VertexTypeNamespace, "", gardenerutils.ComputeGardenNamespace(seed.Name))
@@ -105,6 +107,11 @@ func (g *graph) handleSeedCreateOrUpdate(seed *gardencorev1beta1.Seed) {
 		secretVertex := g.getOrCreateVertex(VertexTypeSecret, seed.Spec.DNS.Provider.SecretRef.Namespace, seed.Spec.DNS.Provider.SecretRef.Name)
 		g.addEdge(secretVertex, seedVertex)
 	}
+
+	if clusterAuditPolicyName := v1beta1helper.SeedSettingClusterAuditPolicyName(seed.Spec.Settings); clusterAuditPolicyName != "" {
+		clusterAuditPolicyVertex := g.getOrCreateVertex(VertexTypeClusterAuditPolicy, "", clusterAuditPolicyName)
+		g.addEdge(clusterAuditPolicyVertex, seedVertex)
+	}
 }
 
 func (g *graph) handleSeedDelete(seed *gardencorev1beta1.Seed) {
@@ -60,6 +60,8 @@ const (
 	VertexTypeCredentialsBinding
 	// VertexTypeWorkloadIdentity is a constant for a 'WorkloadIdentity' vertex.
 	VertexTypeWorkloadIdentity
+	// VertexTypeClusterAuditPolicy is a constant for a 'ClusterAuditPolicy' vertex.
+	VertexTypeClusterAuditPolicy
 )
 
 var vertexTypes = map[VertexType]string{
@@ -88,6 +90,7 @@ var vertexTypes = map[VertexType]string{
 	VertexTypeShootState:                "ShootState",
 	VertexTypeCredentialsBinding:        "CredentialsBinding",
 	VertexTypeWorkloadIdentity:          "WorkloadIdentity",
+	VertexTypeClusterAuditPolicy:        "ClusterAuditPolicy",
 }
 
 type vertex struct {
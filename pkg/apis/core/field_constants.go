@@ -48,4 +48,7 @@ const (
 	// the Seed cluster of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot
 	// referred in the status.
 	ShootStatusSeedName = "status.seedName"
+	// ShootPurposeName is the field selector path for finding
+	// the purpose of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
+	ShootPurposeName = "spec.purpose"
 )
@@ -195,6 +195,15 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *core.SeedSettings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingClusterAuditPolicyName returns the name of the ClusterAuditPolicy which is used as default audit policy for
+// the shoots on the seed. It returns an empty string if no ClusterAuditPolicy is configured.
+func SeedSettingClusterAuditPolicyName(settings *core.SeedSettings) string {
+	if settings == nil || settings.AuditPolicy == nil {
+		return ""
+	}
+	return settings.AuditPolicy.ClusterAuditPolicyName
+}
+
 // FindMachineImageVersion finds the machine image version in the <cloudProfile> for the given <name> and <version>.
 // In case no machine image version can be found with the given <name> or <version>, false is being returned.
 func FindMachineImageVersion(machineImages []core.MachineImage, name, version string) (core.MachineImageVersion, bool) {
@@ -240,6 +249,16 @@ func ShootNeedsForceDeletion(shoot *core.Shoot) bool {
 	return forceDelete
 }
 
+// IsShootImport determines whether a Shoot adopts an existing cluster instead of creating a new one.
+func IsShootImport(shoot *core.Shoot) bool {
+	if shoot == nil {
+		return false
+	}
+
+	isImport, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.AnnotationShootImport])
+	return isImport
+}
+
 // FindPrimaryDNSProvider finds the primary provider among the given `providers`.
 // It returns the first provider if multiple candidates are found.
 func FindPrimaryDNSProvider(providers []core.DNSProvider) *core.DNSProvider {
@@ -252,6 +271,18 @@ func FindPrimaryDNSProvider(providers []core.DNSProvider) *core.DNSProvider {
 	return nil
 }
 
+// FindSecondaryDNSProvider finds the secondary provider among the given `providers`.
+// It returns the first provider if multiple candidates are found.
+func FindSecondaryDNSProvider(providers []core.DNSProvider) *core.DNSProvider {
+	for _, provider := range providers {
+		if provider.Secondary != nil && *provider.Secondary {
+			secondaryProvider := provider
+			return &secondaryProvider
+		}
+	}
+	return nil
+}
+
 // FindWorkerByName tries to find the worker with the given name. If it cannot be found it returns nil.
 func FindWorkerByName(workers []core.Worker, name string) *core.Worker {
 	for _, w := range workers {
@@ -340,6 +371,16 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *core.KubeAPIServerConfig)
 	return nil
 }
 
+// GetShootClusterAuditPolicyName returns the name of the ClusterAuditPolicy referenced by the Shoot.
+func GetShootClusterAuditPolicyName(apiServerConfig *core.KubeAPIServerConfig) string {
+	if apiServerConfig != nil &&
+		apiServerConfig.AuditConfig != nil &&
+		apiServerConfig.AuditConfig.AuditPolicy != nil {
+		return ptr.Deref(apiServerConfig.AuditConfig.AuditPolicy.ClusterAuditPolicyName, "")
+	}
+	return ""
+}
+
 // HibernationIsEnabled checks if the given shoot's desired state is hibernated.
 func HibernationIsEnabled(shoot *core.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
@@ -359,6 +400,11 @@ func SystemComponentsAllowed(worker *core.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// IsUpdateStrategyInPlace checks if the given worker uses the in-place update strategy.
+func IsUpdateStrategyInPlace(worker *core.Worker) bool {
+	return worker.UpdateStrategy != nil && *worker.UpdateStrategy == core.MachineUpdateStrategyInPlace
+}
+
 // GetResourceByName returns the NamedResourceReference with the given name in the given slice, or nil if not found.
 func GetResourceByName(resources []core.NamedResourceReference, name string) *core.NamedResourceReference {
 	for _, resource := range resources {
@@ -257,6 +257,25 @@ var _ = Describe("helper", func() {
 			BeTrue()),
 	)
 
+	DescribeTable("#IsShootImport",
+		func(shoot *core.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootImport(shoot)).To(match)
+		},
+
+		Entry("shoot is nil",
+			nil,
+			BeFalse()),
+		Entry("no import annotation present",
+			&core.Shoot{},
+			BeFalse()),
+		Entry("import annotation present but value is false",
+			&core.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootImport: "false"}}},
+			BeFalse()),
+		Entry("import annotation present and value is true",
+			&core.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootImport: "true"}}},
+			BeTrue()),
+	)
+
 	DescribeTable("#FindWorkerByName",
 		func(workers []core.Worker, name string, expectedWorker *core.Worker) {
 			Expect(FindWorkerByName(workers, name)).To(Equal(expectedWorker))
@@ -303,6 +322,25 @@ var _ = Describe("helper", func() {
 		}, Equal(&core.DNSProvider{Type: ptr.To("provider1"), Primary: ptr.To(true)})),
 	)
 
+	DescribeTable("#FindSecondaryDNSProvider",
+		func(providers []core.DNSProvider, matcher gomegatypes.GomegaMatcher) {
+			Expect(FindSecondaryDNSProvider(providers)).To(matcher)
+		},
+
+		Entry("no providers", nil, BeNil()),
+		Entry("only primary provider", []core.DNSProvider{{Type: ptr.To("provider"), Primary: ptr.To(true)}}, BeNil()),
+		Entry("primary and secondary provider", []core.DNSProvider{
+			{
+				Type:    ptr.To("provider1"),
+				Primary: ptr.To(true),
+			},
+			{
+				Type:      ptr.To("provider2"),
+				Secondary: ptr.To(true),
+			},
+		}, Equal(&core.DNSProvider{Type: ptr.To("provider2"), Secondary: ptr.To(true)})),
+	)
+
 	Describe("#GetRemovedVersions", func() {
 		var (
 			versions = []core.ExpirableVersion{
@@ -430,6 +468,15 @@ var _ = Describe("helper", func() {
 		Entry("systemComponents.allowed = true", &core.Worker{SystemComponents: &core.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#IsUpdateStrategyInPlace",
+		func(worker *core.Worker, inPlace bool) {
+			Expect(IsUpdateStrategyInPlace(worker)).To(Equal(inPlace))
+		},
+		Entry("no update strategy", &core.Worker{}, false),
+		Entry("rolling update strategy", &core.Worker{UpdateStrategy: ptr.To(core.MachineUpdateStrategyRollingUpdate)}, false),
+		Entry("in-place update strategy", &core.Worker{UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)}, true),
+	)
+
 	Describe("GetShootAuditPolicyConfigMapName", func() {
 		test := func(description string, config *core.KubeAPIServerConfig, expectedName string) {
 			It(description, Offset(1), func() {
@@ -456,6 +503,32 @@ var _ = Describe("helper", func() {
 		}, "foo")
 	})
 
+	Describe("GetShootClusterAuditPolicyName", func() {
+		test := func(description string, config *core.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootClusterAuditPolicyName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("AuditConfig = nil", &core.KubeAPIServerConfig{}, "")
+		test("AuditPolicy = nil", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{},
+		}, "")
+		test("ClusterAuditPolicyName = nil", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{
+				AuditPolicy: &core.AuditPolicy{},
+			},
+		}, "")
+		test("ClusterAuditPolicyName set", &core.KubeAPIServerConfig{
+			AuditConfig: &core.AuditConfig{
+				AuditPolicy: &core.AuditPolicy{
+					ClusterAuditPolicyName: ptr.To("foo"),
+				},
+			},
+		}, "foo")
+	})
+
 	Describe("GetShootAuditPolicyConfigMapRef", func() {
 		test := func(description string, config *core.KubeAPIServerConfig, expectedRef *corev1.ObjectReference) {
 			It(description, Offset(1), func() {
@@ -549,6 +622,16 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing disabled", &core.SeedSettings{TopologyAwareRouting: &core.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingClusterAuditPolicyName",
+		func(settings *core.SeedSettings, expected string) {
+			Expect(SeedSettingClusterAuditPolicyName(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, ""),
+		Entry("no audit policy setting", &core.SeedSettings{}, ""),
+		Entry("cluster audit policy referenced", &core.SeedSettings{AuditPolicy: &core.SeedSettingAuditPolicy{ClusterAuditPolicyName: "default"}}, "default"),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []core.MachineImage
 
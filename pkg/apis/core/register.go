@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupEntryList{},
 		&CloudProfile{},
 		&CloudProfileList{},
+		&ClusterAuditPolicy{},
+		&ClusterAuditPolicyList{},
 		&ControllerRegistration{},
 		&ControllerRegistrationList{},
 		&ControllerDeployment{},
@@ -49,10 +51,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControllerInstallationList{},
 		&ExposureClass{},
 		&ExposureClassList{},
+		&GardenCapabilities{},
 		&InternalSecret{},
 		&InternalSecretList{},
 		&NamespacedCloudProfile{},
 		&NamespacedCloudProfileList{},
+		&NotificationSink{},
+		&NotificationSinkList{},
 		&Project{},
 		&ProjectList{},
 		&Quota{},
@@ -65,6 +70,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootStateList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootMaintenancePreview{},
 	)
 
 	return nil
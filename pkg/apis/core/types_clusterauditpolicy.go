@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAuditPolicy represents an audit policy for kube-apiservers which is managed centrally and can be referenced by
+// shoots.
+type ClusterAuditPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of this audit policy.
+	Spec ClusterAuditPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAuditPolicyList is a collection of ClusterAuditPolicies.
+type ClusterAuditPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ClusterAuditPolicies.
+	Items []ClusterAuditPolicy
+}
+
+// ClusterAuditPolicySpec is the specification of a ClusterAuditPolicy.
+type ClusterAuditPolicySpec struct {
+	// Policy is the audit policy (audit.k8s.io/v1.Policy) for the kube-apiserver.
+	Policy runtime.RawExtension
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GardenCapabilitiesName is the name of the only GardenCapabilities object.
+const GardenCapabilitiesName = "garden"
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=get
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GardenCapabilities reports the capabilities of the Gardener landscape, i.e., the feature gates of the
+// gardener-apiserver, the registered extensions, the served API resources, and the seeds. It is computed on request and
+// not persisted.
+type GardenCapabilities struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// FeatureGates are the feature gates of the gardener-apiserver.
+	FeatureGates []FeatureGateCapability
+	// Extensions are the extension kinds and types which are registered via ControllerRegistrations.
+	Extensions []ExtensionCapability
+	// APIResources are the resources and subresources served by the gardener-apiserver.
+	APIResources []APIResourcesCapability
+	// Seeds are the capabilities of the registered seeds.
+	Seeds []SeedCapability
+}
+
+// FeatureGateCapability describes a feature gate.
+type FeatureGateCapability struct {
+	// Name is the name of the feature gate.
+	Name string
+	// Enabled states whether the feature gate is enabled.
+	Enabled bool
+	// Stage is the maturity stage of the feature gate, e.g. `ALPHA` or `BETA`. It is empty for GA feature gates.
+	Stage string
+}
+
+// ExtensionCapability describes the types of an extension kind.
+type ExtensionCapability struct {
+	// Kind is the kind of the extension resource, e.g. `Infrastructure`.
+	Kind string
+	// Types are the types of the extension kind which are registered, e.g. `aws`.
+	Types []string
+}
+
+// APIResourcesCapability describes the resources of an API group version.
+type APIResourcesCapability struct {
+	// GroupVersion is the API group version, e.g. `core.gardener.cloud/v1beta1`.
+	GroupVersion string
+	// Resources are the resources and subresources served in the group version, e.g. `shoots/adminkubeconfig`.
+	Resources []string
+}
+
+// SeedCapability describes the capabilities of a seed.
+type SeedCapability struct {
+	// Name is the name of the seed.
+	Name string
+	// ProviderType is the provider type of the seed.
+	ProviderType string
+	// Region is the region of the seed.
+	Region string
+	// GardenletVersion is the version of the gardenlet which last reported the status of the seed.
+	GardenletVersion *string
+}
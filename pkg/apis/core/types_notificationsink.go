@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSink represents a receiver of Shoot lifecycle notifications for the whole garden.
+type NotificationSink struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of this notification sink.
+	Spec NotificationSinkSpec
+	// Status contains the delivery status of this notification sink.
+	Status NotificationSinkStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSinkList is a collection of NotificationSinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of NotificationSinks.
+	Items []NotificationSink
+}
+
+// NotificationSinkSpec is the specification of a NotificationSink. Exactly one of Webhook, Slack or Email must be set.
+type NotificationSinkSpec struct {
+	// Webhook contains the configuration for delivering notifications as JSON to an HTTP endpoint.
+	Webhook *NotificationSinkWebhook
+	// Slack contains the configuration for delivering notifications to a Slack incoming webhook.
+	Slack *NotificationSinkSlack
+	// Email contains the configuration for delivering notifications via SMTP.
+	Email *NotificationSinkEmail
+	// SecretRef is a reference to a secret containing the credentials for the sink. For webhooks, the optional `token`
+	// key is sent as bearer token. For Slack, the `url` key must contain the incoming webhook URL. For email, the
+	// optional `username` and `password` keys are used for authenticating to the SMTP server.
+	SecretRef *corev1.SecretReference
+	// Filter restricts the notifications delivered to this sink. If not set, all notifications are delivered.
+	Filter *NotificationFilter
+}
+
+// NotificationSinkWebhook contains the configuration for delivering notifications to an HTTP endpoint.
+type NotificationSinkWebhook struct {
+	// URL is the HTTPS endpoint the notifications are posted to.
+	URL string
+}
+
+// NotificationSinkSlack contains the configuration for delivering notifications to a Slack incoming webhook.
+type NotificationSinkSlack struct {
+	// Channel overrides the default channel of the incoming webhook.
+	Channel *string
+}
+
+// NotificationSinkEmail contains the configuration for delivering notifications via SMTP.
+type NotificationSinkEmail struct {
+	// Host is the host name of the SMTP server.
+	Host string
+	// Port is the port of the SMTP server.
+	Port int32
+	// From is the sender address of the notifications.
+	From string
+	// To is the list of recipient addresses of the notifications.
+	To []string
+}
+
+// NotificationFilter restricts the notifications delivered to a NotificationSink. All set fields must match.
+type NotificationFilter struct {
+	// Projects is the list of project names whose Shoots are considered.
+	Projects []string
+	// Purposes is the list of Shoot purposes which are considered.
+	Purposes []ShootPurpose
+	// EventTypes is the list of event types which are considered.
+	EventTypes []NotificationEventType
+}
+
+// NotificationEventType is the type of a Shoot lifecycle notification.
+type NotificationEventType string
+
+const (
+	// NotificationEventTypeShootCreated is the event type of notifications for successfully created Shoots.
+	NotificationEventTypeShootCreated NotificationEventType = "ShootCreated"
+	// NotificationEventTypeShootReconcileFailed is the event type of notifications for Shoots whose creation or
+	// reconciliation failed.
+	NotificationEventTypeShootReconcileFailed NotificationEventType = "ShootReconcileFailed"
+	// NotificationEventTypeShootHibernated is the event type of notifications for hibernated Shoots.
+	NotificationEventTypeShootHibernated NotificationEventType = "ShootHibernated"
+	// NotificationEventTypeShootExpiring is the event type of notifications for Shoots which are about to expire.
+	NotificationEventTypeShootExpiring NotificationEventType = "ShootExpiring"
+)
+
+// NotificationSinkStatus contains the delivery status of a NotificationSink.
+type NotificationSinkStatus struct {
+	// LastDelivery is the last notification which was delivered successfully.
+	LastDelivery *NotificationDelivery
+	// LastFailure is the last notification whose delivery failed.
+	LastFailure *NotificationDelivery
+}
+
+// NotificationDelivery describes the delivery of a notification.
+type NotificationDelivery struct {
+	// Shoot is the namespace and name of the Shoot the notification is about.
+	Shoot string
+	// EventType is the event type of the notification.
+	EventType NotificationEventType
+	// Time is the time of the delivery attempt.
+	Time metav1.Time
+	// Message contains the error in case the delivery failed.
+	Message *string
+}
@@ -58,6 +58,8 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// ShootDefaults contains default values which are applied to new shoots in this project if they don't specify them.
+	ShootDefaults *ProjectShootDefaults
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -73,6 +75,9 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// ControlPlaneResourceUsage contains the resources consumed by the control planes of all Shoots of this project in
+	// the seed clusters.
+	ControlPlaneResourceUsage *ControlPlaneResourceUsage
 }
 
 // ProjectMember is a member of a project.
@@ -93,6 +98,18 @@ type ProjectTolerations struct {
 	Whitelist []Toleration
 }
 
+// ProjectShootDefaults contains default values which are applied to new shoots in a project.
+type ProjectShootDefaults struct {
+	// Purpose is the default purpose of shoots.
+	Purpose *ShootPurpose
+	// MaintenanceTimeWindow is the default maintenance time window of shoots.
+	MaintenanceTimeWindow *MaintenanceTimeWindow
+	// HibernationSchedules are the default hibernation schedules of shoots.
+	HibernationSchedules []HibernationSchedule
+	// NetworkingType is the default type of the networking plugin of shoots with workers.
+	NetworkingType *string
+}
+
 // Toleration is a toleration for a seed taint.
 type Toleration struct {
 	// Key is the toleration key to be applied to a project or shoot.
@@ -95,6 +95,48 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// CapacityReport contains a report about the capacity and the utilization of the seed cluster. It is periodically
+	// updated by gardenlet.
+	CapacityReport *SeedCapacityReport
+}
+
+// SeedCapacityReport contains a report about the capacity and the utilization of a seed cluster.
+type SeedCapacityReport struct {
+	// LastUpdateTime is the time when the report was last updated.
+	LastUpdateTime metav1.Time
+	// ControlPlanes is the number of shoot control planes hosted by the seed.
+	ControlPlanes int32
+	// ControlPlanePods is the number of pods running in the control plane namespaces of the shoots.
+	ControlPlanePods int32
+	// CPU contains the allocatable and the requested CPU of the seed's nodes.
+	CPU *SeedResourceUtilization
+	// Memory contains the allocatable and the requested memory of the seed's nodes.
+	Memory *SeedResourceUtilization
+	// ETCDStorage is the total storage capacity of the etcds of the shoot control planes.
+	ETCDStorage *resource.Quantity
+	// NodePodCIDRs contains the number of pod CIDRs which can be assigned to nodes from the seed's pod network and the
+	// number of assigned ones.
+	NodePodCIDRs *SeedPoolUtilization
+	// LoadBalancers contains the number of services of type LoadBalancer in the seed and the configured quota.
+	LoadBalancers *SeedPoolUtilization
+}
+
+// SeedResourceUtilization contains the allocatable and the requested amount of a resource.
+type SeedResourceUtilization struct {
+	// Allocatable is the amount of the resource which is allocatable on all nodes.
+	Allocatable resource.Quantity
+	// Requested is the amount of the resource which is requested by all scheduled pods.
+	Requested resource.Quantity
+	// Headroom is the amount of the resource which is allocatable but not requested yet.
+	Headroom resource.Quantity
+}
+
+// SeedPoolUtilization contains the size of a pool and the number of allocated entries.
+type SeedPoolUtilization struct {
+	// Capacity is the size of the pool. It is not set if the size is unknown.
+	Capacity *int32
+	// Allocated is the number of allocated entries of the pool.
+	Allocated int32
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -196,6 +238,8 @@ type SeedSettings struct {
 	// TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting
+	// AuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.
+	AuditPolicy *SeedSettingAuditPolicy
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the
@@ -307,6 +351,13 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingAuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.
+type SeedSettingAuditPolicy struct {
+	// ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the
+	// kube-apiservers of shoots which do not configure an audit policy themselves.
+	ClusterAuditPolicyName string
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -346,6 +397,9 @@ const (
 	SeedGardenletReady ConditionType = "GardenletReady"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedPodSecurityCompliant is a constant for a condition type indicating whether the pods in the namespaces managed
+	// by the gardenlet comply with the configured Pod Security Standard.
+	SeedPodSecurityCompliant ConditionType = "SeedPodSecurityCompliant"
 )
 
 // Resource constants for Gardener object types
@@ -108,6 +108,10 @@ type ShootSpec struct {
 	// The credentials will be used to create the shoot in the respective account. The field is mutually exclusive with SecretBindingName.
 	// This field is immutable.
 	CredentialsBindingName *string
+	// Paused indicates whether all operations of gardenlet on the Shoot (creation, reconciliation, migration,
+	// deletion) are paused. Health checks are continued while the Shoot is paused. When the Shoot is resumed, it is
+	// reconciled immediately.
+	Paused *bool
 }
 
 // GetProviderType gets the type of the provider.
@@ -150,7 +154,7 @@ type ShootStatus struct {
 	// ClusterIdentity is the identity of the Shoot cluster. This field is immutable.
 	ClusterIdentity *string
 	// List of addresses that are relevant to the shoot.
-	// These include the Kube API server address and also the service account issuer.
+	// These include the Kube API server addresses, the service account issuer and the endpoint used by nodes for bootstrapping.
 	AdvertisedAddresses []ShootAdvertisedAddress
 	// MigrationStartTime is the time when a migration to a different seed was initiated.
 	MigrationStartTime *metav1.Time
@@ -164,6 +168,16 @@ type ShootStatus struct {
 	EncryptedResources []string
 	// Networking contains information about cluster networking such as CIDRs.
 	Networking *NetworkingStatus
+	// PausedSince is the time since when the operations of gardenlet on the Shoot are paused.
+	PausedSince *metav1.Time
+	// DNS contains information about the DNS providers managing the records of the external domain.
+	DNS *ShootDNSStatus
+	// Maintenance contains information about the upcoming maintenance of the Shoot.
+	Maintenance *ShootMaintenanceStatus
+	// ControlPlaneResourceUsage contains the resources consumed by the control plane of the Shoot in the seed cluster.
+	ControlPlaneResourceUsage *ControlPlaneResourceUsage
+	// ConformanceTest contains the report of the last conformance test run against the Shoot.
+	ConformanceTest *ConformanceTestReport
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -178,6 +192,67 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// ShootMaintenanceStatus contains information about the upcoming maintenance of the Shoot.
+type ShootMaintenanceStatus struct {
+	// PendingChanges are the changes which the next maintenance would apply. They are computed when the Shoot is
+	// annotated with `maintenance.gardener.cloud/operation=preview` and removed once the maintenance was performed.
+	PendingChanges *MaintenancePendingChanges
+}
+
+// MaintenancePendingChanges contains the changes which the next maintenance of a Shoot would apply.
+type MaintenancePendingChanges struct {
+	// ComputedTime is the time when the pending changes were computed.
+	ComputedTime metav1.Time
+	// NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.
+	NextMaintenanceTimeWindow MaintenancePreviewTimeWindow
+	// KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would
+	// not be updated.
+	KubernetesVersion *VersionUpdatePreview
+	// Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.
+	Workers []WorkerMaintenancePreview
+	// Operation is the operation which would be triggered by the maintenance.
+	Operation *string
+	// Changes contains the descriptions of further changes which would be applied to the Shoot specification.
+	Changes []string
+}
+
+// ControlPlaneResourceUsage contains the resources consumed by control planes in the seed cluster.
+type ControlPlaneResourceUsage struct {
+	// Requests is the sum of the resource requests of all control plane pods.
+	Requests corev1.ResourceList
+	// Limits is the sum of the resource limits of all control plane pods. Containers without limits are not taken into
+	// account.
+	Limits corev1.ResourceList
+	// ETCDStorage is the sum of the storage capacity of all etcd volumes.
+	ETCDStorage *resource.Quantity
+	// LastUpdateTime is the time when the resource usage was last computed.
+	LastUpdateTime metav1.Time
+}
+
+// ConformanceTestReport contains the report of a conformance test run against a Shoot.
+type ConformanceTestReport struct {
+	// Phase is the phase of the conformance test run.
+	Phase ConformanceTestPhase
+	// StartTime is the time when the conformance test run was started.
+	StartTime *metav1.Time
+	// CompletionTime is the time when the conformance test run was completed.
+	CompletionTime *metav1.Time
+	// Summary is a human-readable summary of the results of the conformance test run.
+	Summary string
+}
+
+// ConformanceTestPhase is the phase of a conformance test run.
+type ConformanceTestPhase string
+
+const (
+	// ConformanceTestRunning indicates that the conformance tests are running.
+	ConformanceTestRunning ConformanceTestPhase = "Running"
+	// ConformanceTestSucceeded indicates that all conformance tests passed.
+	ConformanceTestSucceeded ConformanceTestPhase = "Succeeded"
+	// ConformanceTestFailed indicates that conformance tests failed or could not be run.
+	ConformanceTestFailed ConformanceTestPhase = "Failed"
+)
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -186,8 +261,30 @@ type NetworkingStatus struct {
 	Nodes []string
 	// Services are the CIDRs of the service network.
 	Services []string
+	// DualStackMigrationInitiationTime is the time when the migration of the networking from IPv4 single-stack to
+	// dual-stack was initiated.
+	DualStackMigrationInitiationTime *metav1.Time
 }
 
+// ShootDNSStatus contains information about the DNS providers managing the records of the external domain.
+type ShootDNSStatus struct {
+	// ActiveProvider is the role of the DNS provider which currently manages the records of the external domain.
+	ActiveProvider DNSProviderRole
+	// LastFailoverTime is the last time when the management of the records switched from one DNS provider to another.
+	LastFailoverTime *metav1.Time
+}
+
+// DNSProviderRole is the role of a DNS provider for the external domain of a Shoot.
+type DNSProviderRole string
+
+const (
+	// DNSProviderRolePrimary is the role of the primary DNS provider.
+	DNSProviderRolePrimary DNSProviderRole = "primary"
+	// DNSProviderRoleSecondary is the role of the secondary DNS provider which is used if the primary DNS provider is
+	// unavailable.
+	DNSProviderRoleSecondary DNSProviderRole = "secondary"
+)
+
 // ShootCredentials contains information about the shoot credentials.
 type ShootCredentials struct {
 	// Rotation contains information about the credential rotations.
@@ -390,6 +487,9 @@ type DNSProvider struct {
 	// Deprecated: This field is deprecated and will be removed in a future release.
 	// Please use the DNS extension provider config (e.g. shoot-dns-service) for additional configuration.
 	Zones *DNSIncludeExclude
+	// Secondary indicates that this DNSProvider takes over the management of the shoot related records if the primary
+	// DNSProvider is unavailable.
+	Secondary *bool
 }
 
 // DNSIncludeExclude contains information about which domains shall be included/excluded.
@@ -440,6 +540,20 @@ type HibernationSchedule struct {
 	End *string
 	// Location is the time location in which both start and shall be evaluated.
 	Location *string
+	// Exceptions is a list of dates or date ranges on which the Shoot is not hibernated by the Start of this schedule.
+	// The dates are evaluated in the Location of this schedule.
+	Exceptions []HibernationScheduleException
+	// HolidayCalendarConfigMapName is the name of a ConfigMap in the namespace of the Shoot containing additional
+	// exceptions. Each value of the ConfigMap is either a date (`2006-01-02`) or a date range (`2006-01-02/2006-01-06`).
+	HolidayCalendarConfigMapName *string
+}
+
+// HibernationScheduleException is a date range on which the Shoot is not hibernated by a schedule.
+type HibernationScheduleException struct {
+	// Start is the first date of the exception in the format `2006-01-02`.
+	Start string
+	// End is the last date of the exception in the format `2006-01-02`. Defaults to Start.
+	End *string
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -470,8 +584,35 @@ type Kubernetes struct {
 	// Defaults to true for Shoots with Kubernetes versions < 1.26. Defaults to false for Shoots with Kubernetes versions >= 1.26.
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	EnableStaticTokenKubeconfig *bool
+	// PKI contains configuration for the certificates generated for the Shoot cluster.
+	PKI *PKI
 }
 
+// PKI contains configuration for the public key infrastructure of a cluster, i.e., for the certificate authorities and
+// the server and client certificates signed by them. Changes only take effect when the respective certificates are
+// regenerated, e.g., when the certificate authorities are rotated.
+type PKI struct {
+	// KeyAlgorithm is the algorithm of the private keys of the generated certificates.
+	KeyAlgorithm *KeyAlgorithm
+	// KeySize is the size of the private keys of the generated certificates in bits.
+	KeySize *int32
+	// CertificateAuthorityValidity is the validity of the generated certificate authorities.
+	CertificateAuthorityValidity *metav1.Duration
+	// CertificateValidity is the validity of the generated server and client certificates for which no dedicated
+	// validity is configured.
+	CertificateValidity *metav1.Duration
+}
+
+// KeyAlgorithm is a string alias for private key algorithms.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA is a constant for the RSA key algorithm.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSA is a constant for the ECDSA key algorithm.
+	KeyAlgorithmECDSA KeyAlgorithm = "ECDSA"
+)
+
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
 type ClusterAutoscaler struct {
 	// ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 1 hour).
@@ -623,8 +764,25 @@ type KubeAPIServerConfig struct {
 	DefaultUnreachableTolerationSeconds *int64
 	// EncryptionConfig contains customizable encryption configuration of the API server.
 	EncryptionConfig *EncryptionConfig
+	// Profile is a preset of request, priority-and-fairness and watch cache settings for the kube-apiserver which is
+	// maintained by Gardener. Explicitly configured `requests` and `watchCacheSizes` take precedence over the values of
+	// the profile. Possible values are `small`, `large` and `ci-burst`.
+	Profile *KubeAPIServerProfile
 }
 
+// KubeAPIServerProfile is a preset of tuning settings for the kube-apiserver.
+type KubeAPIServerProfile string
+
+const (
+	// KubeAPIServerProfileSmall is a profile for small clusters with few clients.
+	KubeAPIServerProfileSmall KubeAPIServerProfile = "small"
+	// KubeAPIServerProfileLarge is a profile for large clusters with many nodes and controllers.
+	KubeAPIServerProfileLarge KubeAPIServerProfile = "large"
+	// KubeAPIServerProfileCIBurst is a profile for clusters which are subject to short bursts of many requests, e.g.,
+	// when used by CI pipelines creating and deleting many objects in a short period of time.
+	KubeAPIServerProfileCIBurst KubeAPIServerProfile = "ci-burst"
+)
+
 // APIServerLogging contains configuration for the logs level and http access logs
 type APIServerLogging struct {
 	// Verbosity is the kube-apiserver log verbosity level
@@ -686,6 +844,9 @@ type AuditPolicy struct {
 	// ConfigMapRef is a reference to a ConfigMap object in the same namespace,
 	// which contains the audit policy for the kube-apiserver.
 	ConfigMapRef *corev1.ObjectReference
+	// ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the
+	// kube-apiserver. It is mutually exclusive with ConfigMapRef.
+	ClusterAuditPolicyName *string
 }
 
 // OIDCConfig contains configuration settings for the OIDC provider.
@@ -935,6 +1096,10 @@ type KubeletConfig struct {
 	StreamingConnectionIdleTimeout *metav1.Duration
 	// MemorySwap configures swap memory available to container workloads.
 	MemorySwap *MemorySwapConfiguration
+	// CredentialProviders is a list of image credential provider plugins that will be enabled by the kubelet.
+	// The plugin binaries must be present in the `/opt/bin/credential-providers` directory of the nodes, e.g. provided
+	// by the operating system image or an extension.
+	CredentialProviders []KubeletCredentialProvider
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -1015,6 +1180,35 @@ type MemorySwapConfiguration struct {
 	SwapBehavior *SwapBehavior
 }
 
+// KubeletCredentialProvider contains the configuration of an image credential provider plugin invoked by the kubelet.
+type KubeletCredentialProvider struct {
+	// Name is the name of the credential provider. It must match the name of the provider executable.
+	Name string
+	// MatchImages is a list of strings used to match against images in order to determine if this provider should be
+	// invoked, e.g. `*.dkr.ecr.*.amazonaws.com` or `*.azurecr.io`.
+	MatchImages []string
+	// DefaultCacheDuration is the default duration the plugin will cache credentials in-memory if a cache duration is
+	// not provided in the plugin response.
+	DefaultCacheDuration *metav1.Duration
+	// APIVersion is the input version of the exec CredentialProviderRequest, i.e. `credentialprovider.kubelet.k8s.io/v1`
+	// or `credentialprovider.kubelet.k8s.io/v1beta1`.
+	// Defaults to `credentialprovider.kubelet.k8s.io/v1` for Kubernetes >= v1.26 and to
+	// `credentialprovider.kubelet.k8s.io/v1beta1` otherwise.
+	APIVersion *string
+	// Args are the arguments passed to the plugin when executing it.
+	Args []string
+	// Env defines additional environment variables to expose to the plugin process.
+	Env []KubeletCredentialProviderEnvVar
+}
+
+// KubeletCredentialProviderEnvVar is an environment variable passed to an image credential provider plugin.
+type KubeletCredentialProviderEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string
+	// Value is the value of the environment variable.
+	Value string
+}
+
 // Networking defines networking parameters for the shoot cluster.
 type Networking struct {
 	// Type identifies the type of the networking plugin. This field is immutable.
@@ -1158,6 +1352,64 @@ type Worker struct {
 	Sysctls map[string]string
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	ClusterAutoscaler *ClusterAutoscalerOptions
+	// ExistingHosts specifies that this worker pool is backed by pre-existing hosts (e.g., bare metal servers or virtual
+	// machines) which are not provisioned by Gardener but registered to the cluster by the user. Gardener only manages
+	// the operating system configuration and the kubelet of such hosts.
+	ExistingHosts *WorkerExistingHosts
+	// Headroom contains the configuration for capacity headroom of this worker pool.
+	Headroom *WorkerHeadroom
+	// UpdateStrategy specifies how the nodes of the worker pool are updated when the Kubernetes version, the machine
+	// image version, or the kubelet configuration change.
+	UpdateStrategy *MachineUpdateStrategy
+	// RollingUpdate contains the configuration for progressive rolling updates of the worker pool's nodes during
+	// Kubernetes minor version upgrades.
+	RollingUpdate *WorkerRollingUpdate
+}
+
+// MachineUpdateStrategy is the update strategy of the nodes of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// MachineUpdateStrategyRollingUpdate replaces the nodes of the worker pool with new machines.
+	MachineUpdateStrategyRollingUpdate MachineUpdateStrategy = "RollingUpdate"
+	// MachineUpdateStrategyInPlace updates the operating system and the kubelet of the existing nodes of the worker pool.
+	MachineUpdateStrategyInPlace MachineUpdateStrategy = "InPlace"
+)
+
+// WorkerRollingUpdate contains the configuration for progressive rolling updates of a worker pool. When the Kubernetes
+// minor version of the worker pool is upgraded, the nodes are rolled in canary steps. After each step, the rollout is
+// paused until the soak period has passed and all nodes are healthy.
+type WorkerRollingUpdate struct {
+	// MaxSurge is the maximum number of machines that are created per zone while the canary steps are rolled out.
+	MaxSurge *intstr.IntOrString
+	// MaxUnavailable is the maximum number of machines per zone that can be unavailable while the canary steps are
+	// rolled out.
+	MaxUnavailable *intstr.IntOrString
+	// CanarySteps is the list of cumulative numbers or percentages of machines per zone which are rolled before the
+	// rollout is paused.
+	CanarySteps []intstr.IntOrString
+	// SoakPeriod is the duration for which the rollout is paused after each canary step.
+	SoakPeriod *metav1.Duration
+}
+
+// WorkerHeadroom contains the configuration for capacity headroom of a worker pool. Gardener runs low-priority
+// placeholder pods on the pool's nodes which reserve the given resources. They are preempted as soon as pods with a
+// higher priority need the capacity, hence these pods can be scheduled immediately while the cluster-autoscaler
+// provisions new nodes for the preempted placeholders.
+type WorkerHeadroom struct {
+	// Replicas is the number of placeholder pods.
+	Replicas int32
+	// CPU is the amount of CPU reserved by each placeholder pod.
+	CPU *resource.Quantity
+	// Memory is the amount of memory reserved by each placeholder pod.
+	Memory *resource.Quantity
+}
+
+// WorkerExistingHosts contains the configuration for worker pools backed by pre-existing hosts.
+type WorkerExistingHosts struct {
+	// BootstrapTokenValidity is the validity of the bootstrap token used for registering hosts. The token is renewed
+	// with every reconciliation of the Shoot.
+	BootstrapTokenValidity *metav1.Duration
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1172,6 +1424,10 @@ type ClusterAutoscalerOptions struct {
 	ScaleDownUnreadyTime *metav1.Duration
 	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
 	MaxNodeProvisionTime *metav1.Duration
+	// Priority is the priority of the worker pool for the priority expander of the cluster autoscaler. Worker pools with
+	// a higher priority are preferred during scale-up. Worker pools without a priority are treated as having priority 0.
+	// It is only considered if the priority expander is configured in `.spec.kubernetes.clusterAutoscaler.expander`.
+	Priority *int32
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -1303,14 +1559,40 @@ type SystemComponents struct {
 	CoreDNS *CoreDNS
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	NodeLocalDNS *NodeLocalDNS
+	// RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster.
+	RuntimeSecurity *RuntimeSecurity
+	// CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster.
+	CertificateService *CertificateService
+	// ResourceTier is the tier of the resource presets (requests, limits and bounds of the vertical pod autoscaling)
+	// of the managed system components (CoreDNS, kube-proxy, node-problem-detector, metrics-server).
+	ResourceTier *SystemComponentsResourceTier
 }
 
+// SystemComponentsResourceTier is a type alias for the resource tier of the managed system components.
+type SystemComponentsResourceTier string
+
+const (
+	// SystemComponentsResourceTierSmall is a constant for the resource tier suitable for small clusters.
+	SystemComponentsResourceTierSmall SystemComponentsResourceTier = "small"
+	// SystemComponentsResourceTierMedium is a constant for the default resource tier.
+	SystemComponentsResourceTierMedium SystemComponentsResourceTier = "medium"
+	// SystemComponentsResourceTierLarge is a constant for the resource tier suitable for large clusters.
+	SystemComponentsResourceTierLarge SystemComponentsResourceTier = "large"
+)
+
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
 type CoreDNS struct {
 	// Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
 	Autoscaling *CoreDNSAutoscaling
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	Rewriting *CoreDNSRewriting
+	// Plugins is a list of additional plugins which are enabled in the default server block of Core DNS.
+	Plugins []CoreDNSPlugin
+	// Cache contains the settings of the cache of the default server block of Core DNS.
+	Cache *CoreDNSCache
+	// ForwardZones is a list of zones whose requests are forwarded to dedicated upstream name servers instead of the
+	// name servers configured on the nodes.
+	ForwardZones []CoreDNSForwardZone
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1318,6 +1600,16 @@ type CoreDNSAutoscaling struct {
 	// The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster.
 	// Supported values are `horizontal` and `cluster-proportional`.
 	Mode CoreDNSAutoscalingMode
+	// MinReplicas is the minimum number of Core DNS replicas.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of Core DNS replicas. It is only supported for the `horizontal` mode.
+	MaxReplicas *int32
+	// CoresPerReplica is the number of CPU cores of the nodes per Core DNS replica. It is only supported for the
+	// `cluster-proportional` mode.
+	CoresPerReplica *int32
+	// NodesPerReplica is the number of nodes per Core DNS replica. It is only supported for the `cluster-proportional`
+	// mode.
+	NodesPerReplica *int32
 }
 
 // CoreDNSAutoscalingMode is a type alias for the Core DNS autoscaling mode string.
@@ -1336,6 +1628,32 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string
 }
 
+// CoreDNSPlugin contains the settings of an additional Core DNS plugin.
+type CoreDNSPlugin struct {
+	// Name is the name of the plugin.
+	Name string
+	// Args is a list of arguments which are passed to the plugin.
+	Args []string
+}
+
+// CoreDNSCache contains the settings of the cache of Core DNS.
+type CoreDNSCache struct {
+	// TTL is the maximum time in seconds for which responses are cached.
+	TTL *int32
+	// SuccessCapacity is the maximum number of cached successful responses.
+	SuccessCapacity *int32
+	// DenialCapacity is the maximum number of cached denial responses.
+	DenialCapacity *int32
+}
+
+// CoreDNSForwardZone contains the settings for forwarding the requests of a zone to dedicated upstream name servers.
+type CoreDNSForwardZone struct {
+	// Zone is the DNS zone whose requests are forwarded, e.g. `corp.example.com`.
+	Zone string
+	// Upstreams is a list of IP addresses (optionally with port) of the upstream name servers.
+	Upstreams []string
+}
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1352,6 +1670,18 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster.
+type RuntimeSecurity struct {
+	// Enabled indicates whether the runtime security agent is enabled or not.
+	Enabled bool
+}
+
+// CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster.
+type CertificateService struct {
+	// Enabled indicates whether the certificate service is enabled or not.
+	Enabled bool
+}
+
 const (
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
@@ -1361,10 +1691,16 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationSkipped indicates that hibernation was skipped due to an exception of the schedule.
+	ShootEventHibernationSkipped = "HibernationSkipped"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventPaused indicates that the operations on the Shoot were paused.
+	ShootEventPaused = "Paused"
+	// ShootEventResumed indicates that the operations on the Shoot were resumed.
+	ShootEventResumed = "Resumed"
 )
 
 const (
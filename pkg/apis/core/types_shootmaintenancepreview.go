@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootMaintenancePreview contains the changes which the next maintenance of a Shoot would apply based on the current
+// state of the Shoot and its CloudProfile. It is returned by the `shoots/maintenance-preview` subresource.
+type ShootMaintenancePreview struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.
+	NextMaintenanceTimeWindow MaintenancePreviewTimeWindow
+	// KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would
+	// not be updated.
+	KubernetesVersion *VersionUpdatePreview
+	// Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.
+	Workers []WorkerMaintenancePreview
+	// Operation is the operation which would be triggered by the maintenance, e.g. a credentials rotation which was
+	// requested via the `maintenance.gardener.cloud/operation` annotation.
+	Operation *string
+	// Changes contains the descriptions of further changes which would be applied to the Shoot specification, e.g.
+	// removed feature gates or admission plugins.
+	Changes []string
+}
+
+// MaintenancePreviewTimeWindow is a concrete maintenance time window.
+type MaintenancePreviewTimeWindow struct {
+	// Begin is the begin of the time window.
+	Begin metav1.Time
+	// End is the end of the time window.
+	End metav1.Time
+}
+
+// VersionUpdatePreview is a version update which would be performed by the next maintenance.
+type VersionUpdatePreview struct {
+	// CurrentVersion is the currently used version.
+	CurrentVersion string
+	// TargetVersion is the version which would be used after the maintenance. It is not set if no suitable version could
+	// be determined.
+	TargetVersion *string
+	// Reason is the reason for the update, e.g. an automatic update or an expired version.
+	Reason string
+	// FailureReason describes why the update would fail.
+	FailureReason *string
+}
+
+// WorkerMaintenancePreview contains the updates of a worker pool which would be performed by the next maintenance.
+type WorkerMaintenancePreview struct {
+	// Name is the name of the worker pool.
+	Name string
+	// KubernetesVersion is the update of the Kubernetes version of the worker pool.
+	KubernetesVersion *VersionUpdatePreview
+	// MachineImage is the update of the machine image version of the worker pool.
+	MachineImage *MachineImageUpdatePreview
+}
+
+// MachineImageUpdatePreview is a machine image version update which would be performed by the next maintenance.
+type MachineImageUpdatePreview struct {
+	// Name is the name of the machine image.
+	Name string
+	// VersionUpdatePreview is the update of the machine image version.
+	VersionUpdatePreview
+}
@@ -260,6 +260,14 @@ const (
 	GardenRoleCACluster = "ca-cluster"
 	// GardenRoleCAClient is the value of the GardenRole key indicating type 'ca-client'.
 	GardenRoleCAClient = "ca-client"
+	// GardenRoleClusterInventory is the value of the GardenRole key indicating type 'cluster-inventory'.
+	GardenRoleClusterInventory = "cluster-inventory"
+	// GardenRoleHostBootstrap is the value of the GardenRole key indicating type 'host-bootstrap'.
+	GardenRoleHostBootstrap = "host-bootstrap"
+	// GardenRoleInventory is the value of the GardenRole key indicating type 'inventory'.
+	GardenRoleInventory = "inventory"
+	// GardenRoleShootStateEncryptionKey is the value of the GardenRole key indicating type 'shootstate-encryption-key'.
+	GardenRoleShootStateEncryptionKey = "shootstate-encryption-key"
 	// GardenRoleSSHKeyPair is the value of the GardenRole key indicating type 'ssh-keypair'.
 	GardenRoleSSHKeyPair = "ssh-keypair"
 	// GardenRoleDefaultDomain is the value of the GardenRole key indicating type 'default-domain'.
@@ -270,6 +278,8 @@ const (
 	GardenRoleGlobalMonitoring = "global-monitoring"
 	// GardenRoleGlobalShootRemoteWriteMonitoring is the value of the GardenRole key indicating type 'global-shoot-remote-write-monitoring'
 	GardenRoleGlobalShootRemoteWriteMonitoring = "global-shoot-remote-write-monitoring"
+	// GardenRoleGlobalSeedRemoteWriteMonitoring is the value of the GardenRole key indicating type 'global-seed-remote-write-monitoring'
+	GardenRoleGlobalSeedRemoteWriteMonitoring = "global-seed-remote-write-monitoring"
 	// GardenRoleAlerting is the value of GardenRole key indicating type 'alerting'.
 	GardenRoleAlerting = "alerting"
 	// GardenRoleHvpa is the value of GardenRole key indicating type 'hvpa'.
@@ -324,6 +334,10 @@ const (
 	// excluded from automatic remediation.
 	LabelExcludeWebhookFromRemediation = "remediation.webhook.shoot.gardener.cloud/exclude"
 
+	// LabelHibernationPreCheck is a constant for a label on a PodDisruptionBudget in the shoot which makes it being
+	// considered by the hibernation pre-checks.
+	LabelHibernationPreCheck = "hibernation.shoot.gardener.cloud/pre-check"
+
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.gardener.cloud/tasks"
 	// ShootTaskDeployInfrastructure is a name for a Shoot's infrastructure deployment task. It indicates that the
@@ -345,9 +359,29 @@ const (
 	// ShootOperationMaintain is a constant for an annotation on a Shoot indicating that the Shoot maintenance shall be
 	// executed as soon as possible.
 	ShootOperationMaintain = "maintain"
+	// ShootMaintenanceOperationPreview is a constant for the value of the maintenance operation annotation on a Shoot
+	// indicating that the changes of the next maintenance shall be computed and written to the Shoot status without
+	// applying them.
+	ShootMaintenanceOperationPreview = "preview"
 	// ShootOperationRetry is a constant for an annotation on a Shoot indicating that a failed Shoot reconciliation shall be
 	// retried.
 	ShootOperationRetry = "retry"
+	// ShootOperationRetryFailedTasks is a constant for an annotation on a Shoot indicating that a failed Shoot
+	// reconciliation shall be retried while only re-running the flow tasks which failed during the last operation (and
+	// the tasks depending on them) instead of the whole reconciliation flow.
+	ShootOperationRetryFailedTasks = "retry-failed-task"
+	// ShootOperationEnableDebugMode is a constant for an annotation on a Shoot indicating that the debug mode of the
+	// control plane shall be enabled for a bounded time window, see AnnotationShootDebugModeUntil.
+	ShootOperationEnableDebugMode = "enable-debug-mode"
+	// ShootOperationDisableDebugMode is a constant for an annotation on a Shoot indicating that the debug mode of the
+	// control plane shall be disabled before its time window ends.
+	ShootOperationDisableDebugMode = "disable-debug-mode"
+	// ShootOperationEnableDualStack is a constant for an annotation on a Shoot indicating that the networking of an IPv4
+	// single-stack Shoot shall be migrated to dual-stack (IPv4 and IPv6).
+	ShootOperationEnableDualStack = "enable-dual-stack"
+	// ShootOperationMigrateBackupBucket is a constant for an annotation on a Shoot indicating that the etcd backups of
+	// the Shoot shall be moved to the BackupBucket referenced by AnnotationShootBackupBucketMigrationTarget.
+	ShootOperationMigrateBackupBucket = "migrate-backup-bucket"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -608,6 +642,9 @@ const (
 	// DataTypeSecret is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing that
 	// the data is a secret.
 	DataTypeSecret = "secret"
+	// DataTypeEncryptedSecret is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
+	// that the data is a secret which is encrypted with the shoot-specific ShootState encryption key.
+	DataTypeEncryptedSecret = "encrypted-secret"
 	// DataTypeMachineState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
 	// that the data is machine state.
 	DataTypeMachineState = "machine-state"
@@ -633,8 +670,22 @@ const (
 	// AnnotationShootSkipCleanup is a key for an annotation on a Shoot resource that declares that the clean up steps should be skipped when the
 	// cluster is deleted. Concretely, this will skip everything except the deletion of (load balancer) services and persistent volume resources.
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
+	// AnnotationShootImport is a key for an annotation on a Shoot resource that declares that the cluster already exists
+	// and shall be adopted instead of being created. gardenlet does not act on such Shoots until the import is triggered
+	// via the `shoots/import` subresource.
+	AnnotationShootImport = "shoot.gardener.cloud/import"
+	// AnnotationShootConformanceTest is a key for an annotation on a Shoot resource that triggers a run of the
+	// conformance tests against the cluster when set to ShootConformanceTestRun. gardenlet removes the annotation once
+	// the run was started and publishes the results in the Shoot status.
+	AnnotationShootConformanceTest = "shoot.gardener.cloud/conformance-test"
+	// ShootConformanceTestRun is the value of the AnnotationShootConformanceTest annotation which triggers a run of the
+	// conformance tests.
+	ShootConformanceTestRun = "run"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
+	// AnnotationShootSkipRemediation is a key for an annotation on a Shoot resource that disables the automatic
+	// remediation of failed health checks by the shoot care controller for this Shoot.
+	AnnotationShootSkipRemediation = "shoot.gardener.cloud/skip-remediation"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
 	// declares the grace period in seconds for finalizing the resources handled in the 'cleanup webhooks' step.
 	// Concretely, after the specified seconds, all the finalizers of the affected resources are forcefully removed.
@@ -657,6 +708,16 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootDebugModeUntil is a key for an annotation on a Shoot resource whose value is an RFC3339 timestamp
+	// until which the debug mode of the control plane is enabled. While it is enabled, the control plane components
+	// run with increased log verbosity and their profiling endpoints are enabled. The annotation is removed by
+	// gardenlet after the timestamp has passed which reverts the settings.
+	AnnotationShootDebugModeUntil = "shoot.gardener.cloud/debug-mode-until"
+	// AnnotationShootBackupBucketMigrationTarget is a key for an annotation on a Shoot resource whose value is the name
+	// of the BackupBucket to which the etcd backups of the Shoot shall be moved. The BackupBucket must be assigned to the
+	// Seed of the Shoot. The annotation is removed by gardenlet after the backups were copied and etcd was switched to the
+	// new BackupBucket.
+	AnnotationShootBackupBucketMigrationTarget = "shoot.gardener.cloud/backup-bucket-migration-target"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -664,6 +725,9 @@ const (
 	// AnnotationAuthenticationIssuerManaged is the value for [AnnotationAuthenticationIssuer] annotation that indicates that
 	// a shoot's issuer should be managed by Gardener.
 	AnnotationAuthenticationIssuerManaged = "managed"
+	// AnnotationAuthenticationIssuerHostname is the key for an annotation applied to a Shoot with managed issuer which
+	// specifies a custom hostname used for the shoot's issuer instead of the hostname of the Gardener Discovery Server.
+	AnnotationAuthenticationIssuerHostname = "authentication.gardener.cloud/issuer-hostname"
 
 	// AnnotationPodSecurityEnforce is a constant for an annotation on `ControllerRegistration`s and `ControllerInstallation`s. When set the
 	// `extension` namespace is created with "pod-security.kubernetes.io/enforce" label set to AnnotationPodSecurityEnforce's value.
@@ -713,6 +777,23 @@ const (
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
 
+	// AnnotationEventCorrelationID is a key for an annotation on events emitted by Gardener's controllers containing the
+	// ID of the reconciliation which emitted the event. It can be used to correlate the event with the logs of the
+	// reconciliation.
+	AnnotationEventCorrelationID = "events.gardener.cloud/correlation-id"
+	// AnnotationEventShootName is a key for an annotation on events emitted by Gardener's controllers containing the
+	// name of the shoot the event relates to.
+	AnnotationEventShootName = "events.gardener.cloud/shoot-name"
+	// AnnotationEventShootNamespace is a key for an annotation on events emitted by Gardener's controllers containing the
+	// namespace of the shoot the event relates to.
+	AnnotationEventShootNamespace = "events.gardener.cloud/shoot-namespace"
+	// AnnotationEventSeedName is a key for an annotation on events emitted by Gardener's controllers containing the name
+	// of the seed the event relates to.
+	AnnotationEventSeedName = "events.gardener.cloud/seed-name"
+	// AnnotationEventErrorCodes is a key for an annotation on events emitted by Gardener's controllers containing a
+	// comma-separated list of the error codes related to the event.
+	AnnotationEventErrorCodes = "events.gardener.cloud/error-codes"
+
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
 	ReferencedResourcesPrefix = "ref-"
@@ -783,6 +864,9 @@ const (
 	DNSRecordInternalName = "internal"
 	// DNSRecordExternalName is a constant for DNSRecord objects used for the external domain name.
 	DNSRecordExternalName = "external"
+	// DNSRecordExternalSecondaryName is a constant for DNSRecord objects used for the external domain name when managed
+	// by the secondary DNS provider.
+	DNSRecordExternalSecondaryName = "external-secondary"
 
 	// ArchitectureAMD64 is a constant for the 'amd64' architecture.
 	ArchitectureAMD64 = "amd64"
@@ -812,6 +896,12 @@ const (
 	// AdvertisedAddressServiceAccountIssuer is a constant that represents the name of the address
 	// that is used as a service account issuer for the kube-apiserver.
 	AdvertisedAddressServiceAccountIssuer = "service-account-issuer"
+	// AdvertisedAddressServiceAccountIssuerJWKS is a constant that represents the name of the address
+	// serving the JSON Web Key Set of a managed service account issuer.
+	AdvertisedAddressServiceAccountIssuerJWKS = "service-account-issuer-jwks"
+	// AdvertisedAddressBootstrap is a constant that represents the name of the kube-apiserver address
+	// which is used by the nodes of the shoot for bootstrapping with a bootstrap token.
+	AdvertisedAddressBootstrap = "bootstrap"
 
 	// CloudProfileReferenceKindCloudProfile is a constant for the CloudProfile kind reference.
 	CloudProfileReferenceKindCloudProfile = "CloudProfile"
@@ -825,6 +915,7 @@ var (
 		GardenRoleKubeconfig,
 		GardenRoleSSHKeyPair,
 		GardenRoleMonitoring,
+		GardenRoleHostBootstrap,
 	}
 
 	// ValidArchitectures contains all CPU architectures which are supported by the Shoot.
@@ -867,6 +958,10 @@ const (
 	// PriorityClassNameShootSystem600 is the name of a PriorityClass for Shoot system components.
 	// Please consider the documentation in https://github.com/gardener/gardener/blob/master/docs/development/priority-classes.md
 	PriorityClassNameShootSystem600 = "gardener-shoot-system-600"
+	// PriorityClassNameShootWorkerHeadroom is the name of a PriorityClass for reserving capacity headroom in worker pools
+	// of a Shoot cluster.
+	// Please consider the documentation in https://github.com/gardener/gardener/blob/master/docs/development/priority-classes.md
+	PriorityClassNameShootWorkerHeadroom = "gardener-shoot-worker-headroom"
 
 	// PriorityClassNameSeedSystemCritical is the name of a PriorityClass for Seed system components.
 	// Please consider the documentation in https://github.com/gardener/gardener/blob/master/docs/development/priority-classes.md
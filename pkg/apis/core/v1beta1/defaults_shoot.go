@@ -13,7 +13,6 @@ import (
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
 // SetDefaults_Shoot sets default values for Shoot objects.
@@ -22,11 +21,6 @@ func SetDefaults_Shoot(obj *Shoot) {
 		obj.Spec.Kubernetes.KubeAPIServer = &KubeAPIServerConfig{}
 	}
 
-	if obj.Spec.Purpose == nil {
-		p := ShootPurposeEvaluation
-		obj.Spec.Purpose = &p
-	}
-
 	// In previous Gardener versions that weren't supporting tolerations, it was hard-coded to (only) allow shoots in the
 	// `garden` namespace to use seeds that had the 'protected' taint. In order to be backwards compatible, now with the
 	// introduction of tolerations, we add the 'protected' toleration to the garden namespace by default.
@@ -209,17 +203,6 @@ func SetDefaults_Networking(obj *Networking) {
 	}
 }
 
-// SetDefaults_Maintenance sets default values for Maintenance objects.
-func SetDefaults_Maintenance(obj *Maintenance) {
-	if obj.TimeWindow == nil {
-		mt := timewindow.RandomMaintenanceTimeWindow()
-		obj.TimeWindow = &MaintenanceTimeWindow{
-			Begin: mt.Begin().Formatted(),
-			End:   mt.End().Formatted(),
-		}
-	}
-}
-
 // SetDefaults_VerticalPodAutoscaler sets default values for VerticalPodAutoscaler objects.
 func SetDefaults_VerticalPodAutoscaler(obj *VerticalPodAutoscaler) {
 	if obj.EvictAfterOOMThreshold == nil {
@@ -289,6 +272,28 @@ func SetDefaults_Worker(obj *Worker) {
 			Allow: DefaultWorkerSystemComponentsAllow,
 		}
 	}
+	if obj.RollingUpdate != nil {
+		if obj.RollingUpdate.MaxSurge == nil {
+			obj.RollingUpdate.MaxSurge = obj.MaxSurge
+		}
+		if obj.RollingUpdate.MaxUnavailable == nil {
+			obj.RollingUpdate.MaxUnavailable = obj.MaxUnavailable
+		}
+	}
+}
+
+// SetDefaults_WorkerRollingUpdate sets default values for WorkerRollingUpdate objects.
+func SetDefaults_WorkerRollingUpdate(obj *WorkerRollingUpdate) {
+	if obj.SoakPeriod == nil {
+		obj.SoakPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_WorkerExistingHosts sets default values for WorkerExistingHosts objects.
+func SetDefaults_WorkerExistingHosts(obj *WorkerExistingHosts) {
+	if obj.BootstrapTokenValidity == nil {
+		obj.BootstrapTokenValidity = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 }
 
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
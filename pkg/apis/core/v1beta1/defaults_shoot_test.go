@@ -269,21 +269,12 @@ var _ = Describe("Shoot defaulting", func() {
 	})
 
 	Describe("Purpose defaulting", func() {
-		It("should default purpose field", func() {
+		It("should not default purpose field", func() {
 			obj.Spec.Purpose = nil
 
 			SetObjectDefaults_Shoot(obj)
 
-			Expect(obj.Spec.Purpose).To(PointTo(Equal(ShootPurposeEvaluation)))
-		})
-
-		It("should not default purpose field if it is already set", func() {
-			p := ShootPurposeDevelopment
-			obj.Spec.Purpose = &p
-
-			SetObjectDefaults_Shoot(obj)
-
-			Expect(obj.Spec.Purpose).To(PointTo(Equal(ShootPurposeDevelopment)))
+			Expect(obj.Spec.Purpose).To(BeNil())
 		})
 	})
 
@@ -494,12 +485,10 @@ var _ = Describe("Shoot defaulting", func() {
 			obj.Spec.Maintenance = nil
 		})
 
-		It("should default the maintenance timeWindow field", func() {
+		It("should not default the maintenance timeWindow field", func() {
 			SetObjectDefaults_Shoot(obj)
 
-			Expect(obj.Spec.Maintenance.TimeWindow).NotTo(BeNil())
-			Expect(obj.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
-			Expect(obj.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			Expect(obj.Spec.Maintenance.TimeWindow).To(BeNil())
 		})
 
 		It("should default both KubernetesVersion and MachineImageVersion field for shoot with workers", func() {
@@ -797,6 +786,59 @@ var _ = Describe("Shoot defaulting", func() {
 				Expect(worker.SystemComponents.Allow).To(BeFalse())
 			}
 		})
+
+		It("should default the bootstrap token validity for worker pools with existing hosts", func() {
+			obj.Spec.Provider.Workers = []Worker{{ExistingHosts: &WorkerExistingHosts{}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].ExistingHosts.BootstrapTokenValidity).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+		})
+
+		It("should not overwrite the already set bootstrap token validity", func() {
+			obj.Spec.Provider.Workers = []Worker{{ExistingHosts: &WorkerExistingHosts{BootstrapTokenValidity: &metav1.Duration{Duration: time.Hour}}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].ExistingHosts.BootstrapTokenValidity).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should default the rolling update configuration based on the worker pool settings", func() {
+			obj.Spec.Provider.Workers = []Worker{{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				RollingUpdate:  &WorkerRollingUpdate{CanarySteps: []intstr.IntOrString{intstr.FromInt32(1)}},
+			}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].RollingUpdate).To(Equal(&WorkerRollingUpdate{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				CanarySteps:    []intstr.IntOrString{intstr.FromInt32(1)},
+				SoakPeriod:     &metav1.Duration{Duration: 10 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite the already set rolling update configuration", func() {
+			obj.Spec.Provider.Workers = []Worker{{
+				RollingUpdate: &WorkerRollingUpdate{
+					MaxSurge:       &maxUnavailable,
+					MaxUnavailable: &maxSurge,
+					CanarySteps:    []intstr.IntOrString{intstr.FromString("10%")},
+					SoakPeriod:     &metav1.Duration{Duration: time.Hour},
+				},
+			}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].RollingUpdate).To(Equal(&WorkerRollingUpdate{
+				MaxSurge:       &maxUnavailable,
+				MaxUnavailable: &maxSurge,
+				CanarySteps:    []intstr.IntOrString{intstr.FromString("10%")},
+				SoakPeriod:     &metav1.Duration{Duration: time.Hour},
+			}))
+		})
 	})
 
 	Describe("ClusterAutoscaler defaulting", func() {
@@ -23,7 +23,7 @@ func InitConditionWithClock(clock clock.Clock, conditionType gardencorev1beta1.C
 	return gardencorev1beta1.Condition{
 		Type:               conditionType,
 		Status:             gardencorev1beta1.ConditionUnknown,
-		Reason:             "ConditionInitialized",
+		Reason:             gardencorev1beta1.ConditionInitialized,
 		Message:            "The condition has been initialized but its semantic check has not been performed yet.",
 		LastTransitionTime: now,
 		LastUpdateTime:     now,
@@ -208,3 +208,111 @@ func IsResourceSupported(resources []gardencorev1beta1.ControllerResource, resou
 
 	return false
 }
+
+// ConditionReasonSeverity is the severity of a condition reason.
+type ConditionReasonSeverity string
+
+const (
+	// ConditionReasonSeverityInfo indicates that the condition reason reports a healthy state.
+	ConditionReasonSeverityInfo ConditionReasonSeverity = "Info"
+	// ConditionReasonSeverityWarning indicates that the condition reason reports a state which might require attention,
+	// e.g., because it is transitional.
+	ConditionReasonSeverityWarning ConditionReasonSeverity = "Warning"
+	// ConditionReasonSeverityError indicates that the condition reason reports a problem.
+	ConditionReasonSeverityError ConditionReasonSeverity = "Error"
+)
+
+// ConditionReasonsDocumentationURL is the URL of the documentation describing the well-known condition reasons.
+const ConditionReasonsDocumentationURL = "https://github.com/gardener/gardener/blob/master/docs/usage/shoot_status.md#condition-reasons"
+
+// ConditionReasonInfo contains well-defined metadata about a condition reason which allows automation (e.g., alerting
+// rules or dashboards) to map reasons to actions.
+type ConditionReasonInfo struct {
+	// Severity is the severity of the condition reason.
+	Severity ConditionReasonSeverity
+	// DocumentationURL is a link to the documentation of the condition reason.
+	DocumentationURL string
+}
+
+var conditionReasonSeverities = map[string]ConditionReasonSeverity{
+	gardencorev1beta1.ConditionCheckError:                     ConditionReasonSeverityError,
+	gardencorev1beta1.ManagedResourceMissingConditionError:    ConditionReasonSeverityError,
+	gardencorev1beta1.OutdatedStatusError:                     ConditionReasonSeverityWarning,
+	gardencorev1beta1.ManagedResourceProgressingRolloutStuck:  ConditionReasonSeverityError,
+	gardencorev1beta1.ConditionInitialized:                    ConditionReasonSeverityInfo,
+	gardencorev1beta1.ConditionNotChecked:                     ConditionReasonSeverityInfo,
+	gardencorev1beta1.ConstraintNotChecked:                    ConditionReasonSeverityInfo,
+	gardencorev1beta1.APIServerDown:                           ConditionReasonSeverityError,
+	gardencorev1beta1.ControllersScaledDown:                   ConditionReasonSeverityError,
+	gardencorev1beta1.ControllersScaledDownCheckError:         ConditionReasonSeverityError,
+	gardencorev1beta1.ControlPlaneRunning:                     ConditionReasonSeverityInfo,
+	gardencorev1beta1.ObservabilityComponentsRunning:          ConditionReasonSeverityInfo,
+	gardencorev1beta1.SystemComponentsRunning:                 ConditionReasonSeverityInfo,
+	gardencorev1beta1.RuntimeComponentsRunning:                ConditionReasonSeverityInfo,
+	gardencorev1beta1.VirtualComponentsRunning:                ConditionReasonSeverityInfo,
+	gardencorev1beta1.NoTunnelDeployed:                        ConditionReasonSeverityError,
+	gardencorev1beta1.TunnelConnectionBroken:                  ConditionReasonSeverityError,
+	gardencorev1beta1.EveryNodeReady:                          ConditionReasonSeverityInfo,
+	gardencorev1beta1.MissingNodes:                            ConditionReasonSeverityError,
+	gardencorev1beta1.OperatingSystemConfigOutdated:           ConditionReasonSeverityWarning,
+	gardencorev1beta1.NodeAgentUnhealthy:                      ConditionReasonSeverityError,
+	gardencorev1beta1.NodesScalingUp:                          ConditionReasonSeverityWarning,
+	gardencorev1beta1.NodesScalingDown:                        ConditionReasonSeverityWarning,
+	gardencorev1beta1.TooManyExpiredNodeLeases:                ConditionReasonSeverityError,
+	gardencorev1beta1.ManagedResourceListingFailed:            ConditionReasonSeverityError,
+	gardencorev1beta1.DeploymentMissing:                       ConditionReasonSeverityError,
+	gardencorev1beta1.DeploymentUnhealthy:                     ConditionReasonSeverityError,
+	gardencorev1beta1.EtcdMissing:                             ConditionReasonSeverityError,
+	gardencorev1beta1.EtcdUnhealthy:                           ConditionReasonSeverityError,
+	gardencorev1beta1.NodeUnhealthy:                           ConditionReasonSeverityError,
+	gardencorev1beta1.VersionParseError:                       ConditionReasonSeverityError,
+	gardencorev1beta1.KubeletVersionMismatch:                  ConditionReasonSeverityWarning,
+	gardencorev1beta1.VirtualGardenAPIServerDown:              ConditionReasonSeverityError,
+	gardencorev1beta1.ExpiringCACertificates:                  ConditionReasonSeverityWarning,
+	gardencorev1beta1.NoExpiringCACertificates:                ConditionReasonSeverityInfo,
+	gardencorev1beta1.CRDsWithProblematicConversionWebhooks:   ConditionReasonSeverityWarning,
+	gardencorev1beta1.NoCRDsWithProblematicConversionWebhooks: ConditionReasonSeverityInfo,
+	gardencorev1beta1.HibernationPreChecksFailed:              ConditionReasonSeverityWarning,
+	gardencorev1beta1.HibernationPreChecksWarning:             ConditionReasonSeverityWarning,
+	gardencorev1beta1.ProblematicWebhooks:                     ConditionReasonSeverityWarning,
+	gardencorev1beta1.RemediatedWebhooks:                      ConditionReasonSeverityWarning,
+	gardencorev1beta1.NoProblematicWebhooks:                   ConditionReasonSeverityInfo,
+	gardencorev1beta1.SeedReadError:                           ConditionReasonSeverityError,
+	gardencorev1beta1.InstallationPending:                     ConditionReasonSeverityWarning,
+	gardencorev1beta1.InstallationSuccessful:                  ConditionReasonSeverityInfo,
+	gardencorev1beta1.ControllerNotHealthy:                    ConditionReasonSeverityError,
+	gardencorev1beta1.ControllerHealthy:                       ConditionReasonSeverityInfo,
+	gardencorev1beta1.ControllerNotRolledOut:                  ConditionReasonSeverityWarning,
+	gardencorev1beta1.ControllerRolledOut:                     ConditionReasonSeverityInfo,
+	gardencorev1beta1.PodSecurityViolations:                   ConditionReasonSeverityWarning,
+	gardencorev1beta1.NoPodSecurityViolations:                 ConditionReasonSeverityInfo,
+	gardencorev1beta1.RegistrationNotFound:                    ConditionReasonSeverityError,
+	gardencorev1beta1.RegistrationReadError:                   ConditionReasonSeverityError,
+	gardencorev1beta1.SeedNotFound:                            ConditionReasonSeverityError,
+	gardencorev1beta1.ChartInformationInvalid:                 ConditionReasonSeverityError,
+	gardencorev1beta1.OCIChartCannotBePulled:                  ConditionReasonSeverityError,
+	gardencorev1beta1.ChartCannotBeRendered:                   ConditionReasonSeverityError,
+	gardencorev1beta1.RegistrationValid:                       ConditionReasonSeverityInfo,
+	gardencorev1beta1.InstallationFailed:                      ConditionReasonSeverityError,
+	gardencorev1beta1.DeletionPending:                         ConditionReasonSeverityWarning,
+	gardencorev1beta1.DeletionFailed:                          ConditionReasonSeverityError,
+	gardencorev1beta1.DeletionSuccessful:                      ConditionReasonSeverityInfo,
+	gardencorev1beta1.NotAllExtensionsValid:                   ConditionReasonSeverityError,
+	gardencorev1beta1.NotAllExtensionsInstalled:               ConditionReasonSeverityError,
+	gardencorev1beta1.NotAllExtensionsHealthy:                 ConditionReasonSeverityError,
+	gardencorev1beta1.SomeExtensionsProgressing:               ConditionReasonSeverityWarning,
+	gardencorev1beta1.AllExtensionsReady:                      ConditionReasonSeverityInfo,
+	gardencorev1beta1.HealthzRequestFailed:                    ConditionReasonSeverityError,
+	gardencorev1beta1.HealthzRequestError:                     ConditionReasonSeverityError,
+	gardencorev1beta1.HealthzRequestSucceeded:                 ConditionReasonSeverityInfo,
+}
+
+// GetConditionReasonInfo returns the well-defined metadata for the given condition reason. The second return value is
+// false if the reason is unknown.
+func GetConditionReasonInfo(reason string) (ConditionReasonInfo, bool) {
+	severity, ok := conditionReasonSeverities[reason]
+	if !ok {
+		return ConditionReasonInfo{}, false
+	}
+	return ConditionReasonInfo{Severity: severity, DocumentationURL: ConditionReasonsDocumentationURL}, true
+}
@@ -162,7 +162,7 @@ func (b *defaultConditionBuilder) buildReason() string {
 		return "Unspecified"
 	}
 	if b.old.Reason == "" {
-		return "ConditionInitialized"
+		return gardencorev1beta1.ConditionInitialized
 	}
 	return b.old.Reason
 }
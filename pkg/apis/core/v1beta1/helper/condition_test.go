@@ -480,6 +480,23 @@ var _ = Describe("Condition", func() {
 			Expect(conditions.Reason).To(Equal("ConditionCheckError"))
 		})
 	})
+
+	DescribeTable("#GetConditionReasonInfo",
+		func(reason string, expectedSeverity ConditionReasonSeverity, expectedOK bool) {
+			info, ok := GetConditionReasonInfo(reason)
+			Expect(ok).To(Equal(expectedOK))
+			Expect(info.Severity).To(Equal(expectedSeverity))
+			if expectedOK {
+				Expect(info.DocumentationURL).To(Equal(ConditionReasonsDocumentationURL))
+			}
+		},
+
+		Entry("healthy reason", "ControlPlaneRunning", ConditionReasonSeverityInfo, true),
+		Entry("transitional reason", "NodesScalingUp", ConditionReasonSeverityWarning, true),
+		Entry("failure reason", "APIServerDown", ConditionReasonSeverityError, true),
+		Entry("existing managed resource reason", "ProgressingRolloutStuck", ConditionReasonSeverityError, true),
+		Entry("unknown reason", "Foo", ConditionReasonSeverity(""), false),
+	)
 })
 
 func beConditionWithStatus(status gardencorev1beta1.ConditionStatus) gomegatypes.GomegaMatcher {
@@ -6,6 +6,7 @@ package helper
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
@@ -51,6 +52,42 @@ type Coder interface {
 	Codes() []gardencorev1beta1.ErrorCode
 }
 
+// ComponentError is an error which additionally carries the name of the component which originally reported it.
+type ComponentError struct {
+	err       error
+	component string
+}
+
+// NewErrorWithComponent creates a new error that additionally exposes the given component name.
+func NewErrorWithComponent(err error, component string) error {
+	return &ComponentError{err, component}
+}
+
+// Component returns the name of the component which originally reported the error.
+func (e *ComponentError) Component() string {
+	return e.component
+}
+
+// Unwrap retrieves the error from ComponentError.
+func (e *ComponentError) Unwrap() error {
+	return e.err
+}
+
+// Error returns the error message.
+func (e *ComponentError) Error() string {
+	return e.err.Error()
+}
+
+// ExtractComponent extracts the name of the component which originally reported the given error. It returns an empty
+// string if the error does not carry a component.
+func ExtractComponent(err error) string {
+	var componentErr *ComponentError
+	if errors.As(err, &componentErr) {
+		return componentErr.Component()
+	}
+	return ""
+}
+
 // ExtractErrorCodes extracts all error codes from the given error by using errorsutils.Errors
 func ExtractErrorCodes(err error) []gardencorev1beta1.ErrorCode {
 	var codes []gardencorev1beta1.ErrorCode
@@ -141,10 +178,16 @@ func NewWrappedLastErrors(description string, err error) *WrappedLastErrors {
 	var lastErrors []gardencorev1beta1.LastError
 
 	for _, partError := range errorsutils.Errors(err) {
-		lastErrors = append(lastErrors, *LastErrorWithTaskID(
+		lastError := LastErrorWithTaskID(
 			partError.Error(),
 			errorsutils.GetID(partError),
-			ExtractErrorCodes(partError)...))
+			ExtractErrorCodes(partError)...)
+
+		if component := ExtractComponent(partError); component != "" {
+			lastError.Component = &component
+		}
+
+		lastErrors = append(lastErrors, *lastError)
 	}
 
 	return &WrappedLastErrors{
@@ -158,6 +201,7 @@ func LastError(description string, codes ...gardencorev1beta1.ErrorCode) *garden
 	return &gardencorev1beta1.LastError{
 		Description: description,
 		Codes:       codes,
+		Hint:        ErrorCodesHint(codes...),
 		LastUpdateTime: &metav1.Time{
 			Time: time.Now(),
 		},
@@ -169,6 +213,7 @@ func LastErrorWithTaskID(description string, taskID string, codes ...gardencorev
 	return &gardencorev1beta1.LastError{
 		Description: description,
 		Codes:       codes,
+		Hint:        ErrorCodesHint(codes...),
 		TaskID:      &taskID,
 		LastUpdateTime: &metav1.Time{
 			Time: time.Now(),
@@ -176,18 +221,105 @@ func LastErrorWithTaskID(description string, taskID string, codes ...gardencorev
 	}
 }
 
+// ErrorCodeInfo contains well-defined metadata about an ErrorCode which allows automation to classify errors.
+type ErrorCodeInfo struct {
+	// UserError is true if the error can be remediated by the user, i.e., it does not require a Gardener operator.
+	UserError bool
+	// Retryable is false if an automatic retry would not help fixing the problem.
+	Retryable bool
+	// Hint is a human readable hint how the error can be remediated.
+	Hint string
+}
+
+var errorCodeInfos = map[gardencorev1beta1.ErrorCode]ErrorCodeInfo{
+	gardencorev1beta1.ErrorInfraUnauthenticated: {
+		UserError: true,
+		Hint:      "Check that the infrastructure credentials referenced by the shoot are valid and not expired.",
+	},
+	gardencorev1beta1.ErrorInfraUnauthorized: {
+		UserError: true,
+		Hint:      "Check that the infrastructure credentials referenced by the shoot have all required permissions.",
+	},
+	gardencorev1beta1.ErrorInfraQuotaExceeded: {
+		UserError: true,
+		Hint:      "Request a quota increase from your infrastructure provider or reduce the requested resources.",
+	},
+	gardencorev1beta1.ErrorInfraRateLimitsExceeded: {
+		Hint: "The infrastructure provider throttles requests, the operation will succeed once the rate limits allow it.",
+	},
+	gardencorev1beta1.ErrorInfraDependencies: {
+		UserError: true,
+		Hint:      "Remove infrastructure resources which were created outside of Gardener and depend on the shoot's infrastructure.",
+	},
+	gardencorev1beta1.ErrorRetryableInfraDependencies: {
+		Retryable: true,
+		Hint:      "Resources depending on the shoot's infrastructure are still being cleaned up, the operation will be retried.",
+	},
+	gardencorev1beta1.ErrorInfraResourcesDepleted: {
+		UserError: true,
+		Retryable: true,
+		Hint:      "The infrastructure provider has no capacity left, consider using other machine types or zones.",
+	},
+	gardencorev1beta1.ErrorCleanupClusterResources: {
+		UserError: true,
+		Retryable: true,
+		Hint:      "Remove the finalizers of or fix the controllers for the resources in the cluster which are stuck in deletion.",
+	},
+	gardencorev1beta1.ErrorConfigurationProblem: {
+		UserError: true,
+		Hint:      "Correct the configuration of the shoot according to the error description.",
+	},
+	gardencorev1beta1.ErrorRetryableConfigurationProblem: {
+		UserError: true,
+		Retryable: true,
+		Hint:      "Correct the configuration of the shoot according to the error description or wait for the problem to resolve itself.",
+	},
+	gardencorev1beta1.ErrorProblematicWebhook: {
+		UserError: true,
+		Hint:      "Adapt the webhook configuration in the shoot to follow the Kubernetes best practices for admission webhooks.",
+	},
+	gardencorev1beta1.ErrorImageVerificationFailed: {
+		Retryable: true,
+		Hint:      "Ensure that the container images of the landscape are signed with one of the public keys trusted by gardenlet and that they are referenced by digest if required.",
+	},
+	gardencorev1beta1.ErrorInfraInternal: {
+		Retryable: true,
+		Hint:      "The infrastructure provider reported an internal error, the operation will be retried. Contact the infrastructure provider if the problem persists.",
+	},
+	gardencorev1beta1.ErrorInfraResourceNotFound: {
+		UserError: true,
+		Hint:      "Check that the infrastructure resources referenced by the shoot (e.g., networks, machine images or keys) exist in the configured region.",
+	},
+	gardencorev1beta1.ErrorExtensionNotReconciled: {
+		Retryable: true,
+		Hint:      "Check that the extension responsible for the resource is installed on the seed and that its controller is running.",
+	},
+}
+
+// GetErrorCodeInfo returns the well-defined metadata for the given error code. The second return value is false if the
+// error code is unknown.
+func GetErrorCodeInfo(code gardencorev1beta1.ErrorCode) (ErrorCodeInfo, bool) {
+	info, ok := errorCodeInfos[code]
+	return info, ok
+}
+
+// ErrorCodesHint returns the remediation hint of the first of the given error codes which is known, or nil if none of
+// them is known.
+func ErrorCodesHint(codes ...gardencorev1beta1.ErrorCode) *string {
+	for _, code := range codes {
+		if info, ok := GetErrorCodeInfo(code); ok && info.Hint != "" {
+			return &info.Hint
+		}
+	}
+	return nil
+}
+
 // HasNonRetryableErrorCode returns true if at least one of given list of last errors has at least one error code that
 // indicates that an automatic retry would not help fixing the problem.
 func HasNonRetryableErrorCode(lastErrors ...gardencorev1beta1.LastError) bool {
 	for _, lastError := range lastErrors {
 		for _, code := range lastError.Codes {
-			if code == gardencorev1beta1.ErrorInfraUnauthenticated ||
-				code == gardencorev1beta1.ErrorInfraUnauthorized ||
-				code == gardencorev1beta1.ErrorInfraDependencies ||
-				code == gardencorev1beta1.ErrorInfraQuotaExceeded ||
-				code == gardencorev1beta1.ErrorInfraRateLimitsExceeded ||
-				code == gardencorev1beta1.ErrorConfigurationProblem ||
-				code == gardencorev1beta1.ErrorProblematicWebhook {
+			if info, ok := GetErrorCodeInfo(code); ok && !info.Retryable {
 				return true
 			}
 		}
@@ -208,3 +340,18 @@ func HasErrorCode(lastErrors []gardencorev1beta1.LastError, code gardencorev1bet
 
 	return false
 }
+
+// ErrorCodesOf returns the distinct error codes of the given LastErrors.
+func ErrorCodesOf(lastErrors ...gardencorev1beta1.LastError) []gardencorev1beta1.ErrorCode {
+	var codes []gardencorev1beta1.ErrorCode
+
+	for _, lastError := range lastErrors {
+		for _, code := range lastError.Codes {
+			if !slices.Contains(codes, code) {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	return codes
+}
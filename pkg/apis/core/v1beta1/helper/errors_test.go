@@ -11,10 +11,12 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
@@ -42,6 +44,65 @@ var _ = Describe("errors", func() {
 			ConsistOf(Equal(gardencorev1beta1.ErrorInfraUnauthorized))),
 	)
 
+	DescribeTable("#ExtractComponent",
+		func(err error, matcher gomegatypes.GomegaMatcher) {
+			Expect(ExtractComponent(err)).To(matcher)
+		},
+
+		Entry("no error given", nil, BeEmpty()),
+		Entry("no component error given", errors.New("error"), BeEmpty()),
+		Entry("component error given", NewErrorWithComponent(errors.New("error"), "foo"), Equal("foo")),
+		Entry("wrapped component error", fmt.Errorf("error %w", NewErrorWithComponent(NewErrorWithCodes(errors.New("error"), gardencorev1beta1.ErrorInfraUnauthorized), "foo")), Equal("foo")),
+	)
+
+	Describe("#NewWrappedLastErrors", func() {
+		It("should set the task ID, codes, component and hint of the last errors", func() {
+			err := errorsutils.WithID("task", NewErrorWithComponent(NewErrorWithCodes(errors.New("error"), gardencorev1beta1.ErrorInfraQuotaExceeded), "foo"))
+
+			wrapped := NewWrappedLastErrors("description", err)
+			Expect(wrapped.Description).To(Equal("description"))
+			Expect(wrapped.LastErrors).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Description": Equal("error"),
+				"TaskID":      PointTo(Equal("task")),
+				"Codes":       ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded),
+				"Component":   PointTo(Equal("foo")),
+				"Hint":        PointTo(ContainSubstring("quota increase")),
+			})))
+		})
+	})
+
+	DescribeTable("#ErrorCodesHint",
+		func(codes []gardencorev1beta1.ErrorCode, matcher gomegatypes.GomegaMatcher) {
+			Expect(ErrorCodesHint(codes...)).To(matcher)
+		},
+
+		Entry("no codes given", nil, BeNil()),
+		Entry("unknown code given", []gardencorev1beta1.ErrorCode{"foo"}, BeNil()),
+		Entry("known code given", []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}, PointTo(ContainSubstring("credentials"))),
+		Entry("unknown and known code given", []gardencorev1beta1.ErrorCode{"foo", gardencorev1beta1.ErrorProblematicWebhook}, PointTo(ContainSubstring("webhook"))),
+	)
+
+	Describe("#GetErrorCodeInfo", func() {
+		It("should return the info for a known error code", func() {
+			info, ok := GetErrorCodeInfo(gardencorev1beta1.ErrorInfraQuotaExceeded)
+			Expect(ok).To(BeTrue())
+			Expect(info.UserError).To(BeTrue())
+			Expect(info.Retryable).To(BeFalse())
+		})
+
+		It("should classify internal errors of the infrastructure provider as retryable non-user errors", func() {
+			info, ok := GetErrorCodeInfo(gardencorev1beta1.ErrorInfraInternal)
+			Expect(ok).To(BeTrue())
+			Expect(info.UserError).To(BeFalse())
+			Expect(info.Retryable).To(BeTrue())
+		})
+
+		It("should return false for an unknown error code", func() {
+			_, ok := GetErrorCodeInfo("foo")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("#MultiErrorWithCodes", func() {
 		var (
 			formatFn   func(errs []error) string
@@ -117,6 +178,9 @@ var _ = Describe("errors", func() {
 		infraDependenciesError       = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies}}
 		infraResourcesDepletedError  = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}}
 		cleanupClusterResourcesError = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorCleanupClusterResources}}
+		infraResourceNotFoundError   = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourceNotFound}}
+		infraInternalError           = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraInternal}}
+		extensionNotReconciledError  = gardencorev1beta1.LastError{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorExtensionNotReconciled}}
 		errorWithoutCodes            = gardencorev1beta1.LastError{}
 	)
 
@@ -130,6 +194,8 @@ var _ = Describe("errors", func() {
 		Entry("only errors with retryable error codes", []gardencorev1beta1.LastError{infraResourcesDepletedError, cleanupClusterResourcesError}, BeFalse()),
 		Entry("errors with both retryable and not retryable error codes", []gardencorev1beta1.LastError{unauthorizedError, unauthenticatedError, configurationProblemError, infraQuotaExceededError, infraRateLimitsExceededError, infraDependenciesError, infraResourcesDepletedError, cleanupClusterResourcesError}, BeTrue()),
 		Entry("errors without error codes", []gardencorev1beta1.LastError{errorWithoutCodes}, BeFalse()),
+		Entry("error for a missing infrastructure resource", []gardencorev1beta1.LastError{infraResourceNotFoundError}, BeTrue()),
+		Entry("errors for provider-internal errors and unreconciled extensions", []gardencorev1beta1.LastError{infraInternalError, extensionNotReconciledError}, BeFalse()),
 	)
 
 	DescribeTable("#HasErrorCode",
@@ -141,4 +207,13 @@ var _ = Describe("errors", func() {
 		Entry("should return false when error code is not present", []gardencorev1beta1.LastError{unauthorizedError, infraResourcesDepletedError}, gardencorev1beta1.ErrorInfraRateLimitsExceeded, BeFalse()),
 		Entry("should return true when error code is present", []gardencorev1beta1.LastError{unauthorizedError, infraResourcesDepletedError, infraRateLimitsExceededError}, gardencorev1beta1.ErrorInfraRateLimitsExceeded, BeTrue()),
 	)
+
+	DescribeTable("#ErrorCodesOf",
+		func(lastErrors []gardencorev1beta1.LastError, expectedCodes []gardencorev1beta1.ErrorCode) {
+			Expect(ErrorCodesOf(lastErrors...)).To(Equal(expectedCodes))
+		},
+
+		Entry("should return nil when no error given", nil, nil),
+		Entry("should return the distinct error codes", []gardencorev1beta1.LastError{unauthorizedError, infraResourcesDepletedError, unauthorizedError}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized, gardencorev1beta1.ErrorInfraResourcesDepleted}),
+	)
 })
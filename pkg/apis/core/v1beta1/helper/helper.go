@@ -366,6 +366,27 @@ func ShootNeedsForceDeletion(shoot *gardencorev1beta1.Shoot) bool {
 	return forceDelete
 }
 
+// IsShootImport determines whether a Shoot adopts an existing cluster instead of creating a new one.
+func IsShootImport(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot == nil {
+		return false
+	}
+
+	isImport, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.AnnotationShootImport])
+	return isImport
+}
+
+// ShootAwaitsImport determines whether a Shoot adopts an existing cluster but the import was not yet triggered via the
+// `shoots/import` subresource.
+func ShootAwaitsImport(shoot *gardencorev1beta1.Shoot) bool {
+	return IsShootImport(shoot) && shoot.Status.LastOperation == nil
+}
+
+// IsShootPaused determines whether the operations of gardenlet on a Shoot are paused.
+func IsShootPaused(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot != nil && ptr.Deref(shoot.Spec.Paused, false)
+}
+
 // ShootSchedulingProfile returns the scheduling profile of the given Shoot.
 func ShootSchedulingProfile(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.SchedulingProfile {
 	if shoot.Spec.Kubernetes.KubeScheduler != nil {
@@ -404,6 +425,15 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *gardencorev1beta1.SeedSett
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingClusterAuditPolicyName returns the name of the ClusterAuditPolicy which is used as default audit policy for
+// the shoots on the seed. It returns an empty string if no ClusterAuditPolicy is configured.
+func SeedSettingClusterAuditPolicyName(settings *gardencorev1beta1.SeedSettings) string {
+	if settings == nil || settings.AuditPolicy == nil {
+		return ""
+	}
+	return settings.AuditPolicy.ClusterAuditPolicyName
+}
+
 // DetermineMachineImageForName finds the cloud specific machine images in the <cloudProfile> for the given <name> and
 // region. In case it does not find the machine image with the <name>, it returns false. Otherwise, true and the
 // cloud-specific machine image will be returned.
@@ -471,6 +501,11 @@ func SystemComponentsAllowed(worker *gardencorev1beta1.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// IsUpdateStrategyInPlace checks if the given worker uses the in-place update strategy.
+func IsUpdateStrategyInPlace(worker *gardencorev1beta1.Worker) bool {
+	return worker.UpdateStrategy != nil && *worker.UpdateStrategy == gardencorev1beta1.MachineUpdateStrategyInPlace
+}
+
 // KubernetesVersionExistsInCloudProfile checks if the given Kubernetes version exists in the CloudProfile
 func KubernetesVersionExistsInCloudProfile(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion string) (bool, gardencorev1beta1.ExpirableVersion, error) {
 	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
@@ -524,6 +559,18 @@ func FindPrimaryDNSProvider(providers []gardencorev1beta1.DNSProvider) *gardenco
 	return nil
 }
 
+// FindSecondaryDNSProvider finds the secondary provider among the given `providers`.
+// It returns the first provider if multiple candidates are found.
+func FindSecondaryDNSProvider(providers []gardencorev1beta1.DNSProvider) *gardencorev1beta1.DNSProvider {
+	for _, provider := range providers {
+		if provider.Secondary != nil && *provider.Secondary {
+			secondaryProvider := provider
+			return &secondaryProvider
+		}
+	}
+	return nil
+}
+
 // VersionPredicate is a function that evaluates a condition on the given versions.
 type VersionPredicate func(expirableVersion gardencorev1beta1.ExpirableVersion, version *semver.Version) (bool, error)
 
@@ -1120,6 +1167,17 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *gardencorev1beta1.KubeAPIS
 	return nil
 }
 
+// GetShootClusterAuditPolicyName returns the name of the ClusterAuditPolicy referenced by the Shoot or an empty string
+// if none is referenced.
+func GetShootClusterAuditPolicyName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil &&
+		apiServerConfig.AuditConfig != nil &&
+		apiServerConfig.AuditConfig.AuditPolicy != nil {
+		return ptr.Deref(apiServerConfig.AuditConfig.AuditPolicy.ClusterAuditPolicyName, "")
+	}
+	return ""
+}
+
 // AnonymousAuthenticationEnabled returns true if anonymous authentication is set explicitly to 'true' and false otherwise.
 func AnonymousAuthenticationEnabled(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig) bool {
 	if kubeAPIServerConfig == nil {
@@ -1224,11 +1282,31 @@ func IsCoreDNSAutoscalingModeUsed(systemComponents *gardencorev1beta1.SystemComp
 	return systemComponents.CoreDNS.Autoscaling.Mode == autoscalingMode
 }
 
+// GetSystemComponentsResourceTier returns the resource tier of the managed system components. It defaults to 'medium'
+// if no tier is configured.
+func GetSystemComponentsResourceTier(systemComponents *gardencorev1beta1.SystemComponents) gardencorev1beta1.SystemComponentsResourceTier {
+	if systemComponents == nil || systemComponents.ResourceTier == nil {
+		return gardencorev1beta1.SystemComponentsResourceTierMedium
+	}
+
+	return *systemComponents.ResourceTier
+}
+
 // IsNodeLocalDNSEnabled indicates whether the node local DNS cache is enabled or not.
 func IsNodeLocalDNSEnabled(systemComponents *gardencorev1beta1.SystemComponents) bool {
 	return systemComponents != nil && systemComponents.NodeLocalDNS != nil && systemComponents.NodeLocalDNS.Enabled
 }
 
+// IsRuntimeSecurityEnabled indicates whether the runtime security agent is enabled or not.
+func IsRuntimeSecurityEnabled(systemComponents *gardencorev1beta1.SystemComponents) bool {
+	return systemComponents != nil && systemComponents.RuntimeSecurity != nil && systemComponents.RuntimeSecurity.Enabled
+}
+
+// IsCertificateServiceEnabled indicates whether the certificate service is enabled or not.
+func IsCertificateServiceEnabled(systemComponents *gardencorev1beta1.SystemComponents) bool {
+	return systemComponents != nil && systemComponents.CertificateService != nil && systemComponents.CertificateService.Enabled
+}
+
 // GetNodeLocalDNS returns a pointer to the NodeLocalDNS spec.
 func GetNodeLocalDNS(systemComponents *gardencorev1beta1.SystemComponents) *gardencorev1beta1.NodeLocalDNS {
 	if systemComponents != nil {
@@ -1542,3 +1620,11 @@ func ConvertShootList(list []gardencorev1beta1.Shoot) []*gardencorev1beta1.Shoot
 func HasManagedIssuer(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
+
+// GetManagedIssuerHostname returns the custom hostname of the shoot's managed issuer, or nil if it is not configured.
+func GetManagedIssuerHostname(shoot *gardencorev1beta1.Shoot) *string {
+	if hostname, ok := shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuerHostname]; ok && hostname != "" {
+		return &hostname
+	}
+	return nil
+}
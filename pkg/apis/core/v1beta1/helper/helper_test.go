@@ -494,6 +494,15 @@ var _ = Describe("Helper", func() {
 		Entry("systemComponents.allowed = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#IsUpdateStrategyInPlace",
+		func(worker *gardencorev1beta1.Worker, inPlace bool) {
+			Expect(IsUpdateStrategyInPlace(worker)).To(Equal(inPlace))
+		},
+		Entry("no update strategy", &gardencorev1beta1.Worker{}, false),
+		Entry("rolling update strategy", &gardencorev1beta1.Worker{UpdateStrategy: ptr.To(gardencorev1beta1.MachineUpdateStrategyRollingUpdate)}, false),
+		Entry("in-place update strategy", &gardencorev1beta1.Worker{UpdateStrategy: ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace)}, true),
+	)
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -607,6 +616,47 @@ var _ = Describe("Helper", func() {
 			BeTrue()),
 	)
 
+	DescribeTable("#IsShootImport",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootImport(shoot)).To(match)
+		},
+
+		Entry("shoot is nil",
+			nil,
+			BeFalse()),
+		Entry("no import annotation present",
+			&gardencorev1beta1.Shoot{},
+			BeFalse()),
+		Entry("import annotation present but value is false",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootImport: "false"}}},
+			BeFalse()),
+		Entry("import annotation present and value is true",
+			&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1constants.AnnotationShootImport: "true"}}},
+			BeTrue()),
+	)
+
+	DescribeTable("#ShootAwaitsImport",
+		func(annotations map[string]string, lastOperation *gardencorev1beta1.LastOperation, match gomegatypes.GomegaMatcher) {
+			shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}, Status: gardencorev1beta1.ShootStatus{LastOperation: lastOperation}}
+			Expect(ShootAwaitsImport(shoot)).To(match)
+		},
+
+		Entry("no import annotation present", nil, nil, BeFalse()),
+		Entry("import annotation present and no last operation", map[string]string{v1beta1constants.AnnotationShootImport: "true"}, nil, BeTrue()),
+		Entry("import annotation present and import triggered", map[string]string{v1beta1constants.AnnotationShootImport: "true"}, &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore}, BeFalse()),
+	)
+
+	DescribeTable("#IsShootPaused",
+		func(shoot *gardencorev1beta1.Shoot, match gomegatypes.GomegaMatcher) {
+			Expect(IsShootPaused(shoot)).To(match)
+		},
+
+		Entry("shoot is nil", nil, BeFalse()),
+		Entry("paused is not set", &gardencorev1beta1.Shoot{}, BeFalse()),
+		Entry("paused is false", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Paused: ptr.To(false)}}, BeFalse()),
+		Entry("paused is true", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Paused: ptr.To(true)}}, BeTrue()),
+	)
+
 	var profile = gardencorev1beta1.SchedulingProfileBinPacking
 
 	DescribeTable("#ShootSchedulingProfile",
@@ -685,6 +735,16 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &gardencorev1beta1.SeedSettings{TopologyAwareRouting: &gardencorev1beta1.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingClusterAuditPolicyName",
+		func(settings *gardencorev1beta1.SeedSettings, expected string) {
+			Expect(SeedSettingClusterAuditPolicyName(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, ""),
+		Entry("no audit policy setting", &gardencorev1beta1.SeedSettings{}, ""),
+		Entry("cluster audit policy referenced", &gardencorev1beta1.SeedSettings{AuditPolicy: &gardencorev1beta1.SeedSettingAuditPolicy{ClusterAuditPolicyName: "default"}}, "default"),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []gardencorev1beta1.MachineImage
 
@@ -771,6 +831,25 @@ var _ = Describe("Helper", func() {
 		}, Equal(&gardencorev1beta1.DNSProvider{Type: ptr.To("provider1"), Primary: ptr.To(true)})),
 	)
 
+	DescribeTable("#FindSecondaryDNSProvider",
+		func(providers []gardencorev1beta1.DNSProvider, matcher gomegatypes.GomegaMatcher) {
+			Expect(FindSecondaryDNSProvider(providers)).To(matcher)
+		},
+
+		Entry("no providers", nil, BeNil()),
+		Entry("only primary provider", []gardencorev1beta1.DNSProvider{{Type: ptr.To("provider"), Primary: ptr.To(true)}}, BeNil()),
+		Entry("primary and secondary provider", []gardencorev1beta1.DNSProvider{
+			{
+				Type:    ptr.To("provider1"),
+				Primary: ptr.To(true),
+			},
+			{
+				Type:      ptr.To("provider2"),
+				Secondary: ptr.To(true),
+			},
+		}, Equal(&gardencorev1beta1.DNSProvider{Type: ptr.To("provider2"), Secondary: ptr.To(true)})),
+	)
+
 	Describe("#ShootMachineImageVersionExists", func() {
 		var (
 			constraint        gardencorev1beta1.MachineImage
@@ -2544,6 +2623,32 @@ var _ = Describe("Helper", func() {
 		}, &corev1.ObjectReference{Name: "foo"})
 	})
 
+	Describe("GetShootClusterAuditPolicyName", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			It(description, Offset(1), func() {
+				Expect(GetShootClusterAuditPolicyName(config)).To(Equal(expectedName))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, "")
+		test("AuditConfig = nil", &gardencorev1beta1.KubeAPIServerConfig{}, "")
+		test("AuditPolicy = nil", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{},
+		}, "")
+		test("ClusterAuditPolicyName = nil", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{
+				AuditPolicy: &gardencorev1beta1.AuditPolicy{},
+			},
+		}, "")
+		test("ClusterAuditPolicyName set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{
+				AuditPolicy: &gardencorev1beta1.AuditPolicy{
+					ClusterAuditPolicyName: ptr.To("foo"),
+				},
+			},
+		}, "foo")
+	})
+
 	Describe("#CalculateSeedUsage", func() {
 		type shootCase struct {
 			specSeedName, statusSeedName string
@@ -2685,6 +2790,17 @@ var _ = Describe("Helper", func() {
 		Entry("with cluster-proportional autoscaling mode (horizontal)", &gardencorev1beta1.SystemComponents{CoreDNS: &gardencorev1beta1.CoreDNS{Autoscaling: &gardencorev1beta1.CoreDNSAutoscaling{Mode: "cluster-proportional"}}}, gardencorev1beta1.CoreDNSAutoscalingModeHorizontal, false),
 	)
 
+	DescribeTable("#GetSystemComponentsResourceTier",
+		func(systemComponents *gardencorev1beta1.SystemComponents, expected gardencorev1beta1.SystemComponentsResourceTier) {
+			Expect(GetSystemComponentsResourceTier(systemComponents)).To(Equal(expected))
+		},
+
+		Entry("with nil", nil, gardencorev1beta1.SystemComponentsResourceTierMedium),
+		Entry("with empty system components", &gardencorev1beta1.SystemComponents{}, gardencorev1beta1.SystemComponentsResourceTierMedium),
+		Entry("with small resource tier", &gardencorev1beta1.SystemComponents{ResourceTier: ptr.To(gardencorev1beta1.SystemComponentsResourceTierSmall)}, gardencorev1beta1.SystemComponentsResourceTierSmall),
+		Entry("with large resource tier", &gardencorev1beta1.SystemComponents{ResourceTier: ptr.To(gardencorev1beta1.SystemComponentsResourceTierLarge)}, gardencorev1beta1.SystemComponentsResourceTierLarge),
+	)
+
 	DescribeTable("#IsNodeLocalDNSEnabled",
 		func(systemComponents *gardencorev1beta1.SystemComponents, expected bool) {
 			Expect(IsNodeLocalDNSEnabled(systemComponents)).To(Equal(expected))
@@ -2696,6 +2812,28 @@ var _ = Describe("Helper", func() {
 		Entry("with system components and node-local-dns is disabled", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}, false),
 	)
 
+	DescribeTable("#IsRuntimeSecurityEnabled",
+		func(systemComponents *gardencorev1beta1.SystemComponents, expected bool) {
+			Expect(IsRuntimeSecurityEnabled(systemComponents)).To(Equal(expected))
+		},
+
+		Entry("with nil (disabled)", nil, false),
+		Entry("with empty system components", &gardencorev1beta1.SystemComponents{}, false),
+		Entry("with system components and runtime security is enabled", &gardencorev1beta1.SystemComponents{RuntimeSecurity: &gardencorev1beta1.RuntimeSecurity{Enabled: true}}, true),
+		Entry("with system components and runtime security is disabled", &gardencorev1beta1.SystemComponents{RuntimeSecurity: &gardencorev1beta1.RuntimeSecurity{Enabled: false}}, false),
+	)
+
+	DescribeTable("#IsCertificateServiceEnabled",
+		func(systemComponents *gardencorev1beta1.SystemComponents, expected bool) {
+			Expect(IsCertificateServiceEnabled(systemComponents)).To(Equal(expected))
+		},
+
+		Entry("with nil (disabled)", nil, false),
+		Entry("with empty system components", &gardencorev1beta1.SystemComponents{}, false),
+		Entry("with system components and certificate service is enabled", &gardencorev1beta1.SystemComponents{CertificateService: &gardencorev1beta1.CertificateService{Enabled: true}}, true),
+		Entry("with system components and certificate service is disabled", &gardencorev1beta1.SystemComponents{CertificateService: &gardencorev1beta1.CertificateService{Enabled: false}}, false),
+	)
+
 	DescribeTable("#GetNodeLocalDNS",
 		func(systemComponents *gardencorev1beta1.SystemComponents, expected *gardencorev1beta1.NodeLocalDNS) {
 			Expect(GetNodeLocalDNS(systemComponents)).To(Equal(expected))
@@ -3062,6 +3200,21 @@ var _ = Describe("Helper", func() {
 		})
 	})
 
+	Describe("#GetManagedIssuerHostname", func() {
+		It("should return nil when the shoot does not have a custom issuer hostname", func() {
+			Expect(GetManagedIssuerHostname(&gardencorev1beta1.Shoot{})).To(BeNil())
+		})
+
+		It("should return the custom issuer hostname", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"authentication.gardener.cloud/issuer-hostname": "issuer.example.com"},
+				},
+			}
+			Expect(GetManagedIssuerHostname(shoot)).To(PointTo(Equal("issuer.example.com")))
+		})
+	})
+
 	DescribeTable("#ShootEnablesSSHAccess",
 		func(workers []gardencorev1beta1.Worker, workersSettings *gardencorev1beta1.WorkersSettings, expectedResult bool) {
 			shoot := &gardencorev1beta1.Shoot{
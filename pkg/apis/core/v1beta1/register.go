@@ -43,6 +43,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupEntryList{},
 		&CloudProfile{},
 		&CloudProfileList{},
+		&ClusterAuditPolicy{},
+		&ClusterAuditPolicyList{},
 		&ControllerRegistration{},
 		&ControllerRegistrationList{},
 		&ControllerDeployment{},
@@ -51,10 +53,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControllerInstallationList{},
 		&ExposureClass{},
 		&ExposureClassList{},
+		&GardenCapabilities{},
 		&InternalSecret{},
 		&InternalSecretList{},
 		&NamespacedCloudProfile{},
 		&NamespacedCloudProfileList{},
+		&NotificationSink{},
+		&NotificationSinkList{},
 		&Project{},
 		&ProjectList{},
 		&Quota{},
@@ -65,6 +70,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SeedList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootMaintenancePreview{},
 		&ShootState{},
 		&ShootStateList{},
 	)
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAuditPolicy represents an audit policy for kube-apiservers which is managed centrally and can be referenced by
+// shoots.
+type ClusterAuditPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of this audit policy.
+	Spec ClusterAuditPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAuditPolicyList is a collection of ClusterAuditPolicies.
+type ClusterAuditPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ClusterAuditPolicies.
+	Items []ClusterAuditPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterAuditPolicySpec is the specification of a ClusterAuditPolicy.
+type ClusterAuditPolicySpec struct {
+	// Policy is the audit policy (audit.k8s.io/v1.Policy) for the kube-apiserver.
+	Policy runtime.RawExtension `json:"policy" protobuf:"bytes,1,opt,name=policy"`
+}
@@ -41,6 +41,21 @@ const (
 	// best practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings).
 	// It is classified as a non-retryable error code.
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
+	// ErrorImageVerificationFailed indicates that the last error occurred due to container images which could not be
+	// verified against the trust roots configured for gardenlet.
+	ErrorImageVerificationFailed ErrorCode = "ERR_IMAGE_VERIFICATION_FAILED"
+	// ErrorInfraInternal indicates that the last error occurred due to an internal error of the infrastructure provider's
+	// API, e.g., a server-side error which is not caused by the request.
+	ErrorInfraInternal ErrorCode = "ERR_INFRA_INTERNAL"
+	// ErrorInfraResourceNotFound indicates that the last error occurred due to infrastructure resources referenced in the
+	// configuration (e.g., networks, machine images or keys) which do not exist.
+	// It is classified as a non-retryable error code, i.e., Shoot operations failing with this error code are not retried
+	// automatically but marked as failed right away.
+	ErrorInfraResourceNotFound ErrorCode = "ERR_INFRA_RESOURCE_NOT_FOUND"
+	// ErrorExtensionNotReconciled indicates that the last error occurred due to an extension resource which was not
+	// reconciled by the responsible extension controller in time, e.g., because the controller is not installed or not
+	// running.
+	ErrorExtensionNotReconciled ErrorCode = "ERR_EXTENSION_NOT_RECONCILED"
 )
 
 // LastError indicates the last occurred error for an operation on a resource.
@@ -56,6 +71,13 @@ type LastError struct {
 	// Last time the error was reported
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// Component is the name of the component which originally reported the error, e.g. the type of the extension
+	// resource. It is empty if the error was reported by the controller handling the resource itself.
+	// +optional
+	Component *string `json:"component,omitempty" protobuf:"bytes,5,opt,name=component"`
+	// Hint is a human readable hint how the error can be remediated. It is derived from the well-defined error codes.
+	// +optional
+	Hint *string `json:"hint,omitempty" protobuf:"bytes,6,opt,name=hint"`
 }
 
 // LastOperationType is a string alias.
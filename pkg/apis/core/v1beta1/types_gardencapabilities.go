@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GardenCapabilitiesName is the name of the only GardenCapabilities object.
+const GardenCapabilitiesName = "garden"
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=get
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GardenCapabilities reports the capabilities of the Gardener landscape, i.e., the feature gates of the
+// gardener-apiserver, the registered extensions, the served API resources, and the seeds. It is computed on request and
+// not persisted.
+type GardenCapabilities struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// FeatureGates are the feature gates of the gardener-apiserver.
+	// +optional
+	// +listType=atomic
+	FeatureGates []FeatureGateCapability `json:"featureGates,omitempty" protobuf:"bytes,2,rep,name=featureGates"`
+	// Extensions are the extension kinds and types which are registered via ControllerRegistrations.
+	// +optional
+	// +listType=atomic
+	Extensions []ExtensionCapability `json:"extensions,omitempty" protobuf:"bytes,3,rep,name=extensions"`
+	// APIResources are the resources and subresources served by the gardener-apiserver.
+	// +optional
+	// +listType=atomic
+	APIResources []APIResourcesCapability `json:"apiResources,omitempty" protobuf:"bytes,4,rep,name=apiResources"`
+	// Seeds are the capabilities of the registered seeds.
+	// +optional
+	// +listType=atomic
+	Seeds []SeedCapability `json:"seeds,omitempty" protobuf:"bytes,5,rep,name=seeds"`
+}
+
+// FeatureGateCapability describes a feature gate.
+type FeatureGateCapability struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Enabled states whether the feature gate is enabled.
+	Enabled bool `json:"enabled" protobuf:"varint,2,opt,name=enabled"`
+	// Stage is the maturity stage of the feature gate, e.g. `ALPHA` or `BETA`. It is empty for GA feature gates.
+	// +optional
+	Stage string `json:"stage,omitempty" protobuf:"bytes,3,opt,name=stage"`
+}
+
+// ExtensionCapability describes the types of an extension kind.
+type ExtensionCapability struct {
+	// Kind is the kind of the extension resource, e.g. `Infrastructure`.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Types are the types of the extension kind which are registered, e.g. `aws`.
+	// +listType=atomic
+	Types []string `json:"types" protobuf:"bytes,2,rep,name=types"`
+}
+
+// APIResourcesCapability describes the resources of an API group version.
+type APIResourcesCapability struct {
+	// GroupVersion is the API group version, e.g. `core.gardener.cloud/v1beta1`.
+	GroupVersion string `json:"groupVersion" protobuf:"bytes,1,opt,name=groupVersion"`
+	// Resources are the resources and subresources served in the group version, e.g. `shoots/adminkubeconfig`.
+	// +listType=atomic
+	Resources []string `json:"resources" protobuf:"bytes,2,rep,name=resources"`
+}
+
+// SeedCapability describes the capabilities of a seed.
+type SeedCapability struct {
+	// Name is the name of the seed.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ProviderType is the provider type of the seed.
+	ProviderType string `json:"providerType" protobuf:"bytes,2,opt,name=providerType"`
+	// Region is the region of the seed.
+	Region string `json:"region" protobuf:"bytes,3,opt,name=region"`
+	// GardenletVersion is the version of the gardenlet which last reported the status of the seed.
+	// +optional
+	GardenletVersion *string `json:"gardenletVersion,omitempty" protobuf:"bytes,4,opt,name=gardenletVersion"`
+}
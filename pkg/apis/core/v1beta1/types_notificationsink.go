@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSink represents a receiver of Shoot lifecycle notifications for the whole garden.
+type NotificationSink struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of this notification sink.
+	Spec NotificationSinkSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the delivery status of this notification sink.
+	// +optional
+	Status NotificationSinkStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationSinkList is a collection of NotificationSinks.
+type NotificationSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of NotificationSinks.
+	Items []NotificationSink `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NotificationSinkSpec is the specification of a NotificationSink. Exactly one of Webhook, Slack or Email must be set.
+type NotificationSinkSpec struct {
+	// Webhook contains the configuration for delivering notifications as JSON to an HTTP endpoint.
+	// +optional
+	Webhook *NotificationSinkWebhook `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+	// Slack contains the configuration for delivering notifications to a Slack incoming webhook.
+	// +optional
+	Slack *NotificationSinkSlack `json:"slack,omitempty" protobuf:"bytes,2,opt,name=slack"`
+	// Email contains the configuration for delivering notifications via SMTP.
+	// +optional
+	Email *NotificationSinkEmail `json:"email,omitempty" protobuf:"bytes,3,opt,name=email"`
+	// SecretRef is a reference to a secret containing the credentials for the sink. For webhooks, the optional `token`
+	// key is sent as bearer token. For Slack, the `url` key must contain the incoming webhook URL. For email, the
+	// optional `username` and `password` keys are used for authenticating to the SMTP server.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty" protobuf:"bytes,4,opt,name=secretRef"`
+	// Filter restricts the notifications delivered to this sink. If not set, all notifications are delivered.
+	// +optional
+	Filter *NotificationFilter `json:"filter,omitempty" protobuf:"bytes,5,opt,name=filter"`
+}
+
+// NotificationSinkWebhook contains the configuration for delivering notifications to an HTTP endpoint.
+type NotificationSinkWebhook struct {
+	// URL is the HTTPS endpoint the notifications are posted to.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+}
+
+// NotificationSinkSlack contains the configuration for delivering notifications to a Slack incoming webhook.
+type NotificationSinkSlack struct {
+	// Channel overrides the default channel of the incoming webhook.
+	// +optional
+	Channel *string `json:"channel,omitempty" protobuf:"bytes,1,opt,name=channel"`
+}
+
+// NotificationSinkEmail contains the configuration for delivering notifications via SMTP.
+type NotificationSinkEmail struct {
+	// Host is the host name of the SMTP server.
+	Host string `json:"host" protobuf:"bytes,1,opt,name=host"`
+	// Port is the port of the SMTP server.
+	Port int32 `json:"port" protobuf:"varint,2,opt,name=port"`
+	// From is the sender address of the notifications.
+	From string `json:"from" protobuf:"bytes,3,opt,name=from"`
+	// To is the list of recipient addresses of the notifications.
+	// +listType=atomic
+	To []string `json:"to" protobuf:"bytes,4,rep,name=to"`
+}
+
+// NotificationFilter restricts the notifications delivered to a NotificationSink. All set fields must match.
+type NotificationFilter struct {
+	// Projects is the list of project names whose Shoots are considered.
+	// +optional
+	// +listType=atomic
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,1,rep,name=projects"`
+	// Purposes is the list of Shoot purposes which are considered.
+	// +optional
+	// +listType=atomic
+	Purposes []ShootPurpose `json:"purposes,omitempty" protobuf:"bytes,2,rep,name=purposes,casttype=ShootPurpose"`
+	// EventTypes is the list of event types which are considered.
+	// +optional
+	// +listType=atomic
+	EventTypes []NotificationEventType `json:"eventTypes,omitempty" protobuf:"bytes,3,rep,name=eventTypes,casttype=NotificationEventType"`
+}
+
+// NotificationEventType is the type of a Shoot lifecycle notification.
+type NotificationEventType string
+
+const (
+	// NotificationEventTypeShootCreated is the event type of notifications for successfully created Shoots.
+	NotificationEventTypeShootCreated NotificationEventType = "ShootCreated"
+	// NotificationEventTypeShootReconcileFailed is the event type of notifications for Shoots whose creation or
+	// reconciliation failed.
+	NotificationEventTypeShootReconcileFailed NotificationEventType = "ShootReconcileFailed"
+	// NotificationEventTypeShootHibernated is the event type of notifications for hibernated Shoots.
+	NotificationEventTypeShootHibernated NotificationEventType = "ShootHibernated"
+	// NotificationEventTypeShootExpiring is the event type of notifications for Shoots which are about to expire.
+	NotificationEventTypeShootExpiring NotificationEventType = "ShootExpiring"
+)
+
+// NotificationSinkStatus contains the delivery status of a NotificationSink.
+type NotificationSinkStatus struct {
+	// LastDelivery is the last notification which was delivered successfully.
+	// +optional
+	LastDelivery *NotificationDelivery `json:"lastDelivery,omitempty" protobuf:"bytes,1,opt,name=lastDelivery"`
+	// LastFailure is the last notification whose delivery failed.
+	// +optional
+	LastFailure *NotificationDelivery `json:"lastFailure,omitempty" protobuf:"bytes,2,opt,name=lastFailure"`
+}
+
+// NotificationDelivery describes the delivery of a notification.
+type NotificationDelivery struct {
+	// Shoot is the namespace and name of the Shoot the notification is about.
+	Shoot string `json:"shoot" protobuf:"bytes,1,opt,name=shoot"`
+	// EventType is the event type of the notification.
+	EventType NotificationEventType `json:"eventType" protobuf:"bytes,2,opt,name=eventType,casttype=NotificationEventType"`
+	// Time is the time of the delivery attempt.
+	Time metav1.Time `json:"time" protobuf:"bytes,3,opt,name=time"`
+	// Message contains the error in case the delivery failed.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
@@ -74,6 +74,9 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// ShootDefaults contains default values which are applied to new shoots in this project if they don't specify them.
+	// +optional
+	ShootDefaults *ProjectShootDefaults `json:"shootDefaults,omitempty" protobuf:"bytes,9,opt,name=shootDefaults"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -93,6 +96,11 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// ControlPlaneResourceUsage contains the resources consumed by the control planes of all Shoots of this project in
+	// the seed clusters. It is only maintained if the project resource usage controller of gardener-controller-manager
+	// is enabled.
+	// +optional
+	ControlPlaneResourceUsage *ControlPlaneResourceUsage `json:"controlPlaneResourceUsage,omitempty" protobuf:"bytes,6,opt,name=controlPlaneResourceUsage"`
 }
 
 // ProjectMember is a member of a project.
@@ -125,6 +133,23 @@ type ProjectTolerations struct {
 	Whitelist []Toleration `json:"whitelist,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,2,rep,name=whitelist"`
 }
 
+// ProjectShootDefaults contains default values which are applied to new shoots in a project.
+type ProjectShootDefaults struct {
+	// Purpose is the default purpose of shoots.
+	// +optional
+	Purpose *ShootPurpose `json:"purpose,omitempty" protobuf:"bytes,1,opt,name=purpose,casttype=ShootPurpose"`
+	// MaintenanceTimeWindow is the default maintenance time window of shoots.
+	// +optional
+	MaintenanceTimeWindow *MaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty" protobuf:"bytes,2,opt,name=maintenanceTimeWindow"`
+	// HibernationSchedules are the default hibernation schedules of shoots.
+	// +optional
+	// +listType=atomic
+	HibernationSchedules []HibernationSchedule `json:"hibernationSchedules,omitempty" protobuf:"bytes,3,rep,name=hibernationSchedules"`
+	// NetworkingType is the default type of the networking plugin of shoots with workers.
+	// +optional
+	NetworkingType *string `json:"networkingType,omitempty" protobuf:"bytes,4,opt,name=networkingType"`
+}
+
 // Toleration is a toleration for a seed taint.
 type Toleration struct {
 	// Key is the toleration key to be applied to a project or shoot.
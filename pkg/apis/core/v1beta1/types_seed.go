@@ -113,6 +113,55 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// CapacityReport contains a report about the capacity and the utilization of the seed cluster. It is periodically
+	// updated by gardenlet.
+	// +optional
+	CapacityReport *SeedCapacityReport `json:"capacityReport,omitempty" protobuf:"bytes,10,opt,name=capacityReport"`
+}
+
+// SeedCapacityReport contains a report about the capacity and the utilization of a seed cluster.
+type SeedCapacityReport struct {
+	// LastUpdateTime is the time when the report was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,1,opt,name=lastUpdateTime"`
+	// ControlPlanes is the number of shoot control planes hosted by the seed.
+	ControlPlanes int32 `json:"controlPlanes" protobuf:"varint,2,opt,name=controlPlanes"`
+	// ControlPlanePods is the number of pods running in the control plane namespaces of the shoots.
+	ControlPlanePods int32 `json:"controlPlanePods" protobuf:"varint,3,opt,name=controlPlanePods"`
+	// CPU contains the allocatable and the requested CPU of the seed's nodes.
+	// +optional
+	CPU *SeedResourceUtilization `json:"cpu,omitempty" protobuf:"bytes,4,opt,name=cpu"`
+	// Memory contains the allocatable and the requested memory of the seed's nodes.
+	// +optional
+	Memory *SeedResourceUtilization `json:"memory,omitempty" protobuf:"bytes,5,opt,name=memory"`
+	// ETCDStorage is the total storage capacity of the etcds of the shoot control planes.
+	// +optional
+	ETCDStorage *resource.Quantity `json:"etcdStorage,omitempty" protobuf:"bytes,6,opt,name=etcdStorage"`
+	// NodePodCIDRs contains the number of pod CIDRs which can be assigned to nodes from the seed's pod network and the
+	// number of assigned ones.
+	// +optional
+	NodePodCIDRs *SeedPoolUtilization `json:"nodePodCIDRs,omitempty" protobuf:"bytes,7,opt,name=nodePodCIDRs"`
+	// LoadBalancers contains the number of services of type LoadBalancer in the seed and the configured quota.
+	// +optional
+	LoadBalancers *SeedPoolUtilization `json:"loadBalancers,omitempty" protobuf:"bytes,8,opt,name=loadBalancers"`
+}
+
+// SeedResourceUtilization contains the allocatable and the requested amount of a resource.
+type SeedResourceUtilization struct {
+	// Allocatable is the amount of the resource which is allocatable on all nodes.
+	Allocatable resource.Quantity `json:"allocatable" protobuf:"bytes,1,opt,name=allocatable"`
+	// Requested is the amount of the resource which is requested by all scheduled pods.
+	Requested resource.Quantity `json:"requested" protobuf:"bytes,2,opt,name=requested"`
+	// Headroom is the amount of the resource which is allocatable but not requested yet.
+	Headroom resource.Quantity `json:"headroom" protobuf:"bytes,3,opt,name=headroom"`
+}
+
+// SeedPoolUtilization contains the size of a pool and the number of allocated entries.
+type SeedPoolUtilization struct {
+	// Capacity is the size of the pool. It is not set if the size is unknown.
+	// +optional
+	Capacity *int32 `json:"capacity,omitempty" protobuf:"varint,1,opt,name=capacity"`
+	// Allocated is the number of allocated entries of the pool.
+	Allocated int32 `json:"allocated" protobuf:"varint,2,opt,name=allocated"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -253,6 +302,9 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty" protobuf:"bytes,8,opt,name=topologyAwareRouting"`
+	// AuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.
+	// +optional
+	AuditPolicy *SeedSettingAuditPolicy `json:"auditPolicy,omitempty" protobuf:"bytes,9,opt,name=auditPolicy"`
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.
@@ -382,6 +434,13 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingAuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.
+type SeedSettingAuditPolicy struct {
+	// ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the
+	// kube-apiservers of shoots which do not configure an audit policy themselves.
+	ClusterAuditPolicyName string `json:"clusterAuditPolicyName" protobuf:"bytes,1,opt,name=clusterAuditPolicyName"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -426,6 +485,9 @@ const (
 	SeedGardenletReady ConditionType = "GardenletReady"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedPodSecurityCompliant is a constant for a condition type indicating whether the pods in the namespaces managed
+	// by the gardenlet comply with the configured Pod Security Standard.
+	SeedPodSecurityCompliant ConditionType = "SeedPodSecurityCompliant"
 )
 
 // Resource constants for Gardener object types
@@ -138,6 +138,11 @@ type ShootSpec struct {
 	// This field is immutable.
 	// +optional
 	CredentialsBindingName *string `json:"credentialsBindingName,omitempty" protobuf:"bytes,23,opt,name=credentialsBindingName"`
+	// Paused indicates whether all operations of gardenlet on the Shoot (creation, reconciliation, migration,
+	// deletion) are paused. Health checks are continued while the Shoot is paused. When the Shoot is resumed, it is
+	// reconciled immediately.
+	// +optional
+	Paused *bool `json:"paused,omitempty" protobuf:"varint,24,opt,name=paused"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -189,7 +194,7 @@ type ShootStatus struct {
 	// +optional
 	ClusterIdentity *string `json:"clusterIdentity,omitempty" protobuf:"bytes,12,opt,name=clusterIdentity"`
 	// List of addresses that are relevant to the shoot.
-	// These include the Kube API server address and also the service account issuer.
+	// These include the Kube API server addresses, the service account issuer and the endpoint used by nodes for bootstrapping.
 	// +optional
 	// +patchMergeKey=name
 	// +patchStrategy=merge
@@ -215,6 +220,23 @@ type ShootStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,19,opt,name=networking"`
+	// PausedSince is the time since when the operations of gardenlet on the Shoot are paused.
+	// +optional
+	PausedSince *metav1.Time `json:"pausedSince,omitempty" protobuf:"bytes,20,opt,name=pausedSince"`
+	// DNS contains information about the DNS providers managing the records of the external domain.
+	// +optional
+	DNS *ShootDNSStatus `json:"dns,omitempty" protobuf:"bytes,21,opt,name=dns"`
+	// Maintenance contains information about the upcoming maintenance of the Shoot.
+	// +optional
+	Maintenance *ShootMaintenanceStatus `json:"maintenance,omitempty" protobuf:"bytes,22,opt,name=maintenance"`
+	// ControlPlaneResourceUsage contains the resources consumed by the control plane of the Shoot in the seed cluster.
+	// It is periodically updated by gardenlet.
+	// +optional
+	ControlPlaneResourceUsage *ControlPlaneResourceUsage `json:"controlPlaneResourceUsage,omitempty" protobuf:"bytes,23,opt,name=controlPlaneResourceUsage"`
+	// ConformanceTest contains the report of the last conformance test run against the Shoot. A run is triggered by
+	// annotating the Shoot with `shoot.gardener.cloud/conformance-test=run`.
+	// +optional
+	ConformanceTest *ConformanceTestReport `json:"conformanceTest,omitempty" protobuf:"bytes,24,opt,name=conformanceTest"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -230,6 +252,80 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// ShootMaintenanceStatus contains information about the upcoming maintenance of the Shoot.
+type ShootMaintenanceStatus struct {
+	// PendingChanges are the changes which the next maintenance would apply. They are computed when the Shoot is
+	// annotated with `maintenance.gardener.cloud/operation=preview` and removed once the maintenance was performed.
+	// +optional
+	PendingChanges *MaintenancePendingChanges `json:"pendingChanges,omitempty" protobuf:"bytes,1,opt,name=pendingChanges"`
+}
+
+// MaintenancePendingChanges contains the changes which the next maintenance of a Shoot would apply.
+type MaintenancePendingChanges struct {
+	// ComputedTime is the time when the pending changes were computed.
+	ComputedTime metav1.Time `json:"computedTime" protobuf:"bytes,1,opt,name=computedTime"`
+	// NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.
+	NextMaintenanceTimeWindow MaintenancePreviewTimeWindow `json:"nextMaintenanceTimeWindow" protobuf:"bytes,2,opt,name=nextMaintenanceTimeWindow"`
+	// KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would
+	// not be updated.
+	// +optional
+	KubernetesVersion *VersionUpdatePreview `json:"kubernetesVersion,omitempty" protobuf:"bytes,3,opt,name=kubernetesVersion"`
+	// Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.
+	// +optional
+	// +listType=atomic
+	Workers []WorkerMaintenancePreview `json:"workers,omitempty" protobuf:"bytes,4,rep,name=workers"`
+	// Operation is the operation which would be triggered by the maintenance.
+	// +optional
+	Operation *string `json:"operation,omitempty" protobuf:"bytes,5,opt,name=operation"`
+	// Changes contains the descriptions of further changes which would be applied to the Shoot specification.
+	// +optional
+	// +listType=atomic
+	Changes []string `json:"changes,omitempty" protobuf:"bytes,6,rep,name=changes"`
+}
+
+// ControlPlaneResourceUsage contains the resources consumed by control planes in the seed cluster.
+type ControlPlaneResourceUsage struct {
+	// Requests is the sum of the resource requests of all control plane pods.
+	// +optional
+	Requests corev1.ResourceList `json:"requests,omitempty" protobuf:"bytes,1,rep,name=requests"`
+	// Limits is the sum of the resource limits of all control plane pods. Containers without limits are not taken into
+	// account.
+	// +optional
+	Limits corev1.ResourceList `json:"limits,omitempty" protobuf:"bytes,2,rep,name=limits"`
+	// ETCDStorage is the sum of the storage capacity of all etcd volumes.
+	// +optional
+	ETCDStorage *resource.Quantity `json:"etcdStorage,omitempty" protobuf:"bytes,3,opt,name=etcdStorage"`
+	// LastUpdateTime is the time when the resource usage was last computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+}
+
+// ConformanceTestReport contains the report of a conformance test run against a Shoot.
+type ConformanceTestReport struct {
+	// Phase is the phase of the conformance test run.
+	Phase ConformanceTestPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=ConformanceTestPhase"`
+	// StartTime is the time when the conformance test run was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,2,opt,name=startTime"`
+	// CompletionTime is the time when the conformance test run was completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,3,opt,name=completionTime"`
+	// Summary is a human-readable summary of the results of the conformance test run.
+	// +optional
+	Summary string `json:"summary,omitempty" protobuf:"bytes,4,opt,name=summary"`
+}
+
+// ConformanceTestPhase is the phase of a conformance test run.
+type ConformanceTestPhase string
+
+const (
+	// ConformanceTestRunning indicates that the conformance tests are running.
+	ConformanceTestRunning ConformanceTestPhase = "Running"
+	// ConformanceTestSucceeded indicates that all conformance tests passed.
+	ConformanceTestSucceeded ConformanceTestPhase = "Succeeded"
+	// ConformanceTestFailed indicates that conformance tests failed or could not be run.
+	ConformanceTestFailed ConformanceTestPhase = "Failed"
+)
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -241,8 +337,32 @@ type NetworkingStatus struct {
 	// Services are the CIDRs of the service network.
 	// +optional
 	Services []string `json:"services,omitempty" protobuf:"bytes,3,rep,name=services"`
+	// DualStackMigrationInitiationTime is the time when the migration of the networking from IPv4 single-stack to
+	// dual-stack was initiated.
+	// +optional
+	DualStackMigrationInitiationTime *metav1.Time `json:"dualStackMigrationInitiationTime,omitempty" protobuf:"bytes,4,opt,name=dualStackMigrationInitiationTime"`
 }
 
+// ShootDNSStatus contains information about the DNS providers managing the records of the external domain.
+type ShootDNSStatus struct {
+	// ActiveProvider is the role of the DNS provider which currently manages the records of the external domain.
+	ActiveProvider DNSProviderRole `json:"activeProvider" protobuf:"bytes,1,opt,name=activeProvider,casttype=DNSProviderRole"`
+	// LastFailoverTime is the last time when the management of the records switched from one DNS provider to another.
+	// +optional
+	LastFailoverTime *metav1.Time `json:"lastFailoverTime,omitempty" protobuf:"bytes,2,opt,name=lastFailoverTime"`
+}
+
+// DNSProviderRole is the role of a DNS provider for the external domain of a Shoot.
+type DNSProviderRole string
+
+const (
+	// DNSProviderRolePrimary is the role of the primary DNS provider.
+	DNSProviderRolePrimary DNSProviderRole = "primary"
+	// DNSProviderRoleSecondary is the role of the secondary DNS provider which is used if the primary DNS provider is
+	// unavailable.
+	DNSProviderRoleSecondary DNSProviderRole = "secondary"
+)
+
 // ShootCredentials contains information about the shoot credentials.
 type ShootCredentials struct {
 	// Rotation contains information about the credential rotations.
@@ -486,6 +606,10 @@ type DNSProvider struct {
 	// Please use the DNS extension provider config (e.g. shoot-dns-service) for additional configuration.
 	// +optional
 	Zones *DNSIncludeExclude `json:"zones,omitempty" protobuf:"bytes,5,opt,name=zones"`
+	// Secondary indicates that this DNSProvider takes over the management of the shoot related records if the primary
+	// DNSProvider is unavailable.
+	// +optional
+	Secondary *bool `json:"secondary,omitempty" protobuf:"varint,6,opt,name=secondary"`
 }
 
 // DNSIncludeExclude contains information about which domains shall be included/excluded.
@@ -545,6 +669,24 @@ type HibernationSchedule struct {
 	// Location is the time location in which both start and shall be evaluated.
 	// +optional
 	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
+	// Exceptions is a list of dates or date ranges on which the Shoot is not hibernated by the Start of this schedule.
+	// The dates are evaluated in the Location of this schedule.
+	// +optional
+	// +listType=atomic
+	Exceptions []HibernationScheduleException `json:"exceptions,omitempty" protobuf:"bytes,4,rep,name=exceptions"`
+	// HolidayCalendarConfigMapName is the name of a ConfigMap in the namespace of the Shoot containing additional
+	// exceptions. Each value of the ConfigMap is either a date (`2006-01-02`) or a date range (`2006-01-02/2006-01-06`).
+	// +optional
+	HolidayCalendarConfigMapName *string `json:"holidayCalendarConfigMapName,omitempty" protobuf:"bytes,5,opt,name=holidayCalendarConfigMapName"`
+}
+
+// HibernationScheduleException is a date range on which the Shoot is not hibernated by a schedule.
+type HibernationScheduleException struct {
+	// Start is the first date of the exception in the format `2006-01-02`.
+	Start string `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the last date of the exception in the format `2006-01-02`. Defaults to Start.
+	// +optional
+	End *string `json:"end,omitempty" protobuf:"bytes,2,opt,name=end"`
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -587,8 +729,42 @@ type Kubernetes struct {
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	// +optional
 	EnableStaticTokenKubeconfig *bool `json:"enableStaticTokenKubeconfig,omitempty" protobuf:"varint,10,opt,name=enableStaticTokenKubeconfig"`
+	// PKI contains configuration for the certificates generated for the Shoot cluster.
+	// +optional
+	PKI *PKI `json:"pki,omitempty" protobuf:"bytes,11,opt,name=pki"`
+}
+
+// PKI contains configuration for the public key infrastructure of a cluster, i.e., for the certificate authorities and
+// the server and client certificates signed by them. Changes only take effect when the respective certificates are
+// regenerated, e.g., when the certificate authorities are rotated.
+type PKI struct {
+	// KeyAlgorithm is the algorithm of the private keys of the generated certificates. Supported values are 'RSA' and
+	// 'ECDSA'. Defaults to 'RSA'.
+	// +optional
+	KeyAlgorithm *KeyAlgorithm `json:"keyAlgorithm,omitempty" protobuf:"bytes,1,opt,name=keyAlgorithm,casttype=KeyAlgorithm"`
+	// KeySize is the size of the private keys of the generated certificates in bits. Supported values are 3072 and 4096
+	// for 'RSA' (defaults to 3072) and 256 and 384 for 'ECDSA' (defaults to 256).
+	// +optional
+	KeySize *int32 `json:"keySize,omitempty" protobuf:"varint,2,opt,name=keySize"`
+	// CertificateAuthorityValidity is the validity of the generated certificate authorities. Defaults to 10 years.
+	// +optional
+	CertificateAuthorityValidity *metav1.Duration `json:"certificateAuthorityValidity,omitempty" protobuf:"bytes,3,opt,name=certificateAuthorityValidity"`
+	// CertificateValidity is the validity of the generated server and client certificates for which no dedicated
+	// validity is configured. Defaults to 10 years.
+	// +optional
+	CertificateValidity *metav1.Duration `json:"certificateValidity,omitempty" protobuf:"bytes,4,opt,name=certificateValidity"`
 }
 
+// KeyAlgorithm is a string alias for private key algorithms.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA is a constant for the RSA key algorithm.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSA is a constant for the ECDSA key algorithm.
+	KeyAlgorithmECDSA KeyAlgorithm = "ECDSA"
+)
+
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
 type ClusterAutoscaler struct {
 	// ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 1 hour).
@@ -823,8 +999,26 @@ type KubeAPIServerConfig struct {
 	// EncryptionConfig contains customizable encryption configuration of the Kube API server.
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty" protobuf:"bytes,16,opt,name=encryptionConfig"`
+	// Profile is a preset of request, priority-and-fairness and watch cache settings for the kube-apiserver which is
+	// maintained by Gardener. Explicitly configured `requests` and `watchCacheSizes` take precedence over the values of
+	// the profile. Possible values are `small`, `large` and `ci-burst`.
+	// +optional
+	Profile *KubeAPIServerProfile `json:"profile,omitempty" protobuf:"bytes,17,opt,name=profile,casttype=KubeAPIServerProfile"`
 }
 
+// KubeAPIServerProfile is a preset of tuning settings for the kube-apiserver.
+type KubeAPIServerProfile string
+
+const (
+	// KubeAPIServerProfileSmall is a profile for small clusters with few clients.
+	KubeAPIServerProfileSmall KubeAPIServerProfile = "small"
+	// KubeAPIServerProfileLarge is a profile for large clusters with many nodes and controllers.
+	KubeAPIServerProfileLarge KubeAPIServerProfile = "large"
+	// KubeAPIServerProfileCIBurst is a profile for clusters which are subject to short bursts of many requests, e.g.,
+	// when used by CI pipelines creating and deleting many objects in a short period of time.
+	KubeAPIServerProfileCIBurst KubeAPIServerProfile = "ci-burst"
+)
+
 // APIServerLogging contains configuration for the logs level and http access logs
 type APIServerLogging struct {
 	// Verbosity is the kube-apiserver log verbosity level
@@ -901,6 +1095,10 @@ type AuditPolicy struct {
 	// which contains the audit policy for the kube-apiserver.
 	// +optional
 	ConfigMapRef *corev1.ObjectReference `json:"configMapRef,omitempty" protobuf:"bytes,1,opt,name=configMapRef"`
+	// ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the
+	// kube-apiserver. It is mutually exclusive with ConfigMapRef.
+	// +optional
+	ClusterAuditPolicyName *string `json:"clusterAuditPolicyName,omitempty" protobuf:"bytes,2,opt,name=clusterAuditPolicyName"`
 }
 
 // OIDCConfig contains configuration settings for the OIDC provider.
@@ -1228,6 +1426,12 @@ type KubeletConfig struct {
 	// MemorySwap configures swap memory available to container workloads.
 	// +optional
 	MemorySwap *MemorySwapConfiguration `json:"memorySwap,omitempty" protobuf:"bytes,26,opt,name=memorySwap"`
+	// CredentialProviders is a list of image credential provider plugins that will be enabled by the kubelet.
+	// The plugin binaries must be present in the `/opt/bin/credential-providers` directory of the nodes, e.g. provided
+	// by the operating system image or an extension.
+	// +optional
+	// +listType=atomic
+	CredentialProviders []KubeletCredentialProvider `json:"credentialProviders,omitempty" protobuf:"bytes,27,rep,name=credentialProviders"`
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -1328,6 +1532,41 @@ type MemorySwapConfiguration struct {
 	SwapBehavior *SwapBehavior `json:"swapBehavior,omitempty" protobuf:"bytes,1,opt,name=swapBehavior"`
 }
 
+// KubeletCredentialProvider contains the configuration of an image credential provider plugin invoked by the kubelet.
+type KubeletCredentialProvider struct {
+	// Name is the name of the credential provider. It must match the name of the provider executable.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MatchImages is a list of strings used to match against images in order to determine if this provider should be
+	// invoked, e.g. `*.dkr.ecr.*.amazonaws.com` or `*.azurecr.io`.
+	// +listType=atomic
+	MatchImages []string `json:"matchImages" protobuf:"bytes,2,rep,name=matchImages"`
+	// DefaultCacheDuration is the default duration the plugin will cache credentials in-memory if a cache duration is
+	// not provided in the plugin response.
+	DefaultCacheDuration *metav1.Duration `json:"defaultCacheDuration" protobuf:"bytes,3,opt,name=defaultCacheDuration"`
+	// APIVersion is the input version of the exec CredentialProviderRequest, i.e. `credentialprovider.kubelet.k8s.io/v1`
+	// or `credentialprovider.kubelet.k8s.io/v1beta1`.
+	// Defaults to `credentialprovider.kubelet.k8s.io/v1` for Kubernetes >= v1.26 and to
+	// `credentialprovider.kubelet.k8s.io/v1beta1` otherwise.
+	// +optional
+	APIVersion *string `json:"apiVersion,omitempty" protobuf:"bytes,4,opt,name=apiVersion"`
+	// Args are the arguments passed to the plugin when executing it.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty" protobuf:"bytes,5,rep,name=args"`
+	// Env defines additional environment variables to expose to the plugin process.
+	// +optional
+	// +listType=atomic
+	Env []KubeletCredentialProviderEnvVar `json:"env,omitempty" protobuf:"bytes,6,rep,name=env"`
+}
+
+// KubeletCredentialProviderEnvVar is an environment variable passed to an image credential provider plugin.
+type KubeletCredentialProviderEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the environment variable.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
 // Networking defines networking parameters for the shoot cluster.
 type Networking struct {
 	// Type identifies the type of the networking plugin. This field is immutable.
@@ -1510,6 +1749,81 @@ type Worker struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+	// ExistingHosts specifies that this worker pool is backed by pre-existing hosts (e.g., bare metal servers or virtual
+	// machines) which are not provisioned by Gardener but registered to the cluster by the user. Gardener only manages
+	// the operating system configuration and the kubelet of such hosts.
+	// This field is immutable.
+	// +optional
+	ExistingHosts *WorkerExistingHosts `json:"existingHosts,omitempty" protobuf:"bytes,22,opt,name=existingHosts"`
+	// Headroom contains the configuration for capacity headroom of this worker pool.
+	// +optional
+	Headroom *WorkerHeadroom `json:"headroom,omitempty" protobuf:"bytes,23,opt,name=headroom"`
+	// UpdateStrategy specifies how the nodes of the worker pool are updated when the Kubernetes version, the machine
+	// image version, or the kubelet configuration change. With `InPlace`, the operating system and the kubelet of the
+	// existing nodes are updated instead of replacing the nodes with new machines. Defaults to `RollingUpdate`.
+	// This field is immutable.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,24,opt,name=updateStrategy,casttype=MachineUpdateStrategy"`
+	// RollingUpdate contains the configuration for progressive rolling updates of the worker pool's nodes during
+	// Kubernetes minor version upgrades. It cannot be used together with the `InPlace` update strategy.
+	// +optional
+	RollingUpdate *WorkerRollingUpdate `json:"rollingUpdate,omitempty" protobuf:"bytes,25,opt,name=rollingUpdate"`
+}
+
+// MachineUpdateStrategy is the update strategy of the nodes of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// MachineUpdateStrategyRollingUpdate replaces the nodes of the worker pool with new machines.
+	MachineUpdateStrategyRollingUpdate MachineUpdateStrategy = "RollingUpdate"
+	// MachineUpdateStrategyInPlace updates the operating system and the kubelet of the existing nodes of the worker pool.
+	MachineUpdateStrategyInPlace MachineUpdateStrategy = "InPlace"
+)
+
+// WorkerRollingUpdate contains the configuration for progressive rolling updates of a worker pool. When the Kubernetes
+// minor version of the worker pool is upgraded, the nodes are rolled in canary steps. After each step, the rollout is
+// paused until the soak period has passed and all nodes are healthy. Afterwards, the remaining nodes are rolled with
+// the `maxSurge` and `maxUnavailable` settings of the worker pool.
+type WorkerRollingUpdate struct {
+	// MaxSurge is the maximum number of machines that are created per zone while the canary steps are rolled out.
+	// Defaults to the `maxSurge` setting of the worker pool.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" protobuf:"bytes,1,opt,name=maxSurge"`
+	// MaxUnavailable is the maximum number of machines per zone that can be unavailable while the canary steps are
+	// rolled out. Defaults to the `maxUnavailable` setting of the worker pool.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,2,opt,name=maxUnavailable"`
+	// CanarySteps is the list of cumulative numbers or percentages of machines per zone which are rolled before the
+	// rollout is paused, e.g. `[1, 25%]`. Percentages are rounded up. The steps must be increasing.
+	// +listType=atomic
+	CanarySteps []intstr.IntOrString `json:"canarySteps" protobuf:"bytes,3,rep,name=canarySteps"`
+	// SoakPeriod is the duration for which the rollout is paused after each canary step before it continues, given
+	// that all nodes are healthy. Defaults to 10m.
+	// +optional
+	SoakPeriod *metav1.Duration `json:"soakPeriod,omitempty" protobuf:"bytes,4,opt,name=soakPeriod"`
+}
+
+// WorkerHeadroom contains the configuration for capacity headroom of a worker pool. Gardener runs low-priority
+// placeholder pods on the pool's nodes which reserve the given resources. They are preempted as soon as pods with a
+// higher priority need the capacity, hence these pods can be scheduled immediately while the cluster-autoscaler
+// provisions new nodes for the preempted placeholders.
+type WorkerHeadroom struct {
+	// Replicas is the number of placeholder pods.
+	Replicas int32 `json:"replicas" protobuf:"varint,1,opt,name=replicas"`
+	// CPU is the amount of CPU reserved by each placeholder pod.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty" protobuf:"bytes,2,opt,name=cpu"`
+	// Memory is the amount of memory reserved by each placeholder pod.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty" protobuf:"bytes,3,opt,name=memory"`
+}
+
+// WorkerExistingHosts contains the configuration for worker pools backed by pre-existing hosts.
+type WorkerExistingHosts struct {
+	// BootstrapTokenValidity is the validity of the bootstrap token used for registering hosts. The token is renewed
+	// with every reconciliation of the Shoot. Defaults to 24h.
+	// +optional
+	BootstrapTokenValidity *metav1.Duration `json:"bootstrapTokenValidity,omitempty" protobuf:"bytes,1,opt,name=bootstrapTokenValidity"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1529,6 +1843,11 @@ type ClusterAutoscalerOptions struct {
 	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
 	// +optional
 	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,5,opt,name=maxNodeProvisionTime"`
+	// Priority is the priority of the worker pool for the priority expander of the cluster autoscaler. Worker pools with
+	// a higher priority are preferred during scale-up. Worker pools without a priority are treated as having priority 0.
+	// It is only considered if the priority expander is configured in `.spec.kubernetes.clusterAutoscaler.expander`.
+	// +optional
+	Priority *int32 `json:"priority,omitempty" protobuf:"varint,6,opt,name=priority"`
 }
 
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
@@ -1684,8 +2003,36 @@ type SystemComponents struct {
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	// +optional
 	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty" protobuf:"bytes,2,opt,name=nodeLocalDNS"`
+	// RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster.
+	// The agent detects suspicious behaviour of processes and containers. Its findings are forwarded to the logging
+	// stack of the Shoot cluster, and critical findings are additionally reported as events of the affected Node.
+	// +optional
+	RuntimeSecurity *RuntimeSecurity `json:"runtimeSecurity,omitempty" protobuf:"bytes,3,opt,name=runtimeSecurity"`
+	// CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster.
+	// Workloads can request TLS certificates for the domain of the Shoot via `Certificate` resources or annotations of
+	// `Service`s and `Ingress`es, the DNS01 challenges are solved with the DNS provider of the Shoot domain.
+	// +optional
+	CertificateService *CertificateService `json:"certificateService,omitempty" protobuf:"bytes,4,opt,name=certificateService"`
+	// ResourceTier is the tier of the resource presets (requests, limits and bounds of the vertical pod autoscaling)
+	// of the managed system components (CoreDNS, kube-proxy, node-problem-detector, metrics-server). The 'small' tier
+	// reduces the footprint on small clusters, the 'large' tier raises the presets for huge clusters.
+	// Possible values are 'small', 'medium' and 'large'. Defaults to 'medium' if not set.
+	// +optional
+	ResourceTier *SystemComponentsResourceTier `json:"resourceTier,omitempty" protobuf:"bytes,5,opt,name=resourceTier,casttype=SystemComponentsResourceTier"`
 }
 
+// SystemComponentsResourceTier is a type alias for the resource tier of the managed system components.
+type SystemComponentsResourceTier string
+
+const (
+	// SystemComponentsResourceTierSmall is a constant for the resource tier suitable for small clusters.
+	SystemComponentsResourceTierSmall SystemComponentsResourceTier = "small"
+	// SystemComponentsResourceTierMedium is a constant for the default resource tier.
+	SystemComponentsResourceTierMedium SystemComponentsResourceTier = "medium"
+	// SystemComponentsResourceTierLarge is a constant for the resource tier suitable for large clusters.
+	SystemComponentsResourceTierLarge SystemComponentsResourceTier = "large"
+)
+
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
 type CoreDNS struct {
 	// Autoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1694,6 +2041,19 @@ type CoreDNS struct {
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	// +optional
 	Rewriting *CoreDNSRewriting `json:"rewriting,omitempty" protobuf:"bytes,2,opt,name=rewriting"`
+	// Plugins is a list of additional plugins which are enabled in the default server block of Core DNS.
+	// Supported plugins are `any`, `bufsize`, `minimal` and `nsid`.
+	// +optional
+	// +listType=atomic
+	Plugins []CoreDNSPlugin `json:"plugins,omitempty" protobuf:"bytes,3,rep,name=plugins"`
+	// Cache contains the settings of the cache of the default server block of Core DNS.
+	// +optional
+	Cache *CoreDNSCache `json:"cache,omitempty" protobuf:"bytes,4,opt,name=cache"`
+	// ForwardZones is a list of zones whose requests are forwarded to dedicated upstream name servers instead of the
+	// name servers configured on the nodes.
+	// +optional
+	// +listType=atomic
+	ForwardZones []CoreDNSForwardZone `json:"forwardZones,omitempty" protobuf:"bytes,5,rep,name=forwardZones"`
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1701,6 +2061,21 @@ type CoreDNSAutoscaling struct {
 	// The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster.
 	// Supported values are `horizontal` and `cluster-proportional`.
 	Mode CoreDNSAutoscalingMode `json:"mode" protobuf:"bytes,1,opt,name=mode"`
+	// MinReplicas is the minimum number of Core DNS replicas. Defaults to 2.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,2,opt,name=minReplicas"`
+	// MaxReplicas is the maximum number of Core DNS replicas. It is only supported for the `horizontal` mode and
+	// defaults to 5.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" protobuf:"varint,3,opt,name=maxReplicas"`
+	// CoresPerReplica is the number of CPU cores of the nodes per Core DNS replica. It is only supported for the
+	// `cluster-proportional` mode and defaults to 256.
+	// +optional
+	CoresPerReplica *int32 `json:"coresPerReplica,omitempty" protobuf:"varint,4,opt,name=coresPerReplica"`
+	// NodesPerReplica is the number of nodes per Core DNS replica. It is only supported for the `cluster-proportional`
+	// mode and defaults to 16.
+	// +optional
+	NodesPerReplica *int32 `json:"nodesPerReplica,omitempty" protobuf:"varint,5,opt,name=nodesPerReplica"`
 }
 
 // CoreDNSAutoscalingMode is a type alias for the Core DNS autoscaling mode string.
@@ -1720,6 +2095,38 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string `json:"commonSuffixes,omitempty" protobuf:"bytes,1,rep,name=commonSuffixes"`
 }
 
+// CoreDNSPlugin contains the settings of an additional Core DNS plugin.
+type CoreDNSPlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Args is a list of arguments which are passed to the plugin.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
+}
+
+// CoreDNSCache contains the settings of the cache of Core DNS.
+type CoreDNSCache struct {
+	// TTL is the maximum time in seconds for which responses are cached. Defaults to 30.
+	// +optional
+	TTL *int32 `json:"ttl,omitempty" protobuf:"varint,1,opt,name=ttl"`
+	// SuccessCapacity is the maximum number of cached successful responses.
+	// +optional
+	SuccessCapacity *int32 `json:"successCapacity,omitempty" protobuf:"varint,2,opt,name=successCapacity"`
+	// DenialCapacity is the maximum number of cached denial responses.
+	// +optional
+	DenialCapacity *int32 `json:"denialCapacity,omitempty" protobuf:"varint,3,opt,name=denialCapacity"`
+}
+
+// CoreDNSForwardZone contains the settings for forwarding the requests of a zone to dedicated upstream name servers.
+type CoreDNSForwardZone struct {
+	// Zone is the DNS zone whose requests are forwarded, e.g. `corp.example.com`.
+	Zone string `json:"zone" protobuf:"bytes,1,opt,name=zone"`
+	// Upstreams is a list of IP addresses (optionally with port) of the upstream name servers.
+	// +listType=atomic
+	Upstreams []string `json:"upstreams" protobuf:"bytes,2,rep,name=upstreams"`
+}
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1738,6 +2145,18 @@ type NodeLocalDNS struct {
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
 }
 
+// RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster.
+type RuntimeSecurity struct {
+	// Enabled indicates whether the runtime security agent is enabled or not.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster.
+type CertificateService struct {
+	// Enabled indicates whether the certificate service is enabled or not.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
@@ -1749,10 +2168,16 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationSkipped indicates that hibernation was skipped due to an exception of the schedule.
+	ShootEventHibernationSkipped = "HibernationSkipped"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventPaused indicates that the operations on the Shoot were paused.
+	ShootEventPaused = "Paused"
+	// ShootEventResumed indicates that the operations on the Shoot were resumed.
+	ShootEventResumed = "Resumed"
 )
 
 const (
@@ -1777,6 +2202,9 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootDualStackNodesMigrationReady is a constant for a condition type indicating whether all nodes of a Shoot
+	// cluster which is migrated to dual-stack networking have been rolled out and got pod CIDRs of both IP families.
+	ShootDualStackNodesMigrationReady ConditionType = "DualStackNodesMigrationReady"
 )
 
 // ShootPurpose is a type alias for string.
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootMaintenancePreview contains the changes which the next maintenance of a Shoot would apply based on the current
+// state of the Shoot and its CloudProfile. It is returned by the `shoots/maintenance-preview` subresource.
+type ShootMaintenancePreview struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.
+	NextMaintenanceTimeWindow MaintenancePreviewTimeWindow `json:"nextMaintenanceTimeWindow" protobuf:"bytes,2,opt,name=nextMaintenanceTimeWindow"`
+	// KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would
+	// not be updated.
+	// +optional
+	KubernetesVersion *VersionUpdatePreview `json:"kubernetesVersion,omitempty" protobuf:"bytes,3,opt,name=kubernetesVersion"`
+	// Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.
+	// +optional
+	// +listType=atomic
+	Workers []WorkerMaintenancePreview `json:"workers,omitempty" protobuf:"bytes,4,rep,name=workers"`
+	// Operation is the operation which would be triggered by the maintenance, e.g. a credentials rotation which was
+	// requested via the `maintenance.gardener.cloud/operation` annotation.
+	// +optional
+	Operation *string `json:"operation,omitempty" protobuf:"bytes,5,opt,name=operation"`
+	// Changes contains the descriptions of further changes which would be applied to the Shoot specification, e.g.
+	// removed feature gates or admission plugins.
+	// +optional
+	// +listType=atomic
+	Changes []string `json:"changes,omitempty" protobuf:"bytes,6,rep,name=changes"`
+}
+
+// MaintenancePreviewTimeWindow is a concrete maintenance time window.
+type MaintenancePreviewTimeWindow struct {
+	// Begin is the begin of the time window.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the end of the time window.
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+}
+
+// VersionUpdatePreview is a version update which would be performed by the next maintenance.
+type VersionUpdatePreview struct {
+	// CurrentVersion is the currently used version.
+	CurrentVersion string `json:"currentVersion" protobuf:"bytes,1,opt,name=currentVersion"`
+	// TargetVersion is the version which would be used after the maintenance. It is not set if no suitable version could
+	// be determined.
+	// +optional
+	TargetVersion *string `json:"targetVersion,omitempty" protobuf:"bytes,2,opt,name=targetVersion"`
+	// Reason is the reason for the update, e.g. an automatic update or an expired version.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
+	// FailureReason describes why the update would fail.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
+}
+
+// WorkerMaintenancePreview contains the updates of a worker pool which would be performed by the next maintenance.
+type WorkerMaintenancePreview struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KubernetesVersion is the update of the Kubernetes version of the worker pool.
+	// +optional
+	KubernetesVersion *VersionUpdatePreview `json:"kubernetesVersion,omitempty" protobuf:"bytes,2,opt,name=kubernetesVersion"`
+	// MachineImage is the update of the machine image version of the worker pool.
+	// +optional
+	MachineImage *MachineImageUpdatePreview `json:"machineImage,omitempty" protobuf:"bytes,3,opt,name=machineImage"`
+}
+
+// MachineImageUpdatePreview is a machine image version update which would be performed by the next maintenance.
+type MachineImageUpdatePreview struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// VersionUpdatePreview is the update of the machine image version.
+	VersionUpdatePreview `json:",inline" protobuf:"bytes,2,opt,name=versionUpdatePreview"`
+}
@@ -63,3 +63,144 @@ const (
 	// managed resource progressing condition is stuck in the true state for more than the threshold time.
 	ManagedResourceProgressingRolloutStuck = "ProgressingRolloutStuck"
 )
+
+// Reasons used in conditions and constraints maintained by Gardener's controllers. Their severity and a link to the
+// documentation can be retrieved with the `GetConditionReasonInfo` function of the `helper` package.
+const (
+	// ConditionInitialized is a constant for a reason in a condition that indicates that the condition has been initialized but not yet been checked.
+	ConditionInitialized = "ConditionInitialized"
+	// ConditionNotChecked is a constant for a reason in a condition that indicates that the condition has not been checked, e.g., because the shoot cluster is hibernated.
+	ConditionNotChecked = "ConditionNotChecked"
+	// ConstraintNotChecked is a constant for a reason in a condition that indicates that the constraint has not been checked, e.g., because the shoot control plane is not running.
+	ConstraintNotChecked = "ConstraintNotChecked"
+	// APIServerDown is a constant for a reason in a condition that indicates that the kube-apiserver of the shoot could not be reached.
+	APIServerDown = "APIServerDown"
+	// ControllersScaledDown is a constant for a reason in a condition that indicates that control plane controllers have been scaled down to zero replicas, e.g., by dependency-watchdog.
+	ControllersScaledDown = "ControllersScaledDown"
+	// ControllersScaledDownCheckError is a constant for a reason in a condition that indicates that it could not be checked whether control plane controllers have been scaled down.
+	ControllersScaledDownCheckError = "ControllersScaledDownCheckError"
+	// ControlPlaneRunning is a constant for a reason in a condition that indicates that all control plane components are healthy.
+	ControlPlaneRunning = "ControlPlaneRunning"
+	// ObservabilityComponentsRunning is a constant for a reason in a condition that indicates that all observability components are healthy.
+	ObservabilityComponentsRunning = "ObservabilityComponentsRunning"
+	// SystemComponentsRunning is a constant for a reason in a condition that indicates that all system components are healthy.
+	SystemComponentsRunning = "SystemComponentsRunning"
+	// RuntimeComponentsRunning is a constant for a reason in a condition that indicates that all runtime components are healthy.
+	RuntimeComponentsRunning = "RuntimeComponentsRunning"
+	// VirtualComponentsRunning is a constant for a reason in a condition that indicates that all virtual garden components are healthy.
+	VirtualComponentsRunning = "VirtualComponentsRunning"
+	// NoTunnelDeployed is a constant for a reason in a condition that indicates that no VPN tunnel is deployed in the shoot cluster.
+	NoTunnelDeployed = "NoTunnelDeployed"
+	// TunnelConnectionBroken is a constant for a reason in a condition that indicates that the VPN tunnel connection between control plane and shoot cluster is broken.
+	TunnelConnectionBroken = "TunnelConnectionBroken"
+	// EveryNodeReady is a constant for a reason in a condition that indicates that all nodes are ready.
+	EveryNodeReady = "EveryNodeReady"
+	// MissingNodes is a constant for a reason in a condition that indicates that not enough worker nodes are registered to meet the minimum desired machine count of a worker pool.
+	MissingNodes = "MissingNodes"
+	// OperatingSystemConfigOutdated is a constant for a reason in a condition that indicates that the operating system config has not yet been applied on all nodes.
+	OperatingSystemConfigOutdated = "OperatingSystemConfigOutdated"
+	// NodeAgentUnhealthy is a constant for a reason in a condition that indicates that the gardener-node-agent is unhealthy on at least one node.
+	NodeAgentUnhealthy = "NodeAgentUnhealthy"
+	// NodesScalingUp is a constant for a reason in a condition that indicates that nodes are being scaled up.
+	NodesScalingUp = "NodesScalingUp"
+	// NodesScalingDown is a constant for a reason in a condition that indicates that nodes are being scaled down.
+	NodesScalingDown = "NodesScalingDown"
+	// TooManyExpiredNodeLeases is a constant for a reason in a condition that indicates that too many node leases are expired.
+	TooManyExpiredNodeLeases = "TooManyExpiredNodeLeases"
+	// ManagedResourceListingFailed is a constant for a reason in a condition that indicates that the ManagedResources could not be listed.
+	ManagedResourceListingFailed = "ManagedResourceListingFailed"
+	// DeploymentMissing is a constant for a reason in a condition that indicates that a required deployment is missing.
+	DeploymentMissing = "DeploymentMissing"
+	// DeploymentUnhealthy is a constant for a reason in a condition that indicates that a deployment is unhealthy.
+	DeploymentUnhealthy = "DeploymentUnhealthy"
+	// EtcdMissing is a constant for a reason in a condition that indicates that a required etcd is missing.
+	EtcdMissing = "EtcdMissing"
+	// EtcdUnhealthy is a constant for a reason in a condition that indicates that an etcd is unhealthy.
+	EtcdUnhealthy = "EtcdUnhealthy"
+	// NodeUnhealthy is a constant for a reason in a condition that indicates that a node is unhealthy.
+	NodeUnhealthy = "NodeUnhealthy"
+	// VersionParseError is a constant for a reason in a condition that indicates that the Kubernetes version of a node could not be parsed.
+	VersionParseError = "VersionParseError"
+	// KubeletVersionMismatch is a constant for a reason in a condition that indicates that the kubelet version of a node does not match the desired Kubernetes version.
+	KubeletVersionMismatch = "KubeletVersionMismatch"
+	// VirtualGardenAPIServerDown is a constant for a reason in a condition that indicates that the virtual garden kube-apiserver could not be reached.
+	VirtualGardenAPIServerDown = "VirtualGardenAPIServerDown"
+	// ExpiringCACertificates is a constant for a reason in a condition that indicates that at least one CA certificate expires in less than one year, a credentials rotation should be considered.
+	ExpiringCACertificates = "ExpiringCACertificates"
+	// NoExpiringCACertificates is a constant for a reason in a condition that indicates that no CA certificate expires in less than one year.
+	NoExpiringCACertificates = "NoExpiringCACertificates"
+	// CRDsWithProblematicConversionWebhooks is a constant for a reason in a condition that indicates that at least one CustomResourceDefinition has multiple stored versions and a conversion webhook configured.
+	CRDsWithProblematicConversionWebhooks = "CRDsWithProblematicConversionWebhooks"
+	// NoCRDsWithProblematicConversionWebhooks is a constant for a reason in a condition that indicates that no CustomResourceDefinition has multiple stored versions and a conversion webhook configured.
+	NoCRDsWithProblematicConversionWebhooks = "NoCRDsWithProblematicConversionWebhooks"
+	// DualStackNodesMigrationPending is a constant for a reason in a condition that indicates that at least one node has not yet been rolled out after the migration to dual-stack networking.
+	DualStackNodesMigrationPending = "DualStackNodesMigrationPending"
+	// DualStackNodesMigrated is a constant for a reason in a condition that indicates that all nodes have been rolled out after the migration to dual-stack networking.
+	DualStackNodesMigrated = "DualStackNodesMigrated"
+	// HibernationPreChecksFailed is a constant for a reason in a condition that indicates that at least one pre-check which blocks the hibernation of the shoot failed.
+	HibernationPreChecksFailed = "HibernationPreChecksFailed"
+	// HibernationPreChecksWarning is a constant for a reason in a condition that indicates that at least one pre-check which does not block the hibernation of the shoot failed.
+	HibernationPreChecksWarning = "HibernationPreChecksWarning"
+	// ProblematicWebhooks is a constant for a reason in a condition that indicates that at least one webhook does not follow the Kubernetes best practices.
+	ProblematicWebhooks = "ProblematicWebhooks"
+	// RemediatedWebhooks is a constant for a reason in a condition that indicates that at least one webhook which did not follow the Kubernetes best practices has been remediated by Gardener.
+	RemediatedWebhooks = "RemediatedWebhooks"
+	// NoProblematicWebhooks is a constant for a reason in a condition that indicates that all webhooks follow the Kubernetes best practices.
+	NoProblematicWebhooks = "NoProblematicWebhooks"
+	// SeedReadError is a constant for a reason in a condition that indicates that the seed could not be read.
+	SeedReadError = "SeedReadError"
+	// InstallationPending is a constant for a reason in a condition that indicates that the controller has not yet been installed in the seed cluster.
+	InstallationPending = "InstallationPending"
+	// InstallationSuccessful is a constant for a reason in a condition that indicates that the controller was successfully installed in the seed cluster.
+	InstallationSuccessful = "InstallationSuccessful"
+	// ControllerNotHealthy is a constant for a reason in a condition that indicates that the controller running in the seed cluster is unhealthy.
+	ControllerNotHealthy = "ControllerNotHealthy"
+	// ControllerHealthy is a constant for a reason in a condition that indicates that the controller running in the seed cluster is healthy.
+	ControllerHealthy = "ControllerHealthy"
+	// ControllerNotRolledOut is a constant for a reason in a condition that indicates that the controller is still being rolled out.
+	ControllerNotRolledOut = "ControllerNotRolledOut"
+	// ControllerRolledOut is a constant for a reason in a condition that indicates that the controller has been rolled out successfully.
+	ControllerRolledOut = "ControllerRolledOut"
+	// PodSecurityViolations is a constant for a reason in a condition that indicates that at least one pod in the namespaces managed by the gardenlet violates the configured Pod Security Standard.
+	PodSecurityViolations = "PodSecurityViolations"
+	// NoPodSecurityViolations is a constant for a reason in a condition that indicates that all pods in the namespaces managed by the gardenlet comply with the configured Pod Security Standard.
+	NoPodSecurityViolations = "NoPodSecurityViolations"
+	// RegistrationNotFound is a constant for a reason in a condition that indicates that the referenced ControllerRegistration does not exist.
+	RegistrationNotFound = "RegistrationNotFound"
+	// RegistrationReadError is a constant for a reason in a condition that indicates that the referenced ControllerRegistration could not be read.
+	RegistrationReadError = "RegistrationReadError"
+	// SeedNotFound is a constant for a reason in a condition that indicates that the referenced seed does not exist.
+	SeedNotFound = "SeedNotFound"
+	// ChartInformationInvalid is a constant for a reason in a condition that indicates that the Helm chart information of the ControllerDeployment is invalid.
+	ChartInformationInvalid = "ChartInformationInvalid"
+	// OCIChartCannotBePulled is a constant for a reason in a condition that indicates that the Helm chart of the ControllerDeployment could not be pulled from the OCI registry.
+	OCIChartCannotBePulled = "OCIChartCannotBePulled"
+	// ChartCannotBeRendered is a constant for a reason in a condition that indicates that the Helm chart of the ControllerDeployment could not be rendered.
+	ChartCannotBeRendered = "ChartCannotBeRendered"
+	// RegistrationValid is a constant for a reason in a condition that indicates that the Helm chart of the ControllerDeployment could be rendered successfully.
+	RegistrationValid = "RegistrationValid"
+	// InstallationFailed is a constant for a reason in a condition that indicates that the controller could not be installed in the seed cluster.
+	InstallationFailed = "InstallationFailed"
+	// DeletionPending is a constant for a reason in a condition that indicates that the deletion of the controller from the seed cluster is still pending.
+	DeletionPending = "DeletionPending"
+	// DeletionFailed is a constant for a reason in a condition that indicates that the controller could not be deleted from the seed cluster.
+	DeletionFailed = "DeletionFailed"
+	// DeletionSuccessful is a constant for a reason in a condition that indicates that the controller was successfully deleted from the seed cluster.
+	DeletionSuccessful = "DeletionSuccessful"
+	// NotAllExtensionsValid is a constant for a reason in a condition that indicates that at least one ControllerInstallation for the seed is not valid.
+	NotAllExtensionsValid = "NotAllExtensionsValid"
+	// NotAllExtensionsInstalled is a constant for a reason in a condition that indicates that at least one ControllerInstallation for the seed is not installed.
+	NotAllExtensionsInstalled = "NotAllExtensionsInstalled"
+	// NotAllExtensionsHealthy is a constant for a reason in a condition that indicates that at least one ControllerInstallation for the seed is not healthy.
+	NotAllExtensionsHealthy = "NotAllExtensionsHealthy"
+	// SomeExtensionsProgressing is a constant for a reason in a condition that indicates that at least one ControllerInstallation for the seed is still progressing.
+	SomeExtensionsProgressing = "SomeExtensionsProgressing"
+	// AllExtensionsReady is a constant for a reason in a condition that indicates that all ControllerInstallations for the seed are ready and healthy.
+	AllExtensionsReady = "AllExtensionsReady"
+	// HealthzRequestFailed is a constant for a reason in a condition that indicates that the request to the /healthz endpoint of an API server failed.
+	HealthzRequestFailed = "HealthzRequestFailed"
+	// HealthzRequestError is a constant for a reason in a condition that indicates that the /healthz endpoint of an API server returned a non-ok status code.
+	HealthzRequestError = "HealthzRequestError"
+	// HealthzRequestSucceeded is a constant for a reason in a condition that indicates that the /healthz endpoint of an API server reported a healthy state.
+	HealthzRequestSucceeded = "HealthzRequestSucceeded"
+)
@@ -31,6 +31,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIResourcesCapability)(nil), (*core.APIResourcesCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIResourcesCapability_To_core_APIResourcesCapability(a.(*APIResourcesCapability), b.(*core.APIResourcesCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIResourcesCapability)(nil), (*APIResourcesCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIResourcesCapability_To_v1beta1_APIResourcesCapability(a.(*core.APIResourcesCapability), b.(*APIResourcesCapability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIServerLogging)(nil), (*core.APIServerLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerLogging_To_core_APIServerLogging(a.(*APIServerLogging), b.(*core.APIServerLogging), scope)
 	}); err != nil {
@@ -261,6 +271,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateService)(nil), (*core.CertificateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateService_To_core_CertificateService(a.(*CertificateService), b.(*core.CertificateService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CertificateService)(nil), (*CertificateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CertificateService_To_v1beta1_CertificateService(a.(*core.CertificateService), b.(*CertificateService), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfile)(nil), (*core.CloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProfile_To_core_CloudProfile(a.(*CloudProfile), b.(*core.CloudProfile), scope)
 	}); err != nil {
@@ -301,6 +321,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAuditPolicy)(nil), (*core.ClusterAuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAuditPolicy_To_core_ClusterAuditPolicy(a.(*ClusterAuditPolicy), b.(*core.ClusterAuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterAuditPolicy)(nil), (*ClusterAuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterAuditPolicy_To_v1beta1_ClusterAuditPolicy(a.(*core.ClusterAuditPolicy), b.(*ClusterAuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAuditPolicyList)(nil), (*core.ClusterAuditPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAuditPolicyList_To_core_ClusterAuditPolicyList(a.(*ClusterAuditPolicyList), b.(*core.ClusterAuditPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterAuditPolicyList)(nil), (*ClusterAuditPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterAuditPolicyList_To_v1beta1_ClusterAuditPolicyList(a.(*core.ClusterAuditPolicyList), b.(*ClusterAuditPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAuditPolicySpec)(nil), (*core.ClusterAuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec(a.(*ClusterAuditPolicySpec), b.(*core.ClusterAuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterAuditPolicySpec)(nil), (*ClusterAuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec(a.(*core.ClusterAuditPolicySpec), b.(*ClusterAuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaler)(nil), (*core.ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(a.(*ClusterAutoscaler), b.(*core.ClusterAutoscaler), scope)
 	}); err != nil {
@@ -331,6 +381,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConformanceTestReport)(nil), (*core.ConformanceTestReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ConformanceTestReport_To_core_ConformanceTestReport(a.(*ConformanceTestReport), b.(*core.ConformanceTestReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ConformanceTestReport)(nil), (*ConformanceTestReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ConformanceTestReport_To_v1beta1_ConformanceTestReport(a.(*core.ConformanceTestReport), b.(*ConformanceTestReport), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ContainerRuntime)(nil), (*core.ContainerRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ContainerRuntime_To_core_ContainerRuntime(a.(*ContainerRuntime), b.(*core.ContainerRuntime), scope)
 	}); err != nil {
@@ -351,6 +411,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneResourceUsage)(nil), (*core.ControlPlaneResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneResourceUsage_To_core_ControlPlaneResourceUsage(a.(*ControlPlaneResourceUsage), b.(*core.ControlPlaneResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneResourceUsage)(nil), (*ControlPlaneResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneResourceUsage_To_v1beta1_ControlPlaneResourceUsage(a.(*core.ControlPlaneResourceUsage), b.(*ControlPlaneResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -481,6 +551,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSCache)(nil), (*core.CoreDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSCache_To_core_CoreDNSCache(a.(*CoreDNSCache), b.(*core.CoreDNSCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSCache)(nil), (*CoreDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSCache_To_v1beta1_CoreDNSCache(a.(*core.CoreDNSCache), b.(*CoreDNSCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSForwardZone)(nil), (*core.CoreDNSForwardZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(a.(*CoreDNSForwardZone), b.(*core.CoreDNSForwardZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSForwardZone)(nil), (*CoreDNSForwardZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(a.(*core.CoreDNSForwardZone), b.(*CoreDNSForwardZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSPlugin)(nil), (*core.CoreDNSPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSPlugin_To_core_CoreDNSPlugin(a.(*CoreDNSPlugin), b.(*core.CoreDNSPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSPlugin)(nil), (*CoreDNSPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSPlugin_To_v1beta1_CoreDNSPlugin(a.(*core.CoreDNSPlugin), b.(*CoreDNSPlugin), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CoreDNSRewriting)(nil), (*core.CoreDNSRewriting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(a.(*CoreDNSRewriting), b.(*core.CoreDNSRewriting), scope)
 	}); err != nil {
@@ -621,6 +721,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExtensionCapability)(nil), (*core.ExtensionCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExtensionCapability_To_core_ExtensionCapability(a.(*ExtensionCapability), b.(*core.ExtensionCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExtensionCapability)(nil), (*ExtensionCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExtensionCapability_To_v1beta1_ExtensionCapability(a.(*core.ExtensionCapability), b.(*ExtensionCapability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExtensionResourceState)(nil), (*core.ExtensionResourceState)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExtensionResourceState_To_core_ExtensionResourceState(a.(*ExtensionResourceState), b.(*core.ExtensionResourceState), scope)
 	}); err != nil {
@@ -641,6 +751,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FeatureGateCapability)(nil), (*core.FeatureGateCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FeatureGateCapability_To_core_FeatureGateCapability(a.(*FeatureGateCapability), b.(*core.FeatureGateCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureGateCapability)(nil), (*FeatureGateCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureGateCapability_To_v1beta1_FeatureGateCapability(a.(*core.FeatureGateCapability), b.(*FeatureGateCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GardenCapabilities)(nil), (*core.GardenCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GardenCapabilities_To_core_GardenCapabilities(a.(*GardenCapabilities), b.(*core.GardenCapabilities), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GardenCapabilities)(nil), (*GardenCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GardenCapabilities_To_v1beta1_GardenCapabilities(a.(*core.GardenCapabilities), b.(*GardenCapabilities), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Gardener)(nil), (*core.Gardener)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Gardener_To_core_Gardener(a.(*Gardener), b.(*core.Gardener), scope)
 	}); err != nil {
@@ -681,6 +811,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationScheduleException)(nil), (*core.HibernationScheduleException)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationScheduleException_To_core_HibernationScheduleException(a.(*HibernationScheduleException), b.(*core.HibernationScheduleException), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationScheduleException)(nil), (*HibernationScheduleException)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationScheduleException_To_v1beta1_HibernationScheduleException(a.(*core.HibernationScheduleException), b.(*HibernationScheduleException), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*core.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HighAvailability_To_core_HighAvailability(a.(*HighAvailability), b.(*core.HighAvailability), scope)
 	}); err != nil {
@@ -826,6 +966,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletCredentialProvider)(nil), (*core.KubeletCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(a.(*KubeletCredentialProvider), b.(*core.KubeletCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeletCredentialProvider)(nil), (*KubeletCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(a.(*core.KubeletCredentialProvider), b.(*KubeletCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeletCredentialProviderEnvVar)(nil), (*core.KubeletCredentialProviderEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(a.(*KubeletCredentialProviderEnvVar), b.(*core.KubeletCredentialProviderEnvVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeletCredentialProviderEnvVar)(nil), (*KubeletCredentialProviderEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(a.(*core.KubeletCredentialProviderEnvVar), b.(*KubeletCredentialProviderEnvVar), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kubernetes)(nil), (*core.Kubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Kubernetes_To_core_Kubernetes(a.(*Kubernetes), b.(*core.Kubernetes), scope)
 	}); err != nil {
@@ -936,6 +1096,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageUpdatePreview)(nil), (*core.MachineImageUpdatePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageUpdatePreview_To_core_MachineImageUpdatePreview(a.(*MachineImageUpdatePreview), b.(*core.MachineImageUpdatePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageUpdatePreview)(nil), (*MachineImageUpdatePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageUpdatePreview_To_v1beta1_MachineImageUpdatePreview(a.(*core.MachineImageUpdatePreview), b.(*MachineImageUpdatePreview), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*core.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(a.(*MachineImageVersion), b.(*core.MachineImageVersion), scope)
 	}); err != nil {
@@ -986,6 +1156,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePendingChanges)(nil), (*core.MaintenancePendingChanges)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePendingChanges_To_core_MaintenancePendingChanges(a.(*MaintenancePendingChanges), b.(*core.MaintenancePendingChanges), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePendingChanges)(nil), (*MaintenancePendingChanges)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePendingChanges_To_v1beta1_MaintenancePendingChanges(a.(*core.MaintenancePendingChanges), b.(*MaintenancePendingChanges), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePreviewTimeWindow)(nil), (*core.MaintenancePreviewTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(a.(*MaintenancePreviewTimeWindow), b.(*core.MaintenancePreviewTimeWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePreviewTimeWindow)(nil), (*MaintenancePreviewTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(a.(*core.MaintenancePreviewTimeWindow), b.(*MaintenancePreviewTimeWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1106,6 +1296,96 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NotificationDelivery)(nil), (*core.NotificationDelivery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationDelivery_To_core_NotificationDelivery(a.(*NotificationDelivery), b.(*core.NotificationDelivery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationDelivery)(nil), (*NotificationDelivery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationDelivery_To_v1beta1_NotificationDelivery(a.(*core.NotificationDelivery), b.(*NotificationDelivery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationFilter)(nil), (*core.NotificationFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationFilter_To_core_NotificationFilter(a.(*NotificationFilter), b.(*core.NotificationFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationFilter)(nil), (*NotificationFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationFilter_To_v1beta1_NotificationFilter(a.(*core.NotificationFilter), b.(*NotificationFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSink)(nil), (*core.NotificationSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSink_To_core_NotificationSink(a.(*NotificationSink), b.(*core.NotificationSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSink)(nil), (*NotificationSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSink_To_v1beta1_NotificationSink(a.(*core.NotificationSink), b.(*NotificationSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkEmail)(nil), (*core.NotificationSinkEmail)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkEmail_To_core_NotificationSinkEmail(a.(*NotificationSinkEmail), b.(*core.NotificationSinkEmail), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkEmail)(nil), (*NotificationSinkEmail)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkEmail_To_v1beta1_NotificationSinkEmail(a.(*core.NotificationSinkEmail), b.(*NotificationSinkEmail), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkList)(nil), (*core.NotificationSinkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkList_To_core_NotificationSinkList(a.(*NotificationSinkList), b.(*core.NotificationSinkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkList)(nil), (*NotificationSinkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkList_To_v1beta1_NotificationSinkList(a.(*core.NotificationSinkList), b.(*NotificationSinkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkSlack)(nil), (*core.NotificationSinkSlack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkSlack_To_core_NotificationSinkSlack(a.(*NotificationSinkSlack), b.(*core.NotificationSinkSlack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkSlack)(nil), (*NotificationSinkSlack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkSlack_To_v1beta1_NotificationSinkSlack(a.(*core.NotificationSinkSlack), b.(*NotificationSinkSlack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkSpec)(nil), (*core.NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec(a.(*NotificationSinkSpec), b.(*core.NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkSpec)(nil), (*NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(a.(*core.NotificationSinkSpec), b.(*NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkStatus)(nil), (*core.NotificationSinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus(a.(*NotificationSinkStatus), b.(*core.NotificationSinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkStatus)(nil), (*NotificationSinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus(a.(*core.NotificationSinkStatus), b.(*NotificationSinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkWebhook)(nil), (*core.NotificationSinkWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSinkWebhook_To_core_NotificationSinkWebhook(a.(*NotificationSinkWebhook), b.(*core.NotificationSinkWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSinkWebhook)(nil), (*NotificationSinkWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSinkWebhook_To_v1beta1_NotificationSinkWebhook(a.(*core.NotificationSinkWebhook), b.(*NotificationSinkWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OCIRepository)(nil), (*core.OCIRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIRepository_To_core_OCIRepository(a.(*OCIRepository), b.(*core.OCIRepository), scope)
 	}); err != nil {
@@ -1146,6 +1426,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PKI)(nil), (*core.PKI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PKI_To_core_PKI(a.(*PKI), b.(*core.PKI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PKI)(nil), (*PKI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PKI_To_v1beta1_PKI(a.(*core.PKI), b.(*PKI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1166,6 +1456,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectShootDefaults)(nil), (*core.ProjectShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(a.(*ProjectShootDefaults), b.(*core.ProjectShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectShootDefaults)(nil), (*ProjectShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(a.(*core.ProjectShootDefaults), b.(*ProjectShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1256,6 +1556,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RuntimeSecurity)(nil), (*core.RuntimeSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RuntimeSecurity_To_core_RuntimeSecurity(a.(*RuntimeSecurity), b.(*core.RuntimeSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RuntimeSecurity)(nil), (*RuntimeSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RuntimeSecurity_To_v1beta1_RuntimeSecurity(a.(*core.RuntimeSecurity), b.(*RuntimeSecurity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHAccess)(nil), (*core.SSHAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SSHAccess_To_core_SSHAccess(a.(*SSHAccess), b.(*core.SSHAccess), scope)
 	}); err != nil {
@@ -1316,6 +1626,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedCapability)(nil), (*core.SeedCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedCapability_To_core_SeedCapability(a.(*SeedCapability), b.(*core.SeedCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedCapability)(nil), (*SeedCapability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedCapability_To_v1beta1_SeedCapability(a.(*core.SeedCapability), b.(*SeedCapability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedCapacityReport)(nil), (*core.SeedCapacityReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedCapacityReport_To_core_SeedCapacityReport(a.(*SeedCapacityReport), b.(*core.SeedCapacityReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedCapacityReport)(nil), (*SeedCapacityReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedCapacityReport_To_v1beta1_SeedCapacityReport(a.(*core.SeedCapacityReport), b.(*SeedCapacityReport), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedDNS)(nil), (*core.SeedDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedDNS_To_core_SeedDNS(a.(*SeedDNS), b.(*core.SeedDNS), scope)
 	}); err != nil {
@@ -1356,6 +1686,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedPoolUtilization)(nil), (*core.SeedPoolUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedPoolUtilization_To_core_SeedPoolUtilization(a.(*SeedPoolUtilization), b.(*core.SeedPoolUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedPoolUtilization)(nil), (*SeedPoolUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedPoolUtilization_To_v1beta1_SeedPoolUtilization(a.(*core.SeedPoolUtilization), b.(*SeedPoolUtilization), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedProvider)(nil), (*core.SeedProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedProvider_To_core_SeedProvider(a.(*SeedProvider), b.(*core.SeedProvider), scope)
 	}); err != nil {
@@ -1366,6 +1706,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedResourceUtilization)(nil), (*core.SeedResourceUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedResourceUtilization_To_core_SeedResourceUtilization(a.(*SeedResourceUtilization), b.(*core.SeedResourceUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedResourceUtilization)(nil), (*SeedResourceUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedResourceUtilization_To_v1beta1_SeedResourceUtilization(a.(*core.SeedResourceUtilization), b.(*SeedResourceUtilization), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSelector)(nil), (*core.SeedSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSelector_To_core_SeedSelector(a.(*SeedSelector), b.(*core.SeedSelector), scope)
 	}); err != nil {
@@ -1376,6 +1726,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingAuditPolicy)(nil), (*core.SeedSettingAuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingAuditPolicy_To_core_SeedSettingAuditPolicy(a.(*SeedSettingAuditPolicy), b.(*core.SeedSettingAuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingAuditPolicy)(nil), (*SeedSettingAuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingAuditPolicy_To_v1beta1_SeedSettingAuditPolicy(a.(*core.SeedSettingAuditPolicy), b.(*SeedSettingAuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1606,6 +1966,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootDNSStatus)(nil), (*core.ShootDNSStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootDNSStatus_To_core_ShootDNSStatus(a.(*ShootDNSStatus), b.(*core.ShootDNSStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootDNSStatus)(nil), (*ShootDNSStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootDNSStatus_To_v1beta1_ShootDNSStatus(a.(*core.ShootDNSStatus), b.(*ShootDNSStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootKubeconfigRotation)(nil), (*core.ShootKubeconfigRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(a.(*ShootKubeconfigRotation), b.(*core.ShootKubeconfigRotation), scope)
 	}); err != nil {
@@ -1636,6 +2006,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMaintenancePreview)(nil), (*core.ShootMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootMaintenancePreview_To_core_ShootMaintenancePreview(a.(*ShootMaintenancePreview), b.(*core.ShootMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootMaintenancePreview)(nil), (*ShootMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootMaintenancePreview_To_v1beta1_ShootMaintenancePreview(a.(*core.ShootMaintenancePreview), b.(*ShootMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceStatus)(nil), (*core.ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(a.(*ShootMaintenanceStatus), b.(*core.ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootMaintenanceStatus)(nil), (*ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(a.(*core.ShootMaintenanceStatus), b.(*ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*core.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootNetworks_To_core_ShootNetworks(a.(*ShootNetworks), b.(*core.ShootNetworks), scope)
 	}); err != nil {
@@ -1736,6 +2126,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionUpdatePreview)(nil), (*core.VersionUpdatePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview(a.(*VersionUpdatePreview), b.(*core.VersionUpdatePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionUpdatePreview)(nil), (*VersionUpdatePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview(a.(*core.VersionUpdatePreview), b.(*VersionUpdatePreview), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1786,33 +2186,73 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*core.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(a.(*WorkerKubernetes), b.(*core.WorkerKubernetes), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerExistingHosts)(nil), (*core.WorkerExistingHosts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerExistingHosts_To_core_WorkerExistingHosts(a.(*WorkerExistingHosts), b.(*core.WorkerExistingHosts), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkerKubernetes)(nil), (*WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(a.(*core.WorkerKubernetes), b.(*WorkerKubernetes), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerExistingHosts)(nil), (*WorkerExistingHosts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerExistingHosts_To_v1beta1_WorkerExistingHosts(a.(*core.WorkerExistingHosts), b.(*WorkerExistingHosts), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerHeadroom)(nil), (*core.WorkerHeadroom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerHeadroom_To_core_WorkerHeadroom(a.(*WorkerHeadroom), b.(*core.WorkerHeadroom), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkerSystemComponents)(nil), (*WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(a.(*core.WorkerSystemComponents), b.(*WorkerSystemComponents), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerHeadroom)(nil), (*WorkerHeadroom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerHeadroom_To_v1beta1_WorkerHeadroom(a.(*core.WorkerHeadroom), b.(*WorkerHeadroom), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkersSettings)(nil), (*core.WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(a.(*WorkersSettings), b.(*core.WorkersSettings), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*core.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(a.(*WorkerKubernetes), b.(*core.WorkerKubernetes), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkersSettings)(nil), (*WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(a.(*core.WorkersSettings), b.(*WorkersSettings), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerKubernetes)(nil), (*WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(a.(*core.WorkerKubernetes), b.(*WorkerKubernetes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerMaintenancePreview)(nil), (*core.WorkerMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(a.(*WorkerMaintenancePreview), b.(*core.WorkerMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerMaintenancePreview)(nil), (*WorkerMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(a.(*core.WorkerMaintenancePreview), b.(*WorkerMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerRollingUpdate)(nil), (*core.WorkerRollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerRollingUpdate_To_core_WorkerRollingUpdate(a.(*WorkerRollingUpdate), b.(*core.WorkerRollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerRollingUpdate)(nil), (*WorkerRollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerRollingUpdate_To_v1beta1_WorkerRollingUpdate(a.(*core.WorkerRollingUpdate), b.(*WorkerRollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerSystemComponents)(nil), (*WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(a.(*core.WorkerSystemComponents), b.(*WorkerSystemComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkersSettings)(nil), (*core.WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(a.(*WorkersSettings), b.(*core.WorkersSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkersSettings)(nil), (*WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(a.(*core.WorkersSettings), b.(*WorkersSettings), scope)
 	}); err != nil {
 		return err
 	}
@@ -1864,6 +2304,28 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1beta1_APIResourcesCapability_To_core_APIResourcesCapability(in *APIResourcesCapability, out *core.APIResourcesCapability, s conversion.Scope) error {
+	out.GroupVersion = in.GroupVersion
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta1_APIResourcesCapability_To_core_APIResourcesCapability is an autogenerated conversion function.
+func Convert_v1beta1_APIResourcesCapability_To_core_APIResourcesCapability(in *APIResourcesCapability, out *core.APIResourcesCapability, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIResourcesCapability_To_core_APIResourcesCapability(in, out, s)
+}
+
+func autoConvert_core_APIResourcesCapability_To_v1beta1_APIResourcesCapability(in *core.APIResourcesCapability, out *APIResourcesCapability, s conversion.Scope) error {
+	out.GroupVersion = in.GroupVersion
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_core_APIResourcesCapability_To_v1beta1_APIResourcesCapability is an autogenerated conversion function.
+func Convert_core_APIResourcesCapability_To_v1beta1_APIResourcesCapability(in *core.APIResourcesCapability, out *APIResourcesCapability, s conversion.Scope) error {
+	return autoConvert_core_APIResourcesCapability_To_v1beta1_APIResourcesCapability(in, out, s)
+}
+
 func autoConvert_v1beta1_APIServerLogging_To_core_APIServerLogging(in *APIServerLogging, out *core.APIServerLogging, s conversion.Scope) error {
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	out.HTTPAccessVerbosity = (*int32)(unsafe.Pointer(in.HTTPAccessVerbosity))
@@ -2018,6 +2480,7 @@ func Convert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *
 
 func autoConvert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.AuditPolicy, s conversion.Scope) error {
 	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ClusterAuditPolicyName = (*string)(unsafe.Pointer(in.ClusterAuditPolicyName))
 	return nil
 }
 
@@ -2028,6 +2491,7 @@ func Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.
 
 func autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
 	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ClusterAuditPolicyName = (*string)(unsafe.Pointer(in.ClusterAuditPolicyName))
 	return nil
 }
 
@@ -2412,6 +2876,26 @@ func Convert_core_CRI_To_v1beta1_CRI(in *core.CRI, out *CRI, s conversion.Scope)
 	return autoConvert_core_CRI_To_v1beta1_CRI(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateService_To_core_CertificateService(in *CertificateService, out *core.CertificateService, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_CertificateService_To_core_CertificateService is an autogenerated conversion function.
+func Convert_v1beta1_CertificateService_To_core_CertificateService(in *CertificateService, out *core.CertificateService, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateService_To_core_CertificateService(in, out, s)
+}
+
+func autoConvert_core_CertificateService_To_v1beta1_CertificateService(in *core.CertificateService, out *CertificateService, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_CertificateService_To_v1beta1_CertificateService is an autogenerated conversion function.
+func Convert_core_CertificateService_To_v1beta1_CertificateService(in *core.CertificateService, out *CertificateService, s conversion.Scope) error {
+	return autoConvert_core_CertificateService_To_v1beta1_CertificateService(in, out, s)
+}
+
 func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out *core.CloudProfile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2524,6 +3008,74 @@ func Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.CloudPro
 	return autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterAuditPolicy_To_core_ClusterAuditPolicy(in *ClusterAuditPolicy, out *core.ClusterAuditPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ClusterAuditPolicy_To_core_ClusterAuditPolicy is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAuditPolicy_To_core_ClusterAuditPolicy(in *ClusterAuditPolicy, out *core.ClusterAuditPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAuditPolicy_To_core_ClusterAuditPolicy(in, out, s)
+}
+
+func autoConvert_core_ClusterAuditPolicy_To_v1beta1_ClusterAuditPolicy(in *core.ClusterAuditPolicy, out *ClusterAuditPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ClusterAuditPolicy_To_v1beta1_ClusterAuditPolicy is an autogenerated conversion function.
+func Convert_core_ClusterAuditPolicy_To_v1beta1_ClusterAuditPolicy(in *core.ClusterAuditPolicy, out *ClusterAuditPolicy, s conversion.Scope) error {
+	return autoConvert_core_ClusterAuditPolicy_To_v1beta1_ClusterAuditPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterAuditPolicyList_To_core_ClusterAuditPolicyList(in *ClusterAuditPolicyList, out *core.ClusterAuditPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ClusterAuditPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ClusterAuditPolicyList_To_core_ClusterAuditPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAuditPolicyList_To_core_ClusterAuditPolicyList(in *ClusterAuditPolicyList, out *core.ClusterAuditPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAuditPolicyList_To_core_ClusterAuditPolicyList(in, out, s)
+}
+
+func autoConvert_core_ClusterAuditPolicyList_To_v1beta1_ClusterAuditPolicyList(in *core.ClusterAuditPolicyList, out *ClusterAuditPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAuditPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ClusterAuditPolicyList_To_v1beta1_ClusterAuditPolicyList is an autogenerated conversion function.
+func Convert_core_ClusterAuditPolicyList_To_v1beta1_ClusterAuditPolicyList(in *core.ClusterAuditPolicyList, out *ClusterAuditPolicyList, s conversion.Scope) error {
+	return autoConvert_core_ClusterAuditPolicyList_To_v1beta1_ClusterAuditPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec(in *ClusterAuditPolicySpec, out *core.ClusterAuditPolicySpec, s conversion.Scope) error {
+	out.Policy = in.Policy
+	return nil
+}
+
+// Convert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec(in *ClusterAuditPolicySpec, out *core.ClusterAuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAuditPolicySpec_To_core_ClusterAuditPolicySpec(in, out, s)
+}
+
+func autoConvert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec(in *core.ClusterAuditPolicySpec, out *ClusterAuditPolicySpec, s conversion.Scope) error {
+	out.Policy = in.Policy
+	return nil
+}
+
+// Convert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec is an autogenerated conversion function.
+func Convert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec(in *core.ClusterAuditPolicySpec, out *ClusterAuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_core_ClusterAuditPolicySpec_To_v1beta1_ClusterAuditPolicySpec(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAutoscaler, out *core.ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
@@ -2576,6 +3128,7 @@ func autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptio
 	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
 	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	return nil
 }
 
@@ -2590,6 +3143,7 @@ func autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptio
 	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
 	out.ScaleDownUnreadyTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnreadyTime))
 	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	return nil
 }
 
@@ -2630,6 +3184,32 @@ func Convert_core_Condition_To_v1beta1_Condition(in *core.Condition, out *Condit
 	return autoConvert_core_Condition_To_v1beta1_Condition(in, out, s)
 }
 
+func autoConvert_v1beta1_ConformanceTestReport_To_core_ConformanceTestReport(in *ConformanceTestReport, out *core.ConformanceTestReport, s conversion.Scope) error {
+	out.Phase = core.ConformanceTestPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Summary = in.Summary
+	return nil
+}
+
+// Convert_v1beta1_ConformanceTestReport_To_core_ConformanceTestReport is an autogenerated conversion function.
+func Convert_v1beta1_ConformanceTestReport_To_core_ConformanceTestReport(in *ConformanceTestReport, out *core.ConformanceTestReport, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConformanceTestReport_To_core_ConformanceTestReport(in, out, s)
+}
+
+func autoConvert_core_ConformanceTestReport_To_v1beta1_ConformanceTestReport(in *core.ConformanceTestReport, out *ConformanceTestReport, s conversion.Scope) error {
+	out.Phase = ConformanceTestPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Summary = in.Summary
+	return nil
+}
+
+// Convert_core_ConformanceTestReport_To_v1beta1_ConformanceTestReport is an autogenerated conversion function.
+func Convert_core_ConformanceTestReport_To_v1beta1_ConformanceTestReport(in *core.ConformanceTestReport, out *ConformanceTestReport, s conversion.Scope) error {
+	return autoConvert_core_ConformanceTestReport_To_v1beta1_ConformanceTestReport(in, out, s)
+}
+
 func autoConvert_v1beta1_ContainerRuntime_To_core_ContainerRuntime(in *ContainerRuntime, out *core.ContainerRuntime, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
@@ -2672,6 +3252,32 @@ func Convert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, ou
 	return autoConvert_core_ControlPlane_To_v1beta1_ControlPlane(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneResourceUsage_To_core_ControlPlaneResourceUsage(in *ControlPlaneResourceUsage, out *core.ControlPlaneResourceUsage, s conversion.Scope) error {
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	out.ETCDStorage = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorage))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneResourceUsage_To_core_ControlPlaneResourceUsage is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneResourceUsage_To_core_ControlPlaneResourceUsage(in *ControlPlaneResourceUsage, out *core.ControlPlaneResourceUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneResourceUsage_To_core_ControlPlaneResourceUsage(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneResourceUsage_To_v1beta1_ControlPlaneResourceUsage(in *core.ControlPlaneResourceUsage, out *ControlPlaneResourceUsage, s conversion.Scope) error {
+	out.Requests = *(*v1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	out.ETCDStorage = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorage))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ControlPlaneResourceUsage_To_v1beta1_ControlPlaneResourceUsage is an autogenerated conversion function.
+func Convert_core_ControlPlaneResourceUsage_To_v1beta1_ControlPlaneResourceUsage(in *core.ControlPlaneResourceUsage, out *ControlPlaneResourceUsage, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneResourceUsage_To_v1beta1_ControlPlaneResourceUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -2986,6 +3592,9 @@ func Convert_core_ControllerResourceLifecycle_To_v1beta1_ControllerResourceLifec
 func autoConvert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*core.CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*core.CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.Plugins = *(*[]core.CoreDNSPlugin)(unsafe.Pointer(&in.Plugins))
+	out.Cache = (*core.CoreDNSCache)(unsafe.Pointer(in.Cache))
+	out.ForwardZones = *(*[]core.CoreDNSForwardZone)(unsafe.Pointer(&in.ForwardZones))
 	return nil
 }
 
@@ -2997,6 +3606,9 @@ func Convert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s c
 func autoConvert_core_CoreDNS_To_v1beta1_CoreDNS(in *core.CoreDNS, out *CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.Plugins = *(*[]CoreDNSPlugin)(unsafe.Pointer(&in.Plugins))
+	out.Cache = (*CoreDNSCache)(unsafe.Pointer(in.Cache))
+	out.ForwardZones = *(*[]CoreDNSForwardZone)(unsafe.Pointer(&in.ForwardZones))
 	return nil
 }
 
@@ -3007,6 +3619,10 @@ func Convert_core_CoreDNS_To_v1beta1_CoreDNS(in *core.CoreDNS, out *CoreDNS, s c
 
 func autoConvert_v1beta1_CoreDNSAutoscaling_To_core_CoreDNSAutoscaling(in *CoreDNSAutoscaling, out *core.CoreDNSAutoscaling, s conversion.Scope) error {
 	out.Mode = core.CoreDNSAutoscalingMode(in.Mode)
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.CoresPerReplica = (*int32)(unsafe.Pointer(in.CoresPerReplica))
+	out.NodesPerReplica = (*int32)(unsafe.Pointer(in.NodesPerReplica))
 	return nil
 }
 
@@ -3017,6 +3633,10 @@ func Convert_v1beta1_CoreDNSAutoscaling_To_core_CoreDNSAutoscaling(in *CoreDNSAu
 
 func autoConvert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in *core.CoreDNSAutoscaling, out *CoreDNSAutoscaling, s conversion.Scope) error {
 	out.Mode = CoreDNSAutoscalingMode(in.Mode)
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.CoresPerReplica = (*int32)(unsafe.Pointer(in.CoresPerReplica))
+	out.NodesPerReplica = (*int32)(unsafe.Pointer(in.NodesPerReplica))
 	return nil
 }
 
@@ -3025,6 +3645,74 @@ func Convert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in *core.Core
 	return autoConvert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_CoreDNSCache_To_core_CoreDNSCache(in *CoreDNSCache, out *core.CoreDNSCache, s conversion.Scope) error {
+	out.TTL = (*int32)(unsafe.Pointer(in.TTL))
+	out.SuccessCapacity = (*int32)(unsafe.Pointer(in.SuccessCapacity))
+	out.DenialCapacity = (*int32)(unsafe.Pointer(in.DenialCapacity))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSCache_To_core_CoreDNSCache is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSCache_To_core_CoreDNSCache(in *CoreDNSCache, out *core.CoreDNSCache, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSCache_To_core_CoreDNSCache(in, out, s)
+}
+
+func autoConvert_core_CoreDNSCache_To_v1beta1_CoreDNSCache(in *core.CoreDNSCache, out *CoreDNSCache, s conversion.Scope) error {
+	out.TTL = (*int32)(unsafe.Pointer(in.TTL))
+	out.SuccessCapacity = (*int32)(unsafe.Pointer(in.SuccessCapacity))
+	out.DenialCapacity = (*int32)(unsafe.Pointer(in.DenialCapacity))
+	return nil
+}
+
+// Convert_core_CoreDNSCache_To_v1beta1_CoreDNSCache is an autogenerated conversion function.
+func Convert_core_CoreDNSCache_To_v1beta1_CoreDNSCache(in *core.CoreDNSCache, out *CoreDNSCache, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSCache_To_v1beta1_CoreDNSCache(in, out, s)
+}
+
+func autoConvert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in *CoreDNSForwardZone, out *core.CoreDNSForwardZone, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in *CoreDNSForwardZone, out *core.CoreDNSForwardZone, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in, out, s)
+}
+
+func autoConvert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in *core.CoreDNSForwardZone, out *CoreDNSForwardZone, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone is an autogenerated conversion function.
+func Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in *core.CoreDNSForwardZone, out *CoreDNSForwardZone, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in, out, s)
+}
+
+func autoConvert_v1beta1_CoreDNSPlugin_To_core_CoreDNSPlugin(in *CoreDNSPlugin, out *core.CoreDNSPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSPlugin_To_core_CoreDNSPlugin is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSPlugin_To_core_CoreDNSPlugin(in *CoreDNSPlugin, out *core.CoreDNSPlugin, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSPlugin_To_core_CoreDNSPlugin(in, out, s)
+}
+
+func autoConvert_core_CoreDNSPlugin_To_v1beta1_CoreDNSPlugin(in *core.CoreDNSPlugin, out *CoreDNSPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	return nil
+}
+
+// Convert_core_CoreDNSPlugin_To_v1beta1_CoreDNSPlugin is an autogenerated conversion function.
+func Convert_core_CoreDNSPlugin_To_v1beta1_CoreDNSPlugin(in *core.CoreDNSPlugin, out *CoreDNSPlugin, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSPlugin_To_v1beta1_CoreDNSPlugin(in, out, s)
+}
+
 func autoConvert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(in *CoreDNSRewriting, out *core.CoreDNSRewriting, s conversion.Scope) error {
 	out.CommonSuffixes = *(*[]string)(unsafe.Pointer(&in.CommonSuffixes))
 	return nil
@@ -3095,6 +3783,7 @@ func autoConvert_v1beta1_DNSProvider_To_core_DNSProvider(in *DNSProvider, out *c
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.Zones = (*core.DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+	out.Secondary = (*bool)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -3109,6 +3798,7 @@ func autoConvert_core_DNSProvider_To_v1beta1_DNSProvider(in *core.DNSProvider, o
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.Zones = (*DNSIncludeExclude)(unsafe.Pointer(in.Zones))
+	out.Secondary = (*bool)(unsafe.Pointer(in.Secondary))
 	return nil
 }
 
@@ -3351,6 +4041,28 @@ func Convert_core_Extension_To_v1beta1_Extension(in *core.Extension, out *Extens
 	return autoConvert_core_Extension_To_v1beta1_Extension(in, out, s)
 }
 
+func autoConvert_v1beta1_ExtensionCapability_To_core_ExtensionCapability(in *ExtensionCapability, out *core.ExtensionCapability, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Types = *(*[]string)(unsafe.Pointer(&in.Types))
+	return nil
+}
+
+// Convert_v1beta1_ExtensionCapability_To_core_ExtensionCapability is an autogenerated conversion function.
+func Convert_v1beta1_ExtensionCapability_To_core_ExtensionCapability(in *ExtensionCapability, out *core.ExtensionCapability, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExtensionCapability_To_core_ExtensionCapability(in, out, s)
+}
+
+func autoConvert_core_ExtensionCapability_To_v1beta1_ExtensionCapability(in *core.ExtensionCapability, out *ExtensionCapability, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Types = *(*[]string)(unsafe.Pointer(&in.Types))
+	return nil
+}
+
+// Convert_core_ExtensionCapability_To_v1beta1_ExtensionCapability is an autogenerated conversion function.
+func Convert_core_ExtensionCapability_To_v1beta1_ExtensionCapability(in *core.ExtensionCapability, out *ExtensionCapability, s conversion.Scope) error {
+	return autoConvert_core_ExtensionCapability_To_v1beta1_ExtensionCapability(in, out, s)
+}
+
 func autoConvert_v1beta1_ExtensionResourceState_To_core_ExtensionResourceState(in *ExtensionResourceState, out *core.ExtensionResourceState, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Name = (*string)(unsafe.Pointer(in.Name))
@@ -3399,6 +4111,58 @@ func Convert_core_FailureTolerance_To_v1beta1_FailureTolerance(in *core.FailureT
 	return autoConvert_core_FailureTolerance_To_v1beta1_FailureTolerance(in, out, s)
 }
 
+func autoConvert_v1beta1_FeatureGateCapability_To_core_FeatureGateCapability(in *FeatureGateCapability, out *core.FeatureGateCapability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = in.Enabled
+	out.Stage = in.Stage
+	return nil
+}
+
+// Convert_v1beta1_FeatureGateCapability_To_core_FeatureGateCapability is an autogenerated conversion function.
+func Convert_v1beta1_FeatureGateCapability_To_core_FeatureGateCapability(in *FeatureGateCapability, out *core.FeatureGateCapability, s conversion.Scope) error {
+	return autoConvert_v1beta1_FeatureGateCapability_To_core_FeatureGateCapability(in, out, s)
+}
+
+func autoConvert_core_FeatureGateCapability_To_v1beta1_FeatureGateCapability(in *core.FeatureGateCapability, out *FeatureGateCapability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = in.Enabled
+	out.Stage = in.Stage
+	return nil
+}
+
+// Convert_core_FeatureGateCapability_To_v1beta1_FeatureGateCapability is an autogenerated conversion function.
+func Convert_core_FeatureGateCapability_To_v1beta1_FeatureGateCapability(in *core.FeatureGateCapability, out *FeatureGateCapability, s conversion.Scope) error {
+	return autoConvert_core_FeatureGateCapability_To_v1beta1_FeatureGateCapability(in, out, s)
+}
+
+func autoConvert_v1beta1_GardenCapabilities_To_core_GardenCapabilities(in *GardenCapabilities, out *core.GardenCapabilities, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.FeatureGates = *(*[]core.FeatureGateCapability)(unsafe.Pointer(&in.FeatureGates))
+	out.Extensions = *(*[]core.ExtensionCapability)(unsafe.Pointer(&in.Extensions))
+	out.APIResources = *(*[]core.APIResourcesCapability)(unsafe.Pointer(&in.APIResources))
+	out.Seeds = *(*[]core.SeedCapability)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_v1beta1_GardenCapabilities_To_core_GardenCapabilities is an autogenerated conversion function.
+func Convert_v1beta1_GardenCapabilities_To_core_GardenCapabilities(in *GardenCapabilities, out *core.GardenCapabilities, s conversion.Scope) error {
+	return autoConvert_v1beta1_GardenCapabilities_To_core_GardenCapabilities(in, out, s)
+}
+
+func autoConvert_core_GardenCapabilities_To_v1beta1_GardenCapabilities(in *core.GardenCapabilities, out *GardenCapabilities, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.FeatureGates = *(*[]FeatureGateCapability)(unsafe.Pointer(&in.FeatureGates))
+	out.Extensions = *(*[]ExtensionCapability)(unsafe.Pointer(&in.Extensions))
+	out.APIResources = *(*[]APIResourcesCapability)(unsafe.Pointer(&in.APIResources))
+	out.Seeds = *(*[]SeedCapability)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_core_GardenCapabilities_To_v1beta1_GardenCapabilities is an autogenerated conversion function.
+func Convert_core_GardenCapabilities_To_v1beta1_GardenCapabilities(in *core.GardenCapabilities, out *GardenCapabilities, s conversion.Scope) error {
+	return autoConvert_core_GardenCapabilities_To_v1beta1_GardenCapabilities(in, out, s)
+}
+
 func autoConvert_v1beta1_Gardener_To_core_Gardener(in *Gardener, out *core.Gardener, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -3489,6 +4253,8 @@ func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *Hib
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]core.HibernationScheduleException)(unsafe.Pointer(&in.Exceptions))
+	out.HolidayCalendarConfigMapName = (*string)(unsafe.Pointer(in.HolidayCalendarConfigMapName))
 	return nil
 }
 
@@ -3501,6 +4267,8 @@ func autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *cor
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]HibernationScheduleException)(unsafe.Pointer(&in.Exceptions))
+	out.HolidayCalendarConfigMapName = (*string)(unsafe.Pointer(in.HolidayCalendarConfigMapName))
 	return nil
 }
 
@@ -3509,6 +4277,28 @@ func Convert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *core.Hi
 	return autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationScheduleException_To_core_HibernationScheduleException(in *HibernationScheduleException, out *core.HibernationScheduleException, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = (*string)(unsafe.Pointer(in.End))
+	return nil
+}
+
+// Convert_v1beta1_HibernationScheduleException_To_core_HibernationScheduleException is an autogenerated conversion function.
+func Convert_v1beta1_HibernationScheduleException_To_core_HibernationScheduleException(in *HibernationScheduleException, out *core.HibernationScheduleException, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationScheduleException_To_core_HibernationScheduleException(in, out, s)
+}
+
+func autoConvert_core_HibernationScheduleException_To_v1beta1_HibernationScheduleException(in *core.HibernationScheduleException, out *HibernationScheduleException, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = (*string)(unsafe.Pointer(in.End))
+	return nil
+}
+
+// Convert_core_HibernationScheduleException_To_v1beta1_HibernationScheduleException is an autogenerated conversion function.
+func Convert_core_HibernationScheduleException_To_v1beta1_HibernationScheduleException(in *core.HibernationScheduleException, out *HibernationScheduleException, s conversion.Scope) error {
+	return autoConvert_core_HibernationScheduleException_To_v1beta1_HibernationScheduleException(in, out, s)
+}
+
 func autoConvert_v1beta1_HighAvailability_To_core_HighAvailability(in *HighAvailability, out *core.HighAvailability, s conversion.Scope) error {
 	if err := Convert_v1beta1_FailureTolerance_To_core_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
 		return err
@@ -3701,6 +4491,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*core.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.Profile = (*core.KubeAPIServerProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -3737,6 +4528,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.Profile = (*KubeAPIServerProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -3861,6 +4653,7 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.CredentialProviders = *(*[]core.KubeletCredentialProvider)(unsafe.Pointer(&in.CredentialProviders))
 	return nil
 }
 
@@ -3897,6 +4690,7 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.CredentialProviders = *(*[]KubeletCredentialProvider)(unsafe.Pointer(&in.CredentialProviders))
 	return nil
 }
 
@@ -4015,6 +4809,58 @@ func Convert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in *cor
 	return autoConvert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in *KubeletCredentialProvider, out *core.KubeletCredentialProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.DefaultCacheDuration = (*metav1.Duration)(unsafe.Pointer(in.DefaultCacheDuration))
+	out.APIVersion = (*string)(unsafe.Pointer(in.APIVersion))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]core.KubeletCredentialProviderEnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider is an autogenerated conversion function.
+func Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in *KubeletCredentialProvider, out *core.KubeletCredentialProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in, out, s)
+}
+
+func autoConvert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in *core.KubeletCredentialProvider, out *KubeletCredentialProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.DefaultCacheDuration = (*metav1.Duration)(unsafe.Pointer(in.DefaultCacheDuration))
+	out.APIVersion = (*string)(unsafe.Pointer(in.APIVersion))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]KubeletCredentialProviderEnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider is an autogenerated conversion function.
+func Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in *core.KubeletCredentialProvider, out *KubeletCredentialProvider, s conversion.Scope) error {
+	return autoConvert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in, out, s)
+}
+
+func autoConvert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in *KubeletCredentialProviderEnvVar, out *core.KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar is an autogenerated conversion function.
+func Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in *KubeletCredentialProviderEnvVar, out *core.KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in, out, s)
+}
+
+func autoConvert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in *core.KubeletCredentialProviderEnvVar, out *KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar is an autogenerated conversion function.
+func Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in *core.KubeletCredentialProviderEnvVar, out *KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	return autoConvert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in, out, s)
+}
+
 func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core.Kubernetes, s conversion.Scope) error {
 	out.ClusterAutoscaler = (*core.ClusterAutoscaler)(unsafe.Pointer(in.ClusterAutoscaler))
 	if in.KubeAPIServer != nil {
@@ -4041,6 +4887,7 @@ func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*core.VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.PKI = (*core.PKI)(unsafe.Pointer(in.PKI))
 	return nil
 }
 
@@ -4075,6 +4922,7 @@ func autoConvert_core_Kubernetes_To_v1beta1_Kubernetes(in *core.Kubernetes, out
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.PKI = (*PKI)(unsafe.Pointer(in.PKI))
 	return nil
 }
 
@@ -4154,6 +5002,8 @@ func autoConvert_v1beta1_LastError_To_core_LastError(in *LastError, out *core.La
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Component = (*string)(unsafe.Pointer(in.Component))
+	out.Hint = (*string)(unsafe.Pointer(in.Hint))
 	return nil
 }
 
@@ -4167,6 +5017,8 @@ func autoConvert_core_LastError_To_v1beta1_LastError(in *core.LastError, out *La
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Component = (*string)(unsafe.Pointer(in.Component))
+	out.Hint = (*string)(unsafe.Pointer(in.Hint))
 	return nil
 }
 
@@ -4341,6 +5193,32 @@ func Convert_core_MachineImage_To_v1beta1_MachineImage(in *core.MachineImage, ou
 	return autoConvert_core_MachineImage_To_v1beta1_MachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageUpdatePreview_To_core_MachineImageUpdatePreview(in *MachineImageUpdatePreview, out *core.MachineImageUpdatePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview(&in.VersionUpdatePreview, &out.VersionUpdatePreview, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineImageUpdatePreview_To_core_MachineImageUpdatePreview is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageUpdatePreview_To_core_MachineImageUpdatePreview(in *MachineImageUpdatePreview, out *core.MachineImageUpdatePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageUpdatePreview_To_core_MachineImageUpdatePreview(in, out, s)
+}
+
+func autoConvert_core_MachineImageUpdatePreview_To_v1beta1_MachineImageUpdatePreview(in *core.MachineImageUpdatePreview, out *MachineImageUpdatePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview(&in.VersionUpdatePreview, &out.VersionUpdatePreview, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MachineImageUpdatePreview_To_v1beta1_MachineImageUpdatePreview is an autogenerated conversion function.
+func Convert_core_MachineImageUpdatePreview_To_v1beta1_MachineImageUpdatePreview(in *core.MachineImageUpdatePreview, out *MachineImageUpdatePreview, s conversion.Scope) error {
+	return autoConvert_core_MachineImageUpdatePreview_To_v1beta1_MachineImageUpdatePreview(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *MachineImageVersion, out *core.MachineImageVersion, s conversion.Scope) error {
 	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
 		return err
@@ -4475,6 +5353,62 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenancePendingChanges_To_core_MaintenancePendingChanges(in *MaintenancePendingChanges, out *core.MaintenancePendingChanges, s conversion.Scope) error {
+	out.ComputedTime = in.ComputedTime
+	if err := Convert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(&in.NextMaintenanceTimeWindow, &out.NextMaintenanceTimeWindow, s); err != nil {
+		return err
+	}
+	out.KubernetesVersion = (*core.VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]core.WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.Operation = (*string)(unsafe.Pointer(in.Operation))
+	out.Changes = *(*[]string)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePendingChanges_To_core_MaintenancePendingChanges is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePendingChanges_To_core_MaintenancePendingChanges(in *MaintenancePendingChanges, out *core.MaintenancePendingChanges, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePendingChanges_To_core_MaintenancePendingChanges(in, out, s)
+}
+
+func autoConvert_core_MaintenancePendingChanges_To_v1beta1_MaintenancePendingChanges(in *core.MaintenancePendingChanges, out *MaintenancePendingChanges, s conversion.Scope) error {
+	out.ComputedTime = in.ComputedTime
+	if err := Convert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(&in.NextMaintenanceTimeWindow, &out.NextMaintenanceTimeWindow, s); err != nil {
+		return err
+	}
+	out.KubernetesVersion = (*VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.Operation = (*string)(unsafe.Pointer(in.Operation))
+	out.Changes = *(*[]string)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_core_MaintenancePendingChanges_To_v1beta1_MaintenancePendingChanges is an autogenerated conversion function.
+func Convert_core_MaintenancePendingChanges_To_v1beta1_MaintenancePendingChanges(in *core.MaintenancePendingChanges, out *MaintenancePendingChanges, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePendingChanges_To_v1beta1_MaintenancePendingChanges(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(in *MaintenancePreviewTimeWindow, out *core.MaintenancePreviewTimeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(in *MaintenancePreviewTimeWindow, out *core.MaintenancePreviewTimeWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(in *core.MaintenancePreviewTimeWindow, out *MaintenancePreviewTimeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow is an autogenerated conversion function.
+func Convert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(in *core.MaintenancePreviewTimeWindow, out *MaintenancePreviewTimeWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4685,104 +5619,326 @@ func autoConvert_v1beta1_Networking_To_core_Networking(in *Networking, out *core
 	return nil
 }
 
-// Convert_v1beta1_Networking_To_core_Networking is an autogenerated conversion function.
-func Convert_v1beta1_Networking_To_core_Networking(in *Networking, out *core.Networking, s conversion.Scope) error {
-	return autoConvert_v1beta1_Networking_To_core_Networking(in, out, s)
+// Convert_v1beta1_Networking_To_core_Networking is an autogenerated conversion function.
+func Convert_v1beta1_Networking_To_core_Networking(in *Networking, out *core.Networking, s conversion.Scope) error {
+	return autoConvert_v1beta1_Networking_To_core_Networking(in, out, s)
+}
+
+func autoConvert_core_Networking_To_v1beta1_Networking(in *core.Networking, out *Networking, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
+	out.Services = (*string)(unsafe.Pointer(in.Services))
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	return nil
+}
+
+// Convert_core_Networking_To_v1beta1_Networking is an autogenerated conversion function.
+func Convert_core_Networking_To_v1beta1_Networking(in *core.Networking, out *Networking, s conversion.Scope) error {
+	return autoConvert_core_Networking_To_v1beta1_Networking(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
+	out.Pods = *(*[]string)(unsafe.Pointer(&in.Pods))
+	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
+	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
+	out.DualStackMigrationInitiationTime = (*metav1.Time)(unsafe.Pointer(in.DualStackMigrationInitiationTime))
+	return nil
+}
+
+// Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus is an autogenerated conversion function.
+func Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in, out, s)
+}
+
+func autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
+	out.Pods = *(*[]string)(unsafe.Pointer(&in.Pods))
+	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
+	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
+	out.DualStackMigrationInitiationTime = (*metav1.Time)(unsafe.Pointer(in.DualStackMigrationInitiationTime))
+	return nil
+}
+
+// Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus is an autogenerated conversion function.
+func Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
+	return autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out *core.NginxIngress, s conversion.Scope) error {
+	if err := Convert_v1beta1_Addon_To_core_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	return nil
+}
+
+// Convert_v1beta1_NginxIngress_To_core_NginxIngress is an autogenerated conversion function.
+func Convert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out *core.NginxIngress, s conversion.Scope) error {
+	return autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in, out, s)
+}
+
+func autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress, out *NginxIngress, s conversion.Scope) error {
+	if err := Convert_core_Addon_To_v1beta1_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+	return nil
+}
+
+// Convert_core_NginxIngress_To_v1beta1_NginxIngress is an autogenerated conversion function.
+func Convert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress, out *NginxIngress, s conversion.Scope) error {
+	return autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out *core.NodeLocalDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
+	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
+	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	return nil
+}
+
+// Convert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS is an autogenerated conversion function.
+func Convert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out *core.NodeLocalDNS, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in, out, s)
+}
+
+func autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, out *NodeLocalDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
+	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
+	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	return nil
+}
+
+// Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS is an autogenerated conversion function.
+func Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, out *NodeLocalDNS, s conversion.Scope) error {
+	return autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationDelivery_To_core_NotificationDelivery(in *NotificationDelivery, out *core.NotificationDelivery, s conversion.Scope) error {
+	out.Shoot = in.Shoot
+	out.EventType = core.NotificationEventType(in.EventType)
+	out.Time = in.Time
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_NotificationDelivery_To_core_NotificationDelivery is an autogenerated conversion function.
+func Convert_v1beta1_NotificationDelivery_To_core_NotificationDelivery(in *NotificationDelivery, out *core.NotificationDelivery, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationDelivery_To_core_NotificationDelivery(in, out, s)
+}
+
+func autoConvert_core_NotificationDelivery_To_v1beta1_NotificationDelivery(in *core.NotificationDelivery, out *NotificationDelivery, s conversion.Scope) error {
+	out.Shoot = in.Shoot
+	out.EventType = NotificationEventType(in.EventType)
+	out.Time = in.Time
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_NotificationDelivery_To_v1beta1_NotificationDelivery is an autogenerated conversion function.
+func Convert_core_NotificationDelivery_To_v1beta1_NotificationDelivery(in *core.NotificationDelivery, out *NotificationDelivery, s conversion.Scope) error {
+	return autoConvert_core_NotificationDelivery_To_v1beta1_NotificationDelivery(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationFilter_To_core_NotificationFilter(in *NotificationFilter, out *core.NotificationFilter, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.Purposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.EventTypes = *(*[]core.NotificationEventType)(unsafe.Pointer(&in.EventTypes))
+	return nil
+}
+
+// Convert_v1beta1_NotificationFilter_To_core_NotificationFilter is an autogenerated conversion function.
+func Convert_v1beta1_NotificationFilter_To_core_NotificationFilter(in *NotificationFilter, out *core.NotificationFilter, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationFilter_To_core_NotificationFilter(in, out, s)
+}
+
+func autoConvert_core_NotificationFilter_To_v1beta1_NotificationFilter(in *core.NotificationFilter, out *NotificationFilter, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.Purposes = *(*[]ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.EventTypes = *(*[]NotificationEventType)(unsafe.Pointer(&in.EventTypes))
+	return nil
+}
+
+// Convert_core_NotificationFilter_To_v1beta1_NotificationFilter is an autogenerated conversion function.
+func Convert_core_NotificationFilter_To_v1beta1_NotificationFilter(in *core.NotificationFilter, out *NotificationFilter, s conversion.Scope) error {
+	return autoConvert_core_NotificationFilter_To_v1beta1_NotificationFilter(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSink_To_core_NotificationSink(in *NotificationSink, out *core.NotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NotificationSink_To_core_NotificationSink is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSink_To_core_NotificationSink(in *NotificationSink, out *core.NotificationSink, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSink_To_core_NotificationSink(in, out, s)
+}
+
+func autoConvert_core_NotificationSink_To_v1beta1_NotificationSink(in *core.NotificationSink, out *NotificationSink, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NotificationSink_To_v1beta1_NotificationSink is an autogenerated conversion function.
+func Convert_core_NotificationSink_To_v1beta1_NotificationSink(in *core.NotificationSink, out *NotificationSink, s conversion.Scope) error {
+	return autoConvert_core_NotificationSink_To_v1beta1_NotificationSink(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSinkEmail_To_core_NotificationSinkEmail(in *NotificationSinkEmail, out *core.NotificationSinkEmail, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	out.From = in.From
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_v1beta1_NotificationSinkEmail_To_core_NotificationSinkEmail is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkEmail_To_core_NotificationSinkEmail(in *NotificationSinkEmail, out *core.NotificationSinkEmail, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkEmail_To_core_NotificationSinkEmail(in, out, s)
+}
+
+func autoConvert_core_NotificationSinkEmail_To_v1beta1_NotificationSinkEmail(in *core.NotificationSinkEmail, out *NotificationSinkEmail, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	out.From = in.From
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_core_NotificationSinkEmail_To_v1beta1_NotificationSinkEmail is an autogenerated conversion function.
+func Convert_core_NotificationSinkEmail_To_v1beta1_NotificationSinkEmail(in *core.NotificationSinkEmail, out *NotificationSinkEmail, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkEmail_To_v1beta1_NotificationSinkEmail(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSinkList_To_core_NotificationSinkList(in *NotificationSinkList, out *core.NotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.NotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_NotificationSinkList_To_core_NotificationSinkList is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkList_To_core_NotificationSinkList(in *NotificationSinkList, out *core.NotificationSinkList, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkList_To_core_NotificationSinkList(in, out, s)
+}
+
+func autoConvert_core_NotificationSinkList_To_v1beta1_NotificationSinkList(in *core.NotificationSinkList, out *NotificationSinkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NotificationSink)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_NotificationSinkList_To_v1beta1_NotificationSinkList is an autogenerated conversion function.
+func Convert_core_NotificationSinkList_To_v1beta1_NotificationSinkList(in *core.NotificationSinkList, out *NotificationSinkList, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkList_To_v1beta1_NotificationSinkList(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationSinkSlack_To_core_NotificationSinkSlack(in *NotificationSinkSlack, out *core.NotificationSinkSlack, s conversion.Scope) error {
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_v1beta1_NotificationSinkSlack_To_core_NotificationSinkSlack is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkSlack_To_core_NotificationSinkSlack(in *NotificationSinkSlack, out *core.NotificationSinkSlack, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkSlack_To_core_NotificationSinkSlack(in, out, s)
 }
 
-func autoConvert_core_Networking_To_v1beta1_Networking(in *core.Networking, out *Networking, s conversion.Scope) error {
-	out.Type = (*string)(unsafe.Pointer(in.Type))
-	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	out.Pods = (*string)(unsafe.Pointer(in.Pods))
-	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
-	out.Services = (*string)(unsafe.Pointer(in.Services))
-	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+func autoConvert_core_NotificationSinkSlack_To_v1beta1_NotificationSinkSlack(in *core.NotificationSinkSlack, out *NotificationSinkSlack, s conversion.Scope) error {
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
 	return nil
 }
 
-// Convert_core_Networking_To_v1beta1_Networking is an autogenerated conversion function.
-func Convert_core_Networking_To_v1beta1_Networking(in *core.Networking, out *Networking, s conversion.Scope) error {
-	return autoConvert_core_Networking_To_v1beta1_Networking(in, out, s)
+// Convert_core_NotificationSinkSlack_To_v1beta1_NotificationSinkSlack is an autogenerated conversion function.
+func Convert_core_NotificationSinkSlack_To_v1beta1_NotificationSinkSlack(in *core.NotificationSinkSlack, out *NotificationSinkSlack, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkSlack_To_v1beta1_NotificationSinkSlack(in, out, s)
 }
 
-func autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
-	out.Pods = *(*[]string)(unsafe.Pointer(&in.Pods))
-	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
-	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
+func autoConvert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec(in *NotificationSinkSpec, out *core.NotificationSinkSpec, s conversion.Scope) error {
+	out.Webhook = (*core.NotificationSinkWebhook)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*core.NotificationSinkSlack)(unsafe.Pointer(in.Slack))
+	out.Email = (*core.NotificationSinkEmail)(unsafe.Pointer(in.Email))
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.Filter = (*core.NotificationFilter)(unsafe.Pointer(in.Filter))
 	return nil
 }
 
-// Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus is an autogenerated conversion function.
-func Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in, out, s)
+// Convert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec(in *NotificationSinkSpec, out *core.NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkSpec_To_core_NotificationSinkSpec(in, out, s)
 }
 
-func autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
-	out.Pods = *(*[]string)(unsafe.Pointer(&in.Pods))
-	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
-	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
+func autoConvert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in *core.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	out.Webhook = (*NotificationSinkWebhook)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*NotificationSinkSlack)(unsafe.Pointer(in.Slack))
+	out.Email = (*NotificationSinkEmail)(unsafe.Pointer(in.Email))
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.Filter = (*NotificationFilter)(unsafe.Pointer(in.Filter))
 	return nil
 }
 
-// Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus is an autogenerated conversion function.
-func Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
-	return autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in, out, s)
+// Convert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in *core.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkSpec_To_v1beta1_NotificationSinkSpec(in, out, s)
 }
 
-func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out *core.NginxIngress, s conversion.Scope) error {
-	if err := Convert_v1beta1_Addon_To_core_Addon(&in.Addon, &out.Addon, s); err != nil {
-		return err
-	}
-	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
-	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+func autoConvert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus(in *NotificationSinkStatus, out *core.NotificationSinkStatus, s conversion.Scope) error {
+	out.LastDelivery = (*core.NotificationDelivery)(unsafe.Pointer(in.LastDelivery))
+	out.LastFailure = (*core.NotificationDelivery)(unsafe.Pointer(in.LastFailure))
 	return nil
 }
 
-// Convert_v1beta1_NginxIngress_To_core_NginxIngress is an autogenerated conversion function.
-func Convert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out *core.NginxIngress, s conversion.Scope) error {
-	return autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in, out, s)
+// Convert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus(in *NotificationSinkStatus, out *core.NotificationSinkStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkStatus_To_core_NotificationSinkStatus(in, out, s)
 }
 
-func autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress, out *NginxIngress, s conversion.Scope) error {
-	if err := Convert_core_Addon_To_v1beta1_Addon(&in.Addon, &out.Addon, s); err != nil {
-		return err
-	}
-	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
-	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
-	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
+func autoConvert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus(in *core.NotificationSinkStatus, out *NotificationSinkStatus, s conversion.Scope) error {
+	out.LastDelivery = (*NotificationDelivery)(unsafe.Pointer(in.LastDelivery))
+	out.LastFailure = (*NotificationDelivery)(unsafe.Pointer(in.LastFailure))
 	return nil
 }
 
-// Convert_core_NginxIngress_To_v1beta1_NginxIngress is an autogenerated conversion function.
-func Convert_core_NginxIngress_To_v1beta1_NginxIngress(in *core.NginxIngress, out *NginxIngress, s conversion.Scope) error {
-	return autoConvert_core_NginxIngress_To_v1beta1_NginxIngress(in, out, s)
+// Convert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus is an autogenerated conversion function.
+func Convert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus(in *core.NotificationSinkStatus, out *NotificationSinkStatus, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkStatus_To_v1beta1_NotificationSinkStatus(in, out, s)
 }
 
-func autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out *core.NodeLocalDNS, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
-	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
-	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+func autoConvert_v1beta1_NotificationSinkWebhook_To_core_NotificationSinkWebhook(in *NotificationSinkWebhook, out *core.NotificationSinkWebhook, s conversion.Scope) error {
+	out.URL = in.URL
 	return nil
 }
 
-// Convert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS is an autogenerated conversion function.
-func Convert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out *core.NodeLocalDNS, s conversion.Scope) error {
-	return autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in, out, s)
+// Convert_v1beta1_NotificationSinkWebhook_To_core_NotificationSinkWebhook is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSinkWebhook_To_core_NotificationSinkWebhook(in *NotificationSinkWebhook, out *core.NotificationSinkWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSinkWebhook_To_core_NotificationSinkWebhook(in, out, s)
 }
 
-func autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, out *NodeLocalDNS, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
-	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
-	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+func autoConvert_core_NotificationSinkWebhook_To_v1beta1_NotificationSinkWebhook(in *core.NotificationSinkWebhook, out *NotificationSinkWebhook, s conversion.Scope) error {
+	out.URL = in.URL
 	return nil
 }
 
-// Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS is an autogenerated conversion function.
-func Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, out *NodeLocalDNS, s conversion.Scope) error {
-	return autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in, out, s)
+// Convert_core_NotificationSinkWebhook_To_v1beta1_NotificationSinkWebhook is an autogenerated conversion function.
+func Convert_core_NotificationSinkWebhook_To_v1beta1_NotificationSinkWebhook(in *core.NotificationSinkWebhook, out *NotificationSinkWebhook, s conversion.Scope) error {
+	return autoConvert_core_NotificationSinkWebhook_To_v1beta1_NotificationSinkWebhook(in, out, s)
 }
 
 func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *core.OCIRepository, s conversion.Scope) error {
@@ -4893,6 +6049,32 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PKI_To_core_PKI(in *PKI, out *core.PKI, s conversion.Scope) error {
+	out.KeyAlgorithm = (*core.KeyAlgorithm)(unsafe.Pointer(in.KeyAlgorithm))
+	out.KeySize = (*int32)(unsafe.Pointer(in.KeySize))
+	out.CertificateAuthorityValidity = (*metav1.Duration)(unsafe.Pointer(in.CertificateAuthorityValidity))
+	out.CertificateValidity = (*metav1.Duration)(unsafe.Pointer(in.CertificateValidity))
+	return nil
+}
+
+// Convert_v1beta1_PKI_To_core_PKI is an autogenerated conversion function.
+func Convert_v1beta1_PKI_To_core_PKI(in *PKI, out *core.PKI, s conversion.Scope) error {
+	return autoConvert_v1beta1_PKI_To_core_PKI(in, out, s)
+}
+
+func autoConvert_core_PKI_To_v1beta1_PKI(in *core.PKI, out *PKI, s conversion.Scope) error {
+	out.KeyAlgorithm = (*KeyAlgorithm)(unsafe.Pointer(in.KeyAlgorithm))
+	out.KeySize = (*int32)(unsafe.Pointer(in.KeySize))
+	out.CertificateAuthorityValidity = (*metav1.Duration)(unsafe.Pointer(in.CertificateAuthorityValidity))
+	out.CertificateValidity = (*metav1.Duration)(unsafe.Pointer(in.CertificateValidity))
+	return nil
+}
+
+// Convert_core_PKI_To_v1beta1_PKI is an autogenerated conversion function.
+func Convert_core_PKI_To_v1beta1_PKI(in *core.PKI, out *PKI, s conversion.Scope) error {
+	return autoConvert_core_PKI_To_v1beta1_PKI(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4980,6 +6162,32 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
+func autoConvert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in *ProjectShootDefaults, out *core.ProjectShootDefaults, s conversion.Scope) error {
+	out.Purpose = (*core.ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.MaintenanceTimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.MaintenanceTimeWindow))
+	out.HibernationSchedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.HibernationSchedules))
+	out.NetworkingType = (*string)(unsafe.Pointer(in.NetworkingType))
+	return nil
+}
+
+// Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults is an autogenerated conversion function.
+func Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in *ProjectShootDefaults, out *core.ProjectShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(in, out, s)
+}
+
+func autoConvert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in *core.ProjectShootDefaults, out *ProjectShootDefaults, s conversion.Scope) error {
+	out.Purpose = (*ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.MaintenanceTimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.MaintenanceTimeWindow))
+	out.HibernationSchedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.HibernationSchedules))
+	out.NetworkingType = (*string)(unsafe.Pointer(in.NetworkingType))
+	return nil
+}
+
+// Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults is an autogenerated conversion function.
+func Convert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in *core.ProjectShootDefaults, out *ProjectShootDefaults, s conversion.Scope) error {
+	return autoConvert_core_ProjectShootDefaults_To_v1beta1_ProjectShootDefaults(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -4999,6 +6207,7 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.ShootDefaults = (*core.ProjectShootDefaults)(unsafe.Pointer(in.ShootDefaults))
 	return nil
 }
 
@@ -5021,6 +6230,7 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.ShootDefaults = (*ProjectShootDefaults)(unsafe.Pointer(in.ShootDefaults))
 	return nil
 }
 
@@ -5030,6 +6240,7 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.ControlPlaneResourceUsage = (*core.ControlPlaneResourceUsage)(unsafe.Pointer(in.ControlPlaneResourceUsage))
 	return nil
 }
 
@@ -5044,6 +6255,7 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.ControlPlaneResourceUsage = (*ControlPlaneResourceUsage)(unsafe.Pointer(in.ControlPlaneResourceUsage))
 	return nil
 }
 
@@ -5264,6 +6476,26 @@ func Convert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in *c
 	return autoConvert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in, out, s)
 }
 
+func autoConvert_v1beta1_RuntimeSecurity_To_core_RuntimeSecurity(in *RuntimeSecurity, out *core.RuntimeSecurity, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_RuntimeSecurity_To_core_RuntimeSecurity is an autogenerated conversion function.
+func Convert_v1beta1_RuntimeSecurity_To_core_RuntimeSecurity(in *RuntimeSecurity, out *core.RuntimeSecurity, s conversion.Scope) error {
+	return autoConvert_v1beta1_RuntimeSecurity_To_core_RuntimeSecurity(in, out, s)
+}
+
+func autoConvert_core_RuntimeSecurity_To_v1beta1_RuntimeSecurity(in *core.RuntimeSecurity, out *RuntimeSecurity, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_RuntimeSecurity_To_v1beta1_RuntimeSecurity is an autogenerated conversion function.
+func Convert_core_RuntimeSecurity_To_v1beta1_RuntimeSecurity(in *core.RuntimeSecurity, out *RuntimeSecurity, s conversion.Scope) error {
+	return autoConvert_core_RuntimeSecurity_To_v1beta1_RuntimeSecurity(in, out, s)
+}
+
 func autoConvert_v1beta1_SSHAccess_To_core_SSHAccess(in *SSHAccess, out *core.SSHAccess, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -5410,6 +6642,66 @@ func Convert_core_SeedBackup_To_v1beta1_SeedBackup(in *core.SeedBackup, out *See
 	return autoConvert_core_SeedBackup_To_v1beta1_SeedBackup(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedCapability_To_core_SeedCapability(in *SeedCapability, out *core.SeedCapability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderType = in.ProviderType
+	out.Region = in.Region
+	out.GardenletVersion = (*string)(unsafe.Pointer(in.GardenletVersion))
+	return nil
+}
+
+// Convert_v1beta1_SeedCapability_To_core_SeedCapability is an autogenerated conversion function.
+func Convert_v1beta1_SeedCapability_To_core_SeedCapability(in *SeedCapability, out *core.SeedCapability, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCapability_To_core_SeedCapability(in, out, s)
+}
+
+func autoConvert_core_SeedCapability_To_v1beta1_SeedCapability(in *core.SeedCapability, out *SeedCapability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderType = in.ProviderType
+	out.Region = in.Region
+	out.GardenletVersion = (*string)(unsafe.Pointer(in.GardenletVersion))
+	return nil
+}
+
+// Convert_core_SeedCapability_To_v1beta1_SeedCapability is an autogenerated conversion function.
+func Convert_core_SeedCapability_To_v1beta1_SeedCapability(in *core.SeedCapability, out *SeedCapability, s conversion.Scope) error {
+	return autoConvert_core_SeedCapability_To_v1beta1_SeedCapability(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedCapacityReport_To_core_SeedCapacityReport(in *SeedCapacityReport, out *core.SeedCapacityReport, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.ControlPlanes = in.ControlPlanes
+	out.ControlPlanePods = in.ControlPlanePods
+	out.CPU = (*core.SeedResourceUtilization)(unsafe.Pointer(in.CPU))
+	out.Memory = (*core.SeedResourceUtilization)(unsafe.Pointer(in.Memory))
+	out.ETCDStorage = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorage))
+	out.NodePodCIDRs = (*core.SeedPoolUtilization)(unsafe.Pointer(in.NodePodCIDRs))
+	out.LoadBalancers = (*core.SeedPoolUtilization)(unsafe.Pointer(in.LoadBalancers))
+	return nil
+}
+
+// Convert_v1beta1_SeedCapacityReport_To_core_SeedCapacityReport is an autogenerated conversion function.
+func Convert_v1beta1_SeedCapacityReport_To_core_SeedCapacityReport(in *SeedCapacityReport, out *core.SeedCapacityReport, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCapacityReport_To_core_SeedCapacityReport(in, out, s)
+}
+
+func autoConvert_core_SeedCapacityReport_To_v1beta1_SeedCapacityReport(in *core.SeedCapacityReport, out *SeedCapacityReport, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.ControlPlanes = in.ControlPlanes
+	out.ControlPlanePods = in.ControlPlanePods
+	out.CPU = (*SeedResourceUtilization)(unsafe.Pointer(in.CPU))
+	out.Memory = (*SeedResourceUtilization)(unsafe.Pointer(in.Memory))
+	out.ETCDStorage = (*resource.Quantity)(unsafe.Pointer(in.ETCDStorage))
+	out.NodePodCIDRs = (*SeedPoolUtilization)(unsafe.Pointer(in.NodePodCIDRs))
+	out.LoadBalancers = (*SeedPoolUtilization)(unsafe.Pointer(in.LoadBalancers))
+	return nil
+}
+
+// Convert_core_SeedCapacityReport_To_v1beta1_SeedCapacityReport is an autogenerated conversion function.
+func Convert_core_SeedCapacityReport_To_v1beta1_SeedCapacityReport(in *core.SeedCapacityReport, out *SeedCapacityReport, s conversion.Scope) error {
+	return autoConvert_core_SeedCapacityReport_To_v1beta1_SeedCapacityReport(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedDNS_To_core_SeedDNS(in *SeedDNS, out *core.SeedDNS, s conversion.Scope) error {
 	out.Provider = (*core.SeedDNSProvider)(unsafe.Pointer(in.Provider))
 	return nil
@@ -5524,6 +6816,28 @@ func Convert_core_SeedNetworks_To_v1beta1_SeedNetworks(in *core.SeedNetworks, ou
 	return autoConvert_core_SeedNetworks_To_v1beta1_SeedNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedPoolUtilization_To_core_SeedPoolUtilization(in *SeedPoolUtilization, out *core.SeedPoolUtilization, s conversion.Scope) error {
+	out.Capacity = (*int32)(unsafe.Pointer(in.Capacity))
+	out.Allocated = in.Allocated
+	return nil
+}
+
+// Convert_v1beta1_SeedPoolUtilization_To_core_SeedPoolUtilization is an autogenerated conversion function.
+func Convert_v1beta1_SeedPoolUtilization_To_core_SeedPoolUtilization(in *SeedPoolUtilization, out *core.SeedPoolUtilization, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedPoolUtilization_To_core_SeedPoolUtilization(in, out, s)
+}
+
+func autoConvert_core_SeedPoolUtilization_To_v1beta1_SeedPoolUtilization(in *core.SeedPoolUtilization, out *SeedPoolUtilization, s conversion.Scope) error {
+	out.Capacity = (*int32)(unsafe.Pointer(in.Capacity))
+	out.Allocated = in.Allocated
+	return nil
+}
+
+// Convert_core_SeedPoolUtilization_To_v1beta1_SeedPoolUtilization is an autogenerated conversion function.
+func Convert_core_SeedPoolUtilization_To_v1beta1_SeedPoolUtilization(in *core.SeedPoolUtilization, out *SeedPoolUtilization, s conversion.Scope) error {
+	return autoConvert_core_SeedPoolUtilization_To_v1beta1_SeedPoolUtilization(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedProvider_To_core_SeedProvider(in *SeedProvider, out *core.SeedProvider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
@@ -5550,6 +6864,30 @@ func Convert_core_SeedProvider_To_v1beta1_SeedProvider(in *core.SeedProvider, ou
 	return autoConvert_core_SeedProvider_To_v1beta1_SeedProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedResourceUtilization_To_core_SeedResourceUtilization(in *SeedResourceUtilization, out *core.SeedResourceUtilization, s conversion.Scope) error {
+	out.Allocatable = in.Allocatable
+	out.Requested = in.Requested
+	out.Headroom = in.Headroom
+	return nil
+}
+
+// Convert_v1beta1_SeedResourceUtilization_To_core_SeedResourceUtilization is an autogenerated conversion function.
+func Convert_v1beta1_SeedResourceUtilization_To_core_SeedResourceUtilization(in *SeedResourceUtilization, out *core.SeedResourceUtilization, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedResourceUtilization_To_core_SeedResourceUtilization(in, out, s)
+}
+
+func autoConvert_core_SeedResourceUtilization_To_v1beta1_SeedResourceUtilization(in *core.SeedResourceUtilization, out *SeedResourceUtilization, s conversion.Scope) error {
+	out.Allocatable = in.Allocatable
+	out.Requested = in.Requested
+	out.Headroom = in.Headroom
+	return nil
+}
+
+// Convert_core_SeedResourceUtilization_To_v1beta1_SeedResourceUtilization is an autogenerated conversion function.
+func Convert_core_SeedResourceUtilization_To_v1beta1_SeedResourceUtilization(in *core.SeedResourceUtilization, out *SeedResourceUtilization, s conversion.Scope) error {
+	return autoConvert_core_SeedResourceUtilization_To_v1beta1_SeedResourceUtilization(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSelector_To_core_SeedSelector(in *SeedSelector, out *core.SeedSelector, s conversion.Scope) error {
 	out.LabelSelector = in.LabelSelector
 	out.ProviderTypes = *(*[]string)(unsafe.Pointer(&in.ProviderTypes))
@@ -5572,6 +6910,26 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingAuditPolicy_To_core_SeedSettingAuditPolicy(in *SeedSettingAuditPolicy, out *core.SeedSettingAuditPolicy, s conversion.Scope) error {
+	out.ClusterAuditPolicyName = in.ClusterAuditPolicyName
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingAuditPolicy_To_core_SeedSettingAuditPolicy is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingAuditPolicy_To_core_SeedSettingAuditPolicy(in *SeedSettingAuditPolicy, out *core.SeedSettingAuditPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingAuditPolicy_To_core_SeedSettingAuditPolicy(in, out, s)
+}
+
+func autoConvert_core_SeedSettingAuditPolicy_To_v1beta1_SeedSettingAuditPolicy(in *core.SeedSettingAuditPolicy, out *SeedSettingAuditPolicy, s conversion.Scope) error {
+	out.ClusterAuditPolicyName = in.ClusterAuditPolicyName
+	return nil
+}
+
+// Convert_core_SeedSettingAuditPolicy_To_v1beta1_SeedSettingAuditPolicy is an autogenerated conversion function.
+func Convert_core_SeedSettingAuditPolicy_To_v1beta1_SeedSettingAuditPolicy(in *core.SeedSettingAuditPolicy, out *SeedSettingAuditPolicy, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingAuditPolicy_To_v1beta1_SeedSettingAuditPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -5799,6 +7157,7 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.VerticalPodAutoscaler = (*core.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.AuditPolicy = (*core.SeedSettingAuditPolicy)(unsafe.Pointer(in.AuditPolicy))
 	return nil
 }
 
@@ -5814,6 +7173,7 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.AuditPolicy = (*SeedSettingAuditPolicy)(unsafe.Pointer(in.AuditPolicy))
 	return nil
 }
 
@@ -5878,6 +7238,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.CapacityReport = (*core.SeedCapacityReport)(unsafe.Pointer(in.CapacityReport))
 	return nil
 }
 
@@ -5896,6 +7257,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.CapacityReport = (*SeedCapacityReport)(unsafe.Pointer(in.CapacityReport))
 	return nil
 }
 
@@ -6154,6 +7516,28 @@ func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(i
 	return autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootDNSStatus_To_core_ShootDNSStatus(in *ShootDNSStatus, out *core.ShootDNSStatus, s conversion.Scope) error {
+	out.ActiveProvider = core.DNSProviderRole(in.ActiveProvider)
+	out.LastFailoverTime = (*metav1.Time)(unsafe.Pointer(in.LastFailoverTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootDNSStatus_To_core_ShootDNSStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootDNSStatus_To_core_ShootDNSStatus(in *ShootDNSStatus, out *core.ShootDNSStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootDNSStatus_To_core_ShootDNSStatus(in, out, s)
+}
+
+func autoConvert_core_ShootDNSStatus_To_v1beta1_ShootDNSStatus(in *core.ShootDNSStatus, out *ShootDNSStatus, s conversion.Scope) error {
+	out.ActiveProvider = DNSProviderRole(in.ActiveProvider)
+	out.LastFailoverTime = (*metav1.Time)(unsafe.Pointer(in.LastFailoverTime))
+	return nil
+}
+
+// Convert_core_ShootDNSStatus_To_v1beta1_ShootDNSStatus is an autogenerated conversion function.
+func Convert_core_ShootDNSStatus_To_v1beta1_ShootDNSStatus(in *core.ShootDNSStatus, out *ShootDNSStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootDNSStatus_To_v1beta1_ShootDNSStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -6246,6 +7630,60 @@ func Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootM
 	return autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootMaintenancePreview_To_core_ShootMaintenancePreview(in *ShootMaintenancePreview, out *core.ShootMaintenancePreview, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MaintenancePreviewTimeWindow_To_core_MaintenancePreviewTimeWindow(&in.NextMaintenanceTimeWindow, &out.NextMaintenanceTimeWindow, s); err != nil {
+		return err
+	}
+	out.KubernetesVersion = (*core.VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]core.WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.Operation = (*string)(unsafe.Pointer(in.Operation))
+	out.Changes = *(*[]string)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1beta1_ShootMaintenancePreview_To_core_ShootMaintenancePreview is an autogenerated conversion function.
+func Convert_v1beta1_ShootMaintenancePreview_To_core_ShootMaintenancePreview(in *ShootMaintenancePreview, out *core.ShootMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMaintenancePreview_To_core_ShootMaintenancePreview(in, out, s)
+}
+
+func autoConvert_core_ShootMaintenancePreview_To_v1beta1_ShootMaintenancePreview(in *core.ShootMaintenancePreview, out *ShootMaintenancePreview, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_MaintenancePreviewTimeWindow_To_v1beta1_MaintenancePreviewTimeWindow(&in.NextMaintenanceTimeWindow, &out.NextMaintenanceTimeWindow, s); err != nil {
+		return err
+	}
+	out.KubernetesVersion = (*VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.Operation = (*string)(unsafe.Pointer(in.Operation))
+	out.Changes = *(*[]string)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_core_ShootMaintenancePreview_To_v1beta1_ShootMaintenancePreview is an autogenerated conversion function.
+func Convert_core_ShootMaintenancePreview_To_v1beta1_ShootMaintenancePreview(in *core.ShootMaintenancePreview, out *ShootMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_core_ShootMaintenancePreview_To_v1beta1_ShootMaintenancePreview(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *core.ShootMaintenanceStatus, s conversion.Scope) error {
+	out.PendingChanges = (*core.MaintenancePendingChanges)(unsafe.Pointer(in.PendingChanges))
+	return nil
+}
+
+// Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *core.ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *core.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	out.PendingChanges = (*MaintenancePendingChanges)(unsafe.Pointer(in.PendingChanges))
+	return nil
+}
+
+// Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *core.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootNetworks_To_core_ShootNetworks(in *ShootNetworks, out *core.ShootNetworks, s conversion.Scope) error {
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
@@ -6318,6 +7756,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
+	out.Paused = (*bool)(unsafe.Pointer(in.Paused))
 	return nil
 }
 
@@ -6354,6 +7793,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
+	out.Paused = (*bool)(unsafe.Pointer(in.Paused))
 	return nil
 }
 
@@ -6456,6 +7896,11 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.PausedSince = (*metav1.Time)(unsafe.Pointer(in.PausedSince))
+	out.DNS = (*core.ShootDNSStatus)(unsafe.Pointer(in.DNS))
+	out.Maintenance = (*core.ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.ControlPlaneResourceUsage = (*core.ControlPlaneResourceUsage)(unsafe.Pointer(in.ControlPlaneResourceUsage))
+	out.ConformanceTest = (*core.ConformanceTestReport)(unsafe.Pointer(in.ConformanceTest))
 	return nil
 }
 
@@ -6486,6 +7931,11 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.PausedSince = (*metav1.Time)(unsafe.Pointer(in.PausedSince))
+	out.DNS = (*ShootDNSStatus)(unsafe.Pointer(in.DNS))
+	out.Maintenance = (*ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.ControlPlaneResourceUsage = (*ControlPlaneResourceUsage)(unsafe.Pointer(in.ControlPlaneResourceUsage))
+	out.ConformanceTest = (*ConformanceTestReport)(unsafe.Pointer(in.ConformanceTest))
 	return nil
 }
 
@@ -6523,6 +7973,9 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.RuntimeSecurity = (*core.RuntimeSecurity)(unsafe.Pointer(in.RuntimeSecurity))
+	out.CertificateService = (*core.CertificateService)(unsafe.Pointer(in.CertificateService))
+	out.ResourceTier = (*core.SystemComponentsResourceTier)(unsafe.Pointer(in.ResourceTier))
 	return nil
 }
 
@@ -6534,6 +7987,9 @@ func Convert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemCompone
 func autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemComponents, out *SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.RuntimeSecurity = (*RuntimeSecurity)(unsafe.Pointer(in.RuntimeSecurity))
+	out.CertificateService = (*CertificateService)(unsafe.Pointer(in.CertificateService))
+	out.ResourceTier = (*SystemComponentsResourceTier)(unsafe.Pointer(in.ResourceTier))
 	return nil
 }
 
@@ -6564,6 +8020,32 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview(in *VersionUpdatePreview, out *core.VersionUpdatePreview, s conversion.Scope) error {
+	out.CurrentVersion = in.CurrentVersion
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Reason = in.Reason
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	return nil
+}
+
+// Convert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview is an autogenerated conversion function.
+func Convert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview(in *VersionUpdatePreview, out *core.VersionUpdatePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionUpdatePreview_To_core_VersionUpdatePreview(in, out, s)
+}
+
+func autoConvert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview(in *core.VersionUpdatePreview, out *VersionUpdatePreview, s conversion.Scope) error {
+	out.CurrentVersion = in.CurrentVersion
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Reason = in.Reason
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	return nil
+}
+
+// Convert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview is an autogenerated conversion function.
+func Convert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview(in *core.VersionUpdatePreview, out *VersionUpdatePreview, s conversion.Scope) error {
+	return autoConvert_core_VersionUpdatePreview_To_v1beta1_VersionUpdatePreview(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6716,6 +8198,10 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.ExistingHosts = (*core.WorkerExistingHosts)(unsafe.Pointer(in.ExistingHosts))
+	out.Headroom = (*core.WorkerHeadroom)(unsafe.Pointer(in.Headroom))
+	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.RollingUpdate = (*core.WorkerRollingUpdate)(unsafe.Pointer(in.RollingUpdate))
 	return nil
 }
 
@@ -6756,6 +8242,10 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.ExistingHosts = (*WorkerExistingHosts)(unsafe.Pointer(in.ExistingHosts))
+	out.Headroom = (*WorkerHeadroom)(unsafe.Pointer(in.Headroom))
+	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.RollingUpdate = (*WorkerRollingUpdate)(unsafe.Pointer(in.RollingUpdate))
 	return nil
 }
 
@@ -6764,6 +8254,50 @@ func Convert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s conve
 	return autoConvert_core_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerExistingHosts_To_core_WorkerExistingHosts(in *WorkerExistingHosts, out *core.WorkerExistingHosts, s conversion.Scope) error {
+	out.BootstrapTokenValidity = (*metav1.Duration)(unsafe.Pointer(in.BootstrapTokenValidity))
+	return nil
+}
+
+// Convert_v1beta1_WorkerExistingHosts_To_core_WorkerExistingHosts is an autogenerated conversion function.
+func Convert_v1beta1_WorkerExistingHosts_To_core_WorkerExistingHosts(in *WorkerExistingHosts, out *core.WorkerExistingHosts, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerExistingHosts_To_core_WorkerExistingHosts(in, out, s)
+}
+
+func autoConvert_core_WorkerExistingHosts_To_v1beta1_WorkerExistingHosts(in *core.WorkerExistingHosts, out *WorkerExistingHosts, s conversion.Scope) error {
+	out.BootstrapTokenValidity = (*metav1.Duration)(unsafe.Pointer(in.BootstrapTokenValidity))
+	return nil
+}
+
+// Convert_core_WorkerExistingHosts_To_v1beta1_WorkerExistingHosts is an autogenerated conversion function.
+func Convert_core_WorkerExistingHosts_To_v1beta1_WorkerExistingHosts(in *core.WorkerExistingHosts, out *WorkerExistingHosts, s conversion.Scope) error {
+	return autoConvert_core_WorkerExistingHosts_To_v1beta1_WorkerExistingHosts(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerHeadroom_To_core_WorkerHeadroom(in *WorkerHeadroom, out *core.WorkerHeadroom, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	return nil
+}
+
+// Convert_v1beta1_WorkerHeadroom_To_core_WorkerHeadroom is an autogenerated conversion function.
+func Convert_v1beta1_WorkerHeadroom_To_core_WorkerHeadroom(in *WorkerHeadroom, out *core.WorkerHeadroom, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerHeadroom_To_core_WorkerHeadroom(in, out, s)
+}
+
+func autoConvert_core_WorkerHeadroom_To_v1beta1_WorkerHeadroom(in *core.WorkerHeadroom, out *WorkerHeadroom, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	return nil
+}
+
+// Convert_core_WorkerHeadroom_To_v1beta1_WorkerHeadroom is an autogenerated conversion function.
+func Convert_core_WorkerHeadroom_To_v1beta1_WorkerHeadroom(in *core.WorkerHeadroom, out *WorkerHeadroom, s conversion.Scope) error {
+	return autoConvert_core_WorkerHeadroom_To_v1beta1_WorkerHeadroom(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(in *WorkerKubernetes, out *core.WorkerKubernetes, s conversion.Scope) error {
 	if in.Kubelet != nil {
 		in, out := &in.Kubelet, &out.Kubelet
@@ -6802,6 +8336,56 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in *WorkerMaintenancePreview, out *core.WorkerMaintenancePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = (*core.VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.MachineImage = (*core.MachineImageUpdatePreview)(unsafe.Pointer(in.MachineImage))
+	return nil
+}
+
+// Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview is an autogenerated conversion function.
+func Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in *WorkerMaintenancePreview, out *core.WorkerMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in, out, s)
+}
+
+func autoConvert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in *core.WorkerMaintenancePreview, out *WorkerMaintenancePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = (*VersionUpdatePreview)(unsafe.Pointer(in.KubernetesVersion))
+	out.MachineImage = (*MachineImageUpdatePreview)(unsafe.Pointer(in.MachineImage))
+	return nil
+}
+
+// Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview is an autogenerated conversion function.
+func Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in *core.WorkerMaintenancePreview, out *WorkerMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerRollingUpdate_To_core_WorkerRollingUpdate(in *WorkerRollingUpdate, out *core.WorkerRollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.CanarySteps = *(*[]intstr.IntOrString)(unsafe.Pointer(&in.CanarySteps))
+	out.SoakPeriod = (*metav1.Duration)(unsafe.Pointer(in.SoakPeriod))
+	return nil
+}
+
+// Convert_v1beta1_WorkerRollingUpdate_To_core_WorkerRollingUpdate is an autogenerated conversion function.
+func Convert_v1beta1_WorkerRollingUpdate_To_core_WorkerRollingUpdate(in *WorkerRollingUpdate, out *core.WorkerRollingUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerRollingUpdate_To_core_WorkerRollingUpdate(in, out, s)
+}
+
+func autoConvert_core_WorkerRollingUpdate_To_v1beta1_WorkerRollingUpdate(in *core.WorkerRollingUpdate, out *WorkerRollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.CanarySteps = *(*[]intstr.IntOrString)(unsafe.Pointer(&in.CanarySteps))
+	out.SoakPeriod = (*metav1.Duration)(unsafe.Pointer(in.SoakPeriod))
+	return nil
+}
+
+// Convert_core_WorkerRollingUpdate_To_v1beta1_WorkerRollingUpdate is an autogenerated conversion function.
+func Convert_core_WorkerRollingUpdate_To_v1beta1_WorkerRollingUpdate(in *core.WorkerRollingUpdate, out *WorkerRollingUpdate, s conversion.Scope) error {
+	return autoConvert_core_WorkerRollingUpdate_To_v1beta1_WorkerRollingUpdate(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
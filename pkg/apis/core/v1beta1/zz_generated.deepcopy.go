@@ -18,6 +18,27 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIResourcesCapability) DeepCopyInto(out *APIResourcesCapability) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIResourcesCapability.
+func (in *APIResourcesCapability) DeepCopy() *APIResourcesCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(APIResourcesCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerLogging) DeepCopyInto(out *APIServerLogging) {
 	*out = *in
@@ -193,6 +214,11 @@ func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.ClusterAuditPolicyName != nil {
+		in, out := &in.ClusterAuditPolicyName, &out.ClusterAuditPolicyName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -608,6 +634,22 @@ func (in *CRI) DeepCopy() *CRI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateService) DeepCopyInto(out *CertificateService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateService.
+func (in *CertificateService) DeepCopy() *CertificateService {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	*out = *in
@@ -749,6 +791,83 @@ func (in *CloudProfileSpec) DeepCopy() *CloudProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuditPolicy) DeepCopyInto(out *ClusterAuditPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuditPolicy.
+func (in *ClusterAuditPolicy) DeepCopy() *ClusterAuditPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuditPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuditPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuditPolicyList) DeepCopyInto(out *ClusterAuditPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAuditPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuditPolicyList.
+func (in *ClusterAuditPolicyList) DeepCopy() *ClusterAuditPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuditPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAuditPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuditPolicySpec) DeepCopyInto(out *ClusterAuditPolicySpec) {
+	*out = *in
+	in.Policy.DeepCopyInto(&out.Policy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuditPolicySpec.
+func (in *ClusterAuditPolicySpec) DeepCopy() *ClusterAuditPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuditPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
@@ -863,6 +982,11 @@ func (in *ClusterAutoscalerOptions) DeepCopyInto(out *ClusterAutoscalerOptions)
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -899,6 +1023,30 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConformanceTestReport) DeepCopyInto(out *ConformanceTestReport) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConformanceTestReport.
+func (in *ConformanceTestReport) DeepCopy() *ConformanceTestReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ConformanceTestReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerRuntime) DeepCopyInto(out *ContainerRuntime) {
 	*out = *in
@@ -941,6 +1089,42 @@ func (in *ControlPlane) DeepCopy() *ControlPlane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneResourceUsage) DeepCopyInto(out *ControlPlaneResourceUsage) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ETCDStorage != nil {
+		in, out := &in.ETCDStorage, &out.ETCDStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourceUsage.
+func (in *ControlPlaneResourceUsage) DeepCopy() *ControlPlaneResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -1310,13 +1494,32 @@ func (in *CoreDNS) DeepCopyInto(out *CoreDNS) {
 	if in.Autoscaling != nil {
 		in, out := &in.Autoscaling, &out.Autoscaling
 		*out = new(CoreDNSAutoscaling)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Rewriting != nil {
 		in, out := &in.Rewriting, &out.Rewriting
 		*out = new(CoreDNSRewriting)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]CoreDNSPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(CoreDNSCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardZones != nil {
+		in, out := &in.ForwardZones, &out.ForwardZones
+		*out = make([]CoreDNSForwardZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1333,6 +1536,26 @@ func (in *CoreDNS) DeepCopy() *CoreDNS {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNSAutoscaling) DeepCopyInto(out *CoreDNSAutoscaling) {
 	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CoresPerReplica != nil {
+		in, out := &in.CoresPerReplica, &out.CoresPerReplica
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodesPerReplica != nil {
+		in, out := &in.NodesPerReplica, &out.NodesPerReplica
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1346,6 +1569,79 @@ func (in *CoreDNSAutoscaling) DeepCopy() *CoreDNSAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSCache) DeepCopyInto(out *CoreDNSCache) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessCapacity != nil {
+		in, out := &in.SuccessCapacity, &out.SuccessCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DenialCapacity != nil {
+		in, out := &in.DenialCapacity, &out.DenialCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSCache.
+func (in *CoreDNSCache) DeepCopy() *CoreDNSCache {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSForwardZone) DeepCopyInto(out *CoreDNSForwardZone) {
+	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSForwardZone.
+func (in *CoreDNSForwardZone) DeepCopy() *CoreDNSForwardZone {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSForwardZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSPlugin) DeepCopyInto(out *CoreDNSPlugin) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSPlugin.
+func (in *CoreDNSPlugin) DeepCopy() *CoreDNSPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNSRewriting) DeepCopyInto(out *CoreDNSRewriting) {
 	*out = *in
@@ -1449,6 +1745,11 @@ func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
 		*out = new(DNSIncludeExclude)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secondary != nil {
+		in, out := &in.Secondary, &out.Secondary
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1722,6 +2023,27 @@ func (in *Extension) DeepCopy() *Extension {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionCapability) DeepCopyInto(out *ExtensionCapability) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionCapability.
+func (in *ExtensionCapability) DeepCopy() *ExtensionCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionResourceState) DeepCopyInto(out *ExtensionResourceState) {
 	*out = *in
@@ -1775,34 +2097,102 @@ func (in *FailureTolerance) DeepCopy() *FailureTolerance {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Gardener) DeepCopyInto(out *Gardener) {
+func (in *FeatureGateCapability) DeepCopyInto(out *FeatureGateCapability) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardener.
-func (in *Gardener) DeepCopy() *Gardener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGateCapability.
+func (in *FeatureGateCapability) DeepCopy() *FeatureGateCapability {
 	if in == nil {
 		return nil
 	}
-	out := new(Gardener)
+	out := new(FeatureGateCapability)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GardenerResourceData) DeepCopyInto(out *GardenerResourceData) {
+func (in *GardenCapabilities) DeepCopyInto(out *GardenCapabilities) {
 	*out = *in
-	in.Data.DeepCopyInto(&out.Data)
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGateCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]ExtensionCapability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	return
-}
+	if in.APIResources != nil {
+		in, out := &in.APIResources, &out.APIResources
+		*out = make([]APIResourcesCapability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]SeedCapability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenCapabilities.
+func (in *GardenCapabilities) DeepCopy() *GardenCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GardenCapabilities) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gardener) DeepCopyInto(out *Gardener) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardener.
+func (in *Gardener) DeepCopy() *Gardener {
+	if in == nil {
+		return nil
+	}
+	out := new(Gardener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerResourceData) DeepCopyInto(out *GardenerResourceData) {
+	*out = *in
+	in.Data.DeepCopyInto(&out.Data)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerResourceData.
 func (in *GardenerResourceData) DeepCopy() *GardenerResourceData {
@@ -1891,6 +2281,18 @@ func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = make([]HibernationScheduleException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HolidayCalendarConfigMapName != nil {
+		in, out := &in.HolidayCalendarConfigMapName, &out.HolidayCalendarConfigMapName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1904,6 +2306,27 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationScheduleException) DeepCopyInto(out *HibernationScheduleException) {
+	*out = *in
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationScheduleException.
+func (in *HibernationScheduleException) DeepCopy() *HibernationScheduleException {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationScheduleException)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
 	*out = *in
@@ -2164,6 +2587,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(KubeAPIServerProfile)
+		**out = **in
+	}
 	return
 }
 
@@ -2392,6 +2820,13 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(MemorySwapConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]KubeletCredentialProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2564,6 +2999,63 @@ func (in *KubeletConfigReserved) DeepCopy() *KubeletConfigReserved {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletCredentialProvider) DeepCopyInto(out *KubeletCredentialProvider) {
+	*out = *in
+	if in.MatchImages != nil {
+		in, out := &in.MatchImages, &out.MatchImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCacheDuration != nil {
+		in, out := &in.DefaultCacheDuration, &out.DefaultCacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]KubeletCredentialProviderEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletCredentialProvider.
+func (in *KubeletCredentialProvider) DeepCopy() *KubeletCredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletCredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletCredentialProviderEnvVar) DeepCopyInto(out *KubeletCredentialProviderEnvVar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletCredentialProviderEnvVar.
+func (in *KubeletCredentialProviderEnvVar) DeepCopy() *KubeletCredentialProviderEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletCredentialProviderEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -2607,6 +3099,11 @@ func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PKI != nil {
+		in, out := &in.PKI, &out.PKI
+		*out = new(PKI)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2705,6 +3202,16 @@ func (in *LastError) DeepCopyInto(out *LastError) {
 		in, out := &in.LastUpdateTime, &out.LastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hint != nil {
+		in, out := &in.Hint, &out.Hint
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2868,6 +3375,23 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageUpdatePreview) DeepCopyInto(out *MachineImageUpdatePreview) {
+	*out = *in
+	in.VersionUpdatePreview.DeepCopyInto(&out.VersionUpdatePreview)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageUpdatePreview.
+func (in *MachineImageUpdatePreview) DeepCopy() *MachineImageUpdatePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageUpdatePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
@@ -3014,6 +3538,64 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePendingChanges) DeepCopyInto(out *MaintenancePendingChanges) {
+	*out = *in
+	in.ComputedTime.DeepCopyInto(&out.ComputedTime)
+	in.NextMaintenanceTimeWindow.DeepCopyInto(&out.NextMaintenanceTimeWindow)
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(VersionUpdatePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerMaintenancePreview, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePendingChanges.
+func (in *MaintenancePendingChanges) DeepCopy() *MaintenancePendingChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePendingChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePreviewTimeWindow) DeepCopyInto(out *MaintenancePreviewTimeWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePreviewTimeWindow.
+func (in *MaintenancePreviewTimeWindow) DeepCopy() *MaintenancePreviewTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePreviewTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -3286,6 +3868,10 @@ func (in *NetworkingStatus) DeepCopyInto(out *NetworkingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DualStackMigrationInitiationTime != nil {
+		in, out := &in.DualStackMigrationInitiationTime, &out.DualStackMigrationInitiationTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3364,6 +3950,245 @@ func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make([]ShootPurpose, len(*in))
+		copy(*out, *in)
+	}
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]NotificationEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
+func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkEmail) DeepCopyInto(out *NotificationSinkEmail) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkEmail.
+func (in *NotificationSinkEmail) DeepCopy() *NotificationSinkEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkList) DeepCopyInto(out *NotificationSinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkList.
+func (in *NotificationSinkList) DeepCopy() *NotificationSinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationSinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkSlack) DeepCopyInto(out *NotificationSinkSlack) {
+	*out = *in
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSlack.
+func (in *NotificationSinkSlack) DeepCopy() *NotificationSinkSlack {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkSlack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkSpec) DeepCopyInto(out *NotificationSinkSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(NotificationSinkWebhook)
+		**out = **in
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(NotificationSinkSlack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(NotificationSinkEmail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(NotificationFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSpec.
+func (in *NotificationSinkSpec) DeepCopy() *NotificationSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
+	*out = *in
+	if in.LastDelivery != nil {
+		in, out := &in.LastDelivery, &out.LastDelivery
+		*out = new(NotificationDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastFailure != nil {
+		in, out := &in.LastFailure, &out.LastFailure
+		*out = new(NotificationDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkWebhook) DeepCopyInto(out *NotificationSinkWebhook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkWebhook.
+func (in *NotificationSinkWebhook) DeepCopy() *NotificationSinkWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
 	*out = *in
@@ -3520,6 +4345,42 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKI) DeepCopyInto(out *PKI) {
+	*out = *in
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(KeyAlgorithm)
+		**out = **in
+	}
+	if in.KeySize != nil {
+		in, out := &in.KeySize, &out.KeySize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CertificateAuthorityValidity != nil {
+		in, out := &in.CertificateAuthorityValidity, &out.CertificateAuthorityValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CertificateValidity != nil {
+		in, out := &in.CertificateValidity, &out.CertificateValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKI.
+func (in *PKI) DeepCopy() *PKI {
+	if in == nil {
+		return nil
+	}
+	out := new(PKI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3603,6 +4464,44 @@ func (in *ProjectMember) DeepCopy() *ProjectMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectShootDefaults) DeepCopyInto(out *ProjectShootDefaults) {
+	*out = *in
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(ShootPurpose)
+		**out = **in
+	}
+	if in.MaintenanceTimeWindow != nil {
+		in, out := &in.MaintenanceTimeWindow, &out.MaintenanceTimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.HibernationSchedules != nil {
+		in, out := &in.HibernationSchedules, &out.HibernationSchedules
+		*out = make([]HibernationSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkingType != nil {
+		in, out := &in.NetworkingType, &out.NetworkingType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectShootDefaults.
+func (in *ProjectShootDefaults) DeepCopy() *ProjectShootDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectShootDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -3650,6 +4549,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ProjectShootDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3678,6 +4582,11 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.ControlPlaneResourceUsage != nil {
+		in, out := &in.ControlPlaneResourceUsage, &out.ControlPlaneResourceUsage
+		*out = new(ControlPlaneResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3917,6 +4826,22 @@ func (in *ResourceWatchCacheSize) DeepCopy() *ResourceWatchCacheSize {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeSecurity) DeepCopyInto(out *RuntimeSecurity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeSecurity.
+func (in *RuntimeSecurity) DeepCopy() *RuntimeSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHAccess) DeepCopyInto(out *SSHAccess) {
 	*out = *in
@@ -4060,16 +4985,79 @@ func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
 		*out = new(string)
 		**out = **in
 	}
-	out.SecretRef = in.SecretRef
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackup.
+func (in *SeedBackup) DeepCopy() *SeedBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapability) DeepCopyInto(out *SeedCapability) {
+	*out = *in
+	if in.GardenletVersion != nil {
+		in, out := &in.GardenletVersion, &out.GardenletVersion
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapability.
+func (in *SeedCapability) DeepCopy() *SeedCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapacityReport) DeepCopyInto(out *SeedCapacityReport) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(SeedResourceUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(SeedResourceUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDStorage != nil {
+		in, out := &in.ETCDStorage, &out.ETCDStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NodePodCIDRs != nil {
+		in, out := &in.NodePodCIDRs, &out.NodePodCIDRs
+		*out = new(SeedPoolUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(SeedPoolUtilization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackup.
-func (in *SeedBackup) DeepCopy() *SeedBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapacityReport.
+func (in *SeedCapacityReport) DeepCopy() *SeedCapacityReport {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedBackup)
+	out := new(SeedCapacityReport)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4181,6 +5169,27 @@ func (in *SeedNetworks) DeepCopy() *SeedNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedPoolUtilization) DeepCopyInto(out *SeedPoolUtilization) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedPoolUtilization.
+func (in *SeedPoolUtilization) DeepCopy() *SeedPoolUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedPoolUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
 	*out = *in
@@ -4207,6 +5216,25 @@ func (in *SeedProvider) DeepCopy() *SeedProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedResourceUtilization) DeepCopyInto(out *SeedResourceUtilization) {
+	*out = *in
+	out.Allocatable = in.Allocatable.DeepCopy()
+	out.Requested = in.Requested.DeepCopy()
+	out.Headroom = in.Headroom.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedResourceUtilization.
+func (in *SeedResourceUtilization) DeepCopy() *SeedResourceUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedResourceUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSelector) DeepCopyInto(out *SeedSelector) {
 	*out = *in
@@ -4229,6 +5257,22 @@ func (in *SeedSelector) DeepCopy() *SeedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingAuditPolicy) DeepCopyInto(out *SeedSettingAuditPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingAuditPolicy.
+func (in *SeedSettingAuditPolicy) DeepCopy() *SeedSettingAuditPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingAuditPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
@@ -4506,6 +5550,11 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 		*out = new(SeedSettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		*out = new(SeedSettingAuditPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -4613,6 +5662,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityReport != nil {
+		in, out := &in.CapacityReport, &out.CapacityReport
+		*out = new(SeedCapacityReport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4886,6 +5940,26 @@ func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDNSStatus) DeepCopyInto(out *ShootDNSStatus) {
+	*out = *in
+	if in.LastFailoverTime != nil {
+		in, out := &in.LastFailoverTime, &out.LastFailoverTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDNSStatus.
+func (in *ShootDNSStatus) DeepCopy() *ShootDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootKubeconfigRotation) DeepCopyInto(out *ShootKubeconfigRotation) {
 	*out = *in
@@ -4969,6 +6043,76 @@ func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMaintenancePreview) DeepCopyInto(out *ShootMaintenancePreview) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.NextMaintenanceTimeWindow.DeepCopyInto(&out.NextMaintenanceTimeWindow)
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(VersionUpdatePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerMaintenancePreview, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMaintenancePreview.
+func (in *ShootMaintenancePreview) DeepCopy() *ShootMaintenancePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMaintenancePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootMaintenancePreview) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMaintenanceStatus) DeepCopyInto(out *ShootMaintenanceStatus) {
+	*out = *in
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = new(MaintenancePendingChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMaintenanceStatus.
+func (in *ShootMaintenanceStatus) DeepCopy() *ShootMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
 	*out = *in
@@ -5128,6 +6272,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -5315,6 +6464,30 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(NetworkingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PausedSince != nil {
+		in, out := &in.PausedSince, &out.PausedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ShootDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ShootMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneResourceUsage != nil {
+		in, out := &in.ControlPlaneResourceUsage, &out.ControlPlaneResourceUsage
+		*out = new(ControlPlaneResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConformanceTest != nil {
+		in, out := &in.ConformanceTest, &out.ConformanceTest
+		*out = new(ConformanceTestReport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5359,6 +6532,21 @@ func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 		*out = new(NodeLocalDNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RuntimeSecurity != nil {
+		in, out := &in.RuntimeSecurity, &out.RuntimeSecurity
+		*out = new(RuntimeSecurity)
+		**out = **in
+	}
+	if in.CertificateService != nil {
+		in, out := &in.CertificateService, &out.CertificateService
+		*out = new(CertificateService)
+		**out = **in
+	}
+	if in.ResourceTier != nil {
+		in, out := &in.ResourceTier, &out.ResourceTier
+		*out = new(SystemComponentsResourceTier)
+		**out = **in
+	}
 	return
 }
 
@@ -5393,6 +6581,32 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionUpdatePreview) DeepCopyInto(out *VersionUpdatePreview) {
+	*out = *in
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionUpdatePreview.
+func (in *VersionUpdatePreview) DeepCopy() *VersionUpdatePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionUpdatePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -5658,6 +6872,26 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExistingHosts != nil {
+		in, out := &in.ExistingHosts, &out.ExistingHosts
+		*out = new(WorkerExistingHosts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headroom != nil {
+		in, out := &in.Headroom, &out.Headroom
+		*out = new(WorkerHeadroom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineUpdateStrategy)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(WorkerRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5671,6 +6905,53 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerExistingHosts) DeepCopyInto(out *WorkerExistingHosts) {
+	*out = *in
+	if in.BootstrapTokenValidity != nil {
+		in, out := &in.BootstrapTokenValidity, &out.BootstrapTokenValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerExistingHosts.
+func (in *WorkerExistingHosts) DeepCopy() *WorkerExistingHosts {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerExistingHosts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerHeadroom) DeepCopyInto(out *WorkerHeadroom) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerHeadroom.
+func (in *WorkerHeadroom) DeepCopy() *WorkerHeadroom {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerHeadroom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 	*out = *in
@@ -5697,6 +6978,68 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerMaintenancePreview) DeepCopyInto(out *WorkerMaintenancePreview) {
+	*out = *in
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(VersionUpdatePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(MachineImageUpdatePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerMaintenancePreview.
+func (in *WorkerMaintenancePreview) DeepCopy() *WorkerMaintenancePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerMaintenancePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRollingUpdate) DeepCopyInto(out *WorkerRollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.CanarySteps != nil {
+		in, out := &in.CanarySteps, &out.CanarySteps
+		*out = make([]intstr.IntOrString, len(*in))
+		copy(*out, *in)
+	}
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRollingUpdate.
+func (in *WorkerRollingUpdate) DeepCopy() *WorkerRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
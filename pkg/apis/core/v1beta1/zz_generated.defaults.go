@@ -185,12 +185,15 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	if in.Spec.Networking != nil {
 		SetDefaults_Networking(in.Spec.Networking)
 	}
-	if in.Spec.Maintenance != nil {
-		SetDefaults_Maintenance(in.Spec.Maintenance)
-	}
 	for i := range in.Spec.Provider.Workers {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
+		if a.ExistingHosts != nil {
+			SetDefaults_WorkerExistingHosts(a.ExistingHosts)
+		}
+		if a.RollingUpdate != nil {
+			SetDefaults_WorkerRollingUpdate(a.RollingUpdate)
+		}
 	}
 }
 
@@ -69,6 +69,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateKubernetesSettings(spec.Kubernetes, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateMachineImages(spec.MachineImages, fldPath.Child("machineImages"))...)
 	allErrs = append(allErrs, validateMachineTypes(spec.MachineTypes, fldPath.Child("machineTypes"))...)
+	allErrs = append(allErrs, validateMachineTypeArchitecturesSupportedByMachineImages(spec.MachineTypes, spec.MachineImages, fldPath.Child("machineTypes"))...)
 	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
 	allErrs = append(allErrs, validateRegions(spec.Regions, fldPath.Child("regions"))...)
 	if spec.SeedSelector != nil {
@@ -340,6 +341,41 @@ func validateMachineTypeArchitecture(arch *string, fldPath *field.Path) field.Er
 	return allErrs
 }
 
+// validateMachineTypeArchitecturesSupportedByMachineImages ensures that worker pools of each machine type can be created,
+// i.e., that at least one machine image version supports the architecture of the machine type.
+func validateMachineTypeArchitecturesSupportedByMachineImages(machineTypes []core.MachineType, machineImages []core.MachineImage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedArchitectures := sets.New[string]()
+	for _, image := range machineImages {
+		for _, version := range image.Versions {
+			for _, arch := range version.Architectures {
+				// invalid architectures are already reported by validateMachineImageVersionArchitecture
+				if slices.Contains(v1beta1constants.ValidArchitectures, arch) {
+					supportedArchitectures.Insert(arch)
+				}
+			}
+		}
+	}
+
+	// architectures of machine image versions are defaulted, skip the check if they are not set
+	if supportedArchitectures.Len() == 0 {
+		return allErrs
+	}
+
+	for i, machineType := range machineTypes {
+		if machineType.Architecture == nil || !slices.Contains(v1beta1constants.ValidArchitectures, *machineType.Architecture) {
+			continue
+		}
+
+		if !supportedArchitectures.Has(*machineType.Architecture) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("architecture"), *machineType.Architecture, fmt.Sprintf("no machine image version supports the architecture of machine type %q, supported architectures are %v", machineType.Name, sets.List(supportedArchitectures))))
+		}
+	}
+
+	return allErrs
+}
+
 func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -656,6 +656,76 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					}))))
 				})
 
+				It("should forbid machine types with an architecture not supported by any machine image version", func() {
+					cloudProfile.Spec.MachineImages = []core.MachineImage{
+						{
+							Name: "some-machineimage",
+							Versions: []core.MachineImageVersion{
+								{
+									ExpirableVersion: core.ExpirableVersion{
+										Version: "0.1.2",
+									},
+									CRI:           []core.CRI{{Name: "containerd"}},
+									Architectures: []string{"amd64"},
+								},
+							},
+						},
+					}
+					cloudProfile.Spec.MachineTypes = append(cloudProfile.Spec.MachineTypes, core.MachineType{
+						Name:         "machine-type-arm",
+						CPU:          resource.MustParse("2"),
+						GPU:          resource.MustParse("0"),
+						Memory:       resource.MustParse("100Gi"),
+						Architecture: ptr.To("arm64"),
+					})
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.machineTypes[1].architecture"),
+						"Detail": Equal(`no machine image version supports the architecture of machine type "machine-type-arm", supported architectures are [amd64]`),
+					}))))
+				})
+
+				It("should allow machine types with different architectures if each is supported by a machine image version", func() {
+					cloudProfile.Spec.MachineImages = []core.MachineImage{
+						{
+							Name: "some-machineimage",
+							Versions: []core.MachineImageVersion{
+								{
+									ExpirableVersion: core.ExpirableVersion{
+										Version: "0.1.2",
+									},
+									CRI:           []core.CRI{{Name: "containerd"}},
+									Architectures: []string{"amd64"},
+								},
+							},
+						},
+						{
+							Name: "other-machineimage",
+							Versions: []core.MachineImageVersion{
+								{
+									ExpirableVersion: core.ExpirableVersion{
+										Version: "1.0.0",
+									},
+									CRI:           []core.CRI{{Name: "containerd"}},
+									Architectures: []string{"arm64"},
+								},
+							},
+						},
+					}
+					cloudProfile.Spec.MachineTypes = append(cloudProfile.Spec.MachineTypes, core.MachineType{
+						Name:         "machine-type-arm",
+						CPU:          resource.MustParse("2"),
+						GPU:          resource.MustParse("0"),
+						Memory:       resource.MustParse("100Gi"),
+						Architecture: ptr.To("arm64"),
+					})
+
+					errorList := ValidateCloudProfile(cloudProfile)
+					Expect(errorList).To(BeEmpty())
+				})
+
 				It("should allow valid kubeletVersionConstraint for machine image versions", func() {
 					cloudProfile.Spec.MachineImages = []core.MachineImage{
 						{
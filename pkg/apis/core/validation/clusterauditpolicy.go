@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	auditvalidation "k8s.io/apiserver/pkg/apis/audit/validation"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var auditPolicyDecoder runtime.Decoder
+
+func init() {
+	auditPolicyScheme := runtime.NewScheme()
+	utilruntime.Must(auditv1.AddToScheme(auditPolicyScheme))
+	utilruntime.Must(auditinternal.AddToScheme(auditPolicyScheme))
+	auditPolicyDecoder = serializer.NewCodecFactory(auditPolicyScheme).UniversalDecoder()
+}
+
+// ValidateClusterAuditPolicy validates a ClusterAuditPolicy object.
+func ValidateClusterAuditPolicy(clusterAuditPolicy *core.ClusterAuditPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&clusterAuditPolicy.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateClusterAuditPolicySpec(&clusterAuditPolicy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateClusterAuditPolicyUpdate validates a ClusterAuditPolicy object before an update.
+func ValidateClusterAuditPolicyUpdate(newClusterAuditPolicy, oldClusterAuditPolicy *core.ClusterAuditPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newClusterAuditPolicy.ObjectMeta, &oldClusterAuditPolicy.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateClusterAuditPolicy(newClusterAuditPolicy)...)
+
+	return allErrs
+}
+
+func validateClusterAuditPolicySpec(spec *core.ClusterAuditPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	policyPath := fldPath.Child("policy")
+
+	if len(spec.Policy.Raw) == 0 {
+		return append(allErrs, field.Required(policyPath, "must provide an audit policy"))
+	}
+
+	obj, _, err := auditPolicyDecoder.Decode(spec.Policy.Raw, nil, nil)
+	if err != nil {
+		return append(allErrs, field.Invalid(policyPath, "", fmt.Sprintf("failed to decode the audit policy: %v", err)))
+	}
+
+	policy, ok := obj.(*auditinternal.Policy)
+	if !ok {
+		return append(allErrs, field.Invalid(policyPath, "", fmt.Sprintf("expected an audit policy but got %T", obj)))
+	}
+
+	for _, err := range auditvalidation.ValidatePolicy(policy) {
+		err.Field = policyPath.String() + "." + err.Field
+		allErrs = append(allErrs, err)
+	}
+
+	return allErrs
+}
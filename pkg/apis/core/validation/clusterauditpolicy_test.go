@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ClusterAuditPolicy Validation Tests", func() {
+	var clusterAuditPolicy *core.ClusterAuditPolicy
+
+	BeforeEach(func() {
+		clusterAuditPolicy = &core.ClusterAuditPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default",
+			},
+			Spec: core.ClusterAuditPolicySpec{
+				Policy: runtime.RawExtension{Raw: []byte(`{"apiVersion":"audit.k8s.io/v1","kind":"Policy","rules":[{"level":"Metadata"}]}`)},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterAuditPolicy", func() {
+		It("should allow a valid cluster audit policy", func() {
+			Expect(ValidateClusterAuditPolicy(clusterAuditPolicy)).To(BeEmpty())
+		})
+
+		It("should forbid invalid names", func() {
+			clusterAuditPolicy.Name = "Foo_Bar"
+
+			Expect(ValidateClusterAuditPolicy(clusterAuditPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should require a policy", func() {
+			clusterAuditPolicy.Spec.Policy = runtime.RawExtension{}
+
+			Expect(ValidateClusterAuditPolicy(clusterAuditPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.policy"),
+			}))))
+		})
+
+		It("should forbid policies which cannot be decoded", func() {
+			clusterAuditPolicy.Spec.Policy.Raw = []byte(`{"apiVersion":"audit.k8s.io/v1","kind":"Foo"}`)
+
+			Expect(ValidateClusterAuditPolicy(clusterAuditPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy"),
+			}))))
+		})
+
+		It("should forbid invalid audit policies", func() {
+			clusterAuditPolicy.Spec.Policy.Raw = []byte(`{"apiVersion":"audit.k8s.io/v1","kind":"Policy","rules":[{"level":"Foo"}]}`)
+
+			Expect(ValidateClusterAuditPolicy(clusterAuditPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.policy.rules[0].level"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateClusterAuditPolicyUpdate", func() {
+		It("should allow updating the policy", func() {
+			newClusterAuditPolicy := clusterAuditPolicy.DeepCopy()
+			newClusterAuditPolicy.ResourceVersion = "1"
+			newClusterAuditPolicy.Spec.Policy.Raw = []byte(`{"apiVersion":"audit.k8s.io/v1","kind":"Policy","rules":[{"level":"None"}]}`)
+			clusterAuditPolicy.ResourceVersion = "1"
+
+			Expect(ValidateClusterAuditPolicyUpdate(newClusterAuditPolicy, clusterAuditPolicy)).To(BeEmpty())
+		})
+
+		It("should forbid invalid audit policies", func() {
+			newClusterAuditPolicy := clusterAuditPolicy.DeepCopy()
+			newClusterAuditPolicy.ResourceVersion = "1"
+			newClusterAuditPolicy.Spec.Policy = runtime.RawExtension{}
+			clusterAuditPolicy.ResourceVersion = "1"
+
+			Expect(ValidateClusterAuditPolicyUpdate(newClusterAuditPolicy, clusterAuditPolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.policy"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/mail"
+	"net/url"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var availableNotificationEventTypes = sets.New(
+	string(core.NotificationEventTypeShootCreated),
+	string(core.NotificationEventTypeShootReconcileFailed),
+	string(core.NotificationEventTypeShootHibernated),
+	string(core.NotificationEventTypeShootExpiring),
+)
+
+// ValidateNotificationSink validates a NotificationSink object.
+func ValidateNotificationSink(notificationSink *core.NotificationSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&notificationSink.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateNotificationSinkSpec(&notificationSink.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateNotificationSinkUpdate validates a NotificationSink object before an update.
+func ValidateNotificationSinkUpdate(newNotificationSink, oldNotificationSink *core.NotificationSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newNotificationSink.ObjectMeta, &oldNotificationSink.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateNotificationSink(newNotificationSink)...)
+
+	return allErrs
+}
+
+// ValidateNotificationSinkStatusUpdate validates the status field of a NotificationSink object.
+func ValidateNotificationSinkStatusUpdate(_, _ *core.NotificationSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	return allErrs
+}
+
+func validateNotificationSinkSpec(spec *core.NotificationSinkSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var sinks int
+	if spec.Webhook != nil {
+		sinks++
+		allErrs = append(allErrs, validateNotificationSinkWebhook(spec.Webhook, fldPath.Child("webhook"))...)
+	}
+	if spec.Slack != nil {
+		sinks++
+		if spec.SecretRef == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretRef"), "must provide a secret containing the incoming webhook URL for Slack sinks"))
+		}
+	}
+	if spec.Email != nil {
+		sinks++
+		allErrs = append(allErrs, validateNotificationSinkEmail(spec.Email, fldPath.Child("email"))...)
+	}
+	if sinks != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "exactly one of webhook, slack or email must be set"))
+	}
+
+	if spec.SecretRef != nil {
+		allErrs = append(allErrs, validateSecretReference(*spec.SecretRef, fldPath.Child("secretRef"))...)
+	}
+
+	if spec.Filter != nil {
+		allErrs = append(allErrs, validateNotificationFilter(spec.Filter, fldPath.Child("filter"))...)
+	}
+
+	return allErrs
+}
+
+func validateNotificationSinkWebhook(webhook *core.NotificationSinkWebhook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "must be a valid URL: "+err.Error()))
+	}
+	if u.Scheme != "https" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "'https' is the only allowed URL scheme"))
+	}
+	if len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "host must be provided"))
+	}
+	if u.User != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "user information is not permitted in the URL, use the secretRef instead"))
+	}
+
+	return allErrs
+}
+
+func validateNotificationSinkEmail(email *core.NotificationSinkEmail, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(email.Host) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("host"), "must provide the host of the SMTP server"))
+	}
+	if email.Port <= 0 || email.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), email.Port, "must be a valid port number"))
+	}
+	if _, err := mail.ParseAddress(email.From); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("from"), email.From, "must be a valid email address: "+err.Error()))
+	}
+	if len(email.To) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("to"), "must provide at least one recipient"))
+	}
+	for i, to := range email.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("to").Index(i), to, "must be a valid email address: "+err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNotificationFilter(filter *core.NotificationFilter, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, purpose := range filter.Purposes {
+		if allowedPurposes := sets.New(append(sets.List(availableShootPurposes), string(core.ShootPurposeInfrastructure))...); !allowedPurposes.Has(string(purpose)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("purposes").Index(i), purpose, sets.List(allowedPurposes)))
+		}
+	}
+
+	for i, eventType := range filter.EventTypes {
+		if !availableNotificationEventTypes.Has(string(eventType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("eventTypes").Index(i), eventType, sets.List(availableNotificationEventTypes)))
+		}
+	}
+
+	return allErrs
+}
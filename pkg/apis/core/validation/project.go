@@ -16,8 +16,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
@@ -116,6 +118,37 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
 
+	if projectSpec.ShootDefaults != nil {
+		allErrs = append(allErrs, validateProjectShootDefaults(projectSpec.ShootDefaults, ptr.Deref(projectSpec.Namespace, "") == v1beta1constants.GardenNamespace, fldPath.Child("shootDefaults"))...)
+	}
+
+	return allErrs
+}
+
+func validateProjectShootDefaults(shootDefaults *core.ProjectShootDefaults, isGardenNamespace bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if purpose := shootDefaults.Purpose; purpose != nil {
+		allowedShootPurposes := availableShootPurposes
+		if isGardenNamespace {
+			allowedShootPurposes = sets.New(append(sets.List(availableShootPurposes), string(core.ShootPurposeInfrastructure))...)
+		}
+
+		if !allowedShootPurposes.Has(string(*purpose)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("purpose"), *purpose, sets.List(allowedShootPurposes)))
+		}
+	}
+
+	if shootDefaults.MaintenanceTimeWindow != nil {
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(shootDefaults.MaintenanceTimeWindow, fldPath.Child("maintenanceTimeWindow"))...)
+	}
+
+	allErrs = append(allErrs, ValidateHibernationSchedules(shootDefaults.HibernationSchedules, fldPath.Child("hibernationSchedules"))...)
+
+	if networkingType := shootDefaults.NetworkingType; networkingType != nil && len(*networkingType) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkingType"), "must provide a networking type when key is present"))
+	}
+
 	return allErrs
 }
 
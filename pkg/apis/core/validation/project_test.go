@@ -456,6 +456,54 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("shoot defaults", func() {
+			It("should allow valid shoot defaults", func() {
+				project.Spec.ShootDefaults = &core.ProjectShootDefaults{
+					Purpose:               ptr.To(core.ShootPurposeProduction),
+					MaintenanceTimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+					HibernationSchedules:  []core.HibernationSchedule{{Start: ptr.To("0 20 * * 1-5"), End: ptr.To("0 8 * * 1-5")}},
+					NetworkingType:        ptr.To("calico"),
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid invalid shoot defaults", func() {
+				project.Spec.ShootDefaults = &core.ProjectShootDefaults{
+					Purpose:               ptr.To(core.ShootPurposeInfrastructure),
+					MaintenanceTimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "221000+0100"},
+					HibernationSchedules:  []core.HibernationSchedule{{Start: ptr.To("foo")}},
+					NetworkingType:        ptr.To(""),
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.shootDefaults.purpose"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.maintenanceTimeWindow"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootDefaults.hibernationSchedules[0].start"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.shootDefaults.networkingType"),
+					})),
+				))
+			})
+
+			It("should allow the infrastructure purpose for the garden project", func() {
+				project.Spec.Namespace = ptr.To("garden")
+				project.Spec.ShootDefaults = &core.ProjectShootDefaults{Purpose: ptr.To(core.ShootPurposeInfrastructure)}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -197,6 +197,11 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if auditPolicy := seedSpec.Settings.AuditPolicy; auditPolicy != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(auditPolicy.ClusterAuditPolicyName, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("settings", "auditPolicy", "clusterAuditPolicyName"), auditPolicy.ClusterAuditPolicyName, msg))
+			}
+		}
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -1038,6 +1038,27 @@ var _ = Describe("Seed Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow referencing a ClusterAuditPolicy", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					AuditPolicy: &core.SeedSettingAuditPolicy{ClusterAuditPolicyName: "audit-policy"},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid invalid names of the referenced ClusterAuditPolicy", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					AuditPolicy: &core.SeedSettingAuditPolicy{ClusterAuditPolicyName: ""},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.auditPolicy.clusterAuditPolicyName"),
+					})),
+				))
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
@@ -38,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/ipam"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
 	apigroupsvalidation "github.com/gardener/gardener/pkg/utils/validation/apigroups"
@@ -52,6 +53,11 @@ var (
 		string(core.ProxyModeIPTables),
 		string(core.ProxyModeIPVS),
 	)
+	availableKubeAPIServerProfiles = sets.New(
+		string(core.KubeAPIServerProfileSmall),
+		string(core.KubeAPIServerProfileLarge),
+		string(core.KubeAPIServerProfileCIBurst),
+	)
 	availableKubernetesDashboardAuthenticationModes = sets.New(
 		core.KubernetesDashboardAuthModeToken,
 	)
@@ -62,6 +68,11 @@ var (
 	availableShootOperations = sets.New(
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
+		v1beta1constants.ShootOperationRetryFailedTasks,
+		v1beta1constants.ShootOperationEnableDebugMode,
+		v1beta1constants.ShootOperationDisableDebugMode,
+		v1beta1constants.ShootOperationEnableDualStack,
+		v1beta1constants.ShootOperationMigrateBackupBucket,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -92,6 +103,10 @@ var (
 	availableWorkerCRINames = sets.New(
 		string(core.CRINameContainerD),
 	)
+	availableMachineUpdateStrategies = sets.New(
+		string(core.MachineUpdateStrategyRollingUpdate),
+		string(core.MachineUpdateStrategyInPlace),
+	)
 	availableClusterAutoscalerExpanderModes = sets.New(
 		string(core.ClusterAutoscalerExpanderLeastWaste),
 		string(core.ClusterAutoscalerExpanderMostPods),
@@ -102,6 +117,24 @@ var (
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
 	)
+	availableSystemComponentsResourceTiers = sets.New(
+		string(core.SystemComponentsResourceTierSmall),
+		string(core.SystemComponentsResourceTierMedium),
+		string(core.SystemComponentsResourceTierLarge),
+	)
+	// availableCoreDNSPlugins maps the additional Core DNS plugins which can be enabled by users to the maximum number
+	// of their arguments.
+	availableCoreDNSPlugins = map[string]int{
+		"any":     0,
+		"bufsize": 1,
+		"minimal": 0,
+		"nsid":    1,
+	}
+	coreDNSPluginArgRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+	availablePKIKeySizes  = map[core.KeyAlgorithm]sets.Set[int32]{
+		core.KeyAlgorithmRSA:   sets.New[int32](3072, 4096),
+		core.KeyAlgorithmECDSA: sets.New[int32](256, 384),
+	}
 	availableSchedulingProfiles = sets.New(
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
@@ -136,6 +169,9 @@ var (
 	workerlessErrorMsg = "this field should not be set for workerless Shoot clusters"
 )
 
+// maxDebugModeDuration is the maximum duration for which the debug mode of a Shoot control plane can be enabled.
+const maxDebugModeDuration = 24 * time.Hour
+
 // ValidateShoot validates a Shoot object.
 func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -143,6 +179,8 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
 	allErrs = append(allErrs, validateShootOperation(shoot.Annotations[v1beta1constants.GardenerOperation], shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation], shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootDebugModeUntil(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootBackupBucketMigrationTarget(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 	allErrs = append(allErrs, validateShootManagedIssuer(shoot)...)
@@ -181,6 +219,17 @@ func ValidateShootUpdate(newShoot, oldShoot *core.Shoot) field.ErrorList {
 	if helper.HasManagedIssuer(oldShoot) && !helper.HasManagedIssuer(newShoot) {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationAuthenticationIssuer), "once enabled managed shoot issuer cannot be disabled"))
 	}
+	if until, ok := newShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil]; ok && until != oldShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil] {
+		if t, err := time.Parse(time.RFC3339, until); err == nil && time.Until(t) > maxDebugModeDuration {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationShootDebugModeUntil), until, fmt.Sprintf("debug mode must not be enabled for longer than %s", maxDebugModeDuration)))
+		}
+	}
+	if oldHostname, ok := oldShoot.Annotations[v1beta1constants.AnnotationAuthenticationIssuerHostname]; ok && newShoot.Annotations[v1beta1constants.AnnotationAuthenticationIssuerHostname] != oldHostname {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationAuthenticationIssuerHostname), "once set the custom hostname of the managed shoot issuer cannot be changed or removed"))
+	}
+	if newShoot.Annotations[ipam.AnnotationAllocatedNetworks] != oldShoot.Annotations[ipam.AnnotationAllocatedNetworks] {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations").Key(ipam.AnnotationAllocatedNetworks), "the networks allocated by the IPAM server cannot be changed"))
+	}
 
 	allErrs = append(allErrs, ValidateEncryptionConfigUpdate(newEncryptionConfig, oldEncryptionConfig, sets.New(newShoot.Status.EncryptedResources...), etcdEncryptionKeyRotation, hibernationEnabled, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig"))...)
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
@@ -304,6 +353,10 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	}
 	allErrs = append(allErrs, ValidateTolerations(spec.Tolerations, fldPath.Child("tolerations"))...)
 	allErrs = append(allErrs, ValidateSystemComponents(spec.SystemComponents, fldPath.Child("systemComponents"), workerless)...)
+	if spec.SystemComponents != nil && spec.SystemComponents.CertificateService != nil && spec.SystemComponents.CertificateService.Enabled &&
+		(spec.DNS == nil || spec.DNS.Domain == nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("systemComponents", "certificateService", "enabled"), "the certificate service can only be enabled for shoots with a domain (.spec.dns.domain)"))
+	}
 
 	return allErrs
 }
@@ -374,6 +427,12 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 
 		// worker kubernetes versions must not be downgraded and but can skip minor versions
 		allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, idxPath.Child("kubernetes", "version"))...)
+
+		if (newWorker.ExistingHosts == nil) != (oldWorker.ExistingHosts == nil) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("existingHosts"), "cannot switch a worker pool between provisioned machines and existing hosts"))
+		}
+
+		allErrs = append(allErrs, validateWorkerUpdateStrategyUpdate(newWorker, oldWorker, idxPath)...)
 	}
 
 	allErrs = append(allErrs, validateNetworkingUpdate(newSpec.Networking, oldSpec.Networking, fldPath.Child("networking"))...)
@@ -680,9 +739,15 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Type, oldNetworking.Type, fldPath.Child("type"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	if !isDualStackMigration(newNetworking.IPFamilies, oldNetworking.IPFamilies) {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	}
 	if oldNetworking.Pods != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
+		if features.DefaultFeatureGate.Enabled(features.ShootNetworkingCIDRExpansion) {
+			allErrs = append(allErrs, validateCIDRExpansion(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
+		}
 	}
 	if oldNetworking.Services != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Services, oldNetworking.Services, fldPath.Child("services"))...)
@@ -691,6 +756,45 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	return allErrs
 }
 
+// isDualStackMigration returns true if the IP families are changed from IPv4 single-stack to dual-stack with IPv4 as
+// primary family, which is the only allowed change of the IP families.
+func isDualStackMigration(newIPFamilies, oldIPFamilies []core.IPFamily) bool {
+	return core.IsIPv4SingleStack(oldIPFamilies) &&
+		len(newIPFamilies) == 2 && newIPFamilies[0] == core.IPFamilyIPv4 && newIPFamilies[1] == core.IPFamilyIPv6
+}
+
+// validateCIDRExpansion validates that the new CIDR is either equal to the old CIDR or a larger CIDR of the same IP
+// family which contains the old CIDR.
+func validateCIDRExpansion(newCIDR, oldCIDR *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ptr.Equal(newCIDR, oldCIDR) {
+		return allErrs
+	}
+	if newCIDR == nil {
+		return append(allErrs, field.Forbidden(fldPath, "field cannot be removed once set"))
+	}
+
+	_, oldNet, err := net.ParseCIDR(*oldCIDR)
+	if err != nil {
+		// the old CIDR is invalid, hence it cannot be expanded
+		return append(allErrs, apivalidation.ValidateImmutableField(newCIDR, oldCIDR, fldPath)...)
+	}
+	_, newNet, err := net.ParseCIDR(*newCIDR)
+	if err != nil {
+		// the format of the new CIDR is validated in validateNetworking
+		return allErrs
+	}
+
+	oldOnes, oldBits := oldNet.Mask.Size()
+	newOnes, newBits := newNet.Mask.Size()
+	if newBits != oldBits || newOnes > oldOnes || !newNet.Contains(oldNet.IP) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *newCIDR, fmt.Sprintf("CIDR can only be expanded to a larger CIDR containing the previous CIDR %q", *oldCIDR)))
+	}
+
+	return allErrs
+}
+
 // validateWorkerGroupAndControlPlaneKubernetesVersion ensures that new version is newer than old version and does not skip two minor
 func validateWorkerGroupAndControlPlaneKubernetesVersion(controlPlaneVersion, workerGroupVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -751,8 +855,9 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 	}
 
 	var (
-		names        = sets.New[string]()
-		primaryFound bool
+		names          = sets.New[string]()
+		primaryFound   bool
+		secondaryFound bool
 	)
 	for i, provider := range dns.Providers {
 		idxPath := fldPath.Child("providers").Index(i)
@@ -777,6 +882,11 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 			primaryFound = true
 		}
 
+		if provider.Secondary != nil && *provider.Secondary {
+			allErrs = append(allErrs, validateSecondaryDNSProvider(provider, secondaryFound, idxPath)...)
+			secondaryFound = true
+		}
+
 		if providerType := provider.Type; providerType != nil {
 			if *providerType == core.DNSUnmanaged && provider.SecretName != nil {
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), provider.SecretName, fmt.Sprintf("secretName must not be set when type is %q", core.DNSUnmanaged)))
@@ -789,6 +899,31 @@ func validateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	if secondaryFound && !primaryFound {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providers"), "a secondary DNS provider requires a primary DNS provider"))
+	}
+
+	return allErrs
+}
+
+func validateSecondaryDNSProvider(provider core.DNSProvider, secondaryFound bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if secondaryFound {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondary"), "multiple secondary DNS providers are not supported"))
+	}
+	if provider.Primary != nil && *provider.Primary {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondary"), "a DNS provider cannot be primary and secondary at the same time"))
+	}
+	if provider.Type == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type must be set for a secondary DNS provider"))
+	} else if *provider.Type == core.DNSUnmanaged {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), *provider.Type, fmt.Sprintf("type must not be %q for a secondary DNS provider", core.DNSUnmanaged)))
+	}
+	if provider.SecretName == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretName"), "secretName must be set for a secondary DNS provider"))
+	}
+
 	return allErrs
 }
 
@@ -838,6 +973,7 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 
 	allErrs = append(allErrs, ValidateKubeAPIServer(kubernetes.KubeAPIServer, kubernetes.Version, workerless, gardenerutils.DefaultResourcesForEncryption(), fldPath.Child("kubeAPIServer"))...)
 	allErrs = append(allErrs, ValidateKubeControllerManager(kubernetes.KubeControllerManager, networking, kubernetes.Version, workerless, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, ValidatePKI(kubernetes.PKI, fldPath.Child("pki"))...)
 
 	if workerless {
 		allErrs = append(allErrs, validateKubernetesForWorkerlessShoot(kubernetes, fldPath)...)
@@ -1198,6 +1334,36 @@ func ValidateCloudProfileReference(cloudProfileReference, oldCloudProfileReferen
 	return allErrs
 }
 
+// ValidatePKI validates the given PKI fields.
+func ValidatePKI(pki *core.PKI, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if pki == nil {
+		return allErrs
+	}
+
+	keyAlgorithm := ptr.Deref(pki.KeyAlgorithm, core.KeyAlgorithmRSA)
+	if keySizes, ok := availablePKIKeySizes[keyAlgorithm]; !ok {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("keyAlgorithm"), keyAlgorithm, []core.KeyAlgorithm{core.KeyAlgorithmRSA, core.KeyAlgorithmECDSA}))
+	} else if pki.KeySize != nil && !keySizes.Has(*pki.KeySize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("keySize"), *pki.KeySize, fmt.Sprintf("supported key sizes for key algorithm %q are %v", keyAlgorithm, sets.List(keySizes))))
+	}
+
+	if validity := pki.CertificateAuthorityValidity; validity != nil && validity.Duration < 30*24*time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateAuthorityValidity"), validity.Duration.String(), "must be at least 30 days"))
+	}
+	if validity := pki.CertificateValidity; validity != nil {
+		if validity.Duration < 24*time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateValidity"), validity.Duration.String(), "must be at least 24 hours"))
+		}
+		if caValidity := pki.CertificateAuthorityValidity; caValidity != nil && validity.Duration > caValidity.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateValidity"), validity.Duration.String(), "must not exceed the validity of the certificate authorities"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateVerticalPodAutoscaler validates the given VerticalPodAutoscaler fields.
 func ValidateVerticalPodAutoscaler(autoScaler core.VerticalPodAutoscaler, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1346,8 +1512,19 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 
 	if auditConfig := kubeAPIServer.AuditConfig; auditConfig != nil {
 		auditPath := fldPath.Child("auditConfig")
-		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
-			allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
+		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil {
+			auditPolicyPath := auditPath.Child("auditPolicy")
+			if auditPolicy.ConfigMapRef != nil {
+				allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPolicyPath.Child("configMapRef"))...)
+			}
+			if auditPolicy.ClusterAuditPolicyName != nil {
+				if auditPolicy.ConfigMapRef != nil {
+					allErrs = append(allErrs, field.Forbidden(auditPolicyPath.Child("clusterAuditPolicyName"), "must not be set together with configMapRef"))
+				}
+				for _, msg := range apivalidation.NameIsDNSSubdomain(*auditPolicy.ClusterAuditPolicyName, false) {
+					allErrs = append(allErrs, field.Invalid(auditPolicyPath.Child("clusterAuditPolicyName"), *auditPolicy.ClusterAuditPolicyName, msg))
+				}
+			}
 		}
 	}
 
@@ -1366,6 +1543,10 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 
 	allErrs = append(allErrs, ValidateAPIServerRequests(kubeAPIServer.Requests, fldPath.Child("requests"))...)
 
+	if profile := kubeAPIServer.Profile; profile != nil && !availableKubeAPIServerProfiles.Has(string(*profile)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("profile"), *profile, sets.List(availableKubeAPIServerProfiles)))
+	}
+
 	if kubeAPIServer.ServiceAccountConfig != nil {
 		if kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration != nil {
 			if kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration.Duration < 0 {
@@ -1546,20 +1727,27 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 	}
 
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
-		} else {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > core.MaintenanceTimeWindowDurationMaximum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
-				return allErrs
-			}
-			if duration < core.MaintenanceTimeWindowDurationMinimum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
-				return allErrs
-			}
-		}
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeWindow(timeWindow *core.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), timeWindow, err.Error()))
+		return allErrs
+	}
+
+	duration := maintenanceTimeWindow.Duration()
+	if duration > core.MaintenanceTimeWindowDurationMaximum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
+	}
+	if duration < core.MaintenanceTimeWindowDurationMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
 	}
 
 	return allErrs
@@ -1743,6 +1931,140 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 
 	if worker.ClusterAutoscaler != nil {
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
+
+		if worker.ClusterAutoscaler.Priority != nil && !isPriorityExpanderConfigured(kubernetes.ClusterAutoscaler) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoscaler", "priority"), fmt.Sprintf("worker pool priorities can only be set if the %q expander is configured in .spec.kubernetes.clusterAutoscaler.expander", core.ClusterAutoscalerExpanderPriority)))
+		}
+	}
+
+	if worker.ExistingHosts != nil {
+		allErrs = append(allErrs, validateWorkerExistingHosts(worker, fldPath)...)
+	}
+
+	if worker.Headroom != nil {
+		allErrs = append(allErrs, validateWorkerHeadroom(worker.Headroom, fldPath.Child("headroom"))...)
+	}
+
+	if worker.UpdateStrategy != nil && !availableMachineUpdateStrategies.Has(string(*worker.UpdateStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableMachineUpdateStrategies)))
+	}
+
+	if worker.RollingUpdate != nil {
+		allErrs = append(allErrs, validateWorkerRollingUpdate(worker.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+
+		if helper.IsUpdateStrategyInPlace(&worker) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"), fmt.Sprintf("cannot be set for worker pools with the %q update strategy", core.MachineUpdateStrategyInPlace)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerRollingUpdate(rollingUpdate *core.WorkerRollingUpdate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidatePositiveIntOrPercent(rollingUpdate.MaxSurge, fldPath.Child("maxSurge"))...)
+	allErrs = append(allErrs, ValidatePositiveIntOrPercent(rollingUpdate.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	allErrs = append(allErrs, IsNotMoreThan100Percent(rollingUpdate.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+
+	if (rollingUpdate.MaxUnavailable == nil || getIntOrPercentValue(*rollingUpdate.MaxUnavailable) == 0) && (rollingUpdate.MaxSurge != nil && getIntOrPercentValue(*rollingUpdate.MaxSurge) == 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable, "may not be 0 when `maxSurge` is 0"))
+	}
+
+	if len(rollingUpdate.CanarySteps) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("canarySteps"), "at least one canary step must be specified"))
+	}
+
+	// Steps given as numbers and steps given as percentages can only be compared with each other once the number of
+	// machines is known, hence they are checked separately for being increasing.
+	var lastCount, lastPercent int
+	for i, step := range rollingUpdate.CanarySteps {
+		idxPath := fldPath.Child("canarySteps").Index(i)
+
+		if stepErrs := ValidatePositiveIntOrPercent(&step, idxPath); len(stepErrs) > 0 {
+			allErrs = append(allErrs, stepErrs...)
+			continue
+		}
+
+		value, isPercent := getPercentValue(step)
+		switch {
+		case isPercent && value >= 100:
+			allErrs = append(allErrs, field.Invalid(idxPath, step, "must be less than 100%"))
+		case isPercent && value <= lastPercent, !isPercent && step.IntValue() <= lastCount:
+			allErrs = append(allErrs, field.Invalid(idxPath, step, "must be greater than zero and greater than the previous steps"))
+		case isPercent:
+			lastPercent = value
+		default:
+			lastCount = step.IntValue()
+		}
+	}
+
+	if rollingUpdate.SoakPeriod != nil && rollingUpdate.SoakPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakPeriod"), rollingUpdate.SoakPeriod.Duration, "can not be negative"))
+	}
+
+	return allErrs
+}
+
+// validateWorkerUpdateStrategyUpdate validates that the update strategy of a worker pool is not changed and that worker
+// pools with the in-place update strategy are not changed in a way which requires new machines.
+func validateWorkerUpdateStrategyUpdate(newWorker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if helper.IsUpdateStrategyInPlace(&newWorker) != helper.IsUpdateStrategyInPlace(&oldWorker) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("updateStrategy"), "cannot switch a worker pool between rolling and in-place updates"))
+		return allErrs
+	}
+
+	if !helper.IsUpdateStrategyInPlace(&newWorker) {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Machine.Type, oldWorker.Machine.Type, fldPath.Child("machine", "type"))...)
+	if newWorker.Machine.Image != nil && oldWorker.Machine.Image != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Machine.Image.Name, oldWorker.Machine.Image.Name, fldPath.Child("machine", "image", "name"))...)
+	}
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Volume, oldWorker.Volume, fldPath.Child("volume"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.CRI, oldWorker.CRI, fldPath.Child("cri"))...)
+
+	return allErrs
+}
+
+func validateWorkerHeadroom(headroom *core.WorkerHeadroom, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(headroom.Replicas), fldPath.Child("replicas"))...)
+
+	if headroom.CPU == nil && headroom.Memory == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of cpu or memory must be set"))
+	}
+	if headroom.CPU != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("cpu", *headroom.CPU, fldPath.Child("cpu"))...)
+	}
+	if headroom.Memory != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("memory", *headroom.Memory, fldPath.Child("memory"))...)
+	}
+
+	return allErrs
+}
+
+func validateWorkerExistingHosts(worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if validity := worker.ExistingHosts.BootstrapTokenValidity; validity != nil && validity.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("existingHosts", "bootstrapTokenValidity"), validity.Duration, "must be a positive duration"))
+	}
+	if worker.ClusterAutoscaler != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("clusterAutoscaler"), "cannot be set for worker pools with existing hosts"))
+	}
+	if worker.MachineControllerManagerSettings != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("machineControllerManager"), "cannot be set for worker pools with existing hosts"))
+	}
+	if worker.Headroom != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("headroom"), "cannot be set for worker pools with existing hosts"))
+	}
+	if worker.RollingUpdate != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"), "cannot be set for worker pools with existing hosts"))
 	}
 
 	return allErrs
@@ -1777,10 +2099,21 @@ func ValidateClusterAutoscalerOptions(caOptions *core.ClusterAutoscalerOptions,
 	if maxNodeProvisionTime := caOptions.MaxNodeProvisionTime; maxNodeProvisionTime != nil && maxNodeProvisionTime.Duration < 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodeProvisionTime"), *maxNodeProvisionTime, "can not be negative"))
 	}
+	if priority := caOptions.Priority; priority != nil && *priority < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), *priority, "can not be negative"))
+	}
 
 	return allErrs
 }
 
+func isPriorityExpanderConfigured(clusterAutoscaler *core.ClusterAutoscaler) bool {
+	if clusterAutoscaler == nil || clusterAutoscaler.Expander == nil {
+		return false
+	}
+
+	return slices.Contains(strings.Split(string(*clusterAutoscaler.Expander), ","), string(core.ClusterAutoscalerExpanderPriority))
+}
+
 // PodPIDsLimitMinimum is a constant for the minimum value for the podPIDsLimit field.
 const PodPIDsLimitMinimum int64 = 100
 
@@ -1876,6 +2209,68 @@ func ValidateKubeletConfig(kubeletConfig core.KubeletConfig, version string, fld
 		}
 	}
 
+	allErrs = append(allErrs, validateKubeletCredentialProviders(kubeletConfig.CredentialProviders, version, fldPath.Child("credentialProviders"))...)
+
+	return allErrs
+}
+
+func validateKubeletCredentialProviders(credentialProviders []core.KubeletCredentialProvider, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedAPIVersions := []string{"credentialprovider.kubelet.k8s.io/v1beta1"}
+	if k8sGreaterEqual126, _ := versionutils.CheckVersionMeetsConstraint(version, ">= 1.26"); k8sGreaterEqual126 {
+		supportedAPIVersions = append(supportedAPIVersions, "credentialprovider.kubelet.k8s.io/v1")
+	}
+
+	names := sets.New[string]()
+	for i, provider := range credentialProviders {
+		idxPath := fldPath.Index(i)
+
+		if len(provider.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(provider.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), provider.Name, msg))
+			}
+			if names.Has(provider.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), provider.Name))
+			}
+			names.Insert(provider.Name)
+		}
+
+		if len(provider.MatchImages) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("matchImages"), "must provide at least one image pattern"))
+		}
+		for j, matchImage := range provider.MatchImages {
+			if len(matchImage) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("matchImages").Index(j), "must not be empty"))
+			}
+		}
+
+		if provider.DefaultCacheDuration == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("defaultCacheDuration"), "must provide a default cache duration"))
+		} else if provider.DefaultCacheDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("defaultCacheDuration"), provider.DefaultCacheDuration.Duration.String(), "must not be negative"))
+		}
+
+		if provider.APIVersion != nil && !slices.Contains(supportedAPIVersions, *provider.APIVersion) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("apiVersion"), *provider.APIVersion, supportedAPIVersions))
+		}
+
+		envNames := sets.New[string]()
+		for j, env := range provider.Env {
+			envPath := idxPath.Child("env").Index(j)
+			if len(env.Name) == 0 {
+				allErrs = append(allErrs, field.Required(envPath.Child("name"), "must provide a name"))
+				continue
+			}
+			if envNames.Has(env.Name) {
+				allErrs = append(allErrs, field.Duplicate(envPath.Child("name"), env.Name))
+			}
+			envNames.Insert(env.Name)
+		}
+	}
+
 	return allErrs
 }
 
@@ -2180,6 +2575,16 @@ func ValidateHibernationSchedule(seenSpecs sets.Set[string], schedule *core.Hibe
 	if schedule.Location != nil {
 		allErrs = append(allErrs, ValidateHibernationScheduleLocation(*schedule.Location, fldPath.Child("location"))...)
 	}
+	for i, exception := range schedule.Exceptions {
+		if _, err := timewindow.ParseDateRange(exception.Start, ptr.Deref(exception.End, "")); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("exceptions").Index(i), exception, err.Error()))
+		}
+	}
+	if schedule.HolidayCalendarConfigMapName != nil {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(*schedule.HolidayCalendarConfigMapName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("holidayCalendarConfigMapName"), *schedule.HolidayCalendarConfigMapName, msg))
+		}
+	}
 
 	return allErrs
 }
@@ -2315,6 +2720,10 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
 
+	if tier := systemComponents.ResourceTier; tier != nil && !availableSystemComponentsResourceTiers.Has(string(*tier)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("resourceTier"), *tier, sets.List(availableSystemComponentsResourceTiers)))
+	}
+
 	return allErrs
 }
 
@@ -2326,16 +2735,156 @@ func validateCoreDNS(coreDNS *core.CoreDNS, fldPath *field.Path) field.ErrorList
 		return allErrs
 	}
 
-	if coreDNS.Autoscaling != nil && !availableCoreDNSAutoscalingModes.Has(string(coreDNS.Autoscaling.Mode)) {
-		allErrs = append(allErrs, field.NotSupported(fldPath.Child("autoscaling").Child("mode"), coreDNS.Autoscaling.Mode, sets.List(availableCoreDNSAutoscalingModes)))
+	if coreDNS.Autoscaling != nil {
+		allErrs = append(allErrs, validateCoreDNSAutoscaling(coreDNS.Autoscaling, fldPath.Child("autoscaling"))...)
 	}
 	if coreDNS.Rewriting != nil {
 		allErrs = append(allErrs, ValidateCoreDNSRewritingCommonSuffixes(coreDNS.Rewriting.CommonSuffixes, fldPath.Child("rewriting"))...)
 	}
+	allErrs = append(allErrs, validateCoreDNSPlugins(coreDNS.Plugins, fldPath.Child("plugins"))...)
+	if coreDNS.Cache != nil {
+		allErrs = append(allErrs, validateCoreDNSCache(coreDNS.Cache, fldPath.Child("cache"))...)
+	}
+	allErrs = append(allErrs, validateCoreDNSForwardZones(coreDNS.ForwardZones, fldPath.Child("forwardZones"))...)
+
+	return allErrs
+}
+
+func validateCoreDNSAutoscaling(autoscaling *core.CoreDNSAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableCoreDNSAutoscalingModes.Has(string(autoscaling.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), autoscaling.Mode, sets.List(availableCoreDNSAutoscalingModes)))
+	}
+
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be greater than 0"))
+	}
+
+	if autoscaling.MaxReplicas != nil {
+		if autoscaling.Mode != core.CoreDNSAutoscalingModeHorizontal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxReplicas"), fmt.Sprintf("is only supported for autoscaling mode %q", core.CoreDNSAutoscalingModeHorizontal)))
+		} else if *autoscaling.MaxReplicas < ptr.Deref(autoscaling.MinReplicas, 1) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be greater than or equal to minReplicas"))
+		}
+	}
+
+	for name, value := range map[string]*int32{"coresPerReplica": autoscaling.CoresPerReplica, "nodesPerReplica": autoscaling.NodesPerReplica} {
+		if value == nil {
+			continue
+		}
+
+		if autoscaling.Mode != core.CoreDNSAutoscalingModeClusterProportional {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(name), fmt.Sprintf("is only supported for autoscaling mode %q", core.CoreDNSAutoscalingModeClusterProportional)))
+		} else if *value < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *value, "must be greater than 0"))
+		}
+	}
 
 	return allErrs
 }
 
+func validateCoreDNSPlugins(plugins []core.CoreDNSPlugin, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, plugin := range plugins {
+		idxPath := fldPath.Index(i)
+
+		maxArgs, ok := availableCoreDNSPlugins[plugin.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, sets.List(sets.KeySet(availableCoreDNSPlugins))))
+			continue
+		}
+
+		if names.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names.Insert(plugin.Name)
+
+		if len(plugin.Args) > maxArgs {
+			allErrs = append(allErrs, field.TooMany(idxPath.Child("args"), len(plugin.Args), maxArgs))
+		}
+
+		for j, arg := range plugin.Args {
+			if !coreDNSPluginArgRegex.MatchString(arg) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("args").Index(j), arg, fmt.Sprintf("must match regex %q", coreDNSPluginArgRegex.String())))
+			}
+		}
+
+		if plugin.Name == "bufsize" && len(plugin.Args) == 1 {
+			if size, err := strconv.Atoi(plugin.Args[0]); err != nil || size < 512 || size > 4096 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("args").Index(0), plugin.Args[0], "must be an integer between 512 and 4096"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateCoreDNSCache(cache *core.CoreDNSCache, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, value := range map[string]*int32{"ttl": cache.TTL, "successCapacity": cache.SuccessCapacity, "denialCapacity": cache.DenialCapacity} {
+		if value != nil && *value < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *value, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCoreDNSForwardZones(forwardZones []core.CoreDNSForwardZone, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		zones   = sets.New[string]()
+	)
+
+	for i, forwardZone := range forwardZones {
+		var (
+			idxPath = fldPath.Index(i)
+			zone    = strings.TrimSuffix(forwardZone.Zone, ".")
+		)
+
+		for _, msg := range validation.IsDNS1123Subdomain(zone) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("zone"), forwardZone.Zone, msg))
+		}
+		if zone == gardencorev1beta1.DefaultDomain || strings.HasSuffix(zone, "."+gardencorev1beta1.DefaultDomain) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("zone"), "requests for the cluster domain cannot be forwarded"))
+		}
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), forwardZone.Zone))
+		}
+		zones.Insert(zone)
+
+		if len(forwardZone.Upstreams) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstreams"), "at least one upstream name server must be specified"))
+		} else if len(forwardZone.Upstreams) > 15 {
+			allErrs = append(allErrs, field.TooMany(idxPath.Child("upstreams"), len(forwardZone.Upstreams), 15))
+		}
+
+		for j, upstream := range forwardZone.Upstreams {
+			if !isValidCoreDNSUpstream(upstream) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("upstreams").Index(j), upstream, "must be a valid IP address, optionally with port"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func isValidCoreDNSUpstream(upstream string) bool {
+	host, port, err := net.SplitHostPort(upstream)
+	if err != nil {
+		return net.ParseIP(upstream) != nil
+	}
+
+	portNum, err := strconv.Atoi(port)
+	return err == nil && len(validation.IsValidPortNum(portNum)) == 0 && net.ParseIP(host) != nil
+}
+
 // ValidateFinalizersOnCreation validates the finalizers of a Shoot object.
 func ValidateFinalizersOnCreation(finalizers []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2401,8 +2950,8 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 	}
 
 	if maintenanceOperation != "" {
-		if !availableShootMaintenanceOperations.Has(maintenanceOperation) {
-			allErrs = append(allErrs, field.NotSupported(fldPathMaintOp, maintenanceOperation, sets.List(availableShootMaintenanceOperations)))
+		if supportedMaintenanceOperations := availableShootMaintenanceOperations.Clone().Insert(v1beta1constants.ShootMaintenanceOperationPreview); !supportedMaintenanceOperations.Has(maintenanceOperation) {
+			allErrs = append(allErrs, field.NotSupported(fldPathMaintOp, maintenanceOperation, sets.List(supportedMaintenanceOperations)))
 		}
 		if helper.IsShootInHibernation(shoot) && forbiddenShootOperationsWhenHibernated.Has(maintenanceOperation) {
 			allErrs = append(allErrs, field.Forbidden(fldPathMaintOp, "operation is not permitted when shoot is hibernated or is waking up"))
@@ -2424,10 +2973,61 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 	return allErrs
 }
 
+func validateShootDebugModeUntil(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if until, ok := annotations[v1beta1constants.AnnotationShootDebugModeUntil]; ok {
+		if _, err := time.Parse(time.RFC3339, until); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootDebugModeUntil), until, fmt.Sprintf("must be a timestamp in RFC3339 format: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootBackupBucketMigrationTarget(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if target, ok := annotations[v1beta1constants.AnnotationShootBackupBucketMigrationTarget]; ok {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(target, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootBackupBucketMigrationTarget), target, msg))
+		}
+	}
+
+	return allErrs
+}
+
 func validateShootOperationContext(operation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	switch operation {
+	case v1beta1constants.ShootOperationEnableDebugMode:
+		if shoot.Status.LastOperation == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot enable debug mode if shoot was not yet created"))
+		}
+
+	case v1beta1constants.ShootOperationEnableDualStack:
+		if shoot.Spec.Networking == nil || !core.IsIPv4SingleStack(shoot.Spec.Networking.IPFamilies) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot migrate to dual-stack networking if shoot is not IPv4 single-stack"))
+		}
+		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot migrate to dual-stack networking if shoot was not yet created successfully or is not ready for reconciliation"))
+		}
+
+	case v1beta1constants.ShootOperationMigrateBackupBucket:
+		if len(shoot.Annotations[v1beta1constants.AnnotationShootBackupBucketMigrationTarget]) == 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("cannot migrate backup bucket if annotation %q is not set", v1beta1constants.AnnotationShootBackupBucketMigrationTarget)))
+		}
+		if shoot.Spec.SeedName == nil || !ptr.Equal(shoot.Spec.SeedName, shoot.Status.SeedName) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot migrate backup bucket if shoot is not scheduled or its control plane is being migrated"))
+		}
+		if helper.IsShootInHibernation(shoot) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot migrate backup bucket if shoot is hibernated or waking up"))
+		}
+		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot migrate backup bucket if shoot was not yet created successfully or is not ready for reconciliation"))
+		}
+
 	case v1beta1constants.OperationRotateCredentialsStart:
 		if !isShootReadyForRotationStart(shoot.Status.LastOperation) {
 			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot start rotation of all credentials if shoot was not yet created successfully or is not ready for reconciliation"))
@@ -2610,5 +3210,15 @@ func validateShootManagedIssuer(shoot *core.Shoot) field.ErrorList {
 		}
 	}
 
+	if hostname, ok := shoot.Annotations[v1beta1constants.AnnotationAuthenticationIssuerHostname]; ok {
+		fldPath := field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationAuthenticationIssuerHostname)
+		if !helper.HasManagedIssuer(shoot) {
+			allErrors = append(allErrors, field.Forbidden(fldPath, "custom issuer hostname can only be set when managed shoot issuer is enabled"))
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(hostname) {
+			allErrors = append(allErrors, field.Invalid(fldPath, hostname, msg))
+		}
+	}
+
 	return allErrors
 }
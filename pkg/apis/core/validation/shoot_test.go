@@ -1228,6 +1228,113 @@ var _ = Describe("Shoot Validation Tests", func() {
 				errorList := ValidateShootUpdate(newShoot, shoot)
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow a custom issuer hostname for shoots with managed issuer", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer":          "managed",
+					"authentication.gardener.cloud/issuer-hostname": "issuer.example.com",
+				}
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should not allow a custom issuer hostname for shoots without managed issuer", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer-hostname": "issuer.example.com",
+				}
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("metadata.annotations[authentication.gardener.cloud/issuer-hostname]"),
+					"Detail": ContainSubstring("custom issuer hostname can only be set when managed shoot issuer is enabled"),
+				}))))
+			})
+
+			It("should not allow an invalid custom issuer hostname", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer":          "managed",
+					"authentication.gardener.cloud/issuer-hostname": "https://issuer.example.com/foo",
+				}
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[authentication.gardener.cloud/issuer-hostname]"),
+				}))))
+			})
+
+			It("should allow setting a custom issuer hostname for shoots with managed issuer", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer": "managed",
+				}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Annotations["authentication.gardener.cloud/issuer-hostname"] = "issuer.example.com"
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should not allow changing the custom issuer hostname", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer":          "managed",
+					"authentication.gardener.cloud/issuer-hostname": "issuer.example.com",
+				}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Annotations["authentication.gardener.cloud/issuer-hostname"] = "other.example.com"
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("metadata.annotations[authentication.gardener.cloud/issuer-hostname]"),
+					"Detail": ContainSubstring("once set the custom hostname of the managed shoot issuer cannot be changed or removed"),
+				}))))
+			})
+
+			It("should not allow removing the custom issuer hostname", func() {
+				shoot.Annotations = map[string]string{
+					"authentication.gardener.cloud/issuer":          "managed",
+					"authentication.gardener.cloud/issuer-hostname": "issuer.example.com",
+				}
+				newShoot := prepareShootForUpdate(shoot)
+				delete(newShoot.Annotations, "authentication.gardener.cloud/issuer-hostname")
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.annotations[authentication.gardener.cloud/issuer-hostname]"),
+				}))))
+			})
+		})
+
+		Context("IPAM", func() {
+			It("should allow keeping the allocated networks", func() {
+				shoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services"}
+				newShoot := prepareShootForUpdate(shoot)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			DescribeTable("should forbid changing the allocated networks",
+				func(oldValue, newValue *string) {
+					shoot.Annotations = map[string]string{}
+					if oldValue != nil {
+						shoot.Annotations["ipam.gardener.cloud/allocated-networks"] = *oldValue
+					}
+					newShoot := prepareShootForUpdate(shoot)
+					delete(newShoot.Annotations, "ipam.gardener.cloud/allocated-networks")
+					if newValue != nil {
+						newShoot.Annotations["ipam.gardener.cloud/allocated-networks"] = *newValue
+					}
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("metadata.annotations[ipam.gardener.cloud/allocated-networks]"),
+					}))))
+				},
+
+				Entry("adding", nil, ptr.To("pods")),
+				Entry("changing", ptr.To("pods"), ptr.To("pods,nodes")),
+				Entry("removing", ptr.To("pods"), nil),
+			)
 		})
 
 		Context("Provider validation", func() {
@@ -1371,6 +1478,79 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should forbid switching a worker pool to existing hosts", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].ExistingHosts = &core.WorkerExistingHosts{}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.workers[0].existingHosts"),
+					"Detail": ContainSubstring("cannot switch a worker pool between provisioned machines and existing hosts"),
+				}))))
+			})
+
+			Context("update strategy", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.MachineUpdateStrategyInPlace)
+				})
+
+				It("should forbid switching a worker pool to rolling updates", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.MachineUpdateStrategyRollingUpdate)
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.provider.workers[0].updateStrategy"),
+						"Detail": ContainSubstring("cannot switch a worker pool between rolling and in-place updates"),
+					}))))
+				})
+
+				It("should allow updating the machine image version of worker pools with in-place updates", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Provider.Workers[0].Machine.Image.Version = "2.0.0"
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+				})
+
+				It("should forbid changes requiring new machines for worker pools with in-place updates", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Provider.Workers[0].Machine.Type = "xlarge"
+					newShoot.Spec.Provider.Workers[0].Machine.Image.Name = "other-image"
+					newShoot.Spec.Provider.Workers[0].Volume = &core.Volume{VolumeSize: "50Gi"}
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].machine.type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].machine.image.name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].volume"),
+						})),
+					))
+				})
+			})
+
+			It("should allow adding a worker pool with existing hosts", func() {
+				newShoot := prepareShootForUpdate(shoot)
+
+				worker := *shoot.Spec.Provider.Workers[0].DeepCopy()
+				worker.Name = "second-worker"
+				worker.ExistingHosts = &core.WorkerExistingHosts{}
+
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, worker)
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
 			It("should allow removing a worker pool", func() {
 				newShoot := prepareShootForUpdate(shoot)
 
@@ -1514,7 +1694,30 @@ var _ = Describe("Shoot Validation Tests", func() {
 					Entry("invalid negative MaxNodeProvisionTime", core.ClusterAutoscalerOptions{
 						MaxNodeProvisionTime: ptr.To(negativeDuration),
 					}, ConsistOf(field.Invalid(field.NewPath("maxNodeProvisionTime"), negativeDuration, "can not be negative"))),
+					Entry("valid with Priority", core.ClusterAutoscalerOptions{
+						Priority: ptr.To[int32](10),
+					}, BeEmpty()),
+					Entry("invalid negative Priority", core.ClusterAutoscalerOptions{
+						Priority: ptr.To[int32](-1),
+					}, ConsistOf(field.Invalid(field.NewPath("priority"), int32(-1), "can not be negative"))),
 				)
+
+				It("should forbid worker pool priorities if the priority expander is not configured", func() {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &core.ClusterAutoscaler{Expander: ptr.To(core.ClusterAutoscalerExpanderLeastWaste)}
+					shoot.Spec.Provider.Workers[0].ClusterAutoscaler = &core.ClusterAutoscalerOptions{Priority: ptr.To[int32](10)}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.workers[0].autoscaler.priority"),
+					}))))
+				})
+
+				It("should allow worker pool priorities if the priority expander is configured", func() {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &core.ClusterAutoscaler{Expander: ptr.To(core.ExpanderMode("priority,least-waste"))}
+					shoot.Spec.Provider.Workers[0].ClusterAutoscaler = &core.ClusterAutoscalerOptions{Priority: ptr.To[int32](10)}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
 			})
 		})
 
@@ -1544,6 +1747,26 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow enabling the certificate service for shoots with a domain", func() {
+				shoot.Spec.SystemComponents = &core.SystemComponents{CertificateService: &core.CertificateService{Enabled: true}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid enabling the certificate service for shoots without a domain", func() {
+				shoot.Spec.DNS = nil
+				shoot.Spec.SystemComponents = &core.SystemComponents{CertificateService: &core.CertificateService{Enabled: true}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.systemComponents.certificateService.enabled"),
+				}))))
+			})
+
 			It("should forbid specifying invalid domain", func() {
 				shoot.Spec.DNS.Providers = nil
 				shoot.Spec.DNS.Domain = ptr.To("foo/bar.baz")
@@ -1755,6 +1978,66 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.dns.providers[1].primary"),
 				}))))
 			})
+
+			It("should allow a secondary provider", func() {
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers, core.DNSProvider{
+					Secondary:  ptr.To(true),
+					SecretName: &secretName,
+					Type:       ptr.To("other-provider"),
+				})
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid secondary providers", func() {
+				shoot.Spec.DNS.Providers[0].Secondary = ptr.To(true)
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers,
+					core.DNSProvider{
+						Secondary: ptr.To(true),
+						Type:      ptr.To(core.DNSUnmanaged),
+					},
+				)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.dns.providers[0].secondary"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.providers[0].secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.dns.providers[1].secondary"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.providers[1].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.providers[1].secretName"),
+					})),
+				))
+			})
+
+			It("should forbid a secondary provider without primary provider", func() {
+				shoot.Spec.DNS.Providers = []core.DNSProvider{{
+					Secondary:  ptr.To(true),
+					SecretName: &secretName,
+					Type:       &dnsProviderType,
+				}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dns.providers"),
+				}))))
+			})
 		})
 
 		Context("KubeAPIServer validation", func() {
@@ -2289,6 +2572,31 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("profile", func() {
+				DescribeTable("should allow supported profiles",
+					func(profile core.KubeAPIServerProfile) {
+						shoot.Spec.Kubernetes.KubeAPIServer.Profile = &profile
+
+						Expect(ValidateShoot(shoot)).To(BeEmpty())
+					},
+
+					Entry("small", core.KubeAPIServerProfileSmall),
+					Entry("large", core.KubeAPIServerProfileLarge),
+					Entry("ci-burst", core.KubeAPIServerProfileCIBurst),
+				)
+
+				It("should forbid unsupported profiles", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Profile = ptr.To(core.KubeAPIServerProfile("huge"))
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.profile"),
+					}))))
+				})
+			})
+
 			Context("service account config", func() {
 				It("should not allow to specify a negative max token duration", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
@@ -3044,6 +3352,77 @@ var _ = Describe("Shoot Validation Tests", func() {
 			)
 		})
 
+		Context("PKI validation", func() {
+			DescribeTable("PKI values",
+				func(pki *core.PKI, matcher gomegatypes.GomegaMatcher) {
+					Expect(ValidatePKI(pki, field.NewPath("pki"))).To(matcher)
+				},
+				Entry("nil", nil, BeEmpty()),
+				Entry("empty", &core.PKI{}, BeEmpty()),
+				Entry("valid RSA configuration", &core.PKI{
+					KeyAlgorithm:                 ptr.To(core.KeyAlgorithmRSA),
+					KeySize:                      ptr.To[int32](4096),
+					CertificateAuthorityValidity: &metav1.Duration{Duration: 5 * 365 * 24 * time.Hour},
+					CertificateValidity:          &metav1.Duration{Duration: 90 * 24 * time.Hour},
+				}, BeEmpty()),
+				Entry("valid ECDSA configuration", &core.PKI{
+					KeyAlgorithm: ptr.To(core.KeyAlgorithmECDSA),
+					KeySize:      ptr.To[int32](384),
+				}, BeEmpty()),
+				Entry("unsupported key algorithm", &core.PKI{
+					KeyAlgorithm: ptr.To(core.KeyAlgorithm("DSA")),
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("pki.keyAlgorithm"),
+				})))),
+				Entry("unsupported key size for default key algorithm", &core.PKI{
+					KeySize: ptr.To[int32](2048),
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("pki.keySize"),
+					"Detail": Equal(`supported key sizes for key algorithm "RSA" are [3072 4096]`),
+				})))),
+				Entry("unsupported key size for ECDSA", &core.PKI{
+					KeyAlgorithm: ptr.To(core.KeyAlgorithmECDSA),
+					KeySize:      ptr.To[int32](3072),
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("pki.keySize"),
+				})))),
+				Entry("too short validities", &core.PKI{
+					CertificateAuthorityValidity: &metav1.Duration{Duration: 24 * time.Hour},
+					CertificateValidity:          &metav1.Duration{Duration: time.Hour},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("pki.certificateAuthorityValidity"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("pki.certificateValidity"),
+						"Detail": Equal("must be at least 24 hours"),
+					})),
+				)),
+				Entry("certificate validity exceeding CA validity", &core.PKI{
+					CertificateAuthorityValidity: &metav1.Duration{Duration: 365 * 24 * time.Hour},
+					CertificateValidity:          &metav1.Duration{Duration: 2 * 365 * 24 * time.Hour},
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("pki.certificateValidity"),
+					"Detail": Equal("must not exceed the validity of the certificate authorities"),
+				})))),
+			)
+
+			It("should validate the PKI configuration of the shoot", func() {
+				shoot.Spec.Kubernetes.PKI = &core.PKI{KeySize: ptr.To[int32](256)}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.pki.keySize"),
+				}))))
+			})
+		})
+
 		Context("AuditConfig validation", func() {
 			It("should forbid empty name", func() {
 				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name = ""
@@ -3056,6 +3435,30 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow referencing a cluster audit policy", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy = &core.AuditPolicy{ClusterAuditPolicyName: ptr.To("default")}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid referencing a cluster audit policy together with a config map", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ClusterAuditPolicyName = ptr.To("default")
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy.clusterAuditPolicyName"),
+				}))))
+			})
+
+			It("should forbid invalid cluster audit policy names", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy = &core.AuditPolicy{ClusterAuditPolicyName: ptr.To("Foo_Bar")}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy.clusterAuditPolicyName"),
+				}))))
+			})
+
 			It("should allow nil AuditConfig", func() {
 				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = nil
 				errorList := ValidateShoot(shoot)
@@ -3382,6 +3785,67 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			Context("pods CIDR update", func() {
+				BeforeEach(func() {
+					shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/16")
+					shoot.Spec.Networking.Services = ptr.To("100.64.0.0/13")
+					shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/16")
+				})
+
+				It("should forbid changing the pods CIDR if the ShootNetworkingCIDRExpansion feature gate is disabled", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.Pods = ptr.To("100.96.0.0/15")
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.pods"),
+						"Detail": Equal("field is immutable"),
+					}))))
+				})
+
+				Context("ShootNetworkingCIDRExpansion feature gate is enabled", func() {
+					BeforeEach(func() {
+						DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootNetworkingCIDRExpansion, true))
+					})
+
+					It("should allow expanding the pods CIDR", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Networking.Pods = ptr.To("100.96.0.0/14")
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+					})
+
+					DescribeTable("should forbid changing the pods CIDR to a CIDR not containing the previous one",
+						func(pods string) {
+							newShoot := prepareShootForUpdate(shoot)
+							newShoot.Spec.Networking.Pods = ptr.To(pods)
+
+							Expect(ValidateShootUpdate(newShoot, shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.networking.pods"),
+								"Detail": ContainSubstring("CIDR can only be expanded to a larger CIDR containing the previous CIDR"),
+							}))))
+						},
+						Entry("smaller CIDR", "100.96.0.0/17"),
+						Entry("disjoint CIDR", "100.100.0.0/16"),
+						Entry("larger disjoint CIDR", "100.100.0.0/15"),
+						Entry("other IP family", "2001:db8:1::/48"),
+					)
+
+					It("should forbid removing the pods CIDR", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Networking.Pods = nil
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.networking.pods"),
+						}))))
+					})
+				})
+			})
+
 			It("should forbid specifying unsupported IP family", func() {
 				shoot.Spec.Networking.IPFamilies = []core.IPFamily{"IPv5"}
 
@@ -3708,11 +4172,109 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})))),
 				Entry("empty system components", &core.SystemComponents{}, false, BeEmpty()),
 				Entry("empty core dns", &core.SystemComponents{CoreDNS: &core.CoreDNS{}}, false, BeEmpty()),
+				Entry("small resource tier", &core.SystemComponents{ResourceTier: ptr.To(core.SystemComponentsResourceTierSmall)}, false, BeEmpty()),
+				Entry("large resource tier", &core.SystemComponents{ResourceTier: ptr.To(core.SystemComponentsResourceTierLarge)}, false, BeEmpty()),
+				Entry("unsupported resource tier", &core.SystemComponents{ResourceTier: ptr.To[core.SystemComponentsResourceTier]("huge")}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("resourceTier"),
+				})))),
 				Entry("horizontal core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeHorizontal}}}, false, BeEmpty()),
 				Entry("cluster proportional core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeHorizontal}}}, false, BeEmpty()),
 				Entry("incorrect core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: "dummy"}}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeNotSupported),
 				})))),
+				Entry("horizontal core dns autoscaler with replica bounds", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeHorizontal, MinReplicas: ptr.To[int32](3), MaxReplicas: ptr.To[int32](10)}}}, false, BeEmpty()),
+				Entry("horizontal core dns autoscaler with invalid replica bounds", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeHorizontal, MinReplicas: ptr.To[int32](3), MaxReplicas: ptr.To[int32](2), CoresPerReplica: ptr.To[int32](128)}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.autoscaling.maxReplicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("coreDNS.autoscaling.coresPerReplica"),
+					})),
+				)),
+				Entry("cluster proportional core dns autoscaler with parameters", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeClusterProportional, MinReplicas: ptr.To[int32](2), CoresPerReplica: ptr.To[int32](128), NodesPerReplica: ptr.To[int32](8)}}}, false, BeEmpty()),
+				Entry("cluster proportional core dns autoscaler with invalid parameters", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: core.CoreDNSAutoscalingModeClusterProportional, MinReplicas: ptr.To[int32](0), MaxReplicas: ptr.To[int32](5), NodesPerReplica: ptr.To[int32](0)}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.autoscaling.minReplicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("coreDNS.autoscaling.maxReplicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.autoscaling.nodesPerReplica"),
+					})),
+				)),
+				Entry("valid core dns plugins", &core.SystemComponents{CoreDNS: &core.CoreDNS{Plugins: []core.CoreDNSPlugin{{Name: "bufsize", Args: []string{"1232"}}, {Name: "minimal"}, {Name: "nsid", Args: []string{"my-cluster"}}}}}, false, BeEmpty()),
+				Entry("invalid core dns plugins", &core.SystemComponents{CoreDNS: &core.CoreDNS{Plugins: []core.CoreDNSPlugin{{Name: "file"}, {Name: "minimal", Args: []string{"foo"}}, {Name: "minimal"}, {Name: "bufsize", Args: []string{"100000"}}, {Name: "nsid", Args: []string{"foo }"}}}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("coreDNS.plugins[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("coreDNS.plugins[1].args"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("coreDNS.plugins[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("coreDNS.plugins[3].args[0]"),
+						"Detail": Equal("must be an integer between 512 and 4096"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.plugins[4].args[0]"),
+					})),
+				)),
+				Entry("valid core dns cache", &core.SystemComponents{CoreDNS: &core.CoreDNS{Cache: &core.CoreDNSCache{TTL: ptr.To[int32](60), SuccessCapacity: ptr.To[int32](20000), DenialCapacity: ptr.To[int32](5000)}}}, false, BeEmpty()),
+				Entry("invalid core dns cache", &core.SystemComponents{CoreDNS: &core.CoreDNS{Cache: &core.CoreDNSCache{TTL: ptr.To[int32](0), DenialCapacity: ptr.To[int32](-1)}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.cache.ttl"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.cache.denialCapacity"),
+					})),
+				)),
+				Entry("valid core dns forward zones", &core.SystemComponents{CoreDNS: &core.CoreDNS{ForwardZones: []core.CoreDNSForwardZone{{Zone: "corp.example.com", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}}, {Zone: "example.org.", Upstreams: []string{"2001:db8::1", "[2001:db8::2]:53"}}}}}, false, BeEmpty()),
+				Entry("invalid core dns forward zones", &core.SystemComponents{CoreDNS: &core.CoreDNS{ForwardZones: []core.CoreDNSForwardZone{{Zone: "foo_bar", Upstreams: []string{"10.0.0.10"}}, {Zone: "svc.cluster.local", Upstreams: []string{"10.0.0.10"}}, {Zone: "foo_bar.", Upstreams: []string{"dns.example.com", "10.0.0.10:70000"}}, {Zone: "example.com"}}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.forwardZones[0].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("coreDNS.forwardZones[1].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.forwardZones[2].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("coreDNS.forwardZones[2].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.forwardZones[2].upstreams[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("coreDNS.forwardZones[2].upstreams[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("coreDNS.forwardZones[3].upstreams"),
+					})),
+				)),
 			)
 		})
 
@@ -3740,9 +4302,200 @@ var _ = Describe("Shoot Validation Tests", func() {
 			)
 		})
 
-		Context("operation validation", func() {
-			It("should do nothing if the operation annotation is not set", func() {
-				Expect(ValidateShoot(shoot)).To(BeEmpty())
+		Context("operation validation", func() {
+			It("should do nothing if the operation annotation is not set", func() {
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			Context("debug mode", func() {
+				It("should forbid enabling the debug mode if the shoot was not yet created", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "enable-debug-mode")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot enable debug mode if shoot was not yet created"),
+					}))))
+				})
+
+				It("should allow enabling and disabling the debug mode for existing shoots", func() {
+					shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateFailed}
+
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "enable-debug-mode")
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "disable-debug-mode")
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid an invalid end of the debug mode time window", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/debug-mode-until", "tomorrow")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/debug-mode-until]"),
+					}))))
+				})
+
+				It("should allow extending the debug mode time window up to the maximum duration", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/debug-mode-until", time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Annotations["shoot.gardener.cloud/debug-mode-until"] = time.Now().Add(23 * time.Hour).UTC().Format(time.RFC3339)
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+				})
+
+				It("should forbid enabling the debug mode for longer than the maximum duration", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, "shoot.gardener.cloud/debug-mode-until", time.Now().Add(25*time.Hour).UTC().Format(time.RFC3339))
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("metadata.annotations[shoot.gardener.cloud/debug-mode-until]"),
+						"Detail": Equal("debug mode must not be enabled for longer than 24h0m0s"),
+					}))))
+				})
+
+				It("should allow keeping an unchanged debug mode time window", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/debug-mode-until", time.Now().Add(48*time.Hour).UTC().Format(time.RFC3339))
+					newShoot := prepareShootForUpdate(shoot)
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+				})
+			})
+
+			Context("dual-stack migration", func() {
+				BeforeEach(func() {
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+					shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateSucceeded}
+				})
+
+				It("should allow migrating IPv4 single-stack shoots", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "enable-dual-stack")
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid migrating shoots which are not IPv4 single-stack", func() {
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv6}
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "enable-dual-stack")
+
+					Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate to dual-stack networking if shoot is not IPv4 single-stack"),
+					}))))
+				})
+
+				It("should forbid migrating shoots which were not yet created successfully", func() {
+					shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeCreate, State: core.LastOperationStateFailed}
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "enable-dual-stack")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate to dual-stack networking if shoot was not yet created successfully or is not ready for reconciliation"),
+					}))))
+				})
+
+				It("should allow changing the IP families from IPv4 single-stack to dual-stack", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+				})
+
+				It("should forbid changing the IP families from IPv4 single-stack to dual-stack with IPv6 as primary family", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4}
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.ipFamilies"),
+					}))))
+				})
+
+				It("should forbid changing the IP families from dual-stack back to IPv4 single-stack", func() {
+					shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+
+					Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.networking.ipFamilies"),
+						"Detail": ContainSubstring("field is immutable"),
+					}))))
+				})
+			})
+
+			Context("backup bucket migration", func() {
+				BeforeEach(func() {
+					shoot.Spec.SeedName = ptr.To("some-seed")
+					shoot.Status.SeedName = ptr.To("some-seed")
+					shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateSucceeded}
+				})
+
+				It("should allow migrating the backup bucket if a target is set", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "migrate-backup-bucket")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/backup-bucket-migration-target", "new-bucket")
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid migrating the backup bucket if no target is set", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "migrate-backup-bucket")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate backup bucket if annotation \"shoot.gardener.cloud/backup-bucket-migration-target\" is not set"),
+					}))))
+				})
+
+				It("should forbid migrating the backup bucket if the control plane is being migrated", func() {
+					shoot.Spec.SeedName = ptr.To("other-seed")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "migrate-backup-bucket")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/backup-bucket-migration-target", "new-bucket")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate backup bucket if shoot is not scheduled or its control plane is being migrated"),
+					}))))
+				})
+
+				It("should forbid migrating the backup bucket if the shoot is hibernated", func() {
+					shoot.Status.IsHibernated = true
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "migrate-backup-bucket")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/backup-bucket-migration-target", "new-bucket")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate backup bucket if shoot is hibernated or waking up"),
+					}))))
+				})
+
+				It("should forbid migrating the backup bucket if the shoot was not yet created successfully", func() {
+					shoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeCreate, State: core.LastOperationStateFailed}
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "migrate-backup-bucket")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/backup-bucket-migration-target", "new-bucket")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot migrate backup bucket if shoot was not yet created successfully or is not ready for reconciliation"),
+					}))))
+				})
+
+				It("should forbid an invalid backup bucket migration target", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/backup-bucket-migration-target", "Invalid_Bucket")
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("metadata.annotations[shoot.gardener.cloud/backup-bucket-migration-target]"),
+					}))))
+				})
 			})
 
 			DescribeTable("starting rotation of all credentials",
@@ -4685,6 +5438,19 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow the preview maintenance operation annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "preview")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should return an error if the preview operation is used as operation annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "preview")
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[gardener.cloud/operation]"),
+				}))))
+			})
+
 			It("should return an error if maintenance annotation is not allowed in this context", func() {
 				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "rotate-etcd-encryption-key-complete")
 				shoot.Status = core.ShootStatus{
@@ -5661,6 +6427,288 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("existing hosts", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					ExistingHosts: &core.WorkerExistingHosts{
+						BootstrapTokenValidity: &metav1.Duration{Duration: time.Hour},
+					},
+				}
+			})
+
+			It("should allow worker pools with existing hosts", func() {
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(BeEmpty())
+			})
+
+			It("should reject a non-positive bootstrap token validity", func() {
+				worker.ExistingHosts.BootstrapTokenValidity = &metav1.Duration{}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("existingHosts.bootstrapTokenValidity"),
+					})),
+				))
+			})
+
+			It("should reject settings for provisioned machines", func() {
+				worker.ClusterAutoscaler = &core.ClusterAutoscalerOptions{}
+				worker.MachineControllerManagerSettings = &core.MachineControllerManagerSettings{}
+				worker.Headroom = &core.WorkerHeadroom{Replicas: 1, CPU: ptr.To(resource.MustParse("1"))}
+				worker.RollingUpdate = &core.WorkerRollingUpdate{CanarySteps: []intstr.IntOrString{intstr.FromInt32(1)}}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("clusterAutoscaler"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("machineControllerManager"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("headroom"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("rollingUpdate"),
+					})),
+				))
+			})
+		})
+
+		Context("headroom", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					Minimum:        1,
+					Maximum:        3,
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					Headroom: &core.WorkerHeadroom{
+						Replicas: 2,
+						CPU:      ptr.To(resource.MustParse("500m")),
+						Memory:   ptr.To(resource.MustParse("1Gi")),
+					},
+				}
+			})
+
+			It("should allow a valid headroom configuration", func() {
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(BeEmpty())
+			})
+
+			It("should reject negative values", func() {
+				worker.Headroom.Replicas = -1
+				worker.Headroom.CPU = ptr.To(resource.MustParse("-1"))
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("headroom.replicas"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("headroom.cpu"),
+					})),
+				))
+			})
+
+			It("should require cpu or memory", func() {
+				worker.Headroom.CPU = nil
+				worker.Headroom.Memory = nil
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("headroom"),
+					})),
+				))
+			})
+		})
+
+		Context("rolling update", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					Minimum:        1,
+					Maximum:        3,
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					RollingUpdate: &core.WorkerRollingUpdate{
+						MaxSurge:       ptr.To(intstr.FromInt32(1)),
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+						CanarySteps:    []intstr.IntOrString{intstr.FromInt32(1), intstr.FromString("25%"), intstr.FromInt32(5), intstr.FromString("50%")},
+						SoakPeriod:     &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				}
+			})
+
+			It("should allow a valid rolling update configuration", func() {
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(BeEmpty())
+			})
+
+			It("should require at least one canary step", func() {
+				worker.RollingUpdate.CanarySteps = nil
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("rollingUpdate.canarySteps"),
+					})),
+				))
+			})
+
+			It("should reject invalid canary steps", func() {
+				worker.RollingUpdate.CanarySteps = []intstr.IntOrString{
+					intstr.FromInt32(0),
+					intstr.FromString("0%"),
+					intstr.FromInt32(2),
+					intstr.FromInt32(2),
+					intstr.FromString("50%"),
+					intstr.FromString("30%"),
+					intstr.FromString("100%"),
+					intstr.FromString("foo"),
+					intstr.FromInt32(-1),
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("rollingUpdate.canarySteps[0]"),
+						"Detail": Equal("must be greater than zero and greater than the previous steps"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.canarySteps[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.canarySteps[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.canarySteps[5]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("rollingUpdate.canarySteps[6]"),
+						"Detail": Equal("must be less than 100%"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.canarySteps[7]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.canarySteps[8]"),
+					})),
+				))
+			})
+
+			It("should reject invalid surge settings and a negative soak period", func() {
+				worker.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(0))
+				worker.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("0%"))
+				worker.RollingUpdate.SoakPeriod = &metav1.Duration{Duration: -time.Minute}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("rollingUpdate.maxUnavailable"),
+						"Detail": Equal("may not be 0 when `maxSurge` is 0"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rollingUpdate.soakPeriod"),
+					})),
+				))
+			})
+
+			It("should forbid the rolling update configuration for the in-place update strategy", func() {
+				worker.UpdateStrategy = ptr.To(core.MachineUpdateStrategyInPlace)
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("rollingUpdate"),
+					})),
+				))
+			})
+		})
+
+		Context("update strategy", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				}
+			})
+
+			DescribeTable("should allow supported update strategies",
+				func(updateStrategy core.MachineUpdateStrategy) {
+					worker.UpdateStrategy = &updateStrategy
+					Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(BeEmpty())
+				},
+				Entry("rolling update", core.MachineUpdateStrategyRollingUpdate),
+				Entry("in-place", core.MachineUpdateStrategyInPlace),
+			)
+
+			It("should reject unsupported update strategies", func() {
+				worker.UpdateStrategy = ptr.To[core.MachineUpdateStrategy]("Recreate")
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("updateStrategy"),
+					})),
+				))
+			})
+		})
+
 		It("should reject if data volume names are duplicated", func() {
 			maxSurge := intstr.FromInt32(1)
 			maxUnavailable := intstr.FromInt32(0)
@@ -6683,6 +7731,96 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 		})
 
+		Describe("#CredentialProviders", func() {
+			var credentialProvider core.KubeletCredentialProvider
+
+			BeforeEach(func() {
+				credentialProvider = core.KubeletCredentialProvider{
+					Name:                 "ecr-credential-provider",
+					MatchImages:          []string{"*.dkr.ecr.*.amazonaws.com"},
+					DefaultCacheDuration: &metav1.Duration{Duration: 12 * time.Hour},
+					APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v1"),
+					Args:                 []string{"get-credentials"},
+					Env:                  []core.KubeletCredentialProviderEnvVar{{Name: "AWS_PROFILE", Value: "foo"}},
+				}
+			})
+
+			It("should accept a valid credential provider", func() {
+				kubeletConfig := core.KubeletConfig{CredentialProviders: []core.KubeletCredentialProvider{credentialProvider}}
+
+				Expect(ValidateKubeletConfig(kubeletConfig, "1.29.0", nil)).To(BeEmpty())
+			})
+
+			It("should forbid the v1 API version for Kubernetes < 1.26", func() {
+				kubeletConfig := core.KubeletConfig{CredentialProviders: []core.KubeletCredentialProvider{credentialProvider}}
+
+				Expect(ValidateKubeletConfig(kubeletConfig, "1.25.10", nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("credentialProviders[0].apiVersion"),
+					})),
+				))
+			})
+
+			It("should reject invalid credential providers", func() {
+				invalidProvider := core.KubeletCredentialProvider{
+					Name:                 "../foo",
+					MatchImages:          []string{""},
+					DefaultCacheDuration: &metav1.Duration{Duration: -time.Minute},
+					APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v2"),
+					Env:                  []core.KubeletCredentialProviderEnvVar{{Name: "FOO"}, {Name: "FOO"}, {Value: "bar"}},
+				}
+				kubeletConfig := core.KubeletConfig{CredentialProviders: []core.KubeletCredentialProvider{
+					credentialProvider,
+					invalidProvider,
+					credentialProvider,
+					{},
+				}}
+
+				Expect(ValidateKubeletConfig(kubeletConfig, "1.29.0", nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("credentialProviders[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("credentialProviders[1].matchImages[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("credentialProviders[1].defaultCacheDuration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("credentialProviders[1].apiVersion"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("credentialProviders[1].env[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("credentialProviders[1].env[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("credentialProviders[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("credentialProviders[3].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("credentialProviders[3].matchImages"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("credentialProviders[3].defaultCacheDuration"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateHibernationSchedules", func() {
@@ -6796,6 +7934,38 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Field": Equal(field.NewPath("end").String()),
 					})),
 				)),
+			Entry("valid exceptions and holiday calendar", sets.New[string](), &core.HibernationSchedule{
+				Start: ptr.To("0 20 * * *"),
+				Exceptions: []core.HibernationScheduleException{
+					{Start: "2024-12-24"},
+					{Start: "2024-12-27", End: ptr.To("2024-12-31")},
+				},
+				HolidayCalendarConfigMapName: ptr.To("holidays"),
+			}, BeEmpty()),
+			Entry("invalid exceptions", sets.New[string](), &core.HibernationSchedule{
+				Start: ptr.To("0 20 * * *"),
+				Exceptions: []core.HibernationScheduleException{
+					{Start: "2024-12-24"},
+					{Start: "24.12.2024"},
+					{Start: "2024-12-31", End: ptr.To("2024-12-27")},
+				},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("exceptions").Index(1).String()),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(field.NewPath("exceptions").Index(2).String()),
+				})),
+			)),
+			Entry("invalid holiday calendar config map name", sets.New[string](), &core.HibernationSchedule{
+				Start:                        ptr.To("0 20 * * *"),
+				HolidayCalendarConfigMapName: ptr.To("Holidays!"),
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal(field.NewPath("holidayCalendarConfigMapName").String()),
+			})))),
 		)
 	})
 
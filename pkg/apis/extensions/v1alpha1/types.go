@@ -74,6 +74,12 @@ type Object interface {
 // what you do.
 const ShootAlphaCSIMigrationKubernetesVersion = "alpha.csimigration.shoot.extensions.gardener.cloud/kubernetes-version"
 
+// HealthContributorConditionTypeSeparator separates the type of a shoot health condition from the name of an additional
+// health contributor in the condition types of extension resources, e.g. `SystemComponentsHealthy/csi-driver`. Such
+// conditions contribute to the respective shoot condition in the same way as conditions of the plain type. This allows
+// extensions to report the health of multiple components separately.
+const HealthContributorConditionTypeSeparator = "/"
+
 // IPFamily is a type for specifying an IP protocol version to use in Gardener clusters.
 type IPFamily string
 
@@ -84,6 +84,18 @@ type OperatingSystemConfigSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	Files []File `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"path"`
+	// InPlaceUpdates contains the versions the existing nodes shall be updated to in-place. It is only set for worker
+	// pools with the `InPlace` update strategy and the `reconcile` purpose.
+	// +optional
+	InPlaceUpdates *InPlaceUpdates `json:"inPlaceUpdates,omitempty"`
+}
+
+// InPlaceUpdates contains the versions the existing nodes of a worker pool shall be updated to in-place.
+type InPlaceUpdates struct {
+	// OperatingSystemVersion is the desired version of the operating system, i.e., the version of the machine image.
+	OperatingSystemVersion string `json:"operatingSystemVersion"`
+	// KubeletVersion is the desired version of the kubelet.
+	KubeletVersion string `json:"kubeletVersion"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -173,6 +173,14 @@ type WorkerPool struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty"`
+	// UpdateStrategy specifies how the nodes of the worker pool are updated. With `InPlace`, changes of the Kubernetes
+	// version and the machine image version are applied to the existing machines, hence they must not be rolled.
+	// +optional
+	UpdateStrategy *gardencorev1beta1.MachineUpdateStrategy `json:"updateStrategy,omitempty"`
+	// RollingUpdate contains the configuration for progressive rolling updates of the worker pool's nodes. If set, the
+	// machines are rolled in canary steps when the Kubernetes minor version of the worker pool is upgraded.
+	// +optional
+	RollingUpdate *gardencorev1beta1.WorkerRollingUpdate `json:"rollingUpdate,omitempty"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
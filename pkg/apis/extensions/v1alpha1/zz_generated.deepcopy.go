@@ -1185,6 +1185,22 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdates) DeepCopyInto(out *InPlaceUpdates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdates.
+func (in *InPlaceUpdates) DeepCopy() *InPlaceUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -1571,6 +1587,11 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdates)
+		**out = **in
+	}
 	return
 }
 
@@ -1906,6 +1927,16 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(v1beta1.MachineUpdateStrategy)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(v1beta1.WorkerRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -135,6 +135,11 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 	return garden.Spec.VirtualCluster.ControlPlane != nil && garden.Spec.VirtualCluster.ControlPlane.HighAvailability != nil
 }
 
+// PrometheusRemoteWriteReceiverEnabled returns true if the remote write receiver of the garden Prometheus is enabled.
+func PrometheusRemoteWriteReceiverEnabled(settings *operatorv1alpha1.Settings) bool {
+	return settings != nil && settings.PrometheusRemoteWriteReceiver != nil && settings.PrometheusRemoteWriteReceiver.Enabled
+}
+
 // TopologyAwareRoutingEnabled returns true if the topology-aware routing is enabled.
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
@@ -171,6 +171,17 @@ var _ = Describe("helper", func() {
 		Entry("high-availability set", &operatorv1alpha1.ControlPlane{HighAvailability: &operatorv1alpha1.HighAvailability{}}, true),
 	)
 
+	DescribeTable("#PrometheusRemoteWriteReceiverEnabled",
+		func(settings *operatorv1alpha1.Settings, expected bool) {
+			Expect(PrometheusRemoteWriteReceiverEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no remote write receiver setting", &operatorv1alpha1.Settings{}, false),
+		Entry("remote write receiver enabled", &operatorv1alpha1.Settings{PrometheusRemoteWriteReceiver: &operatorv1alpha1.SettingPrometheusRemoteWriteReceiver{Enabled: true}}, true),
+		Entry("remote write receiver disabled", &operatorv1alpha1.Settings{PrometheusRemoteWriteReceiver: &operatorv1alpha1.SettingPrometheusRemoteWriteReceiver{Enabled: false}}, false),
+	)
+
 	DescribeTable("#TopologyAwareRoutingEnabled",
 		func(settings *operatorv1alpha1.Settings, expected bool) {
 			Expect(TopologyAwareRoutingEnabled(settings)).To(Equal(expected))
@@ -131,6 +131,10 @@ type Settings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty"`
+	// PrometheusRemoteWriteReceiver controls whether the garden Prometheus accepts metrics pushed via the Prometheus
+	// remote write protocol, e.g., by the aggregate Prometheus instances of seeds.
+	// +optional
+	PrometheusRemoteWriteReceiver *SettingPrometheusRemoteWriteReceiver `json:"prometheusRemoteWriteReceiver,omitempty"`
 }
 
 // SettingLoadBalancerServices controls certain settings for services of type load balancer that are created in the
@@ -163,6 +167,14 @@ type SettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled"`
 }
 
+// SettingPrometheusRemoteWriteReceiver controls the remote write receiver of the garden Prometheus.
+type SettingPrometheusRemoteWriteReceiver struct {
+	// Enabled controls whether the garden Prometheus accepts metrics pushed via the Prometheus remote write protocol.
+	// The endpoint is exposed at `https://prometheus-garden.<ingress-domain>/api/v1/write` and protected with the same
+	// credentials as the other observability ingresses of the runtime cluster.
+	Enabled bool `json:"enabled"`
+}
+
 // Volume contains settings for persistent volumes created in the runtime cluster.
 type Volume struct {
 	// MinimumSize defines the minimum size that should be used for PVCs in the runtime cluster.
@@ -331,6 +343,9 @@ type Kubernetes struct {
 	// Version is the semantic Kubernetes version to use for the virtual garden cluster.
 	// +kubebuilder:validation:MinLength=1
 	Version string `json:"version"`
+	// PKI contains configuration for the certificates generated by gardener-operator.
+	// +optional
+	PKI *gardencorev1beta1.PKI `json:"pki,omitempty"`
 }
 
 // KubeAPIServerConfig contains configuration settings for the kube-apiserver.
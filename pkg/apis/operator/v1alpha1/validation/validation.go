@@ -202,6 +202,17 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeControllerManager(coreKubeControllerManagerConfig, nil, virtualCluster.Kubernetes.Version, true, path)...)
 	}
 
+	if pki := virtualCluster.Kubernetes.PKI; pki != nil {
+		path := fldPath.Child("kubernetes", "pki")
+
+		corePKI := &gardencore.PKI{}
+		if err := gardenCoreScheme.Convert(pki, corePKI, nil); err != nil {
+			allErrs = append(allErrs, field.InternalError(path, err))
+		}
+
+		allErrs = append(allErrs, gardencorevalidation.ValidatePKI(corePKI, path)...)
+	}
+
 	allErrs = append(allErrs, validateGardener(virtualCluster.Gardener, virtualCluster.Kubernetes, fldPath.Child("gardener"))...)
 
 	if _, _, err := net.ParseCIDR(virtualCluster.Networking.Services); err != nil {
@@ -284,6 +295,9 @@ func validateGardenerAPIServerConfig(config *operatorv1alpha1.GardenerAPIServerC
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, gardencorevalidation.ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditPolicy.ClusterAuditPolicyName != nil {
+			allErrs = append(allErrs, field.Forbidden(auditPath.Child("auditPolicy", "clusterAuditPolicyName"), "cluster audit policies are not supported for the garden"))
+		}
 	}
 
 	if config.WatchCacheSizes != nil {
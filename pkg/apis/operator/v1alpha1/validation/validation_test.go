@@ -937,6 +937,29 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("PKI", func() {
+				It("should allow a valid PKI configuration", func() {
+					garden.Spec.VirtualCluster.Kubernetes.PKI = &gardencorev1beta1.PKI{
+						KeyAlgorithm: ptr.To(gardencorev1beta1.KeyAlgorithmECDSA),
+						KeySize:      ptr.To[int32](384),
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about an unsupported key size", func() {
+					garden.Spec.VirtualCluster.Kubernetes.PKI = &gardencorev1beta1.PKI{
+						KeyAlgorithm: ptr.To(gardencorev1beta1.KeyAlgorithmECDSA),
+						KeySize:      ptr.To[int32](4096),
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.virtualCluster.kubernetes.pki.keySize"),
+					}))))
+				})
+			})
+
 			Context("Networking", func() {
 				It("should complain about an invalid service CIDR", func() {
 					garden.Spec.VirtualCluster.Networking.Services = "not-parseable-cidr"
@@ -1053,6 +1076,19 @@ var _ = Describe("Validation Tests", func() {
 								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.auditPolicy.configMapRef.name"),
 							}))))
 						})
+
+						It("should forbid referencing a cluster audit policy", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ClusterAuditPolicyName: ptr.To("foo"),
+								},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.auditPolicy.clusterAuditPolicyName"),
+							}))))
+						})
 					})
 
 					Context("EncryptionConfig", func() {
@@ -1238,6 +1238,11 @@ func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 		*out = new(KubeControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PKI != nil {
+		in, out := &in.PKI, &out.PKI
+		*out = new(v1beta1.PKI)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1496,6 +1501,22 @@ func (in *SettingLoadBalancerServices) DeepCopy() *SettingLoadBalancerServices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingPrometheusRemoteWriteReceiver) DeepCopyInto(out *SettingPrometheusRemoteWriteReceiver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingPrometheusRemoteWriteReceiver.
+func (in *SettingPrometheusRemoteWriteReceiver) DeepCopy() *SettingPrometheusRemoteWriteReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingPrometheusRemoteWriteReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SettingTopologyAwareRouting) DeepCopyInto(out *SettingTopologyAwareRouting) {
 	*out = *in
@@ -1551,6 +1572,11 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 		*out = new(SettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.PrometheusRemoteWriteReceiver != nil {
+		in, out := &in.PrometheusRemoteWriteReceiver, &out.PrometheusRemoteWriteReceiver
+		*out = new(SettingPrometheusRemoteWriteReceiver)
+		**out = **in
+	}
 	return
 }
 
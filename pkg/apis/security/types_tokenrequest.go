@@ -28,6 +28,9 @@ type TokenRequestSpec struct {
 	ContextObject *ContextObject
 	// ExpirationSeconds specifies for how long the requested token should be valid.
 	ExpirationSeconds int64
+	// Audiences restricts the 'aud' claim of the requested token to a subset of the audiences of the WorkloadIdentity.
+	// If empty, the token is issued for all audiences of the WorkloadIdentity.
+	Audiences []string
 }
 
 // ContextObject identifies the object the token is requested for.
@@ -34,6 +34,9 @@ type WorkloadIdentitySpec struct {
 	Audiences []string
 	// TargetSystem represents specific configurations for the system that will accept the JWTs.
 	TargetSystem TargetSystem
+	// MaxTokenExpiration is the maximum validity duration of the tokens issued for this WorkloadIdentity.
+	// If a token with a longer validity is requested, it is issued with a validity of this value.
+	MaxTokenExpiration *metav1.Duration
 }
 
 // TargetSystem represents specific configurations for the system that will accept the JWTs.
@@ -14,6 +14,9 @@ const (
 	AnnotationWorkloadIdentityName = workloadIdentityPrefix + "/name"
 	// AnnotationWorkloadIdentityContextObject is an annotation key used to indicate the context object for which the origin WorkloadIdentity will be used.
 	AnnotationWorkloadIdentityContextObject = workloadIdentityPrefix + "/context-object"
+	// AnnotationWorkloadIdentityTokenRenewTimestamp is an annotation key used to indicate the time when the workload identity
+	// token stored in the labeled secret has to be renewed.
+	AnnotationWorkloadIdentityTokenRenewTimestamp = workloadIdentityPrefix + "/token-renew-timestamp"
 
 	// LabelPurpose is a label used to indicate the purpose of the labeled resource.
 	// Specific values might cause controllers to act on the said object.
@@ -25,4 +28,11 @@ const (
 
 	// LabelWorkloadIdentityProvider is a label key indicating the target system type before which workload identity tokens will be presented.
 	LabelWorkloadIdentityProvider = workloadIdentityPrefix + "/provider"
+
+	// DataKeyToken is the data key of secrets labeled for the workload identity token requestor which holds the
+	// workload identity token.
+	DataKeyToken = "token"
+	// DataKeyConfig is the data key of secrets labeled for the workload identity token requestor which holds the
+	// provider config of the workload identity's target system.
+	DataKeyConfig = "config"
 )
@@ -13,6 +13,7 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
@@ -390,69 +391,71 @@ func init() {
 }
 
 var fileDescriptor_32adcae6cdc9d73e = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
+	// 1024 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc6, 0x4e, 0x94, 0x4c, 0xfe, 0x28, 0x99, 0x96, 0xc8, 0x0a, 0xc2, 0x0e, 0xdb, 0x0b,
-	0xa2, 0xea, 0xba, 0x89, 0x10, 0xe1, 0x84, 0xd4, 0x75, 0x03, 0xb2, 0x42, 0xdb, 0x30, 0x4e, 0x41,
-	0x42, 0x20, 0x31, 0xde, 0x7d, 0x71, 0x06, 0x67, 0xff, 0x74, 0x67, 0x36, 0x8d, 0x4f, 0x85, 0x6f,
-	0xc0, 0x9d, 0xaf, 0xc0, 0x91, 0x8f, 0xc0, 0x21, 0xc7, 0x8a, 0x53, 0x4e, 0x16, 0x31, 0x1c, 0xf8,
-	0x08, 0x88, 0x13, 0x9a, 0xf1, 0x6c, 0xf6, 0x8f, 0xe3, 0x36, 0xb2, 0xa2, 0x9c, 0xbc, 0x33, 0xef,
-	0xbd, 0xdf, 0xfb, 0xf7, 0x7b, 0xfb, 0xd6, 0xe8, 0xf3, 0x2e, 0x13, 0x47, 0x71, 0xc7, 0x72, 0x02,
-	0xaf, 0xd1, 0xa5, 0x91, 0x0b, 0x3e, 0x44, 0xe9, 0x43, 0xd8, 0xeb, 0x36, 0x68, 0xc8, 0x78, 0x83,
-	0x83, 0x13, 0x47, 0x4c, 0xf4, 0x1b, 0x27, 0x5b, 0xf4, 0x38, 0x3c, 0xa2, 0x5b, 0x8d, 0xae, 0x54,
-	0xa0, 0x02, 0x5c, 0x2b, 0x8c, 0x02, 0x11, 0xe0, 0x9d, 0x14, 0xc8, 0x4a, 0xec, 0xd3, 0x87, 0xb0,
-	0xd7, 0xb5, 0x24, 0x90, 0x95, 0x00, 0x59, 0x09, 0xd0, 0xc6, 0x83, 0x6c, 0x04, 0x41, 0x37, 0x68,
-	0x28, 0xbc, 0x4e, 0x7c, 0xa8, 0x4e, 0xea, 0xa0, 0x9e, 0x46, 0x7e, 0x36, 0xcc, 0xde, 0x27, 0xdc,
-	0x62, 0x81, 0x0c, 0xab, 0xe1, 0x04, 0x11, 0x34, 0x4e, 0xc6, 0x62, 0xd9, 0xf8, 0x28, 0xd5, 0xf1,
-	0xa8, 0x73, 0xc4, 0x7c, 0x88, 0xfa, 0x69, 0x2e, 0x1e, 0x08, 0x7a, 0x95, 0x55, 0x63, 0x92, 0x55,
-	0x14, 0xfb, 0x82, 0x79, 0x30, 0x66, 0xf0, 0xf1, 0xdb, 0x0c, 0xb8, 0x73, 0x04, 0x1e, 0x2d, 0xda,
-	0x99, 0xff, 0x1a, 0x68, 0xb9, 0x19, 0xf8, 0x02, 0x4e, 0xc5, 0xb3, 0xce, 0x0f, 0xe0, 0x08, 0xbc,
-	0x89, 0x2a, 0x3d, 0xe6, 0xbb, 0x55, 0x63, 0xd3, 0xf8, 0x60, 0xc1, 0x5e, 0x3a, 0x1b, 0xd4, 0x4b,
-	0xc3, 0x41, 0xbd, 0xb2, 0xc7, 0x7c, 0x97, 0x28, 0x09, 0xde, 0x46, 0x88, 0x86, 0xec, 0x2b, 0x88,
-	0x38, 0x0b, 0xfc, 0xea, 0x8c, 0xd2, 0xc3, 0x5a, 0x0f, 0x3d, 0xda, 0x6f, 0x69, 0x09, 0xc9, 0x68,
-	0x49, 0x54, 0x9f, 0x7a, 0x50, 0x2d, 0xe7, 0x51, 0x9f, 0x52, 0x0f, 0x88, 0x92, 0xe0, 0xfb, 0x68,
-	0x41, 0xfe, 0xf2, 0x90, 0x3a, 0x50, 0xad, 0x28, 0xb5, 0xe5, 0xe1, 0xa0, 0xbe, 0xf0, 0x34, 0xb9,
-	0x24, 0xa9, 0x1c, 0xdb, 0xa8, 0x1c, 0x33, 0xb7, 0x3a, 0xab, 0xd4, 0x1e, 0x6a, 0xb4, 0xf2, 0xf3,
-	0xd6, 0xe3, 0xff, 0x06, 0xf5, 0xf7, 0x27, 0x95, 0x42, 0xf4, 0x43, 0xe0, 0xd6, 0xf3, 0xd6, 0x63,
-	0x22, 0x8d, 0xcd, 0x5f, 0xcb, 0x08, 0x37, 0x23, 0x70, 0xc1, 0x17, 0x8c, 0x1e, 0x73, 0x9b, 0xf9,
-	0x2e, 0xf3, 0xbb, 0xf8, 0x7b, 0x34, 0x2f, 0xbb, 0xe2, 0x52, 0x41, 0x55, 0x0d, 0x16, 0xb7, 0x1f,
-	0x5a, 0x23, 0x44, 0x2b, 0x8b, 0x98, 0xd2, 0x48, 0x6a, 0x5b, 0x27, 0x5b, 0xd6, 0xa8, 0x7e, 0x4f,
-	0x40, 0xd0, 0xb4, 0x1a, 0xe9, 0x1d, 0xb9, 0x44, 0xc5, 0x3f, 0x19, 0x68, 0x3e, 0x8c, 0x82, 0x13,
-	0xe6, 0x42, 0xa4, 0xca, 0xb7, 0xb8, 0xdd, 0xb6, 0xa6, 0xa4, 0xac, 0x35, 0x9e, 0xc1, 0xbe, 0x86,
-	0xb6, 0x57, 0x75, 0x14, 0xf3, 0xc9, 0x0d, 0xb9, 0x74, 0x8b, 0x1d, 0xb4, 0xe2, 0xa4, 0x96, 0x04,
-	0x0e, 0x55, 0x67, 0x16, 0xb7, 0xef, 0x65, 0x72, 0xb5, 0x24, 0xa7, 0xd3, 0xcc, 0x08, 0x1c, 0x42,
-	0x04, 0xbe, 0x03, 0xf6, 0xba, 0x06, 0x5e, 0x69, 0xe6, 0x20, 0x48, 0x01, 0x12, 0xef, 0xa1, 0xb9,
-	0x17, 0x71, 0x20, 0x28, 0xaf, 0x56, 0x36, 0xcb, 0xd7, 0x05, 0x5f, 0xd1, 0xe0, 0x73, 0x5f, 0x2a,
-	0x53, 0xa2, 0x21, 0xcc, 0x7f, 0x0c, 0xb4, 0x3e, 0x9e, 0xec, 0x17, 0x8c, 0x0b, 0xfc, 0xed, 0x58,
-	0xcb, 0xac, 0xeb, 0xb5, 0x4c, 0x5a, 0xab, 0x86, 0x5d, 0x96, 0x2a, 0xb9, 0xc9, 0xb4, 0x2b, 0x44,
-	0xb3, 0x4c, 0x80, 0xc7, 0xab, 0x33, 0x2a, 0x89, 0xbd, 0x1b, 0x6c, 0x95, 0xbd, 0xac, 0xfd, 0xce,
-	0xb6, 0xa4, 0x07, 0x32, 0x72, 0x64, 0x7e, 0x8a, 0x36, 0x26, 0xb7, 0x55, 0x8e, 0x92, 0x64, 0x72,
-	0x71, 0x40, 0x0f, 0xfa, 0x21, 0x10, 0x25, 0x31, 0x7f, 0x31, 0xd0, 0xd2, 0x01, 0x8d, 0xba, 0x20,
-	0xda, 0x7d, 0x2e, 0xc0, 0x7b, 0xbb, 0x09, 0x66, 0x68, 0x25, 0xe1, 0x46, 0x33, 0xf0, 0x0f, 0x59,
-	0x57, 0x13, 0xf3, 0xc1, 0xc4, 0x42, 0xea, 0x17, 0x8b, 0x45, 0xe8, 0xcb, 0xdd, 0x53, 0x01, 0xbe,
-	0x1c, 0x73, 0x1b, 0x4b, 0x56, 0xec, 0xe7, 0x80, 0x48, 0x01, 0xd8, 0xfc, 0x63, 0x06, 0x2d, 0x1d,
-	0x04, 0x3d, 0xf0, 0x09, 0xbc, 0x88, 0x81, 0x8b, 0x5b, 0x98, 0xb8, 0x1e, 0xaa, 0xf0, 0x10, 0x1c,
-	0x9d, 0x53, 0x6b, 0xea, 0x0e, 0x66, 0xc3, 0x6e, 0x87, 0xe0, 0xa4, 0xa5, 0x94, 0x27, 0xa2, 0x9c,
-	0x60, 0x8e, 0xe6, 0xb8, 0xa0, 0x22, 0xe6, 0x7a, 0xa4, 0xf6, 0x6e, 0xc6, 0x9d, 0x82, 0x4c, 0xa7,
-	0x63, 0x74, 0x26, 0xda, 0x95, 0x79, 0x6e, 0xa0, 0xd5, 0x62, 0x74, 0xf8, 0x15, 0x5a, 0x76, 0xb2,
-	0xef, 0x76, 0x5d, 0xdd, 0xcf, 0xa6, 0x67, 0x70, 0x16, 0xcd, 0x5e, 0x1b, 0x0e, 0xea, 0xf9, 0xe5,
-	0x41, 0xf2, 0xfe, 0x70, 0x13, 0xad, 0xc1, 0x69, 0xc8, 0x22, 0x2a, 0x58, 0xe0, 0xb7, 0xc1, 0x09,
-	0x7c, 0x97, 0xab, 0x26, 0x94, 0xed, 0x77, 0x86, 0x83, 0xfa, 0xda, 0x6e, 0x51, 0x48, 0xc6, 0xf5,
-	0xcd, 0xdf, 0x0c, 0x84, 0xc7, 0x2b, 0x81, 0xef, 0xa1, 0x59, 0x21, 0x6f, 0x35, 0xa9, 0x2f, 0x27,
-	0x69, 0xa4, 0x3a, 0x92, 0xe1, 0x3e, 0xba, 0x93, 0x02, 0x1e, 0x30, 0x0f, 0xb8, 0xa0, 0x5e, 0xa8,
-	0x79, 0xf0, 0xe1, 0xf5, 0x58, 0x26, 0xcd, 0xec, 0x77, 0x35, 0xfc, 0x9d, 0xdd, 0x1c, 0x5c, 0x5b,
-	0xc2, 0x91, 0xab, 0x7c, 0x98, 0x83, 0x19, 0xb4, 0xfa, 0x75, 0x10, 0xf5, 0x8e, 0x03, 0xea, 0xb6,
-	0xd4, 0x2c, 0x8b, 0xfe, 0x2d, 0x50, 0x3d, 0xc8, 0x51, 0xfd, 0xc9, 0xd4, 0xad, 0x2e, 0x86, 0x3e,
-	0x91, 0xee, 0x2f, 0x0b, 0x74, 0x7f, 0x76, 0x73, 0x2e, 0xdf, 0x4c, 0xf9, 0xbf, 0x0d, 0x74, 0xb7,
-	0x68, 0x72, 0x0b, 0xeb, 0xc0, 0xcf, 0xaf, 0x83, 0xd6, 0x8d, 0xa5, 0x3b, 0x61, 0x19, 0xfc, 0x7e,
-	0x45, 0x9a, 0x6a, 0xba, 0xef, 0xa3, 0x05, 0x1a, 0xbb, 0x4c, 0x2e, 0x4d, 0x5e, 0x35, 0x36, 0xcb,
-	0xc9, 0x07, 0xd3, 0xa3, 0xe4, 0x92, 0xa4, 0x72, 0xfc, 0x0a, 0x2d, 0x89, 0xcc, 0x46, 0xd0, 0xf4,
-	0xd8, 0x9d, 0xfe, 0xd5, 0x94, 0x01, 0xb3, 0xef, 0xea, 0xc0, 0x73, 0x4b, 0x87, 0xe4, 0x1c, 0x9a,
-	0x3b, 0x68, 0xfd, 0xea, 0xfe, 0xe2, 0xf7, 0x50, 0x99, 0xc7, 0x1d, 0x3d, 0xc6, 0x8b, 0xc9, 0xb7,
-	0x5c, 0x3b, 0xee, 0x10, 0x79, 0x6f, 0x7f, 0x77, 0x76, 0x51, 0x2b, 0xbd, 0xbe, 0xa8, 0x95, 0xce,
-	0x2f, 0x6a, 0xa5, 0x1f, 0x87, 0x35, 0xe3, 0x6c, 0x58, 0x33, 0x5e, 0x0f, 0x6b, 0xc6, 0xf9, 0xb0,
-	0x66, 0xfc, 0x39, 0xac, 0x19, 0x3f, 0xff, 0x55, 0x2b, 0x7d, 0xb3, 0x33, 0xe5, 0x7f, 0x87, 0xff,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x42, 0x22, 0x27, 0xb9, 0x75, 0x0c, 0x00, 0x00,
+	0x14, 0xcf, 0xda, 0x49, 0x95, 0x4c, 0xfe, 0x28, 0x99, 0x96, 0xc8, 0x0a, 0xc2, 0x0e, 0xdb, 0x0b,
+	0x02, 0x75, 0xdd, 0x44, 0x88, 0x70, 0x42, 0xea, 0x3a, 0x01, 0x59, 0x21, 0x6d, 0x18, 0xa7, 0x20,
+	0x21, 0x90, 0x18, 0xef, 0xbe, 0x38, 0x83, 0xb3, 0x7f, 0xba, 0x33, 0xeb, 0xc6, 0xa7, 0xc2, 0x81,
+	0x3b, 0x77, 0xbe, 0x02, 0x47, 0x8e, 0x7c, 0x80, 0x1c, 0x2b, 0x4e, 0x3d, 0x59, 0x64, 0xe1, 0xc0,
+	0x47, 0x40, 0x9c, 0xd0, 0x8c, 0x67, 0xb3, 0xbb, 0x76, 0xdc, 0x46, 0x56, 0x94, 0x53, 0x76, 0xe6,
+	0xbd, 0xf7, 0x7b, 0x7f, 0x7f, 0xf3, 0x62, 0xf4, 0x59, 0x87, 0x89, 0x93, 0xb8, 0x6d, 0x39, 0x81,
+	0x57, 0xef, 0xd0, 0xc8, 0x05, 0x1f, 0xa2, 0xec, 0x23, 0xec, 0x76, 0xea, 0x34, 0x64, 0xbc, 0xce,
+	0xc1, 0x89, 0x23, 0x26, 0xfa, 0xf5, 0xde, 0x16, 0x3d, 0x0d, 0x4f, 0xe8, 0x56, 0xbd, 0x23, 0x15,
+	0xa8, 0x00, 0xd7, 0x0a, 0xa3, 0x40, 0x04, 0x78, 0x27, 0x03, 0xb2, 0x52, 0xfb, 0xec, 0x23, 0xec,
+	0x76, 0x2c, 0x09, 0x64, 0xa5, 0x40, 0x56, 0x0a, 0xb4, 0xf1, 0x20, 0x1f, 0x41, 0xd0, 0x09, 0xea,
+	0x0a, 0xaf, 0x1d, 0x1f, 0xab, 0x93, 0x3a, 0xa8, 0xaf, 0xa1, 0x9f, 0x0d, 0xb3, 0xfb, 0x31, 0xb7,
+	0x58, 0x20, 0xc3, 0xaa, 0x3b, 0x41, 0x04, 0xf5, 0xde, 0x58, 0x2c, 0x1b, 0x1f, 0x66, 0x3a, 0x1e,
+	0x75, 0x4e, 0x98, 0x0f, 0x51, 0x3f, 0xcb, 0xc5, 0x03, 0x41, 0xaf, 0xb2, 0xaa, 0x4f, 0xb2, 0x8a,
+	0x62, 0x5f, 0x30, 0x0f, 0xc6, 0x0c, 0x3e, 0x7a, 0x93, 0x01, 0x77, 0x4e, 0xc0, 0xa3, 0xa3, 0x76,
+	0xe6, 0xbf, 0x06, 0x5a, 0x6e, 0x04, 0xbe, 0x80, 0x33, 0xf1, 0xa4, 0xfd, 0x3d, 0x38, 0x02, 0x6f,
+	0xa2, 0xd9, 0x2e, 0xf3, 0xdd, 0x8a, 0xb1, 0x69, 0xbc, 0xb7, 0x60, 0x2f, 0x9d, 0x0f, 0x6a, 0x33,
+	0xc9, 0xa0, 0x36, 0xbb, 0xcf, 0x7c, 0x97, 0x28, 0x09, 0xde, 0x46, 0x88, 0x86, 0xec, 0x4b, 0x88,
+	0x38, 0x0b, 0xfc, 0x4a, 0x49, 0xe9, 0x61, 0xad, 0x87, 0x1e, 0x1d, 0x36, 0xb5, 0x84, 0xe4, 0xb4,
+	0x24, 0xaa, 0x4f, 0x3d, 0xa8, 0x94, 0x8b, 0xa8, 0x8f, 0xa9, 0x07, 0x44, 0x49, 0xf0, 0x07, 0x68,
+	0x41, 0xfe, 0xe5, 0x21, 0x75, 0xa0, 0x32, 0xab, 0xd4, 0x96, 0x93, 0x41, 0x6d, 0xe1, 0x71, 0x7a,
+	0x49, 0x32, 0x39, 0xb6, 0x51, 0x39, 0x66, 0x6e, 0x65, 0x4e, 0xa9, 0x3d, 0xd4, 0x68, 0xe5, 0xa7,
+	0xcd, 0xdd, 0xff, 0x06, 0xb5, 0x77, 0x27, 0x95, 0x42, 0xf4, 0x43, 0xe0, 0xd6, 0xd3, 0xe6, 0x2e,
+	0x91, 0xc6, 0xe6, 0xaf, 0x65, 0x84, 0x1b, 0x11, 0xb8, 0xe0, 0x0b, 0x46, 0x4f, 0xb9, 0xcd, 0x7c,
+	0x97, 0xf9, 0x1d, 0xfc, 0x1d, 0x9a, 0x97, 0x5d, 0x71, 0xa9, 0xa0, 0xaa, 0x06, 0x8b, 0xdb, 0x0f,
+	0xad, 0x21, 0xa2, 0x95, 0x47, 0xcc, 0xc6, 0x48, 0x6a, 0x5b, 0xbd, 0x2d, 0x6b, 0x58, 0xbf, 0x03,
+	0x10, 0x34, 0xab, 0x46, 0x76, 0x47, 0x2e, 0x51, 0xf1, 0x8f, 0x06, 0x9a, 0x0f, 0xa3, 0xa0, 0xc7,
+	0x5c, 0x88, 0x54, 0xf9, 0x16, 0xb7, 0x5b, 0xd6, 0x94, 0x23, 0x6b, 0x8d, 0x67, 0x70, 0xa8, 0xa1,
+	0xed, 0x55, 0x1d, 0xc5, 0x7c, 0x7a, 0x43, 0x2e, 0xdd, 0x62, 0x07, 0xad, 0x38, 0x99, 0x25, 0x81,
+	0x63, 0xd5, 0x99, 0xc5, 0xed, 0xfb, 0xb9, 0x5c, 0x2d, 0x39, 0xd3, 0x59, 0x66, 0x04, 0x8e, 0x21,
+	0x02, 0xdf, 0x01, 0x7b, 0x5d, 0x03, 0xaf, 0x34, 0x0a, 0x10, 0x64, 0x04, 0x12, 0xef, 0xa3, 0x3b,
+	0xcf, 0xe2, 0x40, 0x50, 0x5e, 0x99, 0xdd, 0x2c, 0x5f, 0x17, 0x7c, 0x45, 0x83, 0xdf, 0xf9, 0x42,
+	0x99, 0x12, 0x0d, 0x61, 0xfe, 0x63, 0xa0, 0xf5, 0xf1, 0x64, 0x3f, 0x67, 0x5c, 0xe0, 0x6f, 0xc6,
+	0x5a, 0x66, 0x5d, 0xaf, 0x65, 0xd2, 0x5a, 0x35, 0xec, 0xb2, 0x54, 0xe9, 0x4d, 0xae, 0x5d, 0x21,
+	0x9a, 0x63, 0x02, 0x3c, 0x5e, 0x29, 0xa9, 0x24, 0xf6, 0x6f, 0xb0, 0x55, 0xf6, 0xb2, 0xf6, 0x3b,
+	0xd7, 0x94, 0x1e, 0xc8, 0xd0, 0x91, 0xf9, 0x09, 0xda, 0x98, 0xdc, 0x56, 0x49, 0x25, 0x39, 0xc9,
+	0xa3, 0x04, 0x3d, 0xea, 0x87, 0x40, 0x94, 0xc4, 0xfc, 0xc5, 0x40, 0x4b, 0x47, 0x34, 0xea, 0x80,
+	0x68, 0xf5, 0xb9, 0x00, 0xef, 0xcd, 0x26, 0x98, 0xa1, 0x95, 0x74, 0x36, 0x1a, 0x81, 0x7f, 0xcc,
+	0x3a, 0x7a, 0x30, 0x1f, 0x4c, 0x2c, 0xa4, 0x7e, 0x58, 0x2c, 0x42, 0x9f, 0xef, 0x9d, 0x09, 0xf0,
+	0x25, 0xcd, 0x6d, 0x2c, 0xa7, 0xe2, 0xb0, 0x00, 0x44, 0x46, 0x80, 0xcd, 0x3f, 0x4a, 0x68, 0xe9,
+	0x28, 0xe8, 0x82, 0x4f, 0xe0, 0x59, 0x0c, 0x5c, 0xdc, 0x02, 0xe3, 0xba, 0x68, 0x96, 0x87, 0xe0,
+	0xe8, 0x9c, 0x9a, 0x53, 0x77, 0x30, 0x1f, 0x76, 0x2b, 0x04, 0x27, 0x2b, 0xa5, 0x3c, 0x11, 0xe5,
+	0x04, 0x73, 0x74, 0x87, 0x0b, 0x2a, 0x62, 0xae, 0x29, 0xb5, 0x7f, 0x33, 0xee, 0x14, 0x64, 0xc6,
+	0x8e, 0xe1, 0x99, 0x68, 0x57, 0xe6, 0x4f, 0x25, 0xb4, 0x3a, 0x1a, 0x1d, 0x7e, 0x81, 0x96, 0x9d,
+	0xfc, 0xdb, 0xae, 0xab, 0xfb, 0xe9, 0xf4, 0x13, 0x9c, 0x47, 0xb3, 0xd7, 0x92, 0x41, 0xad, 0xb8,
+	0x3c, 0x48, 0xd1, 0x1f, 0x6e, 0xa0, 0x35, 0x38, 0x0b, 0x59, 0x44, 0x05, 0x0b, 0xfc, 0x16, 0x38,
+	0x81, 0xef, 0x72, 0xd5, 0x84, 0xb2, 0xfd, 0x56, 0x32, 0xa8, 0xad, 0xed, 0x8d, 0x0a, 0xc9, 0xb8,
+	0xbe, 0x5c, 0x0c, 0x34, 0x76, 0x99, 0x7c, 0x1c, 0x64, 0x49, 0xcb, 0xe9, 0x62, 0x78, 0x94, 0x5e,
+	0x92, 0x4c, 0x6e, 0xfe, 0x66, 0x20, 0x3c, 0x5e, 0x36, 0x7c, 0x1f, 0xcd, 0x09, 0x79, 0xab, 0x19,
+	0x70, 0x49, 0xbb, 0xa1, 0xea, 0x50, 0x86, 0xfb, 0xe8, 0x6e, 0xe6, 0xfd, 0x88, 0x79, 0xc0, 0x05,
+	0xf5, 0x42, 0x3d, 0x34, 0xef, 0x5f, 0x6f, 0x24, 0xa5, 0x99, 0xfd, 0xb6, 0x86, 0xbf, 0xbb, 0x57,
+	0x80, 0x6b, 0x49, 0x38, 0x72, 0x95, 0x0f, 0x73, 0x50, 0x42, 0xab, 0x5f, 0x05, 0x51, 0xf7, 0x34,
+	0xa0, 0x6e, 0x53, 0x11, 0x5f, 0xf4, 0x6f, 0x81, 0x17, 0x41, 0x81, 0x17, 0x07, 0x53, 0xcf, 0xc5,
+	0x68, 0xe8, 0x13, 0xb9, 0xf1, 0x7c, 0x84, 0x1b, 0x4f, 0x6e, 0xce, 0xe5, 0xeb, 0xf9, 0xf1, 0xb7,
+	0x81, 0xee, 0x8d, 0x9a, 0xdc, 0xc2, 0xee, 0xf0, 0x8b, 0xbb, 0xa3, 0x79, 0x63, 0xe9, 0x4e, 0xd8,
+	0x1c, 0xbf, 0x97, 0xc6, 0xd3, 0x54, 0x4f, 0x41, 0x81, 0x44, 0xc6, 0xeb, 0x49, 0x84, 0x5f, 0xa0,
+	0x25, 0x91, 0x5b, 0x1f, 0x7a, 0x3c, 0xf6, 0xa6, 0x7f, 0xc7, 0x72, 0x60, 0xf6, 0x3d, 0x1d, 0x78,
+	0x61, 0x43, 0x91, 0x82, 0x43, 0xdc, 0x43, 0xd8, 0xa3, 0x67, 0x8a, 0x9c, 0x19, 0x85, 0xf4, 0xc8,
+	0x5c, 0xb3, 0x3d, 0xbb, 0xf1, 0xd0, 0xca, 0x5e, 0x4f, 0x06, 0x35, 0x7c, 0x30, 0x86, 0x46, 0xae,
+	0xf0, 0x60, 0xee, 0xa0, 0xf5, 0xab, 0xe7, 0x0a, 0xbf, 0x83, 0xca, 0x3c, 0x6e, 0xeb, 0xe7, 0x63,
+	0x31, 0xfd, 0x87, 0xb3, 0x15, 0xb7, 0x89, 0xbc, 0xb7, 0xbf, 0x3d, 0xbf, 0xa8, 0xce, 0xbc, 0xbc,
+	0xa8, 0xce, 0xbc, 0xba, 0xa8, 0xce, 0xfc, 0x90, 0x54, 0x8d, 0xf3, 0xa4, 0x6a, 0xbc, 0x4c, 0xaa,
+	0xc6, 0xab, 0xa4, 0x6a, 0xfc, 0x99, 0x54, 0x8d, 0x9f, 0xff, 0xaa, 0xce, 0x7c, 0xbd, 0x33, 0xe5,
+	0x0f, 0x9c, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x88, 0x20, 0x78, 0x1a, 0x0d, 0x00, 0x00,
 }
 
 func (m *ContextObject) Marshal() (dAtA []byte, err error) {
@@ -760,6 +763,15 @@ func (m *TokenRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Audiences) > 0 {
+		for iNdEx := len(m.Audiences) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Audiences[iNdEx])
+			copy(dAtA[i:], m.Audiences[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Audiences[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ExpirationSeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ExpirationSeconds))
 		i--
@@ -938,6 +950,18 @@ func (m *WorkloadIdentitySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTokenExpiration != nil {
+		{
+			size, err := m.MaxTokenExpiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.TargetSystem.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1112,6 +1136,12 @@ func (m *TokenRequestSpec) Size() (n int) {
 	if m.ExpirationSeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.ExpirationSeconds))
 	}
+	if len(m.Audiences) > 0 {
+		for _, s := range m.Audiences {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1174,6 +1204,10 @@ func (m *WorkloadIdentitySpec) Size() (n int) {
 	}
 	l = m.TargetSystem.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxTokenExpiration != nil {
+		l = m.MaxTokenExpiration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1282,6 +1316,7 @@ func (this *TokenRequestSpec) String() string {
 	s := strings.Join([]string{`&TokenRequestSpec{`,
 		`ContextObject:` + strings.Replace(this.ContextObject.String(), "ContextObject", "ContextObject", 1) + `,`,
 		`ExpirationSeconds:` + valueToStringGenerated(this.ExpirationSeconds) + `,`,
+		`Audiences:` + fmt.Sprintf("%v", this.Audiences) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1332,6 +1367,7 @@ func (this *WorkloadIdentitySpec) String() string {
 	s := strings.Join([]string{`&WorkloadIdentitySpec{`,
 		`Audiences:` + fmt.Sprintf("%v", this.Audiences) + `,`,
 		`TargetSystem:` + strings.Replace(strings.Replace(this.TargetSystem.String(), "TargetSystem", "TargetSystem", 1), `&`, ``, 1) + `,`,
+		`MaxTokenExpiration:` + strings.Replace(fmt.Sprintf("%v", this.MaxTokenExpiration), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2299,6 +2335,38 @@ func (m *TokenRequestSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ExpirationSeconds = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audiences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audiences = append(m.Audiences, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2795,6 +2863,42 @@ func (m *WorkloadIdentitySpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokenExpiration == nil {
+				m.MaxTokenExpiration = &v1.Duration{}
+			}
+			if err := m.MaxTokenExpiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
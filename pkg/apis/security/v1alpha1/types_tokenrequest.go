@@ -30,6 +30,11 @@ type TokenRequestSpec struct {
 	// ExpirationSeconds specifies for how long the requested token should be valid.
 	// +optional
 	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"bytes,2,opt,name=expirationSeconds"`
+	// Audiences restricts the 'aud' claim of the requested token to a subset of the audiences of the WorkloadIdentity.
+	// If empty, the token is issued for all audiences of the WorkloadIdentity.
+	// +optional
+	// +listType=atomic
+	Audiences []string `json:"audiences,omitempty" protobuf:"bytes,3,rep,name=audiences"`
 }
 
 // ContextObject identifies the object the token is requested for.
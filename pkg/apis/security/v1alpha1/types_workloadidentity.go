@@ -35,6 +35,10 @@ type WorkloadIdentitySpec struct {
 	Audiences []string `json:"audiences" protobuf:"bytes,1,opt,name=audiences"`
 	// TargetSystem represents specific configurations for the system that will accept the JWTs.
 	TargetSystem TargetSystem `json:"targetSystem" protobuf:"bytes,2,opt,name=targetSystem"`
+	// MaxTokenExpiration is the maximum validity duration of the tokens issued for this WorkloadIdentity.
+	// If a token with a longer validity is requested, it is issued with a validity of this value.
+	// +optional
+	MaxTokenExpiration *metav1.Duration `json:"maxTokenExpiration,omitempty" protobuf:"bytes,3,opt,name=maxTokenExpiration"`
 }
 
 // TargetSystem represents specific configurations for the system that will accept the JWTs.
@@ -299,6 +299,7 @@ func autoConvert_v1alpha1_TokenRequestSpec_To_security_TokenRequestSpec(in *Toke
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
 		return err
 	}
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
 	return nil
 }
 
@@ -312,6 +313,7 @@ func autoConvert_security_TokenRequestSpec_To_v1alpha1_TokenRequestSpec(in *secu
 	if err := metav1.Convert_int64_To_Pointer_int64(&in.ExpirationSeconds, &out.ExpirationSeconds, s); err != nil {
 		return err
 	}
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
 	return nil
 }
 
@@ -421,6 +423,7 @@ func autoConvert_v1alpha1_WorkloadIdentitySpec_To_security_WorkloadIdentitySpec(
 	if err := Convert_v1alpha1_TargetSystem_To_security_TargetSystem(&in.TargetSystem, &out.TargetSystem, s); err != nil {
 		return err
 	}
+	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	return nil
 }
 
@@ -434,6 +437,7 @@ func autoConvert_security_WorkloadIdentitySpec_To_v1alpha1_WorkloadIdentitySpec(
 	if err := Convert_security_TargetSystem_To_v1alpha1_TargetSystem(&in.TargetSystem, &out.TargetSystem, s); err != nil {
 		return err
 	}
+	out.MaxTokenExpiration = (*metav1.Duration)(unsafe.Pointer(in.MaxTokenExpiration))
 	return nil
 }
 
@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -179,6 +180,11 @@ func (in *TokenRequestSpec) DeepCopyInto(out *TokenRequestSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -279,6 +285,11 @@ func (in *WorkloadIdentitySpec) DeepCopyInto(out *WorkloadIdentitySpec) {
 		copy(*out, *in)
 	}
 	in.TargetSystem.DeepCopyInto(&out.TargetSystem)
+	if in.MaxTokenExpiration != nil {
+		in, out := &in.MaxTokenExpiration, &out.MaxTokenExpiration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -7,6 +7,7 @@ package validation
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -21,6 +22,7 @@ func ValidateTokenRequest(request *security.TokenRequest) field.ErrorList {
 	)
 
 	allErrs = append(allErrs, validateExpirationSeconds(request.Spec.ExpirationSeconds, specPath.Child("expirationSeconds"))...)
+	allErrs = append(allErrs, validateTokenRequestAudiences(request.Spec.Audiences, specPath.Child("audiences"))...)
 
 	if request.Spec.ContextObject != nil {
 		allErrs = append(allErrs, validateContextObject(*request.Spec.ContextObject, specPath.Child("contextObject"))...)
@@ -29,23 +31,59 @@ func ValidateTokenRequest(request *security.TokenRequest) field.ErrorList {
 	return allErrs
 }
 
-func validateExpirationSeconds(expirationSeconds int64, path *field.Path) field.ErrorList {
-	const (
-		minDuration = time.Minute * 10
-		maxDuration = 1 << 32
+const (
+	minTokenExpiration        = time.Minute * 10
+	maxTokenExpirationSeconds = 1 << 32
+)
+
+// ValidateTokenRequestForWorkloadIdentity validates a TokenRequest against the WorkloadIdentity the token is requested
+// for.
+func ValidateTokenRequestForWorkloadIdentity(request *security.TokenRequest, workloadIdentity *security.WorkloadIdentity) field.ErrorList {
+	var (
+		allErrs          = field.ErrorList{}
+		allowedAudiences = sets.New(workloadIdentity.Spec.Audiences...)
 	)
+
+	for i, audience := range request.Spec.Audiences {
+		if !allowedAudiences.Has(audience) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "audiences").Index(i), audience, workloadIdentity.Spec.Audiences))
+		}
+	}
+
+	return allErrs
+}
+
+func validateExpirationSeconds(expirationSeconds int64, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if expirationSeconds < int64(minDuration.Seconds()) {
+	if expirationSeconds < int64(minTokenExpiration.Seconds()) {
 		allErrs = append(allErrs, field.Invalid(path, expirationSeconds, "may not specify a duration shorter than 10 minutes"))
 	}
-	if expirationSeconds > maxDuration {
+	if expirationSeconds > maxTokenExpirationSeconds {
 		allErrs = append(allErrs, field.Invalid(path, expirationSeconds, "may not specify a duration longer than 2^32 seconds"))
 	}
 
 	return allErrs
 }
 
+func validateTokenRequestAudiences(audiences []string, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	requestedAudiences := sets.New[string]()
+	for i, audience := range audiences {
+		if audience == "" {
+			allErrs = append(allErrs, field.Required(path.Index(i), "must specify non-empty audience"))
+		}
+		if requestedAudiences.Has(audience) {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), audience))
+		} else {
+			requestedAudiences.Insert(audience)
+		}
+	}
+
+	return allErrs
+}
+
 func validateContextObject(ctxObj security.ContextObject, path *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -165,5 +165,79 @@ var _ = Describe("TokenRequest Validation Tests", func() {
 				),
 			),
 		)
+
+		DescribeTable("Audiences",
+			func(audiences []string, matcher gomegatypes.GomegaMatcher) {
+				tokenRequest.Spec.Audiences = audiences
+
+				errs := ValidateTokenRequest(tokenRequest)
+				Expect(errs).To(matcher)
+			},
+			Entry("should allow no audiences",
+				nil,
+				BeEmpty(),
+			),
+			Entry("should allow multiple non-empty audiences",
+				[]string{"foo", "bar"},
+				BeEmpty(),
+			),
+			Entry("should forbid empty audience",
+				[]string{"foo", ""},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.audiences[1]"),
+				}))),
+			),
+			Entry("should forbid duplicated audience",
+				[]string{"foo", "bar", "foo"},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeDuplicate),
+					"Field":    Equal("spec.audiences[2]"),
+					"BadValue": Equal("foo"),
+				}))),
+			),
+		)
+	})
+
+	Describe("#ValidateTokenRequestForWorkloadIdentity", func() {
+		var (
+			tokenRequest     *security.TokenRequest
+			workloadIdentity *security.WorkloadIdentity
+		)
+
+		BeforeEach(func() {
+			tokenRequest = &security.TokenRequest{
+				Spec: security.TokenRequestSpec{
+					ExpirationSeconds: int64(time.Hour.Seconds()),
+				},
+			}
+			workloadIdentity = &security.WorkloadIdentity{
+				Spec: security.WorkloadIdentitySpec{
+					Audiences: []string{"gardener.cloud", "sts.example.com"},
+				},
+			}
+		})
+
+		It("should allow requests without audiences", func() {
+			Expect(ValidateTokenRequestForWorkloadIdentity(tokenRequest, workloadIdentity)).To(BeEmpty())
+		})
+
+		It("should allow requesting a subset of the audiences of the workload identity", func() {
+			tokenRequest.Spec.Audiences = []string{"sts.example.com"}
+
+			Expect(ValidateTokenRequestForWorkloadIdentity(tokenRequest, workloadIdentity)).To(BeEmpty())
+		})
+
+		It("should forbid requesting audiences which are not configured for the workload identity", func() {
+			tokenRequest.Spec.Audiences = []string{"sts.example.com", "other.example.com"}
+
+			Expect(ValidateTokenRequestForWorkloadIdentity(tokenRequest, workloadIdentity)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("spec.audiences[1]"),
+					"BadValue": Equal("other.example.com"),
+				})),
+			))
+		})
 	})
 })
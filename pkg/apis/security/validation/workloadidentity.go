@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -41,6 +42,10 @@ func validateSpec(spec security.WorkloadIdentitySpec, path *field.Path) field.Er
 	allErrs = append(allErrs, validateAudiences(spec.Audiences, path.Child("audiences"))...)
 	allErrs = append(allErrs, validateTargetSystem(spec.TargetSystem, path.Child("targetSystem"))...)
 
+	if spec.MaxTokenExpiration != nil {
+		allErrs = append(allErrs, validateMaxTokenExpiration(spec.MaxTokenExpiration.Duration, path.Child("maxTokenExpiration"))...)
+	}
+
 	return allErrs
 }
 
@@ -67,6 +72,21 @@ func validateAudiences(audiences []string, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validateMaxTokenExpiration validates the maximum validity duration of the tokens of a WorkloadIdentity. It has the
+// same bounds as the expiration of a TokenRequest.
+func validateMaxTokenExpiration(maxTokenExpiration time.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maxTokenExpiration < minTokenExpiration {
+		allErrs = append(allErrs, field.Invalid(fldPath, maxTokenExpiration.String(), "may not specify a duration shorter than 10 minutes"))
+	}
+	if maxTokenExpiration > maxTokenExpirationSeconds*time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath, maxTokenExpiration.String(), "may not specify a duration longer than 2^32 seconds"))
+	}
+
+	return allErrs
+}
+
 // validateTargetSystem validates a WorkloadIdentity TargetSystem object.
 func validateTargetSystem(targetSystem security.TargetSystem, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
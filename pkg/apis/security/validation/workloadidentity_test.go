@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -243,6 +244,46 @@ var _ = Describe("WorkloadIdentity Validation Tests", func() {
 			),
 		)
 
+		DescribeTable("MaxTokenExpiration",
+			func(maxTokenExpiration *metav1.Duration, matcher gomegatypes.GomegaMatcher) {
+				workloadIdentity.Spec.MaxTokenExpiration = maxTokenExpiration
+				errList := ValidateWorkloadIdentity(workloadIdentity)
+				Expect(errList).To(matcher)
+			},
+			Entry("should allow no maximum token expiration",
+				nil,
+				BeEmpty(),
+			),
+			Entry("should allow maximum token expiration of 10 minutes",
+				&metav1.Duration{Duration: 10 * time.Minute},
+				BeEmpty(),
+			),
+			Entry("should allow maximum token expiration of 2^32 seconds",
+				&metav1.Duration{Duration: (1 << 32) * time.Second},
+				BeEmpty(),
+			),
+			Entry("should forbid maximum token expiration shorter than 10 minutes",
+				&metav1.Duration{Duration: 5 * time.Minute},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.maxTokenExpiration"),
+						"Detail": Equal("may not specify a duration shorter than 10 minutes"),
+					})),
+				),
+			),
+			Entry("should forbid maximum token expiration longer than 2^32 seconds",
+				&metav1.Duration{Duration: (1<<32 + 1) * time.Second},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.maxTokenExpiration"),
+						"Detail": Equal("may not specify a duration longer than 2^32 seconds"),
+					})),
+				),
+			),
+		)
+
 		DescribeTable("Sub claim",
 			func(name string, f func() (string, string), matcher gomegatypes.GomegaMatcher) {
 				workloadIdentity.Name = name
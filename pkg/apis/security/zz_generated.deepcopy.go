@@ -11,6 +11,7 @@ package security
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -172,6 +173,11 @@ func (in *TokenRequestSpec) DeepCopyInto(out *TokenRequestSpec) {
 		*out = new(ContextObject)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -272,6 +278,11 @@ func (in *WorkloadIdentitySpec) DeepCopyInto(out *WorkloadIdentitySpec) {
 		copy(*out, *in)
 	}
 	in.TargetSystem.DeepCopyInto(&out.TargetSystem)
+	if in.MaxTokenExpiration != nil {
+		in, out := &in.MaxTokenExpiration, &out.MaxTokenExpiration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
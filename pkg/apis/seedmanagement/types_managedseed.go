@@ -144,4 +144,7 @@ const (
 	// ManagedSeedSeedRegistered is a condition type for indicating whether the ManagedSeed's seed has been registered,
 	// either directly or by deploying gardenlet into the shoot.
 	ManagedSeedSeedRegistered gardencore.ConditionType = "SeedRegistered"
+	// ManagedSeedConfigurationConformant is a condition type for indicating whether the actual seed and gardenlet
+	// configuration conform to the configuration declared in the ManagedSeed.
+	ManagedSeedConfigurationConformant gardencore.ConditionType = "ConfigurationConformant"
 )
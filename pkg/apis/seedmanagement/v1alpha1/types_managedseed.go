@@ -176,4 +176,7 @@ const (
 	ManagedSeedShootReconciled gardencorev1beta1.ConditionType = "ShootReconciled"
 	// SeedRegistered is a condition type for indicating whether the seed has been registered by gardenlet.
 	SeedRegistered gardencorev1beta1.ConditionType = "SeedRegistered"
+	// ManagedSeedConfigurationConformant is a condition type for indicating whether the actual seed and gardenlet
+	// configuration conform to the configuration declared in the ManagedSeed.
+	ManagedSeedConfigurationConformant gardencorev1beta1.ConditionType = "ConfigurationConformant"
 )
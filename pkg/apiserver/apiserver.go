@@ -16,6 +16,7 @@ import (
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/util/keyutil"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	corerest "github.com/gardener/gardener/pkg/apiserver/registry/core/rest"
 	operationsrest "github.com/gardener/gardener/pkg/apiserver/registry/operations/rest"
 	securityrest "github.com/gardener/gardener/pkg/apiserver/registry/security/rest"
@@ -95,6 +96,10 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		}
 	}
 
+	// The served resources are reported by the GardenCapabilities, hence, they are determined lazily after all API group
+	// infos have been constructed.
+	var apiGroupInfos []*genericapiserver.APIGroupInfo
+
 	var (
 		s                = &GardenerServer{GenericAPIServer: genericServer}
 		coreAPIGroupInfo = (corerest.StorageProvider{
@@ -103,6 +108,9 @@ func (c completedConfig) New() (*GardenerServer, error) {
 			CredentialsRotationInterval:   c.ExtraConfig.CredentialsRotationInterval,
 			KubeInformerFactory:           c.kubeInformerFactory,
 			CoreInformerFactory:           c.coreInformerFactory,
+			APIResourcesFunc: func() []core.APIResourcesCapability {
+				return servedAPIResources(apiGroupInfos)
+			},
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo       = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -113,13 +121,35 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 	)
 
-	if err := s.GenericAPIServer.InstallAPIGroups(&coreAPIGroupInfo, &settingsAPIGroupInfo, &seedManagementAPIGroupInfo, &operationsAPIGroupInfo, &securityAPIGroupInfo); err != nil {
+	apiGroupInfos = []*genericapiserver.APIGroupInfo{&coreAPIGroupInfo, &settingsAPIGroupInfo, &seedManagementAPIGroupInfo, &operationsAPIGroupInfo, &securityAPIGroupInfo}
+
+	if err := s.GenericAPIServer.InstallAPIGroups(apiGroupInfos...); err != nil {
 		return nil, err
 	}
 
 	return s, nil
 }
 
+func servedAPIResources(apiGroupInfos []*genericapiserver.APIGroupInfo) []core.APIResourcesCapability {
+	var apiResources []core.APIResourcesCapability
+
+	for _, apiGroupInfo := range apiGroupInfos {
+		for _, groupVersion := range apiGroupInfo.PrioritizedVersions {
+			resources, ok := apiGroupInfo.VersionedResourcesStorageMap[groupVersion.Version]
+			if !ok {
+				continue
+			}
+
+			apiResources = append(apiResources, core.APIResourcesCapability{
+				GroupVersion: groupVersion.String(),
+				Resources:    sets.List(sets.KeySet(resources)),
+			})
+		}
+	}
+
+	return apiResources
+}
+
 // ExtraOptions is used for providing additional options to the Gardener API Server
 type ExtraOptions struct {
 	ClusterIdentity                    string
@@ -17,5 +17,10 @@ func RegisterFeatureGates() {
 		features.ShootForceDeletion,
 		features.UseNamespacedCloudProfile,
 		features.ShootCredentialsBinding,
+		features.ShootOperationAuthorization,
+		features.ShootImport,
+		features.ExistingHostWorkerPools,
+		features.InPlaceNodeUpdates,
+		features.ShootNetworkingCIDRExpansion,
 	)))
 }
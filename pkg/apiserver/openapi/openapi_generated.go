@@ -32,6 +32,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeploymentList":                        schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1.HelmControllerDeployment":                        schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1.OCIRepository":                                   schema_pkg_apis_core_v1_OCIRepository(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIResourcesCapability":                     schema_pkg_apis_core_v1beta1_APIResourcesCapability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging":                           schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests":                          schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                      schema_pkg_apis_core_v1beta1_Addon(ref),
@@ -55,15 +56,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineType":                         schema_pkg_apis_core_v1beta1_BastionMachineType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation":                                 schema_pkg_apis_core_v1beta1_CARotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                        schema_pkg_apis_core_v1beta1_CRI(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CertificateService":                         schema_pkg_apis_core_v1beta1_CertificateService(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                               schema_pkg_apis_core_v1beta1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                           schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference":                      schema_pkg_apis_core_v1beta1_CloudProfileReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                           schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicy":                         schema_pkg_apis_core_v1beta1_ClusterAuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicyList":                     schema_pkg_apis_core_v1beta1_ClusterAuditPolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicySpec":                     schema_pkg_apis_core_v1beta1_ClusterAuditPolicySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                          schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions":                   schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                  schema_pkg_apis_core_v1beta1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ConformanceTestReport":                      schema_pkg_apis_core_v1beta1_ConformanceTestReport(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                           schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                               schema_pkg_apis_core_v1beta1_ControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceUsage":                  schema_pkg_apis_core_v1beta1_ControlPlaneResourceUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                       schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeploymentList":                   schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                     schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
@@ -78,6 +85,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                    schema_pkg_apis_core_v1beta1_CoreDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                         schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCache":                               schema_pkg_apis_core_v1beta1_CoreDNSCache(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone":                         schema_pkg_apis_core_v1beta1_CoreDNSForwardZone(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSPlugin":                              schema_pkg_apis_core_v1beta1_CoreDNSPlugin(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                           schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                        schema_pkg_apis_core_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                          schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
@@ -92,13 +102,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassList":                          schema_pkg_apis_core_v1beta1_ExposureClassList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling":                    schema_pkg_apis_core_v1beta1_ExposureClassScheduling(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension":                                  schema_pkg_apis_core_v1beta1_Extension(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionCapability":                        schema_pkg_apis_core_v1beta1_ExtensionCapability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState":                     schema_pkg_apis_core_v1beta1_ExtensionResourceState(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance":                           schema_pkg_apis_core_v1beta1_FailureTolerance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGateCapability":                      schema_pkg_apis_core_v1beta1_FeatureGateCapability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenCapabilities":                         schema_pkg_apis_core_v1beta1_GardenCapabilities(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                                   schema_pkg_apis_core_v1beta1_Gardener(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                       schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HelmControllerDeployment":                   schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                schema_pkg_apis_core_v1beta1_Hibernation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleException":               schema_pkg_apis_core_v1beta1_HibernationScheduleException(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                    schema_pkg_apis_core_v1beta1_Ingress(ref),
@@ -114,6 +128,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":        schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod":       schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved":                      schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProvider":                  schema_pkg_apis_core_v1beta1_KubeletCredentialProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProviderEnvVar":            schema_pkg_apis_core_v1beta1_KubeletCredentialProviderEnvVar(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes":                                 schema_pkg_apis_core_v1beta1_Kubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                           schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                        schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
@@ -125,11 +141,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                    schema_pkg_apis_core_v1beta1_Machine(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":           schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                               schema_pkg_apis_core_v1beta1_MachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePreview":                  schema_pkg_apis_core_v1beta1_MachineImageUpdatePreview(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                        schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                schema_pkg_apis_core_v1beta1_MachineType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePendingChanges":                  schema_pkg_apis_core_v1beta1_MaintenancePendingChanges(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreviewTimeWindow":               schema_pkg_apis_core_v1beta1_MaintenancePreviewTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
@@ -142,13 +161,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus":                           schema_pkg_apis_core_v1beta1_NetworkingStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                               schema_pkg_apis_core_v1beta1_NginxIngress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                               schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationDelivery":                       schema_pkg_apis_core_v1beta1_NotificationDelivery(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationFilter":                         schema_pkg_apis_core_v1beta1_NotificationFilter(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSink":                           schema_pkg_apis_core_v1beta1_NotificationSink(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkEmail":                      schema_pkg_apis_core_v1beta1_NotificationSinkEmail(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkList":                       schema_pkg_apis_core_v1beta1_NotificationSinkList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSlack":                      schema_pkg_apis_core_v1beta1_NotificationSinkSlack(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSpec":                       schema_pkg_apis_core_v1beta1_NotificationSinkSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkStatus":                     schema_pkg_apis_core_v1beta1_NotificationSinkStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkWebhook":                    schema_pkg_apis_core_v1beta1_NotificationSinkWebhook(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository":                              schema_pkg_apis_core_v1beta1_OCIRepository(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                 schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PKI":                                        schema_pkg_apis_core_v1beta1_PKI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectShootDefaults":                       schema_pkg_apis_core_v1beta1_ProjectShootDefaults(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                              schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                         schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
@@ -159,18 +189,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.RuntimeSecurity":                            schema_pkg_apis_core_v1beta1_RuntimeSecurity(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess":                                  schema_pkg_apis_core_v1beta1_SSHAccess(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBinding":                              schema_pkg_apis_core_v1beta1_SecretBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingList":                          schema_pkg_apis_core_v1beta1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider":                      schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed":                                       schema_pkg_apis_core_v1beta1_Seed(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup":                                 schema_pkg_apis_core_v1beta1_SeedBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapability":                             schema_pkg_apis_core_v1beta1_SeedCapability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapacityReport":                         schema_pkg_apis_core_v1beta1_SeedCapacityReport(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS":                                    schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider":                            schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedList":                                   schema_pkg_apis_core_v1beta1_SeedList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                               schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPoolUtilization":                        schema_pkg_apis_core_v1beta1_SeedPoolUtilization(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                               schema_pkg_apis_core_v1beta1_SeedProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedResourceUtilization":                    schema_pkg_apis_core_v1beta1_SeedResourceUtilization(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector":                               schema_pkg_apis_core_v1beta1_SeedSelector(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingAuditPolicy":                     schema_pkg_apis_core_v1beta1_SeedSettingAuditPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog":              schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
@@ -194,9 +230,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                     schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                           schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                   schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDNSStatus":                             schema_pkg_apis_core_v1beta1_ShootDNSStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                    schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                                  schema_pkg_apis_core_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                          schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMaintenancePreview":                    schema_pkg_apis_core_v1beta1_ShootMaintenancePreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMaintenanceStatus":                     schema_pkg_apis_core_v1beta1_ShootMaintenanceStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                              schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                    schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                  schema_pkg_apis_core_v1beta1_ShootSpec(ref),
@@ -207,12 +246,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate":                              schema_pkg_apis_core_v1beta1_ShootTemplate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                           schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                 schema_pkg_apis_core_v1beta1_Toleration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview":                       schema_pkg_apis_core_v1beta1_VersionUpdatePreview(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                     schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerExistingHosts":                        schema_pkg_apis_core_v1beta1_WorkerExistingHosts(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerHeadroom":                             schema_pkg_apis_core_v1beta1_WorkerHeadroom(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview":                   schema_pkg_apis_core_v1beta1_WorkerMaintenancePreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRollingUpdate":                        schema_pkg_apis_core_v1beta1_WorkerRollingUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
@@ -995,6 +1039,48 @@ func schema_pkg_apis_core_v1_OCIRepository(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_APIResourcesCapability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIResourcesCapability describes the resources of an API group version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupVersion is the API group version, e.g. `core.gardener.cloud/v1beta1`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are the resources and subresources served in the group version, e.g. `shoots/adminkubeconfig`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"groupVersion", "resources"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_APIServerLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1204,6 +1290,13 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"clusterAuditPolicyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the kube-apiserver. It is mutually exclusive with ConfigMapRef.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1827,6 +1920,28 @@ func schema_pkg_apis_core_v1beta1_CRI(ref common.ReferenceCallback) common.OpenA
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CertificateService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the certificate service is enabled or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2087,6 +2202,123 @@ func schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ClusterAuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAuditPolicy represents an audit policy for kube-apiservers which is managed centrally and can be referenced by shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of this audit policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicySpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ClusterAuditPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAuditPolicyList is a collection of ClusterAuditPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ClusterAuditPolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAuditPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ClusterAuditPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAuditPolicySpec is the specification of a ClusterAuditPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is the audit policy (audit.k8s.io/v1.Policy) for the kube-apiserver.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"policy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2240,6 +2472,13 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref common.ReferenceC
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority is the priority of the worker pool for the priority expander of the cluster autoscaler. Worker pools with a higher priority are preferred during scale-up. Worker pools without a priority are treated as having priority 0. It is only considered if the priority expander is configured in `.spec.kubernetes.clusterAutoscaler.expander`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -2323,6 +2562,49 @@ func schema_pkg_apis_core_v1beta1_Condition(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ConformanceTestReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConformanceTestReport contains the report of a conformance test run against a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the conformance test run.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the conformance test run was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the conformance test run was completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is a human-readable summary of the results of the conformance test run.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ContainerRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2374,6 +2656,62 @@ func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ControlPlaneResourceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneResourceUsage contains the resources consumed by control planes in the seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests is the sum of the resource requests of all control plane pods.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits is the sum of the resource limits of all control plane pods. Containers without limits are not taken into account.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"etcdStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCDStorage is the sum of the storage capacity of all etcd volumes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the resource usage was last computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2943,11 +3281,55 @@ func schema_pkg_apis_core_v1beta1_CoreDNS(ref common.ReferenceCallback) common.O
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"),
 						},
 					},
-				},
-			},
-		},
+					"plugins": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugins is a list of additional plugins which are enabled in the default server block of Core DNS. Supported plugins are `any`, `bufsize`, `minimal` and `nsid`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSPlugin"),
+									},
+								},
+							},
+						},
+					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache contains the settings of the cache of the default server block of Core DNS.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCache"),
+						},
+					},
+					"forwardZones": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ForwardZones is a list of zones whose requests are forwarded to dedicated upstream name servers instead of the name servers configured on the nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCache", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"},
 	}
 }
 
@@ -2966,6 +3348,34 @@ func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of Core DNS replicas. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of Core DNS replicas. It is only supported for the `horizontal` mode and defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"coresPerReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoresPerReplica is the number of CPU cores of the nodes per Core DNS replica. It is only supported for the `cluster-proportional` mode and defaults to 256.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodesPerReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodesPerReplica is the number of nodes per Core DNS replica. It is only supported for the `cluster-proportional` mode and defaults to 16.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"mode"},
 			},
@@ -2973,6 +3383,124 @@ func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CoreDNSCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSCache contains the settings of the cache of Core DNS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the maximum time in seconds for which responses are cached. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"successCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessCapacity is the maximum number of cached successful responses.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"denialCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DenialCapacity is the maximum number of cached denial responses.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSForwardZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSForwardZone contains the settings for forwarding the requests of a zone to dedicated upstream name servers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the DNS zone whose requests are forwarded, e.g. `corp.example.com`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"upstreams": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Upstreams is a list of IP addresses (optionally with port) of the upstream name servers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"zone", "upstreams"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSPlugin contains the settings of an additional Core DNS plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args is a list of arguments which are passed to the plugin.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3126,6 +3654,13 @@ func schema_pkg_apis_core_v1beta1_DNSProvider(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude"),
 						},
 					},
+					"secondary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secondary indicates that this DNSProvider takes over the management of the shoot related records if the primary DNSProvider is unavailable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3532,6 +4067,48 @@ func schema_pkg_apis_core_v1beta1_Extension(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ExtensionCapability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExtensionCapability describes the types of an extension kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the extension resource, e.g. `Infrastructure`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"types": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Types are the types of the extension kind which are registered, e.g. `aws`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "types"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ExtensionResourceState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3612,92 +4189,241 @@ func schema_pkg_apis_core_v1beta1_FailureTolerance(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_FeatureGateCapability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Description: "FeatureGateCapability describes a feature gate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the container id of the Gardener which last acted on a resource.",
+							Description: "Name is the name of the feature gate.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Enabled states whether the feature gate is enabled.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"stage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the Gardener which last acted on a resource.",
-							Default:     "",
+							Description: "Stage is the maturity stage of the feature gate, e.g. `ALPHA` or `BETA`. It is empty for GA feature gates.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"id", "name", "version"},
+				Required: []string{"name", "enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_GardenerResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_GardenCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GardenerResourceData holds the data which is used to generate resources, deployed in the Shoot's control plane.",
+				Description: "GardenCapabilities reports the capabilities of the Gardener landscape, i.e., the feature gates of the gardener-apiserver, the registered extensions, the served API resources, and the seeds. It is computed on request and not persisted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the object required to generate resources",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the object",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"data": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the payload required to generate resources",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"labels": {
+					"featureGates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels are labels of the object",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "FeatureGates are the feature gates of the gardener-apiserver.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGateCapability"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"name", "type", "data"},
-			},
+					"extensions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions are the extension kinds and types which are registered via ControllerRegistrations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionCapability"),
+									},
+								},
+							},
+						},
+					},
+					"apiResources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "APIResources are the resources and subresources served by the gardener-apiserver.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIResourcesCapability"),
+									},
+								},
+							},
+						},
+					},
+					"seeds": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Seeds are the capabilities of the registered seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapability"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIResourcesCapability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionCapability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGateCapability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapability", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the container id of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "name", "version"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_GardenerResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenerResourceData holds the data which is used to generate resources, deployed in the Shoot's control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the object required to generate resources",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the object",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the payload required to generate resources",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are labels of the object",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "type", "data"},
+			},
 		},
 		Dependencies: []string{
 			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
@@ -3802,7 +4528,64 @@ func schema_pkg_apis_core_v1beta1_HibernationSchedule(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"exceptions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Exceptions is a list of dates or date ranges on which the Shoot is not hibernated by the Start of this schedule. The dates are evaluated in the Location of this schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleException"),
+									},
+								},
+							},
+						},
+					},
+					"holidayCalendarConfigMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HolidayCalendarConfigMapName is the name of a ConfigMap in the namespace of the Shoot containing additional exceptions. Each value of the ConfigMap is either a date (`2006-01-02`) or a date range (`2006-01-02/2006-01-06`).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleException"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationScheduleException(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationScheduleException is a date range on which the Shoot is not hibernated by a schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the first date of the exception in the format `2006-01-02`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the last date of the exception in the format `2006-01-02`. Defaults to Start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"start"},
 			},
 		},
 	}
@@ -4213,6 +4996,13 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig"),
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is a preset of request, priority-and-fairness and watch cache settings for the kube-apiserver which is maintained by Gardener. Explicitly configured `requests` and `watchCacheSizes` take precedence over the values of the profile. Possible values are `small`, `large` and `ci-burst`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4544,11 +5334,30 @@ func schema_pkg_apis_core_v1beta1_KubeletConfig(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration"),
 						},
 					},
+					"credentialProviders": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialProviders is a list of image credential provider plugins that will be enabled by the kubelet. The plugin binaries must be present in the `/opt/bin/credential-providers` directory of the nodes, e.g. provided by the operating system image or an extension.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProvider"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4729,48 +5538,174 @@ func schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_KubeletCredentialProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
+				Description: "KubeletCredentialProvider contains the configuration of an image credential provider plugin invoked by the kubelet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterAutoscaler": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler"),
+							Description: "Name is the name of the credential provider. It must match the name of the provider executable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeAPIServer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig"),
+					"matchImages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"kubeControllerManager": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig"),
+							Description: "MatchImages is a list of strings used to match against images in order to determine if this provider should be invoked, e.g. `*.dkr.ecr.*.amazonaws.com` or `*.azurecr.io`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"kubeScheduler": {
+					"defaultCacheDuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig"),
+							Description: "DefaultCacheDuration is the default duration the plugin will cache credentials in-memory if a cache duration is not provided in the plugin response.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"kubeProxy": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeProxy contains configuration settings for the kube-proxy.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig"),
+							Description: "APIVersion is the input version of the exec CredentialProviderRequest, i.e. `credentialprovider.kubelet.k8s.io/v1` or `credentialprovider.kubelet.k8s.io/v1beta1`. Defaults to `credentialprovider.kubelet.k8s.io/v1` for Kubernetes >= v1.26 and to `credentialprovider.kubelet.k8s.io/v1beta1` otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
-						},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments passed to the plugin when executing it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env defines additional environment variables to expose to the plugin process.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProviderEnvVar"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "matchImages", "defaultCacheDuration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletCredentialProviderEnvVar", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeletCredentialProviderEnvVar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletCredentialProviderEnvVar is an environment variable passed to an image credential provider plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the environment variable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the environment variable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler"),
+						},
+					},
+					"kubeAPIServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig"),
+						},
+					},
+					"kubeControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig"),
+						},
+					},
+					"kubeScheduler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig"),
+						},
+					},
+					"kubeProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeProxy contains configuration settings for the kube-proxy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig"),
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig"),
+						},
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
@@ -4792,11 +5727,17 @@ func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"pki": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PKI contains configuration for the certificates generated for the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.PKI"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.PKI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler"},
 	}
 }
 
@@ -4936,6 +5877,20 @@ func schema_pkg_apis_core_v1beta1_LastError(ref common.ReferenceCallback) common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"component": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Component is the name of the component which originally reported the error, e.g. the type of the extension resource. It is empty if the error was reported by the controller handling the resource itself.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hint is a human readable hint how the error can be remediated. It is derived from the well-defined error codes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"description"},
 			},
@@ -5209,6 +6164,58 @@ func schema_pkg_apis_core_v1beta1_MachineImage(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachineImageUpdatePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageUpdatePreview is a machine image version update which would be performed by the next maintenance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentVersion is the currently used version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the version which would be used after the maintenance. It is not set if no suitable version could be determined.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason for the update, e.g. an automatic update or an expired version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason describes why the update would fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "currentVersion", "reason"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5451,6 +6458,115 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenancePendingChanges(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenancePendingChanges contains the changes which the next maintenance of a Shoot would apply.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"computedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComputedTime is the time when the pending changes were computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextMaintenanceTimeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreviewTimeWindow"),
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would not be updated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview"),
+						},
+					},
+					"workers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview"),
+									},
+								},
+							},
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the operation which would be triggered by the maintenance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes contains the descriptions of further changes which would be applied to the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"computedTime", "nextMaintenanceTimeWindow"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreviewTimeWindow", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenancePreviewTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenancePreviewTimeWindow is a concrete maintenance time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the begin of the time window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5915,9 +7031,17 @@ func schema_pkg_apis_core_v1beta1_NetworkingStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"dualStackMigrationInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DualStackMigrationInitiationTime is the time when the migration of the networking from IPv4 single-stack to dual-stack was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5982,44 +7106,436 @@ func schema_pkg_apis_core_v1beta1_NginxIngress(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether node local DNS is enabled or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"forceTCPToClusterDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceTCPToClusterDNS indicates whether the connection from the node local DNS to the cluster DNS (Core DNS) will be forced to TCP or not. Default, if unspecified, is to enforce TCP.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"forceTCPToUpstreamDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceTCPToUpstreamDNS indicates whether the connection from the node local DNS to the upstream DNS (infrastructure DNS) will be forced to TCP or not. Default, if unspecified, is to enforce TCP.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disableForwardToUpstreamDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableForwardToUpstreamDNS indicates whether requests from node local DNS to upstream DNS should be disabled. Default, if unspecified, is to forward requests for external domains to upstream DNS",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationDelivery describes the delivery of a notification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoot is the namespace and name of the Shoot the notification is about.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventType is the event type of the notification.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the delivery attempt.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains the error in case the delivery failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"shoot", "eventType", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationFilter restricts the notifications delivered to a NotificationSink. All set fields must match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projects": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects is the list of project names whose Shoots are considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"purposes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Purposes is the list of Shoot purposes which are considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"eventTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTypes is the list of event types which are considered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSink represents a receiver of Shoot lifecycle notifications for the whole garden.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of this notification sink.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the delivery status of this notification sink.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSinkEmail contains the configuration for delivering notifications via SMTP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the host name of the SMTP server.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port of the SMTP server.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the sender address of the notifications.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the list of recipient addresses of the notifications.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"host", "port", "from", "to"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSinkList is a collection of NotificationSinks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of NotificationSinks.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSink"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSink", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkSlack(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSinkSlack contains the configuration for delivering notifications to a Slack incoming webhook.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Channel overrides the default channel of the incoming webhook.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSinkSpec is the specification of a NotificationSink. Exactly one of Webhook, Slack or Email must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains the configuration for delivering notifications as JSON to an HTTP endpoint.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkWebhook"),
+						},
+					},
+					"slack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Slack contains the configuration for delivering notifications to a Slack incoming webhook.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSlack"),
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email contains the configuration for delivering notifications via SMTP.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkEmail"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret containing the credentials for the sink. For webhooks, the optional `token` key is sent as bearer token. For Slack, the `url` key must contain the incoming webhook URL. For email, the optional `username` and `password` keys are used for authenticating to the SMTP server.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter restricts the notifications delivered to this sink. If not set, all notifications are delivered.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationFilter", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkEmail", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkSlack", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationSinkWebhook", "k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.",
+				Description: "NotificationSinkStatus contains the delivery status of a NotificationSink.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether node local DNS is enabled or not.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"forceTCPToClusterDNS": {
+					"lastDelivery": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ForceTCPToClusterDNS indicates whether the connection from the node local DNS to the cluster DNS (Core DNS) will be forced to TCP or not. Default, if unspecified, is to enforce TCP.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "LastDelivery is the last notification which was delivered successfully.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationDelivery"),
 						},
 					},
-					"forceTCPToUpstreamDNS": {
+					"lastFailure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ForceTCPToUpstreamDNS indicates whether the connection from the node local DNS to the upstream DNS (infrastructure DNS) will be forced to TCP or not. Default, if unspecified, is to enforce TCP.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "LastFailure is the last notification whose delivery failed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationDelivery"),
 						},
 					},
-					"disableForwardToUpstreamDNS": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NotificationDelivery"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NotificationSinkWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationSinkWebhook contains the configuration for delivering notifications to an HTTP endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisableForwardToUpstreamDNS indicates whether requests from node local DNS to upstream DNS should be disabled. Default, if unspecified, is to forward requests for external domains to upstream DNS",
-							Type:        []string{"boolean"},
+							Description: "URL is the HTTPS endpoint the notifications are posted to.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"url"},
 			},
 		},
 	}
@@ -6230,6 +7746,47 @@ func schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref common.R
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_PKI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PKI contains configuration for the public key infrastructure of a cluster, i.e., for the certificate authorities and the server and client certificates signed by them. Changes only take effect when the respective certificates are regenerated, e.g., when the certificate authorities are rotated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyAlgorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyAlgorithm is the algorithm of the private keys of the generated certificates. Supported values are 'RSA' and 'ECDSA'. Defaults to 'RSA'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keySize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeySize is the size of the private keys of the generated certificates in bits. Supported values are 3072 and 4096 for 'RSA' (defaults to 3072) and 256 and 384 for 'ECDSA' (defaults to 256).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"certificateAuthorityValidity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorityValidity is the validity of the generated certificate authorities. Defaults to 10 years.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"certificateValidity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateValidity is the validity of the generated server and client certificates for which no dedicated validity is configured. Defaults to 10 years.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6398,6 +7955,60 @@ func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectShootDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectShootDefaults contains default values which are applied to new shoots in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose is the default purpose of shoots.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceTimeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceTimeWindow is the default maintenance time window of shoots.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"),
+						},
+					},
+					"hibernationSchedules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernationSchedules are the default hibernation schedules of shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"networkingType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkingType is the default type of the networking plugin of shoots with workers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6472,11 +8083,17 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"shootDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootDefaults contains default values which are applied to new shoots in this project if they don't specify them.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectShootDefaults"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectShootDefaults", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -6519,11 +8136,17 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"controlPlaneResourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneResourceUsage contains the resources consumed by the control planes of all Shoots of this project in the seed clusters. It is only maintained if the project resource usage controller of gardener-controller-manager is enabled.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceUsage"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceUsage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6924,6 +8547,28 @@ func schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_RuntimeSecurity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the runtime security agent is enabled or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SSHAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7120,60 +8765,173 @@ func schema_pkg_apis_core_v1beta1_Seed(ref common.ReferenceCallback) common.Open
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of this installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus"),
+							Description: "Status contains the status of this installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedBackup contains the object store configuration for backups for shoot (currently only etcd).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is a provider name. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to BackupBucket resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is a region name. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"provider", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedCapability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCapability describes the capabilities of a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the seed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderType is the provider type of the seed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the seed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gardenletVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GardenletVersion is the version of the gardenlet which last reported the status of the seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "providerType", "region"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedCapacityReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCapacityReport contains a report about the capacity and the utilization of a seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the report was last updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"controlPlanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlanes is the number of shoot control planes hosted by the seed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"controlPlanePods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlanePods is the number of pods running in the control plane namespaces of the shoots.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU contains the allocatable and the requested CPU of the seed's nodes.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedResourceUtilization"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedBackup contains the object store configuration for backups for shoot (currently only etcd).",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"provider": {
+					"memory": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider is a provider name. This field is immutable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Memory contains the allocatable and the requested memory of the seed's nodes.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedResourceUtilization"),
 						},
 					},
-					"providerConfig": {
+					"etcdStorage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to BackupBucket resource.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "ETCDStorage is the total storage capacity of the etcds of the shoot control planes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"region": {
+					"nodePodCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a region name. This field is immutable.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NodePodCIDRs contains the number of pod CIDRs which can be assigned to nodes from the seed's pod network and the number of assigned ones.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPoolUtilization"),
 						},
 					},
-					"secretRef": {
+					"loadBalancers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "LoadBalancers contains the number of services of type LoadBalancer in the seed and the configured quota.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPoolUtilization"),
 						},
 					},
 				},
-				Required: []string{"provider", "secretRef"},
+				Required: []string{"lastUpdateTime", "controlPlanes", "controlPlanePods"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPoolUtilization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedResourceUtilization", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7355,6 +9113,35 @@ func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedPoolUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedPoolUtilization contains the size of a pool and the number of allocated entries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity is the size of the pool. It is not set if the size is unknown.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocated is the number of allocated entries of the pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"allocated"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7408,6 +9195,40 @@ func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedResourceUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedResourceUtilization contains the allocatable and the requested amount of a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable is the amount of the resource which is allocatable on all nodes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"requested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requested is the amount of the resource which is requested by all scheduled pods.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"headroom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headroom is the amount of the resource which is allocatable but not requested yet.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"allocatable", "requested", "headroom"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7468,6 +9289,28 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingAuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingAuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterAuditPolicyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAuditPolicyName is the name of a ClusterAuditPolicy object which contains the audit policy for the kube-apiservers of shoots which do not configure an audit policy themselves.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"clusterAuditPolicyName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7857,11 +9700,17 @@ func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
 						},
 					},
+					"auditPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditPolicy controls the default audit policy for the kube-apiservers of shoot control planes in the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingAuditPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingAuditPolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
 	}
 }
 
@@ -8034,11 +9883,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
 						},
 					},
+					"capacityReport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityReport contains a report about the capacity and the utilization of the seed cluster. It is periodically updated by gardenlet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapacityReport"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCapacityReport", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8421,6 +10276,36 @@ func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootDNSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDNSStatus contains information about the DNS providers managing the records of the external domain.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveProvider is the role of the DNS provider which currently manages the records of the external domain.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastFailoverTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFailoverTime is the last time when the management of the records switched from one DNS provider to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"activeProvider"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8478,61 +10363,178 @@ func schema_pkg_apis_core_v1beta1_ShootList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Shoots.",
+							Description: "Items is the list of Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the shoot's image. If version is not provided, it will be defaulted to the latest version from the CloudProfile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootMaintenancePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootMaintenancePreview contains the changes which the next maintenance of a Shoot would apply based on the current state of the Shoot and its CloudProfile. It is returned by the `shoots/maintenance-preview` subresource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"nextMaintenanceTimeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMaintenanceTimeWindow is the next time window in which the Shoot will be maintained.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreviewTimeWindow"),
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the update of the Kubernetes version of the control plane. It is not set if the version would not be updated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview"),
+						},
+					},
+					"workers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers contains the updates of the worker pools. Worker pools whose versions would not be updated are not listed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview"),
+									},
+								},
+							},
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the operation which would be triggered by the maintenance, e.g. a credentials rotation which was requested via the `maintenance.gardener.cloud/operation` annotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes contains the descriptions of further changes which would be applied to the Shoot specification, e.g. removed feature gates or admission plugins.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"nextMaintenanceTimeWindow"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreviewTimeWindow", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootMaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Description: "ShootMaintenanceStatus contains information about the upcoming maintenance of the Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"providerConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"version": {
+					"pendingChanges": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the shoot's image. If version is not provided, it will be defaulted to the latest version from the CloudProfile.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PendingChanges are the changes which the next maintenance would apply. They are computed when the Shoot is annotated with `maintenance.gardener.cloud/operation=preview` and removed once the maintenance was performed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePendingChanges"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePendingChanges"},
 	}
 }
 
@@ -8776,6 +10778,13 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused indicates whether all operations of gardenlet on the Shoot (creation, reconciliation, migration, deletion) are paused. Health checks are continued while the Shoot is paused. When the Shoot is resumed, it is reconciled immediately.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
@@ -9075,7 +11084,7 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses that are relevant to the shoot. These include the Kube API server address and also the service account issuer.",
+							Description: "List of addresses that are relevant to the shoot. These include the Kube API server addresses, the service account issuer and the endpoint used by nodes for bootstrapping.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9132,12 +11141,42 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
 						},
 					},
+					"pausedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PausedSince is the time since when the operations of gardenlet on the Shoot are paused.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS contains information about the DNS providers managing the records of the external domain.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDNSStatus"),
+						},
+					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains information about the upcoming maintenance of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMaintenanceStatus"),
+						},
+					},
+					"controlPlaneResourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneResourceUsage contains the resources consumed by the control plane of the Shoot in the seed cluster. It is periodically updated by gardenlet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceUsage"),
+						},
+					},
+					"conformanceTest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConformanceTest contains the report of the last conformance test run against the Shoot. A run is triggered by annotating the Shoot with `shoot.gardener.cloud/conformance-test=run`.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ConformanceTestReport"),
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ConformanceTestReport", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneResourceUsage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootDNSStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMaintenanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9189,11 +11228,30 @@ func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback)
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"),
 						},
 					},
+					"runtimeSecurity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeSecurity contains the settings of the runtime security agent running on the nodes of the Shoot cluster. The agent detects suspicious behaviour of processes and containers. Its findings are forwarded to the logging stack of the Shoot cluster, and critical findings are additionally reported as events of the affected Node.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.RuntimeSecurity"),
+						},
+					},
+					"certificateService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateService contains the settings of the Gardener-managed certificate service of the Shoot cluster. Workloads can request TLS certificates for the domain of the Shoot via `Certificate` resources or annotations of `Service`s and `Ingress`es, the DNS01 challenges are solved with the DNS provider of the Shoot domain.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CertificateService"),
+						},
+					},
+					"resourceTier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceTier is the tier of the resource presets (requests, limits and bounds of the vertical pod autoscaling) of the managed system components (CoreDNS, kube-proxy, node-problem-detector, metrics-server). The 'small' tier reduces the footprint on small clusters, the 'large' tier raises the presets for huge clusters. Possible values are 'small', 'medium' and 'large'. Defaults to 'medium' if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CertificateService", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.RuntimeSecurity"},
 	}
 }
 
@@ -9226,6 +11284,50 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_VersionUpdatePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionUpdatePreview is a version update which would be performed by the next maintenance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentVersion is the currently used version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the version which would be used after the maintenance. It is not set if no suitable version could be determined.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason for the update, e.g. an automatic update or an expired version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason describes why the update would fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"currentVersion", "reason"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9659,12 +11761,94 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions"),
 						},
 					},
+					"existingHosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExistingHosts specifies that this worker pool is backed by pre-existing hosts (e.g., bare metal servers or virtual machines) which are not provisioned by Gardener but registered to the cluster by the user. Gardener only manages the operating system configuration and the kubelet of such hosts. This field is immutable.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerExistingHosts"),
+						},
+					},
+					"headroom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headroom contains the configuration for capacity headroom of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerHeadroom"),
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy specifies how the nodes of the worker pool are updated when the Kubernetes version, the machine image version, or the kubelet configuration change. With `InPlace`, the operating system and the kubelet of the existing nodes are updated instead of replacing the nodes with new machines. Defaults to `RollingUpdate`. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollingUpdate contains the configuration for progressive rolling updates of the worker pool's nodes during Kubernetes minor version upgrades. It cannot be used together with the `InPlace` update strategy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRollingUpdate"),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerExistingHosts", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerHeadroom", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRollingUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerExistingHosts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerExistingHosts contains the configuration for worker pools backed by pre-existing hosts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bootstrapTokenValidity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BootstrapTokenValidity is the validity of the bootstrap token used for registering hosts. The token is renewed with every reconciliation of the Shoot. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerHeadroom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerHeadroom contains the configuration for capacity headroom of a worker pool. Gardener runs low-priority placeholder pods on the pool's nodes which reserve the given resources. They are preempted as soon as pods with a higher priority need the capacity, hence these pods can be scheduled immediately while the cluster-autoscaler provisions new nodes for the preempted placeholders.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of placeholder pods.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the amount of CPU reserved by each placeholder pod.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of memory reserved by each placeholder pod.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -9696,6 +11880,94 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerMaintenancePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerMaintenancePreview contains the updates of a worker pool which would be performed by the next maintenance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the update of the Kubernetes version of the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview"),
+						},
+					},
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImage is the update of the machine image version of the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePreview"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageUpdatePreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePreview"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerRollingUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerRollingUpdate contains the configuration for progressive rolling updates of a worker pool. When the Kubernetes minor version of the worker pool is upgraded, the nodes are rolled in canary steps. After each step, the rollout is paused until the soak period has passed and all nodes are healthy. Afterwards, the remaining nodes are rolled with the `maxSurge` and `maxUnavailable` settings of the worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is the maximum number of machines that are created per zone while the canary steps are rolled out. Defaults to the `maxSurge` setting of the worker pool.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of machines per zone that can be unavailable while the canary steps are rolled out. Defaults to the `maxUnavailable` setting of the worker pool.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"canarySteps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CanarySteps is the list of cumulative numbers or percentages of machines per zone which are rolled before the rollout is paused, e.g. `[1, 25%]`. Percentages are rounded up. The steps must be increasing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+									},
+								},
+							},
+						},
+					},
+					"soakPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakPeriod is the duration for which the rollout is paused after each canary step before it continues, given that all nodes are healthy. Defaults to 10m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"canarySteps"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10275,6 +12547,26 @@ func schema_pkg_apis_security_v1alpha1_TokenRequestSpec(ref common.ReferenceCall
 							Format:      "int64",
 						},
 					},
+					"audiences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Audiences restricts the 'aud' claim of the requested token to a subset of the audiences of the WorkloadIdentity. If empty, the token is issued for all audiences of the WorkloadIdentity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -10444,12 +12736,18 @@ func schema_pkg_apis_security_v1alpha1_WorkloadIdentitySpec(ref common.Reference
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem"),
 						},
 					},
+					"maxTokenExpiration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTokenExpiration is the maximum validity duration of the tokens issued for this WorkloadIdentity. If a token with a longer validity is requested, it is issued with a validity of this value.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"audiences", "targetSystem"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem"},
+			"github.com/gardener/gardener/pkg/apis/security/v1alpha1.TargetSystem", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
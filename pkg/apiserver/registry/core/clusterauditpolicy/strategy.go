@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterauditpolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// Strategy defines the strategy for storing clusterauditpolicies.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy returns a storage strategy for clusterauditpolicies.
+func NewStrategy() Strategy {
+	return Strategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (Strategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	clusterAuditPolicy := obj.(*core.ClusterAuditPolicy)
+
+	clusterAuditPolicy.Generation = 1
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (Strategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newClusterAuditPolicy := newObj.(*core.ClusterAuditPolicy)
+	oldClusterAuditPolicy := oldObj.(*core.ClusterAuditPolicy)
+
+	if mustIncreaseGeneration(oldClusterAuditPolicy, newClusterAuditPolicy) {
+		newClusterAuditPolicy.Generation = oldClusterAuditPolicy.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldClusterAuditPolicy, newClusterAuditPolicy *core.ClusterAuditPolicy) bool {
+	// The ClusterAuditPolicy specification changes.
+	if !apiequality.Semantic.DeepEqual(oldClusterAuditPolicy.Spec, newClusterAuditPolicy.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldClusterAuditPolicy.DeletionTimestamp == nil && newClusterAuditPolicy.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+// Validate allows to validate the object.
+func (Strategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	clusterAuditPolicy := obj.(*core.ClusterAuditPolicy)
+	return validation.ValidateClusterAuditPolicy(clusterAuditPolicy)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (Strategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldClusterAuditPolicy, newClusterAuditPolicy := oldObj.(*core.ClusterAuditPolicy), newObj.(*core.ClusterAuditPolicy)
+	return validation.ValidateClusterAuditPolicyUpdate(newClusterAuditPolicy, oldClusterAuditPolicy)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (Strategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (Strategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (Strategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/features"
+)
+
+// APIResourcesFunc returns the resources and subresources served by the gardener-apiserver.
+type APIResourcesFunc func() []core.APIResourcesCapability
+
+// REST implements the REST endpoint for reading the capabilities of the Gardener landscape.
+type REST struct {
+	featureGate                  featuregate.MutableFeatureGate
+	controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister
+	seedLister                   gardencorev1beta1listers.SeedLister
+	apiResourcesFunc             APIResourcesFunc
+}
+
+var (
+	_ = rest.Getter(&REST{})
+	_ = rest.Scoper(&REST{})
+	_ = rest.SingularNameProvider(&REST{})
+)
+
+// NewREST returns a REST object that computes the capabilities of the Gardener landscape on request.
+func NewREST(
+	featureGate featuregate.MutableFeatureGate,
+	controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister,
+	seedLister gardencorev1beta1listers.SeedLister,
+	apiResourcesFunc APIResourcesFunc,
+) *REST {
+	return &REST{
+		featureGate:                  featureGate,
+		controllerRegistrationLister: controllerRegistrationLister,
+		seedLister:                   seedLister,
+		apiResourcesFunc:             apiResourcesFunc,
+	}
+}
+
+// New returns an instance of the object.
+func (r *REST) New() runtime.Object {
+	return &core.GardenCapabilities{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *REST) Destroy() {}
+
+// NamespaceScoped returns false because GardenCapabilities are cluster-scoped.
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName returns the singular name of the resource.
+func (r *REST) GetSingularName() string {
+	return "gardencapabilities"
+}
+
+// Get returns the capabilities of the Gardener landscape. Only the object with name `garden` exists.
+func (r *REST) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	if name != core.GardenCapabilitiesName {
+		return nil, apierrors.NewNotFound(core.Resource("gardencapabilities"), name)
+	}
+
+	extensions, err := r.extensions()
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not list controller registrations: %w", err))
+	}
+
+	seeds, err := r.seeds()
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not list seeds: %w", err))
+	}
+
+	return &core.GardenCapabilities{
+		ObjectMeta:   metav1.ObjectMeta{Name: core.GardenCapabilitiesName},
+		FeatureGates: r.featureGates(),
+		Extensions:   extensions,
+		APIResources: r.apiResourcesFunc(),
+		Seeds:        seeds,
+	}, nil
+}
+
+func (r *REST) featureGates() []core.FeatureGateCapability {
+	var featureGates []core.FeatureGateCapability
+
+	// The feature gate also contains the generic feature gates of the kube-apiserver library which are not relevant for
+	// clients, hence, only Gardener's own feature gates are reported.
+	for name, spec := range r.featureGate.GetAll() {
+		if _, ok := features.AllFeatureGates[name]; !ok {
+			continue
+		}
+
+		featureGates = append(featureGates, core.FeatureGateCapability{
+			Name:    string(name),
+			Enabled: r.featureGate.Enabled(name),
+			Stage:   string(spec.PreRelease),
+		})
+	}
+
+	slices.SortFunc(featureGates, func(a, b core.FeatureGateCapability) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return featureGates
+}
+
+func (r *REST) extensions() ([]core.ExtensionCapability, error) {
+	controllerRegistrations, err := r.controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	kindToTypes := map[string]sets.Set[string]{}
+	for _, controllerRegistration := range controllerRegistrations {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if _, ok := kindToTypes[resource.Kind]; !ok {
+				kindToTypes[resource.Kind] = sets.New[string]()
+			}
+			kindToTypes[resource.Kind].Insert(resource.Type)
+		}
+	}
+
+	var extensions []core.ExtensionCapability
+	for _, kind := range sets.List(sets.KeySet(kindToTypes)) {
+		extensions = append(extensions, core.ExtensionCapability{
+			Kind:  kind,
+			Types: sets.List(kindToTypes[kind]),
+		})
+	}
+	return extensions, nil
+}
+
+func (r *REST) seeds() ([]core.SeedCapability, error) {
+	seedList, err := r.seedLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var seeds []core.SeedCapability
+	for _, seed := range seedList {
+		seedCapability := core.SeedCapability{
+			Name:         seed.Name,
+			ProviderType: seed.Spec.Provider.Type,
+			Region:       seed.Spec.Provider.Region,
+		}
+
+		if seed.Status.Gardener != nil {
+			seedCapability.GardenletVersion = &seed.Status.Gardener.Version
+		}
+
+		seeds = append(seeds, seedCapability)
+	}
+
+	slices.SortFunc(seeds, func(a, b core.SeedCapability) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return seeds, nil
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/core/gardencapabilities/storage"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/features"
+)
+
+var _ = Describe("REST", func() {
+	var (
+		ctx = context.TODO()
+
+		featureGate                   featuregate.MutableFeatureGate
+		controllerRegistrationIndexer cache.Indexer
+		seedIndexer                   cache.Indexer
+		apiResources                  []core.APIResourcesCapability
+
+		r *REST
+	)
+
+	BeforeEach(func() {
+		featureGate = featuregate.NewFeatureGate()
+		Expect(featureGate.Add(features.GetFeatures(features.ShootForceDeletion, features.InPlaceNodeUpdates))).To(Succeed())
+		Expect(featureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{"NotAGardenerFeature": {Default: true, PreRelease: featuregate.Beta}})).To(Succeed())
+		Expect(featureGate.SetFromMap(map[string]bool{string(features.InPlaceNodeUpdates): true})).To(Succeed())
+
+		controllerRegistrationIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(controllerRegistrationIndexer.Add(&gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{Resources: []gardencorev1beta1.ControllerResource{
+				{Kind: "Infrastructure", Type: "local"},
+				{Kind: "DNSRecord", Type: "local"},
+			}},
+		})).To(Succeed())
+		Expect(controllerRegistrationIndexer.Add(&gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-aws"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{Resources: []gardencorev1beta1.ControllerResource{
+				{Kind: "Infrastructure", Type: "aws"},
+			}},
+		})).To(Succeed())
+
+		seedIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(seedIndexer.Add(&gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-b"},
+			Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "aws", Region: "eu-west-1"}},
+			Status:     gardencorev1beta1.SeedStatus{Gardener: &gardencorev1beta1.Gardener{Version: "v1.100.0"}},
+		})).To(Succeed())
+		Expect(seedIndexer.Add(&gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed-a"},
+			Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: "local"}},
+		})).To(Succeed())
+
+		apiResources = []core.APIResourcesCapability{{GroupVersion: "core.gardener.cloud/v1beta1", Resources: []string{"shoots", "shoots/status"}}}
+	})
+
+	JustBeforeEach(func() {
+		r = NewREST(
+			featureGate,
+			gardencorev1beta1listers.NewControllerRegistrationLister(controllerRegistrationIndexer),
+			gardencorev1beta1listers.NewSeedLister(seedIndexer),
+			func() []core.APIResourcesCapability { return apiResources },
+		)
+	})
+
+	It("should be a cluster-scoped resource", func() {
+		Expect(r.New()).To(Equal(&core.GardenCapabilities{}))
+		Expect(r.NamespaceScoped()).To(BeFalse())
+		Expect(r.GetSingularName()).To(Equal("gardencapabilities"))
+	})
+
+	It("should return the capabilities of the landscape", func() {
+		obj, err := r.Get(ctx, "garden", &metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(obj).To(Equal(&core.GardenCapabilities{
+			ObjectMeta: metav1.ObjectMeta{Name: "garden"},
+			FeatureGates: []core.FeatureGateCapability{
+				{Name: "InPlaceNodeUpdates", Enabled: true, Stage: "ALPHA"},
+				{Name: "ShootForceDeletion", Enabled: true, Stage: "BETA"},
+			},
+			Extensions: []core.ExtensionCapability{
+				{Kind: "DNSRecord", Types: []string{"local"}},
+				{Kind: "Infrastructure", Types: []string{"aws", "local"}},
+			},
+			APIResources: apiResources,
+			Seeds: []core.SeedCapability{
+				{Name: "seed-a", ProviderType: "local", Region: "local"},
+				{Name: "seed-b", ProviderType: "aws", Region: "eu-west-1", GardenletVersion: ptr.To("v1.100.0")},
+			},
+		}))
+	})
+
+	Context("nothing is registered", func() {
+		BeforeEach(func() {
+			featureGate = featuregate.NewFeatureGate()
+			controllerRegistrationIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			seedIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			apiResources = nil
+		})
+
+		It("should return an empty object", func() {
+			obj, err := r.Get(ctx, "garden", &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(Equal(&core.GardenCapabilities{ObjectMeta: metav1.ObjectMeta{Name: "garden"}}))
+		})
+	})
+
+	It("should return a not found error for other names", func() {
+		_, err := r.Get(ctx, "foo", &metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Type", Type: "string", Description: "The type of the notification sink."},
+			{Name: "Last Delivery", Type: "date", Description: "The time of the last successful delivery."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			notificationSink = obj.(*core.NotificationSink)
+			cells            = []any{}
+		)
+		cells = append(cells, notificationSink.Name)
+		switch {
+		case notificationSink.Spec.Webhook != nil:
+			cells = append(cells, "webhook")
+		case notificationSink.Spec.Slack != nil:
+			cells = append(cells, "slack")
+		case notificationSink.Spec.Email != nil:
+			cells = append(cells, "email")
+		default:
+			cells = append(cells, "<unknown>")
+		}
+		if lastDelivery := notificationSink.Status.LastDelivery; lastDelivery != nil {
+			cells = append(cells, metatable.ConvertToHumanReadableDateType(lastDelivery.Time))
+		} else {
+			cells = append(cells, "<none>")
+		}
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(notificationSink.CreationTimestamp))
+		return cells, nil
+	})
+
+	return table, err
+}
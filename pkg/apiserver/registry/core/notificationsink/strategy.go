@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// Strategy defines the strategy for storing notificationsinks.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy returns a storage strategy for notificationsinks.
+func NewStrategy() Strategy {
+	return Strategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (Strategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	notificationSink := obj.(*core.NotificationSink)
+
+	notificationSink.Generation = 1
+	notificationSink.Status = core.NotificationSinkStatus{}
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (Strategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newNotificationSink := newObj.(*core.NotificationSink)
+	oldNotificationSink := oldObj.(*core.NotificationSink)
+	newNotificationSink.Status = oldNotificationSink.Status
+
+	if mustIncreaseGeneration(oldNotificationSink, newNotificationSink) {
+		newNotificationSink.Generation = oldNotificationSink.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldNotificationSink, newNotificationSink *core.NotificationSink) bool {
+	// The NotificationSink specification changes.
+	if !apiequality.Semantic.DeepEqual(oldNotificationSink.Spec, newNotificationSink.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldNotificationSink.DeletionTimestamp == nil && newNotificationSink.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+// Validate allows to validate the object.
+func (Strategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	notificationSink := obj.(*core.NotificationSink)
+	return validation.ValidateNotificationSink(notificationSink)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (Strategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldNotificationSink, newNotificationSink := oldObj.(*core.NotificationSink), newObj.(*core.NotificationSink)
+	return validation.ValidateNotificationSinkUpdate(newNotificationSink, oldNotificationSink)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (Strategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (Strategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (Strategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+// StatusStrategy defines the strategy for storing the status subresource of notificationsinks.
+type StatusStrategy struct {
+	Strategy
+}
+
+// NewStatusStrategy returns a storage strategy for the status subresource of notificationsinks.
+func NewStatusStrategy() StatusStrategy {
+	return StatusStrategy{NewStrategy()}
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (StatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newNotificationSink := newObj.(*core.NotificationSink)
+	oldNotificationSink := oldObj.(*core.NotificationSink)
+	newNotificationSink.Spec = oldNotificationSink.Spec
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (StatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldNotificationSink, newNotificationSink := oldObj.(*core.NotificationSink), newObj.(*core.NotificationSink)
+	return validation.ValidateNotificationSinkStatusUpdate(newNotificationSink, oldNotificationSink)
+}
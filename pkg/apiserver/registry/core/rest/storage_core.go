@@ -11,6 +11,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	kubeinformers "k8s.io/client-go/informers"
 
 	"github.com/gardener/gardener/pkg/api"
@@ -20,12 +21,15 @@ import (
 	backupbucketstore "github.com/gardener/gardener/pkg/apiserver/registry/core/backupbucket/storage"
 	backupentrystore "github.com/gardener/gardener/pkg/apiserver/registry/core/backupentry/storage"
 	cloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/cloudprofile/storage"
+	clusterauditpolicystore "github.com/gardener/gardener/pkg/apiserver/registry/core/clusterauditpolicy/storage"
 	controllerdeploymentstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerdeployment/storage"
 	controllerinstallationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerinstallation/storage"
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
+	gardencapabilitiesstore "github.com/gardener/gardener/pkg/apiserver/registry/core/gardencapabilities/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
+	notificationsinkstore "github.com/gardener/gardener/pkg/apiserver/registry/core/notificationsink/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/apiserver/registry/core/quota/storage"
 	secretbindingstore "github.com/gardener/gardener/pkg/apiserver/registry/core/secretbinding/storage"
@@ -33,6 +37,8 @@ import (
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	shootmaintenance "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
+	"github.com/gardener/gardener/pkg/features"
 )
 
 // StorageProvider contains configurations related to the core resources.
@@ -42,6 +48,9 @@ type StorageProvider struct {
 	CredentialsRotationInterval   time.Duration
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
+	// APIResourcesFunc returns the resources served by the gardener-apiserver which are reported by the
+	// GardenCapabilities.
+	APIResourcesFunc gardencapabilitiesstore.APIResourcesFunc
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -80,10 +89,17 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	cloudprofileStorage := cloudprofilestore.NewStorage(restOptionsGetter)
 	storage["cloudprofiles"] = cloudprofileStorage.CloudProfile
 
+	clusterAuditPolicyStorage := clusterauditpolicystore.NewStorage(restOptionsGetter)
+	storage["clusterauditpolicies"] = clusterAuditPolicyStorage.ClusterAuditPolicy
+
 	namespacedcloudprofileStorage := namespacedcloudprofilestore.NewStorage(restOptionsGetter)
 	storage["namespacedcloudprofiles"] = namespacedcloudprofileStorage.NamespacedCloudProfile
 	storage["namespacedcloudprofiles/status"] = namespacedcloudprofileStorage.Status
 
+	notificationSinkStorage := notificationsinkstore.NewStorage(restOptionsGetter)
+	storage["notificationsinks"] = notificationSinkStorage.NotificationSink
+	storage["notificationsinks/status"] = notificationSinkStorage.Status
+
 	controllerDeploymentStorage := controllerdeploymentstore.NewStorage(restOptionsGetter)
 	storage["controllerdeployments"] = controllerDeploymentStorage.ControllerDeployment
 
@@ -97,6 +113,13 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	exposureClassStorage := exposureclassstore.NewStorage(restOptionsGetter)
 	storage["exposureclasses"] = exposureClassStorage.ExposureClass
 
+	storage["gardencapabilities"] = gardencapabilitiesstore.NewREST(
+		features.DefaultFeatureGate,
+		p.CoreInformerFactory.Core().V1beta1().ControllerRegistrations().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().Seeds().Lister(),
+		p.APIResourcesFunc,
+	)
+
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
@@ -121,6 +144,9 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 		p.CoreInformerFactory.Core().V1beta1().InternalSecrets().Lister(),
 		p.KubeInformerFactory.Core().V1().Secrets().Lister(),
 		p.KubeInformerFactory.Core().V1().ConfigMaps().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().CloudProfiles().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Lister(),
+		shootmaintenance.Preview,
 		p.AdminKubeconfigMaxExpiration,
 		p.ViewerKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
@@ -130,6 +156,10 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/maintenance-preview"] = shootStorage.MaintenancePreview
+	if utilfeature.DefaultFeatureGate.Enabled(features.ShootImport) {
+		storage["shoots/import"] = shootStorage.Import
+	}
 
 	return storage
 }
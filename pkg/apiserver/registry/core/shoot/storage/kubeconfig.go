@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/audit"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
@@ -29,6 +31,18 @@ import (
 	"github.com/gardener/gardener/pkg/utils/secrets"
 )
 
+const (
+	// AuditAnnotationUser is the audit annotation containing the name of the user for whom the kubeconfig was issued.
+	AuditAnnotationUser = "authentication.gardener.cloud/user"
+	// AuditAnnotationRequestedExpirationSeconds is the audit annotation containing the validity requested by the user.
+	AuditAnnotationRequestedExpirationSeconds = "authentication.gardener.cloud/requested-expiration-seconds"
+	// AuditAnnotationExpirationSeconds is the audit annotation containing the validity of the issued kubeconfig, i.e.,
+	// the requested validity capped at the configured maximum.
+	AuditAnnotationExpirationSeconds = "authentication.gardener.cloud/expiration-seconds"
+	// AuditAnnotationExpirationTimestamp is the audit annotation containing the time when the issued kubeconfig expires.
+	AuditAnnotationExpirationTimestamp = "authentication.gardener.cloud/expiration-timestamp"
+)
+
 // KubeconfigREST implements a RESTStorage for a kubeconfig request.
 type KubeconfigREST struct {
 	// TODO(petersutter): Remove secretLister field from struct after v1.110 has been released, as the cluster CA should then only be read from the ConfigMap.
@@ -141,6 +155,7 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 	}
 
 	// generate kubeconfig with client certificate
+	requestedExpirationSeconds := kubeconfigRequest.Spec.ExpirationSeconds
 	if r.maxExpirationSeconds > 0 && kubeconfigRequest.Spec.ExpirationSeconds > r.maxExpirationSeconds {
 		kubeconfigRequest.Spec.ExpirationSeconds = r.maxExpirationSeconds
 	}
@@ -183,6 +198,14 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 	kubeconfigRequest.Status.Kubeconfig = controlPlaneSecret.Kubeconfig
 	kubeconfigRequest.Status.ExpirationTimestamp = metav1.Time{Time: controlPlaneSecret.Certificate.Certificate.NotAfter}
 
+	// record details about the issued kubeconfig in the audit log for traceability
+	audit.AddAuditAnnotations(ctx,
+		AuditAnnotationUser, userInfo.GetName(),
+		AuditAnnotationRequestedExpirationSeconds, strconv.FormatInt(requestedExpirationSeconds, 10),
+		AuditAnnotationExpirationSeconds, strconv.FormatInt(kubeconfigRequest.Spec.ExpirationSeconds, 10),
+		AuditAnnotationExpirationTimestamp, kubeconfigRequest.Status.ExpirationTimestamp.UTC().Format(time.RFC3339),
+	)
+
 	if err := api.Scheme.Convert(kubeconfigRequest, obj, nil); err != nil {
 		return nil, fmt.Errorf("failed converting %T to %T: %w", kubeconfigRequest, obj, err)
 	}
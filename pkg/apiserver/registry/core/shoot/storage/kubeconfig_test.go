@@ -18,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	registryrest "k8s.io/apiserver/pkg/registry/rest"
@@ -313,6 +314,35 @@ lIwEl8tStnO9u1JUK4w1e+lC37zI2v5k4WMQmJcolUEMwmZjnCR/
 			Expect(cert.NotBefore.UTC()).To(Equal(time.Unix(10, 0).UTC()))
 			Expect(cert.Issuer.CommonName).To(Equal(clientCACertName))
 		})
+
+		It("should add audit annotations about the issued kubeconfig", func() {
+			ctx = audit.WithAuditContext(ctx)
+
+			_, err := kcREST.Create(ctx, name, obj, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(audit.AuditContextFrom(ctx).Event.Annotations).To(Equal(map[string]string{
+				"authentication.gardener.cloud/user":                         userName,
+				"authentication.gardener.cloud/requested-expiration-seconds": "660",
+				"authentication.gardener.cloud/expiration-seconds":           "660",
+				"authentication.gardener.cloud/expiration-timestamp":         "1970-01-01T00:11:10Z",
+			}))
+		})
+
+		It("should add audit annotations with the requested validity if it was capped", func() {
+			ctx = audit.WithAuditContext(ctx)
+			setExpirationSeconds(obj, ptr.To(int64(2*time.Hour/time.Second)))
+
+			_, err := kcREST.Create(ctx, name, obj, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(audit.AuditContextFrom(ctx).Event.Annotations).To(Equal(map[string]string{
+				"authentication.gardener.cloud/user":                         userName,
+				"authentication.gardener.cloud/requested-expiration-seconds": "7200",
+				"authentication.gardener.cloud/expiration-seconds":           "3600",
+				"authentication.gardener.cloud/expiration-timestamp":         "1970-01-01T01:00:10Z",
+			}))
+		})
 	})
 }
 
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// MaintenancePreviewFunc computes the changes which the next maintenance of the given Shoot would apply based on the
+// given CloudProfile.
+type MaintenancePreviewFunc func(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) (*gardencorev1beta1.ShootMaintenancePreview, error)
+
+// MaintenancePreviewREST implements the REST endpoint for previewing the next maintenance of a Shoot.
+type MaintenancePreviewREST struct {
+	shootStorage                 getter
+	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister
+	previewFunc                  MaintenancePreviewFunc
+	clock                        clock.PassiveClock
+}
+
+var (
+	_ = rest.Getter(&MaintenancePreviewREST{})
+	_ = rest.GroupVersionKindProvider(&MaintenancePreviewREST{})
+)
+
+// NewMaintenancePreviewREST returns a new MaintenancePreviewREST which computes the preview with the given function.
+func NewMaintenancePreviewREST(
+	shootGetter getter,
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister,
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister,
+	previewFunc MaintenancePreviewFunc,
+	clock clock.PassiveClock,
+) *MaintenancePreviewREST {
+	return &MaintenancePreviewREST{
+		shootStorage:                 shootGetter,
+		cloudProfileLister:           cloudProfileLister,
+		namespacedCloudProfileLister: namespacedCloudProfileLister,
+		previewFunc:                  previewFunc,
+		clock:                        clock,
+	}
+}
+
+// New returns an instance of the object.
+func (r *MaintenancePreviewREST) New() runtime.Object {
+	return &core.ShootMaintenancePreview{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *MaintenancePreviewREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Get returns the changes which the next maintenance of the Shoot would apply based on the current state of the Shoot
+// and its CloudProfile.
+func (r *MaintenancePreviewREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	shootObj, err := r.shootStorage.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	shootV1beta1 := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, shootV1beta1, nil); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed converting %T to %T: %w", shoot, shootV1beta1, err))
+	}
+
+	cloudProfile, err := r.getCloudProfile(shootV1beta1)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get cloud profile of shoot: %w", err))
+	}
+
+	previewV1beta1, err := r.previewFunc(shootV1beta1, cloudProfile, r.clock.Now())
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not compute maintenance preview: %w", err))
+	}
+
+	preview := &core.ShootMaintenancePreview{}
+	if err := api.Scheme.Convert(previewV1beta1, preview, nil); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed converting %T to %T: %w", previewV1beta1, preview, err))
+	}
+
+	return preview, nil
+}
+
+func (r *MaintenancePreviewREST) getCloudProfile(shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, error) {
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("could not determine cloudprofile from shoot")
+	}
+
+	switch cloudProfileReference.Kind {
+	case v1beta1constants.CloudProfileReferenceKindCloudProfile:
+		return r.cloudProfileLister.Get(cloudProfileReference.Name)
+	case v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile:
+		namespacedCloudProfile, err := r.namespacedCloudProfileLister.NamespacedCloudProfiles(shoot.Namespace).Get(cloudProfileReference.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &gardencorev1beta1.CloudProfile{Spec: namespacedCloudProfile.Status.CloudProfileSpec}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported cloudprofile kind %q", cloudProfileReference.Kind)
+}
+
+// GroupVersionKind returns the GVK for the maintenance preview type.
+func (r *MaintenancePreviewREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootMaintenancePreview")
+}
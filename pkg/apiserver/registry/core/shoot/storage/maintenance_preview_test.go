@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+)
+
+var _ = Describe("MaintenancePreview", func() {
+	const (
+		name      = "test"
+		namespace = "baz"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		shoot                  *gardencore.Shoot
+		cloudProfile           *gardencorev1beta1.CloudProfile
+		namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+
+		cloudProfileIndexer           cache.Indexer
+		namespacedCloudProfileIndexer cache.Indexer
+		shootGetter                   *fakeGetter
+		fakeClock                     *testclock.FakeClock
+
+		previewedShoot        *gardencorev1beta1.Shoot
+		previewedCloudProfile *gardencorev1beta1.CloudProfile
+		previewFunc           MaintenancePreviewFunc
+
+		r *MaintenancePreviewREST
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencore.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				Kubernetes:       gardencore.Kubernetes{Version: "1.30.1"},
+			},
+		}
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.30.1"}}},
+			},
+		}
+		namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "namespaced-profile", Namespace: namespace},
+			Status: gardencorev1beta1.NamespacedCloudProfileStatus{
+				CloudProfileSpec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.30.2"}}},
+				},
+			},
+		}
+
+		cloudProfileIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(cloudProfileIndexer.Add(cloudProfile)).To(Succeed())
+		namespacedCloudProfileIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		Expect(namespacedCloudProfileIndexer.Add(namespacedCloudProfile)).To(Succeed())
+
+		shootGetter = &fakeGetter{obj: shoot}
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		previewedShoot, previewedCloudProfile = nil, nil
+		previewFunc = func(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) (*gardencorev1beta1.ShootMaintenancePreview, error) {
+			previewedShoot, previewedCloudProfile = shoot, cloudProfile
+			return &gardencorev1beta1.ShootMaintenancePreview{
+				ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace},
+				NextMaintenanceTimeWindow: gardencorev1beta1.MaintenancePreviewTimeWindow{
+					Begin: metav1.NewTime(now),
+					End:   metav1.NewTime(now.Add(time.Hour)),
+				},
+				KubernetesVersion: &gardencorev1beta1.VersionUpdatePreview{
+					CurrentVersion: shoot.Spec.Kubernetes.Version,
+					TargetVersion:  ptr.To(cloudProfile.Spec.Kubernetes.Versions[0].Version),
+					Reason:         "Automatic update of Kubernetes version configured",
+				},
+			}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		r = NewMaintenancePreviewREST(
+			shootGetter,
+			gardencorev1beta1listers.NewCloudProfileLister(cloudProfileIndexer),
+			gardencorev1beta1listers.NewNamespacedCloudProfileLister(namespacedCloudProfileIndexer),
+			previewFunc,
+			fakeClock,
+		)
+	})
+
+	It("should return an empty preview object", func() {
+		Expect(r.New()).To(Equal(&gardencore.ShootMaintenancePreview{}))
+		Expect(r.GroupVersionKind(gardencorev1beta1.SchemeGroupVersion).Kind).To(Equal("ShootMaintenancePreview"))
+	})
+
+	It("should compute the preview based on the referenced CloudProfile", func() {
+		obj, err := r.Get(ctx, name, &metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(previewedShoot.Name).To(Equal(name))
+		Expect(previewedCloudProfile).To(Equal(cloudProfile))
+		Expect(obj).To(Equal(&gardencore.ShootMaintenancePreview{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			NextMaintenanceTimeWindow: gardencore.MaintenancePreviewTimeWindow{
+				Begin: metav1.NewTime(fakeClock.Now()),
+				End:   metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+			},
+			KubernetesVersion: &gardencore.VersionUpdatePreview{
+				CurrentVersion: "1.30.1",
+				TargetVersion:  ptr.To("1.30.1"),
+				Reason:         "Automatic update of Kubernetes version configured",
+			},
+		}))
+	})
+
+	It("should compute the preview based on the status of the referenced NamespacedCloudProfile", func() {
+		shoot.Spec.CloudProfileName = nil
+		shoot.Spec.CloudProfile = &gardencore.CloudProfileReference{
+			Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile,
+			Name: namespacedCloudProfile.Name,
+		}
+
+		obj, err := r.Get(ctx, name, &metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(previewedCloudProfile.Spec).To(Equal(namespacedCloudProfile.Status.CloudProfileSpec))
+		Expect(obj.(*gardencore.ShootMaintenancePreview).KubernetesVersion.TargetVersion).To(Equal(ptr.To("1.30.2")))
+	})
+
+	It("should return the error if the shoot cannot be read", func() {
+		shootGetter.err = apierrors.NewNotFound(gardencore.Resource("shoots"), name)
+
+		_, err := r.Get(ctx, name, &metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should return an error if the referenced CloudProfile does not exist", func() {
+		shoot.Spec.CloudProfileName = ptr.To("does-not-exist")
+
+		_, err := r.Get(ctx, name, &metav1.GetOptions{})
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("could not get cloud profile of shoot")))
+	})
+
+	It("should return an error if the preview cannot be computed", func() {
+		r.previewFunc = func(*gardencorev1beta1.Shoot, *gardencorev1beta1.CloudProfile, time.Time) (*gardencorev1beta1.ShootMaintenancePreview, error) {
+			return nil, errors.New("fake")
+		}
+
+		_, err := r.Get(ctx, name, &metav1.GetOptions{})
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("could not compute maintenance preview: fake")))
+	})
+})
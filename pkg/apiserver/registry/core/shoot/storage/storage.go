@@ -15,6 +15,8 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
@@ -28,11 +30,13 @@ type REST struct {
 
 // ShootStorage implements the storage for Shoots and all their subresources.
 type ShootStorage struct {
-	Shoot            *REST
-	Status           *StatusREST
-	AdminKubeconfig  *KubeconfigREST
-	ViewerKubeconfig *KubeconfigREST
-	Binding          *BindingREST
+	Shoot              *REST
+	Status             *StatusREST
+	AdminKubeconfig    *KubeconfigREST
+	ViewerKubeconfig   *KubeconfigREST
+	MaintenancePreview *MaintenancePreviewREST
+	Binding            *BindingREST
+	Import             *ImportREST
 }
 
 // NewStorage creates a new ShootStorage object.
@@ -41,23 +45,28 @@ func NewStorage(
 	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
 	secretLister kubecorev1listers.SecretLister,
 	configMapLister kubecorev1listers.ConfigMapLister,
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister,
+	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister,
+	maintenancePreviewFunc MaintenancePreviewFunc,
 	adminKubeconfigMaxExpiration time.Duration,
 	viewerKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 ) ShootStorage {
-	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	shootRest, shootStatusRest, bindingREST, importREST := NewREST(optsGetter, credentialsRotationInterval)
 
 	return ShootStorage{
-		Shoot:            shootRest,
-		Status:           shootStatusRest,
-		Binding:          bindingREST,
-		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration),
-		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration),
+		Shoot:              shootRest,
+		Status:             shootStatusRest,
+		Binding:            bindingREST,
+		Import:             importREST,
+		AdminKubeconfig:    NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration),
+		ViewerKubeconfig:   NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration),
+		MaintenancePreview: NewMaintenancePreviewREST(shootRest, cloudProfileLister, namespacedCloudProfileLister, maintenancePreviewFunc, clock.RealClock{}),
 	}
 }
 
 // NewREST returns a RESTStorage object that will work against shoots.
-func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST) {
+func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST, *ImportREST) {
 	var (
 		shootStrategy = shoot.NewStrategy(credentialsRotationInterval)
 		store         = &genericregistry.Store{
@@ -78,6 +87,11 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 			RESTOptions: optsGetter,
 			AttrFunc:    shoot.GetAttrs,
 			TriggerFunc: map[string]storage.IndexerFunc{core.ShootSeedName: shoot.SeedNameTriggerFunc},
+			Indexers: &cache.Indexers{
+				storage.FieldIndex(core.ShootSeedName):       shoot.SeedNameIndexFunc,
+				storage.FieldIndex(core.ShootStatusSeedName): shoot.StatusSeedNameIndexFunc,
+				storage.FieldIndex(core.ShootPurposeName):    shoot.PurposeIndexFunc,
+			},
 		}
 	)
 
@@ -89,7 +103,9 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 	statusStore.UpdateStrategy = shoot.NewStatusStrategy()
 	bindingStore := *store
 	bindingStore.UpdateStrategy = shoot.NewBindingStrategy()
-	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+	importStore := *store
+	importStore.UpdateStrategy = shoot.NewImportStrategy()
+	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}, &ImportREST{store: &importStore}
 }
 
 // Implement CategoriesProvider
@@ -164,6 +180,38 @@ func (r *BindingREST) Update(ctx context.Context, name string, objInfo rest.Upda
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
 }
 
+// ImportREST implements the REST endpoint for importing an existing cluster as Shoot.
+type ImportREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &ImportREST{}
+	_ rest.Getter  = &ImportREST{}
+	_ rest.Updater = &ImportREST{}
+)
+
+// New creates a new (empty) internal Shoot object.
+func (r *ImportREST) New() runtime.Object {
+	return &core.Shoot{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ImportREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ImportREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update triggers the import of the existing cluster.
+func (r *ImportREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
 // Implement ShortNamesProvider
 var _ rest.ShortNamesProvider = &REST{}
 
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/api/core/shoot"
@@ -28,9 +29,14 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	"github.com/gardener/gardener/plugin/pkg/utils"
 )
 
+// defaultDebugModeDuration is the duration for which the debug mode of the control plane is enabled if the
+// enable-debug-mode operation is requested without specifying the end of the time window.
+const defaultDebugModeDuration = time.Hour
+
 type shootStrategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
@@ -56,11 +62,15 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	newShoot.Generation = 1
 	newShoot.Status = core.ShootStatus{}
 
+	setDefaultPurposeAndMaintenanceTimeWindow(newShoot)
 	utils.SyncCloudProfileFields(nil, newShoot)
 
 	if !utilfeature.DefaultFeatureGate.Enabled(features.ShootCredentialsBinding) {
 		newShoot.Spec.CredentialsBindingName = nil
 	}
+
+	dropDisabledExistingHosts(newShoot, nil)
+	dropDisabledInPlaceUpdates(newShoot, nil)
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -70,6 +80,9 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	newShoot.Status = oldShoot.Status               // can only be changed by shoots/status subresource
 	newShoot.Spec.SeedName = oldShoot.Spec.SeedName // can only be changed by shoots/binding subresource
 
+	handleDebugModeOperation(newShoot, time.Now())
+	setDefaultPurposeAndMaintenanceTimeWindow(newShoot)
+
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
@@ -79,6 +92,80 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	if oldShoot.Spec.CredentialsBindingName == nil && !utilfeature.DefaultFeatureGate.Enabled(features.ShootCredentialsBinding) {
 		newShoot.Spec.CredentialsBindingName = nil
 	}
+
+	dropDisabledExistingHosts(newShoot, oldShoot)
+	dropDisabledInPlaceUpdates(newShoot, oldShoot)
+}
+
+// setDefaultPurposeAndMaintenanceTimeWindow defaults the purpose and the maintenance time window of the given Shoot if
+// they are not set. In contrast to the other defaults, they are not set when the API object is decoded, so that
+// mutating admission plugins (e.g., ShootProjectDefaults) can still distinguish unset fields from explicitly set ones.
+func setDefaultPurposeAndMaintenanceTimeWindow(shoot *core.Shoot) {
+	if shoot.Spec.Purpose == nil {
+		shoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+	}
+
+	if shoot.Spec.Maintenance == nil {
+		shoot.Spec.Maintenance = &core.Maintenance{}
+	}
+	if shoot.Spec.Maintenance.TimeWindow == nil {
+		mt := timewindow.RandomMaintenanceTimeWindow()
+		shoot.Spec.Maintenance.TimeWindow = &core.MaintenanceTimeWindow{
+			Begin: mt.Begin().Formatted(),
+			End:   mt.End().Formatted(),
+		}
+	}
+}
+
+// handleDebugModeOperation translates the enable-debug-mode and disable-debug-mode operations into the
+// debug-mode-until annotation which is evaluated by gardenlet. If the annotation is already set along with the
+// enable-debug-mode operation, its value is kept.
+func handleDebugModeOperation(newShoot *core.Shoot, now time.Time) {
+	switch newShoot.Annotations[v1beta1constants.GardenerOperation] {
+	case v1beta1constants.ShootOperationEnableDebugMode:
+		if _, ok := newShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil]; !ok {
+			newShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil] = now.Add(defaultDebugModeDuration).UTC().Format(time.RFC3339)
+		}
+		delete(newShoot.Annotations, v1beta1constants.GardenerOperation)
+
+	case v1beta1constants.ShootOperationDisableDebugMode:
+		delete(newShoot.Annotations, v1beta1constants.AnnotationShootDebugModeUntil)
+		delete(newShoot.Annotations, v1beta1constants.GardenerOperation)
+	}
+}
+
+// dropDisabledExistingHosts removes the existing hosts configuration from worker pools if the ExistingHostWorkerPools
+// feature gate is disabled. Worker pools which already used existing hosts keep their configuration.
+func dropDisabledExistingHosts(newShoot, oldShoot *core.Shoot) {
+	if utilfeature.DefaultFeatureGate.Enabled(features.ExistingHostWorkerPools) {
+		return
+	}
+
+	for i, worker := range newShoot.Spec.Provider.Workers {
+		if oldShoot != nil {
+			if oldWorker := gardencorehelper.FindWorkerByName(oldShoot.Spec.Provider.Workers, worker.Name); oldWorker != nil && oldWorker.ExistingHosts != nil {
+				continue
+			}
+		}
+		newShoot.Spec.Provider.Workers[i].ExistingHosts = nil
+	}
+}
+
+// dropDisabledInPlaceUpdates removes the update strategy from worker pools if the InPlaceNodeUpdates feature gate is
+// disabled. Worker pools which already used an update strategy keep their configuration.
+func dropDisabledInPlaceUpdates(newShoot, oldShoot *core.Shoot) {
+	if utilfeature.DefaultFeatureGate.Enabled(features.InPlaceNodeUpdates) {
+		return
+	}
+
+	for i, worker := range newShoot.Spec.Provider.Workers {
+		if oldShoot != nil {
+			if oldWorker := gardencorehelper.FindWorkerByName(oldShoot.Spec.Provider.Workers, worker.Name); oldWorker != nil && oldWorker.UpdateStrategy != nil {
+				continue
+			}
+		}
+		newShoot.Spec.Provider.Workers[i].UpdateStrategy = nil
+	}
 }
 
 func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
@@ -92,6 +179,12 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 		return true
 	}
 
+	// The debug mode of the control plane is enabled, disabled, or its time window changes.
+	// This is necessary because we want to apply or revert the debug settings right away even if the Shoot is failed.
+	if oldShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil] != newShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil] {
+		return true
+	}
+
 	// Force delete annotation is set.
 	// This is necessary because we want to trigger a reconciliation right away even if the Shoot is failed.
 	if !gardencorehelper.ShootNeedsForceDeletion(oldShoot) && gardencorehelper.ShootNeedsForceDeletion(newShoot) {
@@ -106,8 +199,14 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 
 		switch lastOperation.State {
 		case core.LastOperationStateFailed:
-			if val, ok := newShoot.Annotations[v1beta1constants.GardenerOperation]; ok && val == v1beta1constants.ShootOperationRetry {
+			switch newShoot.Annotations[v1beta1constants.GardenerOperation] {
+			case v1beta1constants.ShootOperationRetry:
 				mustIncrease, mustRemoveOperationAnnotation = true, true
+
+			case v1beta1constants.ShootOperationRetryFailedTasks:
+				// We don't want to remove the annotation so that the gardenlet can pick it up and only retry the failed
+				// tasks. It has to remove the annotation when it starts the operation.
+				mustIncrease, mustRemoveOperationAnnotation = true, false
 			}
 
 		default:
@@ -129,6 +228,16 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 				// the rotation. It has to remove the annotation after it is done.
 				mustIncrease, mustRemoveOperationAnnotation = true, false
 
+			case v1beta1constants.ShootOperationEnableDualStack:
+				// We don't want to remove the annotation so that the gardenlet can pick it up and start the migration to
+				// dual-stack networking. It has to remove the annotation when it starts the operation.
+				mustIncrease, mustRemoveOperationAnnotation = true, false
+
+			case v1beta1constants.ShootOperationMigrateBackupBucket:
+				// We don't want to remove the annotation so that the gardenlet can pick it up and start the migration of the
+				// etcd backups. It has to remove the annotation when it starts the operation.
+				mustIncrease, mustRemoveOperationAnnotation = true, false
+
 			case v1beta1constants.ShootOperationRotateSSHKeypair:
 				if !gardencorehelper.ShootEnablesSSHAccess(newShoot) {
 					// If SSH is not enabled for the Shoot, don't increase generation, just remove the annotation
@@ -152,7 +261,10 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 
 func mustIncreaseGenerationForSpecChanges(oldShoot, newShoot *core.Shoot) bool {
 	if newShoot.Spec.Maintenance != nil && newShoot.Spec.Maintenance.ConfineSpecUpdateRollout != nil && *newShoot.Spec.Maintenance.ConfineSpecUpdateRollout {
-		return gardencorehelper.HibernationIsEnabled(oldShoot) != gardencorehelper.HibernationIsEnabled(newShoot)
+		// Pausing and resuming is not confined to the maintenance time window, otherwise gardenlet would not observe
+		// that the operations on the Shoot are resumed.
+		return gardencorehelper.HibernationIsEnabled(oldShoot) != gardencorehelper.HibernationIsEnabled(newShoot) ||
+			ptr.Deref(oldShoot.Spec.Paused, false) != ptr.Deref(newShoot.Spec.Paused, false)
 	}
 
 	return !apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec)
@@ -259,15 +371,66 @@ func (shootBindingStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Obj
 	return nil
 }
 
+type shootImportStrategy struct {
+	shootStrategy
+}
+
+// NewImportStrategy returns a new storage strategy for the import subresource of Shoots.
+func NewImportStrategy() shootImportStrategy {
+	return shootImportStrategy{NewStrategy(0)}
+}
+
+func (shootImportStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShoot := obj.(*core.Shoot)
+	oldShoot := old.(*core.Shoot)
+
+	newShoot.Spec = oldShoot.Spec
+	newShoot.Status = oldShoot.Status
+
+	if oldShoot.Status.LastOperation == nil {
+		// The import adopts the existing cluster by running the restore flow, i.e., the extensions restore their
+		// resources based on the state which was provided by the importer in the ShootState.
+		newShoot.Status.LastOperation = &core.LastOperation{
+			Type:        core.LastOperationTypeRestore,
+			State:       core.LastOperationStatePending,
+			Description: "Shoot cluster import is pending.",
+		}
+		newShoot.Generation = oldShoot.Generation + 1
+	}
+}
+
+func (s shootImportStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	oldShoot := old.(*core.Shoot)
+	allErrs := s.shootStrategy.ValidateUpdate(ctx, obj, old)
+
+	if !gardencorehelper.IsShootImport(oldShoot) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations").Key(v1beta1constants.AnnotationShootImport), "shoot must be annotated for being imported"))
+	}
+	if oldShoot.Status.LastOperation != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("status", "lastOperation"), "shoot can only be imported before it was reconciled for the first time"))
+	}
+
+	return allErrs
+}
+
+func (shootImportStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+func (shootImportStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
 // ToSelectableFields returns a field set that represents the object
 func ToSelectableFields(shoot *core.Shoot) fields.Set {
 	// The purpose of allocation with a given number of elements is to reduce
 	// amount of allocations needed to create the fields.Set. If you add any
 	// field here or the number of object-meta related fields changes, this should
 	// be adjusted.
-	shootSpecificFieldsSet := make(fields.Set, 7)
+	shootSpecificFieldsSet := make(fields.Set, 8)
 	shootSpecificFieldsSet[core.ShootSeedName] = getSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootStatusSeedName] = getStatusSeedName(shoot)
+	shootSpecificFieldsSet[core.ShootPurposeName] = getPurpose(shoot)
 	if shoot.Spec.CloudProfileName != nil {
 		shootSpecificFieldsSet[core.ShootCloudProfileName] = *shoot.Spec.CloudProfileName
 	}
@@ -293,7 +456,7 @@ func MatchShoot(label labels.Selector, field fields.Selector) storage.SelectionP
 		Label:       label,
 		Field:       field,
 		GetAttrs:    GetAttrs,
-		IndexFields: []string{core.ShootSeedName},
+		IndexFields: []string{core.ShootSeedName, core.ShootStatusSeedName, core.ShootPurposeName},
 	}
 }
 
@@ -307,6 +470,36 @@ func SeedNameTriggerFunc(obj runtime.Object) string {
 	return getSeedName(shoot)
 }
 
+// SeedNameIndexFunc returns spec.seedName of given Shoot.
+func SeedNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getSeedName(shoot)}, nil
+}
+
+// StatusSeedNameIndexFunc returns status.seedName of given Shoot.
+func StatusSeedNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getStatusSeedName(shoot)}, nil
+}
+
+// PurposeIndexFunc returns spec.purpose of given Shoot.
+func PurposeIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getPurpose(shoot)}, nil
+}
+
 func getSeedName(shoot *core.Shoot) string {
 	if shoot.Spec.SeedName == nil {
 		return ""
@@ -320,3 +513,10 @@ func getStatusSeedName(shoot *core.Shoot) string {
 	}
 	return *shoot.Status.SeedName
 }
+
+func getPurpose(shoot *core.Shoot) string {
+	if shoot.Spec.Purpose == nil {
+		return ""
+	}
+	return string(*shoot.Spec.Purpose)
+}
@@ -6,12 +6,15 @@ package shoot_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/utils/ptr"
 
@@ -156,6 +159,69 @@ var _ = Describe("Strategy", func() {
 
 				Expect(shoot.Spec.CredentialsBindingName).To(Equal(ptr.To("binding")))
 			})
+
+			It("should remove the existing hosts configuration of worker pools if ExistingHostWorkerPools feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ExistingHostWorkerPools, false))
+
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pool", ExistingHosts: &core.WorkerExistingHosts{}}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Provider.Workers[0].ExistingHosts).To(BeNil())
+			})
+
+			It("should not remove the existing hosts configuration of worker pools if ExistingHostWorkerPools feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ExistingHostWorkerPools, true))
+
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pool", ExistingHosts: &core.WorkerExistingHosts{}}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Provider.Workers[0].ExistingHosts).NotTo(BeNil())
+			})
+
+			It("should remove the update strategy of worker pools if InPlaceNodeUpdates feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, false))
+
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pool", UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Provider.Workers[0].UpdateStrategy).To(BeNil())
+			})
+
+			It("should not remove the update strategy of worker pools if InPlaceNodeUpdates feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, true))
+
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pool", UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)}}
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Provider.Workers[0].UpdateStrategy).To(Equal(ptr.To(core.MachineUpdateStrategyInPlace)))
+			})
+		})
+
+		Context("purpose and maintenance time window defaulting", func() {
+			var shoot *core.Shoot
+
+			BeforeEach(func() {
+				shoot = &core.Shoot{}
+			})
+
+			It("should default the purpose and the maintenance time window", func() {
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeEvaluation)))
+				Expect(shoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+				Expect(shoot.Spec.Maintenance.TimeWindow.Begin).To(HaveSuffix("0000+0000"))
+				Expect(shoot.Spec.Maintenance.TimeWindow.End).To(HaveSuffix("0000+0000"))
+			})
+
+			It("should not overwrite the purpose and the maintenance time window if they are set", func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+				shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}}
+
+				strategy.PrepareForCreate(context.TODO(), shoot)
+
+				Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeProduction)))
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "010000+0100", End: "020000+0100"}))
+			})
 		})
 	})
 
@@ -290,6 +356,50 @@ var _ = Describe("Strategy", func() {
 
 				Expect(newShoot.Spec.CredentialsBindingName).To(Equal(ptr.To("binding")))
 			})
+
+			It("should remove the existing hosts configuration of new worker pools if ExistingHostWorkerPools feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ExistingHostWorkerPools, false))
+
+				oldShoot.Spec.Provider.Workers = []core.Worker{{Name: "pool1", ExistingHosts: &core.WorkerExistingHosts{}}}
+				newShoot.Spec.Provider.Workers = []core.Worker{
+					{Name: "pool1", ExistingHosts: &core.WorkerExistingHosts{}},
+					{Name: "pool2", ExistingHosts: &core.WorkerExistingHosts{}},
+				}
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Provider.Workers[0].ExistingHosts).NotTo(BeNil())
+				Expect(newShoot.Spec.Provider.Workers[1].ExistingHosts).To(BeNil())
+			})
+
+			It("should remove the update strategy of new worker pools if InPlaceNodeUpdates feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, false))
+
+				oldShoot.Spec.Provider.Workers = []core.Worker{{Name: "pool1", UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)}}
+				newShoot.Spec.Provider.Workers = []core.Worker{
+					{Name: "pool1", UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)},
+					{Name: "pool2", UpdateStrategy: ptr.To(core.MachineUpdateStrategyInPlace)},
+				}
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Provider.Workers[0].UpdateStrategy).To(Equal(ptr.To(core.MachineUpdateStrategyInPlace)))
+				Expect(newShoot.Spec.Provider.Workers[1].UpdateStrategy).To(BeNil())
+			})
+		})
+
+		Context("purpose and maintenance time window defaulting", func() {
+			It("should default the purpose and the maintenance time window if they are removed", func() {
+				oldShoot := &core.Shoot{Spec: defaultedShootSpec()}
+				oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+				newShoot := oldShoot.DeepCopy()
+				newShoot.Spec.Purpose = nil
+				newShoot.Spec.Maintenance = nil
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeEvaluation)))
+				Expect(newShoot.Spec.Maintenance.TimeWindow).NotTo(BeNil())
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
 		})
 
 		Context("seedName change", func() {
@@ -322,7 +432,7 @@ var _ = Describe("Strategy", func() {
 			)
 
 			BeforeEach(func() {
-				oldShoot = &core.Shoot{}
+				oldShoot = &core.Shoot{Spec: defaultedShootSpec()}
 				newShoot = oldShoot.DeepCopy()
 			})
 
@@ -502,6 +612,23 @@ var _ = Describe("Strategy", func() {
 						nil,
 						false,
 					),
+
+					// exceptional cases: spec.paused changes even if confineSpecUpdateRollout is true
+					Entry("paused nil -> true",
+						ptr.To(true), ptr.To(true),
+						nil, func(s *core.Shoot) { s.Spec.Paused = ptr.To(true) },
+						true,
+					),
+					Entry("paused true -> nil",
+						ptr.To(true), ptr.To(true),
+						func(s *core.Shoot) { s.Spec.Paused = ptr.To(true) }, nil,
+						true,
+					),
+					Entry("paused nil -> false",
+						ptr.To(true), ptr.To(true),
+						nil, func(s *core.Shoot) { s.Spec.Paused = ptr.To(false) },
+						false,
+					),
 				)
 			})
 
@@ -509,6 +636,8 @@ var _ = Describe("Strategy", func() {
 				func(operationAnnotation string, mutateOldShoot func(*core.Shoot), shouldIncreaseGeneration, shouldKeepAnnotation bool) {
 					oldShoot := &core.Shoot{
 						Spec: core.ShootSpec{
+							Purpose:     ptr.To(core.ShootPurposeEvaluation),
+							Maintenance: &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}},
 							Provider: core.Provider{
 								Workers: []core.Worker{
 									{
@@ -562,6 +691,18 @@ var _ = Describe("Strategy", func() {
 					false,
 					true,
 				),
+				Entry("retry-failed-task; last operation is failed",
+					v1beta1constants.ShootOperationRetryFailedTasks,
+					func(s *core.Shoot) { s.Status.LastOperation.State = core.LastOperationStateFailed },
+					true,
+					true,
+				),
+				Entry("retry-failed-task; last operation is not failed",
+					v1beta1constants.ShootOperationRetryFailedTasks,
+					func(s *core.Shoot) { s.Status.LastOperation.State = core.LastOperationStateSucceeded },
+					false,
+					true,
+				),
 				Entry("reconcile",
 					v1beta1constants.GardenerOperationReconcile,
 					nil,
@@ -607,6 +748,20 @@ var _ = Describe("Strategy", func() {
 					true,
 				),
 
+				Entry("enable-dual-stack",
+					v1beta1constants.ShootOperationEnableDualStack,
+					nil,
+					true,
+					true,
+				),
+
+				Entry("migrate-backup-bucket",
+					v1beta1constants.ShootOperationMigrateBackupBucket,
+					nil,
+					true,
+					true,
+				),
+
 				Entry("rotate-etcd-encryption-key-start",
 					v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 					nil,
@@ -647,6 +802,75 @@ var _ = Describe("Strategy", func() {
 				),
 			)
 		})
+
+		Context("debug mode", func() {
+			var (
+				oldShoot *core.Shoot
+				newShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				oldShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Generation: 2},
+					Spec:       defaultedShootSpec(),
+					Status:     core.ShootStatus{LastOperation: &core.LastOperation{State: core.LastOperationStateFailed}},
+				}
+				newShoot = oldShoot.DeepCopy()
+			})
+
+			It("should enable the debug mode for one hour and increase the generation even if the shoot is failed", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationEnableDebugMode)
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(newShoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+				Expect(newShoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootDebugModeUntil))
+				until, err := time.Parse(time.RFC3339, newShoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(until).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			})
+
+			It("should keep the end of the time window if it is specified along with the operation", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationEnableDebugMode)
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.AnnotationShootDebugModeUntil, "2024-01-01T12:00:00Z")
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(newShoot.Annotations).To(Equal(map[string]string{v1beta1constants.AnnotationShootDebugModeUntil: "2024-01-01T12:00:00Z"}))
+			})
+
+			It("should disable the debug mode and increase the generation", func() {
+				metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, v1beta1constants.AnnotationShootDebugModeUntil, "2024-01-01T12:00:00Z")
+				newShoot = oldShoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationDisableDebugMode)
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(newShoot.Annotations).To(BeEmpty())
+			})
+
+			It("should not increase the generation if the debug mode is disabled already", func() {
+				metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationDisableDebugMode)
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
+				Expect(newShoot.Annotations).To(BeEmpty())
+			})
+
+			It("should increase the generation if the end of the time window is removed", func() {
+				metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, v1beta1constants.AnnotationShootDebugModeUntil, "2024-01-01T12:00:00Z")
+				newShoot = oldShoot.DeepCopy()
+				delete(newShoot.Annotations, v1beta1constants.AnnotationShootDebugModeUntil)
+
+				strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+				Expect(newShoot.Generation).To(Equal(oldShoot.Generation + 1))
+			})
+		})
 	})
 
 	Describe("#Canonicalize", func() {
@@ -675,11 +899,99 @@ var _ = Describe("Strategy", func() {
 	})
 })
 
+var _ = Describe("ImportStrategy", func() {
+	var (
+		ctx      = context.TODO()
+		strategy rest.RESTUpdateStrategy
+
+		oldShoot *core.Shoot
+		newShoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		strategy = NewImportStrategy()
+
+		oldShoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shoot",
+				Namespace:   "my-namespace",
+				Generation:  1,
+				Annotations: map[string]string{v1beta1constants.AnnotationShootImport: "true"},
+			},
+			Spec: core.ShootSpec{SeedName: ptr.To("seed")},
+		}
+		newShoot = oldShoot.DeepCopy()
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should ignore changes to the spec and status", func() {
+			newShoot.Spec.SeedName = ptr.To("other-seed")
+			newShoot.Status.SeedName = ptr.To("other-seed")
+
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Spec).To(Equal(oldShoot.Spec))
+			Expect(newShoot.Status.SeedName).To(BeNil())
+		})
+
+		It("should trigger the restore of the shoot and increase the generation", func() {
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(core.LastOperationTypeRestore),
+				"State": Equal(core.LastOperationStatePending),
+			})))
+			Expect(newShoot.Generation).To(Equal(int64(2)))
+		})
+
+		It("should not change the last operation if the shoot was already reconciled", func() {
+			oldShoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeReconcile, State: core.LastOperationStateSucceeded}
+			newShoot.Status.LastOperation = nil
+
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Status.LastOperation).To(Equal(oldShoot.Status.LastOperation))
+			Expect(newShoot.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#ValidateUpdate", func() {
+		importAnnotationErr := PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("metadata.annotations[shoot.gardener.cloud/import]"),
+		}))
+		lastOperationErr := PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("status.lastOperation"),
+		}))
+
+		It("should allow importing an annotated shoot which was not reconciled yet", func() {
+			errs := strategy.ValidateUpdate(ctx, newShoot, oldShoot)
+
+			Expect(errs).NotTo(ContainElement(importAnnotationErr))
+			Expect(errs).NotTo(ContainElement(lastOperationErr))
+		})
+
+		It("should forbid importing a shoot without the import annotation", func() {
+			delete(oldShoot.Annotations, v1beta1constants.AnnotationShootImport)
+			delete(newShoot.Annotations, v1beta1constants.AnnotationShootImport)
+
+			Expect(strategy.ValidateUpdate(ctx, newShoot, oldShoot)).To(ContainElement(importAnnotationErr))
+		})
+
+		It("should forbid importing a shoot which was already reconciled", func() {
+			oldShoot.Status.LastOperation = &core.LastOperation{Type: core.LastOperationTypeCreate, State: core.LastOperationStateProcessing}
+
+			Expect(strategy.ValidateUpdate(ctx, newShoot, oldShoot)).To(ContainElement(lastOperationErr))
+		})
+	})
+})
+
 var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := ToSelectableFields(newShoot("foo"))
 
-		Expect(result).To(HaveLen(7))
+		Expect(result).To(HaveLen(8))
 		Expect(result.Has(core.ShootSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootSeedName)).To(Equal("foo"))
 		Expect(result.Has(core.ShootCloudProfileName)).To(BeTrue())
@@ -690,6 +1002,8 @@ var _ = Describe("ToSelectableFields", func() {
 		Expect(result.Get(core.ShootCloudProfileRefKind)).To(Equal("CloudProfile"))
 		Expect(result.Has(core.ShootStatusSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootStatusSeedName)).To(Equal("foo"))
+		Expect(result.Has(core.ShootPurposeName)).To(BeTrue())
+		Expect(result.Get(core.ShootPurposeName)).To(Equal("evaluation"))
 	})
 })
 
@@ -725,7 +1039,45 @@ var _ = Describe("MatchShoot", func() {
 
 		Expect(result.Label).To(Equal(ls))
 		Expect(result.Field).To(Equal(fs))
-		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName))
+		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName, core.ShootStatusSeedName, core.ShootPurposeName))
+	})
+})
+
+var _ = Describe("#SeedNameIndexFunc", func() {
+	It("should return spec.seedName", func() {
+		result, err := SeedNameIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("foo"))
+	})
+
+	It("should return an error for other objects", func() {
+		_, err := SeedNameIndexFunc(&core.Seed{})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("#StatusSeedNameIndexFunc", func() {
+	It("should return status.seedName", func() {
+		result, err := StatusSeedNameIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("foo"))
+	})
+})
+
+var _ = Describe("#PurposeIndexFunc", func() {
+	It("should return spec.purpose", func() {
+		result, err := PurposeIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("evaluation"))
+	})
+
+	It("should return an empty string if the purpose is not set", func() {
+		shoot := newShoot("foo")
+		shoot.Spec.Purpose = nil
+
+		result, err := PurposeIndexFunc(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf(""))
 	})
 })
 
@@ -739,6 +1091,7 @@ func newShoot(seedName string) *core.Shoot {
 		Spec: core.ShootSpec{
 			CloudProfileName: ptr.To("baz"),
 			SeedName:         &seedName,
+			Purpose:          ptr.To(core.ShootPurposeEvaluation),
 			CloudProfile: &core.CloudProfileReference{
 				Kind: "CloudProfile",
 				Name: "baz",
@@ -749,3 +1102,12 @@ func newShoot(seedName string) *core.Shoot {
 		},
 	}
 }
+
+// defaultedShootSpec returns a ShootSpec with the fields which are defaulted by the strategy, i.e., like the spec of a
+// persisted Shoot.
+func defaultedShootSpec() core.ShootSpec {
+	return core.ShootSpec{
+		Purpose:     ptr.To(core.ShootPurposeEvaluation),
+		Maintenance: &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}},
+	}
+}
@@ -44,10 +44,20 @@ func (r *TokenRequestREST) issueToken(user user.Info, tokenRequest *securityapi.
 		return "", nil, fmt.Errorf("failed to resolve context object: %w", err)
 	}
 
+	audiences := workloadIdentity.Spec.Audiences
+	if len(tokenRequest.Spec.Audiences) > 0 {
+		audiences = tokenRequest.Spec.Audiences
+	}
+
+	duration := tokenRequest.Spec.ExpirationSeconds
+	if maxTokenExpiration := workloadIdentity.Spec.MaxTokenExpiration; maxTokenExpiration != nil {
+		duration = min(duration, int64(maxTokenExpiration.Seconds()))
+	}
+
 	token, exp, err := r.tokenIssuer.IssueToken(
 		workloadIdentity.Status.Sub,
-		workloadIdentity.Spec.Audiences,
-		tokenRequest.Spec.ExpirationSeconds,
+		audiences,
+		duration,
 		r.getGardenerClaims(workloadIdentity, shoot, seed, project),
 	)
 	if err != nil {
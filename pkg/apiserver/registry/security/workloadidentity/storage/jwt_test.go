@@ -9,6 +9,8 @@ import (
 	"crypto/rsa"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -530,5 +532,70 @@ var _ = Describe("#TokenRequest", func() {
 			Expect(exp.After(now)).To(BeTrue())
 			Expect(token).ToNot(BeEmpty())
 		})
+
+		It("should issue the token only for the requested audiences", func() {
+			workloadIdentity.Spec.Audiences = []string{aud, "sts.example.com"}
+			tokenRequest := &securityapi.TokenRequest{
+				Spec: securityapi.TokenRequestSpec{
+					ExpirationSeconds: int64(3600),
+					Audiences:         []string{"sts.example.com"},
+				},
+			}
+
+			token, _, err := r.issueToken(&user.DefaultInfo{Name: "foo"}, tokenRequest, workloadIdentity)
+			Expect(err).ToNot(HaveOccurred())
+
+			parsedToken, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+			Expect(err).ToNot(HaveOccurred())
+			claims := &jwt.Claims{}
+			Expect(parsedToken.UnsafeClaimsWithoutVerification(claims)).To(Succeed())
+			Expect(claims.Audience).To(ConsistOf("sts.example.com"))
+		})
+
+		It("should issue the token for all audiences of the workload identity if no audiences are requested", func() {
+			workloadIdentity.Spec.Audiences = []string{aud, "sts.example.com"}
+			tokenRequest := &securityapi.TokenRequest{
+				Spec: securityapi.TokenRequestSpec{
+					ExpirationSeconds: int64(3600),
+				},
+			}
+
+			token, _, err := r.issueToken(&user.DefaultInfo{Name: "foo"}, tokenRequest, workloadIdentity)
+			Expect(err).ToNot(HaveOccurred())
+
+			parsedToken, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+			Expect(err).ToNot(HaveOccurred())
+			claims := &jwt.Claims{}
+			Expect(parsedToken.UnsafeClaimsWithoutVerification(claims)).To(Succeed())
+			Expect(claims.Audience).To(ConsistOf(aud, "sts.example.com"))
+		})
+
+		It("should limit the token validity to the maximum token expiration of the workload identity", func() {
+			workloadIdentity.Spec.MaxTokenExpiration = &metav1.Duration{Duration: 20 * time.Minute}
+			tokenRequest := &securityapi.TokenRequest{
+				Spec: securityapi.TokenRequestSpec{
+					ExpirationSeconds: int64(3600),
+				},
+			}
+
+			now := time.Now()
+			_, exp, err := r.issueToken(&user.DefaultInfo{Name: "foo"}, tokenRequest, workloadIdentity)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*exp).To(BeTemporally("~", now.Add(20*time.Minute), 5*time.Second))
+		})
+
+		It("should not extend the token validity to the maximum token expiration of the workload identity", func() {
+			workloadIdentity.Spec.MaxTokenExpiration = &metav1.Duration{Duration: 24 * time.Hour}
+			tokenRequest := &securityapi.TokenRequest{
+				Spec: securityapi.TokenRequestSpec{
+					ExpirationSeconds: int64(3600),
+				},
+			}
+
+			now := time.Now()
+			_, exp, err := r.issueToken(&user.DefaultInfo{Name: "foo"}, tokenRequest, workloadIdentity)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*exp).To(BeTemporally("~", now.Add(time.Hour), 5*time.Second))
+		})
 	})
 })
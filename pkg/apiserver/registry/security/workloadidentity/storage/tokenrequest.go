@@ -139,6 +139,10 @@ func (r *TokenRequestREST) Create(ctx context.Context, name string, obj runtime.
 		return nil, apierrors.NewInvalid(gvk.GroupKind(), "", errs)
 	}
 
+	if errs := securityvalidation.ValidateTokenRequestForWorkloadIdentity(tokenRequest, workloadIdentity); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(gvk.GroupKind(), "", errs)
+	}
+
 	token, exp, err := r.issueToken(user, tokenRequest, workloadIdentity)
 	if err != nil {
 		return nil, err
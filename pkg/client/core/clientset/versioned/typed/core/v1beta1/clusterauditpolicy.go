@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterAuditPoliciesGetter has a method to return a ClusterAuditPolicyInterface.
+// A group's client should implement this interface.
+type ClusterAuditPoliciesGetter interface {
+	ClusterAuditPolicies() ClusterAuditPolicyInterface
+}
+
+// ClusterAuditPolicyInterface has methods to work with ClusterAuditPolicy resources.
+type ClusterAuditPolicyInterface interface {
+	Create(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.CreateOptions) (*v1beta1.ClusterAuditPolicy, error)
+	Update(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.UpdateOptions) (*v1beta1.ClusterAuditPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ClusterAuditPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ClusterAuditPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterAuditPolicy, err error)
+	ClusterAuditPolicyExpansion
+}
+
+// clusterAuditPolicies implements ClusterAuditPolicyInterface
+type clusterAuditPolicies struct {
+	client rest.Interface
+}
+
+// newClusterAuditPolicies returns a ClusterAuditPolicies
+func newClusterAuditPolicies(c *CoreV1beta1Client) *clusterAuditPolicies {
+	return &clusterAuditPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterAuditPolicy, and returns the corresponding clusterAuditPolicy object, and an error if there is any.
+func (c *clusterAuditPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	result = &v1beta1.ClusterAuditPolicy{}
+	err = c.client.Get().
+		Resource("clusterauditpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterAuditPolicies that match those selectors.
+func (c *clusterAuditPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ClusterAuditPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ClusterAuditPolicyList{}
+	err = c.client.Get().
+		Resource("clusterauditpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterAuditPolicies.
+func (c *clusterAuditPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterauditpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterAuditPolicy and creates it.  Returns the server's representation of the clusterAuditPolicy, and an error, if there is any.
+func (c *clusterAuditPolicies) Create(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.CreateOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	result = &v1beta1.ClusterAuditPolicy{}
+	err = c.client.Post().
+		Resource("clusterauditpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterAuditPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterAuditPolicy and updates it. Returns the server's representation of the clusterAuditPolicy, and an error, if there is any.
+func (c *clusterAuditPolicies) Update(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.UpdateOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	result = &v1beta1.ClusterAuditPolicy{}
+	err = c.client.Put().
+		Resource("clusterauditpolicies").
+		Name(clusterAuditPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterAuditPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterAuditPolicy and deletes it. Returns an error if one occurs.
+func (c *clusterAuditPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterauditpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterAuditPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterauditpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterAuditPolicy.
+func (c *clusterAuditPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterAuditPolicy, err error) {
+	result = &v1beta1.ClusterAuditPolicy{}
+	err = c.client.Patch(pt).
+		Resource("clusterauditpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -19,12 +19,15 @@ type CoreV1beta1Interface interface {
 	BackupBucketsGetter
 	BackupEntriesGetter
 	CloudProfilesGetter
+	ClusterAuditPoliciesGetter
 	ControllerDeploymentsGetter
 	ControllerInstallationsGetter
 	ControllerRegistrationsGetter
 	ExposureClassesGetter
+	GardenCapabilitiesesGetter
 	InternalSecretsGetter
 	NamespacedCloudProfilesGetter
+	NotificationSinksGetter
 	ProjectsGetter
 	QuotasGetter
 	SecretBindingsGetter
@@ -50,6 +53,10 @@ func (c *CoreV1beta1Client) CloudProfiles() CloudProfileInterface {
 	return newCloudProfiles(c)
 }
 
+func (c *CoreV1beta1Client) ClusterAuditPolicies() ClusterAuditPolicyInterface {
+	return newClusterAuditPolicies(c)
+}
+
 func (c *CoreV1beta1Client) ControllerDeployments() ControllerDeploymentInterface {
 	return newControllerDeployments(c)
 }
@@ -66,6 +73,10 @@ func (c *CoreV1beta1Client) ExposureClasses() ExposureClassInterface {
 	return newExposureClasses(c)
 }
 
+func (c *CoreV1beta1Client) GardenCapabilitieses() GardenCapabilitiesInterface {
+	return newGardenCapabilitieses(c)
+}
+
 func (c *CoreV1beta1Client) InternalSecrets(namespace string) InternalSecretInterface {
 	return newInternalSecrets(c, namespace)
 }
@@ -74,6 +85,10 @@ func (c *CoreV1beta1Client) NamespacedCloudProfiles(namespace string) Namespaced
 	return newNamespacedCloudProfiles(c, namespace)
 }
 
+func (c *CoreV1beta1Client) NotificationSinks() NotificationSinkInterface {
+	return newNotificationSinks(c)
+}
+
 func (c *CoreV1beta1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
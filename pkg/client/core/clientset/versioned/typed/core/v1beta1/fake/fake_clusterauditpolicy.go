@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterAuditPolicies implements ClusterAuditPolicyInterface
+type FakeClusterAuditPolicies struct {
+	Fake *FakeCoreV1beta1
+}
+
+var clusterauditpoliciesResource = v1beta1.SchemeGroupVersion.WithResource("clusterauditpolicies")
+
+var clusterauditpoliciesKind = v1beta1.SchemeGroupVersion.WithKind("ClusterAuditPolicy")
+
+// Get takes name of the clusterAuditPolicy, and returns the corresponding clusterAuditPolicy object, and an error if there is any.
+func (c *FakeClusterAuditPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clusterauditpoliciesResource, name), &v1beta1.ClusterAuditPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ClusterAuditPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterAuditPolicies that match those selectors.
+func (c *FakeClusterAuditPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ClusterAuditPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clusterauditpoliciesResource, clusterauditpoliciesKind, opts), &v1beta1.ClusterAuditPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ClusterAuditPolicyList{ListMeta: obj.(*v1beta1.ClusterAuditPolicyList).ListMeta}
+	for _, item := range obj.(*v1beta1.ClusterAuditPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterAuditPolicies.
+func (c *FakeClusterAuditPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clusterauditpoliciesResource, opts))
+}
+
+// Create takes the representation of a clusterAuditPolicy and creates it.  Returns the server's representation of the clusterAuditPolicy, and an error, if there is any.
+func (c *FakeClusterAuditPolicies) Create(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.CreateOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clusterauditpoliciesResource, clusterAuditPolicy), &v1beta1.ClusterAuditPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ClusterAuditPolicy), err
+}
+
+// Update takes the representation of a clusterAuditPolicy and updates it. Returns the server's representation of the clusterAuditPolicy, and an error, if there is any.
+func (c *FakeClusterAuditPolicies) Update(ctx context.Context, clusterAuditPolicy *v1beta1.ClusterAuditPolicy, opts v1.UpdateOptions) (result *v1beta1.ClusterAuditPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clusterauditpoliciesResource, clusterAuditPolicy), &v1beta1.ClusterAuditPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ClusterAuditPolicy), err
+}
+
+// Delete takes name of the clusterAuditPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeClusterAuditPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clusterauditpoliciesResource, name, opts), &v1beta1.ClusterAuditPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterAuditPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clusterauditpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ClusterAuditPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterAuditPolicy.
+func (c *FakeClusterAuditPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterAuditPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clusterauditpoliciesResource, name, pt, data, subresources...), &v1beta1.ClusterAuditPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ClusterAuditPolicy), err
+}
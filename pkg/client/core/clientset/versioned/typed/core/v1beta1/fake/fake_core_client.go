@@ -28,6 +28,10 @@ func (c *FakeCoreV1beta1) CloudProfiles() v1beta1.CloudProfileInterface {
 	return &FakeCloudProfiles{c}
 }
 
+func (c *FakeCoreV1beta1) ClusterAuditPolicies() v1beta1.ClusterAuditPolicyInterface {
+	return &FakeClusterAuditPolicies{c}
+}
+
 func (c *FakeCoreV1beta1) ControllerDeployments() v1beta1.ControllerDeploymentInterface {
 	return &FakeControllerDeployments{c}
 }
@@ -44,6 +48,10 @@ func (c *FakeCoreV1beta1) ExposureClasses() v1beta1.ExposureClassInterface {
 	return &FakeExposureClasses{c}
 }
 
+func (c *FakeCoreV1beta1) GardenCapabilitieses() v1beta1.GardenCapabilitiesInterface {
+	return &FakeGardenCapabilitieses{c}
+}
+
 func (c *FakeCoreV1beta1) InternalSecrets(namespace string) v1beta1.InternalSecretInterface {
 	return &FakeInternalSecrets{c, namespace}
 }
@@ -52,6 +60,10 @@ func (c *FakeCoreV1beta1) NamespacedCloudProfiles(namespace string) v1beta1.Name
 	return &FakeNamespacedCloudProfiles{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) NotificationSinks() v1beta1.NotificationSinkInterface {
+	return &FakeNotificationSinks{c}
+}
+
 func (c *FakeCoreV1beta1) Projects() v1beta1.ProjectInterface {
 	return &FakeProjects{c}
 }
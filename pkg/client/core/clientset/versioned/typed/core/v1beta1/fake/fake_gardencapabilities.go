@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGardenCapabilitieses implements GardenCapabilitiesInterface
+type FakeGardenCapabilitieses struct {
+	Fake *FakeCoreV1beta1
+}
+
+var gardencapabilitiesesResource = v1beta1.SchemeGroupVersion.WithResource("gardencapabilitieses")
+
+var gardencapabilitiesesKind = v1beta1.SchemeGroupVersion.WithKind("GardenCapabilities")
+
+// Get takes name of the gardenCapabilities, and returns the corresponding gardenCapabilities object, and an error if there is any.
+func (c *FakeGardenCapabilitieses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GardenCapabilities, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(gardencapabilitiesesResource, name), &v1beta1.GardenCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GardenCapabilities), err
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNotificationSinks implements NotificationSinkInterface
+type FakeNotificationSinks struct {
+	Fake *FakeCoreV1beta1
+}
+
+var notificationsinksResource = v1beta1.SchemeGroupVersion.WithResource("notificationsinks")
+
+var notificationsinksKind = v1beta1.SchemeGroupVersion.WithKind("NotificationSink")
+
+// Get takes name of the notificationSink, and returns the corresponding notificationSink object, and an error if there is any.
+func (c *FakeNotificationSinks) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.NotificationSink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(notificationsinksResource, name), &v1beta1.NotificationSink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NotificationSink), err
+}
+
+// List takes label and field selectors, and returns the list of NotificationSinks that match those selectors.
+func (c *FakeNotificationSinks) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.NotificationSinkList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(notificationsinksResource, notificationsinksKind, opts), &v1beta1.NotificationSinkList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.NotificationSinkList{ListMeta: obj.(*v1beta1.NotificationSinkList).ListMeta}
+	for _, item := range obj.(*v1beta1.NotificationSinkList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested notificationSinks.
+func (c *FakeNotificationSinks) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(notificationsinksResource, opts))
+}
+
+// Create takes the representation of a notificationSink and creates it.  Returns the server's representation of the notificationSink, and an error, if there is any.
+func (c *FakeNotificationSinks) Create(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.CreateOptions) (result *v1beta1.NotificationSink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(notificationsinksResource, notificationSink), &v1beta1.NotificationSink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NotificationSink), err
+}
+
+// Update takes the representation of a notificationSink and updates it. Returns the server's representation of the notificationSink, and an error, if there is any.
+func (c *FakeNotificationSinks) Update(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (result *v1beta1.NotificationSink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(notificationsinksResource, notificationSink), &v1beta1.NotificationSink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NotificationSink), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNotificationSinks) UpdateStatus(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (*v1beta1.NotificationSink, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(notificationsinksResource, "status", notificationSink), &v1beta1.NotificationSink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NotificationSink), err
+}
+
+// Delete takes name of the notificationSink and deletes it. Returns an error if one occurs.
+func (c *FakeNotificationSinks) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(notificationsinksResource, name, opts), &v1beta1.NotificationSink{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNotificationSinks) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(notificationsinksResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.NotificationSinkList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched notificationSink.
+func (c *FakeNotificationSinks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NotificationSink, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(notificationsinksResource, name, pt, data, subresources...), &v1beta1.NotificationSink{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.NotificationSink), err
+}
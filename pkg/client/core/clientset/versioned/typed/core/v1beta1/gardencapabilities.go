@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rest "k8s.io/client-go/rest"
+)
+
+// GardenCapabilitiesesGetter has a method to return a GardenCapabilitiesInterface.
+// A group's client should implement this interface.
+type GardenCapabilitiesesGetter interface {
+	GardenCapabilitieses() GardenCapabilitiesInterface
+}
+
+// GardenCapabilitiesInterface has methods to work with GardenCapabilities resources.
+type GardenCapabilitiesInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.GardenCapabilities, error)
+	GardenCapabilitiesExpansion
+}
+
+// gardenCapabilitieses implements GardenCapabilitiesInterface
+type gardenCapabilitieses struct {
+	client rest.Interface
+}
+
+// newGardenCapabilitieses returns a GardenCapabilitieses
+func newGardenCapabilitieses(c *CoreV1beta1Client) *gardenCapabilitieses {
+	return &gardenCapabilitieses{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the gardenCapabilities, and returns the corresponding gardenCapabilities object, and an error if there is any.
+func (c *gardenCapabilitieses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GardenCapabilities, err error) {
+	result = &v1beta1.GardenCapabilities{}
+	err = c.client.Get().
+		Resource("gardencapabilitieses").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
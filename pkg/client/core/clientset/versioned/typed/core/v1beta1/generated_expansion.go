@@ -12,6 +12,8 @@ type BackupEntryExpansion interface{}
 
 type CloudProfileExpansion interface{}
 
+type ClusterAuditPolicyExpansion interface{}
+
 type ControllerDeploymentExpansion interface{}
 
 type ControllerInstallationExpansion interface{}
@@ -20,10 +22,14 @@ type ControllerRegistrationExpansion interface{}
 
 type ExposureClassExpansion interface{}
 
+type GardenCapabilitiesExpansion interface{}
+
 type InternalSecretExpansion interface{}
 
 type NamespacedCloudProfileExpansion interface{}
 
+type NotificationSinkExpansion interface{}
+
 type ProjectExpansion interface{}
 
 type QuotaExpansion interface{}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NotificationSinksGetter has a method to return a NotificationSinkInterface.
+// A group's client should implement this interface.
+type NotificationSinksGetter interface {
+	NotificationSinks() NotificationSinkInterface
+}
+
+// NotificationSinkInterface has methods to work with NotificationSink resources.
+type NotificationSinkInterface interface {
+	Create(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.CreateOptions) (*v1beta1.NotificationSink, error)
+	Update(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (*v1beta1.NotificationSink, error)
+	UpdateStatus(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (*v1beta1.NotificationSink, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.NotificationSink, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.NotificationSinkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NotificationSink, err error)
+	NotificationSinkExpansion
+}
+
+// notificationSinks implements NotificationSinkInterface
+type notificationSinks struct {
+	client rest.Interface
+}
+
+// newNotificationSinks returns a NotificationSinks
+func newNotificationSinks(c *CoreV1beta1Client) *notificationSinks {
+	return &notificationSinks{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the notificationSink, and returns the corresponding notificationSink object, and an error if there is any.
+func (c *notificationSinks) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.NotificationSink, err error) {
+	result = &v1beta1.NotificationSink{}
+	err = c.client.Get().
+		Resource("notificationsinks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NotificationSinks that match those selectors.
+func (c *notificationSinks) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.NotificationSinkList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.NotificationSinkList{}
+	err = c.client.Get().
+		Resource("notificationsinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested notificationSinks.
+func (c *notificationSinks) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("notificationsinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a notificationSink and creates it.  Returns the server's representation of the notificationSink, and an error, if there is any.
+func (c *notificationSinks) Create(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.CreateOptions) (result *v1beta1.NotificationSink, err error) {
+	result = &v1beta1.NotificationSink{}
+	err = c.client.Post().
+		Resource("notificationsinks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(notificationSink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a notificationSink and updates it. Returns the server's representation of the notificationSink, and an error, if there is any.
+func (c *notificationSinks) Update(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (result *v1beta1.NotificationSink, err error) {
+	result = &v1beta1.NotificationSink{}
+	err = c.client.Put().
+		Resource("notificationsinks").
+		Name(notificationSink.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(notificationSink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *notificationSinks) UpdateStatus(ctx context.Context, notificationSink *v1beta1.NotificationSink, opts v1.UpdateOptions) (result *v1beta1.NotificationSink, err error) {
+	result = &v1beta1.NotificationSink{}
+	err = c.client.Put().
+		Resource("notificationsinks").
+		Name(notificationSink.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(notificationSink).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the notificationSink and deletes it. Returns an error if one occurs.
+func (c *notificationSinks) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("notificationsinks").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *notificationSinks) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("notificationsinks").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched notificationSink.
+func (c *notificationSinks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.NotificationSink, err error) {
+	result = &v1beta1.NotificationSink{}
+	err = c.client.Patch(pt).
+		Resource("notificationsinks").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
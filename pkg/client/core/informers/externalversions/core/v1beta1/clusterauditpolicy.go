@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	versioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/core/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterAuditPolicyInformer provides access to a shared informer and lister for
+// ClusterAuditPolicies.
+type ClusterAuditPolicyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ClusterAuditPolicyLister
+}
+
+type clusterAuditPolicyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewClusterAuditPolicyInformer constructs a new informer for ClusterAuditPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterAuditPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterAuditPolicyInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterAuditPolicyInformer constructs a new informer for ClusterAuditPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterAuditPolicyInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1beta1().ClusterAuditPolicies().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CoreV1beta1().ClusterAuditPolicies().Watch(context.TODO(), options)
+			},
+		},
+		&corev1beta1.ClusterAuditPolicy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterAuditPolicyInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterAuditPolicyInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterAuditPolicyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&corev1beta1.ClusterAuditPolicy{}, f.defaultInformer)
+}
+
+func (f *clusterAuditPolicyInformer) Lister() v1beta1.ClusterAuditPolicyLister {
+	return v1beta1.NewClusterAuditPolicyLister(f.Informer().GetIndexer())
+}
@@ -18,6 +18,8 @@ type Interface interface {
 	BackupEntries() BackupEntryInformer
 	// CloudProfiles returns a CloudProfileInformer.
 	CloudProfiles() CloudProfileInformer
+	// ClusterAuditPolicies returns a ClusterAuditPolicyInformer.
+	ClusterAuditPolicies() ClusterAuditPolicyInformer
 	// ControllerDeployments returns a ControllerDeploymentInformer.
 	ControllerDeployments() ControllerDeploymentInformer
 	// ControllerInstallations returns a ControllerInstallationInformer.
@@ -30,6 +32,8 @@ type Interface interface {
 	InternalSecrets() InternalSecretInformer
 	// NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
 	NamespacedCloudProfiles() NamespacedCloudProfileInformer
+	// NotificationSinks returns a NotificationSinkInformer.
+	NotificationSinks() NotificationSinkInformer
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
 	// Quotas returns a QuotaInformer.
@@ -70,6 +74,11 @@ func (v *version) CloudProfiles() CloudProfileInformer {
 	return &cloudProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterAuditPolicies returns a ClusterAuditPolicyInformer.
+func (v *version) ClusterAuditPolicies() ClusterAuditPolicyInformer {
+	return &clusterAuditPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ControllerDeployments returns a ControllerDeploymentInformer.
 func (v *version) ControllerDeployments() ControllerDeploymentInformer {
 	return &controllerDeploymentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -100,6 +109,11 @@ func (v *version) NamespacedCloudProfiles() NamespacedCloudProfileInformer {
 	return &namespacedCloudProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NotificationSinks returns a NotificationSinkInformer.
+func (v *version) NotificationSinks() NotificationSinkInformer {
+	return &notificationSinkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Projects returns a ProjectInformer.
 func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -52,6 +52,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().BackupEntries().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("cloudprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().CloudProfiles().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("clusterauditpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ClusterAuditPolicies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("controllerdeployments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ControllerDeployments().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("controllerinstallations"):
@@ -64,6 +66,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().InternalSecrets().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("namespacedcloudprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().NamespacedCloudProfiles().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("notificationsinks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().NotificationSinks().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().Projects().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("quotas"):
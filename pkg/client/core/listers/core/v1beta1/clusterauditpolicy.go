@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterAuditPolicyLister helps list ClusterAuditPolicies.
+// All objects returned here must be treated as read-only.
+type ClusterAuditPolicyLister interface {
+	// List lists all ClusterAuditPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ClusterAuditPolicy, err error)
+	// Get retrieves the ClusterAuditPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.ClusterAuditPolicy, error)
+	ClusterAuditPolicyListerExpansion
+}
+
+// clusterAuditPolicyLister implements the ClusterAuditPolicyLister interface.
+type clusterAuditPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterAuditPolicyLister returns a new ClusterAuditPolicyLister.
+func NewClusterAuditPolicyLister(indexer cache.Indexer) ClusterAuditPolicyLister {
+	return &clusterAuditPolicyLister{indexer: indexer}
+}
+
+// List lists all ClusterAuditPolicies in the indexer.
+func (s *clusterAuditPolicyLister) List(selector labels.Selector) (ret []*v1beta1.ClusterAuditPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ClusterAuditPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterAuditPolicy from the index for a given name.
+func (s *clusterAuditPolicyLister) Get(name string) (*v1beta1.ClusterAuditPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("clusterauditpolicy"), name)
+	}
+	return obj.(*v1beta1.ClusterAuditPolicy), nil
+}
@@ -22,6 +22,10 @@ type BackupEntryNamespaceListerExpansion interface{}
 // CloudProfileLister.
 type CloudProfileListerExpansion interface{}
 
+// ClusterAuditPolicyListerExpansion allows custom methods to be added to
+// ClusterAuditPolicyLister.
+type ClusterAuditPolicyListerExpansion interface{}
+
 // ControllerDeploymentListerExpansion allows custom methods to be added to
 // ControllerDeploymentLister.
 type ControllerDeploymentListerExpansion interface{}
@@ -54,6 +58,10 @@ type NamespacedCloudProfileListerExpansion interface{}
 // NamespacedCloudProfileNamespaceLister.
 type NamespacedCloudProfileNamespaceListerExpansion interface{}
 
+// NotificationSinkListerExpansion allows custom methods to be added to
+// NotificationSinkLister.
+type NotificationSinkListerExpansion interface{}
+
 // ProjectListerExpansion allows custom methods to be added to
 // ProjectLister.
 type ProjectListerExpansion interface{}
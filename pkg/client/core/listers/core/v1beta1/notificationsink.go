@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NotificationSinkLister helps list NotificationSinks.
+// All objects returned here must be treated as read-only.
+type NotificationSinkLister interface {
+	// List lists all NotificationSinks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.NotificationSink, err error)
+	// Get retrieves the NotificationSink from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.NotificationSink, error)
+	NotificationSinkListerExpansion
+}
+
+// notificationSinkLister implements the NotificationSinkLister interface.
+type notificationSinkLister struct {
+	indexer cache.Indexer
+}
+
+// NewNotificationSinkLister returns a new NotificationSinkLister.
+func NewNotificationSinkLister(indexer cache.Indexer) NotificationSinkLister {
+	return &notificationSinkLister{indexer: indexer}
+}
+
+// List lists all NotificationSinks in the indexer.
+func (s *notificationSinkLister) List(selector labels.Selector) (ret []*v1beta1.NotificationSink, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.NotificationSink))
+	})
+	return ret, err
+}
+
+// Get retrieves the NotificationSink from the index for a given name.
+func (s *notificationSinkLister) Get(name string) (*v1beta1.NotificationSink, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("notificationsink"), name)
+	}
+	return obj.(*v1beta1.NotificationSink), nil
+}
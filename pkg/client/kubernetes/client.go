@@ -23,7 +23,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
+	authenticationinstall "github.com/gardener/gardener/pkg/apis/authentication/install"
 	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
+	operationsinstall "github.com/gardener/gardener/pkg/apis/operations/install"
 	securityinstall "github.com/gardener/gardener/pkg/apis/security/install"
 	seedmanagementinstall "github.com/gardener/gardener/pkg/apis/seedmanagement/install"
 	settingsinstall "github.com/gardener/gardener/pkg/apis/settings/install"
@@ -49,7 +51,9 @@ const (
 func init() {
 	// enable protobuf for Gardener API for controller-runtime clients
 	protobufSchemeBuilder := runtime.NewSchemeBuilder(
+		authenticationinstall.AddToScheme,
 		gardencoreinstall.AddToScheme,
+		operationsinstall.AddToScheme,
 		seedmanagementinstall.AddToScheme,
 		settingsinstall.AddToScheme,
 		securityinstall.AddToScheme,
@@ -349,6 +353,9 @@ func setConfigDefaults(conf *Config) error {
 	if conf.newRuntimeCache == nil {
 		conf.newRuntimeCache = NewRuntimeCache
 	}
+	if conf.rateLimiterTarget != "" && conf.restConfig.RateLimiter == nil {
+		conf.restConfig.RateLimiter = NewRateLimiter(conf.rateLimiterTarget, conf.restConfig.QPS, conf.restConfig.Burst)
+	}
 	return nil
 }
 
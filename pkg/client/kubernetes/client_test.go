@@ -5,11 +5,24 @@
 package kubernetes_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
 
@@ -296,4 +309,39 @@ var _ = Describe("Client", func() {
 			),
 		)
 	})
+
+	Describe("protobuf content negotiation", func() {
+		var (
+			server         *httptest.Server
+			acceptedHeader chan string
+		)
+
+		BeforeEach(func() {
+			acceptedHeader = make(chan string, 1)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				acceptedHeader <- r.Header.Get("Accept")
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		DescribeTable("should request the expected content type from the API server",
+			func(gvk schema.GroupVersionKind, matcher gomegatypes.GomegaMatcher) {
+				restConfig := &rest.Config{Host: server.URL}
+				httpClient, err := rest.HTTPClientFor(restConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				restClient, err := apiutil.RESTClientForGVK(gvk, false, restConfig, serializer.NewCodecFactory(kubernetes.GardenScheme), httpClient)
+				Expect(err).NotTo(HaveOccurred())
+
+				_ = restClient.Get().Resource("foos").Name("bar").Do(context.Background())
+				Expect(<-acceptedHeader).To(matcher)
+			},
+
+			Entry("core.gardener.cloud", gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"), HavePrefix(runtime.ContentTypeProtobuf)),
+			Entry("seedmanagement.gardener.cloud", seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeed"), HavePrefix(runtime.ContentTypeProtobuf)),
+			Entry("operations.gardener.cloud", operationsv1alpha1.SchemeGroupVersion.WithKind("Bastion"), HavePrefix(runtime.ContentTypeProtobuf)),
+			Entry("extensions.gardener.cloud (CRD)", extensionsv1alpha1.SchemeGroupVersion.WithKind("Infrastructure"), HavePrefix(runtime.ContentTypeJSON)),
+		)
+	})
 })
@@ -20,6 +20,7 @@ type ShootClientMapBuilder struct {
 	gardenClient           client.Client
 	seedClient             client.Client
 	clientConnectionConfig *componentbaseconfig.ClientConnectionConfiguration
+	perTargetRateLimiting  bool
 }
 
 // NewShootClientMapBuilder constructs a new ShootClientMapBuilder.
@@ -45,6 +46,13 @@ func (b *ShootClientMapBuilder) WithClientConnectionConfig(cfg *componentbasecon
 	return b
 }
 
+// WithPerTargetRateLimiting sets whether all clients of a ClientSet created by this ClientMap should share a single
+// rate limiter for the Shoot cluster.
+func (b *ShootClientMapBuilder) WithPerTargetRateLimiting(enabled bool) *ShootClientMapBuilder {
+	b.perTargetRateLimiting = enabled
+	return b
+}
+
 // Build builds the ShootClientMap using the provided attributes.
 func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, error) {
 	if b.gardenClient == nil {
@@ -61,5 +69,6 @@ func (b *ShootClientMapBuilder) Build(log logr.Logger) (clientmap.ClientMap, err
 		GardenClient:           b.gardenClient,
 		SeedClient:             b.seedClient,
 		ClientConnectionConfig: *b.clientConnectionConfig,
+		PerTargetRateLimiting:  b.perTargetRateLimiting,
 	}), nil
 }
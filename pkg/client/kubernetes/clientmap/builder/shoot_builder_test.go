@@ -47,6 +47,13 @@ var _ = Describe("ShootClientMapBuilder", func() {
 		})
 	})
 
+	Describe("#WithPerTargetRateLimiting", func() {
+		It("should be correctly set by WithPerTargetRateLimiting", func() {
+			builder := NewShootClientMapBuilder().WithPerTargetRateLimiting(true)
+			Expect(builder.perTargetRateLimiting).To(BeTrue())
+		})
+	})
+
 	Describe("#Build", func() {
 		It("should fail if garden client was not set", func() {
 			clientMap, err := NewShootClientMapBuilder().Build(logr.Discard())
@@ -45,6 +45,9 @@ type ShootClientSetFactory struct {
 	SeedClient client.Client
 	// ClientConnectionConfiguration is the configuration that will be used by created ClientSets.
 	ClientConnectionConfig componentbaseconfig.ClientConnectionConfiguration
+	// PerTargetRateLimiting makes all clients of a ClientSet share a single rate limiter for the Shoot cluster, see
+	// kubernetes.WithRateLimiter.
+	PerTargetRateLimiting bool
 
 	// log is a logger for logging entries related to creating Shoot ClientSets.
 	log logr.Logger
@@ -79,11 +82,16 @@ func (f *ShootClientSetFactory) NewClientSet(ctx context.Context, k ClientSetKey
 		return nil, "", fmt.Errorf("token for shoot kubeconfig was not populated yet")
 	}
 
-	clientSet, err := NewClientFromSecretObject(kubeconfigSecret,
+	opts := []kubernetes.ConfigFunc{
 		kubernetes.WithClientConnectionOptions(f.ClientConnectionConfig),
 		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.ShootScheme}),
 		kubernetes.WithDisabledCachedClient(),
-	)
+	}
+	if f.PerTargetRateLimiting {
+		opts = append(opts, kubernetes.WithRateLimiter(rateLimiterTarget(k.(ShootClientSetKey))))
+	}
+
+	clientSet, err := NewClientFromSecretObject(kubeconfigSecret, opts...)
 	if err != nil {
 		return nil, "", err
 	}
@@ -135,9 +143,16 @@ func (f *ShootClientSetFactory) InvalidateClient(k ClientSetKey) error {
 		return fmt.Errorf("unsupported ClientSetKey: expected %T got %T", ShootClientSetKey{}, k)
 	}
 	delete(f.clientKeyToSeedNamespace, key)
+	if f.PerTargetRateLimiting {
+		kubernetes.DeleteRateLimiterMetrics(rateLimiterTarget(key))
+	}
 	return nil
 }
 
+func rateLimiterTarget(key ShootClientSetKey) string {
+	return "shoot--" + key.Namespace + "--" + key.Name
+}
+
 func (f *ShootClientSetFactory) seedNamespaceFromCache(key ShootClientSetKey) (string, error) {
 	namespace, ok := f.clientKeyToSeedNamespace[key]
 	if !ok {
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_client"
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	rateLimiterDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rate_limiter_duration_seconds",
+			Help:      "Duration requests to a target cluster were delayed by the client-side rate limiter.",
+			// same buckets as client-go's rest_client_rate_limiter_duration_seconds metric
+			Buckets: []float64{0.005, 0.025, 0.1, 0.25, 0.5, 1.0, 2.0, 4.0, 8.0, 15.0, 30.0, 60.0},
+		},
+		[]string{
+			"target",
+		},
+	)
+
+	rateLimiterThrottled = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rate_limiter_throttled_requests_total",
+			Help:      "Total number of requests to a target cluster which were throttled by the client-side rate limiter for longer than 50ms.",
+		},
+		[]string{
+			"target",
+		},
+	)
+)
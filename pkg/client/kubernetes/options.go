@@ -28,6 +28,7 @@ type Config struct {
 	disableCache      bool
 	allowedUserFields []string
 	clientConfig      clientcmd.ClientConfig
+	rateLimiterTarget string
 }
 
 // NewConfig returns a new Config with an empty REST config to allow testing ConfigFuncs without exporting
@@ -89,6 +90,16 @@ func WithClientConnectionOptions(cfg componentbaseconfig.ClientConnectionConfigu
 	}
 }
 
+// WithRateLimiter returns a ConfigFunc that makes all clients of the ClientSet share a rate limiter for the given
+// target cluster, see NewRateLimiter. The rate limiter uses the QPS and burst of the REST config, e.g. as set by
+// WithClientConnectionOptions.
+func WithRateLimiter(target string) ConfigFunc {
+	return func(config *Config) error {
+		config.rateLimiterTarget = target
+		return nil
+	}
+}
+
 // WithClientOptions returns a ConfigFunc that sets the passed Options on the Config object.
 func WithClientOptions(opt client.Options) ConfigFunc {
 	return func(config *Config) error {
@@ -123,7 +123,7 @@ func SetupPortForwarder(ctx context.Context, config *rest.Config, namespace, nam
 	var (
 		readyChan = make(chan struct{}, 1)
 		out       = io.Discard
-		localPort int
+		localPort = local
 	)
 
 	client, err := corev1client.NewForConfig(config)
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// throttleThreshold is the duration after which a delayed request is counted as throttled. It matches the threshold
+// after which client-go logs that a request was delayed due to client-side throttling.
+const throttleThreshold = 50 * time.Millisecond
+
+// NewRateLimiter returns a token bucket rate limiter for requests to the given target cluster, e.g. `garden`, `seed` or
+// a shoot. The duration requests are delayed by the rate limiter is recorded in metrics labelled with the target.
+// Contrary to the rate limiters which client-go creates for each REST client, the returned rate limiter is supposed to
+// be shared by all clients of the target, i.e. the given QPS and burst limit all requests to the target.
+// If QPS or burst are zero, the client-go defaults are used. If QPS is negative, nil is returned and client-side rate
+// limiting is disabled.
+func NewRateLimiter(target string, qps float32, burst int) flowcontrol.RateLimiter {
+	if qps < 0 {
+		return nil
+	}
+	if qps == 0 {
+		qps = rest.DefaultQPS
+	}
+	if burst == 0 {
+		burst = rest.DefaultBurst
+	}
+
+	return &rateLimiter{
+		RateLimiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+		target:      target,
+	}
+}
+
+// DeleteRateLimiterMetrics deletes the rate limiter metrics of the given target cluster. It should be called when the
+// clients of the target are discarded, e.g. when a shoot is deleted.
+func DeleteRateLimiterMetrics(target string) {
+	rateLimiterDuration.DeleteLabelValues(target)
+	rateLimiterThrottled.DeleteLabelValues(target)
+}
+
+type rateLimiter struct {
+	flowcontrol.RateLimiter
+	target string
+}
+
+// Accept implements flowcontrol.RateLimiter.
+func (r *rateLimiter) Accept() {
+	start := time.Now()
+	r.RateLimiter.Accept()
+	r.observe(time.Since(start))
+}
+
+// Wait implements flowcontrol.RateLimiter.
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := r.RateLimiter.Wait(ctx)
+	r.observe(time.Since(start))
+	return err
+}
+
+func (r *rateLimiter) observe(latency time.Duration) {
+	rateLimiterDuration.WithLabelValues(r.target).Observe(latency.Seconds())
+	if latency > throttleThreshold {
+		rateLimiterThrottled.WithLabelValues(r.target).Inc()
+	}
+}
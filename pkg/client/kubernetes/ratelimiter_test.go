@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/client-go/rest"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var ctx = context.TODO()
+
+	Describe("#NewRateLimiter", func() {
+		It("should return nil if rate limiting is disabled", func() {
+			Expect(NewRateLimiter("test", -1, 0)).To(BeNil())
+		})
+
+		It("should default QPS and burst", func() {
+			rateLimiter := NewRateLimiter("test", 0, 0)
+			Expect(rateLimiter.QPS()).To(Equal(rest.DefaultQPS))
+		})
+
+		It("should record the duration and throttled requests", func() {
+			DeferCleanup(func() { DeleteRateLimiterMetrics("test") })
+
+			rateLimiter := NewRateLimiter("test", 10, 1)
+			Expect(rateLimiter.Wait(ctx)).To(Succeed())
+			Expect(testutil.GatherAndCount(runtimemetrics.Registry, "gardener_client_rate_limiter_throttled_requests_total")).To(BeZero())
+
+			Expect(rateLimiter.Wait(ctx)).To(Succeed())
+			Expect(testutil.GatherAndCompare(runtimemetrics.Registry, strings.NewReader(`
+# HELP gardener_client_rate_limiter_throttled_requests_total Total number of requests to a target cluster which were throttled by the client-side rate limiter for longer than 50ms.
+# TYPE gardener_client_rate_limiter_throttled_requests_total counter
+gardener_client_rate_limiter_throttled_requests_total{target="test"} 1
+`), "gardener_client_rate_limiter_throttled_requests_total")).To(Succeed())
+			Expect(testutil.GatherAndCount(runtimemetrics.Registry, "gardener_client_rate_limiter_duration_seconds")).To(Equal(1))
+		})
+	})
+
+	Describe("#DeleteRateLimiterMetrics", func() {
+		It("should delete the metrics of the target", func() {
+			Expect(NewRateLimiter("test", 10, 1).Wait(ctx)).To(Succeed())
+			Expect(testutil.GatherAndCount(runtimemetrics.Registry, "gardener_client_rate_limiter_duration_seconds")).To(Equal(1))
+
+			DeleteRateLimiterMetrics("test")
+			Expect(testutil.GatherAndCount(runtimemetrics.Registry, "gardener_client_rate_limiter_duration_seconds")).To(BeZero())
+		})
+	})
+})
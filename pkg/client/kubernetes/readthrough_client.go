@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ client.Client = &ReadThroughClient{}
+
+// ReadThroughClient is a client.Client which memoizes the Secrets and ConfigMaps read via Get requests. It is meant to
+// be used for a short period of time only (e.g., for a single reconciliation of a Shoot) in order to avoid reading the
+// same objects over and over again from the API server if they are not served from an informer cache. This is the case
+// for objects which cannot be watched, e.g., because the client is only allowed to get them individually.
+// Memoized objects are invalidated when they are modified via this client (including their status and subresources),
+// when an event with a different resource version is observed for them by the shared informers, or when they are older
+// than the TTL. Expired objects are pruned periodically. The TTL only matters if the objects are not covered by the
+// shared informers (e.g., because the client is not allowed to list and watch them).
+type ReadThroughClient struct {
+	client.Client
+
+	ctx       context.Context
+	informers cache.Informers
+	clock     clock.PassiveClock
+	ttl       time.Duration
+
+	lock       sync.RWMutex
+	objects    map[readThroughKey]readThroughEntry
+	handlers   map[readThroughKey]readThroughHandler
+	lastPruned time.Time
+}
+
+type readThroughKey struct {
+	kind string
+	key  client.ObjectKey
+}
+
+type readThroughEntry struct {
+	obj       client.Object
+	expiresAt time.Time
+}
+
+type readThroughHandler struct {
+	informer     cache.Informer
+	registration toolscache.ResourceEventHandlerRegistration
+}
+
+// NewReadThroughClient returns a new ReadThroughClient for the given client. If informers are given, the client
+// registers event handlers at the shared informers of the memoized objects and invalidates them as soon as they are
+// changed by someone else. No additional informers or watches are started for this purpose. The event handlers are
+// removed when the given context is cancelled, hence it should be bound to the lifetime of the ReadThroughClient.
+func NewReadThroughClient(ctx context.Context, c client.Client, informers cache.Informers, clock clock.PassiveClock, ttl time.Duration) *ReadThroughClient {
+	readThroughClient := &ReadThroughClient{
+		Client:     c,
+		ctx:        ctx,
+		informers:  informers,
+		clock:      clock,
+		ttl:        ttl,
+		objects:    make(map[readThroughKey]readThroughEntry),
+		handlers:   make(map[readThroughKey]readThroughHandler),
+		lastPruned: clock.Now(),
+	}
+
+	if informers != nil {
+		go func() {
+			<-ctx.Done()
+			readThroughClient.removeEventHandlers()
+		}()
+	}
+
+	return readThroughClient
+}
+
+// Get retrieves an obj for a given object key. Secrets and ConfigMaps are served from the memoized objects if possible.
+func (c *ReadThroughClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	k, ok := readThroughKeyFor(key, obj)
+	if !ok || len(opts) > 0 {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+
+	c.lock.RLock()
+	entry, found := c.objects[k]
+	c.lock.RUnlock()
+
+	if found && c.clock.Now().Before(entry.expiresAt) {
+		reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(entry.obj.DeepCopyObject()).Elem())
+		return nil
+	}
+
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	c.pruneExpired()
+	c.objects[k] = readThroughEntry{obj: obj.DeepCopyObject().(client.Object), expiresAt: c.clock.Now().Add(c.ttl)}
+	c.lock.Unlock()
+
+	c.addEventHandler(k, obj)
+	return nil
+}
+
+// Create creates the given obj and invalidates the memoized object.
+func (c *ReadThroughClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	defer c.invalidate(obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// Update updates the given obj and invalidates the memoized object.
+func (c *ReadThroughClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	defer c.invalidate(obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// Patch patches the given obj and invalidates the memoized object.
+func (c *ReadThroughClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	defer c.invalidate(obj)
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// Delete deletes the given obj and invalidates the memoized object.
+func (c *ReadThroughClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	defer c.invalidate(obj)
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// DeleteAllOf deletes all objects of the given type matching the given options and invalidates all memoized objects.
+func (c *ReadThroughClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	defer c.invalidateAll()
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Status returns a client for the status subresource which invalidates the memoized objects it modifies.
+func (c *ReadThroughClient) Status() client.SubResourceWriter {
+	return &readThroughSubResourceWriter{SubResourceWriter: c.Client.Status(), invalidate: c.invalidate}
+}
+
+// SubResource returns a client for the given subresource which invalidates the memoized objects it modifies.
+func (c *ReadThroughClient) SubResource(subResource string) client.SubResourceClient {
+	subResourceClient := c.Client.SubResource(subResource)
+	return &readThroughSubResourceClient{
+		SubResourceClient: subResourceClient,
+		writer:            &readThroughSubResourceWriter{SubResourceWriter: subResourceClient, invalidate: c.invalidate},
+	}
+}
+
+// addEventHandler registers an event handler for the memoized object with the given key at the shared informer
+// responsible for it if it is not registered yet. The handler invalidates memoized objects when they are deleted or
+// when an event with a different resource version is observed for them. If the informer cannot be retrieved (e.g.,
+// because the objects cannot be watched), the memoized object is only invalidated by the TTL.
+func (c *ReadThroughClient) addEventHandler(k readThroughKey, obj client.Object) {
+	if c.informers == nil || c.ctx.Err() != nil {
+		return
+	}
+
+	c.lock.RLock()
+	_, registered := c.handlers[k]
+	c.lock.RUnlock()
+	if registered {
+		return
+	}
+
+	informer, err := c.informers.GetInformer(c.ctx, obj, cache.BlockUntilSynced(false))
+	if err != nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.handlers[k]; ok {
+		return
+	}
+
+	registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    c.invalidateOutdated,
+		UpdateFunc: func(_, newObj any) { c.invalidateOutdated(newObj) },
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if o, ok := obj.(client.Object); ok {
+				c.invalidate(o)
+			}
+		},
+	})
+	if err != nil {
+		return
+	}
+	c.handlers[k] = readThroughHandler{informer: informer, registration: registration}
+}
+
+func (c *ReadThroughClient) removeEventHandlers() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, handler := range c.handlers {
+		// The handler only invalidates memoized objects, hence there is nothing to do if it cannot be removed.
+		_ = handler.informer.RemoveEventHandler(handler.registration)
+	}
+	c.handlers = make(map[readThroughKey]readThroughHandler)
+}
+
+// invalidateOutdated invalidates the memoized object for the given object unless it has the same resource version,
+// i.e., events for the memoized version of the object (e.g., the initial events of the informer) are ignored.
+func (c *ReadThroughClient) invalidateOutdated(o any) {
+	obj, ok := o.(client.Object)
+	if !ok {
+		return
+	}
+
+	k, ok := readThroughKeyFor(client.ObjectKeyFromObject(obj), obj)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if entry, found := c.objects[k]; found && entry.obj.GetResourceVersion() != obj.GetResourceVersion() {
+		delete(c.objects, k)
+	}
+}
+
+func (c *ReadThroughClient) invalidate(obj client.Object) {
+	k, ok := readThroughKeyFor(client.ObjectKeyFromObject(obj), obj)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	delete(c.objects, k)
+	c.lock.Unlock()
+}
+
+func (c *ReadThroughClient) invalidateAll() {
+	c.lock.Lock()
+	c.objects = make(map[readThroughKey]readThroughEntry)
+	c.lock.Unlock()
+}
+
+// pruneExpired removes all expired objects, at most once per TTL. The caller must hold the lock.
+func (c *ReadThroughClient) pruneExpired() {
+	now := c.clock.Now()
+	if now.Before(c.lastPruned.Add(c.ttl)) {
+		return
+	}
+
+	for k, entry := range c.objects {
+		if !now.Before(entry.expiresAt) {
+			delete(c.objects, k)
+		}
+	}
+	c.lastPruned = now
+}
+
+type readThroughSubResourceWriter struct {
+	client.SubResourceWriter
+	invalidate func(client.Object)
+}
+
+func (w *readThroughSubResourceWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	defer w.invalidate(obj)
+	return w.SubResourceWriter.Create(ctx, obj, subResource, opts...)
+}
+
+func (w *readThroughSubResourceWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	defer w.invalidate(obj)
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *readThroughSubResourceWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	defer w.invalidate(obj)
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+type readThroughSubResourceClient struct {
+	client.SubResourceClient
+	writer *readThroughSubResourceWriter
+}
+
+func (c *readThroughSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return c.writer.Create(ctx, obj, subResource, opts...)
+}
+
+func (c *readThroughSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return c.writer.Update(ctx, obj, opts...)
+}
+
+func (c *readThroughSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return c.writer.Patch(ctx, obj, patch, opts...)
+}
+
+func readThroughKeyFor(key client.ObjectKey, obj client.Object) (readThroughKey, bool) {
+	switch obj.(type) {
+	case *corev1.Secret:
+		return readThroughKey{kind: "Secret", key: key}, true
+	case *corev1.ConfigMap:
+		return readThroughKey{kind: "ConfigMap", key: key}, true
+	default:
+		return readThroughKey{}, false
+	}
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ReadThroughClient", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClock  *testclock.FakeClock
+		fakeClient client.WithWatch
+		getCalls   int
+		c          *ReadThroughClient
+
+		secret    *corev1.Secret
+		configMap *corev1.ConfigMap
+		pod       *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		getCalls = 0
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"}, Data: map[string][]byte{"foo": []byte("bar")}}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: "default"}, Data: map[string]string{"foo": "bar"}}
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithObjects(secret, configMap, pod).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					getCalls++
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+
+		c = NewReadThroughClient(ctx, fakeClient, nil, fakeClock, time.Minute)
+	})
+
+	It("should memoize secrets and configmaps", func() {
+		for i := 0; i < 3; i++ {
+			s := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+			Expect(s.Data).To(Equal(secret.Data))
+
+			cm := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), cm)).To(Succeed())
+			Expect(cm.Data).To(Equal(configMap.Data))
+		}
+
+		Expect(getCalls).To(Equal(2))
+	})
+
+	It("should return copies of the memoized objects", func() {
+		s := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+		s.Data["foo"] = []byte("baz")
+
+		s = &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+		Expect(s.Data).To(HaveKeyWithValue("foo", []byte("bar")))
+	})
+
+	It("should not memoize other objects", func() {
+		for i := 0; i < 3; i++ {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+		}
+
+		Expect(getCalls).To(Equal(3))
+	})
+
+	It("should not memoize objects which were not found", func() {
+		for i := 0; i < 3; i++ {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "other", Namespace: "default"}, &corev1.Secret{})).To(BeNotFoundError())
+		}
+
+		Expect(getCalls).To(Equal(3))
+	})
+
+	It("should read the object again after the TTL expired", func() {
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		fakeClock.Step(time.Minute)
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+
+		Expect(getCalls).To(Equal(2))
+	})
+
+	It("should invalidate the memoized object when it is modified", func() {
+		s := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+
+		s.Data["foo"] = []byte("baz")
+		Expect(c.Update(ctx, s)).To(Succeed())
+
+		s = &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+		Expect(s.Data).To(HaveKeyWithValue("foo", []byte("baz")))
+		Expect(getCalls).To(Equal(2))
+
+		Expect(c.Delete(ctx, s)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+	})
+
+	It("should invalidate the memoized object when its status or a subresource is modified", func() {
+		s := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+
+		// The result of the write does not matter, the memoized object is invalidated in any case.
+		_ = c.Status().Update(ctx, s)
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		Expect(getCalls).To(Equal(2))
+
+		_ = c.SubResource("foo").Patch(ctx, s, client.MergeFrom(s))
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		Expect(getCalls).To(Equal(3))
+	})
+
+	It("should invalidate all memoized objects when objects are deleted collectively", func() {
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(c.DeleteAllOf(ctx, &corev1.Secret{}, client.InNamespace("default"))).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), &corev1.ConfigMap{})).To(Succeed())
+		Expect(getCalls).To(Equal(4))
+	})
+
+	Context("with informers", func() {
+		var (
+			cancel         context.CancelFunc
+			secretInformer *fakeInformer
+		)
+
+		BeforeEach(func() {
+			var informersCtx context.Context
+			informersCtx, cancel = context.WithCancel(ctx)
+			DeferCleanup(cancel)
+
+			secretInformer = &fakeInformer{FakeInformer: &controllertest.FakeInformer{}}
+			c = NewReadThroughClient(informersCtx, fakeClient, &fakeInformers{FakeInformers: &informertest.FakeInformers{}, informer: secretInformer}, fakeClock, time.Minute)
+		})
+
+		It("should invalidate the memoized object when it is changed by someone else", func() {
+			s := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+
+			secret.Data["foo"] = []byte("baz")
+			Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+			secretInformer.Update(s, secret)
+
+			s = &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+			Expect(s.Data).To(HaveKeyWithValue("foo", []byte("baz")))
+			Expect(getCalls).To(Equal(2))
+		})
+
+		It("should invalidate the memoized object when it is deleted by someone else", func() {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+
+			Expect(fakeClient.Delete(ctx, secret)).To(Succeed())
+			secretInformer.handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "default/secret", Obj: secret})
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should not invalidate the memoized object for events with the memoized resource version", func() {
+			s := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+
+			secretInformer.Add(s)
+			secretInformer.Update(s, s)
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+			Expect(getCalls).To(Equal(1))
+		})
+
+		It("should not invalidate the memoized object when other objects are changed", func() {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+
+			other := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", ResourceVersion: "1"}}
+			secretInformer.Add(other)
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+			Expect(getCalls).To(Equal(1))
+		})
+
+		It("should register the event handler only once per memoized object and remove it when the context is cancelled", func() {
+			for i := 0; i < 3; i++ {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+				fakeClock.Step(time.Minute)
+			}
+			Expect(secretInformer.added.Load()).To(BeEquivalentTo(1))
+
+			cancel()
+
+			Eventually(secretInformer.removed.Load).Should(BeEquivalentTo(1))
+		})
+
+		It("should only rely on the TTL if there is no informer for the object", func() {
+			c = NewReadThroughClient(ctx, fakeClient, &fakeInformers{FakeInformers: &informertest.FakeInformers{}, err: fmt.Errorf("forbidden")}, fakeClock, time.Minute)
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+
+			secret.Data["foo"] = []byte("baz")
+			Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+			s := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+			Expect(s.Data).To(HaveKeyWithValue("foo", []byte("bar")))
+
+			fakeClock.Step(time.Minute)
+
+			s = &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), s)).To(Succeed())
+			Expect(s.Data).To(HaveKeyWithValue("foo", []byte("baz")))
+		})
+	})
+})
+
+type fakeInformers struct {
+	*informertest.FakeInformers
+	informer cache.Informer
+	err      error
+}
+
+func (f *fakeInformers) GetInformer(context.Context, client.Object, ...cache.InformerGetOption) (cache.Informer, error) {
+	return f.informer, f.err
+}
+
+type fakeInformer struct {
+	*controllertest.FakeInformer
+	handler        toolscache.ResourceEventHandler
+	added, removed atomic.Int32
+}
+
+func (f *fakeInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	f.added.Add(1)
+	f.handler = handler
+	return f.FakeInformer.AddEventHandler(handler)
+}
+
+func (f *fakeInformer) RemoveEventHandler(toolscache.ResourceEventHandlerRegistration) error {
+	f.removed.Add(1)
+	return nil
+}
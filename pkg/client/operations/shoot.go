@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// DefaultPollInterval is the default interval in which the shoot is read while waiting for an operation.
+const DefaultPollInterval = 10 * time.Second
+
+// ErrOperationPending is returned if a different operation has already been requested for a shoot but not yet been
+// picked up.
+var ErrOperationPending = errors.New("another operation is already pending for the shoot")
+
+// Rotation is a kind of credentials rotation of a shoot.
+type Rotation string
+
+const (
+	// RotationAll rotates all credentials of the shoot.
+	RotationAll Rotation = "all"
+	// RotationCA rotates the certificate authorities of the shoot.
+	RotationCA Rotation = "ca"
+	// RotationServiceAccountKey rotates the service account token signing key of the shoot.
+	RotationServiceAccountKey Rotation = "serviceaccount-key"
+	// RotationETCDEncryptionKey rotates the ETCD encryption key of the shoot.
+	RotationETCDEncryptionKey Rotation = "etcd-encryption-key"
+	// RotationObservability rotates the observability credentials of the shoot. This rotation has only one phase.
+	RotationObservability Rotation = "observability"
+	// RotationSSHKeypair rotates the SSH keypair of the shoot. This rotation has only one phase.
+	RotationSSHKeypair Rotation = "ssh-keypair"
+)
+
+// RotationPhase is a phase of a two-phase credentials rotation.
+type RotationPhase string
+
+const (
+	// RotationPhaseStart starts a rotation, i.e., new credentials are created while the old ones are still valid.
+	RotationPhaseStart RotationPhase = "start"
+	// RotationPhaseComplete completes a rotation, i.e., the old credentials are invalidated.
+	RotationPhaseComplete RotationPhase = "complete"
+)
+
+// RotateCredentialsOptions are the options for a credentials rotation.
+type RotateCredentialsOptions struct {
+	// Rotation is the kind of credentials to rotate. Defaults to RotationAll.
+	Rotation Rotation
+	// Phase is the phase of the rotation. It must be set for two-phase rotations and must not be set for rotations with
+	// only one phase.
+	Phase RotationPhase
+}
+
+// Operation returns the value of the operation annotation for the rotation.
+func (o RotateCredentialsOptions) Operation() (string, error) {
+	rotation := o.Rotation
+	if rotation == "" {
+		rotation = RotationAll
+	}
+
+	var operations map[RotationPhase]string
+	switch rotation {
+	case RotationAll:
+		operations = map[RotationPhase]string{RotationPhaseStart: v1beta1constants.OperationRotateCredentialsStart, RotationPhaseComplete: v1beta1constants.OperationRotateCredentialsComplete}
+	case RotationCA:
+		operations = map[RotationPhase]string{RotationPhaseStart: v1beta1constants.OperationRotateCAStart, RotationPhaseComplete: v1beta1constants.OperationRotateCAComplete}
+	case RotationServiceAccountKey:
+		operations = map[RotationPhase]string{RotationPhaseStart: v1beta1constants.OperationRotateServiceAccountKeyStart, RotationPhaseComplete: v1beta1constants.OperationRotateServiceAccountKeyComplete}
+	case RotationETCDEncryptionKey:
+		operations = map[RotationPhase]string{RotationPhaseStart: v1beta1constants.OperationRotateETCDEncryptionKeyStart, RotationPhaseComplete: v1beta1constants.OperationRotateETCDEncryptionKeyComplete}
+	case RotationObservability:
+		operations = map[RotationPhase]string{"": v1beta1constants.OperationRotateObservabilityCredentials}
+	case RotationSSHKeypair:
+		operations = map[RotationPhase]string{"": v1beta1constants.ShootOperationRotateSSHKeypair}
+	default:
+		return "", fmt.Errorf("unknown rotation %q", rotation)
+	}
+
+	operation, ok := operations[o.Phase]
+	if !ok {
+		if _, singlePhase := operations[""]; singlePhase {
+			return "", fmt.Errorf("rotation %q does not support phases", rotation)
+		}
+		return "", fmt.Errorf("rotation %q requires phase %q or %q, got %q", rotation, RotationPhaseStart, RotationPhaseComplete, o.Phase)
+	}
+
+	return operation, nil
+}
+
+// ShootClient provides typed helpers for triggering operations on shoots and for waiting until they are completed.
+// All helpers re-read the shoot before modifying it and retry on conflicts, so that they can safely be used while
+// other parties update the shoot concurrently.
+type ShootClient struct {
+	clientset    versioned.Interface
+	pollInterval time.Duration
+}
+
+// Option is an option for a ShootClient.
+type Option func(*ShootClient)
+
+// WithPollInterval sets the interval in which the shoot is read while waiting for an operation.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *ShootClient) {
+		c.pollInterval = interval
+	}
+}
+
+// NewShootClient returns a new ShootClient using the given clientset.
+func NewShootClient(clientset versioned.Interface, opts ...Option) *ShootClient {
+	c := &ShootClient{
+		clientset:    clientset,
+		pollInterval: DefaultPollInterval,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// RotateCredentials requests the credentials rotation described by the given options for the shoot.
+func (c *ShootClient) RotateCredentials(ctx context.Context, shoot *gardencorev1beta1.Shoot, opts RotateCredentialsOptions) (*gardencorev1beta1.Shoot, error) {
+	operation, err := opts.Operation()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RequestOperation(ctx, shoot, operation)
+}
+
+// Reconcile requests a reconciliation of the shoot.
+func (c *ShootClient) Reconcile(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Shoot, error) {
+	return c.RequestOperation(ctx, shoot, v1beta1constants.GardenerOperationReconcile)
+}
+
+// Hibernate enables the hibernation of the shoot. It is a no-op if the hibernation is already enabled.
+func (c *ShootClient) Hibernate(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Shoot, error) {
+	return c.setHibernation(ctx, shoot, true)
+}
+
+// WakeUp disables the hibernation of the shoot. It is a no-op if the hibernation is already disabled.
+func (c *ShootClient) WakeUp(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Shoot, error) {
+	return c.setHibernation(ctx, shoot, false)
+}
+
+// RequestOperation sets the operation annotation with the given value on the shoot. It returns ErrOperationPending if
+// a different operation has already been requested but not yet been picked up.
+func (c *ShootClient) RequestOperation(ctx context.Context, shoot *gardencorev1beta1.Shoot, operation string) (*gardencorev1beta1.Shoot, error) {
+	return c.update(ctx, shoot, func(shoot *gardencorev1beta1.Shoot) (bool, error) {
+		if pending, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; ok {
+			if pending == operation {
+				return false, nil
+			}
+			return false, fmt.Errorf("%w: %q", ErrOperationPending, pending)
+		}
+
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		return true, nil
+	})
+}
+
+func (c *ShootClient) setHibernation(ctx context.Context, shoot *gardencorev1beta1.Shoot, enabled bool) (*gardencorev1beta1.Shoot, error) {
+	return c.update(ctx, shoot, func(shoot *gardencorev1beta1.Shoot) (bool, error) {
+		if v1beta1helper.HibernationIsEnabled(shoot) == enabled {
+			return false, nil
+		}
+
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = &enabled
+		return true, nil
+	})
+}
+
+// update reads the current version of the shoot, applies the given mutation and updates the shoot if the mutation
+// reports a change. Conflicts are retried until the given context is cancelled.
+func (c *ShootClient) update(ctx context.Context, shoot *gardencorev1beta1.Shoot, mutate func(*gardencorev1beta1.Shoot) (bool, error)) (*gardencorev1beta1.Shoot, error) {
+	var result *gardencorev1beta1.Shoot
+
+	if err := retry.Until(ctx, c.pollInterval, func(ctx context.Context) (bool, error) {
+		current, err := c.clientset.CoreV1beta1().Shoots(shoot.Namespace).Get(ctx, shoot.Name, metav1.GetOptions{})
+		if err != nil {
+			return retry.SevereError(err)
+		}
+
+		changed, err := mutate(current)
+		if err != nil {
+			return retry.SevereError(err)
+		}
+		if !changed {
+			result = current
+			return retry.Ok()
+		}
+
+		updated, err := c.clientset.CoreV1beta1().Shoots(shoot.Namespace).Update(ctx, current, metav1.UpdateOptions{})
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return retry.MinorError(err)
+			}
+			return retry.SevereError(err)
+		}
+
+		result = updated
+		return retry.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// WaitForOperation waits until the last requested operation or spec change of the shoot has been processed
+// successfully. It returns an error if the last operation failed permanently or if the given context is cancelled.
+func (c *ShootClient) WaitForOperation(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Shoot, error) {
+	var result *gardencorev1beta1.Shoot
+
+	if err := retry.Until(ctx, c.pollInterval, func(ctx context.Context) (bool, error) {
+		current, err := c.clientset.CoreV1beta1().Shoots(shoot.Namespace).Get(ctx, shoot.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.SevereError(err)
+			}
+			return retry.MinorError(err)
+		}
+
+		result = current
+		return CheckOperation(current)
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CheckOperation checks whether the last requested operation or spec change of the given shoot has been processed. It
+// returns true without error if it succeeded, true with an error if it failed permanently, and false with an error
+// describing the current state otherwise. The results can be used directly as results of a retry.Func.
+func CheckOperation(shoot *gardencorev1beta1.Shoot) (bool, error) {
+	if operation, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; ok {
+		return retry.MinorError(fmt.Errorf("operation %q has not yet been picked up", operation))
+	}
+
+	if shoot.Generation != shoot.Status.ObservedGeneration {
+		return retry.MinorError(fmt.Errorf("observed generation %d is outdated, current generation is %d", shoot.Status.ObservedGeneration, shoot.Generation))
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil {
+		return retry.MinorError(errors.New("no last operation present yet"))
+	}
+
+	switch lastOperation.State {
+	case gardencorev1beta1.LastOperationStateSucceeded:
+		return retry.Ok()
+	case gardencorev1beta1.LastOperationStateFailed:
+		return retry.SevereError(fmt.Errorf("last operation %s failed: %s", lastOperation.Type, lastErrorsDescription(shoot)))
+	default:
+		return retry.MinorError(fmt.Errorf("last operation %s is in state %s (%d%%): %s", lastOperation.Type, lastOperation.State, lastOperation.Progress, lastOperation.Description))
+	}
+}
+
+func lastErrorsDescription(shoot *gardencorev1beta1.Shoot) string {
+	if len(shoot.Status.LastErrors) == 0 {
+		return shoot.Status.LastOperation.Description
+	}
+
+	descriptions := make([]string, 0, len(shoot.Status.LastErrors))
+	for _, lastError := range shoot.Status.LastErrors {
+		descriptions = append(descriptions, lastError.Description)
+	}
+	return strings.Join(descriptions, ", ")
+}
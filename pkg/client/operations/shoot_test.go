@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	fakegardencore "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/client/operations"
+)
+
+var _ = Describe("ShootClient", func() {
+	var (
+		ctx context.Context
+
+		clientset   *fakegardencore.Clientset
+		shootClient *ShootClient
+		shoot       *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		DeferCleanup(cancel)
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "foo",
+				Namespace:  "garden-bar",
+				Generation: 1,
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				ObservedGeneration: 1,
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+
+		clientset = fakegardencore.NewSimpleClientset(shoot)
+		shootClient = NewShootClient(clientset, WithPollInterval(time.Millisecond))
+	})
+
+	getShoot := func() *gardencorev1beta1.Shoot {
+		current, err := clientset.CoreV1beta1().Shoots(shoot.Namespace).Get(ctx, shoot.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return current
+	}
+
+	Describe("#RotateCredentialsOptions", func() {
+		DescribeTable("#Operation",
+			func(opts RotateCredentialsOptions, expectedOperation, expectedError string) {
+				operation, err := opts.Operation()
+				if expectedError != "" {
+					Expect(err).To(MatchError(ContainSubstring(expectedError)))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(operation).To(Equal(expectedOperation))
+			},
+
+			Entry("all credentials (default)", RotateCredentialsOptions{Phase: RotationPhaseStart}, v1beta1constants.OperationRotateCredentialsStart, ""),
+			Entry("all credentials", RotateCredentialsOptions{Rotation: RotationAll, Phase: RotationPhaseComplete}, v1beta1constants.OperationRotateCredentialsComplete, ""),
+			Entry("CA", RotateCredentialsOptions{Rotation: RotationCA, Phase: RotationPhaseStart}, v1beta1constants.OperationRotateCAStart, ""),
+			Entry("service account key", RotateCredentialsOptions{Rotation: RotationServiceAccountKey, Phase: RotationPhaseComplete}, v1beta1constants.OperationRotateServiceAccountKeyComplete, ""),
+			Entry("ETCD encryption key", RotateCredentialsOptions{Rotation: RotationETCDEncryptionKey, Phase: RotationPhaseStart}, v1beta1constants.OperationRotateETCDEncryptionKeyStart, ""),
+			Entry("observability", RotateCredentialsOptions{Rotation: RotationObservability}, v1beta1constants.OperationRotateObservabilityCredentials, ""),
+			Entry("SSH keypair", RotateCredentialsOptions{Rotation: RotationSSHKeypair}, v1beta1constants.ShootOperationRotateSSHKeypair, ""),
+			Entry("missing phase", RotateCredentialsOptions{Rotation: RotationCA}, "", `rotation "ca" requires phase`),
+			Entry("unsupported phase", RotateCredentialsOptions{Rotation: RotationSSHKeypair, Phase: RotationPhaseStart}, "", `rotation "ssh-keypair" does not support phases`),
+			Entry("unknown rotation", RotateCredentialsOptions{Rotation: "foo"}, "", `unknown rotation "foo"`),
+		)
+	})
+
+	Describe("#RotateCredentials", func() {
+		It("should set the operation annotation", func() {
+			result, err := shootClient.RotateCredentials(ctx, shoot, RotateCredentialsOptions{Rotation: RotationCA, Phase: RotationPhaseStart})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart))
+			Expect(getShoot().Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart))
+		})
+
+		It("should fail for invalid options without updating the shoot", func() {
+			_, err := shootClient.RotateCredentials(ctx, shoot, RotateCredentialsOptions{Rotation: RotationCA})
+			Expect(err).To(HaveOccurred())
+			Expect(getShoot().Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		})
+	})
+
+	Describe("#RequestOperation", func() {
+		It("should fail if a different operation is pending", func() {
+			_, err := shootClient.Reconcile(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = shootClient.RotateCredentials(ctx, shoot, RotateCredentialsOptions{Phase: RotationPhaseStart})
+			Expect(err).To(MatchError(ErrOperationPending))
+			Expect(getShoot().Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		It("should succeed if the same operation is pending", func() {
+			_, err := shootClient.Reconcile(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = shootClient.Reconcile(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should retry on conflicts", func() {
+			conflicts := 0
+			clientset.PrependReactor("update", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				if conflicts < 2 {
+					conflicts++
+					return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "core.gardener.cloud", Resource: "shoots"}, shoot.Name, nil)
+				}
+				return false, nil, nil
+			})
+
+			_, err := shootClient.Reconcile(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflicts).To(Equal(2))
+			Expect(getShoot().Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		It("should not retry on other errors", func() {
+			clientset.PrependReactor("update", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: "core.gardener.cloud", Resource: "shoots"}, shoot.Name, nil)
+			})
+
+			_, err := shootClient.Reconcile(ctx, shoot)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	Describe("#Hibernate", func() {
+		It("should enable the hibernation", func() {
+			result, err := shootClient.Hibernate(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(getShoot().Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		})
+
+		It("should not update the shoot if it is already hibernated", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			Expect(clientset.Tracker().Update(gardencorev1beta1.SchemeGroupVersion.WithResource("shoots"), shoot, shoot.Namespace)).To(Succeed())
+			clientset.ClearActions()
+
+			_, err := shootClient.Hibernate(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clientset.Actions()).To(HaveLen(1))
+			Expect(clientset.Actions()[0].GetVerb()).To(Equal("get"))
+		})
+	})
+
+	Describe("#WakeUp", func() {
+		It("should disable the hibernation", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			Expect(clientset.Tracker().Update(gardencorev1beta1.SchemeGroupVersion.WithResource("shoots"), shoot, shoot.Namespace)).To(Succeed())
+
+			result, err := shootClient.WakeUp(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+			Expect(getShoot().Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+		})
+
+		It("should not update the shoot if it is not hibernated", func() {
+			clientset.ClearActions()
+
+			result, err := shootClient.WakeUp(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Spec.Hibernation).To(BeNil())
+			Expect(clientset.Actions()).To(HaveLen(1))
+		})
+	})
+
+	Describe("#WaitForOperation", func() {
+		It("should return when the operation succeeded", func() {
+			result, err := shootClient.WaitForOperation(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should wait until the operation succeeded", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(clientset.Tracker().Update(gardencorev1beta1.SchemeGroupVersion.WithResource("shoots"), shoot, shoot.Namespace)).To(Succeed())
+
+			gets := 0
+			clientset.PrependReactor("get", "shoots", func(_ testing.Action) (bool, runtime.Object, error) {
+				if gets++; gets == 3 {
+					succeeded := shoot.DeepCopy()
+					succeeded.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+					Expect(clientset.Tracker().Update(gardencorev1beta1.SchemeGroupVersion.WithResource("shoots"), succeeded, shoot.Namespace)).To(Succeed())
+				}
+				return false, nil, nil
+			})
+
+			_, err := shootClient.WaitForOperation(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gets).To(Equal(3))
+		})
+
+		It("should fail when the operation failed", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastErrors = []gardencorev1beta1.LastError{{Description: "foo"}, {Description: "bar"}}
+			Expect(clientset.Tracker().Update(gardencorev1beta1.SchemeGroupVersion.WithResource("shoots"), shoot, shoot.Namespace)).To(Succeed())
+
+			_, err := shootClient.WaitForOperation(ctx, shoot)
+			Expect(err).To(MatchError("last operation Reconcile failed: foo, bar"))
+		})
+
+		It("should time out if the operation is not picked up", func() {
+			_, err := shootClient.Reconcile(ctx, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = shootClient.WaitForOperation(ctx, shoot)
+			Expect(err).To(MatchError(ContainSubstring(`operation "reconcile" has not yet been picked up`)))
+		})
+	})
+
+	DescribeTable("#CheckOperation",
+		func(mutate func(*gardencorev1beta1.Shoot), expectedDone bool, expectedError string) {
+			mutate(shoot)
+
+			done, err := CheckOperation(shoot)
+			Expect(done).To(Equal(expectedDone))
+			if expectedError == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			}
+		},
+
+		Entry("succeeded", func(*gardencorev1beta1.Shoot) {}, true, ""),
+		Entry("operation annotation present", func(s *gardencorev1beta1.Shoot) {
+			metav1.SetMetaDataAnnotation(&s.ObjectMeta, v1beta1constants.GardenerOperation, "reconcile")
+		}, false, "has not yet been picked up"),
+		Entry("generation not observed", func(s *gardencorev1beta1.Shoot) {
+			s.Generation = 2
+		}, false, "observed generation 1 is outdated"),
+		Entry("no last operation", func(s *gardencorev1beta1.Shoot) {
+			s.Status.LastOperation = nil
+		}, false, "no last operation"),
+		Entry("error", func(s *gardencorev1beta1.Shoot) {
+			s.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+			s.Status.LastOperation.Description = "retrying"
+		}, false, "last operation Reconcile is in state Error (0%): retrying"),
+		Entry("failed", func(s *gardencorev1beta1.Shoot) {
+			s.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			s.Status.LastOperation.Description = "broken"
+		}, true, "last operation Reconcile failed: broken"),
+	)
+})
@@ -25,6 +25,9 @@ const (
 	volumeMountPathCAEtcd     = "/srv/kubernetes/etcd/ca"
 	volumeMountPathEtcdClient = "/srv/kubernetes/etcd/client"
 	volumeMountPathServer     = "/srv/kubernetes/apiserver"
+
+	// debugModeVerbosity is the minimum log verbosity of the API server if the debug mode is enabled.
+	debugModeVerbosity int32 = 4
 )
 
 // InjectDefaultSettings injects default settings into `gardener-apiserver` and `kube-apiserver` deployments.
@@ -43,7 +46,7 @@ func InjectDefaultSettings(
 		fmt.Sprintf("--etcd-keyfile=%s/%s", volumeMountPathEtcdClient, secrets.DataKeyPrivateKey),
 		fmt.Sprintf("--etcd-servers=https://%s%s:%d", namePrefix, etcdconstants.ServiceName(v1beta1constants.ETCDRoleMain), etcdconstants.PortEtcdClient),
 		"--livez-grace-period=1m",
-		"--profiling=false",
+		fmt.Sprintf("--profiling=%t", values.DebugMode),
 		"--shutdown-delay-duration=15s",
 		fmt.Sprintf("--tls-cert-file=%s/%s", volumeMountPathServer, secrets.DataKeyCertificate),
 		fmt.Sprintf("--tls-private-key-file=%s/%s", volumeMountPathServer, secrets.DataKeyPrivateKey),
@@ -63,20 +66,25 @@ func InjectDefaultSettings(
 		}
 	}
 
+	var verbosity *int32
 	if values.Logging != nil {
 		if values.Logging.HTTPAccessVerbosity != nil {
 			deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--vmodule=httplog=%d", *values.Logging.HTTPAccessVerbosity))
 		}
-		if values.Logging.Verbosity != nil {
-			deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--v=%d", *values.Logging.Verbosity))
-		}
+		verbosity = values.Logging.Verbosity
+	}
+	if values.DebugMode {
+		verbosity = ptr.To(max(ptr.Deref(verbosity, 0), debugModeVerbosity))
+	}
+	if verbosity != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--v=%d", *verbosity))
 	}
 
-	if values.WatchCacheSizes != nil && len(values.WatchCacheSizes.Resources) > 0 {
-		if values.WatchCacheSizes != nil && values.WatchCacheSizes.Default != nil {
-			deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--default-watch-cache-size=%d", *values.WatchCacheSizes.Default))
-		}
+	if values.WatchCacheSizes != nil && values.WatchCacheSizes.Default != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--default-watch-cache-size=%d", *values.WatchCacheSizes.Default))
+	}
 
+	if values.WatchCacheSizes != nil && len(values.WatchCacheSizes.Resources) > 0 {
 		var sizes []string
 		for _, resource := range values.WatchCacheSizes.Resources {
 			size := resource.Resource
@@ -122,5 +122,24 @@ var _ = Describe("Deployment", func() {
 				},
 			}))
 		})
+
+		It("should enable profiling and increase the log verbosity in debug mode", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			InjectDefaultSettings(deployment, "", Values{DebugMode: true, Logging: &gardencorev1beta1.APIServerLogging{Verbosity: ptr.To[int32](2)}}, &corev1.Secret{}, &corev1.Secret{}, &corev1.Secret{})
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElements("--profiling=true", "--v=4"))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElements("--profiling=false", "--v=2"))
+		})
+
+		It("should keep a higher log verbosity in debug mode", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			InjectDefaultSettings(deployment, "", Values{DebugMode: true, Logging: &gardencorev1beta1.APIServerLogging{Verbosity: ptr.To[int32](6)}}, &corev1.Secret{}, &corev1.Secret{}, &corev1.Secret{})
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElements("--profiling=true", "--v=6"))
+		})
 	})
 })
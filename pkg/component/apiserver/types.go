@@ -32,6 +32,8 @@ type Values struct {
 	EnabledAdmissionPlugins []AdmissionPluginConfig
 	// DisabledAdmissionPlugins is the list of admission plugins that should be disabled for the API server.
 	DisabledAdmissionPlugins []gardencorev1beta1.AdmissionPlugin
+	// DebugMode states whether the API server runs with increased log verbosity and enabled profiling endpoints.
+	DebugMode bool
 	// Audit contains information for configuring audit settings for the API server.
 	Audit *AuditConfig
 	// Autoscaling contains information for configuring autoscaling settings for the API server.
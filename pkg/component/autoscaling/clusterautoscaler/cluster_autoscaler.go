@@ -7,9 +7,13 @@ package clusterautoscaler
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"golang.org/x/exp/maps"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -24,6 +28,7 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -35,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -47,6 +53,10 @@ const (
 	managedResourceTargetName = "shoot-core-cluster-autoscaler"
 	containerName             = v1beta1constants.DeploymentNameClusterAutoscaler
 
+	// priorityExpanderConfigMapName is the name of the ConfigMap in the kube-system namespace of the shoot which is read
+	// by the priority expander of the cluster-autoscaler.
+	priorityExpanderConfigMapName = "cluster-autoscaler-priority-expander"
+
 	portNameMetrics       = "metrics"
 	portMetrics     int32 = 8085
 )
@@ -70,6 +80,7 @@ func New(
 	image string,
 	replicas int32,
 	config *gardencorev1beta1.ClusterAutoscaler,
+	workers []gardencorev1beta1.Worker,
 	maxNodesTotal int64,
 	runtimeVersion *semver.Version,
 ) Interface {
@@ -80,6 +91,7 @@ func New(
 		image:          image,
 		replicas:       replicas,
 		config:         config,
+		workers:        workers,
 		maxNodesTotal:  maxNodesTotal,
 		runtimeVersion: runtimeVersion,
 	}
@@ -92,6 +104,7 @@ type clusterAutoscaler struct {
 	image          string
 	replicas       int32
 	config         *gardencorev1beta1.ClusterAutoscaler
+	workers        []gardencorev1beta1.Worker
 	maxNodesTotal  int64
 	runtimeVersion *semver.Version
 
@@ -152,7 +165,7 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, service, func() error {
+	if err := c.createOrApply(ctx, service, func() error {
 		service.Labels = getLabels()
 
 		utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForScrapeTargets(service, networkingv1.NetworkPolicyPort{
@@ -180,13 +193,12 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, deployment, func() error {
+	if err := c.createOrApplyDeployment(ctx, deployment, c.replicas, func() error {
 		deployment.Labels = utils.MergeStringMaps(getLabels(), map[string]string{
 			v1beta1constants.GardenRole:                                         v1beta1constants.GardenRoleControlPlane,
 			resourcesv1alpha1.HighAvailabilityConfigType:                        resourcesv1alpha1.HighAvailabilityConfigTypeController,
 			v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook: "true",
 		})
-		deployment.Spec.Replicas = &c.replicas
 		deployment.Spec.RevisionHistoryLimit = ptr.To[int32](1)
 		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: getLabels()}
 		deployment.Spec.Template = corev1.PodTemplateSpec{
@@ -243,7 +255,7 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, podDisruptionBudget, func() error {
+	if err := c.createOrApply(ctx, podDisruptionBudget, func() error {
 		podDisruptionBudget.Labels = getLabels()
 		podDisruptionBudget.Spec = policyv1.PodDisruptionBudgetSpec{
 			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
@@ -256,7 +268,7 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, vpa, func() error {
+	if err := c.createOrApply(ctx, vpa, func() error {
 		vpa.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
 			APIVersion: appsv1.SchemeGroupVersion.String(),
 			Kind:       "Deployment",
@@ -281,7 +293,7 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, prometheusRule, func() error {
+	if err := c.createOrApply(ctx, prometheusRule, func() error {
 		metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", shoot.Label)
 		prometheusRule.Spec = monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{{
@@ -308,7 +320,7 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, serviceMonitor, func() error {
+	if err := c.createOrApply(ctx, serviceMonitor, func() error {
 		metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", shoot.Label)
 		serviceMonitor.Spec = monitoringv1.ServiceMonitorSpec{
 			Selector: metav1.LabelSelector{MatchLabels: getLabels()},
@@ -359,6 +371,14 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 	return managedresources.CreateForShoot(ctx, c.client, c.namespace, managedResourceTargetName, managedresources.LabelValueGardener, false, data)
 }
 
+func (c *clusterAutoscaler) createOrApply(ctx context.Context, obj client.Object, f controllerutil.MutateFn) error {
+	return controllerutils.CreateOrApply(ctx, c.client, obj, features.DefaultFeatureGate.Enabled(features.ServerSideApplyComponents), v1beta1constants.DeploymentNameGardenlet, f)
+}
+
+func (c *clusterAutoscaler) createOrApplyDeployment(ctx context.Context, deployment *appsv1.Deployment, replicas int32, f controllerutil.MutateFn) error {
+	return controllerutils.CreateOrApplyDeployment(ctx, c.client, deployment, replicas, features.DefaultFeatureGate.Enabled(features.ServerSideApplyComponents), v1beta1constants.DeploymentNameGardenlet, f)
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
@@ -619,10 +639,68 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	objects := []client.Object{
 		clusterRole,
 		clusterRoleBinding,
 		role,
 		rolebinding,
-	)
+	}
+
+	if priorityExpanderConfigMap := c.computePriorityExpanderConfigMap(); priorityExpanderConfigMap != nil {
+		objects = append(objects, priorityExpanderConfigMap)
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
+
+// computePriorityExpanderConfigMap returns the ConfigMap for the priority expander of the cluster-autoscaler if at least
+// one worker pool has a priority. The machine deployments of worker pools without a priority get priority 0, otherwise
+// the priority expander would not consider them for scale-up at all.
+func (c *clusterAutoscaler) computePriorityExpanderConfigMap() *corev1.ConfigMap {
+	if !slices.ContainsFunc(c.workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.ClusterAutoscaler != nil && worker.ClusterAutoscaler.Priority != nil
+	}) {
+		return nil
+	}
+
+	priorities := make(map[int32][]string)
+	for _, machineDeployment := range c.machineDeployments {
+		var priority int32
+		for _, worker := range c.workers {
+			if worker.ClusterAutoscaler != nil && c.isMachineDeploymentOfWorkerPool(machineDeployment.Name, worker.Name) {
+				priority = ptr.Deref(worker.ClusterAutoscaler.Priority, 0)
+				break
+			}
+		}
+
+		// The node groups of the cluster-autoscaler are configured as `<namespace>.<machine-deployment-name>`, see
+		// computeCommand.
+		priorities[priority] = append(priorities[priority], `^(`+regexp.QuoteMeta(c.namespace)+`\.)?`+regexp.QuoteMeta(machineDeployment.Name)+`$`)
+	}
+
+	keys := maps.Keys(priorities)
+	slices.Sort(keys)
+	slices.Reverse(keys)
+
+	var data strings.Builder
+	for _, priority := range keys {
+		fmt.Fprintf(&data, "%d:\n", priority)
+		for _, regex := range priorities[priority] {
+			fmt.Fprintf(&data, "- '%s'\n", regex)
+		}
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      priorityExpanderConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{"priorities": data.String()},
+	}
+}
+
+// isMachineDeploymentOfWorkerPool returns true if the machine deployment with the given name belongs to the worker pool
+// with the given name. Provider extensions name the machine deployments `<technical-id>-<pool-name>-z<zone-index>`.
+func (c *clusterAutoscaler) isMachineDeploymentOfWorkerPool(machineDeploymentName, workerPoolName string) bool {
+	return regexp.MustCompile(`^` + regexp.QuoteMeta(c.namespace+"-"+workerPoolName+"-z") + `\d+$`).MatchString(machineDeploymentName)
 }
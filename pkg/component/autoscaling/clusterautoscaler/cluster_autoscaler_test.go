@@ -36,10 +36,12 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/component/autoscaling/clusterautoscaler"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	testutils "github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
@@ -580,7 +582,7 @@ var _ = Describe("ClusterAutoscaler", func() {
 		By("Create secrets managed outside of this package for whose secretsmanager.Get() will be called")
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "generic-token-kubeconfig", Namespace: namespace}})).To(Succeed())
 
-		clusterAutoscaler = New(c, namespace, sm, image, replicas, nil, 1337, nil)
+		clusterAutoscaler = New(c, namespace, sm, image, replicas, nil, nil, 1337, nil)
 		clusterAutoscaler.SetNamespaceUID(namespaceUID)
 		clusterAutoscaler.SetMachineDeployments(machineDeployments)
 	})
@@ -598,9 +600,9 @@ var _ = Describe("ClusterAutoscaler", func() {
 				}
 
 				if runtimeVersionGreaterEquals126 {
-					clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config, 0, semver.MustParse("1.26.1"))
+					clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config, nil, 0, semver.MustParse("1.26.1"))
 				} else {
-					clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config, 0, semver.MustParse("1.25.0"))
+					clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config, nil, 0, semver.MustParse("1.25.0"))
 				}
 				clusterAutoscaler.SetNamespaceUID(namespaceUID)
 				clusterAutoscaler.SetMachineDeployments(machineDeployments)
@@ -662,6 +664,98 @@ var _ = Describe("ClusterAutoscaler", func() {
 			It("w/ config, kubernetes version < 1.26", func() { test(true, false) })
 			It("w/ config, kubernetes version >= 1.26", func() { test(true, true) })
 		})
+
+		It("should deploy the priority expander configuration if worker pools have priorities", func() {
+			workers := []gardencorev1beta1.Worker{
+				{Name: "pool1", ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{Priority: ptr.To[int32](20)}},
+				{Name: "pool", ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{Priority: ptr.To[int32](10)}},
+				{Name: "pool2"},
+			}
+
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, configFull, workers, 0, semver.MustParse("1.26.1"))
+			clusterAutoscaler.SetNamespaceUID(namespaceUID)
+			clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{
+				{Name: namespace + "-pool1-z1", Minimum: 1, Maximum: 2},
+				{Name: namespace + "-pool1-z2", Minimum: 1, Maximum: 2},
+				{Name: namespace + "-pool-z1", Minimum: 1, Maximum: 2},
+				{Name: namespace + "-pool2-z1", Minimum: 1, Maximum: 2},
+			})
+
+			Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+			actualMr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), actualMr)).To(Succeed())
+			managedResource.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: actualMr.Spec.SecretRefs[0].Name}}
+
+			Expect(managedResource).To(consistOf(clusterRoleShoot, clusterRoleBindingShoot, roleShoot, roleBindingShoot, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-autoscaler-priority-expander",
+					Namespace: "kube-system",
+				},
+				Data: map[string]string{"priorities": `20:
+- '^(shoot--foo--bar\.)?shoot--foo--bar-pool1-z1$'
+- '^(shoot--foo--bar\.)?shoot--foo--bar-pool1-z2$'
+10:
+- '^(shoot--foo--bar\.)?shoot--foo--bar-pool-z1$'
+0:
+- '^(shoot--foo--bar\.)?shoot--foo--bar-pool2-z1$'
+`},
+			}))
+		})
+
+		Context("with ServerSideApplyComponents feature gate enabled", func() {
+			var (
+				fieldOwners     map[string]string
+				appliedReplicas *int32
+			)
+
+			BeforeEach(func() {
+				DeferCleanup(testutils.WithFeatureGate(features.DefaultFeatureGate, features.ServerSideApplyComponents, true))
+
+				fieldOwners = map[string]string{}
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(testutils.ServerSideApplyFuncs(func(obj client.Object, opts *client.PatchOptions) {
+					fieldOwners[obj.GetObjectKind().GroupVersionKind().Kind] = opts.FieldManager
+					if deployment, ok := obj.(*appsv1.Deployment); ok {
+						appliedReplicas = deployment.Spec.Replicas
+					}
+				})).Build()
+				sm = fakesecretsmanager.New(fakeClient, namespace)
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "generic-token-kubeconfig", Namespace: namespace}})).To(Succeed())
+			})
+
+			It("should apply the resources with the gardenlet field manager", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, nil, 0, semver.MustParse("1.25.0"))
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(fieldOwners).To(Equal(map[string]string{
+					"Service":               "gardenlet",
+					"Deployment":            "gardenlet",
+					"PodDisruptionBudget":   "gardenlet",
+					"VerticalPodAutoscaler": "gardenlet",
+					"PrometheusRule":        "gardenlet",
+					"ServiceMonitor":        "gardenlet",
+				}))
+
+				// The replicas are not applied so that they are not owned by gardenlet, but set via the scale subresource.
+				Expect(appliedReplicas).To(BeNil())
+				actualDeployment := &appsv1.Deployment{}
+				deploy := deploymentFor(false)
+				deploy.ResourceVersion = "2"
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deploy), actualDeployment)).To(Succeed())
+				Expect(actualDeployment).To(DeepEqual(deploy))
+
+				actualService := &corev1.Service{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), actualService)).To(Succeed())
+				Expect(actualService).To(DeepEqual(service))
+
+				actualVPA := &vpaautoscalingv1.VerticalPodAutoscaler{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(vpa), actualVPA)).To(Succeed())
+				Expect(actualVPA).To(DeepEqual(vpa))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package headroom
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-worker-headroom"
+	// DeploymentNamePrefix is the prefix of the names of the headroom deployments. The name of the worker pool is
+	// appended to it.
+	DeploymentNamePrefix = "worker-headroom-"
+
+	containerName = "pause"
+	labelValue    = "worker-headroom"
+)
+
+// WorkerPool contains the headroom configuration of a worker pool.
+type WorkerPool struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Headroom is the headroom configuration of the worker pool.
+	Headroom gardencorev1beta1.WorkerHeadroom
+	// Taints are the taints of the worker pool's nodes.
+	Taints []corev1.Taint
+}
+
+// Values is a set of configuration values for the worker headroom component.
+type Values struct {
+	// Image is the container image used for the placeholder pods.
+	Image string
+	// WorkerPools are the worker pools for which capacity headroom shall be reserved.
+	WorkerPools []WorkerPool
+}
+
+// New creates a new instance of DeployWaiter for the worker headroom.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &headroom{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type headroom struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (h *headroom) Deploy(ctx context.Context) error {
+	data, err := h.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, h.client, h.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (h *headroom) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, h.client, h.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (h *headroom) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, h.client, h.namespace, ManagedResourceName)
+}
+
+func (h *headroom) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, h.client, h.namespace, ManagedResourceName)
+}
+
+func (h *headroom) computeResourcesData() (map[string][]byte, error) {
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+	for _, pool := range h.values.WorkerPools {
+		if err := registry.Add(h.deployment(pool)); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry.SerializedObjects()
+}
+
+func (h *headroom) deployment(pool WorkerPool) *appsv1.Deployment {
+	resources := corev1.ResourceList{}
+	if pool.Headroom.CPU != nil {
+		resources[corev1.ResourceCPU] = *pool.Headroom.CPU
+	}
+	if pool.Headroom.Memory != nil {
+		resources[corev1.ResourceMemory] = *pool.Headroom.Memory
+	}
+
+	var tolerations []corev1.Toleration
+	for _, taint := range pool.Taints {
+		tolerations = append(tolerations, corev1.Toleration{
+			Key:      taint.Key,
+			Operator: corev1.TolerationOpEqual,
+			Value:    taint.Value,
+			Effect:   taint.Effect,
+		})
+	}
+
+	labels := getLabels(pool.Name)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DeploymentNamePrefix + pool.Name,
+			Namespace: metav1.NamespaceSystem,
+			Labels: utils.MergeStringMaps(labels, map[string]string{
+				managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+				v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+			}),
+			// The placeholder pods are expected to be pending while their capacity is consumed by other workloads.
+			Annotations: map[string]string{
+				resourcesv1alpha1.SkipHealthCheck: "true",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:             ptr.To(pool.Headroom.Replicas),
+			RevisionHistoryLimit: ptr.To[int32](2),
+			Selector:             &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: utils.MergeStringMaps(labels, map[string]string{
+						managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+					}),
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken:  ptr.To(false),
+					TerminationGracePeriodSeconds: ptr.To[int64](0),
+					PriorityClassName:             v1beta1constants.PriorityClassNameShootWorkerHeadroom,
+					NodeSelector:                  map[string]string{v1beta1constants.LabelWorkerPool: pool.Name},
+					Tolerations:                   tolerations,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						RunAsUser:    ptr.To[int64](65534),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []corev1.Container{{
+						Name:            containerName,
+						Image:           h.values.Image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Resources: corev1.ResourceRequirements{
+							Requests: resources,
+							Limits:   resources,
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func getLabels(pool string) map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp:        labelValue,
+		v1beta1constants.LabelWorkerPool: pool,
+	}
+}
@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package headroom_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/autoscaling/headroom"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Headroom", func() {
+	var (
+		ctx = context.Background()
+
+		managedResourceName = "shoot-core-worker-headroom"
+		namespace           = "some-namespace"
+		image               = "some-image:some-tag"
+
+		c         client.Client
+		consistOf func(...client.Object) types.GomegaMatcher
+		values    Values
+		component component.DeployWaiter
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+
+		deployment = func(pool string, replicas int32, resources corev1.ResourceList, tolerations []corev1.Toleration) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "worker-headroom-" + pool,
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"app":                        "worker-headroom",
+						"worker.gardener.cloud/pool": pool,
+						"origin":                     "gardener",
+						"gardener.cloud/role":        "system-component",
+					},
+					Annotations: map[string]string{
+						"resources.gardener.cloud/skip-health-check": "true",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas:             ptr.To(replicas),
+					RevisionHistoryLimit: ptr.To[int32](2),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app":                        "worker-headroom",
+							"worker.gardener.cloud/pool": pool,
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app":                        "worker-headroom",
+								"worker.gardener.cloud/pool": pool,
+								"origin":                     "gardener",
+							},
+						},
+						Spec: corev1.PodSpec{
+							AutomountServiceAccountToken:  ptr.To(false),
+							TerminationGracePeriodSeconds: ptr.To[int64](0),
+							PriorityClassName:             "gardener-shoot-worker-headroom",
+							NodeSelector:                  map[string]string{"worker.gardener.cloud/pool": pool},
+							Tolerations:                   tolerations,
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot: ptr.To(true),
+								RunAsUser:    ptr.To[int64](65534),
+								SeccompProfile: &corev1.SeccompProfile{
+									Type: corev1.SeccompProfileTypeRuntimeDefault,
+								},
+							},
+							Containers: []corev1.Container{{
+								Name:            "pause",
+								Image:           image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Resources: corev1.ResourceRequirements{
+									Requests: resources,
+									Limits:   resources,
+								},
+								SecurityContext: &corev1.SecurityContext{
+									AllowPrivilegeEscalation: ptr.To(false),
+								},
+							}},
+						},
+					},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+		values = Values{
+			Image: image,
+			WorkerPools: []WorkerPool{
+				{
+					Name: "cpu",
+					Headroom: gardencorev1beta1.WorkerHeadroom{
+						Replicas: 2,
+						CPU:      ptr.To(resource.MustParse("1")),
+					},
+				},
+				{
+					Name: "tainted",
+					Headroom: gardencorev1beta1.WorkerHeadroom{
+						Replicas: 1,
+						CPU:      ptr.To(resource.MustParse("500m")),
+						Memory:   ptr.To(resource.MustParse("2Gi")),
+					},
+					Taints: []corev1.Taint{{Key: "dedicated", Value: "latency-sensitive", Effect: corev1.TaintEffectNoSchedule}},
+				},
+			},
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      managedResourceName,
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		It("should successfully deploy a placeholder deployment per worker pool", func() {
+			component = New(c, namespace, values)
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Immutable).To(Equal(ptr.To(true)))
+
+			Expect(managedResource).To(consistOf(
+				deployment("cpu", 2, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}, nil),
+				deployment("tainted", 1,
+					corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+					[]corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "latency-sensitive", Effect: corev1.TaintEffectNoSchedule}},
+				),
+			))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			component = New(c, namespace, values)
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			component = New(c, namespace, values)
+
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail when the wait for the managed resource deletion times out", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(component.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+			})
+
+			It("should not return an error when it's already removed", func() {
+				Expect(component.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
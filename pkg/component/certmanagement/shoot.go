@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certmanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// ShootManagedResourceName is the name of the ManagedResource containing the resources of the certificate service
+	// for the shoot cluster.
+	ShootManagedResourceName = "shoot-core-certificate-service"
+	// SeedManagedResourceName is the name of the ManagedResource containing the resources of the certificate service
+	// in the control plane namespace of the shoot.
+	SeedManagedResourceName = "certificate-service"
+
+	shootResourceName = "gardener.cloud:system:certificate-service"
+)
+
+// ACMEValues contains the configuration of the ACME issuer of the certificate service.
+type ACMEValues struct {
+	// Email is the email address used for the registration of the ACME account.
+	Email string
+	// Server is the URL of the ACME directory.
+	Server string
+	// PrecheckNameservers are the DNS servers used for checking the DNS propagation of the DNS01 challenges.
+	PrecheckNameservers []string
+}
+
+// ShootValues is a set of configuration values for the certificate service of a shoot.
+type ShootValues struct {
+	// Image is the container image used for the cert-controller-manager.
+	Image string
+	// Replicas is the number of pod replicas.
+	Replicas int32
+	// Domain is the domain of the shoot for which certificates can be requested.
+	Domain string
+	// DNSProviderType is the provider type of the DNS records which are created for solving the DNS01 challenges.
+	DNSProviderType string
+	// DNSSecretName is the name of the secret in the control plane namespace containing the credentials of the DNS
+	// provider.
+	DNSSecretName string
+	// ACME contains the configuration of the ACME issuer.
+	ACME ACMEValues
+}
+
+// NewForShoot creates a new instance of DeployWaiter for the certificate service of a shoot. The cert-controller-manager
+// runs in the control plane namespace of the shoot and solves the DNS01 challenges with DNSRecords using the DNS
+// provider of the shoot domain, while the certificates are requested and stored in the shoot cluster.
+func NewForShoot(
+	client client.Client,
+	namespace string,
+	secretsManager secretsmanager.Interface,
+	values ShootValues,
+) component.DeployWaiter {
+	return &certificateService{
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
+	}
+}
+
+type certificateService struct {
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         ShootValues
+}
+
+func (c *certificateService) Deploy(ctx context.Context) error {
+	shootAccessSecret := c.newShootAccessSecret()
+	if err := shootAccessSecret.Reconcile(ctx, c.client); err != nil {
+		return err
+	}
+
+	shootResources, err := c.computeShootResourcesData(shootAccessSecret.ServiceAccountName)
+	if err != nil {
+		return err
+	}
+
+	if err := managedresources.CreateForShoot(ctx, c.client, c.namespace, ShootManagedResourceName, managedresources.LabelValueGardener, false, shootResources); err != nil {
+		return err
+	}
+
+	seedResources, err := c.computeSeedResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, c.client, c.namespace, SeedManagedResourceName, false, seedResources)
+}
+
+func (c *certificateService) Destroy(ctx context.Context) error {
+	if err := managedresources.DeleteForSeed(ctx, c.client, c.namespace, SeedManagedResourceName); err != nil {
+		return err
+	}
+
+	if err := managedresources.DeleteForShoot(ctx, c.client, c.namespace, ShootManagedResourceName); err != nil {
+		return err
+	}
+
+	return kubernetesutils.DeleteObjects(ctx, c.client, c.newShootAccessSecret().Secret)
+}
+
+// TimeoutWaitForShootManagedResource is the timeout used while waiting for the ManagedResources of the certificate
+// service to become healthy or deleted.
+var TimeoutWaitForShootManagedResource = 2 * time.Minute
+
+func (c *certificateService) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForShootManagedResource)
+	defer cancel()
+
+	for _, name := range []string{SeedManagedResourceName, ShootManagedResourceName} {
+		if err := managedresources.WaitUntilHealthy(timeoutCtx, c.client, c.namespace, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *certificateService) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForShootManagedResource)
+	defer cancel()
+
+	for _, name := range []string{SeedManagedResourceName, ShootManagedResourceName} {
+		if err := managedresources.WaitUntilDeleted(timeoutCtx, c.client, c.namespace, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *certificateService) computeShootResourcesData(serviceAccountName string) (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+		clusterRole = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: shootResourceName,
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"services"},
+					Verbs:     []string{"get", "list", "update", "watch"},
+				},
+				{
+					APIGroups: []string{"networking.k8s.io"},
+					Resources: []string{"ingresses"},
+					Verbs:     []string{"get", "list", "update", "watch"},
+				},
+				{
+					APIGroups: []string{"gateway.networking.k8s.io"},
+					Resources: []string{"gateways", "httproutes"},
+					Verbs:     []string{"get", "list", "update", "watch"},
+				},
+				{
+					APIGroups: []string{"networking.istio.io"},
+					Resources: []string{"gateways", "virtualservices"},
+					Verbs:     []string{"get", "list", "update", "watch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"get", "list", "update", "watch", "create", "delete"},
+				},
+				{
+					APIGroups: []string{"cert.gardener.cloud"},
+					Resources: []string{
+						"certificates", "certificates/status",
+						"certificaterevocations", "certificaterevocations/status",
+					},
+					Verbs: []string{"get", "list", "update", "watch", "create", "delete"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "patch"},
+				},
+			},
+		}
+		clusterRoleBinding = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: shootResourceName,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     clusterRole.Name,
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: metav1.NamespaceSystem,
+			}},
+		}
+	)
+
+	registry.AddSerialized("crd-cert.gardener.cloud_certificates.yaml", []byte(crdCertificates))
+	registry.AddSerialized("crd-cert.gardener.cloud_certificaterevocations.yaml", []byte(crdRevocations))
+
+	return registry.AddAllAndSerialize(clusterRole, clusterRoleBinding)
+}
+
+func (c *certificateService) computeSeedResourcesData() (map[string][]byte, error) {
+	genericTokenKubeconfigSecret, found := c.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
+	}
+
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: c.namespace,
+				Labels:    getDeploymentLabels(),
+			},
+			AutomountServiceAccountToken: ptr.To(false),
+		}
+		role = &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: c.namespace,
+				Labels:    getDeploymentLabels(),
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"coordination.k8s.io"},
+					Resources: []string{"leases"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups:     []string{"coordination.k8s.io"},
+					Resources:     []string{"leases"},
+					ResourceNames: []string{"cert-controller-manager-controllers"},
+					Verbs:         []string{"get", "watch", "update"},
+				},
+				{
+					APIGroups: []string{"extensions.gardener.cloud"},
+					Resources: []string{"dnsrecords"},
+					Verbs:     []string{"get", "list", "update", "watch", "create", "delete", "patch"},
+				},
+				{
+					APIGroups: []string{"cert.gardener.cloud"},
+					Resources: []string{"issuers", "issuers/status"},
+					Verbs:     []string{"get", "list", "update", "watch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"get", "list", "update", "watch", "create"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "patch"},
+				},
+			},
+		}
+		roleBinding = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: c.namespace,
+				Labels:    getDeploymentLabels(),
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccount.Name,
+				Namespace: serviceAccount.Namespace,
+			}},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+		}
+		issuer = &certv1alpha1.Issuer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      DefaultIssuerName,
+				Namespace: c.namespace,
+			},
+			Spec: certv1alpha1.IssuerSpec{
+				ACME: &certv1alpha1.ACMESpec{
+					AutoRegistration:    true,
+					Email:               c.values.ACME.Email,
+					Server:              c.values.ACME.Server,
+					PrecheckNameservers: c.values.ACME.PrecheckNameservers,
+				},
+			},
+		}
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: c.namespace,
+				Labels:    getDeploymentLabels(),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas:             ptr.To(c.values.Replicas),
+				RevisionHistoryLimit: ptr.To[int32](2),
+				Selector:             &metav1.LabelSelector{MatchLabels: getDeploymentLabels()},
+				Strategy:             appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: utils.MergeStringMaps(getDeploymentLabels(), map[string]string{
+							v1beta1constants.LabelNetworkPolicyToDNS:                                                                    v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                                       v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToPublicNetworks:                                                         v1beta1constants.LabelNetworkPolicyAllowed,
+							gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
+						}),
+					},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: serviceAccount.Name,
+						PriorityClassName:  v1beta1constants.PriorityClassNameShootControlPlane100,
+						Containers: []corev1.Container{{
+							Name:            containerName,
+							Image:           c.values.Image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args:            c.computeArgs(),
+							LivenessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path:   "/healthz",
+										Port:   intstr.FromInt32(serverPort),
+										Scheme: "HTTP",
+									},
+								},
+								InitialDelaySeconds: 30,
+								TimeoutSeconds:      5,
+								PeriodSeconds:       10,
+								SuccessThreshold:    1,
+								FailureThreshold:    3,
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("20m"),
+									corev1.ResourceMemory: resource.MustParse("64Mi"),
+								},
+							},
+							Ports: []corev1.ContainerPort{{
+								ContainerPort: serverPort,
+								Protocol:      corev1.ProtocolTCP,
+							}},
+						}},
+					},
+				},
+			},
+		}
+	)
+
+	utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, gardenerutils.SecretNamePrefixShootAccess+resourceName))
+
+	return registry.AddAllAndSerialize(serviceAccount, role, roleBinding, issuer, deployment)
+}
+
+func (c *certificateService) computeArgs() []string {
+	return []string{
+		"--name=cert-controller-manager",
+		"--source=" + gardenerutils.PathGenericKubeconfig,
+		"--target=" + gardenerutils.PathGenericKubeconfig,
+		"--dns-namespace=" + c.namespace,
+		"--use-dnsrecords",
+		"--dnsrecord-provider-type=" + c.values.DNSProviderType,
+		"--dnsrecord-secret-ref=" + c.namespace + "/" + c.values.DNSSecretName,
+		"--issuer.issuer-namespace=" + c.namespace,
+		"--issuer.default-issuer=" + DefaultIssuerName,
+		"--issuer.default-issuer-domain-ranges=" + c.values.Domain,
+		fmt.Sprintf("--server-port-http=%d", serverPort),
+		fmt.Sprintf("--default-rsa-private-key-size=%d", rsaPrivateKeySize),
+	}
+}
+
+func (c *certificateService) newShootAccessSecret() *gardenerutils.AccessSecret {
+	return gardenerutils.NewShootAccessSecret(resourceName, c.namespace)
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certmanagement_test
+
+import (
+	"context"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/certmanagement"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("CertificateService", func() {
+	var (
+		ctx = context.Background()
+
+		namespace = "shoot--foo--bar"
+		image     = "some-image:some-tag"
+
+		c              client.Client
+		values         ShootValues
+		certificateSvc component.DeployWaiter
+
+		consistOf func(...client.Object) types.GomegaMatcher
+		contain   func(...client.Object) types.GomegaMatcher
+
+		shootManagedResource *resourcesv1alpha1.ManagedResource
+		seedManagedResource  *resourcesv1alpha1.ManagedResource
+		shootAccessSecret    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+		contain = NewManagedResourceContainsObjectsMatcher(c)
+
+		values = ShootValues{
+			Image:           image,
+			Replicas:        1,
+			Domain:          "bar.foo.example.com",
+			DNSProviderType: "aws-route53",
+			DNSSecretName:   "dnsrecord-bar-external",
+			ACME: ACMEValues{
+				Email:               "admin@example.com",
+				Server:              "https://acme-v02.api.letsencrypt.org/directory",
+				PrecheckNameservers: []string{"8.8.8.8:53"},
+			},
+		}
+
+		By("Create secrets managed outside of this package for which secretsmanager.Get() will be called")
+		Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "generic-token-kubeconfig", Namespace: namespace}})).To(Succeed())
+
+		shootManagedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-certificate-service", Namespace: namespace}}
+		seedManagedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "certificate-service", Namespace: namespace}}
+		shootAccessSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shoot-access-cert-controller-manager", Namespace: namespace}}
+	})
+
+	JustBeforeEach(func() {
+		certificateSvc = NewForShoot(c, namespace, fakesecretsmanager.New(c, namespace), values)
+	})
+
+	Describe("#Deploy", func() {
+		var (
+			serviceAccount *corev1.ServiceAccount
+			role           *rbacv1.Role
+			roleBinding    *rbacv1.RoleBinding
+			issuer         *certv1alpha1.Issuer
+			deployment     *appsv1.Deployment
+		)
+
+		BeforeEach(func() {
+			labels := map[string]string{
+				"app.kubernetes.io/name":     "cert-management",
+				"app.kubernetes.io/instance": "cert-management",
+			}
+
+			serviceAccount = &corev1.ServiceAccount{
+				ObjectMeta:                   metav1.ObjectMeta{Name: "cert-controller-manager", Namespace: namespace, Labels: labels},
+				AutomountServiceAccountToken: ptr.To(false),
+			}
+			role = &rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-controller-manager", Namespace: namespace, Labels: labels},
+				Rules: []rbacv1.PolicyRule{
+					{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, Verbs: []string{"create"}},
+					{APIGroups: []string{"coordination.k8s.io"}, Resources: []string{"leases"}, ResourceNames: []string{"cert-controller-manager-controllers"}, Verbs: []string{"get", "watch", "update"}},
+					{APIGroups: []string{"extensions.gardener.cloud"}, Resources: []string{"dnsrecords"}, Verbs: []string{"get", "list", "update", "watch", "create", "delete", "patch"}},
+					{APIGroups: []string{"cert.gardener.cloud"}, Resources: []string{"issuers", "issuers/status"}, Verbs: []string{"get", "list", "update", "watch"}},
+					{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list", "update", "watch", "create"}},
+					{APIGroups: []string{""}, Resources: []string{"events"}, Verbs: []string{"create", "patch"}},
+				},
+			}
+			roleBinding = &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-controller-manager", Namespace: namespace, Labels: labels},
+				RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: "cert-controller-manager"},
+				Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "cert-controller-manager", Namespace: namespace}},
+			}
+			issuer = &certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-issuer", Namespace: namespace},
+				Spec: certv1alpha1.IssuerSpec{
+					ACME: &certv1alpha1.ACMESpec{
+						AutoRegistration:    true,
+						Email:               "admin@example.com",
+						Server:              "https://acme-v02.api.letsencrypt.org/directory",
+						PrecheckNameservers: []string{"8.8.8.8:53"},
+					},
+				},
+			}
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-controller-manager", Namespace: namespace, Labels: labels},
+				Spec: appsv1.DeploymentSpec{
+					Replicas:             ptr.To[int32](1),
+					RevisionHistoryLimit: ptr.To[int32](2),
+					Selector:             &metav1.LabelSelector{MatchLabels: labels},
+					Strategy:             appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app.kubernetes.io/name":                                        "cert-management",
+								"app.kubernetes.io/instance":                                    "cert-management",
+								"networking.gardener.cloud/to-dns":                              "allowed",
+								"networking.gardener.cloud/to-runtime-apiserver":                "allowed",
+								"networking.gardener.cloud/to-public-networks":                  "allowed",
+								"networking.resources.gardener.cloud/to-kube-apiserver-tcp-443": "allowed",
+							},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: "cert-controller-manager",
+							PriorityClassName:  "gardener-system-100",
+							Containers: []corev1.Container{{
+								Name:            "cert-management",
+								Image:           image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args: []string{
+									"--name=cert-controller-manager",
+									"--source=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig",
+									"--target=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig",
+									"--dns-namespace=" + namespace,
+									"--use-dnsrecords",
+									"--dnsrecord-provider-type=aws-route53",
+									"--dnsrecord-secret-ref=" + namespace + "/dnsrecord-bar-external",
+									"--issuer.issuer-namespace=" + namespace,
+									"--issuer.default-issuer=default-issuer",
+									"--issuer.default-issuer-domain-ranges=bar.foo.example.com",
+									"--server-port-http=8080",
+									"--default-rsa-private-key-size=3072",
+								},
+								LivenessProbe: &corev1.Probe{
+									ProbeHandler: corev1.ProbeHandler{
+										HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080), Scheme: "HTTP"},
+									},
+									InitialDelaySeconds: 30,
+									TimeoutSeconds:      5,
+									PeriodSeconds:       10,
+									SuccessThreshold:    1,
+									FailureThreshold:    3,
+								},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("20m"),
+										corev1.ResourceMemory: resource.MustParse("64Mi"),
+									},
+								},
+								Ports: []corev1.ContainerPort{{ContainerPort: 8080, Protocol: corev1.ProtocolTCP}},
+							}},
+						},
+					},
+				},
+			}
+			Expect(gardenerutils.InjectGenericKubeconfig(deployment, "generic-token-kubeconfig", "shoot-access-cert-controller-manager")).To(Succeed())
+		})
+
+		It("should deploy the resources to the shoot and to the control plane namespace", func() {
+			Expect(certificateSvc.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shootAccessSecret), shootAccessSecret)).To(Succeed())
+			Expect(shootAccessSecret.Annotations).To(HaveKeyWithValue("serviceaccount.resources.gardener.cloud/name", "cert-controller-manager"))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shootManagedResource), shootManagedResource)).To(Succeed())
+			Expect(shootManagedResource.Spec.Class).To(BeNil())
+			Expect(shootManagedResource.Labels).To(HaveKeyWithValue("origin", "gardener"))
+			Expect(shootManagedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			shootManagedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootManagedResource.Spec.SecretRefs[0].Name, Namespace: namespace}}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shootManagedResourceSecret), shootManagedResourceSecret)).To(Succeed())
+			data, err := test.BrotliDecompression(shootManagedResourceSecret.Data["data.yaml.br"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(ContainSubstring("name: certificates.cert.gardener.cloud"))
+			Expect(data).To(ContainSubstring("name: certificaterevocations.cert.gardener.cloud"))
+			Expect(data).NotTo(ContainSubstring("name: issuers.cert.gardener.cloud"))
+			Expect(data).To(ContainSubstring("name: gardener.cloud:system:certificate-service"))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(seedManagedResource), seedManagedResource)).To(Succeed())
+			Expect(seedManagedResource.Spec.Class).To(Equal(ptr.To("seed")))
+			Expect(seedManagedResource).To(consistOf(serviceAccount, role, roleBinding, issuer, deployment))
+		})
+
+		It("should scale down the deployment if no replicas are requested", func() {
+			values.Replicas = 0
+			certificateSvc = NewForShoot(c, namespace, fakesecretsmanager.New(c, namespace), values)
+
+			Expect(certificateSvc.Deploy(ctx)).To(Succeed())
+
+			deployment.Spec.Replicas = ptr.To[int32](0)
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(seedManagedResource), seedManagedResource)).To(Succeed())
+			Expect(seedManagedResource).To(contain(deployment))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete all resources", func() {
+			Expect(certificateSvc.Deploy(ctx)).To(Succeed())
+			Expect(certificateSvc.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shootManagedResource), shootManagedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(seedManagedResource), seedManagedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shootAccessSecret), shootAccessSecret)).To(BeNotFoundError())
+		})
+
+		It("should succeed if nothing was deployed", func() {
+			Expect(certificateSvc.Destroy(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should fail if the managed resources do not exist", func() {
+			Expect(certificateSvc.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should succeed if the managed resources are healthy", func() {
+			Expect(certificateSvc.Deploy(ctx)).To(Succeed())
+
+			for _, mr := range []*resourcesv1alpha1.ManagedResource{shootManagedResource, seedManagedResource} {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+				mr.Status = healthyManagedResourceStatus(mr.Generation)
+				Expect(c.Update(ctx, mr)).To(Succeed())
+			}
+
+			Expect(certificateSvc.Wait(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#WaitCleanup", func() {
+		It("should succeed if the managed resources are deleted", func() {
+			Expect(certificateSvc.WaitCleanup(ctx)).To(Succeed())
+		})
+	})
+})
+
+func healthyManagedResourceStatus(generation int64) resourcesv1alpha1.ManagedResourceStatus {
+	return resourcesv1alpha1.ManagedResourceStatus{
+		ObservedGeneration: generation,
+		Conditions: []gardencorev1beta1.Condition{
+			{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+			{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+		},
+	}
+}
@@ -92,6 +92,18 @@ func (mr *MockInterfaceMockRecorder) SetTargetStore(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTargetStore", reflect.TypeOf((*MockInterface)(nil).SetTargetStore), arg0)
 }
 
+// SetWaitForFinalSnapshot mocks base method.
+func (m *MockInterface) SetWaitForFinalSnapshot(arg0 *v1alpha1.WaitForFinalSnapshotSpec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWaitForFinalSnapshot", arg0)
+}
+
+// SetWaitForFinalSnapshot indicates an expected call of SetWaitForFinalSnapshot.
+func (mr *MockInterfaceMockRecorder) SetWaitForFinalSnapshot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWaitForFinalSnapshot", reflect.TypeOf((*MockInterface)(nil).SetWaitForFinalSnapshot), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
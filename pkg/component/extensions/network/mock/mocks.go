@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -97,6 +98,18 @@ func (mr *MockInterfaceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockInterface)(nil).Restore), arg0, arg1)
 }
 
+// SetIPFamilies mocks base method.
+func (m *MockInterface) SetIPFamilies(arg0 []v1alpha1.IPFamily) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetIPFamilies", arg0)
+}
+
+// SetIPFamilies indicates an expected call of SetIPFamilies.
+func (mr *MockInterfaceMockRecorder) SetIPFamilies(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIPFamilies", reflect.TypeOf((*MockInterface)(nil).SetIPFamilies), arg0)
+}
+
 // SetPodCIDRs mocks base method.
 func (m *MockInterface) SetPodCIDRs(arg0 []net.IPNet) {
 	m.ctrl.T.Helper()
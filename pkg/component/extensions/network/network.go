@@ -38,6 +38,7 @@ var TimeNow = time.Now
 // Interface is an interface for managing the network CRD deployment.
 type Interface interface {
 	component.DeployMigrateWaiter
+	SetIPFamilies([]extensionsv1alpha1.IPFamily)
 	SetPodCIDRs([]net.IPNet)
 	SetServiceCIDRs([]net.IPNet)
 }
@@ -193,6 +194,10 @@ func (n *network) deploy(ctx context.Context, operation string) (extensionsv1alp
 	return n.network, err
 }
 
+func (n *network) SetIPFamilies(ipFamilies []extensionsv1alpha1.IPFamily) {
+	n.values.IPFamilies = ipFamilies
+}
+
 func (n *network) SetPodCIDRs(pods []net.IPNet) {
 	n.values.PodCIDRs = pods
 }
@@ -16,6 +16,7 @@ import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // MockInterface is a mock of Interface interface.
@@ -159,6 +160,18 @@ func (mr *MockInterfaceMockRecorder) SetCredentialsRotationStatus(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationStatus", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationStatus), arg0)
 }
 
+// SetDualStackMigrationInitiationTime mocks base method.
+func (m *MockInterface) SetDualStackMigrationInitiationTime(arg0 *v1.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDualStackMigrationInitiationTime", arg0)
+}
+
+// SetDualStackMigrationInitiationTime indicates an expected call of SetDualStackMigrationInitiationTime.
+func (mr *MockInterfaceMockRecorder) SetDualStackMigrationInitiationTime(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDualStackMigrationInitiationTime", reflect.TypeOf((*MockInterface)(nil).SetDualStackMigrationInitiationTime), arg0)
+}
+
 // SetSSHPublicKeys mocks base method.
 func (m *MockInterface) SetSSHPublicKeys(arg0 []string) {
 	m.ctrl.T.Helper()
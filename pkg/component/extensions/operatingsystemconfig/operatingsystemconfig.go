@@ -85,6 +85,8 @@ type Interface interface {
 	SetCABundle(*string)
 	// SetCredentialsRotationStatus sets the credentials rotation status
 	SetCredentialsRotationStatus(*gardencorev1beta1.ShootCredentialsRotation)
+	// SetDualStackMigrationInitiationTime sets the initiation time of the migration to dual-stack networking.
+	SetDualStackMigrationInitiationTime(*metav1.Time)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
 	// WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
@@ -104,6 +106,8 @@ type Values struct {
 	Workers []gardencorev1beta1.Worker
 	// CredentialsRotationStatus
 	CredentialsRotationStatus *gardencorev1beta1.ShootCredentialsRotation
+	// DualStackMigrationInitiationTime is the time when the migration to dual-stack networking was initiated.
+	DualStackMigrationInitiationTime *metav1.Time
 
 	// InitValues are configuration values required for the 'provision' OperatingSystemConfigPurpose.
 	InitValues
@@ -647,6 +651,10 @@ func (o *operatingSystemConfig) SetCredentialsRotationStatus(status *gardencorev
 	o.values.CredentialsRotationStatus = status
 }
 
+func (o *operatingSystemConfig) SetDualStackMigrationInitiationTime(time *metav1.Time) {
+	o.values.DualStackMigrationInitiationTime = time
+}
+
 // SetSSHPublicKeys sets the SSHPublicKeys value.
 func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
@@ -910,6 +918,14 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			}
 		}
 
+		d.osc.Spec.InPlaceUpdates = nil
+		if v1beta1helper.IsUpdateStrategyInPlace(&d.worker) && d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			d.osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: ptr.Deref(d.worker.Machine.Image.Version, ""),
+				KubeletVersion:         d.kubernetesVersion.String(),
+			}
+		}
+
 		return nil
 	})
 	return d.osc, err
@@ -943,7 +959,7 @@ func calculateKeyForVersion(
 		// TODO(MichaelEischer): Remove KeyV1 after support for Kubernetes 1.30 is dropped
 		return KeyV1(worker.Name, kubernetesVersion, worker.CRI), nil
 	case 2:
-		return KeyV2(kubernetesVersion, values.CredentialsRotationStatus, worker, values.NodeLocalDNSEnabled, kubeletConfiguration), nil
+		return KeyV2(kubernetesVersion, values.CredentialsRotationStatus, values.DualStackMigrationInitiationTime, worker, values.NodeLocalDNSEnabled, kubeletConfiguration), nil
 	default:
 		return "", fmt.Errorf("unsupported osc key hash version %v", version)
 	}
@@ -969,11 +985,12 @@ func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *
 }
 
 // KeyV2 returns the key that can be used as secret name based on the provided worker name,
-// Kubernetes version, machine type, image, worker volume, CRI, credentials rotation, node local dns
-// and kubelet configuration.
+// Kubernetes version, machine type, image, worker volume, CRI, credentials rotation, dual-stack migration, node local
+// dns and kubelet configuration.
 func KeyV2(
 	kubernetesVersion *semver.Version,
 	credentialsRotation *gardencorev1beta1.ShootCredentialsRotation,
+	dualStackMigrationInitiationTime *metav1.Time,
 	worker *gardencorev1beta1.Worker,
 	nodeLocalDNSEnabled bool,
 	kubeletConfiguration *gardencorev1beta1.KubeletConfig,
@@ -982,12 +999,24 @@ func KeyV2(
 		return ""
 	}
 
-	kubernetesMajorMinorVersion := fmt.Sprintf("%d.%d", kubernetesVersion.Major(), kubernetesVersion.Minor())
+	// For worker pools with the in-place update strategy, the Kubernetes and machine image versions as well as the
+	// kubelet configuration are applied to the existing nodes. Hence, they must not be part of the key, otherwise
+	// changing them would cause the machines to be rolled.
+	inPlaceUpdate := v1beta1helper.IsUpdateStrategyInPlace(worker)
 
-	data := []string{
-		kubernetesMajorMinorVersion,
-		worker.Machine.Type,
-		worker.Machine.Image.Name + *worker.Machine.Image.Version,
+	var data []string
+	if inPlaceUpdate {
+		data = []string{
+			worker.Machine.Type,
+			worker.Machine.Image.Name,
+		}
+	} else {
+		kubernetesMajorMinorVersion := fmt.Sprintf("%d.%d", kubernetesVersion.Major(), kubernetesVersion.Minor())
+		data = []string{
+			kubernetesMajorMinorVersion,
+			worker.Machine.Type,
+			worker.Machine.Image.Name + *worker.Machine.Image.Version,
+		}
 	}
 
 	if worker.Volume != nil {
@@ -1010,11 +1039,15 @@ func KeyV2(
 		}
 	}
 
+	if dualStackMigrationInitiationTime != nil {
+		data = append(data, dualStackMigrationInitiationTime.Time.String())
+	}
+
 	if nodeLocalDNSEnabled {
 		data = append(data, "node-local-dns")
 	}
 
-	if kubeletConfiguration != nil {
+	if kubeletConfiguration != nil && !inPlaceUpdate {
 		if kubeReserved := kubeletConfiguration.KubeReserved; kubeReserved != nil {
 			data = append(data, fmt.Sprintf("%s-%s-%s-%s", kubeReserved.CPU, kubeReserved.Memory, kubeReserved.PID, kubeReserved.EphemeralStorage))
 		}
@@ -590,6 +590,36 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should set the in-place updates for worker pools with the in-place update strategy", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[0].DeepCopy(), *workers[1].DeepCopy()}
+				values.Workers[1].UpdateStrategy = ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace)
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				for _, e := range expected {
+					actual := &extensionsv1alpha1.OperatingSystemConfig{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: e.Name, Namespace: e.Namespace}, actual)).To(Succeed())
+
+					if e.Labels["worker.gardener.cloud/pool"] == workers[1].Name && e.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+						Expect(actual.Spec.InPlaceUpdates).To(Equal(&extensionsv1alpha1.InPlaceUpdates{
+							OperatingSystemVersion: "12.34",
+							KubeletVersion:         workerKubernetesVersion,
+						}))
+					} else {
+						Expect(actual.Spec.InPlaceUpdates).To(BeNil())
+					}
+				}
+			})
+
 			It("should exclude the bootstrap token file if purpose is not provision", func() {
 				bootstrapTokenFile := extensionsv1alpha1.File{Path: "/var/lib/gardener-node-agent/credentials/bootstrap-token"}
 				initConfigFnWithBootstrapToken := func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -1395,6 +1425,10 @@ var _ = Describe("OperatingSystemConfig", func() {
 				values.CredentialsRotationStatus.ServiceAccountKey = credentialStatusWithInitiatedRotation
 			})
 
+			It("when a migration to dual-stack networking is triggered", func() {
+				values.DualStackMigrationInitiationTime = &metav1.Time{Time: lastCARotationInitiation.Add(time.Hour)}
+			})
+
 			It("when enabling node local dns via specification", func() {
 				values.NodeLocalDNSEnabled = true
 			})
@@ -1439,5 +1473,60 @@ var _ = Describe("OperatingSystemConfig", func() {
 				kubeletConfig.CPUManagerPolicy = ptr.To("test")
 			})
 		})
+
+		Context("in-place update strategy", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace)
+
+				var err error
+				hash, err = CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("hash value should not change", func() {
+				AfterEach(func() {
+					actual, err := CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(actual).To(Equal(hash))
+				})
+
+				It("when changing machine image version", func() {
+					p.Machine.Image.Version = ptr.To("new-version")
+				})
+
+				It("when changing the kubernetes major/minor version of the worker pool version", func() {
+					kubernetesVersion = semver.MustParse("1.3.3")
+				})
+
+				It("when changing kubeReserved CPU", func() {
+					kubeletConfig.KubeReserved.CPU = ptr.To(resource.MustParse("100m"))
+				})
+
+				It("when changing evictionHard memory threshold", func() {
+					kubeletConfig.EvictionHard.MemoryAvailable = ptr.To("200Mi")
+				})
+			})
+
+			Context("hash value should change", func() {
+				AfterEach(func() {
+					actual, err := CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(actual).NotTo(Equal(hash))
+				})
+
+				It("when changing machine type", func() {
+					p.Machine.Type = "small"
+				})
+
+				It("when changing machine image name", func() {
+					p.Machine.Image.Name = "new-image"
+				})
+
+				It("when a shoot CA rotation is triggered", func() {
+					newRotationTime := metav1.Time{Time: lastCARotationInitiation.Add(time.Hour)}
+					values.CredentialsRotationStatus.CertificateAuthorities.LastInitiationTime = &newRotationTime
+				})
+			})
+		})
 	})
 })
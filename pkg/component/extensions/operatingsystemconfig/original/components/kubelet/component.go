@@ -88,6 +88,21 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		},
 	}
 
+	if len(ctx.KubeletCLIFlags.CredentialProviders) > 0 {
+		fileContentCredentialProviderConfig, err := getFileContentCredentialProviderConfig(ctx.KubernetesVersion, ctx.KubeletCLIFlags.CredentialProviders)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		kubeletFiles = append(kubeletFiles, extensionsv1alpha1.File{
+			Path:        PathCredentialProviderConfig,
+			Permissions: ptr.To[int32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: fileContentCredentialProviderConfig,
+			},
+		})
+	}
+
 	cliFlags := CLIFlags(ctx.KubernetesVersion, ctx.NodeLabels, ctx.CRIName, ctx.KubeletCLIFlags, ctx.PreferIPv6)
 
 	kubeletUnit := extensionsv1alpha1.Unit{
@@ -6,12 +6,15 @@ package kubelet_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
@@ -88,6 +91,96 @@ var _ = Describe("Component", func() {
 			true,
 		),
 	)
+
+	DescribeTable("#Config with credential providers",
+		func(kubernetesVersion, credentialProviderConfig string) {
+			ctx.CRIName = extensionsv1alpha1.CRINameContainerD
+			ctx.KubernetesVersion = semver.MustParse(kubernetesVersion)
+			ctx.KubeletCABundle = kubeletCABundle
+			ctx.Images = map[string]*imagevector.Image{
+				"hyperkube": {
+					Name:       "pause-container",
+					Repository: ptr.To(hyperkubeImageRepo),
+					Tag:        ptr.To(hyperkubeImageTag),
+				},
+			}
+			ctx.KubeletCLIFlags = components.ConfigurableKubeletCLIFlags{
+				CredentialProviders: []gardencorev1beta1.KubeletCredentialProvider{
+					{
+						Name:                 "ecr-credential-provider",
+						MatchImages:          []string{"*.dkr.ecr.*.amazonaws.com"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 12 * time.Hour},
+						Args:                 []string{"get-credentials"},
+						Env:                  []gardencorev1beta1.KubeletCredentialProviderEnvVar{{Name: "AWS_PROFILE", Value: "foo"}},
+					},
+					{
+						Name:                 "acr-credential-provider",
+						MatchImages:          []string{"*.azurecr.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+						APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v1beta1"),
+					},
+				},
+			}
+
+			units, files, err := component.Config(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(units).To(HaveLen(1))
+			Expect(units[0].FilePaths).To(ContainElement("/var/lib/kubelet/credential-provider-config.yaml"))
+			Expect(*units[0].Content).To(ContainSubstring("--image-credential-provider-config=/var/lib/kubelet/credential-provider-config.yaml"))
+			Expect(*units[0].Content).To(ContainSubstring("--image-credential-provider-bin-dir=/opt/bin/credential-providers"))
+
+			Expect(files).To(ContainElement(extensionsv1alpha1.File{
+				Path:        "/var/lib/kubelet/credential-provider-config.yaml",
+				Permissions: ptr.To[int32](0644),
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Encoding: "b64",
+						Data:     utils.EncodeBase64([]byte(credentialProviderConfig)),
+					},
+				},
+			}))
+		},
+
+		Entry("kubernetes 1.25", "1.25.1", `apiVersion: kubelet.config.k8s.io/v1beta1
+kind: CredentialProviderConfig
+providers:
+- apiVersion: credentialprovider.kubelet.k8s.io/v1beta1
+  args:
+  - get-credentials
+  defaultCacheDuration: 12h0m0s
+  env:
+  - name: AWS_PROFILE
+    value: foo
+  matchImages:
+  - '*.dkr.ecr.*.amazonaws.com'
+  name: ecr-credential-provider
+- apiVersion: credentialprovider.kubelet.k8s.io/v1beta1
+  defaultCacheDuration: 10m0s
+  matchImages:
+  - '*.azurecr.io'
+  name: acr-credential-provider
+`),
+		Entry("kubernetes 1.26", "1.26.1", `apiVersion: kubelet.config.k8s.io/v1
+kind: CredentialProviderConfig
+providers:
+- apiVersion: credentialprovider.kubelet.k8s.io/v1
+  args:
+  - get-credentials
+  defaultCacheDuration: 12h0m0s
+  env:
+  - name: AWS_PROFILE
+    value: foo
+  matchImages:
+  - '*.dkr.ecr.*.amazonaws.com'
+  name: ecr-credential-provider
+- apiVersion: credentialprovider.kubelet.k8s.io/v1beta1
+  defaultCacheDuration: 10m0s
+  matchImages:
+  - '*.azurecr.io'
+  name: acr-credential-provider
+`),
+	)
 })
 
 const (
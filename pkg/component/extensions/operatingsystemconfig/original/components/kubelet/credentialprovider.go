@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfigv1 "k8s.io/kubelet/config/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+const (
+	// PathCredentialProviderConfig is the path for the kubelet's image credential provider config file.
+	PathCredentialProviderConfig = PathKubeletDirectory + "/credential-provider-config.yaml"
+	// PathCredentialProviderBinDirectory is the path of the directory containing the image credential provider plugin
+	// binaries.
+	PathCredentialProviderBinDirectory = "/opt/bin/credential-providers"
+)
+
+// CredentialProviderConfig returns the CredentialProviderConfig for the given credential providers and Kubernetes
+// version.
+func CredentialProviderConfig(kubernetesVersion *semver.Version, credentialProviders []gardencorev1beta1.KubeletCredentialProvider) *kubeletconfigv1.CredentialProviderConfig {
+	var (
+		configAPIVersion   = kubeletconfigv1.SchemeGroupVersion.String()
+		providerAPIVersion = "credentialprovider.kubelet.k8s.io/v1"
+	)
+
+	if versionutils.ConstraintK8sLess126.Check(kubernetesVersion) {
+		configAPIVersion = "kubelet.config.k8s.io/v1beta1"
+		providerAPIVersion = "credentialprovider.kubelet.k8s.io/v1beta1"
+	}
+
+	config := &kubeletconfigv1.CredentialProviderConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: configAPIVersion,
+			Kind:       "CredentialProviderConfig",
+		},
+	}
+
+	for _, credentialProvider := range credentialProviders {
+		provider := kubeletconfigv1.CredentialProvider{
+			Name:                 credentialProvider.Name,
+			MatchImages:          credentialProvider.MatchImages,
+			DefaultCacheDuration: credentialProvider.DefaultCacheDuration,
+			APIVersion:           ptr.Deref(credentialProvider.APIVersion, providerAPIVersion),
+			Args:                 credentialProvider.Args,
+		}
+
+		for _, env := range credentialProvider.Env {
+			provider.Env = append(provider.Env, kubeletconfigv1.ExecEnvVar{Name: env.Name, Value: env.Value})
+		}
+
+		config.Providers = append(config.Providers, provider)
+	}
+
+	return config
+}
+
+func getFileContentCredentialProviderConfig(kubernetesVersion *semver.Version, credentialProviders []gardencorev1beta1.KubeletCredentialProvider) (*extensionsv1alpha1.FileContentInline, error) {
+	data, err := yaml.Marshal(CredentialProviderConfig(kubernetesVersion, credentialProviders))
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling credential provider config: %w", err)
+	}
+
+	return &extensionsv1alpha1.FileContentInline{
+		Encoding: "b64",
+		Data:     utils.EncodeBase64(data),
+	}, nil
+}
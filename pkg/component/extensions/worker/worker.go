@@ -118,6 +118,7 @@ type worker struct {
 	worker                           *extensionsv1alpha1.Worker
 	machineDeployments               []extensionsv1alpha1.MachineDeployment
 	machineDeploymentsLastUpdateTime *metav1.Time
+	canaryRolloutDuration            time.Duration
 }
 
 // Deploy uses the seed client to create or update the Worker resource.
@@ -129,6 +130,8 @@ func (w *worker) Deploy(ctx context.Context) error {
 func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alpha1.Object, error) {
 	var pools []extensionsv1alpha1.WorkerPool
 
+	w.canaryRolloutDuration = 0
+
 	obj := &extensionsv1alpha1.Worker{}
 	if err := w.client.Get(ctx, client.ObjectKey{Name: w.worker.Name, Namespace: w.worker.Namespace}, obj); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -188,6 +191,10 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			workerPoolKubernetesVersion = *workerPool.Kubernetes.Version
 		}
 
+		if workerPool.RollingUpdate != nil {
+			w.canaryRolloutDuration = max(w.canaryRolloutDuration, canaryRolloutDuration(obj, workerPool.Name, workerPoolKubernetesVersion, workerPool.RollingUpdate))
+		}
+
 		nodeTemplate, machineType := w.findNodeTemplateAndMachineTypeByPoolName(obj, workerPool.Name)
 
 		if nodeTemplate == nil || machineType != workerPool.Machine.Type {
@@ -258,6 +265,8 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
 			ClusterAutoscaler:                autoscalerOptions,
+			UpdateStrategy:                   workerPool.UpdateStrategy,
+			RollingUpdate:                    workerPool.RollingUpdate,
 		})
 	}
 
@@ -322,7 +331,8 @@ func (w *worker) Destroy(ctx context.Context) error {
 	)
 }
 
-// Wait waits until the Worker resource is ready.
+// Wait waits until the Worker resource is ready. If worker pools are rolled out progressively, the timeout is extended
+// by the soak periods of their canary steps.
 func (w *worker) Wait(ctx context.Context) error {
 	return extensions.WaitUntilExtensionObjectReady(
 		ctx,
@@ -332,7 +342,7 @@ func (w *worker) Wait(ctx context.Context) error {
 		extensionsv1alpha1.WorkerResource,
 		w.waitInterval,
 		w.waitSevereThreshold,
-		w.waitTimeout,
+		w.waitTimeout+w.canaryRolloutDuration,
 		nil,
 	)
 }
@@ -410,6 +420,32 @@ func (w *worker) findNodeTemplateAndMachineTypeByPoolName(obj *extensionsv1alpha
 	return nil, ""
 }
 
+// canaryRolloutDuration returns the time the canary steps of the given worker pool are expected to soak in case its
+// Kubernetes minor version is upgraded, i.e., in case the machines are rolled out progressively.
+func canaryRolloutDuration(obj *extensionsv1alpha1.Worker, poolName, kubernetesVersion string, rollingUpdate *gardencorev1beta1.WorkerRollingUpdate) time.Duration {
+	for _, pool := range obj.Spec.Pools {
+		if pool.Name != poolName || pool.KubernetesVersion == nil {
+			continue
+		}
+
+		oldVersion, err := semver.NewVersion(*pool.KubernetesVersion)
+		if err != nil {
+			return 0
+		}
+		newVersion, err := semver.NewVersion(kubernetesVersion)
+		if err != nil {
+			return 0
+		}
+
+		if newVersion.Major() == oldVersion.Major() && newVersion.Minor() == oldVersion.Minor() {
+			return 0
+		}
+		return time.Duration(len(rollingUpdate.CanarySteps)) * ptr.Deref(rollingUpdate.SoakPeriod, metav1.Duration{}).Duration
+	}
+
+	return 0
+}
+
 // checkWorkerStatusMachineDeploymentsUpdated checks if the status of the worker is updated or not during its reconciliation.
 // It is updated if
 // * The status.MachineDeploymentsLastUpdateTime > the value of the time stamp stored in worker struct before the reconciliation begins.
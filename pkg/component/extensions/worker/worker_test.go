@@ -240,6 +240,7 @@ var _ = Describe("Worker", func() {
 						Version: &workerKubernetesVersion,
 					},
 					ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{},
+					UpdateStrategy:    ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace),
 				},
 			},
 		}
@@ -342,6 +343,7 @@ var _ = Describe("Worker", func() {
 					NodeTemplate:      workerPool2NodeTemplate,
 					Architecture:      worker2Arch,
 					ClusterAutoscaler: emptyAutoscalerOptions,
+					UpdateStrategy:    ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace),
 				},
 			},
 		}
@@ -522,6 +524,30 @@ var _ = Describe("Worker", func() {
 				Spec: *expectedWorkerSpec,
 			}))
 		})
+
+		It("should successfully deploy the Worker resource with the rolling update configuration when present", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			rollingUpdate := &gardencorev1beta1.WorkerRollingUpdate{
+				MaxSurge:    ptr.To(intstr.FromInt32(1)),
+				CanarySteps: []intstr.IntOrString{intstr.FromInt32(1), intstr.FromString("50%")},
+				SoakPeriod:  &metav1.Duration{Duration: time.Minute},
+			}
+
+			newValues := *values
+			newValues.Workers[0].RollingUpdate = rollingUpdate
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools[0].RollingUpdate = rollingUpdate
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec).To(DeepEqual(*expectedWorkerSpec))
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -591,6 +617,50 @@ var _ = Describe("Worker", func() {
 			By("Wait")
 			Expect(defaultDepWaiter.Wait(ctx)).To(Succeed(), "worker is ready")
 		})
+
+		It("should extend the timeout by the soak periods if a worker pool is rolled out progressively", func() {
+			defer test.WithVars(
+				&worker.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			existingWorker := w.DeepCopy()
+			existingWorker.Spec.Pools = []extensionsv1alpha1.WorkerPool{*wSpec.Pools[0].DeepCopy()}
+			existingWorker.Spec.Pools[0].KubernetesVersion = ptr.To("1.24.8")
+			Expect(c.Create(ctx, existingWorker)).To(Succeed())
+
+			newValues := *values
+			newValues.Workers[0].RollingUpdate = &gardencorev1beta1.WorkerRollingUpdate{
+				CanarySteps: []intstr.IntOrString{intstr.FromInt32(1), intstr.FromString("50%")},
+				SoakPeriod:  &metav1.Duration{Duration: time.Second},
+			}
+
+			By("Deploy")
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			By("Patch object after the default timeout")
+			go func() {
+				defer GinkgoRecover()
+
+				time.Sleep(time.Second)
+
+				obj := &extensionsv1alpha1.Worker{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(w), obj)).To(Succeed())
+				patch := client.MergeFrom(obj.DeepCopy())
+				obj.ObjectMeta.Annotations = map[string]string{
+					v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+				}
+				obj.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
+				}
+				Expect(c.Patch(ctx, obj, patch)).To(Succeed())
+			}()
+
+			By("Wait")
+			Expect(defaultDepWaiter.Wait(ctx)).To(Succeed(), "worker is ready")
+		})
 	})
 
 	Describe("#WaitUntilWorkerStatusMachineDeploymentsUpdated", func() {
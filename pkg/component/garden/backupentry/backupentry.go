@@ -84,6 +84,9 @@ type Interface interface {
 	GetActualBucketName() string
 	// SetBucketName sets the name of the BackupBucket for this BackupEntry.
 	SetBucketName(string)
+	// SwitchBucket updates the BackupEntry so that it points to the BackupBucket configured in the values, even if it
+	// already exists with a different `.spec.bucketName`.
+	SwitchBucket(context.Context) error
 	// SetForceDeletionAnnotation sets the `backupentry.core.gardener.cloud/force-deletion` annotation
 	// on the BackupEntry.
 	SetForceDeletionAnnotation(context.Context) error
@@ -116,6 +119,20 @@ func (b *backupEntry) Deploy(ctx context.Context) error {
 	return b.reconcile(ctx, b.backupEntry, seedName, bucketName, v1beta1constants.GardenerOperationReconcile)
 }
 
+// SwitchBucket uses the garden client to create or update the BackupEntry resource in the project namespace in the
+// Garden. In contrast to Deploy, the `.spec.bucketName` is always set to the BucketName in the values.
+func (b *backupEntry) SwitchBucket(ctx context.Context) error {
+	seedName := b.values.SeedName
+
+	if err := b.client.Get(ctx, client.ObjectKeyFromObject(b.backupEntry), b.backupEntry); err == nil {
+		seedName = b.backupEntry.Spec.SeedName
+	} else if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return b.reconcile(ctx, b.backupEntry, seedName, b.values.BucketName, v1beta1constants.GardenerOperationReconcile)
+}
+
 // Wait waits until the BackupEntry resource is ready.
 func (b *backupEntry) Wait(ctx context.Context) error {
 	return extensions.WaitUntilObjectReadyWithHealthFunction(
@@ -138,6 +138,47 @@ var _ = Describe("BackupEntry", func() {
 		})
 	})
 
+	Describe("#SwitchBucket", func() {
+		BeforeEach(func() {
+			expected.ResourceVersion = "1"
+		})
+
+		It("should create correct BackupEntry (newly created)", func() {
+			defer test.WithVars(&TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			Expect(defaultDepWaiter.SwitchBucket(ctx)).To(Succeed())
+
+			actual := &gardencorev1beta1.BackupEntry{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, actual)).To(Succeed())
+			expected.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+
+			Expect(actual).To(DeepEqual(expected))
+		})
+
+		It("should change the BucketName but keep the SeedName of the BackupEntry", func() {
+			defer test.WithVars(&TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			existing := expected.DeepCopy()
+			existing.ResourceVersion = ""
+			existing.Spec.BucketName = differentBucketName
+			existing.Spec.SeedName = &differentSeedName
+			Expect(c.Create(ctx, existing)).To(Succeed(), "creating BackupEntry succeeds")
+
+			Expect(defaultDepWaiter.SwitchBucket(ctx)).To(Succeed())
+
+			actual := &gardencorev1beta1.BackupEntry{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, actual)).To(Succeed())
+
+			expected.Spec.SeedName = &differentSeedName
+			expected.ResourceVersion = "2"
+			expected.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+
+			Expect(actual).To(DeepEqual(expected))
+		})
+	})
+
 	Describe("#Wait", func() {
 		It("should return error when it's not found", func() {
 			Expect(defaultDepWaiter.Wait(ctx)).To(HaveOccurred())
@@ -151,6 +151,20 @@ func (mr *MockInterfaceMockRecorder) SetForceDeletionAnnotation(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetForceDeletionAnnotation", reflect.TypeOf((*MockInterface)(nil).SetForceDeletionAnnotation), arg0)
 }
 
+// SwitchBucket mocks base method.
+func (m *MockInterface) SwitchBucket(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwitchBucket", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwitchBucket indicates an expected call of SwitchBucket.
+func (mr *MockInterfaceMockRecorder) SwitchBucket(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchBucket", reflect.TypeOf((*MockInterface)(nil).SwitchBucket), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
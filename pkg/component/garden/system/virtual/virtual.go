@@ -301,15 +301,23 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:read-global-resources",
 			},
-			Rules: []rbacv1.PolicyRule{{
-				APIGroups: []string{gardencorev1beta1.GroupName},
-				Resources: []string{
-					"cloudprofiles",
-					"exposureclasses",
-					"seeds",
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{
+						"cloudprofiles",
+						"clusterauditpolicies",
+						"exposureclasses",
+						"seeds",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
-				Verbs: []string{"get", "list", "watch"},
-			}},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"gardencapabilities"},
+					Verbs:     []string{"get"},
+				},
+			},
 		}
 		clusterRoleBindingReadGlobalResources = &rbacv1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
@@ -431,6 +439,16 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/maintenance-preview"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots"},
+					Verbs:     []string{"force-delete", "rotate-credentials"},
+				},
 			},
 		}
 		clusterRoleProjectMemberAggregated = &rbacv1.ClusterRole{
@@ -528,6 +546,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/maintenance-preview"},
+					Verbs:     []string{"get"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
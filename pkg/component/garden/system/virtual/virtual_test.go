@@ -321,15 +321,23 @@ var _ = Describe("Virtual", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: "gardener.cloud:system:read-global-resources",
 			},
-			Rules: []rbacv1.PolicyRule{{
-				APIGroups: []string{"core.gardener.cloud"},
-				Resources: []string{
-					"cloudprofiles",
-					"exposureclasses",
-					"seeds",
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{
+						"cloudprofiles",
+						"clusterauditpolicies",
+						"exposureclasses",
+						"seeds",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
-				Verbs: []string{"get", "list", "watch"},
-			}},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"gardencapabilities"},
+					Verbs:     []string{"get"},
+				},
+			},
 		}
 		clusterRoleBindingReadGlobalResources = &rbacv1.ClusterRoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
@@ -451,6 +459,16 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/maintenance-preview"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots"},
+					Verbs:     []string{"force-delete", "rotate-credentials"},
+				},
 			},
 		}
 		clusterRoleProjectMemberAggregated = &rbacv1.ClusterRole{
@@ -546,6 +564,11 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"shoots/viewerkubeconfig"},
 					Verbs:     []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/maintenance-preview"},
+					Verbs:     []string{"get"},
+				},
 			},
 		}
 		clusterRoleProjectViewerAggregated = &rbacv1.ClusterRole{
@@ -50,6 +50,8 @@ type Interface interface {
 	apiserver.Interface
 	// GetValues returns the current configuration values of the deployer.
 	GetValues() Values
+	// SetDebugMode sets the DebugMode field in the Values of the deployer.
+	SetDebugMode(bool)
 	// SetExternalHostname sets the ExternalHostname field in the Values of the deployer.
 	SetExternalHostname(string)
 	// SetExternalServer sets the ExternalServer field in the Values of the deployer.
@@ -534,6 +536,10 @@ func (k *kubeAPIServer) SetETCDEncryptionConfig(config apiserver.ETCDEncryptionC
 	k.values.ETCDEncryption = config
 }
 
+func (k *kubeAPIServer) SetDebugMode(enabled bool) {
+	k.values.DebugMode = enabled
+}
+
 func (k *kubeAPIServer) SetExternalHostname(hostname string) {
 	k.values.ExternalHostname = hostname
 }
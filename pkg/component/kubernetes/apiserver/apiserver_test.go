@@ -3401,6 +3401,21 @@ rules:
 					))
 				})
 
+				It("should configure the default watch cache size without resource-specific sizes", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion:  runtimeVersion,
+							WatchCacheSizes: &gardencorev1beta1.WatchCacheSizes{Default: ptr.To[int32](123)},
+						},
+						Images:  images,
+						Version: version,
+					})
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--default-watch-cache-size=123"))
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(ContainSubstring("--watch-cache-sizes=")))
+				})
+
 				It("should not configure the watch cache settings if not provided", func() {
 					deployAndRead()
 
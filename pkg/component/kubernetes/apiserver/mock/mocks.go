@@ -123,6 +123,18 @@ func (mr *MockInterfaceMockRecorder) SetAutoscalingReplicas(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoscalingReplicas", reflect.TypeOf((*MockInterface)(nil).SetAutoscalingReplicas), arg0)
 }
 
+// SetDebugMode mocks base method.
+func (m *MockInterface) SetDebugMode(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDebugMode", arg0)
+}
+
+// SetDebugMode indicates an expected call of SetDebugMode.
+func (mr *MockInterfaceMockRecorder) SetDebugMode(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDebugMode", reflect.TypeOf((*MockInterface)(nil).SetDebugMode), arg0)
+}
+
 // SetETCDEncryptionConfig mocks base method.
 func (m *MockInterface) SetETCDEncryptionConfig(arg0 apiserver.ETCDEncryptionConfig) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	istionetworkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -35,6 +36,10 @@ var (
 	//go:embed templates/envoyfilter.yaml
 	envoyFilterSpecTemplateContent string
 	envoyFilterSpecTemplate        *template.Template
+
+	//go:embed templates/envoyfilter-limits.yaml
+	envoyFilterLimitsSpecTemplateContent string
+	envoyFilterLimitsSpecTemplate        *template.Template
 )
 
 func init() {
@@ -43,6 +48,11 @@ func init() {
 		Funcs(sprig.TxtFuncMap()).
 		Parse(envoyFilterSpecTemplateContent),
 	)
+	envoyFilterLimitsSpecTemplate = template.Must(template.
+		New("envoy-filter-limits-spec").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(envoyFilterLimitsSpecTemplateContent),
+	)
 }
 
 // SNIValues configure the kube-apiserver service SNI.
@@ -50,6 +60,7 @@ type SNIValues struct {
 	Hosts               []string
 	APIServerProxy      *APIServerProxy
 	IstioIngressGateway IstioIngressGateway
+	Limits              *Limits
 }
 
 // APIServerProxy contains values for the APIServer proxy protocol configuration.
@@ -64,6 +75,16 @@ type IstioIngressGateway struct {
 	Labels    map[string]string
 }
 
+// Limits contains the values for limiting the traffic to the kube-apiserver through the istio ingress gateway.
+type Limits struct {
+	// MaxConnections is the maximum number of concurrent connections to the kube-apiserver.
+	MaxConnections *int32
+	// ConnectionsPerSecond is the maximum number of new connections per second to the kube-apiserver.
+	ConnectionsPerSecond *int32
+	// ConnectionBufferLimitBytes is the maximum number of bytes buffered for each connection to the kube-apiserver.
+	ConnectionBufferLimitBytes *int64
+}
+
 // NewSNI creates a new instance of DeployWaiter which deploys Istio resources for
 // kube-apiserver SNI access.
 func NewSNI(
@@ -99,6 +120,19 @@ type envoyFilterTemplateValues struct {
 	Host                        string
 	Port                        int
 	APIServerClusterIPPrefixLen int
+	ConnectionsPerSecond        int32
+}
+
+type envoyFilterLimitsTemplateValues struct {
+	IngressGatewayLabels       map[string]string
+	Name                       string
+	Namespace                  string
+	ShootNamespace             string
+	Hosts                      []string
+	Host                       string
+	Port                       int
+	ConnectionsPerSecond       int32
+	ConnectionBufferLimitBytes int64
 }
 
 func (s *sni) Deploy(ctx context.Context) error {
@@ -109,12 +143,19 @@ func (s *sni) Deploy(ctx context.Context) error {
 		gateway         = s.emptyGateway()
 		virtualService  = s.emptyVirtualService()
 
-		hostName        = fmt.Sprintf("%s.%s.svc.%s", s.name, s.namespace, gardencorev1beta1.DefaultDomain)
-		envoyFilterSpec bytes.Buffer
+		hostName = fmt.Sprintf("%s.%s.svc.%s", s.name, s.namespace, gardencorev1beta1.DefaultDomain)
+		limits   = ptr.Deref(values.Limits, Limits{})
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
+		limitsEnvoyFilterRequired = limits.ConnectionsPerSecond != nil || limits.ConnectionBufferLimitBytes != nil
 	)
 
 	if values.APIServerProxy != nil {
-		envoyFilter := s.emptyEnvoyFilter()
+		var (
+			envoyFilter     = s.emptyEnvoyFilter()
+			envoyFilterSpec bytes.Buffer
+		)
+
 		apiServerClusterIPPrefixLen, err := netutils.GetBitLen(values.APIServerProxy.APIServerClusterIP)
 		if err != nil {
 			return err
@@ -128,14 +169,38 @@ func (s *sni) Deploy(ctx context.Context) error {
 			Host:                        hostName,
 			Port:                        kubeapiserverconstants.Port,
 			APIServerClusterIPPrefixLen: apiServerClusterIPPrefixLen,
+			ConnectionsPerSecond:        ptr.Deref(limits.ConnectionsPerSecond, 0),
+		}); err != nil {
+			return err
+		}
+
+		registry.AddSerialized(fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name), envoyFilterSpec.Bytes())
+	}
+
+	if limitsEnvoyFilterRequired {
+		var (
+			envoyFilter     = s.emptyLimitsEnvoyFilter()
+			envoyFilterSpec bytes.Buffer
+		)
+
+		if err := envoyFilterLimitsSpecTemplate.Execute(&envoyFilterSpec, envoyFilterLimitsTemplateValues{
+			IngressGatewayLabels:       values.IstioIngressGateway.Labels,
+			Name:                       envoyFilter.Name,
+			Namespace:                  envoyFilter.Namespace,
+			ShootNamespace:             s.namespace,
+			Hosts:                      values.Hosts,
+			Host:                       hostName,
+			Port:                       kubeapiserverconstants.Port,
+			ConnectionsPerSecond:       ptr.Deref(limits.ConnectionsPerSecond, 0),
+			ConnectionBufferLimitBytes: ptr.Deref(limits.ConnectionBufferLimitBytes, 0),
 		}); err != nil {
 			return err
 		}
 
-		filename := fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name)
-		registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
-		registry.AddSerialized(filename, envoyFilterSpec.Bytes())
+		registry.AddSerialized(fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name), envoyFilterSpec.Bytes())
+	}
 
+	if values.APIServerProxy != nil || limitsEnvoyFilterRequired {
 		serializedObjects, err := registry.SerializedObjects()
 		if err != nil {
 			return err
@@ -144,9 +209,19 @@ func (s *sni) Deploy(ctx context.Context) error {
 		if err := managedresources.CreateForSeed(ctx, s.client, s.namespace, managedResourceName, false, serializedObjects); err != nil {
 			return err
 		}
+	} else if err := managedresources.DeleteForSeed(ctx, s.client, s.namespace, managedResourceName); err != nil {
+		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, destinationRule, istio.DestinationRuleWithLocalityPreference(destinationRule, getLabels(), hostName)); err != nil {
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, destinationRule, func() error {
+		if err := istio.DestinationRuleWithLocalityPreference(destinationRule, getLabels(), hostName)(); err != nil {
+			return err
+		}
+		if limits.MaxConnections != nil {
+			destinationRule.Spec.TrafficPolicy.ConnectionPool.Tcp.MaxConnections = *limits.MaxConnections
+		}
+		return nil
+	}); err != nil {
 		return err
 	}
 
@@ -171,6 +246,7 @@ func (s *sni) Destroy(ctx context.Context) error {
 		s.client,
 		s.emptyDestinationRule(),
 		s.emptyEnvoyFilter(),
+		s.emptyLimitsEnvoyFilter(),
 		s.emptyGateway(),
 		s.emptyVirtualService(),
 	)
@@ -187,6 +263,10 @@ func (s *sni) emptyEnvoyFilter() *istionetworkingv1alpha3.EnvoyFilter {
 	return &istionetworkingv1alpha3.EnvoyFilter{ObjectMeta: metav1.ObjectMeta{Name: s.namespace, Namespace: s.valuesFunc().IstioIngressGateway.Namespace}}
 }
 
+func (s *sni) emptyLimitsEnvoyFilter() *istionetworkingv1alpha3.EnvoyFilter {
+	return &istionetworkingv1alpha3.EnvoyFilter{ObjectMeta: metav1.ObjectMeta{Name: s.namespace + "-limits", Namespace: s.valuesFunc().IstioIngressGateway.Namespace}}
+}
+
 func (s *sni) emptyGateway() *istionetworkingv1beta1.Gateway {
 	return &istionetworkingv1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace}}
 }
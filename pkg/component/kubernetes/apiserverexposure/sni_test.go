@@ -48,6 +48,7 @@ var _ = Describe("#SNI", func() {
 		hostName         = "kube-apiserver." + namespace + ".svc.cluster.local"
 
 		apiServerProxyValues *APIServerProxy
+		limits               *Limits
 
 		expectedDestinationRule       *istionetworkingv1beta1.DestinationRule
 		expectedGateway               *istionetworkingv1beta1.Gateway
@@ -69,6 +70,7 @@ var _ = Describe("#SNI", func() {
 			APIServerClusterIP: "1.1.1.1",
 			NamespaceUID:       namespaceUID,
 		}
+		limits = nil
 
 		expectedDestinationRule = &istionetworkingv1beta1.DestinationRule{
 			ObjectMeta: metav1.ObjectMeta{
@@ -196,12 +198,25 @@ var _ = Describe("#SNI", func() {
 					Namespace: istioNamespace,
 					Labels:    istioLabels,
 				},
+				Limits: limits,
 			}
 			return val
 		})
 	})
 
 	Describe("#Deploy", func() {
+		managedResourceData := func() string {
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: expectedManagedResource.Name}, managedResource)).To(Succeed())
+
+			managedResourceSecret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+
+			mrData, err := test.BrotliDecompression(managedResourceSecret.Data["data.yaml.br"])
+			Expect(err).NotTo(HaveOccurred())
+			return string(mrData)
+		}
+
 		test := func() {
 			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
 
@@ -257,6 +272,66 @@ var _ = Describe("#SNI", func() {
 
 			It("should succeed deploying", func() {
 				test()
+
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: expectedManagedResource.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			})
+		})
+
+		Context("when limits are configured", func() {
+			BeforeEach(func() {
+				apiServerProxyValues = nil
+			})
+
+			It("should limit the concurrent connections in the destination rule", func() {
+				limits = &Limits{MaxConnections: ptr.To[int32](100)}
+				expectedDestinationRule.Spec.TrafficPolicy.ConnectionPool.Tcp.MaxConnections = 100
+
+				test()
+
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: expectedManagedResource.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			})
+
+			It("should deploy an envoy filter limiting the connection rate and buffer", func() {
+				limits = &Limits{
+					ConnectionsPerSecond:       ptr.To[int32](20),
+					ConnectionBufferLimitBytes: ptr.To[int64](32768),
+				}
+
+				test()
+
+				data := managedResourceData()
+				Expect(data).To(ContainSubstring("name: " + namespace + "-limits"))
+				Expect(data).To(ContainSubstring("namespace: " + istioNamespace))
+				Expect(data).To(ContainSubstring(`sni: "foo.bar"`))
+				Expect(data).To(ContainSubstring("envoy.filters.network.local_ratelimit"))
+				Expect(data).To(ContainSubstring("max_tokens: 20"))
+				Expect(data).To(ContainSubstring("service: " + hostName))
+				Expect(data).To(ContainSubstring("per_connection_buffer_limit_bytes: 32768"))
+			})
+
+			It("should limit the connection rate of the APIServer proxy", func() {
+				apiServerProxyValues = &APIServerProxy{
+					APIServerClusterIP: "1.1.1.1",
+					NamespaceUID:       namespaceUID,
+				}
+				limits = &Limits{ConnectionsPerSecond: ptr.To[int32](20)}
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				data := managedResourceData()
+				Expect(data).To(ContainSubstring("stat_prefix: \"apiserver_proxy_" + namespace + "\""))
+				Expect(data).To(ContainSubstring("stat_prefix: \"kube_apiserver_" + namespace + "\""))
+				Expect(data).NotTo(ContainSubstring("per_connection_buffer_limit_bytes"))
+			})
+
+			It("should delete the managed resource when the limits are removed", func() {
+				limits = &Limits{ConnectionsPerSecond: ptr.To[int32](20)}
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: expectedManagedResource.Name}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+
+				limits = nil
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedManagedResource.Namespace, Name: expectedManagedResource.Name}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
 			})
 		})
 	})
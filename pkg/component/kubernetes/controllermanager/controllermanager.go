@@ -27,6 +27,7 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -68,6 +69,11 @@ const (
 	volumeMountPathServiceAccountKey = "/srv/kubernetes/service-account-key"
 	volumeMountPathServer            = "/var/lib/kube-controller-manager-server"
 
+	// defaultVerbosity is the log verbosity of the kube-controller-manager.
+	defaultVerbosity = 2
+	// debugModeVerbosity is the log verbosity of the kube-controller-manager if the debug mode is enabled.
+	debugModeVerbosity = 4
+
 	nodeMonitorGraceDuration = 2 * time.Minute
 	// NodeMonitorGraceDurationK8sGreaterEqual127 is the default node monitoring grace duration used with k8s versions >= 1.27
 	NodeMonitorGraceDurationK8sGreaterEqual127 = 40 * time.Second
@@ -89,6 +95,11 @@ type Interface interface {
 	SetServiceNetworks([]net.IPNet)
 	// SetPodNetworks sets the pod CIDRs of the shoot network.
 	SetPodNetworks([]net.IPNet)
+	// SetDebugMode sets whether the kube-controller-manager runs with increased log verbosity and enabled profiling
+	// endpoints.
+	SetDebugMode(enabled bool)
+	// SetServerSideApply sets whether the resources of the kube-controller-manager are deployed via server-side apply.
+	SetServerSideApply(enabled bool)
 }
 
 // New creates a new instance of DeployWaiter for the kube-controller-manager.
@@ -151,6 +162,12 @@ type Values struct {
 	RuntimeConfig map[string]bool
 	// ManagedResourceLabels are labels added to the ManagedResource.
 	ManagedResourceLabels map[string]string
+	// DebugMode states whether the kube-controller-manager runs with increased log verbosity and enabled profiling
+	// endpoints.
+	DebugMode bool
+	// ServerSideApply states whether the resources of the kube-controller-manager are deployed via server-side apply
+	// with the gardenlet field manager.
+	ServerSideApply bool
 }
 
 // ControllerWorkers is used for configuring the workers for controllers.
@@ -250,7 +267,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		command              = k.computeCommand(port)
 	)
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), service, func() error {
+	if err := k.createOrApply(ctx, service, func() error {
 		service.Labels = getLabels()
 
 		networkPolicyPort := networkingv1.NetworkPolicyPort{
@@ -293,13 +310,12 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), deployment, func() error {
+	if err := k.createOrApplyDeployment(ctx, deployment, k.values.Replicas, func() error {
 		deployment.Labels = utils.MergeStringMaps(getLabels(), map[string]string{
 			v1beta1constants.GardenRole:                                         v1beta1constants.GardenRoleControlPlane,
 			resourcesv1alpha1.HighAvailabilityConfigType:                        resourcesv1alpha1.HighAvailabilityConfigTypeController,
 			v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook: "true",
 		})
-		deployment.Spec.Replicas = &k.values.Replicas
 		deployment.Spec.RevisionHistoryLimit = ptr.To[int32](1)
 		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: getLabels()}
 		deployment.Spec.Template = corev1.PodTemplateSpec{
@@ -438,7 +454,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), podDisruptionBudget, func() error {
+	if err := k.createOrApply(ctx, podDisruptionBudget, func() error {
 		podDisruptionBudget.Labels = getLabels()
 		podDisruptionBudget.Spec = policyv1.PodDisruptionBudgetSpec{
 			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
@@ -452,7 +468,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), vpa, func() error {
+	if err := k.createOrApply(ctx, vpa, func() error {
 		vpa.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
 			APIVersion: appsv1.SchemeGroupVersion.String(),
 			Kind:       "Deployment",
@@ -488,7 +504,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), prometheusRule, func() error {
+	if err := k.createOrApply(ctx, prometheusRule, func() error {
 		labels := map[string]string{
 			"service":    v1beta1constants.DeploymentNameKubeControllerManager,
 			"severity":   "critical",
@@ -522,7 +538,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), serviceMonitor, func() error {
+	if err := k.createOrApply(ctx, serviceMonitor, func() error {
 		serviceMonitor.Labels = monitoringutils.Labels(k.prometheusLabel())
 		serviceMonitor.Spec = monitoringv1.ServiceMonitorSpec{
 			Selector: metav1.LabelSelector{MatchLabels: getLabels()},
@@ -572,6 +588,21 @@ func (k *kubeControllerManager) SetRuntimeConfig(runtimeConfig map[string]bool)
 	k.values.RuntimeConfig = runtimeConfig
 }
 
+func (k *kubeControllerManager) SetDebugMode(enabled bool) {
+	k.values.DebugMode = enabled
+}
+
+func (k *kubeControllerManager) SetServerSideApply(enabled bool) {
+	k.values.ServerSideApply = enabled
+}
+
+func (k *kubeControllerManager) verbosity() int {
+	if k.values.DebugMode {
+		return debugModeVerbosity
+	}
+	return defaultVerbosity
+}
+
 func (k *kubeControllerManager) SetPodNetworks(pods []net.IPNet) {
 	k.values.PodNetworks = pods
 }
@@ -626,6 +657,14 @@ func (k *kubeControllerManager) emptyPrometheusRule() *monitoringv1.PrometheusRu
 	return &monitoringv1.PrometheusRule{ObjectMeta: monitoringutils.ConfigObjectMeta(k.values.NamePrefix+v1beta1constants.DeploymentNameKubeControllerManager, k.namespace, k.prometheusLabel())}
 }
 
+func (k *kubeControllerManager) createOrApply(ctx context.Context, obj client.Object, f controllerutil.MutateFn) error {
+	return controllerutils.CreateOrApply(ctx, k.seedClient.Client(), obj, k.values.ServerSideApply, v1beta1constants.DeploymentNameGardenlet, f)
+}
+
+func (k *kubeControllerManager) createOrApplyDeployment(ctx context.Context, deployment *appsv1.Deployment, replicas int32, f controllerutil.MutateFn) error {
+	return controllerutils.CreateOrApplyDeployment(ctx, k.seedClient.Client(), deployment, replicas, k.values.ServerSideApply, v1beta1constants.DeploymentNameGardenlet, f)
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
@@ -658,7 +697,13 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 			nodeMonitorGracePeriod = *v
 		}
 		if k.values.Config.NodeCIDRMaskSize != nil {
-			command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *k.values.Config.NodeCIDRMaskSize))
+			if len(k.values.PodNetworks) > 1 {
+				// The --node-cidr-mask-size flag is not allowed for dual-stack clusters. The configured mask size is meant
+				// for the IPv4 pod network, the IPv6 pod network uses kube-controller-manager's default mask size.
+				command = append(command, fmt.Sprintf("--node-cidr-mask-size-ipv4=%d", *k.values.Config.NodeCIDRMaskSize))
+			} else {
+				command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *k.values.Config.NodeCIDRMaskSize))
+			}
 		}
 
 		command = append(command,
@@ -779,12 +824,12 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 	}
 
 	command = append(command,
-		"--profiling=false",
+		fmt.Sprintf("--profiling=%t", k.values.DebugMode),
 		fmt.Sprintf("--tls-cert-file=%s/%s", volumeMountPathServer, secrets.DataKeyCertificate),
 		fmt.Sprintf("--tls-private-key-file=%s/%s", volumeMountPathServer, secrets.DataKeyPrivateKey),
 		fmt.Sprintf("--tls-cipher-suites=%s", strings.Join(kubernetesutils.TLSCipherSuites, ",")),
 		"--use-service-account-credentials=true",
-		"--v=2",
+		fmt.Sprintf("--v=%d", k.verbosity()),
 	)
 
 	return command
@@ -52,8 +52,11 @@ var _ = Describe("KubeControllerManager", func() {
 		ctx        = context.TODO()
 		testLogger = logr.Discard()
 
-		c             client.Client
-		fakeInterface kubernetes.Interface
+		c               client.Client
+		fakeInterface   kubernetes.Interface
+		fieldOwners     map[string]string
+		appliedReplicas *int32
+		serverSideApply bool
 
 		sm                    secretsmanager.Interface
 		kubeControllerManager Interface
@@ -518,7 +521,15 @@ namespace: kube-system
 	)
 
 	BeforeEach(func() {
-		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fieldOwners = map[string]string{}
+		appliedReplicas = nil
+		serverSideApply = false
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(test.ServerSideApplyFuncs(func(obj client.Object, opts *client.PatchOptions) {
+			fieldOwners[obj.GetObjectKind().GroupVersionKind().Kind] = opts.FieldManager
+			if deployment, ok := obj.(*appsv1.Deployment); ok {
+				appliedReplicas = deployment.Spec.Replicas
+			}
+		})).Build()
 		fakeInterface = kubernetesfake.NewClientSetBuilder().WithAPIReader(c).WithClient(c).Build()
 		sm = fakesecretsmanager.New(c, namespace)
 
@@ -608,7 +619,12 @@ namespace: kube-system
 
 			actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
-			Expect(actualDeployment).To(Equal(deploymentFor(version, config, isWorkerless, controllerWorkers)))
+			expectedDeployment := deploymentFor(version, config, isWorkerless, controllerWorkers)
+			if serverSideApply {
+				// The replicas are set via the scale subresource after the deployment was applied.
+				expectedDeployment.ResourceVersion = "2"
+			}
+			Expect(actualDeployment).To(Equal(expectedDeployment))
 
 			actualService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace}}
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(actualService), actualService)).To(Succeed())
@@ -823,6 +839,41 @@ namespace: kube-system
 			),
 		)
 
+		Context("when server-side apply is enabled", func() {
+			It("should apply the resources with the gardenlet field manager", func() {
+				isWorkerless = false
+				values = Values{
+					RuntimeVersion:         runtimeKubernetesVersion,
+					TargetVersion:          semver.MustParse(version),
+					Image:                  image,
+					Config:                 emptyConfig,
+					PriorityClassName:      priorityClassName,
+					PodNetworks:            podCIDRs,
+					ServiceNetworks:        serviceCIDRs,
+					ClusterSigningDuration: clusterSigningDuration,
+					ControllerWorkers:      controllerWorkers,
+					ControllerSyncPeriods:  controllerSyncPeriods,
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(replicas)
+				kubeControllerManager.SetServerSideApply(true)
+				serverSideApply = true
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(fieldOwners).To(Equal(map[string]string{
+					"Service":               "gardenlet",
+					"Deployment":            "gardenlet",
+					"PodDisruptionBudget":   "gardenlet",
+					"VerticalPodAutoscaler": "gardenlet",
+					"PrometheusRule":        "gardenlet",
+					"ServiceMonitor":        "gardenlet",
+				}))
+				Expect(appliedReplicas).To(BeNil())
+				verifyDeployment(emptyConfig, false, controllerWorkers, true)
+			})
+		})
+
 		Context("when name prefix is set", func() {
 			BeforeEach(func() {
 				values = Values{
@@ -871,6 +922,74 @@ namespace: kube-system
 				Expect(actualPrometheusRule).To(DeepEqual(expectedPrometheusRule))
 			})
 		})
+
+		Context("when debug mode is enabled", func() {
+			BeforeEach(func() {
+				values = Values{
+					RuntimeVersion:    runtimeKubernetesVersion,
+					TargetVersion:     semverVersion,
+					Image:             image,
+					Config:            &kcmConfig,
+					PriorityClassName: priorityClassName,
+					IsWorkerless:      isWorkerless,
+					PodNetworks:       podCIDRs,
+					ServiceNetworks:   serviceCIDRs,
+				}
+				kubeControllerManager = New(
+					testLogger,
+					fakeInterface,
+					namespace,
+					sm,
+					values,
+				)
+				kubeControllerManager.SetDebugMode(true)
+			})
+
+			It("should enable profiling and increase the log verbosity", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements("--profiling=true", "--v=4"))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElements("--profiling=false", "--v=2"))
+			})
+		})
+
+		Context("node CIDR mask size", func() {
+			BeforeEach(func() {
+				values = Values{
+					RuntimeVersion:    runtimeKubernetesVersion,
+					TargetVersion:     semverVersion,
+					Image:             image,
+					Config:            &gardencorev1beta1.KubeControllerManagerConfig{NodeCIDRMaskSize: ptr.To[int32](22)},
+					PriorityClassName: priorityClassName,
+					IsWorkerless:      false,
+					ServiceNetworks:   serviceCIDRs,
+				}
+			})
+
+			It("should configure the mask size for single-stack pod networks", func() {
+				values.PodNetworks = []net.IPNet{*podCIDR1}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--node-cidr-mask-size=22"))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size-ipv4=")))
+			})
+
+			It("should configure the IPv4 mask size for dual-stack pod networks", func() {
+				values.PodNetworks = podCIDRs
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--node-cidr-mask-size-ipv4=22"))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size=")))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -1026,7 +1145,11 @@ func commandForKubernetesVersion(
 
 	if !isWorkerless {
 		if nodeCIDRMaskSize != nil {
-			command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *nodeCIDRMaskSize))
+			if len(podNetwork) > 1 {
+				command = append(command, fmt.Sprintf("--node-cidr-mask-size-ipv4=%d", *nodeCIDRMaskSize))
+			} else {
+				command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *nodeCIDRMaskSize))
+			}
 		}
 
 		command = append(command,
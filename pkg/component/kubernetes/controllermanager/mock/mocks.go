@@ -69,6 +69,18 @@ func (mr *MockInterfaceMockRecorder) Destroy(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), arg0)
 }
 
+// SetDebugMode mocks base method.
+func (m *MockInterface) SetDebugMode(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDebugMode", arg0)
+}
+
+// SetDebugMode indicates an expected call of SetDebugMode.
+func (mr *MockInterfaceMockRecorder) SetDebugMode(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDebugMode", reflect.TypeOf((*MockInterface)(nil).SetDebugMode), arg0)
+}
+
 // SetPodNetworks mocks base method.
 func (m *MockInterface) SetPodNetworks(arg0 []net.IPNet) {
 	m.ctrl.T.Helper()
@@ -105,6 +117,18 @@ func (mr *MockInterfaceMockRecorder) SetRuntimeConfig(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRuntimeConfig", reflect.TypeOf((*MockInterface)(nil).SetRuntimeConfig), arg0)
 }
 
+// SetServerSideApply mocks base method.
+func (m *MockInterface) SetServerSideApply(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetServerSideApply", arg0)
+}
+
+// SetServerSideApply indicates an expected call of SetServerSideApply.
+func (mr *MockInterfaceMockRecorder) SetServerSideApply(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerSideApply", reflect.TypeOf((*MockInterface)(nil).SetServerSideApply), arg0)
+}
+
 // SetServiceNetworks mocks base method.
 func (m *MockInterface) SetServiceNetworks(arg0 []net.IPNet) {
 	m.ctrl.T.Helper()
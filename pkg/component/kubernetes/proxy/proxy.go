@@ -19,6 +19,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
@@ -104,6 +105,8 @@ type Values struct {
 	VPAEnabled bool
 	// WorkerPools is a list of worker pools for which the kube-proxy DaemonSets should be deployed.
 	WorkerPools []WorkerPool
+	// ResourceTier is the tier of the resource presets of kube-proxy. Defaults to 'medium'.
+	ResourceTier gardencorev1beta1.SystemComponentsResourceTier
 }
 
 // WorkerPool contains configuration for the kube-proxy deployment for this specific worker pool.
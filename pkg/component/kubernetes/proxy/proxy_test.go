@@ -1110,6 +1110,47 @@ echo "${KUBE_PROXY_MODE}" >"$1"
 				Expect(managedResource).To(DeepEqual(expectedMr))
 			}
 		})
+
+		It("should successfully deploy the expected resources for the small resource tier when VPA is enabled", func() {
+			values.VPAEnabled = true
+			values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierSmall
+			component = New(c, namespace, values)
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			for _, pool := range values.WorkerPools {
+				By(pool.Name)
+
+				k8sGreaterEqual129 := versionutils.ConstraintK8sGreaterEqual129.Check(pool.KubernetesVersion)
+				requests := corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("32Mi"),
+				}
+
+				ds := daemonSetFor(pool, values.IPVSEnabled, values.VPAEnabled, k8sGreaterEqual129)
+				ds.Spec.Template.Spec.Containers[0].Resources.Requests = requests
+				ds.Spec.Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				}
+				if k8sGreaterEqual129 {
+					ds.Spec.Template.Spec.InitContainers[1].Resources.Requests = requests
+				}
+
+				managedResource := managedResourceForPool(pool)
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource).To(consistOf(ds))
+
+				vpa := vpaFor(pool)
+				vpa.Spec.ResourcePolicy.ContainerPolicies[0].MaxAllowed = corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("2G"),
+				}
+
+				managedResourceForMajorMinorVersionOnly := managedResourceForPoolForMajorMinorVersionOnly(pool)
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceForMajorMinorVersionOnly), managedResourceForMajorMinorVersionOnly)).To(Succeed())
+				Expect(managedResourceForMajorMinorVersionOnly).To(consistOf(vpa))
+			}
+		})
 	})
 
 	Describe("#Destroy", func() {
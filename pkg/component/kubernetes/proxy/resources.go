@@ -21,6 +21,7 @@ import (
 	kubeproxyconfigv1alpha1 "k8s.io/kube-proxy/config/v1alpha1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
@@ -362,9 +363,7 @@ func (k *kubeProxy) computePoolResourcesData(pool WorkerPool) (map[string][]byte
 	)
 
 	if k.values.VPAEnabled {
-		daemonSet.Spec.Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
-			corev1.ResourceMemory: resource.MustParse("2048Mi"),
-		}
+		daemonSet.Spec.Template.Spec.Containers[0].Resources.Limits = k.resourcePresets().limits
 		if k8sGreaterEqual129 {
 			daemonSet.Spec.Template.Spec.InitContainers[1].Resources.Limits = corev1.ResourceList{
 				corev1.ResourceMemory: resource.MustParse("256Mi"),
@@ -404,11 +403,8 @@ func (k *kubeProxy) computePoolResourcesDataForMajorMinorVersionOnly(pool Worker
 				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
 					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 						{
-							ContainerName: containerName,
-							MaxAllowed: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse("4"),
-								corev1.ResourceMemory: resource.MustParse("10G"),
-							},
+							ContainerName:    containerName,
+							MaxAllowed:       k.resourcePresets().vpaMaxAllowed,
 							ControlledValues: &controlledValues,
 						},
 						{
@@ -424,6 +420,63 @@ func (k *kubeProxy) computePoolResourcesDataForMajorMinorVersionOnly(pool Worker
 	return registry.AddAllAndSerialize(vpa)
 }
 
+type resourcePresets struct {
+	requests      corev1.ResourceList
+	limits        corev1.ResourceList
+	vpaMaxAllowed corev1.ResourceList
+}
+
+// resourcePresets returns the resource requests of the kube-proxy container, its limits and the upper bounds of the
+// VerticalPodAutoscaler (only used if the VerticalPodAutoscaler is enabled) for the configured resource tier.
+func (k *kubeProxy) resourcePresets() resourcePresets {
+	switch k.values.ResourceTier {
+	case gardencorev1beta1.SystemComponentsResourceTierSmall:
+		return resourcePresets{
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+			limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1024Mi"),
+			},
+			vpaMaxAllowed: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("2G"),
+			},
+		}
+
+	case gardencorev1beta1.SystemComponentsResourceTierLarge:
+		return resourcePresets{
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+			limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("4096Mi"),
+			},
+			vpaMaxAllowed: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("20G"),
+			},
+		}
+
+	default:
+		return resourcePresets{
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("20m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+			limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("2048Mi"),
+			},
+			vpaMaxAllowed: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("10G"),
+			},
+		}
+	}
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
@@ -549,10 +602,7 @@ func (k *kubeProxy) getKubeProxyContainer(k8sGreaterEqual129 bool, image string,
 			},
 		},
 		Resources: corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
-				corev1.ResourceCPU:    resource.MustParse("20m"),
-				corev1.ResourceMemory: resource.MustParse("64Mi"),
-			},
+			Requests: k.resourcePresets().requests,
 		},
 		VolumeMounts: []corev1.VolumeMount{
 			{
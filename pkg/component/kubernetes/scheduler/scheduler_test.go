@@ -12,6 +12,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -35,11 +36,13 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/kubernetes/scheduler"
 	componenttest "github.com/gardener/gardener/pkg/component/test"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
@@ -635,6 +638,73 @@ var _ = Describe("KubeScheduler", func() {
 			Entry("kubernetes 1.26 w/o config", "1.26.0", "1.26.0", configEmpty, "testdata/component-config-1.25.yaml"),
 			Entry("kubernetes 1.26 w/ full config", "1.26.0", "1.26.0", configFull, "testdata/component-config-1.25-bin-packing.yaml"),
 		)
+
+		Context("with ServerSideApplyComponents feature gate enabled", func() {
+			var (
+				fieldOwners     map[string]string
+				appliedReplicas *int32
+			)
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ServerSideApplyComponents, true))
+
+				fieldOwners = map[string]string{}
+				c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(test.ServerSideApplyFuncs(func(obj client.Object, opts *client.PatchOptions) {
+					Expect(opts.Force).To(PointTo(BeTrue()))
+					fieldOwners[obj.GetObjectKind().GroupVersionKind().Kind] = opts.FieldManager
+					if deployment, ok := obj.(*appsv1.Deployment); ok {
+						appliedReplicas = deployment.Spec.Replicas
+					}
+				})).Build()
+				sm = fakesecretsmanager.New(c, namespace)
+
+				Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-client", Namespace: namespace}})).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "generic-token-kubeconfig", Namespace: namespace}})).To(Succeed())
+			})
+
+			It("should apply the resources with the gardenlet field manager", func() {
+				kubeScheduler = New(c, namespace, sm, runtimeVersion, targetVersion, image, replicas, configEmpty)
+				Expect(kubeScheduler.Deploy(ctx)).To(Succeed())
+
+				Expect(fieldOwners).To(Equal(map[string]string{
+					"Service":               "gardenlet",
+					"Deployment":            "gardenlet",
+					"PodDisruptionBudget":   "gardenlet",
+					"VerticalPodAutoscaler": "gardenlet",
+					"PrometheusRule":        "gardenlet",
+					"ServiceMonitor":        "gardenlet",
+				}))
+
+				// The replicas are not applied so that they are not owned by gardenlet, but set via the scale subresource.
+				Expect(appliedReplicas).To(BeNil())
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+				expectedDeployment := deploymentFor(configEmpty, "testdata/component-config-1.25.yaml")
+				expectedDeployment.ResourceVersion = "2"
+				Expect(actualDeployment).To(DeepEqual(expectedDeployment))
+
+				actualService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualService), actualService)).To(Succeed())
+				Expect(actualService).To(DeepEqual(service))
+
+				actualVPA := &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: vpaName, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(Succeed())
+				Expect(actualVPA).To(DeepEqual(vpa))
+			})
+
+			It("should apply the resources again if they already exist", func() {
+				kubeScheduler = New(c, namespace, sm, runtimeVersion, targetVersion, image, replicas, configEmpty)
+				Expect(kubeScheduler.Deploy(ctx)).To(Succeed())
+
+				kubeScheduler = New(c, namespace, sm, runtimeVersion, targetVersion, image, 2, configEmpty)
+				Expect(kubeScheduler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+				Expect(appliedReplicas).To(BeNil())
+				Expect(actualDeployment.Spec.Replicas).To(PointTo(Equal(int32(2))))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -6,9 +6,11 @@ package coredns
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -99,6 +101,23 @@ type Values struct {
 	WantsVerticalPodAutoscaler bool
 	// SearchPathRewriteCommonSuffixes contains common suffixes to be rewritten when SearchPathRewritesEnabled is set.
 	SearchPathRewriteCommonSuffixes []string
+	// MinReplicas is the minimum number of replicas. Defaults to 2.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of replicas in the horizontal autoscaling mode. Defaults to 5.
+	MaxReplicas *int32
+	// CoresPerReplica is the number of node CPU cores per replica in the cluster-proportional autoscaling mode.
+	// Defaults to 256.
+	CoresPerReplica *int32
+	// NodesPerReplica is the number of nodes per replica in the cluster-proportional autoscaling mode. Defaults to 16.
+	NodesPerReplica *int32
+	// Plugins is a list of additional plugins enabled in the default server block.
+	Plugins []gardencorev1beta1.CoreDNSPlugin
+	// Cache contains the settings of the cache of the default server block.
+	Cache *gardencorev1beta1.CoreDNSCache
+	// ForwardZones is a list of zones whose requests are forwarded to dedicated upstream name servers.
+	ForwardZones []gardencorev1beta1.CoreDNSForwardZone
+	// ResourceTier is the tier of the resource presets of CoreDNS. Defaults to 'medium'.
+	ResourceTier gardencorev1beta1.SystemComponentsResourceTier
 }
 
 // New creates a new instance of DeployWaiter for coredns.
@@ -304,12 +323,12 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
   }
   prometheus :` + strconv.Itoa(portMetrics) + `
   forward . /etc/resolv.conf
-  cache 30
+  ` + getCache(c.values.Cache) + `
   loop
   reload
-  loadbalance round_robin
+  loadbalance round_robin` + getPlugins(c.values.Plugins) + `
   import custom/*.override
-}
+}` + getForwardZoneServerBlocks(c.values.ForwardZones) + `
 import custom/*.server
 `,
 			},
@@ -409,7 +428,7 @@ import custom/*.server
 				}),
 			},
 			Spec: appsv1.DeploymentSpec{
-				Replicas:             ptr.To[int32](2),
+				Replicas:             ptr.To(c.minReplicas()),
 				RevisionHistoryLimit: ptr.To[int32](2),
 				Strategy: appsv1.DeploymentStrategy{
 					Type: appsv1.RollingUpdateDeploymentStrategyType,
@@ -499,15 +518,7 @@ import custom/*.server
 								TimeoutSeconds:      2,
 								PeriodSeconds:       10,
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("50m"),
-									corev1.ResourceMemory: resource.MustParse("15Mi"),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceMemory: resource.MustParse("1500Mi"),
-								},
-							},
+							Resources: c.resources(),
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:      volumeNameConfig,
@@ -643,7 +654,8 @@ import custom/*.server
 								"--namespace=" + metav1.NamespaceSystem,
 								"--configmap=coredns-autoscaler",
 								"--target=deployment/" + deployment.Name,
-								`--default-params={"linear":{"coresPerReplica":256,"nodesPerReplica":16,"min":2,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}`,
+								fmt.Sprintf(`--default-params={"linear":{"coresPerReplica":%d,"nodesPerReplica":%d,"min":%d,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}`,
+									ptr.Deref(c.values.CoresPerReplica, 256), ptr.Deref(c.values.NodesPerReplica, 16), c.minReplicas()),
 								"--logtostderr=true",
 								"--v=2",
 							},
@@ -715,8 +727,8 @@ import custom/*.server
 				},
 			},
 			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
-				MinReplicas: ptr.To[int32](2),
-				MaxReplicas: 5,
+				MinReplicas: ptr.To(c.minReplicas()),
+				MaxReplicas: ptr.Deref(c.values.MaxReplicas, 5),
 				Metrics: []autoscalingv2.MetricSpec{{
 					Type: autoscalingv2.ResourceMetricSourceType,
 					Resource: &autoscalingv2.ResourceMetricSource{
@@ -787,6 +799,32 @@ import custom/*.server
 	return registry.AddAllAndSerialize(managedObjects...)
 }
 
+// resources returns the resource requirements of the CoreDNS container for the configured resource tier.
+func (c *coreDNS) resources() corev1.ResourceRequirements {
+	requestCPU, requestMemory, limitMemory := "50m", "15Mi", "1500Mi"
+
+	switch c.values.ResourceTier {
+	case gardencorev1beta1.SystemComponentsResourceTierSmall:
+		requestCPU, requestMemory, limitMemory = "25m", "10Mi", "500Mi"
+	case gardencorev1beta1.SystemComponentsResourceTierLarge:
+		requestCPU, requestMemory, limitMemory = "100m", "50Mi", "3000Mi"
+	}
+
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(requestCPU),
+			corev1.ResourceMemory: resource.MustParse(requestMemory),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse(limitMemory),
+		},
+	}
+}
+
+func (c *coreDNS) minReplicas() int32 {
+	return ptr.Deref(c.values.MinReplicas, 2)
+}
+
 func (c *coreDNS) SetPodAnnotations(v map[string]string) {
 	c.values.PodAnnotations = v
 }
@@ -846,3 +884,48 @@ func getSearchPathRewrites(clusterDomain string, commonSuffixes []string) string
     answer value (^(?:[^\.]+\.)+)svc\.` + quotedClusterDomain + ` {1}svc.` + clusterDomain + `.svc.` + clusterDomain + `
   }` + suffixRewrites
 }
+
+func getCache(cache *gardencorev1beta1.CoreDNSCache) string {
+	if cache == nil {
+		return "cache 30"
+	}
+
+	out := "cache " + strconv.Itoa(int(ptr.Deref(cache.TTL, 30)))
+	if cache.SuccessCapacity == nil && cache.DenialCapacity == nil {
+		return out
+	}
+
+	out += " {"
+	if cache.SuccessCapacity != nil {
+		out += `
+      success ` + strconv.Itoa(int(*cache.SuccessCapacity))
+	}
+	if cache.DenialCapacity != nil {
+		out += `
+      denial ` + strconv.Itoa(int(*cache.DenialCapacity))
+	}
+	return out + `
+  }`
+}
+
+func getPlugins(plugins []gardencorev1beta1.CoreDNSPlugin) string {
+	out := ""
+	for _, plugin := range plugins {
+		out += `
+  ` + strings.Join(append([]string{plugin.Name}, plugin.Args...), " ")
+	}
+	return out
+}
+
+func getForwardZoneServerBlocks(forwardZones []gardencorev1beta1.CoreDNSForwardZone) string {
+	out := ""
+	for _, forwardZone := range forwardZones {
+		out += `
+` + strings.TrimSuffix(forwardZone.Zone, ".") + `:` + strconv.Itoa(corednsconstants.PortServer) + ` {
+  errors
+  cache 30
+  forward . ` + strings.Join(forwardZone.Upstreams, " ") + `
+}`
+	}
+	return out
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"net"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -116,7 +118,7 @@ subjects:
   name: coredns
   namespace: kube-system
 `
-		configMapYAML = func(commonSuffixes []string) string {
+		configMapYAML = func(commonSuffixes []string, cache, plugins, serverBlocks string) string {
 			out := `apiVersion: v1
 data:
   Corefile: |
@@ -150,12 +152,12 @@ data:
       }
       prometheus :9153
       forward . /etc/resolv.conf
-      cache 30
+      ` + cache + `
       loop
       reload
-      loadbalance round_robin
+      loadbalance round_robin` + plugins + `
       import custom/*.override
-    }
+    }` + serverBlocks + `
     import custom/*.server
 kind: ConfigMap
 metadata:
@@ -431,7 +433,8 @@ spec:
 			return out
 		}
 
-		hpaYAML = `apiVersion: autoscaling/v2
+		hpaYAMLFor = func(minReplicas, maxReplicas int32) string {
+			return `apiVersion: autoscaling/v2
 kind: HorizontalPodAutoscaler
 metadata:
   creationTimestamp: null
@@ -440,7 +443,7 @@ metadata:
   name: coredns
   namespace: kube-system
 spec:
-  maxReplicas: 5
+  maxReplicas: ` + strconv.Itoa(int(maxReplicas)) + `
   metrics:
   - resource:
       name: cpu
@@ -448,7 +451,7 @@ spec:
         averageUtilization: 70
         type: Utilization
     type: Resource
-  minReplicas: 2
+  minReplicas: ` + strconv.Itoa(int(minReplicas)) + `
   scaleTargetRef:
     apiVersion: apps/v1
     kind: Deployment
@@ -457,6 +460,7 @@ status:
   currentMetrics: null
   desiredReplicas: 0
 `
+		}
 
 		cpasaYAML = `apiVersion: v1
 automountServiceAccountToken: false
@@ -516,7 +520,8 @@ subjects:
   name: coredns-autoscaler
   namespace: kube-system
 `
-		cpaDeploymentYAML = `apiVersion: apps/v1
+		cpaDeploymentYAMLFor = func(coresPerReplica, nodesPerReplica, minReplicas int32) string {
+			return `apiVersion: apps/v1
 kind: Deployment
 metadata:
   creationTimestamp: null
@@ -547,7 +552,7 @@ spec:
         - --namespace=kube-system
         - --configmap=coredns-autoscaler
         - --target=deployment/coredns
-        - --default-params={"linear":{"coresPerReplica":256,"nodesPerReplica":16,"min":2,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}
+        - --default-params={"linear":{"coresPerReplica":` + strconv.Itoa(int(coresPerReplica)) + `,"nodesPerReplica":` + strconv.Itoa(int(nodesPerReplica)) + `,"min":` + strconv.Itoa(int(minReplicas)) + `,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}
         - --logtostderr=true
         - --v=2
         image: ` + cpaImage + `
@@ -577,6 +582,7 @@ spec:
       serviceAccountName: coredns-autoscaler
 status: {}
 `
+		}
 
 		cpaDeploymentVpaYAML = `apiVersion: autoscaling.k8s.io/v1
 kind: VerticalPodAutoscaler
@@ -732,10 +738,17 @@ status: {}
 
 	Describe("#Deploy", func() {
 		var (
-			cpaEnabled          bool
-			vpaEnabled          bool
-			commonSuffixes      []string
-			k8sGreaterEquals126 bool
+			cpaEnabled           bool
+			vpaEnabled           bool
+			commonSuffixes       []string
+			k8sGreaterEquals126  bool
+			corefileCache        string
+			corefilePlugins      string
+			corefileServerBlocks string
+			minReplicas          int32
+			maxReplicas          int32
+			coresPerReplica      int32
+			nodesPerReplica      int32
 		)
 
 		BeforeEach(func() {
@@ -743,6 +756,13 @@ status: {}
 			vpaEnabled = false
 			commonSuffixes = []string{}
 			k8sGreaterEquals126 = false
+			corefileCache = "cache 30"
+			corefilePlugins = ""
+			corefileServerBlocks = ""
+			minReplicas = 2
+			maxReplicas = 5
+			coresPerReplica = 256
+			nodesPerReplica = 16
 		})
 
 		JustBeforeEach(func() {
@@ -794,7 +814,7 @@ status: {}
 				serviceAccountYAML,
 				clusterRoleYAML,
 				clusterRoleBindingYAML,
-				configMapYAML(commonSuffixes),
+				configMapYAML(commonSuffixes, corefileCache, corefilePlugins, corefileServerBlocks),
 				configMapCustomYAML,
 				serviceYAML,
 				networkPolicyYAML,
@@ -810,7 +830,7 @@ status: {}
 					cpasaYAML,
 					cpacrYAML,
 					cpacrbYAML,
-					cpaDeploymentYAML,
+					cpaDeploymentYAMLFor(coresPerReplica, nodesPerReplica, minReplicas),
 				))
 				if vpaEnabled {
 					Expect(manifests).To(ContainElement(cpaDeploymentVpaYAML))
@@ -822,11 +842,11 @@ status: {}
 					cpasaYAML,
 					cpacrYAML,
 					cpacrbYAML,
-					cpaDeploymentYAML,
+					cpaDeploymentYAMLFor(coresPerReplica, nodesPerReplica, minReplicas),
 				))
 			}
 			if !cpaEnabled {
-				Expect(manifests).To(ContainElement(hpaYAML))
+				Expect(manifests).To(ContainElement(hpaYAMLFor(minReplicas, maxReplicas)))
 			}
 
 			actualScrapeConfig := &monitoringv1alpha1.ScrapeConfig{}
@@ -889,6 +909,89 @@ status: {}
 			})
 		})
 
+		Context("w/ additional plugins, cache settings and forward zones", func() {
+			BeforeEach(func() {
+				values.Plugins = []gardencorev1beta1.CoreDNSPlugin{{Name: "bufsize", Args: []string{"1232"}}, {Name: "minimal"}}
+				values.Cache = &gardencorev1beta1.CoreDNSCache{TTL: ptr.To[int32](60), SuccessCapacity: ptr.To[int32](20000), DenialCapacity: ptr.To[int32](5000)}
+				values.ForwardZones = []gardencorev1beta1.CoreDNSForwardZone{
+					{Zone: "corp.example.com.", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}},
+					{Zone: "example.org", Upstreams: []string{"10.0.1.10"}},
+				}
+
+				corefileCache = `cache 60 {
+          success 20000
+          denial 5000
+      }`
+				corefilePlugins = `
+      bufsize 1232
+      minimal`
+				corefileServerBlocks = `
+    corp.example.com:8053 {
+      errors
+      cache 30
+      forward . 10.0.0.10 10.0.0.11:5353
+    }
+    example.org:8053 {
+      errors
+      cache 30
+      forward . 10.0.1.10
+    }`
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(manifests).To(ContainElement(deploymentYAMLFor("", nil, true, true)))
+			})
+		})
+
+		Context("w/ horizontal autoscaling parameters", func() {
+			BeforeEach(func() {
+				values.MinReplicas = ptr.To[int32](2)
+				values.MaxReplicas = ptr.To[int32](10)
+				maxReplicas = 10
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(manifests).To(ContainElement(deploymentYAMLFor("", nil, true, true)))
+			})
+		})
+
+		Context("w/ resource tier", func() {
+			resourcesYAML := func(limitMemory, requestCPU, requestMemory string) string {
+				return `
+        resources:
+          limits:
+            memory: ` + limitMemory + `
+          requests:
+            cpu: ` + requestCPU + `
+            memory: ` + requestMemory + `
+`
+			}
+
+			Context("small", func() {
+				BeforeEach(func() {
+					values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierSmall
+				})
+
+				It("should use the small resource presets", func() {
+					Expect(manifests).To(ContainElement(strings.Replace(deploymentYAMLFor("", nil, true, true), resourcesYAML("1500Mi", "50m", "15Mi"), resourcesYAML("500Mi", "25m", "10Mi"), 1)))
+				})
+			})
+
+			Context("large", func() {
+				BeforeEach(func() {
+					values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierLarge
+				})
+
+				It("should use the large resource presets", func() {
+					Expect(manifests).To(ContainElement(strings.Replace(deploymentYAMLFor("", nil, true, true), resourcesYAML("1500Mi", "50m", "15Mi"), resourcesYAML("3000Mi", "100m", "50Mi"), 1)))
+				})
+			})
+
+			It("should use the medium resource presets by default", func() {
+				Expect(manifests).To(ContainElement(ContainSubstring(resourcesYAML("1500Mi", "50m", "15Mi"))))
+			})
+		})
+
 		Context("w/ cluster proportional autoscaler enabled", func() {
 			BeforeEach(func() {
 				cpaEnabled = true
@@ -930,6 +1033,19 @@ status: {}
 					})
 				})
 			})
+
+			Context("w/ autoscaling parameters", func() {
+				BeforeEach(func() {
+					values.MinReplicas = ptr.To[int32](3)
+					values.CoresPerReplica = ptr.To[int32](128)
+					values.NodesPerReplica = ptr.To[int32](8)
+					minReplicas, coresPerReplica, nodesPerReplica = 3, 128, 8
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(manifests).To(ContainElement(deploymentYAMLFor("", nil, false, false)))
+				})
+			})
 		})
 	})
 
@@ -30,6 +30,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -48,10 +49,12 @@ var _ = Describe("MachineControllerManager", func() {
 		namespaceUID             = types.UID("uid")
 		replicas                 = int32(1)
 
-		fakeClient client.Client
-		sm         secretsmanager.Interface
-		values     Values
-		mcm        Interface
+		fakeClient      client.Client
+		fieldOwners     map[string]string
+		appliedReplicas *int32
+		sm              secretsmanager.Interface
+		values          Values
+		mcm             Interface
 
 		clusterRoleYAML        string
 		clusterRoleBindingYAML string
@@ -72,7 +75,13 @@ var _ = Describe("MachineControllerManager", func() {
 	)
 
 	JustBeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fieldOwners = map[string]string{}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(test.ServerSideApplyFuncs(func(obj client.Object, opts *client.PatchOptions) {
+			fieldOwners[obj.GetObjectKind().GroupVersionKind().Kind] = opts.FieldManager
+			if deployment, ok := obj.(*appsv1.Deployment); ok {
+				appliedReplicas = deployment.Spec.Replicas
+			}
+		})).Build()
 		sm = fakesecretsmanager.New(fakeClient, namespace)
 		values = Values{
 			Image:                    image,
@@ -548,6 +557,10 @@ subjects:
 			actualDeployment := &appsv1.Deployment{}
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), actualDeployment)).To(Succeed())
 			deployment.ResourceVersion = "1"
+			if features.DefaultFeatureGate.Enabled(features.ServerSideApplyComponents) {
+				// The replicas are set via the scale subresource after the deployment was applied.
+				deployment.ResourceVersion = "2"
+			}
 			Expect(actualDeployment).To(Equal(deployment))
 
 			actualVPA := &vpaautoscalingv1.VerticalPodAutoscaler{}
@@ -617,6 +630,30 @@ subjects:
 				Expect(actualPodDisruptionBudget).To(Equal(podDisruptionBudget))
 			})
 		})
+
+		Context("with ServerSideApplyComponents feature gate enabled", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ServerSideApplyComponents, true))
+				runtimeKubernetesVersion = semver.MustParse("1.25.0")
+			})
+
+			It("should apply the resources with the gardenlet field manager", func() {
+				Expect(fieldOwners).To(Equal(map[string]string{
+					"Service":               "gardenlet",
+					"Deployment":            "gardenlet",
+					"PodDisruptionBudget":   "gardenlet",
+					"VerticalPodAutoscaler": "gardenlet",
+					"PrometheusRule":        "gardenlet",
+					"ServiceMonitor":        "gardenlet",
+				}))
+				Expect(appliedReplicas).To(BeNil())
+
+				actualPodDisruptionBudget := &policyv1.PodDisruptionBudget{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(podDisruptionBudget), actualPodDisruptionBudget)).To(Succeed())
+				podDisruptionBudget.ResourceVersion = "1"
+				Expect(actualPodDisruptionBudget).To(Equal(podDisruptionBudget))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -21,6 +21,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -54,6 +55,8 @@ type Values struct {
 	VPAEnabled bool
 	// KubernetesVersion is the Kubernetes version of the Shoot.
 	KubernetesVersion *semver.Version
+	// ResourceTier is the tier of the resource presets of node-problem-detector. Defaults to 'medium'.
+	ResourceTier gardencorev1beta1.SystemComponentsResourceTier
 }
 
 // New creates a new instance of DeployWaiter for node-problem-detector.
@@ -108,6 +111,8 @@ func (c *nodeProblemDetector) WaitCleanup(ctx context.Context) error {
 
 func (c *nodeProblemDetector) computeResourcesData() (map[string][]byte, error) {
 	var (
+		tier = resourcePresetsForTier(c.values.ResourceTier)
+
 		registry             = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 		hostPathFileOrCreate = corev1.HostPathFileOrCreate
 		serviceAccount       = &corev1.ServiceAccount{
@@ -243,15 +248,7 @@ func (c *nodeProblemDetector) computeResourcesData() (map[string][]byte, error)
 										ContainerPort: int32(daemonSetPrometheusPort),
 									},
 								},
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("20m"),
-										corev1.ResourceMemory: resource.MustParse("20Mi"),
-									},
-									Limits: corev1.ResourceList{
-										corev1.ResourceMemory: resource.MustParse("500Mi"),
-									},
-								},
+								Resources: tier.resources,
 							},
 						},
 						Tolerations: []corev1.Toleration{
@@ -340,10 +337,9 @@ func (c *nodeProblemDetector) computeResourcesData() (map[string][]byte, error)
 				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
 					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 						{
-							ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
-							MinAllowed: corev1.ResourceList{
-								corev1.ResourceMemory: resource.MustParse("20Mi"),
-							},
+							ContainerName:    vpaautoscalingv1.DefaultContainerResourcePolicy,
+							MinAllowed:       tier.vpaMinAllowed,
+							MaxAllowed:       tier.vpaMaxAllowed,
 							ControlledValues: &controlledValues,
 						},
 					},
@@ -375,3 +371,67 @@ func getLabels() map[string]string {
 		"app.kubernetes.io/instance": "shoot-core",
 	}
 }
+
+type resourcePresets struct {
+	resources     corev1.ResourceRequirements
+	vpaMinAllowed corev1.ResourceList
+	vpaMaxAllowed corev1.ResourceList
+}
+
+// resourcePresetsForTier returns the resource requirements and the bounds of the VerticalPodAutoscaler of the
+// node-problem-detector container for the given resource tier.
+func resourcePresetsForTier(tier gardencorev1beta1.SystemComponentsResourceTier) resourcePresets {
+	switch tier {
+	case gardencorev1beta1.SystemComponentsResourceTierSmall:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("20Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("250Mi"),
+				},
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("20Mi"),
+			},
+			vpaMaxAllowed: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("200m"),
+				corev1.ResourceMemory: resource.MustParse("250Mi"),
+			},
+		}
+
+	case gardencorev1beta1.SystemComponentsResourceTierLarge:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("50m"),
+					corev1.ResourceMemory: resource.MustParse("50Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("50Mi"),
+			},
+		}
+
+	default:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("20m"),
+					corev1.ResourceMemory: resource.MustParse("20Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("500Mi"),
+				},
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("20Mi"),
+			},
+		}
+	}
+}
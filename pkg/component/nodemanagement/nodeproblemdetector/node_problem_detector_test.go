@@ -7,6 +7,7 @@ package nodeproblemdetector_test
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -349,6 +350,75 @@ status: {}
 				Expect(manifests).To(ConsistOf(expectedManifests))
 			})
 		})
+
+		Context("w/ small resource tier, w/ vpaEnabled", func() {
+			BeforeEach(func() {
+				values.VPAEnabled = true
+				values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierSmall
+			})
+
+			It("should successfully deploy all resources", func() {
+				daemonSetYAML := strings.Replace(daemonsetYAMLFor(""), `
+          limits:
+            memory: 500Mi
+          requests:
+            cpu: 20m
+            memory: 20Mi
+`, `
+          limits:
+            memory: 250Mi
+          requests:
+            cpu: 10m
+            memory: 20Mi
+`, 1)
+				smallVPAYAML := strings.Replace(vpaYAML, `
+      minAllowed:
+        memory: 20Mi
+`, `
+      maxAllowed:
+        cpu: 200m
+        memory: 250Mi
+      minAllowed:
+        memory: 20Mi
+`, 1)
+
+				expectedManifests = append(expectedManifests, smallVPAYAML, daemonSetYAML)
+				Expect(manifests).To(ConsistOf(expectedManifests))
+			})
+		})
+
+		Context("w/ large resource tier, w/ vpaEnabled", func() {
+			BeforeEach(func() {
+				values.VPAEnabled = true
+				values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierLarge
+			})
+
+			It("should successfully deploy all resources", func() {
+				daemonSetYAML := strings.Replace(daemonsetYAMLFor(""), `
+          limits:
+            memory: 500Mi
+          requests:
+            cpu: 20m
+            memory: 20Mi
+`, `
+          limits:
+            memory: 1Gi
+          requests:
+            cpu: 50m
+            memory: 50Mi
+`, 1)
+				largeVPAYAML := strings.Replace(vpaYAML, `
+      minAllowed:
+        memory: 20Mi
+`, `
+      minAllowed:
+        memory: 50Mi
+`, 1)
+
+				expectedManifests = append(expectedManifests, largeVPAYAML, daemonSetYAML)
+				Expect(manifests).To(ConsistOf(expectedManifests))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -23,6 +23,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -75,6 +76,8 @@ type Values struct {
 	VPAEnabled bool
 	// KubeAPIServerHost is the kube-apiserver host name.
 	KubeAPIServerHost *string
+	// ResourceTier is the tier of the resource presets of the metrics-server. Defaults to 'medium'.
+	ResourceTier gardencorev1beta1.SystemComponentsResourceTier
 }
 
 type metricsServer struct {
@@ -128,6 +131,8 @@ func (m *metricsServer) computeResourcesData(serverSecret, caSecret *corev1.Secr
 	utilruntime.Must(kubernetesutils.MakeUnique(secret))
 
 	var (
+		tier = resourcePresetsForTier(m.values.ResourceTier)
+
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
 		serviceAccount = &corev1.ServiceAccount{
@@ -331,15 +336,7 @@ func (m *metricsServer) computeResourcesData(serverSecret, caSecret *corev1.Secr
 								PeriodSeconds:       30,
 								FailureThreshold:    1,
 							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("50m"),
-									corev1.ResourceMemory: resource.MustParse("150Mi"),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceMemory: resource.MustParse("1Gi"),
-								},
-							},
+							Resources: tier.resources,
 							VolumeMounts: []corev1.VolumeMount{{
 								Name:      volumeMountNameServer,
 								MountPath: volumeMountPathServer,
@@ -383,10 +380,7 @@ func (m *metricsServer) computeResourcesData(serverSecret, caSecret *corev1.Secr
 	}
 
 	if m.values.VPAEnabled {
-		deployment.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
-			corev1.ResourceCPU:    resource.MustParse("50m"),
-			corev1.ResourceMemory: resource.MustParse("60Mi"),
-		}
+		deployment.Spec.Template.Spec.Containers[0].Resources.Requests = tier.vpaRequests
 
 		vpaUpdateMode := vpaautoscalingv1.UpdateModeAuto
 		controlledValues := vpaautoscalingv1.ContainerControlledValuesRequestsOnly
@@ -407,10 +401,9 @@ func (m *metricsServer) computeResourcesData(serverSecret, caSecret *corev1.Secr
 				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
 					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
 						{
-							ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
-							MinAllowed: corev1.ResourceList{
-								corev1.ResourceMemory: resource.MustParse("60Mi"),
-							},
+							ContainerName:    vpaautoscalingv1.DefaultContainerResourcePolicy,
+							MinAllowed:       tier.vpaMinAllowed,
+							MaxAllowed:       tier.vpaMaxAllowed,
 							ControlledValues: &controlledValues,
 						},
 					},
@@ -439,3 +432,80 @@ func (m *metricsServer) computeResourcesData(serverSecret, caSecret *corev1.Secr
 func getLabels() map[string]string {
 	return map[string]string{"k8s-app": "metrics-server"}
 }
+
+type resourcePresets struct {
+	resources     corev1.ResourceRequirements
+	vpaRequests   corev1.ResourceList
+	vpaMinAllowed corev1.ResourceList
+	vpaMaxAllowed corev1.ResourceList
+}
+
+// resourcePresetsForTier returns the resource requirements of the metrics-server container and the initial requests
+// and bounds used if the VerticalPodAutoscaler is enabled for the given resource tier.
+func resourcePresetsForTier(tier gardencorev1beta1.SystemComponentsResourceTier) resourcePresets {
+	switch tier {
+	case gardencorev1beta1.SystemComponentsResourceTierSmall:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("25m"),
+					corev1.ResourceMemory: resource.MustParse("75Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+			},
+			vpaRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("25m"),
+				corev1.ResourceMemory: resource.MustParse("40Mi"),
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("40Mi"),
+			},
+			vpaMaxAllowed: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		}
+
+	case gardencorev1beta1.SystemComponentsResourceTierLarge:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("300Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+			},
+			vpaRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("150Mi"),
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("150Mi"),
+			},
+		}
+
+	default:
+		return resourcePresets{
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("50m"),
+					corev1.ResourceMemory: resource.MustParse("150Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+			vpaRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+				corev1.ResourceMemory: resource.MustParse("60Mi"),
+			},
+			vpaMinAllowed: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("60Mi"),
+			},
+		}
+	}
+}
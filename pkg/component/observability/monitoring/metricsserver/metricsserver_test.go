@@ -6,6 +6,7 @@ package metricsserver_test
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -456,6 +458,75 @@ spec:
 			})
 		})
 
+		Context("w/ resource tier", func() {
+			resourcesYAML := func(limitMemory, requestCPU, requestMemory string) string {
+				return `
+        resources:
+          limits:
+            memory: ` + limitMemory + `
+          requests:
+            cpu: ` + requestCPU + `
+            memory: ` + requestMemory + `
+`
+			}
+
+			Context("small, w/o VPA", func() {
+				BeforeEach(func() {
+					values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierSmall
+				})
+
+				It("should successfully deploy all resources", func() {
+					expectedManifests = append(expectedManifests, strings.Replace(deploymentYAMLFor(secretName, false, false), resourcesYAML("1Gi", "50m", "150Mi"), resourcesYAML("512Mi", "25m", "75Mi"), 1))
+					Expect(manifests).To(ConsistOf(expectedManifests))
+				})
+			})
+
+			Context("small, w/ VPA", func() {
+				BeforeEach(func() {
+					values.VPAEnabled = true
+					values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierSmall
+				})
+
+				It("should successfully deploy all resources", func() {
+					expectedManifests = append(expectedManifests,
+						strings.Replace(vpaYAML, `
+      minAllowed:
+        memory: 60Mi
+`, `
+      maxAllowed:
+        cpu: 500m
+        memory: 512Mi
+      minAllowed:
+        memory: 40Mi
+`, 1),
+						strings.Replace(deploymentYAMLFor(secretName, false, true), resourcesYAML("1Gi", "50m", "60Mi"), resourcesYAML("512Mi", "25m", "40Mi"), 1),
+					)
+					Expect(manifests).To(ConsistOf(expectedManifests))
+				})
+			})
+
+			Context("large, w/ VPA", func() {
+				BeforeEach(func() {
+					values.VPAEnabled = true
+					values.ResourceTier = gardencorev1beta1.SystemComponentsResourceTierLarge
+				})
+
+				It("should successfully deploy all resources", func() {
+					expectedManifests = append(expectedManifests,
+						strings.Replace(vpaYAML, `
+      minAllowed:
+        memory: 60Mi
+`, `
+      minAllowed:
+        memory: 150Mi
+`, 1),
+						strings.Replace(deploymentYAMLFor(secretName, false, true), resourcesYAML("1Gi", "50m", "60Mi"), resourcesYAML("2Gi", "100m", "150Mi"), 1),
+					)
+					Expect(manifests).To(ConsistOf(expectedManifests))
+				})
+			})
+		})
+
 		Context("w/ VPA, w/ host env, kubernetes version >= 1.26", func() {
 			BeforeEach(func() {
 				values.VPAEnabled = true
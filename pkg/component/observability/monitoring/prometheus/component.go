@@ -93,6 +93,9 @@ type Values struct {
 	Alerting *AlertingValues
 	// RemoteWrite contains remote write configuration for this Prometheus instance.
 	RemoteWrite *RemoteWriteValues
+	// EnableRemoteWriteReceiver specifies whether this Prometheus instance accepts metrics pushed via the remote write
+	// protocol.
+	EnableRemoteWriteReceiver bool
 	// AdditionalResources contains any additional resources which get added to the ManagedResource.
 	AdditionalResources []client.Object
 	// Cortex contains configuration for the cortex frontend sidecar container.
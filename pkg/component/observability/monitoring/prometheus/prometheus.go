@@ -154,6 +154,10 @@ func (p *prometheus) prometheus(cortexConfigMap *corev1.ConfigMap) *monitoringv1
 		obj.Spec.RemoteWrite = append(obj.Spec.RemoteWrite, spec)
 	}
 
+	if p.values.EnableRemoteWriteReceiver {
+		obj.Spec.EnableRemoteWriteReceiver = true
+	}
+
 	if p.values.Cortex != nil {
 		obj.Spec.Containers = append(obj.Spec.Containers, p.cortexContainer())
 		obj.Spec.Volumes = append(obj.Spec.Volumes, p.cortexVolume(cortexConfigMap.Name))
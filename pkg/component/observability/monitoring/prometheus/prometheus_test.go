@@ -1244,6 +1244,37 @@ query_range:
 				})
 			})
 
+			When("remote write receiver is enabled", func() {
+				BeforeEach(func() {
+					values.EnableRemoteWriteReceiver = true
+				})
+
+				It("should successfully deploy all resources", func() {
+					prometheusObj := prometheusFor(nil, false)
+					prometheusObj.Spec.EnableRemoteWriteReceiver = true
+
+					prometheusRule.Namespace = namespace
+					metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&scrapeConfig.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", name)
+					metav1.SetMetaDataLabel(&podMonitor.ObjectMeta, "prometheus", name)
+
+					Expect(managedResource).To(consistOf(
+						serviceAccount,
+						service,
+						clusterRoleBinding,
+						prometheusObj,
+						vpa,
+						prometheusRule,
+						scrapeConfig,
+						serviceMonitor,
+						podMonitor,
+						secretAdditionalScrapeConfigs,
+						additionalConfigMap,
+					))
+				})
+			})
+
 			When("target cluster is configured", func() {
 				var (
 					managedResourceTarget       *resourcesv1alpha1.ManagedResource
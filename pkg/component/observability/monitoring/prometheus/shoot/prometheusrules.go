@@ -26,6 +26,9 @@ var (
 	alertManager     *monitoringv1.PrometheusRule
 
 	// worker rules
+	//go:embed assets/prometheusrules/worker/headroom.yaml
+	workerHeadroomYAML []byte
+	workerHeadroom     *monitoringv1.PrometheusRule
 	//go:embed assets/prometheusrules/worker/kube-kubelet.yaml
 	workerKubeKubeletYAML []byte
 	workerKubeKubelet     *monitoringv1.PrometheusRule
@@ -55,6 +58,8 @@ func init() {
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, alertManagerYAML, alertManager))
 
 	// worker rules
+	workerHeadroom = &monitoringv1.PrometheusRule{}
+	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, workerHeadroomYAML, workerHeadroom))
 	workerKubeKubelet = &monitoringv1.PrometheusRule{}
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, workerKubeKubeletYAML, workerKubeKubelet))
 	workerKubePods = &monitoringv1.PrometheusRule{}
@@ -76,7 +81,7 @@ func CentralPrometheusRules(isWorkerless, wantsAlertmanager bool) []*monitoringv
 	if isWorkerless {
 		out = append(out, workerlessKubePods.DeepCopy(), workerlessNetworking.DeepCopy())
 	} else {
-		out = append(out, workerKubeKubelet.DeepCopy(), workerKubePods.DeepCopy(), workerNetworking.DeepCopy())
+		out = append(out, workerHeadroom.DeepCopy(), workerKubeKubelet.DeepCopy(), workerKubePods.DeepCopy(), workerNetworking.DeepCopy())
 	}
 
 	if wantsAlertmanager {
@@ -30,12 +30,13 @@ var _ = ginkgo.Describe("PrometheusRules", func() {
 
 			ginkgo.Entry("workerless, w/o alertmanager", true, false, []string{"prometheus", "kube-pods", "networking"}),
 			ginkgo.Entry("workerless, w/ alertmanager", true, true, []string{"prometheus", "kube-pods", "networking", "alertmanager"}),
-			ginkgo.Entry("w/ workers, w/o alertmanager", false, false, []string{"prometheus", "kube-kubelet", "kube-pods", "networking"}),
-			ginkgo.Entry("w/ workers, w/ alertmanager", false, true, []string{"prometheus", "kube-kubelet", "kube-pods", "networking", "alertmanager"}),
+			ginkgo.Entry("w/ workers, w/o alertmanager", false, false, []string{"prometheus", "headroom", "kube-kubelet", "kube-pods", "networking"}),
+			ginkgo.Entry("w/ workers, w/ alertmanager", false, true, []string{"prometheus", "headroom", "kube-kubelet", "kube-pods", "networking", "alertmanager"}),
 		)
 
 		ginkgo.It("should run the rules tests", func() {
 			test.PrometheusRule(prometheus, "testdata/prometheus.prometheusrule.test.yaml")
+			test.PrometheusRule(workerHeadroom, "testdata/worker/headroom.prometheusrule.test.yaml")
 			test.PrometheusRule(workerKubeKubelet, "testdata/worker/kube-kubelet.prometheusrule.test.yaml")
 			test.PrometheusRule(workerKubePods, "testdata/worker/kube-pods.prometheusrule.test.yaml")
 		})
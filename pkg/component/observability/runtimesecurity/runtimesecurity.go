@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimesecurity
+
+import (
+	"context"
+	_ "embed"
+	"path/filepath"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "shoot-core-runtime-security"
+
+	// FindingsDirectory is the directory on the worker nodes to which the runtime security agent writes its findings.
+	FindingsDirectory = "/var/log/runtime-security"
+	// FindingsFileName is the name of the file in FindingsDirectory to which the runtime security agent writes its
+	// findings. Each finding is written as a single line of JSON.
+	FindingsFileName = "findings.json"
+
+	name                                         = "runtime-security"
+	containerName                                = "falco"
+	labelValue                                   = "runtime-security"
+	daemonSetTerminationGracePeriodSeconds int64 = 30
+
+	configDataKeyConfig = "falco.yaml"
+	configDataKeyRules  = "gardener_rules.yaml"
+
+	volumeNameConfig   = "config"
+	volumeNameFindings = "findings"
+	volumeNameProc     = "proc"
+	volumeNameEtc      = "etc"
+	volumeNameRun      = "containerd"
+
+	volumeMountPathConfig = "/etc/falco-gardener"
+	volumeMountPathHost   = "/host"
+)
+
+var (
+	//go:embed assets/gardener_rules.yaml
+	gardenerRules string
+
+	// FindingsFilePath is the path of the file on the worker nodes to which the runtime security agent writes its
+	// findings.
+	FindingsFilePath = filepath.Join(FindingsDirectory, FindingsFileName)
+)
+
+// Values is a set of configuration values for the runtime security component.
+type Values struct {
+	// Image is the container image used for the runtime security agent.
+	Image string
+	// VPAEnabled marks whether VerticalPodAutoscaler is enabled for the shoot.
+	VPAEnabled bool
+}
+
+// New creates a new instance of DeployWaiter for the runtime security agent.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &runtimeSecurity{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type runtimeSecurity struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (r *runtimeSecurity) Deploy(ctx context.Context) error {
+	data, err := r.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, r.client, r.namespace, ManagedResourceName, managedresources.LabelValueGardener, false, data)
+}
+
+func (r *runtimeSecurity) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, r.client, r.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (r *runtimeSecurity) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, r.client, r.namespace, ManagedResourceName)
+}
+
+func (r *runtimeSecurity) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, r.client, r.namespace, ManagedResourceName)
+}
+
+func (r *runtimeSecurity) computeResourcesData() (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceSystem,
+				Labels:    getLabels(),
+			},
+			AutomountServiceAccountToken: ptr.To(false),
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-config",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    getLabels(),
+			},
+			Data: map[string]string{
+				configDataKeyConfig: config(),
+				configDataKeyRules:  gardenerRules,
+			},
+		}
+	)
+
+	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
+
+	var (
+		hostPathDirectoryOrCreate = corev1.HostPathDirectoryOrCreate
+
+		daemonSet = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceSystem,
+				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+					managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+					v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+				}),
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: utils.MergeStringMaps(getLabels(), map[string]string{
+						v1beta1constants.LabelApp: labelValue,
+					}),
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+							v1beta1constants.LabelApp:       labelValue,
+							v1beta1constants.GardenRole:     v1beta1constants.GardenRoleSystemComponent,
+							managedresources.LabelKeyOrigin: managedresources.LabelValueGardener,
+						}),
+					},
+					Spec: corev1.PodSpec{
+						ServiceAccountName:            serviceAccount.Name,
+						AutomountServiceAccountToken:  ptr.To(false),
+						HostPID:                       true,
+						TerminationGracePeriodSeconds: ptr.To(daemonSetTerminationGracePeriodSeconds),
+						PriorityClassName:             v1beta1constants.PriorityClassNameShootSystem700,
+						Containers: []corev1.Container{
+							{
+								Name:            containerName,
+								Image:           r.values.Image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args: []string{
+									"/usr/bin/falco",
+									"-c",
+									filepath.Join(volumeMountPathConfig, configDataKeyConfig),
+									"--cri=/run/containerd/containerd.sock",
+									"--disable-cri-async",
+								},
+								Env: []corev1.EnvVar{
+									{
+										Name:  "HOST_ROOT",
+										Value: volumeMountPathHost,
+									},
+									{
+										Name: "FALCO_HOSTNAME",
+										ValueFrom: &corev1.EnvVarSource{
+											FieldRef: &corev1.ObjectFieldSelector{
+												FieldPath: "spec.nodeName",
+											},
+										},
+									},
+								},
+								SecurityContext: &corev1.SecurityContext{
+									Privileged: ptr.To(true),
+								},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("50m"),
+										corev1.ResourceMemory: resource.MustParse("128Mi"),
+									},
+									Limits: corev1.ResourceList{
+										corev1.ResourceMemory: resource.MustParse("1Gi"),
+									},
+								},
+								VolumeMounts: []corev1.VolumeMount{
+									{
+										Name:      volumeNameConfig,
+										MountPath: volumeMountPathConfig,
+										ReadOnly:  true,
+									},
+									{
+										Name:      volumeNameFindings,
+										MountPath: FindingsDirectory,
+									},
+									{
+										Name:      volumeNameProc,
+										MountPath: filepath.Join(volumeMountPathHost, "proc"),
+										ReadOnly:  true,
+									},
+									{
+										Name:      volumeNameEtc,
+										MountPath: filepath.Join(volumeMountPathHost, "etc"),
+										ReadOnly:  true,
+									},
+									{
+										Name:      volumeNameRun,
+										MountPath: filepath.Join(volumeMountPathHost, "run", "containerd"),
+									},
+								},
+							},
+						},
+						Tolerations: []corev1.Toleration{
+							{
+								Effect:   corev1.TaintEffectNoSchedule,
+								Operator: corev1.TolerationOpExists,
+							},
+							{
+								Effect:   corev1.TaintEffectNoExecute,
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+						Volumes: []corev1.Volume{
+							{
+								Name: volumeNameConfig,
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: configMap.Name,
+										},
+									},
+								},
+							},
+							{
+								Name: volumeNameFindings,
+								VolumeSource: corev1.VolumeSource{
+									HostPath: &corev1.HostPathVolumeSource{
+										Path: FindingsDirectory,
+										Type: &hostPathDirectoryOrCreate,
+									},
+								},
+							},
+							{
+								Name: volumeNameProc,
+								VolumeSource: corev1.VolumeSource{
+									HostPath: &corev1.HostPathVolumeSource{
+										Path: "/proc",
+									},
+								},
+							},
+							{
+								Name: volumeNameEtc,
+								VolumeSource: corev1.VolumeSource{
+									HostPath: &corev1.HostPathVolumeSource{
+										Path: "/etc",
+									},
+								},
+							},
+							{
+								Name: volumeNameRun,
+								VolumeSource: corev1.VolumeSource{
+									HostPath: &corev1.HostPathVolumeSource{
+										Path: "/run/containerd",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		vpa *vpaautoscalingv1.VerticalPodAutoscaler
+	)
+
+	utilruntime.Must(references.InjectAnnotations(daemonSet))
+
+	if r.values.VPAEnabled {
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "DaemonSet",
+					Name:       daemonSet.Name,
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: ptr.To(vpaautoscalingv1.UpdateModeAuto),
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{
+						{
+							ContainerName: vpaautoscalingv1.DefaultContainerResourcePolicy,
+							MinAllowed: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("128Mi"),
+							},
+							ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return registry.AddAllAndSerialize(
+		serviceAccount,
+		configMap,
+		daemonSet,
+		vpa,
+	)
+}
+
+// config returns the configuration of the runtime security agent. Findings are written as JSON to stdout, so that
+// they are forwarded to the logging stack of the shoot, and to FindingsFilePath, so that gardener-node-agent can
+// report critical findings as events.
+func config() string {
+	return `engine:
+  kind: modern_ebpf
+rules_files:
+- /etc/falco/falco_rules.yaml
+- ` + filepath.Join(volumeMountPathConfig, configDataKeyRules) + `
+load_plugins: []
+watch_config_files: false
+priority: notice
+json_output: true
+json_include_output_property: true
+json_include_tags_property: true
+buffered_outputs: false
+stdout_output:
+  enabled: true
+syslog_output:
+  enabled: false
+file_output:
+  enabled: true
+  keep_alive: false
+  filename: ` + FindingsFilePath + `
+http_output:
+  enabled: false
+grpc:
+  enabled: false
+grpc_output:
+  enabled: false
+webserver:
+  enabled: false
+`
+}
+
+func getLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     labelValue,
+		"app.kubernetes.io/instance": "shoot-core",
+	}
+}
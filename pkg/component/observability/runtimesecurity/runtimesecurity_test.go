@@ -0,0 +1,376 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimesecurity_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/observability/runtimesecurity"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("RuntimeSecurity", func() {
+	var (
+		ctx = context.Background()
+
+		managedResourceName = "shoot-core-runtime-security"
+		namespace           = "some-namespace"
+		image               = "some-image:some-tag"
+
+		c         client.Client
+		consistOf func(...client.Object) types.GomegaMatcher
+		values    Values
+		component component.DeployWaiter
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+
+		labels = func() map[string]string {
+			return map[string]string{
+				"app.kubernetes.io/name":     "runtime-security",
+				"app.kubernetes.io/instance": "shoot-core",
+			}
+		}
+
+		serviceAccount = &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "runtime-security",
+				Namespace: "kube-system",
+				Labels:    labels(),
+			},
+			AutomountServiceAccountToken: ptr.To(false),
+		}
+
+		configMap = func() *corev1.ConfigMap {
+			gardenerRules, err := os.ReadFile("assets/gardener_rules.yaml")
+			Expect(err).NotTo(HaveOccurred())
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "runtime-security-config",
+					Namespace: "kube-system",
+					Labels:    labels(),
+				},
+				Data: map[string]string{
+					"falco.yaml": `engine:
+  kind: modern_ebpf
+rules_files:
+- /etc/falco/falco_rules.yaml
+- /etc/falco-gardener/gardener_rules.yaml
+load_plugins: []
+watch_config_files: false
+priority: notice
+json_output: true
+json_include_output_property: true
+json_include_tags_property: true
+buffered_outputs: false
+stdout_output:
+  enabled: true
+syslog_output:
+  enabled: false
+file_output:
+  enabled: true
+  keep_alive: false
+  filename: /var/log/runtime-security/findings.json
+http_output:
+  enabled: false
+grpc:
+  enabled: false
+grpc_output:
+  enabled: false
+webserver:
+  enabled: false
+`,
+					"gardener_rules.yaml": string(gardenerRules),
+				},
+			}
+			Expect(kubernetesutils.MakeUnique(configMap)).To(Succeed())
+			return configMap
+		}
+
+		daemonSet = func(configMapName string) *appsv1.DaemonSet {
+			daemonSet := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "runtime-security",
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":     "runtime-security",
+						"app.kubernetes.io/instance": "shoot-core",
+						"origin":                     "gardener",
+						"gardener.cloud/role":        "system-component",
+					},
+				},
+				Spec: appsv1.DaemonSetSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app.kubernetes.io/name":     "runtime-security",
+							"app.kubernetes.io/instance": "shoot-core",
+							"app":                        "runtime-security",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app.kubernetes.io/name":     "runtime-security",
+								"app.kubernetes.io/instance": "shoot-core",
+								"app":                        "runtime-security",
+								"gardener.cloud/role":        "system-component",
+								"origin":                     "gardener",
+							},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName:            "runtime-security",
+							AutomountServiceAccountToken:  ptr.To(false),
+							HostPID:                       true,
+							TerminationGracePeriodSeconds: ptr.To[int64](30),
+							PriorityClassName:             "gardener-shoot-system-700",
+							Containers: []corev1.Container{{
+								Name:            "falco",
+								Image:           image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args: []string{
+									"/usr/bin/falco",
+									"-c",
+									"/etc/falco-gardener/falco.yaml",
+									"--cri=/run/containerd/containerd.sock",
+									"--disable-cri-async",
+								},
+								Env: []corev1.EnvVar{
+									{Name: "HOST_ROOT", Value: "/host"},
+									{Name: "FALCO_HOSTNAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+								},
+								SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("50m"),
+										corev1.ResourceMemory: resource.MustParse("128Mi"),
+									},
+									Limits: corev1.ResourceList{
+										corev1.ResourceMemory: resource.MustParse("1Gi"),
+									},
+								},
+								VolumeMounts: []corev1.VolumeMount{
+									{Name: "config", MountPath: "/etc/falco-gardener", ReadOnly: true},
+									{Name: "findings", MountPath: "/var/log/runtime-security"},
+									{Name: "proc", MountPath: "/host/proc", ReadOnly: true},
+									{Name: "etc", MountPath: "/host/etc", ReadOnly: true},
+									{Name: "containerd", MountPath: "/host/run/containerd"},
+								},
+							}},
+							Tolerations: []corev1.Toleration{
+								{Effect: corev1.TaintEffectNoSchedule, Operator: corev1.TolerationOpExists},
+								{Effect: corev1.TaintEffectNoExecute, Operator: corev1.TolerationOpExists},
+							},
+							Volumes: []corev1.Volume{
+								{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMapName}}}},
+								{Name: "findings", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/runtime-security", Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}},
+								{Name: "proc", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/proc"}}},
+								{Name: "etc", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/etc"}}},
+								{Name: "containerd", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/run/containerd"}}},
+							},
+						},
+					},
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(daemonSet))
+			return daemonSet
+		}
+
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "runtime-security",
+				Namespace: "kube-system",
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       "runtime-security",
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: ptr.To(vpaautoscalingv1.UpdateModeAuto),
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+						ContainerName:    "*",
+						MinAllowed:       corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+						ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+					}},
+				},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+		values = Values{
+			Image: image,
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      managedResourceName,
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		JustBeforeEach(func() {
+			component = New(c, namespace, values)
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            managedResource.Name,
+					Namespace:       managedResource.Namespace,
+					ResourceVersion: "1",
+					Labels:          map[string]string{"origin": "gardener"},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					InjectLabels: map[string]string{"shoot.gardener.cloud/no-cleanup": "true"},
+					SecretRefs: []corev1.LocalObjectReference{{
+						Name: managedResource.Spec.SecretRefs[0].Name,
+					}},
+					KeepObjects: ptr.To(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(expectedMr))
+			Expect(managedResource).To(DeepEqual(expectedMr))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(managedResourceSecret.Immutable).To(Equal(ptr.To(true)))
+			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
+		})
+
+		Context("VPA disabled", func() {
+			It("should successfully deploy all resources", func() {
+				cm := configMap()
+				Expect(managedResource).To(consistOf(serviceAccount, cm, daemonSet(cm.Name)))
+			})
+		})
+
+		Context("VPA enabled", func() {
+			BeforeEach(func() {
+				values.VPAEnabled = true
+			})
+
+			It("should successfully deploy all resources including the VPA", func() {
+				cm := configMap()
+				Expect(managedResource).To(consistOf(serviceAccount, cm, daemonSet(cm.Name), vpa))
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			component = New(c, namespace, values)
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			component = New(c, namespace, values)
+
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail when the wait for the managed resource deletion times out", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(component.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+			})
+
+			It("should not return an error when it's already removed", func() {
+				Expect(component.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artifactcache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/cache"
+	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "artifact-cache"
+	// Port is the port on which the artifact cache serves the OCI distribution API.
+	Port = 5000
+
+	name            = "artifact-cache"
+	labelUpstream   = "upstream"
+	portNameHTTP    = "http"
+	portNameMetrics = "metrics"
+	portMetrics     = 5001
+	volumeName      = "cache"
+	volumeMountPath = "/var/lib/registry"
+)
+
+// Values is a set of configuration values for the artifact cache.
+type Values struct {
+	// Image is the container image of the pull-through cache.
+	Image string
+	// Upstreams is the list of upstream registries for which a pull-through cache is deployed.
+	Upstreams []Upstream
+	// StorageSize is the size of the volume of each cache.
+	StorageSize resource.Quantity
+	// GarbageCollectionTTL is the duration after which cached artifacts are removed from the cache.
+	GarbageCollectionTTL time.Duration
+	// NodesCIDR is the CIDR of the seed nodes. If set, the container runtimes of the nodes are allowed to pull from the
+	// caches.
+	NodesCIDR *string
+}
+
+// Upstream is an upstream registry for which a pull-through cache is deployed.
+type Upstream struct {
+	// Host is the host of the upstream registry, e.g. `europe-docker.pkg.dev`.
+	Host string
+	// RemoteURL is the URL of the upstream registry, e.g. `https://europe-docker.pkg.dev`.
+	RemoteURL string
+}
+
+// ServiceName returns the name of the service of the artifact cache for the given upstream host.
+func ServiceName(host string) string {
+	return name + "-" + strings.NewReplacer(".", "-", ":", "-").Replace(host)
+}
+
+// Endpoint returns the in-cluster endpoint of the artifact cache for the given upstream host.
+func Endpoint(namespace, host string) string {
+	return fmt.Sprintf("%s.%s.svc:%d", ServiceName(host), namespace, Port)
+}
+
+// New creates a new instance of DeployWaiter for the artifact cache.
+func New(
+	client client.Client,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &artifactCache{
+		client:    client,
+		namespace: namespace,
+		values:    values,
+	}
+}
+
+type artifactCache struct {
+	client    client.Client
+	namespace string
+	values    Values
+}
+
+func (a *artifactCache) Deploy(ctx context.Context) error {
+	data, err := a.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, a.client, a.namespace, ManagedResourceName, false, data)
+}
+
+func (a *artifactCache) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, a.client, a.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (a *artifactCache) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *artifactCache) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, a.client, a.namespace, ManagedResourceName)
+}
+
+func (a *artifactCache) computeResourcesData() (map[string][]byte, error) {
+	var (
+		registry = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+		objects  []client.Object
+	)
+
+	for _, upstream := range a.values.Upstreams {
+		objects = append(objects, a.upstreamObjects(upstream)...)
+	}
+
+	objects = append(objects, a.networkPolicy(), a.serviceMonitor())
+
+	return registry.AddAllAndSerialize(objects...)
+}
+
+func (a *artifactCache) upstreamObjects(upstream Upstream) []client.Object {
+	var (
+		serviceName = ServiceName(upstream.Host)
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serviceName,
+				Namespace: a.namespace,
+				Labels:    getLabels(upstream.Host),
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: getLabels(upstream.Host),
+				Ports: []corev1.ServicePort{
+					{
+						Name:       portNameHTTP,
+						Port:       Port,
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt32(Port),
+					},
+					{
+						Name:       portNameMetrics,
+						Port:       portMetrics,
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt32(portMetrics),
+					},
+				},
+			},
+		}
+
+		statefulSet = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serviceName,
+				Namespace: a.namespace,
+				Labels:    getLabels(upstream.Host),
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:             ptr.To[int32](1),
+				RevisionHistoryLimit: ptr.To[int32](2),
+				ServiceName:          serviceName,
+				Selector:             &metav1.LabelSelector{MatchLabels: getLabels(upstream.Host)},
+				PersistentVolumeClaimRetentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+					WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: utils.MergeStringMaps(getLabels(upstream.Host), map[string]string{
+							v1beta1constants.LabelNetworkPolicyToDNS:             v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToPublicNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+							v1beta1constants.LabelNetworkPolicyToPrivateNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
+						}),
+					},
+					Spec: corev1.PodSpec{
+						PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem600,
+						SecurityContext: &corev1.PodSecurityContext{
+							RunAsNonRoot: ptr.To(true),
+							RunAsUser:    ptr.To[int64](65534),
+							RunAsGroup:   ptr.To[int64](65534),
+							FSGroup:      ptr.To[int64](65534),
+							SeccompProfile: &corev1.SeccompProfile{
+								Type: corev1.SeccompProfileTypeRuntimeDefault,
+							},
+						},
+						Containers: []corev1.Container{{
+							Name:            name,
+							Image:           a.values.Image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Env: []corev1.EnvVar{
+								{Name: "REGISTRY_PROXY_REMOTEURL", Value: upstream.RemoteURL},
+								{Name: "REGISTRY_PROXY_TTL", Value: a.values.GarbageCollectionTTL.String()},
+								{Name: "REGISTRY_HTTP_ADDR", Value: fmt.Sprintf(":%d", Port)},
+								{Name: "REGISTRY_HTTP_DEBUG_ADDR", Value: fmt.Sprintf(":%d", portMetrics)},
+								{Name: "REGISTRY_HTTP_DEBUG_PROMETHEUS_ENABLED", Value: "true"},
+								{Name: "REGISTRY_STORAGE_FILESYSTEM_ROOTDIRECTORY", Value: volumeMountPath},
+								{Name: "REGISTRY_STORAGE_CACHE_BLOBDESCRIPTOR", Value: "inmemory"},
+								{Name: "REGISTRY_STORAGE_DELETE_ENABLED", Value: "true"},
+							},
+							Ports: []corev1.ContainerPort{
+								{
+									Name:          portNameHTTP,
+									ContainerPort: Port,
+									Protocol:      corev1.ProtocolTCP,
+								},
+								{
+									Name:          portNameMetrics,
+									ContainerPort: portMetrics,
+									Protocol:      corev1.ProtocolTCP,
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/",
+										Port: intstr.FromString(portNameHTTP),
+									},
+								},
+								PeriodSeconds:    10,
+								FailureThreshold: 3,
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("20m"),
+									corev1.ResourceMemory: resource.MustParse("50Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								ReadOnlyRootFilesystem:   ptr.To(true),
+							},
+							VolumeMounts: []corev1.VolumeMount{{
+								Name:      volumeName,
+								MountPath: volumeMountPath,
+							}},
+						}},
+					},
+				},
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   volumeName,
+						Labels: getLabels(upstream.Host),
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: a.values.StorageSize,
+							},
+						},
+					},
+				}},
+			},
+		}
+
+		vpaUpdateMode = vpaautoscalingv1.UpdateModeAuto
+		vpa           = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serviceName,
+				Namespace: a.namespace,
+			},
+			Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "StatefulSet",
+					Name:       statefulSet.Name,
+				},
+				UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{
+					UpdateMode: &vpaUpdateMode,
+				},
+				ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+					ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+						ContainerName:    vpaautoscalingv1.DefaultContainerResourcePolicy,
+						ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+					}},
+				},
+			},
+		}
+	)
+
+	utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForSeedScrapeTargets(service, networkingv1.NetworkPolicyPort{
+		Port:     ptr.To(intstr.FromInt32(portMetrics)),
+		Protocol: ptr.To(corev1.ProtocolTCP),
+	}))
+
+	return []client.Object{service, statefulSet, vpa}
+}
+
+// networkPolicy allows the container runtimes of the seed nodes as well as the pods in the garden and the extension
+// namespaces to pull from the caches.
+func (a *artifactCache) networkPolicy() *networkingv1.NetworkPolicy {
+	peers := []networkingv1.NetworkPolicyPeer{
+		{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: v1beta1constants.GardenNamespace}}},
+		{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleExtension}}},
+	}
+
+	if a.values.NodesCIDR != nil {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: *a.values.NodesCIDR}})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allow-to-" + name,
+			Namespace: a.namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelApp: name}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: peers,
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Port:     ptr.To(intstr.FromInt32(Port)),
+					Protocol: ptr.To(corev1.ProtocolTCP),
+				}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+func (a *artifactCache) serviceMonitor() *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: monitoringutils.ConfigObjectMeta(name, a.namespace, cache.Label),
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{v1beta1constants.LabelApp: name}},
+			Endpoints: []monitoringv1.Endpoint{{
+				Port: portNameMetrics,
+				RelabelConfigs: []monitoringv1.RelabelConfig{{
+					SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_label_" + labelUpstream},
+					TargetLabel:  labelUpstream,
+				}},
+				MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig(
+					"registry_storage_cache_total",
+					"registry_http_requests_total",
+				),
+			}},
+		},
+	}
+}
+
+func getLabels(host string) map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp: name,
+		labelUpstream:             strings.NewReplacer(".", "-", ":", "-").Replace(host),
+	}
+}
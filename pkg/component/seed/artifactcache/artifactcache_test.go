@@ -0,0 +1,412 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artifactcache_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/seed/artifactcache"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ArtifactCache", func() {
+	var (
+		ctx = context.Background()
+
+		managedResourceName = "artifact-cache"
+		namespace           = "some-namespace"
+		image               = "some-image:some-tag"
+
+		c         client.Client
+		values    Values
+		component component.DeployWaiter
+
+		consistOf             func(...client.Object) types.GomegaMatcher
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+
+		service = func(name, upstream string) *corev1.Service {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{"app": "artifact-cache", "upstream": upstream},
+					Annotations: map[string]string{
+						"networking.resources.gardener.cloud/from-all-seed-scrape-targets-allowed-ports": `[{"protocol":"TCP","port":5001}]`,
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeClusterIP,
+					Selector: map[string]string{"app": "artifact-cache", "upstream": upstream},
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 5000, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(5000)},
+						{Name: "metrics", Port: 5001, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(5001)},
+					},
+				},
+			}
+		}
+
+		statefulSet = func(name, upstream, remoteURL string) *appsv1.StatefulSet {
+			return &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{"app": "artifact-cache", "upstream": upstream},
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:             ptr.To[int32](1),
+					RevisionHistoryLimit: ptr.To[int32](2),
+					ServiceName:          name,
+					Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "artifact-cache", "upstream": upstream}},
+					PersistentVolumeClaimRetentionPolicy: &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+						WhenDeleted: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+						WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app":                              "artifact-cache",
+								"upstream":                         upstream,
+								"networking.gardener.cloud/to-dns": "allowed",
+								"networking.gardener.cloud/to-public-networks":  "allowed",
+								"networking.gardener.cloud/to-private-networks": "allowed",
+							},
+						},
+						Spec: corev1.PodSpec{
+							PriorityClassName: "gardener-system-600",
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot:   ptr.To(true),
+								RunAsUser:      ptr.To[int64](65534),
+								RunAsGroup:     ptr.To[int64](65534),
+								FSGroup:        ptr.To[int64](65534),
+								SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+							},
+							Containers: []corev1.Container{{
+								Name:            "artifact-cache",
+								Image:           image,
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Env: []corev1.EnvVar{
+									{Name: "REGISTRY_PROXY_REMOTEURL", Value: remoteURL},
+									{Name: "REGISTRY_PROXY_TTL", Value: "168h0m0s"},
+									{Name: "REGISTRY_HTTP_ADDR", Value: ":5000"},
+									{Name: "REGISTRY_HTTP_DEBUG_ADDR", Value: ":5001"},
+									{Name: "REGISTRY_HTTP_DEBUG_PROMETHEUS_ENABLED", Value: "true"},
+									{Name: "REGISTRY_STORAGE_FILESYSTEM_ROOTDIRECTORY", Value: "/var/lib/registry"},
+									{Name: "REGISTRY_STORAGE_CACHE_BLOBDESCRIPTOR", Value: "inmemory"},
+									{Name: "REGISTRY_STORAGE_DELETE_ENABLED", Value: "true"},
+								},
+								Ports: []corev1.ContainerPort{
+									{Name: "http", ContainerPort: 5000, Protocol: corev1.ProtocolTCP},
+									{Name: "metrics", ContainerPort: 5001, Protocol: corev1.ProtocolTCP},
+								},
+								ReadinessProbe: &corev1.Probe{
+									ProbeHandler: corev1.ProbeHandler{
+										HTTPGet: &corev1.HTTPGetAction{Path: "/", Port: intstr.FromString("http")},
+									},
+									PeriodSeconds:    10,
+									FailureThreshold: 3,
+								},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("20m"),
+										corev1.ResourceMemory: resource.MustParse("50Mi"),
+									},
+								},
+								SecurityContext: &corev1.SecurityContext{
+									AllowPrivilegeEscalation: ptr.To(false),
+									ReadOnlyRootFilesystem:   ptr.To(true),
+								},
+								VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/var/lib/registry"}},
+							}},
+						},
+					},
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "cache",
+							Labels: map[string]string{"app": "artifact-cache", "upstream": upstream},
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+							},
+						},
+					}},
+				},
+			}
+		}
+
+		vpa = func(name string) *vpaautoscalingv1.VerticalPodAutoscaler {
+			return &vpaautoscalingv1.VerticalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: vpaautoscalingv1.VerticalPodAutoscalerSpec{
+					TargetRef: &autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       name,
+					},
+					UpdatePolicy: &vpaautoscalingv1.PodUpdatePolicy{UpdateMode: ptr.To(vpaautoscalingv1.UpdateModeAuto)},
+					ResourcePolicy: &vpaautoscalingv1.PodResourcePolicy{
+						ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
+							ContainerName:    "*",
+							ControlledValues: ptr.To(vpaautoscalingv1.ContainerControlledValuesRequestsOnly),
+						}},
+					},
+				},
+			}
+		}
+
+		networkPolicy = func(peers ...networkingv1.NetworkPolicyPeer) *networkingv1.NetworkPolicy {
+			return &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "allow-to-artifact-cache",
+					Namespace: namespace,
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "artifact-cache"}},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: append([]networkingv1.NetworkPolicyPeer{
+							{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "garden"}}},
+							{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "extension"}}},
+						}, peers...),
+						Ports: []networkingv1.NetworkPolicyPort{{
+							Port:     ptr.To(intstr.FromInt32(5000)),
+							Protocol: ptr.To(corev1.ProtocolTCP),
+						}},
+					}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				},
+			}
+		}
+
+		serviceMonitor = &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cache-artifact-cache",
+				Namespace: namespace,
+				Labels:    map[string]string{"prometheus": "cache"},
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "artifact-cache"}},
+				Endpoints: []monitoringv1.Endpoint{{
+					Port: "metrics",
+					RelabelConfigs: []monitoringv1.RelabelConfig{{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_label_upstream"},
+						TargetLabel:  "upstream",
+					}},
+					MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "keep",
+						Regex:        `^(registry_storage_cache_total|registry_http_requests_total)$`,
+					}},
+				}},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		values = Values{
+			Image: image,
+			Upstreams: []Upstream{
+				{Host: "europe-docker.pkg.dev", RemoteURL: "https://europe-docker.pkg.dev"},
+				{Host: "registry.local:5000", RemoteURL: "http://registry.local:5000"},
+			},
+			StorageSize:          resource.MustParse("20Gi"),
+			GarbageCollectionTTL: 168 * time.Hour,
+		}
+		component = New(c, namespace, values)
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      managedResourceName,
+				Namespace: namespace,
+			},
+		}
+		managedResourceSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "managedresource-" + managedResource.Name,
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#ServiceName", func() {
+		It("should return the service name for the upstream host", func() {
+			Expect(ServiceName("europe-docker.pkg.dev")).To(Equal("artifact-cache-europe-docker-pkg-dev"))
+			Expect(ServiceName("registry.local:5000")).To(Equal("artifact-cache-registry-local-5000"))
+		})
+	})
+
+	Describe("#Endpoint", func() {
+		It("should return the in-cluster endpoint for the upstream host", func() {
+			Expect(Endpoint("garden", "europe-docker.pkg.dev")).To(Equal("artifact-cache-europe-docker-pkg-dev.garden.svc:5000"))
+		})
+	})
+
+	Describe("#Deploy", func() {
+		JustBeforeEach(func() {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            managedResource.Name,
+					Namespace:       managedResource.Namespace,
+					Labels:          map[string]string{"gardener.cloud/role": "seed-system-component"},
+					ResourceVersion: "1",
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					Class: ptr.To("seed"),
+					SecretRefs: []corev1.LocalObjectReference{{
+						Name: managedResource.Spec.SecretRefs[0].Name,
+					}},
+					KeepObjects: ptr.To(false),
+				},
+			}
+			utilruntime.Must(references.InjectAnnotations(expectedMr))
+			Expect(managedResource).To(DeepEqual(expectedMr))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(managedResourceSecret.Immutable).To(Equal(ptr.To(true)))
+			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
+		})
+
+		It("should successfully deploy the resources", func() {
+			Expect(managedResource).To(consistOf(
+				service("artifact-cache-europe-docker-pkg-dev", "europe-docker-pkg-dev"),
+				statefulSet("artifact-cache-europe-docker-pkg-dev", "europe-docker-pkg-dev", "https://europe-docker.pkg.dev"),
+				vpa("artifact-cache-europe-docker-pkg-dev"),
+				service("artifact-cache-registry-local-5000", "registry-local-5000"),
+				statefulSet("artifact-cache-registry-local-5000", "registry-local-5000", "http://registry.local:5000"),
+				vpa("artifact-cache-registry-local-5000"),
+				networkPolicy(),
+				serviceMonitor,
+			))
+		})
+
+		Context("when the nodes CIDR is known", func() {
+			BeforeEach(func() {
+				values.Upstreams = values.Upstreams[:1]
+				values.NodesCIDR = ptr.To("10.250.0.0/16")
+				component = New(c, namespace, values)
+			})
+
+			It("should allow the nodes to pull from the caches", func() {
+				Expect(managedResource).To(consistOf(
+					service("artifact-cache-europe-docker-pkg-dev", "europe-docker-pkg-dev"),
+					statefulSet("artifact-cache-europe-docker-pkg-dev", "europe-docker-pkg-dev", "https://europe-docker.pkg.dev"),
+					vpa("artifact-cache-europe-docker-pkg-dev"),
+					networkPolicy(networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: "10.250.0.0/16"}}),
+					serviceMonitor,
+				))
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because reading the ManagedResource fails", func() {
+				Expect(component.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should successfully wait for the managed resource to become healthy", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       managedResourceName,
+						Namespace:  namespace,
+						Generation: 1,
+					},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{
+								Type:   resourcesv1alpha1.ResourcesApplied,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+							{
+								Type:   resourcesv1alpha1.ResourcesHealthy,
+								Status: gardencorev1beta1.ConditionTrue,
+							},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(component.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should fail when the wait for the managed resource deletion times out", func() {
+				fakeOps.MaxAttempts = 2
+
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(component.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+			})
+
+			It("should not return an error when it's already removed", func() {
+				Expect(component.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
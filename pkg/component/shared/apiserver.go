@@ -33,16 +33,30 @@ func computeAPIServerAuditConfig(
 	*apiserver.AuditConfig,
 	error,
 ) {
-	if config == nil || config.AuditPolicy == nil || config.AuditPolicy.ConfigMapRef == nil {
+	if config == nil || config.AuditPolicy == nil || (config.AuditPolicy.ConfigMapRef == nil && config.AuditPolicy.ClusterAuditPolicyName == nil) {
 		return nil, nil
 	}
 
-	var (
-		out = &apiserver.AuditConfig{
-			Webhook: webhookConfig,
+	out := &apiserver.AuditConfig{
+		Webhook: webhookConfig,
+	}
+
+	if name := config.AuditPolicy.ClusterAuditPolicyName; name != nil {
+		clusterAuditPolicy := &gardencorev1beta1.ClusterAuditPolicy{}
+		if err := cl.Get(ctx, client.ObjectKey{Name: *name}, clusterAuditPolicy); err != nil {
+			// Ignore a missing ClusterAuditPolicy on cluster deletion, similar to missing ConfigMaps (see below).
+			if !apierrors.IsNotFound(err) || objectMeta.DeletionTimestamp == nil {
+				return nil, fmt.Errorf("retrieving audit policy from the ClusterAuditPolicy %s failed: %w", *name, err)
+			}
+		} else {
+			policy := string(clusterAuditPolicy.Spec.Policy.Raw)
+			out.Policy = &policy
 		}
-		key = client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.AuditPolicy.ConfigMapRef.Name}
-	)
+
+		return out, nil
+	}
+
+	key := client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.AuditPolicy.ConfigMapRef.Name}
 
 	configMap := &corev1.ConfigMap{}
 	if err := cl.Get(ctx, key, configMap); err != nil {
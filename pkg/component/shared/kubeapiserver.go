@@ -143,6 +143,10 @@ func NewKubeAPIServer(
 		requests = apiServerConfig.Requests
 		runtimeConfig = apiServerConfig.RuntimeConfig
 		watchCacheSizes = apiServerConfig.WatchCacheSizes
+
+		if apiServerConfig.Profile != nil {
+			requests, watchCacheSizes = applyKubeAPIServerProfile(*apiServerConfig.Profile, requests, watchCacheSizes)
+		}
 	}
 
 	enabledAdmissionPluginConfigs, err := convertToAdmissionPluginConfigs(ctx, resourceConfigClient, objectMeta.Namespace, enabledAdmissionPlugins)
@@ -271,6 +275,70 @@ func DeployKubeAPIServer(
 	return handleETCDEncryptionKeyRotation(ctx, runtimeClient, runtimeNamespace, deploymentName, kubeAPIServer, etcdEncryptionConfig, etcdEncryptionKeyRotationPhase)
 }
 
+// kubeAPIServerProfileSettings contains the kube-apiserver settings of a profile. With API priority and fairness
+// (enabled by default), the sum of the max inflight requests is the total concurrency limit which is distributed among
+// the priority levels.
+type kubeAPIServerProfileSettings struct {
+	maxNonMutatingInflight int32
+	maxMutatingInflight    int32
+	defaultWatchCacheSize  int32
+}
+
+var kubeAPIServerProfiles = map[gardencorev1beta1.KubeAPIServerProfile]kubeAPIServerProfileSettings{
+	gardencorev1beta1.KubeAPIServerProfileSmall: {
+		maxNonMutatingInflight: 200,
+		maxMutatingInflight:    100,
+		defaultWatchCacheSize:  50,
+	},
+	gardencorev1beta1.KubeAPIServerProfileLarge: {
+		maxNonMutatingInflight: 800,
+		maxMutatingInflight:    400,
+		defaultWatchCacheSize:  500,
+	},
+	gardencorev1beta1.KubeAPIServerProfileCIBurst: {
+		maxNonMutatingInflight: 1200,
+		maxMutatingInflight:    800,
+		defaultWatchCacheSize:  200,
+	},
+}
+
+// applyKubeAPIServerProfile returns the request and watch cache settings of the given profile. Explicitly configured
+// values take precedence over the values of the profile.
+func applyKubeAPIServerProfile(
+	profile gardencorev1beta1.KubeAPIServerProfile,
+	requests *gardencorev1beta1.APIServerRequests,
+	watchCacheSizes *gardencorev1beta1.WatchCacheSizes,
+) (
+	*gardencorev1beta1.APIServerRequests,
+	*gardencorev1beta1.WatchCacheSizes,
+) {
+	settings, ok := kubeAPIServerProfiles[profile]
+	if !ok {
+		return requests, watchCacheSizes
+	}
+
+	requests = requests.DeepCopy()
+	if requests == nil {
+		requests = &gardencorev1beta1.APIServerRequests{}
+	}
+	if requests.MaxNonMutatingInflight == nil {
+		requests.MaxNonMutatingInflight = ptr.To(settings.maxNonMutatingInflight)
+	}
+	if requests.MaxMutatingInflight == nil {
+		requests.MaxMutatingInflight = ptr.To(settings.maxMutatingInflight)
+	}
+
+	watchCacheSizes = watchCacheSizes.DeepCopy()
+	if watchCacheSizes == nil {
+		watchCacheSizes = &gardencorev1beta1.WatchCacheSizes{}
+	}
+	if watchCacheSizes.Default == nil {
+		watchCacheSizes.Default = ptr.To(settings.defaultWatchCacheSize)
+	}
+
+	return requests, watchCacheSizes
+}
+
 func computeKubeAPIServerImages(
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
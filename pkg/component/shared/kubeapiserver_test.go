@@ -24,7 +24,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
 	admissionapiv1 "k8s.io/pod-security-admission/admission/api/v1"
 	admissionapiv1alpha1 "k8s.io/pod-security-admission/admission/api/v1alpha1"
 	admissionapiv1beta1 "k8s.io/pod-security-admission/admission/api/v1beta1"
@@ -109,7 +108,7 @@ var _ = Describe("KubeAPIServer", func() {
 			}
 
 			runtimeClientSet = fake.NewClientSetBuilder().WithClient(runtimeClient).WithVersion(runtimeVersion.String()).Build()
-			resourceConfigClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+			resourceConfigClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
 			sm = fakesecretsmanager.New(runtimeClient, namespace)
 		})
 
@@ -685,6 +684,55 @@ exemptions:
 					},
 					Not(HaveOccurred()),
 				),
+				Entry("ClusterAuditPolicyName is provided but cluster audit policy is missing",
+					func() {
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ClusterAuditPolicyName: ptr.To("my-cluster-audit-policy"),
+								},
+							},
+						}
+					},
+					nil,
+					MatchError(ContainSubstring("not found")),
+				),
+				Entry("ClusterAuditPolicyName is provided but cluster audit policy is missing while shoot has a deletion timestamp",
+					func() {
+						objectMeta.DeletionTimestamp = &metav1.Time{}
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ClusterAuditPolicyName: ptr.To("my-cluster-audit-policy"),
+								},
+							},
+						}
+					},
+					&apiserver.AuditConfig{},
+					Not(HaveOccurred()),
+				),
+				Entry("ClusterAuditPolicyName is provided and cluster audit policy exists",
+					func() {
+						Expect(resourceConfigClient.Create(ctx, &gardencorev1beta1.ClusterAuditPolicy{
+							ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-audit-policy"},
+							Spec: gardencorev1beta1.ClusterAuditPolicySpec{
+								Policy: runtime.RawExtension{Raw: []byte(`{"apiVersion":"audit.k8s.io/v1","kind":"Policy"}`)},
+							},
+						})).To(Succeed())
+
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ClusterAuditPolicyName: ptr.To("my-cluster-audit-policy"),
+								},
+							},
+						}
+					},
+					&apiserver.AuditConfig{
+						Policy: ptr.To(`{"apiVersion":"audit.k8s.io/v1","kind":"Policy"}`),
+					},
+					Not(HaveOccurred()),
+				),
 				Entry("webhook config is provided",
 					func() {
 						Expect(resourceConfigClient.Create(ctx, auditPolicyConfigMap)).To(Succeed())
@@ -823,6 +871,47 @@ exemptions:
 			})
 		})
 
+		Describe("Profile", func() {
+			It("should set the requests and watch cache sizes of the profile", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{Profile: ptr.To(gardencorev1beta1.KubeAPIServerProfileLarge)}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Requests).To(Equal(&gardencorev1beta1.APIServerRequests{
+					MaxNonMutatingInflight: ptr.To[int32](800),
+					MaxMutatingInflight:    ptr.To[int32](400),
+				}))
+				Expect(kubeAPIServer.GetValues().WatchCacheSizes).To(Equal(&gardencorev1beta1.WatchCacheSizes{
+					Default: ptr.To[int32](500),
+				}))
+			})
+
+			It("should prefer the explicitly configured values over the profile", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					Profile: ptr.To(gardencorev1beta1.KubeAPIServerProfileCIBurst),
+					Requests: &gardencorev1beta1.APIServerRequests{
+						MaxMutatingInflight: ptr.To[int32](1),
+					},
+					WatchCacheSizes: &gardencorev1beta1.WatchCacheSizes{
+						Default:   ptr.To[int32](2),
+						Resources: []gardencorev1beta1.ResourceWatchCacheSize{{Resource: "foo", CacheSize: 3}},
+					},
+				}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Requests).To(Equal(&gardencorev1beta1.APIServerRequests{
+					MaxNonMutatingInflight: ptr.To[int32](1200),
+					MaxMutatingInflight:    ptr.To[int32](1),
+				}))
+				Expect(kubeAPIServer.GetValues().WatchCacheSizes).To(Equal(&gardencorev1beta1.WatchCacheSizes{
+					Default:   ptr.To[int32](2),
+					Resources: []gardencorev1beta1.ResourceWatchCacheSize{{Resource: "foo", CacheSize: 3}},
+				}))
+				Expect(apiServerConfig.Requests.MaxNonMutatingInflight).To(BeNil())
+			})
+		})
+
 		Describe("RuntimeConfig", func() {
 			It("should set the field to nil by default", func() {
 				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfig, resourcesToStoreInETCDEvents)
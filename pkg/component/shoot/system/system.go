@@ -392,6 +392,7 @@ var gardenletManagedPriorityClasses = []struct {
 	{v1beta1constants.PriorityClassNameShootSystem800, 999999800, "PriorityClass for Shoot system components"},
 	{v1beta1constants.PriorityClassNameShootSystem700, 999999700, "PriorityClass for Shoot system components"},
 	{v1beta1constants.PriorityClassNameShootSystem600, 999999600, "PriorityClass for Shoot system components"},
+	{v1beta1constants.PriorityClassNameShootWorkerHeadroom, -5, "PriorityClass for reserving capacity headroom in worker pools of a Shoot cluster"},
 }
 
 func priorityClassResources() []client.Object {
@@ -767,6 +767,7 @@ func expectPriorityClasses(mr *resourcesv1alpha1.ManagedResource, contain func(.
 		{"gardener-shoot-system-800", 999999800, "PriorityClass for Shoot system components"},
 		{"gardener-shoot-system-700", 999999700, "PriorityClass for Shoot system components"},
 		{"gardener-shoot-system-600", 999999600, "PriorityClass for Shoot system components"},
+		{"gardener-shoot-worker-headroom", -5, "PriorityClass for reserving capacity headroom in worker pools of a Shoot cluster"},
 	}
 
 	expectedPriorityClasses := make([]client.Object, 0, len(expected))
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/controller/tokenrequestor/workloadidentity"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SecretPredicate", func() {
+		var (
+			p      predicate.Predicate
+			secret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).SecretPredicate()
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"security.gardener.cloud/purpose": "workload-identity-token-requestor"},
+					Annotations: map[string]string{
+						"workloadidentity.security.gardener.cloud/namespace": "garden-foo",
+						"workloadidentity.security.gardener.cloud/name":      "bar",
+					},
+				},
+			}
+		})
+
+		Describe("#Create", func() {
+			It("should return false when object is not Secret", func() {
+				Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+			})
+
+			It("should return false when secret is not labeled as expected", func() {
+				secret.Labels["security.gardener.cloud/purpose"] = "foo"
+				Expect(p.Create(event.CreateEvent{Object: secret})).To(BeFalse())
+			})
+
+			It("should return false when secret does not reference a workload identity", func() {
+				delete(secret.Annotations, "workloadidentity.security.gardener.cloud/name")
+				Expect(p.Create(event.CreateEvent{Object: secret})).To(BeFalse())
+			})
+
+			It("should return true when secret is labeled and annotated as expected", func() {
+				Expect(p.Create(event.CreateEvent{Object: secret})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false when secret is not labeled as expected", func() {
+				secret.Labels["security.gardener.cloud/purpose"] = "foo"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: secret, ObjectNew: secret})).To(BeFalse())
+			})
+
+			It("should return true when secret is labeled and annotated as expected", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: secret, ObjectNew: secret})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: secret})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{Object: secret})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	securityv1alpha1constants "github.com/gardener/gardener/pkg/apis/security/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const maxRenewDuration = 24 * time.Hour
+
+// Reconciler requests tokens for WorkloadIdentities in the garden cluster and populates them into secrets in the seed
+// cluster. The tokens are cached in the secrets and only renewed shortly before they expire. Consumers, e.g. provider
+// extensions, exchange them for short-lived credentials of the respective target system.
+type Reconciler struct {
+	SeedClient      client.Client
+	GardenClient    client.Client
+	ConcurrentSyncs int
+	Clock           clock.Clock
+	JitterFunc      func(time.Duration, float64) time.Duration
+}
+
+// Reconcile requests and populates workload identity tokens.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	secret := &corev1.Secret{}
+	if err := r.SeedClient.Get(ctx, req.NamespacedName, secret); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !isRelevantSecret(secret) {
+		return reconcile.Result{}, nil
+	}
+
+	mustRequeue, requeueAfter, err := r.requeue(secret)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if mustRequeue {
+		log.Info("No need to generate new token, renewal is scheduled", "after", requeueAfter)
+		return reconcile.Result{Requeue: true, RequeueAfter: requeueAfter}, nil
+	}
+
+	workloadIdentity := &securityv1alpha1.WorkloadIdentity{ObjectMeta: metav1.ObjectMeta{
+		Namespace: secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityNamespace],
+		Name:      secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityName],
+	}}
+	log = log.WithValues("workloadIdentity", client.ObjectKeyFromObject(workloadIdentity))
+
+	tokenRequest := &securityv1alpha1.TokenRequest{}
+	if contextObject, ok := secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityContextObject]; ok {
+		tokenRequest.Spec.ContextObject = &securityv1alpha1.ContextObject{}
+		if err := json.Unmarshal([]byte(contextObject), tokenRequest.Spec.ContextObject); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed unmarshalling context object from secret annotation %q: %w", securityv1alpha1constants.AnnotationWorkloadIdentityContextObject, err)
+		}
+	}
+
+	log.Info("Requesting new token")
+	if err := r.GardenClient.SubResource("token").Create(ctx, workloadIdentity, tokenRequest); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed requesting token for workload identity %s: %w", client.ObjectKeyFromObject(workloadIdentity), err)
+	}
+
+	renewDuration := r.renewDuration(tokenRequest.Status.ExpirationTimeStamp.Time)
+
+	// Use optimistic locking to not overwrite concurrent updates of the secret's data, e.g. the provider config written
+	// by gardenlet.
+	patch := client.MergeFromWithOptions(secret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, securityv1alpha1constants.AnnotationWorkloadIdentityTokenRenewTimestamp, r.Clock.Now().UTC().Add(renewDuration).Format(time.RFC3339))
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, 1)
+	}
+	secret.Data[securityv1alpha1constants.DataKeyToken] = []byte(tokenRequest.Status.Token)
+
+	if err := r.SeedClient.Patch(ctx, secret, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update secret with token: %w", err)
+	}
+
+	log.Info("Successfully requested token and scheduled renewal", "after", renewDuration)
+	return reconcile.Result{Requeue: true, RequeueAfter: renewDuration}, nil
+}
+
+func (r *Reconciler) requeue(secret *corev1.Secret) (bool, time.Duration, error) {
+	renewTimestamp := secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityTokenRenewTimestamp]
+	if len(renewTimestamp) == 0 || len(secret.Data[securityv1alpha1constants.DataKeyToken]) == 0 {
+		return false, 0, nil
+	}
+
+	renewTime, err := time.Parse(time.RFC3339, renewTimestamp)
+	if err != nil {
+		return false, 0, fmt.Errorf("could not parse renew timestamp: %w", err)
+	}
+
+	if r.Clock.Now().UTC().Before(renewTime.UTC()) {
+		return true, renewTime.UTC().Sub(r.Clock.Now().UTC()), nil
+	}
+
+	return false, 0, nil
+}
+
+func (r *Reconciler) renewDuration(expirationTimestamp time.Time) time.Duration {
+	expirationDuration := expirationTimestamp.UTC().Sub(r.Clock.Now().UTC())
+	if expirationDuration >= maxRenewDuration {
+		expirationDuration = maxRenewDuration
+	}
+
+	return r.JitterFunc(expirationDuration*80/100, 0.05)
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadidentity_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controller/tokenrequestor/workloadidentity"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClock *testclock.FakeClock
+
+		seedClient   client.Client
+		gardenClient client.Client
+
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		secret *corev1.Secret
+
+		tokenRequests   []*securityv1alpha1.TokenRequest
+		requestedFor    []client.ObjectKey
+		tokenRequestErr error
+		token           string
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		tokenRequests, requestedFor, tokenRequestErr, token = nil, nil, nil, "some-token"
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cloudprovider",
+				Namespace: "shoot--foo--bar",
+				Labels:    map[string]string{"security.gardener.cloud/purpose": "workload-identity-token-requestor"},
+				Annotations: map[string]string{
+					"workloadidentity.security.gardener.cloud/namespace":      "garden-foo",
+					"workloadidentity.security.gardener.cloud/name":           "banana",
+					"workloadidentity.security.gardener.cloud/context-object": `{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","namespace":"garden-foo","name":"bar","uid":"1234"}`,
+				},
+			},
+			Data: map[string][]byte{"config": []byte("some-config")},
+		}
+
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(secret).Build()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+				if subResourceName != "token" {
+					return errors.New("subresource should be 'token'")
+				}
+				if tokenRequestErr != nil {
+					return tokenRequestErr
+				}
+
+				tokenRequest, ok := subResource.(*securityv1alpha1.TokenRequest)
+				if !ok {
+					return errors.New("subresource should be a TokenRequest")
+				}
+
+				requestedFor = append(requestedFor, client.ObjectKeyFromObject(obj))
+				tokenRequests = append(tokenRequests, tokenRequest.DeepCopy())
+
+				tokenRequest.Status.Token = token
+				tokenRequest.Status.ExpirationTimeStamp = metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+				return nil
+			},
+		}).Build()
+
+		reconciler = &Reconciler{
+			SeedClient:   seedClient,
+			GardenClient: gardenClient,
+			Clock:        fakeClock,
+			JitterFunc:   func(d time.Duration, _ float64) time.Duration { return d },
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}}
+	})
+
+	It("should do nothing if the secret does not exist", func() {
+		Expect(seedClient.Delete(ctx, secret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(tokenRequests).To(BeEmpty())
+	})
+
+	It("should do nothing if the secret is not relevant", func() {
+		secret.Labels = nil
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(tokenRequests).To(BeEmpty())
+	})
+
+	It("should request a token and populate it into the secret", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+
+		Expect(requestedFor).To(ConsistOf(client.ObjectKey{Namespace: "garden-foo", Name: "banana"}))
+		Expect(tokenRequests).To(ConsistOf(&securityv1alpha1.TokenRequest{
+			Spec: securityv1alpha1.TokenRequestSpec{
+				ContextObject: &securityv1alpha1.ContextObject{
+					APIVersion: "core.gardener.cloud/v1beta1",
+					Kind:       "Shoot",
+					Namespace:  ptr.To("garden-foo"),
+					Name:       "bar",
+					UID:        "1234",
+				},
+			},
+		}))
+
+		Expect(seedClient.Get(ctx, request.NamespacedName, secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(map[string][]byte{
+			"config": []byte("some-config"),
+			"token":  []byte("some-token"),
+		}))
+		Expect(secret.Annotations).To(HaveKeyWithValue("workloadidentity.security.gardener.cloud/token-renew-timestamp", "2024-01-01T00:48:00Z"))
+	})
+
+	It("should request a token without context object", func() {
+		delete(secret.Annotations, "workloadidentity.security.gardener.cloud/context-object")
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+		Expect(tokenRequests).To(ConsistOf(&securityv1alpha1.TokenRequest{}))
+	})
+
+	It("should not request a new token if the cached token is not due for renewal", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+
+		fakeClock.Step(30 * time.Minute)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 18 * time.Minute}))
+		Expect(tokenRequests).To(HaveLen(1))
+	})
+
+	It("should renew the token if it is due for renewal", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+
+		fakeClock.Step(48 * time.Minute)
+		token = "new-token"
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+		Expect(tokenRequests).To(HaveLen(2))
+
+		Expect(seedClient.Get(ctx, request.NamespacedName, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("token", []byte("new-token")))
+		Expect(secret.Annotations).To(HaveKeyWithValue("workloadidentity.security.gardener.cloud/token-renew-timestamp", "2024-01-01T01:36:00Z"))
+	})
+
+	It("should request a new token if the token was removed from the secret", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+
+		Expect(seedClient.Get(ctx, request.NamespacedName, secret)).To(Succeed())
+		delete(secret.Data, "token")
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{Requeue: true, RequeueAfter: 48 * time.Minute}))
+		Expect(tokenRequests).To(HaveLen(2))
+	})
+
+	It("should return an error if the context object cannot be decoded", func() {
+		secret.Annotations["workloadidentity.security.gardener.cloud/context-object"] = "{"
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed unmarshalling context object")))
+	})
+
+	It("should return an error if the token request fails", func() {
+		tokenRequestErr = errors.New("fake")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed requesting token for workload identity garden-foo/banana: fake")))
+	})
+})
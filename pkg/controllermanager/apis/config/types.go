@@ -5,6 +5,7 @@
 package config
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -22,6 +23,9 @@ type ControllerManagerConfiguration struct {
 	Controllers ControllerManagerControllerConfiguration
 	// LeaderElection defines the configuration of leader election client.
 	LeaderElection *componentbaseconfig.LeaderElectionConfiguration
+	// Sharding defines the configuration of the sharding of controllers. If enabled, all replicas actively reconcile a
+	// disjoint subset of the objects of sharded controllers instead of only the leader.
+	Sharding *ShardingConfiguration
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
 	// LogFormat is the output format for the logs. Must be one of [text,json].
@@ -45,6 +49,8 @@ type ControllerManagerControllerConfiguration struct {
 	CertificateSigningRequest *CertificateSigningRequestControllerConfiguration
 	// CloudProfile defines the configuration of the CloudProfile controller.
 	CloudProfile *CloudProfileControllerConfiguration
+	// ClusterAuditPolicy defines the configuration of the ClusterAuditPolicy controller.
+	ClusterAuditPolicy *ClusterAuditPolicyControllerConfiguration
 	// ControllerDeployment defines the configuration of the ControllerDeployment controller.
 	ControllerDeployment *ControllerDeploymentControllerConfiguration
 	// ControllerRegistration defines the configuration of the ControllerRegistration controller.
@@ -53,8 +59,16 @@ type ControllerManagerControllerConfiguration struct {
 	Event *EventControllerConfiguration
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	ExposureClass *ExposureClassControllerConfiguration
+	// NotificationSink defines the configuration of the NotificationSink controller.
+	NotificationSink *NotificationSinkControllerConfiguration
 	// Project defines the configuration of the Project controller.
 	Project *ProjectControllerConfiguration
+	// ProjectInventory defines the configuration of the ProjectInventory controller. If unset, the controller will be
+	// disabled.
+	ProjectInventory *ProjectInventoryControllerConfiguration
+	// ProjectResourceUsage defines the configuration of the ProjectResourceUsage controller. If unset, the controller
+	// will be disabled.
+	ProjectResourceUsage *ProjectResourceUsageControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
 	Quota *QuotaControllerConfiguration
 	// SecretBinding defines the configuration of the SecretBinding controller.
@@ -81,6 +95,13 @@ type ControllerManagerControllerConfiguration struct {
 	ShootConditions *ShootConditionsControllerConfiguration
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration
+	// ShootClusterAPI defines the configuration of the ShootClusterAPI controller. If unset, the controller will be
+	// disabled.
+	ShootClusterAPI *ShootClusterAPIControllerConfiguration
+	// ShootGitOps defines the configuration of the ShootGitOps controller. If unset, the controller will be disabled.
+	ShootGitOps *ShootGitOpsControllerConfiguration
+	// ShootIPAM defines the configuration of the ShootIPAM controller. If unset, the controller will be disabled.
+	ShootIPAM *ShootIPAMControllerConfiguration
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration
 }
@@ -137,6 +158,14 @@ type EventControllerConfiguration struct {
 	TTLNonShootEvents *metav1.Duration
 }
 
+// ClusterAuditPolicyControllerConfiguration defines the configuration of the
+// ClusterAuditPolicy controller.
+type ClusterAuditPolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // ExposureClassControllerConfiguration defines the configuration of the
 // ExposureClass controller.
 type ExposureClassControllerConfiguration struct {
@@ -145,6 +174,17 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// NotificationSinkControllerConfiguration defines the configuration of the
+// NotificationSink controller.
+type NotificationSinkControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// ExpirationWarningPeriod is the period before the expiration of a Shoot in which
+	// the `ShootExpiring` notification is delivered.
+	ExpirationWarningPeriod *metav1.Duration
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -166,6 +206,28 @@ type ProjectControllerConfiguration struct {
 	StaleSyncPeriod *metav1.Duration
 }
 
+// ProjectInventoryControllerConfiguration defines the configuration of the
+// ProjectInventory controller.
+type ProjectInventoryControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the inventory of a Project is refreshed regardless of changes to its
+	// Shoots.
+	SyncPeriod *metav1.Duration
+}
+
+// ProjectResourceUsageControllerConfiguration defines the configuration of the
+// ProjectResourceUsage controller.
+type ProjectResourceUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the resource usage of a Project is refreshed regardless of changes to its
+	// Shoots.
+	SyncPeriod *metav1.Duration
+}
+
 // QuotaConfiguration defines quota configurations.
 type QuotaConfiguration struct {
 	// Config is the quota specification used for the project set-up.
@@ -252,6 +314,11 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// MaxConcurrentMaintenancesPerSeed is the maximum number of Shoots on the same Seed which are maintained at the same
+	// time. A Shoot counts as being maintained until gardenlet has finished its reconciliation. If the limit is reached,
+	// the maintenance of further Shoots is deferred within their maintenance time windows. If not set, the number is not
+	// limited.
+	MaxConcurrentMaintenancesPerSeed *int
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -314,6 +381,70 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// ShootClusterAPIControllerConfiguration defines the configuration of the
+// ShootClusterAPI controller.
+type ShootClusterAPIControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
+// ShootGitOpsControllerConfiguration defines the configuration of the
+// ShootGitOps controller.
+type ShootGitOpsControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the Shoots of a project are synced from the Git repository.
+	SyncPeriod *metav1.Duration
+	// Repository is the Git repository containing the Shoot specifications.
+	Repository GitOpsRepository
+	// ProtectedFields is a list of field paths of Shoots, e.g., `spec.kubernetes.version`, which are managed in the
+	// garden cluster. They are neither overwritten with the values from the Git repository nor reported as drift.
+	ProtectedFields []string
+}
+
+// GitOpsRepository contains the settings of the Git repository containing the Shoot specifications.
+type GitOpsRepository struct {
+	// URL is the URL of the Git repository.
+	URL string
+	// Branch is the branch of the Git repository.
+	Branch *string
+	// Path is the directory in the Git repository which contains a sub-directory for every project. The
+	// sub-directories are named after the projects and contain the manifests of their Shoots.
+	Path *string
+	// SecretRef references a secret containing the credentials for the Git repository.
+	SecretRef *corev1.SecretReference
+	// VerifyCommitSignatures specifies whether the signature of the synced commit must be verified.
+	VerifyCommitSignatures bool
+	// TrustedKeys is a list of ASCII armored OpenPGP public keys which are trusted to sign the synced commits.
+	TrustedKeys []string
+}
+
+// ShootIPAMControllerConfiguration defines the configuration of the
+// ShootIPAM controller.
+type ShootIPAMControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the period how often the allocations of the IPAM server are compared with the existing Shoots in
+	// order to release the CIDRs which were allocated for Shoots that were never persisted.
+	SyncPeriod *metav1.Duration
+	// Server is the external IPAM server which releases the CIDRs of deleted Shoots.
+	Server IPAMServer
+}
+
+// IPAMServer contains the connection settings of the external IPAM server.
+type IPAMServer struct {
+	// URL is the base URL of the IPAM server.
+	URL string
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the IPAM server.
+	// If empty, the system trust store is used.
+	CABundle []byte
+	// Timeout is the timeout for requests to the IPAM server.
+	Timeout *metav1.Duration
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -334,6 +465,15 @@ type ConditionThreshold struct {
 	Duration metav1.Duration
 }
 
+// ShardingConfiguration defines the configuration of the sharding of controllers.
+type ShardingConfiguration struct {
+	// Enabled specifies whether sharding is enabled.
+	Enabled bool
+	// LeaseDuration is the duration after which a replica whose shard lease was not renewed is no longer considered
+	// to be a member of the shard ring.
+	LeaseDuration *metav1.Duration
+}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
@@ -54,6 +54,13 @@ func SetDefaults_LeaderElectionConfiguration(obj *componentbaseconfigv1alpha1.Le
 	}
 }
 
+// SetDefaults_ShardingConfiguration sets defaults for the ShardingConfiguration.
+func SetDefaults_ShardingConfiguration(obj *ShardingConfiguration) {
+	if obj.LeaseDuration == nil {
+		obj.LeaseDuration = &metav1.Duration{Duration: 15 * time.Second}
+	}
+}
+
 // SetDefaults_ShootRetryControllerConfiguration sets defaults for the ShootRetryControllerConfiguration.
 func SetDefaults_ShootRetryControllerConfiguration(obj *ShootRetryControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -110,6 +117,26 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 	}
 }
 
+// SetDefaults_ProjectInventoryControllerConfiguration sets defaults for the ProjectInventoryControllerConfiguration.
+func SetDefaults_ProjectInventoryControllerConfiguration(obj *ProjectInventoryControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
+// SetDefaults_ProjectResourceUsageControllerConfiguration sets defaults for the ProjectResourceUsageControllerConfiguration.
+func SetDefaults_ProjectResourceUsageControllerConfiguration(obj *ProjectResourceUsageControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -165,6 +192,13 @@ func SetDefaults_ControllerRegistrationControllerConfiguration(obj *ControllerRe
 	}
 }
 
+// SetDefaults_ClusterAuditPolicyControllerConfiguration sets defaults for the ClusterAuditPolicyControllerConfiguration.
+func SetDefaults_ClusterAuditPolicyControllerConfiguration(obj *ClusterAuditPolicyControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ExposureClassControllerConfiguration sets defaults for the ExposureClassControllerConfiguration.
 func SetDefaults_ExposureClassControllerConfiguration(obj *ExposureClassControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -276,6 +310,53 @@ func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelCo
 	}
 }
 
+// SetDefaults_ShootClusterAPIControllerConfiguration sets defaults for the ShootClusterAPIControllerConfiguration.
+func SetDefaults_ShootClusterAPIControllerConfiguration(obj *ShootClusterAPIControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
+// SetDefaults_ShootGitOpsControllerConfiguration sets defaults for the ShootGitOpsControllerConfiguration.
+func SetDefaults_ShootGitOpsControllerConfiguration(obj *ShootGitOpsControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+
+	if obj.Repository.Branch == nil {
+		obj.Repository.Branch = ptr.To("main")
+	}
+}
+
+// SetDefaults_ShootIPAMControllerConfiguration sets defaults for the ShootIPAMControllerConfiguration.
+func SetDefaults_ShootIPAMControllerConfiguration(obj *ShootIPAMControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+
+	if obj.Server.Timeout == nil {
+		obj.Server.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_NotificationSinkControllerConfiguration sets defaults for the NotificationSinkControllerConfiguration.
+func SetDefaults_NotificationSinkControllerConfiguration(obj *NotificationSinkControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.ExpirationWarningPeriod == nil {
+		obj.ExpirationWarningPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -297,6 +378,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.CloudProfile == nil {
 		obj.CloudProfile = &CloudProfileControllerConfiguration{}
 	}
+	if obj.ClusterAuditPolicy == nil {
+		obj.ClusterAuditPolicy = &ClusterAuditPolicyControllerConfiguration{}
+	}
 	if obj.ControllerDeployment == nil {
 		obj.ControllerDeployment = &ControllerDeploymentControllerConfiguration{}
 	}
@@ -306,6 +390,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ExposureClass == nil {
 		obj.ExposureClass = &ExposureClassControllerConfiguration{}
 	}
+	if obj.NotificationSink == nil {
+		obj.NotificationSink = &NotificationSinkControllerConfiguration{}
+	}
 	if obj.Project == nil {
 		obj.Project = &ProjectControllerConfiguration{}
 	}
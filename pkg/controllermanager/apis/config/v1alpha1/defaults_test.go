@@ -113,6 +113,32 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShardingConfiguration defaulting", func() {
+		It("should not default ShardingConfiguration if it is not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Sharding).To(BeNil())
+		})
+
+		It("should default ShardingConfiguration correctly", func() {
+			obj.Sharding = &ShardingConfiguration{Enabled: true}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Sharding).To(Equal(&ShardingConfiguration{
+				Enabled:       true,
+				LeaseDuration: &metav1.Duration{Duration: 15 * time.Second},
+			}))
+		})
+
+		It("should not default fields that are set", func() {
+			obj.Sharding = &ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{Duration: time.Minute}}
+			expected := obj.Sharding.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Sharding).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootRetryControllerConfiguration defaulting", func() {
 		It("should default ShootRetryControllerConfiguration correctly", func() {
 			expected := &ShootRetryControllerConfiguration{
@@ -394,6 +420,58 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ClusterAuditPolicyControllerConfiguration defaulting", func() {
+		It("should default ClusterAuditPolicyControllerConfiguration correctly", func() {
+			expected := &ClusterAuditPolicyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ClusterAuditPolicy).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ClusterAuditPolicy: &ClusterAuditPolicyControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.ClusterAuditPolicy.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ClusterAuditPolicy).To(Equal(expected))
+		})
+	})
+
+	Describe("NotificationSinkControllerConfiguration defaulting", func() {
+		It("should default NotificationSinkControllerConfiguration correctly", func() {
+			expected := &NotificationSinkControllerConfiguration{
+				ConcurrentSyncs:         ptr.To(DefaultControllerConcurrentSyncs),
+				ExpirationWarningPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NotificationSink).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					NotificationSink: &NotificationSinkControllerConfiguration{
+						ConcurrentSyncs:         ptr.To(10),
+						ExpirationWarningPeriod: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.NotificationSink.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NotificationSink).To(Equal(expected))
+		})
+	})
+
 	Describe("ExposureClassControllerConfiguration defaulting", func() {
 		It("should default ExposureClassControllerConfiguration correctly", func() {
 			expected := &ExposureClassControllerConfiguration{
@@ -724,6 +802,176 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootGitOpsControllerConfiguration defaulting", func() {
+		It("should default ShootGitOpsControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootGitOps: &ShootGitOpsControllerConfiguration{},
+				},
+			}
+			expected := &ShootGitOpsControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
+				Repository: GitOpsRepository{
+					Branch: ptr.To("main"),
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootGitOps).To(Equal(expected))
+		})
+
+		It("should not overwrite already set values for ShootGitOpsControllerConfiguration", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootGitOps: &ShootGitOpsControllerConfiguration{
+						ConcurrentSyncs: ptr.To(1),
+						SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+						Repository: GitOpsRepository{
+							Branch: ptr.To("shoots"),
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootGitOps.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootGitOps).To(Equal(expected))
+		})
+
+		It("should not default ShootGitOpsControllerConfiguration if not set", func() {
+			var expected *ShootGitOpsControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootGitOps).To(Equal(expected))
+		})
+	})
+
+	Describe("ProjectInventoryControllerConfiguration defaulting", func() {
+		It("should default ProjectInventoryControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ProjectInventory: &ProjectInventoryControllerConfiguration{},
+				},
+			}
+			expected := &ProjectInventoryControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectInventory).To(Equal(expected))
+		})
+
+		It("should not overwrite already set values for ProjectInventoryControllerConfiguration", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ProjectInventory: &ProjectInventoryControllerConfiguration{
+						ConcurrentSyncs: ptr.To(1),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.ProjectInventory.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectInventory).To(Equal(expected))
+		})
+	})
+
+	Describe("ProjectResourceUsageControllerConfiguration defaulting", func() {
+		It("should default ProjectResourceUsageControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ProjectResourceUsage: &ProjectResourceUsageControllerConfiguration{},
+				},
+			}
+			expected := &ProjectResourceUsageControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectResourceUsage).To(Equal(expected))
+		})
+
+		It("should not overwrite already set values for ProjectResourceUsageControllerConfiguration", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ProjectResourceUsage: &ProjectResourceUsageControllerConfiguration{
+						ConcurrentSyncs: ptr.To(1),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.ProjectResourceUsage.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ProjectResourceUsage).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootIPAMControllerConfiguration defaulting", func() {
+		It("should default ShootIPAMControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootIPAM: &ShootIPAMControllerConfiguration{},
+				},
+			}
+			expected := &ShootIPAMControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+				Server: IPAMServer{
+					Timeout: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootIPAM).To(Equal(expected))
+		})
+
+		It("should not overwrite already set values for ShootIPAMControllerConfiguration", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootIPAM: &ShootIPAMControllerConfiguration{
+						ConcurrentSyncs: ptr.To(1),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+						Server: IPAMServer{
+							Timeout: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootIPAM.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootIPAM).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootClusterAPIControllerConfiguration defaulting", func() {
+		It("should default ShootClusterAPIControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootClusterAPI: &ShootClusterAPIControllerConfiguration{},
+				},
+			}
+			expected := &ShootClusterAPIControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootClusterAPI).To(Equal(expected))
+		})
+
+		It("should not default ShootClusterAPIControllerConfiguration if not set", func() {
+			var expected *ShootClusterAPIControllerConfiguration
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootClusterAPI).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootStatusLabelControllerConfiguration defaulting", func() {
 		It("should default ShootStatusLabelControllerConfiguration correctly", func() {
 			expected := &ShootStatusLabelControllerConfiguration{
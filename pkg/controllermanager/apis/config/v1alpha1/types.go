@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -23,6 +24,10 @@ type ControllerManagerConfiguration struct {
 	// LeaderElection defines the configuration of leader election client.
 	// +optional
 	LeaderElection *componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection,omitempty"`
+	// Sharding defines the configuration of the sharding of controllers. If enabled, all replicas actively reconcile a
+	// disjoint subset of the objects of sharded controllers instead of only the leader.
+	// +optional
+	Sharding *ShardingConfiguration `json:"sharding,omitempty"`
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string `json:"logLevel"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
@@ -51,6 +56,9 @@ type ControllerManagerControllerConfiguration struct {
 	// CloudProfile defines the configuration of the CloudProfile controller.
 	// +optional
 	CloudProfile *CloudProfileControllerConfiguration `json:"cloudProfile,omitempty"`
+	// ClusterAuditPolicy defines the configuration of the ClusterAuditPolicy controller.
+	// +optional
+	ClusterAuditPolicy *ClusterAuditPolicyControllerConfiguration `json:"clusterAuditPolicy,omitempty"`
 	// ControllerDeployment defines the configuration of the ControllerDeployment controller.
 	// +optional
 	ControllerDeployment *ControllerDeploymentControllerConfiguration `json:"controllerDeployment,omitempty"`
@@ -63,9 +71,20 @@ type ControllerManagerControllerConfiguration struct {
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
+	// NotificationSink defines the configuration of the NotificationSink controller.
+	// +optional
+	NotificationSink *NotificationSinkControllerConfiguration `json:"notificationSink,omitempty"`
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
+	// ProjectInventory defines the configuration of the ProjectInventory controller. If unset, the controller will be
+	// disabled.
+	// +optional
+	ProjectInventory *ProjectInventoryControllerConfiguration `json:"projectInventory,omitempty"`
+	// ProjectResourceUsage defines the configuration of the ProjectResourceUsage controller. If unset, the controller
+	// will be disabled.
+	// +optional
+	ProjectResourceUsage *ProjectResourceUsageControllerConfiguration `json:"projectResourceUsage,omitempty"`
 	// Quota defines the configuration of the Quota controller.
 	// +optional
 	Quota *QuotaControllerConfiguration `json:"quota,omitempty"`
@@ -103,6 +122,16 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootClusterAPI defines the configuration of the ShootClusterAPI controller. If unset, the controller will be
+	// disabled.
+	// +optional
+	ShootClusterAPI *ShootClusterAPIControllerConfiguration `json:"shootClusterAPI,omitempty"`
+	// ShootGitOps defines the configuration of the ShootGitOps controller. If unset, the controller will be disabled.
+	// +optional
+	ShootGitOps *ShootGitOpsControllerConfiguration `json:"shootGitOps,omitempty"`
+	// ShootIPAM defines the configuration of the ShootIPAM controller. If unset, the controller will be disabled.
+	// +optional
+	ShootIPAM *ShootIPAMControllerConfiguration `json:"shootIPAM,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -168,6 +197,15 @@ type EventControllerConfiguration struct {
 	TTLNonShootEvents *metav1.Duration `json:"ttlNonShootEvents,omitempty"`
 }
 
+// ClusterAuditPolicyControllerConfiguration defines the configuration of the
+// ClusterAuditPolicy controller.
+type ClusterAuditPolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ExposureClassControllerConfiguration defines the configuration of the
 // ExposureClass controller.
 type ExposureClassControllerConfiguration struct {
@@ -177,6 +215,19 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// NotificationSinkControllerConfiguration defines the configuration of the
+// NotificationSink controller.
+type NotificationSinkControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// ExpirationWarningPeriod is the period before the expiration of a Shoot in which
+	// the `ShootExpiring` notification is delivered.
+	// +optional
+	ExpirationWarningPeriod *metav1.Duration `json:"expirationWarningPeriod,omitempty"`
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -204,6 +255,32 @@ type ProjectControllerConfiguration struct {
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
 }
 
+// ProjectInventoryControllerConfiguration defines the configuration of the
+// ProjectInventory controller.
+type ProjectInventoryControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the inventory of a Project is refreshed regardless of changes to its
+	// Shoots. Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// ProjectResourceUsageControllerConfiguration defines the configuration of the
+// ProjectResourceUsage controller.
+type ProjectResourceUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the resource usage of a Project is refreshed regardless of changes to its
+	// Shoots. Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // QuotaConfiguration defines quota configurations.
 type QuotaConfiguration struct {
 	// Config is the quota specification used for the project set-up.
@@ -307,6 +384,12 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// MaxConcurrentMaintenancesPerSeed is the maximum number of Shoots on the same Seed which are maintained at the same
+	// time. A Shoot counts as being maintained until gardenlet has finished its reconciliation. If the limit is reached,
+	// the maintenance of further Shoots is deferred within their maintenance time windows. If not set, the number is not
+	// limited.
+	// +optional
+	MaxConcurrentMaintenancesPerSeed *int `json:"maxConcurrentMaintenancesPerSeed,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -380,6 +463,88 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootClusterAPIControllerConfiguration defines the configuration of the
+// ShootClusterAPI controller.
+type ShootClusterAPIControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
+// ShootGitOpsControllerConfiguration defines the configuration of the
+// ShootGitOps controller.
+type ShootGitOpsControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the Shoots of a project are synced from the Git repository.
+	// Defaults to 5m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Repository is the Git repository containing the Shoot specifications.
+	Repository GitOpsRepository `json:"repository"`
+	// ProtectedFields is a list of field paths of Shoots, e.g., `spec.kubernetes.version`, which are managed in the
+	// garden cluster. They are neither overwritten with the values from the Git repository nor reported as drift.
+	// +optional
+	ProtectedFields []string `json:"protectedFields,omitempty"`
+}
+
+// GitOpsRepository contains the settings of the Git repository containing the Shoot specifications.
+type GitOpsRepository struct {
+	// URL is the URL of the Git repository.
+	URL string `json:"url"`
+	// Branch is the branch of the Git repository. Defaults to `main`.
+	// +optional
+	Branch *string `json:"branch,omitempty"`
+	// Path is the directory in the Git repository which contains a sub-directory for every project. The
+	// sub-directories are named after the projects and contain the manifests of their Shoots. Defaults to the root
+	// directory of the repository.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// SecretRef references a secret containing the credentials for the Git repository. For HTTPS, it must contain the
+	// `username` and `password` (e.g., an access token) data keys. For SSH, it must contain the `identity` data key (a
+	// PEM encoded private key) and the `knownHosts` data key (the public keys of the server in the `known_hosts` format).
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+	// VerifyCommitSignatures specifies whether the signature of the synced commit must be verified.
+	// +optional
+	VerifyCommitSignatures bool `json:"verifyCommitSignatures,omitempty"`
+	// TrustedKeys is a list of ASCII armored OpenPGP public keys which are trusted to sign the synced commits. It is
+	// required if VerifyCommitSignatures is enabled.
+	// +optional
+	TrustedKeys []string `json:"trustedKeys,omitempty"`
+}
+
+// ShootIPAMControllerConfiguration defines the configuration of the
+// ShootIPAM controller.
+type ShootIPAMControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the period how often the allocations of the IPAM server are compared with the existing Shoots in
+	// order to release the CIDRs which were allocated for Shoots that were never persisted. Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Server is the external IPAM server which releases the CIDRs of deleted Shoots.
+	Server IPAMServer `json:"server"`
+}
+
+// IPAMServer contains the connection settings of the external IPAM server.
+type IPAMServer struct {
+	// URL is the base URL of the IPAM server.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the IPAM server.
+	// If empty, the system trust store is used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Timeout is the timeout for requests to the IPAM server. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -402,6 +567,16 @@ type ConditionThreshold struct {
 	Duration metav1.Duration `json:"duration"`
 }
 
+// ShardingConfiguration defines the configuration of the sharding of controllers.
+type ShardingConfiguration struct {
+	// Enabled specifies whether sharding is enabled.
+	Enabled bool `json:"enabled"`
+	// LeaseDuration is the duration after which a replica whose shard lease was not renewed is no longer considered
+	// to be a member of the shard ring. Defaults to 15s.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -57,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAuditPolicyControllerConfiguration)(nil), (*config.ClusterAuditPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAuditPolicyControllerConfiguration_To_config_ClusterAuditPolicyControllerConfiguration(a.(*ClusterAuditPolicyControllerConfiguration), b.(*config.ClusterAuditPolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClusterAuditPolicyControllerConfiguration)(nil), (*ClusterAuditPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClusterAuditPolicyControllerConfiguration_To_v1alpha1_ClusterAuditPolicyControllerConfiguration(a.(*config.ClusterAuditPolicyControllerConfiguration), b.(*ClusterAuditPolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -137,6 +148,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GitOpsRepository)(nil), (*config.GitOpsRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository(a.(*GitOpsRepository), b.(*config.GitOpsRepository), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GitOpsRepository)(nil), (*GitOpsRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository(a.(*config.GitOpsRepository), b.(*GitOpsRepository), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAMServer)(nil), (*config.IPAMServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAMServer_To_config_IPAMServer(a.(*IPAMServer), b.(*config.IPAMServer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.IPAMServer)(nil), (*IPAMServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_IPAMServer_To_v1alpha1_IPAMServer(a.(*config.IPAMServer), b.(*IPAMServer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetControllerConfiguration)(nil), (*config.ManagedSeedSetControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(a.(*ManagedSeedSetControllerConfiguration), b.(*config.ManagedSeedSetControllerConfiguration), scope)
 	}); err != nil {
@@ -147,6 +178,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkControllerConfiguration)(nil), (*config.NotificationSinkControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationSinkControllerConfiguration_To_config_NotificationSinkControllerConfiguration(a.(*NotificationSinkControllerConfiguration), b.(*config.NotificationSinkControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NotificationSinkControllerConfiguration)(nil), (*NotificationSinkControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NotificationSinkControllerConfiguration_To_v1alpha1_NotificationSinkControllerConfiguration(a.(*config.NotificationSinkControllerConfiguration), b.(*NotificationSinkControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectControllerConfiguration)(nil), (*config.ProjectControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(a.(*ProjectControllerConfiguration), b.(*config.ProjectControllerConfiguration), scope)
 	}); err != nil {
@@ -157,6 +198,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectInventoryControllerConfiguration)(nil), (*config.ProjectInventoryControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectInventoryControllerConfiguration_To_config_ProjectInventoryControllerConfiguration(a.(*ProjectInventoryControllerConfiguration), b.(*config.ProjectInventoryControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectInventoryControllerConfiguration)(nil), (*ProjectInventoryControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectInventoryControllerConfiguration_To_v1alpha1_ProjectInventoryControllerConfiguration(a.(*config.ProjectInventoryControllerConfiguration), b.(*ProjectInventoryControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectResourceUsageControllerConfiguration)(nil), (*config.ProjectResourceUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectResourceUsageControllerConfiguration_To_config_ProjectResourceUsageControllerConfiguration(a.(*ProjectResourceUsageControllerConfiguration), b.(*config.ProjectResourceUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectResourceUsageControllerConfiguration)(nil), (*ProjectResourceUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectResourceUsageControllerConfiguration_To_v1alpha1_ProjectResourceUsageControllerConfiguration(a.(*config.ProjectResourceUsageControllerConfiguration), b.(*ProjectResourceUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*config.QuotaConfiguration)(nil), (*QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_config_QuotaConfiguration_To_v1alpha1_QuotaConfiguration(a.(*config.QuotaConfiguration), b.(*QuotaConfiguration), scope)
 	}); err != nil {
@@ -232,6 +293,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShardingConfiguration)(nil), (*config.ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(a.(*ShardingConfiguration), b.(*config.ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShardingConfiguration)(nil), (*ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(a.(*config.ShardingConfiguration), b.(*ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootClusterAPIControllerConfiguration)(nil), (*config.ShootClusterAPIControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootClusterAPIControllerConfiguration_To_config_ShootClusterAPIControllerConfiguration(a.(*ShootClusterAPIControllerConfiguration), b.(*config.ShootClusterAPIControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootClusterAPIControllerConfiguration)(nil), (*ShootClusterAPIControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootClusterAPIControllerConfiguration_To_v1alpha1_ShootClusterAPIControllerConfiguration(a.(*config.ShootClusterAPIControllerConfiguration), b.(*ShootClusterAPIControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootConditionsControllerConfiguration)(nil), (*config.ShootConditionsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(a.(*ShootConditionsControllerConfiguration), b.(*config.ShootConditionsControllerConfiguration), scope)
 	}); err != nil {
@@ -242,6 +323,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootGitOpsControllerConfiguration)(nil), (*config.ShootGitOpsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootGitOpsControllerConfiguration_To_config_ShootGitOpsControllerConfiguration(a.(*ShootGitOpsControllerConfiguration), b.(*config.ShootGitOpsControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootGitOpsControllerConfiguration)(nil), (*ShootGitOpsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootGitOpsControllerConfiguration_To_v1alpha1_ShootGitOpsControllerConfiguration(a.(*config.ShootGitOpsControllerConfiguration), b.(*ShootGitOpsControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootHibernationControllerConfiguration)(nil), (*config.ShootHibernationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(a.(*ShootHibernationControllerConfiguration), b.(*config.ShootHibernationControllerConfiguration), scope)
 	}); err != nil {
@@ -252,6 +343,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootIPAMControllerConfiguration)(nil), (*config.ShootIPAMControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootIPAMControllerConfiguration_To_config_ShootIPAMControllerConfiguration(a.(*ShootIPAMControllerConfiguration), b.(*config.ShootIPAMControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootIPAMControllerConfiguration)(nil), (*ShootIPAMControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootIPAMControllerConfiguration_To_v1alpha1_ShootIPAMControllerConfiguration(a.(*config.ShootIPAMControllerConfiguration), b.(*ShootIPAMControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceControllerConfiguration)(nil), (*config.ShootMaintenanceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(a.(*ShootMaintenanceControllerConfiguration), b.(*config.ShootMaintenanceControllerConfiguration), scope)
 	}); err != nil {
@@ -372,6 +473,26 @@ func Convert_config_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfile
 	return autoConvert_config_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfileControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterAuditPolicyControllerConfiguration_To_config_ClusterAuditPolicyControllerConfiguration(in *ClusterAuditPolicyControllerConfiguration, out *config.ClusterAuditPolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAuditPolicyControllerConfiguration_To_config_ClusterAuditPolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAuditPolicyControllerConfiguration_To_config_ClusterAuditPolicyControllerConfiguration(in *ClusterAuditPolicyControllerConfiguration, out *config.ClusterAuditPolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAuditPolicyControllerConfiguration_To_config_ClusterAuditPolicyControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ClusterAuditPolicyControllerConfiguration_To_v1alpha1_ClusterAuditPolicyControllerConfiguration(in *config.ClusterAuditPolicyControllerConfiguration, out *ClusterAuditPolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ClusterAuditPolicyControllerConfiguration_To_v1alpha1_ClusterAuditPolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ClusterAuditPolicyControllerConfiguration_To_v1alpha1_ClusterAuditPolicyControllerConfiguration(in *config.ClusterAuditPolicyControllerConfiguration, out *ClusterAuditPolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ClusterAuditPolicyControllerConfiguration_To_v1alpha1_ClusterAuditPolicyControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
@@ -430,6 +551,7 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 	} else {
 		out.LeaderElection = nil
 	}
+	out.Sharding = (*config.ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	out.LogLevel = in.LogLevel
 	out.LogFormat = in.LogFormat
 	if err := Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
@@ -469,6 +591,7 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 	} else {
 		out.LeaderElection = nil
 	}
+	out.Sharding = (*ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	out.LogLevel = in.LogLevel
 	out.LogFormat = in.LogFormat
 	if err := Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
@@ -496,10 +619,12 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.Bastion = (*config.BastionControllerConfiguration)(unsafe.Pointer(in.Bastion))
 	out.CertificateSigningRequest = (*config.CertificateSigningRequestControllerConfiguration)(unsafe.Pointer(in.CertificateSigningRequest))
 	out.CloudProfile = (*config.CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
+	out.ClusterAuditPolicy = (*config.ClusterAuditPolicyControllerConfiguration)(unsafe.Pointer(in.ClusterAuditPolicy))
 	out.ControllerDeployment = (*config.ControllerDeploymentControllerConfiguration)(unsafe.Pointer(in.ControllerDeployment))
 	out.ControllerRegistration = (*config.ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*config.EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*config.ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.NotificationSink = (*config.NotificationSinkControllerConfiguration)(unsafe.Pointer(in.NotificationSink))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(config.ProjectControllerConfiguration)
@@ -509,6 +634,8 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	} else {
 		out.Project = nil
 	}
+	out.ProjectInventory = (*config.ProjectInventoryControllerConfiguration)(unsafe.Pointer(in.ProjectInventory))
+	out.ProjectResourceUsage = (*config.ProjectResourceUsageControllerConfiguration)(unsafe.Pointer(in.ProjectResourceUsage))
 	out.Quota = (*config.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.SecretBinding = (*config.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.CredentialsBinding = (*config.CredentialsBindingControllerConfiguration)(unsafe.Pointer(in.CredentialsBinding))
@@ -526,6 +653,9 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ShootRetry = (*config.ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*config.ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootClusterAPI = (*config.ShootClusterAPIControllerConfiguration)(unsafe.Pointer(in.ShootClusterAPI))
+	out.ShootGitOps = (*config.ShootGitOpsControllerConfiguration)(unsafe.Pointer(in.ShootGitOps))
+	out.ShootIPAM = (*config.ShootIPAMControllerConfiguration)(unsafe.Pointer(in.ShootIPAM))
 	out.ManagedSeedSet = (*config.ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -539,10 +669,12 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.Bastion = (*BastionControllerConfiguration)(unsafe.Pointer(in.Bastion))
 	out.CertificateSigningRequest = (*CertificateSigningRequestControllerConfiguration)(unsafe.Pointer(in.CertificateSigningRequest))
 	out.CloudProfile = (*CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
+	out.ClusterAuditPolicy = (*ClusterAuditPolicyControllerConfiguration)(unsafe.Pointer(in.ClusterAuditPolicy))
 	out.ControllerDeployment = (*ControllerDeploymentControllerConfiguration)(unsafe.Pointer(in.ControllerDeployment))
 	out.ControllerRegistration = (*ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.NotificationSink = (*NotificationSinkControllerConfiguration)(unsafe.Pointer(in.NotificationSink))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -552,6 +684,8 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	} else {
 		out.Project = nil
 	}
+	out.ProjectInventory = (*ProjectInventoryControllerConfiguration)(unsafe.Pointer(in.ProjectInventory))
+	out.ProjectResourceUsage = (*ProjectResourceUsageControllerConfiguration)(unsafe.Pointer(in.ProjectResourceUsage))
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.CredentialsBinding = (*CredentialsBindingControllerConfiguration)(unsafe.Pointer(in.CredentialsBinding))
@@ -569,6 +703,9 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ShootRetry = (*ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootClusterAPI = (*ShootClusterAPIControllerConfiguration)(unsafe.Pointer(in.ShootClusterAPI))
+	out.ShootGitOps = (*ShootGitOpsControllerConfiguration)(unsafe.Pointer(in.ShootGitOps))
+	out.ShootIPAM = (*ShootIPAMControllerConfiguration)(unsafe.Pointer(in.ShootIPAM))
 	out.ManagedSeedSet = (*ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -660,6 +797,60 @@ func Convert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureCla
 	return autoConvert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureClassControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository(in *GitOpsRepository, out *config.GitOpsRepository, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Branch = (*string)(unsafe.Pointer(in.Branch))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.VerifyCommitSignatures = in.VerifyCommitSignatures
+	out.TrustedKeys = *(*[]string)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository is an autogenerated conversion function.
+func Convert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository(in *GitOpsRepository, out *config.GitOpsRepository, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository(in, out, s)
+}
+
+func autoConvert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository(in *config.GitOpsRepository, out *GitOpsRepository, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Branch = (*string)(unsafe.Pointer(in.Branch))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.VerifyCommitSignatures = in.VerifyCommitSignatures
+	out.TrustedKeys = *(*[]string)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository is an autogenerated conversion function.
+func Convert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository(in *config.GitOpsRepository, out *GitOpsRepository, s conversion.Scope) error {
+	return autoConvert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAMServer_To_config_IPAMServer(in *IPAMServer, out *config.IPAMServer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_IPAMServer_To_config_IPAMServer is an autogenerated conversion function.
+func Convert_v1alpha1_IPAMServer_To_config_IPAMServer(in *IPAMServer, out *config.IPAMServer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAMServer_To_config_IPAMServer(in, out, s)
+}
+
+func autoConvert_config_IPAMServer_To_v1alpha1_IPAMServer(in *config.IPAMServer, out *IPAMServer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_IPAMServer_To_v1alpha1_IPAMServer is an autogenerated conversion function.
+func Convert_config_IPAMServer_To_v1alpha1_IPAMServer(in *config.IPAMServer, out *IPAMServer, s conversion.Scope) error {
+	return autoConvert_config_IPAMServer_To_v1alpha1_IPAMServer(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(in *ManagedSeedSetControllerConfiguration, out *config.ManagedSeedSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxShootRetries = (*int)(unsafe.Pointer(in.MaxShootRetries))
@@ -684,6 +875,28 @@ func Convert_config_ManagedSeedSetControllerConfiguration_To_v1alpha1_ManagedSee
 	return autoConvert_config_ManagedSeedSetControllerConfiguration_To_v1alpha1_ManagedSeedSetControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NotificationSinkControllerConfiguration_To_config_NotificationSinkControllerConfiguration(in *NotificationSinkControllerConfiguration, out *config.NotificationSinkControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.ExpirationWarningPeriod = (*v1.Duration)(unsafe.Pointer(in.ExpirationWarningPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_NotificationSinkControllerConfiguration_To_config_NotificationSinkControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationSinkControllerConfiguration_To_config_NotificationSinkControllerConfiguration(in *NotificationSinkControllerConfiguration, out *config.NotificationSinkControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationSinkControllerConfiguration_To_config_NotificationSinkControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_NotificationSinkControllerConfiguration_To_v1alpha1_NotificationSinkControllerConfiguration(in *config.NotificationSinkControllerConfiguration, out *NotificationSinkControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.ExpirationWarningPeriod = (*v1.Duration)(unsafe.Pointer(in.ExpirationWarningPeriod))
+	return nil
+}
+
+// Convert_config_NotificationSinkControllerConfiguration_To_v1alpha1_NotificationSinkControllerConfiguration is an autogenerated conversion function.
+func Convert_config_NotificationSinkControllerConfiguration_To_v1alpha1_NotificationSinkControllerConfiguration(in *config.NotificationSinkControllerConfiguration, out *NotificationSinkControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_NotificationSinkControllerConfiguration_To_v1alpha1_NotificationSinkControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MinimumLifetimeDays = (*int)(unsafe.Pointer(in.MinimumLifetimeDays))
@@ -734,6 +947,50 @@ func Convert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectController
 	return autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProjectInventoryControllerConfiguration_To_config_ProjectInventoryControllerConfiguration(in *ProjectInventoryControllerConfiguration, out *config.ProjectInventoryControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectInventoryControllerConfiguration_To_config_ProjectInventoryControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectInventoryControllerConfiguration_To_config_ProjectInventoryControllerConfiguration(in *ProjectInventoryControllerConfiguration, out *config.ProjectInventoryControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectInventoryControllerConfiguration_To_config_ProjectInventoryControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProjectInventoryControllerConfiguration_To_v1alpha1_ProjectInventoryControllerConfiguration(in *config.ProjectInventoryControllerConfiguration, out *ProjectInventoryControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ProjectInventoryControllerConfiguration_To_v1alpha1_ProjectInventoryControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ProjectInventoryControllerConfiguration_To_v1alpha1_ProjectInventoryControllerConfiguration(in *config.ProjectInventoryControllerConfiguration, out *ProjectInventoryControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProjectInventoryControllerConfiguration_To_v1alpha1_ProjectInventoryControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProjectResourceUsageControllerConfiguration_To_config_ProjectResourceUsageControllerConfiguration(in *ProjectResourceUsageControllerConfiguration, out *config.ProjectResourceUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectResourceUsageControllerConfiguration_To_config_ProjectResourceUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectResourceUsageControllerConfiguration_To_config_ProjectResourceUsageControllerConfiguration(in *ProjectResourceUsageControllerConfiguration, out *config.ProjectResourceUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectResourceUsageControllerConfiguration_To_config_ProjectResourceUsageControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProjectResourceUsageControllerConfiguration_To_v1alpha1_ProjectResourceUsageControllerConfiguration(in *config.ProjectResourceUsageControllerConfiguration, out *ProjectResourceUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ProjectResourceUsageControllerConfiguration_To_v1alpha1_ProjectResourceUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ProjectResourceUsageControllerConfiguration_To_v1alpha1_ProjectResourceUsageControllerConfiguration(in *config.ProjectResourceUsageControllerConfiguration, out *ProjectResourceUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProjectResourceUsageControllerConfiguration_To_v1alpha1_ProjectResourceUsageControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaConfiguration, out *config.QuotaConfiguration, s conversion.Scope) error {
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
 		return err
@@ -913,6 +1170,48 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	return nil
+}
+
+// Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	return nil
+}
+
+// Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration is an autogenerated conversion function.
+func Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootClusterAPIControllerConfiguration_To_config_ShootClusterAPIControllerConfiguration(in *ShootClusterAPIControllerConfiguration, out *config.ShootClusterAPIControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootClusterAPIControllerConfiguration_To_config_ShootClusterAPIControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootClusterAPIControllerConfiguration_To_config_ShootClusterAPIControllerConfiguration(in *ShootClusterAPIControllerConfiguration, out *config.ShootClusterAPIControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootClusterAPIControllerConfiguration_To_config_ShootClusterAPIControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootClusterAPIControllerConfiguration_To_v1alpha1_ShootClusterAPIControllerConfiguration(in *config.ShootClusterAPIControllerConfiguration, out *ShootClusterAPIControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootClusterAPIControllerConfiguration_To_v1alpha1_ShootClusterAPIControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootClusterAPIControllerConfiguration_To_v1alpha1_ShootClusterAPIControllerConfiguration(in *config.ShootClusterAPIControllerConfiguration, out *ShootClusterAPIControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootClusterAPIControllerConfiguration_To_v1alpha1_ShootClusterAPIControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(in *ShootConditionsControllerConfiguration, out *config.ShootConditionsControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -933,6 +1232,36 @@ func Convert_config_ShootConditionsControllerConfiguration_To_v1alpha1_ShootCond
 	return autoConvert_config_ShootConditionsControllerConfiguration_To_v1alpha1_ShootConditionsControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootGitOpsControllerConfiguration_To_config_ShootGitOpsControllerConfiguration(in *ShootGitOpsControllerConfiguration, out *config.ShootGitOpsControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	if err := Convert_v1alpha1_GitOpsRepository_To_config_GitOpsRepository(&in.Repository, &out.Repository, s); err != nil {
+		return err
+	}
+	out.ProtectedFields = *(*[]string)(unsafe.Pointer(&in.ProtectedFields))
+	return nil
+}
+
+// Convert_v1alpha1_ShootGitOpsControllerConfiguration_To_config_ShootGitOpsControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootGitOpsControllerConfiguration_To_config_ShootGitOpsControllerConfiguration(in *ShootGitOpsControllerConfiguration, out *config.ShootGitOpsControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootGitOpsControllerConfiguration_To_config_ShootGitOpsControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootGitOpsControllerConfiguration_To_v1alpha1_ShootGitOpsControllerConfiguration(in *config.ShootGitOpsControllerConfiguration, out *ShootGitOpsControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	if err := Convert_config_GitOpsRepository_To_v1alpha1_GitOpsRepository(&in.Repository, &out.Repository, s); err != nil {
+		return err
+	}
+	out.ProtectedFields = *(*[]string)(unsafe.Pointer(&in.ProtectedFields))
+	return nil
+}
+
+// Convert_config_ShootGitOpsControllerConfiguration_To_v1alpha1_ShootGitOpsControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootGitOpsControllerConfiguration_To_v1alpha1_ShootGitOpsControllerConfiguration(in *config.ShootGitOpsControllerConfiguration, out *ShootGitOpsControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootGitOpsControllerConfiguration_To_v1alpha1_ShootGitOpsControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(in *ShootHibernationControllerConfiguration, out *config.ShootHibernationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.TriggerDeadlineDuration = (*v1.Duration)(unsafe.Pointer(in.TriggerDeadlineDuration))
@@ -955,10 +1284,39 @@ func Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHib
 	return autoConvert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootIPAMControllerConfiguration_To_config_ShootIPAMControllerConfiguration(in *ShootIPAMControllerConfiguration, out *config.ShootIPAMControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	if err := Convert_v1alpha1_IPAMServer_To_config_IPAMServer(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootIPAMControllerConfiguration_To_config_ShootIPAMControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootIPAMControllerConfiguration_To_config_ShootIPAMControllerConfiguration(in *ShootIPAMControllerConfiguration, out *config.ShootIPAMControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootIPAMControllerConfiguration_To_config_ShootIPAMControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootIPAMControllerConfiguration_To_v1alpha1_ShootIPAMControllerConfiguration(in *config.ShootIPAMControllerConfiguration, out *ShootIPAMControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	if err := Convert_config_IPAMServer_To_v1alpha1_IPAMServer(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ShootIPAMControllerConfiguration_To_v1alpha1_ShootIPAMControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootIPAMControllerConfiguration_To_v1alpha1_ShootIPAMControllerConfiguration(in *config.ShootIPAMControllerConfiguration, out *ShootIPAMControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootIPAMControllerConfiguration_To_v1alpha1_ShootIPAMControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *config.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.MaxConcurrentMaintenancesPerSeed = (*int)(unsafe.Pointer(in.MaxConcurrentMaintenancesPerSeed))
 	return nil
 }
 
@@ -971,6 +1329,7 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.MaxConcurrentMaintenancesPerSeed = (*int)(unsafe.Pointer(in.MaxConcurrentMaintenancesPerSeed))
 	return nil
 }
 
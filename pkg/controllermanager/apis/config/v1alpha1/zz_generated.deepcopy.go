@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -83,6 +84,27 @@ func (in *CloudProfileControllerConfiguration) DeepCopy() *CloudProfileControlle
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuditPolicyControllerConfiguration) DeepCopyInto(out *ClusterAuditPolicyControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuditPolicyControllerConfiguration.
+func (in *ClusterAuditPolicyControllerConfiguration) DeepCopy() *ClusterAuditPolicyControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuditPolicyControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -132,6 +154,11 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(configv1alpha1.LeaderElectionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(ShardingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Server.DeepCopyInto(&out.Server)
 	if in.Debugging != nil {
 		in, out := &in.Debugging, &out.Debugging
@@ -184,6 +211,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(CloudProfileControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterAuditPolicy != nil {
+		in, out := &in.ClusterAuditPolicy, &out.ClusterAuditPolicy
+		*out = new(ClusterAuditPolicyControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ControllerDeployment != nil {
 		in, out := &in.ControllerDeployment, &out.ControllerDeployment
 		*out = new(ControllerDeploymentControllerConfiguration)
@@ -204,11 +236,26 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ExposureClassControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NotificationSink != nil {
+		in, out := &in.NotificationSink, &out.NotificationSink
+		*out = new(NotificationSinkControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProjectInventory != nil {
+		in, out := &in.ProjectInventory, &out.ProjectInventory
+		*out = new(ProjectInventoryControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectResourceUsage != nil {
+		in, out := &in.ProjectResourceUsage, &out.ProjectResourceUsage
+		*out = new(ProjectResourceUsageControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
 		*out = new(QuotaControllerConfiguration)
@@ -266,6 +313,21 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootClusterAPI != nil {
+		in, out := &in.ShootClusterAPI, &out.ShootClusterAPI
+		*out = new(ShootClusterAPIControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootGitOps != nil {
+		in, out := &in.ShootGitOps, &out.ShootGitOps
+		*out = new(ShootGitOpsControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootIPAM != nil {
+		in, out := &in.ShootIPAM, &out.ShootIPAM
+		*out = new(ShootIPAMControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -373,6 +435,68 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitOpsRepository) DeepCopyInto(out *GitOpsRepository) {
+	*out = *in
+	if in.Branch != nil {
+		in, out := &in.Branch, &out.Branch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsRepository.
+func (in *GitOpsRepository) DeepCopy() *GitOpsRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMServer) DeepCopyInto(out *IPAMServer) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMServer.
+func (in *IPAMServer) DeepCopy() *IPAMServer {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -400,6 +524,32 @@ func (in *ManagedSeedSetControllerConfiguration) DeepCopy() *ManagedSeedSetContr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkControllerConfiguration) DeepCopyInto(out *NotificationSinkControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.ExpirationWarningPeriod != nil {
+		in, out := &in.ExpirationWarningPeriod, &out.ExpirationWarningPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkControllerConfiguration.
+func (in *NotificationSinkControllerConfiguration) DeepCopy() *NotificationSinkControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
@@ -448,6 +598,58 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectInventoryControllerConfiguration) DeepCopyInto(out *ProjectInventoryControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectInventoryControllerConfiguration.
+func (in *ProjectInventoryControllerConfiguration) DeepCopy() *ProjectInventoryControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectInventoryControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectResourceUsageControllerConfiguration) DeepCopyInto(out *ProjectResourceUsageControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectResourceUsageControllerConfiguration.
+func (in *ProjectResourceUsageControllerConfiguration) DeepCopy() *ProjectResourceUsageControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectResourceUsageControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -652,6 +854,48 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingConfiguration) DeepCopyInto(out *ShardingConfiguration) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingConfiguration.
+func (in *ShardingConfiguration) DeepCopy() *ShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClusterAPIControllerConfiguration) DeepCopyInto(out *ShootClusterAPIControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClusterAPIControllerConfiguration.
+func (in *ShootClusterAPIControllerConfiguration) DeepCopy() *ShootClusterAPIControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClusterAPIControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootConditionsControllerConfiguration) DeepCopyInto(out *ShootConditionsControllerConfiguration) {
 	*out = *in
@@ -673,6 +917,38 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootGitOpsControllerConfiguration) DeepCopyInto(out *ShootGitOpsControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.Repository.DeepCopyInto(&out.Repository)
+	if in.ProtectedFields != nil {
+		in, out := &in.ProtectedFields, &out.ProtectedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootGitOpsControllerConfiguration.
+func (in *ShootGitOpsControllerConfiguration) DeepCopy() *ShootGitOpsControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootGitOpsControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
@@ -699,6 +975,33 @@ func (in *ShootHibernationControllerConfiguration) DeepCopy() *ShootHibernationC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootIPAMControllerConfiguration) DeepCopyInto(out *ShootIPAMControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.Server.DeepCopyInto(&out.Server)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootIPAMControllerConfiguration.
+func (in *ShootIPAMControllerConfiguration) DeepCopy() *ShootIPAMControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootIPAMControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
@@ -717,6 +1020,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxConcurrentMaintenancesPerSeed != nil {
+		in, out := &in.MaxConcurrentMaintenancesPerSeed, &out.MaxConcurrentMaintenancesPerSeed
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
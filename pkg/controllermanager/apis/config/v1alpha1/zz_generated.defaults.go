@@ -36,6 +36,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.CloudProfile != nil {
 		SetDefaults_CloudProfileControllerConfiguration(in.Controllers.CloudProfile)
 	}
+	if in.Controllers.ClusterAuditPolicy != nil {
+		SetDefaults_ClusterAuditPolicyControllerConfiguration(in.Controllers.ClusterAuditPolicy)
+	}
 	if in.Controllers.ControllerDeployment != nil {
 		SetDefaults_ControllerDeploymentControllerConfiguration(in.Controllers.ControllerDeployment)
 	}
@@ -48,9 +51,18 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
+	if in.Controllers.NotificationSink != nil {
+		SetDefaults_NotificationSinkControllerConfiguration(in.Controllers.NotificationSink)
+	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
 	}
+	if in.Controllers.ProjectInventory != nil {
+		SetDefaults_ProjectInventoryControllerConfiguration(in.Controllers.ProjectInventory)
+	}
+	if in.Controllers.ProjectResourceUsage != nil {
+		SetDefaults_ProjectResourceUsageControllerConfiguration(in.Controllers.ProjectResourceUsage)
+	}
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
 	}
@@ -86,11 +98,23 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootClusterAPI != nil {
+		SetDefaults_ShootClusterAPIControllerConfiguration(in.Controllers.ShootClusterAPI)
+	}
+	if in.Controllers.ShootGitOps != nil {
+		SetDefaults_ShootGitOpsControllerConfiguration(in.Controllers.ShootGitOps)
+	}
+	if in.Controllers.ShootIPAM != nil {
+		SetDefaults_ShootIPAMControllerConfiguration(in.Controllers.ShootIPAM)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
 	if in.LeaderElection != nil {
 		SetDefaults_LeaderElectionConfiguration(in.LeaderElection)
 	}
+	if in.Sharding != nil {
+		SetDefaults_ShardingConfiguration(in.Sharding)
+	}
 	SetDefaults_ServerConfiguration(&in.Server)
 }
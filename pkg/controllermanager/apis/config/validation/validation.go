@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"net/url"
+	"strings"
+
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -29,10 +32,28 @@ func ValidateControllerManagerConfiguration(conf *config.ControllerManagerConfig
 		}
 	}
 
+	if conf.Sharding != nil {
+		allErrs = append(allErrs, validateShardingConfiguration(conf.Sharding, field.NewPath("sharding"))...)
+
+		if conf.Sharding.Enabled && (conf.LeaderElection == nil || conf.LeaderElection.ResourceNamespace == "") {
+			allErrs = append(allErrs, field.Required(field.NewPath("leaderElection", "resourceNamespace"), "must be set when sharding is enabled since the shard leases are maintained in this namespace"))
+		}
+	}
+
 	allErrs = append(allErrs, validateControllerManagerControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	return allErrs
 }
 
+func validateShardingConfiguration(conf *config.ShardingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Enabled && (conf.LeaseDuration == nil || conf.LeaseDuration.Duration <= 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaseDuration"), conf.LeaseDuration, "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
 func validateControllerManagerControllerConfiguration(conf config.ControllerManagerControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -41,6 +62,67 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.ShootGitOps != nil {
+		allErrs = append(allErrs, validateShootGitOpsControllerConfiguration(conf.ShootGitOps, fldPath.Child("shootGitOps"))...)
+	}
+
+	if conf.ShootIPAM != nil {
+		allErrs = append(allErrs, validateShootIPAMControllerConfiguration(conf.ShootIPAM, fldPath.Child("shootIPAM"))...)
+	}
+
+	allErrs = append(allErrs, validateShootMaintenanceControllerConfiguration(conf.ShootMaintenance, fldPath.Child("shootMaintenance"))...)
+
+	return allErrs
+}
+
+func validateShootMaintenanceControllerConfiguration(conf config.ShootMaintenanceControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxConcurrentMaintenancesPerSeed != nil && *conf.MaxConcurrentMaintenancesPerSeed <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMaintenancesPerSeed"), *conf.MaxConcurrentMaintenancesPerSeed, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateShootGitOpsControllerConfiguration(conf *config.ShootGitOpsControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(conf.Repository.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository", "url"), "must provide the URL of the Git repository"))
+	}
+
+	if secretRef := conf.Repository.SecretRef; secretRef != nil {
+		if len(secretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("repository", "secretRef", "name"), "must provide the name of the secret"))
+		}
+		if len(secretRef.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("repository", "secretRef", "namespace"), "must provide the namespace of the secret"))
+		}
+	}
+
+	if conf.Repository.VerifyCommitSignatures && len(conf.Repository.TrustedKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository", "trustedKeys"), "must provide the trusted keys if commit signatures are verified"))
+	}
+
+	for i, protectedField := range conf.ProtectedFields {
+		if path := strings.Split(protectedField, "."); len(path) < 2 || path[0] != "spec" || sets.New(path...).Has("") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("protectedFields").Index(i), protectedField, "must be a field path in the spec of Shoots, e.g., spec.kubernetes.version"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootIPAMControllerConfiguration(conf *config.ShootIPAMControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(conf.Server.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("server", "url"), "must provide the URL of the IPAM server"))
+	} else if u, err := url.Parse(conf.Server.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server", "url"), conf.Server.URL, "must be a valid https URL"))
+	}
+
 	return allErrs
 }
 
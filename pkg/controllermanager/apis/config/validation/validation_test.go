@@ -5,12 +5,16 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	componentbaseconfig "k8s.io/component-base/config"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -25,6 +29,44 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		}
 	})
 
+	Context("ShardingConfiguration", func() {
+		It("should pass because sharding is disabled", func() {
+			conf.Sharding = &config.ShardingConfiguration{}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should pass because the lease duration is valid", func() {
+			conf.Sharding = &config.ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{Duration: 15 * time.Second}}
+			conf.LeaderElection = &componentbaseconfig.LeaderElectionConfiguration{ResourceNamespace: "garden"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the namespace for the shard leases is not set", func() {
+			conf.Sharding = &config.ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{Duration: 15 * time.Second}}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("leaderElection.resourceNamespace"),
+				})),
+			))
+		})
+
+		It("should fail because the lease duration is not positive", func() {
+			conf.Sharding = &config.ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{}}
+			conf.LeaderElection = &componentbaseconfig.LeaderElectionConfiguration{ResourceNamespace: "garden"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("sharding.leaseDuration"),
+				})),
+			))
+		})
+	})
+
 	Context("ProjectControllerConfiguration", func() {
 		Context("ProjectQuotaConfiguration", func() {
 			BeforeEach(func() {
@@ -105,4 +147,126 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootGitOpsControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootGitOps = &config.ShootGitOpsControllerConfiguration{
+				Repository:      config.GitOpsRepository{URL: "https://git.example.com/shoots.git"},
+				ProtectedFields: []string{"spec.kubernetes.version", "spec.hibernation"},
+			}
+		})
+
+		It("should pass because the configuration is valid", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the repository URL is not specified", func() {
+			conf.Controllers.ShootGitOps.Repository.URL = ""
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootGitOps.repository.url"),
+				})),
+			))
+		})
+
+		It("should fail because the protected fields are no spec fields", func() {
+			conf.Controllers.ShootGitOps.ProtectedFields = []string{"spec", "metadata.labels", "spec..version"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootGitOps.protectedFields[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootGitOps.protectedFields[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootGitOps.protectedFields[2]"),
+				})),
+			))
+		})
+
+		It("should fail because the secret reference is incomplete", func() {
+			conf.Controllers.ShootGitOps.Repository.SecretRef = &corev1.SecretReference{}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootGitOps.repository.secretRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootGitOps.repository.secretRef.namespace"),
+				})),
+			))
+		})
+
+		It("should fail because commit signatures are verified without trusted keys", func() {
+			conf.Controllers.ShootGitOps.Repository.VerifyCommitSignatures = true
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootGitOps.repository.trustedKeys"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootIPAMControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootIPAM = &config.ShootIPAMControllerConfiguration{
+				Server: config.IPAMServer{URL: "https://ipam.example.com"},
+			}
+		})
+
+		It("should pass because the configuration is valid", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the server URL is not specified", func() {
+			conf.Controllers.ShootIPAM.Server.URL = ""
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootIPAM.server.url"),
+				})),
+			))
+		})
+
+		It("should fail because the server URL does not use https", func() {
+			conf.Controllers.ShootIPAM.Server.URL = "http://ipam.example.com"
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootIPAM.server.url"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		It("should pass because the maximum number of concurrent maintenances per seed is valid", func() {
+			conf.Controllers.ShootMaintenance.MaxConcurrentMaintenancesPerSeed = ptr.To(10)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the maximum number of concurrent maintenances per seed is not positive", func() {
+			conf.Controllers.ShootMaintenance.MaxConcurrentMaintenancesPerSeed = ptr.To(0)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.maxConcurrentMaintenancesPerSeed"),
+				})),
+			))
+		})
+	})
 })
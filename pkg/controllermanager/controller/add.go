@@ -15,17 +15,20 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/certificatesigningrequest"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/clusterauditpolicy"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerdeployment"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/credentialsbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/event"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/notificationsink"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 )
 
 // AddToManager adds all controller-manager controllers to the given manager.
@@ -35,6 +38,21 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed creating Kubernetes client: %w", err)
 	}
 
+	var shard *sharding.Shard
+	if cfg.Sharding != nil && cfg.Sharding.Enabled {
+		if cfg.LeaderElection == nil {
+			return fmt.Errorf("leader election configuration is required for determining the namespace of the shard leases")
+		}
+
+		shard = &sharding.Shard{
+			Config:    *cfg.Sharding,
+			Namespace: cfg.LeaderElection.ResourceNamespace,
+		}
+		if err := shard.AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding shard: %w", err)
+		}
+	}
+
 	if err := (&bastion.Reconciler{
 		Config: *cfg.Controllers.Bastion,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -54,6 +72,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding CloudProfile controller: %w", err)
 	}
 
+	if err := (&clusterauditpolicy.Reconciler{
+		Config: *cfg.Controllers.ClusterAuditPolicy,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding ClusterAuditPolicy controller: %w", err)
+	}
+
 	if err := (&controllerdeployment.Reconciler{
 		Config: *cfg.Controllers.ControllerDeployment,
 	}).AddToManager(mgr); err != nil {
@@ -90,7 +114,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding ManagedSeedSet controller: %w", err)
 	}
 
-	if err := project.AddToManager(ctx, mgr, *cfg); err != nil {
+	if err := (&notificationsink.Reconciler{
+		Config: *cfg.Controllers.NotificationSink,
+		Shard:  shard,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding NotificationSink controller: %w", err)
+	}
+
+	if err := project.AddToManager(ctx, mgr, *cfg, shard); err != nil {
 		return fmt.Errorf("failed adding Project controller: %w", err)
 	}
 
@@ -110,7 +141,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
-	if err := shoot.AddToManager(ctx, mgr, *cfg); err != nil {
+	if err := shoot.AddToManager(ctx, mgr, *cfg, shard); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
@@ -74,7 +74,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 
 		message := fmt.Sprintf("Cannot delete CloudProfile, because the following Shoots are still referencing it: %+v", associatedShoots)
-		r.Recorder.Event(cloudProfile, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
+		controllerutils.RecordEvent(ctx, r.Recorder, cloudProfile, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
 		return reconcile.Result{}, errors.New(message)
 	}
 
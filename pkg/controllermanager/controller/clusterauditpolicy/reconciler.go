@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterauditpolicy
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles ClusterAuditPolicy.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ClusterAuditPolicyControllerConfiguration
+	Recorder record.EventRecorder
+
+	// RateLimiter allows limiting exponential backoff for testing purposes
+	RateLimiter ratelimiter.RateLimiter
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	clusterAuditPolicy := &gardencorev1beta1.ClusterAuditPolicy{}
+	if err := r.Client.Get(ctx, request.NamespacedName, clusterAuditPolicy); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if clusterAuditPolicy.DeletionTimestamp != nil {
+		// Ignore the cluster audit policy if it has no gardener finalizer.
+		if !sets.New(clusterAuditPolicy.Finalizers...).Has(gardencorev1beta1.GardenerName) {
+			return reconcile.Result{}, nil
+		}
+
+		// Lookup shoots and seeds which reference the cluster audit policy. The finalizer will be only
+		// removed if there is no Shoot or Seed referencing the cluster audit policy anymore.
+		associatedShoots, err := controllerutils.DetermineShootsAssociatedTo(ctx, r.Client, clusterAuditPolicy)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		associatedSeeds, err := controllerutils.DetermineSeedAssociations(ctx, r.Client, clusterAuditPolicy)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if len(associatedShoots) == 0 && len(associatedSeeds) == 0 {
+			log.Info("No Shoots or Seeds are referencing ClusterAuditPolicy, deletion accepted")
+
+			if controllerutil.ContainsFinalizer(clusterAuditPolicy, gardencorev1beta1.GardenerName) {
+				log.Info("Removing finalizer")
+				if err := controllerutils.RemoveFinalizers(ctx, r.Client, clusterAuditPolicy, gardencorev1beta1.GardenerName); err != nil {
+					return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+				}
+			}
+
+			return reconcile.Result{}, nil
+		}
+
+		message := fmt.Sprintf("Cannot delete ClusterAuditPolicy, because it is still associated by the following Shoots: %+v and Seeds: %+v", associatedShoots, associatedSeeds)
+		controllerutils.RecordEvent(ctx, r.Recorder, clusterAuditPolicy, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
+		return reconcile.Result{}, fmt.Errorf(message)
+	}
+
+	if !controllerutil.ContainsFinalizer(clusterAuditPolicy, gardencorev1beta1.GardenerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.Client, clusterAuditPolicy, gardencorev1beta1.GardenerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not add finalizer: %w", err)
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
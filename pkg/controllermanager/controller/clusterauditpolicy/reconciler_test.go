@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterauditpolicy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/clusterauditpolicy"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Controller", func() {
+	const finalizerName = "gardener"
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler reconcile.Reconciler
+
+		clusterAuditPolicyName string
+		clusterAuditPolicy     *gardencorev1beta1.ClusterAuditPolicy
+		shoot                  *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		clusterAuditPolicyName = "test-clusterauditpolicy"
+		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
+		clusterAuditPolicy = &gardencorev1beta1.ClusterAuditPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterAuditPolicyName,
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-shoot",
+				Namespace: "test-namespace",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						AuditConfig: &gardencorev1beta1.AuditConfig{
+							AuditPolicy: &gardencorev1beta1.AuditPolicy{
+								ClusterAuditPolicyName: &clusterAuditPolicyName,
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should return nil because object is not found", func() {
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterAuditPolicy), &gardencorev1beta1.ClusterAuditPolicy{})).To(BeNotFoundError())
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when deletion timestamp is not set", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, clusterAuditPolicy)).To(Succeed())
+		})
+
+		It("should ensure the finalizer", func() {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterAuditPolicy), clusterAuditPolicy)).To(Succeed())
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterAuditPolicy.GetFinalizers()).Should(ConsistOf(finalizerName))
+		})
+	})
+
+	Context("when deletion timestamp is set", func() {
+		BeforeEach(func() {
+			clusterAuditPolicy.Finalizers = []string{finalizerName}
+
+			Expect(fakeClient.Create(ctx, clusterAuditPolicy)).To(Succeed())
+			Expect(fakeClient.Delete(ctx, clusterAuditPolicy)).To(Succeed())
+		})
+
+		It("should do nothing because finalizer is not present", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			patch := client.MergeFrom(clusterAuditPolicy.DeepCopy())
+			clusterAuditPolicy.Finalizers = []string{"test-finalizer"}
+			Expect(fakeClient.Patch(ctx, clusterAuditPolicy, patch)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return error because Shoot referencing ClusterAuditPolicy exists", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).To(MatchError(ContainSubstring("Cannot delete ClusterAuditPolicy")))
+		})
+
+		It("should return error because Seed referencing ClusterAuditPolicy exists", func() {
+			seed := &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-seed"},
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{
+						AuditPolicy: &gardencorev1beta1.SeedSettingAuditPolicy{ClusterAuditPolicyName: clusterAuditPolicyName},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).To(MatchError(ContainSubstring("Seeds: [test-seed]")))
+		})
+
+		It("should remove the finalizer because no Shoot or Seed is referencing the ClusterAuditPolicy", func() {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterAuditPolicyName}})
+			Expect(result).To(Equal(reconcile.Result{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterAuditPolicy), clusterAuditPolicy)).To(BeNotFoundError())
+		})
+	})
+})
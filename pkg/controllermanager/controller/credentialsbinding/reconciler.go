@@ -151,7 +151,7 @@ func (r *Reconciler) delete(ctx context.Context, credentialsBinding *securityv1a
 
 	if len(associatedShoots) != 0 {
 		message := fmt.Sprintf("Cannot delete CredentialsBinding, because the following Shoots are still referencing it: %+v", associatedShoots)
-		r.Recorder.Event(credentialsBinding, corev1.EventTypeWarning, v1beta1constants.EventResourceReferenced, message)
+		controllerutils.RecordEvent(ctx, r.Recorder, credentialsBinding, corev1.EventTypeWarning, v1beta1constants.EventResourceReferenced, message)
 		return errors.New(message)
 	}
 
@@ -77,7 +77,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 
 		message := fmt.Sprintf("Cannot delete ExposureClasss, because it is still associated by the following Shoots: %+v", associatedShoots)
-		r.Recorder.Event(exposureClass, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
+		controllerutils.RecordEvent(ctx, r.Recorder, exposureClass, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, message)
 		return reconcile.Result{}, fmt.Errorf(message)
 	}
 
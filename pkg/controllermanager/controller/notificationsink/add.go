@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink
+
+import (
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "notificationsink"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Sender == nil {
+		r.Sender = NewSender()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	b := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			NeedLeaderElection:      r.Shard.ControllerNeedLeaderElection(),
+		})
+
+	return r.Shard.Complete(b, &gardencorev1beta1.Shoot{}, r)
+}
+
+// ShootPredicate reacts on 'CREATE' Shoot events and on 'UPDATE' events which may change the active lifecycle events.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return lastOperationTypeAndState(shoot) != lastOperationTypeAndState(oldShoot) ||
+				shoot.Status.IsHibernated != oldShoot.Status.IsHibernated ||
+				v1beta1helper.HibernationIsEnabled(shoot) != v1beta1helper.HibernationIsEnabled(oldShoot) ||
+				shoot.Annotations[v1beta1constants.ShootExpirationTimestamp] != oldShoot.Annotations[v1beta1constants.ShootExpirationTimestamp]
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+func lastOperationTypeAndState(shoot *gardencorev1beta1.Shoot) string {
+	if shoot.Status.LastOperation == nil {
+		return ""
+	}
+	return string(shoot.Status.LastOperation.Type) + "/" + string(shoot.Status.LastOperation.State)
+}
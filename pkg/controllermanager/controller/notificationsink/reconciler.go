@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// AnnotationNotified is the annotation on Shoots containing the comma-separated list of currently active event types
+// which were already delivered to all matching sinks. Event types which were only delivered to some of the matching
+// sinks are recorded as `<event type>:<sink name>` for each of these sinks.
+const AnnotationNotified = "notification.gardener.cloud/notified"
+
+// Reconciler delivers lifecycle notifications of Shoots to the matching NotificationSinks. A notification is delivered
+// once an event becomes active for a Shoot, e.g., when it gets hibernated. Failed deliveries are retried with
+// exponential backoff. The deliveries are tracked per sink, i.e., a notification is not delivered to a sink again if
+// the delivery to another sink failed.
+// Shoots which do not have the AnnotationNotified annotation yet (e.g., all existing Shoots when the controller is
+// enabled for the first time) are only annotated with their currently active events, i.e., no notifications are
+// delivered for them.
+type Reconciler struct {
+	Client client.Client
+	Config config.NotificationSinkControllerConfiguration
+	Sender Sender
+	Clock  clock.Clock
+	Shard  *sharding.Shard
+}
+
+// Reconcile delivers the pending notifications of a Shoot.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		now                = r.Clock.Now()
+		active             = ActiveEvents(shoot, now, r.Config.ExpirationWarningPeriod.Duration)
+		notified, partial  = notifiedEvents(shoot, active)
+		result             = reconcile.Result{RequeueAfter: r.nextExpirationWarning(shoot, now)}
+		currentValue, seen = shoot.Annotations[AnnotationNotified]
+		deliveryErrs       []error
+	)
+
+	if !seen {
+		log.Info("Recording the active events of the shoot without delivering notifications since it was not tracked before", "eventTypes", sets.List(active))
+		notified = active.Clone()
+	}
+
+	if pending := active.Difference(notified); pending.Len() > 0 {
+		sinkList := &gardencorev1beta1.NotificationSinkList{}
+		if err := r.Client.List(ctx, sinkList); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed listing notification sinks: %w", err)
+		}
+
+		var projectName string
+		if len(sinkList.Items) > 0 {
+			project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed determining the project of the shoot: %w", err)
+			}
+			projectName = project.Name
+		}
+
+		for _, eventType := range sets.List(pending) {
+			notification := Notification{
+				EventType: eventType,
+				Project:   projectName,
+				Namespace: shoot.Namespace,
+				Name:      shoot.Name,
+				Purpose:   ptr.Deref(shoot.Spec.Purpose, ""),
+				Message:   message(shoot, eventType),
+			}
+
+			var (
+				deliveredSinks = partial[eventType]
+				failed         bool
+			)
+			if deliveredSinks == nil {
+				deliveredSinks = sets.New[string]()
+			}
+
+			for _, sink := range sinkList.Items {
+				if !Matches(sink.Spec.Filter, notification) || deliveredSinks.Has(sink.Name) {
+					continue
+				}
+
+				log.Info("Delivering notification", "notificationSink", sink.Name, "eventType", eventType)
+				if err := r.deliver(ctx, &sink, notification); err != nil {
+					failed = true
+					deliveryErrs = append(deliveryErrs, fmt.Errorf("failed delivering %s notification to sink %s: %w", eventType, sink.Name, err))
+					continue
+				}
+				deliveredSinks.Insert(sink.Name)
+			}
+
+			if failed {
+				partial[eventType] = deliveredSinks
+			} else {
+				notified.Insert(eventType)
+				delete(partial, eventType)
+			}
+		}
+	}
+
+	if value := notifiedValue(notified, partial); !seen || value != currentValue {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, AnnotationNotified, value)
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording the delivered notifications: %w", err)
+		}
+	}
+
+	if err := errors.Join(deliveryErrs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) deliver(ctx context.Context, sink *gardencorev1beta1.NotificationSink, notification Notification) error {
+	var credentials map[string][]byte
+	if secretRef := sink.Spec.SecretRef; secretRef != nil {
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, secret); err != nil {
+			return r.recordDelivery(ctx, sink, notification, fmt.Errorf("failed reading secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err))
+		}
+		credentials = secret.Data
+	}
+
+	return r.recordDelivery(ctx, sink, notification, r.Sender.Send(ctx, sink, credentials, notification))
+}
+
+func (r *Reconciler) recordDelivery(ctx context.Context, sink *gardencorev1beta1.NotificationSink, notification Notification, deliveryErr error) error {
+	delivery := &gardencorev1beta1.NotificationDelivery{
+		Shoot:     notification.Namespace + "/" + notification.Name,
+		EventType: notification.EventType,
+		Time:      metav1.NewTime(r.Clock.Now()),
+	}
+
+	patch := client.MergeFrom(sink.DeepCopy())
+	if deliveryErr != nil {
+		delivery.Message = ptr.To(deliveryErr.Error())
+		sink.Status.LastFailure = delivery
+	} else {
+		sink.Status.LastDelivery = delivery
+	}
+
+	if err := r.Client.Status().Patch(ctx, sink, patch); err != nil {
+		return errors.Join(deliveryErr, fmt.Errorf("failed updating status of sink: %w", err))
+	}
+
+	return deliveryErr
+}
+
+// nextExpirationWarning returns the duration until the ShootExpiring event becomes active, or zero if the Shoot does
+// not expire or the event is already active.
+func (r *Reconciler) nextExpirationWarning(shoot *gardencorev1beta1.Shoot, now time.Time) time.Duration {
+	expirationTime, ok := expirationTime(shoot)
+	if !ok {
+		return 0
+	}
+
+	if d := expirationTime.Add(-r.Config.ExpirationWarningPeriod.Duration).Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// ActiveEvents returns the event types which are currently active for the given Shoot.
+func ActiveEvents(shoot *gardencorev1beta1.Shoot, now time.Time, expirationWarningPeriod time.Duration) sets.Set[gardencorev1beta1.NotificationEventType] {
+	active := sets.New[gardencorev1beta1.NotificationEventType]()
+
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+		switch {
+		case lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded:
+			active.Insert(gardencorev1beta1.NotificationEventTypeShootCreated)
+		case (lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate || lastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile) &&
+			lastOperation.State == gardencorev1beta1.LastOperationStateFailed:
+			active.Insert(gardencorev1beta1.NotificationEventTypeShootReconcileFailed)
+		}
+	}
+
+	if shoot.Status.IsHibernated && v1beta1helper.HibernationIsEnabled(shoot) {
+		active.Insert(gardencorev1beta1.NotificationEventTypeShootHibernated)
+	}
+
+	if expirationTime, ok := expirationTime(shoot); ok && !now.Before(expirationTime.Add(-expirationWarningPeriod)) {
+		active.Insert(gardencorev1beta1.NotificationEventTypeShootExpiring)
+	}
+
+	return active
+}
+
+// Matches returns true if the given notification passes the filter.
+func Matches(filter *gardencorev1beta1.NotificationFilter, notification Notification) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Projects) > 0 && !slices.Contains(filter.Projects, notification.Project) {
+		return false
+	}
+	if len(filter.Purposes) > 0 && !slices.Contains(filter.Purposes, notification.Purpose) {
+		return false
+	}
+	if len(filter.EventTypes) > 0 && !slices.Contains(filter.EventTypes, notification.EventType) {
+		return false
+	}
+
+	return true
+}
+
+func message(shoot *gardencorev1beta1.Shoot, eventType gardencorev1beta1.NotificationEventType) string {
+	key := shoot.Namespace + "/" + shoot.Name
+
+	switch eventType {
+	case gardencorev1beta1.NotificationEventTypeShootCreated:
+		return fmt.Sprintf("Shoot %s was created successfully.", key)
+	case gardencorev1beta1.NotificationEventTypeShootReconcileFailed:
+		msg := fmt.Sprintf("Reconciliation of shoot %s failed", key)
+		if len(shoot.Status.LastErrors) > 0 {
+			return msg + ": " + shoot.Status.LastErrors[0].Description
+		}
+		if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.Description != "" {
+			return msg + ": " + shoot.Status.LastOperation.Description
+		}
+		return msg + "."
+	case gardencorev1beta1.NotificationEventTypeShootHibernated:
+		return fmt.Sprintf("Shoot %s was hibernated.", key)
+	case gardencorev1beta1.NotificationEventTypeShootExpiring:
+		expirationTime, _ := expirationTime(shoot)
+		return fmt.Sprintf("Shoot %s expires at %s and will be deleted afterwards.", key, expirationTime.UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("Shoot %s: %s", key, eventType)
+}
+
+func expirationTime(shoot *gardencorev1beta1.Shoot) (time.Time, bool) {
+	value, ok := shoot.Annotations[v1beta1constants.ShootExpirationTimestamp]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	expirationTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return expirationTime, true
+}
+
+// notifiedEvents returns the given active event types which were delivered to all matching sinks, and the sinks which
+// the other active event types were already delivered to.
+func notifiedEvents(shoot *gardencorev1beta1.Shoot, active sets.Set[gardencorev1beta1.NotificationEventType]) (sets.Set[gardencorev1beta1.NotificationEventType], map[gardencorev1beta1.NotificationEventType]sets.Set[string]) {
+	var (
+		notified = sets.New[gardencorev1beta1.NotificationEventType]()
+		partial  = map[gardencorev1beta1.NotificationEventType]sets.Set[string]{}
+	)
+
+	for _, entry := range strings.Split(shoot.Annotations[AnnotationNotified], ",") {
+		value, sinkName, isPartial := strings.Cut(entry, ":")
+		eventType := gardencorev1beta1.NotificationEventType(value)
+		if eventType == "" || !active.Has(eventType) {
+			continue
+		}
+
+		if !isPartial {
+			notified.Insert(eventType)
+			continue
+		}
+		if partial[eventType] == nil {
+			partial[eventType] = sets.New[string]()
+		}
+		partial[eventType].Insert(sinkName)
+	}
+
+	for eventType := range notified {
+		delete(partial, eventType)
+	}
+
+	return notified, partial
+}
+
+// notifiedValue returns the value of the AnnotationNotified annotation for the given notified event types.
+func notifiedValue(notified sets.Set[gardencorev1beta1.NotificationEventType], partial map[gardencorev1beta1.NotificationEventType]sets.Set[string]) string {
+	entries := toStrings(sets.List(notified))
+	for eventType, sinkNames := range partial {
+		for _, sinkName := range sets.List(sinkNames) {
+			entries = append(entries, string(eventType)+":"+sinkName)
+		}
+	}
+	slices.Sort(entries)
+
+	return strings.Join(entries, ",")
+}
+
+func toStrings(eventTypes []gardencorev1beta1.NotificationEventType) []string {
+	out := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		out = append(out, string(eventType))
+	}
+	return out
+}
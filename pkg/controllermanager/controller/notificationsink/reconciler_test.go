@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/notificationsink"
+)
+
+type delivery struct {
+	sink         string
+	credentials  map[string][]byte
+	notification Notification
+}
+
+type fakeSender struct {
+	deliveries   []delivery
+	err          error
+	failingSinks sets.Set[string]
+}
+
+func (f *fakeSender) Send(_ context.Context, sink *gardencorev1beta1.NotificationSink, credentials map[string][]byte, notification Notification) error {
+	f.deliveries = append(f.deliveries, delivery{sink: sink.Name, credentials: credentials, notification: notification})
+	if f.failingSinks.Has(sink.Name) {
+		return errors.New("fake")
+	}
+	return f.err
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		now        = time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC).Local()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		sender     *fakeSender
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+		webhook *gardencorev1beta1.NotificationSink
+		slack   *gardencorev1beta1.NotificationSink
+		secret  *corev1.Secret
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"notification.gardener.cloud/notified": ""},
+			},
+			Spec: gardencorev1beta1.ShootSpec{Purpose: ptr.To(gardencorev1beta1.ShootPurposeProduction)},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeCreate,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		webhook = &gardencorev1beta1.NotificationSink{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+			Spec: gardencorev1beta1.NotificationSinkSpec{
+				Webhook:   &gardencorev1beta1.NotificationSinkWebhook{URL: "https://example.com"},
+				SecretRef: &corev1.SecretReference{Name: "webhook", Namespace: "garden"},
+			},
+		}
+		slack = &gardencorev1beta1.NotificationSink{
+			ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+			Spec: gardencorev1beta1.NotificationSinkSpec{
+				Slack: &gardencorev1beta1.NotificationSinkSlack{},
+				Filter: &gardencorev1beta1.NotificationFilter{
+					Projects:   []string{"foo"},
+					EventTypes: []gardencorev1beta1.NotificationEventType{gardencorev1beta1.NotificationEventTypeShootReconcileFailed},
+				},
+			},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "garden"},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(project, shoot, webhook, slack, secret).
+			WithStatusSubresource(&gardencorev1beta1.NotificationSink{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		fakeClock = testclock.NewFakeClock(now)
+		sender = &fakeSender{}
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.NotificationSinkControllerConfiguration{ExpirationWarningPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+			Sender: sender,
+			Clock:  fakeClock,
+		}
+	})
+
+	getSink := func(sink *gardencorev1beta1.NotificationSink) *gardencorev1beta1.NotificationSink {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(sink), sink)).To(Succeed())
+		return sink
+	}
+
+	updateShoot := func(mutate func()) {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		mutate()
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+	}
+
+	It("should do nothing if the shoot does not exist", func() {
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(BeEmpty())
+	})
+
+	It("should deliver the notification to the matching sinks and record it", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(sender.deliveries).To(ConsistOf(delivery{
+			sink:        "webhook",
+			credentials: map[string][]byte{"token": []byte("secret")},
+			notification: Notification{
+				EventType: gardencorev1beta1.NotificationEventTypeShootCreated,
+				Project:   "foo",
+				Namespace: "garden-foo",
+				Name:      "bar",
+				Purpose:   gardencorev1beta1.ShootPurposeProduction,
+				Message:   "Shoot garden-foo/bar was created successfully.",
+			},
+		}))
+		Expect(getSink(webhook).Status.LastDelivery).To(Equal(&gardencorev1beta1.NotificationDelivery{
+			Shoot:     "garden-foo/bar",
+			EventType: gardencorev1beta1.NotificationEventTypeShootCreated,
+			Time:      metav1.NewTime(now),
+		}))
+		Expect(getSink(slack).Status.LastDelivery).To(BeNil())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", "ShootCreated"))
+	})
+
+	It("should not deliver notifications twice", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(sender.deliveries).To(HaveLen(1))
+	})
+
+	It("should deliver notifications for events which became active again", func() {
+		shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(2))
+
+		updateShoot(func() { shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded })
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", ""))
+
+		updateShoot(func() { shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed })
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(4))
+	})
+
+	It("should retry failed deliveries and record the failure", func() {
+		sender.err = errors.New("fake")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed delivering ShootCreated notification to sink webhook: fake")))
+		Expect(getSink(webhook).Status.LastFailure).To(Equal(&gardencorev1beta1.NotificationDelivery{
+			Shoot:     "garden-foo/bar",
+			EventType: gardencorev1beta1.NotificationEventTypeShootCreated,
+			Time:      metav1.NewTime(now),
+			Message:   ptr.To("fake"),
+		}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", ""))
+
+		sender.err = nil
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(2))
+		Expect(getSink(webhook).Status.LastDelivery).NotTo(BeNil())
+	})
+
+	It("should not deliver notifications again to sinks which already received them", func() {
+		shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		sender.failingSinks = sets.New("slack")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed delivering ShootReconcileFailed notification to sink slack: fake")))
+		Expect(sender.deliveries).To(HaveLen(2))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", "ShootReconcileFailed:webhook"))
+
+		sender.failingSinks = nil
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(3))
+		Expect(sender.deliveries[2].sink).To(Equal("slack"))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", "ShootReconcileFailed"))
+	})
+
+	It("should only record the active events of shoots which were not tracked before", func() {
+		updateShoot(func() { delete(shoot.Annotations, "notification.gardener.cloud/notified") })
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(BeEmpty())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("notification.gardener.cloud/notified", "ShootCreated"))
+
+		updateShoot(func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shoot.Status.IsHibernated = true
+		})
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(1))
+		Expect(sender.deliveries[0].notification.EventType).To(Equal(gardencorev1beta1.NotificationEventTypeShootHibernated))
+	})
+
+	It("should requeue until the expiration warning period starts", func() {
+		shoot.Annotations["shoot.gardener.cloud/expiration-timestamp"] = now.Add(36 * time.Hour).Format(time.RFC3339)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+		Expect(sender.deliveries).To(HaveLen(1))
+
+		fakeClock.Step(12 * time.Hour)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(sender.deliveries).To(HaveLen(2))
+		Expect(sender.deliveries[1].notification.EventType).To(Equal(gardencorev1beta1.NotificationEventTypeShootExpiring))
+		Expect(sender.deliveries[1].notification.Message).To(Equal("Shoot garden-foo/bar expires at 2024-07-03T00:00:00Z and will be deleted afterwards."))
+	})
+
+	Describe("#ActiveEvents", func() {
+		It("should return the active events", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shoot.Status.IsHibernated = true
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/expiration-timestamp": now.Add(time.Hour).Format(time.RFC3339)}
+
+			Expect(ActiveEvents(shoot, now, 24*time.Hour)).To(Equal(sets.New(
+				gardencorev1beta1.NotificationEventTypeShootReconcileFailed,
+				gardencorev1beta1.NotificationEventTypeShootHibernated,
+				gardencorev1beta1.NotificationEventTypeShootExpiring,
+			)))
+		})
+
+		It("should not return events for operations in progress", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+
+			Expect(ActiveEvents(shoot, now, 24*time.Hour)).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#Matches",
+		func(filter *gardencorev1beta1.NotificationFilter, matcher OmegaMatcher) {
+			Expect(Matches(filter, Notification{
+				EventType: gardencorev1beta1.NotificationEventTypeShootHibernated,
+				Project:   "foo",
+				Purpose:   gardencorev1beta1.ShootPurposeEvaluation,
+			})).To(matcher)
+		},
+
+		Entry("no filter", nil, BeTrue()),
+		Entry("matching filter", &gardencorev1beta1.NotificationFilter{
+			Projects:   []string{"foo"},
+			Purposes:   []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation},
+			EventTypes: []gardencorev1beta1.NotificationEventType{gardencorev1beta1.NotificationEventTypeShootHibernated},
+		}, BeTrue()),
+		Entry("other project", &gardencorev1beta1.NotificationFilter{Projects: []string{"bar"}}, BeFalse()),
+		Entry("other purpose", &gardencorev1beta1.NotificationFilter{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeProduction}}, BeFalse()),
+		Entry("other event type", &gardencorev1beta1.NotificationFilter{EventTypes: []gardencorev1beta1.NotificationEventType{gardencorev1beta1.NotificationEventTypeShootCreated}}, BeFalse()),
+	)
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should react on relevant changes only", func() {
+			oldShoot := shoot.DeepCopy()
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+
+			shoot.Status.IsHibernated = true
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+
+			shoot = oldShoot.DeepCopy()
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+
+			shoot = oldShoot.DeepCopy()
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/expiration-timestamp": now.Format(time.RFC3339)}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should ignore delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// DataKeyToken is the key in the secret of a webhook sink containing the bearer token.
+	DataKeyToken = "token"
+	// DataKeyURL is the key in the secret of a Slack sink containing the incoming webhook URL.
+	DataKeyURL = "url"
+	// DataKeyUsername is the key in the secret of an email sink containing the user name for the SMTP server.
+	DataKeyUsername = "username"
+	// DataKeyPassword is the key in the secret of an email sink containing the password for the SMTP server.
+	DataKeyPassword = "password"
+)
+
+// Notification is a Shoot lifecycle notification. It is posted as JSON to webhook sinks.
+type Notification struct {
+	// EventType is the event type of the notification.
+	EventType gardencorev1beta1.NotificationEventType `json:"eventType"`
+	// Project is the name of the project of the Shoot.
+	Project string `json:"project,omitempty"`
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Purpose is the purpose of the Shoot.
+	Purpose gardencorev1beta1.ShootPurpose `json:"purpose,omitempty"`
+	// Message is a human-readable description of the event.
+	Message string `json:"message"`
+}
+
+// Sender delivers notifications to a NotificationSink.
+type Sender interface {
+	// Send delivers the notification to the given sink. The credentials contain the data of the secret referenced by
+	// the sink, if any.
+	Send(ctx context.Context, sink *gardencorev1beta1.NotificationSink, credentials map[string][]byte, notification Notification) error
+}
+
+// deliveryTimeout is the maximum duration of the delivery of a notification.
+const deliveryTimeout = 10 * time.Second
+
+// NewSender returns a Sender which delivers notifications via HTTP and SMTP.
+func NewSender() Sender {
+	return &sender{
+		httpClient: &http.Client{Timeout: deliveryTimeout},
+		dialer:     &net.Dialer{Timeout: deliveryTimeout},
+	}
+}
+
+type sender struct {
+	httpClient *http.Client
+	dialer     *net.Dialer
+}
+
+func (s *sender) Send(ctx context.Context, sink *gardencorev1beta1.NotificationSink, credentials map[string][]byte, notification Notification) error {
+	switch {
+	case sink.Spec.Webhook != nil:
+		body, err := json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+
+		header := http.Header{}
+		if token := credentials[DataKeyToken]; len(token) > 0 {
+			header.Set("Authorization", "Bearer "+string(token))
+		}
+
+		return s.post(ctx, sink.Spec.Webhook.URL, header, body)
+
+	case sink.Spec.Slack != nil:
+		url := credentials[DataKeyURL]
+		if len(url) == 0 {
+			return fmt.Errorf("secret of sink does not contain the %q key", DataKeyURL)
+		}
+
+		payload := map[string]string{"text": fmt.Sprintf("*%s*: %s", notification.EventType, notification.Message)}
+		if channel := sink.Spec.Slack.Channel; channel != nil {
+			payload["channel"] = *channel
+		}
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		return s.post(ctx, string(url), http.Header{}, body)
+
+	case sink.Spec.Email != nil:
+		var (
+			email = sink.Spec.Email
+			auth  smtp.Auth
+		)
+
+		if username := credentials[DataKeyUsername]; len(username) > 0 {
+			auth = smtp.PlainAuth("", string(username), string(credentials[DataKeyPassword]), email.Host)
+		}
+
+		return s.sendMail(ctx, email, auth, emailMessage(email, notification))
+	}
+
+	return fmt.Errorf("sink does not specify a webhook, slack or email configuration")
+}
+
+func (s *sender) post(ctx context.Context, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
+
+// sendMail sends the given message via the SMTP server of the given email sink. In contrast to `smtp.SendMail`, it
+// aborts the delivery when the delivery timeout is exceeded or the given context is cancelled, so that an unresponsive
+// SMTP server does not block the reconciliation.
+func (s *sender) sendMail(ctx context.Context, email *gardencorev1beta1.NotificationSinkEmail, auth smtp.Auth, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	conn, err := s.dialer.DialContext(ctx, "tcp", net.JoinHostPort(email.Host, strconv.Itoa(int(email.Port))))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The SMTP client does not support contexts, hence the deadline of the context is enforced on the connection.
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, email.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: email.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server does not support authentication")
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(email.From); err != nil {
+		return err
+	}
+	for _, to := range email.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func emailMessage(email *gardencorev1beta1.NotificationSinkEmail, notification Notification) []byte {
+	var msg bytes.Buffer
+
+	fmt.Fprintf(&msg, "From: %s\r\n", email.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(email.To, ", "))
+	fmt.Fprintf(&msg, "Subject: [Gardener] %s: %s/%s\r\n", notification.EventType, notification.Namespace, notification.Name)
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&msg, "\r\n%s\r\n", notification.Message)
+
+	return msg.Bytes()
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notificationsink_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/notificationsink"
+)
+
+var _ = Describe("Sender", func() {
+	var (
+		ctx          = context.Background()
+		sender       Sender
+		server       *httptest.Server
+		requests     []*http.Request
+		bodies       []map[string]any
+		statusCode   int
+		notification Notification
+	)
+
+	BeforeEach(func() {
+		sender = NewSender()
+		requests, bodies, statusCode = nil, nil, http.StatusOK
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			data, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			body := map[string]any{}
+			Expect(json.Unmarshal(data, &body)).To(Succeed())
+
+			requests = append(requests, r)
+			bodies = append(bodies, body)
+			w.WriteHeader(statusCode)
+		}))
+		DeferCleanup(server.Close)
+
+		notification = Notification{
+			EventType: gardencorev1beta1.NotificationEventTypeShootHibernated,
+			Project:   "foo",
+			Namespace: "garden-foo",
+			Name:      "bar",
+			Message:   "Shoot garden-foo/bar was hibernated.",
+		}
+	})
+
+	Describe("webhook", func() {
+		var sink *gardencorev1beta1.NotificationSink
+
+		BeforeEach(func() {
+			sink = &gardencorev1beta1.NotificationSink{Spec: gardencorev1beta1.NotificationSinkSpec{
+				Webhook: &gardencorev1beta1.NotificationSinkWebhook{URL: server.URL},
+			}}
+		})
+
+		It("should post the notification with the bearer token", func() {
+			Expect(sender.Send(ctx, sink, map[string][]byte{"token": []byte("secret")}, notification)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(requests[0].Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(bodies[0]).To(Equal(map[string]any{
+				"eventType": "ShootHibernated",
+				"project":   "foo",
+				"namespace": "garden-foo",
+				"name":      "bar",
+				"message":   "Shoot garden-foo/bar was hibernated.",
+			}))
+		})
+
+		It("should fail for unsuccessful response codes", func() {
+			statusCode = http.StatusServiceUnavailable
+
+			Expect(sender.Send(ctx, sink, nil, notification)).To(MatchError(ContainSubstring("unexpected response code 503")))
+		})
+	})
+
+	Describe("slack", func() {
+		var sink *gardencorev1beta1.NotificationSink
+
+		BeforeEach(func() {
+			sink = &gardencorev1beta1.NotificationSink{Spec: gardencorev1beta1.NotificationSinkSpec{
+				Slack: &gardencorev1beta1.NotificationSinkSlack{Channel: ptr.To("#ops")},
+			}}
+		})
+
+		It("should post the notification to the incoming webhook", func() {
+			Expect(sender.Send(ctx, sink, map[string][]byte{"url": []byte(server.URL)}, notification)).To(Succeed())
+
+			Expect(bodies).To(ConsistOf(map[string]any{
+				"text":    "*ShootHibernated*: Shoot garden-foo/bar was hibernated.",
+				"channel": "#ops",
+			}))
+		})
+
+		It("should fail if the secret does not contain the URL", func() {
+			Expect(sender.Send(ctx, sink, nil, notification)).To(MatchError(ContainSubstring(`secret of sink does not contain the "url" key`)))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	Describe("email", func() {
+		var (
+			listener net.Listener
+			sink     *gardencorev1beta1.NotificationSink
+		)
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(listener.Close)
+
+			host, port, err := net.SplitHostPort(listener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			portNumber, err := strconv.Atoi(port)
+			Expect(err).NotTo(HaveOccurred())
+
+			sink = &gardencorev1beta1.NotificationSink{Spec: gardencorev1beta1.NotificationSinkSpec{
+				Email: &gardencorev1beta1.NotificationSinkEmail{
+					Host: host,
+					Port: int32(portNumber),
+					From: "gardener@example.com",
+					To:   []string{"ops@example.com"},
+				},
+			}}
+		})
+
+		It("should send the notification via SMTP", func() {
+			received := make(chan string, 1)
+			go serveSMTP(listener, received)
+
+			Expect(sender.Send(ctx, sink, nil, notification)).To(Succeed())
+
+			var msg string
+			Eventually(received).Should(Receive(&msg))
+			Expect(msg).To(ContainSubstring("Subject: [Gardener] ShootHibernated: garden-foo/bar"))
+			Expect(msg).To(ContainSubstring("Shoot garden-foo/bar was hibernated."))
+		})
+
+		It("should abort the delivery if the SMTP server does not respond", func() {
+			// Accept the connection but never send the greeting.
+			accepted := make(chan net.Conn, 1)
+			go func() {
+				if conn, err := listener.Accept(); err == nil {
+					accepted <- conn
+				}
+			}()
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			Expect(sender.Send(timeoutCtx, sink, nil, notification)).To(MatchError(ContainSubstring("i/o timeout")))
+
+			var conn net.Conn
+			Eventually(accepted).Should(Receive(&conn))
+			Expect(conn.Close()).To(Succeed())
+		})
+	})
+})
+
+// serveSMTP serves a single SMTP session on the given listener and sends the received message to the given channel.
+func serveSMTP(listener net.Listener, received chan<- string) {
+	defer GinkgoRecover()
+
+	conn, err := listener.Accept()
+	Expect(err).NotTo(HaveOccurred())
+	defer conn.Close()
+
+	var (
+		text = textproto.NewConn(conn)
+		msg  string
+	)
+
+	Expect(text.PrintfLine("220 localhost ESMTP")).To(Succeed())
+	for {
+		line, err := text.ReadLine()
+		Expect(err).NotTo(HaveOccurred())
+
+		switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+		case "EHLO", "HELO", "MAIL", "RCPT":
+			Expect(text.PrintfLine("250 OK")).To(Succeed())
+		case "DATA":
+			Expect(text.PrintfLine("354 Go ahead")).To(Succeed())
+			data, err := text.ReadDotBytes()
+			Expect(err).NotTo(HaveOccurred())
+			msg = string(data)
+			Expect(text.PrintfLine("250 OK")).To(Succeed())
+		case "QUIT":
+			Expect(text.PrintfLine("221 Bye")).To(Succeed())
+			received <- msg
+			return
+		default:
+			Expect(text.PrintfLine("502 Unknown command")).To(Succeed())
+		}
+	}
+}
@@ -12,12 +12,15 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/inventory"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/resourceusage"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 )
 
 // AddToManager adds all Project controllers to the given manager.
-func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration, shard *sharding.Shard) error {
 	if err := (&activity.Reconciler{
 		Config: *cfg.Controllers.Project,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -26,15 +29,35 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 
 	if err := (&project.Reconciler{
 		Config: *cfg.Controllers.Project,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
 
 	if err := (&stale.Reconciler{
 		Config: *cfg.Controllers.Project,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.ProjectInventory; config != nil {
+		if err := (&inventory.Reconciler{
+			Config: *config,
+			Shard:  shard,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding inventory reconciler: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.ProjectResourceUsage; config != nil {
+		if err := (&resourceusage.Reconciler{
+			Config: *config,
+			Shard:  shard,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding resource usage reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
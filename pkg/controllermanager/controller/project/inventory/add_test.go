@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/inventory"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+			},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for update events which do not change the inventory", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Progress: 50}
+			oldShoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Progress: 10}
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+		})
+
+		It("should return true for update events which change the inventory", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.31.0"
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+
+		It("should return true for update events which change the status label", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Labels = map[string]string{"shoot.gardener.cloud/status": "unhealthy"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToProject", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			project    *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			reconciler.Client = fakeClient
+
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}}
+		})
+
+		It("should do nothing if no related Project can be found", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, shoot)).To(BeEmpty())
+		})
+
+		It("should map the Shoot to the Project", func() {
+			project.Spec.Namespace = &shoot.Namespace
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}},
+			))
+		})
+	})
+})
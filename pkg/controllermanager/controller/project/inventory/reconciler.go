@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// ConfigMapName is the name of the config map in the project namespace which contains the inventory of the
+	// project's clusters.
+	ConfigMapName = "cluster-inventory"
+	// DataKeyClusters is the key in the inventory config map which contains the clusters of the project.
+	DataKeyClusters = "clusters.json"
+)
+
+// Reconciler reconciles Projects and maintains a config map with the inventory of their Shoots in the project
+// namespace.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectInventoryControllerConfiguration
+	Shard  *sharding.Shard
+}
+
+// Reconcile reconciles Projects and maintains a config map with the inventory of their Shoots in the project
+// namespace.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	clusters := make([]cluster, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		clusters = append(clusters, clusterFor(&shoot))
+	}
+	slices.SortFunc(clusters, func(a, b cluster) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	data, err := json.Marshal(inventory{Project: project.Name, Clusters: clusters})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: *project.Spec.Namespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.Client, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleClusterInventory)
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.ProjectName, project.Name)
+		configMap.Data = map[string]string{DataKeyClusters: string(data)}
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating inventory config map: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func clusterFor(shoot *gardencorev1beta1.Shoot) cluster {
+	c := cluster{
+		Name:              shoot.Name,
+		UID:               string(shoot.UID),
+		CreationTimestamp: shoot.CreationTimestamp,
+		CreatedBy:         shoot.Annotations[v1beta1constants.GardenCreatedBy],
+		KubernetesVersion: shoot.Spec.Kubernetes.Version,
+		Provider:          shoot.Spec.Provider.Type,
+		Region:            shoot.Spec.Region,
+		Seed:              shoot.Status.SeedName,
+		Hibernated:        shoot.Status.IsHibernated,
+		Status:            shoot.Labels[v1beta1constants.ShootStatus],
+	}
+
+	if shoot.Spec.Purpose != nil {
+		c.Purpose = string(*shoot.Spec.Purpose)
+	}
+	if c.Status == "" {
+		c.Status = string(gardenerutils.ShootStatusUnknown)
+	}
+
+	for _, address := range shoot.Status.AdvertisedAddresses {
+		c.Endpoints = append(c.Endpoints, endpoint{Name: address.Name, URL: address.URL})
+	}
+
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+		c.LastOperation = &operation{
+			Type:  string(lastOperation.Type),
+			State: string(lastOperation.State),
+		}
+	}
+
+	return c
+}
+
+type inventory struct {
+	Project  string    `json:"project"`
+	Clusters []cluster `json:"clusters"`
+}
+
+type cluster struct {
+	Name              string      `json:"name"`
+	UID               string      `json:"uid"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	CreatedBy         string      `json:"createdBy,omitempty"`
+	Purpose           string      `json:"purpose,omitempty"`
+	KubernetesVersion string      `json:"kubernetesVersion"`
+	Provider          string      `json:"provider"`
+	Region            string      `json:"region"`
+	Seed              *string     `json:"seed,omitempty"`
+	Hibernated        bool        `json:"hibernated"`
+	Status            string      `json:"status"`
+	Endpoints         []endpoint  `json:"endpoints,omitempty"`
+	LastOperation     *operation  `json:"lastOperation,omitempty"`
+}
+
+type endpoint struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type operation struct {
+	Type  string `json:"type"`
+	State string `json:"state"`
+}
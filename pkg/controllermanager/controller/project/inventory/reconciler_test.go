@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/inventory"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		project   *gardencorev1beta1.Project
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectInventoryControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Hour}},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cluster-inventory", Namespace: "garden-foo"}}
+	})
+
+	It("should do nothing if the project is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "bar"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the project does not have a namespace yet", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+
+	It("should publish the inventory of the project's shoots", func() {
+		for _, shoot := range []*gardencorev1beta1.Shoot{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "b",
+					Namespace:   "garden-foo",
+					UID:         "uid-b",
+					Labels:      map[string]string{"shoot.gardener.cloud/status": "healthy"},
+					Annotations: map[string]string{"gardener.cloud/created-by": "john.doe@example.com"},
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+					Provider:   gardencorev1beta1.Provider{Type: "aws"},
+					Purpose:    ptr.To(gardencorev1beta1.ShootPurposeProduction),
+					Region:     "eu-west-1",
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName: ptr.To("seed"),
+					AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+						{Name: "external", URL: "https://api.b.foo.example.com"},
+					},
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:     gardencorev1beta1.LastOperationTypeReconcile,
+						State:    gardencorev1beta1.LastOperationStateSucceeded,
+						Progress: 100,
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "garden-foo", UID: "uid-a"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.29.5"},
+					Provider:   gardencorev1beta1.Provider{Type: "gcp"},
+					Region:     "europe-west1",
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-bar"},
+			},
+		} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(configMap.Labels).To(And(
+			HaveKeyWithValue("gardener.cloud/role", "cluster-inventory"),
+			HaveKeyWithValue("project.gardener.cloud/name", "foo"),
+		))
+
+		inventory := map[string]any{}
+		Expect(json.Unmarshal([]byte(configMap.Data["clusters.json"]), &inventory)).To(Succeed())
+		Expect(inventory).To(Equal(map[string]any{
+			"project": "foo",
+			"clusters": []any{
+				map[string]any{
+					"name":              "a",
+					"uid":               "uid-a",
+					"creationTimestamp": nil,
+					"kubernetesVersion": "1.29.5",
+					"provider":          "gcp",
+					"region":            "europe-west1",
+					"hibernated":        false,
+					"status":            "unknown",
+				},
+				map[string]any{
+					"name":              "b",
+					"uid":               "uid-b",
+					"creationTimestamp": nil,
+					"createdBy":         "john.doe@example.com",
+					"purpose":           "production",
+					"kubernetesVersion": "1.30.1",
+					"provider":          "aws",
+					"region":            "eu-west-1",
+					"seed":              "seed",
+					"hibernated":        false,
+					"status":            "healthy",
+					"endpoints": []any{
+						map[string]any{"name": "external", "url": "https://api.b.foo.example.com"},
+					},
+					"lastOperation": map[string]any{"type": "Reconcile", "state": "Succeeded"},
+				},
+			},
+		}))
+	})
+
+	It("should publish an empty inventory if the project does not have shoots", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue("clusters.json", `{"project":"foo","clusters":[]}`))
+	})
+})
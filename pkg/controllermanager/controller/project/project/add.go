@@ -31,7 +31,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
 
-	return builder.
+	b := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Project{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
@@ -39,9 +39,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		Owns(&rbacv1.RoleBinding{}, builder.WithPredicates(r.RoleBindingPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			NeedLeaderElection:      r.Shard.ControllerNeedLeaderElection(),
 			RateLimiter:             r.RateLimiter,
-		}).
-		Complete(r)
+		})
+
+	return r.Shard.Complete(b, &gardencorev1beta1.Project{}, r)
 }
 
 // RoleBindingPredicate filters for events for RoleBindings that we might need to reconcile back.
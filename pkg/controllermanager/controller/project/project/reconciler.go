@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/garden/projectrbac"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -41,6 +42,7 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   config.ProjectControllerConfiguration
+	Shard    *sharding.Shard
 	Recorder record.EventRecorder
 
 	// RateLimiter allows limiting exponential backoff for testing purposes
@@ -101,19 +103,19 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	// - if it is not set, determine the namespace name based on project UID and create it
 	namespace, err := r.reconcileNamespaceForProject(ctx, log, project, ownerReference)
 	if err != nil {
-		r.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, err.Error())
+		controllerutils.RecordEvent(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, err.Error())
 		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 			log.Error(err, "Failed to update Project status")
 		}
 		return err
 	}
-	r.Recorder.Eventf(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventNamespaceReconcileSuccessful, "Successfully reconciled namespace %q for project", namespace.Name)
+	controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventNamespaceReconcileSuccessful, "Successfully reconciled namespace %q for project", namespace.Name)
 
 	// set the created namespace in spec.namespace
 	if project.Spec.Namespace == nil {
 		project.Spec.Namespace = ptr.To(namespace.Name)
 		if err := r.Client.Update(ctx, project); err != nil {
-			r.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, err.Error())
+			controllerutils.RecordEvent(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, err.Error())
 			if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 				log.Error(err, "Failed to update Project status")
 			}
@@ -124,7 +126,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	// Create ResourceQuota for project if configured.
 	quotaConfig, err := quotaConfigurationForProject(r.Config, project)
 	if err != nil {
-		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up ResourceQuota: %+v", err)
+		controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up ResourceQuota: %+v", err)
 		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 			log.Error(err, "Failed to update Project status")
 		}
@@ -133,7 +135,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 
 	if quotaConfig != nil {
 		if err := createOrUpdateResourceQuota(ctx, r.Client, namespace.Name, ownerReference, *quotaConfig); err != nil {
-			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up ResourceQuota: %+v", err)
+			controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up ResourceQuota: %+v", err)
 			if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 				log.Error(err, "Failed to update Project status")
 			}
@@ -144,7 +146,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	// Create RBAC rules to allow project members to interact with it.
 	rbac, err := projectrbac.New(r.Client, project)
 	if err != nil {
-		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while preparing for reconciling RBAC resources for namespace %q: %+v", namespace.Name, err)
+		controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while preparing for reconciling RBAC resources for namespace %q: %+v", namespace.Name, err)
 		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 			log.Error(err, "Failed to update Project status")
 		}
@@ -152,7 +154,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	}
 
 	if err := rbac.Deploy(ctx); err != nil {
-		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while reconciling RBAC resources for namespace %q: %+v", namespace.Name, err)
+		controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while reconciling RBAC resources for namespace %q: %+v", namespace.Name, err)
 		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 			log.Error(err, "Failed to update Project status")
 		}
@@ -160,7 +162,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	}
 
 	if err := rbac.DeleteStaleExtensionRolesResources(ctx); err != nil {
-		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while deleting stale RBAC rules for extension roles: %+v", err)
+		controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while deleting stale RBAC rules for extension roles: %+v", err)
 		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 			log.Error(err, "Failed to update Project status")
 		}
@@ -169,7 +171,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 
 	// Update the project status to mark it as 'ready'.
 	if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectReady); err != nil {
-		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while trying to mark project as ready: %+v", err)
+		controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while trying to mark project as ready: %+v", err)
 		return err
 	}
 
@@ -328,14 +330,14 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, project *garde
 		}
 
 		if inUse {
-			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceNotEmpty, "Cannot release namespace %q because it still contains Shoots", *namespace)
+			controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceNotEmpty, "Cannot release namespace %q because it still contains Shoots", *namespace)
 			log.Info("Cannot release Project Namespace because it still contains Shoots")
 			return reconcile.Result{Requeue: true}, patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectTerminating)
 		}
 
 		released, err := r.releaseNamespace(ctx, log, project, *namespace)
 		if err != nil {
-			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceDeletionFailed, "Failed to release project namespace %q: %v", *namespace, err)
+			controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceDeletionFailed, "Failed to release project namespace %q: %v", *namespace, err)
 			if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 				log.Error(err, "Failed to update Project status")
 			}
@@ -343,7 +345,7 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, project *garde
 		}
 
 		if !released {
-			r.Recorder.Eventf(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventNamespaceMarkedForDeletion, "Successfully marked project namespace %q for deletion", *namespace)
+			controllerutils.RecordEventf(ctx, r.Recorder, project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventNamespaceMarkedForDeletion, "Successfully marked project namespace %q for deletion", *namespace)
 			// Project will be enqueued again once project namespace is gone, but recheck every minute to be sure
 			return reconcile.Result{RequeueAfter: time.Minute}, patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectTerminating)
 		}
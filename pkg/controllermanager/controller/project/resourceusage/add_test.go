@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/resourceusage"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Status: gardencorev1beta1.ShootStatus{
+				ControlPlaneResourceUsage: &gardencorev1beta1.ControlPlaneResourceUsage{
+					Requests:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					LastUpdateTime: metav1.Now(),
+				},
+			},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for update events which only change the last update time of the resource usage", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.ControlPlaneResourceUsage.LastUpdateTime = metav1.NewTime(shoot.Status.ControlPlaneResourceUsage.LastUpdateTime.Add(time.Minute))
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+		})
+
+		It("should return true for update events which change the resource usage", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.ControlPlaneResourceUsage.Requests[corev1.ResourceCPU] = resource.MustParse("2")
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+
+		It("should return true for update events which publish the resource usage for the first time", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Status.ControlPlaneResourceUsage = nil
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToProject", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			project    *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+			reconciler.Client = fakeClient
+
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}}
+		})
+
+		It("should do nothing if no related Project can be found", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, shoot)).To(BeEmpty())
+		})
+
+		It("should map the Shoot to the Project", func() {
+			project.Spec.Namespace = &shoot.Namespace
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.MapShootToProject(ctx, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}},
+			))
+		})
+	})
+})
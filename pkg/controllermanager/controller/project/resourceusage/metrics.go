@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	metricsNamespace = "gardener_controller_manager"
+	metricsSubsystem = "project"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// ControlPlaneResourceRequests is the metric for the sum of the resource requests of the control planes of all
+	// Shoots of a Project. Exposed for testing.
+	ControlPlaneResourceRequests = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "control_plane_resource_requests",
+			Help:      "Sum of the resource requests of the control planes of all shoots of a project in the seed clusters (cores for cpu, bytes for memory).",
+		},
+		[]string{
+			"project",
+			"resource",
+		},
+	)
+
+	// ControlPlaneResourceLimits is the metric for the sum of the resource limits of the control planes of all Shoots
+	// of a Project. Exposed for testing.
+	ControlPlaneResourceLimits = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "control_plane_resource_limits",
+			Help:      "Sum of the resource limits of the control planes of all shoots of a project in the seed clusters (cores for cpu, bytes for memory).",
+		},
+		[]string{
+			"project",
+			"resource",
+		},
+	)
+
+	// ControlPlaneETCDStorage is the metric for the sum of the etcd storage capacity of the control planes of all
+	// Shoots of a Project. Exposed for testing.
+	ControlPlaneETCDStorage = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "control_plane_etcd_storage_bytes",
+			Help:      "Sum of the storage capacity of the etcds of the control planes of all shoots of a project in the seed clusters.",
+		},
+		[]string{
+			"project",
+		},
+	)
+)
+
+func recordResourceUsageMetrics(project string, usage *gardencorev1beta1.ControlPlaneResourceUsage) {
+	// Resources might have vanished from the usage, hence the existing series of the project are removed first.
+	deleteResourceUsageMetrics(project)
+
+	for name, quantity := range usage.Requests {
+		ControlPlaneResourceRequests.WithLabelValues(project, string(name)).Set(quantity.AsApproximateFloat64())
+	}
+	for name, quantity := range usage.Limits {
+		ControlPlaneResourceLimits.WithLabelValues(project, string(name)).Set(quantity.AsApproximateFloat64())
+	}
+	if usage.ETCDStorage != nil {
+		ControlPlaneETCDStorage.WithLabelValues(project).Set(usage.ETCDStorage.AsApproximateFloat64())
+	}
+}
+
+func deleteResourceUsageMetrics(project string) {
+	ControlPlaneResourceRequests.DeletePartialMatch(prometheus.Labels{"project": project})
+	ControlPlaneResourceLimits.DeletePartialMatch(prometheus.Labels{"project": project})
+	ControlPlaneETCDStorage.DeletePartialMatch(prometheus.Labels{"project": project})
+}
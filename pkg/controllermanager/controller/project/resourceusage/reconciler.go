@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Projects and aggregates the control plane resource usage of their Shoots in the Project status
+// and in metrics.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectResourceUsageControllerConfiguration
+	Clock  clock.Clock
+	Shard  *sharding.Shard
+}
+
+// Reconcile reconciles Projects and aggregates the control plane resource usage of their Shoots in the Project status
+// and in metrics.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteResourceUsageMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		deleteResourceUsageMetrics(project.Name)
+		return reconcile.Result{}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	usage := aggregateResourceUsage(shootList.Items)
+	recordResourceUsageMetrics(project.Name, usage)
+
+	if !resourceUsageEqual(project.Status.ControlPlaneResourceUsage, usage) {
+		log.V(1).Info("Updating control plane resource usage", "requests", usage.Requests, "limits", usage.Limits, "etcdStorage", usage.ETCDStorage)
+		usage.LastUpdateTime = metav1.NewTime(r.Clock.Now().UTC())
+
+		patch := client.MergeFrom(project.DeepCopy())
+		project.Status.ControlPlaneResourceUsage = usage
+		if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating control plane resource usage: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func aggregateResourceUsage(shoots []gardencorev1beta1.Shoot) *gardencorev1beta1.ControlPlaneResourceUsage {
+	var (
+		requests    = corev1.ResourceList{}
+		limits      = corev1.ResourceList{}
+		etcdStorage = resource.Quantity{Format: resource.BinarySI}
+	)
+
+	for _, shoot := range shoots {
+		shootUsage := shoot.Status.ControlPlaneResourceUsage
+		if shootUsage == nil {
+			continue
+		}
+
+		addResourceList(requests, shootUsage.Requests)
+		addResourceList(limits, shootUsage.Limits)
+		if shootUsage.ETCDStorage != nil {
+			etcdStorage.Add(*shootUsage.ETCDStorage)
+		}
+	}
+
+	return &gardencorev1beta1.ControlPlaneResourceUsage{
+		Requests:    requests,
+		Limits:      limits,
+		ETCDStorage: &etcdStorage,
+	}
+}
+
+func addResourceList(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		value := list[name]
+		value.Add(quantity)
+		list[name] = value
+	}
+}
+
+// resourceUsageEqual returns whether the given resource usages are equal. The last update time is not considered.
+func resourceUsageEqual(a, b *gardencorev1beta1.ControlPlaneResourceUsage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return apiequality.Semantic.DeepEqual(a.Requests, b.Requests) &&
+		apiequality.Semantic.DeepEqual(a.Limits, b.Limits) &&
+		apiequality.Semantic.DeepEqual(a.ETCDStorage, b.ETCDStorage)
+}
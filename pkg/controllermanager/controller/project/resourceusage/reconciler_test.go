@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/resourceusage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Project{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectResourceUsageControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Hour}},
+			Clock:  fakeClock,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+
+		DeferCleanup(func() {
+			ControlPlaneResourceRequests.Reset()
+			ControlPlaneResourceLimits.Reset()
+			ControlPlaneETCDStorage.Reset()
+		})
+	})
+
+	It("should do nothing if the project is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "bar"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the project does not have a namespace yet", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.ControlPlaneResourceUsage).To(BeNil())
+	})
+
+	It("should aggregate the resource usage of the project's shoots", func() {
+		for _, shoot := range []*gardencorev1beta1.Shoot{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "garden-foo"},
+				Status: gardencorev1beta1.ShootStatus{ControlPlaneResourceUsage: &gardencorev1beta1.ControlPlaneResourceUsage{
+					Requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+					Limits:      corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+					ETCDStorage: ptr.To(resource.MustParse("35Gi")),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "garden-foo"},
+				Status: gardencorev1beta1.ShootStatus{ControlPlaneResourceUsage: &gardencorev1beta1.ControlPlaneResourceUsage{
+					Requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+					Limits:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+					ETCDStorage: ptr.To(resource.MustParse("10Gi")),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "garden-foo"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "garden-bar"},
+				Status: gardencorev1beta1.ShootStatus{ControlPlaneResourceUsage: &gardencorev1beta1.ControlPlaneResourceUsage{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+				}},
+			},
+		} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		usage := project.Status.ControlPlaneResourceUsage
+		Expect(usage).NotTo(BeNil())
+		Expect(usage.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		Expect(usage.Requests.Cpu().Cmp(resource.MustParse("2"))).To(Equal(0))
+		Expect(usage.Requests.Memory().Cmp(resource.MustParse("3Gi"))).To(Equal(0))
+		Expect(usage.Limits.Cpu().Cmp(resource.MustParse("1"))).To(Equal(0))
+		Expect(usage.Limits.Memory().Cmp(resource.MustParse("6Gi"))).To(Equal(0))
+		Expect(usage.ETCDStorage.Cmp(resource.MustParse("45Gi"))).To(Equal(0))
+
+		Expect(testutil.ToFloat64(ControlPlaneResourceRequests.WithLabelValues("foo", "cpu"))).To(Equal(float64(2)))
+		Expect(testutil.ToFloat64(ControlPlaneResourceRequests.WithLabelValues("foo", "memory"))).To(Equal(float64(3 << 30)))
+		Expect(testutil.ToFloat64(ControlPlaneResourceLimits.WithLabelValues("foo", "cpu"))).To(Equal(float64(1)))
+		Expect(testutil.ToFloat64(ControlPlaneResourceLimits.WithLabelValues("foo", "memory"))).To(Equal(float64(6 << 30)))
+		Expect(testutil.ToFloat64(ControlPlaneETCDStorage.WithLabelValues("foo"))).To(Equal(float64(45 << 30)))
+	})
+
+	It("should not update the project status if the resource usage did not change", func() {
+		lastUpdateTime := metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+		project.Status.ControlPlaneResourceUsage = &gardencorev1beta1.ControlPlaneResourceUsage{
+			Requests:       corev1.ResourceList{},
+			Limits:         corev1.ResourceList{},
+			ETCDStorage:    ptr.To(resource.MustParse("0")),
+			LastUpdateTime: lastUpdateTime,
+		}
+		Expect(fakeClient.Status().Update(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.ControlPlaneResourceUsage.LastUpdateTime.Time.Equal(lastUpdateTime.Time)).To(BeTrue())
+	})
+
+	It("should remove the metrics of a deleted project", func() {
+		ControlPlaneResourceRequests.WithLabelValues("bar", "cpu").Set(1)
+		ControlPlaneETCDStorage.WithLabelValues("bar").Set(1)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "bar"}})).To(Equal(reconcile.Result{}))
+
+		Expect(testutil.CollectAndCount(ControlPlaneResourceRequests)).To(BeZero())
+		Expect(testutil.CollectAndCount(ControlPlaneETCDStorage)).To(BeZero())
+	})
+})
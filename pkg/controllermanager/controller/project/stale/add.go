@@ -30,14 +30,16 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Clock = clock.RealClock{}
 	}
 
-	return builder.
+	b := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Project{}, builder.WithPredicates(r.ProjectPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
-		}).
-		Complete(r)
+			NeedLeaderElection:      r.Shard.ControllerNeedLeaderElection(),
+		})
+
+	return r.Shard.Complete(b, &gardencorev1beta1.Project{}, r)
 }
 
 // ProjectPredicate returns true for 'CREATE' events. For 'UPDATE' events, it returns true when the
@@ -110,15 +110,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	switch {
 	case len(notValid) != 0:
-		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "NotAllExtensionsValid", fmt.Sprintf("Some extensions are not valid: %+v", notValid))
+		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, gardencorev1beta1.NotAllExtensionsValid, fmt.Sprintf("Some extensions are not valid: %+v", notValid))
 	case len(notInstalled) != 0:
-		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "NotAllExtensionsInstalled", fmt.Sprintf("Some extensions are not installed: %+v", notInstalled))
+		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, gardencorev1beta1.NotAllExtensionsInstalled, fmt.Sprintf("Some extensions are not installed: %+v", notInstalled))
 	case len(notHealthy) != 0:
-		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "NotAllExtensionsHealthy", fmt.Sprintf("Some extensions are not healthy: %+v", notHealthy))
+		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, gardencorev1beta1.NotAllExtensionsHealthy, fmt.Sprintf("Some extensions are not healthy: %+v", notHealthy))
 	case len(progressing) != 0:
-		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, "SomeExtensionsProgressing", fmt.Sprintf("Some extensions are progressing: %+v", progressing))
+		condition = utils.SetToProgressingOrFalse(r.Clock, extensionsReadyThreshold, condition, gardencorev1beta1.SomeExtensionsProgressing, fmt.Sprintf("Some extensions are progressing: %+v", progressing))
 	default:
-		condition = helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "AllExtensionsReady", "All extensions installed into the seed cluster are ready and healthy.")
+		condition = helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.AllExtensionsReady, "All extensions installed into the seed cluster are ready and healthy.")
 	}
 
 	if err := utils.PatchSeedCondition(ctx, log, r.Client.Status(), seed, condition); err != nil {
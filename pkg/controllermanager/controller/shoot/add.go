@@ -11,17 +11,54 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/clusterapi"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/gitops"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/ipam"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
-func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration, shard *sharding.Shard) error {
+	if config := cfg.Controllers.ShootClusterAPI; config != nil {
+		if err := (&clusterapi.Reconciler{
+			Config: *config,
+			Shard:  shard,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding clusterapi reconciler: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.ShootGitOps; config != nil {
+		if err := (&gitops.Reconciler{
+			Config: *config,
+			Shard:  shard,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding gitops reconciler: %w", err)
+		}
+	}
+
+	if config := cfg.Controllers.ShootIPAM; config != nil {
+		if err := (&ipam.Reconciler{
+			Config: *config,
+			Shard:  shard,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding ipam reconciler: %w", err)
+		}
+
+		if err := (&ipam.GarbageCollector{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding ipam garbage collector: %w", err)
+		}
+	}
+
 	if err := (&conditions.Reconciler{
 		Config: *cfg.Controllers.ShootConditions,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -30,18 +67,21 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 
 	if err := (&hibernation.Reconciler{
 		Config: cfg.Controllers.ShootHibernation,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding hibernation reconciler: %w", err)
 	}
 
 	if err := (&maintenance.Reconciler{
 		Config: cfg.Controllers.ShootMaintenance,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
 	}
 
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.ShootQuota,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding quota reconciler: %w", err)
 	}
@@ -52,12 +92,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 
 	if err := (&retry.Reconciler{
 		Config: *cfg.Controllers.ShootRetry,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding retry reconciler: %w", err)
 	}
 
 	if err := (&statuslabel.Reconciler{
 		Config: *cfg.Controllers.ShootStatusLabel,
+		Shard:  shard,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding statuslabel reconciler: %w", err)
 	}
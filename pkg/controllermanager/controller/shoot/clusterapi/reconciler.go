@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// LabelClusterName is the label used by Cluster API to associate objects with a Cluster.
+	LabelClusterName = "cluster.x-k8s.io/cluster-name"
+	// LabelDeploymentName is the label used by Cluster API to associate Machines with a MachineDeployment.
+	LabelDeploymentName = "cluster.x-k8s.io/deployment-name"
+	// AnnotationNodeGroupMinSize is the annotation used by the Cluster API provider of the cluster-autoscaler for the
+	// minimum size of a MachineDeployment.
+	AnnotationNodeGroupMinSize = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
+	// AnnotationNodeGroupMaxSize is the annotation used by the Cluster API provider of the cluster-autoscaler for the
+	// maximum size of a MachineDeployment.
+	AnnotationNodeGroupMaxSize = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
+
+	// ClusterPhasePending is the Cluster API phase of a Shoot which has not been processed yet.
+	ClusterPhasePending = "Pending"
+	// ClusterPhaseProvisioning is the Cluster API phase of a Shoot which is being created.
+	ClusterPhaseProvisioning = "Provisioning"
+	// ClusterPhaseProvisioned is the Cluster API phase of a Shoot which was created successfully.
+	ClusterPhaseProvisioned = "Provisioned"
+	// ClusterPhaseDeleting is the Cluster API phase of a Shoot which is being deleted.
+	ClusterPhaseDeleting = "Deleting"
+	// ClusterPhaseFailed is the Cluster API phase of a Shoot whose last operation failed.
+	ClusterPhaseFailed = "Failed"
+)
+
+var (
+	// GroupVersion is the API group and version of the Cluster API resources managed by this controller.
+	GroupVersion = schema.GroupVersion{Group: "cluster.x-k8s.io", Version: "v1beta1"}
+	// ClusterGVK is the GroupVersionKind of Cluster API Clusters.
+	ClusterGVK = GroupVersion.WithKind("Cluster")
+	// MachineDeploymentGVK is the GroupVersionKind of Cluster API MachineDeployments.
+	MachineDeploymentGVK = GroupVersion.WithKind("MachineDeployment")
+)
+
+// Reconciler projects Shoots into read-only Cluster API Cluster and MachineDeployment objects in the project
+// namespace. The objects are paused, i.e., Cluster API controllers do not act on them, and they are owned by the Shoot,
+// i.e., they are garbage collected together with it.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootClusterAPIControllerConfiguration
+	Shard  *sharding.Shard
+}
+
+// Reconcile projects the Shoot into Cluster API objects.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if err := r.reconcileCluster(ctx, shoot); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling Cluster: %w", err)
+	}
+
+	if err := r.reconcileMachineDeployments(ctx, shoot); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reconciling MachineDeployments: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) reconcileCluster(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(ClusterGVK)
+	cluster.SetName(shoot.Name)
+	cluster.SetNamespace(shoot.Namespace)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.Client, cluster, func() error {
+		cluster.SetLabels(withLabel(cluster.GetLabels(), LabelClusterName, shoot.Name))
+
+		spec := map[string]interface{}{
+			"paused": true,
+		}
+		if networking := clusterNetwork(shoot); len(networking) > 0 {
+			spec["clusterNetwork"] = networking
+		}
+		if endpoint := controlPlaneEndpoint(shoot); endpoint != nil {
+			spec["controlPlaneEndpoint"] = endpoint
+		}
+		if err := unstructured.SetNestedField(cluster.Object, spec, "spec"); err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(shoot, cluster, r.Client.Scheme())
+	}); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cluster.DeepCopy())
+	if err := unstructured.SetNestedField(cluster.Object, map[string]interface{}{
+		"phase":               ClusterPhase(shoot),
+		"infrastructureReady": infrastructureReady(shoot),
+		"controlPlaneReady":   controlPlaneReady(shoot),
+	}, "status"); err != nil {
+		return err
+	}
+
+	return r.Client.Status().Patch(ctx, cluster, patch)
+}
+
+func (r *Reconciler) reconcileMachineDeployments(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	desiredNames := sets.New[string]()
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		machineDeployment := &unstructured.Unstructured{}
+		machineDeployment.SetGroupVersionKind(MachineDeploymentGVK)
+		machineDeployment.SetName(shoot.Name + "-" + worker.Name)
+		machineDeployment.SetNamespace(shoot.Namespace)
+		desiredNames.Insert(machineDeployment.GetName())
+
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.Client, machineDeployment, func() error {
+			machineDeployment.SetLabels(withLabel(machineDeployment.GetLabels(), LabelClusterName, shoot.Name))
+
+			annotations := machineDeployment.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[AnnotationNodeGroupMinSize] = strconv.Itoa(int(worker.Minimum))
+			annotations[AnnotationNodeGroupMaxSize] = strconv.Itoa(int(worker.Maximum))
+			machineDeployment.SetAnnotations(annotations)
+
+			selectorLabels := map[string]interface{}{
+				LabelClusterName:    shoot.Name,
+				LabelDeploymentName: machineDeployment.GetName(),
+			}
+
+			replicas := int64(worker.Minimum)
+			if v1beta1helper.HibernationIsEnabled(shoot) {
+				replicas = 0
+			}
+
+			machineSpec := map[string]interface{}{
+				"clusterName": shoot.Name,
+				"bootstrap":   map[string]interface{}{},
+				// There is no Cluster API infrastructure provider for Gardener-managed machines, hence the Shoot
+				// itself is referenced as the source of truth for the infrastructure.
+				"infrastructureRef": map[string]interface{}{
+					"apiVersion": gardencorev1beta1.SchemeGroupVersion.String(),
+					"kind":       "Shoot",
+					"name":       shoot.Name,
+					"namespace":  shoot.Namespace,
+				},
+			}
+			if version := kubernetesVersion(shoot, worker); version != "" {
+				machineSpec["version"] = version
+			}
+
+			if err := unstructured.SetNestedField(machineDeployment.Object, map[string]interface{}{
+				"clusterName": shoot.Name,
+				"paused":      true,
+				"replicas":    replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": selectorLabels,
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": selectorLabels,
+					},
+					"spec": machineSpec,
+				},
+			}, "spec"); err != nil {
+				return err
+			}
+
+			return controllerutil.SetControllerReference(shoot, machineDeployment, r.Client.Scheme())
+		}); err != nil {
+			return fmt.Errorf("failed reconciling MachineDeployment %s: %w", client.ObjectKeyFromObject(machineDeployment), err)
+		}
+	}
+
+	machineDeploymentList := &unstructured.UnstructuredList{}
+	machineDeploymentList.SetGroupVersionKind(MachineDeploymentGVK.GroupVersion().WithKind(MachineDeploymentGVK.Kind + "List"))
+	if err := r.Client.List(ctx, machineDeploymentList, client.InNamespace(shoot.Namespace), client.MatchingLabels{LabelClusterName: shoot.Name}); err != nil {
+		return fmt.Errorf("failed listing MachineDeployments: %w", err)
+	}
+
+	for _, machineDeployment := range machineDeploymentList.Items {
+		if desiredNames.Has(machineDeployment.GetName()) || !metav1.IsControlledBy(&machineDeployment, shoot) {
+			continue
+		}
+
+		if err := r.Client.Delete(ctx, &machineDeployment); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting MachineDeployment %s: %w", client.ObjectKeyFromObject(&machineDeployment), err)
+		}
+	}
+
+	return nil
+}
+
+// ClusterPhase computes the Cluster API phase of the given Shoot.
+func ClusterPhase(shoot *gardencorev1beta1.Shoot) string {
+	if shoot.DeletionTimestamp != nil {
+		return ClusterPhaseDeleting
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil {
+		return ClusterPhasePending
+	}
+
+	switch {
+	case lastOperation.State == gardencorev1beta1.LastOperationStateFailed:
+		return ClusterPhaseFailed
+	case lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded:
+		return ClusterPhaseProvisioning
+	}
+
+	return ClusterPhaseProvisioned
+}
+
+func infrastructureReady(shoot *gardencorev1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		(lastOperation.Type != gardencorev1beta1.LastOperationTypeCreate || lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded)
+}
+
+func controlPlaneReady(shoot *gardencorev1beta1.Shoot) bool {
+	condition := v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootAPIServerAvailable)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
+}
+
+func clusterNetwork(shoot *gardencorev1beta1.Shoot) map[string]interface{} {
+	out := map[string]interface{}{}
+
+	if networking := shoot.Spec.Networking; networking != nil {
+		if networking.Pods != nil {
+			out["pods"] = map[string]interface{}{"cidrBlocks": []interface{}{*networking.Pods}}
+		}
+		if networking.Services != nil {
+			out["services"] = map[string]interface{}{"cidrBlocks": []interface{}{*networking.Services}}
+		}
+	}
+
+	return out
+}
+
+func controlPlaneEndpoint(shoot *gardencorev1beta1.Shoot) map[string]interface{} {
+	var address string
+	for _, advertisedAddress := range shoot.Status.AdvertisedAddresses {
+		if advertisedAddress.Name == "external" || address == "" {
+			address = advertisedAddress.URL
+		}
+	}
+
+	u, err := url.Parse(address)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+
+	port := int64(443)
+	if p := u.Port(); p != "" {
+		if parsed, err := strconv.ParseInt(p, 10, 32); err == nil {
+			port = parsed
+		}
+	}
+
+	return map[string]interface{}{
+		"host": u.Hostname(),
+		"port": port,
+	}
+}
+
+func kubernetesVersion(shoot *gardencorev1beta1.Shoot, worker gardencorev1beta1.Worker) string {
+	version := shoot.Spec.Kubernetes.Version
+	if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+		version = *worker.Kubernetes.Version
+	}
+
+	if version == "" {
+		return ""
+	}
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+func withLabel(labels map[string]string, key, value string) map[string]string {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[key] = value
+	return labels
+}
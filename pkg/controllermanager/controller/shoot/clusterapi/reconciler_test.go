@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterapi_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/clusterapi"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		shoot   *gardencorev1beta1.Shoot
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-bar",
+				UID:       "1234",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.29.4"},
+				Networking: &gardencorev1beta1.Networking{
+					Pods:     ptr.To("100.96.0.0/11"),
+					Services: ptr.To("100.64.0.0/13"),
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "pool1", Minimum: 1, Maximum: 3},
+						{Name: "pool2", Minimum: 2, Maximum: 5, Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.28.9")}},
+					},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue},
+				},
+				AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "internal", URL: "https://api.internal.foo.bar"},
+					{Name: "external", URL: "https://api.foo.bar"},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(ClusterGVK)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(cluster).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+	})
+
+	getCluster := func() *unstructured.Unstructured {
+		GinkgoHelper()
+
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(ClusterGVK)
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name}, cluster)).To(Succeed())
+		return cluster
+	}
+
+	getMachineDeployment := func(name string) *unstructured.Unstructured {
+		GinkgoHelper()
+
+		machineDeployment := &unstructured.Unstructured{}
+		machineDeployment.SetGroupVersionKind(MachineDeploymentGVK)
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: name}, machineDeployment)).To(Succeed())
+		return machineDeployment
+	}
+
+	It("should do nothing if the shoot does not exist", func() {
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should create the Cluster object", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		cluster := getCluster()
+		Expect(cluster.GetLabels()).To(HaveKeyWithValue("cluster.x-k8s.io/cluster-name", "foo"))
+		Expect(cluster.GetOwnerReferences()).To(ConsistOf(metav1.OwnerReference{
+			APIVersion:         "core.gardener.cloud/v1beta1",
+			Kind:               "Shoot",
+			Name:               "foo",
+			UID:                "1234",
+			Controller:         ptr.To(true),
+			BlockOwnerDeletion: ptr.To(true),
+		}))
+		Expect(cluster.Object["spec"]).To(Equal(map[string]interface{}{
+			"paused": true,
+			"clusterNetwork": map[string]interface{}{
+				"pods":     map[string]interface{}{"cidrBlocks": []interface{}{"100.96.0.0/11"}},
+				"services": map[string]interface{}{"cidrBlocks": []interface{}{"100.64.0.0/13"}},
+			},
+			"controlPlaneEndpoint": map[string]interface{}{
+				"host": "api.foo.bar",
+				"port": int64(443),
+			},
+		}))
+		Expect(cluster.Object["status"]).To(Equal(map[string]interface{}{
+			"phase":               "Provisioned",
+			"infrastructureReady": true,
+			"controlPlaneReady":   true,
+		}))
+	})
+
+	It("should create the MachineDeployment objects", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		machineDeployment := getMachineDeployment("foo-pool2")
+		Expect(machineDeployment.GetLabels()).To(HaveKeyWithValue("cluster.x-k8s.io/cluster-name", "foo"))
+		Expect(machineDeployment.GetAnnotations()).To(And(
+			HaveKeyWithValue("cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size", "2"),
+			HaveKeyWithValue("cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size", "5"),
+		))
+		Expect(machineDeployment.GetOwnerReferences()).To(HaveLen(1))
+		Expect(machineDeployment.Object["spec"]).To(Equal(map[string]interface{}{
+			"clusterName": "foo",
+			"paused":      true,
+			"replicas":    int64(2),
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					"cluster.x-k8s.io/cluster-name":    "foo",
+					"cluster.x-k8s.io/deployment-name": "foo-pool2",
+				},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"cluster.x-k8s.io/cluster-name":    "foo",
+						"cluster.x-k8s.io/deployment-name": "foo-pool2",
+					},
+				},
+				"spec": map[string]interface{}{
+					"clusterName": "foo",
+					"bootstrap":   map[string]interface{}{},
+					"infrastructureRef": map[string]interface{}{
+						"apiVersion": "core.gardener.cloud/v1beta1",
+						"kind":       "Shoot",
+						"name":       "foo",
+						"namespace":  "garden-bar",
+					},
+					"version": "v1.28.9",
+				},
+			},
+		}))
+
+		Expect(getMachineDeployment("foo-pool1").Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("template", HaveKeyWithValue("spec", HaveKeyWithValue("version", "v1.29.4")))))
+	})
+
+	It("should scale the MachineDeployments to zero if the shoot is hibernated", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(getMachineDeployment("foo-pool1").Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("replicas", int64(0))))
+	})
+
+	It("should delete MachineDeployments of removed worker pools", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		unrelated := &unstructured.Unstructured{}
+		unrelated.SetGroupVersionKind(MachineDeploymentGVK)
+		unrelated.SetName("foo-unrelated")
+		unrelated.SetNamespace(shoot.Namespace)
+		unrelated.SetLabels(map[string]string{"cluster.x-k8s.io/cluster-name": "foo"})
+		Expect(fakeClient.Create(ctx, unrelated)).To(Succeed())
+
+		shoot.Spec.Provider.Workers = shoot.Spec.Provider.Workers[:1]
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		getMachineDeployment("foo-pool1")
+		getMachineDeployment("foo-unrelated")
+		machineDeployment := &unstructured.Unstructured{}
+		machineDeployment.SetGroupVersionKind(MachineDeploymentGVK)
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: "foo-pool2"}, machineDeployment)).To(BeNotFoundError())
+	})
+
+	DescribeTable("#ClusterPhase",
+		func(mutate func(*gardencorev1beta1.Shoot), phase string) {
+			mutate(shoot)
+			Expect(ClusterPhase(shoot)).To(Equal(phase))
+		},
+
+		Entry("pending", func(s *gardencorev1beta1.Shoot) { s.Status.LastOperation = nil }, "Pending"),
+		Entry("provisioning", func(s *gardencorev1beta1.Shoot) {
+			s.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate, State: gardencorev1beta1.LastOperationStateProcessing}
+		}, "Provisioning"),
+		Entry("provisioned", func(_ *gardencorev1beta1.Shoot) {}, "Provisioned"),
+		Entry("failed", func(s *gardencorev1beta1.Shoot) {
+			s.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+		}, "Failed"),
+		Entry("deleting", func(s *gardencorev1beta1.Shoot) { s.DeletionTimestamp = &metav1.Time{} }, "Deleting"),
+	)
+})
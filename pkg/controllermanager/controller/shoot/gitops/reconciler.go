@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// LabelManaged is the label on Shoots which are managed by this controller.
+	LabelManaged = "gitops.gardener.cloud/managed"
+	// AnnotationRevision is the annotation on Shoots containing the revision of the Git repository which was synced
+	// last.
+	AnnotationRevision = "gitops.gardener.cloud/revision"
+
+	// EventShootCreated is the reason of the event which is recorded when a Shoot was created from the Git repository.
+	EventShootCreated = "GitOpsShootCreated"
+	// EventShootSynced is the reason of the event which is recorded when a Shoot was synced to a new revision.
+	EventShootSynced = "GitOpsShootSynced"
+	// EventDriftDetected is the reason of the event which is recorded when a Shoot deviates from the Git repository.
+	EventDriftDetected = "GitOpsDriftDetected"
+	// EventConflict is the reason of the event which is recorded when a Shoot from the Git repository already exists
+	// but is not managed by this controller.
+	EventConflict = "GitOpsConflict"
+	// EventSyncFailed is the reason of the event which is recorded when the manifests could not be synced.
+	EventSyncFailed = "GitOpsSyncFailed"
+)
+
+// Reconciler syncs the Shoot manifests stored in the project's directory of a Git repository into the project
+// namespace. Only the fields specified in the Git repository are synced, all other fields and the configured protected
+// fields are managed in the garden cluster. Changes to synced fields which were not made via the Git repository are
+// reported as drift and reverted.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ShootGitOpsControllerConfiguration
+	Source   Source
+	Recorder record.EventRecorder
+	Shard    *sharding.Shard
+}
+
+// Reconcile syncs the Shoots of the project from the Git repository.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		log.V(1).Info("Project is being deleted or has no namespace yet, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	snapshot, err := r.Source.Fetch(ctx, path.Join(ptr.Deref(r.Config.Repository.Path, ""), project.Name))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed fetching the Shoot manifests of the project: %w", err)
+	}
+	log = log.WithValues("revision", snapshot.Revision)
+
+	shoots, err := DecodeShoots(snapshot.Files)
+	if err != nil {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, EventSyncFailed, "Failed decoding the Shoot manifests of revision %s: %v", snapshot.Revision, err)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	var errs []error
+	for _, shoot := range shoots {
+		if err := r.syncShoot(ctx, log.WithValues("shoot", client.ObjectKey{Namespace: *project.Spec.Namespace, Name: shoot.GetName()}), project, snapshot.Revision, shoot); err != nil {
+			errs = append(errs, fmt.Errorf("failed syncing Shoot %s: %w", shoot.GetName(), err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) syncShoot(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, revision string, desired *unstructured.Unstructured) error {
+	if namespace := desired.GetNamespace(); namespace != "" && namespace != *project.Spec.Namespace {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, EventSyncFailed, "Shoot %s of revision %s specifies namespace %s instead of the project namespace %s", desired.GetName(), revision, namespace, *project.Spec.Namespace)
+		return nil
+	}
+
+	// Protected fields are managed in the garden cluster, i.e., they are neither set when the Shoot is created nor
+	// when it is updated.
+	for _, protectedField := range r.Config.ProtectedFields {
+		unstructured.RemoveNestedField(desired.Object, strings.Split(protectedField, ".")...)
+	}
+
+	desiredSpec, _, err := unstructured.NestedMap(desired.Object, "spec")
+	if err != nil {
+		return err
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: *project.Spec.Namespace, Name: desired.GetName()}, shoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		log.Info("Creating Shoot")
+		desired.SetNamespace(*project.Spec.Namespace)
+		desired.SetLabels(mergeStringMaps(desired.GetLabels(), map[string]string{LabelManaged: "true"}))
+		desired.SetAnnotations(mergeStringMaps(desired.GetAnnotations(), map[string]string{AnnotationRevision: revision}))
+		if err := r.Client.Create(ctx, desired); err != nil {
+			return err
+		}
+
+		r.Recorder.Eventf(project, corev1.EventTypeNormal, EventShootCreated, "Created Shoot %s from revision %s", desired.GetName(), revision)
+		return nil
+	}
+
+	if shoot.Labels[LabelManaged] != "true" {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, EventConflict, "Shoot %s already exists but is not managed via GitOps, skipping", shoot.Name)
+		return nil
+	}
+
+	currentSpec, err := toMap(shoot.Spec)
+	if err != nil {
+		return err
+	}
+	desiredSpec, err = toMap(desiredSpec)
+	if err != nil {
+		return err
+	}
+
+	var (
+		newSpec       = mergeMaps(currentSpec, desiredSpec)
+		changedFields = ChangedFields(currentSpec, newSpec)
+		lastRevision  = shoot.Annotations[AnnotationRevision]
+	)
+
+	if len(changedFields) == 0 && lastRevision == revision {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Spec = gardencorev1beta1.ShootSpec{}
+	if err := fromMap(newSpec, &shoot.Spec); err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, AnnotationRevision, revision)
+
+	if len(changedFields) > 0 {
+		if lastRevision == revision {
+			log.Info("Shoot deviates from the Git repository, reverting drift", "fields", changedFields)
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventDriftDetected, "Shoot deviates from revision %s of the Git repository in fields %s, reverting", revision, strings.Join(changedFields, ", "))
+		} else {
+			log.Info("Syncing Shoot", "fields", changedFields)
+			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventShootSynced, "Synced fields %s from revision %s of the Git repository", strings.Join(changedFields, ", "), revision)
+		}
+	}
+
+	return r.Client.Patch(ctx, shoot, patch)
+}
+
+// DecodeShoots decodes the Shoot manifests from the given files. Only files with the `.yaml`, `.yml` or `.json`
+// extensions are considered. They may contain multiple documents.
+func DecodeShoots(files map[string][]byte) ([]*unstructured.Unstructured, error) {
+	var (
+		shoots []*unstructured.Unstructured
+		names  = sets.New[string]()
+	)
+
+	fileNames := make([]string, 0, len(files))
+	for name := range files {
+		if ext := path.Ext(name); ext == ".yaml" || ext == ".yml" || ext == ".json" {
+			fileNames = append(fileNames, name)
+		}
+	}
+	slices.Sort(fileNames)
+
+	for _, fileName := range fileNames {
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(files[fileName]), 1024)
+
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("failed decoding %s: %w", fileName, err)
+			}
+			if len(obj.Object) == 0 {
+				continue
+			}
+
+			if gvk := obj.GroupVersionKind(); gvk != gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot") {
+				return nil, fmt.Errorf("unsupported object %s in %s, only %s Shoots are supported", gvk, fileName, gardencorev1beta1.SchemeGroupVersion)
+			}
+			if obj.GetName() == "" {
+				return nil, fmt.Errorf("shoot without name in %s", fileName)
+			}
+			if names.Has(obj.GetName()) {
+				return nil, fmt.Errorf("duplicate shoot %s in %s", obj.GetName(), fileName)
+			}
+
+			names.Insert(obj.GetName())
+			shoots = append(shoots, obj)
+		}
+	}
+
+	return shoots, nil
+}
+
+// ChangedFields returns the paths of the top-level fields of the Shoot spec which differ between the given maps.
+func ChangedFields(oldSpec, newSpec map[string]any) []string {
+	var changed []string
+
+	for key := range sets.KeySet(oldSpec).Union(sets.KeySet(newSpec)) {
+		if !reflect.DeepEqual(oldSpec[key], newSpec[key]) {
+			changed = append(changed, "spec."+key)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
+
+// mergeMaps merges the desired map into the current map with the semantics of a JSON merge patch, i.e., nested maps
+// are merged recursively, all other values are replaced and nil values remove the respective key.
+func mergeMaps(current, desired map[string]any) map[string]any {
+	out := make(map[string]any, len(current))
+	for key, value := range current {
+		out[key] = value
+	}
+
+	for key, desiredValue := range desired {
+		if desiredValue == nil {
+			delete(out, key)
+			continue
+		}
+
+		desiredMap, desiredIsMap := desiredValue.(map[string]any)
+		currentMap, currentIsMap := out[key].(map[string]any)
+		if desiredIsMap && currentIsMap {
+			out[key] = mergeMaps(currentMap, desiredMap)
+			continue
+		}
+
+		out[key] = desiredValue
+	}
+
+	return out
+}
+
+// toMap converts the given object into its JSON representation as map, so that values can be compared independent of
+// the representation they were decoded from.
+func toMap(obj any) (map[string]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]any{}
+	return out, json.Unmarshal(data, &out)
+}
+
+func fromMap(in map[string]any, obj any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, obj)
+}
+
+func mergeStringMaps(a, b map[string]string) map[string]string {
+	out := make(map[string]string, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
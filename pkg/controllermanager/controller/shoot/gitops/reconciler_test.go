@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gitops_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/gitops"
+)
+
+type fakeSource struct {
+	dir      string
+	snapshot *Snapshot
+}
+
+func (f *fakeSource) Fetch(_ context.Context, dir string) (*Snapshot, error) {
+	f.dir = dir
+	return f.snapshot, nil
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		source     *fakeSource
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		project *gardencorev1beta1.Project
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+
+		source = &fakeSource{snapshot: &Snapshot{
+			Revision: "rev1",
+			Files: map[string][]byte{
+				"bar.yaml": []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: bar
+  labels:
+    team: a
+spec:
+  region: eu-1
+  kubernetes:
+    version: 1.29.4
+  hibernation:
+    enabled: false
+`),
+				"README.md": []byte("# Shoots of project foo"),
+			},
+		}}
+		recorder = record.NewFakeRecorder(10)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(project).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ShootGitOpsControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+				Repository:      config.GitOpsRepository{Path: ptr.To("projects")},
+				ProtectedFields: []string{"spec.hibernation"},
+			},
+			Source:   source,
+			Recorder: recorder,
+		}
+	})
+
+	getShoot := func() *gardencorev1beta1.Shoot {
+		GinkgoHelper()
+
+		shoot := &gardencorev1beta1.Shoot{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-foo", Name: "bar"}, shoot)).To(Succeed())
+		return shoot
+	}
+
+	It("should do nothing if the project does not exist", func() {
+		Expect(fakeClient.Delete(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(source.dir).To(BeEmpty())
+	})
+
+	It("should create the Shoots of the project without the protected fields", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(source.dir).To(Equal("projects/foo"))
+
+		shoot := getShoot()
+		Expect(shoot.Labels).To(Equal(map[string]string{"team": "a", "gitops.gardener.cloud/managed": "true"}))
+		Expect(shoot.Annotations).To(HaveKeyWithValue("gitops.gardener.cloud/revision", "rev1"))
+		Expect(shoot.Spec.Region).To(Equal("eu-1"))
+		Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.29.4"))
+		Expect(shoot.Spec.Hibernation).To(BeNil())
+		Expect(recorder.Events).To(Receive(ContainSubstring("GitOpsShootCreated")))
+	})
+
+	It("should sync a new revision but keep the protected and unspecified fields", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		<-recorder.Events
+
+		shoot := getShoot()
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeEvaluation)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		source.snapshot.Revision = "rev2"
+		source.snapshot.Files["bar.yaml"] = []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: bar
+spec:
+  region: eu-1
+  kubernetes:
+    version: 1.30.1
+  hibernation:
+    enabled: false
+`)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		shoot = getShoot()
+		Expect(shoot.Annotations).To(HaveKeyWithValue("gitops.gardener.cloud/revision", "rev2"))
+		Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.1"))
+		Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		Expect(shoot.Spec.Purpose).To(PointTo(Equal(gardencorev1beta1.ShootPurposeEvaluation)))
+		Expect(recorder.Events).To(Receive(And(ContainSubstring("GitOpsShootSynced"), ContainSubstring("spec.kubernetes"))))
+	})
+
+	It("should report and revert drift", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		<-recorder.Events
+
+		shoot := getShoot()
+		shoot.Spec.Region = "eu-2"
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(getShoot().Spec.Region).To(Equal("eu-1"))
+		Expect(recorder.Events).To(Receive(And(ContainSubstring("GitOpsDriftDetected"), ContainSubstring("spec.region"))))
+	})
+
+	It("should not touch Shoots which are not managed via GitOps", func() {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{Region: "eu-2"},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(getShoot().Spec.Region).To(Equal("eu-2"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("GitOpsConflict")))
+	})
+
+	It("should not sync Shoots of other namespaces", func() {
+		source.snapshot.Files["bar.yaml"] = []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: bar
+  namespace: garden-other
+`)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-foo", Name: "bar"}, &gardencorev1beta1.Shoot{})).NotTo(Succeed())
+		Expect(recorder.Events).To(Receive(ContainSubstring("GitOpsSyncFailed")))
+	})
+
+	Describe("#DecodeShoots", func() {
+		It("should decode multiple documents", func() {
+			shoots, err := DecodeShoots(map[string][]byte{
+				"a.yaml": []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: a
+---
+apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: b
+`),
+				"c.json": []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"c"}}`),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoots).To(HaveLen(3))
+		})
+
+		It("should fail for other objects", func() {
+			_, err := DecodeShoots(map[string][]byte{"a.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+`)})
+			Expect(err).To(MatchError(ContainSubstring("only core.gardener.cloud/v1beta1 Shoots are supported")))
+		})
+
+		It("should fail for duplicate Shoots", func() {
+			shoot := []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","metadata":{"name":"a"}}`)
+			_, err := DecodeShoots(map[string][]byte{"a.yaml": shoot, "b.yaml": shoot})
+			Expect(err).To(MatchError(ContainSubstring("duplicate shoot a")))
+		})
+	})
+
+	Describe("#ChangedFields", func() {
+		It("should return the changed top-level fields", func() {
+			Expect(ChangedFields(
+				map[string]any{"region": "eu-1", "kubernetes": map[string]any{"version": "1.29.4"}, "purpose": "evaluation"},
+				map[string]any{"region": "eu-1", "kubernetes": map[string]any{"version": "1.30.1"}, "hibernation": map[string]any{}},
+			)).To(Equal([]string{"spec.hibernation", "spec.kubernetes", "spec.purpose"}))
+		})
+	})
+})
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+const (
+	// DataKeyUsername is the key in the credentials secret of the Git repository containing the username for HTTPS.
+	DataKeyUsername = "username"
+	// DataKeyPassword is the key in the credentials secret of the Git repository containing the password (e.g., an
+	// access token) for HTTPS.
+	DataKeyPassword = "password"
+	// DataKeyIdentity is the key in the credentials secret of the Git repository containing the PEM encoded private key
+	// for SSH.
+	DataKeyIdentity = "identity"
+	// DataKeyKnownHosts is the key in the credentials secret of the Git repository containing the public keys of the
+	// SSH server in the `known_hosts` format.
+	DataKeyKnownHosts = "knownHosts"
+)
+
+// Source provides the manifests of the Shoots which are synced into the garden cluster.
+type Source interface {
+	// Fetch returns the latest revision of the source and the files in the given directory.
+	Fetch(ctx context.Context, dir string) (*Snapshot, error)
+}
+
+// Snapshot contains the files of a directory at a given revision of a Source.
+type Snapshot struct {
+	// Revision is the revision of the source, e.g., the commit SHA.
+	Revision string
+	// Files maps the names of the files in the directory to their content.
+	Files map[string][]byte
+}
+
+type gitSource struct {
+	reader     client.Reader
+	repository config.GitOpsRepository
+
+	lock sync.Mutex
+	repo *git.Repository
+}
+
+// NewGitSource returns a Source which reads the files from the given Git repository. Only the latest commit of the
+// configured branch is fetched and kept in memory, i.e., neither the `git` binary nor a writable file system is
+// required. The credentials referenced by the repository are read with the given reader on every fetch.
+func NewGitSource(reader client.Reader, repository config.GitOpsRepository) Source {
+	return &gitSource{reader: reader, repository: repository}
+}
+
+func (g *gitSource) Fetch(ctx context.Context, dir string) (*Snapshot, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	auth, err := g.auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := ptr.Deref(g.repository.Branch, "main")
+
+	if g.repo == nil {
+		repo, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+			URL:           g.repository.URL,
+			Auth:          auth,
+			ReferenceName: plumbing.NewBranchReferenceName(branch),
+			SingleBranch:  true,
+			Depth:         1,
+			Tags:          git.NoTags,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed cloning the Git repository: %w", err)
+		}
+		g.repo = repo
+	} else {
+		if err := g.repo.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: git.DefaultRemoteName,
+			RefSpecs:   []gitconfig.RefSpec{gitconfig.RefSpec(fmt.Sprintf("+refs/heads/%[1]s:refs/remotes/%[2]s/%[1]s", branch, git.DefaultRemoteName))},
+			Auth:       auth,
+			Depth:      1,
+			Tags:       git.NoTags,
+			Force:      true,
+		}); err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil, fmt.Errorf("failed fetching the Git repository: %w", err)
+		}
+	}
+
+	ref, err := g.repo.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, branch), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving branch %s: %w", branch, err)
+	}
+
+	commit, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if g.repository.VerifyCommitSignatures {
+		if err := verifyCommit(commit, g.repository.TrustedKeys); err != nil {
+			return nil, fmt.Errorf("failed verifying the signature of commit %s: %w", commit.Hash, err)
+		}
+	}
+
+	snapshot := &Snapshot{Revision: commit.Hash.String(), Files: map[string][]byte{}}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	if dir = path.Clean(dir); dir != "." && dir != "/" {
+		tree, err = tree.Tree(dir)
+		if err != nil {
+			if errors.Is(err, object.ErrDirectoryNotFound) {
+				return snapshot, nil
+			}
+			return nil, err
+		}
+	}
+
+	for _, entry := range tree.Entries {
+		if entry.Mode != filemode.Regular && entry.Mode != filemode.Executable {
+			continue
+		}
+
+		file, err := tree.TreeEntryFile(&entry)
+		if err != nil {
+			return nil, err
+		}
+		content, err := file.Contents()
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Files[entry.Name] = []byte(content)
+	}
+
+	return snapshot, nil
+}
+
+func (g *gitSource) auth(ctx context.Context) (transport.AuthMethod, error) {
+	if g.repository.SecretRef == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := g.reader.Get(ctx, client.ObjectKey{Namespace: g.repository.SecretRef.Namespace, Name: g.repository.SecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading the credentials of the Git repository: %w", err)
+	}
+
+	return AuthMethodFromSecret(secret)
+}
+
+// AuthMethodFromSecret returns the authentication method for the Git repository based on the data of the given secret.
+// It returns SSH public key authentication if the secret contains an identity, and HTTP basic authentication
+// otherwise.
+func AuthMethodFromSecret(secret *corev1.Secret) (transport.AuthMethod, error) {
+	if identity, ok := secret.Data[DataKeyIdentity]; ok {
+		publicKeys, err := gitssh.NewPublicKeys(gitssh.DefaultUsername, identity, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing the identity in secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+
+		hostKeyCallback, err := knownHostsCallback(secret.Data[DataKeyKnownHosts])
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing the known hosts in secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+		publicKeys.HostKeyCallback = hostKeyCallback
+
+		return publicKeys, nil
+	}
+
+	if _, ok := secret.Data[DataKeyPassword]; !ok {
+		return nil, fmt.Errorf("secret %s must contain either the %q or the %q data key", client.ObjectKeyFromObject(secret), DataKeyIdentity, DataKeyPassword)
+	}
+
+	return &githttp.BasicAuth{
+		Username: string(secret.Data[DataKeyUsername]),
+		Password: string(secret.Data[DataKeyPassword]),
+	}, nil
+}
+
+// knownHostsCallback returns a callback which only accepts the host keys listed in the given data in the
+// `known_hosts` format. Hashed host names and markers (e.g., `@cert-authority`) are not supported.
+func knownHostsCallback(data []byte) (ssh.HostKeyCallback, error) {
+	type knownHost struct {
+		hosts []string
+		key   ssh.PublicKey
+	}
+
+	var knownHostList []knownHost
+	for rest := data; len(bytes.TrimSpace(rest)) > 0; {
+		marker, hosts, key, _, r, err := ssh.ParseKnownHosts(rest)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		rest = r
+
+		if marker == "" {
+			knownHostList = append(knownHostList, knownHost{hosts: hosts, key: key})
+		}
+	}
+
+	if len(knownHostList) == 0 {
+		return nil, fmt.Errorf("no known hosts provided")
+	}
+
+	return func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+		host := knownhosts.Normalize(hostname)
+		for _, knownHost := range knownHostList {
+			if slices.Contains(knownHost.hosts, host) && bytes.Equal(knownHost.key.Marshal(), key.Marshal()) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host key of %s is not known", hostname)
+	}, nil
+}
+
+// verifyCommit verifies that the given commit is signed by one of the given ASCII armored OpenPGP public keys.
+func verifyCommit(commit *object.Commit, trustedKeys []string) error {
+	if commit.PGPSignature == "" {
+		return fmt.Errorf("commit is not signed")
+	}
+
+	var errs []error
+	for _, trustedKey := range trustedKeys {
+		if _, err := commit.Verify(trustedKey); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+
+	return fmt.Errorf("commit is not signed by any of the trusted keys: %w", errors.Join(errs...))
+}
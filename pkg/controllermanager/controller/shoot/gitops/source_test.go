@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gitops_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/gitops"
+)
+
+var _ = Describe("Source", func() {
+	Describe("#NewGitSource", func() {
+		var (
+			ctx = context.Background()
+
+			repoDir  string
+			repo     *git.Repository
+			worktree *git.Worktree
+
+			repository config.GitOpsRepository
+		)
+
+		commit := func(files map[string]string, signKey *openpgp.Entity) string {
+			GinkgoHelper()
+
+			for name, content := range files {
+				Expect(os.MkdirAll(filepath.Dir(filepath.Join(repoDir, name)), 0o755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o600)).To(Succeed())
+				_, err := worktree.Add(name)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			hash, err := worktree.Commit("update", &git.CommitOptions{
+				Author:  &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+				SignKey: signKey,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return hash.String()
+		}
+
+		BeforeEach(func() {
+			repoDir = GinkgoT().TempDir()
+
+			var err error
+			repo, err = git.PlainInitWithOptions(repoDir, &git.PlainInitOptions{
+				InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			worktree, err = repo.Worktree()
+			Expect(err).NotTo(HaveOccurred())
+
+			repository = config.GitOpsRepository{URL: "file://" + repoDir}
+		})
+
+		It("should return the regular files in the given directory of the latest revision", func() {
+			revision := commit(map[string]string{
+				"projects/foo/bar.yaml":     "bar",
+				"projects/foo/baz/qux.yaml": "qux",
+				"projects/other/bar.yaml":   "other",
+			}, nil)
+
+			source := NewGitSource(nil, repository)
+
+			snapshot, err := source.Fetch(ctx, "projects/foo")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Revision).To(Equal(revision))
+			Expect(snapshot.Files).To(Equal(map[string][]byte{"bar.yaml": []byte("bar")}))
+
+			revision = commit(map[string]string{"projects/foo/bar.yaml": "new"}, nil)
+
+			snapshot, err = source.Fetch(ctx, "projects/foo")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Revision).To(Equal(revision))
+			Expect(snapshot.Files).To(Equal(map[string][]byte{"bar.yaml": []byte("new")}))
+		})
+
+		It("should return no files if the directory does not exist", func() {
+			revision := commit(map[string]string{"projects/other/bar.yaml": "other"}, nil)
+
+			snapshot, err := NewGitSource(nil, repository).Fetch(ctx, "projects/foo")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot.Revision).To(Equal(revision))
+			Expect(snapshot.Files).To(BeEmpty())
+		})
+
+		It("should fail if the branch does not exist", func() {
+			commit(map[string]string{"projects/foo/bar.yaml": "bar"}, nil)
+			repository.Branch = ptr.To("other")
+
+			_, err := NewGitSource(nil, repository).Fetch(ctx, "projects/foo")
+			Expect(err).To(MatchError(ContainSubstring("failed cloning the Git repository")))
+		})
+
+		Context("with signature verification", func() {
+			var trustedEntity *openpgp.Entity
+
+			BeforeEach(func() {
+				trustedEntity = newEntity()
+				repository.VerifyCommitSignatures = true
+				repository.TrustedKeys = []string{armoredPublicKey(newEntity()), armoredPublicKey(trustedEntity)}
+			})
+
+			It("should accept commits signed by a trusted key", func() {
+				revision := commit(map[string]string{"projects/foo/bar.yaml": "bar"}, trustedEntity)
+
+				snapshot, err := NewGitSource(nil, repository).Fetch(ctx, "projects/foo")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Revision).To(Equal(revision))
+			})
+
+			It("should reject commits signed by other keys", func() {
+				commit(map[string]string{"projects/foo/bar.yaml": "bar"}, newEntity())
+
+				_, err := NewGitSource(nil, repository).Fetch(ctx, "projects/foo")
+				Expect(err).To(MatchError(ContainSubstring("commit is not signed by any of the trusted keys")))
+			})
+
+			It("should reject unsigned commits", func() {
+				commit(map[string]string{"projects/foo/bar.yaml": "bar"}, nil)
+
+				_, err := NewGitSource(nil, repository).Fetch(ctx, "projects/foo")
+				Expect(err).To(MatchError(ContainSubstring("commit is not signed")))
+			})
+		})
+
+		It("should fail if the referenced credentials do not exist", func() {
+			repository.SecretRef = &corev1.SecretReference{Name: "git-credentials", Namespace: "garden"}
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+			_, err := NewGitSource(fakeClient, repository).Fetch(ctx, "projects/foo")
+			Expect(err).To(MatchError(ContainSubstring("failed reading the credentials of the Git repository")))
+		})
+	})
+
+	Describe("#AuthMethodFromSecret", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "git-credentials", Namespace: "garden"}}
+		})
+
+		It("should return basic authentication", func() {
+			secret.Data = map[string][]byte{"username": []byte("user"), "password": []byte("token")}
+
+			Expect(AuthMethodFromSecret(secret)).To(Equal(&githttp.BasicAuth{Username: "user", Password: "token"}))
+		})
+
+		It("should return SSH public key authentication which only accepts the known hosts", func() {
+			hostPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			hostKey, err := ssh.NewPublicKey(hostPublicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			otherKey, err := ssh.NewPublicKey(otherPublicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, identity, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			identityPEM, err := ssh.MarshalPrivateKey(identity, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Data = map[string][]byte{
+				"identity":   pem.EncodeToMemory(identityPEM),
+				"knownHosts": []byte(knownhosts.Line([]string{"git.example.com"}, hostKey) + "\n"),
+			}
+
+			authMethod, err := AuthMethodFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authMethod).To(BeAssignableToTypeOf(&gitssh.PublicKeys{}))
+
+			hostKeyCallback := authMethod.(*gitssh.PublicKeys).HostKeyCallback
+			Expect(hostKeyCallback("git.example.com:22", nil, hostKey)).To(Succeed())
+			Expect(hostKeyCallback("git.example.com:22", nil, otherKey)).To(MatchError(ContainSubstring("is not known")))
+			Expect(hostKeyCallback("other.example.com:22", nil, hostKey)).To(MatchError(ContainSubstring("is not known")))
+		})
+
+		It("should fail if the known hosts are missing for SSH", func() {
+			_, identity, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			identityPEM, err := ssh.MarshalPrivateKey(identity, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Data = map[string][]byte{"identity": pem.EncodeToMemory(identityPEM)}
+
+			_, err = AuthMethodFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("no known hosts provided")))
+		})
+
+		It("should fail if the secret contains no credentials", func() {
+			_, err := AuthMethodFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("must contain either the \"identity\" or the \"password\" data key")))
+		})
+	})
+})
+
+func newEntity() *openpgp.Entity {
+	GinkgoHelper()
+
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	Expect(err).NotTo(HaveOccurred())
+	return entity
+}
+
+func armoredPublicKey(entity *openpgp.Entity) string {
+	GinkgoHelper()
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(entity.Serialize(w)).To(Succeed())
+	Expect(w.Close()).To(Succeed())
+	return buf.String()
+}
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,8 +24,10 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
 const (
@@ -40,11 +43,14 @@ const (
 )
 
 // parsedHibernationSchedule holds the loaded location, parsed cron schedule and information whether
-// the cluster should be hibernated or woken up.
+// the cluster should be hibernated or woken up. Exceptions and the holiday calendar are only set for schedules
+// which hibernate the cluster.
 type parsedHibernationSchedule struct {
-	location  time.Location
-	schedule  cron.Schedule
-	operation operation
+	location        time.Location
+	schedule        cron.Schedule
+	operation       operation
+	exceptions      []*timewindow.DateRange
+	holidayCalendar *string
 }
 
 // next returns the time in UTC from the schedule, that is immediately after the input time 't'.
@@ -70,10 +76,12 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 
 // Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
 type Reconciler struct {
-	Client   client.Client
-	Config   config.ShootHibernationControllerConfiguration
-	Clock    clock.Clock
-	Recorder record.EventRecorder
+	Client    client.Client
+	APIReader client.Reader
+	Config    config.ShootHibernationControllerConfiguration
+	Shard     *sharding.Shard
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
 }
 
 // Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
@@ -121,10 +129,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// hibernated or wakeup the at a later time.
 	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
 	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, log, shoot, mostRecentSchedule, now); err != nil {
 			return reconcile.Result{}, err
 		}
-		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 	}
 
 	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
@@ -132,25 +139,77 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
+func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
 	switch schedule.operation {
 	case hibernate:
+		exception, err := r.getHibernationException(ctx, log, shoot, schedule, now)
+		if err != nil {
+			return err
+		}
+		if exception != "" {
+			log.Info("Skipping hibernation due to schedule exception", "exception", exception)
+			controllerutils.RecordEventf(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationSkipped, "Skipping hibernation due to schedule exception %s", exception)
+			break
+		}
 		shoot.Spec.Hibernation.Enabled = ptr.To(true)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to schedule")
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to schedule")
 	case wakeUp:
 		shoot.Spec.Hibernation.Enabled = ptr.To(false)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to schedule")
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to schedule")
 	}
 	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 		return err
 	}
+	if shoot.Spec.Hibernation.Enabled != nil {
+		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+	}
 
+	// The trigger time is also updated if the hibernation was skipped, so that the schedule is not triggered again.
 	patch = client.MergeFrom(shoot.DeepCopy())
 	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
+// getHibernationException returns the exception or holiday which contains the current date in the location of the
+// given schedule. An empty string is returned if the hibernation must not be skipped. Invalid entries of the holiday
+// calendar are ignored.
+func (r *Reconciler) getHibernationException(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) (string, error) {
+	nowInLocation := now.In(&schedule.location)
+
+	for _, exception := range schedule.exceptions {
+		if exception.Contains(nowInLocation) {
+			return exception.String(), nil
+		}
+	}
+
+	if schedule.holidayCalendar == nil {
+		return "", nil
+	}
+
+	holidayCalendar := &corev1.ConfigMap{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *schedule.holidayCalendar}, holidayCalendar); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Holiday calendar ConfigMap not found, ignoring it", "configMapName", *schedule.holidayCalendar)
+			return "", nil
+		}
+		return "", fmt.Errorf("failed reading holiday calendar ConfigMap %q: %w", *schedule.holidayCalendar, err)
+	}
+
+	for name, value := range holidayCalendar.Data {
+		holiday, err := timewindow.ParseDateRangeString(value)
+		if err != nil {
+			log.Info("Ignoring invalid entry of holiday calendar", "configMapName", holidayCalendar.Name, "key", name, "error", err.Error())
+			continue
+		}
+		if holiday.Contains(nowInLocation) {
+			return fmt.Sprintf("%s (holiday %q of calendar %q)", holiday, name, holidayCalendar.Name), nil
+		}
+	}
+
+	return "", nil
+}
+
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
 func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
@@ -172,8 +231,18 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 			if err != nil {
 				return nil, err
 			}
+
+			exceptions := make([]*timewindow.DateRange, 0, len(schedule.Exceptions))
+			for _, exception := range schedule.Exceptions {
+				dateRange, err := timewindow.ParseDateRange(exception.Start, ptr.Deref(exception.End, ""))
+				if err != nil {
+					return nil, err
+				}
+				exceptions = append(exceptions, dateRange)
+			}
+
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate, exceptions: exceptions, holidayCalendar: schedule.HolidayCalendarConfigMapName},
 			)
 		}
 
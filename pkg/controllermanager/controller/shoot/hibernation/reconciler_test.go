@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/component-base/version"
@@ -32,7 +33,10 @@ var _ = Describe("Shoot Hibernation", func() {
 		triggerDeadlineDuration time.Duration
 		shootSettings           func(shoot *gardencorev1beta1.Shoot)
 
+		holidayCalendar map[string]string
+
 		triggerHibernationOrWakeup  bool
+		skipHibernation             bool
 		expectedRequeueDurationFunc func(now time.Time) time.Duration
 		expectedHibernationEnabled  bool
 	}
@@ -176,6 +180,14 @@ var _ = Describe("Shoot Hibernation", func() {
 				By("Create shoot")
 				Expect(c.Create(ctx, shoot)).To(Succeed())
 
+				if t.holidayCalendar != nil {
+					By("Create holiday calendar")
+					Expect(c.Create(ctx, &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: shoot.Namespace},
+						Data:       t.holidayCalendar,
+					})).To(Succeed())
+				}
+
 				By("Configure hibernation reconciler")
 				config := config.ShootHibernationControllerConfiguration{}
 				if t.triggerDeadlineDuration != noDeadLine {
@@ -183,10 +195,11 @@ var _ = Describe("Shoot Hibernation", func() {
 				}
 
 				reconciler := &Reconciler{
-					Client:   c,
-					Config:   config,
-					Recorder: record.NewFakeRecorder(1),
-					Clock:    fakeClock,
+					Client:    c,
+					APIReader: c,
+					Config:    config,
+					Recorder:  record.NewFakeRecorder(1),
+					Clock:     fakeClock,
 				}
 
 				By("Reconcile shoot resource")
@@ -207,6 +220,9 @@ var _ = Describe("Shoot Hibernation", func() {
 				if t.triggerHibernationOrWakeup {
 					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(PointTo(Equal(t.expectedHibernationEnabled)))
 					Expect(reconciledShoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(timeNow))
+				} else if t.skipHibernation {
+					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(reconciledShoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(timeNow))
 				} else {
 					Expect(reconciledShoot.Spec.Hibernation.Enabled).To(BeNil())
 				}
@@ -416,6 +432,115 @@ var _ = Describe("Shoot Hibernation", func() {
 					triggerDeadlineDuration:     shortDeadline,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when the current date is an exception of the hibernation schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt7,
+							End:        &everyDayAt2,
+							Exceptions: []gardencorev1beta1.HibernationScheduleException{{Start: "2022-04-11", End: ptr.To("2022-04-13")}},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					skipHibernation:             true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the current date is not an exception of the hibernation schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt7,
+							End:        &everyDayAt2,
+							Exceptions: []gardencorev1beta1.HibernationScheduleException{{Start: "2022-04-13"}},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the current date in the schedule's location is an exception of the hibernation schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt0, -1*time.Hour),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -48*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt2,
+							End:        &everyDayAt7,
+							Location:   &locationEUSofia,
+							Exceptions: []gardencorev1beta1.HibernationScheduleException{{Start: "2022-04-12"}},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					skipHibernation:             true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt7, locationEUSofia),
+				}),
+				Entry("when the current date is contained in the holiday calendar", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:                        &everyDayAt7,
+							End:                          &everyDayAt2,
+							HolidayCalendarConfigMapName: ptr.To("holidays"),
+						}}
+					},
+					holidayCalendar: map[string]string{
+						"invalid": "foo",
+						"easter":  "2022-04-15/2022-04-18",
+						"team":    "2022-04-12",
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					skipHibernation:             true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the current date is not contained in the holiday calendar", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:                        &everyDayAt7,
+							End:                          &everyDayAt2,
+							HolidayCalendarConfigMapName: ptr.To("holidays"),
+						}}
+					},
+					holidayCalendar:             map[string]string{"easter": "2022-04-15/2022-04-18"},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the holiday calendar does not exist", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:                        &everyDayAt7,
+							End:                          &everyDayAt2,
+							HolidayCalendarConfigMapName: ptr.To("holidays"),
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the shoot is woken up on a date which is an exception of the hibernation schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt2, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt7,
+							End:        &everyDayAt2,
+							Exceptions: []gardencorev1beta1.HibernationScheduleException{{Start: "2022-04-12"}},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  false,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt7, "UTC"),
+				}),
 			)
 		})
 	})
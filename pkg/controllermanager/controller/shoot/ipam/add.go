@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"time"
+
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+)
+
+const (
+	// ControllerName is the name of this controller.
+	ControllerName = "shoot-ipam"
+	// GarbageCollectorControllerName is the name of the controller releasing unused allocations.
+	GarbageCollectorControllerName = "shoot-ipam-garbage-collector"
+)
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.IPAMClient == nil {
+		var err error
+		if r.IPAMClient, err = ipam.NewClient(r.Config.Server.URL, r.Config.Server.CABundle, r.Config.Server.Timeout.Duration); err != nil {
+			return err
+		}
+	}
+
+	b := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			NeedLeaderElection:      r.Shard.ControllerNeedLeaderElection(),
+		})
+
+	return r.Shard.Complete(b, &gardencorev1beta1.Shoot{}, r)
+}
+
+// ShootPredicate returns true for Shoots with networks allocated by the IPAM server or with the finalizer of this
+// controller.
+func ShootPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[ipam.AnnotationAllocatedNetworks]
+		return ok || controllerutil.ContainsFinalizer(obj, ipam.FinalizerName)
+	})
+}
+
+// AddToManager adds GarbageCollector to the given manager.
+func (g *GarbageCollector) AddToManager(mgr manager.Manager) error {
+	if g.Reader == nil {
+		g.Reader = mgr.GetAPIReader()
+	}
+	if g.IPAMClient == nil {
+		var err error
+		if g.IPAMClient, err = ipam.NewClient(g.Config.Server.URL, g.Config.Server.CABundle, g.Config.Server.Timeout.Duration); err != nil {
+			return err
+		}
+	}
+	if g.Clock == nil {
+		g.Clock = clock.RealClock{}
+	}
+	if g.MinimumAllocationAge == nil {
+		g.MinimumAllocationAge = ptr.To(10 * time.Minute)
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(GarbageCollectorControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		WatchesRawSource(controllerutils.EnqueueOnce, nil).
+		Complete(g)
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+)
+
+// GarbageCollector periodically releases the CIDRs which the external IPAM server allocated for Shoots that do not use
+// them. This is the case if the creation of a Shoot was rejected after the ShootIPAM admission plugin allocated its
+// CIDRs, e.g., by a subsequent admission plugin or by validation.
+type GarbageCollector struct {
+	Reader     client.Reader
+	Config     config.ShootIPAMControllerConfiguration
+	IPAMClient ipam.Client
+	Clock      clock.Clock
+	// MinimumAllocationAge is the minimum age of allocations before they are considered for garbage collection. It
+	// prevents releasing the CIDRs of Shoots whose creation is still in progress.
+	MinimumAllocationAge *time.Duration
+}
+
+// Reconcile releases the networks of all allocations of the IPAM server which are not recorded in the
+// `ipam.gardener.cloud/allocated-networks` annotation of the respective Shoot.
+func (g *GarbageCollector) Reconcile(reconcileCtx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, g.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	allocatedShoots, err := g.IPAMClient.List(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var errs []error
+	for _, allocatedShoot := range allocatedShoots {
+		if allocatedShoot.AllocationTime.Add(*g.MinimumAllocationAge).UTC().After(g.Clock.Now().UTC()) {
+			// Do not consider recent allocations since the respective Shoot might not be persisted yet.
+			continue
+		}
+
+		key := client.ObjectKey{Namespace: allocatedShoot.Namespace, Name: allocatedShoot.Name}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := g.Reader.Get(ctx, key, shoot); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed reading shoot %s: %w", key, err))
+				continue
+			}
+			shoot = nil
+		}
+
+		networks := unusedNetworks(allocatedShoot.Networks, shoot)
+		if len(networks) == 0 {
+			continue
+		}
+
+		log.Info("Releasing networks which are not used by shoot", "shoot", key, "networks", networks)
+		if err := g.IPAMClient.Release(ctx, ipam.Request{Namespace: key.Namespace, Name: key.Name, Networks: networks}); err != nil {
+			errs = append(errs, fmt.Errorf("failed releasing networks of shoot %s: %w", key, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: g.Config.SyncPeriod.Duration}, nil
+}
+
+// unusedNetworks returns the given networks which are not recorded as allocated in the annotation of the given Shoot.
+// All networks are unused if the Shoot does not exist.
+func unusedNetworks(networks []string, shoot *gardencorev1beta1.Shoot) []string {
+	var used []string
+	if shoot != nil {
+		used = allocatedNetworks(shoot)
+	}
+
+	var unused []string
+	for _, network := range networks {
+		if !slices.Contains(used, network) {
+			unused = append(unused, network)
+		}
+	}
+
+	return unused
+}
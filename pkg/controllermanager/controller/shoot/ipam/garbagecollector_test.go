@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/ipam"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+)
+
+var _ = Describe("GarbageCollector", func() {
+	var (
+		ctx        = context.Background()
+		now        = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		fakeClient client.Client
+		ipamClient *fakeIPAMClient
+		gc         *GarbageCollector
+	)
+
+	BeforeEach(func() {
+		ipamClient = &fakeIPAMClient{}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		gc = &GarbageCollector{
+			Reader:               fakeClient,
+			Config:               config.ShootIPAMControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Hour}},
+			IPAMClient:           ipamClient,
+			Clock:                testclock.NewFakeClock(now),
+			MinimumAllocationAge: ptr.To(10 * time.Minute),
+		}
+	})
+
+	It("should release the networks of shoots which do not exist", func() {
+		ipamClient.allocatedShoots = []ipam.AllocatedShoot{
+			{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods", "services"}, AllocationTime: now.Add(-time.Hour)},
+		}
+
+		Expect(gc.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(ipamClient.requests).To(ConsistOf(ipam.Request{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods", "services"}}))
+	})
+
+	It("should not release recent allocations", func() {
+		ipamClient.allocatedShoots = []ipam.AllocatedShoot{
+			{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods"}, AllocationTime: now.Add(-time.Minute)},
+		}
+
+		Expect(gc.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(ipamClient.requests).To(BeEmpty())
+	})
+
+	It("should only release the networks which are not recorded in the annotation of the shoot", func() {
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Name:        "bar",
+			Namespace:   "garden-foo",
+			Annotations: map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services"},
+		}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Name:      "baz",
+			Namespace: "garden-foo",
+		}})).To(Succeed())
+
+		ipamClient.allocatedShoots = []ipam.AllocatedShoot{
+			{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods", "services", "nodes"}, AllocationTime: now.Add(-time.Hour)},
+			{Namespace: "garden-foo", Name: "baz", Networks: []string{"services"}, AllocationTime: now.Add(-time.Hour)},
+		}
+
+		Expect(gc.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(ipamClient.requests).To(ConsistOf(
+			ipam.Request{Namespace: "garden-foo", Name: "bar", Networks: []string{"nodes"}},
+			ipam.Request{Namespace: "garden-foo", Name: "baz", Networks: []string{"services"}},
+		))
+	})
+
+	It("should not release networks which are used by the shoot", func() {
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Name:        "bar",
+			Namespace:   "garden-foo",
+			Annotations: map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services"},
+		}})).To(Succeed())
+
+		ipamClient.allocatedShoots = []ipam.AllocatedShoot{
+			{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods", "services"}, AllocationTime: now.Add(-time.Hour)},
+		}
+
+		Expect(gc.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(ipamClient.requests).To(BeEmpty())
+	})
+
+	It("should continue with other allocations if a release fails", func() {
+		ipamClient.err = errors.New("fake")
+		ipamClient.allocatedShoots = []ipam.AllocatedShoot{
+			{Namespace: "garden-foo", Name: "bar", Networks: []string{"pods"}, AllocationTime: now.Add(-time.Hour)},
+			{Namespace: "garden-foo", Name: "baz", Networks: []string{"pods"}, AllocationTime: now.Add(-time.Hour)},
+		}
+
+		_, err := gc.Reconcile(ctx, reconcile.Request{})
+		Expect(err).To(MatchError(ContainSubstring("failed releasing networks of shoot garden-foo/bar: fake")))
+		Expect(err).To(MatchError(ContainSubstring("failed releasing networks of shoot garden-foo/baz: fake")))
+		Expect(ipamClient.requests).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+)
+
+// Reconciler releases the CIDRs of Shoots which were allocated by the external IPAM server. It adds a finalizer to
+// such Shoots and releases the CIDRs once the Shoot cluster was deleted, i.e., after gardenlet removed its finalizer.
+type Reconciler struct {
+	Client     client.Client
+	Config     config.ShootIPAMControllerConfiguration
+	IPAMClient ipam.Client
+	Shard      *sharding.Shard
+}
+
+// Reconcile adds the finalizer to Shoots with allocated networks and releases the networks of deleted Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	networks := allocatedNetworks(shoot)
+
+	if shoot.DeletionTimestamp == nil {
+		if len(networks) > 0 && !controllerutil.ContainsFinalizer(shoot, ipam.FinalizerName) {
+			log.Info("Adding finalizer")
+			if err := controllerutils.AddFinalizers(ctx, r.Client, shoot, ipam.FinalizerName); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+			}
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(shoot, ipam.FinalizerName) {
+		return reconcile.Result{}, nil
+	}
+
+	if controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
+		log.V(1).Info("Shoot cluster is not yet deleted, waiting before releasing its networks")
+		return reconcile.Result{}, nil
+	}
+
+	if len(networks) > 0 {
+		log.Info("Releasing networks", "networks", networks)
+		if err := r.IPAMClient.Release(ctx, newRequest(shoot, networks)); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Info("Removing finalizer")
+	if err := controllerutils.RemoveFinalizers(ctx, r.Client, shoot, ipam.FinalizerName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// allocatedNetworks returns the names of the networks of the given Shoot which were allocated by the IPAM server.
+func allocatedNetworks(shoot *gardencorev1beta1.Shoot) []string {
+	var networks []string
+
+	for _, network := range strings.Split(shoot.Annotations[ipam.AnnotationAllocatedNetworks], ",") {
+		if network = strings.TrimSpace(network); network != "" {
+			networks = append(networks, network)
+		}
+	}
+
+	return networks
+}
+
+// newRequest returns the IPAM request for the given networks of the given Shoot.
+func newRequest(shoot *gardencorev1beta1.Shoot, networks []string) ipam.Request {
+	request := ipam.Request{
+		Namespace:    shoot.Namespace,
+		Name:         shoot.Name,
+		ProviderType: shoot.Spec.Provider.Type,
+		Region:       shoot.Spec.Region,
+		Networks:     networks,
+	}
+
+	if shoot.Spec.Networking != nil {
+		for _, ipFamily := range shoot.Spec.Networking.IPFamilies {
+			request.IPFamilies = append(request.IPFamilies, string(ipFamily))
+		}
+	}
+
+	return request
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/ipam"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+type fakeIPAMClient struct {
+	err             error
+	requests        []ipam.Request
+	allocatedShoots []ipam.AllocatedShoot
+}
+
+func (f *fakeIPAMClient) Allocate(_ context.Context, _ ipam.Request) (*ipam.Allocation, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeIPAMClient) Release(_ context.Context, request ipam.Request) error {
+	f.requests = append(f.requests, request)
+	return f.err
+}
+
+func (f *fakeIPAMClient) List(_ context.Context) ([]ipam.AllocatedShoot, error) {
+	return f.allocatedShoots, nil
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		ipamClient *fakeIPAMClient
+		reconciler *Reconciler
+
+		shoot   *gardencorev1beta1.Shoot
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region:   "europe",
+				Provider: gardencorev1beta1.Provider{Type: "local"},
+				Networking: &gardencorev1beta1.Networking{
+					IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		ipamClient = &fakeIPAMClient{}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{
+			Client:     fakeClient,
+			IPAMClient: ipamClient,
+		}
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should add the finalizer to shoots with allocated networks", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Finalizers).To(ConsistOf("ipam.gardener.cloud/release"))
+		Expect(ipamClient.requests).To(BeEmpty())
+	})
+
+	It("should not add the finalizer to shoots without allocated networks", func() {
+		shoot.Annotations = nil
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Finalizers).To(BeEmpty())
+	})
+
+	Context("deleted shoot", func() {
+		BeforeEach(func() {
+			shoot.Finalizers = []string{"gardener", "ipam.gardener.cloud/release"}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+		})
+
+		It("should wait until the shoot cluster is deleted", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Finalizers).To(ConsistOf("gardener", "ipam.gardener.cloud/release"))
+			Expect(ipamClient.requests).To(BeEmpty())
+		})
+
+		Context("shoot cluster is deleted", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				shoot.Finalizers = []string{"ipam.gardener.cloud/release"}
+				Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			})
+
+			It("should release the networks and remove the finalizer", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(ipamClient.requests).To(ConsistOf(ipam.Request{
+					Namespace:    "garden-foo",
+					Name:         "bar",
+					ProviderType: "local",
+					Region:       "europe",
+					IPFamilies:   []string{"IPv4"},
+					Networks:     []string{"pods", "services"},
+				}))
+				Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(BeNotFoundError())
+			})
+
+			It("should keep the finalizer if the release fails", func() {
+				ipamClient.err = errors.New("fake")
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError("fake"))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Finalizers).To(ConsistOf("ipam.gardener.cloud/release"))
+			})
+		})
+	})
+})
@@ -76,6 +76,18 @@ var _ = Describe("Add", func() {
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
 
+			It("should return true when there is maintenance preview annotation only on new object", func() {
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "preview")
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return false when there is maintenance preview annotation on old and new object", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "preview")
+				oldShoot := shoot.DeepCopy()
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+
 			It("should return true because there is time window change", func() {
 				oldShoot := shoot.DeepCopy()
 				shoot.Spec.Maintenance.TimeWindow.Begin = "123"
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Preview returns the changes which the next maintenance of the given Shoot would apply based on the given
+// CloudProfile. The changes are computed in the same way as by the reconciler, hence, they only differ from the actual
+// maintenance if the Shoot or the CloudProfile are changed until the next maintenance time window.
+func Preview(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) (*gardencorev1beta1.ShootMaintenancePreview, error) {
+	result, err := computeMaintenance(logr.Discard(), shoot, cloudProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	begin, end := gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).Next(now)
+
+	preview := &gardencorev1beta1.ShootMaintenancePreview{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+		},
+		NextMaintenanceTimeWindow: gardencorev1beta1.MaintenancePreviewTimeWindow{
+			Begin: metav1.NewTime(begin),
+			End:   metav1.NewTime(end),
+		},
+		KubernetesVersion: versionUpdatePreview(result.kubernetesControlPlaneUpdate),
+		Changes:           result.operations,
+	}
+
+	if result.operation != "" {
+		preview.Operation = ptr.To(result.operation)
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerPreview := gardencorev1beta1.WorkerMaintenancePreview{Name: worker.Name}
+
+		if update, ok := result.workerToKubernetesUpdate[worker.Name]; ok {
+			workerPreview.KubernetesVersion = versionUpdatePreview(&update)
+		}
+
+		if update, ok := result.workerToMachineImageUpdate[worker.Name]; ok && worker.Machine.Image != nil {
+			workerPreview.MachineImage = &gardencorev1beta1.MachineImageUpdatePreview{
+				Name:                 worker.Machine.Image.Name,
+				VersionUpdatePreview: *versionUpdatePreview(&update),
+			}
+		}
+
+		if workerPreview.KubernetesVersion == nil && workerPreview.MachineImage == nil {
+			continue
+		}
+
+		preview.Workers = append(preview.Workers, workerPreview)
+	}
+
+	return preview, nil
+}
+
+func versionUpdatePreview(result *updateResult) *gardencorev1beta1.VersionUpdatePreview {
+	if result == nil {
+		return nil
+	}
+
+	preview := &gardencorev1beta1.VersionUpdatePreview{
+		CurrentVersion: result.currentVersion,
+		Reason:         result.reason,
+	}
+
+	if result.isSuccessful {
+		preview.TargetVersion = ptr.To(result.targetVersion)
+	} else {
+		preview.FailureReason = ptr.To(result.description)
+	}
+
+	return preview
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+var _ = Describe("Preview", func() {
+	var (
+		now                     = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		expirationDateInThePast = metav1.NewTime(time.Now().AddDate(0, 0, -1))
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.30.2"},
+						{Version: "1.30.1"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{
+					{
+						Name:           "gardenlinux",
+						UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"},
+								CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+								Architectures:    []string{"amd64"},
+							},
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+								CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+								Architectures:    []string{"amd64"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   false,
+						MachineImageVersion: ptr.To(false),
+					},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+						Begin: "220000+0000",
+						End:   "230000+0000",
+					},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name: "worker",
+							Machine: gardencorev1beta1.Machine{
+								Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")},
+								Architecture: ptr.To("amd64"),
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should return an empty preview if nothing would be changed", func() {
+		preview, err := Preview(shoot, cloudProfile, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview).To(Equal(&gardencorev1beta1.ShootMaintenancePreview{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			NextMaintenanceTimeWindow: gardencorev1beta1.MaintenancePreviewTimeWindow{
+				Begin: metav1.NewTime(time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC)),
+				End:   metav1.NewTime(time.Date(2024, 1, 1, 22, 45, 0, 0, time.UTC)),
+			},
+		}))
+	})
+
+	It("should return the version updates of the control plane and the worker pools", func() {
+		shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+			Name: "up-to-date",
+			Machine: gardencorev1beta1.Machine{
+				Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")},
+				Architecture: ptr.To("amd64"),
+			},
+		})
+		original := shoot.DeepCopy()
+
+		preview, err := Preview(shoot, cloudProfile, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview.KubernetesVersion).To(Equal(&gardencorev1beta1.VersionUpdatePreview{
+			CurrentVersion: "1.30.1",
+			TargetVersion:  ptr.To("1.30.2"),
+			Reason:         "Automatic update of Kubernetes version configured",
+		}))
+		Expect(preview.Workers).To(ConsistOf(gardencorev1beta1.WorkerMaintenancePreview{
+			Name: "worker",
+			MachineImage: &gardencorev1beta1.MachineImageUpdatePreview{
+				Name: "gardenlinux",
+				VersionUpdatePreview: gardencorev1beta1.VersionUpdatePreview{
+					CurrentVersion: "1.0.0",
+					TargetVersion:  ptr.To("1.1.0"),
+					Reason:         "Automatic update of the machine image version is configured (image update strategy: major)",
+				},
+			},
+		}))
+		Expect(shoot).To(Equal(original))
+	})
+
+	It("should return the failure reason if no suitable version can be determined for an expired version", func() {
+		cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.30.1", ExpirationDate: &expirationDateInThePast}}
+
+		preview, err := Preview(shoot, cloudProfile, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview.KubernetesVersion).NotTo(BeNil())
+		Expect(preview.KubernetesVersion.CurrentVersion).To(Equal("1.30.1"))
+		Expect(preview.KubernetesVersion.TargetVersion).To(BeNil())
+		Expect(preview.KubernetesVersion.Reason).To(Equal("Kubernetes version expired - force update required"))
+		Expect(preview.KubernetesVersion.FailureReason).To(PointTo(ContainSubstring("could not determine higher suitable version than \"1.30.1\"")))
+	})
+
+	It("should return the operation requested for the maintenance", func() {
+		shoot.Annotations = map[string]string{v1beta1constants.GardenerMaintenanceOperation: v1beta1constants.OperationRotateCredentialsStart}
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+
+		preview, err := Preview(shoot, cloudProfile, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview.Operation).To(PointTo(Equal(v1beta1constants.OperationRotateCredentialsStart)))
+		Expect(shoot.Annotations).To(HaveKey(v1beta1constants.GardenerMaintenanceOperation))
+	})
+})
@@ -28,6 +28,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
@@ -39,8 +40,11 @@ import (
 type Reconciler struct {
 	Client   client.Client
 	Config   config.ShootMaintenanceControllerConfiguration
+	Shard    *sharding.Shard
 	Clock    clock.Clock
 	Recorder record.EventRecorder
+
+	seedMaintenances seedMaintenances
 }
 
 // Reconcile reconciles Shoots and maintains them by updating versions or triggering operations.
@@ -64,6 +68,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if hasMaintenancePreviewAnnotation(shoot) {
+		if err := r.previewMaintenance(ctx, log, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	requeueAfter, nextMaintenance := requeueAfterDuration(shoot)
 
 	if !mustMaintainNow(shoot, r.Clock) {
@@ -72,6 +82,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	deferralDuration, err := r.deferMaintenance(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking concurrent maintenances on the seed: %w", err)
+	}
+	if deferralDuration > 0 {
+		log.Info("Deferring maintenance of Shoot because the maximum number of concurrent maintenances on its Seed is reached", "seedName", *shoot.Spec.SeedName, "duration", deferralDuration.Round(time.Second))
+		return reconcile.Result{RequeueAfter: deferralDuration}, nil
+	}
+
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -80,6 +99,44 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// previewMaintenance computes the changes which the next maintenance would apply, writes them to the status of the
+// Shoot and removes the preview annotation. Nothing is applied to the Shoot specification.
+func (r *Reconciler) previewMaintenance(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	log.Info("Computing pending maintenance changes")
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return err
+	}
+
+	// The preview annotation must not be considered as the operation which is triggered by the maintenance.
+	shootWithoutPreviewAnnotation := shoot.DeepCopy()
+	delete(shootWithoutPreviewAnnotation.Annotations, v1beta1constants.GardenerMaintenanceOperation)
+
+	now := r.Clock.Now()
+	preview, err := Preview(shootWithoutPreviewAnnotation, cloudProfile, now)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	// Removal of the annotation during the status patch is possible because only the spec is kept in its original form
+	// during status updates.
+	delete(shoot.Annotations, v1beta1constants.GardenerMaintenanceOperation)
+	shoot.Status.Maintenance = &gardencorev1beta1.ShootMaintenanceStatus{
+		PendingChanges: &gardencorev1beta1.MaintenancePendingChanges{
+			ComputedTime:              metav1.NewTime(now),
+			NextMaintenanceTimeWindow: preview.NextMaintenanceTimeWindow,
+			KubernetesVersion:         preview.KubernetesVersion,
+			Workers:                   preview.Workers,
+			Operation:                 preview.Operation,
+			Changes:                   preview.Changes,
+		},
+	}
+
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
 	var (
 		now             = time.Now()
@@ -95,138 +152,36 @@ func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.T
 // Such maintenance operations can fail if a version must be updated, but the GCM cannot find a suitable version to update to.
 // Note: the updates might still be rejected by APIServer validation.
 type updateResult struct {
-	description  string
-	reason       string
-	isSuccessful bool
+	description    string
+	reason         string
+	isSuccessful   bool
+	currentVersion string
+	targetVersion  string
 }
 
 func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
 	log.Info("Maintaining Shoot")
 
-	var (
-		maintainedShoot = shoot.DeepCopy()
-		// for maintenance operations unrelated to machine images and Kubernetes versions
-		operations []string
-		err        error
-	)
-
-	workerToKubernetesUpdate := make(map[string]updateResult)
-	workerToMachineImageUpdate := make(map[string]updateResult)
-
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
 		return err
 	}
 
-	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
-		if err != nil {
-			// continue execution to allow the kubernetes version update
-			log.Error(err, "Failed to maintain Shoot machine images")
-		}
-	}
-
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-		maintainedShoot.Spec.Kubernetes.Version = v
-		return v, nil
-	})
-	if err != nil {
-		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
-		log.Error(err, "Failed to maintain Shoot kubernetes version")
-	}
-
-	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	result, err := computeMaintenance(log, shoot, cloudProfile)
 	if err != nil {
 		return err
 	}
 
-	shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
-	if err != nil {
-		return err
-	}
-
-	// Reset the `EnableStaticTokenKubeconfig` value to false, when shoot cluster is updated to  k8s version >= 1.27.
-	if versionutils.ConstraintK8sLess127.Check(oldShootKubernetesVersion) && versionutils.ConstraintK8sGreaterEqual127.Check(shootKubernetesVersion) {
-		if ptr.Deref(maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig, false) {
-			maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
-
-			reason := "EnableStaticTokenKubeconfig is set to false. Reason: The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27 and higher"
-			operations = append(operations, reason)
-		}
-
-		reasonsForIncreasingMaxWorkers := ensureSufficientMaxWorkers(maintainedShoot, fmt.Sprintf("Maximum number of workers of a worker group must be greater or equal to its number of zones for updating Kubernetes to %q", shootKubernetesVersion.String()))
-		operations = append(operations, reasonsForIncreasingMaxWorkers...)
-	}
-
-	// Now it's time to update worker pool kubernetes version if specified
-	for i, pool := range maintainedShoot.Spec.Provider.Workers {
-		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
-			continue
-		}
-
-		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-			workerPoolSemver, err := semver.NewVersion(v)
-			if err != nil {
-				return "", err
-			}
-			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
-			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
-				workerPoolSemver = shootKubernetesVersion
-			}
-			v = workerPoolSemver.String()
-			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
-			return v, nil
-		})
-		if err != nil {
-			// continue execution to allow other maintenance activities to continue
-			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
-		}
-
-		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
-		}
-	}
-
-	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
-	}
-
-	if reasons := maintainAdmissionPluginsForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
-	}
-
-	// Set the swap behavior to `LimitedSwap`, when the shoot cluster and/or worker pool is updated to k8s version >= 1.30.
-	{
-		if versionutils.ConstraintK8sLess130.Check(oldShootKubernetesVersion) &&
-			versionutils.ConstraintK8sGreaterEqual130.Check(shootKubernetesVersion) &&
-			maintainedShoot.Spec.Kubernetes.Kubelet != nil {
-			operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Kubernetes.Kubelet, "spec.kubernetes.kubelet.memorySwap.swapBehavior")...)
-		}
-
-		for i := range maintainedShoot.Spec.Provider.Workers {
-			if maintainedShoot.Spec.Provider.Workers[i].Kubernetes != nil && maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet != nil {
-				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, shoot.Spec.Kubernetes.Version)
-				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
-				if err != nil {
-					return fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
-				}
-
-				if versionutils.ConstraintK8sGreaterEqual130.Check(kubeletSemverVersion) {
-					operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet, fmt.Sprintf("spec.provider.workers[%d].kubernetes.kubelet.meomrySwap.swapBehavior", i))...)
-				}
-			}
-		}
-	}
+	var (
+		maintainedShoot              = result.maintainedShoot
+		kubernetesControlPlaneUpdate = result.kubernetesControlPlaneUpdate
+		workerToKubernetesUpdate     = result.workerToKubernetesUpdate
+		workerToMachineImageUpdate   = result.workerToMachineImageUpdate
+		operations                   = result.operations
+	)
 
-	operation := maintainOperation(maintainedShoot)
-	if operation != "" {
-		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
+	if result.operation != "" {
+		operations = append(operations, fmt.Sprintf("Added %q operation annotation", result.operation))
 	}
 
 	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0
@@ -295,7 +250,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
 	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
 	if err := r.Client.Update(ctx, shoot); err != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
 		return err
 	}
 
@@ -322,22 +277,173 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
+	// the pending changes are outdated once the maintenance was performed
+	if shoot.Status.Maintenance != nil {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.Maintenance = nil
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
 	// make sure to report (partial) maintenance failures
 	if kubernetesControlPlaneUpdate != nil {
 		if kubernetesControlPlaneUpdate.isSuccessful {
-			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
+			controllerutils.RecordEventf(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
 		} else {
-			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
+			controllerutils.RecordEventf(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
 		}
 	}
 
-	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
-	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
+	r.recordMaintenanceEventsForPool(ctx, workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
+	r.recordMaintenanceEventsForPool(ctx, workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
 
 	log.Info("Shoot maintenance completed")
 	return nil
 }
 
+// maintenanceResult contains the changes which the maintenance applies to a Shoot.
+type maintenanceResult struct {
+	// maintainedShoot is a copy of the Shoot with the maintained specification.
+	maintainedShoot              *gardencorev1beta1.Shoot
+	kubernetesControlPlaneUpdate *updateResult
+	workerToKubernetesUpdate     map[string]updateResult
+	workerToMachineImageUpdate   map[string]updateResult
+	// operations contains the maintenance operations unrelated to machine images and Kubernetes versions
+	operations []string
+	// operation is the operation annotation which is added by the maintenance
+	operation string
+}
+
+// computeMaintenance computes the changes which the maintenance applies to the given Shoot based on the given
+// CloudProfile. The given Shoot is not modified.
+func computeMaintenance(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (*maintenanceResult, error) {
+	var (
+		maintainedShoot = shoot.DeepCopy()
+		// for maintenance operations unrelated to machine images and Kubernetes versions
+		operations []string
+		err        error
+	)
+
+	workerToKubernetesUpdate := make(map[string]updateResult)
+	workerToMachineImageUpdate := make(map[string]updateResult)
+
+	if !v1beta1helper.IsWorkerless(shoot) {
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		if err != nil {
+			// continue execution to allow the kubernetes version update
+			log.Error(err, "Failed to maintain Shoot machine images")
+		}
+	}
+
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		maintainedShoot.Spec.Kubernetes.Version = v
+		return v, nil
+	})
+	if err != nil {
+		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
+		log.Error(err, "Failed to maintain Shoot kubernetes version")
+	}
+
+	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reset the `EnableStaticTokenKubeconfig` value to false, when shoot cluster is updated to  k8s version >= 1.27.
+	if versionutils.ConstraintK8sLess127.Check(oldShootKubernetesVersion) && versionutils.ConstraintK8sGreaterEqual127.Check(shootKubernetesVersion) {
+		if ptr.Deref(maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig, false) {
+			maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
+
+			reason := "EnableStaticTokenKubeconfig is set to false. Reason: The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27 and higher"
+			operations = append(operations, reason)
+		}
+
+		reasonsForIncreasingMaxWorkers := ensureSufficientMaxWorkers(maintainedShoot, fmt.Sprintf("Maximum number of workers of a worker group must be greater or equal to its number of zones for updating Kubernetes to %q", shootKubernetesVersion.String()))
+		operations = append(operations, reasonsForIncreasingMaxWorkers...)
+	}
+
+	// Now it's time to update worker pool kubernetes version if specified
+	for i, pool := range maintainedShoot.Spec.Provider.Workers {
+		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
+			continue
+		}
+
+		workerLog := log.WithValues("worker", pool.Name)
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			workerPoolSemver, err := semver.NewVersion(v)
+			if err != nil {
+				return "", err
+			}
+			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
+			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
+				workerPoolSemver = shootKubernetesVersion
+			}
+			v = workerPoolSemver.String()
+			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow other maintenance activities to continue
+			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
+		}
+
+		if workerKubernetesUpdate != nil {
+			result := *workerKubernetesUpdate
+			if result.isSuccessful {
+				result.description += inPlaceUpdateSuffix(&pool)
+			}
+			workerToKubernetesUpdate[pool.Name] = result
+		}
+	}
+
+	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
+		operations = append(operations, reasons...)
+	}
+
+	if reasons := maintainAdmissionPluginsForShoot(maintainedShoot); len(reasons) > 0 {
+		operations = append(operations, reasons...)
+	}
+
+	// Set the swap behavior to `LimitedSwap`, when the shoot cluster and/or worker pool is updated to k8s version >= 1.30.
+	{
+		if versionutils.ConstraintK8sLess130.Check(oldShootKubernetesVersion) &&
+			versionutils.ConstraintK8sGreaterEqual130.Check(shootKubernetesVersion) &&
+			maintainedShoot.Spec.Kubernetes.Kubelet != nil {
+			operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Kubernetes.Kubelet, "spec.kubernetes.kubelet.memorySwap.swapBehavior")...)
+		}
+
+		for i := range maintainedShoot.Spec.Provider.Workers {
+			if maintainedShoot.Spec.Provider.Workers[i].Kubernetes != nil && maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet != nil {
+				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, shoot.Spec.Kubernetes.Version)
+				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
+				}
+
+				if versionutils.ConstraintK8sGreaterEqual130.Check(kubeletSemverVersion) {
+					operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet, fmt.Sprintf("spec.provider.workers[%d].kubernetes.kubelet.meomrySwap.swapBehavior", i))...)
+				}
+			}
+		}
+	}
+
+	return &maintenanceResult{
+		maintainedShoot:              maintainedShoot,
+		kubernetesControlPlaneUpdate: kubernetesControlPlaneUpdate,
+		workerToKubernetesUpdate:     workerToKubernetesUpdate,
+		workerToMachineImageUpdate:   workerToMachineImageUpdate,
+		operations:                   operations,
+		operation:                    maintainOperation(maintainedShoot),
+	}, nil
+}
+
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
 // contains an indication for the failure and the reason the update was triggered. Details for failed maintenance operations are returned in the second return string.
 func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate map[string]updateResult, workerToMachineImageUpdate map[string]updateResult) (string, string) {
@@ -392,15 +498,15 @@ func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, worker
 }
 
 // recordMaintenanceEventsForPool records dedicated events for each failed/succeeded maintenance operation per pool
-func (r *Reconciler) recordMaintenanceEventsForPool(workerToUpdateResult map[string]updateResult, shoot *gardencorev1beta1.Shoot, eventType string, maintenanceType string) {
+func (r *Reconciler) recordMaintenanceEventsForPool(ctx context.Context, workerToUpdateResult map[string]updateResult, shoot *gardencorev1beta1.Shoot, eventType string, maintenanceType string) {
 	for worker, reason := range workerToUpdateResult {
 		if reason.isSuccessful {
-			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, eventType, "%s", fmt.Sprintf("Worker pool %q: %v. Reason: %s.",
+			controllerutils.RecordEventf(ctx, r.Recorder, shoot, corev1.EventTypeNormal, eventType, "%s", fmt.Sprintf("Worker pool %q: %v. Reason: %s.",
 				worker, reason.description, reason.reason))
 			continue
 		}
 
-		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, eventType, "%s", fmt.Sprintf("Worker pool %q: %s version maintenance failed. Reason for update: %s. Error: %v",
+		controllerutils.RecordEventf(ctx, r.Recorder, shoot, corev1.EventTypeWarning, eventType, "%s", fmt.Sprintf("Worker pool %q: %s version maintenance failed. Reason for update: %s. Error: %v",
 			worker, maintenanceType, reason.reason, reason.description))
 	}
 }
@@ -475,6 +581,19 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		}
 
 		filteredMachineImageVersionsFromCloudProfile := filterForArchitecture(&machineImageFromCloudProfile, worker.Machine.Architecture)
+		if len(filteredMachineImageVersionsFromCloudProfile.Versions) == 0 {
+			// there is no version to update to, so fail with a clear reason instead of a generic version resolution error
+			workerLog.Info("Maintenance of machine image not possible, no version supports the architecture of the worker pool", "architecture", *worker.Machine.Architecture)
+			maintenanceResults[worker.Name] = updateResult{
+				description:    fmt.Sprintf("failed to update machine image %q: the CloudProfile does not offer any version of the machine image supporting CPU architecture %q", workerImage.Name, *worker.Machine.Architecture),
+				reason:         fmt.Sprintf("Machine image has no version for CPU architecture %q", *worker.Machine.Architecture),
+				isSuccessful:   false,
+				currentVersion: ptr.Deref(workerImage.Version, ""),
+			}
+			continue
+		}
+
+		versionSupportsArchitecture, _ := v1beta1helper.ShootMachineImageVersionExists(*filteredMachineImageVersionsFromCloudProfile, *workerImage)
 		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
 
@@ -484,13 +603,18 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			continue
 		}
 
+		if versionExists, _ := v1beta1helper.ShootMachineImageVersionExists(machineImageFromCloudProfile, *workerImage); versionExists && !versionSupportsArchitecture {
+			reason = fmt.Sprintf("Version does not support CPU architecture %q", *worker.Machine.Architecture)
+		}
+
 		updatedMachineImageVersion, err := determineMachineImageVersion(workerImage, filteredMachineImageVersionsFromCloudProfile, isExpired)
 		if err != nil {
 			log.Error(err, "Maintenance of machine image failed", "workerPool", worker.Name, "machineImage", workerImage.Name)
 			maintenanceResults[worker.Name] = updateResult{
-				description:  fmt.Sprintf("failed to update machine image %q: %s", workerImage.Name, err.Error()),
-				reason:       reason,
-				isSuccessful: false,
+				description:    fmt.Sprintf("failed to update machine image %q: %s", workerImage.Name, err.Error()),
+				reason:         reason,
+				isSuccessful:   false,
+				currentVersion: ptr.Deref(workerImage.Version, ""),
 			}
 			continue
 		}
@@ -501,9 +625,11 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 
 		workerLog.Info("MachineImage will be updated", "newVersion", updatedMachineImageVersion, "reason", reason)
 		maintenanceResults[worker.Name] = updateResult{
-			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
-			reason:       reason,
-			isSuccessful: true,
+			description:    fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion) + inPlaceUpdateSuffix(&worker),
+			reason:         reason,
+			isSuccessful:   true,
+			currentVersion: *workerImage.Version,
+			targetVersion:  updatedMachineImageVersion,
 		}
 
 		// update the machine image version
@@ -513,6 +639,15 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
+// inPlaceUpdateSuffix returns a note for the maintenance description if the nodes of the worker pool are updated
+// in-place instead of being rolled.
+func inPlaceUpdateSuffix(worker *gardencorev1beta1.Worker) string {
+	if v1beta1helper.IsUpdateStrategyInPlace(worker) {
+		return " (nodes are updated in-place)"
+	}
+	return ""
+}
+
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
 func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
@@ -526,9 +661,10 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, profile, isExpired)
 	if err != nil {
 		return &updateResult{
-			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
-			reason:       reason,
-			isSuccessful: false,
+			description:    fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
+			reason:         reason,
+			isSuccessful:   false,
+			currentVersion: kubernetesVersion,
 		}, err
 	}
 	// current version is already the latest
@@ -540,17 +676,20 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion)
 	if err != nil {
 		return &updateResult{
-			description:  err.Error(),
-			reason:       reason,
-			isSuccessful: false,
+			description:    err.Error(),
+			reason:         reason,
+			isSuccessful:   false,
+			currentVersion: kubernetesVersion,
 		}, err
 	}
 
 	log.Info("Kubernetes version will be updated", "version", kubernetesVersion, "newVersion", actualUpdatedKubernetesVersion, "reason", reason)
 	return &updateResult{
-		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
-		reason:       reason,
-		isSuccessful: true,
+		description:    fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
+		reason:         reason,
+		isSuccessful:   true,
+		currentVersion: kubernetesVersion,
+		targetVersion:  actualUpdatedKubernetesVersion,
 	}, nil
 }
 
@@ -599,6 +738,10 @@ func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
 	return ok && operation == v1beta1constants.ShootOperationMaintain
 }
 
+func hasMaintenancePreviewAnnotation(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation] == v1beta1constants.ShootMaintenanceOperationPreview
+}
+
 func needsRetry(shoot *gardencorev1beta1.Shoot) bool {
 	needsRetryOperation := false
 
@@ -140,6 +140,16 @@ var _ = Describe("Shoot Maintenance", func() {
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 			})
 
+			It("should note in the description that the nodes of worker pools with in-place update strategy are updated in-place", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(gardencorev1beta1.MachineUpdateStrategyInPlace)
+
+				results, err := maintainMachineImages(log, shoot, cloudProfile)
+
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+				Expect(results["cpu-worker"].description).To(Equal(`Updated machine image "CoreOs" from "1.0.0" to "1.5.3" (nodes are updated in-place)`))
+			})
+
 			It("should update machine image version to overall latest for correct architecture. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
 				expectedVersion := "1.1.2"
 
@@ -170,9 +180,47 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
+				Expect(results["cpu-worker"].reason).To(Equal(`Version does not support CPU architecture "arm64"`))
+			})
+
+			It("should fail with a clear description if no version of the machine image supports the architecture of the worker pool", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
+
+				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
+				Expect(results["cpu-worker"]).To(Equal(updateResult{
+					description:    `failed to update machine image "CoreOs": the CloudProfile does not offer any version of the machine image supporting CPU architecture "arm64"`,
+					reason:         `Machine image has no version for CPU architecture "arm64"`,
+					isSuccessful:   false,
+					currentVersion: shootCurrentImageVersion,
+				}))
+			})
+
+			It("should update the machine images of worker pools with different architectures independently", func() {
+				cloudProfile.Spec.MachineImages[0].Versions[0].Architectures = []string{"amd64", "arm64"}
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions,
+					gardencorev1beta1.MachineImageVersion{
+						ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.2.0", ExpirationDate: &expirationDateInTheFuture},
+						CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+						Architectures:    []string{"arm64"},
+					},
+				)
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+					Name: "arm-worker",
+					Machine: gardencorev1beta1.Machine{
+						Image:        shootCurrentImage.DeepCopy(),
+						Architecture: ptr.To("arm64"),
+					},
+				})
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[1], "CoreOs", "1.2.0")
 			})
 
 			It("should update version of multiple worker pools to the overall latest of the respective images. Auto update: multiple worker pools", func() {
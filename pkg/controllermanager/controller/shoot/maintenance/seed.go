@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// deferralMinDelay is the minimum delay after which the maintenance of a Shoot is retried if the maximum number of
+	// concurrent maintenances on its Seed is reached.
+	deferralMinDelay = time.Minute
+	// deferralMaxJitter is the maximum jitter added to deferralMinDelay, so that deferred Shoots don't retry at the same
+	// time.
+	deferralMaxJitter = 2 * time.Minute
+	// startedMaintenanceTTL is the duration for which a started maintenance is counted even if gardenlet has not yet
+	// started reconciling the Shoot, e.g., because the cache is not yet up-to-date or gardenlet is busy.
+	startedMaintenanceTTL = 2 * time.Minute
+)
+
+// seedMaintenances keeps track of the maintenances started recently by this controller per Seed.
+type seedMaintenances struct {
+	lock sync.Mutex
+	// started maps Seed names to the keys of the Shoots whose maintenance was started recently and the start times.
+	started map[string]map[client.ObjectKey]time.Time
+}
+
+// deferMaintenance returns the duration after which the maintenance of the given Shoot shall be retried because the
+// maximum number of concurrent maintenances on its Seed is reached. It returns 0 if the Shoot can be maintained now, in
+// this case the maintenance is counted for the Seed.
+// Shoots are always maintained if the maintenance was triggered explicitly or if the retry would happen after the end
+// of their maintenance time windows, i.e., the limit only spreads the maintenances within the time windows.
+func (r *Reconciler) deferMaintenance(ctx context.Context, shoot *gardencorev1beta1.Shoot) (time.Duration, error) {
+	if r.Config.MaxConcurrentMaintenancesPerSeed == nil || shoot.Spec.SeedName == nil || hasMaintainNowAnnotation(shoot) {
+		return 0, nil
+	}
+
+	var (
+		seedName   = *shoot.Spec.SeedName
+		now        = r.Clock.Now()
+		retryAfter = deferralMinDelay + utils.RandomDuration(deferralMaxJitter)
+	)
+
+	r.seedMaintenances.lock.Lock()
+	defer r.seedMaintenances.lock.Unlock()
+
+	if r.seedMaintenances.started == nil {
+		r.seedMaintenances.started = make(map[string]map[client.ObjectKey]time.Time)
+	}
+	started := r.seedMaintenances.started[seedName]
+	if started == nil {
+		started = make(map[client.ObjectKey]time.Time)
+		r.seedMaintenances.started[seedName] = started
+	}
+
+	if gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).Contains(now.Add(retryAfter)) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+			return 0, err
+		}
+
+		inMaintenance := sets.New[client.ObjectKey]()
+		for _, s := range shootList.Items {
+			if isBeingReconciled(&s) {
+				inMaintenance.Insert(client.ObjectKeyFromObject(&s))
+			}
+		}
+		for key, startTime := range started {
+			if now.Sub(startTime) > startedMaintenanceTTL {
+				delete(started, key)
+				continue
+			}
+			inMaintenance.Insert(key)
+		}
+		inMaintenance.Delete(client.ObjectKeyFromObject(shoot))
+
+		if inMaintenance.Len() >= *r.Config.MaxConcurrentMaintenancesPerSeed {
+			return retryAfter, nil
+		}
+	}
+
+	started[client.ObjectKeyFromObject(shoot)] = now
+	return 0, nil
+}
+
+func isBeingReconciled(shoot *gardencorev1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		(lastOperation.State == gardencorev1beta1.LastOperationStateProcessing || lastOperation.State == gardencorev1beta1.LastOperationStatePending)
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+var _ = Describe("Seed", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock *testclock.FakeClock
+
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		newShoot func(name, seedName string, lastOperationState gardencorev1beta1.LastOperationState) *gardencorev1beta1.Shoot
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		// the effective maintenance time window of the shoots is 22:00-22:45
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 22, 10, 0, 0, time.UTC))
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: config.ShootMaintenanceControllerConfiguration{MaxConcurrentMaintenancesPerSeed: ptr.To(2)},
+		}
+
+		newShoot = func(name, seedName string, lastOperationState gardencorev1beta1.LastOperationState) *gardencorev1beta1.Shoot {
+			s := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: ptr.To(seedName),
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+			}
+			if lastOperationState != "" {
+				s.Status.LastOperation = &gardencorev1beta1.LastOperation{State: lastOperationState}
+			}
+			return s
+		}
+
+		shoot = newShoot("shoot", "seed", gardencorev1beta1.LastOperationStateSucceeded)
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	Describe("#deferMaintenance", func() {
+		It("should not defer the maintenance if the number of concurrent maintenances is not limited", func() {
+			reconciler.Config.MaxConcurrentMaintenancesPerSeed = nil
+			createShoots(ctx, fakeClient, newShoot, "seed", 2)
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should not defer the maintenance if the limit is not reached", func() {
+			createShoots(ctx, fakeClient, newShoot, "seed", 1)
+			Expect(fakeClient.Create(ctx, newShoot("succeeded", "seed", gardencorev1beta1.LastOperationStateSucceeded))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newShoot("failed", "seed", gardencorev1beta1.LastOperationStateFailed))).To(Succeed())
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should not count shoots on other seeds", func() {
+			createShoots(ctx, fakeClient, newShoot, "other-seed", 2)
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should not count the shoot itself", func() {
+			createShoots(ctx, fakeClient, newShoot, "seed", 1)
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should defer the maintenance if the limit is reached", func() {
+			createShoots(ctx, fakeClient, newShoot, "seed", 2)
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(And(
+				BeNumerically(">=", deferralMinDelay),
+				BeNumerically("<=", deferralMinDelay+deferralMaxJitter),
+			))
+		})
+
+		It("should count maintenances started recently which are not yet reconciled", func() {
+			for i := range 2 {
+				s := newShoot(fmt.Sprintf("started-%d", i), "seed", gardencorev1beta1.LastOperationStateSucceeded)
+				Expect(fakeClient.Create(ctx, s)).To(Succeed())
+				Expect(reconciler.deferMaintenance(ctx, s)).To(BeZero())
+			}
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).NotTo(BeZero())
+
+			By("Forget the started maintenances after some time")
+			fakeClock.Step(startedMaintenanceTTL + time.Second)
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should not defer the maintenance if it was triggered explicitly", func() {
+			createShoots(ctx, fakeClient, newShoot, "seed", 2)
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+
+		It("should not defer the maintenance if the retry would be after the end of the maintenance time window", func() {
+			createShoots(ctx, fakeClient, newShoot, "seed", 2)
+			fakeClock.SetTime(time.Date(2024, 1, 1, 22, 44, 0, 0, time.UTC))
+
+			Expect(reconciler.deferMaintenance(ctx, shoot)).To(BeZero())
+		})
+	})
+})
+
+func createShoots(
+	ctx context.Context,
+	c client.Client,
+	newShoot func(string, string, gardencorev1beta1.LastOperationState) *gardencorev1beta1.Shoot,
+	seedName string,
+	count int,
+) {
+	for i := range count {
+		state := gardencorev1beta1.LastOperationStateProcessing
+		if i%2 == 1 {
+			state = gardencorev1beta1.LastOperationStatePending
+		}
+		ExpectWithOffset(1, c.Create(ctx, newShoot(fmt.Sprintf("%s-processing-%d", seedName, i), seedName, state))).To(Succeed())
+	}
+}
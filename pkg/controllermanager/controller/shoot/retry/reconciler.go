@@ -18,6 +18,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -26,6 +27,7 @@ import (
 type Reconciler struct {
 	Client client.Client
 	Config config.ShootRetryControllerConfiguration
+	Shard  *sharding.Shard
 }
 
 // Reconcile reconciles failed Shoots and retries them.
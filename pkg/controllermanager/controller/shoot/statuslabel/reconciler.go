@@ -17,6 +17,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -25,6 +26,7 @@ import (
 type Reconciler struct {
 	Client client.Client
 	Config config.ShootStatusLabelControllerConfiguration
+	Shard  *sharding.Shard
 }
 
 // Reconcile reconciles Shoots and updates their status label.
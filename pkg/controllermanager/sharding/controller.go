@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// ControllerNeedLeaderElection returns the value for the NeedLeaderElection controller option of sharded controllers.
+// Sharded controllers run on all replicas, i.e., they do not need leader election. If the shard is nil (sharding is
+// disabled), nil is returned so that the default of the manager is used.
+func (s *Shard) ControllerNeedLeaderElection() *bool {
+	if s == nil {
+		return nil
+	}
+	return ptr.To(false)
+}
+
+// Complete completes the given builder whose controller reconciles objects of the kind of the given object. If the
+// shard is nil (sharding is disabled), the builder is completed with the given reconciler as is. Otherwise, the
+// reconciler only reconciles the objects owned by this shard, and all owned objects are enqueued whenever the members
+// of the shard ring change.
+func (s *Shard) Complete(b *builder.Builder, obj client.Object, r reconcile.Reconciler) error {
+	if s == nil {
+		return b.Complete(r)
+	}
+
+	return b.
+		WatchesRawSource(
+			&source.Channel{Source: s.subscribe()},
+			handler.EnqueueRequestsFromMapFunc(s.MapOwnedObjects(obj)),
+		).
+		Complete(s.Reconciler(obj, r))
+}
+
+// Reconciler wraps the given reconciler of a sharded controller whose controller reconciles objects of the kind of the
+// given object. The returned reconciler only forwards requests for objects owned by this shard.
+func (s *Shard) Reconciler(obj client.Object, r reconcile.Reconciler) reconcile.Reconciler {
+	return &reconciler{
+		shard:      s,
+		obj:        obj,
+		reconciler: r,
+	}
+}
+
+// MapOwnedObjects returns a map function which maps to requests for all objects of the kind of the given object which
+// are owned by this shard.
+func (s *Shard) MapOwnedObjects(obj client.Object) handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		log := logf.FromContext(ctx)
+
+		list, err := s.newListFor(obj)
+		if err != nil {
+			log.Error(err, "Failed creating list for sharded objects")
+			return nil
+		}
+
+		if err := s.Client.List(ctx, list); err != nil {
+			log.Error(err, "Failed listing sharded objects")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		if err := meta.EachListItem(list, func(o runtime.Object) error {
+			item, ok := o.(client.Object)
+			if ok && s.Owns(item) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(item)})
+			}
+			return nil
+		}); err != nil {
+			log.Error(err, "Failed iterating over sharded objects")
+			return nil
+		}
+
+		return requests
+	}
+}
+
+func (s *Shard) newListFor(obj client.Object) (client.ObjectList, error) {
+	gvk, err := apiutil.GVKForObject(obj, s.Client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.Client.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+
+	objectList, ok := list.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a client.ObjectList", list)
+	}
+	return objectList, nil
+}
+
+// reconciler wraps a reconciler of a sharded controller and only forwards requests for objects owned by the shard.
+// Requests for objects owned by other shards are dropped, e.g., when a requeue was scheduled before the ownership of
+// the object moved to another replica.
+type reconciler struct {
+	shard      *Shard
+	obj        client.Object
+	reconciler reconcile.Reconciler
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	obj := r.obj.DeepCopyObject().(client.Object)
+	if err := r.shard.Client.Get(ctx, request.NamespacedName, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+		}
+		// Let the wrapped reconciler handle the deletion of the object.
+		return r.reconciler.Reconcile(ctx, request)
+	}
+
+	if !r.shard.Owns(obj) {
+		logf.FromContext(ctx).V(1).Info("Skipping object because it is owned by another shard")
+		return reconcile.Result{}, nil
+	}
+
+	return r.reconciler.Reconcile(ctx, request)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// virtualNodesPerMember is the number of points each member occupies on the ring. A higher number results in a more
+// even distribution of the keys.
+const virtualNodesPerMember = 100
+
+// Ring is a consistent hash ring which assigns keys to members. Adding or removing a member only moves the keys of
+// this member, all other keys keep their assignment.
+type Ring struct {
+	members []string
+	hashes  []uint64
+	owners  map[uint64]string
+}
+
+// NewRing returns a new Ring for the given members.
+func NewRing(members ...string) *Ring {
+	members = slices.Clone(members)
+	slices.Sort(members)
+
+	r := &Ring{
+		members: slices.Compact(members),
+		owners:  make(map[uint64]string, len(members)*virtualNodesPerMember),
+	}
+
+	for _, member := range r.members {
+		for i := 0; i < virtualNodesPerMember; i++ {
+			h := hash(member + "#" + strconv.Itoa(i))
+			r.hashes = append(r.hashes, h)
+			r.owners[h] = member
+		}
+	}
+	slices.Sort(r.hashes)
+
+	return r
+}
+
+// Members returns the sorted members of the ring.
+func (r *Ring) Members() []string {
+	return slices.Clone(r.members)
+}
+
+// Owner returns the member the given key is assigned to. It returns an empty string if the ring has no members.
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+
+	return r.owners[r.hashes[i]]
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding_test
+
+import (
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/sharding"
+)
+
+var _ = Describe("Ring", func() {
+	keys := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, "key-"+strconv.Itoa(i))
+	}
+
+	It("should return an empty owner if the ring has no members", func() {
+		Expect(NewRing().Owner("foo")).To(BeEmpty())
+	})
+
+	It("should deduplicate and sort the members", func() {
+		Expect(NewRing("b", "a", "b").Members()).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should assign all keys to the only member", func() {
+		ring := NewRing("a")
+
+		for _, key := range keys {
+			Expect(ring.Owner(key)).To(Equal("a"))
+		}
+	})
+
+	It("should distribute the keys among all members", func() {
+		ring := NewRing("a", "b", "c")
+
+		counts := map[string]int{}
+		for _, key := range keys {
+			counts[ring.Owner(key)]++
+		}
+
+		Expect(counts).To(HaveLen(3))
+		for _, count := range counts {
+			Expect(count).To(BeNumerically(">", 200))
+		}
+	})
+
+	It("should only move the keys of a removed member", func() {
+		ring := NewRing("a", "b", "c")
+		newRing := NewRing("a", "b")
+
+		for _, key := range keys {
+			if owner := ring.Owner(key); owner != "c" {
+				Expect(newRing.Owner(key)).To(Equal(owner))
+			}
+		}
+	})
+})
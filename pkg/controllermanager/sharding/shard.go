@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+const (
+	// LabelShard is the label key for the leases of the shards.
+	LabelShard = "controllermanager.gardener.cloud/shard"
+	// LeaseNamePrefix is the prefix of the names of the leases of the shards.
+	LeaseNamePrefix = "gardener-controller-manager-shard-"
+	// DefaultLeaseDuration is the default duration of the shard leases.
+	DefaultLeaseDuration = 15 * time.Second
+)
+
+// Shard maintains the membership of this gardener-controller-manager replica in the shard ring and decides which
+// objects are reconciled by this replica. Each replica renews its own shard lease, the ring consists of all replicas
+// whose lease is not expired. A replica only considers itself a member as long as the last successful renewal of its
+// own lease is not expired, i.e., it sees the same ring as the other replicas. Objects are assigned to the replicas based on their UID via consistent hashing.
+type Shard struct {
+	Client    client.Client
+	APIReader client.Reader
+	Clock     clock.WithTicker
+	Config    config.ShardingConfiguration
+	// Identity is the identity of this replica. Defaults to the hostname.
+	Identity string
+	// Namespace is the namespace of the shard leases.
+	Namespace string
+
+	log           logr.Logger
+	ring          atomic.Pointer[Ring]
+	lastRenewTime time.Time
+	lock          sync.Mutex
+	subscribers   []chan event.GenericEvent
+}
+
+// AddToManager adds the Shard to the given manager.
+func (s *Shard) AddToManager(mgr manager.Manager) error {
+	if s.Client == nil {
+		s.Client = mgr.GetClient()
+	}
+	if s.APIReader == nil {
+		s.APIReader = mgr.GetAPIReader()
+	}
+	if s.Clock == nil {
+		s.Clock = clock.RealClock{}
+	}
+	if s.Config.LeaseDuration == nil {
+		s.Config.LeaseDuration = &metav1.Duration{Duration: DefaultLeaseDuration}
+	}
+	if s.Namespace == "" {
+		return fmt.Errorf("namespace for shard leases must not be empty")
+	}
+	if s.Identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("failed determining hostname: %w", err)
+		}
+		s.Identity = hostname
+	}
+	s.log = mgr.GetLogger().WithName("sharding").WithValues("identity", s.Identity)
+
+	return mgr.Add(s)
+}
+
+// NeedLeaderElection returns false since all replicas must maintain their shard lease.
+func (s *Shard) NeedLeaderElection() bool {
+	return false
+}
+
+// Start renews the shard lease and refreshes the ring periodically until the context is cancelled. The shard lease is
+// deleted afterwards so that the other replicas take over the objects of this replica without waiting for the lease
+// to expire.
+func (s *Shard) Start(ctx context.Context) error {
+	ticker := s.Clock.NewTicker(s.leaseDuration() / 3)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.log.Error(err, "Failed syncing shard")
+		}
+
+		select {
+		case <-ctx.Done():
+			deleteCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := client.IgnoreNotFound(s.Client.Delete(deleteCtx, s.emptyLease())); err != nil {
+				s.log.Error(err, "Failed deleting shard lease")
+			}
+			return nil
+
+		case <-ticker.C():
+		}
+	}
+}
+
+// Sync renews the shard lease of this replica and refreshes the ring. If the members of the ring changed, all
+// subscribers are notified. If the shard lease cannot be renewed, the ring is still refreshed so that this replica
+// leaves the ring once its lease expired.
+func (s *Shard) Sync(ctx context.Context) error {
+	renewErr := s.renewLease(ctx)
+	if renewErr != nil {
+		renewErr = fmt.Errorf("failed renewing shard lease: %w", renewErr)
+	} else {
+		s.lastRenewTime = s.Clock.Now()
+	}
+
+	leaseList := &coordinationv1.LeaseList{}
+	if err := s.APIReader.List(ctx, leaseList, client.InNamespace(s.Namespace), client.MatchingLabels{LabelShard: "true"}); err != nil {
+		return errors.Join(renewErr, fmt.Errorf("failed listing shard leases: %w", err))
+	}
+
+	var members []string
+	if !s.lastRenewTime.IsZero() && s.Clock.Now().Before(s.lastRenewTime.Add(s.leaseDuration())) {
+		members = append(members, s.Identity)
+	}
+
+	for _, lease := range leaseList.Items {
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+
+		// The membership of this replica is determined by the result of its own renewals only.
+		if *lease.Spec.HolderIdentity == s.Identity {
+			continue
+		}
+
+		expiration := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if s.Clock.Now().Before(expiration) {
+			members = append(members, *lease.Spec.HolderIdentity)
+		}
+	}
+
+	ring := NewRing(members...)
+	if oldRing := s.ring.Load(); oldRing != nil && slices.Equal(oldRing.Members(), ring.Members()) {
+		return renewErr
+	}
+
+	s.log.Info("Members of shard ring changed", "members", ring.Members())
+	s.ring.Store(ring)
+	s.notifySubscribers()
+
+	return renewErr
+}
+
+// Owns returns true if the given object is reconciled by this replica.
+func (s *Shard) Owns(obj client.Object) bool {
+	ring := s.ring.Load()
+	if ring == nil {
+		return false
+	}
+
+	return ring.Owner(string(obj.GetUID())) == s.Identity
+}
+
+func (s *Shard) renewLease(ctx context.Context) error {
+	lease := s.emptyLease()
+	if err := s.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		s.mutateLease(lease)
+		return s.Client.Create(ctx, lease)
+	}
+
+	s.mutateLease(lease)
+	return s.Client.Update(ctx, lease)
+}
+
+func (s *Shard) leaseDuration() time.Duration {
+	if s.Config.LeaseDuration == nil {
+		return DefaultLeaseDuration
+	}
+	return s.Config.LeaseDuration.Duration
+}
+
+func (s *Shard) emptyLease() *coordinationv1.Lease {
+	return &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: LeaseNamePrefix + s.Identity, Namespace: s.Namespace}}
+}
+
+func (s *Shard) mutateLease(lease *coordinationv1.Lease) {
+	metav1.SetMetaDataLabel(&lease.ObjectMeta, LabelShard, "true")
+	lease.Spec.HolderIdentity = &s.Identity
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(s.leaseDuration().Seconds()))
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: s.Clock.Now().UTC()}
+}
+
+func (s *Shard) subscribe() <-chan event.GenericEvent {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan event.GenericEvent, 1)
+	s.subscribers = append(s.subscribers, ch)
+	return ch
+}
+
+func (s *Shard) notifySubscribers() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- event.GenericEvent{Object: s.emptyLease()}:
+		default:
+			// A notification is already pending, subscribers list all objects anyway.
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/sharding"
+)
+
+var _ = Describe("Shard", func() {
+	const namespace = "garden"
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		shard      *Shard
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		shard = &Shard{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Clock:     fakeClock,
+			Config:    config.ShardingConfiguration{Enabled: true, LeaseDuration: &metav1.Duration{Duration: 15 * time.Second}},
+			Identity:  "replica-a",
+			Namespace: namespace,
+		}
+	})
+
+	newLease := func(identity string, renewTime time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      LeaseNamePrefix + identity,
+				Namespace: namespace,
+				Labels:    map[string]string{LabelShard: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(identity),
+				LeaseDurationSeconds: ptr.To[int32](15),
+				RenewTime:            &metav1.MicroTime{Time: renewTime},
+			},
+		}
+	}
+
+	shootWithUID := func(i int) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{UID: types.UID("uid-" + strconv.Itoa(i))}}
+	}
+
+	Describe("#Sync", func() {
+		It("should create the shard lease", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: LeaseNamePrefix + "replica-a", Namespace: namespace}, lease)).To(Succeed())
+			Expect(lease.Labels).To(HaveKeyWithValue(LabelShard, "true"))
+			Expect(lease.Spec.HolderIdentity).To(PointTo(Equal("replica-a")))
+			Expect(lease.Spec.LeaseDurationSeconds).To(PointTo(Equal(int32(15))))
+			Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		})
+
+		It("should renew the shard lease", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+			fakeClock.Step(5 * time.Second)
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: LeaseNamePrefix + "replica-a", Namespace: namespace}, lease)).To(Succeed())
+			Expect(lease.Spec.RenewTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		})
+
+		It("should own all objects if it is the only member", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			for i := 0; i < 100; i++ {
+				Expect(shard.Owns(shootWithUID(i))).To(BeTrue())
+			}
+		})
+
+		It("should share the objects with the other members and ignore expired leases", func() {
+			Expect(fakeClient.Create(ctx, newLease("replica-b", fakeClock.Now()))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newLease("replica-c", fakeClock.Now().Add(-time.Minute)))).To(Succeed())
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			ring := NewRing("replica-a", "replica-b")
+			owned := 0
+			for i := 0; i < 100; i++ {
+				shoot := shootWithUID(i)
+				Expect(shard.Owns(shoot)).To(Equal(ring.Owner(string(shoot.UID)) == "replica-a"))
+				if shard.Owns(shoot) {
+					owned++
+				}
+			}
+			Expect(owned).To(BeNumerically(">", 0))
+			Expect(owned).To(BeNumerically("<", 100))
+		})
+	})
+
+	Describe("#Sync with failing lease renewals", func() {
+		var renewErr error
+
+		BeforeEach(func() {
+			renewErr = nil
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if renewErr != nil {
+						return renewErr
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if renewErr != nil {
+						return renewErr
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			}).Build()
+			shard.Client = fakeClient
+			shard.APIReader = fakeClient
+		})
+
+		It("should not become a member if the shard lease was never renewed", func() {
+			Expect(fakeClient.Create(ctx, newLease("replica-b", fakeClock.Now()))).To(Succeed())
+			renewErr = errors.New("fake")
+
+			Expect(shard.Sync(ctx)).To(MatchError(ContainSubstring("failed renewing shard lease")))
+
+			for i := 0; i < 100; i++ {
+				Expect(shard.Owns(shootWithUID(i))).To(BeFalse())
+			}
+		})
+
+		It("should stay a member until the last successful renewal expired", func() {
+			Expect(shard.Sync(ctx)).To(Succeed())
+			Expect(fakeClient.Create(ctx, newLease("replica-b", fakeClock.Now().Add(time.Hour)))).To(Succeed())
+
+			renewErr = errors.New("fake")
+			fakeClock.Step(10 * time.Second)
+			Expect(shard.Sync(ctx)).To(MatchError(ContainSubstring("failed renewing shard lease")))
+
+			ring := NewRing("replica-a", "replica-b")
+			for i := 0; i < 100; i++ {
+				shoot := shootWithUID(i)
+				Expect(shard.Owns(shoot)).To(Equal(ring.Owner(string(shoot.UID)) == "replica-a"))
+			}
+
+			fakeClock.Step(10 * time.Second)
+			Expect(shard.Sync(ctx)).To(MatchError(ContainSubstring("failed renewing shard lease")))
+
+			for i := 0; i < 100; i++ {
+				Expect(shard.Owns(shootWithUID(i))).To(BeFalse())
+			}
+		})
+	})
+
+	Describe("#Reconciler", func() {
+		var (
+			reconciled []reconcile.Request
+			r          reconcile.Reconciler
+		)
+
+		BeforeEach(func() {
+			reconciled = nil
+			r = shard.Reconciler(&gardencorev1beta1.Shoot{}, reconcile.Func(func(_ context.Context, request reconcile.Request) (reconcile.Result, error) {
+				reconciled = append(reconciled, request)
+				return reconcile.Result{}, nil
+			}))
+
+			Expect(fakeClient.Create(ctx, newLease("replica-b", fakeClock.Now()))).To(Succeed())
+			Expect(shard.Sync(ctx)).To(Succeed())
+		})
+
+		It("should only forward requests for owned objects", func() {
+			var owned, notOwned *gardencorev1beta1.Shoot
+			for i := 0; owned == nil || notOwned == nil; i++ {
+				shoot := shootWithUID(i)
+				shoot.Name, shoot.Namespace = "shoot-"+strconv.Itoa(i), "garden-foo"
+				if shard.Owns(shoot) {
+					owned = shoot
+				} else {
+					notOwned = shoot
+				}
+			}
+			Expect(fakeClient.Create(ctx, owned)).To(Succeed())
+			Expect(fakeClient.Create(ctx, notOwned)).To(Succeed())
+
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(owned)})).To(Equal(reconcile.Result{}))
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(notOwned)})).To(Equal(reconcile.Result{}))
+
+			Expect(reconciled).To(HaveExactElements(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(owned)}))
+		})
+
+		It("should forward requests for deleted objects", func() {
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "foo", Namespace: "garden-foo"}}
+
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(reconciled).To(HaveExactElements(request))
+		})
+	})
+
+	Describe("#MapOwnedObjects", func() {
+		It("should map to all owned objects", func() {
+			Expect(fakeClient.Create(ctx, newLease("replica-b", fakeClock.Now()))).To(Succeed())
+			Expect(shard.Sync(ctx)).To(Succeed())
+
+			var expected []reconcile.Request
+			for i := 0; i < 20; i++ {
+				shoot := shootWithUID(i)
+				shoot.Name, shoot.Namespace = "shoot-"+strconv.Itoa(i), "garden-foo"
+				Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+				if shard.Owns(shoot) {
+					expected = append(expected, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				}
+			}
+
+			Expect(shard.MapOwnedObjects(&gardencorev1beta1.Shoot{})(ctx, nil)).To(ConsistOf(expected))
+		})
+	})
+
+	Describe("#Owns", func() {
+		It("should not own any object before the first sync", func() {
+			Expect(shard.Owns(shootWithUID(0))).To(BeFalse())
+		})
+	})
+
+	Describe("#ControllerNeedLeaderElection", func() {
+		It("should return nil if sharding is disabled", func() {
+			var shard *Shard
+			Expect(shard.ControllerNeedLeaderElection()).To(BeNil())
+		})
+
+		It("should return false if sharding is enabled", func() {
+			Expect(shard.ControllerNeedLeaderElection()).To(PointTo(BeFalse()))
+		})
+	})
+})
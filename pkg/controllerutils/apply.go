@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ServerSideApply is an alternative to GetAndCreateOrMergePatch which uses server-side apply. It does not read the
+// object from the client. Instead, the MutateFn is called on an empty object which only has the name and namespace of
+// the given object, i.e., the MutateFn must set all fields owned by the given field owner and must not depend on the
+// existing state of the object. The result is applied with the given field owner, and conflicting fields owned by
+// other field managers are taken over.
+// Fields which are not set by the MutateFn are neither reset nor owned, i.e., they can be managed by other actors without
+// causing conflicts or churn. Hence, the MutateFn must not set fields which are managed by autoscalers, e.g.,
+// `.spec.replicas` of a Deployment, see CreateOrApplyDeployment.
+//
+// After the call, the given object contains the state returned by the API server.
+func ServerSideApply(ctx context.Context, c client.Client, obj client.Object, fieldOwner string, f controllerutil.MutateFn) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+
+	// Reset the object, so that only the fields set by the MutateFn are applied.
+	name, namespace := obj.GetName(), obj.GetNamespace()
+	objValue := reflect.ValueOf(obj)
+	if objValue.Kind() != reflect.Pointer || objValue.IsNil() {
+		return fmt.Errorf("object must be a non-nil pointer, got %T", obj)
+	}
+	objValue.Elem().Set(reflect.Zero(objValue.Elem().Type()))
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	return c.Patch(ctx, obj, client.Apply, client.FieldOwner(fieldOwner), client.ForceOwnership)
+}
+
+// CreateOrApply deploys the given object with the given mutate function. If serverSideApply is true, the object is
+// applied with the given field owner, see ServerSideApply. Otherwise, the object is read and patched, see
+// GetAndCreateOrMergePatch.
+func CreateOrApply(ctx context.Context, c client.Client, obj client.Object, serverSideApply bool, fieldOwner string, f controllerutil.MutateFn) error {
+	if serverSideApply {
+		return ServerSideApply(ctx, c, obj, fieldOwner, f)
+	}
+
+	_, err := GetAndCreateOrMergePatch(ctx, c, obj, f)
+	return err
+}
+
+// CreateOrApplyDeployment deploys the given Deployment like CreateOrApply, but handles its `.spec.replicas` separately,
+// i.e., the MutateFn must not set them. With read-and-patch, the given replicas are patched together with the fields
+// set by the MutateFn. With server-side apply, the replicas are not part of the applied object, so that they are not
+// owned by the given field owner and not taken over from autoscalers like the HorizontalPodAutoscaler. Instead, the
+// Deployment is scaled via its `scale` subresource if its current replicas differ from the given replicas.
+// If the replicas of a Deployment are completely managed by an autoscaler, use CreateOrApply and leave them unset.
+func CreateOrApplyDeployment(ctx context.Context, c client.Client, deployment *appsv1.Deployment, replicas int32, serverSideApply bool, fieldOwner string, f controllerutil.MutateFn) error {
+	if !serverSideApply {
+		_, err := GetAndCreateOrMergePatch(ctx, c, deployment, func() error {
+			if err := f(); err != nil {
+				return err
+			}
+			deployment.Spec.Replicas = &replicas
+			return nil
+		})
+		return err
+	}
+
+	if err := ServerSideApply(ctx, c, deployment, fieldOwner, f); err != nil {
+		return err
+	}
+
+	if ptr.Equal(deployment.Spec.Replicas, &replicas) {
+		return nil
+	}
+
+	// The response of the scale subresource is a Scale object, hence a copy is passed to not overwrite the Deployment.
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)))
+	if err := c.SubResource("scale").Patch(ctx, deployment.DeepCopy(), patch, client.FieldOwner(fieldOwner)); err != nil {
+		return fmt.Errorf("failed scaling deployment %s to %d replicas: %w", client.ObjectKeyFromObject(deployment), replicas, err)
+	}
+	deployment.Spec.Replicas = &replicas
+	return nil
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	corescheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/gardener/pkg/controllerutils"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		ctx     = context.TODO()
+		fakeErr = errors.New("fake err")
+
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+		obj  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       "bar",
+			ResourceVersion: "42",
+			Labels:          map[string]string{"existing": "label"},
+		}}
+
+		scheme := runtime.NewScheme()
+		Expect(corescheme.AddToScheme(scheme)).To(Succeed())
+		c.EXPECT().Scheme().Return(scheme).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ServerSideApply", func() {
+		It("should apply only the fields set by the mutate function", func() {
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{}), client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership).
+				DoAndReturn(func(_ context.Context, o client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(o).To(Equal(&corev1.ConfigMap{
+						TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+						ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+						Data:       map[string]string{"foo": "bar"},
+					}))
+					return nil
+				})
+
+			Expect(ServerSideApply(ctx, c, obj, "gardenlet", func() error {
+				Expect(obj.Labels).To(BeEmpty())
+				obj.Data = map[string]string{"foo": "bar"}
+				return nil
+			})).To(Succeed())
+		})
+
+		It("should return the error of the mutate function", func() {
+			Expect(ServerSideApply(ctx, c, obj, "gardenlet", func() error {
+				return fakeErr
+			})).To(MatchError(fakeErr))
+		})
+
+		It("should return the error of the patch", func() {
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{}), client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership).Return(fakeErr)
+
+			Expect(ServerSideApply(ctx, c, obj, "gardenlet", func() error {
+				return nil
+			})).To(MatchError(fakeErr))
+		})
+	})
+	Describe("#CreateOrApply", func() {
+		It("should apply the object if server-side apply is requested", func() {
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.ConfigMap{}), client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership)
+
+			Expect(CreateOrApply(ctx, c, obj, true, "gardenlet", func() error {
+				obj.Data = map[string]string{"foo": "bar"}
+				return nil
+			})).To(Succeed())
+		})
+
+		It("should read and patch the object if server-side apply is not requested", func() {
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(obj), obj)
+			c.EXPECT().Patch(ctx, obj, gomock.Any())
+
+			Expect(CreateOrApply(ctx, c, obj, false, "gardenlet", func() error {
+				obj.Data = map[string]string{"foo": "bar"}
+				return nil
+			})).To(Succeed())
+		})
+	})
+
+	Describe("#CreateOrApplyDeployment", func() {
+		var (
+			deployment *appsv1.Deployment
+			mutate     func() error
+		)
+
+		BeforeEach(func() {
+			deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+			mutate = func() error {
+				deployment.Spec.RevisionHistoryLimit = ptr.To[int32](1)
+				return nil
+			}
+		})
+
+		Context("with server-side apply", func() {
+			var sw *mockclient.MockSubResourceClient
+
+			BeforeEach(func() {
+				sw = mockclient.NewMockSubResourceClient(ctrl)
+			})
+
+			It("should not apply the replicas and scale the deployment if they differ", func() {
+				gomock.InOrder(
+					c.EXPECT().Patch(ctx, deployment, client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership).
+						DoAndReturn(func(_ context.Context, o client.Object, _ client.Patch, _ ...client.PatchOption) error {
+							Expect(o.(*appsv1.Deployment).Spec.Replicas).To(BeNil())
+							// replicas are returned as currently set by other actors
+							o.(*appsv1.Deployment).Spec.Replicas = ptr.To[int32](1)
+							return nil
+						}),
+					c.EXPECT().SubResource("scale").Return(sw),
+					sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&appsv1.Deployment{}), client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":2}}`)), client.FieldOwner("gardenlet")),
+				)
+
+				Expect(CreateOrApplyDeployment(ctx, c, deployment, 2, true, "gardenlet", mutate)).To(Succeed())
+				Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(2))))
+			})
+
+			It("should not scale the deployment if the replicas do not differ", func() {
+				c.EXPECT().Patch(ctx, deployment, client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership).
+					DoAndReturn(func(_ context.Context, o client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						o.(*appsv1.Deployment).Spec.Replicas = ptr.To[int32](2)
+						return nil
+					})
+
+				Expect(CreateOrApplyDeployment(ctx, c, deployment, 2, true, "gardenlet", mutate)).To(Succeed())
+			})
+
+			It("should return the error of the scale patch", func() {
+				c.EXPECT().Patch(ctx, deployment, client.Apply, client.FieldOwner("gardenlet"), client.ForceOwnership)
+				c.EXPECT().SubResource("scale").Return(sw)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&appsv1.Deployment{}), gomock.Any(), client.FieldOwner("gardenlet")).Return(fakeErr)
+
+				Expect(CreateOrApplyDeployment(ctx, c, deployment, 2, true, "gardenlet", mutate)).To(MatchError(ContainSubstring(fakeErr.Error())))
+			})
+		})
+
+		It("should read and patch the deployment including the replicas if server-side apply is not requested", func() {
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(deployment), deployment)
+			c.EXPECT().Patch(ctx, deployment, gomock.Any())
+
+			Expect(CreateOrApplyDeployment(ctx, c, deployment, 2, false, "gardenlet", mutate)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(2))))
+			Expect(deployment.Spec.RevisionHistoryLimit).To(PointTo(Equal(int32(1))))
+		})
+	})
+})
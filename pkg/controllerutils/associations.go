@@ -16,11 +16,12 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 )
 
 // DetermineShootsAssociatedTo gets a <shootLister> to determine the Shoots resources which are associated
-// to given <obj> (either a CloudProfile, Seed, Secretbinding, CredentialsBinding or a ExposureClass object).
+// to given <obj> (either a CloudProfile, Seed, Secretbinding, CredentialsBinding, ExposureClass or a ClusterAuditPolicy object).
 func DetermineShootsAssociatedTo(ctx context.Context, gardenClient client.Reader, obj any) ([]string, error) {
 	shootList := &gardencorev1beta1.ShootList{}
 	if err := gardenClient.List(ctx, shootList); err != nil {
@@ -59,6 +60,11 @@ func DetermineShootsAssociatedTo(ctx context.Context, gardenClient client.Reader
 			if ptr.Deref(shoot.Spec.ExposureClassName, "") == exposureClass.Name {
 				associatedShoots = append(associatedShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
 			}
+		case *gardencorev1beta1.ClusterAuditPolicy:
+			clusterAuditPolicy := obj.(*gardencorev1beta1.ClusterAuditPolicy)
+			if v1beta1helper.GetShootClusterAuditPolicyName(shoot.Spec.Kubernetes.KubeAPIServer) == clusterAuditPolicy.Name {
+				associatedShoots = append(associatedShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+			}
 		default:
 			return nil, fmt.Errorf("unable to determine Shoot associations, due to unknown type %t", t)
 		}
@@ -86,6 +92,23 @@ func DetermineSecretBindingAssociations(ctx context.Context, c client.Client, qu
 	return associatedBindings, nil
 }
 
+// DetermineSeedAssociations determines the Seed resources which reference the given ClusterAuditPolicy <obj> as
+// default audit policy for their shoots.
+func DetermineSeedAssociations(ctx context.Context, c client.Client, clusterAuditPolicy *gardencorev1beta1.ClusterAuditPolicy) ([]string, error) {
+	seeds := &gardencorev1beta1.SeedList{}
+	if err := c.List(ctx, seeds); err != nil {
+		return nil, err
+	}
+
+	var associatedSeeds []string
+	for _, seed := range seeds.Items {
+		if v1beta1helper.SeedSettingClusterAuditPolicyName(seed.Spec.Settings) == clusterAuditPolicy.Name {
+			associatedSeeds = append(associatedSeeds, seed.Name)
+		}
+	}
+	return associatedSeeds, nil
+}
+
 // DetermineCredentialsBindingAssociations determines the CredentialsBinding resources
 // which are associated to given Quota <obj>.
 func DetermineCredentialsBindingAssociations(ctx context.Context, c client.Client, quota *gardencorev1beta1.Quota) ([]string, error) {
@@ -110,6 +110,14 @@ var _ = Describe("Associations", func() {
 			&gardencorev1beta1.ExposureClass{ObjectMeta: metav1.ObjectMeta{Name: "exposureclass"}}, func(s *gardencorev1beta1.Shoot, obj client.Object) {
 				s.Spec.ExposureClassName = ptr.To(obj.GetName())
 			}, BeNil()),
+		Entry("should return shoots associated to clusterauditpolicy",
+			&gardencorev1beta1.ClusterAuditPolicy{ObjectMeta: metav1.ObjectMeta{Name: "clusterauditpolicy"}}, func(s *gardencorev1beta1.Shoot, obj client.Object) {
+				s.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: &gardencorev1beta1.AuditPolicy{ClusterAuditPolicyName: ptr.To(obj.GetName())},
+					},
+				}
+			}, BeNil()),
 		Entry("should return error if the object is of not supported type",
 			&gardencorev1beta1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "backupbucket"}}, func(_ *gardencorev1beta1.Shoot, _ client.Object) {}, HaveOccurred()),
 	)
@@ -152,6 +160,29 @@ var _ = Describe("Associations", func() {
 		})
 	})
 
+	Describe("#DetermineSeedAssociations", func() {
+		It("should return seeds associated to cluster audit policy", func() {
+			clusterAuditPolicy := &gardencorev1beta1.ClusterAuditPolicy{ObjectMeta: metav1.ObjectMeta{Name: "audit-policy"}}
+
+			seed := &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec: gardencorev1beta1.SeedSpec{
+					Settings: &gardencorev1beta1.SeedSettings{
+						AuditPolicy: &gardencorev1beta1.SeedSettingAuditPolicy{ClusterAuditPolicyName: clusterAuditPolicy.Name},
+					},
+				},
+			}
+			otherSeed := &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "other-seed"}}
+
+			Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeClient.Create(ctx, otherSeed)).To(Succeed())
+
+			seeds, err := DetermineSeedAssociations(ctx, fakeClient, clusterAuditPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(seeds).To(ConsistOf(seed.Name))
+		})
+	})
+
 	Describe("#DetermineBackupBucketAssociations", func() {
 		It("should return backupbucket associated to seed", func() {
 			backupbucket = &gardencorev1beta1.BackupBucket{
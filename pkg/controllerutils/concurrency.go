@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ConcurrencyLimiter limits the number of concurrent reconciliations of a controller. In contrast to the
+// `MaxConcurrentReconciles` option of controllers, the limit can be changed at runtime. It cannot exceed the number of
+// workers of the controller, see `Workers`.
+type ConcurrencyLimiter struct {
+	workers int
+
+	lock    sync.Mutex
+	limit   int
+	active  int
+	changed chan struct{}
+}
+
+// NewConcurrencyLimiter returns a new ConcurrencyLimiter with the given limit. The controller should be started with
+// the returned number of workers, which is the maximum of the given limit and the given number of workers.
+func NewConcurrencyLimiter(limit, workers int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		workers: max(limit, workers),
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Workers returns the number of workers the controller should be started with, i.e., the maximum limit.
+func (l *ConcurrencyLimiter) Workers() int {
+	return l.workers
+}
+
+// Limit returns the current limit.
+func (l *ConcurrencyLimiter) Limit() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.limit
+}
+
+// SetLimit changes the limit. Reconciliations which are already running are not interrupted if the limit is decreased.
+// It returns an error if the limit exceeds the number of workers.
+func (l *ConcurrencyLimiter) SetLimit(limit int) error {
+	if err := l.ValidateLimit(limit); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.limit = limit
+	l.notify()
+	return nil
+}
+
+// ValidateLimit returns an error if the given limit exceeds the number of workers.
+func (l *ConcurrencyLimiter) ValidateLimit(limit int) error {
+	if limit > l.workers {
+		return fmt.Errorf("limit %d exceeds the number of workers %d", limit, l.workers)
+	}
+	return nil
+}
+
+// Acquire blocks until a reconciliation slot is available or the given context is cancelled.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.lock.Lock()
+		if l.active < l.limit {
+			l.active++
+			l.lock.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Release frees a reconciliation slot previously reserved with Acquire.
+func (l *ConcurrencyLimiter) Release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.active--
+	l.notify()
+}
+
+// notify wakes up all callers waiting in Acquire. The lock must be held by the caller.
+func (l *ConcurrencyLimiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// LimitConcurrency returns a reconciler which calls the given reconciler only if the given limiter provides a
+// reconciliation slot.
+func LimitConcurrency(limiter *ConcurrencyLimiter, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+		if err := limiter.Acquire(ctx); err != nil {
+			return reconcile.Result{}, err
+		}
+		defer limiter.Release()
+
+		return reconciler.Reconcile(ctx, request)
+	})
+}
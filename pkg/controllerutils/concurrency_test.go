@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/controllerutils"
+)
+
+var _ = Describe("ConcurrencyLimiter", func() {
+	var (
+		ctx     = context.Background()
+		limiter *ConcurrencyLimiter
+	)
+
+	BeforeEach(func() {
+		limiter = NewConcurrencyLimiter(1, 5)
+	})
+
+	It("should start the maximum of the limit and the given number of workers", func() {
+		Expect(limiter.Workers()).To(Equal(5))
+		Expect(NewConcurrencyLimiter(10, 5).Workers()).To(Equal(10))
+	})
+
+	It("should block until a slot is released", func() {
+		Expect(limiter.Acquire(ctx)).To(Succeed())
+
+		acquired := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(limiter.Acquire(ctx)).To(Succeed())
+			close(acquired)
+		}()
+
+		Consistently(acquired).ShouldNot(BeClosed())
+		limiter.Release()
+		Eventually(acquired).Should(BeClosed())
+	})
+
+	It("should unblock waiting callers when the limit is increased", func() {
+		Expect(limiter.Acquire(ctx)).To(Succeed())
+
+		acquired := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(limiter.Acquire(ctx)).To(Succeed())
+			close(acquired)
+		}()
+
+		Consistently(acquired).ShouldNot(BeClosed())
+		Expect(limiter.SetLimit(2)).To(Succeed())
+		Eventually(acquired).Should(BeClosed())
+		Expect(limiter.Limit()).To(Equal(2))
+	})
+
+	It("should return when the context is cancelled", func() {
+		Expect(limiter.Acquire(ctx)).To(Succeed())
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		Expect(limiter.Acquire(cancelledCtx)).To(MatchError(context.Canceled))
+	})
+
+	It("should reject limits exceeding the number of workers", func() {
+		Expect(limiter.SetLimit(6)).To(MatchError("limit 6 exceeds the number of workers 5"))
+		Expect(limiter.Limit()).To(Equal(1))
+	})
+
+	Describe("#LimitConcurrency", func() {
+		It("should call the reconciler while holding a slot", func() {
+			reconciler := LimitConcurrency(limiter, reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				cancelledCtx, cancel := context.WithCancel(ctx)
+				cancel()
+
+				Expect(limiter.Acquire(cancelledCtx)).To(MatchError(context.Canceled))
+				return reconcile.Result{Requeue: true}, nil
+			}))
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{Requeue: true}))
+			Expect(limiter.Acquire(ctx)).To(Succeed())
+		})
+	})
+})
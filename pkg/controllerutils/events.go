@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+)
+
+// RecordEvent records an event for the given object. The event is annotated with machine-readable metadata, i.e., the
+// ID of the current reconciliation (if any), the identifiers of the related shoot and seed, and the given error codes.
+func RecordEvent(ctx context.Context, recorder record.EventRecorder, object runtime.Object, eventType, reason, message string, codes ...gardencorev1beta1.ErrorCode) {
+	annotations := EventAnnotations(ctx, object)
+	if len(codes) > 0 {
+		errorCodes := make([]string, 0, len(codes))
+		for _, code := range codes {
+			errorCodes = append(errorCodes, string(code))
+		}
+		annotations[v1beta1constants.AnnotationEventErrorCodes] = strings.Join(errorCodes, separator)
+	}
+
+	recorder.AnnotatedEventf(object, annotations, eventType, reason, "%s", message)
+}
+
+// RecordEventf is like RecordEvent but uses fmt.Sprintf to construct the message.
+func RecordEventf(ctx context.Context, recorder record.EventRecorder, object runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	RecordEvent(ctx, recorder, object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// EventAnnotations returns the annotations which are attached to events recorded for the given object.
+func EventAnnotations(ctx context.Context, object runtime.Object) map[string]string {
+	annotations := map[string]string{}
+
+	if reconcileID := controller.ReconcileIDFromContext(ctx); reconcileID != "" {
+		annotations[v1beta1constants.AnnotationEventCorrelationID] = string(reconcileID)
+	}
+
+	var seedName *string
+
+	switch obj := object.(type) {
+	case *gardencorev1beta1.Shoot:
+		annotations[v1beta1constants.AnnotationEventShootNamespace] = obj.Namespace
+		annotations[v1beta1constants.AnnotationEventShootName] = obj.Name
+		seedName = obj.Spec.SeedName
+	case *gardencorev1beta1.Seed:
+		seedName = &obj.Name
+	case *gardencorev1beta1.BackupBucket:
+		seedName = obj.Spec.SeedName
+	case *gardencorev1beta1.BackupEntry:
+		seedName = obj.Spec.SeedName
+	case *seedmanagementv1alpha1.ManagedSeed:
+		if obj.Spec.Shoot != nil {
+			annotations[v1beta1constants.AnnotationEventShootNamespace] = obj.Namespace
+			annotations[v1beta1constants.AnnotationEventShootName] = obj.Spec.Shoot.Name
+		}
+		seedName = &obj.Name
+	}
+
+	if seedName != nil && *seedName != "" {
+		annotations[v1beta1constants.AnnotationEventSeedName] = *seedName
+	}
+
+	return annotations
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllerutils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllerutils"
+)
+
+var _ = Describe("Events", func() {
+	var (
+		ctx   = context.Background()
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+		}
+	})
+
+	Describe("#EventAnnotations", func() {
+		It("should return the shoot and seed identifiers for shoots", func() {
+			Expect(EventAnnotations(ctx, shoot)).To(Equal(map[string]string{
+				"events.gardener.cloud/shoot-namespace": "garden-bar",
+				"events.gardener.cloud/shoot-name":      "foo",
+				"events.gardener.cloud/seed-name":       "seed",
+			}))
+		})
+
+		It("should not return the seed name for unscheduled shoots", func() {
+			shoot.Spec.SeedName = nil
+
+			Expect(EventAnnotations(ctx, shoot)).To(Equal(map[string]string{
+				"events.gardener.cloud/shoot-namespace": "garden-bar",
+				"events.gardener.cloud/shoot-name":      "foo",
+			}))
+		})
+
+		It("should return the seed name for seeds", func() {
+			Expect(EventAnnotations(ctx, &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}})).To(Equal(map[string]string{
+				"events.gardener.cloud/seed-name": "seed",
+			}))
+		})
+
+		It("should return the shoot and seed identifiers for managed seeds", func() {
+			managedSeed := &seedmanagementv1alpha1.ManagedSeed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed", Namespace: "garden"},
+				Spec:       seedmanagementv1alpha1.ManagedSeedSpec{Shoot: &seedmanagementv1alpha1.Shoot{Name: "foo"}},
+			}
+
+			Expect(EventAnnotations(ctx, managedSeed)).To(Equal(map[string]string{
+				"events.gardener.cloud/shoot-namespace": "garden",
+				"events.gardener.cloud/shoot-name":      "foo",
+				"events.gardener.cloud/seed-name":       "seed",
+			}))
+		})
+
+		It("should return no annotations for other objects", func() {
+			Expect(EventAnnotations(ctx, &corev1.ConfigMap{})).To(BeEmpty())
+		})
+	})
+
+	Describe("#RecordEvent", func() {
+		It("should record an annotated event with the error codes", func() {
+			recorder := record.NewFakeRecorder(1)
+
+			RecordEvent(ctx, recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, "some error", gardencorev1beta1.ErrorInfraUnauthorized, gardencorev1beta1.ErrorInfraQuotaExceeded)
+
+			Expect(recorder.Events).To(Receive(Equal("Warning ReconcileError some error map[" +
+				"events.gardener.cloud/error-codes:ERR_INFRA_UNAUTHORIZED,ERR_INFRA_QUOTA_EXCEEDED " +
+				"events.gardener.cloud/seed-name:seed " +
+				"events.gardener.cloud/shoot-name:foo " +
+				"events.gardener.cloud/shoot-namespace:garden-bar]")))
+		})
+	})
+
+	Describe("#RecordEventf", func() {
+		It("should record an annotated event with the formatted message", func() {
+			recorder := record.NewFakeRecorder(1)
+
+			RecordEventf(ctx, recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, "Reconciled %s", "shoot")
+
+			Expect(recorder.Events).To(Receive(Equal("Normal Reconciled Reconciled shoot map[" +
+				"events.gardener.cloud/seed-name:seed " +
+				"events.gardener.cloud/shoot-name:foo " +
+				"events.gardener.cloud/shoot-namespace:garden-bar]")))
+		})
+	})
+})
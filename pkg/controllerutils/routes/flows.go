@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	// FlowHandlers is list of endpoints for inspecting the dependency graphs and the task states of the last flow
+	// executions.
+	FlowHandlers = map[string]http.Handler{
+		"/debug/flows": flow.DefaultRecorder,
+	}
+)
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var (
+	// ShootStateHandlers is list of endpoints for comparing the persisted ShootStates with the live state in the seed.
+	ShootStateHandlers = map[string]http.Handler{
+		"/debug/shootstates": shootstate.DefaultDiffHandler,
+	}
+)
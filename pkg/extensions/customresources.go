@@ -120,6 +120,9 @@ func WaitUntilObjectReadyWithHealthFunction(
 	}); err != nil {
 		message := fmt.Sprintf("Error while waiting for %s to become ready", extensionKey(kind, namespace, name))
 		if lastObservedError != nil {
+			if health.IsExtensionNotReconciled(lastObservedError) {
+				return v1beta1helper.NewErrorWithCodes(fmt.Errorf("%s: %w", message, lastObservedError), gardencorev1beta1.ErrorExtensionNotReconciled)
+			}
 			return fmt.Errorf("%s: %w", message, lastObservedError)
 		}
 		return fmt.Errorf("%s: %w", message, err)
@@ -124,6 +124,17 @@ var _ = Describe("extensions", func() {
 			Expect(err).To(HaveOccurred(), "worker readiness error")
 		})
 
+		It("should return error with code if extension object was not reconciled", func() {
+			Expect(c.Create(ctx, expected)).ToNot(HaveOccurred(), "creating worker succeeds")
+			err := WaitUntilExtensionObjectReady(
+				ctx, c, log,
+				expected, extensionsv1alpha1.WorkerResource,
+				defaultInterval, defaultThreshold, defaultTimeout, nil,
+			)
+			Expect(err).To(MatchError(ContainSubstring("extension did not record a last operation yet")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorExtensionNotReconciled))
+		})
+
 		It("should return success if extension object got ready the first time", func() {
 			passedObj := expected.DeepCopy()
 			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
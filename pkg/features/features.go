@@ -86,6 +86,66 @@ const (
 	// owner: @MichaelEischer
 	// alpha: v1.98.0
 	NewWorkerPoolHash featuregate.Feature = "NewWorkerPoolHash"
+
+	// ServerSideApplyComponents makes gardenlet deploy the resources of migrated control plane components via
+	// server-side apply instead of reading and patching them.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ServerSideApplyComponents featuregate.Feature = "ServerSideApplyComponents"
+
+	// RuntimeSecurity enables the deployment of the runtime security agent to the nodes of shoot clusters which
+	// enable it via `.spec.systemComponents.runtimeSecurity.enabled`.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	RuntimeSecurity featuregate.Feature = "RuntimeSecurity"
+
+	// ShootStateEncryption makes gardenlet encrypt the secrets persisted in ShootStates with a shoot-specific data key
+	// which is stored in an InternalSecret in the project namespace.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ShootStateEncryption featuregate.Feature = "ShootStateEncryption"
+
+	// ShootOperationAuthorization makes gardener-apiserver require dedicated custom RBAC verbs on `shoots` for
+	// triggering sensitive operations like force-deletion or credentials rotation.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ShootOperationAuthorization featuregate.Feature = "ShootOperationAuthorization"
+
+	// ShootImport enables the `shoots/import` subresource in gardener-apiserver and makes gardenlet adopt existing,
+	// externally created clusters as shoots.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ShootImport featuregate.Feature = "ShootImport"
+
+	// ShootComponentInventory makes gardenlet publish the images and digests of the control plane and system
+	// components of shoots as a CycloneDX document in the `<shoot-name>.inventory` ConfigMap in the project namespace.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ShootComponentInventory featuregate.Feature = "ShootComponentInventory"
+
+	// ExistingHostWorkerPools allows specifying worker pools of shoots which are backed by pre-existing hosts that are
+	// registered to the cluster by the user instead of machines provisioned by Gardener.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ExistingHostWorkerPools featuregate.Feature = "ExistingHostWorkerPools"
+
+	// PerTargetClientRateLimiting makes gardenlet share a single client-side rate limiter for all requests to the garden,
+	// the seed and each shoot cluster, respectively, and exposes metrics about how long requests were throttled.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	PerTargetClientRateLimiting featuregate.Feature = "PerTargetClientRateLimiting"
+
+	// InPlaceNodeUpdates allows specifying the in-place update strategy for worker pools of shoots. For such worker
+	// pools, the operating system and the kubelet of the existing nodes are updated instead of rolling the nodes.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	InPlaceNodeUpdates featuregate.Feature = "InPlaceNodeUpdates"
+
+	// ShootNetworkingCIDRExpansion allows enlarging the node and pod CIDRs of existing shoots to CIDRs containing the
+	// previous ones.
+	// owner: @ashwani2k
+	// alpha: v1.102.0
+	ShootNetworkingCIDRExpansion featuregate.Feature = "ShootNetworkingCIDRExpansion"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -113,17 +173,27 @@ var DefaultFeatureGate = utilfeature.DefaultMutableFeatureGate
 
 // AllFeatureGates is the list of all feature gates.
 var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	HVPA:                      {Default: false, PreRelease: featuregate.Alpha},
-	HVPAForShootedSeed:        {Default: false, PreRelease: featuregate.Alpha},
-	VPAForETCD:                {Default: true, PreRelease: featuregate.Beta},
-	DefaultSeccompProfile:     {Default: false, PreRelease: featuregate.Alpha},
-	IPv6SingleStack:           {Default: false, PreRelease: featuregate.Alpha},
-	ShootManagedIssuer:        {Default: false, PreRelease: featuregate.Alpha},
-	ShootForceDeletion:        {Default: true, PreRelease: featuregate.Beta},
-	UseNamespacedCloudProfile: {Default: false, PreRelease: featuregate.Alpha},
-	VPAAndHPAForAPIServer:     {Default: true, PreRelease: featuregate.Beta},
-	ShootCredentialsBinding:   {Default: false, PreRelease: featuregate.Alpha},
-	NewWorkerPoolHash:         {Default: false, PreRelease: featuregate.Alpha},
+	HVPA:                         {Default: false, PreRelease: featuregate.Alpha},
+	HVPAForShootedSeed:           {Default: false, PreRelease: featuregate.Alpha},
+	VPAForETCD:                   {Default: true, PreRelease: featuregate.Beta},
+	DefaultSeccompProfile:        {Default: false, PreRelease: featuregate.Alpha},
+	IPv6SingleStack:              {Default: false, PreRelease: featuregate.Alpha},
+	ShootManagedIssuer:           {Default: false, PreRelease: featuregate.Alpha},
+	ShootForceDeletion:           {Default: true, PreRelease: featuregate.Beta},
+	UseNamespacedCloudProfile:    {Default: false, PreRelease: featuregate.Alpha},
+	VPAAndHPAForAPIServer:        {Default: true, PreRelease: featuregate.Beta},
+	ShootCredentialsBinding:      {Default: false, PreRelease: featuregate.Alpha},
+	NewWorkerPoolHash:            {Default: false, PreRelease: featuregate.Alpha},
+	ServerSideApplyComponents:    {Default: false, PreRelease: featuregate.Alpha},
+	RuntimeSecurity:              {Default: false, PreRelease: featuregate.Alpha},
+	ShootStateEncryption:         {Default: false, PreRelease: featuregate.Alpha},
+	ShootOperationAuthorization:  {Default: false, PreRelease: featuregate.Alpha},
+	ShootImport:                  {Default: false, PreRelease: featuregate.Alpha},
+	ShootComponentInventory:      {Default: false, PreRelease: featuregate.Alpha},
+	ExistingHostWorkerPools:      {Default: false, PreRelease: featuregate.Alpha},
+	PerTargetClientRateLimiting:  {Default: false, PreRelease: featuregate.Alpha},
+	InPlaceNodeUpdates:           {Default: false, PreRelease: featuregate.Alpha},
+	ShootNetworkingCIDRExpansion: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
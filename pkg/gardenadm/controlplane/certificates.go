@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// CertificatesSecretName is the name of the secret in the kube-system namespace containing the encrypted
+	// certificates and keys shared by all control plane nodes.
+	CertificatesSecretName = "gardenadm-control-plane-certificates"
+	// DataKeyCertificates is the data key of the secret containing the encrypted certificates and keys.
+	DataKeyCertificates = "certificates"
+
+	certificateKeyLength = 32
+)
+
+// SharedCertificateFiles are the files (relative to the PKI directory) which are shared by all control plane nodes.
+// Node-specific certificates (e.g., the serving and peer certificates of etcd) are generated by each node itself.
+var SharedCertificateFiles = []string{
+	"ca.crt",
+	"ca.key",
+	"sa.key",
+	"sa.pub",
+	"front-proxy-ca.crt",
+	"front-proxy-ca.key",
+	"etcd/ca.crt",
+	"etcd/ca.key",
+}
+
+// GenerateCertificateKey generates a new random key which can be used for uploading and downloading the shared
+// certificates. The key is hex-encoded.
+func GenerateCertificateKey() (string, error) {
+	key := make([]byte, certificateKeyLength)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", fmt.Errorf("failed generating certificate key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// UploadCertificates reads the shared certificates and keys from the given PKI directory, encrypts them with the given
+// certificate key and stores them in a secret in the kube-system namespace so that additional control plane nodes can
+// download them when joining the cluster.
+func UploadCertificates(ctx context.Context, fs afero.Afero, c client.Client, pkiDir, certificateKey string) error {
+	files := make(map[string][]byte, len(SharedCertificateFiles))
+	for _, name := range SharedCertificateFiles {
+		data, err := fs.ReadFile(filepath.Join(pkiDir, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("failed reading %q: %w", name, err)
+		}
+		files[name] = data
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("no shared certificates found in %q", pkiDir)
+	}
+
+	plaintext, err := json.Marshal(files)
+	if err != nil {
+		return fmt.Errorf("failed marshalling certificates: %w", err)
+	}
+
+	ciphertext, err := encrypt(certificateKey, plaintext)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: CertificatesSecretName, Namespace: metav1.NamespaceSystem}}
+	_, err = controllerutil.CreateOrUpdate(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{DataKeyCertificates: ciphertext}
+		return nil
+	})
+	return err
+}
+
+// DownloadCertificates downloads the shared certificates and keys uploaded by UploadCertificates, decrypts them with
+// the given certificate key and writes them to the given PKI directory. Existing files are not overwritten.
+func DownloadCertificates(ctx context.Context, fs afero.Afero, c client.Client, pkiDir, certificateKey string) error {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: CertificatesSecretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("secret %s/%s not found, run 'gardenadm upload-certs' on an existing control plane node first", metav1.NamespaceSystem, CertificatesSecretName)
+		}
+		return fmt.Errorf("failed reading secret with certificates: %w", err)
+	}
+
+	plaintext, err := decrypt(certificateKey, secret.Data[DataKeyCertificates])
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{}
+	if err := json.Unmarshal(plaintext, &files); err != nil {
+		return fmt.Errorf("failed unmarshalling certificates: %w", err)
+	}
+
+	for name, data := range files {
+		path := filepath.Join(pkiDir, filepath.Clean("/"+name))
+		if exists, err := fs.Exists(path); err != nil {
+			return fmt.Errorf("failed checking whether %q exists: %w", path, err)
+		} else if exists {
+			continue
+		}
+
+		if err := writeFile(fs, path, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newAEAD(certificateKey string) (cipher.AEAD, error) {
+	key, err := hex.DecodeString(certificateKey)
+	if err != nil {
+		return nil, fmt.Errorf("certificate key is not hex-encoded: %w", err)
+	}
+	if len(key) != certificateKeyLength {
+		return nil, fmt.Errorf("certificate key must be %d bytes long but is %d bytes long", certificateKeyLength, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encrypt(certificateKey string, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(certificateKey string, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted certificates are malformed")
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting certificates, is the certificate key correct? %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/controlplane"
+)
+
+var _ = Describe("Certificates", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+
+		sourceFS afero.Afero
+		targetFS afero.Afero
+
+		certificateKey string
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().Build()
+		sourceFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		targetFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		var err error
+		certificateKey, err = GenerateCertificateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sourceFS.WriteFile("/pki/ca.crt", []byte("ca-cert"), 0600)).To(Succeed())
+		Expect(sourceFS.WriteFile("/pki/ca.key", []byte("ca-key"), 0600)).To(Succeed())
+		Expect(sourceFS.WriteFile("/pki/etcd/ca.crt", []byte("etcd-ca-cert"), 0600)).To(Succeed())
+		Expect(sourceFS.WriteFile("/pki/apiserver.crt", []byte("node-specific"), 0600)).To(Succeed())
+	})
+
+	It("should upload and download the shared certificates", func() {
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/pki", certificateKey)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: CertificatesSecretName, Namespace: "kube-system"}, secret)).To(Succeed())
+		Expect(string(secret.Data[DataKeyCertificates])).NotTo(ContainSubstring("ca-key"))
+
+		Expect(DownloadCertificates(ctx, targetFS, fakeClient, "/etc/kubernetes/pki", certificateKey)).To(Succeed())
+
+		Expect(targetFS.ReadFile("/etc/kubernetes/pki/ca.crt")).To(Equal([]byte("ca-cert")))
+		Expect(targetFS.ReadFile("/etc/kubernetes/pki/ca.key")).To(Equal([]byte("ca-key")))
+		Expect(targetFS.ReadFile("/etc/kubernetes/pki/etcd/ca.crt")).To(Equal([]byte("etcd-ca-cert")))
+		Expect(targetFS.Exists("/etc/kubernetes/pki/apiserver.crt")).To(BeFalse())
+	})
+
+	It("should not overwrite existing files", func() {
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/pki", certificateKey)).To(Succeed())
+		Expect(targetFS.WriteFile("/pki/ca.crt", []byte("existing"), 0600)).To(Succeed())
+
+		Expect(DownloadCertificates(ctx, targetFS, fakeClient, "/pki", certificateKey)).To(Succeed())
+
+		Expect(targetFS.ReadFile("/pki/ca.crt")).To(Equal([]byte("existing")))
+		Expect(targetFS.ReadFile("/pki/ca.key")).To(Equal([]byte("ca-key")))
+	})
+
+	It("should fail downloading the certificates with a wrong key", func() {
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/pki", certificateKey)).To(Succeed())
+
+		otherKey, err := GenerateCertificateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(DownloadCertificates(ctx, targetFS, fakeClient, "/pki", otherKey)).To(MatchError(ContainSubstring("failed decrypting certificates")))
+	})
+
+	It("should fail if the certificate key is invalid", func() {
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/pki", "not-hex")).To(MatchError(ContainSubstring("not hex-encoded")))
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/pki", "abcd")).To(MatchError(ContainSubstring("must be 32 bytes long")))
+	})
+
+	It("should fail if no shared certificates exist", func() {
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/other", certificateKey)).To(MatchError(ContainSubstring("no shared certificates found")))
+	})
+
+	It("should fail if the certificates were not uploaded", func() {
+		Expect(DownloadCertificates(ctx, targetFS, fakeClient, "/pki", certificateKey)).To(MatchError(ContainSubstring("run 'gardenadm upload-certs'")))
+	})
+})
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// EtcdMembers is the subset of the etcd cluster API which is required for adding a new member to an existing etcd
+// cluster. It is implemented by *clientv3.Client.
+type EtcdMembers interface {
+	// MemberList lists the current cluster membership.
+	MemberList(ctx context.Context) (*clientv3.MemberListResponse, error)
+	// MemberAddAsLearner adds a new learner member into the cluster.
+	MemberAddAsLearner(ctx context.Context, peerAddrs []string) (*clientv3.MemberAddResponse, error)
+	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
+	MemberPromote(ctx context.Context, id uint64) (*clientv3.MemberPromoteResponse, error)
+	// MemberRemove removes an existing member from the cluster.
+	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
+}
+
+// addEtcdLearner adds a new learner member with the given name and peer URL to the etcd cluster. If a member with the
+// given peer URL already exists (e.g., because a previous join attempt failed after adding the member), it is reused.
+// It returns the ID of the member and the value of the `--initial-cluster` flag for the new member.
+func addEtcdLearner(ctx context.Context, log logr.Logger, members EtcdMembers, name, peerURL string) (uint64, string, error) {
+	list, err := members.MemberList(ctx)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed listing etcd members: %w", err)
+	}
+
+	var (
+		memberID      uint64
+		memberFound   bool
+		initialMember = map[string]string{name: peerURL}
+	)
+
+	for _, member := range list.Members {
+		if slices.Contains(member.PeerURLs, peerURL) {
+			log.Info("Etcd member with peer URL already exists, reusing it", "peerURL", peerURL, "memberID", fmt.Sprintf("%x", member.ID))
+			memberID, memberFound = member.ID, true
+			continue
+		}
+		// Members which have been added but not started yet do not have a name. They cannot be part of the initial
+		// cluster since etcd would refuse to start with an incomplete configuration.
+		if member.Name != "" && len(member.PeerURLs) > 0 {
+			initialMember[member.Name] = member.PeerURLs[0]
+		}
+	}
+
+	if !memberFound {
+		log.Info("Adding etcd learner member", "name", name, "peerURL", peerURL)
+		response, err := members.MemberAddAsLearner(ctx, []string{peerURL})
+		if err != nil {
+			return 0, "", fmt.Errorf("failed adding etcd learner member: %w", err)
+		}
+		memberID = response.Member.ID
+	}
+
+	initialCluster := make([]string, 0, len(initialMember))
+	for memberName, memberPeerURL := range initialMember {
+		initialCluster = append(initialCluster, memberName+"="+memberPeerURL)
+	}
+	sort.Strings(initialCluster)
+
+	return memberID, strings.Join(initialCluster, ","), nil
+}
+
+// promoteEtcdLearner promotes the learner member with the given ID to a voting member. etcd only allows promoting
+// learners which are in sync with the leader, hence this function retries until the given timeout is reached.
+func promoteEtcdLearner(ctx context.Context, log logr.Logger, members EtcdMembers, id uint64, interval, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		if _, err := members.MemberPromote(ctx, id); err != nil {
+			switch rpctypes.ErrorDesc(err) {
+			case rpctypes.ErrorDesc(rpctypes.ErrGRPCMemberNotLearner):
+				return retry.Ok()
+			case rpctypes.ErrorDesc(rpctypes.ErrGRPCLearnerNotReady):
+				log.Info("Etcd learner member is not in sync with the leader yet", "memberID", fmt.Sprintf("%x", id))
+				return retry.MinorError(err)
+			default:
+				return retry.MinorError(fmt.Errorf("failed promoting etcd learner member: %w", err))
+			}
+		}
+		return retry.Ok()
+	})
+}
+
+// removeEtcdMember removes the member with the given ID from the etcd cluster. It is used for rolling back a failed
+// join so that the quorum of the existing cluster is not affected by a member which will never start.
+func removeEtcdMember(ctx context.Context, log logr.Logger, members EtcdMembers, id uint64) error {
+	log.Info("Removing etcd member", "memberID", fmt.Sprintf("%x", id))
+	if _, err := members.MemberRemove(ctx, id); err != nil && rpctypes.ErrorDesc(err) != rpctypes.ErrorDesc(rpctypes.ErrGRPCMemberNotFound) {
+		return fmt.Errorf("failed removing etcd member: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// LabelNodeRoleControlPlane is the label of nodes which run control plane components.
+const LabelNodeRoleControlPlane = "node-role.kubernetes.io/control-plane"
+
+// NewEtcdMembersFunc is a function which creates a client for the members API of the etcd cluster with the given
+// endpoints. The returned closer is called once the client is not needed anymore.
+type NewEtcdMembersFunc func(endpoints []string, tlsConfig *tls.Config) (EtcdMembers, io.Closer, error)
+
+// JoinOptions are the options for joining a node as an additional control plane node.
+type JoinOptions struct {
+	// NodeName is the name of the joining node.
+	NodeName string
+	// NodeAddress is the address of the joining node which is advertised to the other control plane nodes.
+	NodeAddress string
+	// ControlPlaneNode is the name of an existing control plane node whose static pods are used as template. If empty,
+	// any existing control plane node is used.
+	ControlPlaneNode string
+	// CertificateKey is the key for decrypting the certificates uploaded with UploadCertificates.
+	CertificateKey string
+	// PKIDir is the directory containing the certificates and keys shared by all control plane nodes.
+	PKIDir string
+	// StaticPodPath is the directory from which the kubelet reads static pod manifests.
+	StaticPodPath string
+	// PollInterval is the interval for checking the health of the etcd member and the control plane components.
+	PollInterval time.Duration
+	// Timeout is the maximum duration for each health gate.
+	Timeout time.Duration
+}
+
+// JoinControlPlane joins the node as an additional control plane node to the cluster. The node must already be
+// registered with the cluster (i.e., it runs a kubelet configured with the given static pod path). The join consists
+// of the following steps, each of them is gated on the health of the previous one:
+//  1. The certificates and keys shared by all control plane nodes are downloaded and node-specific certificates are
+//     generated.
+//  2. The node is added as learner member to the etcd cluster and the etcd static pod is started. Once the learner is
+//     in sync with the leader, it is promoted to a voting member. If this fails or the etcd pod does not become ready
+//     after the promotion, the member is removed again so that the quorum of the cluster is not affected.
+//  3. The static pods of the remaining control plane components are started and the node is labeled as control plane
+//     node once all of them are ready.
+func JoinControlPlane(ctx context.Context, log logr.Logger, fs afero.Afero, c client.Client, newEtcdMembers NewEtcdMembersFunc, opts JoinOptions) error {
+	target := node{name: opts.NodeName, address: opts.NodeAddress}
+
+	source, err := sourceControlPlaneNode(ctx, c, opts.ControlPlaneNode, target.name)
+	if err != nil {
+		return err
+	}
+	log = log.WithValues("sourceNode", source.name)
+
+	log.Info("Fetching static pods of existing control plane node")
+	mirrorPods, err := fetchStaticPods(ctx, c, source.name)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Downloading shared control plane certificates", "pkiDir", opts.PKIDir)
+	if err := DownloadCertificates(ctx, fs, c, opts.PKIDir, opts.CertificateKey); err != nil {
+		return err
+	}
+
+	apiServerURL := "https://" + target.address + ":6443"
+	for _, mirrorPod := range mirrorPods {
+		if container := containerByName(mirrorPod, "kube-apiserver"); container != nil {
+			if port, ok := flagValue(container, "secure-port"); ok {
+				apiServerURL = "https://" + target.address + ":" + port
+			}
+		}
+	}
+
+	for _, mirrorPod := range mirrorPods {
+		staticPod := renderStaticPod(mirrorPod, source, target)
+		log := log.WithValues("staticPod", staticPod.Name)
+
+		log.Info("Generating node-specific certificates")
+		if err := ensureNodeCertificates(fs, opts.PKIDir, staticPod, target); err != nil {
+			return err
+		}
+		for i := range staticPod.Spec.Containers {
+			if err := ensureComponentKubeconfig(fs, opts.PKIDir, &staticPod.Spec.Containers[i], apiServerURL); err != nil {
+				return err
+			}
+		}
+
+		if container := containerByName(staticPod, ContainerNameEtcd); container != nil {
+			if err := joinEtcd(ctx, log, fs, c, newEtcdMembers, opts, mirrorPod, staticPod, container, target); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := writeStaticPod(fs, opts.StaticPodPath, staticPod); err != nil {
+			return err
+		}
+		if err := waitUntilMirrorPodReady(ctx, log, c, staticPod, target, opts); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Labeling node as control plane node")
+	nodeObj := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: target.name}}
+	patch := client.MergeFrom(nodeObj.DeepCopy())
+	metav1.SetMetaDataLabel(&nodeObj.ObjectMeta, LabelNodeRoleControlPlane, "")
+	if err := c.Patch(ctx, nodeObj, patch); err != nil {
+		return fmt.Errorf("failed labeling node %q as control plane node: %w", target.name, err)
+	}
+
+	log.Info("Node joined as control plane node successfully")
+	return nil
+}
+
+func joinEtcd(
+	ctx context.Context,
+	log logr.Logger,
+	fs afero.Afero,
+	c client.Client,
+	newEtcdMembers NewEtcdMembersFunc,
+	opts JoinOptions,
+	mirrorPod, staticPod *corev1.Pod,
+	container *corev1.Container,
+	target node,
+) error {
+	clientURLs, ok := flagValue(containerByName(mirrorPod, ContainerNameEtcd), "advertise-client-urls")
+	if !ok {
+		return fmt.Errorf("etcd of existing control plane node does not have flag --advertise-client-urls")
+	}
+	peerURL, ok := flagValue(container, "initial-advertise-peer-urls")
+	if !ok {
+		return fmt.Errorf("etcd of existing control plane node does not have flag --initial-advertise-peer-urls")
+	}
+	peerURL = strings.Split(peerURL, ",")[0]
+
+	tlsConfig, err := etcdClientTLSConfig(fs, opts.PKIDir)
+	if err != nil {
+		return err
+	}
+
+	members, closer, err := newEtcdMembers(strings.Split(clientURLs, ","), tlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed creating etcd client: %w", err)
+	}
+	defer func() {
+		if err := closer.Close(); err != nil {
+			log.Error(err, "Failed closing etcd client")
+		}
+	}()
+
+	memberID, initialCluster, err := addEtcdLearner(ctx, log, members, target.name, peerURL)
+	if err != nil {
+		return err
+	}
+
+	setFlag(container, "name", target.name)
+	setFlag(container, "initial-cluster", initialCluster)
+	setFlag(container, "initial-cluster-state", "existing")
+
+	rollback := func(cause error) error {
+		if err := removeEtcdMember(ctx, log, members, memberID); err != nil {
+			return fmt.Errorf("%w, additionally rolling back the etcd member failed: %w", cause, err)
+		}
+		if err := fs.Remove(staticPodFilePath(opts.StaticPodPath, staticPod)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("%w, additionally removing the etcd static pod failed: %w", cause, err)
+		}
+		return cause
+	}
+
+	if err := writeStaticPod(fs, opts.StaticPodPath, staticPod); err != nil {
+		return rollback(err)
+	}
+
+	log.Info("Waiting until etcd learner member can be promoted")
+	if err := promoteEtcdLearner(ctx, log, members, memberID, opts.PollInterval, opts.Timeout); err != nil {
+		return rollback(fmt.Errorf("failed promoting etcd member: %w", err))
+	}
+
+	// The promoted member counts towards the quorum of the cluster. If it does not become healthy, it has to be removed
+	// again, otherwise the cluster would not tolerate the failure of another member anymore.
+	if err := waitUntilMirrorPodReady(ctx, log, c, staticPod, target, opts); err != nil {
+		return rollback(err)
+	}
+	return nil
+}
+
+func sourceControlPlaneNode(ctx context.Context, c client.Client, name, targetName string) (node, error) {
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList, client.HasLabels{LabelNodeRoleControlPlane}); err != nil {
+		return node{}, fmt.Errorf("failed listing control plane nodes: %w", err)
+	}
+
+	for _, n := range nodeList.Items {
+		if n.Name == targetName || (name != "" && n.Name != name) {
+			continue
+		}
+		for _, address := range n.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				return node{name: n.Name, address: address.Address}, nil
+			}
+		}
+	}
+
+	if name != "" {
+		return node{}, fmt.Errorf("control plane node %q not found or it has no internal IP", name)
+	}
+	return node{}, fmt.Errorf("no existing control plane node with internal IP found")
+}
+
+// etcdClientTLSConfig returns a TLS configuration with a short-lived client certificate signed by the etcd CA.
+func etcdClientTLSConfig(fs afero.Afero, pkiDir string) (*tls.Config, error) {
+	ca, err := loadCA(fs, pkiDir, caETCD)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := (&secretsutils.CertificateSecretConfig{
+		Name:       "gardenadm-etcd-client",
+		CommonName: "gardenadm",
+		CertType:   secretsutils.ClientCert,
+		SigningCA:  ca,
+		Validity:   ptr.To(time.Hour),
+	}).GenerateCertificate()
+	if err != nil {
+		return nil, fmt.Errorf("failed generating etcd client certificate: %w", err)
+	}
+
+	keyPair, err := tls.X509KeyPair(certificate.CertificatePEM, certificate.PrivateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.Certificate)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func waitUntilMirrorPodReady(ctx context.Context, log logr.Logger, c client.Client, staticPod *corev1.Pod, target node, opts JoinOptions) error {
+	log.Info("Waiting until static pod is ready")
+
+	key := client.ObjectKey{Name: mirrorPodName(staticPod, target.name), Namespace: staticPod.Namespace}
+	return retry.UntilTimeout(ctx, opts.PollInterval, opts.Timeout, func(ctx context.Context) (bool, error) {
+		pod := &corev1.Pod{}
+		if err := c.Get(ctx, key, pod); err != nil {
+			return retry.MinorError(fmt.Errorf("failed getting mirror pod %s: %w", key, err))
+		}
+		if err := health.CheckPod(pod); err != nil {
+			return retry.MinorError(err)
+		}
+		if !health.IsPodReady(pod) {
+			return retry.MinorError(fmt.Errorf("mirror pod %s is not ready yet", key))
+		}
+		return retry.Ok()
+	})
+}
+
+func writeStaticPod(fs afero.Afero, staticPodPath string, pod *corev1.Pod) error {
+	manifest, err := yaml.Marshal(pod)
+	if err != nil {
+		return fmt.Errorf("failed marshalling static pod %s: %w", pod.Name, err)
+	}
+	return writeFile(fs, staticPodFilePath(staticPodPath, pod), manifest)
+}
+
+func staticPodFilePath(staticPodPath string, pod *corev1.Pod) string {
+	return filepath.Join(staticPodPath, pod.Name+".yaml")
+}
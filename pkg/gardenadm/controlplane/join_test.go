@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/controlplane"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("JoinControlPlane", func() {
+	var (
+		ctx        = context.TODO()
+		log        = logr.Discard()
+		fakeClient client.Client
+		fs         afero.Afero
+		etcd       *fakeEtcd
+
+		certificateKey string
+		opts           JoinOptions
+
+		newEtcdMembers NewEtcdMembersFunc
+		etcdEndpoints  []string
+	)
+
+	BeforeEach(func() {
+		sourceFS := afero.Afero{Fs: afero.NewMemMapFs()}
+		for _, ca := range []string{"ca", "etcd/ca", "front-proxy-ca"} {
+			certificate, err := (&secretsutils.CertificateSecretConfig{Name: ca, CommonName: ca, CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sourceFS.WriteFile("/etc/kubernetes/pki/"+ca+".crt", certificate.CertificatePEM, 0600)).To(Succeed())
+			Expect(sourceFS.WriteFile("/etc/kubernetes/pki/"+ca+".key", certificate.PrivateKeyPEM, 0600)).To(Succeed())
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().WithObjects(
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp-0", Labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}},
+				Status:     corev1.NodeStatus{Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.1"}}},
+			},
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status:     corev1.NodeStatus{Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.10"}}},
+			},
+			mirrorPod("etcd-cp-0", "cp-0", "etcd",
+				"--name=cp-0",
+				"--advertise-client-urls=https://10.0.0.1:2379",
+				"--listen-client-urls=https://127.0.0.1:2379,https://10.0.0.1:2379",
+				"--initial-advertise-peer-urls=https://10.0.0.1:2380",
+				"--listen-peer-urls=https://10.0.0.1:2380",
+				"--initial-cluster=cp-0=https://10.0.0.1:2380",
+				"--cert-file=/etc/kubernetes/pki/etcd/server.crt",
+				"--key-file=/etc/kubernetes/pki/etcd/server.key",
+				"--peer-cert-file=/etc/kubernetes/pki/etcd/peer.crt",
+				"--peer-key-file=/etc/kubernetes/pki/etcd/peer.key",
+			),
+			mirrorPod("kube-apiserver-cp-0", "cp-0", "kube-apiserver",
+				"--advertise-address=10.0.0.1",
+				"--secure-port=6443",
+				"--etcd-servers=https://127.0.0.1:2379",
+				"--service-cluster-ip-range=10.0.0.100/32",
+				"--tls-cert-file=/etc/kubernetes/pki/apiserver.crt",
+				"--tls-private-key-file=/etc/kubernetes/pki/apiserver.key",
+				"--etcd-certfile=/etc/kubernetes/pki/apiserver-etcd-client.crt",
+				"--etcd-keyfile=/etc/kubernetes/pki/apiserver-etcd-client.key",
+			),
+			mirrorPod("kube-scheduler-cp-0", "cp-0", "kube-scheduler",
+				"--kubeconfig=/etc/kubernetes/scheduler.conf",
+			),
+			// The mirror pods of the joining node are created by the kubelet once the static pods are written. They are
+			// created upfront to simulate the kubelet.
+			readyPod(mirrorPod("etcd-node-1", "node-1", "etcd")),
+			readyPod(mirrorPod("kube-apiserver-node-1", "node-1", "kube-apiserver")),
+			readyPod(mirrorPod("kube-scheduler-node-1", "node-1", "kube-scheduler")),
+		).Build()
+
+		var err error
+		certificateKey, err = GenerateCertificateKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(UploadCertificates(ctx, sourceFS, fakeClient, "/etc/kubernetes/pki", certificateKey)).To(Succeed())
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		etcd = &fakeEtcd{members: []*etcdserverpb.Member{{ID: 1, Name: "cp-0", PeerURLs: []string{"https://10.0.0.1:2380"}}}}
+
+		etcdEndpoints = nil
+		newEtcdMembers = func(endpoints []string, tlsConfig *tls.Config) (EtcdMembers, io.Closer, error) {
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+			Expect(tlsConfig.RootCAs).NotTo(BeNil())
+			etcdEndpoints = endpoints
+			return etcd, etcd, nil
+		}
+
+		opts = JoinOptions{
+			NodeName:       "node-1",
+			NodeAddress:    "10.0.0.10",
+			CertificateKey: certificateKey,
+			PKIDir:         "/etc/kubernetes/pki",
+			StaticPodPath:  "/etc/kubernetes/manifests",
+			PollInterval:   time.Millisecond,
+			Timeout:        100 * time.Millisecond,
+		}
+	})
+
+	It("should join the node as control plane node", func() {
+		etcd.promoteErrors = []error{rpctypes.ErrGRPCLearnerNotReady, rpctypes.ErrGRPCLearnerNotReady}
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(Succeed())
+
+		By("Adding and promoting the etcd member")
+		Expect(etcdEndpoints).To(Equal([]string{"https://10.0.0.1:2379"}))
+		Expect(etcd.added).To(Equal([][]string{{"https://10.0.0.10:2380"}}))
+		Expect(etcd.promoted).To(Equal([]uint64{42, 42, 42}))
+		Expect(etcd.removed).To(BeEmpty())
+		Expect(etcd.closed).To(BeTrue())
+
+		By("Writing the static pods")
+		etcdPod := readStaticPod(fs, "/etc/kubernetes/manifests/etcd.yaml")
+		Expect(etcdPod.Spec.NodeName).To(BeEmpty())
+		Expect(etcdPod.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(etcdPod.Spec.Containers[0].Command).To(ConsistOf(
+			"etcd",
+			"--name=node-1",
+			"--advertise-client-urls=https://10.0.0.10:2379",
+			"--listen-client-urls=https://127.0.0.1:2379,https://10.0.0.10:2379",
+			"--initial-advertise-peer-urls=https://10.0.0.10:2380",
+			"--listen-peer-urls=https://10.0.0.10:2380",
+			"--initial-cluster=cp-0=https://10.0.0.1:2380,node-1=https://10.0.0.10:2380",
+			"--initial-cluster-state=existing",
+			"--cert-file=/etc/kubernetes/pki/etcd/server.crt",
+			"--key-file=/etc/kubernetes/pki/etcd/server.key",
+			"--peer-cert-file=/etc/kubernetes/pki/etcd/peer.crt",
+			"--peer-key-file=/etc/kubernetes/pki/etcd/peer.key",
+		))
+
+		apiServerPod := readStaticPod(fs, "/etc/kubernetes/manifests/kube-apiserver.yaml")
+		Expect(apiServerPod.Spec.Containers[0].Command).To(ContainElements(
+			"--advertise-address=10.0.0.10",
+			"--service-cluster-ip-range=10.0.0.100/32",
+		))
+		Expect(fs.Exists("/etc/kubernetes/manifests/kube-scheduler.yaml")).To(BeTrue())
+
+		By("Generating the node-specific certificates")
+		certificate := readCertificate(fs, "/etc/kubernetes/pki/etcd/peer")
+		Expect(certificate.Certificate.IPAddresses).To(ContainElement(net.ParseIP("10.0.0.10").To4()))
+		Expect(certificate.Certificate.Issuer.CommonName).To(Equal("etcd/ca"))
+		certificate = readCertificate(fs, "/etc/kubernetes/pki/apiserver")
+		Expect(certificate.Certificate.DNSNames).To(ContainElement("kubernetes.default.svc"))
+		Expect(certificate.Certificate.Issuer.CommonName).To(Equal("ca"))
+		certificate = readCertificate(fs, "/etc/kubernetes/pki/apiserver-etcd-client")
+		Expect(certificate.Certificate.Subject.CommonName).To(Equal("kube-apiserver-etcd-client"))
+
+		kubeconfig, err := fs.ReadFile("/etc/kubernetes/scheduler.conf")
+		Expect(err).NotTo(HaveOccurred())
+		config, err := clientcmd.Load(kubeconfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Clusters["kube-scheduler"].Server).To(Equal("https://10.0.0.10:6443"))
+
+		By("Labeling the node")
+		node := &corev1.Node{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+		Expect(node.Labels).To(HaveKey("node-role.kubernetes.io/control-plane"))
+	})
+
+	It("should reuse an existing etcd member of the node", func() {
+		etcd.members = append(etcd.members, &etcdserverpb.Member{ID: 7, PeerURLs: []string{"https://10.0.0.10:2380"}, IsLearner: true})
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(Succeed())
+
+		Expect(etcd.added).To(BeEmpty())
+		Expect(etcd.promoted).To(Equal([]uint64{7}))
+	})
+
+	It("should consider an already promoted etcd member as success", func() {
+		etcd.promoteErrors = []error{rpctypes.ErrGRPCMemberNotLearner}
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(Succeed())
+	})
+
+	It("should remove the etcd member again if it cannot be promoted", func() {
+		etcd.promoteErrors = []error{rpctypes.ErrGRPCLearnerNotReady}
+		etcd.promoteForever = true
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(MatchError(ContainSubstring("failed promoting etcd member")))
+
+		Expect(etcd.removed).To(Equal([]uint64{42}))
+		Expect(fs.Exists("/etc/kubernetes/manifests/etcd.yaml")).To(BeFalse())
+		Expect(fs.Exists("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeFalse())
+
+		node := &corev1.Node{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "node-1"}, node)).To(Succeed())
+		Expect(node.Labels).NotTo(HaveKey("node-role.kubernetes.io/control-plane"))
+	})
+
+	It("should remove the etcd member again if etcd does not become ready after the promotion", func() {
+		Expect(fakeClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "etcd-node-1", Namespace: "kube-system"}})).To(Succeed())
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(MatchError(ContainSubstring("etcd-node-1")))
+
+		Expect(etcd.promoted).To(Equal([]uint64{42}))
+		Expect(etcd.removed).To(Equal([]uint64{42}))
+		Expect(etcd.members).To(HaveLen(1))
+		Expect(fs.Exists("/etc/kubernetes/manifests/etcd.yaml")).To(BeFalse())
+		Expect(fs.Exists("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeFalse())
+	})
+
+	It("should fail if a control plane component does not become ready", func() {
+		Expect(fakeClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-node-1", Namespace: "kube-system"}})).To(Succeed())
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(MatchError(ContainSubstring("kube-apiserver-node-1")))
+
+		Expect(fs.Exists("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeTrue())
+		Expect(fs.Exists("/etc/kubernetes/manifests/kube-scheduler.yaml")).To(BeFalse())
+	})
+
+	It("should fail if the given control plane node does not exist", func() {
+		opts.ControlPlaneNode = "cp-1"
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(MatchError(ContainSubstring(`control plane node "cp-1" not found`)))
+	})
+
+	It("should fail if the certificate key is wrong", func() {
+		var err error
+		opts.CertificateKey, err = GenerateCertificateKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(JoinControlPlane(ctx, log, fs, fakeClient, newEtcdMembers, opts)).To(MatchError(ContainSubstring("failed decrypting certificates")))
+		Expect(etcd.added).To(BeEmpty())
+	})
+})
+
+func mirrorPod(name, nodeName, containerName string, flags ...string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kube-system",
+			Labels:    map[string]string{"component": containerName},
+			Annotations: map[string]string{
+				"kubernetes.io/config.mirror": "hash",
+				"kubernetes.io/config.source": "file",
+				"foo":                         "bar",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name:    containerName,
+				Command: append([]string{containerName}, flags...),
+			}},
+		},
+	}
+}
+
+func readyPod(pod *corev1.Pod) *corev1.Pod {
+	pod.Status = corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+	return pod
+}
+
+func readStaticPod(fs afero.Afero, path string) *corev1.Pod {
+	data, err := fs.ReadFile(path)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	pod := &corev1.Pod{}
+	ExpectWithOffset(1, yaml.Unmarshal(data, pod)).To(Succeed())
+	ExpectWithOffset(1, pod.Kind).To(Equal("Pod"))
+	return pod
+}
+
+func readCertificate(fs afero.Afero, path string) *secretsutils.Certificate {
+	certificatePEM, err := fs.ReadFile(path + ".crt")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	privateKeyPEM, err := fs.ReadFile(path + ".key")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	certificate, err := secretsutils.LoadCertificate("", privateKeyPEM, certificatePEM)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return certificate
+}
+
+type fakeEtcd struct {
+	members []*etcdserverpb.Member
+
+	promoteErrors  []error
+	promoteForever bool
+
+	added    [][]string
+	promoted []uint64
+	removed  []uint64
+	closed   bool
+}
+
+func (f *fakeEtcd) MemberList(_ context.Context) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeEtcd) MemberAddAsLearner(_ context.Context, peerAddrs []string) (*clientv3.MemberAddResponse, error) {
+	f.added = append(f.added, peerAddrs)
+	member := &etcdserverpb.Member{ID: 42, PeerURLs: peerAddrs, IsLearner: true}
+	f.members = append(f.members, member)
+	return &clientv3.MemberAddResponse{Member: member, Members: f.members}, nil
+}
+
+func (f *fakeEtcd) MemberPromote(_ context.Context, id uint64) (*clientv3.MemberPromoteResponse, error) {
+	f.promoted = append(f.promoted, id)
+	if len(f.promoteErrors) > 0 {
+		err := f.promoteErrors[0]
+		if !f.promoteForever {
+			f.promoteErrors = f.promoteErrors[1:]
+		}
+		return nil, err
+	}
+	return &clientv3.MemberPromoteResponse{}, nil
+}
+
+func (f *fakeEtcd) MemberRemove(_ context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
+	f.removed = append(f.removed, id)
+	for i, member := range f.members {
+		if member.ID == id {
+			f.members = append(f.members[:i], f.members[i+1:]...)
+			return &clientv3.MemberRemoveResponse{}, nil
+		}
+	}
+	return nil, fmt.Errorf("member %d not found", id)
+}
+
+func (f *fakeEtcd) Close() error {
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	caETCD       = "etcd/ca"
+	caCluster    = "ca"
+	caFrontProxy = "front-proxy-ca"
+)
+
+// nodeCertificate describes a node-specific certificate which is referenced by a pair of flags of a control plane
+// component.
+type nodeCertificate struct {
+	certificateFlag string
+	privateKeyFlag  string
+	ca              string
+	config          func(target node) *secretsutils.CertificateSecretConfig
+}
+
+var nodeCertificates = []nodeCertificate{
+	{
+		// serving certificate of etcd
+		certificateFlag: "cert-file",
+		privateKeyFlag:  "key-file",
+		ca:              caETCD,
+		config: func(target node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName:  target.name,
+				DNSNames:    []string{target.name, "localhost"},
+				IPAddresses: ipAddresses(target.address, "127.0.0.1"),
+				CertType:    secretsutils.ServerClientCert,
+			}
+		},
+	},
+	{
+		// peer certificate of etcd
+		certificateFlag: "peer-cert-file",
+		privateKeyFlag:  "peer-key-file",
+		ca:              caETCD,
+		config: func(target node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName:  target.name,
+				DNSNames:    []string{target.name, "localhost"},
+				IPAddresses: ipAddresses(target.address, "127.0.0.1"),
+				CertType:    secretsutils.ServerClientCert,
+			}
+		},
+	},
+	{
+		// serving certificate of kube-apiserver
+		certificateFlag: "tls-cert-file",
+		privateKeyFlag:  "tls-private-key-file",
+		ca:              caCluster,
+		config: func(target node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName:  "kube-apiserver",
+				DNSNames:    append([]string{target.name, "localhost"}, kubernetesutils.DNSNamesForService("kubernetes", "default")...),
+				IPAddresses: ipAddresses(target.address, "127.0.0.1"),
+				CertType:    secretsutils.ServerCert,
+			}
+		},
+	},
+	{
+		// client certificate of kube-apiserver for etcd
+		certificateFlag: "etcd-certfile",
+		privateKeyFlag:  "etcd-keyfile",
+		ca:              caETCD,
+		config: func(_ node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName: "kube-apiserver-etcd-client",
+				CertType:   secretsutils.ClientCert,
+			}
+		},
+	},
+	{
+		// client certificate of kube-apiserver for kubelets
+		certificateFlag: "kubelet-client-certificate",
+		privateKeyFlag:  "kubelet-client-key",
+		ca:              caCluster,
+		config: func(_ node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName:   "kube-apiserver-kubelet-client",
+				Organization: []string{"system:masters"},
+				CertType:     secretsutils.ClientCert,
+			}
+		},
+	},
+	{
+		// client certificate of kube-apiserver for aggregated API servers
+		certificateFlag: "proxy-client-cert-file",
+		privateKeyFlag:  "proxy-client-key-file",
+		ca:              caFrontProxy,
+		config: func(_ node) *secretsutils.CertificateSecretConfig {
+			return &secretsutils.CertificateSecretConfig{
+				CommonName: "front-proxy-client",
+				CertType:   secretsutils.ClientCert,
+			}
+		},
+	},
+}
+
+// ensureNodeCertificates generates the node-specific certificates which are referenced by the flags of the containers
+// of the given static pod. Certificates which already exist are not regenerated.
+func ensureNodeCertificates(fs afero.Afero, pkiDir string, pod *corev1.Pod, target node) error {
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+
+		for _, nodeCertificate := range nodeCertificates {
+			certificatePath, ok := flagValue(container, nodeCertificate.certificateFlag)
+			if !ok {
+				continue
+			}
+			privateKeyPath, ok := flagValue(container, nodeCertificate.privateKeyFlag)
+			if !ok {
+				continue
+			}
+
+			if exists, err := allFilesExist(fs, certificatePath, privateKeyPath); err != nil || exists {
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			ca, err := loadCA(fs, pkiDir, nodeCertificate.ca)
+			if err != nil {
+				return err
+			}
+
+			config := nodeCertificate.config(target)
+			config.Name = filepath.Base(certificatePath)
+			config.SigningCA = ca
+
+			certificate, err := config.GenerateCertificate()
+			if err != nil {
+				return fmt.Errorf("failed generating certificate for flag --%s: %w", nodeCertificate.certificateFlag, err)
+			}
+
+			if err := writeFile(fs, certificatePath, certificate.CertificatePEM); err != nil {
+				return err
+			}
+			if err := writeFile(fs, privateKeyPath, certificate.PrivateKeyPEM); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureComponentKubeconfig generates the kubeconfig referenced by the `--kubeconfig` flag of the given container (used
+// by kube-controller-manager and kube-scheduler) if it does not exist yet. The kubeconfig authenticates with a client
+// certificate for the user `system:<container-name>` against the kube-apiserver on the target node.
+func ensureComponentKubeconfig(fs afero.Afero, pkiDir string, container *corev1.Container, server string) error {
+	kubeconfigPath, ok := flagValue(container, "kubeconfig")
+	if !ok {
+		return nil
+	}
+
+	if exists, err := fs.Exists(kubeconfigPath); err != nil || exists {
+		return err
+	}
+
+	ca, err := loadCA(fs, pkiDir, caCluster)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := (&secretsutils.CertificateSecretConfig{
+		Name:       container.Name,
+		CommonName: "system:" + container.Name,
+		CertType:   secretsutils.ClientCert,
+		SigningCA:  ca,
+	}).GenerateCertificate()
+	if err != nil {
+		return fmt.Errorf("failed generating client certificate for %s: %w", container.Name, err)
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		container.Name,
+		clientcmdv1.Cluster{Server: server, CertificateAuthorityData: ca.CertificatePEM},
+		clientcmdv1.AuthInfo{ClientCertificateData: certificate.CertificatePEM, ClientKeyData: certificate.PrivateKeyPEM},
+	))
+	if err != nil {
+		return fmt.Errorf("failed encoding kubeconfig for %s: %w", container.Name, err)
+	}
+
+	return writeFile(fs, kubeconfigPath, kubeconfig)
+}
+
+func loadCA(fs afero.Afero, pkiDir, name string) (*secretsutils.Certificate, error) {
+	certificatePEM, err := fs.ReadFile(filepath.Join(pkiDir, name+".crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CA certificate %q: %w", name, err)
+	}
+	privateKeyPEM, err := fs.ReadFile(filepath.Join(pkiDir, name+".key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CA private key %q: %w", name, err)
+	}
+
+	ca, err := secretsutils.LoadCertificate(name, privateKeyPEM, certificatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading CA %q: %w", name, err)
+	}
+	return ca, nil
+}
+
+func allFilesExist(fs afero.Afero, paths ...string) (bool, error) {
+	for _, path := range paths {
+		exists, err := fs.Exists(path)
+		if err != nil {
+			return false, fmt.Errorf("failed checking whether %q exists: %w", path, err)
+		}
+		if !exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func writeFile(fs afero.Afero, path string, data []byte) error {
+	if err := fs.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed creating directory for %q: %w", path, err)
+	}
+	if err := fs.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed writing %q: %w", path, err)
+	}
+	return nil
+}
+
+func ipAddresses(addresses ...string) []net.IP {
+	var ips []net.IP
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
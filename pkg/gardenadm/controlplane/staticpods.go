@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// annotationMirrorPod is the annotation which is added by the kubelet to mirror pods of static pods.
+	annotationMirrorPod = "kubernetes.io/config.mirror"
+	// annotationConfigPrefix is the prefix of all annotations which are added by the kubelet to mirror pods.
+	annotationConfigPrefix = "kubernetes.io/config."
+
+	// ContainerNameEtcd is the name of the etcd container in the etcd static pod.
+	ContainerNameEtcd = "etcd"
+)
+
+// node contains the name and the address of a node.
+type node struct {
+	name    string
+	address string
+}
+
+// fetchStaticPods reconstructs the static pods of the given control plane node from their mirror pods. The returned
+// pods are sorted so that etcd comes first since all other control plane components depend on it.
+func fetchStaticPods(ctx context.Context, c client.Client, nodeName string) ([]*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	var pods []*corev1.Pod
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		if _, ok := pod.Annotations[annotationMirrorPod]; !ok {
+			continue
+		}
+		pods = append(pods, pod.DeepCopy())
+	}
+
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no static pods found on control plane node %q", nodeName)
+	}
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		iEtcd, jEtcd := hasContainer(pods[i], ContainerNameEtcd), hasContainer(pods[j], ContainerNameEtcd)
+		if iEtcd != jEtcd {
+			return iEtcd
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// renderStaticPod renders the static pod for the target node based on the given mirror pod of the source node. All
+// occurrences of the address of the source node in the commands, arguments, environment variables and probes of the
+// containers are replaced with the address of the target node.
+func renderStaticPod(mirrorPod *corev1.Pod, source, target node) *corev1.Pod {
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      staticPodName(mirrorPod, source.name),
+			Namespace: mirrorPod.Namespace,
+			Labels:    mirrorPod.Labels,
+		},
+		Spec: *mirrorPod.Spec.DeepCopy(),
+	}
+
+	for key, value := range mirrorPod.Annotations {
+		if strings.HasPrefix(key, annotationConfigPrefix) {
+			continue
+		}
+		metav1.SetMetaDataAnnotation(&pod.ObjectMeta, key, value)
+	}
+
+	pod.Spec.NodeName = ""
+	for i := range pod.Spec.Containers {
+		replaceAddressInContainer(&pod.Spec.Containers[i], source.address, target.address)
+	}
+	for i := range pod.Spec.InitContainers {
+		replaceAddressInContainer(&pod.Spec.InitContainers[i], source.address, target.address)
+	}
+
+	return pod
+}
+
+// staticPodName returns the name of the static pod of the given mirror pod. The kubelet appends the node name to the
+// names of mirror pods.
+func staticPodName(mirrorPod *corev1.Pod, nodeName string) string {
+	return strings.TrimSuffix(mirrorPod.Name, "-"+nodeName)
+}
+
+// mirrorPodName returns the name of the mirror pod of the given static pod on the given node.
+func mirrorPodName(staticPod *corev1.Pod, nodeName string) string {
+	return staticPod.Name + "-" + nodeName
+}
+
+func replaceAddressInContainer(container *corev1.Container, oldAddress, newAddress string) {
+	if oldAddress == "" || oldAddress == newAddress {
+		return
+	}
+
+	// Only replace complete addresses, e.g., 10.0.0.1 must not be replaced in 10.0.0.10.
+	expression := regexp.MustCompile(`(^|[^0-9A-Za-z.:-])` + regexp.QuoteMeta(oldAddress) + `($|[^0-9A-Za-z.-])`)
+	replace := func(s string) string {
+		return expression.ReplaceAllString(s, "${1}"+newAddress+"${2}")
+	}
+
+	for i := range container.Command {
+		container.Command[i] = replace(container.Command[i])
+	}
+	for i := range container.Args {
+		container.Args[i] = replace(container.Args[i])
+	}
+	for i := range container.Env {
+		container.Env[i].Value = replace(container.Env[i].Value)
+	}
+	for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+		if probe != nil && probe.HTTPGet != nil {
+			probe.HTTPGet.Host = replace(probe.HTTPGet.Host)
+		}
+	}
+}
+
+func hasContainer(pod *corev1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// flagValue returns the value of the given flag (in the form `--flag=value`) in the command or arguments of the given
+// container.
+func flagValue(container *corev1.Container, flag string) (string, bool) {
+	prefix := "--" + flag + "="
+	for _, arg := range append(append([]string{}, container.Command...), container.Args...) {
+		if strings.HasPrefix(arg, prefix) {
+			return strings.TrimPrefix(arg, prefix), true
+		}
+	}
+	return "", false
+}
+
+// setFlag sets the given flag (in the form `--flag=value`) in the command or arguments of the given container. If the
+// flag is not present yet, it is appended to the arguments (or the command if the container has no arguments).
+func setFlag(container *corev1.Container, flag, value string) {
+	var (
+		prefix  = "--" + flag + "="
+		setFlag = func(args []string) bool {
+			for i, arg := range args {
+				if strings.HasPrefix(arg, prefix) {
+					args[i] = prefix + value
+					return true
+				}
+			}
+			return false
+		}
+	)
+
+	if setFlag(container.Command) || setFlag(container.Args) {
+		return
+	}
+
+	if len(container.Args) > 0 {
+		container.Args = append(container.Args, prefix+value)
+	} else {
+		container.Command = append(container.Command, prefix+value)
+	}
+}
+
+func containerByName(pod *corev1.Pod, name string) *corev1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	return nil
+}
@@ -6,10 +6,15 @@ package helper
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
+	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -108,6 +113,40 @@ func IsMonitoringEnabled(c *config.GardenletConfiguration) bool {
 	return true
 }
 
+// GardenNamespacePodSecurityLevel returns the Pod Security Standard level enforced in the garden namespace of the seed.
+// Default is 'privileged'.
+func GardenNamespacePodSecurityLevel(c *config.GardenletConfiguration) string {
+	if c != nil && c.PodSecurity != nil && c.PodSecurity.GardenNamespaceLevel != nil {
+		return *c.PodSecurity.GardenNamespaceLevel
+	}
+	return string(podsecurityadmissionapi.LevelPrivileged)
+}
+
+// ShootNamespacesPodSecurityLevel returns the Pod Security Standard level enforced in the shoot namespaces of the seed.
+// Default is 'privileged'.
+func ShootNamespacesPodSecurityLevel(c *config.GardenletConfiguration) string {
+	if c != nil && c.PodSecurity != nil && c.PodSecurity.ShootNamespacesLevel != nil {
+		return *c.PodSecurity.ShootNamespacesLevel
+	}
+	return string(podsecurityadmissionapi.LevelPrivileged)
+}
+
+// SetPodSecurityLabels sets the Pod Security admission labels on the given namespace metadata. The given level is
+// enforced, while the configured audit level is used for the audit and warn labels. The latter are removed if no audit
+// level is configured.
+func SetPodSecurityLabels(obj *metav1.ObjectMeta, enforceLevel string, c *config.GardenletConfiguration) {
+	metav1.SetMetaDataLabel(obj, podsecurityadmissionapi.EnforceLevelLabel, enforceLevel)
+
+	if c == nil || c.PodSecurity == nil || c.PodSecurity.AuditLevel == nil {
+		delete(obj.Labels, podsecurityadmissionapi.AuditLevelLabel)
+		delete(obj.Labels, podsecurityadmissionapi.WarnLevelLabel)
+		return
+	}
+
+	metav1.SetMetaDataLabel(obj, podsecurityadmissionapi.AuditLevelLabel, *c.PodSecurity.AuditLevel)
+	metav1.SetMetaDataLabel(obj, podsecurityadmissionapi.WarnLevelLabel, *c.PodSecurity.AuditLevel)
+}
+
 // GetManagedResourceProgressingThreshold returns ManagedResourceProgressingThreshold if set otherwise it returns nil.
 func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *metav1.Duration {
 	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.ManagedResourceProgressingThreshold != nil {
@@ -115,3 +154,90 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// IsShootHealthCheckEnabled returns false if the given check is disabled in the shoot care controller configuration.
+func IsShootHealthCheckEnabled(c *config.GardenletConfiguration, check config.ShootHealthCheck) bool {
+	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.HealthChecks != nil {
+		return !slices.Contains(c.Controllers.ShootCare.HealthChecks.DisabledChecks, check)
+	}
+	return true
+}
+
+// GetNodeAgentLeaseStalenessThreshold returns NodeAgentLeaseStalenessThreshold if set otherwise it returns nil.
+func GetNodeAgentLeaseStalenessThreshold(c *config.GardenletConfiguration) *metav1.Duration {
+	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.HealthChecks != nil {
+		return c.Controllers.ShootCare.HealthChecks.NodeAgentLeaseStalenessThreshold
+	}
+	return nil
+}
+
+// GetExpiredNodeLeasesThresholdPercentage returns ExpiredNodeLeasesThresholdPercentage if set otherwise it returns 20.
+func GetExpiredNodeLeasesThresholdPercentage(c *config.GardenletConfiguration) int {
+	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.HealthChecks != nil && c.Controllers.ShootCare.HealthChecks.ExpiredNodeLeasesThresholdPercentage != nil {
+		return *c.Controllers.ShootCare.HealthChecks.ExpiredNodeLeasesThresholdPercentage
+	}
+	return 20
+}
+
+// IsArtifactCacheEnabled returns true if the seed-local artifact cache is enabled in the gardenlet configuration.
+func IsArtifactCacheEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.ArtifactCache != nil && ptr.Deref(c.ArtifactCache.Enabled, false)
+}
+
+// CareSyncPeriod returns the duration after which the health of an object with the given conditions shall be checked
+// again. If no adaptive sync period is configured, the given sync period is returned. Otherwise, objects whose
+// conditions have all been healthy for at least the stabilization period are checked with the healthy sync period, and
+// all other objects are checked with the degraded sync period. The sync periods can be overwritten per shoot purpose
+// and are jittered by the configured percentage.
+func CareSyncPeriod(syncPeriod time.Duration, c *config.AdaptiveSyncPeriod, purpose *gardencorev1beta1.ShootPurpose, conditions []gardencorev1beta1.Condition, now time.Time) time.Duration {
+	if c == nil {
+		return syncPeriod
+	}
+
+	healthySyncPeriod, degradedSyncPeriod := c.HealthySyncPeriod, c.DegradedSyncPeriod
+	if purpose != nil {
+		for _, p := range c.Purposes {
+			if string(p.Purpose) != string(*purpose) {
+				continue
+			}
+			if p.HealthySyncPeriod != nil {
+				healthySyncPeriod = p.HealthySyncPeriod
+			}
+			if p.DegradedSyncPeriod != nil {
+				degradedSyncPeriod = p.DegradedSyncPeriod
+			}
+		}
+	}
+
+	period := syncPeriod
+	if healthy(conditions, c.StabilizationPeriod, now) {
+		if healthySyncPeriod != nil {
+			period = healthySyncPeriod.Duration
+		}
+	} else if degradedSyncPeriod != nil {
+		period = degradedSyncPeriod.Duration
+	}
+
+	if jitter := ptr.Deref(c.JitterPercentage, 0); jitter > 0 {
+		period = wait.Jitter(period, float64(jitter)/100)
+	}
+
+	return period
+}
+
+func healthy(conditions []gardencorev1beta1.Condition, stabilizationPeriod *metav1.Duration, now time.Time) bool {
+	if len(conditions) == 0 {
+		return false
+	}
+
+	for _, condition := range conditions {
+		if condition.Status != gardencorev1beta1.ConditionTrue {
+			return false
+		}
+		if stabilizationPeriod != nil && now.Sub(condition.LastTransitionTime.Time) < stabilizationPeriod.Duration {
+			return false
+		}
+	}
+
+	return true
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
@@ -133,6 +134,63 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#GardenNamespacePodSecurityLevel", func() {
+		It("should return 'privileged' when nothing is set", func() {
+			Expect(GardenNamespacePodSecurityLevel(nil)).To(Equal("privileged"))
+			Expect(GardenNamespacePodSecurityLevel(&config.GardenletConfiguration{})).To(Equal("privileged"))
+		})
+
+		It("should return the configured level", func() {
+			gardenletConfig := &config.GardenletConfiguration{PodSecurity: &config.PodSecurityConfiguration{GardenNamespaceLevel: ptr.To("baseline")}}
+			Expect(GardenNamespacePodSecurityLevel(gardenletConfig)).To(Equal("baseline"))
+		})
+	})
+
+	Describe("#ShootNamespacesPodSecurityLevel", func() {
+		It("should return 'privileged' when nothing is set", func() {
+			Expect(ShootNamespacesPodSecurityLevel(nil)).To(Equal("privileged"))
+			Expect(ShootNamespacesPodSecurityLevel(&config.GardenletConfiguration{})).To(Equal("privileged"))
+		})
+
+		It("should return the configured level", func() {
+			gardenletConfig := &config.GardenletConfiguration{PodSecurity: &config.PodSecurityConfiguration{ShootNamespacesLevel: ptr.To("restricted")}}
+			Expect(ShootNamespacesPodSecurityLevel(gardenletConfig)).To(Equal("restricted"))
+		})
+	})
+
+	Describe("#SetPodSecurityLabels", func() {
+		var obj *metav1.ObjectMeta
+
+		BeforeEach(func() {
+			obj = &metav1.ObjectMeta{Labels: map[string]string{
+				"foo":                                "bar",
+				"pod-security.kubernetes.io/audit":   "restricted",
+				"pod-security.kubernetes.io/warn":    "restricted",
+				"pod-security.kubernetes.io/enforce": "restricted",
+			}}
+		})
+
+		It("should only set the enforce label when no audit level is configured", func() {
+			SetPodSecurityLabels(obj, "privileged", &config.GardenletConfiguration{})
+
+			Expect(obj.Labels).To(Equal(map[string]string{
+				"foo":                                "bar",
+				"pod-security.kubernetes.io/enforce": "privileged",
+			}))
+		})
+
+		It("should set the audit and warn labels when an audit level is configured", func() {
+			SetPodSecurityLabels(obj, "privileged", &config.GardenletConfiguration{PodSecurity: &config.PodSecurityConfiguration{AuditLevel: ptr.To("baseline")}})
+
+			Expect(obj.Labels).To(Equal(map[string]string{
+				"foo":                                "bar",
+				"pod-security.kubernetes.io/audit":   "baseline",
+				"pod-security.kubernetes.io/warn":    "baseline",
+				"pod-security.kubernetes.io/enforce": "privileged",
+			}))
+		})
+	})
+
 	Describe("#LoggingConfiguration", func() {
 		It("should return false when the GardenletConfiguration is nil", func() {
 			Expect(IsLoggingEnabled(nil)).To(BeFalse())
@@ -308,4 +366,143 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#IsShootHealthCheckEnabled", func() {
+		It("should return true when nothing is configured", func() {
+			Expect(IsShootHealthCheckEnabled(nil, config.ShootHealthCheckTunnelConnection)).To(BeTrue())
+			Expect(IsShootHealthCheckEnabled(&config.GardenletConfiguration{}, config.ShootHealthCheckTunnelConnection)).To(BeTrue())
+		})
+
+		It("should return whether the check is disabled", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{
+					ShootCare: &config.ShootCareControllerConfiguration{
+						HealthChecks: &config.ShootHealthChecks{
+							DisabledChecks: []config.ShootHealthCheck{config.ShootHealthCheckTunnelConnection},
+						},
+					},
+				},
+			}
+
+			Expect(IsShootHealthCheckEnabled(gardenletConfig, config.ShootHealthCheckTunnelConnection)).To(BeFalse())
+			Expect(IsShootHealthCheckEnabled(gardenletConfig, config.ShootHealthCheckNodesScaling)).To(BeTrue())
+		})
+	})
+
+	Describe("#GetNodeAgentLeaseStalenessThreshold", func() {
+		It("should return nil when nothing is configured", func() {
+			Expect(GetNodeAgentLeaseStalenessThreshold(nil)).To(BeNil())
+			Expect(GetNodeAgentLeaseStalenessThreshold(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured threshold", func() {
+			threshold := &metav1.Duration{Duration: time.Minute}
+			gardenletConfig := &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{
+					ShootCare: &config.ShootCareControllerConfiguration{
+						HealthChecks: &config.ShootHealthChecks{NodeAgentLeaseStalenessThreshold: threshold},
+					},
+				},
+			}
+
+			Expect(GetNodeAgentLeaseStalenessThreshold(gardenletConfig)).To(Equal(threshold))
+		})
+	})
+
+	Describe("#GetExpiredNodeLeasesThresholdPercentage", func() {
+		It("should return 20 when nothing is configured", func() {
+			Expect(GetExpiredNodeLeasesThresholdPercentage(nil)).To(Equal(20))
+			Expect(GetExpiredNodeLeasesThresholdPercentage(&config.GardenletConfiguration{})).To(Equal(20))
+		})
+
+		It("should return the configured percentage", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{
+					ShootCare: &config.ShootCareControllerConfiguration{
+						HealthChecks: &config.ShootHealthChecks{ExpiredNodeLeasesThresholdPercentage: ptr.To(50)},
+					},
+				},
+			}
+
+			Expect(GetExpiredNodeLeasesThresholdPercentage(gardenletConfig)).To(Equal(50))
+		})
+	})
+
+	Describe("#IsArtifactCacheEnabled", func() {
+		It("should return false when nothing is set", func() {
+			Expect(IsArtifactCacheEnabled(nil)).To(BeFalse())
+			Expect(IsArtifactCacheEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+			Expect(IsArtifactCacheEnabled(&config.GardenletConfiguration{ArtifactCache: &config.ArtifactCache{}})).To(BeFalse())
+		})
+
+		It("should return the configured value", func() {
+			Expect(IsArtifactCacheEnabled(&config.GardenletConfiguration{ArtifactCache: &config.ArtifactCache{Enabled: ptr.To(false)}})).To(BeFalse())
+			Expect(IsArtifactCacheEnabled(&config.GardenletConfiguration{ArtifactCache: &config.ArtifactCache{Enabled: ptr.To(true)}})).To(BeTrue())
+		})
+	})
+
+	Describe("#CareSyncPeriod", func() {
+		var (
+			now        = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+			syncPeriod = time.Minute
+			c          *config.AdaptiveSyncPeriod
+
+			condition = func(status gardencorev1beta1.ConditionStatus, age time.Duration) gardencorev1beta1.Condition {
+				return gardencorev1beta1.Condition{Status: status, LastTransitionTime: metav1.NewTime(now.Add(-age))}
+			}
+		)
+
+		BeforeEach(func() {
+			c = &config.AdaptiveSyncPeriod{
+				HealthySyncPeriod:   &metav1.Duration{Duration: 5 * time.Minute},
+				DegradedSyncPeriod:  &metav1.Duration{Duration: 30 * time.Second},
+				StabilizationPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				JitterPercentage:    ptr.To[int32](0),
+				Purposes: []config.AdaptiveSyncPeriodPurpose{
+					{Purpose: gardencore.ShootPurposeProduction, HealthySyncPeriod: &metav1.Duration{Duration: 2 * time.Minute}},
+				},
+			}
+		})
+
+		It("should return the sync period if no adaptive sync period is configured", func() {
+			Expect(CareSyncPeriod(syncPeriod, nil, nil, []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour)}, now)).To(Equal(syncPeriod))
+		})
+
+		It("should return the healthy sync period if all conditions have been healthy for the stabilization period", func() {
+			conditions := []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour), condition(gardencorev1beta1.ConditionTrue, 10*time.Minute)}
+			Expect(CareSyncPeriod(syncPeriod, c, nil, conditions, now)).To(Equal(5 * time.Minute))
+		})
+
+		It("should return the degraded sync period if a condition recently became healthy", func() {
+			conditions := []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour), condition(gardencorev1beta1.ConditionTrue, time.Minute)}
+			Expect(CareSyncPeriod(syncPeriod, c, nil, conditions, now)).To(Equal(30 * time.Second))
+		})
+
+		It("should return the degraded sync period if a condition is not healthy", func() {
+			conditions := []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour), condition(gardencorev1beta1.ConditionProgressing, time.Hour)}
+			Expect(CareSyncPeriod(syncPeriod, c, nil, conditions, now)).To(Equal(30 * time.Second))
+		})
+
+		It("should return the degraded sync period if there are no conditions", func() {
+			Expect(CareSyncPeriod(syncPeriod, c, nil, nil, now)).To(Equal(30 * time.Second))
+		})
+
+		It("should respect the sync periods of the purpose", func() {
+			conditions := []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour)}
+			Expect(CareSyncPeriod(syncPeriod, c, ptr.To(gardencorev1beta1.ShootPurposeProduction), conditions, now)).To(Equal(2 * time.Minute))
+			Expect(CareSyncPeriod(syncPeriod, c, ptr.To(gardencorev1beta1.ShootPurposeEvaluation), conditions, now)).To(Equal(5 * time.Minute))
+
+			conditions = []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionFalse, time.Hour)}
+			Expect(CareSyncPeriod(syncPeriod, c, ptr.To(gardencorev1beta1.ShootPurposeProduction), conditions, now)).To(Equal(30 * time.Second))
+		})
+
+		It("should jitter the sync period", func() {
+			c.JitterPercentage = ptr.To[int32](20)
+			conditions := []gardencorev1beta1.Condition{condition(gardencorev1beta1.ConditionTrue, time.Hour)}
+
+			for i := 0; i < 100; i++ {
+				Expect(CareSyncPeriod(syncPeriod, c, nil, conditions, now)).To(BeNumerically("~", 5*time.Minute+30*time.Second, 30*time.Second))
+			}
+		})
+	})
 })
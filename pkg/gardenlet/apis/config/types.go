@@ -63,6 +63,15 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// PodSecurity contains optional settings for the Pod Security Standards applied to the namespaces managed by the
+	// gardenlet in the seed cluster.
+	PodSecurity *PodSecurityConfiguration
+	// ArtifactCache contains optional settings for the pull-through cache for OCI artifacts deployed into the seed
+	// cluster.
+	ArtifactCache *ArtifactCache
+	// CertificateService contains optional settings for the Gardener-managed certificate service which can be enabled
+	// for shoot clusters via `.spec.systemComponents.certificateService.enabled`.
+	CertificateService *CertificateService
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -86,6 +95,12 @@ type GardenClientConnection struct {
 	// KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig
 	// secrets.
 	KubeconfigValidity *KubeconfigValidity
+	// TokenFile is the path to a file containing a token which is used to authenticate against the garden cluster
+	// instead of a client certificate, e.g., a projected service account token of the seed cluster which is accepted
+	// by a JWT authenticator of the garden cluster. The file is re-read regularly, hence renewed tokens are picked up
+	// automatically. If set, `gardenClusterAddress` and `gardenClusterCACert` are used for connecting to the garden
+	// cluster and neither the kubeconfig bootstrap process nor the certificate rotation are performed.
+	TokenFile *string
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -138,12 +153,18 @@ type GardenletControllerConfiguration struct {
 	Seed *SeedControllerConfiguration
 	// SeedCare defines the configuration of the SeedCare controller.
 	SeedCare *SeedCareControllerConfiguration
+	// SeedCapacity defines the configuration of the SeedCapacity controller.
+	SeedCapacity *SeedCapacityControllerConfiguration
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot *ShootControllerConfiguration
 	// ShootCare defines the configuration of the ShootCare controller.
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootResourceUsage defines the configuration of the ShootResourceUsage controller.
+	ShootResourceUsage *ShootResourceUsageControllerConfiguration
+	// ShootConformanceTest defines the configuration of the ShootConformanceTest controller.
+	ShootConformanceTest *ShootConformanceTestControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -246,6 +267,53 @@ type ShootControllerConfiguration struct {
 	// DNSEntryTTLSeconds is the TTL in seconds that is being used for DNS entries when reconciling shoots.
 	// Default: 120s
 	DNSEntryTTLSeconds *int64
+	// ImageVerification contains the configuration for verifying the container images of the control plane and system
+	// components before they are deployed. If not set, images are not verified.
+	ImageVerification *ImageVerification
+	// ProjectConcurrency contains the configuration for distributing the workers of the controller fairly among the
+	// projects. If not set, the number of concurrent reconciliations per project is not limited.
+	ProjectConcurrency *ShootProjectConcurrency
+	// FlowConcurrency contains the configuration for limiting the number of tasks of the shoot flows which run in
+	// parallel. If not set, all tasks are started as soon as their dependencies have completed.
+	FlowConcurrency *ShootFlowConcurrency
+}
+
+// ShootProjectConcurrency contains the configuration for distributing the workers of the shoot controller fairly
+// among the projects.
+type ShootProjectConcurrency struct {
+	// MaxConcurrentReconciles is the maximum number of shoots of the same project which are reconciled concurrently.
+	// Further shoots of the project are requeued until a reconciliation of a shoot of the project has finished, so that
+	// a burst of shoots in one project cannot occupy all workers of the controller.
+	MaxConcurrentReconciles *int
+	// ProjectNamespaces overwrites MaxConcurrentReconciles for the projects with the given namespaces.
+	ProjectNamespaces map[string]int
+	// RequeueDelay is the duration after which a shoot is requeued if the maximum number of concurrent reconciliations
+	// of its project is reached.
+	RequeueDelay *metav1.Duration
+}
+
+// ShootFlowConcurrency contains the configuration for limiting the number of tasks of the shoot flows which run in
+// parallel.
+type ShootFlowConcurrency struct {
+	// MaxParallelTasks is the maximum number of tasks of a shoot flow which run in parallel.
+	MaxParallelTasks *int
+	// MaxParallelTasksPerClass is the maximum number of tasks of a concurrency class which run in parallel in a shoot
+	// flow. The supported classes are `extension` (tasks deploying extension resources) and `system-component` (tasks
+	// deploying the system components of the shoot cluster).
+	MaxParallelTasksPerClass map[string]int
+}
+
+// ImageVerification contains the configuration for verifying container images.
+type ImageVerification struct {
+	// RequireDigest specifies whether all images must be referenced by digest. Images referenced by tag are rejected.
+	RequireDigest bool
+	// PublicKeys is a list of PEM-encoded public keys which are trusted for verifying cosign signatures. If set, each
+	// image must have a valid cosign signature created with one of these keys.
+	PublicKeys []string
+	// PullSecretRefs are references to image pull secrets (of type `kubernetes.io/dockerconfigjson` or
+	// `kubernetes.io/dockercfg`) in the seed cluster. Their credentials are used for resolving the digests and fetching
+	// the signatures of images in private registries.
+	PullSecretRefs []corev1.SecretReference
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -270,6 +338,21 @@ type ShootCareControllerConfiguration struct {
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	WebhookRemediatorEnabled *bool
+	// AdaptiveSyncPeriod configures adaptive sync periods for the health checks. If set, the SyncPeriod is only
+	// used for the timeouts of the health checks, and the shoots are requeued based on their health instead.
+	AdaptiveSyncPeriod *AdaptiveSyncPeriod
+	// EtcdMemberRemediation configures the automated replacement of permanently failed members of highly available
+	// etcd clusters.
+	EtcdMemberRemediation *EtcdMemberRemediation
+	// Remediation configures the automatic remediation of well-understood failures which are detected by the health
+	// checks.
+	Remediation *Remediation
+	// HealthChecks configures the checks which contribute to the EveryNodeReady and SystemComponentsHealthy
+	// conditions of shoots.
+	HealthChecks *ShootHealthChecks
+	// HibernationPreChecks is a list of checks which are performed before shoots are hibernated. Depending on their
+	// mode, failing checks either block the hibernation or are reported as a warning.
+	HibernationPreChecks []ShootHibernationPreCheck
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -280,6 +363,49 @@ type SeedCareControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 	// ConditionThresholds defines the condition threshold per condition type.
 	ConditionThresholds []ConditionThreshold
+	// AdaptiveSyncPeriod configures adaptive sync periods for the health checks. If set, the SyncPeriod is only
+	// used for the timeouts of the health checks, and the seed is requeued based on its health instead.
+	// Purposes must not be set for seeds.
+	AdaptiveSyncPeriod *AdaptiveSyncPeriod
+}
+
+// SeedCapacityControllerConfiguration defines the configuration of the SeedCapacity
+// controller.
+type SeedCapacityControllerConfiguration struct {
+	// SyncPeriod is the duration how often the capacity report of the seed is updated. The capacity report is not
+	// published if set to 0.
+	SyncPeriod *metav1.Duration
+	// LoadBalancerQuota is the maximum number of services of type LoadBalancer which can be created in the seed
+	// cluster, e.g., due to a quota of the infrastructure provider. It is only used for the capacity report.
+	LoadBalancerQuota *int32
+}
+
+// AdaptiveSyncPeriod defines the configuration of adaptive sync periods for health checks. Healthy objects are
+// checked less frequently than degraded ones, and all sync periods are jittered to avoid synchronized load spikes.
+type AdaptiveSyncPeriod struct {
+	// HealthySyncPeriod is the sync period for objects whose conditions have all been healthy for at least the
+	// StabilizationPeriod.
+	HealthySyncPeriod *metav1.Duration
+	// DegradedSyncPeriod is the sync period for objects with at least one unhealthy condition or with a condition which
+	// changed its status within the StabilizationPeriod.
+	DegradedSyncPeriod *metav1.Duration
+	// StabilizationPeriod is the duration for which all conditions must have been healthy before an object is checked
+	// with the HealthySyncPeriod.
+	StabilizationPeriod *metav1.Duration
+	// JitterPercentage is the maximum percentage by which the sync periods are randomly extended.
+	JitterPercentage *int32
+	// Purposes allows to overwrite the sync periods per shoot purpose.
+	Purposes []AdaptiveSyncPeriodPurpose
+}
+
+// AdaptiveSyncPeriodPurpose defines the sync periods for shoots of a specific purpose.
+type AdaptiveSyncPeriodPurpose struct {
+	// Purpose is the shoot purpose.
+	Purpose gardencore.ShootPurpose
+	// HealthySyncPeriod overwrites the HealthySyncPeriod for shoots of this purpose.
+	HealthySyncPeriod *metav1.Duration
+	// DegradedSyncPeriod overwrites the DegradedSyncPeriod for shoots of this purpose.
+	DegradedSyncPeriod *metav1.Duration
 }
 
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
@@ -291,6 +417,29 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootResourceUsageControllerConfiguration defines the configuration of the ShootResourceUsage controller.
+type ShootResourceUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the resource usage of the shoot control planes is updated. The resource
+	// usage is not published if set to 0.
+	SyncPeriod *metav1.Duration
+}
+
+// ShootConformanceTestControllerConfiguration defines the configuration of the ShootConformanceTest controller.
+type ShootConformanceTestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events. The controller is disabled if
+	// set to 0.
+	ConcurrentSyncs *int
+	// Focus is a regular expression selecting the tests of the Kubernetes end-to-end test suite which are run.
+	Focus *string
+	// Skip is a regular expression selecting the tests of the Kubernetes end-to-end test suite which are skipped.
+	Skip *string
+	// Timeout is the maximum duration of a conformance test run. Runs exceeding the timeout are aborted and reported as
+	// failed.
+	Timeout *metav1.Duration
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -302,6 +451,121 @@ type StaleExtensionHealthChecks struct {
 	Threshold *metav1.Duration
 }
 
+// EtcdMemberRemediation configures the automated replacement of permanently failed etcd members.
+type EtcdMemberRemediation struct {
+	// Enabled specifies whether permanently failed members of highly available etcd clusters are replaced
+	// automatically.
+	Enabled bool
+	// Threshold is the duration for which a member and its pod must have been not ready before the member is replaced.
+	// Defaults to 15m.
+	Threshold *metav1.Duration
+}
+
+// Remediation configures the automatic remediation of well-understood failures which are detected by the health
+// checks of the shoot care controller.
+type Remediation struct {
+	// Enabled specifies whether failures are remediated automatically. It serves as kill switch for all remediation
+	// actions.
+	Enabled bool
+	// Actions is the list of remediation actions which are performed if their health check fails.
+	// Defaults to all remediation actions.
+	Actions []RemediationAction
+	// Threshold is the duration for which a failure must have persisted before it is remediated.
+	// Defaults to 10m.
+	Threshold *metav1.Duration
+	// MaxActionsPerShoot is the maximum number of remediation actions which are performed for a shoot within the
+	// RateLimitPeriod.
+	// Defaults to 3.
+	MaxActionsPerShoot *int
+	// RateLimitPeriod is the period for which MaxActionsPerShoot applies.
+	// Defaults to 1h.
+	RateLimitPeriod *metav1.Duration
+}
+
+// RemediationAction is an action for remediating a well-understood failure.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartCrashLoopingControlPlanePod deletes a control plane pod in the seed which is
+	// crash-looping. It is performed if the ControlPlaneHealthy condition is not true.
+	RemediationActionRestartCrashLoopingControlPlanePod RemediationAction = "RestartCrashLoopingControlPlanePod"
+	// RemediationActionRetriggerFailedManagedResource triggers a new reconciliation of a ManagedResource in the seed
+	// whose resources could not be applied. It is performed if the ControlPlaneHealthy, SystemComponentsHealthy or
+	// ObservabilityComponentsHealthy condition is not true.
+	RemediationActionRetriggerFailedManagedResource RemediationAction = "RetriggerFailedManagedResource"
+	// RemediationActionRecreateBrokenVPNPod deletes a vpn-shoot pod in the shoot which is not ready. It is performed if
+	// the SystemComponentsHealthy condition is not true.
+	RemediationActionRecreateBrokenVPNPod RemediationAction = "RecreateBrokenVPNPod"
+)
+
+// ShootHealthChecks configures the checks which contribute to the health conditions of shoots.
+type ShootHealthChecks struct {
+	// DisabledChecks is a list of checks which do not contribute to the health conditions of shoots.
+	DisabledChecks []ShootHealthCheck
+	// NodeAgentLeaseStalenessThreshold is the duration after which a gardener-node-agent Lease which has not been
+	// renewed is considered stale. If not set, a Lease is considered stale once its lease duration has passed.
+	NodeAgentLeaseStalenessThreshold *metav1.Duration
+	// ExpiredNodeLeasesThresholdPercentage is the percentage of expired node Leases in the kube-node-lease namespace
+	// from which on the EveryNodeReady condition is considered unhealthy.
+	// Defaults to 20.
+	ExpiredNodeLeasesThresholdPercentage *int
+}
+
+// ShootHealthCheck is a check which contributes to the health conditions of shoots.
+type ShootHealthCheck string
+
+const (
+	// ShootHealthCheckTunnelConnection checks whether the VPN tunnel between the control plane and the shoot is
+	// established. It contributes to the SystemComponentsHealthy condition.
+	ShootHealthCheckTunnelConnection ShootHealthCheck = "TunnelConnection"
+	// ShootHealthCheckOperatingSystemConfig checks whether the latest operating system configuration was applied on all
+	// nodes. It contributes to the EveryNodeReady condition.
+	ShootHealthCheckOperatingSystemConfig ShootHealthCheck = "OperatingSystemConfig"
+	// ShootHealthCheckNodeAgentLeases checks whether gardener-node-agent is running on all nodes. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckNodeAgentLeases ShootHealthCheck = "NodeAgentLeases"
+	// ShootHealthCheckNodesScaling checks whether nodes are being scaled up or down. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckNodesScaling ShootHealthCheck = "NodesScaling"
+	// ShootHealthCheckExpiredNodeLeases checks whether too many node Leases are expired. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckExpiredNodeLeases ShootHealthCheck = "ExpiredNodeLeases"
+)
+
+// ShootHibernationPreCheck is a check which is performed before a shoot is hibernated.
+type ShootHibernationPreCheck struct {
+	// Name is the name of the check.
+	Name ShootHibernationPreCheckName
+	// Mode specifies whether a failing check blocks the hibernation or is only reported as a warning.
+	// Defaults to 'Warn'.
+	Mode ShootHibernationPreCheckMode
+}
+
+// ShootHibernationPreCheckName is the name of a check which is performed before a shoot is hibernated.
+type ShootHibernationPreCheckName string
+
+const (
+	// ShootHibernationPreCheckPendingVolumeDetachments checks whether volumes are still being detached from nodes of the
+	// shoot, i.e., whether there are VolumeAttachments which are being deleted or failed to detach.
+	ShootHibernationPreCheckPendingVolumeDetachments ShootHibernationPreCheckName = "PendingVolumeDetachments"
+	// ShootHibernationPreCheckCredentialsRotationInProgress checks whether a credentials rotation of the shoot is being
+	// prepared or completed.
+	ShootHibernationPreCheckCredentialsRotationInProgress ShootHibernationPreCheckName = "CredentialsRotationInProgress"
+	// ShootHibernationPreCheckPodDisruptionBudgets checks whether PodDisruptionBudgets which were flagged by the user
+	// with the 'hibernation.shoot.gardener.cloud/pre-check=true' label currently disallow any disruption.
+	ShootHibernationPreCheckPodDisruptionBudgets ShootHibernationPreCheckName = "PodDisruptionBudgets"
+)
+
+// ShootHibernationPreCheckMode specifies how a failing check is treated before a shoot is hibernated.
+type ShootHibernationPreCheckMode string
+
+const (
+	// ShootHibernationPreCheckModeWarn reports a failing check as a warning but does not block the hibernation.
+	ShootHibernationPreCheckModeWarn ShootHibernationPreCheckMode = "Warn"
+	// ShootHibernationPreCheckModeBlock blocks the hibernation if the check fails.
+	ShootHibernationPreCheckModeBlock ShootHibernationPreCheckMode = "Block"
+)
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
@@ -343,6 +607,10 @@ type ManagedSeedControllerConfiguration struct {
 	// The applied jitterPeriod is taken from SyncJitterPeriod.
 	// Defaults to false.
 	JitterUpdates *bool
+	// DriftDetectionPeriod is the duration how often the actual seed and gardenlet configuration of managed seeds are
+	// compared against the declared configuration in order to detect drift. A value of 0 disables the drift detection.
+	// Defaults to 10m.
+	DriftDetectionPeriod *metav1.Duration
 }
 
 // TokenRequestorControllerConfiguration defines the configuration of the TokenRequestor controller.
@@ -433,6 +701,31 @@ type Server struct {
 type SNI struct {
 	// Ingress is the ingressgateway configuration.
 	Ingress *SNIIngress
+	// Limits contains limits for the traffic of each shoot to its kube-apiserver through the ingressgateway. They
+	// protect the network path shared by all shoots exposed via the ingressgateway from a single shoot generating
+	// excessive load.
+	Limits *SNILimits
+}
+
+// SNILimits contains limits for the traffic of each shoot to its kube-apiserver through the ingressgateway.
+type SNILimits struct {
+	// Default contains the limits for all shoots.
+	Default *SNIShootLimits
+	// Purposes contains the limits for shoots with the given purpose. They replace the default limits.
+	Purposes map[gardencore.ShootPurpose]SNIShootLimits
+}
+
+// SNIShootLimits contains limits for the traffic of a single shoot to its kube-apiserver through the ingressgateway.
+type SNIShootLimits struct {
+	// MaxConnections is the maximum number of concurrent connections to the kube-apiserver.
+	MaxConnections *int32
+	// ConnectionsPerSecond is the maximum number of new connections per second to the kube-apiserver. Connections
+	// exceeding the limit are closed immediately.
+	ConnectionsPerSecond *int32
+	// ConnectionBufferLimit is the maximum amount of data which is buffered for each connection to the kube-apiserver.
+	// As the traffic is passed through without terminating TLS, the size of requests cannot be limited directly.
+	// Instead, large requests are slowed down to the pace of the kube-apiserver.
+	ConnectionBufferLimit *resource.Quantity
 }
 
 // SNIIngress contains configuration of the ingressgateway.
@@ -535,6 +828,17 @@ type LoadBalancerServiceConfig struct {
 type MonitoringConfig struct {
 	// Shoot is optional and contains settings for the shoot monitoring stack.
 	Shoot *ShootMonitoringConfig
+	// Seed is optional and contains settings for the seed monitoring stack.
+	Seed *SeedMonitoringConfig
+}
+
+// SeedMonitoringConfig contains settings for the seed monitoring stack.
+type SeedMonitoringConfig struct {
+	// RemoteWrite is optional and contains remote write settings for the aggregate Prometheus of the seed. It can be
+	// used to federate the aggregated shoot and seed metrics to a central Prometheus, e.g., the garden Prometheus.
+	// The basic auth credentials for the remote write endpoint are read from the secret with the
+	// `gardener.cloud/role=global-seed-remote-write-monitoring` label in the garden namespace of the garden cluster.
+	RemoteWrite *RemoteWriteMonitoringConfig
 }
 
 // ShootMonitoringConfig contains settings for the shoot monitoring stack.
@@ -565,3 +869,56 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// PodSecurityConfiguration contains settings for the Pod Security Standards applied to the namespaces managed by the
+// gardenlet in the seed cluster.
+type PodSecurityConfiguration struct {
+	// GardenNamespaceLevel is the Pod Security Standard level enforced in the garden namespace.
+	GardenNamespaceLevel *string
+	// ShootNamespacesLevel is the Pod Security Standard level enforced in the shoot namespaces.
+	ShootNamespacesLevel *string
+	// AuditLevel is the Pod Security Standard level which is audited and warned about in the managed namespaces. Pods
+	// violating this level are reported in the `SeedPodSecurityCompliant` condition of the Seed.
+	AuditLevel *string
+	// Exemptions is a list of label selectors for pods which are not reported in the `SeedPodSecurityCompliant`
+	// condition, e.g., because the respective component requires elevated privileges.
+	Exemptions []metav1.LabelSelector
+}
+
+// ArtifactCache contains settings for the pull-through cache for OCI artifacts (e.g., container images and Helm charts)
+// deployed by the gardenlet into the seed cluster.
+type ArtifactCache struct {
+	// Enabled specifies whether the artifact cache is deployed.
+	Enabled *bool
+	// Upstreams is the list of upstream registries which are cached.
+	Upstreams []ArtifactCacheUpstream
+	// StorageSize is the size of the volume used for caching the artifacts of each upstream.
+	StorageSize *resource.Quantity
+	// GarbageCollectionTTL is the duration after which cached artifacts are removed from the cache.
+	GarbageCollectionTTL *metav1.Duration
+}
+
+// ArtifactCacheUpstream contains settings for an upstream registry which is cached.
+type ArtifactCacheUpstream struct {
+	// Host is the host of the upstream registry, e.g., `europe-docker.pkg.dev`.
+	Host string
+	// RemoteURL is the URL of the upstream registry.
+	RemoteURL *string
+}
+
+// CertificateService contains settings for the Gardener-managed certificate service for shoot clusters.
+type CertificateService struct {
+	// ACME contains the settings of the ACME server used for issuing the certificates of shoot clusters.
+	ACME CertificateServiceACME
+}
+
+// CertificateServiceACME contains the settings of an ACME server.
+type CertificateServiceACME struct {
+	// Email is the e-mail address used for registering at the ACME server.
+	Email string
+	// Server is the URL of the ACME directory, e.g., `https://acme-v02.api.letsencrypt.org/directory`.
+	Server string
+	// PrecheckNameservers overwrites the nameservers used for checking the DNS propagation of the challenges.
+	// Format `host` or `host:port`, e.g., `8.8.8.8` or `8.8.8.8:53`.
+	PrecheckNameservers []string
+}
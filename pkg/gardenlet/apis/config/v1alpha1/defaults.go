@@ -7,8 +7,10 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -161,9 +163,18 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.SeedCare == nil {
 		obj.SeedCare = &SeedCareControllerConfiguration{}
 	}
+	if obj.SeedCapacity == nil {
+		obj.SeedCapacity = &SeedCapacityControllerConfiguration{}
+	}
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootResourceUsage == nil {
+		obj.ShootResourceUsage = &ShootResourceUsageControllerConfiguration{}
+	}
+	if obj.ShootConformanceTest == nil {
+		obj.ShootConformanceTest = &ShootConformanceTestControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -312,6 +323,13 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 	}
 }
 
+// SetDefaults_SeedCapacityControllerConfiguration sets defaults for the seed capacity controller.
+func SetDefaults_SeedCapacityControllerConfiguration(obj *SeedCapacityControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
 func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -342,6 +360,10 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 	if obj.DNSEntryTTLSeconds == nil {
 		obj.DNSEntryTTLSeconds = ptr.To[int64](120)
 	}
+
+	if obj.ProjectConcurrency != nil && obj.ProjectConcurrency.RequeueDelay == nil {
+		obj.ProjectConcurrency.RequeueDelay = &metav1.Duration{Duration: 10 * time.Second}
+	}
 }
 
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
@@ -362,6 +384,63 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 	}
 }
 
+// SetDefaults_AdaptiveSyncPeriod sets defaults for the adaptive sync periods of the care controllers.
+func SetDefaults_AdaptiveSyncPeriod(obj *AdaptiveSyncPeriod) {
+	if obj.HealthySyncPeriod == nil {
+		obj.HealthySyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.DegradedSyncPeriod == nil {
+		obj.DegradedSyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+	if obj.StabilizationPeriod == nil {
+		obj.StabilizationPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.JitterPercentage == nil {
+		obj.JitterPercentage = ptr.To[int32](20)
+	}
+}
+
+// SetDefaults_EtcdMemberRemediation sets defaults for the etcd member remediation.
+func SetDefaults_EtcdMemberRemediation(obj *EtcdMemberRemediation) {
+	if obj.Threshold == nil {
+		obj.Threshold = &metav1.Duration{Duration: 15 * time.Minute}
+	}
+}
+
+// SetDefaults_Remediation sets defaults for the remediation of failed health checks.
+func SetDefaults_Remediation(obj *Remediation) {
+	if obj.Actions == nil {
+		obj.Actions = []RemediationAction{
+			RemediationActionRestartCrashLoopingControlPlanePod,
+			RemediationActionRetriggerFailedManagedResource,
+			RemediationActionRecreateBrokenVPNPod,
+		}
+	}
+	if obj.Threshold == nil {
+		obj.Threshold = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.MaxActionsPerShoot == nil {
+		obj.MaxActionsPerShoot = ptr.To(3)
+	}
+	if obj.RateLimitPeriod == nil {
+		obj.RateLimitPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
+// SetDefaults_ShootHealthChecks sets defaults for the health checks of the shoot care controller.
+func SetDefaults_ShootHealthChecks(obj *ShootHealthChecks) {
+	if obj.ExpiredNodeLeasesThresholdPercentage == nil {
+		obj.ExpiredNodeLeasesThresholdPercentage = ptr.To(20)
+	}
+}
+
+// SetDefaults_ShootHibernationPreCheck sets defaults for the hibernation pre-checks of the shoot care controller.
+func SetDefaults_ShootHibernationPreCheck(obj *ShootHibernationPreCheck) {
+	if obj.Mode == "" {
+		obj.Mode = ShootHibernationPreCheckModeWarn
+	}
+}
+
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
 func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	if obj.Threshold == nil {
@@ -380,6 +459,32 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootResourceUsageControllerConfiguration sets defaults for the shoot resource usage controller.
+func SetDefaults_ShootResourceUsageControllerConfiguration(obj *ShootResourceUsageControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_ShootConformanceTestControllerConfiguration sets defaults for the shoot conformance test controller.
+func SetDefaults_ShootConformanceTestControllerConfiguration(obj *ShootConformanceTestControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.Focus == nil {
+		obj.Focus = ptr.To(`\[Conformance\]`)
+	}
+	if obj.Skip == nil {
+		obj.Skip = ptr.To(`\[Serial\]|\[Disruptive\]|\[Slow\]`)
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 2 * time.Hour}
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -413,6 +518,10 @@ func SetDefaults_ManagedSeedControllerConfiguration(obj *ManagedSeedControllerCo
 	if obj.JitterUpdates == nil {
 		obj.JitterUpdates = ptr.To(false)
 	}
+
+	if obj.DriftDetectionPeriod == nil {
+		obj.DriftDetectionPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
 }
 
 // SetDefaults_TokenRequestorControllerConfiguration sets defaults for the TokenRequestor controller.
@@ -526,3 +635,35 @@ func SetDefaults_BackupCompactionController(obj *BackupCompactionController) {
 		obj.MetricsScrapeWaitDuration = &metav1.Duration{Duration: 60 * time.Second}
 	}
 }
+
+// SetDefaults_PodSecurityConfiguration sets defaults for the Pod Security configuration.
+func SetDefaults_PodSecurityConfiguration(obj *PodSecurityConfiguration) {
+	if obj.GardenNamespaceLevel == nil {
+		obj.GardenNamespaceLevel = ptr.To(string(podsecurityadmissionapi.LevelPrivileged))
+	}
+	if obj.ShootNamespacesLevel == nil {
+		obj.ShootNamespacesLevel = ptr.To(string(podsecurityadmissionapi.LevelPrivileged))
+	}
+	if obj.AuditLevel == nil {
+		obj.AuditLevel = ptr.To(string(podsecurityadmissionapi.LevelBaseline))
+	}
+}
+
+// SetDefaults_ArtifactCache sets defaults for the artifact cache configuration.
+func SetDefaults_ArtifactCache(obj *ArtifactCache) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(false)
+	}
+	if obj.StorageSize == nil {
+		obj.StorageSize = ptr.To(resource.MustParse("20Gi"))
+	}
+	if obj.GarbageCollectionTTL == nil {
+		obj.GarbageCollectionTTL = &metav1.Duration{Duration: 168 * time.Hour}
+	}
+
+	for i, upstream := range obj.Upstreams {
+		if upstream.RemoteURL == nil {
+			obj.Upstreams[i].RemoteURL = ptr.To("https://" + upstream.Host)
+		}
+	}
+}
@@ -45,7 +45,10 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot).NotTo(BeNil())
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
+			Expect(obj.Controllers.SeedCapacity).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootResourceUsage).NotTo(BeNil())
+			Expect(obj.Controllers.ShootConformanceTest).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
@@ -338,6 +341,74 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedCapacityControllerConfiguration defaulting", func() {
+		It("should default the seed capacity controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCapacity.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.SeedCapacity.LoadBalancerQuota).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the seed capacity controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedCapacity: &SeedCapacityControllerConfiguration{SyncPeriod: &syncPeriod},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCapacity.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
+	Describe("ShootResourceUsageControllerConfiguration defaulting", func() {
+		It("should default the shoot resource usage controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootResourceUsage.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootResourceUsage.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the shoot resource usage controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootResourceUsage: &ShootResourceUsageControllerConfiguration{ConcurrentSyncs: ptr.To(1), SyncPeriod: &syncPeriod},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootResourceUsage.ConcurrentSyncs).To(PointTo(Equal(1)))
+			Expect(obj.Controllers.ShootResourceUsage.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
+	Describe("ShootConformanceTestControllerConfiguration defaulting", func() {
+		It("should default the shoot conformance test controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootConformanceTest.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootConformanceTest.Focus).To(PointTo(Equal(`\[Conformance\]`)))
+			Expect(obj.Controllers.ShootConformanceTest.Skip).To(PointTo(Equal(`\[Serial\]|\[Disruptive\]|\[Slow\]`)))
+			Expect(obj.Controllers.ShootConformanceTest.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for the shoot conformance test controller configuration", func() {
+			timeout := metav1.Duration{Duration: 30 * time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootConformanceTest: &ShootConformanceTestControllerConfiguration{
+					ConcurrentSyncs: ptr.To(1),
+					Focus:           ptr.To("foo"),
+					Skip:            ptr.To(""),
+					Timeout:         &timeout,
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootConformanceTest.ConcurrentSyncs).To(PointTo(Equal(1)))
+			Expect(obj.Controllers.ShootConformanceTest.Focus).To(PointTo(Equal("foo")))
+			Expect(obj.Controllers.ShootConformanceTest.Skip).To(PointTo(BeEmpty()))
+			Expect(obj.Controllers.ShootConformanceTest.Timeout).To(PointTo(Equal(timeout)))
+		})
+	})
+
 	Describe("SeedCareControllerConfiguration defaulting", func() {
 		It("should default the seed care controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -354,6 +425,23 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.SeedCare.SyncPeriod).To(PointTo(Equal(syncPeriod)))
 		})
+
+		It("should default the adaptive sync period configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedCare:  &SeedCareControllerConfiguration{AdaptiveSyncPeriod: &AdaptiveSyncPeriod{}},
+				ShootCare: &ShootCareControllerConfiguration{AdaptiveSyncPeriod: &AdaptiveSyncPeriod{HealthySyncPeriod: &metav1.Duration{Duration: time.Hour}}},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedCare.AdaptiveSyncPeriod).To(Equal(&AdaptiveSyncPeriod{
+				HealthySyncPeriod:   &metav1.Duration{Duration: 5 * time.Minute},
+				DegradedSyncPeriod:  &metav1.Duration{Duration: 30 * time.Second},
+				StabilizationPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				JitterPercentage:    ptr.To[int32](20),
+			}))
+			Expect(obj.Controllers.ShootCare.AdaptiveSyncPeriod.HealthySyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootCare.AdaptiveSyncPeriod.DegradedSyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+		})
 	})
 
 	Describe("ShootControllerConfiguration defaulting", func() {
@@ -389,6 +477,17 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot.RetryDuration).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
 			Expect(obj.Controllers.Shoot.DNSEntryTTLSeconds).To(PointTo(Equal(int64(60))))
 		})
+
+		It("should default the requeue delay of the project concurrency", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				Shoot: &ShootControllerConfiguration{
+					ProjectConcurrency: &ShootProjectConcurrency{MaxConcurrentReconciles: ptr.To(5)},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.Shoot.ProjectConcurrency.RequeueDelay).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+		})
 	})
 
 	Describe("ShootCareControllerConfiguration defaulting", func() {
@@ -439,6 +538,129 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("EtcdMemberRemediation defaulting", func() {
+		It("should default the etcd member remediation", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					EtcdMemberRemediation: &EtcdMemberRemediation{Enabled: true},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.EtcdMemberRemediation.Threshold).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the etcd member remediation", func() {
+			threshold := metav1.Duration{Duration: time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					EtcdMemberRemediation: &EtcdMemberRemediation{Enabled: true, Threshold: &threshold},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.EtcdMemberRemediation.Threshold).To(PointTo(Equal(threshold)))
+		})
+	})
+
+	Describe("Remediation defaulting", func() {
+		It("should default the remediation", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediation: &Remediation{Enabled: true},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediation).To(Equal(&Remediation{
+				Enabled: true,
+				Actions: []RemediationAction{
+					RemediationActionRestartCrashLoopingControlPlanePod,
+					RemediationActionRetriggerFailedManagedResource,
+					RemediationActionRecreateBrokenVPNPod,
+				},
+				Threshold:          &metav1.Duration{Duration: 10 * time.Minute},
+				MaxActionsPerShoot: ptr.To(3),
+				RateLimitPeriod:    &metav1.Duration{Duration: time.Hour},
+			}))
+		})
+
+		It("should not overwrite already set values for the remediation", func() {
+			remediation := &Remediation{
+				Enabled:            true,
+				Actions:            []RemediationAction{},
+				Threshold:          &metav1.Duration{Duration: time.Minute},
+				MaxActionsPerShoot: ptr.To(1),
+				RateLimitPeriod:    &metav1.Duration{Duration: 24 * time.Hour},
+			}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediation: remediation.DeepCopy(),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediation).To(Equal(remediation))
+		})
+	})
+
+	Describe("ShootHealthChecks defaulting", func() {
+		It("should default the health checks", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					HealthChecks: &ShootHealthChecks{},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.HealthChecks).To(Equal(&ShootHealthChecks{
+				ExpiredNodeLeasesThresholdPercentage: ptr.To(20),
+			}))
+		})
+
+		It("should not overwrite already set values for the health checks", func() {
+			healthChecks := &ShootHealthChecks{
+				DisabledChecks:                       []ShootHealthCheck{ShootHealthCheckTunnelConnection},
+				NodeAgentLeaseStalenessThreshold:     &metav1.Duration{Duration: time.Minute},
+				ExpiredNodeLeasesThresholdPercentage: ptr.To(50),
+			}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					HealthChecks: healthChecks.DeepCopy(),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.HealthChecks).To(Equal(healthChecks))
+		})
+	})
+
+	Describe("ShootHibernationPreCheck defaulting", func() {
+		It("should default the mode of the hibernation pre-checks", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					HibernationPreChecks: []ShootHibernationPreCheck{
+						{Name: ShootHibernationPreCheckPendingVolumeDetachments},
+						{Name: ShootHibernationPreCheckPodDisruptionBudgets, Mode: ShootHibernationPreCheckModeBlock},
+					},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.HibernationPreChecks).To(Equal([]ShootHibernationPreCheck{
+				{Name: ShootHibernationPreCheckPendingVolumeDetachments, Mode: ShootHibernationPreCheckModeWarn},
+				{Name: ShootHibernationPreCheckPodDisruptionBudgets, Mode: ShootHibernationPreCheckModeBlock},
+			}))
+		})
+	})
+
 	Describe("ShootStateControllerConfiguration defaulting", func() {
 		It("should default the shoot state controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -489,17 +711,19 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ManagedSeed.WaitSyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Second})))
 			Expect(obj.Controllers.ManagedSeed.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
 			Expect(obj.Controllers.ManagedSeed.JitterUpdates).To(PointTo(BeFalse()))
+			Expect(obj.Controllers.ManagedSeed.DriftDetectionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
 		})
 
 		It("should not overwrite already set values for the managed seed controller configuration", func() {
 			v := metav1.Duration{Duration: 2 * time.Minute}
 			obj.Controllers = &GardenletControllerConfiguration{
 				ManagedSeed: &ManagedSeedControllerConfiguration{
-					ConcurrentSyncs:  ptr.To(10),
-					SyncPeriod:       &v,
-					WaitSyncPeriod:   &v,
-					SyncJitterPeriod: &v,
-					JitterUpdates:    ptr.To(true),
+					ConcurrentSyncs:      ptr.To(10),
+					SyncPeriod:           &v,
+					WaitSyncPeriod:       &v,
+					SyncJitterPeriod:     &v,
+					JitterUpdates:        ptr.To(true),
+					DriftDetectionPeriod: &v,
 				},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -509,6 +733,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ManagedSeed.WaitSyncPeriod).To(PointTo(Equal(v)))
 			Expect(obj.Controllers.ManagedSeed.SyncJitterPeriod).To(PointTo(Equal(v)))
 			Expect(obj.Controllers.ManagedSeed.JitterUpdates).To(PointTo(BeTrue()))
+			Expect(obj.Controllers.ManagedSeed.DriftDetectionPeriod).To(PointTo(Equal(v)))
 		})
 	})
 
@@ -836,7 +1061,7 @@ var _ = Describe("Defaults", func() {
 
 		It("should not overwrite already set values for the shoot monitoring configuration", func() {
 			obj.Monitoring = &MonitoringConfig{
-				&ShootMonitoringConfig{
+				Shoot: &ShootMonitoringConfig{
 					Enabled: ptr.To(false),
 				}}
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -845,6 +1070,69 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("PodSecurityConfiguration defaulting", func() {
+		It("should not default the pod security configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.PodSecurity).To(BeNil())
+		})
+
+		It("should default the pod security configuration", func() {
+			obj.PodSecurity = &PodSecurityConfiguration{}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.PodSecurity.GardenNamespaceLevel).To(PointTo(Equal("privileged")))
+			Expect(obj.PodSecurity.ShootNamespacesLevel).To(PointTo(Equal("privileged")))
+			Expect(obj.PodSecurity.AuditLevel).To(PointTo(Equal("baseline")))
+		})
+
+		It("should not overwrite already set values for the pod security configuration", func() {
+			obj.PodSecurity = &PodSecurityConfiguration{
+				GardenNamespaceLevel: ptr.To("baseline"),
+				ShootNamespacesLevel: ptr.To("baseline"),
+				AuditLevel:           ptr.To("restricted"),
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.PodSecurity.GardenNamespaceLevel).To(PointTo(Equal("baseline")))
+			Expect(obj.PodSecurity.ShootNamespacesLevel).To(PointTo(Equal("baseline")))
+			Expect(obj.PodSecurity.AuditLevel).To(PointTo(Equal("restricted")))
+		})
+	})
+
+	Describe("ArtifactCache defaulting", func() {
+		It("should not default the artifact cache configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactCache).To(BeNil())
+		})
+
+		It("should default the artifact cache configuration", func() {
+			obj.ArtifactCache = &ArtifactCache{Upstreams: []ArtifactCacheUpstream{{Host: "europe-docker.pkg.dev"}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactCache.Enabled).To(PointTo(BeFalse()))
+			Expect(obj.ArtifactCache.StorageSize).To(PointTo(Equal(resource.MustParse("20Gi"))))
+			Expect(obj.ArtifactCache.GarbageCollectionTTL).To(PointTo(Equal(metav1.Duration{Duration: 168 * time.Hour})))
+			Expect(obj.ArtifactCache.Upstreams).To(ConsistOf(ArtifactCacheUpstream{Host: "europe-docker.pkg.dev", RemoteURL: ptr.To("https://europe-docker.pkg.dev")}))
+		})
+
+		It("should not overwrite already set values for the artifact cache configuration", func() {
+			obj.ArtifactCache = &ArtifactCache{
+				Enabled:              ptr.To(true),
+				Upstreams:            []ArtifactCacheUpstream{{Host: "registry.local", RemoteURL: ptr.To("http://registry.local:5000")}},
+				StorageSize:          ptr.To(resource.MustParse("50Gi")),
+				GarbageCollectionTTL: &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactCache.Enabled).To(PointTo(BeTrue()))
+			Expect(obj.ArtifactCache.StorageSize).To(PointTo(Equal(resource.MustParse("50Gi"))))
+			Expect(obj.ArtifactCache.GarbageCollectionTTL).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.ArtifactCache.Upstreams).To(ConsistOf(ArtifactCacheUpstream{Host: "registry.local", RemoteURL: ptr.To("http://registry.local:5000")}))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
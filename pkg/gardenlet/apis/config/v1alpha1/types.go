@@ -80,6 +80,18 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// PodSecurity contains optional settings for the Pod Security Standards applied to the namespaces managed by the
+	// gardenlet in the seed cluster.
+	// +optional
+	PodSecurity *PodSecurityConfiguration `json:"podSecurity,omitempty"`
+	// ArtifactCache contains optional settings for the pull-through cache for OCI artifacts deployed into the seed
+	// cluster.
+	// +optional
+	ArtifactCache *ArtifactCache `json:"artifactCache,omitempty"`
+	// CertificateService contains optional settings for the Gardener-managed certificate service which can be enabled
+	// for shoot clusters via `.spec.systemComponents.certificateService.enabled`.
+	// +optional
+	CertificateService *CertificateService `json:"certificateService,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -108,6 +120,13 @@ type GardenClientConnection struct {
 	// secrets.
 	// +optional
 	KubeconfigValidity *KubeconfigValidity `json:"kubeconfigValidity,omitempty"`
+	// TokenFile is the path to a file containing a token which is used to authenticate against the garden cluster
+	// instead of a client certificate, e.g., a projected service account token of the seed cluster which is accepted
+	// by a JWT authenticator of the garden cluster. The file is re-read regularly, hence renewed tokens are picked up
+	// automatically. If set, `gardenClusterAddress` and `gardenClusterCACert` are used for connecting to the garden
+	// cluster and neither the kubeconfig bootstrap process nor the certificate rotation are performed.
+	// +optional
+	TokenFile *string `json:"tokenFile,omitempty"`
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -172,6 +191,9 @@ type GardenletControllerConfiguration struct {
 	// SeedCare defines the configuration of the SeedCare controller.
 	// +optional
 	SeedCare *SeedCareControllerConfiguration `json:"seedCare,omitempty"`
+	// SeedCapacity defines the configuration of the SeedCapacity controller.
+	// +optional
+	SeedCapacity *SeedCapacityControllerConfiguration `json:"seedCapacity,omitempty"`
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootControllerConfiguration `json:"shoot,omitempty"`
@@ -181,6 +203,12 @@ type GardenletControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// ShootResourceUsage defines the configuration of the ShootResourceUsage controller.
+	// +optional
+	ShootResourceUsage *ShootResourceUsageControllerConfiguration `json:"shootResourceUsage,omitempty"`
+	// ShootConformanceTest defines the configuration of the ShootConformanceTest controller.
+	// +optional
+	ShootConformanceTest *ShootConformanceTestControllerConfiguration `json:"shootConformanceTest,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -306,6 +334,64 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// ImageVerification contains the configuration for verifying the container images of the control plane and system
+	// components before they are deployed. If not set, images are not verified.
+	// +optional
+	ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
+	// ProjectConcurrency contains the configuration for distributing the workers of the controller fairly among the
+	// projects. If not set, the number of concurrent reconciliations per project is not limited.
+	// +optional
+	ProjectConcurrency *ShootProjectConcurrency `json:"projectConcurrency,omitempty"`
+	// FlowConcurrency contains the configuration for limiting the number of tasks of the shoot flows which run in
+	// parallel. If not set, all tasks are started as soon as their dependencies have completed.
+	// +optional
+	FlowConcurrency *ShootFlowConcurrency `json:"flowConcurrency,omitempty"`
+}
+
+// ShootProjectConcurrency contains the configuration for distributing the workers of the shoot controller fairly
+// among the projects.
+type ShootProjectConcurrency struct {
+	// MaxConcurrentReconciles is the maximum number of shoots of the same project which are reconciled concurrently.
+	// Further shoots of the project are requeued until a reconciliation of a shoot of the project has finished, so that
+	// a burst of shoots in one project cannot occupy all workers of the controller.
+	// +optional
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// ProjectNamespaces overwrites MaxConcurrentReconciles for the projects with the given namespaces.
+	// +optional
+	ProjectNamespaces map[string]int `json:"projectNamespaces,omitempty"`
+	// RequeueDelay is the duration after which a shoot is requeued if the maximum number of concurrent reconciliations
+	// of its project is reached. Defaults to 10s.
+	// +optional
+	RequeueDelay *metav1.Duration `json:"requeueDelay,omitempty"`
+}
+
+// ShootFlowConcurrency contains the configuration for limiting the number of tasks of the shoot flows which run in
+// parallel.
+type ShootFlowConcurrency struct {
+	// MaxParallelTasks is the maximum number of tasks of a shoot flow which run in parallel.
+	// +optional
+	MaxParallelTasks *int `json:"maxParallelTasks,omitempty"`
+	// MaxParallelTasksPerClass is the maximum number of tasks of a concurrency class which run in parallel in a shoot
+	// flow. The supported classes are `extension` (tasks deploying extension resources) and `system-component` (tasks
+	// deploying the system components of the shoot cluster).
+	// +optional
+	MaxParallelTasksPerClass map[string]int `json:"maxParallelTasksPerClass,omitempty"`
+}
+
+// ImageVerification contains the configuration for verifying container images.
+type ImageVerification struct {
+	// RequireDigest specifies whether all images must be referenced by digest. Images referenced by tag are rejected.
+	// +optional
+	RequireDigest bool `json:"requireDigest,omitempty"`
+	// PublicKeys is a list of PEM-encoded public keys which are trusted for verifying cosign signatures. If set, each
+	// image must have a valid cosign signature created with one of these keys.
+	// +optional
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// PullSecretRefs are references to image pull secrets (of type `kubernetes.io/dockerconfigjson` or
+	// `kubernetes.io/dockercfg`) in the seed cluster. Their credentials are used for resolving the digests and fetching
+	// the signatures of images in private registries.
+	// +optional
+	PullSecretRefs []corev1.SecretReference `json:"pullSecretRefs,omitempty"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -336,6 +422,26 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// AdaptiveSyncPeriod configures adaptive sync periods for the health checks. If set, the SyncPeriod is only
+	// used for the timeouts of the health checks, and the shoots are requeued based on their health instead.
+	// +optional
+	AdaptiveSyncPeriod *AdaptiveSyncPeriod `json:"adaptiveSyncPeriod,omitempty"`
+	// EtcdMemberRemediation configures the automated replacement of permanently failed members of highly available
+	// etcd clusters.
+	// +optional
+	EtcdMemberRemediation *EtcdMemberRemediation `json:"etcdMemberRemediation,omitempty"`
+	// Remediation configures the automatic remediation of well-understood failures which are detected by the health
+	// checks.
+	// +optional
+	Remediation *Remediation `json:"remediation,omitempty"`
+	// HealthChecks configures the checks which contribute to the EveryNodeReady and SystemComponentsHealthy
+	// conditions of shoots.
+	// +optional
+	HealthChecks *ShootHealthChecks `json:"healthChecks,omitempty"`
+	// HibernationPreChecks is a list of checks which are performed before shoots are hibernated. Depending on their
+	// mode, failing checks either block the hibernation or are reported as a warning.
+	// +optional
+	HibernationPreChecks []ShootHibernationPreCheck `json:"hibernationPreChecks,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -348,6 +454,60 @@ type SeedCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// AdaptiveSyncPeriod configures adaptive sync periods for the health checks. If set, the SyncPeriod is only
+	// used for the timeouts of the health checks, and the seed is requeued based on its health instead.
+	// Purposes must not be set for seeds.
+	// +optional
+	AdaptiveSyncPeriod *AdaptiveSyncPeriod `json:"adaptiveSyncPeriod,omitempty"`
+}
+
+// SeedCapacityControllerConfiguration defines the configuration of the SeedCapacity
+// controller.
+type SeedCapacityControllerConfiguration struct {
+	// SyncPeriod is the duration how often the capacity report of the seed is updated. The capacity report is not
+	// published if set to 0.
+	// Defaults to 5m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// LoadBalancerQuota is the maximum number of services of type LoadBalancer which can be created in the seed
+	// cluster, e.g., due to a quota of the infrastructure provider. It is only used for the capacity report.
+	// +optional
+	LoadBalancerQuota *int32 `json:"loadBalancerQuota,omitempty"`
+}
+
+// AdaptiveSyncPeriod defines the configuration of adaptive sync periods for health checks. Healthy objects are
+// checked less frequently than degraded ones, and all sync periods are jittered to avoid synchronized load spikes.
+type AdaptiveSyncPeriod struct {
+	// HealthySyncPeriod is the sync period for objects whose conditions have all been healthy for at least the
+	// StabilizationPeriod. Defaults to 5m.
+	// +optional
+	HealthySyncPeriod *metav1.Duration `json:"healthySyncPeriod,omitempty"`
+	// DegradedSyncPeriod is the sync period for objects with at least one unhealthy condition or with a condition which
+	// changed its status within the StabilizationPeriod. Defaults to 30s.
+	// +optional
+	DegradedSyncPeriod *metav1.Duration `json:"degradedSyncPeriod,omitempty"`
+	// StabilizationPeriod is the duration for which all conditions must have been healthy before an object is checked
+	// with the HealthySyncPeriod. Defaults to 10m.
+	// +optional
+	StabilizationPeriod *metav1.Duration `json:"stabilizationPeriod,omitempty"`
+	// JitterPercentage is the maximum percentage by which the sync periods are randomly extended. Defaults to 20.
+	// +optional
+	JitterPercentage *int32 `json:"jitterPercentage,omitempty"`
+	// Purposes allows to overwrite the sync periods per shoot purpose.
+	// +optional
+	Purposes []AdaptiveSyncPeriodPurpose `json:"purposes,omitempty"`
+}
+
+// AdaptiveSyncPeriodPurpose defines the sync periods for shoots of a specific purpose.
+type AdaptiveSyncPeriodPurpose struct {
+	// Purpose is the shoot purpose.
+	Purpose gardencorev1beta1.ShootPurpose `json:"purpose"`
+	// HealthySyncPeriod overwrites the HealthySyncPeriod for shoots of this purpose.
+	// +optional
+	HealthySyncPeriod *metav1.Duration `json:"healthySyncPeriod,omitempty"`
+	// DegradedSyncPeriod overwrites the DegradedSyncPeriod for shoots of this purpose.
+	// +optional
+	DegradedSyncPeriod *metav1.Duration `json:"degradedSyncPeriod,omitempty"`
 }
 
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
@@ -361,6 +521,40 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootResourceUsageControllerConfiguration defines the configuration of the ShootResourceUsage controller.
+type ShootResourceUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the resource usage of the shoot control planes is updated. The resource
+	// usage is not published if set to 0.
+	// Defaults to 10m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// ShootConformanceTestControllerConfiguration defines the configuration of the ShootConformanceTest controller.
+type ShootConformanceTestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events. The controller is disabled if
+	// set to 0.
+	// Defaults to 5.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// Focus is a regular expression selecting the tests of the Kubernetes end-to-end test suite which are run.
+	// Defaults to `\[Conformance\]`.
+	// +optional
+	Focus *string `json:"focus,omitempty"`
+	// Skip is a regular expression selecting the tests of the Kubernetes end-to-end test suite which are skipped.
+	// Defaults to `\[Serial\]|\[Disruptive\]|\[Slow\]`.
+	// +optional
+	Skip *string `json:"skip,omitempty"`
+	// Timeout is the maximum duration of a conformance test run. Runs exceeding the timeout are aborted and reported as
+	// failed.
+	// Defaults to 2h.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -373,6 +567,130 @@ type StaleExtensionHealthChecks struct {
 	Threshold *metav1.Duration `json:"threshold,omitempty"`
 }
 
+// EtcdMemberRemediation configures the automated replacement of permanently failed etcd members.
+type EtcdMemberRemediation struct {
+	// Enabled specifies whether permanently failed members of highly available etcd clusters are replaced
+	// automatically.
+	Enabled bool `json:"enabled"`
+	// Threshold is the duration for which a member and its pod must have been not ready before the member is replaced.
+	// Defaults to 15m.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+}
+
+// Remediation configures the automatic remediation of well-understood failures which are detected by the health
+// checks of the shoot care controller.
+type Remediation struct {
+	// Enabled specifies whether failures are remediated automatically. It serves as kill switch for all remediation
+	// actions.
+	Enabled bool `json:"enabled"`
+	// Actions is the list of remediation actions which are performed if their health check fails.
+	// Defaults to all remediation actions.
+	// +optional
+	Actions []RemediationAction `json:"actions,omitempty"`
+	// Threshold is the duration for which a failure must have persisted before it is remediated.
+	// Defaults to 10m.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+	// MaxActionsPerShoot is the maximum number of remediation actions which are performed for a shoot within the
+	// RateLimitPeriod.
+	// Defaults to 3.
+	// +optional
+	MaxActionsPerShoot *int `json:"maxActionsPerShoot,omitempty"`
+	// RateLimitPeriod is the period for which MaxActionsPerShoot applies.
+	// Defaults to 1h.
+	// +optional
+	RateLimitPeriod *metav1.Duration `json:"rateLimitPeriod,omitempty"`
+}
+
+// RemediationAction is an action for remediating a well-understood failure.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartCrashLoopingControlPlanePod deletes a control plane pod in the seed which is
+	// crash-looping. It is performed if the ControlPlaneHealthy condition is not true.
+	RemediationActionRestartCrashLoopingControlPlanePod RemediationAction = "RestartCrashLoopingControlPlanePod"
+	// RemediationActionRetriggerFailedManagedResource triggers a new reconciliation of a ManagedResource in the seed
+	// whose resources could not be applied. It is performed if the ControlPlaneHealthy, SystemComponentsHealthy or
+	// ObservabilityComponentsHealthy condition is not true.
+	RemediationActionRetriggerFailedManagedResource RemediationAction = "RetriggerFailedManagedResource"
+	// RemediationActionRecreateBrokenVPNPod deletes a vpn-shoot pod in the shoot which is not ready. It is performed if
+	// the SystemComponentsHealthy condition is not true.
+	RemediationActionRecreateBrokenVPNPod RemediationAction = "RecreateBrokenVPNPod"
+)
+
+// ShootHealthChecks configures the checks which contribute to the health conditions of shoots.
+type ShootHealthChecks struct {
+	// DisabledChecks is a list of checks which do not contribute to the health conditions of shoots.
+	// +optional
+	DisabledChecks []ShootHealthCheck `json:"disabledChecks,omitempty"`
+	// NodeAgentLeaseStalenessThreshold is the duration after which a gardener-node-agent Lease which has not been
+	// renewed is considered stale. If not set, a Lease is considered stale once its lease duration has passed.
+	// +optional
+	NodeAgentLeaseStalenessThreshold *metav1.Duration `json:"nodeAgentLeaseStalenessThreshold,omitempty"`
+	// ExpiredNodeLeasesThresholdPercentage is the percentage of expired node Leases in the kube-node-lease namespace
+	// from which on the EveryNodeReady condition is considered unhealthy.
+	// Defaults to 20.
+	// +optional
+	ExpiredNodeLeasesThresholdPercentage *int `json:"expiredNodeLeasesThresholdPercentage,omitempty"`
+}
+
+// ShootHealthCheck is a check which contributes to the health conditions of shoots.
+type ShootHealthCheck string
+
+const (
+	// ShootHealthCheckTunnelConnection checks whether the VPN tunnel between the control plane and the shoot is
+	// established. It contributes to the SystemComponentsHealthy condition.
+	ShootHealthCheckTunnelConnection ShootHealthCheck = "TunnelConnection"
+	// ShootHealthCheckOperatingSystemConfig checks whether the latest operating system configuration was applied on all
+	// nodes. It contributes to the EveryNodeReady condition.
+	ShootHealthCheckOperatingSystemConfig ShootHealthCheck = "OperatingSystemConfig"
+	// ShootHealthCheckNodeAgentLeases checks whether gardener-node-agent is running on all nodes. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckNodeAgentLeases ShootHealthCheck = "NodeAgentLeases"
+	// ShootHealthCheckNodesScaling checks whether nodes are being scaled up or down. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckNodesScaling ShootHealthCheck = "NodesScaling"
+	// ShootHealthCheckExpiredNodeLeases checks whether too many node Leases are expired. It contributes to the
+	// EveryNodeReady condition.
+	ShootHealthCheckExpiredNodeLeases ShootHealthCheck = "ExpiredNodeLeases"
+)
+
+// ShootHibernationPreCheck is a check which is performed before a shoot is hibernated.
+type ShootHibernationPreCheck struct {
+	// Name is the name of the check.
+	Name ShootHibernationPreCheckName `json:"name"`
+	// Mode specifies whether a failing check blocks the hibernation or is only reported as a warning.
+	// Defaults to 'Warn'.
+	// +optional
+	Mode ShootHibernationPreCheckMode `json:"mode,omitempty"`
+}
+
+// ShootHibernationPreCheckName is the name of a check which is performed before a shoot is hibernated.
+type ShootHibernationPreCheckName string
+
+const (
+	// ShootHibernationPreCheckPendingVolumeDetachments checks whether volumes are still being detached from nodes of the
+	// shoot, i.e., whether there are VolumeAttachments which are being deleted or failed to detach.
+	ShootHibernationPreCheckPendingVolumeDetachments ShootHibernationPreCheckName = "PendingVolumeDetachments"
+	// ShootHibernationPreCheckCredentialsRotationInProgress checks whether a credentials rotation of the shoot is being
+	// prepared or completed.
+	ShootHibernationPreCheckCredentialsRotationInProgress ShootHibernationPreCheckName = "CredentialsRotationInProgress"
+	// ShootHibernationPreCheckPodDisruptionBudgets checks whether PodDisruptionBudgets which were flagged by the user
+	// with the 'hibernation.shoot.gardener.cloud/pre-check=true' label currently disallow any disruption.
+	ShootHibernationPreCheckPodDisruptionBudgets ShootHibernationPreCheckName = "PodDisruptionBudgets"
+)
+
+// ShootHibernationPreCheckMode specifies how a failing check is treated before a shoot is hibernated.
+type ShootHibernationPreCheckMode string
+
+const (
+	// ShootHibernationPreCheckModeWarn reports a failing check as a warning but does not block the hibernation.
+	ShootHibernationPreCheckModeWarn ShootHibernationPreCheckMode = "Warn"
+	// ShootHibernationPreCheckModeBlock blocks the hibernation if the check fails.
+	ShootHibernationPreCheckModeBlock ShootHibernationPreCheckMode = "Block"
+)
+
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
 type ConditionThreshold struct {
 	// Type is the type of the condition to define the threshold for.
@@ -421,6 +739,11 @@ type ManagedSeedControllerConfiguration struct {
 	// The applied jitterPeriod is taken from SyncJitterPeriod.
 	// +optional
 	JitterUpdates *bool `json:"jitterUpdates,omitempty"`
+	// DriftDetectionPeriod is the duration how often the actual seed and gardenlet configuration of managed seeds are
+	// compared against the declared configuration in order to detect drift. A value of 0 disables the drift detection.
+	// Defaults to 10m.
+	// +optional
+	DriftDetectionPeriod *metav1.Duration `json:"driftDetectionPeriod,omitempty"`
 }
 
 // TokenRequestorControllerConfiguration defines the configuration of the TokenRequestor controller.
@@ -527,6 +850,37 @@ type SNI struct {
 	// Ingress is the ingressgateway configuration.
 	// +optional
 	Ingress *SNIIngress `json:"ingress,omitempty"`
+	// Limits contains limits for the traffic of each shoot to its kube-apiserver through the ingressgateway. They
+	// protect the network path shared by all shoots exposed via the ingressgateway from a single shoot generating
+	// excessive load.
+	// +optional
+	Limits *SNILimits `json:"limits,omitempty"`
+}
+
+// SNILimits contains limits for the traffic of each shoot to its kube-apiserver through the ingressgateway.
+type SNILimits struct {
+	// Default contains the limits for all shoots.
+	// +optional
+	Default *SNIShootLimits `json:"default,omitempty"`
+	// Purposes contains the limits for shoots with the given purpose. They replace the default limits.
+	// +optional
+	Purposes map[gardencorev1beta1.ShootPurpose]SNIShootLimits `json:"purposes,omitempty"`
+}
+
+// SNIShootLimits contains limits for the traffic of a single shoot to its kube-apiserver through the ingressgateway.
+type SNIShootLimits struct {
+	// MaxConnections is the maximum number of concurrent connections to the kube-apiserver. Defaults to 5000.
+	// +optional
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+	// ConnectionsPerSecond is the maximum number of new connections per second to the kube-apiserver. Connections
+	// exceeding the limit are closed immediately.
+	// +optional
+	ConnectionsPerSecond *int32 `json:"connectionsPerSecond,omitempty"`
+	// ConnectionBufferLimit is the maximum amount of data which is buffered for each connection to the kube-apiserver.
+	// As the traffic is passed through without terminating TLS, the size of requests cannot be limited directly.
+	// Instead, large requests are slowed down to the pace of the kube-apiserver.
+	// +optional
+	ConnectionBufferLimit *resource.Quantity `json:"connectionBufferLimit,omitempty"`
 }
 
 // SNIIngress contains configuration of the ingressgateway.
@@ -650,6 +1004,19 @@ type MonitoringConfig struct {
 	// Shoot is optional and contains settings for the shoot monitoring stack.
 	// +optional
 	Shoot *ShootMonitoringConfig `json:"shoot,omitempty"`
+	// Seed is optional and contains settings for the seed monitoring stack.
+	// +optional
+	Seed *SeedMonitoringConfig `json:"seed,omitempty"`
+}
+
+// SeedMonitoringConfig contains settings for the seed monitoring stack.
+type SeedMonitoringConfig struct {
+	// RemoteWrite is optional and contains remote write settings for the aggregate Prometheus of the seed. It can be
+	// used to federate the aggregated shoot and seed metrics to a central Prometheus, e.g., the garden Prometheus.
+	// The basic auth credentials for the remote write endpoint are read from the secret with the
+	// `gardener.cloud/role=global-seed-remote-write-monitoring` label in the garden namespace of the garden cluster.
+	// +optional
+	RemoteWrite *RemoteWriteMonitoringConfig `json:"remoteWrite,omitempty"`
 }
 
 // ShootMonitoringConfig contains settings for the shoot monitoring stack.
@@ -731,3 +1098,73 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// PodSecurityConfiguration contains settings for the Pod Security Standards applied to the namespaces managed by the
+// gardenlet in the seed cluster.
+type PodSecurityConfiguration struct {
+	// GardenNamespaceLevel is the Pod Security Standard level enforced in the garden namespace.
+	// Defaults to `privileged`.
+	// +optional
+	GardenNamespaceLevel *string `json:"gardenNamespaceLevel,omitempty"`
+	// ShootNamespacesLevel is the Pod Security Standard level enforced in the shoot namespaces.
+	// Defaults to `privileged`.
+	// +optional
+	ShootNamespacesLevel *string `json:"shootNamespacesLevel,omitempty"`
+	// AuditLevel is the Pod Security Standard level which is audited and warned about in the managed namespaces. Pods
+	// violating this level are reported in the `SeedPodSecurityCompliant` condition of the Seed.
+	// Defaults to `baseline`.
+	// +optional
+	AuditLevel *string `json:"auditLevel,omitempty"`
+	// Exemptions is a list of label selectors for pods which are not reported in the `SeedPodSecurityCompliant`
+	// condition, e.g., because the respective component requires elevated privileges.
+	// +optional
+	Exemptions []metav1.LabelSelector `json:"exemptions,omitempty"`
+}
+
+// ArtifactCache contains settings for the pull-through cache for OCI artifacts (e.g., container images and Helm charts)
+// deployed by the gardenlet into the seed cluster.
+type ArtifactCache struct {
+	// Enabled specifies whether the artifact cache is deployed.
+	// Defaults to `false`.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Upstreams is the list of upstream registries which are cached.
+	// +optional
+	Upstreams []ArtifactCacheUpstream `json:"upstreams,omitempty"`
+	// StorageSize is the size of the volume used for caching the artifacts of each upstream.
+	// Defaults to `20Gi`.
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+	// GarbageCollectionTTL is the duration after which cached artifacts are removed from the cache.
+	// Defaults to `168h`.
+	// +optional
+	GarbageCollectionTTL *metav1.Duration `json:"garbageCollectionTTL,omitempty"`
+}
+
+// ArtifactCacheUpstream contains settings for an upstream registry which is cached.
+type ArtifactCacheUpstream struct {
+	// Host is the host of the upstream registry, e.g., `europe-docker.pkg.dev`.
+	Host string `json:"host"`
+	// RemoteURL is the URL of the upstream registry.
+	// Defaults to `https://<host>`.
+	// +optional
+	RemoteURL *string `json:"remoteURL,omitempty"`
+}
+
+// CertificateService contains settings for the Gardener-managed certificate service for shoot clusters.
+type CertificateService struct {
+	// ACME contains the settings of the ACME server used for issuing the certificates of shoot clusters.
+	ACME CertificateServiceACME `json:"acme"`
+}
+
+// CertificateServiceACME contains the settings of an ACME server.
+type CertificateServiceACME struct {
+	// Email is the e-mail address used for registering at the ACME server.
+	Email string `json:"email"`
+	// Server is the URL of the ACME directory, e.g., `https://acme-v02.api.letsencrypt.org/directory`.
+	Server string `json:"server"`
+	// PrecheckNameservers overwrites the nameservers used for checking the DNS propagation of the challenges.
+	// Format `host` or `host:port`, e.g., `8.8.8.8` or `8.8.8.8:53`.
+	// +optional
+	PrecheckNameservers []string `json:"precheckNameservers,omitempty"`
+}
@@ -31,6 +31,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdaptiveSyncPeriod)(nil), (*config.AdaptiveSyncPeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdaptiveSyncPeriod_To_config_AdaptiveSyncPeriod(a.(*AdaptiveSyncPeriod), b.(*config.AdaptiveSyncPeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AdaptiveSyncPeriod)(nil), (*AdaptiveSyncPeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AdaptiveSyncPeriod_To_v1alpha1_AdaptiveSyncPeriod(a.(*config.AdaptiveSyncPeriod), b.(*AdaptiveSyncPeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdaptiveSyncPeriodPurpose)(nil), (*config.AdaptiveSyncPeriodPurpose)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdaptiveSyncPeriodPurpose_To_config_AdaptiveSyncPeriodPurpose(a.(*AdaptiveSyncPeriodPurpose), b.(*config.AdaptiveSyncPeriodPurpose), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AdaptiveSyncPeriodPurpose)(nil), (*AdaptiveSyncPeriodPurpose)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AdaptiveSyncPeriodPurpose_To_v1alpha1_AdaptiveSyncPeriodPurpose(a.(*config.AdaptiveSyncPeriodPurpose), b.(*AdaptiveSyncPeriodPurpose), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArtifactCache)(nil), (*config.ArtifactCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArtifactCache_To_config_ArtifactCache(a.(*ArtifactCache), b.(*config.ArtifactCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArtifactCache)(nil), (*ArtifactCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArtifactCache_To_v1alpha1_ArtifactCache(a.(*config.ArtifactCache), b.(*ArtifactCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArtifactCacheUpstream)(nil), (*config.ArtifactCacheUpstream)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArtifactCacheUpstream_To_config_ArtifactCacheUpstream(a.(*ArtifactCacheUpstream), b.(*config.ArtifactCacheUpstream), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArtifactCacheUpstream)(nil), (*ArtifactCacheUpstream)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArtifactCacheUpstream_To_v1alpha1_ArtifactCacheUpstream(a.(*config.ArtifactCacheUpstream), b.(*ArtifactCacheUpstream), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketControllerConfiguration)(nil), (*config.BackupBucketControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(a.(*BackupBucketControllerConfiguration), b.(*config.BackupBucketControllerConfiguration), scope)
 	}); err != nil {
@@ -71,6 +111,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateService)(nil), (*config.CertificateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateService_To_config_CertificateService(a.(*CertificateService), b.(*config.CertificateService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CertificateService)(nil), (*CertificateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CertificateService_To_v1alpha1_CertificateService(a.(*config.CertificateService), b.(*CertificateService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateServiceACME)(nil), (*config.CertificateServiceACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME(a.(*CertificateServiceACME), b.(*config.CertificateServiceACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CertificateServiceACME)(nil), (*CertificateServiceACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME(a.(*config.CertificateServiceACME), b.(*CertificateServiceACME), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -151,6 +211,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdMemberRemediation)(nil), (*config.EtcdMemberRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EtcdMemberRemediation_To_config_EtcdMemberRemediation(a.(*EtcdMemberRemediation), b.(*config.EtcdMemberRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EtcdMemberRemediation)(nil), (*EtcdMemberRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EtcdMemberRemediation_To_v1alpha1_EtcdMemberRemediation(a.(*config.EtcdMemberRemediation), b.(*EtcdMemberRemediation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassHandler)(nil), (*config.ExposureClassHandler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(a.(*ExposureClassHandler), b.(*config.ExposureClassHandler), scope)
 	}); err != nil {
@@ -211,6 +281,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageVerification)(nil), (*config.ImageVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageVerification_To_config_ImageVerification(a.(*ImageVerification), b.(*config.ImageVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageVerification)(nil), (*ImageVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageVerification_To_v1alpha1_ImageVerification(a.(*config.ImageVerification), b.(*ImageVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeconfigValidity)(nil), (*config.KubeconfigValidity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(a.(*KubeconfigValidity), b.(*config.KubeconfigValidity), scope)
 	}); err != nil {
@@ -281,6 +361,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityConfiguration)(nil), (*config.PodSecurityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodSecurityConfiguration_To_config_PodSecurityConfiguration(a.(*PodSecurityConfiguration), b.(*config.PodSecurityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodSecurityConfiguration)(nil), (*PodSecurityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodSecurityConfiguration_To_v1alpha1_PodSecurityConfiguration(a.(*config.PodSecurityConfiguration), b.(*PodSecurityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Remediation)(nil), (*config.Remediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Remediation_To_config_Remediation(a.(*Remediation), b.(*config.Remediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Remediation)(nil), (*Remediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Remediation_To_v1alpha1_Remediation(a.(*config.Remediation), b.(*Remediation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteWriteMonitoringConfig)(nil), (*config.RemoteWriteMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(a.(*RemoteWriteMonitoringConfig), b.(*config.RemoteWriteMonitoringConfig), scope)
 	}); err != nil {
@@ -321,6 +421,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SNILimits)(nil), (*config.SNILimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SNILimits_To_config_SNILimits(a.(*SNILimits), b.(*config.SNILimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SNILimits)(nil), (*SNILimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SNILimits_To_v1alpha1_SNILimits(a.(*config.SNILimits), b.(*SNILimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SNIShootLimits)(nil), (*config.SNIShootLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SNIShootLimits_To_config_SNIShootLimits(a.(*SNIShootLimits), b.(*config.SNIShootLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SNIShootLimits)(nil), (*SNIShootLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SNIShootLimits_To_v1alpha1_SNIShootLimits(a.(*config.SNIShootLimits), b.(*SNIShootLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedCapacityControllerConfiguration)(nil), (*config.SeedCapacityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedCapacityControllerConfiguration_To_config_SeedCapacityControllerConfiguration(a.(*SeedCapacityControllerConfiguration), b.(*config.SeedCapacityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedCapacityControllerConfiguration)(nil), (*SeedCapacityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedCapacityControllerConfiguration_To_v1alpha1_SeedCapacityControllerConfiguration(a.(*config.SeedCapacityControllerConfiguration), b.(*SeedCapacityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCareControllerConfiguration)(nil), (*config.SeedCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(a.(*SeedCareControllerConfiguration), b.(*config.SeedCareControllerConfiguration), scope)
 	}); err != nil {
@@ -361,6 +491,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedMonitoringConfig)(nil), (*config.SeedMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedMonitoringConfig_To_config_SeedMonitoringConfig(a.(*SeedMonitoringConfig), b.(*config.SeedMonitoringConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedMonitoringConfig)(nil), (*SeedMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedMonitoringConfig_To_v1alpha1_SeedMonitoringConfig(a.(*config.SeedMonitoringConfig), b.(*SeedMonitoringConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -401,6 +541,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootConformanceTestControllerConfiguration)(nil), (*config.ShootConformanceTestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootConformanceTestControllerConfiguration_To_config_ShootConformanceTestControllerConfiguration(a.(*ShootConformanceTestControllerConfiguration), b.(*config.ShootConformanceTestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootConformanceTestControllerConfiguration)(nil), (*ShootConformanceTestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootConformanceTestControllerConfiguration_To_v1alpha1_ShootConformanceTestControllerConfiguration(a.(*config.ShootConformanceTestControllerConfiguration), b.(*ShootConformanceTestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootControllerConfiguration)(nil), (*config.ShootControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(a.(*ShootControllerConfiguration), b.(*config.ShootControllerConfiguration), scope)
 	}); err != nil {
@@ -421,6 +571,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootFlowConcurrency)(nil), (*config.ShootFlowConcurrency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootFlowConcurrency_To_config_ShootFlowConcurrency(a.(*ShootFlowConcurrency), b.(*config.ShootFlowConcurrency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootFlowConcurrency)(nil), (*ShootFlowConcurrency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootFlowConcurrency_To_v1alpha1_ShootFlowConcurrency(a.(*config.ShootFlowConcurrency), b.(*ShootFlowConcurrency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootHealthChecks)(nil), (*config.ShootHealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootHealthChecks_To_config_ShootHealthChecks(a.(*ShootHealthChecks), b.(*config.ShootHealthChecks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootHealthChecks)(nil), (*ShootHealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootHealthChecks_To_v1alpha1_ShootHealthChecks(a.(*config.ShootHealthChecks), b.(*ShootHealthChecks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootHibernationPreCheck)(nil), (*config.ShootHibernationPreCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootHibernationPreCheck_To_config_ShootHibernationPreCheck(a.(*ShootHibernationPreCheck), b.(*config.ShootHibernationPreCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootHibernationPreCheck)(nil), (*ShootHibernationPreCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootHibernationPreCheck_To_v1alpha1_ShootHibernationPreCheck(a.(*config.ShootHibernationPreCheck), b.(*ShootHibernationPreCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMonitoringConfig)(nil), (*config.ShootMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(a.(*ShootMonitoringConfig), b.(*config.ShootMonitoringConfig), scope)
 	}); err != nil {
@@ -441,6 +621,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootProjectConcurrency)(nil), (*config.ShootProjectConcurrency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootProjectConcurrency_To_config_ShootProjectConcurrency(a.(*ShootProjectConcurrency), b.(*config.ShootProjectConcurrency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootProjectConcurrency)(nil), (*ShootProjectConcurrency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootProjectConcurrency_To_v1alpha1_ShootProjectConcurrency(a.(*config.ShootProjectConcurrency), b.(*ShootProjectConcurrency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootResourceUsageControllerConfiguration)(nil), (*config.ShootResourceUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootResourceUsageControllerConfiguration_To_config_ShootResourceUsageControllerConfiguration(a.(*ShootResourceUsageControllerConfiguration), b.(*config.ShootResourceUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootResourceUsageControllerConfiguration)(nil), (*ShootResourceUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootResourceUsageControllerConfiguration_To_v1alpha1_ShootResourceUsageControllerConfiguration(a.(*config.ShootResourceUsageControllerConfiguration), b.(*ShootResourceUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootStateControllerConfiguration)(nil), (*config.ShootStateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(a.(*ShootStateControllerConfiguration), b.(*config.ShootStateControllerConfiguration), scope)
 	}); err != nil {
@@ -504,6 +704,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AdaptiveSyncPeriod_To_config_AdaptiveSyncPeriod(in *AdaptiveSyncPeriod, out *config.AdaptiveSyncPeriod, s conversion.Scope) error {
+	out.HealthySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthySyncPeriod))
+	out.DegradedSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.DegradedSyncPeriod))
+	out.StabilizationPeriod = (*v1.Duration)(unsafe.Pointer(in.StabilizationPeriod))
+	out.JitterPercentage = (*int32)(unsafe.Pointer(in.JitterPercentage))
+	out.Purposes = *(*[]config.AdaptiveSyncPeriodPurpose)(unsafe.Pointer(&in.Purposes))
+	return nil
+}
+
+// Convert_v1alpha1_AdaptiveSyncPeriod_To_config_AdaptiveSyncPeriod is an autogenerated conversion function.
+func Convert_v1alpha1_AdaptiveSyncPeriod_To_config_AdaptiveSyncPeriod(in *AdaptiveSyncPeriod, out *config.AdaptiveSyncPeriod, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdaptiveSyncPeriod_To_config_AdaptiveSyncPeriod(in, out, s)
+}
+
+func autoConvert_config_AdaptiveSyncPeriod_To_v1alpha1_AdaptiveSyncPeriod(in *config.AdaptiveSyncPeriod, out *AdaptiveSyncPeriod, s conversion.Scope) error {
+	out.HealthySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthySyncPeriod))
+	out.DegradedSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.DegradedSyncPeriod))
+	out.StabilizationPeriod = (*v1.Duration)(unsafe.Pointer(in.StabilizationPeriod))
+	out.JitterPercentage = (*int32)(unsafe.Pointer(in.JitterPercentage))
+	out.Purposes = *(*[]AdaptiveSyncPeriodPurpose)(unsafe.Pointer(&in.Purposes))
+	return nil
+}
+
+// Convert_config_AdaptiveSyncPeriod_To_v1alpha1_AdaptiveSyncPeriod is an autogenerated conversion function.
+func Convert_config_AdaptiveSyncPeriod_To_v1alpha1_AdaptiveSyncPeriod(in *config.AdaptiveSyncPeriod, out *AdaptiveSyncPeriod, s conversion.Scope) error {
+	return autoConvert_config_AdaptiveSyncPeriod_To_v1alpha1_AdaptiveSyncPeriod(in, out, s)
+}
+
+func autoConvert_v1alpha1_AdaptiveSyncPeriodPurpose_To_config_AdaptiveSyncPeriodPurpose(in *AdaptiveSyncPeriodPurpose, out *config.AdaptiveSyncPeriodPurpose, s conversion.Scope) error {
+	out.Purpose = core.ShootPurpose(in.Purpose)
+	out.HealthySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthySyncPeriod))
+	out.DegradedSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.DegradedSyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_AdaptiveSyncPeriodPurpose_To_config_AdaptiveSyncPeriodPurpose is an autogenerated conversion function.
+func Convert_v1alpha1_AdaptiveSyncPeriodPurpose_To_config_AdaptiveSyncPeriodPurpose(in *AdaptiveSyncPeriodPurpose, out *config.AdaptiveSyncPeriodPurpose, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdaptiveSyncPeriodPurpose_To_config_AdaptiveSyncPeriodPurpose(in, out, s)
+}
+
+func autoConvert_config_AdaptiveSyncPeriodPurpose_To_v1alpha1_AdaptiveSyncPeriodPurpose(in *config.AdaptiveSyncPeriodPurpose, out *AdaptiveSyncPeriodPurpose, s conversion.Scope) error {
+	out.Purpose = v1beta1.ShootPurpose(in.Purpose)
+	out.HealthySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthySyncPeriod))
+	out.DegradedSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.DegradedSyncPeriod))
+	return nil
+}
+
+// Convert_config_AdaptiveSyncPeriodPurpose_To_v1alpha1_AdaptiveSyncPeriodPurpose is an autogenerated conversion function.
+func Convert_config_AdaptiveSyncPeriodPurpose_To_v1alpha1_AdaptiveSyncPeriodPurpose(in *config.AdaptiveSyncPeriodPurpose, out *AdaptiveSyncPeriodPurpose, s conversion.Scope) error {
+	return autoConvert_config_AdaptiveSyncPeriodPurpose_To_v1alpha1_AdaptiveSyncPeriodPurpose(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArtifactCache_To_config_ArtifactCache(in *ArtifactCache, out *config.ArtifactCache, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Upstreams = *(*[]config.ArtifactCacheUpstream)(unsafe.Pointer(&in.Upstreams))
+	out.StorageSize = (*resource.Quantity)(unsafe.Pointer(in.StorageSize))
+	out.GarbageCollectionTTL = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionTTL))
+	return nil
+}
+
+// Convert_v1alpha1_ArtifactCache_To_config_ArtifactCache is an autogenerated conversion function.
+func Convert_v1alpha1_ArtifactCache_To_config_ArtifactCache(in *ArtifactCache, out *config.ArtifactCache, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArtifactCache_To_config_ArtifactCache(in, out, s)
+}
+
+func autoConvert_config_ArtifactCache_To_v1alpha1_ArtifactCache(in *config.ArtifactCache, out *ArtifactCache, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Upstreams = *(*[]ArtifactCacheUpstream)(unsafe.Pointer(&in.Upstreams))
+	out.StorageSize = (*resource.Quantity)(unsafe.Pointer(in.StorageSize))
+	out.GarbageCollectionTTL = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionTTL))
+	return nil
+}
+
+// Convert_config_ArtifactCache_To_v1alpha1_ArtifactCache is an autogenerated conversion function.
+func Convert_config_ArtifactCache_To_v1alpha1_ArtifactCache(in *config.ArtifactCache, out *ArtifactCache, s conversion.Scope) error {
+	return autoConvert_config_ArtifactCache_To_v1alpha1_ArtifactCache(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArtifactCacheUpstream_To_config_ArtifactCacheUpstream(in *ArtifactCacheUpstream, out *config.ArtifactCacheUpstream, s conversion.Scope) error {
+	out.Host = in.Host
+	out.RemoteURL = (*string)(unsafe.Pointer(in.RemoteURL))
+	return nil
+}
+
+// Convert_v1alpha1_ArtifactCacheUpstream_To_config_ArtifactCacheUpstream is an autogenerated conversion function.
+func Convert_v1alpha1_ArtifactCacheUpstream_To_config_ArtifactCacheUpstream(in *ArtifactCacheUpstream, out *config.ArtifactCacheUpstream, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArtifactCacheUpstream_To_config_ArtifactCacheUpstream(in, out, s)
+}
+
+func autoConvert_config_ArtifactCacheUpstream_To_v1alpha1_ArtifactCacheUpstream(in *config.ArtifactCacheUpstream, out *ArtifactCacheUpstream, s conversion.Scope) error {
+	out.Host = in.Host
+	out.RemoteURL = (*string)(unsafe.Pointer(in.RemoteURL))
+	return nil
+}
+
+// Convert_config_ArtifactCacheUpstream_To_v1alpha1_ArtifactCacheUpstream is an autogenerated conversion function.
+func Convert_config_ArtifactCacheUpstream_To_v1alpha1_ArtifactCacheUpstream(in *config.ArtifactCacheUpstream, out *ArtifactCacheUpstream, s conversion.Scope) error {
+	return autoConvert_config_ArtifactCacheUpstream_To_v1alpha1_ArtifactCacheUpstream(in, out, s)
+}
+
 func autoConvert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(in *BackupBucketControllerConfiguration, out *config.BackupBucketControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -596,6 +896,54 @@ func Convert_config_BastionControllerConfiguration_To_v1alpha1_BastionController
 	return autoConvert_config_BastionControllerConfiguration_To_v1alpha1_BastionControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CertificateService_To_config_CertificateService(in *CertificateService, out *config.CertificateService, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME(&in.ACME, &out.ACME, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CertificateService_To_config_CertificateService is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateService_To_config_CertificateService(in *CertificateService, out *config.CertificateService, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateService_To_config_CertificateService(in, out, s)
+}
+
+func autoConvert_config_CertificateService_To_v1alpha1_CertificateService(in *config.CertificateService, out *CertificateService, s conversion.Scope) error {
+	if err := Convert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME(&in.ACME, &out.ACME, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CertificateService_To_v1alpha1_CertificateService is an autogenerated conversion function.
+func Convert_config_CertificateService_To_v1alpha1_CertificateService(in *config.CertificateService, out *CertificateService, s conversion.Scope) error {
+	return autoConvert_config_CertificateService_To_v1alpha1_CertificateService(in, out, s)
+}
+
+func autoConvert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME(in *CertificateServiceACME, out *config.CertificateServiceACME, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Server = in.Server
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	return nil
+}
+
+// Convert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME(in *CertificateServiceACME, out *config.CertificateServiceACME, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateServiceACME_To_config_CertificateServiceACME(in, out, s)
+}
+
+func autoConvert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME(in *config.CertificateServiceACME, out *CertificateServiceACME, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Server = in.Server
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	return nil
+}
+
+// Convert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME is an autogenerated conversion function.
+func Convert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME(in *config.CertificateServiceACME, out *CertificateServiceACME, s conversion.Scope) error {
+	return autoConvert_config_CertificateServiceACME_To_v1alpha1_CertificateServiceACME(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
@@ -772,6 +1120,28 @@ func Convert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETCDCon
 	return autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in, out, s)
 }
 
+func autoConvert_v1alpha1_EtcdMemberRemediation_To_config_EtcdMemberRemediation(in *EtcdMemberRemediation, out *config.EtcdMemberRemediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_v1alpha1_EtcdMemberRemediation_To_config_EtcdMemberRemediation is an autogenerated conversion function.
+func Convert_v1alpha1_EtcdMemberRemediation_To_config_EtcdMemberRemediation(in *EtcdMemberRemediation, out *config.EtcdMemberRemediation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EtcdMemberRemediation_To_config_EtcdMemberRemediation(in, out, s)
+}
+
+func autoConvert_config_EtcdMemberRemediation_To_v1alpha1_EtcdMemberRemediation(in *config.EtcdMemberRemediation, out *EtcdMemberRemediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_config_EtcdMemberRemediation_To_v1alpha1_EtcdMemberRemediation is an autogenerated conversion function.
+func Convert_config_EtcdMemberRemediation_To_v1alpha1_EtcdMemberRemediation(in *config.EtcdMemberRemediation, out *EtcdMemberRemediation, s conversion.Scope) error {
+	return autoConvert_config_EtcdMemberRemediation_To_v1alpha1_EtcdMemberRemediation(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(in *ExposureClassHandler, out *config.ExposureClassHandler, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_LoadBalancerServiceConfig_To_config_LoadBalancerServiceConfig(&in.LoadBalancerService, &out.LoadBalancerService, s); err != nil {
@@ -809,6 +1179,7 @@ func autoConvert_v1alpha1_GardenClientConnection_To_config_GardenClientConnectio
 	out.BootstrapKubeconfig = (*corev1.SecretReference)(unsafe.Pointer(in.BootstrapKubeconfig))
 	out.KubeconfigSecret = (*corev1.SecretReference)(unsafe.Pointer(in.KubeconfigSecret))
 	out.KubeconfigValidity = (*config.KubeconfigValidity)(unsafe.Pointer(in.KubeconfigValidity))
+	out.TokenFile = (*string)(unsafe.Pointer(in.TokenFile))
 	return nil
 }
 
@@ -826,6 +1197,7 @@ func autoConvert_config_GardenClientConnection_To_v1alpha1_GardenClientConnectio
 	out.BootstrapKubeconfig = (*corev1.SecretReference)(unsafe.Pointer(in.BootstrapKubeconfig))
 	out.KubeconfigSecret = (*corev1.SecretReference)(unsafe.Pointer(in.KubeconfigSecret))
 	out.KubeconfigValidity = (*KubeconfigValidity)(unsafe.Pointer(in.KubeconfigValidity))
+	out.TokenFile = (*string)(unsafe.Pointer(in.TokenFile))
 	return nil
 }
 
@@ -923,6 +1295,9 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.PodSecurity = (*config.PodSecurityConfiguration)(unsafe.Pointer(in.PodSecurity))
+	out.ArtifactCache = (*config.ArtifactCache)(unsafe.Pointer(in.ArtifactCache))
+	out.CertificateService = (*config.CertificateService)(unsafe.Pointer(in.CertificateService))
 	return nil
 }
 
@@ -1000,6 +1375,9 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.PodSecurity = (*PodSecurityConfiguration)(unsafe.Pointer(in.PodSecurity))
+	out.ArtifactCache = (*ArtifactCache)(unsafe.Pointer(in.ArtifactCache))
+	out.CertificateService = (*CertificateService)(unsafe.Pointer(in.CertificateService))
 	return nil
 }
 
@@ -1018,9 +1396,12 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.Gardenlet = (*config.GardenletObjectControllerConfiguration)(unsafe.Pointer(in.Gardenlet))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedCare = (*config.SeedCareControllerConfiguration)(unsafe.Pointer(in.SeedCare))
+	out.SeedCapacity = (*config.SeedCapacityControllerConfiguration)(unsafe.Pointer(in.SeedCapacity))
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootResourceUsage = (*config.ShootResourceUsageControllerConfiguration)(unsafe.Pointer(in.ShootResourceUsage))
+	out.ShootConformanceTest = (*config.ShootConformanceTestControllerConfiguration)(unsafe.Pointer(in.ShootConformanceTest))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*config.TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1043,9 +1424,12 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.Gardenlet = (*GardenletObjectControllerConfiguration)(unsafe.Pointer(in.Gardenlet))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedCare = (*SeedCareControllerConfiguration)(unsafe.Pointer(in.SeedCare))
+	out.SeedCapacity = (*SeedCapacityControllerConfiguration)(unsafe.Pointer(in.SeedCapacity))
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootResourceUsage = (*ShootResourceUsageControllerConfiguration)(unsafe.Pointer(in.ShootResourceUsage))
+	out.ShootConformanceTest = (*ShootConformanceTestControllerConfiguration)(unsafe.Pointer(in.ShootConformanceTest))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1078,6 +1462,30 @@ func Convert_config_GardenletObjectControllerConfiguration_To_v1alpha1_Gardenlet
 	return autoConvert_config_GardenletObjectControllerConfiguration_To_v1alpha1_GardenletObjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageVerification_To_config_ImageVerification(in *ImageVerification, out *config.ImageVerification, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.PullSecretRefs = *(*[]corev1.SecretReference)(unsafe.Pointer(&in.PullSecretRefs))
+	return nil
+}
+
+// Convert_v1alpha1_ImageVerification_To_config_ImageVerification is an autogenerated conversion function.
+func Convert_v1alpha1_ImageVerification_To_config_ImageVerification(in *ImageVerification, out *config.ImageVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageVerification_To_config_ImageVerification(in, out, s)
+}
+
+func autoConvert_config_ImageVerification_To_v1alpha1_ImageVerification(in *config.ImageVerification, out *ImageVerification, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.PullSecretRefs = *(*[]corev1.SecretReference)(unsafe.Pointer(&in.PullSecretRefs))
+	return nil
+}
+
+// Convert_config_ImageVerification_To_v1alpha1_ImageVerification is an autogenerated conversion function.
+func Convert_config_ImageVerification_To_v1alpha1_ImageVerification(in *config.ImageVerification, out *ImageVerification, s conversion.Scope) error {
+	return autoConvert_config_ImageVerification_To_v1alpha1_ImageVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(in *KubeconfigValidity, out *config.KubeconfigValidity, s conversion.Scope) error {
 	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
 	out.AutoRotationJitterPercentageMin = (*int32)(unsafe.Pointer(in.AutoRotationJitterPercentageMin))
@@ -1154,6 +1562,7 @@ func autoConvert_v1alpha1_ManagedSeedControllerConfiguration_To_config_ManagedSe
 	out.WaitSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.WaitSyncPeriod))
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.JitterUpdates = (*bool)(unsafe.Pointer(in.JitterUpdates))
+	out.DriftDetectionPeriod = (*v1.Duration)(unsafe.Pointer(in.DriftDetectionPeriod))
 	return nil
 }
 
@@ -1168,6 +1577,7 @@ func autoConvert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSe
 	out.WaitSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.WaitSyncPeriod))
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.JitterUpdates = (*bool)(unsafe.Pointer(in.JitterUpdates))
+	out.DriftDetectionPeriod = (*v1.Duration)(unsafe.Pointer(in.DriftDetectionPeriod))
 	return nil
 }
 
@@ -1178,6 +1588,7 @@ func Convert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSeedCo
 
 func autoConvert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(in *MonitoringConfig, out *config.MonitoringConfig, s conversion.Scope) error {
 	out.Shoot = (*config.ShootMonitoringConfig)(unsafe.Pointer(in.Shoot))
+	out.Seed = (*config.SeedMonitoringConfig)(unsafe.Pointer(in.Seed))
 	return nil
 }
 
@@ -1188,6 +1599,7 @@ func Convert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(in *Monitoring
 
 func autoConvert_config_MonitoringConfig_To_v1alpha1_MonitoringConfig(in *config.MonitoringConfig, out *MonitoringConfig, s conversion.Scope) error {
 	out.Shoot = (*ShootMonitoringConfig)(unsafe.Pointer(in.Shoot))
+	out.Seed = (*SeedMonitoringConfig)(unsafe.Pointer(in.Seed))
 	return nil
 }
 
@@ -1240,6 +1652,60 @@ func Convert_config_NodeToleration_To_v1alpha1_NodeToleration(in *config.NodeTol
 	return autoConvert_config_NodeToleration_To_v1alpha1_NodeToleration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodSecurityConfiguration_To_config_PodSecurityConfiguration(in *PodSecurityConfiguration, out *config.PodSecurityConfiguration, s conversion.Scope) error {
+	out.GardenNamespaceLevel = (*string)(unsafe.Pointer(in.GardenNamespaceLevel))
+	out.ShootNamespacesLevel = (*string)(unsafe.Pointer(in.ShootNamespacesLevel))
+	out.AuditLevel = (*string)(unsafe.Pointer(in.AuditLevel))
+	out.Exemptions = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.Exemptions))
+	return nil
+}
+
+// Convert_v1alpha1_PodSecurityConfiguration_To_config_PodSecurityConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PodSecurityConfiguration_To_config_PodSecurityConfiguration(in *PodSecurityConfiguration, out *config.PodSecurityConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodSecurityConfiguration_To_config_PodSecurityConfiguration(in, out, s)
+}
+
+func autoConvert_config_PodSecurityConfiguration_To_v1alpha1_PodSecurityConfiguration(in *config.PodSecurityConfiguration, out *PodSecurityConfiguration, s conversion.Scope) error {
+	out.GardenNamespaceLevel = (*string)(unsafe.Pointer(in.GardenNamespaceLevel))
+	out.ShootNamespacesLevel = (*string)(unsafe.Pointer(in.ShootNamespacesLevel))
+	out.AuditLevel = (*string)(unsafe.Pointer(in.AuditLevel))
+	out.Exemptions = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.Exemptions))
+	return nil
+}
+
+// Convert_config_PodSecurityConfiguration_To_v1alpha1_PodSecurityConfiguration is an autogenerated conversion function.
+func Convert_config_PodSecurityConfiguration_To_v1alpha1_PodSecurityConfiguration(in *config.PodSecurityConfiguration, out *PodSecurityConfiguration, s conversion.Scope) error {
+	return autoConvert_config_PodSecurityConfiguration_To_v1alpha1_PodSecurityConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Remediation_To_config_Remediation(in *Remediation, out *config.Remediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Actions = *(*[]config.RemediationAction)(unsafe.Pointer(&in.Actions))
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	out.MaxActionsPerShoot = (*int)(unsafe.Pointer(in.MaxActionsPerShoot))
+	out.RateLimitPeriod = (*v1.Duration)(unsafe.Pointer(in.RateLimitPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_Remediation_To_config_Remediation is an autogenerated conversion function.
+func Convert_v1alpha1_Remediation_To_config_Remediation(in *Remediation, out *config.Remediation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Remediation_To_config_Remediation(in, out, s)
+}
+
+func autoConvert_config_Remediation_To_v1alpha1_Remediation(in *config.Remediation, out *Remediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Actions = *(*[]RemediationAction)(unsafe.Pointer(&in.Actions))
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	out.MaxActionsPerShoot = (*int)(unsafe.Pointer(in.MaxActionsPerShoot))
+	out.RateLimitPeriod = (*v1.Duration)(unsafe.Pointer(in.RateLimitPeriod))
+	return nil
+}
+
+// Convert_config_Remediation_To_v1alpha1_Remediation is an autogenerated conversion function.
+func Convert_config_Remediation_To_v1alpha1_Remediation(in *config.Remediation, out *Remediation, s conversion.Scope) error {
+	return autoConvert_config_Remediation_To_v1alpha1_Remediation(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(in *RemoteWriteMonitoringConfig, out *config.RemoteWriteMonitoringConfig, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
@@ -1286,6 +1752,7 @@ func Convert_config_ResourcesConfiguration_To_v1alpha1_ResourcesConfiguration(in
 
 func autoConvert_v1alpha1_SNI_To_config_SNI(in *SNI, out *config.SNI, s conversion.Scope) error {
 	out.Ingress = (*config.SNIIngress)(unsafe.Pointer(in.Ingress))
+	out.Limits = (*config.SNILimits)(unsafe.Pointer(in.Limits))
 	return nil
 }
 
@@ -1296,6 +1763,7 @@ func Convert_v1alpha1_SNI_To_config_SNI(in *SNI, out *config.SNI, s conversion.S
 
 func autoConvert_config_SNI_To_v1alpha1_SNI(in *config.SNI, out *SNI, s conversion.Scope) error {
 	out.Ingress = (*SNIIngress)(unsafe.Pointer(in.Ingress))
+	out.Limits = (*SNILimits)(unsafe.Pointer(in.Limits))
 	return nil
 }
 
@@ -1330,9 +1798,78 @@ func Convert_config_SNIIngress_To_v1alpha1_SNIIngress(in *config.SNIIngress, out
 	return autoConvert_config_SNIIngress_To_v1alpha1_SNIIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_SNILimits_To_config_SNILimits(in *SNILimits, out *config.SNILimits, s conversion.Scope) error {
+	out.Default = (*config.SNIShootLimits)(unsafe.Pointer(in.Default))
+	out.Purposes = *(*map[core.ShootPurpose]config.SNIShootLimits)(unsafe.Pointer(&in.Purposes))
+	return nil
+}
+
+// Convert_v1alpha1_SNILimits_To_config_SNILimits is an autogenerated conversion function.
+func Convert_v1alpha1_SNILimits_To_config_SNILimits(in *SNILimits, out *config.SNILimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SNILimits_To_config_SNILimits(in, out, s)
+}
+
+func autoConvert_config_SNILimits_To_v1alpha1_SNILimits(in *config.SNILimits, out *SNILimits, s conversion.Scope) error {
+	out.Default = (*SNIShootLimits)(unsafe.Pointer(in.Default))
+	out.Purposes = *(*map[v1beta1.ShootPurpose]SNIShootLimits)(unsafe.Pointer(&in.Purposes))
+	return nil
+}
+
+// Convert_config_SNILimits_To_v1alpha1_SNILimits is an autogenerated conversion function.
+func Convert_config_SNILimits_To_v1alpha1_SNILimits(in *config.SNILimits, out *SNILimits, s conversion.Scope) error {
+	return autoConvert_config_SNILimits_To_v1alpha1_SNILimits(in, out, s)
+}
+
+func autoConvert_v1alpha1_SNIShootLimits_To_config_SNIShootLimits(in *SNIShootLimits, out *config.SNIShootLimits, s conversion.Scope) error {
+	out.MaxConnections = (*int32)(unsafe.Pointer(in.MaxConnections))
+	out.ConnectionsPerSecond = (*int32)(unsafe.Pointer(in.ConnectionsPerSecond))
+	out.ConnectionBufferLimit = (*resource.Quantity)(unsafe.Pointer(in.ConnectionBufferLimit))
+	return nil
+}
+
+// Convert_v1alpha1_SNIShootLimits_To_config_SNIShootLimits is an autogenerated conversion function.
+func Convert_v1alpha1_SNIShootLimits_To_config_SNIShootLimits(in *SNIShootLimits, out *config.SNIShootLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SNIShootLimits_To_config_SNIShootLimits(in, out, s)
+}
+
+func autoConvert_config_SNIShootLimits_To_v1alpha1_SNIShootLimits(in *config.SNIShootLimits, out *SNIShootLimits, s conversion.Scope) error {
+	out.MaxConnections = (*int32)(unsafe.Pointer(in.MaxConnections))
+	out.ConnectionsPerSecond = (*int32)(unsafe.Pointer(in.ConnectionsPerSecond))
+	out.ConnectionBufferLimit = (*resource.Quantity)(unsafe.Pointer(in.ConnectionBufferLimit))
+	return nil
+}
+
+// Convert_config_SNIShootLimits_To_v1alpha1_SNIShootLimits is an autogenerated conversion function.
+func Convert_config_SNIShootLimits_To_v1alpha1_SNIShootLimits(in *config.SNIShootLimits, out *SNIShootLimits, s conversion.Scope) error {
+	return autoConvert_config_SNIShootLimits_To_v1alpha1_SNIShootLimits(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedCapacityControllerConfiguration_To_config_SeedCapacityControllerConfiguration(in *SeedCapacityControllerConfiguration, out *config.SeedCapacityControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.LoadBalancerQuota = (*int32)(unsafe.Pointer(in.LoadBalancerQuota))
+	return nil
+}
+
+// Convert_v1alpha1_SeedCapacityControllerConfiguration_To_config_SeedCapacityControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedCapacityControllerConfiguration_To_config_SeedCapacityControllerConfiguration(in *SeedCapacityControllerConfiguration, out *config.SeedCapacityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedCapacityControllerConfiguration_To_config_SeedCapacityControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedCapacityControllerConfiguration_To_v1alpha1_SeedCapacityControllerConfiguration(in *config.SeedCapacityControllerConfiguration, out *SeedCapacityControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.LoadBalancerQuota = (*int32)(unsafe.Pointer(in.LoadBalancerQuota))
+	return nil
+}
+
+// Convert_config_SeedCapacityControllerConfiguration_To_v1alpha1_SeedCapacityControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedCapacityControllerConfiguration_To_v1alpha1_SeedCapacityControllerConfiguration(in *config.SeedCapacityControllerConfiguration, out *SeedCapacityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedCapacityControllerConfiguration_To_v1alpha1_SeedCapacityControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(in *SeedCareControllerConfiguration, out *config.SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.AdaptiveSyncPeriod = (*config.AdaptiveSyncPeriod)(unsafe.Pointer(in.AdaptiveSyncPeriod))
 	return nil
 }
 
@@ -1344,6 +1881,7 @@ func Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControll
 func autoConvert_config_SeedCareControllerConfiguration_To_v1alpha1_SeedCareControllerConfiguration(in *config.SeedCareControllerConfiguration, out *SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.AdaptiveSyncPeriod = (*AdaptiveSyncPeriod)(unsafe.Pointer(in.AdaptiveSyncPeriod))
 	return nil
 }
 
@@ -1424,6 +1962,26 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedMonitoringConfig_To_config_SeedMonitoringConfig(in *SeedMonitoringConfig, out *config.SeedMonitoringConfig, s conversion.Scope) error {
+	out.RemoteWrite = (*config.RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
+	return nil
+}
+
+// Convert_v1alpha1_SeedMonitoringConfig_To_config_SeedMonitoringConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SeedMonitoringConfig_To_config_SeedMonitoringConfig(in *SeedMonitoringConfig, out *config.SeedMonitoringConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedMonitoringConfig_To_config_SeedMonitoringConfig(in, out, s)
+}
+
+func autoConvert_config_SeedMonitoringConfig_To_v1alpha1_SeedMonitoringConfig(in *config.SeedMonitoringConfig, out *SeedMonitoringConfig, s conversion.Scope) error {
+	out.RemoteWrite = (*RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
+	return nil
+}
+
+// Convert_config_SeedMonitoringConfig_To_v1alpha1_SeedMonitoringConfig is an autogenerated conversion function.
+func Convert_config_SeedMonitoringConfig_To_v1alpha1_SeedMonitoringConfig(in *config.SeedMonitoringConfig, out *SeedMonitoringConfig, s conversion.Scope) error {
+	return autoConvert_config_SeedMonitoringConfig_To_v1alpha1_SeedMonitoringConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -1475,6 +2033,11 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.AdaptiveSyncPeriod = (*config.AdaptiveSyncPeriod)(unsafe.Pointer(in.AdaptiveSyncPeriod))
+	out.EtcdMemberRemediation = (*config.EtcdMemberRemediation)(unsafe.Pointer(in.EtcdMemberRemediation))
+	out.Remediation = (*config.Remediation)(unsafe.Pointer(in.Remediation))
+	out.HealthChecks = (*config.ShootHealthChecks)(unsafe.Pointer(in.HealthChecks))
+	out.HibernationPreChecks = *(*[]config.ShootHibernationPreCheck)(unsafe.Pointer(&in.HibernationPreChecks))
 	return nil
 }
 
@@ -1490,6 +2053,11 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.AdaptiveSyncPeriod = (*AdaptiveSyncPeriod)(unsafe.Pointer(in.AdaptiveSyncPeriod))
+	out.EtcdMemberRemediation = (*EtcdMemberRemediation)(unsafe.Pointer(in.EtcdMemberRemediation))
+	out.Remediation = (*Remediation)(unsafe.Pointer(in.Remediation))
+	out.HealthChecks = (*ShootHealthChecks)(unsafe.Pointer(in.HealthChecks))
+	out.HibernationPreChecks = *(*[]ShootHibernationPreCheck)(unsafe.Pointer(&in.HibernationPreChecks))
 	return nil
 }
 
@@ -1522,6 +2090,32 @@ func Convert_config_ShootClientConnection_To_v1alpha1_ShootClientConnection(in *
 	return autoConvert_config_ShootClientConnection_To_v1alpha1_ShootClientConnection(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootConformanceTestControllerConfiguration_To_config_ShootConformanceTestControllerConfiguration(in *ShootConformanceTestControllerConfiguration, out *config.ShootConformanceTestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.Focus = (*string)(unsafe.Pointer(in.Focus))
+	out.Skip = (*string)(unsafe.Pointer(in.Skip))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_ShootConformanceTestControllerConfiguration_To_config_ShootConformanceTestControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootConformanceTestControllerConfiguration_To_config_ShootConformanceTestControllerConfiguration(in *ShootConformanceTestControllerConfiguration, out *config.ShootConformanceTestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootConformanceTestControllerConfiguration_To_config_ShootConformanceTestControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootConformanceTestControllerConfiguration_To_v1alpha1_ShootConformanceTestControllerConfiguration(in *config.ShootConformanceTestControllerConfiguration, out *ShootConformanceTestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.Focus = (*string)(unsafe.Pointer(in.Focus))
+	out.Skip = (*string)(unsafe.Pointer(in.Skip))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_ShootConformanceTestControllerConfiguration_To_v1alpha1_ShootConformanceTestControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootConformanceTestControllerConfiguration_To_v1alpha1_ShootConformanceTestControllerConfiguration(in *config.ShootConformanceTestControllerConfiguration, out *ShootConformanceTestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootConformanceTestControllerConfiguration_To_v1alpha1_ShootConformanceTestControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(in *ShootControllerConfiguration, out *config.ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.ProgressReportPeriod = (*v1.Duration)(unsafe.Pointer(in.ProgressReportPeriod))
@@ -1530,6 +2124,9 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.ImageVerification = (*config.ImageVerification)(unsafe.Pointer(in.ImageVerification))
+	out.ProjectConcurrency = (*config.ShootProjectConcurrency)(unsafe.Pointer(in.ProjectConcurrency))
+	out.FlowConcurrency = (*config.ShootFlowConcurrency)(unsafe.Pointer(in.FlowConcurrency))
 	return nil
 }
 
@@ -1546,6 +2143,9 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.ImageVerification = (*ImageVerification)(unsafe.Pointer(in.ImageVerification))
+	out.ProjectConcurrency = (*ShootProjectConcurrency)(unsafe.Pointer(in.ProjectConcurrency))
+	out.FlowConcurrency = (*ShootFlowConcurrency)(unsafe.Pointer(in.FlowConcurrency))
 	return nil
 }
 
@@ -1574,6 +2174,74 @@ func Convert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in *config.S
 	return autoConvert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootFlowConcurrency_To_config_ShootFlowConcurrency(in *ShootFlowConcurrency, out *config.ShootFlowConcurrency, s conversion.Scope) error {
+	out.MaxParallelTasks = (*int)(unsafe.Pointer(in.MaxParallelTasks))
+	out.MaxParallelTasksPerClass = *(*map[string]int)(unsafe.Pointer(&in.MaxParallelTasksPerClass))
+	return nil
+}
+
+// Convert_v1alpha1_ShootFlowConcurrency_To_config_ShootFlowConcurrency is an autogenerated conversion function.
+func Convert_v1alpha1_ShootFlowConcurrency_To_config_ShootFlowConcurrency(in *ShootFlowConcurrency, out *config.ShootFlowConcurrency, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootFlowConcurrency_To_config_ShootFlowConcurrency(in, out, s)
+}
+
+func autoConvert_config_ShootFlowConcurrency_To_v1alpha1_ShootFlowConcurrency(in *config.ShootFlowConcurrency, out *ShootFlowConcurrency, s conversion.Scope) error {
+	out.MaxParallelTasks = (*int)(unsafe.Pointer(in.MaxParallelTasks))
+	out.MaxParallelTasksPerClass = *(*map[string]int)(unsafe.Pointer(&in.MaxParallelTasksPerClass))
+	return nil
+}
+
+// Convert_config_ShootFlowConcurrency_To_v1alpha1_ShootFlowConcurrency is an autogenerated conversion function.
+func Convert_config_ShootFlowConcurrency_To_v1alpha1_ShootFlowConcurrency(in *config.ShootFlowConcurrency, out *ShootFlowConcurrency, s conversion.Scope) error {
+	return autoConvert_config_ShootFlowConcurrency_To_v1alpha1_ShootFlowConcurrency(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootHealthChecks_To_config_ShootHealthChecks(in *ShootHealthChecks, out *config.ShootHealthChecks, s conversion.Scope) error {
+	out.DisabledChecks = *(*[]config.ShootHealthCheck)(unsafe.Pointer(&in.DisabledChecks))
+	out.NodeAgentLeaseStalenessThreshold = (*v1.Duration)(unsafe.Pointer(in.NodeAgentLeaseStalenessThreshold))
+	out.ExpiredNodeLeasesThresholdPercentage = (*int)(unsafe.Pointer(in.ExpiredNodeLeasesThresholdPercentage))
+	return nil
+}
+
+// Convert_v1alpha1_ShootHealthChecks_To_config_ShootHealthChecks is an autogenerated conversion function.
+func Convert_v1alpha1_ShootHealthChecks_To_config_ShootHealthChecks(in *ShootHealthChecks, out *config.ShootHealthChecks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootHealthChecks_To_config_ShootHealthChecks(in, out, s)
+}
+
+func autoConvert_config_ShootHealthChecks_To_v1alpha1_ShootHealthChecks(in *config.ShootHealthChecks, out *ShootHealthChecks, s conversion.Scope) error {
+	out.DisabledChecks = *(*[]ShootHealthCheck)(unsafe.Pointer(&in.DisabledChecks))
+	out.NodeAgentLeaseStalenessThreshold = (*v1.Duration)(unsafe.Pointer(in.NodeAgentLeaseStalenessThreshold))
+	out.ExpiredNodeLeasesThresholdPercentage = (*int)(unsafe.Pointer(in.ExpiredNodeLeasesThresholdPercentage))
+	return nil
+}
+
+// Convert_config_ShootHealthChecks_To_v1alpha1_ShootHealthChecks is an autogenerated conversion function.
+func Convert_config_ShootHealthChecks_To_v1alpha1_ShootHealthChecks(in *config.ShootHealthChecks, out *ShootHealthChecks, s conversion.Scope) error {
+	return autoConvert_config_ShootHealthChecks_To_v1alpha1_ShootHealthChecks(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootHibernationPreCheck_To_config_ShootHibernationPreCheck(in *ShootHibernationPreCheck, out *config.ShootHibernationPreCheck, s conversion.Scope) error {
+	out.Name = config.ShootHibernationPreCheckName(in.Name)
+	out.Mode = config.ShootHibernationPreCheckMode(in.Mode)
+	return nil
+}
+
+// Convert_v1alpha1_ShootHibernationPreCheck_To_config_ShootHibernationPreCheck is an autogenerated conversion function.
+func Convert_v1alpha1_ShootHibernationPreCheck_To_config_ShootHibernationPreCheck(in *ShootHibernationPreCheck, out *config.ShootHibernationPreCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootHibernationPreCheck_To_config_ShootHibernationPreCheck(in, out, s)
+}
+
+func autoConvert_config_ShootHibernationPreCheck_To_v1alpha1_ShootHibernationPreCheck(in *config.ShootHibernationPreCheck, out *ShootHibernationPreCheck, s conversion.Scope) error {
+	out.Name = ShootHibernationPreCheckName(in.Name)
+	out.Mode = ShootHibernationPreCheckMode(in.Mode)
+	return nil
+}
+
+// Convert_config_ShootHibernationPreCheck_To_v1alpha1_ShootHibernationPreCheck is an autogenerated conversion function.
+func Convert_config_ShootHibernationPreCheck_To_v1alpha1_ShootHibernationPreCheck(in *config.ShootHibernationPreCheck, out *ShootHibernationPreCheck, s conversion.Scope) error {
+	return autoConvert_config_ShootHibernationPreCheck_To_v1alpha1_ShootHibernationPreCheck(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(in *ShootMonitoringConfig, out *config.ShootMonitoringConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.RemoteWrite = (*config.RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
@@ -1618,6 +2286,52 @@ func Convert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in *config.Sho
 	return autoConvert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootProjectConcurrency_To_config_ShootProjectConcurrency(in *ShootProjectConcurrency, out *config.ShootProjectConcurrency, s conversion.Scope) error {
+	out.MaxConcurrentReconciles = (*int)(unsafe.Pointer(in.MaxConcurrentReconciles))
+	out.ProjectNamespaces = *(*map[string]int)(unsafe.Pointer(&in.ProjectNamespaces))
+	out.RequeueDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueDelay))
+	return nil
+}
+
+// Convert_v1alpha1_ShootProjectConcurrency_To_config_ShootProjectConcurrency is an autogenerated conversion function.
+func Convert_v1alpha1_ShootProjectConcurrency_To_config_ShootProjectConcurrency(in *ShootProjectConcurrency, out *config.ShootProjectConcurrency, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootProjectConcurrency_To_config_ShootProjectConcurrency(in, out, s)
+}
+
+func autoConvert_config_ShootProjectConcurrency_To_v1alpha1_ShootProjectConcurrency(in *config.ShootProjectConcurrency, out *ShootProjectConcurrency, s conversion.Scope) error {
+	out.MaxConcurrentReconciles = (*int)(unsafe.Pointer(in.MaxConcurrentReconciles))
+	out.ProjectNamespaces = *(*map[string]int)(unsafe.Pointer(&in.ProjectNamespaces))
+	out.RequeueDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueDelay))
+	return nil
+}
+
+// Convert_config_ShootProjectConcurrency_To_v1alpha1_ShootProjectConcurrency is an autogenerated conversion function.
+func Convert_config_ShootProjectConcurrency_To_v1alpha1_ShootProjectConcurrency(in *config.ShootProjectConcurrency, out *ShootProjectConcurrency, s conversion.Scope) error {
+	return autoConvert_config_ShootProjectConcurrency_To_v1alpha1_ShootProjectConcurrency(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootResourceUsageControllerConfiguration_To_config_ShootResourceUsageControllerConfiguration(in *ShootResourceUsageControllerConfiguration, out *config.ShootResourceUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootResourceUsageControllerConfiguration_To_config_ShootResourceUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootResourceUsageControllerConfiguration_To_config_ShootResourceUsageControllerConfiguration(in *ShootResourceUsageControllerConfiguration, out *config.ShootResourceUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootResourceUsageControllerConfiguration_To_config_ShootResourceUsageControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootResourceUsageControllerConfiguration_To_v1alpha1_ShootResourceUsageControllerConfiguration(in *config.ShootResourceUsageControllerConfiguration, out *ShootResourceUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ShootResourceUsageControllerConfiguration_To_v1alpha1_ShootResourceUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootResourceUsageControllerConfiguration_To_v1alpha1_ShootResourceUsageControllerConfiguration(in *config.ShootResourceUsageControllerConfiguration, out *ShootResourceUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootResourceUsageControllerConfiguration_To_v1alpha1_ShootResourceUsageControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(in *ShootStateControllerConfiguration, out *config.ShootStateControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -64,6 +64,12 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.SeedCare != nil {
 			SetDefaults_SeedCareControllerConfiguration(in.Controllers.SeedCare)
+			if in.Controllers.SeedCare.AdaptiveSyncPeriod != nil {
+				SetDefaults_AdaptiveSyncPeriod(in.Controllers.SeedCare.AdaptiveSyncPeriod)
+			}
+		}
+		if in.Controllers.SeedCapacity != nil {
+			SetDefaults_SeedCapacityControllerConfiguration(in.Controllers.SeedCapacity)
 		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
@@ -73,10 +79,32 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			if in.Controllers.ShootCare.AdaptiveSyncPeriod != nil {
+				SetDefaults_AdaptiveSyncPeriod(in.Controllers.ShootCare.AdaptiveSyncPeriod)
+			}
+			if in.Controllers.ShootCare.EtcdMemberRemediation != nil {
+				SetDefaults_EtcdMemberRemediation(in.Controllers.ShootCare.EtcdMemberRemediation)
+			}
+			if in.Controllers.ShootCare.Remediation != nil {
+				SetDefaults_Remediation(in.Controllers.ShootCare.Remediation)
+			}
+			if in.Controllers.ShootCare.HealthChecks != nil {
+				SetDefaults_ShootHealthChecks(in.Controllers.ShootCare.HealthChecks)
+			}
+			for i := range in.Controllers.ShootCare.HibernationPreChecks {
+				a := &in.Controllers.ShootCare.HibernationPreChecks[i]
+				SetDefaults_ShootHibernationPreCheck(a)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootResourceUsage != nil {
+			SetDefaults_ShootResourceUsageControllerConfiguration(in.Controllers.ShootResourceUsage)
+		}
+		if in.Controllers.ShootConformanceTest != nil {
+			SetDefaults_ShootConformanceTestControllerConfiguration(in.Controllers.ShootConformanceTest)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -130,4 +158,10 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.PodSecurity != nil {
+		SetDefaults_PodSecurityConfiguration(in.PodSecurity)
+	}
+	if in.ArtifactCache != nil {
+		SetDefaults_ArtifactCache(in.ArtifactCache)
+	}
 }
@@ -7,19 +7,25 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
 	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // ValidateGardenletConfiguration validates a GardenletConfiguration object.
@@ -46,6 +52,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		}
 	}
 
+	if cfg.GardenClientConnection != nil && cfg.GardenClientConnection.TokenFile != nil {
+		allErrs = append(allErrs, validateGardenClientConnectionTokenFile(cfg.GardenClientConnection, field.NewPath("gardenClientConnection"), inTemplate)...)
+	}
+
 	if cfg.Controllers != nil {
 		if cfg.Controllers.BackupEntry != nil {
 			allErrs = append(allErrs, validateBackupEntryControllerConfiguration(cfg.Controllers.BackupEntry, fldPath.Child("controllers", "backupEntry"))...)
@@ -59,6 +69,22 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.SeedCare != nil && cfg.Controllers.SeedCare.AdaptiveSyncPeriod != nil {
+			fldPath := fldPath.Child("controllers", "seedCare", "adaptiveSyncPeriod")
+			allErrs = append(allErrs, validateAdaptiveSyncPeriod(cfg.Controllers.SeedCare.AdaptiveSyncPeriod, fldPath)...)
+			if len(cfg.Controllers.SeedCare.AdaptiveSyncPeriod.Purposes) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("purposes"), "purposes are not supported for seeds"))
+			}
+		}
+		if cfg.Controllers.SeedCapacity != nil {
+			allErrs = append(allErrs, validateSeedCapacityControllerConfiguration(cfg.Controllers.SeedCapacity, fldPath.Child("controllers", "seedCapacity"))...)
+		}
+		if cfg.Controllers.ShootResourceUsage != nil {
+			allErrs = append(allErrs, validateShootResourceUsageControllerConfiguration(cfg.Controllers.ShootResourceUsage, fldPath.Child("controllers", "shootResourceUsage"))...)
+		}
+		if cfg.Controllers.ShootConformanceTest != nil {
+			allErrs = append(allErrs, validateShootConformanceTestControllerConfiguration(cfg.Controllers.ShootConformanceTest, fldPath.Child("controllers", "shootConformanceTest"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
@@ -107,6 +133,9 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 			allErrs = append(allErrs, field.Invalid(sniPath.Child("serviceExternalIP"), cfg.SNI.Ingress.ServiceExternalIP, "external service ip is invalid"))
 		}
 	}
+	if cfg.SNI != nil && cfg.SNI.Limits != nil {
+		allErrs = append(allErrs, validateSNILimits(cfg.SNI.Limits, fldPath.Child("sni", "limits"))...)
+	}
 
 	exposureClassHandlersPath := fldPath.Child("exposureClassHandlers")
 	for i, handler := range cfg.ExposureClassHandlers {
@@ -121,6 +150,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 				allErrs = append(allErrs, field.Invalid(handlerPath.Child("sni", "ingress", "serviceExternalIP"), handler.SNI.Ingress.ServiceExternalIP, "external service ip is invalid"))
 			}
 		}
+
+		if handler.SNI != nil && handler.SNI.Limits != nil {
+			allErrs = append(allErrs, validateSNILimits(handler.SNI.Limits, handlerPath.Child("sni", "limits"))...)
+		}
 	}
 
 	if nodeTolerationCfg := cfg.NodeToleration; nodeTolerationCfg != nil {
@@ -130,6 +163,18 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.PodSecurity != nil {
+		allErrs = append(allErrs, validatePodSecurityConfiguration(cfg.PodSecurity, fldPath.Child("podSecurity"))...)
+	}
+
+	if cfg.ArtifactCache != nil {
+		allErrs = append(allErrs, validateArtifactCache(cfg.ArtifactCache, fldPath.Child("artifactCache"))...)
+	}
+
+	if cfg.CertificateService != nil {
+		allErrs = append(allErrs, validateCertificateService(cfg.CertificateService, fldPath.Child("certificateService"))...)
+	}
+
 	return allErrs
 }
 
@@ -144,6 +189,32 @@ func ValidateGardenletConfigurationUpdate(newCfg, oldCfg *config.GardenletConfig
 	return allErrs
 }
 
+func validateGardenClientConnectionTokenFile(cfg *config.GardenClientConnection, fldPath *field.Path, inTemplate bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if inTemplate {
+		return append(allErrs, field.Forbidden(fldPath.Child("tokenFile"), "token files are not supported for gardenlets deployed by ManagedSeeds"))
+	}
+
+	if len(*cfg.TokenFile) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tokenFile"), *cfg.TokenFile, "token file path must not be empty"))
+	}
+	if cfg.GardenClusterAddress == nil || len(*cfg.GardenClusterAddress) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("gardenClusterAddress"), "must be set when authenticating with a token file"))
+	}
+	if cfg.BootstrapKubeconfig != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("bootstrapKubeconfig"), "must not be set when authenticating with a token file"))
+	}
+	if cfg.KubeconfigSecret != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubeconfigSecret"), "must not be set when authenticating with a token file"))
+	}
+	if len(cfg.Kubeconfig) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubeconfig"), "must not be set when authenticating with a token file"))
+	}
+
+	return allErrs
+}
+
 func validateShootControllerConfiguration(cfg *config.ShootControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -174,6 +245,167 @@ func validateShootControllerConfiguration(cfg *config.ShootControllerConfigurati
 		}
 	}
 
+	if cfg.ImageVerification != nil {
+		allErrs = append(allErrs, validateImageVerification(cfg.ImageVerification, fldPath.Child("imageVerification"))...)
+	}
+
+	if cfg.ProjectConcurrency != nil {
+		allErrs = append(allErrs, validateShootProjectConcurrency(cfg.ProjectConcurrency, fldPath.Child("projectConcurrency"))...)
+	}
+
+	if cfg.FlowConcurrency != nil {
+		allErrs = append(allErrs, validateShootFlowConcurrency(cfg.FlowConcurrency, fldPath.Child("flowConcurrency"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootFlowConcurrency(cfg *config.ShootFlowConcurrency, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.MaxParallelTasks != nil && *cfg.MaxParallelTasks < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelTasks"), *cfg.MaxParallelTasks, "must be at least 1"))
+	}
+
+	for class, maxParallelTasks := range cfg.MaxParallelTasksPerClass {
+		if maxParallelTasks < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelTasksPerClass").Key(class), maxParallelTasks, "must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootProjectConcurrency(cfg *config.ShootProjectConcurrency, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.MaxConcurrentReconciles != nil && *cfg.MaxConcurrentReconciles < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentReconciles"), *cfg.MaxConcurrentReconciles, "must be at least 1"))
+	}
+
+	for namespace, maxConcurrentReconciles := range cfg.ProjectNamespaces {
+		if maxConcurrentReconciles < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("projectNamespaces").Key(namespace), maxConcurrentReconciles, "must be at least 1"))
+		}
+	}
+
+	if cfg.RequeueDelay != nil && cfg.RequeueDelay.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requeueDelay"), cfg.RequeueDelay.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateImageVerification(cfg *config.ImageVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !cfg.RequireDigest && len(cfg.PublicKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "either requireDigest must be enabled or publicKeys must be provided"))
+	}
+
+	for i, publicKey := range cfg.PublicKeys {
+		if _, err := utils.DecodePublicKey([]byte(publicKey)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), publicKey, fmt.Sprintf("must be a PEM-encoded public key: %v", err)))
+		}
+	}
+
+	for i, ref := range cfg.PullSecretRefs {
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("pullSecretRefs").Index(i).Child("name"), "must provide a secret name"))
+		}
+		if len(ref.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("pullSecretRefs").Index(i).Child("namespace"), "must provide a secret namespace"))
+		}
+	}
+
+	return allErrs
+}
+
+var availablePodSecurityLevels = sets.New(
+	string(podsecurityadmissionapi.LevelPrivileged),
+	string(podsecurityadmissionapi.LevelBaseline),
+	string(podsecurityadmissionapi.LevelRestricted),
+)
+
+func validatePodSecurityConfiguration(cfg *config.PodSecurityConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validateLevel := func(level *string, fldPath *field.Path) {
+		if level != nil && !availablePodSecurityLevels.Has(*level) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, *level, sets.List(availablePodSecurityLevels)))
+		}
+	}
+
+	validateLevel(cfg.GardenNamespaceLevel, fldPath.Child("gardenNamespaceLevel"))
+	validateLevel(cfg.ShootNamespacesLevel, fldPath.Child("shootNamespacesLevel"))
+	validateLevel(cfg.AuditLevel, fldPath.Child("auditLevel"))
+
+	for i, exemption := range cfg.Exemptions {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&exemption, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("exemptions").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateCertificateService(cfg *config.CertificateService, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	acmePath := fldPath.Child("acme")
+
+	if cfg.ACME.Email == "" {
+		allErrs = append(allErrs, field.Required(acmePath.Child("email"), "email must be provided"))
+	} else if !strings.Contains(cfg.ACME.Email, "@") {
+		allErrs = append(allErrs, field.Invalid(acmePath.Child("email"), cfg.ACME.Email, "must be a valid e-mail address"))
+	}
+
+	if cfg.ACME.Server == "" {
+		allErrs = append(allErrs, field.Required(acmePath.Child("server"), "server must be provided"))
+	} else if u, err := url.Parse(cfg.ACME.Server); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(acmePath.Child("server"), cfg.ACME.Server, "must be a valid URL with scheme https"))
+	}
+
+	for i, nameserver := range cfg.ACME.PrecheckNameservers {
+		if nameserver == "" {
+			allErrs = append(allErrs, field.Required(acmePath.Child("precheckNameservers").Index(i), "nameserver must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateArtifactCache(cfg *config.ArtifactCache, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ptr.Deref(cfg.Enabled, false) && len(cfg.Upstreams) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("upstreams"), "at least one upstream must be configured when the artifact cache is enabled"))
+	}
+
+	hosts := sets.New[string]()
+	for i, upstream := range cfg.Upstreams {
+		idxPath := fldPath.Child("upstreams").Index(i)
+
+		if upstream.Host == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("host"), "host must be provided"))
+		} else if hosts.Has(upstream.Host) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("host"), upstream.Host))
+		} else {
+			hosts.Insert(upstream.Host)
+		}
+
+		if upstream.RemoteURL != nil {
+			if u, err := url.Parse(*upstream.RemoteURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("remoteURL"), *upstream.RemoteURL, "must be a valid URL with scheme http or https"))
+			}
+		}
+	}
+
+	if cfg.StorageSize != nil && cfg.StorageSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageSize"), cfg.StorageSize.String(), "must be greater than 0"))
+	}
+
+	if cfg.GarbageCollectionTTL != nil && cfg.GarbageCollectionTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("garbageCollectionTTL"), cfg.GarbageCollectionTTL.Duration.String(), "must be non-negative"))
+	}
+
 	return allErrs
 }
 
@@ -200,6 +432,234 @@ func validateShootCareControllerConfiguration(cfg *config.ShootCareControllerCon
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	if cfg.AdaptiveSyncPeriod != nil {
+		allErrs = append(allErrs, validateAdaptiveSyncPeriod(cfg.AdaptiveSyncPeriod, fldPath.Child("adaptiveSyncPeriod"))...)
+	}
+
+	if cfg.EtcdMemberRemediation != nil && cfg.EtcdMemberRemediation.Threshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.EtcdMemberRemediation.Threshold.Duration), fldPath.Child("etcdMemberRemediation", "threshold"))...)
+	}
+
+	if cfg.Remediation != nil {
+		allErrs = append(allErrs, validateRemediation(cfg.Remediation, fldPath.Child("remediation"))...)
+	}
+
+	if cfg.HealthChecks != nil {
+		allErrs = append(allErrs, validateShootHealthChecks(cfg.HealthChecks, fldPath.Child("healthChecks"))...)
+	}
+
+	allErrs = append(allErrs, validateShootHibernationPreChecks(cfg.HibernationPreChecks, fldPath.Child("hibernationPreChecks"))...)
+
+	return allErrs
+}
+
+var availableAdaptiveSyncPeriodPurposes = sets.New(
+	gardencore.ShootPurposeEvaluation,
+	gardencore.ShootPurposeTesting,
+	gardencore.ShootPurposeDevelopment,
+	gardencore.ShootPurposeProduction,
+	gardencore.ShootPurposeInfrastructure,
+)
+
+func validateAdaptiveSyncPeriod(cfg *config.AdaptiveSyncPeriod, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validateSyncPeriods := func(healthy, degraded *metav1.Duration, fldPath *field.Path) {
+		if healthy != nil && healthy.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("healthySyncPeriod"), healthy.Duration.String(), "must be positive"))
+		}
+		if degraded != nil && degraded.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("degradedSyncPeriod"), degraded.Duration.String(), "must be positive"))
+		}
+		if healthy != nil && degraded != nil && degraded.Duration > healthy.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("degradedSyncPeriod"), degraded.Duration.String(), "must not be greater than healthySyncPeriod"))
+		}
+	}
+
+	validateSyncPeriods(cfg.HealthySyncPeriod, cfg.DegradedSyncPeriod, fldPath)
+
+	if cfg.StabilizationPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.StabilizationPeriod.Duration), fldPath.Child("stabilizationPeriod"))...)
+	}
+
+	if v := cfg.JitterPercentage; v != nil && (*v < 0 || *v > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitterPercentage"), *v, "must be between 0 and 100"))
+	}
+
+	purposes := sets.New[gardencore.ShootPurpose]()
+	for i, purpose := range cfg.Purposes {
+		idxPath := fldPath.Child("purposes").Index(i)
+
+		if !availableAdaptiveSyncPeriodPurposes.Has(purpose.Purpose) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("purpose"), purpose.Purpose, sets.List(availableAdaptiveSyncPeriodPurposes)))
+		} else if purposes.Has(purpose.Purpose) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("purpose"), purpose.Purpose))
+		}
+		purposes.Insert(purpose.Purpose)
+
+		if purpose.HealthySyncPeriod != nil || purpose.DegradedSyncPeriod != nil {
+			healthy, degraded := cfg.HealthySyncPeriod, cfg.DegradedSyncPeriod
+			if purpose.HealthySyncPeriod != nil {
+				healthy = purpose.HealthySyncPeriod
+			}
+			if purpose.DegradedSyncPeriod != nil {
+				degraded = purpose.DegradedSyncPeriod
+			}
+			validateSyncPeriods(healthy, degraded, idxPath)
+		}
+	}
+
+	return allErrs
+}
+
+var availableRemediationActions = sets.New(
+	config.RemediationActionRestartCrashLoopingControlPlanePod,
+	config.RemediationActionRetriggerFailedManagedResource,
+	config.RemediationActionRecreateBrokenVPNPod,
+)
+
+func validateRemediation(cfg *config.Remediation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	actions := sets.New[config.RemediationAction]()
+	for i, action := range cfg.Actions {
+		idxPath := fldPath.Child("actions").Index(i)
+
+		if !availableRemediationActions.Has(action) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, action, sets.List(availableRemediationActions)))
+		} else if actions.Has(action) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, action))
+		}
+		actions.Insert(action)
+	}
+
+	if cfg.Threshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.Threshold.Duration), fldPath.Child("threshold"))...)
+	}
+
+	if cfg.MaxActionsPerShoot != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.MaxActionsPerShoot), fldPath.Child("maxActionsPerShoot"))...)
+	}
+
+	if cfg.RateLimitPeriod != nil && cfg.RateLimitPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimitPeriod"), cfg.RateLimitPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+var availableShootHealthChecks = sets.New(
+	config.ShootHealthCheckTunnelConnection,
+	config.ShootHealthCheckOperatingSystemConfig,
+	config.ShootHealthCheckNodeAgentLeases,
+	config.ShootHealthCheckNodesScaling,
+	config.ShootHealthCheckExpiredNodeLeases,
+)
+
+func validateShootHealthChecks(cfg *config.ShootHealthChecks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	checks := sets.New[config.ShootHealthCheck]()
+	for i, check := range cfg.DisabledChecks {
+		idxPath := fldPath.Child("disabledChecks").Index(i)
+
+		if !availableShootHealthChecks.Has(check) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, check, sets.List(availableShootHealthChecks)))
+		} else if checks.Has(check) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, check))
+		}
+		checks.Insert(check)
+	}
+
+	if cfg.NodeAgentLeaseStalenessThreshold != nil && cfg.NodeAgentLeaseStalenessThreshold.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeAgentLeaseStalenessThreshold"), cfg.NodeAgentLeaseStalenessThreshold.Duration.String(), "must be positive"))
+	}
+
+	if percentage := cfg.ExpiredNodeLeasesThresholdPercentage; percentage != nil && (*percentage <= 0 || *percentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expiredNodeLeasesThresholdPercentage"), *percentage, "must be greater than 0 and at most 100"))
+	}
+
+	return allErrs
+}
+
+var (
+	availableShootHibernationPreChecks = sets.New(
+		config.ShootHibernationPreCheckPendingVolumeDetachments,
+		config.ShootHibernationPreCheckCredentialsRotationInProgress,
+		config.ShootHibernationPreCheckPodDisruptionBudgets,
+	)
+	availableShootHibernationPreCheckModes = sets.New(
+		config.ShootHibernationPreCheckModeWarn,
+		config.ShootHibernationPreCheckModeBlock,
+	)
+)
+
+func validateShootHibernationPreChecks(preChecks []config.ShootHibernationPreCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[config.ShootHibernationPreCheckName]()
+	for i, preCheck := range preChecks {
+		idxPath := fldPath.Index(i)
+
+		if !availableShootHibernationPreChecks.Has(preCheck.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), preCheck.Name, sets.List(availableShootHibernationPreChecks)))
+		} else if names.Has(preCheck.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), preCheck.Name))
+		}
+		names.Insert(preCheck.Name)
+
+		if !availableShootHibernationPreCheckModes.Has(preCheck.Mode) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("mode"), preCheck.Mode, sets.List(availableShootHibernationPreCheckModes)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedCapacityControllerConfiguration(cfg *config.SeedCapacityControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+	if cfg.LoadBalancerQuota != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.LoadBalancerQuota), fldPath.Child("loadBalancerQuota"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootResourceUsageControllerConfiguration(cfg *config.ShootResourceUsageControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootConformanceTestControllerConfiguration(cfg *config.ShootConformanceTestControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+	for name, expression := range map[string]*string{"focus": cfg.Focus, "skip": cfg.Skip} {
+		if expression == nil {
+			continue
+		}
+		if _, err := regexp.Compile(*expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *expression, fmt.Sprintf("must be a valid regular expression: %v", err)))
+		}
+	}
+	if cfg.Timeout != nil && cfg.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), cfg.Timeout.Duration.String(), "must be positive"))
+	}
+
 	return allErrs
 }
 
@@ -218,6 +678,9 @@ func validateManagedSeedControllerConfiguration(cfg *config.ManagedSeedControlle
 	if cfg.SyncJitterPeriod != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncJitterPeriod.Duration), fldPath.Child("syncJitterPeriod"))...)
 	}
+	if cfg.DriftDetectionPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.DriftDetectionPeriod.Duration), fldPath.Child("driftDetectionPeriod"))...)
+	}
 
 	return allErrs
 }
@@ -270,3 +733,38 @@ func validateBastionControllerConfiguration(cfg *config.BastionControllerConfigu
 
 	return allErrs
 }
+
+func validateSNILimits(limits *config.SNILimits, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits.Default != nil {
+		allErrs = append(allErrs, validateSNIShootLimits(*limits.Default, fldPath.Child("default"))...)
+	}
+
+	for purpose, shootLimits := range limits.Purposes {
+		purposePath := fldPath.Child("purposes").Key(string(purpose))
+
+		if !availableShootPurposes.Has(string(purpose)) {
+			allErrs = append(allErrs, field.NotSupported(purposePath, purpose, sets.List(availableShootPurposes)))
+		}
+		allErrs = append(allErrs, validateSNIShootLimits(shootLimits, purposePath)...)
+	}
+
+	return allErrs
+}
+
+func validateSNIShootLimits(limits config.SNIShootLimits, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits.MaxConnections != nil && *limits.MaxConnections < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConnections"), *limits.MaxConnections, "must be at least 1"))
+	}
+	if limits.ConnectionsPerSecond != nil && *limits.ConnectionsPerSecond < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("connectionsPerSecond"), *limits.ConnectionsPerSecond, "must be at least 1"))
+	}
+	if limits.ConnectionBufferLimit != nil && limits.ConnectionBufferLimit.Value() < 1024 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("connectionBufferLimit"), limits.ConnectionBufferLimit.String(), "must be at least 1Ki"))
+	}
+
+	return allErrs
+}
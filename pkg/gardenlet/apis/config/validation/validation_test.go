@@ -5,6 +5,11 @@
 package validation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -204,6 +209,63 @@ var _ = Describe("GardenletConfiguration", func() {
 					}))))
 				})
 			})
+
+			Context("token file", func() {
+				BeforeEach(func() {
+					cfg.GardenClientConnection = &config.GardenClientConnection{
+						GardenClusterAddress: ptr.To("https://api.garden.example.com"),
+						TokenFile:            ptr.To("/var/run/secrets/gardener.cloud/garden/token"),
+					}
+				})
+
+				It("should allow valid configurations", func() {
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				})
+
+				It("should forbid empty paths and require the garden cluster address", func() {
+					cfg.GardenClientConnection.TokenFile = ptr.To("")
+					cfg.GardenClientConnection.GardenClusterAddress = nil
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("gardenClientConnection.tokenFile"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("gardenClientConnection.gardenClusterAddress"),
+						})),
+					))
+				})
+
+				It("should forbid combining it with other means of authentication", func() {
+					cfg.GardenClientConnection.Kubeconfig = "/etc/gardenlet/kubeconfig-garden/kubeconfig"
+					cfg.GardenClientConnection.BootstrapKubeconfig = &corev1.SecretReference{Name: "gardenlet-kubeconfig-bootstrap", Namespace: "garden"}
+					cfg.GardenClientConnection.KubeconfigSecret = &corev1.SecretReference{Name: "gardenlet-kubeconfig", Namespace: "garden"}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("gardenClientConnection.kubeconfig"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("gardenClientConnection.bootstrapKubeconfig"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("gardenClientConnection.kubeconfigSecret"),
+						})),
+					))
+				})
+
+				It("should forbid token files in gardenlet templates of ManagedSeeds", func() {
+					Expect(ValidateGardenletConfiguration(cfg, nil, true)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("gardenClientConnection.tokenFile"),
+					}))))
+				})
+			})
 		})
 
 		Context("shoot controller", func() {
@@ -258,6 +320,122 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow valid project concurrency configuration", func() {
+				cfg.Controllers.Shoot.ProjectConcurrency = &config.ShootProjectConcurrency{
+					MaxConcurrentReconciles: ptr.To(5),
+					ProjectNamespaces:       map[string]int{"garden-foo": 1},
+					RequeueDelay:            &metav1.Duration{Duration: 10 * time.Second},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid project concurrency configuration", func() {
+				cfg.Controllers.Shoot.ProjectConcurrency = &config.ShootProjectConcurrency{
+					MaxConcurrentReconciles: ptr.To(0),
+					ProjectNamespaces:       map[string]int{"garden-foo": -1},
+					RequeueDelay:            &metav1.Duration{},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.projectConcurrency.maxConcurrentReconciles"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.projectConcurrency.projectNamespaces[garden-foo]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.projectConcurrency.requeueDelay"),
+					})),
+				))
+			})
+
+			It("should allow valid flow concurrency configuration", func() {
+				cfg.Controllers.Shoot.FlowConcurrency = &config.ShootFlowConcurrency{
+					MaxParallelTasks:         ptr.To(10),
+					MaxParallelTasksPerClass: map[string]int{"extension": 3},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid flow concurrency configuration", func() {
+				cfg.Controllers.Shoot.FlowConcurrency = &config.ShootFlowConcurrency{
+					MaxParallelTasks:         ptr.To(0),
+					MaxParallelTasksPerClass: map[string]int{"extension": 0},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.flowConcurrency.maxParallelTasks"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.flowConcurrency.maxParallelTasksPerClass[extension]"),
+					})),
+				))
+			})
+
+			Context("image verification", func() {
+				It("should allow valid configuration", func() {
+					privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+					Expect(err).NotTo(HaveOccurred())
+					publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+					Expect(err).NotTo(HaveOccurred())
+
+					cfg.Controllers.Shoot.ImageVerification = &config.ImageVerification{
+						RequireDigest: true,
+						PublicKeys:    []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))},
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				})
+
+				It("should forbid empty configuration", func() {
+					cfg.Controllers.Shoot.ImageVerification = &config.ImageVerification{}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shoot.imageVerification"),
+					}))))
+				})
+
+				It("should forbid invalid public keys", func() {
+					cfg.Controllers.Shoot.ImageVerification = &config.ImageVerification{PublicKeys: []string{"foo"}}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.imageVerification.publicKeys[0]"),
+					}))))
+				})
+
+				It("should forbid incomplete pull secret references", func() {
+					cfg.Controllers.Shoot.ImageVerification = &config.ImageVerification{
+						RequireDigest:  true,
+						PullSecretRefs: []corev1.SecretReference{{Name: "foo", Namespace: "garden"}, {}},
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.shoot.imageVerification.pullSecretRefs[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.shoot.imageVerification.pullSecretRefs[1].namespace"),
+						})),
+					))
+				})
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -269,6 +447,7 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &config.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.ConditionThresholds = []config.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.EtcdMemberRemediation = &config.EtcdMemberRemediation{Enabled: true, Threshold: &metav1.Duration{Duration: -1}}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -293,10 +472,304 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.etcdMemberRemediation.threshold"),
+					})),
+				))
+			})
+
+			It("should allow valid adaptive sync period configuration", func() {
+				cfg.Controllers.ShootCare.AdaptiveSyncPeriod = &config.AdaptiveSyncPeriod{
+					HealthySyncPeriod:   &metav1.Duration{Duration: 5 * time.Minute},
+					DegradedSyncPeriod:  &metav1.Duration{Duration: 30 * time.Second},
+					StabilizationPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+					JitterPercentage:    ptr.To[int32](20),
+					Purposes: []config.AdaptiveSyncPeriodPurpose{
+						{Purpose: gardencore.ShootPurposeProduction, HealthySyncPeriod: &metav1.Duration{Duration: time.Minute}},
+						{Purpose: gardencore.ShootPurposeEvaluation, HealthySyncPeriod: &metav1.Duration{Duration: 15 * time.Minute}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid adaptive sync period configuration", func() {
+				cfg.Controllers.ShootCare.AdaptiveSyncPeriod = &config.AdaptiveSyncPeriod{
+					HealthySyncPeriod:   &metav1.Duration{Duration: time.Minute},
+					DegradedSyncPeriod:  &metav1.Duration{Duration: 2 * time.Minute},
+					StabilizationPeriod: &metav1.Duration{Duration: -1},
+					JitterPercentage:    ptr.To[int32](101),
+					Purposes: []config.AdaptiveSyncPeriodPurpose{
+						{Purpose: "foo"},
+						{Purpose: gardencore.ShootPurposeProduction, HealthySyncPeriod: &metav1.Duration{Duration: 5 * time.Minute}, DegradedSyncPeriod: &metav1.Duration{Duration: 0}},
+						{Purpose: gardencore.ShootPurposeProduction},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.degradedSyncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.stabilizationPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.jitterPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.purposes[0].purpose"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.purposes[1].degradedSyncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootCare.adaptiveSyncPeriod.purposes[2].purpose"),
+					})),
+				))
+			})
+
+			It("should allow valid remediation configuration", func() {
+				cfg.Controllers.ShootCare.Remediation = &config.Remediation{
+					Enabled:            true,
+					Actions:            []config.RemediationAction{config.RemediationActionRestartCrashLoopingControlPlanePod, config.RemediationActionRecreateBrokenVPNPod},
+					Threshold:          &metav1.Duration{Duration: 10 * time.Minute},
+					MaxActionsPerShoot: ptr.To(0),
+					RateLimitPeriod:    &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid remediation configuration", func() {
+				cfg.Controllers.ShootCare.Remediation = &config.Remediation{
+					Enabled:            true,
+					Actions:            []config.RemediationAction{"foo", config.RemediationActionRecreateBrokenVPNPod, config.RemediationActionRecreateBrokenVPNPod},
+					Threshold:          &metav1.Duration{Duration: -1},
+					MaxActionsPerShoot: ptr.To(-1),
+					RateLimitPeriod:    &metav1.Duration{Duration: 0},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.remediation.actions[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootCare.remediation.actions[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediation.threshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediation.maxActionsPerShoot"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediation.rateLimitPeriod"),
+					})),
+				))
+			})
+
+			It("should allow valid health checks configuration", func() {
+				cfg.Controllers.ShootCare.HealthChecks = &config.ShootHealthChecks{
+					DisabledChecks:                       []config.ShootHealthCheck{config.ShootHealthCheckTunnelConnection, config.ShootHealthCheckNodesScaling},
+					NodeAgentLeaseStalenessThreshold:     &metav1.Duration{Duration: 5 * time.Minute},
+					ExpiredNodeLeasesThresholdPercentage: ptr.To(100),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid health checks configuration", func() {
+				cfg.Controllers.ShootCare.HealthChecks = &config.ShootHealthChecks{
+					DisabledChecks:                       []config.ShootHealthCheck{"foo", config.ShootHealthCheckExpiredNodeLeases, config.ShootHealthCheckExpiredNodeLeases},
+					NodeAgentLeaseStalenessThreshold:     &metav1.Duration{Duration: 0},
+					ExpiredNodeLeasesThresholdPercentage: ptr.To(101),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.healthChecks.disabledChecks[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootCare.healthChecks.disabledChecks[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.healthChecks.nodeAgentLeaseStalenessThreshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.healthChecks.expiredNodeLeasesThresholdPercentage"),
+					})),
+				))
+			})
+
+			It("should allow valid hibernation pre-checks configuration", func() {
+				cfg.Controllers.ShootCare.HibernationPreChecks = []config.ShootHibernationPreCheck{
+					{Name: config.ShootHibernationPreCheckPendingVolumeDetachments, Mode: config.ShootHibernationPreCheckModeBlock},
+					{Name: config.ShootHibernationPreCheckCredentialsRotationInProgress, Mode: config.ShootHibernationPreCheckModeWarn},
+					{Name: config.ShootHibernationPreCheckPodDisruptionBudgets, Mode: config.ShootHibernationPreCheckModeBlock},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid hibernation pre-checks configuration", func() {
+				cfg.Controllers.ShootCare.HibernationPreChecks = []config.ShootHibernationPreCheck{
+					{Name: "foo", Mode: config.ShootHibernationPreCheckModeWarn},
+					{Name: config.ShootHibernationPreCheckPodDisruptionBudgets, Mode: "bar"},
+					{Name: config.ShootHibernationPreCheckPodDisruptionBudgets, Mode: config.ShootHibernationPreCheckModeBlock},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.hibernationPreChecks[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.hibernationPreChecks[1].mode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shootCare.hibernationPreChecks[2].name"),
+					})),
+				))
+			})
+		})
+
+		Context("seedCare controller", func() {
+			It("should forbid purposes in the adaptive sync period configuration", func() {
+				cfg.Controllers.SeedCare = &config.SeedCareControllerConfiguration{
+					AdaptiveSyncPeriod: &config.AdaptiveSyncPeriod{
+						HealthySyncPeriod: &metav1.Duration{Duration: -1},
+						Purposes:          []config.AdaptiveSyncPeriodPurpose{{Purpose: gardencore.ShootPurposeProduction}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCare.adaptiveSyncPeriod.healthySyncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("controllers.seedCare.adaptiveSyncPeriod.purposes"),
+					})),
 				))
 			})
 		})
 
+		Context("seedCapacity controller", func() {
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.SeedCapacity = &config.SeedCapacityControllerConfiguration{
+					SyncPeriod:        &metav1.Duration{Duration: -1},
+					LoadBalancerQuota: ptr.To[int32](-1),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCapacity.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedCapacity.loadBalancerQuota"),
+					})),
+				))
+			})
+
+			It("should allow disabling the capacity report", func() {
+				cfg.Controllers.SeedCapacity = &config.SeedCapacityControllerConfiguration{
+					SyncPeriod: &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+		})
+
+		Context("shootResourceUsage controller", func() {
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootResourceUsage = &config.ShootResourceUsageControllerConfiguration{
+					ConcurrentSyncs: ptr.To(-1),
+					SyncPeriod:      &metav1.Duration{Duration: -1},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootResourceUsage.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootResourceUsage.syncPeriod"),
+					})),
+				))
+			})
+
+			It("should allow disabling the resource usage report", func() {
+				cfg.Controllers.ShootResourceUsage = &config.ShootResourceUsageControllerConfiguration{
+					SyncPeriod: &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+		})
+
+		Context("shootConformanceTest controller", func() {
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootConformanceTest = &config.ShootConformanceTestControllerConfiguration{
+					ConcurrentSyncs: ptr.To(-1),
+					Focus:           ptr.To("[Conformance"),
+					Skip:            ptr.To("(Serial"),
+					Timeout:         &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootConformanceTest.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootConformanceTest.focus"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootConformanceTest.skip"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootConformanceTest.timeout"),
+					})),
+				))
+			})
+
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ShootConformanceTest = &config.ShootConformanceTestControllerConfiguration{
+					ConcurrentSyncs: ptr.To(0),
+					Focus:           ptr.To(`\[Conformance\]`),
+					Skip:            ptr.To(""),
+					Timeout:         &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+		})
+
 		Context("managed seed controller", func() {
 			It("should forbid invalid configuration", func() {
 				invalidConcurrentSyncs := -1
@@ -305,6 +778,7 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ManagedSeed.SyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ManagedSeed.WaitSyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ManagedSeed.SyncJitterPeriod = &metav1.Duration{Duration: -1}
+				cfg.Controllers.ManagedSeed.DriftDetectionPeriod = &metav1.Duration{Duration: -1}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -325,6 +799,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.managedSeed.syncJitterPeriod"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.managedSeed.driftDetectionPeriod"),
+					})),
 				))
 			})
 		})
@@ -506,6 +984,55 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("sni.ingress.serviceExternalIP"),
 				}))))
 			})
+
+			It("should pass as sni config contains valid limits", func() {
+				cfg.SNI.Limits = &config.SNILimits{
+					Default: &config.SNIShootLimits{
+						MaxConnections:        ptr.To[int32](1000),
+						ConnectionsPerSecond:  ptr.To[int32](50),
+						ConnectionBufferLimit: ptr.To(resource.MustParse("32Ki")),
+					},
+					Purposes: map[gardencore.ShootPurpose]config.SNIShootLimits{
+						gardencore.ShootPurposeEvaluation: {MaxConnections: ptr.To[int32](100)},
+					},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid as sni config contains invalid limits", func() {
+				cfg.SNI.Limits = &config.SNILimits{
+					Default: &config.SNIShootLimits{
+						MaxConnections:        ptr.To[int32](0),
+						ConnectionsPerSecond:  ptr.To[int32](-1),
+						ConnectionBufferLimit: ptr.To(resource.MustParse("512")),
+					},
+					Purposes: map[gardencore.ShootPurpose]config.SNIShootLimits{
+						"foo": {},
+					},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("sni.limits.default.maxConnections"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("sni.limits.default.connectionsPerSecond"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("sni.limits.default.connectionBufferLimit"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("sni.limits.purposes[foo]"),
+					})),
+				))
+			})
 		})
 
 		Context("exposureClassHandlers", func() {
@@ -585,6 +1112,20 @@ var _ = Describe("GardenletConfiguration", func() {
 					}))))
 				})
 			})
+
+			It("should forbid invalid sni limits", func() {
+				cfg.ExposureClassHandlers[0].SNI.Limits = &config.SNILimits{
+					Purposes: map[gardencore.ShootPurpose]config.SNIShootLimits{
+						gardencore.ShootPurposeProduction: {ConnectionsPerSecond: ptr.To[int32](0)},
+					},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("exposureClassHandlers[0].sni.limits.purposes[production].connectionsPerSecond"),
+				}))))
+			})
 		})
 
 		Context("nodeToleration", func() {
@@ -632,6 +1173,172 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("podSecurity", func() {
+			It("should pass with valid pod security settings", func() {
+				cfg.PodSecurity = &config.PodSecurityConfiguration{
+					GardenNamespaceLevel: ptr.To("privileged"),
+					ShootNamespacesLevel: ptr.To("baseline"),
+					AuditLevel:           ptr.To("restricted"),
+					Exemptions: []metav1.LabelSelector{
+						{MatchLabels: map[string]string{"app": "vpn-seed-server"}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid pod security settings", func() {
+				cfg.PodSecurity = &config.PodSecurityConfiguration{
+					GardenNamespaceLevel: ptr.To("foo"),
+					ShootNamespacesLevel: ptr.To("bar"),
+					AuditLevel:           ptr.To("baz"),
+					Exemptions: []metav1.LabelSelector{
+						{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Foo"}}},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("podSecurity.gardenNamespaceLevel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("podSecurity.shootNamespacesLevel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("podSecurity.auditLevel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("podSecurity.exemptions[0].matchExpressions[0].operator"),
+					})),
+				))
+			})
+		})
+
+		Context("certificateService", func() {
+			It("should pass with valid certificate service settings", func() {
+				cfg.CertificateService = &config.CertificateService{
+					ACME: config.CertificateServiceACME{
+						Email:               "admin@example.com",
+						Server:              "https://acme-v02.api.letsencrypt.org/directory",
+						PrecheckNameservers: []string{"8.8.8.8:53"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail without ACME settings", func() {
+				cfg.CertificateService = &config.CertificateService{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("certificateService.acme.email"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("certificateService.acme.server"),
+					})),
+				))
+			})
+
+			It("should fail with invalid ACME settings", func() {
+				cfg.CertificateService = &config.CertificateService{
+					ACME: config.CertificateServiceACME{
+						Email:               "admin",
+						Server:              "http://acme-v02.api.letsencrypt.org/directory",
+						PrecheckNameservers: []string{""},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("certificateService.acme.email"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("certificateService.acme.server"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("certificateService.acme.precheckNameservers[0]"),
+					})),
+				))
+			})
+		})
+
+		Context("artifactCache", func() {
+			It("should pass with valid artifact cache settings", func() {
+				cfg.ArtifactCache = &config.ArtifactCache{
+					Enabled: ptr.To(true),
+					Upstreams: []config.ArtifactCacheUpstream{
+						{Host: "europe-docker.pkg.dev", RemoteURL: ptr.To("https://europe-docker.pkg.dev")},
+						{Host: "registry.local", RemoteURL: ptr.To("http://registry.local:5000")},
+					},
+					StorageSize:          ptr.To(resource.MustParse("20Gi")),
+					GarbageCollectionTTL: &metav1.Duration{Duration: 168 * time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail when the artifact cache is enabled without upstreams", func() {
+				cfg.ArtifactCache = &config.ArtifactCache{Enabled: ptr.To(true)}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("artifactCache.upstreams"),
+					})),
+				))
+			})
+
+			It("should fail with invalid artifact cache settings", func() {
+				cfg.ArtifactCache = &config.ArtifactCache{
+					Enabled: ptr.To(true),
+					Upstreams: []config.ArtifactCacheUpstream{
+						{Host: "europe-docker.pkg.dev", RemoteURL: ptr.To("ftp://europe-docker.pkg.dev")},
+						{Host: "europe-docker.pkg.dev"},
+						{RemoteURL: ptr.To("https://")},
+					},
+					StorageSize:          ptr.To(resource.MustParse("0")),
+					GarbageCollectionTTL: &metav1.Duration{Duration: -time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactCache.upstreams[0].remoteURL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("artifactCache.upstreams[1].host"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("artifactCache.upstreams[2].host"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactCache.upstreams[2].remoteURL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactCache.storageSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactCache.garbageCollectionTTL"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
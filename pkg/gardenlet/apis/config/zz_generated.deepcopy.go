@@ -17,6 +17,134 @@ import (
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSyncPeriod) DeepCopyInto(out *AdaptiveSyncPeriod) {
+	*out = *in
+	if in.HealthySyncPeriod != nil {
+		in, out := &in.HealthySyncPeriod, &out.HealthySyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DegradedSyncPeriod != nil {
+		in, out := &in.DegradedSyncPeriod, &out.DegradedSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StabilizationPeriod != nil {
+		in, out := &in.StabilizationPeriod, &out.StabilizationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.JitterPercentage != nil {
+		in, out := &in.JitterPercentage, &out.JitterPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make([]AdaptiveSyncPeriodPurpose, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSyncPeriod.
+func (in *AdaptiveSyncPeriod) DeepCopy() *AdaptiveSyncPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSyncPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSyncPeriodPurpose) DeepCopyInto(out *AdaptiveSyncPeriodPurpose) {
+	*out = *in
+	if in.HealthySyncPeriod != nil {
+		in, out := &in.HealthySyncPeriod, &out.HealthySyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DegradedSyncPeriod != nil {
+		in, out := &in.DegradedSyncPeriod, &out.DegradedSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSyncPeriodPurpose.
+func (in *AdaptiveSyncPeriodPurpose) DeepCopy() *AdaptiveSyncPeriodPurpose {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSyncPeriodPurpose)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCache) DeepCopyInto(out *ArtifactCache) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]ArtifactCacheUpstream, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GarbageCollectionTTL != nil {
+		in, out := &in.GarbageCollectionTTL, &out.GarbageCollectionTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCache.
+func (in *ArtifactCache) DeepCopy() *ArtifactCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCacheUpstream) DeepCopyInto(out *ArtifactCacheUpstream) {
+	*out = *in
+	if in.RemoteURL != nil {
+		in, out := &in.RemoteURL, &out.RemoteURL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCacheUpstream.
+func (in *ArtifactCacheUpstream) DeepCopy() *ArtifactCacheUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCacheUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketControllerConfiguration) DeepCopyInto(out *BackupBucketControllerConfiguration) {
 	*out = *in
@@ -131,6 +259,44 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateService) DeepCopyInto(out *CertificateService) {
+	*out = *in
+	in.ACME.DeepCopyInto(&out.ACME)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateService.
+func (in *CertificateService) DeepCopy() *CertificateService {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateServiceACME) DeepCopyInto(out *CertificateServiceACME) {
+	*out = *in
+	if in.PrecheckNameservers != nil {
+		in, out := &in.PrecheckNameservers, &out.PrecheckNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateServiceACME.
+func (in *CertificateServiceACME) DeepCopy() *CertificateServiceACME {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateServiceACME)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -332,6 +498,27 @@ func (in *ETCDController) DeepCopy() *ETCDController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMemberRemediation) DeepCopyInto(out *EtcdMemberRemediation) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMemberRemediation.
+func (in *EtcdMemberRemediation) DeepCopy() *EtcdMemberRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMemberRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 	*out = *in
@@ -383,6 +570,11 @@ func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 		*out = new(KubeconfigValidity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TokenFile != nil {
+		in, out := &in.TokenFile, &out.TokenFile
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -501,6 +693,21 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactCache != nil {
+		in, out := &in.ArtifactCache, &out.ArtifactCache
+		*out = new(ArtifactCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateService != nil {
+		in, out := &in.CertificateService, &out.CertificateService
+		*out = new(CertificateService)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -570,6 +777,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(SeedCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedCapacity != nil {
+		in, out := &in.SeedCapacity, &out.SeedCapacity
+		*out = new(SeedCapacityControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootControllerConfiguration)
@@ -585,6 +797,16 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootResourceUsage != nil {
+		in, out := &in.ShootResourceUsage, &out.ShootResourceUsage
+		*out = new(ShootResourceUsageControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootConformanceTest != nil {
+		in, out := &in.ShootConformanceTest, &out.ShootConformanceTest
+		*out = new(ShootConformanceTestControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -639,6 +861,32 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullSecretRefs != nil {
+		in, out := &in.PullSecretRefs, &out.PullSecretRefs
+		*out = make([]corev1.SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -757,6 +1005,11 @@ func (in *ManagedSeedControllerConfiguration) DeepCopyInto(out *ManagedSeedContr
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DriftDetectionPeriod != nil {
+		in, out := &in.DriftDetectionPeriod, &out.DriftDetectionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -778,6 +1031,11 @@ func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 		*out = new(ShootMonitoringConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(SeedMonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -845,6 +1103,80 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityConfiguration) DeepCopyInto(out *PodSecurityConfiguration) {
+	*out = *in
+	if in.GardenNamespaceLevel != nil {
+		in, out := &in.GardenNamespaceLevel, &out.GardenNamespaceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootNamespacesLevel != nil {
+		in, out := &in.ShootNamespacesLevel, &out.ShootNamespacesLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuditLevel != nil {
+		in, out := &in.AuditLevel, &out.AuditLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Exemptions != nil {
+		in, out := &in.Exemptions, &out.Exemptions
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityConfiguration.
+func (in *PodSecurityConfiguration) DeepCopy() *PodSecurityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Remediation) DeepCopyInto(out *Remediation) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]RemediationAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxActionsPerShoot != nil {
+		in, out := &in.MaxActionsPerShoot, &out.MaxActionsPerShoot
+		*out = new(int)
+		**out = **in
+	}
+	if in.RateLimitPeriod != nil {
+		in, out := &in.RateLimitPeriod, &out.RateLimitPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Remediation.
+func (in *Remediation) DeepCopy() *Remediation {
+	if in == nil {
+		return nil
+	}
+	out := new(Remediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -904,6 +1236,11 @@ func (in *SNI) DeepCopyInto(out *SNI) {
 		*out = new(SNIIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(SNILimits)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -955,6 +1292,91 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNILimits) DeepCopyInto(out *SNILimits) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(SNIShootLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Purposes != nil {
+		in, out := &in.Purposes, &out.Purposes
+		*out = make(map[core.ShootPurpose]SNIShootLimits, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNILimits.
+func (in *SNILimits) DeepCopy() *SNILimits {
+	if in == nil {
+		return nil
+	}
+	out := new(SNILimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNIShootLimits) DeepCopyInto(out *SNIShootLimits) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionsPerSecond != nil {
+		in, out := &in.ConnectionsPerSecond, &out.ConnectionsPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionBufferLimit != nil {
+		in, out := &in.ConnectionBufferLimit, &out.ConnectionBufferLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNIShootLimits.
+func (in *SNIShootLimits) DeepCopy() *SNIShootLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(SNIShootLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapacityControllerConfiguration) DeepCopyInto(out *SeedCapacityControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LoadBalancerQuota != nil {
+		in, out := &in.LoadBalancerQuota, &out.LoadBalancerQuota
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapacityControllerConfiguration.
+func (in *SeedCapacityControllerConfiguration) DeepCopy() *SeedCapacityControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCapacityControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -968,6 +1390,11 @@ func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerC
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdaptiveSyncPeriod != nil {
+		in, out := &in.AdaptiveSyncPeriod, &out.AdaptiveSyncPeriod
+		*out = new(AdaptiveSyncPeriod)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1046,6 +1473,27 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedMonitoringConfig) DeepCopyInto(out *SeedMonitoringConfig) {
+	*out = *in
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = new(RemoteWriteMonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedMonitoringConfig.
+func (in *SeedMonitoringConfig) DeepCopy() *SeedMonitoringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedMonitoringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1121,6 +1569,31 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AdaptiveSyncPeriod != nil {
+		in, out := &in.AdaptiveSyncPeriod, &out.AdaptiveSyncPeriod
+		*out = new(AdaptiveSyncPeriod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdMemberRemediation != nil {
+		in, out := &in.EtcdMemberRemediation, &out.EtcdMemberRemediation
+		*out = new(EtcdMemberRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(Remediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = new(ShootHealthChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HibernationPreChecks != nil {
+		in, out := &in.HibernationPreChecks, &out.HibernationPreChecks
+		*out = make([]ShootHibernationPreCheck, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1151,6 +1624,42 @@ func (in *ShootClientConnection) DeepCopy() *ShootClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootConformanceTestControllerConfiguration) DeepCopyInto(out *ShootConformanceTestControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.Focus != nil {
+		in, out := &in.Focus, &out.Focus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Skip != nil {
+		in, out := &in.Skip, &out.Skip
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootConformanceTestControllerConfiguration.
+func (in *ShootConformanceTestControllerConfiguration) DeepCopy() *ShootConformanceTestControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootConformanceTestControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
@@ -1189,6 +1698,21 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectConcurrency != nil {
+		in, out := &in.ProjectConcurrency, &out.ProjectConcurrency
+		*out = new(ShootProjectConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowConcurrency != nil {
+		in, out := &in.FlowConcurrency, &out.FlowConcurrency
+		*out = new(ShootFlowConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1223,6 +1747,81 @@ func (in *ShootEventLogging) DeepCopy() *ShootEventLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootFlowConcurrency) DeepCopyInto(out *ShootFlowConcurrency) {
+	*out = *in
+	if in.MaxParallelTasks != nil {
+		in, out := &in.MaxParallelTasks, &out.MaxParallelTasks
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxParallelTasksPerClass != nil {
+		in, out := &in.MaxParallelTasksPerClass, &out.MaxParallelTasksPerClass
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootFlowConcurrency.
+func (in *ShootFlowConcurrency) DeepCopy() *ShootFlowConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootFlowConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHealthChecks) DeepCopyInto(out *ShootHealthChecks) {
+	*out = *in
+	if in.DisabledChecks != nil {
+		in, out := &in.DisabledChecks, &out.DisabledChecks
+		*out = make([]ShootHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeAgentLeaseStalenessThreshold != nil {
+		in, out := &in.NodeAgentLeaseStalenessThreshold, &out.NodeAgentLeaseStalenessThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExpiredNodeLeasesThresholdPercentage != nil {
+		in, out := &in.ExpiredNodeLeasesThresholdPercentage, &out.ExpiredNodeLeasesThresholdPercentage
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHealthChecks.
+func (in *ShootHealthChecks) DeepCopy() *ShootHealthChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHealthChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHibernationPreCheck) DeepCopyInto(out *ShootHibernationPreCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHibernationPreCheck.
+func (in *ShootHibernationPreCheck) DeepCopy() *ShootHibernationPreCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHibernationPreCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 	*out = *in
@@ -1277,6 +1876,65 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootProjectConcurrency) DeepCopyInto(out *ShootProjectConcurrency) {
+	*out = *in
+	if in.MaxConcurrentReconciles != nil {
+		in, out := &in.MaxConcurrentReconciles, &out.MaxConcurrentReconciles
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectNamespaces != nil {
+		in, out := &in.ProjectNamespaces, &out.ProjectNamespaces
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueDelay != nil {
+		in, out := &in.RequeueDelay, &out.RequeueDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootProjectConcurrency.
+func (in *ShootProjectConcurrency) DeepCopy() *ShootProjectConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootProjectConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootResourceUsageControllerConfiguration) DeepCopyInto(out *ShootResourceUsageControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootResourceUsageControllerConfiguration.
+func (in *ShootResourceUsageControllerConfiguration) DeepCopy() *ShootResourceUsageControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootResourceUsageControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
+	workloadidentitytokenrequestor "github.com/gardener/gardener/pkg/controller/tokenrequestor/workloadidentity"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
@@ -33,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/vpaevictionrequirements"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	"github.com/gardener/gardener/pkg/healthz"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -47,6 +49,7 @@ func AddToManager(
 	shootClientMap clientmap.ClientMap,
 	cfg *config.GardenletConfiguration,
 	healthManager healthz.Manager,
+	settings *reload.Settings,
 ) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
@@ -109,15 +112,24 @@ func AddToManager(
 		return fmt.Errorf("failed adding ManagedSeed controller: %w", err)
 	}
 
+	if cfg.Controllers.ManagedSeed.DriftDetectionPeriod != nil && cfg.Controllers.ManagedSeed.DriftDetectionPeriod.Duration > 0 {
+		if err := (&managedseed.DriftReconciler{
+			Config:         *cfg.Controllers.ManagedSeed,
+			ShootClientMap: shootClientMap,
+		}).AddToManager(ctx, mgr, gardenCluster, cfg.SeedConfig.SeedTemplate.Name); err != nil {
+			return fmt.Errorf("failed adding ManagedSeed drift controller: %w", err)
+		}
+	}
+
 	if err := networkpolicy.AddToManager(ctx, mgr, gardenletCancel, seedCluster, *cfg.Controllers.NetworkPolicy, cfg.SeedConfig.Spec.Networks, nil); err != nil {
 		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
 	}
 
-	if err := seed.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager); err != nil {
+	if err := seed.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, healthManager, settings); err != nil {
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
 
-	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity); err != nil {
+	if err := shoot.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, shootClientMap, *cfg, identity, gardenClusterIdentity, settings); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
@@ -135,5 +147,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding token requestor controller: %w", err)
 	}
 
+	if err := (&workloadidentitytokenrequestor.Reconciler{
+		ConcurrentSyncs: ptr.Deref(cfg.Controllers.TokenRequestor.ConcurrentSyncs, 0),
+		Clock:           clock.RealClock{},
+		JitterFunc:      wait.Jitter,
+	}).AddToManager(mgr, seedCluster, gardenCluster); err != nil {
+		return fmt.Errorf("failed adding workload identity token requestor controller: %w", err)
+	}
+
 	return nil
 }
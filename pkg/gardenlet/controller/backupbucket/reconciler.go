@@ -108,7 +108,7 @@ func (r *Reconciler) reconcileBackupBucket(
 	gardenSecret, err := kubernetesutils.GetSecretByReference(gardenCtx, r.GardenClient, &backupBucket.Spec.SecretRef)
 	if err != nil {
 		log.Error(err, "Failed to get backup secret", "secret", client.ObjectKey{Namespace: backupBucket.Spec.SecretRef.Namespace, Name: backupBucket.Spec.SecretRef.Name})
-		r.Recorder.Eventf(backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, "Failed to get backup secret %s/%s: %w", backupBucket.Spec.SecretRef.Namespace, backupBucket.Spec.SecretRef.Name, err)
+		controllerutils.RecordEventf(gardenCtx, r.Recorder, backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, "Failed to get backup secret %s/%s: %v", backupBucket.Spec.SecretRef.Namespace, backupBucket.Spec.SecretRef.Name, err)
 		return err
 	}
 
@@ -216,7 +216,7 @@ func (r *Reconciler) reconcileBackupBucket(
 			Description: lastObservedError.Error(),
 		}
 
-		r.Recorder.Event(backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
+		controllerutils.RecordEvent(gardenCtx, r.Recorder, backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
 
 		if updateErr := r.updateBackupBucketStatusError(gardenCtx, backupBucket, reconcileErr.Description, reconcileErr); updateErr != nil {
 			return fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
@@ -289,7 +289,7 @@ func (r *Reconciler) deleteBackupBucket(
 		}
 	} else if err == nil {
 		if lastError := extensionBackupBucket.Status.LastError; lastError != nil {
-			r.Recorder.Event(backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description)
+			controllerutils.RecordEvent(gardenCtx, r.Recorder, backupBucket, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description, lastError.Codes...)
 
 			if updateErr := r.updateBackupBucketStatusError(gardenCtx, backupBucket, lastError.Description+" Operation will be retried.", lastError); updateErr != nil {
 				return reconcile.Result{}, fmt.Errorf("could not update status after deletion error: %w", updateErr)
@@ -96,7 +96,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.migrateBackupEntry(gardenCtx, seedCtx, log, backupEntry)
 	}
 
-	return reconcile.Result{}, r.reconcileBackupEntry(gardenCtx, seedCtx, log, backupEntry)
+	return r.reconcileBackupEntry(gardenCtx, seedCtx, log, backupEntry)
 }
 
 func (r *Reconciler) reconcileBackupEntry(
@@ -104,17 +104,20 @@ func (r *Reconciler) reconcileBackupEntry(
 	seedCtx context.Context,
 	log logr.Logger,
 	backupEntry *gardencorev1beta1.BackupEntry,
-) error {
+) (
+	reconcile.Result,
+	error,
+) {
 	if !controllerutil.ContainsFinalizer(backupEntry, gardencorev1beta1.GardenerName) {
 		log.Info("Adding finalizer")
 		if err := controllerutils.AddFinalizers(gardenCtx, r.GardenClient, backupEntry, gardencorev1beta1.GardenerName); err != nil {
-			return fmt.Errorf("failed to add finalizer: %w", err)
+			return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
 		}
 	}
 
 	operationType := v1beta1helper.ComputeOperationType(backupEntry.ObjectMeta, backupEntry.Status.LastOperation)
 	if updateErr := r.updateBackupEntryStatusOperationStart(gardenCtx, backupEntry, operationType); updateErr != nil {
-		return fmt.Errorf("could not update status after reconciliation start: %w", updateErr)
+		return reconcile.Result{}, fmt.Errorf("could not update status after reconciliation start: %w", updateErr)
 	}
 
 	var (
@@ -145,24 +148,24 @@ func (r *Reconciler) reconcileBackupEntry(
 			Description: err.Error(),
 		}
 
-		r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
+		controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
 
 		if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, operationType, reconcileErr.Description, reconcileErr); updateErr != nil {
-			return fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
+			return reconcile.Result{}, fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
 		}
 
 		// the backupEntry will be requeued when the state of the BackupBucket changes to Succeeded
-		return nil
+		return reconcile.Result{}, nil
 	}
 
 	gardenSecret, err := r.getGardenSecret(gardenCtx, backupBucket)
 	if err != nil {
-		return err
+		return reconcile.Result{}, err
 	}
 
 	if err := r.SeedClient.Get(seedCtx, client.ObjectKeyFromObject(extensionSecret), extensionSecret); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return err
+			return reconcile.Result{}, err
 		}
 		// if the extension secret doesn't exist yet, create it
 		mustReconcileExtensionSecret = true
@@ -180,7 +183,7 @@ func (r *Reconciler) reconcileBackupEntry(
 
 	if mustReconcileExtensionSecret {
 		if err := r.reconcileBackupEntryExtensionSecret(seedCtx, extensionSecret, gardenSecret); err != nil {
-			return err
+			return reconcile.Result{}, err
 		}
 	}
 
@@ -200,7 +203,7 @@ func (r *Reconciler) reconcileBackupEntry(
 
 	secretLastUpdateTime, err := time.Parse(time.RFC3339Nano, extensionSecret.Annotations[v1beta1constants.GardenerTimestamp])
 	if err != nil {
-		return err
+		return reconcile.Result{}, err
 	}
 
 	// truncate the secret timestamp because extension.Status.LastOperation.LastUpdateTime
@@ -209,10 +212,14 @@ func (r *Reconciler) reconcileBackupEntry(
 
 	if err := r.SeedClient.Get(seedCtx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return err
+			return reconcile.Result{}, err
 		}
 		// if the extension BackupEntry doesn't exist yet, create it
 		mustReconcileExtensionBackupEntry = true
+	} else if extensionBackupEntry.Spec.Type != extensionBackupEntrySpec.Type {
+		// The type of an extension BackupEntry is immutable. If the BackupEntry was moved to a BackupBucket of a different
+		// provider, the extension BackupEntry has to be replaced.
+		return r.replaceExtensionBackupEntry(seedCtx, log, extensionBackupEntry, component)
 	} else if !reflect.DeepEqual(extensionBackupEntry.Spec, extensionBackupEntrySpec) ||
 		(extensionBackupEntry.Status.LastOperation != nil && extensionBackupEntry.Status.LastOperation.LastUpdateTime.Time.UTC().Before(secretLastUpdateTime)) {
 		// if the spec of the extensionBackupEntry has changed or it has not been reconciled after the last updation of secret, reconcile it
@@ -246,34 +253,34 @@ func (r *Reconciler) reconcileBackupEntry(
 			Description: lastObservedError.Error(),
 		}
 
-		r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
+		controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
 
 		if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, operationType, reconcileErr.Description, reconcileErr); updateErr != nil {
-			return fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
+			return reconcile.Result{}, fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
 		}
 	}
 
 	if mustReconcileExtensionBackupEntry {
 		if err := r.reconcileBackupEntryExtension(gardenCtx, seedCtx, backupBucket, backupEntry, component); err != nil {
-			return err
+			return reconcile.Result{}, err
 		}
 		// return early here, the BackupEntry status will be updated by the reconciliation caused by the extension BackupEntry status update.
-		return nil
+		return reconcile.Result{}, nil
 	}
 
 	if extensionBackupEntry.Status.LastOperation != nil && extensionBackupEntry.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
 		if updateErr := r.updateBackupEntryStatusSucceeded(gardenCtx, backupEntry, operationType); updateErr != nil {
-			return fmt.Errorf("could not update status after reconciliation success: %w", updateErr)
+			return reconcile.Result{}, fmt.Errorf("could not update status after reconciliation success: %w", updateErr)
 		}
 
 		if kubernetesutils.HasMetaDataAnnotation(&backupEntry.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationRestore) {
 			if updateErr := removeGardenerOperationAnnotation(gardenCtx, r.GardenClient, backupEntry); updateErr != nil {
-				return fmt.Errorf("could not remove %q annotation: %w", v1beta1constants.GardenerOperation, updateErr)
+				return reconcile.Result{}, fmt.Errorf("could not remove %q annotation: %w", v1beta1constants.GardenerOperation, updateErr)
 			}
 		}
 	}
 
-	return nil
+	return reconcile.Result{}, nil
 }
 
 func (r *Reconciler) deleteBackupEntry(
@@ -311,7 +318,7 @@ func (r *Reconciler) deleteBackupEntry(
 				Description: err.Error(),
 			}
 
-			r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
+			controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, reconcileErr.Description)
 
 			if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, operationType, reconcileErr.Description, reconcileErr); updateErr != nil {
 				return reconcile.Result{}, fmt.Errorf("could not update status after reconciliation error: %w", updateErr)
@@ -347,7 +354,7 @@ func (r *Reconciler) deleteBackupEntry(
 			}
 		} else if err == nil {
 			if lastError := extensionBackupEntry.Status.LastError; lastError != nil {
-				r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description)
+				controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description, lastError.Codes...)
 
 				if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, operationType, lastError.Description, lastError); updateErr != nil {
 					return reconcile.Result{}, fmt.Errorf("could not update status after deletion error: %w", updateErr)
@@ -443,7 +450,7 @@ func (r *Reconciler) migrateBackupEntry(
 					Description: lastError.Error(),
 				}
 
-				r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, migrateError.Description)
+				controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, migrateError.Description)
 
 				description := migrateError.Description
 				if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, gardencorev1beta1.LastOperationTypeMigrate, description, migrateError); updateErr != nil {
@@ -462,7 +469,7 @@ func (r *Reconciler) migrateBackupEntry(
 			}
 		case gardencorev1beta1.LastOperationTypeDelete:
 			if lastError := extensionBackupEntry.Status.LastError; lastError != nil {
-				r.Recorder.Event(backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description)
+				controllerutils.RecordEvent(gardenCtx, r.Recorder, backupEntry, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastError.Description, lastError.Codes...)
 
 				if updateErr := r.updateBackupEntryStatusError(gardenCtx, backupEntry, gardencorev1beta1.LastOperationTypeDelete, lastError.Description, lastError); updateErr != nil {
 					return reconcile.Result{}, fmt.Errorf("could not update status after deletion error: %w", updateErr)
@@ -487,6 +494,47 @@ func (r *Reconciler) migrateBackupEntry(
 	return reconcile.Result{}, nil
 }
 
+// replaceExtensionBackupEntry migrates the given extension BackupEntry and deletes it afterwards. Migrating it first
+// makes the responsible extension release the object without deleting the backups in the BackupBucket, so that they can
+// still be copied to the new BackupBucket. The extension BackupEntry is recreated in a subsequent reconciliation.
+func (r *Reconciler) replaceExtensionBackupEntry(
+	seedCtx context.Context,
+	log logr.Logger,
+	extensionBackupEntry *extensionsv1alpha1.BackupEntry,
+	component extensionsbackupentry.Interface,
+) (
+	reconcile.Result,
+	error,
+) {
+	if extensionBackupEntry.DeletionTimestamp != nil {
+		log.Info("Extension BackupEntry of previous provider not yet deleted", "extensionBackupEntry", client.ObjectKeyFromObject(extensionBackupEntry))
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, nil
+	}
+
+	lastOperation := extensionBackupEntry.Status.LastOperation
+	if lastOperation == nil || lastOperation.Type != gardencorev1beta1.LastOperationTypeMigrate {
+		log.Info("Migrating extension BackupEntry of previous provider", "extensionBackupEntry", client.ObjectKeyFromObject(extensionBackupEntry), "type", extensionBackupEntry.Spec.Type)
+		return reconcile.Result{}, component.Migrate(seedCtx)
+	}
+
+	switch lastOperation.State {
+	case gardencorev1beta1.LastOperationStateSucceeded:
+		log.Info("Deleting migrated extension BackupEntry of previous provider", "extensionBackupEntry", client.ObjectKeyFromObject(extensionBackupEntry))
+		if err := component.Destroy(seedCtx); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, nil
+
+	case gardencorev1beta1.LastOperationStateError, gardencorev1beta1.LastOperationStateFailed:
+		if lastError := extensionBackupEntry.Status.LastError; lastError != nil {
+			return reconcile.Result{}, v1beta1helper.NewErrorWithCodes(fmt.Errorf("error during migration of extension BackupEntry of previous provider: %s", lastError.Description), lastError.Codes...)
+		}
+		return reconcile.Result{}, fmt.Errorf("migration of extension BackupEntry of previous provider is not Succeeded but %v", lastOperation.State)
+	}
+
+	return reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}, nil
+}
+
 func (r *Reconciler) updateBackupEntryStatusOperationStart(ctx context.Context, be *gardencorev1beta1.BackupEntry, operationType gardencorev1beta1.LastOperationType) error {
 	var description string
 
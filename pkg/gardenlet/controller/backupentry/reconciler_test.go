@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 const (
@@ -264,4 +265,53 @@ var _ = Describe("Controller", func() {
 		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
 		Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
 	})
+	It("should migrate, delete and recreate the extension BackupEntry if the provider type of the BackupBucket changed", func() {
+		extensionBackupEntry.Spec.Type = "previous-provider-type"
+		Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+		Expect(seedClient.Create(ctx, extensionBackupEntry)).To(Succeed())
+
+		By("Migrate extension BackupEntry of previous provider")
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+		Expect(extensionBackupEntry.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationMigrate))
+		Expect(extensionBackupEntry.Spec.Type).To(Equal("previous-provider-type"))
+
+		By("Delete migrated extension BackupEntry of previous provider")
+		extensionBackupEntry.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeMigrate,
+			State: gardencorev1beta1.LastOperationStateSucceeded,
+		}
+		Expect(seedClient.Update(ctx, extensionBackupEntry)).To(Succeed())
+
+		result, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: RequeueDurationWhenResourceDeletionStillPresent}))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(BeNotFoundError())
+
+		By("Recreate extension BackupEntry for new provider")
+		result, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+		Expect(extensionBackupEntry.Spec.Type).To(Equal(backupBucket.Spec.Provider.Type))
+	})
+
+	It("should report an error if the migration of the extension BackupEntry of the previous provider failed", func() {
+		extensionBackupEntry.Spec.Type = "previous-provider-type"
+		extensionBackupEntry.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeMigrate,
+			State: gardencorev1beta1.LastOperationStateError,
+		}
+		extensionBackupEntry.Status.LastError = &gardencorev1beta1.LastError{Description: "some error"}
+		Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+		Expect(seedClient.Create(ctx, extensionBackupEntry)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("error during migration of extension BackupEntry of previous provider: some error")))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupEntry), extensionBackupEntry)).To(Succeed())
+	})
 })
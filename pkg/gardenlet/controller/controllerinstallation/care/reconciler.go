@@ -71,9 +71,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if err := r.SeedClient.Get(seedCtx, client.ObjectKeyFromObject(managedResource), managedResource); err != nil {
 		msg := fmt.Sprintf("Failed to get ManagedResource %q: %s", client.ObjectKeyFromObject(managedResource).String(), err.Error())
-		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionUnknown, "SeedReadError", msg)
-		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionUnknown, "SeedReadError", msg)
-		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionUnknown, "SeedReadError", msg)
+		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionUnknown, gardencorev1beta1.SeedReadError, msg)
+		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionUnknown, gardencorev1beta1.SeedReadError, msg)
+		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionUnknown, gardencorev1beta1.SeedReadError, msg)
 
 		patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
 		controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditionControllerInstallationHealthy, conditionControllerInstallationInstalled, conditionControllerInstallationProgressing)
@@ -90,21 +90,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if err := health.CheckManagedResourceApplied(managedResource); err != nil {
-		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionFalse, "InstallationPending", err.Error())
+		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionFalse, gardencorev1beta1.InstallationPending, err.Error())
 	} else {
-		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionTrue, "InstallationSuccessful", "The controller was successfully installed in the seed cluster.")
+		conditionControllerInstallationInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationInstalled, gardencorev1beta1.ConditionTrue, gardencorev1beta1.InstallationSuccessful, "The controller was successfully installed in the seed cluster.")
 	}
 
 	if err := health.CheckManagedResourceHealthy(managedResource); err != nil {
-		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionFalse, "ControllerNotHealthy", err.Error())
+		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ControllerNotHealthy, err.Error())
 	} else {
-		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionTrue, "ControllerHealthy", "The controller running in the seed cluster is healthy.")
+		conditionControllerInstallationHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationHealthy, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ControllerHealthy, "The controller running in the seed cluster is healthy.")
 	}
 
 	if err := health.CheckManagedResourceProgressing(managedResource); err != nil {
-		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionTrue, "ControllerNotRolledOut", err.Error())
+		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ControllerNotRolledOut, err.Error())
 	} else {
-		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionFalse, "ControllerRolledOut", "The controller has been rolled out successfully.")
+		conditionControllerInstallationProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionControllerInstallationProgressing, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ControllerRolledOut, "The controller has been rolled out successfully.")
 	}
 
 	patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
@@ -66,7 +66,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	_, gardenletConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(gardenlet.Name, &gardenlet.Spec.Config)
 	if err != nil {
-		r.Recorder.Eventf(gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
+		controllerutils.RecordEventf(ctx, r.Recorder, gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
 		updateCondition(r.Clock, status, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconcileError, err.Error())
 		if updateErr := r.updateStatus(ctx, gardenlet, status); updateErr != nil {
 			log.Error(updateErr, "Could not update status")
@@ -76,7 +76,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	seed, err := gardenletdeployer.GetSeed(ctx, r.GardenClient, gardenlet.Name)
 	if err != nil {
-		r.Recorder.Eventf(gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
+		controllerutils.RecordEventf(ctx, r.Recorder, gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
 		updateCondition(r.Clock, status, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconcileError, err.Error())
 		if updateErr := r.updateStatus(ctx, gardenlet, status); updateErr != nil {
 			log.Error(updateErr, "Could not update status")
@@ -85,9 +85,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Info("Deploying gardenlet")
-	r.Recorder.Eventf(gardenlet, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, "Deploying gardenlet")
+	controllerutils.RecordEventf(ctx, r.Recorder, gardenlet, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, "Deploying gardenlet")
 	if err := r.deployGardenlet(ctx, log, gardenlet, seed, gardenletConfig); err != nil {
-		r.Recorder.Eventf(gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
+		controllerutils.RecordEventf(ctx, r.Recorder, gardenlet, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, err.Error())
 		updateCondition(r.Clock, status, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconcileError, err.Error())
 		if updateErr := r.updateStatus(ctx, gardenlet, status); updateErr != nil {
 			log.Error(updateErr, "Could not update status")
@@ -96,7 +96,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.V(1).Info("Reconciliation finished")
-	r.Recorder.Eventf(gardenlet, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, "Gardenlet has been deployed")
+	controllerutils.RecordEventf(ctx, r.Recorder, gardenlet, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, "Gardenlet has been deployed")
 	updateCondition(r.Clock, status, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EventReconciled, "Gardenlet with chart from "+gardenlet.Spec.Deployment.Helm.OCIRepository.GetURL()+" has been deployed")
 	if updateErr := r.updateStatus(ctx, gardenlet, status); updateErr != nil {
 		log.Error(updateErr, "Could not update status")
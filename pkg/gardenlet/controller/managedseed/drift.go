@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// computeDrift compares the given desired and actual objects and returns the paths of all fields which are declared in
+// the desired object but have a different value in the actual object. Fields which are only present in the actual
+// object (e.g., because they were defaulted) are not considered as drift. Lists are compared element by element, hence
+// lists with a different length are reported as drift as a whole.
+func computeDrift(path string, desired, actual any) ([]string, error) {
+	desiredValue, err := toUnstructured(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting desired object at %s: %w", path, err)
+	}
+	actualValue, err := toUnstructured(actual)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting actual object at %s: %w", path, err)
+	}
+
+	return compareValues(path, desiredValue, actualValue), nil
+}
+
+func toUnstructured(obj any) (any, error) {
+	if raw, ok := obj.([]byte); ok {
+		var out any
+		return out, json.Unmarshal(raw, &out)
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	return out, json.Unmarshal(raw, &out)
+}
+
+func compareValues(path string, desired, actual any) []string {
+	switch desiredValue := desired.(type) {
+	case nil:
+		return nil
+
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			if len(desiredValue) == 0 {
+				return nil
+			}
+			return []string{path}
+		}
+
+		keys := make([]string, 0, len(desiredValue))
+		for key := range desiredValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var drift []string
+		for _, key := range keys {
+			drift = append(drift, compareValues(path+"."+key, desiredValue[key], actualValue[key])...)
+		}
+		return drift
+
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok {
+			if len(desiredValue) == 0 {
+				return nil
+			}
+			return []string{path}
+		}
+		if len(desiredValue) != len(actualValue) {
+			return []string{path}
+		}
+
+		var drift []string
+		for i := range desiredValue {
+			drift = append(drift, compareValues(fmt.Sprintf("%s[%d]", path, i), desiredValue[i], actualValue[i])...)
+		}
+		return drift
+
+	case string:
+		// Empty strings are treated like unset fields since not all fields of the declared configuration are optional.
+		if desiredValue == "" {
+			return nil
+		}
+		if actualValue, ok := actual.(string); ok && equalQuantities(desiredValue, actualValue) {
+			return nil
+		}
+	}
+
+	if reflect.DeepEqual(desired, actual) {
+		return nil
+	}
+	// Fields with zero values might be omitted in the actual object.
+	if actual == nil && isZero(desired) {
+		return nil
+	}
+	return []string{path}
+}
+
+func isZero(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	}
+	return false
+}
+
+// equalQuantities returns true if both strings are equal or if both are resource quantities with the same value. This
+// is needed because quantities might be serialized in their canonical form by the API server.
+func equalQuantities(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	quantityA, err := resource.ParseQuantity(a)
+	if err != nil {
+		return false
+	}
+	quantityB, err := resource.ParseQuantity(b)
+	if err != nil {
+		return false
+	}
+	return quantityA.Cmp(quantityB) == 0
+}
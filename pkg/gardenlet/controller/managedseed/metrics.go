@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardenlet"
+	metricsSubsystem = "managedseed"
+
+	// DriftTargetSeed is the value of the target label for drift of the seed.
+	DriftTargetSeed = "seed"
+	// DriftTargetGardenlet is the value of the target label for drift of the gardenlet deployed into the managed seed.
+	DriftTargetGardenlet = "gardenlet"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// DriftedFields is the metric for the number of fields of a ManagedSeed which were changed out-of-band.
+	// Exposed for testing.
+	DriftedFields = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "drifted_fields",
+			Help:      "Number of fields of the seed or gardenlet of a ManagedSeed which differ from the declared configuration.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"target",
+		},
+	)
+
+	// DriftDetectionTimestamp is the metric for the time of the last successful drift detection of a ManagedSeed.
+	// Exposed for testing.
+	DriftDetectionTimestamp = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "drift_detection_timestamp_seconds",
+			Help:      "Unix timestamp of the last successful drift detection of a ManagedSeed.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+)
+
+func recordDriftMetrics(namespace, name string, drift map[string][]string, timestamp float64) {
+	for _, target := range []string{DriftTargetSeed, DriftTargetGardenlet} {
+		DriftedFields.WithLabelValues(namespace, name, target).Set(float64(len(drift[target])))
+	}
+	DriftDetectionTimestamp.WithLabelValues(namespace, name).Set(timestamp)
+}
+
+func deleteDriftMetrics(namespace, name string) {
+	DriftedFields.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+	DriftDetectionTimestamp.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+}
@@ -180,7 +180,7 @@ func (r *Reconciler) reconcile(
 		log.Info("Waiting for shoot to be reconciled")
 
 		msg := fmt.Sprintf("Waiting for shoot %q to be reconciled", client.ObjectKeyFromObject(shoot).String())
-		r.Recorder.Event(ms, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, msg)
+		controllerutils.RecordEvent(ctx, r.Recorder, ms, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, msg)
 		updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedShootReconciled, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconciling, msg)
 
 		return reconcile.Result{RequeueAfter: r.Config.Controllers.ManagedSeed.WaitSyncPeriod.Duration}, r.updateStatus(ctx, ms, status)
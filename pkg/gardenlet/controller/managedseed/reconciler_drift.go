@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+)
+
+const (
+	// EventDriftDetected is the reason for events and conditions if drift of a ManagedSeed was detected.
+	EventDriftDetected = "DriftDetected"
+	// EventNoDriftDetected is the reason for conditions if no drift of a ManagedSeed was detected.
+	EventNoDriftDetected = "NoDriftDetected"
+	// EventDriftDetectionFailed is the reason for conditions if the drift detection of a ManagedSeed failed.
+	EventDriftDetectionFailed = "DriftDetectionFailed"
+
+	gardenletDeploymentName = "gardenlet"
+	gardenletConfigVolume   = "gardenlet-config"
+	gardenletConfigKey      = "config.yaml"
+
+	// maxReportedDriftedFields is the maximum number of drifted fields listed in the condition message.
+	maxReportedDriftedFields = 10
+)
+
+// DriftReconciler periodically compares the actual seed and gardenlet configuration of ManagedSeeds against the
+// declared configuration and reports fields which were changed out-of-band in the ManagedSeed status.
+type DriftReconciler struct {
+	GardenClient          client.Client
+	Config                config.ManagedSeedControllerConfiguration
+	Clock                 clock.Clock
+	ShootClientMap        clientmap.ClientMap
+	GardenNamespaceGarden string
+	GardenNamespaceShoot  string
+}
+
+// Reconcile performs the drift detection.
+func (r *DriftReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	ms := &seedmanagementv1alpha1.ManagedSeed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, ms); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteDriftMetrics(request.Namespace, request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if ms.DeletionTimestamp != nil {
+		log.V(1).Info("Object is being deleted, stop reconciling")
+		deleteDriftMetrics(ms.Namespace, ms.Name)
+		return reconcile.Result{}, nil
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: ms.Name}, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Seed has not been registered yet, skipping drift detection")
+			return reconcile.Result{RequeueAfter: r.Config.DriftDetectionPeriod.Duration}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed getting seed %s: %w", ms.Name, err)
+	}
+
+	log.V(1).Info("Detecting drift")
+	drift, err := r.detectDrift(ctx, ms, seed)
+	if err != nil {
+		if updateErr := r.updateCondition(ctx, ms, gardencorev1beta1.ConditionUnknown, EventDriftDetectionFailed, err.Error()); updateErr != nil {
+			log.Error(updateErr, "Could not update status")
+		}
+		return reconcile.Result{}, fmt.Errorf("failed detecting drift of ManagedSeed %s: %w", client.ObjectKeyFromObject(ms), err)
+	}
+
+	recordDriftMetrics(ms.Namespace, ms.Name, drift, float64(r.Clock.Now().Unix()))
+
+	var driftedFields []string
+	for _, target := range []string{DriftTargetSeed, DriftTargetGardenlet} {
+		driftedFields = append(driftedFields, drift[target]...)
+	}
+
+	if len(driftedFields) == 0 {
+		log.V(1).Info("No drift detected")
+		return reconcile.Result{RequeueAfter: r.Config.DriftDetectionPeriod.Duration}, r.updateCondition(ctx, ms, gardencorev1beta1.ConditionTrue, EventNoDriftDetected, "The seed and gardenlet conform to the declared configuration.")
+	}
+
+	log.Info("Drift detected", "driftedFields", driftedFields)
+	return reconcile.Result{RequeueAfter: r.Config.DriftDetectionPeriod.Duration}, r.updateCondition(ctx, ms, gardencorev1beta1.ConditionFalse, EventDriftDetected, driftMessage(driftedFields))
+}
+
+func (r *DriftReconciler) detectDrift(ctx context.Context, ms *seedmanagementv1alpha1.ManagedSeed, seed *gardencorev1beta1.Seed) (map[string][]string, error) {
+	if ms.Spec.Gardenlet == nil {
+		return nil, nil
+	}
+
+	seedTemplate, gardenletConfig, err := helper.ExtractSeedTemplateAndGardenletConfig(ms.Name, helper.GardenletConfigFromManagedSeed(ms.Spec.Gardenlet))
+	if err != nil {
+		return nil, err
+	}
+
+	seedDrift, err := detectSeedDrift(seedTemplate, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	gardenletDrift, err := r.detectGardenletDrift(ctx, ms, gardenletConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]string{
+		DriftTargetSeed:      seedDrift,
+		DriftTargetGardenlet: gardenletDrift,
+	}, nil
+}
+
+func detectSeedDrift(seedTemplate *gardencorev1beta1.SeedTemplate, seed *gardencorev1beta1.Seed) ([]string, error) {
+	labelsDrift, err := computeDrift("seed.metadata.labels", seedTemplate.Labels, seed.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	specDrift, err := computeDrift("seed.spec", seedTemplate.Spec, seed.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(labelsDrift, specDrift...), nil
+}
+
+// gardenletDeploymentState contains the fields of the gardenlet deployment which are compared for detecting drift.
+type gardenletDeploymentState struct {
+	ReplicaCount   *int32                       `json:"replicaCount,omitempty"`
+	Image          string                       `json:"image,omitempty"`
+	Resources      *corev1.ResourceRequirements `json:"resources,omitempty"`
+	PodLabels      map[string]string            `json:"podLabels,omitempty"`
+	PodAnnotations map[string]string            `json:"podAnnotations,omitempty"`
+	Env            map[string]corev1.EnvVar     `json:"env,omitempty"`
+}
+
+func (r *DriftReconciler) detectGardenletDrift(ctx context.Context, ms *seedmanagementv1alpha1.ManagedSeed, gardenletConfig *gardenletv1alpha1.GardenletConfiguration) ([]string, error) {
+	if ms.Spec.Shoot == nil {
+		return nil, nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: ms.Namespace, Name: ms.Spec.Shoot.Name}, shoot); err != nil {
+		return nil, fmt.Errorf("failed getting shoot %s: %w", ms.Spec.Shoot.Name, err)
+	}
+
+	shootClient, err := r.ShootClientMap.GetClient(ctx, keys.ForShoot(shoot))
+	if err != nil {
+		return nil, fmt.Errorf("failed getting client for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := shootClient.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespaceShoot, Name: gardenletDeploymentName}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return []string{"gardenlet.deployment"}, nil
+		}
+		return nil, fmt.Errorf("failed getting gardenlet deployment: %w", err)
+	}
+
+	deploymentDrift, err := computeDrift("gardenlet.deployment", desiredGardenletDeploymentState(ms.Spec.Gardenlet.Deployment), actualGardenletDeploymentState(deployment))
+	if err != nil {
+		return nil, err
+	}
+
+	var configMapName string
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == gardenletConfigVolume && volume.ConfigMap != nil {
+			configMapName = volume.ConfigMap.Name
+		}
+	}
+	if configMapName == "" {
+		return append(deploymentDrift, "gardenlet.config"), nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := shootClient.Client().Get(ctx, client.ObjectKey{Namespace: r.GardenNamespaceShoot, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(deploymentDrift, "gardenlet.config"), nil
+		}
+		return nil, fmt.Errorf("failed getting gardenlet config map %s: %w", configMapName, err)
+	}
+
+	actualConfig, err := yaml.YAMLToJSON([]byte(configMap.Data[gardenletConfigKey]))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading gardenlet config from config map %s: %w", configMapName, err)
+	}
+
+	configDrift, err := computeDrift("gardenlet.config", desiredGardenletConfig(gardenletConfig), actualConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(deploymentDrift, configDrift...), nil
+}
+
+// desiredGardenletConfig returns the gardenlet configuration without the fields which are computed while deploying
+// gardenlet or which are covered by the seed drift detection.
+func desiredGardenletConfig(gardenletConfig *gardenletv1alpha1.GardenletConfiguration) *gardenletv1alpha1.GardenletConfiguration {
+	desired := gardenletConfig.DeepCopy()
+	desired.TypeMeta.APIVersion = ""
+	desired.TypeMeta.Kind = ""
+	desired.GardenClientConnection = nil
+	desired.SeedClientConnection = nil
+	desired.ShootClientConnection = nil
+	desired.SeedConfig = nil
+	return desired
+}
+
+func desiredGardenletDeploymentState(deployment *seedmanagementv1alpha1.GardenletDeployment) gardenletDeploymentState {
+	if deployment == nil {
+		return gardenletDeploymentState{}
+	}
+
+	state := gardenletDeploymentState{
+		ReplicaCount:   deployment.ReplicaCount,
+		Resources:      deployment.Resources,
+		PodLabels:      deployment.PodLabels,
+		PodAnnotations: deployment.PodAnnotations,
+		Env:            envVarsByName(deployment.Env),
+	}
+
+	if image := deployment.Image; image != nil && image.Repository != nil && image.Tag != nil {
+		separator := ":"
+		if strings.HasPrefix(*image.Tag, "sha256:") {
+			separator = "@"
+		}
+		state.Image = *image.Repository + separator + *image.Tag
+	}
+
+	return state
+}
+
+func actualGardenletDeploymentState(deployment *appsv1.Deployment) gardenletDeploymentState {
+	state := gardenletDeploymentState{
+		ReplicaCount:   deployment.Spec.Replicas,
+		PodLabels:      deployment.Spec.Template.Labels,
+		PodAnnotations: deployment.Spec.Template.Annotations,
+	}
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == gardenletDeploymentName {
+			state.Image = container.Image
+			state.Resources = container.Resources.DeepCopy()
+			state.Env = envVarsByName(container.Env)
+		}
+	}
+
+	return state
+}
+
+func envVarsByName(envVars []corev1.EnvVar) map[string]corev1.EnvVar {
+	if len(envVars) == 0 {
+		return nil
+	}
+
+	out := make(map[string]corev1.EnvVar, len(envVars))
+	for _, envVar := range envVars {
+		out[envVar.Name] = envVar
+	}
+	return out
+}
+
+func driftMessage(driftedFields []string) string {
+	fields := driftedFields
+	if len(fields) > maxReportedDriftedFields {
+		fields = fields[:maxReportedDriftedFields]
+	}
+
+	msg := fmt.Sprintf("%d field(s) differ from the declared configuration: %s", len(driftedFields), strings.Join(fields, ", "))
+	if len(driftedFields) > maxReportedDriftedFields {
+		msg += fmt.Sprintf(" (and %d more)", len(driftedFields)-maxReportedDriftedFields)
+	}
+	return msg
+}
+
+func (r *DriftReconciler) updateCondition(ctx context.Context, ms *seedmanagementv1alpha1.ManagedSeed, cs gardencorev1beta1.ConditionStatus, reason, message string) error {
+	status := ms.Status.DeepCopy()
+	updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedConfigurationConformant, cs, reason, message)
+
+	patch := client.StrategicMergeFrom(ms.DeepCopy())
+	ms.Status.Conditions = status.Conditions
+	return r.GardenClient.Status().Patch(ctx, ms, patch)
+}
@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+)
+
+var _ = Describe("DriftReconciler", func() {
+	const (
+		driftDetectionPeriod = 10 * time.Minute
+		configMapName        = "gardenlet-configmap-12345678"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		shootClient  client.Client
+		fakeClock    *testclock.FakeClock
+
+		reconciler *DriftReconciler
+		request    reconcile.Request
+
+		managedSeed *seedmanagementv1alpha1.ManagedSeed
+		shoot       *gardencorev1beta1.Shoot
+		seed        *gardencorev1beta1.Seed
+		deployment  *appsv1.Deployment
+		configMap   *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: name}}
+
+		gardenletConfig := &gardenletv1alpha1.GardenletConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardenletv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "GardenletConfiguration",
+			},
+			LogLevel: "debug",
+			SeedConfig: &gardenletv1alpha1.SeedConfig{
+				SeedTemplate: gardencorev1beta1.SeedTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"foo": "bar"},
+					},
+					Spec: gardencorev1beta1.SeedSpec{
+						Provider: gardencorev1beta1.SeedProvider{
+							Type:   "local",
+							Region: "local",
+							Zones:  []string{"a", "b"},
+						},
+						Ingress: &gardencorev1beta1.Ingress{
+							Domain: "ingress.example.com",
+						},
+					},
+				},
+			},
+		}
+		rawConfig, err := encoding.EncodeGardenletConfiguration(gardenletConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		managedSeed = &seedmanagementv1alpha1.ManagedSeed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  namespace,
+				Generation: 1,
+			},
+			Spec: seedmanagementv1alpha1.ManagedSeedSpec{
+				Shoot: &seedmanagementv1alpha1.Shoot{Name: name},
+				Gardenlet: &seedmanagementv1alpha1.GardenletConfig{
+					Deployment: &seedmanagementv1alpha1.GardenletDeployment{
+						ReplicaCount: ptr.To[int32](2),
+						Image: &seedmanagementv1alpha1.Image{
+							Repository: ptr.To("europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet"),
+							Tag:        ptr.To("v1.100.0"),
+						},
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1000m")},
+						},
+						Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+					},
+					Config: *rawConfig,
+				},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"foo": "bar", "seed.gardener.cloud/gardenlet": "true"},
+			},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{
+					Type:   "local",
+					Region: "local",
+					Zones:  []string{"a", "b"},
+				},
+				Ingress: &gardencorev1beta1.Ingress{
+					Domain: "ingress.example.com",
+					Controller: gardencorev1beta1.IngressController{
+						Kind: "nginx",
+					},
+				},
+			},
+		}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardenlet", Namespace: v1beta1constants.GardenNamespace},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "gardenlet",
+							Image: "europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet:v1.100.0",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1"),
+									corev1.ResourceMemory: resource.MustParse("1Gi"),
+								},
+							},
+							Env: []corev1.EnvVar{
+								{Name: "KUBERNETES_SERVICE_HOST", Value: "api.example.com"},
+								{Name: "FOO", Value: "bar"},
+							},
+						}},
+						Volumes: []corev1.Volume{{
+							Name: "gardenlet-config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+								},
+							},
+						}},
+					},
+				},
+			},
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: v1beta1constants.GardenNamespace},
+			Data: map[string]string{"config.yaml": `apiVersion: gardenlet.config.gardener.cloud/v1alpha1
+kind: GardenletConfiguration
+gardenClientConnection:
+  qps: 100
+  burst: 130
+logLevel: debug
+logFormat: json
+`},
+		}
+	})
+
+	JustBeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(managedSeed, shoot, seed).
+			WithStatusSubresource(&seedmanagementv1alpha1.ManagedSeed{}).
+			Build()
+		shootClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(deployment, configMap).
+			Build()
+
+		reconciler = &DriftReconciler{
+			GardenClient: gardenClient,
+			Config: config.ManagedSeedControllerConfiguration{
+				DriftDetectionPeriod: &metav1.Duration{Duration: driftDetectionPeriod},
+			},
+			Clock:                 fakeClock,
+			ShootClientMap:        fakeclientmap.NewClientMapBuilder().WithRuntimeClientForKey(keys.ForShoot(shoot), shootClient, nil).Build(),
+			GardenNamespaceGarden: namespace,
+			GardenNamespaceShoot:  v1beta1constants.GardenNamespace,
+		}
+	})
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(managedSeed), managedSeed)).To(Succeed())
+		return v1beta1helper.GetCondition(managedSeed.Status.Conditions, seedmanagementv1alpha1.ManagedSeedConfigurationConformant)
+	}
+
+	It("should report that the configuration is conformant if there is no drift", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("NoDriftDetected"))
+
+		Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetSeed))).To(BeZero())
+		Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetGardenlet))).To(BeZero())
+		Expect(testutil.ToFloat64(DriftDetectionTimestamp.WithLabelValues(namespace, name))).To(Equal(float64(fakeClock.Now().Unix())))
+	})
+
+	Context("seed drift", func() {
+		BeforeEach(func() {
+			seed.Labels["foo"] = "baz"
+			seed.Spec.Provider.Zones = []string{"a"}
+			seed.Spec.Ingress.Domain = "other.example.com"
+		})
+
+		It("should report the fields of the seed which were changed out-of-band", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DriftDetected"))
+			Expect(condition.Message).To(Equal("3 field(s) differ from the declared configuration: seed.metadata.labels.foo, seed.spec.ingress.domain, seed.spec.provider.zones"))
+
+			Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetSeed))).To(Equal(float64(3)))
+			Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetGardenlet))).To(BeZero())
+		})
+	})
+
+	Context("gardenlet drift", func() {
+		BeforeEach(func() {
+			deployment.Spec.Replicas = ptr.To[int32](1)
+			deployment.Spec.Template.Spec.Containers[0].Image = "example.com/gardenlet:v1.100.0"
+			deployment.Spec.Template.Spec.Containers[0].Env[1].Value = "baz"
+			configMap.Data["config.yaml"] = `apiVersion: gardenlet.config.gardener.cloud/v1alpha1
+kind: GardenletConfiguration
+logLevel: info
+`
+		})
+
+		It("should report the fields of the gardenlet which were changed out-of-band", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal("4 field(s) differ from the declared configuration: gardenlet.deployment.env.FOO.value, gardenlet.deployment.image, gardenlet.deployment.replicaCount, gardenlet.config.logLevel"))
+
+			Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetSeed))).To(BeZero())
+			Expect(testutil.ToFloat64(DriftedFields.WithLabelValues(namespace, name, DriftTargetGardenlet))).To(Equal(float64(4)))
+		})
+
+		It("should report a drift if the gardenlet deployment does not exist", func() {
+			Expect(shootClient.Delete(ctx, deployment)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Message).To(Equal("1 field(s) differ from the declared configuration: gardenlet.deployment"))
+		})
+	})
+
+	It("should not report anything if the seed is not registered yet", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+		Expect(getCondition()).To(BeNil())
+	})
+
+	It("should set the condition to unknown if the drift detection fails", func() {
+		Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed getting shoot")))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal("DriftDetectionFailed"))
+	})
+
+	It("should remove the metrics if the ManagedSeed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: driftDetectionPeriod}))
+		Expect(testutil.CollectAndCount(DriftedFields)).To(BeNumerically(">", 0))
+
+		Expect(gardenClient.Delete(ctx, managedSeed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(DriftedFields)).To(BeZero())
+		Expect(testutil.CollectAndCount(DriftDetectionTimestamp)).To(BeZero())
+	})
+})
@@ -15,9 +15,11 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/capacity"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	"github.com/gardener/gardener/pkg/healthz"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -32,6 +34,7 @@ func AddToManager(
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	healthManager healthz.Manager,
+	settings *reload.Settings,
 ) error {
 	var (
 		componentImageVectors imagevectorutils.ComponentImageVectors
@@ -46,12 +49,22 @@ func AddToManager(
 	}
 
 	if err := (&care.Reconciler{
-		Config:   *cfg.Controllers.SeedCare,
-		SeedName: cfg.SeedConfig.Name,
+		Config:      *cfg.Controllers.SeedCare,
+		SeedName:    cfg.SeedConfig.Name,
+		PodSecurity: cfg.PodSecurity,
 	}).AddToManager(ctx, mgr, gardenCluster, seedCluster); err != nil {
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if cfg.Controllers.SeedCapacity.SyncPeriod.Duration > 0 {
+		if err := (&capacity.Reconciler{
+			Config:   *cfg.Controllers.SeedCapacity,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding capacity reconciler: %w", err)
+		}
+	}
+
 	if err := (&lease.Reconciler{
 		SeedRESTClient: seedClientSet.RESTClient(),
 		Config:         *cfg.Controllers.Seed,
@@ -66,6 +79,7 @@ func AddToManager(
 		Config:                cfg,
 		Identity:              identity,
 		ComponentImageVectors: componentImageVectors,
+		Settings:              settings,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
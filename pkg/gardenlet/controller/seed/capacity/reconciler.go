@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Reconciler periodically publishes a report about the capacity and the utilization of the seed cluster into the
+// Seed status.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedReader   client.Reader
+	Config       config.SeedCapacityControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile computes the capacity report of the seed cluster and updates the Seed status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	report, err := r.computeReport(ctx, seed)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing capacity report: %w", err)
+	}
+
+	log.V(1).Info("Updating capacity report", "controlPlanes", report.ControlPlanes, "controlPlanePods", report.ControlPlanePods)
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.CapacityReport = report
+	if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating capacity report: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) computeReport(ctx context.Context, seed *gardencorev1beta1.Seed) (*gardencorev1beta1.SeedCapacityReport, error) {
+	report := &gardencorev1beta1.SeedCapacityReport{
+		LastUpdateTime: metav1.NewTime(r.Clock.Now().UTC()),
+	}
+
+	namespaceList := &metav1.PartialObjectMetadataList{}
+	namespaceList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	if err := r.SeedReader.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+	shootNamespaces := sets.New[string]()
+	for _, namespace := range namespaceList.Items {
+		shootNamespaces.Insert(namespace.Name)
+	}
+	report.ControlPlanes = int32(shootNamespaces.Len())
+
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedReader.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.SeedReader.List(ctx, podList); err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	var (
+		allocatableCPU, allocatableMemory resource.Quantity
+		requestedCPU, requestedMemory     resource.Quantity
+	)
+
+	for _, node := range nodeList.Items {
+		allocatableCPU.Add(node.Status.Allocatable.Cpu().DeepCopy())
+		allocatableMemory.Add(node.Status.Allocatable.Memory().DeepCopy())
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		if shootNamespaces.Has(pod.Namespace) {
+			report.ControlPlanePods++
+		}
+
+		// Only scheduled pods consume the capacity of the nodes.
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		requests := kubernetesutils.PodRequests(&pod)
+		requestedCPU.Add(requests.Cpu().DeepCopy())
+		requestedMemory.Add(requests.Memory().DeepCopy())
+	}
+
+	report.CPU = resourceUtilization(allocatableCPU, requestedCPU)
+	report.Memory = resourceUtilization(allocatableMemory, requestedMemory)
+	report.NodePodCIDRs = nodePodCIDRUtilization(seed.Spec.Networks.Pods, nodeList.Items)
+
+	etcdList := &druidv1alpha1.EtcdList{}
+	if err := r.SeedReader.List(ctx, etcdList); err != nil {
+		return nil, fmt.Errorf("failed listing etcds: %w", err)
+	}
+
+	etcdStorage := resource.Quantity{Format: resource.BinarySI}
+	for _, etcd := range etcdList.Items {
+		if !shootNamespaces.Has(etcd.Namespace) || etcd.Spec.StorageCapacity == nil {
+			continue
+		}
+		etcdStorage.Add(*etcd.Spec.StorageCapacity)
+	}
+	report.ETCDStorage = &etcdStorage
+
+	serviceList := &corev1.ServiceList{}
+	if err := r.SeedReader.List(ctx, serviceList); err != nil {
+		return nil, fmt.Errorf("failed listing services: %w", err)
+	}
+
+	report.LoadBalancers = &gardencorev1beta1.SeedPoolUtilization{Capacity: r.Config.LoadBalancerQuota}
+	for _, service := range serviceList.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			report.LoadBalancers.Allocated++
+		}
+	}
+
+	return report, nil
+}
+
+func resourceUtilization(allocatable, requested resource.Quantity) *gardencorev1beta1.SeedResourceUtilization {
+	headroom := allocatable.DeepCopy()
+	headroom.Sub(requested)
+	if headroom.Sign() < 0 {
+		headroom = resource.Quantity{Format: allocatable.Format}
+	}
+
+	return &gardencorev1beta1.SeedResourceUtilization{
+		Allocatable: allocatable,
+		Requested:   requested,
+		Headroom:    headroom,
+	}
+}
+
+// nodePodCIDRUtilization returns the number of pod CIDRs which can be assigned to nodes from the given pod network and
+// the number of nodes which have a pod CIDR assigned. The size of the node pod CIDRs is derived from the assigned ones,
+// hence the capacity is unknown if no node has a pod CIDR assigned.
+func nodePodCIDRUtilization(podNetwork string, nodes []corev1.Node) *gardencorev1beta1.SeedPoolUtilization {
+	utilization := &gardencorev1beta1.SeedPoolUtilization{}
+
+	nodeMaskSize := -1
+	for _, node := range nodes {
+		if node.Spec.PodCIDR == "" {
+			continue
+		}
+		utilization.Allocated++
+
+		if nodeMaskSize < 0 {
+			if _, nodeCIDR, err := net.ParseCIDR(node.Spec.PodCIDR); err == nil {
+				nodeMaskSize, _ = nodeCIDR.Mask.Size()
+			}
+		}
+	}
+
+	_, podCIDR, err := net.ParseCIDR(podNetwork)
+	if err != nil || nodeMaskSize < 0 {
+		return utilization
+	}
+
+	podMaskSize, _ := podCIDR.Mask.Size()
+	if diff := nodeMaskSize - podMaskSize; diff >= 0 && diff < 31 {
+		capacity := int32(1) << diff
+		utilization.Capacity = &capacity
+	}
+
+	return utilization
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/capacity"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName   = "seed"
+		syncPeriod = 5 * time.Minute
+	)
+
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+
+		reconciler *Reconciler
+		request    reconcile.Request
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedReader:   seedClient,
+			Config: config.SeedCapacityControllerConfiguration{
+				SyncPeriod:        &metav1.Duration{Duration: syncPeriod},
+				LoadBalancerQuota: ptr.To[int32](10),
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Spec: gardencorev1beta1.SeedSpec{
+				Networks: gardencorev1beta1.SeedNetworks{Pods: "100.96.0.0/11"},
+			},
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should publish an empty report if the seed does not host any control planes", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		report := seed.Status.CapacityReport
+		Expect(report).NotTo(BeNil())
+		Expect(report.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		Expect(report.ControlPlanes).To(BeZero())
+		Expect(report.ControlPlanePods).To(BeZero())
+		Expect(report.CPU.Allocatable.IsZero()).To(BeTrue())
+		Expect(report.ETCDStorage.IsZero()).To(BeTrue())
+		Expect(report.NodePodCIDRs).To(Equal(&gardencorev1beta1.SeedPoolUtilization{}))
+		Expect(report.LoadBalancers).To(Equal(&gardencorev1beta1.SeedPoolUtilization{Capacity: ptr.To[int32](10)}))
+	})
+
+	It("should publish the capacity report of the seed", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		for _, obj := range []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--baz", Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+
+			newNode("node-1", "4", "16Gi", "100.96.0.0/24"),
+			newNode("node-2", "4", "16Gi", "100.96.1.0/24"),
+			newNode("node-3", "4", "16Gi", ""),
+
+			newPod("shoot--foo--bar", "kube-apiserver", "node-1", corev1.PodRunning, "2", "4Gi"),
+			newPod("shoot--foo--baz", "kube-apiserver", "node-2", corev1.PodRunning, "1", "2Gi"),
+			newPod("shoot--foo--baz", "pending", "", corev1.PodPending, "8", "32Gi"),
+			newPod("shoot--foo--baz", "completed", "node-2", corev1.PodSucceeded, "8", "32Gi"),
+			newPod("garden", "gardenlet", "node-3", corev1.PodRunning, "500m", "1Gi"),
+
+			&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "etcd-main"}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("25Gi"))}},
+			&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "etcd-events"}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("10Gi"))}},
+			&druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "etcd-virtual"}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("100Gi"))}},
+
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-ingress", Name: "istio-ingressgateway"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "kube-apiserver"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}},
+		} {
+			Expect(seedClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		report := seed.Status.CapacityReport
+		Expect(report).NotTo(BeNil())
+		Expect(report.ControlPlanes).To(Equal(int32(2)))
+		Expect(report.ControlPlanePods).To(Equal(int32(3)))
+
+		Expect(report.CPU.Allocatable.Cmp(resource.MustParse("12"))).To(BeZero())
+		Expect(report.CPU.Requested.Cmp(resource.MustParse("3500m"))).To(BeZero())
+		Expect(report.CPU.Headroom.Cmp(resource.MustParse("8500m"))).To(BeZero())
+		Expect(report.Memory.Allocatable.Cmp(resource.MustParse("48Gi"))).To(BeZero())
+		Expect(report.Memory.Requested.Cmp(resource.MustParse("7Gi"))).To(BeZero())
+		Expect(report.Memory.Headroom.Cmp(resource.MustParse("41Gi"))).To(BeZero())
+
+		Expect(report.ETCDStorage.Cmp(resource.MustParse("35Gi"))).To(BeZero())
+		Expect(report.NodePodCIDRs).To(Equal(&gardencorev1beta1.SeedPoolUtilization{Capacity: ptr.To[int32](8192), Allocated: 2}))
+		Expect(report.LoadBalancers).To(Equal(&gardencorev1beta1.SeedPoolUtilization{Capacity: ptr.To[int32](10), Allocated: 1}))
+	})
+
+	It("should not report a negative headroom", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(seedClient.Create(ctx, newNode("node-1", "1", "1Gi", ""))).To(Succeed())
+		Expect(seedClient.Create(ctx, newPod("garden", "overcommitted", "node-1", corev1.PodRunning, "2", "2Gi"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.CapacityReport.CPU.Headroom.IsZero()).To(BeTrue())
+		Expect(seed.Status.CapacityReport.Memory.Headroom.IsZero()).To(BeTrue())
+	})
+
+	It("should consider the requests of init containers and the pod overhead", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(seedClient.Create(ctx, newNode("node-1", "4", "16Gi", ""))).To(Succeed())
+
+		pod := newPod("garden", "init", "node-1", corev1.PodRunning, "1", "1Gi")
+		pod.Spec.InitContainers = []corev1.Container{{
+			Name: "init",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			}},
+		}}
+		pod.Spec.Overhead = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}
+		Expect(seedClient.Create(ctx, pod)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		Expect(seed.Status.CapacityReport.CPU.Requested.Cmp(resource.MustParse("2100m"))).To(BeZero())
+		Expect(seed.Status.CapacityReport.Memory.Requested.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+	})
+})
+
+func newNode(name, cpu, memory, podCIDR string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{PodCIDR: podCIDR},
+		Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}},
+	}
+}
+
+func newPod(namespace, name, nodeName string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
@@ -7,8 +7,14 @@ package care
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
+	"k8s.io/pod-security-admission/policy"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +23,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	healthchecker "github.com/gardener/gardener/pkg/utils/kubernetes/health/checker"
 )
 
@@ -26,6 +33,7 @@ type health struct {
 	seedClient    client.Client
 	clock         clock.Clock
 	namespace     *string
+	podSecurity   *config.PodSecurityConfiguration
 	healthChecker *healthchecker.HealthChecker
 }
 
@@ -35,6 +43,7 @@ func NewHealth(
 	seedClient client.Client,
 	clock clock.Clock,
 	namespace *string,
+	podSecurity *config.PodSecurityConfiguration,
 	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration,
 ) HealthCheck {
 	return &health{
@@ -42,6 +51,7 @@ func NewHealth(
 		seed:          seed,
 		clock:         clock,
 		namespace:     namespace,
+		podSecurity:   podSecurity,
 		healthChecker: healthchecker.NewHealthChecker(seedClient, clock, conditionThresholds, seed.Status.LastOperation),
 	}
 }
@@ -58,7 +68,14 @@ func (h *health) Check(
 	}
 
 	newSystemComponentsCondition := h.checkSystemComponents(conditions.systemComponentsHealthy, managedResources)
-	return []gardencorev1beta1.Condition{v1beta1helper.NewConditionOrError(h.clock, conditions.systemComponentsHealthy, newSystemComponentsCondition, nil)}
+	updatedConditions := []gardencorev1beta1.Condition{v1beta1helper.NewConditionOrError(h.clock, conditions.systemComponentsHealthy, newSystemComponentsCondition, nil)}
+
+	if conditions.podSecurityCompliant != nil {
+		newPodSecurityCondition, err := h.checkPodSecurity(ctx, *conditions.podSecurityCompliant)
+		updatedConditions = append(updatedConditions, v1beta1helper.NewConditionOrError(h.clock, *conditions.podSecurityCompliant, newPodSecurityCondition, err))
+	}
+
+	return updatedConditions
 }
 
 func (h *health) listManagedResources(ctx context.Context) ([]resourcesv1alpha1.ManagedResource, error) {
@@ -82,32 +99,124 @@ func (h *health) checkSystemComponents(condition gardencorev1beta1.Condition, ma
 		return exitCondition
 	}
 
-	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy."))
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.SystemComponentsRunning, "All system components are healthy."))
+}
+
+// maxReportedPodSecurityViolations is the maximum number of pods violating the Pod Security Standard which are listed
+// in the condition message.
+const maxReportedPodSecurityViolations = 10
+
+var podSecurityEvaluator policy.Evaluator
+
+func init() {
+	var err error
+	if podSecurityEvaluator, err = policy.NewEvaluator(policy.DefaultChecks()); err != nil {
+		panic(fmt.Errorf("failed creating Pod Security evaluator: %w", err))
+	}
+}
+
+func (h *health) checkPodSecurity(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	level := ptr.Deref(h.podSecurity.AuditLevel, string(podsecurityadmissionapi.LevelBaseline))
+	levelVersion := podsecurityadmissionapi.LevelVersion{Level: podsecurityadmissionapi.Level(level), Version: podsecurityadmissionapi.LatestVersion()}
+
+	exemptions := make([]labels.Selector, 0, len(h.podSecurity.Exemptions))
+	for _, exemption := range h.podSecurity.Exemptions {
+		selector, err := metav1.LabelSelectorAsSelector(&exemption)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing Pod Security exemption: %w", err)
+		}
+		exemptions = append(exemptions, selector)
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := h.seedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	namespaces := []string{ptr.Deref(h.namespace, v1beta1constants.GardenNamespace)}
+	for _, namespace := range namespaceList.Items {
+		namespaces = append(namespaces, namespace.Name)
+	}
+
+	var violations []string
+	for _, namespace := range namespaces {
+		podList := &corev1.PodList{}
+		if err := h.seedClient.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing pods in namespace %s: %w", namespace, err)
+		}
+
+	pods:
+		for _, pod := range podList.Items {
+			for _, exemption := range exemptions {
+				if exemption.Matches(labels.Set(pod.Labels)) {
+					continue pods
+				}
+			}
+
+			if result := policy.AggregateCheckResults(podSecurityEvaluator.EvaluatePod(levelVersion, &pod.ObjectMeta, &pod.Spec)); !result.Allowed {
+				violations = append(violations, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, strings.Join(result.ForbiddenReasons, ", ")))
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		message := fmt.Sprintf("%d pod(s) violate the %q Pod Security Standard: ", len(violations), level)
+		if len(violations) > maxReportedPodSecurityViolations {
+			message += strings.Join(violations[:maxReportedPodSecurityViolations], "; ") + fmt.Sprintf("; and %d more", len(violations)-maxReportedPodSecurityViolations)
+		} else {
+			message += strings.Join(violations, "; ")
+		}
+
+		return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionFalse, gardencorev1beta1.PodSecurityViolations, message)), nil
+	}
+
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.NoPodSecurityViolations, fmt.Sprintf("All pods comply with the %q Pod Security Standard.", level))), nil
 }
 
 // SeedConditions contains all seed related conditions of the seed status subresource.
 type SeedConditions struct {
 	systemComponentsHealthy gardencorev1beta1.Condition
+	podSecurityCompliant    *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the seed conditions as a slice.
 func (s SeedConditions) ConvertToSlice() []gardencorev1beta1.Condition {
-	return []gardencorev1beta1.Condition{
+	conditions := []gardencorev1beta1.Condition{
 		s.systemComponentsHealthy,
 	}
+
+	if s.podSecurityCompliant != nil {
+		conditions = append(conditions, *s.podSecurityCompliant)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all seed condition types.
 func (s SeedConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
-	return []gardencorev1beta1.ConditionType{
+	types := []gardencorev1beta1.ConditionType{
 		s.systemComponentsHealthy.Type,
 	}
+
+	if s.podSecurityCompliant != nil {
+		types = append(types, gardencorev1beta1.SeedPodSecurityCompliant)
+	}
+
+	return types
 }
 
 // NewSeedConditions returns a new instance of SeedConditions.
-// All conditions are retrieved from the given 'status' or newly initialized.
-func NewSeedConditions(clock clock.Clock, status gardencorev1beta1.SeedStatus) SeedConditions {
-	return SeedConditions{
+// All conditions are retrieved from the given 'status' or newly initialized. The SeedPodSecurityCompliant condition is
+// only maintained if 'checkPodSecurity' is true.
+func NewSeedConditions(clock clock.Clock, status gardencorev1beta1.SeedStatus, checkPodSecurity bool) SeedConditions {
+	seedConditions := SeedConditions{
 		systemComponentsHealthy: v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, gardencorev1beta1.SeedSystemComponentsHealthy),
 	}
+
+	if checkPodSecurity {
+		podSecurityCondition := v1beta1helper.GetOrInitConditionWithClock(clock, status.Conditions, gardencorev1beta1.SeedPodSecurityCompliant)
+		seedConditions.podSecurityCompliant = &podSecurityCondition
+	}
+
+	return seedConditions
 }
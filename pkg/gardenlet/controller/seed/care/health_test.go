@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
@@ -22,6 +23,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -81,10 +83,10 @@ var _ = Describe("Seed health", func() {
 			})
 
 			It("should set SeedSystemComponentsHealthy condition to true", func() {
-				healthCheck := NewHealth(seed, c, fakeClock, nil, nil)
+				healthCheck := NewHealth(seed, c, fakeClock, nil, nil, nil)
 				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 					Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-				})
+				}, false)
 
 				updatedConditions := healthCheck.Check(ctx, conditions)
 				Expect(updatedConditions).ToNot(BeEmpty())
@@ -96,10 +98,10 @@ var _ = Describe("Seed health", func() {
 			var (
 				tests = func(reason, message string) {
 					It("should set SeedSystemComponentsHealthy condition to False if there is no Progressing threshold duration mapping", func() {
-						healthCheck := NewHealth(seed, c, fakeClock, nil, nil)
+						healthCheck := NewHealth(seed, c, fakeClock, nil, nil, nil)
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-						})
+						}, false)
 
 						updatedConditions := healthCheck.Check(ctx, conditions)
 
@@ -111,10 +113,10 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionFalse
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-						})
+						}, false)
 
 						updatedConditions := healthCheck.Check(ctx, conditions)
 
@@ -126,10 +128,10 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionTrue
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-						})
+						}, false)
 
 						updatedConditions := healthCheck.Check(ctx, conditions)
 
@@ -141,10 +143,10 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionProgressing
 						fakeClock.Step(30 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-						})
+						}, false)
 
 						updatedConditions := healthCheck.Check(ctx, conditions)
 
@@ -156,10 +158,10 @@ var _ = Describe("Seed health", func() {
 						seedSystemComponentsHealthyCondition.Status = gardencorev1beta1.ConditionProgressing
 						fakeClock.Step(90 * time.Second)
 
-						healthCheck := NewHealth(seed, c, fakeClock, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
+						healthCheck := NewHealth(seed, c, fakeClock, nil, nil, map[gardencorev1beta1.ConditionType]time.Duration{gardencorev1beta1.SeedSystemComponentsHealthy: time.Minute})
 						conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{
 							Conditions: []gardencorev1beta1.Condition{seedSystemComponentsHealthyCondition},
-						})
+						}, false)
 
 						updatedConditions := healthCheck.Check(ctx, conditions)
 
@@ -204,12 +206,81 @@ var _ = Describe("Seed health", func() {
 				tests("MissingManagedResourceCondition", "is missing the following condition(s)")
 			})
 		})
+
+		Context("Pod Security", func() {
+			var (
+				podSecurity *config.PodSecurityConfiguration
+				conditions  SeedConditions
+			)
+
+			pod := func(namespace, name string, privileged bool, labels map[string]string) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name:            "foo",
+						Image:           "foo",
+						SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(privileged)},
+					}}},
+				}
+			}
+
+			BeforeEach(func() {
+				podSecurity = &config.PodSecurityConfiguration{AuditLevel: ptr.To("baseline")}
+				conditions = NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, true)
+
+				Expect(c.Create(ctx, healthyManagedResource(managedResourceName))).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{"gardener.cloud/role": "shoot"}}})).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})).To(Succeed())
+				Expect(c.Create(ctx, pod("garden", "compliant", false, nil))).To(Succeed())
+				Expect(c.Create(ctx, pod("kube-system", "not-managed", true, nil))).To(Succeed())
+			})
+
+			It("should set SeedPodSecurityCompliant condition to true if all pods comply", func() {
+				Expect(c.Create(ctx, pod("shoot--foo--bar", "compliant", false, nil))).To(Succeed())
+
+				updatedConditions := NewHealth(seed, c, fakeClock, nil, podSecurity, nil).Check(ctx, conditions)
+				Expect(updatedConditions).To(HaveLen(2))
+				Expect(updatedConditions[1]).To(And(OfType(gardencorev1beta1.SeedPodSecurityCompliant), beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionTrue, "NoPodSecurityViolations", `All pods comply with the "baseline" Pod Security Standard.`)))
+			})
+
+			It("should set SeedPodSecurityCompliant condition to false if pods violate the audit level", func() {
+				Expect(c.Create(ctx, pod("garden", "privileged", true, nil))).To(Succeed())
+				Expect(c.Create(ctx, pod("shoot--foo--bar", "privileged", true, nil))).To(Succeed())
+
+				updatedConditions := NewHealth(seed, c, fakeClock, nil, podSecurity, nil).Check(ctx, conditions)
+				Expect(updatedConditions).To(HaveLen(2))
+				Expect(updatedConditions[1]).To(And(
+					OfType(gardencorev1beta1.SeedPodSecurityCompliant),
+					WithStatus(gardencorev1beta1.ConditionFalse),
+					WithReason("PodSecurityViolations"),
+					WithMessage(`2 pod(s) violate the "baseline" Pod Security Standard`),
+					WithMessage("garden/privileged (privileged"),
+					WithMessage("shoot--foo--bar/privileged (privileged"),
+				))
+			})
+
+			It("should ignore exempted pods", func() {
+				podSecurity.Exemptions = []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "exempted"}}}
+				Expect(c.Create(ctx, pod("shoot--foo--bar", "exempted", true, map[string]string{"app": "exempted"}))).To(Succeed())
+
+				updatedConditions := NewHealth(seed, c, fakeClock, nil, podSecurity, nil).Check(ctx, conditions)
+				Expect(updatedConditions).To(HaveLen(2))
+				Expect(updatedConditions[1]).To(WithStatus(gardencorev1beta1.ConditionTrue))
+			})
+
+			It("should not check pods if Pod Security is not configured", func() {
+				Expect(c.Create(ctx, pod("garden", "privileged", true, nil))).To(Succeed())
+
+				updatedConditions := NewHealth(seed, c, fakeClock, nil, nil, nil).Check(ctx, NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, false))
+				Expect(updatedConditions).To(HaveExactElements(OfType(gardencorev1beta1.SeedSystemComponentsHealthy)))
+			})
+		})
 	})
 
 	Describe("SeedConditions", func() {
 		Describe("#NewSeedConditions", func() {
 			It("should initialize all conditions", func() {
-				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{})
+				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, false)
 
 				Expect(conditions.ConvertToSlice()).To(ConsistOf(
 					beConditionWithStatusReasonAndMessage("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
@@ -222,7 +293,7 @@ var _ = Describe("Seed health", func() {
 						{Type: "SeedSystemComponentsHealthy"},
 						{Type: "Foo"},
 					},
-				})
+				}, false)
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("SeedSystemComponentsHealthy"),
@@ -232,20 +303,38 @@ var _ = Describe("Seed health", func() {
 
 		Describe("#ConvertToSlice", func() {
 			It("should return the expected conditions", func() {
-				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{})
+				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, false)
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("SeedSystemComponentsHealthy"),
+				))
+			})
+
+			It("should return the expected conditions if Pod Security is checked", func() {
+				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, true)
 
 				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
 					OfType("SeedSystemComponentsHealthy"),
+					OfType("SeedPodSecurityCompliant"),
 				))
 			})
 		})
 
 		Describe("#ConditionTypes", func() {
 			It("should return the expected condition types", func() {
-				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{})
+				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, false)
+
+				Expect(conditions.ConditionTypes()).To(HaveExactElements(
+					gardencorev1beta1.ConditionType("SeedSystemComponentsHealthy"),
+				))
+			})
+
+			It("should return the expected condition types if Pod Security is checked", func() {
+				conditions := NewSeedConditions(fakeClock, gardencorev1beta1.SeedStatus{}, true)
 
 				Expect(conditions.ConditionTypes()).To(HaveExactElements(
 					gardencorev1beta1.ConditionType("SeedSystemComponentsHealthy"),
+					gardencorev1beta1.ConditionType("SeedPodSecurityCompliant"),
 				))
 			})
 		})
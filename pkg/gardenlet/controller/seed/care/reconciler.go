@@ -19,6 +19,7 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 )
 
 // NewHealthCheck is used to create a new Health check instance.
@@ -32,6 +33,7 @@ type Reconciler struct {
 	Clock        clock.Clock
 	Namespace    *string
 	SeedName     string
+	PodSecurity  *config.PodSecurityConfiguration
 }
 
 // Reconcile reconciles Seed resources and executes health check operations.
@@ -58,7 +60,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 	log.V(1).Info("Starting seed care")
 
 	// Initialize conditions based on the current status.
-	seedConditions := NewSeedConditions(r.Clock, seed.Status)
+	seedConditions := NewSeedConditions(r.Clock, seed.Status, r.PodSecurity != nil)
 
 	// Trigger health check
 	updatedConditions := NewHealthCheck(
@@ -66,6 +68,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		r.SeedClient,
 		r.Clock,
 		r.Namespace,
+		r.PodSecurity,
 		r.conditionThresholdsToProgressingMapping(),
 	).Check(
 		ctx,
@@ -87,7 +90,7 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		}
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	return reconcile.Result{RequeueAfter: gardenlethelper.CareSyncPeriod(r.Config.SyncPeriod.Duration, r.Config.AdaptiveSyncPeriod, nil, updatedConditions, r.Clock.Now())}, nil
 }
 
 func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
@@ -182,6 +182,20 @@ var _ = Describe("Seed Care Control", func() {
 					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
 					Expect(updatedSeed.Status.Conditions).To(ConsistOf(conditions))
 				})
+
+				It("should requeue based on the health of the seed if adaptive sync periods are configured", func() {
+					reconciler.Config.AdaptiveSyncPeriod = &config.AdaptiveSyncPeriod{
+						HealthySyncPeriod:   &metav1.Duration{Duration: 5 * time.Minute},
+						DegradedSyncPeriod:  &metav1.Duration{Duration: 30 * time.Second},
+						StabilizationPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+					}
+
+					conditions[0].LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+					conditions[0].LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+				})
 			})
 		})
 	})
@@ -194,7 +208,7 @@ func (c resultingConditionFunc) Check(_ context.Context, conditions SeedConditio
 }
 
 func healthCheckFunc(fn resultingConditionFunc) NewHealthCheckFunc {
-	return func(*gardencorev1beta1.Seed, client.Client, clock.Clock, *string, map[gardencorev1beta1.ConditionType]time.Duration) HealthCheck {
+	return func(*gardencorev1beta1.Seed, client.Client, clock.Clock, *string, *config.PodSecurityConfiguration, map[gardencorev1beta1.ConditionType]time.Duration) HealthCheck {
 		return fn
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/autoscaling/clusterautoscaler"
 	"github.com/gardener/gardener/pkg/component/autoscaling/hvpa"
 	"github.com/gardener/gardener/pkg/component/autoscaling/vpa"
+	"github.com/gardener/gardener/pkg/component/certmanagement"
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
 	"github.com/gardener/gardener/pkg/component/extensions"
@@ -61,6 +62,7 @@ import (
 	seedprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/seed"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheusoperator"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	"github.com/gardener/gardener/pkg/component/seed/artifactcache"
 	seedsystem "github.com/gardener/gardener/pkg/component/seed/system"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
@@ -81,6 +83,7 @@ type components struct {
 	hvpaCRD       component.Deployer
 	fluentCRD     component.Deployer
 	prometheusCRD component.Deployer
+	certCRD       component.Deployer
 
 	clusterIdentity          component.DeployWaiter
 	gardenerResourceManager  component.DeployWaiter
@@ -97,6 +100,7 @@ type components struct {
 	dwdWeeder                component.DeployWaiter
 	dwdProber                component.DeployWaiter
 	vpnAuthzServer           component.DeployWaiter
+	artifactCache            component.DeployWaiter
 
 	kubeAPIServerService component.Deployer
 	kubeAPIServerIngress component.Deployer
@@ -122,6 +126,7 @@ func (r *Reconciler) instantiateComponents(
 	secretsManager secretsmanager.Interface,
 	seedIsGarden bool,
 	globalMonitoringSecretSeed *corev1.Secret,
+	remoteWriteSecret *corev1.Secret,
 	alertingSMTPSecret *corev1.Secret,
 	wildCardCertSecret *corev1.Secret,
 	isManagedSeed bool,
@@ -141,6 +146,7 @@ func (r *Reconciler) instantiateComponents(
 	}
 	c.fluentCRD = fluentoperator.NewCRDs(r.SeedClientSet.Applier())
 	c.prometheusCRD = prometheusoperator.NewCRDs(r.SeedClientSet.Applier())
+	c.certCRD = certmanagement.NewCRDs(r.SeedClientSet.Applier())
 
 	// seed system components
 	c.clusterIdentity = r.newClusterIdentity(seed.GetInfo())
@@ -182,6 +188,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.artifactCache, err = r.newArtifactCache(seed.GetInfo())
+	if err != nil {
+		return
+	}
 
 	c.kubeAPIServerService = r.newKubeAPIServerService(wildCardCertSecret)
 	c.kubeAPIServerIngress = r.newKubeAPIServerIngress(seed, wildCardCertSecret, c.istioDefaultLabels, c.istioDefaultNamespace)
@@ -231,7 +241,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
-	c.aggregatePrometheus, err = r.newAggregatePrometheus(log, seed, secretsManager, globalMonitoringSecretSeed, wildCardCertSecret, alertingSMTPSecret)
+	c.aggregatePrometheus, err = r.newAggregatePrometheus(log, seed, secretsManager, globalMonitoringSecretSeed, remoteWriteSecret, wildCardCertSecret, alertingSMTPSecret)
 	if err != nil {
 		return
 	}
@@ -451,6 +461,38 @@ func (r *Reconciler) newVPNAuthzServer() (component.DeployWaiter, error) {
 	), nil
 }
 
+func (r *Reconciler) newArtifactCache(seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
+	if !gardenlethelper.IsArtifactCacheEnabled(&r.Config) {
+		return component.OpDestroyAndWait(artifactcache.New(r.SeedClientSet.Client(), r.GardenNamespace, artifactcache.Values{})), nil
+	}
+
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameArtifactCache)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := r.Config.ArtifactCache
+	values := artifactcache.Values{
+		Image:     image.String(),
+		NodesCIDR: seed.Spec.Networks.Nodes,
+	}
+
+	for _, upstream := range cfg.Upstreams {
+		values.Upstreams = append(values.Upstreams, artifactcache.Upstream{
+			Host:      upstream.Host,
+			RemoteURL: ptr.Deref(upstream.RemoteURL, "https://"+upstream.Host),
+		})
+	}
+	if cfg.StorageSize != nil {
+		values.StorageSize = *cfg.StorageSize
+	}
+	if cfg.GarbageCollectionTTL != nil {
+		values.GarbageCollectionTTL = cfg.GarbageCollectionTTL.Duration
+	}
+
+	return artifactcache.New(r.SeedClientSet.Client(), r.GardenNamespace, values), nil
+}
+
 func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
 	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer)
 	if err != nil {
@@ -585,7 +627,7 @@ func (r *Reconciler) newSeedPrometheus(log logr.Logger, seed *seedpkg.Seed) (com
 	})
 }
 
-func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed, secretsManager secretsmanager.Interface, globalMonitoringSecret, wildcardCertSecret, alertingSMTPSecret *corev1.Secret) (component.DeployWaiter, error) {
+func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed, secretsManager secretsmanager.Interface, globalMonitoringSecret, remoteWriteSecret, wildcardCertSecret, alertingSMTPSecret *corev1.Secret) (component.DeployWaiter, error) {
 	values := prometheus.Values{
 		Name:              "aggregate",
 		PriorityClassName: v1beta1constants.PriorityClassNameSeedSystem600,
@@ -625,6 +667,17 @@ func (r *Reconciler) newAggregatePrometheus(log logr.Logger, seed *seedpkg.Seed,
 		values.Alerting = &prometheus.AlertingValues{Alertmanagers: []*prometheus.Alertmanager{{Name: "alertmanager-seed"}}}
 	}
 
+	if monitoring := r.monitoring(); monitoring != nil && monitoring.Seed != nil && monitoring.Seed.RemoteWrite != nil {
+		values.RemoteWrite = &prometheus.RemoteWriteValues{
+			URL:                          monitoring.Seed.RemoteWrite.URL,
+			KeptMetrics:                  monitoring.Seed.RemoteWrite.Keep,
+			GlobalShootRemoteWriteSecret: remoteWriteSecret,
+		}
+		// The remote write endpoint is typically located outside the seed cluster, e.g., in the garden runtime cluster.
+		values.AdditionalPodLabels[v1beta1constants.LabelNetworkPolicyToPublicNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+		values.AdditionalPodLabels[v1beta1constants.LabelNetworkPolicyToPrivateNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
 	return sharedcomponent.NewPrometheus(log, r.SeedClientSet.Client(), r.GardenNamespace, values)
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
@@ -46,6 +47,17 @@ type Reconciler struct {
 	ComponentImageVectors                imagevector.ComponentImageVectors
 	ClientCertificateExpirationTimestamp *metav1.Time
 	GardenNamespace                      string
+	// Settings contains the settings which are reloaded without restarting gardenlet. If nil, the settings of Config are
+	// used.
+	Settings *reload.Settings
+}
+
+// monitoring returns the current monitoring settings.
+func (r *Reconciler) monitoring() *config.MonitoringConfig {
+	if r.Settings != nil {
+		return r.Settings.Monitoring()
+	}
+	return r.Config.Monitoring
 }
 
 // Reconcile reconciles Seed resources and provisions or de-provisions the seed system components.
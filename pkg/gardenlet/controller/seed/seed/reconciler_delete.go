@@ -52,7 +52,7 @@ func (r *Reconciler) delete(
 
 	if len(associatedShoots) > 0 {
 		log.Info("Cannot delete Seed because the following Shoots are still referencing it", "shoots", associatedShoots)
-		r.Recorder.Event(seed, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, fmt.Sprintf("%s Shoots=%v", parentLogMessage, associatedShoots))
+		controllerutils.RecordEvent(ctx, r.Recorder, seed, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, fmt.Sprintf("%s Shoots=%v", parentLogMessage, associatedShoots))
 
 		return errors.New("seed still has references")
 	}
@@ -72,7 +72,7 @@ func (r *Reconciler) delete(
 
 	if len(associatedBackupBuckets) > 0 {
 		log.Info("Cannot delete Seed because the following BackupBuckets are still referencing it", "backupBuckets", associatedBackupBuckets)
-		r.Recorder.Event(seed, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, fmt.Sprintf("%s BackupBuckets=%v", parentLogMessage, associatedBackupBuckets))
+		controllerutils.RecordEvent(ctx, r.Recorder, seed, corev1.EventTypeNormal, v1beta1constants.EventResourceReferenced, fmt.Sprintf("%s BackupBuckets=%v", parentLogMessage, associatedBackupBuckets))
 
 		return errors.New("seed still has references")
 	}
@@ -102,7 +102,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 	isManagedSeed bool,
 ) error {
 	log.Info("Instantiating component deployers")
-	c, err := r.instantiateComponents(ctx, log, seed, nil, seedIsGarden, nil, nil, nil, isManagedSeed)
+	c, err := r.instantiateComponents(ctx, log, seed, nil, seedIsGarden, nil, nil, nil, nil, isManagedSeed)
 	if err != nil {
 		return err
 	}
@@ -190,6 +190,10 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroy VPN authorization server",
 			Fn:   component.OpDestroyAndWait(c.vpnAuthzServer).Destroy,
 		})
+		destroyArtifactCache = g.Add(flow.Task{
+			Name: "Destroy artifact cache",
+			Fn:   component.OpDestroyAndWait(c.artifactCache).Destroy,
+		})
 		destroyIstio = g.Add(flow.Task{
 			Name: "Destroy Istio",
 			Fn:   component.OpDestroyAndWait(c.istio).Destroy,
@@ -287,6 +291,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 			destroyKubeAPIServerIngress,
 			destroyKubeAPIServerService,
 			destroyVPNAuthzServer,
+			destroyArtifactCache,
 			destroyIstio,
 			destroyIstioCRDs,
 			destroyMachineControllerManagerCRDs,
@@ -327,6 +332,8 @@ func (r *Reconciler) runDeleteSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      "seed/" + seed.GetInfo().Name,
 	}); err != nil {
 		return flow.Errors(err)
 	}
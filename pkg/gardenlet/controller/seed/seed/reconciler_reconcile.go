@@ -19,7 +19,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
-	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -120,7 +120,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 
 		// When the seed is the garden cluster then this information is managed by gardener-operator.
 		if !seedIsGarden {
-			metav1.SetMetaDataLabel(&gardenNamespace.ObjectMeta, podsecurityadmissionapi.EnforceLevelLabel, string(podsecurityadmissionapi.LevelPrivileged))
+			gardenlethelper.SetPodSecurityLabels(&gardenNamespace.ObjectMeta, gardenlethelper.GardenNamespacePodSecurityLevel(&r.Config), &r.Config)
 			metav1.SetMetaDataLabel(&gardenNamespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
 			metav1.SetMetaDataAnnotation(&gardenNamespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigZones, strings.Join(seed.GetInfo().Spec.Provider.Zones, ","))
 		}
@@ -191,7 +191,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 	}
 
 	log.Info("Instantiating component deployers")
-	c, err := r.instantiateComponents(ctx, log, seed, secretsManager, seedIsGarden, globalMonitoringSecretSeed, alertingSMTPSecret, wildcardCertSecret, isManagedSeed)
+	c, err := r.instantiateComponents(ctx, log, seed, secretsManager, seedIsGarden, globalMonitoringSecretSeed, secrets[v1beta1constants.GardenRoleGlobalSeedRemoteWriteMonitoring], alertingSMTPSecret, wildcardCertSecret, isManagedSeed)
 	if err != nil {
 		return err
 	}
@@ -242,6 +242,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Fn:     c.prometheusCRD.Deploy,
 			SkipIf: seedIsGarden,
 		})
+		deployCertCRD = g.Add(flow.Task{
+			Name:   "Deploying certificate-related custom resource definitions",
+			Fn:     c.certCRD.Deploy,
+			SkipIf: r.Config.CertificateService == nil,
+		})
 		syncPointCRDs = flow.NewTaskIDs(
 			deployMachineCRD,
 			deployExtensionCRD,
@@ -251,6 +256,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			reconcileHVPACRD,
 			deployFluentCRD,
 			deployPrometheusCRD,
+			deployCertCRD,
 		)
 
 		deployGardenerResourceManager = g.Add(flow.Task{
@@ -338,6 +344,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Fn:           c.vpnAuthzServer.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying artifact cache",
+			Fn:           c.artifactCache.Deploy,
+			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+		})
 		_ = g.Add(flow.Task{
 			Name: "Renewing garden access secrets",
 			Fn: func(ctx context.Context) error {
@@ -459,6 +470,8 @@ func (r *Reconciler) runReconcileSeedFlow(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, seed.GetInfo()),
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      "seed/" + seed.GetInfo().Name,
 	}); err != nil {
 		return flow.Errors(err)
 	}
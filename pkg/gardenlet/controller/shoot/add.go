@@ -21,8 +21,11 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/conformancetest"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/resourceusage"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
@@ -36,6 +39,7 @@ func AddToManager(
 	cfg config.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
+	settings *reload.Settings,
 ) error {
 	var responsibleForUnmanagedSeed bool
 	if err := gardenCluster.GetAPIReader().Get(ctx, client.ObjectKey{Name: cfg.SeedConfig.Name, Namespace: v1beta1constants.GardenNamespace}, &seedmanagementv1alpha1.ManagedSeed{}); err != nil {
@@ -54,6 +58,7 @@ func AddToManager(
 		Identity:                    identity,
 		GardenClusterIdentity:       gardenClusterIdentity,
 		ShootStateControllerEnabled: shootStateControllerEnabled,
+		Settings:                    settings,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
@@ -65,10 +70,30 @@ func AddToManager(
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
 		SeedName:              cfg.SeedConfig.Name,
+		Settings:              settings,
 	}).AddToManager(mgr, gardenCluster); err != nil {
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootResourceUsage.SyncPeriod.Duration > 0 {
+		if err := (&resourceusage.Reconciler{
+			Config:   *cfg.Controllers.ShootResourceUsage,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding resource usage reconciler: %w", err)
+		}
+	}
+
+	if ptr.Deref(cfg.Controllers.ShootConformanceTest.ConcurrentSyncs, 0) > 0 {
+		if err := (&conformancetest.Reconciler{
+			ShootClientMap: shootClientMap,
+			Config:         *cfg.Controllers.ShootConformanceTest,
+			SeedName:       cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding conformance test reconciler: %w", err)
+		}
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-22).
 	if shootStateControllerEnabled {
@@ -7,6 +7,7 @@ package care
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
@@ -22,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -37,12 +39,27 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Recorder == nil {
+		r.Recorder = gardenCluster.GetEventRecorderFor(ControllerName + "-controller")
+	}
+	if cfg := r.Config.Controllers.ShootCare.Remediation; cfg != nil && r.RemediationRateLimiter == nil {
+		r.RemediationRateLimiter = NewRemediationRateLimiter(r.Clock, ptr.Deref(cfg.MaxActionsPerShoot, 0), ptr.Deref(cfg.RateLimitPeriod, metav1.Duration{}).Duration)
+	}
+
+	var (
+		reconciler              reconcile.Reconciler = r
+		maxConcurrentReconciles                      = ptr.Deref(r.Config.Controllers.ShootCare.ConcurrentSyncs, 0)
+	)
+	if r.Settings != nil {
+		reconciler = controllerutils.LimitConcurrency(r.Settings.ShootCareConcurrency, r)
+		maxConcurrentReconciles = r.Settings.ShootCareConcurrency.Workers()
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		WithOptions(controller.Options{
-			MaxConcurrentReconciles: ptr.Deref(r.Config.Controllers.ShootCare.ConcurrentSyncs, 0),
+			MaxConcurrentReconciles: maxConcurrentReconciles,
 			// if going into exponential backoff, wait at most the configured sync period
 			RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), r.Config.Controllers.ShootCare.SyncPeriod.Duration),
 		}).
@@ -51,7 +68,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 			r.EventHandler(),
 			builder.WithPredicates(r.ShootPredicate()),
 		).
-		Complete(r)
+		Complete(reconciler)
 }
 
 // RandomDurationWithMetaDuration is an alias for utils.RandomDurationWithMetaDuration.
@@ -27,9 +27,11 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
@@ -49,7 +51,7 @@ const (
 func shootHibernatedConstraints(clock clock.Clock, conditions ...gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
 	hibernationConditions := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
-		hibernationConditions = append(hibernationConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionTrue, "ConstraintNotChecked", "Shoot cluster has been hibernated."))
+		hibernationConditions = append(hibernationConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConstraintNotChecked, "Shoot cluster has been hibernated."))
 	}
 	return hibernationConditions
 }
@@ -57,7 +59,7 @@ func shootHibernatedConstraints(clock clock.Clock, conditions ...gardencorev1bet
 func shootControlPlaneNotRunningConstraints(clock clock.Clock, conditions ...gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
 	constraints := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
-		constraints = append(constraints, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionFalse, "ConstraintNotChecked", "Shoot control plane is not running at the moment."))
+		constraints = append(constraints, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ConstraintNotChecked, "Shoot control plane is not running at the moment."))
 	}
 	return constraints
 }
@@ -70,6 +72,8 @@ type Constraint struct {
 	initializeShootClients ShootClientInit
 	shootClient            client.Client
 
+	hibernationPreChecks []gardenletconfig.ShootHibernationPreCheck
+
 	log   logr.Logger
 	clock clock.Clock
 }
@@ -81,12 +85,14 @@ func NewConstraint(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	hibernationPreChecks []gardenletconfig.ShootHibernationPreCheck,
 ) *Constraint {
 	return &Constraint{
 		clock:                  clock,
 		shoot:                  shoot,
 		seedClient:             seedClient,
 		initializeShootClients: shootClientInit,
+		hibernationPreChecks:   hibernationPreChecks,
 		log:                    log,
 	}
 }
@@ -150,6 +156,16 @@ func (c *Constraint) constraintsChecks(
 		constraints.maintenancePreconditionsSatisfied = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.maintenancePreconditionsSatisfied, status, reason, message, errorCodes...)
 	}
 
+	// The hibernation pre-checks are only considered if the hibernation is not already prevented by problematic webhooks.
+	if constraints.hibernationPossible.Status == gardencorev1beta1.ConditionTrue && len(c.hibernationPreChecks) > 0 {
+		status, reason, message, err = c.checkHibernationPreChecks(ctx)
+		if err != nil {
+			constraints.hibernationPossible = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.hibernationPossible, err)
+		} else if status != "" {
+			constraints.hibernationPossible = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.hibernationPossible, status, reason, message)
+		}
+	}
+
 	status, reason, message, err = c.checkIfCRDsWithProblematicConversionWebhooksPresent(ctx)
 	if err != nil {
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, err)
@@ -157,9 +173,21 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	optionalConstraints := []gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks}
+
+	if c.isDualStackMigrationInitiated() {
+		status, reason, message, err = c.checkIfNodesMigratedToDualStack(ctx)
+		if err != nil {
+			constraints.dualStackNodesMigrationReady = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.dualStackNodesMigrationReady, err)
+		} else {
+			constraints.dualStackNodesMigrationReady = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.dualStackNodesMigrationReady, status, reason, message)
+		}
+		optionalConstraints = append(optionalConstraints, constraints.dualStackNodesMigrationReady)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks},
+		optionalConstraints,
 	)
 }
 
@@ -225,19 +253,53 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 		}
 
 		return gardencorev1beta1.ConditionFalse,
-			"ExpiringCACertificates",
+			gardencorev1beta1.ExpiringCACertificates,
 			fmt.Sprintf("Some CA certificates are expiring in less than %s, you should rotate them: %s", minimumValidity, strings.Join(msgs, ", ")),
 			nil,
 			nil
 	}
 
 	return gardencorev1beta1.ConditionTrue,
-		"NoExpiringCACertificates",
+		gardencorev1beta1.NoExpiringCACertificates,
 		fmt.Sprintf("All CA certificates are still valid for at least %s.", minimumValidity),
 		nil,
 		nil
 }
 
+func (c *Constraint) isDualStackMigrationInitiated() bool {
+	shoot := c.shoot.GetInfo()
+	return shoot.Status.Networking != nil && shoot.Status.Networking.DualStackMigrationInitiationTime != nil &&
+		shoot.Spec.Networking != nil && len(shoot.Spec.Networking.IPFamilies) > 1
+}
+
+// checkIfNodesMigratedToDualStack checks whether all nodes of the shoot have pod CIDRs of both IP families assigned
+// after the migration to dual-stack networking was initiated.
+func (c *Constraint) checkIfNodesMigratedToDualStack(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	nodeList := &corev1.NodeList{}
+	if err := c.shootClient.List(ctx, nodeList); err != nil {
+		return "", "", "", fmt.Errorf("could not list nodes in the shoot: %w", err)
+	}
+
+	var nodesNotMigrated int
+	for _, node := range nodeList.Items {
+		if !kubernetesutils.HasDualStackPodCIDRs(&node) {
+			nodesNotMigrated++
+		}
+	}
+
+	if nodesNotMigrated > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			gardencorev1beta1.DualStackNodesMigrationPending,
+			fmt.Sprintf("%d of %d nodes do not have pod CIDRs of both IP families yet, the network plugin is switched to dual-stack once all nodes have been rolled.", nodesNotMigrated, len(nodeList.Items)),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		gardencorev1beta1.DualStackNodesMigrated,
+		"All nodes have pod CIDRs of both IP families.",
+		nil
+}
+
 // checkIfCRDsWithProblematicConversionWebhooksPresent checks whether there are CRDs with multiple stored versions and
 // conversion webhooks are present in the cluster.
 func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
@@ -258,14 +320,14 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 
 	if crdsWithProblematicConversionWebhooks.Len() > 0 {
 		return gardencorev1beta1.ConditionFalse,
-			"CRDsWithProblematicConversionWebhooks",
+			gardencorev1beta1.CRDsWithProblematicConversionWebhooks,
 			fmt.Sprintf("Some CRDs in your cluster have multiple stored versions present and have a conversion webhook configured: %s. Please see https://github.com/gardener/gardener/blob/master/docs/usage/shoot_status.md#constraints for more details.",
 				strings.Join(sets.List(crdsWithProblematicConversionWebhooks), ", ")),
 			nil
 	}
 
 	return gardencorev1beta1.ConditionTrue,
-		"NoCRDsWithProblematicConversionWebhooks",
+		gardencorev1beta1.NoCRDsWithProblematicConversionWebhooks,
 		"No CRDs have multiple stored versions present and a conversion webhook configured",
 		nil
 }
@@ -283,7 +345,7 @@ func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencor
 			if IsProblematicWebhook(w.FailurePolicy, w.ObjectSelector, w.NamespaceSelector, w.Rules, w.TimeoutSeconds) {
 				msg := buildProblematicWebhookMessage("ValidatingWebhookConfiguration", webhookConfig.Name, w.Name, w.FailurePolicy, w.TimeoutSeconds)
 				return gardencorev1beta1.ConditionFalse,
-					"ProblematicWebhooks",
+					gardencorev1beta1.ProblematicWebhooks,
 					msg,
 					[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorProblematicWebhook},
 					nil
@@ -292,7 +354,7 @@ func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencor
 
 		if wasRemediatedByGardener(webhookConfig.Annotations) {
 			return gardencorev1beta1.ConditionFalse,
-				"RemediatedWebhooks",
+				gardencorev1beta1.RemediatedWebhooks,
 				fmt.Sprintf("ValidatingWebhookConfiguration %q is problematic and was remediated by Gardener (please check its annotations for details).", webhookConfig.Name),
 				[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorProblematicWebhook},
 				nil
@@ -309,7 +371,7 @@ func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencor
 			if IsProblematicWebhook(w.FailurePolicy, w.ObjectSelector, w.NamespaceSelector, w.Rules, w.TimeoutSeconds) {
 				msg := buildProblematicWebhookMessage("MutatingWebhookConfiguration", webhookConfig.Name, w.Name, w.FailurePolicy, w.TimeoutSeconds)
 				return gardencorev1beta1.ConditionFalse,
-					"ProblematicWebhooks",
+					gardencorev1beta1.ProblematicWebhooks,
 					msg,
 					[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorProblematicWebhook},
 					nil
@@ -318,7 +380,7 @@ func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencor
 
 		if wasRemediatedByGardener(webhookConfig.Annotations) {
 			return gardencorev1beta1.ConditionFalse,
-				"RemediatedWebhooks",
+				gardencorev1beta1.RemediatedWebhooks,
 				fmt.Sprintf("MutatingWebhookConfiguration %q is problematic and was remediated by Gardener (please check its annotations for details).", webhookConfig.Name),
 				[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorProblematicWebhook},
 				nil
@@ -326,7 +388,7 @@ func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencor
 	}
 
 	return gardencorev1beta1.ConditionTrue,
-		"NoProblematicWebhooks",
+		gardencorev1beta1.NoProblematicWebhooks,
 		"All webhooks are properly configured.",
 		nil,
 		nil
@@ -425,6 +487,7 @@ type ShootConstraints struct {
 	maintenancePreconditionsSatisfied     gardencorev1beta1.Condition
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
+	dualStackNodesMigrationReady          gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -434,6 +497,7 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.maintenancePreconditionsSatisfied,
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
+		g.dualStackNodesMigrationReady,
 	}
 }
 
@@ -444,6 +508,7 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.maintenancePreconditionsSatisfied.Type,
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
+		g.dualStackNodesMigrationReady.Type,
 	}
 }
 
@@ -455,5 +520,6 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		dualStackNodesMigrationReady:          v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootDualStackNodesMigrationReady),
 	}
 }
@@ -24,12 +24,14 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	rbacv1alpha1 "k8s.io/api/rbac/v1alpha1"
 	rbacv1beta1 "k8s.io/api/rbac/v1beta1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	schedulingv1alpha1 "k8s.io/api/scheduling/v1alpha1"
 	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +46,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -447,7 +450,8 @@ var _ = Describe("Constraints", func() {
 			seedClient    client.Client
 			shootClient   client.Client
 
-			constraint *Constraint
+			operationShoot *shootpkg.Shoot
+			constraint     *Constraint
 
 			newCASecret = func(validUntilTime time.Time) *corev1.Secret {
 				return &corev1.Secret{
@@ -470,19 +474,20 @@ var _ = Describe("Constraints", func() {
 			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
 
-			shoot := &shootpkg.Shoot{
+			operationShoot = &shootpkg.Shoot{
 				SeedNamespace: seedNamespace,
 			}
-			shoot.SetInfo(&gardencorev1beta1.Shoot{})
+			operationShoot.SetInfo(&gardencorev1beta1.Shoot{})
 
 			constraint = NewConstraint(
 				logr.Discard(),
-				shoot,
+				operationShoot,
 				seedClient,
 				func() (kubernetes.Interface, bool, error) {
 					return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 				},
 				clock,
+				nil,
 			)
 		})
 
@@ -560,6 +565,155 @@ var _ = Describe("Constraints", func() {
 					WithMessage(fmt.Sprintf("Some CRDs in your cluster have multiple stored versions present and have a conversion webhook configured: %s.", crd1.Name)),
 				))
 			})
+
+			Context("dual-stack migration", func() {
+				BeforeEach(func() {
+					operationShoot.SetInfo(&gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Networking: &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}},
+						},
+						Status: gardencorev1beta1.ShootStatus{
+							Networking: &gardencorev1beta1.NetworkingStatus{DualStackMigrationInitiationTime: &metav1.Time{Time: now}},
+						},
+					})
+
+					Expect(shootClient.Create(ctx, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+						Spec:       corev1.NodeSpec{PodCIDRs: []string{"100.96.0.0/24", "2001:db8:1::/64"}},
+					})).To(Succeed())
+				})
+
+				It("should not keep the `DualStackNodesMigrationReady` constraint when the migration was not initiated", func() {
+					operationShoot.GetInfo().Status.Networking = nil
+					Expect(shootClient.Create(ctx, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+						Spec:       corev1.NodeSpec{PodCIDRs: []string{"100.96.1.0/24"}},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+					))
+				})
+
+				It("should not keep the `DualStackNodesMigrationReady` constraint when all nodes were migrated", func() {
+					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+					))
+				})
+
+				It("should keep the `DualStackNodesMigrationReady` constraint when not all nodes were migrated", func() {
+					Expect(shootClient.Create(ctx, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+						Spec:       corev1.NodeSpec{PodCIDRs: []string{"100.96.1.0/24"}},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("DualStackNodesMigrationPending"),
+						WithMessage("1 of 2 nodes do not have pod CIDRs of both IP families yet, the network plugin is switched to dual-stack once all nodes have been rolled."),
+					))
+				})
+			})
+			Context("hibernation pre-checks", func() {
+				var preChecks []gardenletconfig.ShootHibernationPreCheck
+
+				BeforeEach(func() {
+					preChecks = []gardenletconfig.ShootHibernationPreCheck{
+						{Name: gardenletconfig.ShootHibernationPreCheckPendingVolumeDetachments, Mode: gardenletconfig.ShootHibernationPreCheckModeBlock},
+						{Name: gardenletconfig.ShootHibernationPreCheckCredentialsRotationInProgress, Mode: gardenletconfig.ShootHibernationPreCheckModeWarn},
+						{Name: gardenletconfig.ShootHibernationPreCheckPodDisruptionBudgets, Mode: gardenletconfig.ShootHibernationPreCheckModeBlock},
+					}
+				})
+
+				JustBeforeEach(func() {
+					constraint = NewConstraint(
+						logr.Discard(),
+						operationShoot,
+						seedClient,
+						func() (kubernetes.Interface, bool, error) {
+							return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+						},
+						clock,
+						preChecks,
+					)
+				})
+
+				It("should keep the `HibernationPossible` constraint true when all pre-checks succeed", func() {
+					Expect(shootClient.Create(ctx, &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: "default", Labels: map[string]string{"hibernation.shoot.gardener.cloud/pre-check": "true"}},
+						Status:     policyv1.PodDisruptionBudgetStatus{ExpectedPods: 2, DisruptionsAllowed: 1},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootHibernationPossible),
+						WithStatus(gardencorev1beta1.ConditionTrue),
+						WithReason("NoProblematicWebhooks"),
+					))
+				})
+
+				It("should report failed pre-checks in mode 'Warn' without blocking the hibernation", func() {
+					operationShoot.GetInfo().Status.Credentials = &gardencorev1beta1.ShootCredentials{
+						Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+							CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+						},
+					}
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootHibernationPossible),
+						WithStatus(gardencorev1beta1.ConditionTrue),
+						WithReason("HibernationPreChecksWarning"),
+						WithMessage("CredentialsRotationInProgress: rotation of credentials is in progress: certificate authorities (Preparing)"),
+					))
+				})
+
+				It("should block the hibernation when pre-checks in mode 'Block' fail", func() {
+					Expect(shootClient.Create(ctx, &storagev1.VolumeAttachment{
+						ObjectMeta: metav1.ObjectMeta{Name: "va1"},
+						Spec:       storagev1.VolumeAttachmentSpec{Attacher: "csi", NodeName: "node1"},
+						Status:     storagev1.VolumeAttachmentStatus{DetachError: &storagev1.VolumeError{Message: "timeout"}},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &storagev1.VolumeAttachment{
+						ObjectMeta: metav1.ObjectMeta{Name: "va2"},
+						Spec:       storagev1.VolumeAttachmentSpec{Attacher: "csi", NodeName: "node1"},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: "pdb1", Namespace: "default", Labels: map[string]string{"hibernation.shoot.gardener.cloud/pre-check": "true"}},
+						Status:     policyv1.PodDisruptionBudgetStatus{ExpectedPods: 1, DisruptionsAllowed: 0},
+					})).To(Succeed())
+					Expect(shootClient.Create(ctx, &policyv1.PodDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{Name: "pdb2", Namespace: "default"},
+						Status:     policyv1.PodDisruptionBudgetStatus{ExpectedPods: 1, DisruptionsAllowed: 0},
+					})).To(Succeed())
+
+					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootHibernationPossible),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("HibernationPreChecksFailed"),
+						WithMessage("Shoot cannot be hibernated because pre-checks failed: PendingVolumeDetachments: volumes are still being detached (VolumeAttachments va1); PodDisruptionBudgets: PodDisruptionBudgets do not allow any disruption (default/pdb1)"),
+					))
+				})
+
+				Context("not all pre-checks configured", func() {
+					BeforeEach(func() {
+						preChecks = preChecks[2:]
+					})
+
+					It("should not perform pre-checks which are not configured", func() {
+						operationShoot.GetInfo().Status.Credentials = &gardencorev1beta1.ShootCredentials{
+							Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+								CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationCompleting},
+							},
+						}
+
+						Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+							OfType(gardencorev1beta1.ShootHibernationPossible),
+							WithStatus(gardencorev1beta1.ConditionTrue),
+							WithReason("NoProblematicWebhooks"),
+						))
+					})
+				})
+			})
 		})
 
 		Describe("#CheckIfCACertificateValiditiesAcceptable", func() {
@@ -641,6 +795,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -660,6 +815,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -673,6 +829,7 @@ var _ = Describe("Constraints", func() {
 					OfType("MaintenancePreconditionsSatisfied"),
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
+					OfType("DualStackNodesMigrationReady"),
 				))
 			})
 		})
@@ -686,6 +843,7 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("MaintenancePreconditionsSatisfied"),
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
+					gardencorev1beta1.ConditionType("DualStackNodesMigrationReady"),
 				))
 			})
 		})
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// EventEtcdMemberReplaced is an event reason for a replaced etcd member.
+	EventEtcdMemberReplaced = "EtcdMemberReplaced"
+	// EventEtcdMemberReplacementSkipped is an event reason for a failed etcd member which cannot be replaced safely.
+	EventEtcdMemberReplacementSkipped = "EtcdMemberReplacementSkipped"
+)
+
+// EtcdMemberRemediation contains required information for the replacement of permanently failed etcd members.
+type EtcdMemberRemediation struct {
+	log        logr.Logger
+	seedClient client.Client
+	recorder   record.EventRecorder
+	clock      clock.Clock
+	shoot      *gardencorev1beta1.Shoot
+	namespace  string
+	threshold  time.Duration
+}
+
+// NewEtcdMemberRemediation creates a new instance for etcd member remediation.
+func NewEtcdMemberRemediation(
+	log logr.Logger,
+	seedClient client.Client,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	threshold time.Duration,
+) *EtcdMemberRemediation {
+	return &EtcdMemberRemediation{
+		log:        log,
+		seedClient: seedClient,
+		recorder:   recorder,
+		clock:      clock,
+		shoot:      shoot,
+		namespace:  namespace,
+		threshold:  threshold,
+	}
+}
+
+// Remediate replaces members of highly available etcd clusters which have been failing for longer than the threshold.
+// The persistent volume claim and the pod of such a member are deleted, so that the member is re-added to the etcd
+// cluster with a fresh data directory by the backup-restore sidecar. At most one member per etcd cluster is replaced,
+// and only if the remaining members still form a quorum.
+func (r *EtcdMemberRemediation) Remediate(ctx context.Context) error {
+	if r.shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	etcdList := &druidv1alpha1.EtcdList{}
+	if err := r.seedClient.List(ctx, etcdList, client.InNamespace(r.namespace)); err != nil {
+		return fmt.Errorf("failed listing etcds: %w", err)
+	}
+
+	for _, etcd := range etcdList.Items {
+		if err := r.remediateEtcd(ctx, &etcd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *EtcdMemberRemediation) remediateEtcd(ctx context.Context, etcd *druidv1alpha1.Etcd) error {
+	// Single-node etcd clusters cannot be remediated since there are no other members to re-add the member from.
+	if etcd.Spec.Replicas < 3 || etcd.DeletionTimestamp != nil || ptr.Deref(etcd.Status.ObservedGeneration, 0) != etcd.Generation {
+		return nil
+	}
+
+	var (
+		log           = r.log.WithValues("etcd", client.ObjectKeyFromObject(etcd))
+		readyMembers  int32
+		failedMembers []druidv1alpha1.EtcdMemberStatus
+	)
+
+	for _, member := range etcd.Status.Members {
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			readyMembers++
+			continue
+		}
+		if r.clock.Since(member.LastTransitionTime.Time) > r.threshold {
+			failedMembers = append(failedMembers, member)
+		}
+	}
+
+	if len(failedMembers) == 0 {
+		return nil
+	}
+
+	if quorum := etcd.Spec.Replicas/2 + 1; len(failedMembers) > 1 || readyMembers < quorum {
+		log.Info("Skipping replacement of failed etcd members since the etcd cluster has no healthy quorum", "failedMembers", len(failedMembers), "readyMembers", readyMembers)
+		r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventEtcdMemberReplacementSkipped, "Failed members of etcd %q cannot be replaced automatically since only %d of %d members are ready", etcd.Name, readyMembers, etcd.Spec.Replicas)
+		return nil
+	}
+
+	member := failedMembers[0]
+	log = log.WithValues("member", member.Name)
+
+	pod := &corev1.Pod{}
+	if err := r.seedClient.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: member.Name}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading pod of etcd member %q: %w", member.Name, err)
+	}
+
+	// The member status might be outdated, hence the pod must have been failing for longer than the threshold, too.
+	// This also prevents replacing the member again while the re-created pod is still catching up.
+	if health.IsPodReady(pod) || r.clock.Since(pod.CreationTimestamp.Time) <= r.threshold {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+		Name:      ptr.Deref(etcd.Spec.VolumeClaimTemplate, etcd.Name) + "-" + member.Name,
+		Namespace: etcd.Namespace,
+	}}
+
+	log.Info("Replacing failed etcd member", "notReadySince", member.LastTransitionTime.Time, "reason", member.Reason)
+	if err := r.seedClient.Delete(ctx, pvc); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting persistent volume claim of etcd member %q: %w", member.Name, err)
+	}
+	if err := r.seedClient.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting pod of etcd member %q: %w", member.Name, err)
+	}
+
+	r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventEtcdMemberReplaced, "Replaced member %q of etcd %q which was not ready since %s (reason: %s), it is re-added to the etcd cluster with a fresh data directory", member.Name, etcd.Name, member.LastTransitionTime.UTC().Format(time.RFC3339), member.Reason)
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("EtcdMemberRemediation", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		threshold = 15 * time.Minute
+	)
+
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		fakeClock  *testclock.FakeClock
+
+		shoot *gardencorev1beta1.Shoot
+		etcd  *druidv1alpha1.Etcd
+		pod   *corev1.Pod
+		pvc   *corev1.PersistentVolumeClaim
+
+		remediator *EtcdMemberRemediation
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		recorder = record.NewFakeRecorder(10)
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+
+		etcd = &druidv1alpha1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace, Generation: 1},
+			Spec: druidv1alpha1.EtcdSpec{
+				Replicas:            3,
+				VolumeClaimTemplate: ptr.To("main-etcd"),
+			},
+			Status: druidv1alpha1.EtcdStatus{
+				ObservedGeneration: ptr.To[int64](1),
+				Members: []druidv1alpha1.EtcdMemberStatus{
+					{Name: "etcd-main-0", Status: druidv1alpha1.EtcdMemberStatusReady},
+					{Name: "etcd-main-1", Status: druidv1alpha1.EtcdMemberStatusNotReady, Reason: "LeaseExpired", LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+					{Name: "etcd-main-2", Status: druidv1alpha1.EtcdMemberStatusReady},
+				},
+			},
+		}
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:              "etcd-main-1",
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+		}}
+		pvc = &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "main-etcd-etcd-main-1", Namespace: namespace}}
+
+		remediator = NewEtcdMemberRemediation(logr.Discard(), fakeClient, recorder, fakeClock, shoot, namespace, threshold)
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, etcd)).To(Succeed())
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+	})
+
+	Describe("#Remediate", func() {
+		It("should replace the failed member", func() {
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(BeNotFoundError())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventEtcdMemberReplaced)))
+		})
+
+		It("should not replace the member if it is failing for less than the threshold", func() {
+			fakeClock.Step(-50 * time.Minute)
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		Context("pod was re-created recently", func() {
+			BeforeEach(func() {
+				pod.CreationTimestamp = metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+			})
+
+			It("should not replace the member again", func() {
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+			})
+		})
+
+		Context("pod is ready", func() {
+			BeforeEach(func() {
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+			})
+
+			It("should not replace the member", func() {
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+			})
+		})
+
+		Context("etcd is not highly available", func() {
+			BeforeEach(func() {
+				etcd.Spec.Replicas = 1
+			})
+
+			It("should not replace the member", func() {
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+			})
+		})
+
+		Context("etcd has no healthy quorum", func() {
+			BeforeEach(func() {
+				etcd.Status.Members[2].Status = druidv1alpha1.EtcdMemberStatusUnknown
+				etcd.Status.Members[2].LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+			})
+
+			It("should not replace any member and report it", func() {
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventEtcdMemberReplacementSkipped)))
+			})
+		})
+
+		Context("etcd spec was not yet observed", func() {
+			BeforeEach(func() {
+				etcd.Generation = 2
+			})
+
+			It("should not replace the member", func() {
+				Expect(remediator.Remediate(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			})
+		})
+	})
+})
@@ -186,7 +186,7 @@ func (h *Health) Check(
 			message = fmt.Sprintf("Could not initialize Shoot client for health check: %+v", err)
 		}
 
-		conditions.apiServerAvailable = v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, conditions.apiServerAvailable, "APIServerDown", "Could not reach API server during client initialization.")
+		conditions.apiServerAvailable = v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, conditions.apiServerAvailable, gardencorev1beta1.APIServerDown, "Could not reach API server during client initialization.")
 		conditions.systemComponentsHealthy = v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, conditions.systemComponentsHealthy, message)
 		if conditions.everyNodeReady != nil {
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
@@ -245,7 +245,7 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 		}
 
 		for _, condition := range acc.GetExtensionStatus().GetConditions() {
-			switch condition.Type {
+			switch shootConditionTypeForExtensionCondition(condition.Type) {
 			case gardencorev1beta1.ShootControlPlaneHealthy:
 				conditionsControlPlaneHealthy = append(conditionsControlPlaneHealthy, healthchecker.ExtensionCondition{
 					Condition:          condition,
@@ -285,6 +285,14 @@ func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker
 	return conditionsControlPlaneHealthy, conditionsEveryNodeReady, conditionsSystemComponentsHealthy, conditionsObservabilityComponentsHealthy, nil
 }
 
+// shootConditionTypeForExtensionCondition returns the type of the shoot condition to which the extension condition
+// with the given type contributes. Additional health contributors of extensions report conditions of the form
+// `<shoot-condition-type>/<contributor-name>`.
+func shootConditionTypeForExtensionCondition(conditionType gardencorev1beta1.ConditionType) gardencorev1beta1.ConditionType {
+	shootConditionType, _, _ := strings.Cut(string(conditionType), extensionsv1alpha1.HealthContributorConditionTypeSeparator)
+	return gardencorev1beta1.ConditionType(shootConditionType)
+}
+
 func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, error) {
 	var (
 		allExtensions       []runtime.Object
@@ -412,9 +420,9 @@ func (h *Health) checkControlPlane(
 
 	if !h.shoot.IsWorkerless && v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(h.seed.GetInfo().Spec.Settings) {
 		if scaledDownDeploymentNames, err := CheckIfDependencyWatchdogProberScaledDownControllers(ctx, h.seedClient.Client(), h.shoot.SeedNamespace); err != nil {
-			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ControllersScaledDownCheckError", err.Error())), nil
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.ControllersScaledDownCheckError, err.Error())), nil
 		} else if len(scaledDownDeploymentNames) > 0 {
-			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ControllersScaledDown", fmt.Sprintf("The following deployments have been scaled down to 0 replicas (perhaps by dependency-watchdog-prober): %s", strings.Join(scaledDownDeploymentNames, ", ")))), nil
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.ControllersScaledDown, fmt.Sprintf("The following deployments have been scaled down to 0 replicas (perhaps by dependency-watchdog-prober): %s", strings.Join(scaledDownDeploymentNames, ", ")))), nil
 		}
 	}
 
@@ -429,7 +437,7 @@ func (h *Health) checkControlPlane(
 		return exitCondition, nil
 	}
 
-	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ControlPlaneRunning", "All control plane components are healthy.")
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ControlPlaneRunning, "All control plane components are healthy.")
 	return &c, nil
 }
 
@@ -511,7 +519,7 @@ func (h *Health) checkObservabilityComponents(
 		return exitCondition, nil
 	}
 
-	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ObservabilityComponentsRunning", "All observability components are healthy.")
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ObservabilityComponentsRunning, "All observability components are healthy.")
 	return &c, nil
 }
 
@@ -538,14 +546,14 @@ func (h *Health) checkSystemComponents(
 		return exitCondition, nil
 	}
 
-	if !h.shoot.IsWorkerless {
+	if !h.shoot.IsWorkerless && gardenlethelper.IsShootHealthCheckEnabled(h.gardenletConfiguration, gardenletconfig.ShootHealthCheckTunnelConnection) {
 		podsList := &corev1.PodList{}
 		if err := shootClient.Client().List(ctx, podsList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{"type": "tunnel"}); err != nil {
 			return nil, err
 		}
 
 		if len(podsList.Items) == 0 {
-			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NoTunnelDeployed", "no tunnels are currently deployed to perform health-check on")
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.NoTunnelDeployed, "no tunnels are currently deployed to perform health-check on")
 			return &c, nil
 		}
 
@@ -554,12 +562,12 @@ func (h *Health) checkSystemComponents(
 			if err != nil {
 				msg += fmt.Sprintf(" (%+v)", err)
 			}
-			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "TunnelConnectionBroken", msg)
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.TunnelConnectionBroken, msg)
 			return &c, nil
 		}
 	}
 
-	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.SystemComponentsRunning, "All system components are healthy.")
 	return &c, nil
 }
 
@@ -579,7 +587,7 @@ func (h *Health) checkWorkers(
 		return exitCondition, err
 	}
 
-	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "EveryNodeReady", "All nodes are ready.")
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EveryNodeReady, "All nodes are ready.")
 	return &c, nil
 }
 
@@ -630,14 +638,16 @@ func (h *Health) CheckClusterNodes(
 		}
 
 		if len(nodes) < int(pool.Minimum) {
-			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "MissingNodes", fmt.Sprintf("Not enough worker nodes registered in worker pool %q to meet minimum desired machine count. (%d/%d).", pool.Name, len(nodes), pool.Minimum))
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.MissingNodes, fmt.Sprintf("Not enough worker nodes registered in worker pool %q to meet minimum desired machine count. (%d/%d).", pool.Name, len(nodes), pool.Minimum))
 			return &c, nil
 		}
 	}
 
-	if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
-		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "OperatingSystemConfigOutdated", err.Error())
-		return &c, nil
+	if gardenlethelper.IsShootHealthCheckEnabled(h.gardenletConfiguration, gardenletconfig.ShootHealthCheckOperatingSystemConfig) {
+		if err := botanist.OperatingSystemConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.OperatingSystemConfigOutdated, err.Error())
+			return &c, nil
+		}
 	}
 
 	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
@@ -647,13 +657,14 @@ func (h *Health) CheckClusterNodes(
 
 	var (
 		nodeList            = convertWorkerPoolToNodesMappingToNodeList(workerPoolToNodes)
+		machineNodeList     = convertWorkerPoolToNodesMappingToNodeList(provisionedWorkerPoolToNodes(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes))
 		readyNodes          int
-		registeredNodes     = len(nodeList.Items)
+		registeredNodes     = len(machineNodeList.Items)
 		desiredMachines     = getDesiredMachineCount(machineDeploymentList.Items)
 		nodeNotManagedByMCM int
 	)
 
-	for _, node := range nodeList.Items {
+	for _, node := range machineNodeList.Items {
 		if metav1.HasAnnotation(node.ObjectMeta, annotationKeyNotManagedByMCM) && node.Annotations[annotationKeyNotManagedByMCM] == "1" {
 			nodeNotManagedByMCM++
 			continue
@@ -678,63 +689,71 @@ func (h *Health) CheckClusterNodes(
 		}
 	}
 
-	leaseList := &coordinationv1.LeaseList{}
-	if err := shootClient.Client().List(ctx, leaseList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
-		return nil, err
-	}
+	if gardenlethelper.IsShootHealthCheckEnabled(h.gardenletConfiguration, gardenletconfig.ShootHealthCheckNodeAgentLeases) {
+		leaseList := &coordinationv1.LeaseList{}
+		if err := shootClient.Client().List(ctx, leaseList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+			return nil, err
+		}
 
-	if err := CheckNodeAgentLeases(nodeList, leaseList, h.clock); err != nil {
-		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NodeAgentUnhealthy", err.Error())
-		return &c, nil
+		if err := CheckNodeAgentLeases(nodeList, leaseList, h.clock, gardenlethelper.GetNodeAgentLeaseStalenessThreshold(h.gardenletConfiguration)); err != nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.NodeAgentUnhealthy, err.Error())
+			return &c, nil
+		}
 	}
 
-	// First check if the MachineDeployments report failed machines. If false then check if the MachineDeployments are
-	// "available". If false then check if there is a regular scale-up happening or if there are machines with an erroneous
-	// phase. Only then check the other MachineDeployment conditions. As last check, check if there is a scale-down happening
-	// (e.g., in case of a rolling-update).
+	if gardenlethelper.IsShootHealthCheckEnabled(h.gardenletConfiguration, gardenletconfig.ShootHealthCheckNodesScaling) {
+		// First check if the MachineDeployments report failed machines. If false then check if the MachineDeployments are
+		// "available". If false then check if there is a regular scale-up happening or if there are machines with an erroneous
+		// phase. Only then check the other MachineDeployment conditions. As last check, check if there is a scale-down happening
+		// (e.g., in case of a rolling-update).
+
+		checkScaleUp := false
+		for _, deployment := range machineDeploymentList.Items {
+			if len(deployment.Status.FailedMachines) > 0 {
+				break
+			}
 
-	checkScaleUp := false
-	for _, deployment := range machineDeploymentList.Items {
-		if len(deployment.Status.FailedMachines) > 0 {
-			break
+			for _, condition := range deployment.Status.Conditions {
+				if condition.Type == machinev1alpha1.MachineDeploymentAvailable && condition.Status != machinev1alpha1.ConditionTrue {
+					checkScaleUp = true
+					break
+				}
+			}
 		}
 
-		for _, condition := range deployment.Status.Conditions {
-			if condition.Type == machinev1alpha1.MachineDeploymentAvailable && condition.Status != machinev1alpha1.ConditionTrue {
-				checkScaleUp = true
-				break
+		if checkScaleUp {
+			if err := CheckNodesScalingUp(machineList, readyNodes, desiredMachines); err != nil {
+				c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.NodesScalingUp, err.Error())
+				return &c, nil
 			}
 		}
-	}
 
-	if checkScaleUp {
-		if err := CheckNodesScalingUp(machineList, readyNodes, desiredMachines); err != nil {
-			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NodesScalingUp", err.Error())
+		if err := CheckNodesScalingDown(machineList, machineNodeList, registeredNodes, desiredMachines); err != nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.NodesScalingDown, err.Error())
 			return &c, nil
 		}
 	}
 
-	if err := CheckNodesScalingDown(machineList, nodeList, registeredNodes, desiredMachines); err != nil {
-		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NodesScalingDown", err.Error())
-		return &c, nil
-	}
-
-	if !h.shoot.IsWorkerless && v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(h.seed.GetInfo().Spec.Settings) {
+	if !h.shoot.IsWorkerless &&
+		v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(h.seed.GetInfo().Spec.Settings) &&
+		gardenlethelper.IsShootHealthCheckEnabled(h.gardenletConfiguration, gardenletconfig.ShootHealthCheckExpiredNodeLeases) {
 		leaseList := &coordinationv1.LeaseList{}
 		if err := shootClient.Client().List(ctx, leaseList, client.InNamespace(corev1.NamespaceNodeLease)); err != nil {
 			return nil, err
 		}
 
-		if err := CheckForExpiredNodeLeases(nodeList, leaseList, h.clock); err != nil {
-			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "TooManyExpiredNodeLeases", err.Error())), nil
+		if err := CheckForExpiredNodeLeases(nodeList, leaseList, h.clock, gardenlethelper.GetExpiredNodeLeasesThresholdPercentage(h.gardenletConfiguration)); err != nil {
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.TooManyExpiredNodeLeases, err.Error())), nil
 		}
 	}
 
 	return nil, nil
 }
 
-// CheckNodeAgentLeases checks if all nodes in the shoot cluster have a corresponding Lease object maintained by gardener-node-agent
-func CheckNodeAgentLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.LeaseList, clock clock.Clock) error {
+// CheckNodeAgentLeases checks if all nodes in the shoot cluster have a corresponding Lease object maintained by gardener-node-agent.
+// A Lease is considered stale if it was not renewed within the given staleness threshold, or within its lease duration
+// if no threshold is given.
+func CheckNodeAgentLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.LeaseList, clock clock.Clock, stalenessThreshold *metav1.Duration) error {
 	nodeNameToLease := make(map[string]coordinationv1.Lease, len(leaseList.Items))
 	for _, lease := range leaseList.Items {
 		if strings.HasPrefix(lease.Name, gardenerutils.NodeLeasePrefix) {
@@ -749,7 +768,12 @@ func CheckNodeAgentLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.L
 			return fmt.Errorf("gardener-node-agent is not running on node %q", node.Name)
 		}
 
-		if lease.Spec.RenewTime.Add(time.Second * time.Duration(*lease.Spec.LeaseDurationSeconds)).Before(clock.Now()) {
+		staleAfter := time.Second * time.Duration(*lease.Spec.LeaseDurationSeconds)
+		if stalenessThreshold != nil {
+			staleAfter = stalenessThreshold.Duration
+		}
+
+		if lease.Spec.RenewTime.Add(staleAfter).Before(clock.Now()) {
 			return fmt.Errorf("gardener-node-agent stopped running on node %q", node.Name)
 		}
 	}
@@ -757,10 +781,10 @@ func CheckNodeAgentLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.L
 	return nil
 }
 
-// CheckForExpiredNodeLeases checks if the number of expired node Leases surpasses 20% of all existing Leases. If yes,
-// an error will be returned. The motivation is that dependency-watchdog is starting to scale down controllers when 60%
-// of the Leases are expired.
-func CheckForExpiredNodeLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.LeaseList, clock clock.Clock) error {
+// CheckForExpiredNodeLeases checks if the number of expired node Leases reaches the given percentage (20% by default) of
+// all existing Leases. If yes, an error will be returned. The motivation is that dependency-watchdog is starting to scale
+// down controllers when 60% of the Leases are expired.
+func CheckForExpiredNodeLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.LeaseList, clock clock.Clock, thresholdPercentage int) error {
 	if len(leaseList.Items) == 0 || len(nodeList.Items) == 0 {
 		return nil
 	}
@@ -779,7 +803,7 @@ func CheckForExpiredNodeLeases(nodeList *corev1.NodeList, leaseList *coordinatio
 		}
 	}
 
-	if expiredLeasesPercentage := 100 * expiredLeases / len(leaseList.Items); expiredLeasesPercentage >= 20 {
+	if expiredLeasesPercentage := 100 * expiredLeases / len(leaseList.Items); expiredLeasesPercentage >= thresholdPercentage {
 		return fmt.Errorf("%d%% of all Leases in %s namespace are expired - dependency-watchdog-prober might start scaling down controllers", expiredLeasesPercentage, corev1.NamespaceNodeLease)
 	}
 
@@ -861,6 +885,25 @@ func CheckNodesScalingDown(machineList *machinev1alpha1.MachineList, nodeList *c
 	return fmt.Errorf("%s waiting to be completely drained from pods. If this persists, check your pod disruption budgets and pending finalizers. Please note, that nodes that fail to be drained will be deleted automatically", cosmeticMachineMessage(cordonedNodes))
 }
 
+// provisionedWorkerPoolToNodes returns the nodes of the worker pools whose machines are provisioned by Gardener. Nodes of
+// worker pools backed by existing hosts are not managed by machine-controller-manager.
+func provisionedWorkerPoolToNodes(workers []gardencorev1beta1.Worker, workerPoolToNodes map[string][]corev1.Node) map[string][]corev1.Node {
+	existingHostsPools := sets.New[string]()
+	for _, worker := range workers {
+		if worker.ExistingHosts != nil {
+			existingHostsPools.Insert(worker.Name)
+		}
+	}
+
+	out := make(map[string][]corev1.Node, len(workerPoolToNodes))
+	for pool, nodes := range workerPoolToNodes {
+		if !existingHostsPools.Has(pool) {
+			out[pool] = nodes
+		}
+	}
+	return out
+}
+
 func convertWorkerPoolToNodesMappingToNodeList(workerPoolToNodes map[string][]corev1.Node) *corev1.NodeList {
 	nodeList := &corev1.NodeList{}
 
@@ -918,7 +961,7 @@ func ComputeRequiredControlPlaneDeployments(shoot *gardencorev1beta1.Shoot) (set
 func shootHibernatedConditions(clock clock.Clock, conditions []gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
 	hibernationConditions := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
-		hibernationConditions = append(hibernationConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionTrue, "ConditionNotChecked", "Shoot cluster has been hibernated."))
+		hibernationConditions = append(hibernationConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ConditionNotChecked, "Shoot cluster has been hibernated."))
 	}
 	return hibernationConditions
 }
@@ -926,7 +969,7 @@ func shootHibernatedConditions(clock clock.Clock, conditions []gardencorev1beta1
 func managedResourceListingFailedConditions(clock clock.Clock, conditions []gardencorev1beta1.Condition, err error) []gardencorev1beta1.Condition {
 	outConditions := make([]gardencorev1beta1.Condition, 0, len(conditions))
 	for _, cond := range conditions {
-		outConditions = append(outConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionFalse, "ManagedResourceListingFailed", fmt.Sprintf("Failed listing ManagedResources: %s", err.Error())))
+		outConditions = append(outConditions, v1beta1helper.UpdatedConditionWithClock(clock, cond, gardencorev1beta1.ConditionFalse, gardencorev1beta1.ManagedResourceListingFailed, fmt.Sprintf("Failed listing ManagedResources: %s", err.Error())))
 	}
 	return outConditions
 }
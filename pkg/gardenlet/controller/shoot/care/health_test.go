@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -420,6 +421,59 @@ var _ = Describe("health check", func() {
 				},
 				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "OperatingSystemConfigOutdated", fmt.Sprintf("the last successfully applied operating system config on node %q is outdated", nodeName)))),
 		)
+
+		It("should not check the operating system config and nodes if the checks are disabled", func() {
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(_ context.Context, list *corev1.NodeList, _ ...client.ListOption) error {
+				*list = corev1.NodeList{Items: []corev1.Node{
+					newNode(labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()}, nil, kubernetesVersion.Original()),
+				}}
+				return nil
+			})
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), gomock.Any()).Return(nil)
+
+			shootObj := &shootpkg.Shoot{
+				SeedNamespace:     seedNamespace,
+				KubernetesVersion: kubernetesVersion,
+			}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{Name: workerPoolName1, Maximum: 10, Minimum: 1}},
+					},
+				},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			health := NewHealth(
+				logr.Discard(),
+				shootObj,
+				seedObj,
+				kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				nil,
+				nil,
+				fakeClock,
+				&gardenletconfig.GardenletConfiguration{
+					Controllers: &gardenletconfig.GardenletControllerConfiguration{
+						ShootCare: &gardenletconfig.ShootCareControllerConfiguration{
+							HealthChecks: &gardenletconfig.ShootHealthChecks{
+								DisabledChecks: []gardenletconfig.ShootHealthCheck{
+									gardenletconfig.ShootHealthCheckOperatingSystemConfig,
+									gardenletconfig.ShootHealthCheckNodeAgentLeases,
+									gardenletconfig.ShootHealthCheckNodesScaling,
+									gardenletconfig.ShootHealthCheckExpiredNodeLeases,
+								},
+							},
+						},
+					},
+				},
+				nil,
+			)
+
+			exitCondition, err := health.CheckClusterNodes(ctx, kubernetesfake.NewClientSetBuilder().WithClient(c).Build(), condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(BeNil())
+		})
 	})
 
 	Describe("#CheckIfDependencyWatchdogProberScaledDownControllers", func() {
@@ -506,7 +560,7 @@ var _ = Describe("health check", func() {
 		)
 
 		DescribeTable("#CheckForExpiredNodeLeases",
-			func(lease *coordinationv1.Lease, node *corev1.Node, additionalNodeNames []string, expected types.GomegaMatcher) {
+			func(lease *coordinationv1.Lease, node *corev1.Node, additionalNodeNames []string, thresholdPercentage int, expected types.GomegaMatcher) {
 				leaseList := coordinationv1.LeaseList{}
 				if lease != nil {
 					leaseList.Items = append(leaseList.Items, *lease)
@@ -525,16 +579,18 @@ var _ = Describe("health check", func() {
 					leaseList.Items = append(leaseList.Items, *lease)
 				}
 
-				Expect(CheckForExpiredNodeLeases(&nodeList, &leaseList, fakeClock)).To(expected)
+				Expect(CheckForExpiredNodeLeases(&nodeList, &leaseList, fakeClock, thresholdPercentage)).To(expected)
 			},
 
-			Entry("should return nil if there is an unexpired lease for node", validLease, node, nil, BeNil()),
-			Entry("should return nil if no leases are present", nil, node, nil, BeNil()),
-			Entry("should return nil if no nodes are present", validLease, nil, nil, BeNil()),
-			Entry("should return nil if no node could be found for the lease", unrelatedLease, node, nil, BeNil()),
-			Entry("should return nil if less than 20% of leases are expired", expiredLease, node, []string{"node2", "node3", "node4", "node5", "node6"}, BeNil()),
-			Entry("should return an error if exactly 20% of leases are expired", expiredLease, node, []string{"node2", "node3", "node4", "node5"}, MatchError(ContainSubstring("Leases in kube-node-lease namespace are expired"))),
-			Entry("should return an error if at least 20% of leases are expired", expiredLease, node, nil, MatchError(ContainSubstring("Leases in kube-node-lease namespace are expired"))),
+			Entry("should return nil if there is an unexpired lease for node", validLease, node, nil, 20, BeNil()),
+			Entry("should return nil if no leases are present", nil, node, nil, 20, BeNil()),
+			Entry("should return nil if no nodes are present", validLease, nil, nil, 20, BeNil()),
+			Entry("should return nil if no node could be found for the lease", unrelatedLease, node, nil, 20, BeNil()),
+			Entry("should return nil if less than 20% of leases are expired", expiredLease, node, []string{"node2", "node3", "node4", "node5", "node6"}, 20, BeNil()),
+			Entry("should return an error if exactly 20% of leases are expired", expiredLease, node, []string{"node2", "node3", "node4", "node5"}, 20, MatchError(ContainSubstring("Leases in kube-node-lease namespace are expired"))),
+			Entry("should return an error if at least 20% of leases are expired", expiredLease, node, nil, 20, MatchError(ContainSubstring("Leases in kube-node-lease namespace are expired"))),
+			Entry("should return nil if less than the configured percentage of leases are expired", expiredLease, node, []string{"node2", "node3", "node4"}, 50, BeNil()),
+			Entry("should return an error if the configured percentage of leases are expired", expiredLease, node, []string{"node2"}, 50, MatchError(ContainSubstring("Leases in kube-node-lease namespace are expired"))),
 		)
 	})
 
@@ -560,6 +616,16 @@ var _ = Describe("health check", func() {
 				},
 			}
 
+			outdatedLease = coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gardener-node-agent-node1",
+				},
+				Spec: coordinationv1.LeaseSpec{
+					RenewTime:            &metav1.MicroTime{Time: fakeClock.Now().Add(-2 * time.Minute)},
+					LeaseDurationSeconds: ptr.To[int32](300),
+				},
+			}
+
 			unrelatedLease = coordinationv1.Lease{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "gardener-node-agent-node2",
@@ -581,18 +647,20 @@ var _ = Describe("health check", func() {
 			}
 		)
 
-		DescribeTable("#CheckingNodeAgentLease", func(lease coordinationv1.Lease, expected types.GomegaMatcher) {
+		DescribeTable("#CheckingNodeAgentLease", func(lease coordinationv1.Lease, stalenessThreshold *metav1.Duration, expected types.GomegaMatcher) {
 			leaseList := coordinationv1.LeaseList{
 				Items: []coordinationv1.Lease{
 					lease,
 				},
 			}
 
-			Expect(CheckNodeAgentLeases(&nodeList, &leaseList, fakeClock)).To(expected)
+			Expect(CheckNodeAgentLeases(&nodeList, &leaseList, fakeClock, stalenessThreshold)).To(expected)
 		},
-			Entry("should return nil if there is a matching lease for node", validLease, BeNil()),
-			Entry("should return Error that node agent is not running if no matching lease could be found for node", unrelatedLease, MatchError(ContainSubstring("not running"))),
-			Entry("should return Error that node agent stopped running if the lease for the node is not valid anymore", expiredLease, MatchError(ContainSubstring("stopped running"))),
+			Entry("should return nil if there is a matching lease for node", validLease, nil, BeNil()),
+			Entry("should return Error that node agent is not running if no matching lease could be found for node", unrelatedLease, nil, MatchError(ContainSubstring("not running"))),
+			Entry("should return Error that node agent stopped running if the lease for the node is not valid anymore", expiredLease, nil, MatchError(ContainSubstring("stopped running"))),
+			Entry("should return nil if the lease for the node was renewed within the staleness threshold", expiredLease, &metav1.Duration{Duration: time.Minute}, BeNil()),
+			Entry("should return Error that node agent stopped running if the lease for the node was not renewed within the staleness threshold", outdatedLease, &metav1.Duration{Duration: time.Minute}, MatchError(ContainSubstring("stopped running"))),
 		)
 	})
 
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// checkHibernationPreChecks performs the configured hibernation pre-checks. If at least one check in mode 'Block'
+// fails, the returned status is 'False'. If only checks in mode 'Warn' fail, the returned status is 'True' and the
+// failures are reported in the message. If no check fails, the returned status is empty.
+func (c *Constraint) checkHibernationPreChecks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	var blockingFailures, warnings []string
+
+	for _, preCheck := range c.hibernationPreChecks {
+		var (
+			failure string
+			err     error
+		)
+
+		switch preCheck.Name {
+		case gardenletconfig.ShootHibernationPreCheckPendingVolumeDetachments:
+			failure, err = c.checkPendingVolumeDetachments(ctx)
+		case gardenletconfig.ShootHibernationPreCheckCredentialsRotationInProgress:
+			failure = c.checkCredentialsRotationInProgress()
+		case gardenletconfig.ShootHibernationPreCheckPodDisruptionBudgets:
+			failure, err = c.checkPodDisruptionBudgets(ctx)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed performing hibernation pre-check %s: %w", preCheck.Name, err)
+		}
+		if failure == "" {
+			continue
+		}
+
+		failure = fmt.Sprintf("%s: %s", preCheck.Name, failure)
+		if preCheck.Mode == gardenletconfig.ShootHibernationPreCheckModeBlock {
+			blockingFailures = append(blockingFailures, failure)
+		} else {
+			warnings = append(warnings, failure)
+		}
+	}
+
+	if len(blockingFailures) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			gardencorev1beta1.HibernationPreChecksFailed,
+			fmt.Sprintf("Shoot cannot be hibernated because pre-checks failed: %s", strings.Join(append(blockingFailures, warnings...), "; ")),
+			nil
+	}
+
+	if len(warnings) > 0 {
+		return gardencorev1beta1.ConditionTrue,
+			gardencorev1beta1.HibernationPreChecksWarning,
+			fmt.Sprintf("Shoot can be hibernated but waking it up might fail because pre-checks failed: %s", strings.Join(warnings, "; ")),
+			nil
+	}
+
+	return "", "", "", nil
+}
+
+// checkPendingVolumeDetachments checks whether there are VolumeAttachments in the shoot which are being deleted or
+// failed to detach. Hibernating a shoot in this state can leave volumes attached to deleted machines, which then
+// cannot be attached to the new machines when the shoot is woken up.
+func (c *Constraint) checkPendingVolumeDetachments(ctx context.Context) (string, error) {
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := c.shootClient.List(ctx, volumeAttachmentList); err != nil {
+		return "", fmt.Errorf("could not list VolumeAttachments in the shoot: %w", err)
+	}
+
+	var pending []string
+	for _, volumeAttachment := range volumeAttachmentList.Items {
+		if volumeAttachment.DeletionTimestamp != nil || volumeAttachment.Status.DetachError != nil {
+			pending = append(pending, volumeAttachment.Name)
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Sprintf("volumes are still being detached (VolumeAttachments %s)", strings.Join(pending, ", ")), nil
+	}
+	return "", nil
+}
+
+// checkCredentialsRotationInProgress checks whether a credentials rotation of the shoot is being prepared or completed.
+func (c *Constraint) checkCredentialsRotationInProgress() string {
+	credentials := c.shoot.GetInfo().Status.Credentials
+
+	var inProgress []string
+	for _, rotation := range []struct {
+		name  string
+		phase gardencorev1beta1.CredentialsRotationPhase
+	}{
+		{"certificate authorities", v1beta1helper.GetShootCARotationPhase(credentials)},
+		{"service account key", v1beta1helper.GetShootServiceAccountKeyRotationPhase(credentials)},
+		{"etcd encryption key", v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(credentials)},
+	} {
+		if rotation.phase == gardencorev1beta1.RotationPreparing || rotation.phase == gardencorev1beta1.RotationCompleting {
+			inProgress = append(inProgress, fmt.Sprintf("%s (%s)", rotation.name, rotation.phase))
+		}
+	}
+
+	if len(inProgress) > 0 {
+		return fmt.Sprintf("rotation of credentials is in progress: %s", strings.Join(inProgress, ", "))
+	}
+	return ""
+}
+
+// checkPodDisruptionBudgets checks whether PodDisruptionBudgets which were flagged by the user with the
+// 'hibernation.shoot.gardener.cloud/pre-check=true' label currently disallow any disruption. The pods protected by such
+// PodDisruptionBudgets cannot be evicted gracefully when the nodes are drained during the hibernation.
+func (c *Constraint) checkPodDisruptionBudgets(ctx context.Context) (string, error) {
+	podDisruptionBudgetList := &policyv1.PodDisruptionBudgetList{}
+	if err := c.shootClient.List(ctx, podDisruptionBudgetList, client.MatchingLabels{v1beta1constants.LabelHibernationPreCheck: "true"}); err != nil {
+		return "", fmt.Errorf("could not list PodDisruptionBudgets in the shoot: %w", err)
+	}
+
+	var violating []string
+	for _, podDisruptionBudget := range podDisruptionBudgetList.Items {
+		if podDisruptionBudget.Status.ExpectedPods > 0 && podDisruptionBudget.Status.DisruptionsAllowed == 0 {
+			violating = append(violating, client.ObjectKeyFromObject(&podDisruptionBudget).String())
+		}
+	}
+
+	if len(violating) > 0 {
+		return fmt.Sprintf("PodDisruptionBudgets do not allow any disruption (%s)", strings.Join(violating, ", ")), nil
+	}
+	return "", nil
+}
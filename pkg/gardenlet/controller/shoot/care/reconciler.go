@@ -12,6 +12,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -42,6 +44,10 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewEtcdMemberRemediator is used to create a new etcd member remediation instance.
+	NewEtcdMemberRemediator = defaultNewEtcdMemberRemediator
+	// NewRemediator is used to create a new instance for the remediation of failed health checks.
+	NewRemediator = defaultNewRemediator
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -51,9 +57,16 @@ type Reconciler struct {
 	ShootClientMap        clientmap.ClientMap
 	Config                config.GardenletConfiguration
 	Clock                 clock.Clock
+	Recorder              record.EventRecorder
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
+	// RemediationRateLimiter limits the number of remediation actions per shoot across all reconciliations. It is
+	// created based on the configuration if not set.
+	RemediationRateLimiter *RemediationRateLimiter
+	// Settings contains the settings which are reloaded without restarting gardenlet. If nil, the concurrency of Config
+	// is used.
+	Settings *reload.Settings
 
 	gardenSecrets map[string]*corev1.Secret
 }
@@ -159,6 +172,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				r.SeedClientSet.Client(),
 				initializeShootClients,
 				clock.RealClock{},
+				r.Config.Controllers.ShootCare.HibernationPreChecks,
 			).Check(
 				ctx,
 				shootConstraints,
@@ -179,10 +193,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Trigger etcd member remediation
+		func(ctx context.Context) error {
+			if cfg := r.Config.Controllers.ShootCare.EtcdMemberRemediation; cfg != nil && cfg.Enabled {
+				if err := NewEtcdMemberRemediator(log, r.SeedClientSet.Client(), r.Recorder, r.Clock, shoot, o.Shoot.SeedNamespace, cfg.Threshold.Duration).Remediate(ctx); err != nil {
+					// errors during etcd member remediation are only being logged and do not cause the care operation to fail
+					log.Error(err, "Failed remediating etcd members")
+				}
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// Trigger remediation of failed health checks
+	if cfg := r.Config.Controllers.ShootCare.Remediation; cfg != nil && cfg.Enabled {
+		if err := NewRemediator(log, r.SeedClientSet.Client(), initializeShootClients, r.Recorder, r.Clock, shoot, o.Shoot.SeedNamespace, cfg, r.RemediationRateLimiter).Remediate(careCtx, updatedConditions); err != nil {
+			// errors during remediation are only being logged and do not cause the care operation to fail
+			log.Error(err, "Failed remediating failed health checks")
+		}
+	}
+
 	// Update Shoot status (conditions, constraints) if necessary
 	if v1beta1helper.ConditionsNeedUpdate(shootConditions.ConvertToSlice(), updatedConditions) ||
 		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) {
@@ -198,7 +230,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
+	return reconcile.Result{RequeueAfter: gardenlethelper.CareSyncPeriod(
+		r.Config.Controllers.ShootCare.SyncPeriod.Duration,
+		r.Config.Controllers.ShootCare.AdaptiveSyncPeriod,
+		shoot.Spec.Purpose,
+		updatedConditions,
+		r.Clock.Now(),
+	)}, nil
 }
 
 func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
@@ -536,6 +536,7 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 		_ client.Client,
 		_ ShootClientInit,
 		_ clock.Clock,
+		_ []gardenletconfig.ShootHibernationPreCheck,
 	) ConstraintCheck {
 		return fn
 	}
@@ -628,5 +629,10 @@ func consistOfConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
 			"Message": Equal(message),
 		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
 	)
 }
@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+const (
+	// EventRemediationActionPerformed is an event reason for a performed remediation action.
+	EventRemediationActionPerformed = "RemediationActionPerformed"
+	// EventRemediationActionRateLimited is an event reason for a remediation action which was not performed because
+	// the rate limit of the shoot was exceeded.
+	EventRemediationActionRateLimited = "RemediationActionRateLimited"
+	// EventRemediationActionFailed is an event reason for a remediation action which failed.
+	EventRemediationActionFailed = "RemediationActionFailed"
+)
+
+// RemediationRateLimiter limits the number of remediation actions per shoot within a period. It is shared by all
+// reconciliations of the care controller.
+type RemediationRateLimiter struct {
+	clock      clock.Clock
+	maxActions int
+	period     time.Duration
+
+	lock    sync.Mutex
+	actions map[types.NamespacedName][]time.Time
+}
+
+// NewRemediationRateLimiter creates a new rate limiter which allows at most <maxActions> remediation actions per
+// shoot within the given period.
+func NewRemediationRateLimiter(clock clock.Clock, maxActions int, period time.Duration) *RemediationRateLimiter {
+	return &RemediationRateLimiter{
+		clock:      clock,
+		maxActions: maxActions,
+		period:     period,
+		actions:    make(map[types.NamespacedName][]time.Time),
+	}
+}
+
+// Allow returns true and records a remediation action for the given shoot if the rate limit is not exceeded yet.
+func (l *RemediationRateLimiter) Allow(shoot types.NamespacedName) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	actions := slices.DeleteFunc(l.actions[shoot], func(t time.Time) bool {
+		return now.Sub(t) >= l.period
+	})
+
+	if len(actions) >= l.maxActions {
+		l.actions[shoot] = actions
+		return false
+	}
+
+	l.actions[shoot] = append(actions, now)
+	return true
+}
+
+// remediationTarget is a failure found by a remediation action together with the function to remediate it.
+type remediationTarget struct {
+	description string
+	remediate   func(context.Context) error
+}
+
+// remediationAction finds a well-understood failure which is detected by the given health check conditions.
+type remediationAction struct {
+	name           gardenletconfig.RemediationAction
+	conditionTypes []gardencorev1beta1.ConditionType
+	// find returns the failure which must be remediated, or nil if there is none. Each action remediates at most one
+	// failure per run.
+	find func(context.Context) (*remediationTarget, error)
+}
+
+// Remediation contains required information for the automatic remediation of failed health checks.
+type Remediation struct {
+	log             logr.Logger
+	seedClient      client.Client
+	shootClientInit ShootClientInit
+	recorder        record.EventRecorder
+	clock           clock.Clock
+	shoot           *gardencorev1beta1.Shoot
+	namespace       string
+	config          *gardenletconfig.Remediation
+	rateLimiter     *RemediationRateLimiter
+}
+
+// NewRemediation creates a new instance for the remediation of failed health checks.
+func NewRemediation(
+	log logr.Logger,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	config *gardenletconfig.Remediation,
+	rateLimiter *RemediationRateLimiter,
+) *Remediation {
+	return &Remediation{
+		log:             log,
+		seedClient:      seedClient,
+		shootClientInit: shootClientInit,
+		recorder:        recorder,
+		clock:           clock,
+		shoot:           shoot,
+		namespace:       namespace,
+		config:          config,
+		rateLimiter:     rateLimiter,
+	}
+}
+
+// Remediate performs the configured remediation actions whose health check conditions are not true. Each action
+// remediates at most one failure per run, and all actions are subject to the rate limit of the shoot. Every performed
+// or rate limited action is reported as event for the shoot.
+func (r *Remediation) Remediate(ctx context.Context, conditions []gardencorev1beta1.Condition) error {
+	if r.shoot.DeletionTimestamp != nil ||
+		v1beta1helper.HibernationIsEnabled(r.shoot) ||
+		r.shoot.Annotations[v1beta1constants.AnnotationShootSkipRemediation] == "true" {
+		return nil
+	}
+
+	// Failures are expected while the shoot is being reconciled, and they are handled by the reconciliation itself.
+	if lastOperation := r.shoot.Status.LastOperation; lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		return nil
+	}
+
+	var errs []error
+	for _, action := range r.actions() {
+		if !slices.Contains(r.config.Actions, action.name) || !anyConditionNotTrue(conditions, action.conditionTypes) {
+			continue
+		}
+
+		log := r.log.WithValues("remediationAction", action.name)
+
+		target, err := action.find(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed finding failures for remediation action %s: %w", action.name, err))
+			continue
+		}
+		if target == nil {
+			continue
+		}
+
+		if !r.rateLimiter.Allow(client.ObjectKeyFromObject(r.shoot)) {
+			log.Info("Skipping remediation action since the rate limit is exceeded", "failure", target.description)
+			r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventRemediationActionRateLimited, "Remediation action %s was not performed since the rate limit of %d actions per %s is exceeded: %s", action.name, ptr.Deref(r.config.MaxActionsPerShoot, 0), ptr.Deref(r.config.RateLimitPeriod, metav1.Duration{}).Duration, target.description)
+			break
+		}
+
+		log.Info("Performing remediation action", "failure", target.description)
+		if err := target.remediate(ctx); err != nil {
+			r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventRemediationActionFailed, "Remediation action %s failed: %s: %v", action.name, target.description, err)
+			errs = append(errs, fmt.Errorf("failed performing remediation action %s: %w", action.name, err))
+			continue
+		}
+		r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventRemediationActionPerformed, "Performed remediation action %s: %s", action.name, target.description)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Remediation) actions() []remediationAction {
+	return []remediationAction{
+		{
+			name:           gardenletconfig.RemediationActionRestartCrashLoopingControlPlanePod,
+			conditionTypes: []gardencorev1beta1.ConditionType{gardencorev1beta1.ShootControlPlaneHealthy},
+			find:           r.findCrashLoopingControlPlanePod,
+		},
+		{
+			name: gardenletconfig.RemediationActionRetriggerFailedManagedResource,
+			conditionTypes: []gardencorev1beta1.ConditionType{
+				gardencorev1beta1.ShootControlPlaneHealthy,
+				gardencorev1beta1.ShootSystemComponentsHealthy,
+				gardencorev1beta1.ShootObservabilityComponentsHealthy,
+			},
+			find: r.findFailedManagedResource,
+		},
+		{
+			name:           gardenletconfig.RemediationActionRecreateBrokenVPNPod,
+			conditionTypes: []gardencorev1beta1.ConditionType{gardencorev1beta1.ShootSystemComponentsHealthy},
+			find:           r.findBrokenVPNPod,
+		},
+	}
+}
+
+func (r *Remediation) threshold() time.Duration {
+	return ptr.Deref(r.config.Threshold, metav1.Duration{}).Duration
+}
+
+// findCrashLoopingControlPlanePod returns a control plane pod in the seed which is crash-looping. The pod must exist
+// for longer than the threshold, which also prevents restarting the re-created pod again too early.
+func (r *Remediation) findCrashLoopingControlPlanePod(ctx context.Context) (*remediationTarget, error) {
+	podList := &corev1.PodList{}
+	if err := r.seedClient.List(ctx, podList, client.InNamespace(r.namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleControlPlane}); err != nil {
+		return nil, fmt.Errorf("failed listing control plane pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil || r.clock.Since(pod.CreationTimestamp.Time) <= r.threshold() {
+			continue
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.State.Waiting == nil || containerStatus.State.Waiting.Reason != "CrashLoopBackOff" {
+				continue
+			}
+
+			return &remediationTarget{
+				description: fmt.Sprintf("container %q of pod %q is crash-looping (%d restarts)", containerStatus.Name, pod.Name, containerStatus.RestartCount),
+				remediate: func(ctx context.Context) error {
+					return client.IgnoreNotFound(r.seedClient.Delete(ctx, &pod))
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findFailedManagedResource returns a ManagedResource in the seed whose resources could not be applied for longer than
+// the threshold.
+func (r *Remediation) findFailedManagedResource(ctx context.Context) (*remediationTarget, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.seedClient.List(ctx, managedResourceList, client.InNamespace(r.namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing managed resources: %w", err)
+	}
+
+	for _, managedResource := range managedResourceList.Items {
+		if managedResource.DeletionTimestamp != nil ||
+			managedResource.Annotations[resourcesv1alpha1.Ignore] == "true" ||
+			managedResource.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
+			continue
+		}
+
+		condition := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+		if condition == nil || condition.Status != gardencorev1beta1.ConditionFalse || r.clock.Since(condition.LastTransitionTime.Time) <= r.threshold() {
+			continue
+		}
+
+		return &remediationTarget{
+			description: fmt.Sprintf("resources of managed resource %q could not be applied (reason: %s)", managedResource.Name, condition.Reason),
+			remediate: func(ctx context.Context) error {
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+				return client.IgnoreNotFound(r.seedClient.Patch(ctx, &managedResource, patch))
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// findBrokenVPNPod returns a vpn-shoot pod in the shoot which has not been ready for longer than the threshold.
+func (r *Remediation) findBrokenVPNPod(ctx context.Context) (*remediationTarget, error) {
+	shootClient, apiServerRunning, err := r.shootClientInit()
+	if err != nil {
+		return nil, fmt.Errorf("failed initializing shoot client: %w", err)
+	}
+	if !apiServerRunning {
+		return nil, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := shootClient.Client().List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.LabelApp: v1beta1constants.VPNTunnel}); err != nil {
+		return nil, fmt.Errorf("failed listing vpn-shoot pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || health.IsPodReady(&pod) {
+			continue
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type != corev1.PodReady || r.clock.Since(condition.LastTransitionTime.Time) <= r.threshold() {
+				continue
+			}
+
+			return &remediationTarget{
+				description: fmt.Sprintf("vpn-shoot pod %q is not ready since %s", pod.Name, condition.LastTransitionTime.UTC().Format(time.RFC3339)),
+				remediate: func(ctx context.Context) error {
+					return client.IgnoreNotFound(shootClient.Client().Delete(ctx, &pod))
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func anyConditionNotTrue(conditions []gardencorev1beta1.Condition, conditionTypes []gardencorev1beta1.ConditionType) bool {
+	for _, conditionType := range conditionTypes {
+		if condition := v1beta1helper.GetCondition(conditions, conditionType); condition != nil && condition.Status != gardencorev1beta1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Remediation", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		threshold = 10 * time.Minute
+	)
+
+	var (
+		ctx = context.Background()
+
+		fakeClock   *testclock.FakeClock
+		rateLimiter *RemediationRateLimiter
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		rateLimiter = NewRemediationRateLimiter(fakeClock, 2, time.Hour)
+	})
+
+	Describe("RemediationRateLimiter", func() {
+		var (
+			shoot      = types.NamespacedName{Namespace: "garden-foo", Name: "bar"}
+			otherShoot = types.NamespacedName{Namespace: "garden-foo", Name: "baz"}
+		)
+
+		It("should allow the configured number of actions per shoot and period", func() {
+			Expect(rateLimiter.Allow(shoot)).To(BeTrue())
+			fakeClock.Step(30 * time.Minute)
+			Expect(rateLimiter.Allow(shoot)).To(BeTrue())
+			Expect(rateLimiter.Allow(shoot)).To(BeFalse())
+			Expect(rateLimiter.Allow(otherShoot)).To(BeTrue())
+
+			fakeClock.Step(30 * time.Minute)
+			Expect(rateLimiter.Allow(shoot)).To(BeTrue())
+			Expect(rateLimiter.Allow(shoot)).To(BeFalse())
+		})
+
+		It("should not allow any action if the maximum is zero", func() {
+			Expect(NewRemediationRateLimiter(fakeClock, 0, time.Hour).Allow(shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#Remediate", func() {
+		var (
+			seedClient  client.Client
+			shootClient client.Client
+			recorder    *record.FakeRecorder
+
+			shootClientInit ShootClientInit
+			shoot           *gardencorev1beta1.Shoot
+			config          *gardenletconfig.Remediation
+			conditions      []gardencorev1beta1.Condition
+
+			controlPlanePod *corev1.Pod
+			managedResource *resourcesv1alpha1.ManagedResource
+			vpnPod          *corev1.Pod
+
+			remediator *Remediation
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			recorder = record.NewFakeRecorder(10)
+
+			shootClientInit = func() (kubernetes.Interface, bool, error) {
+				return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+			}
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+			config = &gardenletconfig.Remediation{
+				Enabled: true,
+				Actions: []gardenletconfig.RemediationAction{
+					gardenletconfig.RemediationActionRestartCrashLoopingControlPlanePod,
+					gardenletconfig.RemediationActionRetriggerFailedManagedResource,
+					gardenletconfig.RemediationActionRecreateBrokenVPNPod,
+				},
+				Threshold:          &metav1.Duration{Duration: threshold},
+				MaxActionsPerShoot: ptr.To(2),
+				RateLimitPeriod:    &metav1.Duration{Duration: time.Hour},
+			}
+			conditions = []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionTrue},
+				{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+				{Type: gardencorev1beta1.ShootObservabilityComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+			}
+
+			controlPlanePod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "kube-controller-manager-abc",
+					Namespace:         namespace,
+					Labels:            map[string]string{"gardener.cloud/role": "controlplane"},
+					CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+				},
+				Status: corev1.PodStatus{
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name:         "kube-controller-manager",
+						RestartCount: 12,
+						State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					}},
+				},
+			}
+			managedResource = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-core", Namespace: namespace},
+				Status: resourcesv1alpha1.ManagedResourceStatus{
+					Conditions: []gardencorev1beta1.Condition{{
+						Type:               resourcesv1alpha1.ResourcesApplied,
+						Status:             gardencorev1beta1.ConditionFalse,
+						Reason:             resourcesv1alpha1.ConditionApplyFailed,
+						LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+					}},
+				},
+			}
+			vpnPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vpn-shoot-abc",
+					Namespace: "kube-system",
+					Labels:    map[string]string{"app": "vpn-shoot"},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{
+						Type:               corev1.PodReady,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+					}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			remediator = NewRemediation(logr.Discard(), seedClient, shootClientInit, recorder, fakeClock, shoot, namespace, config, rateLimiter)
+		})
+
+		setConditionStatus := func(conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus) {
+			for i := range conditions {
+				if conditions[i].Type == conditionType {
+					conditions[i].Status = status
+				}
+			}
+		}
+
+		Context("crash-looping control plane pod", func() {
+			BeforeEach(func() {
+				Expect(seedClient.Create(ctx, controlPlanePod)).To(Succeed())
+			})
+
+			It("should restart the pod if the control plane is not healthy", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(ContainSubstring("RemediationActionPerformed Performed remediation action RestartCrashLoopingControlPlanePod: container \"kube-controller-manager\" of pod \"kube-controller-manager-abc\" is crash-looping (12 restarts)")))
+			})
+
+			It("should not restart the pod if the control plane is healthy", func() {
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should not restart the pod if it was created within the threshold", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+				fakeClock.SetTime(controlPlanePod.CreationTimestamp.Add(threshold))
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+			})
+
+			It("should not restart the pod if the action is not configured", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+				config.Actions = []gardenletconfig.RemediationAction{gardenletconfig.RemediationActionRecreateBrokenVPNPod}
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+			})
+
+			It("should not restart the pod if remediation is disabled for the shoot", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/skip-remediation": "true"}
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+			})
+
+			It("should not restart the pod while the shoot is being reconciled", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+			})
+
+			It("should not restart the pod if the rate limit is exceeded", func() {
+				setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+				Expect(rateLimiter.Allow(client.ObjectKeyFromObject(shoot))).To(BeTrue())
+				Expect(rateLimiter.Allow(client.ObjectKeyFromObject(shoot))).To(BeTrue())
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(Succeed())
+				Expect(recorder.Events).To(Receive(ContainSubstring("RemediationActionRateLimited Remediation action RestartCrashLoopingControlPlanePod was not performed since the rate limit of 2 actions per 1h0m0s is exceeded")))
+			})
+		})
+
+		Context("failed managed resource", func() {
+			BeforeEach(func() {
+				Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+			})
+
+			It("should re-trigger the reconciliation if the system components are not healthy", func() {
+				setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+				Expect(recorder.Events).To(Receive(ContainSubstring("Performed remediation action RetriggerFailedManagedResource: resources of managed resource \"shoot-core\" could not be applied (reason: ApplyFailed)")))
+			})
+
+			It("should not re-trigger the reconciliation if the resources failed within the threshold", func() {
+				setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+				fakeClock.SetTime(managedResource.Status.Conditions[0].LastTransitionTime.Add(threshold))
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			})
+
+			It("should not re-trigger the reconciliation of an ignored managed resource", func() {
+				setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+				managedResource.Annotations = map[string]string{"resources.gardener.cloud/ignore": "true"}
+				Expect(seedClient.Update(ctx, managedResource)).To(Succeed())
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			})
+		})
+
+		Context("broken VPN pod", func() {
+			BeforeEach(func() {
+				Expect(shootClient.Create(ctx, vpnPod)).To(Succeed())
+			})
+
+			It("should recreate the pod if the system components are not healthy", func() {
+				setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionProgressing)
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(vpnPod), vpnPod)).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(ContainSubstring("Performed remediation action RecreateBrokenVPNPod: vpn-shoot pod \"vpn-shoot-abc\" is not ready")))
+			})
+
+			It("should not recreate the pod if it became not ready within the threshold", func() {
+				setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+				fakeClock.SetTime(vpnPod.Status.Conditions[0].LastTransitionTime.Add(threshold))
+
+				Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+				Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(vpnPod), vpnPod)).To(Succeed())
+			})
+
+			Context("API server is not running", func() {
+				BeforeEach(func() {
+					shootClientInit = func() (kubernetes.Interface, bool, error) { return nil, false, nil }
+				})
+
+				It("should not recreate the pod", func() {
+					setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+
+					Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+					Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(vpnPod), vpnPod)).To(Succeed())
+				})
+			})
+		})
+
+		It("should stop performing actions once the rate limit is exceeded", func() {
+			Expect(seedClient.Create(ctx, controlPlanePod)).To(Succeed())
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+			Expect(shootClient.Create(ctx, vpnPod)).To(Succeed())
+			setConditionStatus(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionFalse)
+			setConditionStatus(gardencorev1beta1.ShootSystemComponentsHealthy, gardencorev1beta1.ConditionFalse)
+
+			Expect(remediator.Remediate(ctx, conditions)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlanePod), controlPlanePod)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+			Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(vpnPod), vpnPod)).To(Succeed())
+
+			Expect(recorder.Events).To(HaveLen(3))
+			Expect(<-recorder.Events).To(ContainSubstring("RemediationActionPerformed"))
+			Expect(<-recorder.Events).To(ContainSubstring("RemediationActionPerformed"))
+			Expect(<-recorder.Events).To(ContainSubstring("RemediationActionRateLimited Remediation action RecreateBrokenVPNPod"))
+		})
+	})
+})
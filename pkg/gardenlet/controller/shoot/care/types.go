@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -78,6 +79,7 @@ type NewConstraintCheckFunc func(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	hibernationPreChecks []gardenletconfig.ShootHibernationPreCheck,
 ) ConstraintCheck
 
 // defaultNewConstraintCheck is the default function to create a new instance for performing constraint checks.
@@ -87,6 +89,7 @@ var defaultNewConstraintCheck = func(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	hibernationPreChecks []gardenletconfig.ShootHibernationPreCheck,
 ) ConstraintCheck {
 	return NewConstraint(
 		log,
@@ -94,6 +97,7 @@ var defaultNewConstraintCheck = func(
 		seedClient,
 		shootClientInit,
 		clock,
+		hibernationPreChecks,
 	)
 }
 
@@ -123,6 +127,69 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// EtcdMemberRemediator is an interface used to perform etcd member remediation.
+type EtcdMemberRemediator interface {
+	Remediate(ctx context.Context) error
+}
+
+// NewEtcdMemberRemediatorFunc is a function used to create a new instance to perform etcd member remediation.
+type NewEtcdMemberRemediatorFunc func(
+	log logr.Logger,
+	seedClient client.Client,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	threshold time.Duration,
+) EtcdMemberRemediator
+
+// defaultNewEtcdMemberRemediator is the default function to create a new instance to perform etcd member remediation.
+var defaultNewEtcdMemberRemediator NewEtcdMemberRemediatorFunc = func(
+	log logr.Logger,
+	seedClient client.Client,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	threshold time.Duration,
+) EtcdMemberRemediator {
+	return NewEtcdMemberRemediation(log, seedClient, recorder, clock, shoot, namespace, threshold)
+}
+
+// Remediator is an interface used to perform the remediation of failed health checks.
+type Remediator interface {
+	Remediate(ctx context.Context, conditions []gardencorev1beta1.Condition) error
+}
+
+// NewRemediatorFunc is a function used to create a new instance to perform the remediation of failed health checks.
+type NewRemediatorFunc func(
+	log logr.Logger,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	config *gardenletconfig.Remediation,
+	rateLimiter *RemediationRateLimiter,
+) Remediator
+
+// defaultNewRemediator is the default function to create a new instance to perform the remediation of failed health
+// checks.
+var defaultNewRemediator NewRemediatorFunc = func(
+	log logr.Logger,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	shoot *gardencorev1beta1.Shoot,
+	namespace string,
+	config *gardenletconfig.Remediation,
+	rateLimiter *RemediationRateLimiter,
+) Remediator {
+	return NewRemediation(log, seedClient, shootClientInit, recorder, clock, shoot, namespace, config, rateLimiter)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
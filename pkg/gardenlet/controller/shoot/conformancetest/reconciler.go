@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformancetest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// JobName is the name of the Job running the conformance tests in the kube-system namespace of the shoot.
+	JobName = "gardener-conformance-test"
+	// ServiceAccountName is the name of the ServiceAccount used by the Job running the conformance tests.
+	ServiceAccountName = "gardener-conformance-test"
+	// ClusterRoleBindingName is the name of the ClusterRoleBinding granting the conformance tests access to the shoot.
+	// It is deleted as soon as the run is completed.
+	ClusterRoleBindingName = "gardener.cloud:conformance-test"
+
+	// pollInterval is the interval in which running conformance tests are checked.
+	pollInterval = 30 * time.Second
+	// jobTTLAfterFinished is the time after which finished Jobs are garbage collected in the shoot. Until then, the logs
+	// of the run can be inspected.
+	jobTTLAfterFinished = 24 * time.Hour
+	// logTailLines is the number of lines of the test log which are read for computing the summary.
+	logTailLines = 100
+	// maxSummaryLength is the maximum length of the summary stored in the Shoot status.
+	maxSummaryLength = 2048
+)
+
+var (
+	// summaryLinePattern matches the lines of the ginkgo output which summarize the results of the test run.
+	summaryLinePattern = regexp.MustCompile(`^(Ran \d+ of \d+ Specs|SUCCESS!|FAIL!|\[FAIL\])`)
+	// colorCodePattern matches ANSI color codes which might be contained in the test log.
+	colorCodePattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+)
+
+// Reconciler runs conformance tests against Shoots on request and reports the results in the Shoot status.
+type Reconciler struct {
+	GardenClient   client.Client
+	ShootClientMap clientmap.ClientMap
+	Config         config.ShootConformanceTestControllerConfiguration
+	Clock          clock.Clock
+	SeedName       string
+}
+
+// Reconcile starts a conformance test run if it was requested via the `shoot.gardener.cloud/conformance-test`
+// annotation and tracks running conformance tests until they are completed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if conformanceTestRequested(shoot) {
+		return r.start(ctx, log, shoot)
+	}
+
+	if conformanceTestRunning(shoot) {
+		return r.check(ctx, log, shoot)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) start(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	if reason := r.preventingReason(shoot); reason != "" {
+		log.Info("Conformance test run cannot be started", "reason", reason)
+		if err := r.patchReport(ctx, shoot, &gardencorev1beta1.ConformanceTestReport{
+			Phase:          gardencorev1beta1.ConformanceTestFailed,
+			CompletionTime: ptr.To(metav1.NewTime(r.Clock.Now())),
+			Summary:        "Conformance tests could not be started: " + reason,
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, r.removeAnnotation(ctx, shoot)
+	}
+
+	shootClient, err := r.ShootClientMap.GetClient(ctx, keys.ForShoot(shoot))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting shoot client: %w", err)
+	}
+
+	job, err := r.job(shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Starting conformance test run", "image", job.Spec.Template.Spec.Containers[0].Image)
+	if err := r.deployAccess(ctx, shootClient.Client()); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Jobs are immutable, hence the Job of a previous run is replaced.
+	if err := client.IgnoreNotFound(shootClient.Client().Delete(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem}}, client.PropagationPolicy(metav1.DeletePropagationBackground))); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed deleting job of previous conformance test run: %w", err)
+	}
+	if err := shootClient.Client().Create(ctx, job); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed creating conformance test job: %w", err)
+	}
+
+	if err := r.patchReport(ctx, shoot, &gardencorev1beta1.ConformanceTestReport{
+		Phase:     gardencorev1beta1.ConformanceTestRunning,
+		StartTime: ptr.To(metav1.NewTime(r.Clock.Now())),
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.removeAnnotation(ctx, shoot); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: pollInterval}, nil
+}
+
+// preventingReason returns the reason why conformance tests cannot be run against the given shoot, or an empty string
+// if they can be run.
+func (r *Reconciler) preventingReason(shoot *gardencorev1beta1.Shoot) string {
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return "shoot is hibernated"
+	}
+	if lastOperation := shoot.Status.LastOperation; lastOperation == nil ||
+		(lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded) {
+		return "shoot has not been created successfully yet"
+	}
+	return ""
+}
+
+func (r *Reconciler) check(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	shootClient, err := r.ShootClientMap.GetClient(ctx, keys.ForShoot(shoot))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting shoot client: %w", err)
+	}
+
+	job := &batchv1.Job{}
+	if err := shootClient.Client().Get(ctx, client.ObjectKey{Name: JobName, Namespace: metav1.NamespaceSystem}, job); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed reading conformance test job: %w", err)
+		}
+		return reconcile.Result{}, r.complete(ctx, log, shootClient, shoot, gardencorev1beta1.ConformanceTestFailed, "Conformance test job was not found")
+	}
+
+	switch {
+	case jobHasCondition(job, batchv1.JobComplete):
+		return reconcile.Result{}, r.complete(ctx, log, shootClient, shoot, gardencorev1beta1.ConformanceTestSucceeded, r.summary(ctx, shootClient))
+
+	case jobHasCondition(job, batchv1.JobFailed):
+		return reconcile.Result{}, r.complete(ctx, log, shootClient, shoot, gardencorev1beta1.ConformanceTestFailed, r.summary(ctx, shootClient))
+	}
+
+	if startTime := shoot.Status.ConformanceTest.StartTime; startTime != nil && r.Clock.Since(startTime.Time) > r.Config.Timeout.Duration {
+		if err := client.IgnoreNotFound(shootClient.Client().Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting timed out conformance test job: %w", err)
+		}
+		return reconcile.Result{}, r.complete(ctx, log, shootClient, shoot, gardencorev1beta1.ConformanceTestFailed, fmt.Sprintf("Conformance tests timed out after %s", r.Config.Timeout.Duration))
+	}
+
+	log.V(1).Info("Conformance tests are still running", "requeueAfter", pollInterval)
+	return reconcile.Result{RequeueAfter: pollInterval}, nil
+}
+
+func (r *Reconciler) complete(ctx context.Context, log logr.Logger, shootClient kubernetes.Interface, shoot *gardencorev1beta1.Shoot, phase gardencorev1beta1.ConformanceTestPhase, summary string) error {
+	// The tests run with cluster-admin privileges, hence access is revoked as soon as possible. The Job is kept until its
+	// TTL expires so that the logs of the run can be inspected.
+	if err := kubernetesutils.DeleteObjects(ctx, shootClient.Client(),
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ClusterRoleBindingName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}},
+	); err != nil {
+		return fmt.Errorf("failed revoking access of conformance tests: %w", err)
+	}
+
+	log.Info("Conformance test run completed", "phase", phase)
+	report := shoot.Status.ConformanceTest.DeepCopy()
+	report.Phase = phase
+	report.CompletionTime = ptr.To(metav1.NewTime(r.Clock.Now()))
+	report.Summary = summary
+	return r.patchReport(ctx, shoot, report)
+}
+
+func (r *Reconciler) deployAccess(ctx context.Context, c client.Client) error {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, serviceAccount, func() error {
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed deploying service account for conformance tests: %w", err)
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ClusterRoleBindingName}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, clusterRoleBinding, func() error {
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		}
+		clusterRoleBinding.Subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed deploying cluster role binding for conformance tests: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) job(shoot *gardencorev1beta1.Shoot) (*batchv1.Job, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameConformance, imagevectorutils.TargetVersion(shoot.Spec.Kubernetes.Version))
+	if err != nil {
+		return nil, fmt.Errorf("failed finding conformance test image for Kubernetes version %s: %w", shoot.Spec.Kubernetes.Version, err)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      JobName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{v1beta1constants.LabelApp: JobName},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To[int32](0),
+			ActiveDeadlineSeconds:   ptr.To(int64(r.Config.Timeout.Duration.Seconds())),
+			TTLSecondsAfterFinished: ptr.To(int32(jobTTLAfterFinished.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{v1beta1constants.LabelApp: JobName},
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: ServiceAccountName,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:  "conformance",
+						Image: image.String(),
+						Env: []corev1.EnvVar{
+							{Name: "E2E_FOCUS", Value: ptr.Deref(r.Config.Focus, "")},
+							{Name: "E2E_SKIP", Value: ptr.Deref(r.Config.Skip, "")},
+							{Name: "E2E_PROVIDER", Value: "skeleton"},
+							{Name: "E2E_PARALLEL", Value: "false"},
+							{Name: "RESULTS_DIR", Value: "/tmp/results"},
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      "results",
+							MountPath: "/tmp/results",
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name:         "results",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// summary extracts the summary of the test results from the log of the conformance test pod. If the summary lines
+// cannot be found, the last lines of the log are returned instead.
+func (r *Reconciler) summary(ctx context.Context, shootClient kubernetes.Interface) string {
+	podList := &corev1.PodList{}
+	if err := shootClient.Client().List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.LabelApp: JobName}); err != nil {
+		return fmt.Sprintf("Failed listing conformance test pods: %v", err)
+	}
+	if len(podList.Items) == 0 {
+		return "No conformance test pod was found"
+	}
+
+	pod := podList.Items[0]
+	for _, p := range podList.Items[1:] {
+		if p.CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = p
+		}
+	}
+
+	logs, err := kubernetes.GetPodLogs(ctx, shootClient.Kubernetes().CoreV1().Pods(pod.Namespace), pod.Name, &corev1.PodLogOptions{TailLines: ptr.To[int64](logTailLines)})
+	if err != nil {
+		return fmt.Sprintf("Failed reading logs of conformance test pod: %v", err)
+	}
+
+	return summarizeLogs(string(logs))
+}
+
+func summarizeLogs(logs string) string {
+	var lines, summaryLines []string
+	for _, line := range strings.Split(colorCodePattern.ReplaceAllString(logs, ""), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		lines = append(lines, line)
+		if summaryLinePattern.MatchString(line) {
+			summaryLines = append(summaryLines, line)
+		}
+	}
+
+	if len(summaryLines) == 0 {
+		summaryLines = lines[max(len(lines)-5, 0):]
+	}
+
+	summary := strings.Join(summaryLines, "\n")
+	if len(summary) > maxSummaryLength {
+		summary = summary[:maxSummaryLength]
+	}
+	return summary
+}
+
+func (r *Reconciler) patchReport(ctx context.Context, shoot *gardencorev1beta1.Shoot, report *gardencorev1beta1.ConformanceTestReport) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.ConformanceTest = report
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed updating conformance test report: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) removeAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationShootConformanceTest)
+	if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing conformance test annotation: %w", err)
+	}
+	return nil
+}
+
+func jobHasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
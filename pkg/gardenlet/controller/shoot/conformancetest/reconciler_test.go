@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformancetest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/conformancetest"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName = "seed"
+		timeout  = 2 * time.Hour
+	)
+
+	var (
+		ctx          = context.Background()
+		fakeClock    *testclock.FakeClock
+		gardenClient client.Client
+		shootClient  client.Client
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 5, 1, 10, 0, 0, 0, time.Local))
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bar",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{v1beta1constants.AnnotationShootConformanceTest: v1beta1constants.ShootConformanceTestRun},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:   ptr.To(seedName),
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			ShootClientMap: fakeclientmap.NewClientMapBuilder().WithClientSetForKey(keys.ForShoot(shoot),
+				fakekubernetes.NewClientSetBuilder().WithClient(shootClient).WithKubernetes(kubernetesfake.NewSimpleClientset()).Build(),
+			).Build(),
+			Config: config.ShootConformanceTestControllerConfiguration{
+				Focus:   ptr.To(`\[Conformance\]`),
+				Skip:    ptr.To(`\[Serial\]`),
+				Timeout: &metav1.Duration{Duration: timeout},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should do nothing if the shoot is managed by another seed", func() {
+			shoot.Spec.SeedName = ptr.To("other-seed")
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKey(v1beta1constants.AnnotationShootConformanceTest))
+			Expect(shoot.Status.ConformanceTest).To(BeNil())
+		})
+
+		It("should report a failure if the shoot is hibernated", func() {
+			shoot.Status.IsHibernated = true
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootConformanceTest))
+			Expect(shoot.Status.ConformanceTest).To(Equal(&gardencorev1beta1.ConformanceTestReport{
+				Phase:          gardencorev1beta1.ConformanceTestFailed,
+				CompletionTime: ptr.To(metav1.NewTime(fakeClock.Now())),
+				Summary:        "Conformance tests could not be started: shoot is hibernated",
+			}))
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: JobName, Namespace: metav1.NamespaceSystem}, &batchv1.Job{})).To(BeNotFoundError())
+		})
+
+		It("should start the conformance tests", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(shootClient.Create(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem, Labels: map[string]string{"previous": "run"}}})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootConformanceTest))
+			Expect(shoot.Status.ConformanceTest).To(Equal(&gardencorev1beta1.ConformanceTestReport{
+				Phase:     gardencorev1beta1.ConformanceTestRunning,
+				StartTime: ptr.To(metav1.NewTime(fakeClock.Now())),
+			}))
+
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}, &corev1.ServiceAccount{})).To(Succeed())
+
+			clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: ClusterRoleBindingName}, clusterRoleBinding)).To(Succeed())
+			Expect(clusterRoleBinding.RoleRef.Name).To(Equal("cluster-admin"))
+			Expect(clusterRoleBinding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}))
+
+			job := &batchv1.Job{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: JobName, Namespace: metav1.NamespaceSystem}, job)).To(Succeed())
+			Expect(job.Labels).NotTo(HaveKey("previous"))
+			Expect(job.Spec.BackoffLimit).To(PointTo(Equal(int32(0))))
+			Expect(job.Spec.ActiveDeadlineSeconds).To(PointTo(Equal(int64(7200))))
+			Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(ServiceAccountName))
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.k8s.io/conformance:v1.30.1"))
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "E2E_FOCUS", Value: `\[Conformance\]`},
+				corev1.EnvVar{Name: "E2E_SKIP", Value: `\[Serial\]`},
+			))
+		})
+
+		Context("conformance tests are running", func() {
+			var startTime metav1.Time
+
+			BeforeEach(func() {
+				startTime = metav1.NewTime(fakeClock.Now())
+				delete(shoot.Annotations, v1beta1constants.AnnotationShootConformanceTest)
+				shoot.Status.ConformanceTest = &gardencorev1beta1.ConformanceTestReport{
+					Phase:     gardencorev1beta1.ConformanceTestRunning,
+					StartTime: &startTime,
+				}
+				Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+				Expect(shootClient.Create(ctx, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}})).To(Succeed())
+				Expect(shootClient.Create(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: ClusterRoleBindingName}})).To(Succeed())
+				Expect(shootClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: JobName + "-abcde", Namespace: metav1.NamespaceSystem, Labels: map[string]string{"app": JobName}}})).To(Succeed())
+			})
+
+			expectAccessRevoked := func() {
+				Expect(shootClient.Get(ctx, client.ObjectKey{Name: ServiceAccountName, Namespace: metav1.NamespaceSystem}, &corev1.ServiceAccount{})).To(BeNotFoundError())
+				Expect(shootClient.Get(ctx, client.ObjectKey{Name: ClusterRoleBindingName}, &rbacv1.ClusterRoleBinding{})).To(BeNotFoundError())
+			}
+
+			It("should requeue if the job is still running", func() {
+				Expect(shootClient.Create(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem}})).To(Succeed())
+				fakeClock.Step(time.Hour)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+				Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Status.ConformanceTest.Phase).To(Equal(gardencorev1beta1.ConformanceTestRunning))
+			})
+
+			It("should report success if the job completed", func() {
+				Expect(shootClient.Create(ctx, &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem},
+					Status:     batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}},
+				})).To(Succeed())
+				fakeClock.Step(time.Hour)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Status.ConformanceTest).To(Equal(&gardencorev1beta1.ConformanceTestReport{
+					Phase:          gardencorev1beta1.ConformanceTestSucceeded,
+					StartTime:      &startTime,
+					CompletionTime: ptr.To(metav1.NewTime(fakeClock.Now())),
+					Summary:        "fake logs",
+				}))
+				expectAccessRevoked()
+			})
+
+			It("should report a failure if the job failed", func() {
+				Expect(shootClient.Create(ctx, &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem},
+					Status:     batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}},
+				})).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Status.ConformanceTest.Phase).To(Equal(gardencorev1beta1.ConformanceTestFailed))
+				Expect(shoot.Status.ConformanceTest.Summary).To(Equal("fake logs"))
+				expectAccessRevoked()
+			})
+
+			It("should report a failure if the job is gone", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Status.ConformanceTest.Phase).To(Equal(gardencorev1beta1.ConformanceTestFailed))
+				Expect(shoot.Status.ConformanceTest.Summary).To(Equal("Conformance test job was not found"))
+				expectAccessRevoked()
+			})
+
+			It("should abort the job and report a failure if the timeout is exceeded", func() {
+				Expect(shootClient.Create(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: JobName, Namespace: metav1.NamespaceSystem}})).To(Succeed())
+				fakeClock.Step(timeout + time.Second)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+				Expect(shoot.Status.ConformanceTest.Phase).To(Equal(gardencorev1beta1.ConformanceTestFailed))
+				Expect(shoot.Status.ConformanceTest.Summary).To(Equal("Conformance tests timed out after 2h0m0s"))
+				Expect(shootClient.Get(ctx, client.ObjectKey{Name: JobName, Namespace: metav1.NamespaceSystem}, &batchv1.Job{})).To(BeNotFoundError())
+				expectAccessRevoked()
+			})
+		})
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p = (&Reconciler{}).ShootPredicate()
+
+		BeforeEach(func() {
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootConformanceTest)
+		})
+
+		It("should return false for create events of shoots without requested or running conformance tests", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return true for create events of shoots with running conformance tests", func() {
+			shoot.Status.ConformanceTest = &gardencorev1beta1.ConformanceTestReport{Phase: gardencorev1beta1.ConformanceTestRunning}
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return true for update events if conformance tests are requested", func() {
+			oldShoot := shoot.DeepCopy()
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootConformanceTest, v1beta1constants.ShootConformanceTestRun)
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return false for other update events", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.ConformanceTest = &gardencorev1beta1.ConformanceTestReport{Phase: gardencorev1beta1.ConformanceTestRunning}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformancetest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#summarizeLogs", func() {
+	It("should extract the summary lines of the ginkgo output", func() {
+		logs := `I0501 10:00:00.000000 1 e2e.go:1] Starting e2e run
+  [FAIL] [sig-network] Services should serve endpoints on same port and different protocols [Conformance]
+
+Ran 2 of 7000 Specs in 120.000 seconds
+` + "\x1b[1mFAIL!\x1b[0m" + ` -- 1 Passed | 1 Failed | 0 Pending | 6998 Skipped
+I0501 10:02:00.000000 1 e2e.go:2] Finished e2e run
+`
+
+		Expect(summarizeLogs(logs)).To(Equal(`[FAIL] [sig-network] Services should serve endpoints on same port and different protocols [Conformance]
+Ran 2 of 7000 Specs in 120.000 seconds
+FAIL! -- 1 Passed | 1 Failed | 0 Pending | 6998 Skipped`))
+	})
+
+	It("should return the last lines of the log if no summary is found", func() {
+		Expect(summarizeLogs("1\n2\n\n3\n4\n5\n6\n")).To(Equal("2\n3\n4\n5\n6"))
+	})
+
+	It("should truncate long summaries", func() {
+		Expect(summarizeLogs(string(make([]byte, 3000)))).To(HaveLen(2048))
+	})
+})
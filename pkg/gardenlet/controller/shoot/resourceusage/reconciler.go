@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Reconciler periodically publishes the resources consumed by the control plane of a Shoot in the seed cluster into
+// the Shoot status.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedReader   client.Reader
+	Config       config.ShootResourceUsageControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile computes the resource usage of the control plane of the Shoot and updates the Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.TechnicalID == "" {
+		log.V(1).Info("Requeuing because technical ID of shoot is not yet set", "requeueAfter", r.Config.SyncPeriod.Duration)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	usage, err := r.computeResourceUsage(ctx, shoot.Status.TechnicalID)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing control plane resource usage: %w", err)
+	}
+
+	log.V(1).Info("Updating control plane resource usage", "requests", usage.Requests, "limits", usage.Limits, "etcdStorage", usage.ETCDStorage)
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.ControlPlaneResourceUsage = usage
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating control plane resource usage: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) computeResourceUsage(ctx context.Context, namespace string) (*gardencorev1beta1.ControlPlaneResourceUsage, error) {
+	usage := &gardencorev1beta1.ControlPlaneResourceUsage{
+		Requests:       corev1.ResourceList{},
+		Limits:         corev1.ResourceList{},
+		LastUpdateTime: metav1.NewTime(r.Clock.Now().UTC()),
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.SeedReader.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		addResourceList(usage.Requests, kubernetesutils.PodRequests(&pod))
+		addResourceList(usage.Limits, kubernetesutils.PodLimits(&pod))
+	}
+
+	etcdList := &druidv1alpha1.EtcdList{}
+	if err := r.SeedReader.List(ctx, etcdList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing etcds: %w", err)
+	}
+
+	etcdStorage := resource.Quantity{Format: resource.BinarySI}
+	for _, etcd := range etcdList.Items {
+		if etcd.Spec.StorageCapacity != nil {
+			etcdStorage.Add(*etcd.Spec.StorageCapacity)
+		}
+	}
+	usage.ETCDStorage = &etcdStorage
+
+	return usage, nil
+}
+
+func addResourceList(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		value := list[name]
+		value.Add(quantity)
+		list[name] = value
+	}
+}
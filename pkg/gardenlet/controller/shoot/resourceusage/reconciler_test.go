@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage_test
+
+import (
+	"context"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/resourceusage"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName         = "seed"
+		syncPeriod       = 10 * time.Minute
+		shootNamespace   = "shoot--foo--bar"
+		otherNamespace   = "shoot--foo--baz"
+		projectNamespace = "garden-foo"
+	)
+
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+
+		reconciler *Reconciler
+		request    reconcile.Request
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedReader:   seedClient,
+			Config: config.ShootResourceUsageControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: projectNamespace},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: shootNamespace},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should do nothing if the shoot is gone", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+
+		It("should do nothing if the shoot is not managed by this seed", func() {
+			shoot.Spec.SeedName = ptr.To("other-seed")
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ControlPlaneResourceUsage).To(BeNil())
+		})
+
+		It("should requeue if the technical ID is not yet set", func() {
+			shoot.Status.TechnicalID = ""
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.ControlPlaneResourceUsage).To(BeNil())
+		})
+
+		It("should publish the resource usage of the control plane", func() {
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+			for _, pod := range []*corev1.Pod{
+				newPod("kube-apiserver", shootNamespace, corev1.PodRunning, "500m", "1Gi", "2Gi"),
+				newPod("etcd-main-0", shootNamespace, corev1.PodRunning, "300m", "500Mi", ""),
+				newPod("job", shootNamespace, corev1.PodSucceeded, "1", "1Gi", "1Gi"),
+				newPod("kube-apiserver", otherNamespace, corev1.PodRunning, "1", "1Gi", "1Gi"),
+			} {
+				Expect(seedClient.Create(ctx, pod)).To(Succeed())
+			}
+
+			for _, etcd := range []*druidv1alpha1.Etcd{
+				{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: shootNamespace}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("25Gi"))}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "etcd-events", Namespace: shootNamespace}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("10Gi"))}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: otherNamespace}, Spec: druidv1alpha1.EtcdSpec{StorageCapacity: ptr.To(resource.MustParse("25Gi"))}},
+			} {
+				Expect(seedClient.Create(ctx, etcd)).To(Succeed())
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			usage := shoot.Status.ControlPlaneResourceUsage
+			Expect(usage).NotTo(BeNil())
+			Expect(usage.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(usage.Requests.Cpu().Cmp(resource.MustParse("800m"))).To(Equal(0))
+			Expect(usage.Requests.Memory().Cmp(resource.MustParse("1524Mi"))).To(Equal(0))
+			Expect(usage.Limits).NotTo(HaveKey(corev1.ResourceCPU))
+			Expect(usage.Limits.Memory().Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+			Expect(usage.ETCDStorage.Cmp(resource.MustParse("35Gi"))).To(Equal(0))
+		})
+	})
+
+	Describe("#ShootPredicate", func() {
+		var oldShoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			oldShoot = shoot.DeepCopy()
+		})
+
+		It("should return true for create events", func() {
+			Expect(reconciler.ShootPredicate().Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(reconciler.ShootPredicate().Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(reconciler.ShootPredicate().Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for updates if neither seed name nor technical ID changed", func() {
+			shoot.Generation++
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return true for updates if the seed name changed", func() {
+			shoot.Spec.SeedName = ptr.To("other-seed")
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return true for updates if the technical ID changed", func() {
+			oldShoot.Status.TechnicalID = ""
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+	})
+})
+
+func newPod(name, namespace string, phase corev1.PodPhase, cpuRequest, memoryRequest, memoryLimit string) *corev1.Pod {
+	container := corev1.Container{
+		Name: "container",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+				corev1.ResourceMemory: resource.MustParse(memoryRequest),
+			},
+		},
+	}
+	if memoryLimit != "" {
+		container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memoryLimit)}
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
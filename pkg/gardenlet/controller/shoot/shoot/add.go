@@ -6,12 +6,16 @@ package shoot
 
 import (
 	"context"
+	"crypto"
+	"fmt"
 
 	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/authn"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -22,7 +26,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // ControllerName is the name of this controller.
@@ -33,12 +41,31 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.GardenClient == nil {
 		r.GardenClient = gardenCluster.GetClient()
 	}
+	if r.GardenInformers == nil {
+		r.GardenInformers = gardenCluster.GetCache()
+	}
 	if r.Recorder == nil {
 		r.Recorder = gardenCluster.GetEventRecorderFor(ControllerName + "-controller")
 	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.ImageVerifier == nil && r.Config.Controllers.Shoot.ImageVerification != nil {
+		imageVerifier, err := newImageVerifier(r.Config.Controllers.Shoot.ImageVerification, mgr.GetAPIReader())
+		if err != nil {
+			return err
+		}
+		r.ImageVerifier = imageVerifier
+	}
+
+	var (
+		reconciler              reconcile.Reconciler = r
+		maxConcurrentReconciles                      = ptr.Deref(r.Config.Controllers.Shoot.ConcurrentSyncs, 0)
+	)
+	if r.Settings != nil {
+		reconciler = controllerutils.LimitConcurrency(r.Settings.ShootConcurrency, r)
+		maxConcurrentReconciles = r.Settings.ShootConcurrency.Workers()
+	}
 
 	// It's not possible to call builder.Build() without adding atleast one watch, and without this, we can't get the controller logger.
 	// Hence, we have to build up the controller manually.
@@ -46,8 +73,8 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 		ControllerName,
 		mgr,
 		controller.Options{
-			Reconciler:              r,
-			MaxConcurrentReconciles: ptr.Deref(r.Config.Controllers.Shoot.ConcurrentSyncs, 0),
+			Reconciler:              reconciler,
+			MaxConcurrentReconciles: maxConcurrentReconciles,
 		},
 	)
 	if err != nil {
@@ -61,6 +88,24 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	)
 }
 
+func newImageVerifier(cfg *config.ImageVerification, reader client.Reader) (oci.ImageVerifier, error) {
+	publicKeys := make([]crypto.PublicKey, 0, len(cfg.PublicKeys))
+	for _, data := range cfg.PublicKeys {
+		publicKey, err := utils.DecodePublicKey([]byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding public key for image verification: %w", err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	var keychain authn.Keychain
+	if len(cfg.PullSecretRefs) > 0 {
+		keychain = oci.NewPullSecretKeychain(reader, cfg.PullSecretRefs)
+	}
+
+	return oci.NewImageVerifier(cfg.RequireDigest, publicKeys, keychain), nil
+}
+
 // CalculateControllerInfos is exposed for testing
 var CalculateControllerInfos = helper.CalculateControllerInfos
 
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// projectConcurrencyLimiter limits the number of concurrent reconciliations of shoots per project namespace. The zero
+// value is ready to use.
+type projectConcurrencyLimiter struct {
+	lock     sync.Mutex
+	inFlight map[string]int
+}
+
+// tryAcquire reserves a reconciliation slot for the given project namespace. It returns false if the given maximum
+// number of concurrent reconciliations is already reached.
+func (l *projectConcurrencyLimiter) tryAcquire(namespace string, maxConcurrentReconciles int) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inFlight[namespace] >= maxConcurrentReconciles {
+		return false
+	}
+
+	if l.inFlight == nil {
+		l.inFlight = make(map[string]int)
+	}
+	l.inFlight[namespace]++
+	return true
+}
+
+// release frees a reconciliation slot previously reserved for the given project namespace.
+func (l *projectConcurrencyLimiter) release(namespace string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inFlight[namespace] <= 1 {
+		delete(l.inFlight, namespace)
+		return
+	}
+	l.inFlight[namespace]--
+}
+
+// maxConcurrentReconcilesForProject returns the maximum number of concurrent reconciliations for shoots in the given
+// project namespace. It returns false if the number is not limited.
+func maxConcurrentReconcilesForProject(cfg *config.ShootProjectConcurrency, namespace string) (int, bool) {
+	if cfg == nil {
+		return 0, false
+	}
+	if maxConcurrentReconciles, ok := cfg.ProjectNamespaces[namespace]; ok {
+		return maxConcurrentReconciles, true
+	}
+	if cfg.MaxConcurrentReconciles != nil {
+		return *cfg.MaxConcurrentReconciles, true
+	}
+	return 0, false
+}
+
+func requeueDelay(cfg *config.ShootProjectConcurrency) time.Duration {
+	return ptr.Deref(cfg.RequeueDelay, metav1.Duration{Duration: 10 * time.Second}).Duration
+}
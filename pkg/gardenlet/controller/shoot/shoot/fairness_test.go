@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("fairness", func() {
+	Describe("#projectConcurrencyLimiter", func() {
+		var limiter *projectConcurrencyLimiter
+
+		BeforeEach(func() {
+			limiter = &projectConcurrencyLimiter{}
+		})
+
+		It("should limit the concurrent reconciliations per project namespace", func() {
+			Expect(limiter.tryAcquire("garden-foo", 2)).To(BeTrue())
+			Expect(limiter.tryAcquire("garden-foo", 2)).To(BeTrue())
+			Expect(limiter.tryAcquire("garden-foo", 2)).To(BeFalse())
+			Expect(limiter.tryAcquire("garden-bar", 2)).To(BeTrue())
+		})
+
+		It("should free slots when reconciliations are released", func() {
+			Expect(limiter.tryAcquire("garden-foo", 1)).To(BeTrue())
+			Expect(limiter.tryAcquire("garden-foo", 1)).To(BeFalse())
+
+			limiter.release("garden-foo")
+			Expect(limiter.inFlight).To(BeEmpty())
+			Expect(limiter.tryAcquire("garden-foo", 1)).To(BeTrue())
+		})
+	})
+
+	Describe("#maxConcurrentReconcilesForProject", func() {
+		It("should not limit the reconciliations if nothing is configured", func() {
+			_, limited := maxConcurrentReconcilesForProject(nil, "garden-foo")
+			Expect(limited).To(BeFalse())
+
+			_, limited = maxConcurrentReconcilesForProject(&config.ShootProjectConcurrency{}, "garden-foo")
+			Expect(limited).To(BeFalse())
+		})
+
+		It("should return the configured maximum for the project namespace", func() {
+			cfg := &config.ShootProjectConcurrency{
+				MaxConcurrentReconciles: ptr.To(5),
+				ProjectNamespaces:       map[string]int{"garden-foo": 10},
+			}
+
+			maxConcurrentReconciles, limited := maxConcurrentReconcilesForProject(cfg, "garden-foo")
+			Expect(limited).To(BeTrue())
+			Expect(maxConcurrentReconciles).To(Equal(10))
+
+			maxConcurrentReconciles, limited = maxConcurrentReconcilesForProject(cfg, "garden-bar")
+			Expect(limited).To(BeTrue())
+			Expect(maxConcurrentReconciles).To(Equal(5))
+		})
+	})
+
+	Describe("Reconciler", func() {
+		var (
+			ctx          = context.Background()
+			gardenClient client.Client
+			reconciler   *Reconciler
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("other-seed")},
+			}
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).Build()
+
+			reconciler = &Reconciler{
+				GardenClient: gardenClient,
+				Config: config.GardenletConfiguration{
+					Controllers: &config.GardenletControllerConfiguration{
+						Shoot: &config.ShootControllerConfiguration{
+							ProjectConcurrency: &config.ShootProjectConcurrency{
+								MaxConcurrentReconciles: ptr.To(1),
+								RequeueDelay:            &metav1.Duration{Duration: 5 * time.Second},
+							},
+						},
+					},
+					SeedConfig: &config.SeedConfig{SeedTemplate: gardencore.SeedTemplate{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}},
+				},
+			}
+		})
+
+		It("should requeue the shoot if the maximum number of concurrent reconciliations of its project is reached", func() {
+			shoot.Spec.SeedName = ptr.To("seed")
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+			Expect(reconciler.projectReconciles.tryAcquire(shoot.Namespace, 1)).To(BeTrue())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Second}))
+			Expect(reconciler.projectReconciles.inFlight).To(HaveKeyWithValue(shoot.Namespace, 1))
+		})
+
+		It("should not reserve a slot for shoots managed by other gardenlets", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+			Expect(reconciler.projectReconciles.inFlight).To(BeEmpty())
+		})
+	})
+})
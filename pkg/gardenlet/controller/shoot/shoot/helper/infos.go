@@ -65,6 +65,7 @@ type ControllerInfos struct {
 
 	// based on shoot object
 	isIgnored                             bool
+	isPaused                              bool
 	isFailed                              bool
 	isUpToDate                            bool
 	confineSpecUpdateRollout              bool
@@ -90,6 +91,7 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		respectSyncPeriodOverwrite: respectSyncPeriodOverwrite,
 
 		isIgnored:                             gardenerutils.ShouldIgnoreShoot(respectSyncPeriodOverwrite, shoot),
+		isPaused:                              v1beta1helper.IsShootPaused(shoot),
 		isFailed:                              gardenerutils.IsShootFailedAndUpToDate(shoot),
 		isUpToDate:                            gardenerutils.IsObservedAtLatestGenerationAndSucceeded(shoot),
 		confineSpecUpdateRollout:              v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance),
@@ -109,8 +111,8 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 }
 
 func (i ControllerInfos) shouldReconcileNow() bool {
-	// if the shoot is failed or ignored, it doesn't matter which operation is triggered
-	if i.isFailed || i.isIgnored {
+	// if the shoot is failed, ignored, or paused, it doesn't matter which operation is triggered
+	if i.isFailed || i.isIgnored || i.isPaused {
 		return false
 	}
 
@@ -151,12 +153,12 @@ func (i ControllerInfos) shouldReconcileNow() bool {
 }
 
 func (i ControllerInfos) shouldOnlySyncClusterResource() bool {
-	return i.isFailed || i.isIgnored
+	return i.isFailed || i.isIgnored || i.isPaused
 }
 
 func (i ControllerInfos) enqueueAfter() time.Duration {
-	// if the shoot is failed or ignored, we need to enqueue the shoot now to sync the cluster resource to the seed
-	if i.isFailed || i.isIgnored {
+	// if the shoot is failed, ignored, or paused, we need to enqueue the shoot now to sync the cluster resource to the seed
+	if i.isFailed || i.isIgnored || i.isPaused {
 		return 0
 	}
 
@@ -198,8 +200,8 @@ func (i ControllerInfos) enqueueAfter() time.Duration {
 }
 
 func (i ControllerInfos) requeueAfter() reconcile.Result {
-	// if the shoot is failed or ignored, we don't want to requeue the shoot
-	if i.isFailed || i.isIgnored {
+	// if the shoot is failed, ignored, or paused, we don't want to requeue the shoot
+	if i.isFailed || i.isIgnored || i.isPaused {
 		return reconcile.Result{}
 	}
 
@@ -129,6 +129,22 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Paused = ptr.To(true)
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -357,6 +373,22 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Paused = ptr.To(true)
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -448,6 +480,22 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Paused = ptr.To(true)
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -541,6 +589,22 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Paused = ptr.To(true)
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -632,6 +696,22 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Paused = ptr.To(true)
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -21,6 +21,7 @@ import (
 	"k8s.io/component-base/version"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -44,12 +45,14 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/gardenlet/reload"
 	"github.com/gardener/gardener/pkg/utils"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
@@ -66,12 +69,27 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// ImageVerifier verifies the container images of the shoot components before they are deployed. If it is nil, the
+	// images are not verified.
+	ImageVerifier oci.ImageVerifier
+	// GardenInformers are used to invalidate the Secrets and ConfigMaps memoized by the operations. If nil, they are only
+	// memoized for a fixed duration.
+	GardenInformers cache.Informers
+	// Settings contains the settings which are reloaded without restarting gardenlet. If nil, the settings of Config are
+	// used.
+	Settings *reload.Settings
+
+	projectReconciles projectConcurrencyLimiter
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
+	// Stop the watches for the objects memoized by the operation when the reconciliation is done.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	shoot := &gardencorev1beta1.Shoot{}
 	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -86,6 +104,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if maxConcurrentReconciles, limited := maxConcurrentReconcilesForProject(r.Config.Controllers.Shoot.ProjectConcurrency, shoot.Namespace); limited {
+		if !r.projectReconciles.tryAcquire(shoot.Namespace, maxConcurrentReconciles) {
+			delay := requeueDelay(r.Config.Controllers.Shoot.ProjectConcurrency)
+			log.V(1).Info("Maximum number of concurrent reconciliations for project reached, requeueing Shoot", "maxConcurrentReconciles", maxConcurrentReconciles, "requeueAfter", delay)
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+		defer r.projectReconciles.release(shoot.Namespace)
+	}
+
+	// The paused state is checked before anything else, i.e., no operation (including the shortcuts for deletion and the
+	// removal of annotations) is performed on a paused Shoot.
+	if err := r.syncPausedShoot(ctx, log, shoot); err != nil {
+		return reconcile.Result{}, err
+	}
+	if v1beta1helper.IsShootPaused(shoot) {
+		log.Info("Skipping because operations on Shoot are paused")
+		return reconcile.Result{}, nil
+	}
+
 	if shoot.DeletionTimestamp != nil {
 		return r.deleteShoot(ctx, log, shoot)
 	}
@@ -94,13 +131,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.migrateShoot(ctx, log, shoot)
 	}
 
+	if features.DefaultFeatureGate.Enabled(features.ShootImport) && v1beta1helper.ShootAwaitsImport(shoot) {
+		log.Info("Skipping because Shoot awaits the import of the existing cluster via the shoots/import subresource")
+		return reconcile.Result{}, nil
+	}
+
 	return r.reconcileShoot(ctx, log, shoot)
 }
 
 func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
 	var (
-		operationType = helper.ComputeOperationType(shoot)
-		isRestoring   = operationType == gardencorev1beta1.LastOperationTypeRestore
+		operationType        = helper.ComputeOperationType(shoot)
+		isRestoring          = operationType == gardencorev1beta1.LastOperationTypeRestore
+		retryFailedTasksOnly = shoot.Status.LastOperation != nil &&
+			shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed &&
+			shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationRetryFailedTasks
 	)
 	log = log.WithValues("operation", strings.ToLower(string(operationType)))
 
@@ -111,23 +156,55 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 		}
 	}
 
+	if until, ok := gardenerutils.ShootDebugModeUntil(shoot); ok && !r.Clock.Now().Before(until) {
+		// Removing the annotation increases the generation of the shoot, hence the debug settings are reverted right away.
+		log.Info("Disabling debug mode of the control plane since its time window has ended", "debugModeUntil", until)
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootDebugModeUntil)
+		if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing debug mode annotation: %w", err)
+		}
+	}
+
 	o, result, err := r.prepareOperation(ctx, log, shoot)
 	if err != nil || o == nil {
 		return result, err
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling")))
-	if flowErr := r.runReconcileShootFlow(ctx, o, operationType); flowErr != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, flowErr.Description)
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling")))
+	if retryFailedTasksOnly {
+		log.Info("Only retrying the tasks which failed during the last operation")
+	}
+
+	if flowErr := r.runReconcileShootFlow(ctx, o, operationType, retryFailedTasksOnly); flowErr != nil {
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, flowErr.Description, v1beta1helper.ErrorCodesOf(flowErr.LastErrors...)...)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restored", "Reconciled")))
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restored", "Reconciled")))
 	if err := r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, &o.Seed.GetInfo().Name, operationType); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	if isRestoring && v1beta1helper.IsShootImport(shoot) {
+		log.Info("Removing import annotation after the existing cluster was adopted successfully")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootImport)
+		if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing import annotation: %w", err)
+		}
+	}
+
+	if _, ok := shoot.Annotations[v1beta1constants.AnnotationShootBackupBucketMigrationTarget]; ok && !isRestoring {
+		log.Info("Removing backup bucket migration target annotation after the etcd backups were moved successfully")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootBackupBucketMigrationTarget)
+		if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing backup bucket migration target annotation: %w", err)
+		}
+	}
+
 	if syncErr := r.syncClusterResourceToSeed(ctx, shoot, o.Garden.Project, o.Shoot.CloudProfile, o.Seed.GetInfo()); syncErr != nil {
 		log.Error(syncErr, "Cluster resource sync to seed failed")
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, syncErr.Error(), operationType, shoot.Status.LastErrors...)
@@ -136,6 +213,12 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 
 	// determine when the next shoot reconciliation is supposed to happen
 	result = helper.CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot).RequeueAfter
+	if until, ok := gardenerutils.ShootDebugModeUntil(shoot); ok {
+		// make sure that the debug settings are reverted when the time window of the debug mode ends
+		if untilEnd := until.Sub(r.Clock.Now()); untilEnd < result.RequeueAfter {
+			result.RequeueAfter = max(untilEnd, time.Second)
+		}
+	}
 	nextReconciliation := r.Clock.Now().UTC().Add(result.RequeueAfter)
 
 	log.Info("Shoot operation finished successfully, scheduling next reconciliation for Shoot", "requeueAfter", result.RequeueAfter, "nextReconciliation", nextReconciliation)
@@ -169,9 +252,9 @@ func (r *Reconciler) migrateShoot(ctx context.Context, log logr.Logger, shoot *g
 		return result, err
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventPrepareMigration, "Preparing Shoot cluster for migration")
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventPrepareMigration, "Preparing Shoot cluster for migration")
 	if flowErr := r.runMigrateShootFlow(ctx, o); flowErr != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventMigrationPreparationFailed, flowErr.Description)
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventMigrationPreparationFailed, flowErr.Description, v1beta1helper.ErrorCodesOf(flowErr.LastErrors...)...)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, gardencorev1beta1.LastOperationTypeMigrate, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
 	}
@@ -228,7 +311,7 @@ func (r *Reconciler) deleteShoot(ctx context.Context, log logr.Logger, shoot *ga
 		return result, err
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventDeleting, "Deleting Shoot cluster")
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventDeleting, "Deleting Shoot cluster")
 	var flowErr *v1beta1helper.WrappedLastErrors
 
 	if v1beta1helper.ShootNeedsForceDeletion(shoot) {
@@ -237,34 +320,66 @@ func (r *Reconciler) deleteShoot(ctx context.Context, log logr.Logger, shoot *ga
 		flowErr = r.runDeleteShootFlow(ctx, o)
 	}
 	if flowErr != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, flowErr.Description)
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, flowErr.Description, v1beta1helper.ErrorCodesOf(flowErr.LastErrors...)...)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventDeleted, "Deleted Shoot cluster")
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventDeleted, "Deleted Shoot cluster")
 	return r.finalizeShootDeletion(ctx, log, shoot)
 }
 
-func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*operation.Operation, reconcile.Result, error) {
-	// fetch related objects required for shoot operation
+// getRelatedObjects fetches the Project, the CloudProfile and the Seed required for operations on the given Shoot.
+func (r *Reconciler) getRelatedObjects(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Project, *gardencorev1beta1.CloudProfile, *gardencorev1beta1.Seed, error) {
 	project, _, err := gardenerutils.ProjectAndNamespaceFromReader(ctx, r.GardenClient, shoot.Namespace)
 	if err != nil {
-		return nil, reconcile.Result{}, err
+		return nil, nil, nil, err
 	}
 	if project == nil {
-		return nil, reconcile.Result{}, fmt.Errorf("cannot find Project for namespace '%s'", shoot.Namespace)
+		return nil, nil, nil, fmt.Errorf("cannot find Project for namespace '%s'", shoot.Namespace)
 	}
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.GardenClient, shoot)
 	if err != nil {
-		return nil, reconcile.Result{}, err
+		return nil, nil, nil, err
 	}
 
 	seed := &gardencorev1beta1.Seed{}
 	// always fetch the seed that this gardenlet is responsible for (instead of using spec.seedName),
 	// it is never acting on a foreign seed (e.g., during control plane migration)
 	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: r.Config.SeedConfig.Name}, seed); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return project, cloudProfile, seed, nil
+}
+
+// syncPausedShoot maintains the paused state in the status of the given Shoot. While the Shoot is paused, only its
+// Cluster resource is kept up-to-date, i.e., no other operation is performed on the Shoot.
+func (r *Reconciler) syncPausedShoot(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	if err := r.patchShootStatusPaused(ctx, log, shoot); err != nil {
+		return fmt.Errorf("failed updating paused status: %w", err)
+	}
+
+	if !v1beta1helper.IsShootPaused(shoot) {
+		return nil
+	}
+
+	project, cloudProfile, seed, err := r.getRelatedObjects(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	if err := r.syncClusterResourceToSeed(ctx, shoot, project, cloudProfile, seed); err != nil {
+		return fmt.Errorf("failed syncing Cluster resource to Seed while Shoot is paused: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*operation.Operation, reconcile.Result, error) {
+	// fetch related objects required for shoot operation
+	project, cloudProfile, seed, err := r.getRelatedObjects(ctx, shoot)
+	if err != nil {
 		return nil, reconcile.Result{}, err
 	}
 
@@ -372,16 +487,22 @@ func (r *Reconciler) initializeOperation(
 		return nil, err
 	}
 
+	cfg := r.Config
+	if r.Settings != nil {
+		cfg.Monitoring = r.Settings.Monitoring()
+	}
+
 	op, err := operation.
 		NewBuilder().
 		WithLogger(log).
-		WithConfig(&r.Config).
+		WithConfig(&cfg).
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
 		WithSecrets(gardenSecrets).
 		WithGarden(gardenObj).
 		WithSeed(seedObj).
 		WithShoot(shootObj).
+		WithGardenInformers(r.GardenInformers).
 		Build(ctx, r.GardenClient, r.SeedClientSet, r.ShootClientMap)
 	if err != nil {
 		return nil, err
@@ -427,7 +548,7 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 	if len(shoot.Status.UID) > 0 {
 		if err := o.DeleteClusterResourceFromSeed(ctx); err != nil {
 			lastErr := v1beta1helper.LastError(fmt.Sprintf("Could not delete Cluster resource in seed: %s", err))
-			r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastErr.Description)
+			controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastErr.Description)
 			updateErr := r.patchShootStatusOperationError(ctx, shoot, lastErr.Description, gardencorev1beta1.LastOperationTypeMigrate, *lastErr)
 			return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(lastErr.Description), updateErr)
 		}
@@ -439,7 +560,7 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 		return reconcile.Result{}, err
 	}
 
-	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
+	controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
 	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, nil, gardencorev1beta1.LastOperationTypeMigrate)
 }
 
@@ -447,7 +568,7 @@ func (r *Reconciler) finalizeShootDeletion(ctx context.Context, log logr.Logger,
 	if cleanErr := r.deleteClusterResourceFromSeed(ctx, shoot); cleanErr != nil {
 		lastErr := v1beta1helper.LastError(fmt.Sprintf("Could not delete Cluster resource in seed: %s", cleanErr))
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, lastErr.Description, gardencorev1beta1.LastOperationTypeDelete, *lastErr)
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastErr.Description)
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeWarning, gardencorev1beta1.EventDeleteError, lastErr.Description)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(lastErr.Description), updateErr)
 	}
 
@@ -500,6 +621,37 @@ func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flo
 	return flow.NewImmediateProgressReporter(reporterFn)
 }
 
+const (
+	// flowTaskClassExtension is the concurrency class of the tasks deploying extension resources.
+	flowTaskClassExtension = "extension"
+	// flowTaskClassSystemComponent is the concurrency class of the tasks deploying the system components of the shoot
+	// cluster.
+	flowTaskClassSystemComponent = "system-component"
+)
+
+// withFlowConcurrency sets the concurrency limits for the tasks of the shoot flows configured in the gardenlet
+// configuration.
+func (r *Reconciler) withFlowConcurrency(opts flow.Opts) flow.Opts {
+	if r.Config.Controllers.Shoot == nil || r.Config.Controllers.Shoot.FlowConcurrency == nil {
+		return opts
+	}
+
+	opts.MaxParallelTasks = ptr.Deref(r.Config.Controllers.Shoot.FlowConcurrency.MaxParallelTasks, 0)
+	opts.MaxParallelTasksPerClass = r.Config.Controllers.Shoot.FlowConcurrency.MaxParallelTasksPerClass
+	return opts
+}
+
+// flowRecorderKey returns the key under which the snapshots of the flows of the given shoot are recorded.
+func flowRecorderKey(shoot *gardencorev1beta1.Shoot) string {
+	return "shoot/" + shoot.Namespace + "/" + shoot.Name
+}
+
+// deleteFlowSnapshot deletes the recorded snapshot of the flows of the given shoot. It is called when the shoot's
+// control plane is gone from this seed, i.e., when its deletion or migration completed.
+func deleteFlowSnapshot(shoot *gardencorev1beta1.Shoot) {
+	flow.DefaultRecorder.Delete(flowRecorderKey(shoot))
+}
+
 func (r *Reconciler) updateShootStatusOperationStart(
 	ctx context.Context,
 	shoot *gardencorev1beta1.Shoot,
@@ -558,7 +710,7 @@ func (r *Reconciler) updateShootStatusOperationStart(
 		LastUpdateTime: now,
 	}
 
-	var mustRemoveOperationAnnotation bool
+	var mustRemoveOperationAnnotation, mustEnableDualStack bool
 
 	switch shoot.Annotations[v1beta1constants.GardenerOperation] {
 	case v1beta1constants.OperationRotateCredentialsStart:
@@ -611,6 +763,16 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	case v1beta1constants.OperationRotateETCDEncryptionKeyComplete:
 		mustRemoveOperationAnnotation = true
 		completeRotationETCDEncryptionKey(shoot, &now)
+
+	case v1beta1constants.ShootOperationRetryFailedTasks:
+		mustRemoveOperationAnnotation = true
+
+	case v1beta1constants.ShootOperationEnableDualStack:
+		mustRemoveOperationAnnotation = true
+		mustEnableDualStack = startDualStackMigration(shoot, &now)
+
+	case v1beta1constants.ShootOperationMigrateBackupBucket:
+		mustRemoveOperationAnnotation = true
 	}
 
 	if err := r.GardenClient.Status().Update(ctx, shoot); err != nil {
@@ -620,6 +782,9 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	if mustRemoveOperationAnnotation {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+		if mustEnableDualStack {
+			shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+		}
 		return r.GardenClient.Patch(ctx, shoot, patch)
 	}
 
@@ -822,6 +987,28 @@ func (r *Reconciler) patchShootStatusOperationError(
 	return r.GardenClient.Status().Patch(ctx, shoot, statusPatch)
 }
 
+// patchShootStatusPaused maintains the time since when the operations on the shoot are paused in its status.
+func (r *Reconciler) patchShootStatusPaused(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	paused := v1beta1helper.IsShootPaused(shoot)
+	if paused == (shoot.Status.PausedSince != nil) {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if paused {
+		log.Info("Pausing operations on Shoot")
+		shoot.Status.PausedSince = &metav1.Time{Time: r.Clock.Now().UTC()}
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventPaused, "Paused operations on Shoot cluster")
+	} else {
+		pausedFor := r.Clock.Since(shoot.Status.PausedSince.Time).Round(time.Second)
+		log.Info("Resuming operations on Shoot", "pausedFor", pausedFor)
+		shoot.Status.PausedSince = nil
+		controllerutils.RecordEvent(ctx, r.Recorder, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventResumed, fmt.Sprintf("Resumed operations on Shoot cluster after %s", pausedFor))
+	}
+
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
 func (r *Reconciler) shootHasBastions(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
 	return kubernetesutils.ResourcesExist(ctx, r.GardenClient, &operationsv1alpha1.BastionList{}, r.GardenClient.Scheme(), client.MatchingFields{operations.BastionShootName: shoot.Name})
 }
@@ -1013,3 +1200,17 @@ func startRotationObservability(shoot *gardencorev1beta1.Shoot, now *metav1.Time
 		rotation.LastInitiationTime = now
 	})
 }
+
+// startDualStackMigration records the initiation time of the migration to dual-stack networking in the status of the
+// given shoot. It returns false if the shoot is not IPv4 single-stack, i.e., if there is nothing to migrate.
+func startDualStackMigration(shoot *gardencorev1beta1.Shoot, now *metav1.Time) bool {
+	if shoot.Spec.Networking == nil || !gardencorev1beta1.IsIPv4SingleStack(shoot.Spec.Networking.IPFamilies) {
+		return false
+	}
+
+	if shoot.Status.Networking == nil {
+		shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{}
+	}
+	shoot.Status.Networking.DualStackMigrationInitiationTime = now
+	return true
+}
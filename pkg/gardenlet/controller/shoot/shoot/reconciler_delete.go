@@ -722,12 +722,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		f = g.Compile()
 	)
 
-	if err := f.Run(ctx, flow.Opts{
+	if err := f.Run(ctx, r.withFlowConcurrency(flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
-	}); err != nil {
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      flowRecorderKey(o.Shoot.GetInfo()),
+	})); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
@@ -737,6 +739,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	deleteFlowSnapshot(o.Shoot.GetInfo())
+
 	o.Logger.Info("Successfully deleted Shoot cluster")
 	return nil
 }
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("#withFlowConcurrency", func() {
+	var r *Reconciler
+
+	BeforeEach(func() {
+		r = &Reconciler{Config: config.GardenletConfiguration{Controllers: &config.GardenletControllerConfiguration{
+			Shoot: &config.ShootControllerConfiguration{},
+		}}}
+	})
+
+	It("should not limit the concurrency if nothing is configured", func() {
+		Expect(r.withFlowConcurrency(flow.Opts{RecorderKey: "foo"})).To(Equal(flow.Opts{RecorderKey: "foo"}))
+	})
+
+	It("should set the configured limits", func() {
+		r.Config.Controllers.Shoot.FlowConcurrency = &config.ShootFlowConcurrency{
+			MaxParallelTasks:         ptr.To(10),
+			MaxParallelTasksPerClass: map[string]int{flowTaskClassExtension: 3},
+		}
+
+		Expect(r.withFlowConcurrency(flow.Opts{RecorderKey: "foo"})).To(Equal(flow.Opts{
+			RecorderKey:              "foo",
+			MaxParallelTasks:         10,
+			MaxParallelTasksPerClass: map[string]int{"extension": 3},
+		}))
+	})
+})
+
+var _ = Describe("#deleteFlowSnapshot", func() {
+	BeforeEach(func() {
+		flow.DefaultRecorder.SetEnabled(true)
+		DeferCleanup(func() { flow.DefaultRecorder.SetEnabled(false) })
+	})
+
+	It("should only delete the snapshot of the given shoot", func() {
+		shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"}}
+		otherShoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-dev"}}
+
+		flow.DefaultRecorder.Record(flowRecorderKey(shoot), &flow.Snapshot{Name: "Shoot cluster deletion"})
+		flow.DefaultRecorder.Record(flowRecorderKey(otherShoot), &flow.Snapshot{Name: "Shoot cluster reconciliation"})
+
+		deleteFlowSnapshot(shoot)
+
+		Expect(flow.DefaultRecorder.Keys()).To(ConsistOf("shoot/garden-dev/bar"))
+	})
+})
@@ -193,12 +193,14 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		f = g.Compile()
 	)
 
-	if err := f.Run(ctx, flow.Opts{
+	if err := f.Run(ctx, r.withFlowConcurrency(flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
-	}); err != nil {
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      flowRecorderKey(o.Shoot.GetInfo()),
+	})); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
@@ -208,6 +210,8 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	deleteFlowSnapshot(o.Shoot.GetInfo())
+
 	o.Logger.Info("Successfully force-deleted Shoot cluster")
 	return nil
 }
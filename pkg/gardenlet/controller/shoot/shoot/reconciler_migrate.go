@@ -424,15 +424,19 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 		f = g.Compile()
 	)
 
-	if err := f.Run(ctx, flow.Opts{
+	if err := f.Run(ctx, r.withFlowConcurrency(flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
-	}); err != nil {
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      flowRecorderKey(o.Shoot.GetInfo()),
+	})); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
+	deleteFlowSnapshot(o.Shoot.GetInfo())
+
 	o.Logger.Info("Successfully prepared Shoot cluster for restoration")
 	return nil
 }
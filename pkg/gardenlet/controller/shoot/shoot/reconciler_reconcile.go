@@ -37,8 +37,9 @@ import (
 )
 
 // runReconcileShootFlow reconciles the Shoot cluster.
-// It receives an Operation object <o> which stores the Shoot object.
-func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType) *v1beta1helper.WrappedLastErrors {
+// It receives an Operation object <o> which stores the Shoot object. If <retryFailedTasksOnly> is true, only the tasks
+// which failed during the last operation (and the tasks depending on them) are executed.
+func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Operation, operationType gardencorev1beta1.LastOperationType, retryFailedTasksOnly bool) *v1beta1helper.WrappedLastErrors {
 	// We create the botanists (which will do the actual work).
 	var (
 		botanist                *botanistpkg.Botanist
@@ -76,6 +77,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		errors.ToExecute("Check required extensions", func() error {
 			return botanist.WaitUntilRequiredExtensionsReady(ctx)
 		}),
+		errors.ToExecute("Verify container images", func() error {
+			if r.ImageVerifier == nil {
+				return nil
+			}
+			return botanist.VerifyContainerImages(ctx, r.ImageVerifier)
+		}),
 		errors.ToExecute("Check if copy of backups is required", func() error {
 			isCopyOfBackupsRequired, err = botanist.IsCopyOfBackupsRequired(ctx)
 			return err
@@ -161,6 +168,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployInfrastructure = g.Add(flow.Task{
 			Name:         "Deploying Shoot infrastructure",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployInfrastructure).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
@@ -200,7 +208,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerServiceIsReady),
 		})
 		deployInternalDomainDNSRecord = g.Add(flow.Task{
-			Name: "Deploying internal domain DNS record",
+			Name:  "Deploying internal domain DNS record",
+			Class: flowTaskClassExtension,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := botanist.DeployOrDestroyInternalDNSRecord(ctx); err != nil {
 					return err
@@ -210,8 +219,9 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerServiceIsReady),
 		})
-		_ = g.Add(flow.Task{
-			Name: "Deploying external domain DNS record",
+		deployExternalDomainDNSRecord = g.Add(flow.Task{
+			Name:  "Deploying external domain DNS record",
+			Class: flowTaskClassExtension,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := botanist.DeployOrDestroyExternalDNSRecord(ctx); err != nil {
 					return err
@@ -244,6 +254,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       skipReadiness || !allowBackup,
 			Dependencies: flow.NewTaskIDs(deployBackupEntryInGarden),
 		})
+		deployNamespaceAfterBackupBucketMigration = g.Add(flow.Task{
+			Name:         "Updating backup provider of Shoot namespace in Seed after backup bucket migration",
+			Fn:           flow.TaskFn(botanist.DeploySeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       !isCopyOfBackupsRequired || botanist.IsRestorePhase(),
+			Dependencies: flow.NewTaskIDs(waitUntilBackupEntryInGardenReconciled),
+		})
 		copyEtcdBackups = g.Add(flow.Task{
 			Name:         "Copying etcd backups to new seed's backup bucket",
 			Fn:           botanist.DeployEtcdCopyBackupsTask,
@@ -265,18 +281,24 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
 			Fn:           flow.TaskFn(botanist.DeployEtcd).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
-			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied, deployNamespaceAfterBackupBucketMigration),
+		})
+		waitUntilEtcdMainBackupReady = g.Add(flow.Task{
+			Name:         "Waiting until main etcd has uploaded backups to the new backup bucket",
+			Fn:           botanist.WaitUntilEtcdMainBackupReady,
+			SkipIf:       !isCopyOfBackupsRequired || botanist.IsRestorePhase() || o.Shoot.HibernationEnabled || skipReadiness,
+			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
 		destroySourceBackupEntry = g.Add(flow.Task{
 			Name:         "Destroying source backup entry",
 			Fn:           botanist.DestroySourceBackupEntry,
-			SkipIf:       !allowBackup || !botanist.IsRestorePhase(),
-			Dependencies: flow.NewTaskIDs(deployETCD),
+			SkipIf:       !allowBackup || (!botanist.IsRestorePhase() && !isCopyOfBackupsRequired),
+			Dependencies: flow.NewTaskIDs(deployETCD, waitUntilEtcdMainBackupReady),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until source backup entry has been deleted",
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.WaitCleanup,
-			SkipIf:       !allowBackup || skipReadiness || !botanist.IsRestorePhase(),
+			SkipIf:       !allowBackup || skipReadiness || (!botanist.IsRestorePhase() && !isCopyOfBackupsRequired),
 			Dependencies: flow.NewTaskIDs(destroySourceBackupEntry),
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
@@ -287,6 +309,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources, waitUntilInfrastructureReady),
@@ -350,6 +373,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployControlPlane = g.Add(flow.Task{
 			Name:         "Deploying shoot control plane components",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployControlPlane).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, waitUntilGardenerResourceManagerReady),
@@ -386,6 +410,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployControlPlaneExposure = g.Add(flow.Task{
 			Name:         "Deploying shoot control plane exposure components",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployControlPlaneExposure).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || useDNS,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilKubeAPIServerIsReady),
@@ -549,6 +574,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployOperatingSystemConfig = g.Add(flow.Task{
 			Name:         "Deploying operating system specific configuration for shoot workers",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployOperatingSystemConfig).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilInfrastructureReady, waitUntilControlPlaneReady, deleteBastions, waitUntilExtensionResourcesAfterKAPIReady),
@@ -577,11 +603,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deleteStaleOperatingSystemConfigResources),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Syncing bootstrap configuration for existing hosts to Garden cluster",
+			Fn:           flow.TaskFn(botanist.SyncExistingHostsBootstrapToGarden).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilOperatingSystemConfigReady),
+		})
 		deployNetwork = g.Add(flow.Task{
 			Name:         "Deploying shoot network plugin",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployNetwork).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
+			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, initializeShootClients, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		waitUntilNetworkIsReady = g.Add(flow.Task{
 			Name: "Waiting until shoot network plugin has been reconciled",
@@ -601,12 +634,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployShootSystemResources = g.Add(flow.Task{
 			Name:         "Deploying shoot system resources",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployShootSystem).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilShootNamespacesReady),
 		})
 		deployCoreDNS = g.Add(flow.Task{
-			Name: "Deploying CoreDNS system component",
+			Name:  "Deploying CoreDNS system component",
+			Class: flowTaskClassSystemComponent,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := botanist.DeployCoreDNS(ctx); err != nil {
 					return err
@@ -621,12 +656,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployNodeLocalDNS = g.Add(flow.Task{
 			Name:         "Reconcile node-local-dns system component",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.ReconcileNodeLocalDNS),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, initializeShootClients, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady, waitUntilNetworkIsReady),
 		})
 		deployMetricsServer = g.Add(flow.Task{
-			Name: "Deploying metrics-server system component",
+			Name:  "Deploying metrics-server system component",
+			Class: flowTaskClassSystemComponent,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.SystemComponents.MetricsServer.Deploy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -634,7 +671,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployVPNShoot = g.Add(flow.Task{
-			Name: "Deploying vpn-shoot system component",
+			Name:  "Deploying vpn-shoot system component",
+			Class: flowTaskClassSystemComponent,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.SystemComponents.VPNShoot.Deploy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -642,7 +680,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployGardenerResourceManager, deployKubeScheduler, deployVPNSeedServer, waitUntilShootNamespacesReady),
 		})
 		deployNodeProblemDetector = g.Add(flow.Task{
-			Name: "Deploying node-problem-detector system component",
+			Name:  "Deploying node-problem-detector system component",
+			Class: flowTaskClassSystemComponent,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.SystemComponents.NodeProblemDetector.Deploy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -651,6 +690,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployKubeProxy = g.Add(flow.Task{
 			Name:         "Deploying kube-proxy system component",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployKubeProxy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || !kubeProxyEnabled,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
@@ -673,38 +713,65 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployAPIServerProxy = g.Add(flow.Task{
 			Name:         "Deploying apiserver-proxy",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployAPIServerProxy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployBlackboxExporter = g.Add(flow.Task{
 			Name:         "Deploying blackbox-exporter",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.ReconcileBlackboxExporterCluster).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployNodeExporter = g.Add(flow.Task{
-			Name: "Deploying node-exporter",
+			Name:  "Deploying node-exporter",
+			Class: flowTaskClassSystemComponent,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.ReconcileNodeExporter(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		deployRuntimeSecurity = g.Add(flow.Task{
+			Name:         "Deploying runtime security agent",
+			Class:        flowTaskClassSystemComponent,
+			Fn:           flow.TaskFn(botanist.ReconcileRuntimeSecurity).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, waitUntilShootNamespacesReady),
+		})
+		deployCertificateService = g.Add(flow.Task{
+			Name:         "Deploying certificate service",
+			Class:        flowTaskClassSystemComponent,
+			Fn:           flow.TaskFn(botanist.ReconcileCertificateService).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployExternalDomainDNSRecord, waitUntilShootNamespacesReady),
+		})
+		deployWorkerHeadroom = g.Add(flow.Task{
+			Name:         "Deploying worker headroom",
+			Class:        flowTaskClassSystemComponent,
+			Fn:           flow.TaskFn(botanist.ReconcileWorkerHeadroom).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployShootSystemResources, waitUntilShootNamespacesReady),
+		})
 		deployKubernetesDashboard = g.Add(flow.Task{
 			Name:         "Deploying addon Kubernetes Dashboard",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployKubernetesDashboard).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployNginxIngressAddon = g.Add(flow.Task{
 			Name:         "Deploying addon Nginx Ingress Controller",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployNginxIngressAddon).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		deployManagedResourceForGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Deploying managed resources for the gardener-node-agent",
+			Class:        flowTaskClassSystemComponent,
 			Fn:           flow.TaskFn(botanist.DeployManagedResourceForGardenerNodeAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, ensureShootClusterIdentity, waitUntilOperatingSystemConfigReady),
@@ -722,6 +789,9 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			deployNodeProblemDetector,
 			deployKubeProxy,
 			deployBlackboxExporter,
+			deployRuntimeSecurity,
+			deployCertificateService,
+			deployWorkerHeadroom,
 			deployKubernetesDashboard,
 			deployNginxIngressAddon,
 		)
@@ -740,6 +810,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployWorker = g.Add(flow.Task{
 			Name:         "Configuring shoot worker pools",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployWorker).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployMachineControllerManager),
@@ -754,6 +825,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployExtensionResourcesAfterWorker = g.Add(flow.Task{
 			Name:         "Deploying extension resources after workers",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployExtensionsAfterWorker).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerStatusUpdate),
@@ -797,7 +869,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady, ensureShootClusterIdentity),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Deploying nginx ingress DNS record",
+			Name:  "Deploying nginx ingress DNS record",
+			Class: flowTaskClassExtension,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := botanist.DeployOrDestroyIngressDNSRecord(ctx); err != nil {
 					return err
@@ -845,6 +918,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.DeployPlutono).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(deployPrometheus, deployAlertmanager),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Syncing component inventory to Garden cluster",
+			Fn:           flow.TaskFn(botanist.SyncInventoryToGarden).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			SkipIf:       !features.DefaultFeatureGate.Enabled(features.ShootComponentInventory) || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilTunnelConnectionExists, deployPrometheus, deployAlertmanager),
+		})
 
 		hibernateControlPlane = g.Add(flow.Task{
 			Name:         "Hibernating control plane",
@@ -898,6 +977,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployContainerRuntimeResources = g.Add(flow.Task{
 			Name:         "Deploying container runtime resources",
+			Class:        flowTaskClassExtension,
 			Fn:           flow.TaskFn(botanist.DeployContainerRuntime).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, initializeShootClients),
@@ -941,12 +1021,15 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 	f := g.Compile()
 
-	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorContext:     errorContext,
-		ErrorCleaner:     o.CleanShootTaskError,
-	}); err != nil {
+	if err := f.Run(ctx, r.withFlowConcurrency(flow.Opts{
+		Log:                  o.Logger,
+		ProgressReporter:     r.newProgressReporter(o.ReportShootProgress),
+		ErrorContext:         errorContext,
+		ErrorCleaner:         o.CleanShootTaskError,
+		RetryFailedTasksOnly: retryFailedTasksOnly,
+		Recorder:             flow.DefaultRecorder,
+		RecorderKey:          flowRecorderKey(o.Shoot.GetInfo()),
+	})); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.Background()
+		fakeClock    = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "shoot",
+				Namespace:         "garden-foo",
+				DeletionTimestamp: &metav1.Time{Time: fakeClock.Now()},
+				Finalizers:        []string{gardencorev1beta1.GardenerName},
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("cloudprofile"),
+				Paused:           ptr.To(true),
+				SeedName:         ptr.To("seed"),
+			},
+			Status: gardencorev1beta1.ShootStatus{TechnicalID: "shoot--foo--shoot"},
+		}
+
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithObjects(
+				shoot,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-foo", Labels: map[string]string{v1beta1constants.ProjectName: "foo"}}},
+				&gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+				&gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}},
+				&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+			).
+			Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		reconciler = &Reconciler{
+			GardenClient:  gardenClient,
+			SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Config: config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{Shoot: &config.ShootControllerConfiguration{}},
+				SeedConfig:  &config.SeedConfig{SeedTemplate: gardencore.SeedTemplate{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}},
+			},
+			Recorder: &record.FakeRecorder{},
+			Clock:    fakeClock,
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should not perform any operation on a paused Shoot but maintain its paused state and Cluster resource", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Finalizers).To(ConsistOf(gardencorev1beta1.GardenerName))
+			Expect(shoot.Status.LastOperation).To(BeNil())
+			Expect(shoot.Status.PausedSince).NotTo(BeNil())
+			Expect(shoot.Status.PausedSince.Time).To(BeTemporally("==", fakeClock.Now()))
+
+			Expect(seedClient.Get(ctx, client.ObjectKey{Name: "shoot--foo--shoot"}, &extensionsv1alpha1.Cluster{})).To(Succeed())
+		})
+	})
+})
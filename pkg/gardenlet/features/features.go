@@ -27,5 +27,21 @@ func GetFeatures() []featuregate.Feature {
 		features.ShootManagedIssuer,
 		features.VPAAndHPAForAPIServer,
 		features.NewWorkerPoolHash,
+		features.ServerSideApplyComponents,
+		features.RuntimeSecurity,
+		features.ShootStateEncryption,
+		features.ShootImport,
+		features.ShootComponentInventory,
+		features.PerTargetClientRateLimiting,
+	}
+}
+
+// GetReloadableFeatures returns the gardenlet features which can be changed without restarting gardenlet. They are only
+// evaluated during reconciliations, i.e., a change takes effect with the next reconciliation of the affected objects.
+func GetReloadableFeatures() []featuregate.Feature {
+	return []featuregate.Feature{
+		features.DefaultSeccompProfile,
+		features.RuntimeSecurity,
+		features.ShootComponentInventory,
 	}
 }
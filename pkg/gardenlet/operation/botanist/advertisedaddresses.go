@@ -14,7 +14,8 @@ import (
 )
 
 // UpdateAdvertisedAddresses updates the shoot.status.advertisedAddresses with the list of
-// addresses on which the API server of the shoot is accessible.
+// addresses on which the API server of the shoot is accessible, as well as the addresses of the
+// service account issuer and the endpoint used by nodes for bootstrapping.
 func (b *Botanist) UpdateAdvertisedAddresses(ctx context.Context) error {
 	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
 		addresses, err := b.ToAdvertisedAddresses()
@@ -74,6 +75,23 @@ func (b *Botanist) ToAdvertisedAddresses() ([]gardencorev1beta1.ShootAdvertisedA
 			Name: v1beta1constants.AdvertisedAddressServiceAccountIssuer,
 			URL:  serviceAccountConfig.Issuer,
 		})
+
+		// The JWKS URI is only known if the issuer is managed by Gardener, otherwise the keys are served by the
+		// kube-apiserver (or by the owner of the custom issuer) and are discoverable via the issuer.
+		if serviceAccountConfig.JWKSURI != nil {
+			addresses = append(addresses, gardencorev1beta1.ShootAdvertisedAddress{
+				Name: v1beta1constants.AdvertisedAddressServiceAccountIssuerJWKS,
+				URL:  *serviceAccountConfig.JWKSURI,
+			})
+		}
+	}
+
+	// Nodes use the same address which is configured in the operating system config for bootstrapping.
+	if len(b.Shoot.InternalClusterDomain) > 0 && !b.Shoot.IsWorkerless {
+		addresses = append(addresses, gardencorev1beta1.ShootAdvertisedAddress{
+			Name: v1beta1constants.AdvertisedAddressBootstrap,
+			URL:  "https://" + b.Shoot.ComputeOutOfClusterAPIServerAddress(true),
+		})
 	}
 
 	return addresses, nil
@@ -52,7 +52,7 @@ var _ = Describe("AdvertisedAddresses", func() {
 			}))
 		})
 
-		It("returns internal and service-account-issuer addresses", func() {
+		It("returns internal, service-account-issuer and bootstrap addresses", func() {
 			botanist.Shoot.InternalClusterDomain = "baz.foo"
 
 			addresses, err := botanist.ToAdvertisedAddresses()
@@ -67,6 +67,10 @@ var _ = Describe("AdvertisedAddresses", func() {
 					Name: "service-account-issuer",
 					URL:  "https://api.baz.foo",
 				},
+				{
+					Name: "bootstrap",
+					URL:  "https://api.baz.foo",
+				},
 			}))
 		})
 
@@ -83,7 +87,7 @@ var _ = Describe("AdvertisedAddresses", func() {
 			}))
 		})
 
-		It("returns external, internal, service-account-issuer addresses in correct order", func() {
+		It("returns external, internal, service-account-issuer, bootstrap addresses in correct order", func() {
 			botanist.Shoot.ExternalClusterDomain = ptr.To("foo.bar")
 			botanist.Shoot.InternalClusterDomain = "baz.foo"
 			botanist.APIServerAddress = "bar.foo"
@@ -103,6 +107,10 @@ var _ = Describe("AdvertisedAddresses", func() {
 					Name: "service-account-issuer",
 					URL:  "https://api.baz.foo",
 				},
+				{
+					Name: "bootstrap",
+					URL:  "https://api.baz.foo",
+				},
 			}))
 		})
 
@@ -133,10 +141,14 @@ var _ = Describe("AdvertisedAddresses", func() {
 					Name: "service-account-issuer",
 					URL:  "https://foo.bar.example.issuer",
 				},
+				{
+					Name: "bootstrap",
+					URL:  "https://api.baz.foo",
+				},
 			}))
 		})
 
-		It("returns external, internal addresses with addition to managed service-account-issuer address", func() {
+		It("returns external, internal addresses with addition to managed service-account-issuer and jwks addresses", func() {
 			botanist.Shoot.ExternalClusterDomain = ptr.To("foo.bar")
 			botanist.Shoot.InternalClusterDomain = "baz.foo"
 			botanist.Shoot.ServiceAccountIssuerHostname = ptr.To("managed.foo.bar")
@@ -173,6 +185,14 @@ var _ = Describe("AdvertisedAddresses", func() {
 					Name: "service-account-issuer",
 					URL:  "https://managed.foo.bar/projects/some-proj/shoots/some-uid/issuer",
 				},
+				{
+					Name: "service-account-issuer-jwks",
+					URL:  "https://managed.foo.bar/projects/some-proj/shoots/some-uid/issuer/jwks",
+				},
+				{
+					Name: "bootstrap",
+					URL:  "https://api.baz.foo",
+				},
 			}))
 		})
 
@@ -202,6 +222,33 @@ var _ = Describe("AdvertisedAddresses", func() {
 			addresses, err := botanist.ToAdvertisedAddresses()
 			Expect(err).ToNot(HaveOccurred())
 
+			Expect(addresses).To(Equal([]gardencorev1beta1.ShootAdvertisedAddress{
+				{
+					Name: "external",
+					URL:  "https://api.foo.bar",
+				}, {
+					Name: "internal",
+					URL:  "https://api.baz.foo",
+				},
+				{
+					Name: "service-account-issuer",
+					URL:  "https://api.baz.foo",
+				},
+				{
+					Name: "bootstrap",
+					URL:  "https://api.baz.foo",
+				},
+			}))
+		})
+
+		It("does not return bootstrap address for workerless shoots", func() {
+			botanist.Shoot.ExternalClusterDomain = ptr.To("foo.bar")
+			botanist.Shoot.InternalClusterDomain = "baz.foo"
+			botanist.Shoot.IsWorkerless = true
+
+			addresses, err := botanist.ToAdvertisedAddresses()
+			Expect(err).ToNot(HaveOccurred())
+
 			Expect(addresses).To(Equal([]gardencorev1beta1.ShootAdvertisedAddress{
 				{
 					Name: "external",
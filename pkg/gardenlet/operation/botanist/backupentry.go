@@ -8,12 +8,14 @@ import (
 	"context"
 	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corebackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry"
 )
 
@@ -39,14 +41,42 @@ func (b *Botanist) DefaultCoreBackupEntry() corebackupentry.Interface {
 }
 
 // DeployBackupEntry deploys the BackupEntry resource in the Garden cluster and triggers the restore operation in case
-// the Shoot is in the restore phase of the control plane migration.
+// the Shoot is in the restore phase of the control plane migration. In case a backup bucket migration was requested, the
+// BackupEntry is switched to the target BackupBucket.
 func (b *Botanist) DeployBackupEntry(ctx context.Context) error {
 	if b.IsRestorePhase() {
 		return b.Shoot.Components.BackupEntry.Restore(ctx, b.Shoot.GetShootState())
 	}
+
+	if targetBucketName, ok := b.backupBucketMigrationTarget(); ok {
+		backupBucket := &gardencorev1beta1.BackupBucket{}
+		if err := b.GardenClient.Get(ctx, client.ObjectKey{Name: targetBucketName}, backupBucket); err != nil {
+			return fmt.Errorf("failed reading target BackupBucket %q of backup bucket migration: %w", targetBucketName, err)
+		}
+
+		if seedName := ptr.Deref(backupBucket.Spec.SeedName, ""); seedName != b.Seed.GetInfo().Name {
+			return fmt.Errorf("target BackupBucket %q of backup bucket migration must be assigned to seed %q but is assigned to %q", targetBucketName, b.Seed.GetInfo().Name, seedName)
+		}
+
+		b.Shoot.Components.BackupEntry.SetBucketName(targetBucketName)
+		return b.Shoot.Components.BackupEntry.SwitchBucket(ctx)
+	}
+
 	return b.Shoot.Components.BackupEntry.Deploy(ctx)
 }
 
+// backupBucketMigrationTarget returns the name of the BackupBucket to which the etcd backups of the Shoot shall be
+// moved. During the restore phase of the control plane migration, the backups are always moved to the BackupBucket of
+// the destination seed, hence the annotation is not considered.
+func (b *Botanist) backupBucketMigrationTarget() (string, bool) {
+	if b.IsRestorePhase() {
+		return "", false
+	}
+
+	targetBucketName := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootBackupBucketMigrationTarget]
+	return targetBucketName, len(targetBucketName) > 0
+}
+
 // SourceBackupEntry creates a deployer for a core.gardener.cloud/v1beta1.BackupEntry resource which will be used
 // as source when copying etcd backups.
 func (b *Botanist) SourceBackupEntry() corebackupentry.Interface {
@@ -90,3 +120,19 @@ func (b *Botanist) DestroySourceBackupEntry(ctx context.Context) error {
 
 	return b.Shoot.Components.SourceBackupEntry.Destroy(ctx)
 }
+
+// backupProvider returns the provider type of the BackupBucket which stores the etcd backups of the Shoot. It is read
+// from the extension BackupEntry since the BackupBucket might be served by a different provider than the one configured
+// for the backups of the seed, e.g., after a backup bucket migration. If the extension BackupEntry does not exist yet,
+// the backup provider of the seed is returned.
+func (b *Botanist) backupProvider(ctx context.Context) (string, error) {
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.BackupEntryName}, backupEntry); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed reading extension BackupEntry: %w", err)
+		}
+		return b.Seed.GetInfo().Spec.Backup.Provider, nil
+	}
+
+	return backupEntry.Spec.Type, nil
+}
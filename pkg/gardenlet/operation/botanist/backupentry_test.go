@@ -11,8 +11,13 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockbackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -27,17 +32,23 @@ var _ = Describe("BackupEntry", func() {
 		ctx  = context.TODO()
 
 		botanist          *Botanist
+		fakeGardenClient  client.Client
+		backupEntry       *mockbackupentry.MockInterface
 		sourceBackupEntry *mockbackupentry.MockInterface
 	)
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		backupEntry = mockbackupentry.NewMockInterface(ctrl)
 		sourceBackupEntry = mockbackupentry.NewMockInterface(ctrl)
 		botanist = &Botanist{
 			Operation: &operation.Operation{
+				GardenClient: fakeGardenClient,
 				Shoot: &shootpkg.Shoot{
 					Components: &shootpkg.Components{
+						BackupEntry:       backupEntry,
 						SourceBackupEntry: sourceBackupEntry,
 					},
 				},
@@ -73,6 +84,63 @@ var _ = Describe("BackupEntry", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#DeployBackupEntry", func() {
+		It("should restore the BackupEntry in the restore phase", func() {
+			backupEntry.EXPECT().Restore(ctx, gomock.Any())
+
+			Expect(botanist.DeployBackupEntry(ctx)).To(Succeed())
+		})
+
+		Context("not in the restore phase", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+			})
+
+			It("should deploy the BackupEntry", func() {
+				backupEntry.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployBackupEntry(ctx)).To(Succeed())
+			})
+
+			Context("backup bucket migration", func() {
+				var backupBucket *gardencorev1beta1.BackupBucket
+
+				BeforeEach(func() {
+					botanist.Shoot.GetInfo().Annotations = map[string]string{v1beta1constants.AnnotationShootBackupBucketMigrationTarget: "new-bucket"}
+
+					backupBucket = &gardencorev1beta1.BackupBucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "new-bucket"},
+						Spec: gardencorev1beta1.BackupBucketSpec{
+							SeedName: ptr.To("seed"),
+						},
+					}
+				})
+
+				It("should switch the BackupEntry to the target BackupBucket", func() {
+					Expect(fakeGardenClient.Create(ctx, backupBucket)).To(Succeed())
+
+					gomock.InOrder(
+						backupEntry.EXPECT().SetBucketName("new-bucket"),
+						backupEntry.EXPECT().SwitchBucket(ctx),
+					)
+
+					Expect(botanist.DeployBackupEntry(ctx)).To(Succeed())
+				})
+
+				It("should fail if the target BackupBucket does not exist", func() {
+					Expect(botanist.DeployBackupEntry(ctx)).To(MatchError(ContainSubstring("failed reading target BackupBucket \"new-bucket\"")))
+				})
+
+				It("should fail if the target BackupBucket is assigned to a different seed", func() {
+					backupBucket.Spec.SeedName = ptr.To("other-seed")
+					Expect(fakeGardenClient.Create(ctx, backupBucket)).To(Succeed())
+
+					Expect(botanist.DeployBackupEntry(ctx)).To(MatchError(ContainSubstring("must be assigned to seed \"seed\" but is assigned to \"other-seed\"")))
+				})
+			})
+		})
+	})
+
 	Describe("#DestroySourceBackupEntry", func() {
 		It("should set force-deletion annotation and destroy the SourceBackupEntry component", func() {
 			sourceBackupEntry.EXPECT().SetForceDeletionAnnotation(ctx)
@@ -64,6 +64,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			PKI:                  gardenerutils.PKIConfig(b.Shoot.GetInfo().Spec.Kubernetes.PKI),
 		},
 	)
 	if err != nil {
@@ -72,6 +73,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 
 	// extension components
 	o.Shoot.Components.Extensions.ExternalDNSRecord = b.DefaultExternalDNSRecord()
+	o.Shoot.Components.Extensions.SecondaryExternalDNSRecord = b.DefaultSecondaryExternalDNSRecord()
 	o.Shoot.Components.Extensions.InternalDNSRecord = b.DefaultInternalDNSRecord()
 	o.Shoot.Components.Extensions.IngressDNSRecord = b.DefaultIngressDNSRecord()
 
@@ -208,6 +210,18 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.SystemComponents.RuntimeSecurity, err = b.DefaultRuntimeSecurity()
+		if err != nil {
+			return nil, err
+		}
+		o.Shoot.Components.SystemComponents.CertificateService, err = b.DefaultCertificateService()
+		if err != nil {
+			return nil, err
+		}
+		o.Shoot.Components.SystemComponents.WorkerHeadroom, err = b.DefaultWorkerHeadroom()
+		if err != nil {
+			return nil, err
+		}
 		o.Shoot.Components.SystemComponents.KubeProxy, err = b.DefaultKubeProxy()
 		if err != nil {
 			return nil, err
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/certmanagement"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// DefaultCertificateService returns a deployer for the Gardener-managed certificate service of the shoot.
+func (b *Botanist) DefaultCertificateService() (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameCertManagement, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	values := certmanagement.ShootValues{
+		Image:         image.String(),
+		Replicas:      b.Shoot.GetReplicas(1),
+		DNSSecretName: DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalName,
+	}
+
+	if b.NeedsExternalDNS() {
+		values.Domain = *b.Shoot.ExternalClusterDomain
+		values.DNSProviderType = b.Shoot.ExternalDomain.Provider
+	}
+
+	if b.Config != nil && b.Config.CertificateService != nil {
+		values.ACME = certmanagement.ACMEValues{
+			Email:               b.Config.CertificateService.ACME.Email,
+			Server:              b.Config.CertificateService.ACME.Server,
+			PrecheckNameservers: b.Config.CertificateService.ACME.PrecheckNameservers,
+		}
+	}
+
+	return certmanagement.NewForShoot(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		b.SecretsManager,
+		values,
+	), nil
+}
+
+// ReconcileCertificateService deploys or destroys the certificate service depending on whether it is enabled for the
+// Shoot. The certificate service can only be deployed if it is configured for the gardenlet and if the Shoot uses a
+// domain managed by Gardener, since the DNS01 challenges are solved with the DNS provider of this domain.
+func (b *Botanist) ReconcileCertificateService(ctx context.Context) error {
+	if !v1beta1helper.IsCertificateServiceEnabled(b.Shoot.GetInfo().Spec.SystemComponents) {
+		return b.Shoot.Components.SystemComponents.CertificateService.Destroy(ctx)
+	}
+
+	if b.Config == nil || b.Config.CertificateService == nil {
+		return errors.New("the certificate service is not configured for the gardenlet responsible for this shoot")
+	}
+
+	if !b.NeedsExternalDNS() {
+		return errors.New("the certificate service requires a shoot domain managed by Gardener")
+	}
+
+	return b.Shoot.Components.SystemComponents.CertificateService.Deploy(ctx)
+}
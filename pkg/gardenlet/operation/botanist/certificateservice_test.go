@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("CertificateService", func() {
+	var (
+		ctrl     *gomock.Controller
+		botanist *Botanist
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		botanist = &Botanist{Operation: &operation.Operation{
+			Config: &config.GardenletConfiguration{
+				CertificateService: &config.CertificateService{
+					ACME: config.CertificateServiceACME{
+						Email:  "admin@example.com",
+						Server: "https://acme-v02.api.letsencrypt.org/directory",
+					},
+				},
+			},
+		}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				DNS: &gardencorev1beta1.DNS{
+					Domain: ptr.To("bar.foo.example.com"),
+				},
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.26.1",
+				},
+				SystemComponents: &gardencorev1beta1.SystemComponents{
+					CertificateService: &gardencorev1beta1.CertificateService{Enabled: true},
+				},
+			},
+		}
+		botanist.Shoot = &shootpkg.Shoot{
+			ExternalClusterDomain: ptr.To("bar.foo.example.com"),
+			ExternalDomain:        &gardenerutils.Domain{Domain: "foo.example.com", Provider: "aws-route53"},
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DefaultCertificateService", func() {
+		It("should successfully create a certificate service interface", func() {
+			kubernetesClient := kubernetesmock.NewMockInterface(ctrl)
+			kubernetesClient.EXPECT().Client()
+			kubernetesClient.EXPECT().Version()
+			botanist.SeedClientSet = kubernetesClient
+
+			certificateService, err := botanist.DefaultCertificateService()
+			Expect(certificateService).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#ReconcileCertificateService", func() {
+		var (
+			certificateService *mockcomponent.MockDeployWaiter
+
+			ctx     = context.TODO()
+			fakeErr = errors.New("fake err")
+		)
+
+		BeforeEach(func() {
+			certificateService = mockcomponent.NewMockDeployWaiter(ctrl)
+
+			botanist.Shoot.Components = &shootpkg.Components{
+				SystemComponents: &shootpkg.SystemComponents{
+					CertificateService: certificateService,
+				},
+			}
+		})
+
+		It("should fail when the deploy function fails", func() {
+			certificateService.EXPECT().Deploy(ctx).Return(fakeErr)
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should successfully deploy", func() {
+			certificateService.EXPECT().Deploy(ctx)
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(Succeed())
+		})
+
+		It("should fail if the certificate service is not configured for the gardenlet", func() {
+			botanist.Config.CertificateService = nil
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(MatchError(ContainSubstring("not configured")))
+		})
+
+		It("should fail if the shoot does not use a domain managed by Gardener", func() {
+			botanist.Shoot.ExternalDomain.Provider = "unmanaged"
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(MatchError(ContainSubstring("requires a shoot domain managed by Gardener")))
+		})
+
+		It("should successfully destroy if the certificate service is disabled for the shoot", func() {
+			shoot.Spec.SystemComponents.CertificateService.Enabled = false
+			botanist.Shoot.SetInfo(shoot)
+			certificateService.EXPECT().Destroy(ctx)
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(Succeed())
+		})
+
+		It("should fail when the destroy function fails", func() {
+			shoot.Spec.SystemComponents = nil
+			botanist.Shoot.SetInfo(shoot)
+			certificateService.EXPECT().Destroy(ctx).Return(fakeErr)
+
+			Expect(botanist.ReconcileCertificateService(ctx)).To(MatchError(fakeErr))
+		})
+	})
+})
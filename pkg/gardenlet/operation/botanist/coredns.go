@@ -37,6 +37,7 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		AutoscalingMode:                 gardencorev1beta1.CoreDNSAutoscalingModeHorizontal,
 		SearchPathRewriteCommonSuffixes: getCommonSuffixesForRewriting(b.Shoot.GetInfo().Spec.SystemComponents),
 		KubernetesVersion:               b.Shoot.KubernetesVersion,
+		ResourceTier:                    v1beta1helper.GetSystemComponentsResourceTier(b.Shoot.GetInfo().Spec.SystemComponents),
 		// Pod/node network CIDRs and cluster IPs are set on deployment to handle dynamic network CIDRs
 	}
 
@@ -44,6 +45,19 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		values.APIServerHost = ptr.To(b.outOfClusterAPIServerFQDN())
 	}
 
+	if systemComponents := b.Shoot.GetInfo().Spec.SystemComponents; systemComponents != nil && systemComponents.CoreDNS != nil {
+		values.Plugins = systemComponents.CoreDNS.Plugins
+		values.Cache = systemComponents.CoreDNS.Cache
+		values.ForwardZones = systemComponents.CoreDNS.ForwardZones
+
+		if autoscaling := systemComponents.CoreDNS.Autoscaling; autoscaling != nil {
+			values.MinReplicas = autoscaling.MinReplicas
+			values.MaxReplicas = autoscaling.MaxReplicas
+			values.CoresPerReplica = autoscaling.CoresPerReplica
+			values.NodesPerReplica = autoscaling.NodesPerReplica
+		}
+	}
+
 	if v1beta1helper.IsCoreDNSAutoscalingModeUsed(b.Shoot.GetInfo().Spec.SystemComponents, gardencorev1beta1.CoreDNSAutoscalingModeClusterProportional) {
 		image, err = imagevector.Containers().FindImage(imagevector.ContainerImageNameClusterProportionalAutoscaler, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 		if err != nil {
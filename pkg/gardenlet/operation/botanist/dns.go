@@ -22,6 +22,14 @@ func (b *Botanist) NeedsExternalDNS() bool {
 		b.Shoot.ExternalDomain.Provider != "unmanaged"
 }
 
+// NeedsSecondaryExternalDNS returns true if the Shoot cluster needs external DNS and has a secondary DNS provider
+// configured which can take over the management of the external DNS record.
+func (b *Botanist) NeedsSecondaryExternalDNS() bool {
+	return b.NeedsExternalDNS() &&
+		b.Shoot.SecondaryExternalDomain != nil &&
+		b.Shoot.SecondaryExternalDomain.Provider != "unmanaged"
+}
+
 // NeedsInternalDNS returns true if the Shoot cluster needs internal DNS.
 func (b *Botanist) NeedsInternalDNS() bool {
 	return b.Garden.InternalDomain != nil &&
@@ -38,4 +46,9 @@ func (b *Botanist) newDNSComponentsTargetingAPIServerAddress() {
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
 		b.Shoot.Components.Extensions.ExternalDNSRecord.SetValues([]string{b.APIServerAddress})
 	}
+
+	if b.NeedsSecondaryExternalDNS() {
+		b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(b.APIServerAddress))
+		b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.SetValues([]string{b.APIServerAddress})
+	}
 }
@@ -131,6 +131,39 @@ var _ = Describe("dns", func() {
 		})
 	})
 
+	Context("NeedsSecondaryExternalDNS", func() {
+		BeforeEach(func() {
+			b.Shoot.GetInfo().Spec.DNS = &gardencorev1beta1.DNS{Domain: ptr.To("foo")}
+			b.Shoot.ExternalClusterDomain = ptr.To("baz")
+			b.Shoot.ExternalDomain = &gardenerutils.Domain{Provider: "valid-provider"}
+		})
+
+		It("should be false when Shoot does not need external DNS", func() {
+			b.Shoot.ExternalDomain = nil
+			b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{Provider: "secondary-provider"}
+
+			Expect(b.NeedsSecondaryExternalDNS()).To(BeFalse())
+		})
+
+		It("should be false when Shoot SecondaryExternalDomain is nil", func() {
+			b.Shoot.SecondaryExternalDomain = nil
+
+			Expect(b.NeedsSecondaryExternalDNS()).To(BeFalse())
+		})
+
+		It("should be false when Shoot SecondaryExternalDomain provider is unmanaged", func() {
+			b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{Provider: "unmanaged"}
+
+			Expect(b.NeedsSecondaryExternalDNS()).To(BeFalse())
+		})
+
+		It("should be true when Shoot SecondaryExternalDomain provider is valid", func() {
+			b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{Provider: "secondary-provider"}
+
+			Expect(b.NeedsSecondaryExternalDNS()).To(BeTrue())
+		})
+	})
+
 	Context("NeedsInternalDNS", func() {
 		It("should be false when the internal domain is nil", func() {
 			b.Garden.InternalDomain = nil
@@ -199,5 +232,26 @@ var _ = Describe("dns", func() {
 
 			b.newDNSComponentsTargetingAPIServerAddress()
 		})
+
+		It("sets internal, external and secondary external DNSRecords", func() {
+			secondaryExternalDNSRecord := mockdnsrecord.NewMockInterface(ctrl)
+			b.Shoot.Components.Extensions.SecondaryExternalDNSRecord = secondaryExternalDNSRecord
+
+			b.Shoot.GetInfo().Spec.DNS = &gardencorev1beta1.DNS{Domain: ptr.To("foo")}
+			b.Shoot.InternalClusterDomain = "bar"
+			b.Shoot.ExternalClusterDomain = ptr.To("baz")
+			b.Shoot.ExternalDomain = &gardenerutils.Domain{Provider: "valid-provider"}
+			b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{Provider: "secondary-provider"}
+			b.Garden.InternalDomain = &gardenerutils.Domain{Provider: "valid-provider"}
+
+			externalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			externalDNSRecord.EXPECT().SetValues([]string{"1.2.3.4"})
+			secondaryExternalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			secondaryExternalDNSRecord.EXPECT().SetValues([]string{"1.2.3.4"})
+			internalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			internalDNSRecord.EXPECT().SetValues([]string{"1.2.3.4"})
+
+			b.newDNSComponentsTargetingAPIServerAddress()
+		})
 	})
 })
@@ -6,7 +6,12 @@ package botanist
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
@@ -44,6 +49,37 @@ func (b *Botanist) DefaultExternalDNSRecord() extensionsdnsrecord.Interface {
 	)
 }
 
+// DefaultSecondaryExternalDNSRecord creates the default deployer for the external DNSRecord resource managed by the
+// secondary DNS provider.
+func (b *Botanist) DefaultSecondaryExternalDNSRecord() extensionsdnsrecord.Interface {
+	values := &extensionsdnsrecord.Values{
+		Name:              b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalSecondaryName,
+		SecretName:        DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalSecondaryName,
+		Namespace:         b.Shoot.SeedNamespace,
+		TTL:               b.Config.Controllers.Shoot.DNSEntryTTLSeconds,
+		AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployDNSRecordExternal) || b.IsRestorePhase(),
+		IPStack:           gardenerutils.GetIPStackForShoot(b.Shoot.GetInfo()),
+	}
+
+	if b.NeedsSecondaryExternalDNS() {
+		values.Type = b.Shoot.SecondaryExternalDomain.Provider
+		if b.Shoot.SecondaryExternalDomain.Zone != "" {
+			values.Zone = &b.Shoot.SecondaryExternalDomain.Zone
+		}
+		values.SecretData = b.Shoot.SecondaryExternalDomain.SecretData
+		values.DNSName = gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)
+	}
+
+	return extensionsdnsrecord.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		values,
+		extensionsdnsrecord.DefaultInterval,
+		extensionsdnsrecord.DefaultSevereThreshold,
+		extensionsdnsrecord.DefaultTimeout,
+	)
+}
+
 // DefaultInternalDNSRecord creates the default deployer for the internal DNSRecord resource.
 func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 	values := &extensionsdnsrecord.Values{
@@ -78,11 +114,40 @@ func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 }
 
 // DeployOrDestroyExternalDNSRecord deploys, restores, or destroys the external DNSRecord and waits for the operation to complete.
+// If a secondary DNS provider is configured and the external DNSRecord managed by the primary DNS provider does not
+// become ready, the management of the record fails over to the secondary DNS provider. As soon as the primary DNS
+// provider is healthy again, the DNSRecord of the secondary DNS provider is removed.
 func (b *Botanist) DeployOrDestroyExternalDNSRecord(ctx context.Context) error {
-	if b.NeedsExternalDNS() {
-		return b.deployExternalDNSRecord(ctx)
+	if !b.NeedsExternalDNS() {
+		return b.DestroyExternalDNSRecord(ctx)
+	}
+
+	if !b.NeedsSecondaryExternalDNS() {
+		if err := b.deployExternalDNSRecord(ctx); err != nil {
+			return err
+		}
+		if err := b.destroySecondaryExternalDNSRecord(ctx); err != nil {
+			return err
+		}
+		return b.updateActiveDNSProvider(ctx, "")
 	}
-	return b.DestroyExternalDNSRecord(ctx)
+
+	primaryErr := b.deployExternalDNSRecord(ctx)
+	if primaryErr == nil {
+		if err := b.destroySecondaryExternalDNSRecord(ctx); err != nil {
+			return err
+		}
+		return b.updateActiveDNSProvider(ctx, gardencorev1beta1.DNSProviderRolePrimary)
+	}
+
+	b.Logger.Info("External DNSRecord of primary DNS provider is not ready, failing over to secondary DNS provider", "error", primaryErr.Error())
+	if err := b.deploySecondaryExternalDNSRecord(ctx); err != nil {
+		return errors.Join(
+			fmt.Errorf("failed deploying external DNSRecord of primary DNS provider: %w", primaryErr),
+			fmt.Errorf("failed deploying external DNSRecord of secondary DNS provider: %w", err),
+		)
+	}
+	return b.updateActiveDNSProvider(ctx, gardencorev1beta1.DNSProviderRoleSecondary)
 }
 
 // DeployOrDestroyInternalDNSRecord deploys, restores, or destroys the internal DNSRecord and waits for the operation to complete.
@@ -101,6 +166,41 @@ func (b *Botanist) deployExternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.ExternalDNSRecord.Wait(ctx)
 }
 
+// deploySecondaryExternalDNSRecord deploys or restores the external DNSRecord of the secondary DNS provider and waits
+// for the operation to complete.
+func (b *Botanist) deploySecondaryExternalDNSRecord(ctx context.Context) error {
+	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.SecondaryExternalDNSRecord); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.Wait(ctx)
+}
+
+// updateActiveDNSProvider updates the DNS status of the Shoot with the given active DNS provider role. An empty role
+// removes the DNS status.
+func (b *Botanist) updateActiveDNSProvider(ctx context.Context, role gardencorev1beta1.DNSProviderRole) error {
+	status := b.Shoot.GetInfo().Status.DNS
+	if (role == "" && status == nil) || (status != nil && status.ActiveProvider == role) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if role == "" {
+			shoot.Status.DNS = nil
+			return nil
+		}
+
+		if shoot.Status.DNS == nil {
+			shoot.Status.DNS = &gardencorev1beta1.ShootDNSStatus{}
+		}
+		// Initially the primary DNS provider is active, hence only switching from it is considered a failover.
+		if shoot.Status.DNS.ActiveProvider != "" || role != gardencorev1beta1.DNSProviderRolePrimary {
+			shoot.Status.DNS.LastFailoverTime = &metav1.Time{Time: NowFunc().UTC()}
+		}
+		shoot.Status.DNS.ActiveProvider = role
+		return nil
+	})
+}
+
 // deployInternalDNSRecord deploys or restores the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) deployInternalDNSRecord(ctx context.Context) error {
 	if err := b.deployOrRestoreDNSRecord(ctx, b.Shoot.Components.Extensions.InternalDNSRecord); err != nil {
@@ -109,12 +209,25 @@ func (b *Botanist) deployInternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.InternalDNSRecord.Wait(ctx)
 }
 
-// DestroyExternalDNSRecord destroys the external DNSRecord and waits for the operation to complete.
+// DestroyExternalDNSRecord destroys the external DNSRecords of the primary and secondary DNS providers and waits for
+// the operation to complete.
 func (b *Botanist) DestroyExternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.Destroy(ctx); err != nil {
 		return err
 	}
-	return b.Shoot.Components.Extensions.ExternalDNSRecord.WaitCleanup(ctx)
+	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.WaitCleanup(ctx); err != nil {
+		return err
+	}
+	return b.destroySecondaryExternalDNSRecord(ctx)
+}
+
+// destroySecondaryExternalDNSRecord destroys the external DNSRecord of the secondary DNS provider and waits for the
+// operation to complete.
+func (b *Botanist) destroySecondaryExternalDNSRecord(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.Destroy(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.WaitCleanup(ctx)
 }
 
 // DestroyInternalDNSRecord destroys the internal DNSRecord and waits for the operation to complete.
@@ -125,12 +238,19 @@ func (b *Botanist) DestroyInternalDNSRecord(ctx context.Context) error {
 	return b.Shoot.Components.Extensions.InternalDNSRecord.WaitCleanup(ctx)
 }
 
-// MigrateExternalDNSRecord migrates the external DNSRecord and waits for the operation to complete.
+// MigrateExternalDNSRecord migrates the external DNSRecords of the primary and secondary DNS providers and waits for
+// the operation to complete.
 func (b *Botanist) MigrateExternalDNSRecord(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.Migrate(ctx); err != nil {
 		return err
 	}
-	return b.Shoot.Components.Extensions.ExternalDNSRecord.WaitMigrate(ctx)
+	if err := b.Shoot.Components.Extensions.ExternalDNSRecord.WaitMigrate(ctx); err != nil {
+		return err
+	}
+	if err := b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.Migrate(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.SecondaryExternalDNSRecord.WaitMigrate(ctx)
 }
 
 // MigrateInternalDNSRecord migrates the internal DNSRecord and waits for the operation to complete.
@@ -54,6 +54,9 @@ const (
 	internalProvider = "internal-provider"
 	internalZone     = "internal-zone"
 
+	secondaryExternalProvider = "secondary-external-provider"
+	secondaryExternalZone     = "secondary-external-zone"
+
 	address       = "1.2.3.4"
 	ttl     int64 = 300
 )
@@ -67,8 +70,9 @@ var _ = Describe("dnsrecord", func() {
 		scheme *runtime.Scheme
 		c      client.Client
 
-		externalDNSRecord *mockdnsrecord.MockInterface
-		internalDNSRecord *mockdnsrecord.MockInterface
+		externalDNSRecord          *mockdnsrecord.MockInterface
+		secondaryExternalDNSRecord *mockdnsrecord.MockInterface
+		internalDNSRecord          *mockdnsrecord.MockInterface
 
 		b *Botanist
 
@@ -91,6 +95,7 @@ var _ = Describe("dnsrecord", func() {
 		c = fake.NewClientBuilder().WithScheme(scheme).Build()
 
 		externalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
+		secondaryExternalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 		internalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
 
 		cleanup = test.WithVar(&dnsrecord.TimeNow, func() time.Time { return now })
@@ -120,8 +125,9 @@ var _ = Describe("dnsrecord", func() {
 					InternalClusterDomain: internalDomain,
 					Components: &shoot.Components{
 						Extensions: &shoot.Extensions{
-							ExternalDNSRecord: externalDNSRecord,
-							InternalDNSRecord: internalDNSRecord,
+							ExternalDNSRecord:          externalDNSRecord,
+							SecondaryExternalDNSRecord: secondaryExternalDNSRecord,
+							InternalDNSRecord:          internalDNSRecord,
 						},
 					},
 				},
@@ -151,6 +157,12 @@ var _ = Describe("dnsrecord", func() {
 			},
 		})
 
+		b.GardenClient = fake.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(b.Shoot.GetInfo()).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			Build()
+
 		b.Seed.SetInfo(&gardencorev1beta1.Seed{
 			Status: gardencorev1beta1.SeedStatus{
 				ClusterIdentity: &seedClusterIdentity,
@@ -274,6 +286,48 @@ var _ = Describe("dnsrecord", func() {
 		})
 	})
 
+	Describe("#DefaultSecondaryExternalDNSRecord", func() {
+		It("should create a component with correct values", func() {
+			b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{
+				Domain:   externalDomain,
+				Provider: secondaryExternalProvider,
+				Zone:     secondaryExternalZone,
+				SecretData: map[string][]byte{
+					"secondary-foo": []byte("secondary-bar"),
+				},
+			}
+
+			r := b.DefaultSecondaryExternalDNSRecord()
+			r.SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+			r.SetValues([]string{address})
+
+			actual := r.GetValues()
+			Expect(actual).To(DeepEqual(&dnsrecord.Values{
+				Name:       b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalSecondaryName,
+				SecretName: DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalSecondaryName,
+				Namespace:  seedNamespace,
+				TTL:        ptr.To(ttl),
+				Type:       secondaryExternalProvider,
+				Zone:       ptr.To(secondaryExternalZone),
+				SecretData: map[string][]byte{
+					"secondary-foo": []byte("secondary-bar"),
+				},
+				DNSName:           "api." + externalDomain,
+				RecordType:        extensionsv1alpha1.DNSRecordTypeA,
+				Values:            []string{address},
+				AnnotateOperation: false,
+				IPStack:           "ipv4",
+			}))
+		})
+
+		It("should create a component without provider values if no secondary DNS provider is configured", func() {
+			actual := b.DefaultSecondaryExternalDNSRecord().GetValues()
+			Expect(actual.Name).To(Equal(b.Shoot.GetInfo().Name + "-" + v1beta1constants.DNSRecordExternalSecondaryName))
+			Expect(actual.Type).To(BeEmpty())
+			Expect(actual.SecretData).To(BeNil())
+		})
+	})
+
 	Describe("#DefaultInternalDNSRecord", func() {
 		It("should create a component with correct values", func() {
 			c := b.DefaultInternalDNSRecord()
@@ -387,6 +441,8 @@ var _ = Describe("dnsrecord", func() {
 			It("should call Deploy and Wait and succeed if they succeeded", func() {
 				externalDNSRecord.EXPECT().Deploy(ctx)
 				externalDNSRecord.EXPECT().Wait(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
 				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
 			})
 
@@ -396,6 +452,107 @@ var _ = Describe("dnsrecord", func() {
 			})
 		})
 
+		Context("failover", func() {
+			var fakeNow time.Time
+
+			BeforeEach(func() {
+				fakeNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+				DeferCleanup(test.WithVar(&NowFunc, func() time.Time { return fakeNow }))
+			})
+
+			JustBeforeEach(func() {
+				b.Shoot.SecondaryExternalDomain = &gardenerutils.Domain{
+					Domain:   externalDomain,
+					Provider: secondaryExternalProvider,
+				}
+			})
+
+			getDNSStatus := func() *gardencorev1beta1.ShootDNSStatus {
+				shoot := &gardencorev1beta1.Shoot{}
+				Expect(b.GardenClient.Get(ctx, client.ObjectKey{Name: shootName, Namespace: shootNamespace}, shoot)).To(Succeed())
+				return shoot.Status.DNS
+			}
+
+			It("should keep the primary DNS provider active if its DNSRecord is ready", func() {
+				externalDNSRecord.EXPECT().Deploy(ctx)
+				externalDNSRecord.EXPECT().Wait(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
+
+				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
+
+				status := getDNSStatus()
+				Expect(status).NotTo(BeNil())
+				Expect(status.ActiveProvider).To(Equal(gardencorev1beta1.DNSProviderRolePrimary))
+				Expect(status.LastFailoverTime).To(BeNil())
+				Expect(b.Shoot.GetInfo().Status.DNS).To(Equal(&gardencorev1beta1.ShootDNSStatus{ActiveProvider: gardencorev1beta1.DNSProviderRolePrimary}))
+			})
+
+			It("should fail over to the secondary DNS provider if the DNSRecord of the primary one is not ready", func() {
+				externalDNSRecord.EXPECT().Deploy(ctx)
+				externalDNSRecord.EXPECT().Wait(ctx).Return(testErr)
+				secondaryExternalDNSRecord.EXPECT().Deploy(ctx)
+				secondaryExternalDNSRecord.EXPECT().Wait(ctx)
+
+				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
+
+				status := getDNSStatus()
+				Expect(status).NotTo(BeNil())
+				Expect(status.ActiveProvider).To(Equal(gardencorev1beta1.DNSProviderRoleSecondary))
+				Expect(status.LastFailoverTime.Time).To(BeTemporally("==", fakeNow))
+			})
+
+			It("should fail if the DNSRecords of both DNS providers are not ready", func() {
+				externalDNSRecord.EXPECT().Deploy(ctx).Return(testErr)
+				secondaryExternalDNSRecord.EXPECT().Deploy(ctx)
+				secondaryExternalDNSRecord.EXPECT().Wait(ctx).Return(errors.New("secondary"))
+
+				err := b.DeployOrDestroyExternalDNSRecord(ctx)
+				Expect(err).To(MatchError(testErr))
+				Expect(err).To(MatchError(ContainSubstring("secondary")))
+				Expect(getDNSStatus()).To(BeNil())
+			})
+
+			It("should fail back to the primary DNS provider once its DNSRecord is ready again", func() {
+				failoverTime := metav1.NewTime(fakeNow.Add(-time.Hour))
+				Expect(b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+					shoot.Status.DNS = &gardencorev1beta1.ShootDNSStatus{
+						ActiveProvider:   gardencorev1beta1.DNSProviderRoleSecondary,
+						LastFailoverTime: &failoverTime,
+					}
+					return nil
+				})).To(Succeed())
+
+				externalDNSRecord.EXPECT().Deploy(ctx)
+				externalDNSRecord.EXPECT().Wait(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
+
+				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
+
+				status := getDNSStatus()
+				Expect(status).NotTo(BeNil())
+				Expect(status.ActiveProvider).To(Equal(gardencorev1beta1.DNSProviderRolePrimary))
+				Expect(status.LastFailoverTime.Time).To(BeTemporally("==", fakeNow))
+			})
+
+			It("should remove the DNS status if the secondary DNS provider is no longer configured", func() {
+				Expect(b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+					shoot.Status.DNS = &gardencorev1beta1.ShootDNSStatus{ActiveProvider: gardencorev1beta1.DNSProviderRolePrimary}
+					return nil
+				})).To(Succeed())
+				b.Shoot.SecondaryExternalDomain = nil
+
+				externalDNSRecord.EXPECT().Deploy(ctx)
+				externalDNSRecord.EXPECT().Wait(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
+
+				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
+				Expect(getDNSStatus()).To(BeNil())
+			})
+		})
+
 		Context("restore", func() {
 			var shootState = &gardencorev1beta1.ShootState{}
 
@@ -411,6 +568,8 @@ var _ = Describe("dnsrecord", func() {
 			It("should call Restore and Wait and succeed if they succeeded", func() {
 				externalDNSRecord.EXPECT().Restore(ctx, shootState)
 				externalDNSRecord.EXPECT().Wait(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
 				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
 			})
 
@@ -432,6 +591,8 @@ var _ = Describe("dnsrecord", func() {
 			It("should call Destroy and WaitCleanup and succeed if they succeeded", func() {
 				externalDNSRecord.EXPECT().Destroy(ctx)
 				externalDNSRecord.EXPECT().WaitCleanup(ctx)
+				secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+				secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
 				Expect(b.DeployOrDestroyExternalDNSRecord(ctx)).To(Succeed())
 			})
 
@@ -502,6 +663,8 @@ var _ = Describe("dnsrecord", func() {
 		It("should call Destroy and WaitCleanup and succeed if they succeeded", func() {
 			externalDNSRecord.EXPECT().Destroy(ctx)
 			externalDNSRecord.EXPECT().WaitCleanup(ctx)
+			secondaryExternalDNSRecord.EXPECT().Destroy(ctx)
+			secondaryExternalDNSRecord.EXPECT().WaitCleanup(ctx)
 			Expect(b.DestroyExternalDNSRecord(ctx)).To(Succeed())
 		})
 
@@ -528,6 +691,8 @@ var _ = Describe("dnsrecord", func() {
 		It("should call Migrate and WaitMigrate and succeed if they succeeded", func() {
 			externalDNSRecord.EXPECT().Migrate(ctx)
 			externalDNSRecord.EXPECT().WaitMigrate(ctx)
+			secondaryExternalDNSRecord.EXPECT().Migrate(ctx)
+			secondaryExternalDNSRecord.EXPECT().WaitMigrate(ctx)
 			Expect(b.MigrateExternalDNSRecord(ctx)).To(Succeed())
 		})
 
@@ -6,8 +6,12 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
@@ -96,6 +101,11 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			return err
 		}
 
+		backupProvider, err := b.backupProvider(ctx)
+		if err != nil {
+			return err
+		}
+
 		var (
 			backupLeaderElection         *config.ETCDBackupLeaderElection
 			deltaSnapshotRetentionPeriod *metav1.Duration
@@ -106,7 +116,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 		}
 
 		b.Shoot.Components.ControlPlane.EtcdMain.SetBackupConfig(&etcd.BackupConfig{
-			Provider:                     b.Seed.GetInfo().Spec.Backup.Provider,
+			Provider:                     backupProvider,
 			SecretRefName:                v1beta1constants.BackupSecretName,
 			Prefix:                       b.Shoot.BackupEntryName,
 			Container:                    string(secret.Data[v1beta1constants.DataKeyBackupBucketName]),
@@ -139,6 +149,45 @@ func (b *Botanist) WaitUntilEtcdsReady(ctx context.Context) error {
 	)(ctx)
 }
 
+// WaitUntilEtcdMainBackupReady waits until etcd-main reports its backups as ready and has uploaded a delta snapshot
+// after all its members were rolled out with the current backup configuration. After a backup bucket migration, this
+// verifies that the backups are stored in the new BackupBucket before the old one is released.
+func (b *Botanist) WaitUntilEtcdMainBackupReady(ctx context.Context) error {
+	if err := b.Shoot.Components.ControlPlane.EtcdMain.Wait(ctx); err != nil {
+		return err
+	}
+
+	rolledOutAt := time.Now()
+
+	return retry.UntilTimeout(ctx, 5*time.Second, 15*time.Minute, func(ctx context.Context) (bool, error) {
+		etcdMain, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx)
+		if err != nil {
+			return retry.SevereError(err)
+		}
+
+		var backupReady bool
+		for _, condition := range etcdMain.Status.Conditions {
+			if condition.Type == druidv1alpha1.ConditionTypeBackupReady {
+				backupReady = condition.Status == druidv1alpha1.ConditionTrue
+			}
+		}
+		if !backupReady {
+			return retry.MinorError(fmt.Errorf("backup of etcd %q is not reported as ready yet", etcdMain.Name))
+		}
+
+		lease := &coordinationv1.Lease{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: etcdMain.GetDeltaSnapshotLeaseName()}, lease); err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading delta snapshot lease of etcd %q: %w", etcdMain.Name, err))
+		}
+
+		if lease.Spec.RenewTime == nil || lease.Spec.RenewTime.Time.Before(rolledOutAt) {
+			return retry.MinorError(fmt.Errorf("etcd %q has not uploaded a delta snapshot with the current backup configuration yet", etcdMain.Name))
+		}
+
+		return retry.Ok()
+	})
+}
+
 // DestroyEtcd destroys the etcd main and events.
 func (b *Botanist) DestroyEtcd(ctx context.Context) error {
 	return flow.Parallel(
@@ -31,6 +31,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
@@ -333,6 +334,9 @@ var _ = Describe("Etcd", func() {
 						},
 					)
 				}
+				expectGetBackupEntry = func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: namespace + "--" + string(shootUID)}, gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupEntry{})).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
+				}
 				expectSetBackupConfig = func() {
 					etcdMain.EXPECT().SetBackupConfig(&etcd.BackupConfig{
 						Provider:             backupProvider,
@@ -366,6 +370,7 @@ var _ = Describe("Etcd", func() {
 				}
 
 				expectGetBackupSecret()
+				expectGetBackupEntry()
 				expectSetBackupConfig()
 				etcdMain.EXPECT().Deploy(ctx)
 				etcdEvents.EXPECT().Deploy(ctx)
@@ -404,6 +409,7 @@ var _ = Describe("Etcd", func() {
 
 					expectSetBackupConfig()
 					expectGetBackupSecret()
+					expectGetBackupEntry()
 				})
 
 				It("should properly restore multi-node etcd from backup if etcd main does not exist yet", func() {
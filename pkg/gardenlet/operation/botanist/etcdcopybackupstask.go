@@ -64,8 +64,13 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		return err
 	}
 
+	backupProvider, err := b.backupProvider(ctx)
+	if err != nil {
+		return err
+	}
+
 	sourceProvider := druidv1alpha1.StorageProvider(sourceBackupEntry.Spec.Type)
-	provider := druidv1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
+	provider := druidv1alpha1.StorageProvider(backupProvider)
 	sourceContainer := string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
 	container := string(secret.Data[v1beta1constants.DataKeyBackupBucketName])
 
@@ -82,5 +87,11 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		Container: &container,
 	})
 
+	if !b.IsRestorePhase() {
+		// In case of a backup bucket migration, etcd keeps running and does not take a final full snapshot, hence there
+		// is nothing to wait for.
+		b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetWaitForFinalSnapshot(nil)
+	}
+
 	return b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Deploy(ctx)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
@@ -123,6 +124,7 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdBackupSecret       *corev1.Secret
 			sourceEtcdBackupSecret *corev1.Secret
 			sourceBackupEntry      *extensionsv1alpha1.BackupEntry
+			backupEntry            *extensionsv1alpha1.BackupEntry
 
 			secretGroupResource      = schema.GroupResource{Resource: "Secrets"}
 			backupEntryGroupResource = schema.GroupResource{Resource: "BackupEntries"}
@@ -149,6 +151,16 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 					Namespace: namespace,
 				},
 			}
+			backupEntry = &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: backupEntryName,
+				},
+				Spec: extensionsv1alpha1.BackupEntrySpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						Type: "azure",
+					},
+				},
+			}
 			sourceBackupEntry = &extensionsv1alpha1.BackupEntry{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "source-" + backupEntryName,
@@ -166,13 +178,39 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 		})
 
 		It("should properly deploy EtcdCopyBackupsTask resource", func() {
+			botanist.Shoot.GetInfo().Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore}
+
 			etcdCopyBackupsTask.EXPECT().Destroy(ctx)
 			etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceBackupEntry), gomock.AssignableToTypeOf(sourceBackupEntry))
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceEtcdBackupSecret), gomock.AssignableToTypeOf(sourceEtcdBackupSecret))
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret))
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(backupEntry), gomock.AssignableToTypeOf(backupEntry)).Return(apierrors.NewNotFound(backupEntryGroupResource, backupEntry.Name))
 			etcdCopyBackupsTask.EXPECT().SetSourceStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
-			etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
+			etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{})).Do(func(store druidv1alpha1.StoreSpec) {
+				Expect(store.Provider).To(PointTo(Equal(druidv1alpha1.StorageProvider("gcp"))))
+			})
+			etcdCopyBackupsTask.EXPECT().Deploy(ctx)
+			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
+		})
+
+		It("should use the provider of the extension BackupEntry and not wait for a final snapshot in case of a backup bucket migration", func() {
+			etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+			etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceBackupEntry), gomock.AssignableToTypeOf(sourceBackupEntry))
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceEtcdBackupSecret), gomock.AssignableToTypeOf(sourceEtcdBackupSecret))
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret))
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(backupEntry), gomock.AssignableToTypeOf(backupEntry)).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					backupEntry.DeepCopyInto(obj.(*extensionsv1alpha1.BackupEntry))
+					return nil
+				},
+			)
+			etcdCopyBackupsTask.EXPECT().SetSourceStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
+			etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{})).Do(func(store druidv1alpha1.StoreSpec) {
+				Expect(store.Provider).To(PointTo(Equal(druidv1alpha1.StorageProvider("azure"))))
+			})
+			etcdCopyBackupsTask.EXPECT().SetWaitForFinalSnapshot(nil)
 			etcdCopyBackupsTask.EXPECT().Deploy(ctx)
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
 		})
@@ -218,8 +256,10 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceBackupEntry), gomock.AssignableToTypeOf(sourceBackupEntry))
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(sourceEtcdBackupSecret), gomock.AssignableToTypeOf(sourceEtcdBackupSecret))
 			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret))
+			c.EXPECT().Get(ctx, client.ObjectKeyFromObject(backupEntry), gomock.AssignableToTypeOf(backupEntry))
 			etcdCopyBackupsTask.EXPECT().SetSourceStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
 			etcdCopyBackupsTask.EXPECT().SetTargetStore(gomock.AssignableToTypeOf(druidv1alpha1.StoreSpec{}))
+			etcdCopyBackupsTask.EXPECT().SetWaitForFinalSnapshot(nil)
 			etcdCopyBackupsTask.EXPECT().Deploy(ctx).Return(fakeErr)
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(fakeErr))
 		})
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+)
+
+// bootstrapTokenPlaceholder is the placeholder in the user data of the 'provision' OperatingSystemConfig which is
+// replaced by the actual bootstrap token. For provisioned machines, this is done by machine-controller-manager.
+const bootstrapTokenPlaceholder = "<<BOOTSTRAP_TOKEN>>"
+
+// SyncExistingHostsBootstrapToGarden ensures a bootstrap token for every worker pool backed by existing hosts and
+// publishes the user data for registering hosts of these worker pools in the `<shoot-name>.host-bootstrap` secret in
+// the project namespace. The secret is deleted if the Shoot does not have such worker pools.
+func (b *Botanist) SyncExistingHostsBootstrapToGarden(ctx context.Context) error {
+	var (
+		oscs = b.Shoot.Components.Extensions.OperatingSystemConfig.WorkerPoolNameToOperatingSystemConfigsMap()
+		data = map[string][]byte{}
+	)
+
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if worker.ExistingHosts == nil {
+			continue
+		}
+
+		osc, ok := oscs[worker.Name]
+		if !ok || osc.Init.Content == "" {
+			return fmt.Errorf("operating system config for worker pool %q has not been generated yet", worker.Name)
+		}
+
+		validity := 24 * time.Hour
+		if worker.ExistingHosts.BootstrapTokenValidity != nil {
+			validity = worker.ExistingHosts.BootstrapTokenValidity.Duration
+		}
+
+		secret, err := bootstraptoken.ComputeBootstrapToken(ctx, b.ShootClientSet.Client(), existingHostsBootstrapTokenID(worker.Name), fmt.Sprintf("Used for registering existing hosts of worker pool %q", worker.Name), validity)
+		if err != nil {
+			return fmt.Errorf("failed computing bootstrap token for worker pool %q: %w", worker.Name, err)
+		}
+
+		data[worker.Name] = []byte(strings.ReplaceAll(osc.Init.Content, bootstrapTokenPlaceholder, bootstraptoken.FromSecretData(secret.Data)))
+	}
+
+	if len(data) == 0 {
+		return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixHostBootstrap)
+	}
+
+	return b.syncShootCredentialToGarden(
+		ctx,
+		gardenerutils.ShootProjectSecretSuffixHostBootstrap,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleHostBootstrap},
+		nil,
+		data,
+	)
+}
+
+// existingHostsBootstrapTokenID returns the ID of the bootstrap token used for registering existing hosts of the given
+// worker pool.
+func existingHostsBootstrapTokenID(workerPoolName string) string {
+	return utils.ComputeSHA256Hex([]byte("existing-hosts--" + workerPoolName))[:6]
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
+	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ExistingHosts", func() {
+	var (
+		ctrl                  *gomock.Controller
+		operatingSystemConfig *mockoperatingsystemconfig.MockInterface
+
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		shootClient  client.Client
+
+		botanist *Botanist
+		shoot    *gardencorev1beta1.Shoot
+		secret   *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		operatingSystemConfig = mockoperatingsystemconfig.NewMockInterface(ctrl)
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "machines"},
+						{Name: "hosts", ExistingHosts: &gardencorev1beta1.WorkerExistingHosts{}},
+					},
+				},
+			},
+		}
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient:   gardenClient,
+				ShootClientSet: kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(),
+				Shoot: &shootpkg.Shoot{
+					Components: &shootpkg.Components{
+						Extensions: &shootpkg.Extensions{
+							OperatingSystemConfig: operatingSystemConfig,
+						},
+					},
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bar.host-bootstrap", Namespace: "garden-foo"}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#SyncExistingHostsBootstrapToGarden", func() {
+		It("should publish the user data with a bootstrap token for worker pools with existing hosts", func() {
+			operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(map[string]*operatingsystemconfig.OperatingSystemConfigs{
+				"machines": {Init: operatingsystemconfig.Data{Content: "machines-<<BOOTSTRAP_TOKEN>>"}},
+				"hosts":    {Init: operatingsystemconfig.Data{Content: "hosts-<<BOOTSTRAP_TOKEN>>"}},
+			})
+
+			Expect(botanist.SyncExistingHostsBootstrapToGarden(ctx)).To(Succeed())
+
+			tokenList := &corev1.SecretList{}
+			Expect(shootClient.List(ctx, tokenList, client.InNamespace(metav1.NamespaceSystem))).To(Succeed())
+			Expect(tokenList.Items).To(HaveLen(1))
+			token := tokenList.Items[0]
+			Expect(token.Type).To(Equal(bootstraptokenapi.SecretTypeBootstrapToken))
+			Expect(string(token.Data[bootstraptokenapi.BootstrapTokenDescriptionKey])).To(Equal(`Used for registering existing hosts of worker pool "hosts"`))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			Expect(secret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "host-bootstrap"))
+			Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", "bar")))
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				"hosts": []byte("hosts-" + string(token.Data[bootstraptokenapi.BootstrapTokenIDKey]) + "." + string(token.Data[bootstraptokenapi.BootstrapTokenSecretKey])),
+			}))
+		})
+
+		It("should fail if the operating system config has not been generated yet", func() {
+			operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(map[string]*operatingsystemconfig.OperatingSystemConfigs{})
+
+			Expect(botanist.SyncExistingHostsBootstrapToGarden(ctx)).To(MatchError(ContainSubstring(`operating system config for worker pool "hosts" has not been generated yet`)))
+		})
+
+		It("should delete the secret if there are no worker pools with existing hosts", func() {
+			shoot.Spec.Provider.Workers = shoot.Spec.Provider.Workers[:1]
+			Expect(gardenClient.Create(ctx, secret)).To(Succeed())
+			operatingSystemConfig.EXPECT().WorkerPoolNameToOperatingSystemConfigsMap().Return(nil)
+
+			Expect(botanist.SyncExistingHostsBootstrapToGarden(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+		})
+	})
+})
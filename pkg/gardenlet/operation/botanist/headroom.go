@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/imagevector"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/autoscaling/headroom"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// DefaultWorkerHeadroom returns a deployer for the capacity headroom of the worker pools.
+func (b *Botanist) DefaultWorkerHeadroom() (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	var workerPools []headroom.WorkerPool
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if worker.Headroom == nil {
+			continue
+		}
+
+		workerPools = append(workerPools, headroom.WorkerPool{
+			Name:     worker.Name,
+			Headroom: *worker.Headroom,
+			Taints:   worker.Taints,
+		})
+	}
+
+	return headroom.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		headroom.Values{
+			Image:       image.String(),
+			WorkerPools: workerPools,
+		},
+	), nil
+}
+
+// ReconcileWorkerHeadroom deploys or destroys the capacity headroom depending on whether it is configured for any
+// worker pool of the Shoot.
+func (b *Botanist) ReconcileWorkerHeadroom(ctx context.Context) error {
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if worker.Headroom != nil && worker.Headroom.Replicas > 0 {
+			return b.Shoot.Components.SystemComponents.WorkerHeadroom.Deploy(ctx)
+		}
+	}
+
+	return b.Shoot.Components.SystemComponents.WorkerHeadroom.Destroy(ctx)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("WorkerHeadroom", func() {
+	var (
+		ctrl     *gomock.Controller
+		botanist *Botanist
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		botanist = &Botanist{Operation: &operation.Operation{}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.26.1",
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "without-headroom"},
+						{Name: "with-headroom", Headroom: &gardencorev1beta1.WorkerHeadroom{Replicas: 1, CPU: ptr.To(resource.MustParse("1"))}},
+					},
+				},
+			},
+		}
+		botanist.Shoot = &shootpkg.Shoot{}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DefaultWorkerHeadroom", func() {
+		It("should successfully create a worker headroom interface", func() {
+			kubernetesClient := kubernetesmock.NewMockInterface(ctrl)
+			kubernetesClient.EXPECT().Client()
+			botanist.SeedClientSet = kubernetesClient
+
+			workerHeadroom, err := botanist.DefaultWorkerHeadroom()
+			Expect(workerHeadroom).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#ReconcileWorkerHeadroom", func() {
+		var (
+			workerHeadroom *mockcomponent.MockDeployWaiter
+
+			ctx     = context.TODO()
+			fakeErr = errors.New("fake err")
+		)
+
+		BeforeEach(func() {
+			workerHeadroom = mockcomponent.NewMockDeployWaiter(ctrl)
+
+			botanist.Shoot.Components = &shootpkg.Components{
+				SystemComponents: &shootpkg.SystemComponents{
+					WorkerHeadroom: workerHeadroom,
+				},
+			}
+		})
+
+		It("should fail when the deploy function fails", func() {
+			workerHeadroom.EXPECT().Deploy(ctx).Return(fakeErr)
+
+			Expect(botanist.ReconcileWorkerHeadroom(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should successfully deploy if a worker pool has headroom configured", func() {
+			workerHeadroom.EXPECT().Deploy(ctx)
+
+			Expect(botanist.ReconcileWorkerHeadroom(ctx)).To(Succeed())
+		})
+
+		It("should successfully destroy if no worker pool has headroom configured", func() {
+			shoot.Spec.Provider.Workers[1].Headroom = nil
+			botanist.Shoot.SetInfo(shoot)
+			workerHeadroom.EXPECT().Destroy(ctx)
+
+			Expect(botanist.ReconcileWorkerHeadroom(ctx)).To(Succeed())
+		})
+
+		It("should successfully destroy if the headroom of all worker pools is zero", func() {
+			shoot.Spec.Provider.Workers[1].Headroom.Replicas = 0
+			botanist.Shoot.SetInfo(shoot)
+			workerHeadroom.EXPECT().Destroy(ctx)
+
+			Expect(botanist.ReconcileWorkerHeadroom(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// VerifyContainerImages verifies all container images which might be deployed for the shoot with the given verifier.
+// Images which are not applicable for the versions of the seed and the shoot are skipped.
+func (b *Botanist) VerifyContainerImages(ctx context.Context, verifier oci.ImageVerifier) error {
+	images := sets.New[string]()
+
+	for _, source := range imagevector.Containers() {
+		for _, runtimeVersion := range []string{b.SeedVersion(), b.ShootVersion()} {
+			image, err := imagevector.Containers().FindImage(source.Name, imagevectorutils.RuntimeVersion(runtimeVersion), imagevectorutils.TargetVersion(b.ShootVersion()))
+			if err != nil {
+				continue
+			}
+			images.Insert(image.String())
+		}
+	}
+
+	var errs []error
+	for _, image := range sets.List(images) {
+		if err := verifier.Verify(ctx, image); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return v1beta1helper.NewErrorWithCodes(errors.Join(errs...), gardencorev1beta1.ErrorImageVerificationFailed)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ImageVerification", func() {
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		botanist *Botanist
+		verifier *fakeImageVerifier
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		kubernetesClient := kubernetesmock.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().Version().Return("1.28.0").AnyTimes()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: kubernetesClient,
+			Shoot:         &shoot.Shoot{},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.28.2"},
+			},
+		})
+
+		verifier = &fakeImageVerifier{verified: sets.New[string]()}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#VerifyContainerImages", func() {
+		It("should verify all applicable container images", func() {
+			Expect(botanist.VerifyContainerImages(ctx, verifier)).To(Succeed())
+			Expect(verifier.verified.UnsortedList()).To(ContainElement("registry.k8s.io/kube-state-metrics/kube-state-metrics:v2.8.2"))
+		})
+
+		It("should return an error with code if an image cannot be verified", func() {
+			verifier.failing = "registry.k8s.io/kube-state-metrics/kube-state-metrics:v2.8.2"
+
+			err := botanist.VerifyContainerImages(ctx, verifier)
+			Expect(err).To(MatchError(ContainSubstring("kube-state-metrics")))
+
+			var errorWithCodes *v1beta1helper.ErrorWithCodes
+			Expect(errors.As(err, &errorWithCodes)).To(BeTrue())
+			Expect(errorWithCodes.Codes()).To(ConsistOf(gardencorev1beta1.ErrorImageVerificationFailed))
+		})
+	})
+})
+
+type fakeImageVerifier struct {
+	verified sets.Set[string]
+	failing  string
+}
+
+func (f *fakeImageVerifier) Verify(_ context.Context, image string) error {
+	if image == f.failing {
+		return errors.New("image " + image + " could not be verified")
+	}
+	f.verified.Insert(image)
+	return nil
+}
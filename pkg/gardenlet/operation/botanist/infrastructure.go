@@ -72,11 +72,13 @@ func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 
 		if len(b.Shoot.Components.Extensions.Infrastructure.ServicesCIDRs()) > 0 && len(b.Shoot.Components.Extensions.Infrastructure.PodsCIDRs()) > 0 {
 			if err := b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
-				shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{
-					Nodes:    nodesCIDRs,
-					Services: b.Shoot.Components.Extensions.Infrastructure.ServicesCIDRs(),
-					Pods:     b.Shoot.Components.Extensions.Infrastructure.PodsCIDRs(),
+				// Keep the other networking status fields, e.g., the initiation time of the dual-stack migration.
+				if shoot.Status.Networking == nil {
+					shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{}
 				}
+				shoot.Status.Networking.Nodes = nodesCIDRs
+				shoot.Status.Networking.Services = b.Shoot.Components.Extensions.Infrastructure.ServicesCIDRs()
+				shoot.Status.Networking.Pods = b.Shoot.Components.Extensions.Infrastructure.PodsCIDRs()
 				return nil
 			}); err != nil {
 				return err
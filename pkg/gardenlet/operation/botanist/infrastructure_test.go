@@ -189,6 +189,39 @@ var _ = Describe("Infrastructure", func() {
 			Expect(botanist.Shoot.GetInfo()).To(Equal(updatedShoot2))
 		})
 
+		It("should keep the dual-stack migration initiation time in the networking status", func() {
+			now := metav1.Now()
+			shoot := shoot.DeepCopy()
+			shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{DualStackMigrationInitiationTime: &now}
+			botanist.Shoot.SetInfo(shoot)
+
+			infrastructure.EXPECT().Wait(ctx)
+			infrastructure.EXPECT().NodesCIDRs().Return(nodesCIDRs)
+			infrastructure.EXPECT().PodsCIDRs().Return(podsCIDRs)
+			infrastructure.EXPECT().PodsCIDRs().Return(podsCIDRs)
+			infrastructure.EXPECT().ServicesCIDRs().Return(servicesCIDRs)
+			infrastructure.EXPECT().ServicesCIDRs().Return(servicesCIDRs)
+
+			updatedShoot := shoot.DeepCopy()
+			updatedShoot.Spec.Networking.Nodes = ptr.To(nodesCIDRs[0])
+			test.EXPECTPatch(ctx, gardenClient, updatedShoot, shoot, types.StrategicMergePatchType)
+
+			seedClientSet.EXPECT().Client().Return(seedClient)
+
+			updatedShoot2 := updatedShoot.DeepCopy()
+			updatedShoot2.Status.Networking = &gardencorev1beta1.NetworkingStatus{
+				Nodes:                            nodesCIDRs,
+				Pods:                             podsCIDRs,
+				Services:                         servicesCIDRs,
+				DualStackMigrationInitiationTime: &now,
+			}
+			gardenClient.EXPECT().Status().Return(mockStatusWriter)
+			test.EXPECTStatusPatch(ctx, mockStatusWriter, updatedShoot2, updatedShoot, types.StrategicMergePatchType)
+
+			Expect(botanist.WaitForInfrastructure(ctx)).To(Succeed())
+			Expect(botanist.Shoot.GetInfo()).To(Equal(updatedShoot2))
+		})
+
 		It("should successfully wait (w/o provider status, w/o nodes cidr)", func() {
 			infrastructure.EXPECT().Wait(ctx)
 			infrastructure.EXPECT().NodesCIDRs()
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// DataKeyInventory is the key in the inventory config map of a shoot which contains the CycloneDX document.
+	DataKeyInventory = "bom.cdx.json"
+
+	// InventoryScopeControlPlane is the scope of components running in the shoot namespace of the seed.
+	InventoryScopeControlPlane = "control-plane"
+	// InventoryScopeSystem is the scope of components running in the kube-system namespace of the shoot.
+	InventoryScopeSystem = "system"
+
+	inventoryPropertyScope = "gardener.cloud:scope"
+	inventoryPropertyImage = "gardener.cloud:image"
+)
+
+// SyncInventoryToGarden lists the containers currently running in the control plane of the shoot and in the
+// kube-system namespace of the shoot. It stores a CycloneDX document with their images and digests in the
+// `<shoot-name>.inventory` config map in the project namespace.
+func (b *Botanist) SyncInventoryToGarden(ctx context.Context) error {
+	components, err := inventoryComponents(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, InventoryScopeControlPlane)
+	if err != nil {
+		return fmt.Errorf("failed listing control plane components: %w", err)
+	}
+
+	if !b.Shoot.IsWorkerless {
+		systemComponents, err := inventoryComponents(ctx, b.ShootClientSet.Client(), metav1.NamespaceSystem, InventoryScopeSystem)
+		if err != nil {
+			return fmt.Errorf("failed listing system components: %w", err)
+		}
+		components = append(components, systemComponents...)
+	}
+
+	shoot := b.Shoot.GetInfo()
+	document, err := json.Marshal(cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Component: cycloneDXComponent{
+				Type:    "platform",
+				Name:    shoot.Namespace + "/" + shoot.Name,
+				Version: b.ShootVersion(),
+				Properties: []cycloneDXProperty{
+					{Name: "gardener.cloud:technical-id", Value: b.Shoot.SeedNamespace},
+					{Name: "gardener.cloud:gardener-version", Value: version.Get().GitVersion},
+				},
+			},
+		},
+		Components: components,
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.syncShootConfigMapToGarden(
+		ctx,
+		gardenerutils.ShootProjectConfigMapSuffixInventory,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleInventory},
+		nil,
+		map[string]string{DataKeyInventory: string(document)},
+	)
+}
+
+func inventoryComponents(ctx context.Context, c client.Client, namespace, scope string) ([]cycloneDXComponent, error) {
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var (
+		components = []cycloneDXComponent{}
+		seen       = map[string]struct{}{}
+	)
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		for _, status := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+			if status.ImageID == "" {
+				continue
+			}
+
+			key := status.Name + "|" + status.Image + "|" + status.ImageID
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			components = append(components, inventoryComponent(status, scope))
+		}
+	}
+
+	slices.SortFunc(components, func(a, b cycloneDXComponent) int {
+		return strings.Compare(a.BOMRef, b.BOMRef)
+	})
+
+	return components, nil
+}
+
+func inventoryComponent(status corev1.ContainerStatus, scope string) cycloneDXComponent {
+	repository, tag := splitImage(status.Image)
+
+	digest := status.ImageID
+	if i := strings.Index(digest, "://"); i >= 0 {
+		digest = digest[i+len("://"):]
+	}
+	if i := strings.LastIndex(digest, "@"); i >= 0 {
+		digest = digest[i+1:]
+	}
+
+	component := cycloneDXComponent{
+		BOMRef:  scope + "/" + status.Name + "/" + digest,
+		Type:    "container",
+		Name:    status.Name,
+		Version: tag,
+		Properties: []cycloneDXProperty{
+			{Name: inventoryPropertyScope, Value: scope},
+			{Name: inventoryPropertyImage, Value: status.Image},
+		},
+	}
+	if component.Version == "" {
+		component.Version = digest
+	}
+
+	if alg, content, ok := strings.Cut(digest, ":"); ok && alg == "sha256" {
+		component.Hashes = []cycloneDXHash{{Alg: "SHA-256", Content: content}}
+
+		purl := "pkg:oci/" + repository[strings.LastIndex(repository, "/")+1:] + "@" + strings.ReplaceAll(digest, ":", "%3A") + "?repository_url=" + url.QueryEscape(repository)
+		if tag != "" {
+			purl += "&tag=" + url.QueryEscape(tag)
+		}
+		component.PURL = purl
+	}
+
+	return component
+}
+
+// splitImage splits the given image reference into the repository and the tag. The digest is dropped if present.
+func splitImage(image string) (string, string) {
+	repository, _, _ := strings.Cut(image, "@")
+
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		return repository[:i], repository[i+1:]
+	}
+	return repository, ""
+}
+
+type cycloneDXDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Type       string              `json:"type"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Hashes     []cycloneDXHash     `json:"hashes,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
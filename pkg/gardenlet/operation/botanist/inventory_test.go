@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Inventory", func() {
+	const (
+		digestAPIServer = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		digestCoreDNS   = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		gardenNamespace = "garden-foo"
+		seedNamespace   = "shoot--foo--bar"
+
+		gardenClient client.Client
+		seedClient   client.Client
+		shootClient  client.Client
+
+		botanist *Botanist
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient:   gardenClient,
+				SeedClientSet:  kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+				ShootClientSet: kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(),
+				Shoot: &shootpkg.Shoot{
+					SeedNamespace: seedNamespace,
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: gardenNamespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+			},
+		})
+
+		for _, p := range []*corev1.Pod{
+			newInventoryPod(seedNamespace, "kube-apiserver-0", "kube-apiserver", "registry.k8s.io/kube-apiserver:v1.30.1", "registry.k8s.io/kube-apiserver@"+digestAPIServer, corev1.PodRunning),
+			newInventoryPod(seedNamespace, "kube-apiserver-1", "kube-apiserver", "registry.k8s.io/kube-apiserver:v1.30.1", "registry.k8s.io/kube-apiserver@"+digestAPIServer, corev1.PodRunning),
+			newInventoryPod(seedNamespace, "completed", "job", "registry.k8s.io/job:v1", "registry.k8s.io/job@"+digestAPIServer, corev1.PodSucceeded),
+			newInventoryPod(seedNamespace, "pending", "pending", "registry.k8s.io/pending:v1", "", corev1.PodPending),
+		} {
+			Expect(seedClient.Create(ctx, p)).To(Succeed())
+		}
+		Expect(shootClient.Create(ctx, newInventoryPod("kube-system", "coredns", "coredns", "registry.k8s.io/coredns/coredns@"+digestCoreDNS, "docker-pullable://registry.k8s.io/coredns/coredns@"+digestCoreDNS, corev1.PodRunning))).To(Succeed())
+	})
+
+	Describe("#SyncInventoryToGarden", func() {
+		It("should publish the inventory of the running components", func() {
+			Expect(botanist.SyncInventoryToGarden(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "bar.inventory"}, configMap)).To(Succeed())
+			Expect(configMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "inventory"))
+			Expect(configMap.OwnerReferences).To(ConsistOf(HaveField("Name", "bar")))
+
+			document := map[string]any{}
+			Expect(json.Unmarshal([]byte(configMap.Data["bom.cdx.json"]), &document)).To(Succeed())
+			Expect(document).To(HaveKeyWithValue("bomFormat", "CycloneDX"))
+			Expect(document).To(HaveKeyWithValue("metadata", HaveKeyWithValue("component", And(
+				HaveKeyWithValue("name", "garden-foo/bar"),
+				HaveKeyWithValue("version", "1.30.1"),
+			))))
+			Expect(document["components"]).To(Equal([]any{
+				map[string]any{
+					"bom-ref": "control-plane/kube-apiserver/" + digestAPIServer,
+					"type":    "container",
+					"name":    "kube-apiserver",
+					"version": "v1.30.1",
+					"purl":    "pkg:oci/kube-apiserver@sha256%3A1111111111111111111111111111111111111111111111111111111111111111?repository_url=registry.k8s.io%2Fkube-apiserver&tag=v1.30.1",
+					"hashes": []any{
+						map[string]any{"alg": "SHA-256", "content": "1111111111111111111111111111111111111111111111111111111111111111"},
+					},
+					"properties": []any{
+						map[string]any{"name": "gardener.cloud:scope", "value": "control-plane"},
+						map[string]any{"name": "gardener.cloud:image", "value": "registry.k8s.io/kube-apiserver:v1.30.1"},
+					},
+				},
+				map[string]any{
+					"bom-ref": "system/coredns/" + digestCoreDNS,
+					"type":    "container",
+					"name":    "coredns",
+					"version": digestCoreDNS,
+					"purl":    "pkg:oci/coredns@sha256%3A2222222222222222222222222222222222222222222222222222222222222222?repository_url=registry.k8s.io%2Fcoredns%2Fcoredns",
+					"hashes": []any{
+						map[string]any{"alg": "SHA-256", "content": "2222222222222222222222222222222222222222222222222222222222222222"},
+					},
+					"properties": []any{
+						map[string]any{"name": "gardener.cloud:scope", "value": "system"},
+						map[string]any{"name": "gardener.cloud:image", "value": "registry.k8s.io/coredns/coredns@" + digestCoreDNS},
+					},
+				},
+			}))
+		})
+
+		It("should not list the system components of workerless shoots", func() {
+			botanist.Shoot.IsWorkerless = true
+
+			Expect(botanist.SyncInventoryToGarden(ctx)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: "bar.inventory"}, configMap)).To(Succeed())
+			Expect(configMap.Data["bom.cdx.json"]).To(ContainSubstring("kube-apiserver"))
+			Expect(configMap.Data["bom.cdx.json"]).NotTo(ContainSubstring("coredns"))
+		})
+	})
+})
+
+func newInventoryPod(namespace, name, containerName, image, imageID string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    containerName,
+				Image:   image,
+				ImageID: imageID,
+			}},
+		},
+	}
+}
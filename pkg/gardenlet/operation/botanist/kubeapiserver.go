@@ -9,10 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,7 +48,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		// Pod/service/node network CIDRs are set on deployment to handle dynamic network CIDRs
 	}
 
-	return shared.NewKubeAPIServer(
+	kubeAPIServer, err := shared.NewKubeAPIServer(
 		ctx,
 		b.SeedClientSet,
 		b.GardenClient,
@@ -56,7 +58,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		b.Shoot.KubernetesVersion,
 		b.SecretsManager,
 		"",
-		b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer,
+		b.computeKubeAPIServerConfig(),
 		b.computeKubeAPIServerAutoscalingConfig(),
 		vpnConfig,
 		v1beta1constants.PriorityClassNameShootControlPlane500,
@@ -67,6 +69,42 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		nil,
 		nil,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeAPIServer.SetDebugMode(gardenerutils.IsShootDebugModeActive(b.Shoot.GetInfo(), clock.RealClock{}))
+	return kubeAPIServer, nil
+}
+
+// computeKubeAPIServerConfig returns the kube-apiserver configuration of the shoot. If the shoot does not configure an
+// audit policy, the ClusterAuditPolicy referenced by the seed is used as default.
+func (b *Botanist) computeKubeAPIServerConfig() *gardencorev1beta1.KubeAPIServerConfig {
+	config := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer
+
+	clusterAuditPolicyName := v1beta1helper.SeedSettingClusterAuditPolicyName(b.Seed.GetInfo().Spec.Settings)
+	if clusterAuditPolicyName == "" {
+		return config
+	}
+
+	if config != nil && config.AuditConfig != nil && config.AuditConfig.AuditPolicy != nil &&
+		(config.AuditConfig.AuditPolicy.ConfigMapRef != nil || config.AuditConfig.AuditPolicy.ClusterAuditPolicyName != nil) {
+		return config
+	}
+
+	config = config.DeepCopy()
+	if config == nil {
+		config = &gardencorev1beta1.KubeAPIServerConfig{}
+	}
+	if config.AuditConfig == nil {
+		config.AuditConfig = &gardencorev1beta1.AuditConfig{}
+	}
+	if config.AuditConfig.AuditPolicy == nil {
+		config.AuditConfig.AuditPolicy = &gardencorev1beta1.AuditPolicy{}
+	}
+	config.AuditConfig.AuditPolicy.ClusterAuditPolicyName = &clusterAuditPolicyName
+
+	return config
 }
 
 func (b *Botanist) computeKubeAPIServerAutoscalingConfig() apiserver.AutoscalingConfig {
@@ -309,8 +347,19 @@ func (b *Botanist) computeKubeAPIServerServiceAccountConfig(externalHostname str
 		if config == nil {
 			config = &gardencorev1beta1.ServiceAccountConfig{}
 		}
-		config.Issuer = ptr.To(fmt.Sprintf("https://%s/projects/%s/shoots/%s/issuer", *b.Shoot.ServiceAccountIssuerHostname, b.Garden.Project.Name, b.Shoot.GetInfo().ObjectMeta.UID))
-		jwksURI = ptr.To(fmt.Sprintf("https://%s/projects/%s/shoots/%s/issuer/jwks", *b.Shoot.ServiceAccountIssuerHostname, b.Garden.Project.Name, b.Shoot.GetInfo().ObjectMeta.UID))
+		issuerPath := fmt.Sprintf("/projects/%s/shoots/%s/issuer", b.Garden.Project.Name, b.Shoot.GetInfo().ObjectMeta.UID)
+		issuerHostname := *b.Shoot.ServiceAccountIssuerHostname
+
+		if customHostname := v1beta1helper.GetManagedIssuerHostname(b.Shoot.GetInfo()); customHostname != nil {
+			// Tokens issued before the custom hostname was configured must still be accepted.
+			if managedIssuer := "https://" + issuerHostname + issuerPath; !slices.Contains(config.AcceptedIssuers, managedIssuer) {
+				config.AcceptedIssuers = append(config.AcceptedIssuers, managedIssuer)
+			}
+			issuerHostname = *customHostname
+		}
+
+		config.Issuer = ptr.To("https://" + issuerHostname + issuerPath)
+		jwksURI = ptr.To("https://" + issuerHostname + issuerPath + "/jwks")
 	}
 
 	serviceAccountConfig := kubeapiserver.ComputeKubeAPIServerServiceAccountConfig(
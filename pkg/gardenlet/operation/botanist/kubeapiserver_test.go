@@ -18,6 +18,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -421,6 +422,50 @@ var _ = Describe("KubeAPIServer", func() {
 				),
 			)
 		})
+
+		Describe("AuditConfig", func() {
+			var seedAuditPolicy, shootAuditPolicy *gardencorev1beta1.ClusterAuditPolicy
+
+			BeforeEach(func() {
+				seedAuditPolicy = &gardencorev1beta1.ClusterAuditPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "seed-default"},
+					Spec:       gardencorev1beta1.ClusterAuditPolicySpec{Policy: runtime.RawExtension{Raw: []byte(`{"policy":"seed"}`)}},
+				}
+				shootAuditPolicy = &gardencorev1beta1.ClusterAuditPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot"},
+					Spec:       gardencorev1beta1.ClusterAuditPolicySpec{Policy: runtime.RawExtension{Raw: []byte(`{"policy":"shoot"}`)}},
+				}
+				Expect(gardenClient.Create(ctx, seedAuditPolicy)).To(Succeed())
+				Expect(gardenClient.Create(ctx, shootAuditPolicy)).To(Succeed())
+
+				seed := botanist.Seed.GetInfo()
+				seed.Spec.Settings = &gardencorev1beta1.SeedSettings{
+					AuditPolicy: &gardencorev1beta1.SeedSettingAuditPolicy{ClusterAuditPolicyName: seedAuditPolicy.Name},
+				}
+				botanist.Seed.SetInfo(seed)
+			})
+
+			It("should use the ClusterAuditPolicy of the seed if the shoot does not configure an audit policy", func() {
+				kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Audit.Policy).To(PointTo(Equal(`{"policy":"seed"}`)))
+				Expect(botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer).To(BeNil())
+			})
+
+			It("should use the audit policy of the shoot if configured", func() {
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+					AuditConfig: &gardencorev1beta1.AuditConfig{
+						AuditPolicy: &gardencorev1beta1.AuditPolicy{ClusterAuditPolicyName: &shootAuditPolicy.Name},
+					},
+				}
+				botanist.Shoot.SetInfo(shoot)
+
+				kubeAPIServer, err := botanist.DefaultKubeAPIServer(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Audit.Policy).To(PointTo(Equal(`{"policy":"shoot"}`)))
+			})
+		})
 	})
 
 	DescribeTable("#resourcesRequirementsForKubeAPIServerInBaselineMode",
@@ -604,6 +649,34 @@ var _ = Describe("KubeAPIServer", func() {
 						JWKSURI:               ptr.To("https://foo.bar.example.cloud/projects/test/shoots/some-uuid/issuer/jwks"),
 					},
 				),
+				Entry("should set managed issuer configuration with custom hostname",
+					func() {
+						botanist.Garden = &garden.Garden{
+							Project: &gardencorev1beta1.Project{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "test",
+								},
+							},
+						}
+						botanist.Shoot.ServiceAccountIssuerHostname = ptr.To("foo.bar.example.cloud")
+						botanist.Shoot.GetInfo().ObjectMeta.UID = "some-uuid"
+						botanist.Shoot.GetInfo().Annotations = map[string]string{
+							"authentication.gardener.cloud/issuer":          "managed",
+							"authentication.gardener.cloud/issuer-hostname": "issuer.example.com",
+						}
+						botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+							ServiceAccountConfig: &gardencorev1beta1.ServiceAccountConfig{
+								AcceptedIssuers: []string{"aa"},
+							},
+						}
+						DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootManagedIssuer, true))
+					},
+					kubeapiserver.ServiceAccountConfig{
+						Issuer:          "https://issuer.example.com/projects/test/shoots/some-uuid/issuer",
+						AcceptedIssuers: []string{"aa", "https://foo.bar.example.cloud/projects/test/shoots/some-uuid/issuer", "https://api.internal.foo.bar.com"},
+						JWKSURI:         ptr.To("https://issuer.example.com/projects/test/shoots/some-uuid/issuer/jwks"),
+					},
+				),
 				Entry("should not set managed issuer configuration because ShootManagedIssuer feature gate is disabled",
 					func() {
 						botanist.Garden = &garden.Garden{
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net"
 
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -100,11 +101,28 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 					Namespace: b.IstioNamespace(),
 					Labels:    b.IstioLabels(),
 				},
+				Limits: b.kubeAPIServerSNILimits(),
 			}
 		},
 	)
 }
 
+func (b *Botanist) kubeAPIServerSNILimits() *kubeapiserverexposure.Limits {
+	limits := b.IstioIngressGatewayLimits()
+	if limits == nil {
+		return nil
+	}
+
+	sniLimits := &kubeapiserverexposure.Limits{
+		MaxConnections:       limits.MaxConnections,
+		ConnectionsPerSecond: limits.ConnectionsPerSecond,
+	}
+	if limits.ConnectionBufferLimit != nil {
+		sniLimits.ConnectionBufferLimitBytes = ptr.To(limits.ConnectionBufferLimit.Value())
+	}
+	return sniLimits
+}
+
 // DefaultKubeAPIServerIngress returns a deployer for the kube-apiserver ingress.
 func (b *Botanist) DefaultKubeAPIServerIngress() component.Deployer {
 	return kubeapiserverexposure.NewIngress(
@@ -8,17 +8,20 @@ import (
 	"context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubecontrollermanager "github.com/gardener/gardener/pkg/component/kubernetes/controllermanager"
 	"github.com/gardener/gardener/pkg/component/shared"
+	"github.com/gardener/gardener/pkg/features"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // DefaultKubeControllerManager returns a deployer for the kube-controller-manager.
 func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interface, error) {
-	return shared.NewKubeControllerManager(
+	kubeControllerManager, err := shared.NewKubeControllerManager(
 		b.Logger,
 		b.SeedClientSet,
 		b.Shoot.SeedNamespace,
@@ -35,6 +38,13 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		kubecontrollermanager.ControllerSyncPeriods{},
 		nil,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeControllerManager.SetDebugMode(gardenerutils.IsShootDebugModeActive(b.Shoot.GetInfo(), clock.RealClock{}))
+	kubeControllerManager.SetServerSideApply(features.DefaultFeatureGate.Enabled(features.ServerSideApplyComponents))
+	return kubeControllerManager, nil
 }
 
 // DeployKubeControllerManager deploys the Kubernetes Controller Manager.
@@ -43,6 +43,7 @@ func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
 			FeatureGates: featureGates,
 			ImageAlpine:  imageAlpine.String(),
 			VPAEnabled:   b.Shoot.WantsVerticalPodAutoscaler,
+			ResourceTier: v1beta1helper.GetSystemComponentsResourceTier(b.Shoot.GetInfo().Spec.SystemComponents),
 		},
 	), nil
 }
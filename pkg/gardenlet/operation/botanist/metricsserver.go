@@ -8,6 +8,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/metricsserver"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -30,6 +31,7 @@ func (b *Botanist) DefaultMetricsServer() (component.DeployWaiter, error) {
 		VPAEnabled:        b.Shoot.WantsVerticalPodAutoscaler,
 		KubeAPIServerHost: kubeAPIServerHost,
 		KubernetesVersion: b.Shoot.KubernetesVersion,
+		ResourceTier:      v1beta1helper.GetSystemComponentsResourceTier(b.Shoot.GetInfo().Spec.SystemComponents),
 	}
 
 	return metricsserver.New(
@@ -10,6 +10,7 @@ import (
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,12 +70,16 @@ func (b *Botanist) runParallelTaskForEachComponent(ctx context.Context, componen
 	return flow.Parallel(fns...)(ctx)
 }
 
-// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds.
+// IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds or between BackupBuckets.
 func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
-	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
+	if b.Seed.GetInfo().Spec.Backup == nil {
 		return false, nil
 	}
 
+	if !b.IsRestorePhase() {
+		return b.isCopyOfBackupsRequiredForBackupBucketMigration(ctx)
+	}
+
 	// First we check if the etcd-main Etcd resource has been created. This is only true if backups have been copied.
 	if _, err := b.Shoot.Components.ControlPlane.EtcdMain.Get(ctx); client.IgnoreNotFound(err) != nil {
 		return false, err
@@ -108,6 +113,44 @@ func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+func (b *Botanist) isCopyOfBackupsRequiredForBackupBucketMigration(ctx context.Context) (bool, error) {
+	targetBucketName, ok := b.backupBucketMigrationTarget()
+	if !ok {
+		return false, nil
+	}
+
+	backupEntry, err := b.Shoot.Components.BackupEntry.Get(ctx)
+	if err != nil {
+		// If the BackupEntry does not exist yet, then there are no backups which need to be copied and the BackupEntry
+		// is directly created for the target BackupBucket.
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error while retrieving BackupEntry: %w", err)
+	}
+
+	// If the Shoot's BackupEntry has not been switched to the target BackupBucket yet, then copying the backups has not
+	// been started yet.
+	if backupEntry.Spec.BucketName != targetBucketName {
+		return true, nil
+	}
+
+	sourceBackupEntry, err := b.Shoot.Components.SourceBackupEntry.Get(ctx)
+	if err != nil {
+		// If the source BackupEntry is gone, then the migration was already completed.
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error while retrieving source BackupEntry: %w", err)
+	}
+
+	if sourceBackupEntry.Spec.BucketName == backupEntry.Spec.BucketName {
+		return false, fmt.Errorf("backups have not been copied and source and target backupentry point to the same bucket: %s. ", sourceBackupEntry.Spec.BucketName)
+	}
+
+	return true, nil
+}
+
 // IsRestorePhase returns true when the shoot is in phase 'restore'.
 func (b *Botanist) IsRestorePhase() bool {
 	return v1beta1helper.ShootHasOperationType(b.Shoot.GetInfo().Status.LastOperation, gardencorev1beta1.LastOperationTypeRestore)
@@ -21,6 +21,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mocketcd "github.com/gardener/gardener/pkg/component/etcd/etcd/mock"
@@ -337,6 +338,76 @@ var _ = Describe("migration", func() {
 				Expect(copyRequired).To(BeTrue())
 			})
 		})
+		Context("Last operation is not restore and a backup bucket migration was requested", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+				botanist.Shoot.GetInfo().Annotations = map[string]string{v1beta1constants.AnnotationShootBackupBucketMigrationTarget: "new-bucket"}
+			})
+
+			It("should return false if backupentry does not exist yet", func() {
+				backupEntry.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "backupentry"))
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return an error if backupentry retrieval fails", func() {
+				backupEntry.EXPECT().Get(ctx).Return(nil, fakeErr)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).To(MatchError(fakeErr))
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return true if backupentry.Spec.BucketName has not been switched to the target bucket", func() {
+				backupEntry.EXPECT().Get(ctx).Return(&gardencorev1beta1.BackupEntry{
+					Spec: gardencorev1beta1.BackupEntrySpec{
+						BucketName: "old-bucket",
+					},
+				}, nil)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeTrue())
+			})
+
+			Context("backupentry.Spec.BucketName is switched to the target bucket", func() {
+				BeforeEach(func() {
+					backupEntry.EXPECT().Get(ctx).Return(&gardencorev1beta1.BackupEntry{
+						Spec: gardencorev1beta1.BackupEntrySpec{
+							BucketName: "new-bucket",
+						},
+					}, nil)
+				})
+
+				It("should return false if source backupentry does not exist anymore", func() {
+					sourceBackupEntry.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "source-backupentry"))
+					copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(copyRequired).To(BeFalse())
+				})
+
+				It("should return an error if source backupentry and destination backupentry point to the same bucket", func() {
+					sourceBackupEntry.EXPECT().Get(ctx).Return(&gardencorev1beta1.BackupEntry{
+						Spec: gardencorev1beta1.BackupEntrySpec{
+							BucketName: "new-bucket",
+						},
+					}, nil)
+					copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+					Expect(err).To(HaveOccurred())
+					Expect(copyRequired).To(BeFalse())
+				})
+
+				It("should return true if source backupentry still points to the old bucket", func() {
+					sourceBackupEntry.EXPECT().Get(ctx).Return(&gardencorev1beta1.BackupEntry{
+						Spec: gardencorev1beta1.BackupEntrySpec{
+							BucketName: "old-bucket",
+						},
+					}, nil)
+					copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(copyRequired).To(BeTrue())
+				})
+			})
+		})
 	})
 
 	Describe("#IsRestorePhase", func() {
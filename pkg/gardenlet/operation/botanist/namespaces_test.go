@@ -23,6 +23,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
@@ -178,6 +179,23 @@ var _ = Describe("Namespaces", func() {
 			defaultExpectations("", 1)
 		})
 
+		It("should successfully deploy the namespace with the configured pod security levels", func() {
+			botanist.Config = &config.GardenletConfiguration{
+				PodSecurity: &config.PodSecurityConfiguration{
+					ShootNamespacesLevel: ptr.To("baseline"),
+					AuditLevel:           ptr.To("restricted"),
+				},
+			}
+
+			Expect(botanist.DeploySeedNamespace(ctx)).To(Succeed())
+
+			Expect(botanist.SeedNamespaceObject.Labels).To(And(
+				HaveKeyWithValue("pod-security.kubernetes.io/enforce", "baseline"),
+				HaveKeyWithValue("pod-security.kubernetes.io/audit", "restricted"),
+				HaveKeyWithValue("pod-security.kubernetes.io/warn", "restricted"),
+			))
+		})
+
 		It("should successfully deploy the namespace when seed has no zones", func() {
 			defaultSeedInfo.Spec.Provider.Zones = nil
 			botanist.Seed.SetInfo(defaultSeedInfo)
@@ -6,9 +6,14 @@ package botanist
 
 import (
 	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/network"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	netutils "github.com/gardener/gardener/pkg/utils/net"
 )
 
 // DefaultNetwork creates the default deployer for the Network custom resource.
@@ -37,8 +42,21 @@ func (b *Botanist) DefaultNetwork() network.Interface {
 // DeployNetwork deploys the Network custom resource and triggers the restore operation in case
 // the Shoot is in the restore phase of the control plane migration
 func (b *Botanist) DeployNetwork(ctx context.Context) error {
-	b.Shoot.Components.Extensions.Network.SetPodCIDRs(b.Shoot.Networks.Pods)
-	b.Shoot.Components.Extensions.Network.SetServiceCIDRs(b.Shoot.Networks.Services)
+	podCIDRs, serviceCIDRs := b.Shoot.Networks.Pods, b.Shoot.Networks.Services
+
+	// During the migration from IPv4 single-stack to dual-stack, the network plugin must only be switched to dual-stack
+	// once all nodes have been rolled and got pod CIDRs of both IP families assigned. Until then, it is kept on IPv4.
+	nodesMigrated, err := b.nodesMigratedToDualStack(ctx)
+	if err != nil {
+		return err
+	}
+	if !nodesMigrated {
+		b.Shoot.Components.Extensions.Network.SetIPFamilies([]extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})
+		podCIDRs, serviceCIDRs = netutils.FilterIPv4(podCIDRs), netutils.FilterIPv4(serviceCIDRs)
+	}
+
+	b.Shoot.Components.Extensions.Network.SetPodCIDRs(podCIDRs)
+	b.Shoot.Components.Extensions.Network.SetServiceCIDRs(serviceCIDRs)
 
 	if b.IsRestorePhase() {
 		return b.Shoot.Components.Extensions.Network.Restore(ctx, b.Shoot.GetShootState())
@@ -46,3 +64,30 @@ func (b *Botanist) DeployNetwork(ctx context.Context) error {
 
 	return b.Shoot.Components.Extensions.Network.Deploy(ctx)
 }
+
+// nodesMigratedToDualStack returns false if the migration of the shoot networking from IPv4 single-stack to dual-stack
+// was initiated and there are still nodes without pod CIDRs of both IP families. It returns true in all other cases.
+func (b *Botanist) nodesMigratedToDualStack(ctx context.Context) (bool, error) {
+	shoot := b.Shoot.GetInfo()
+	if shoot.Status.Networking == nil || shoot.Status.Networking.DualStackMigrationInitiationTime == nil ||
+		shoot.Spec.Networking == nil || len(shoot.Spec.Networking.IPFamilies) < 2 {
+		return true, nil
+	}
+
+	// There are no nodes if the shoot is hibernated and the client could not be initialized.
+	if b.ShootClientSet == nil {
+		return true, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := b.ShootClientSet.Client().List(ctx, nodeList); err != nil {
+		return false, fmt.Errorf("failed listing nodes of shoot %q: %w", shoot.Name, err)
+	}
+
+	for _, node := range nodeList.Items {
+		if !kubernetesutils.HasDualStackPodCIDRs(&node) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -12,8 +12,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mocknetwork "github.com/gardener/gardener/pkg/component/extensions/network/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -56,12 +63,55 @@ var _ = Describe("Network", func() {
 	})
 
 	Describe("#DeployNetwork", func() {
-		BeforeEach(func() {
-			network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
-			network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+		Context("dual-stack migration", func() {
+			var shootClient client.Client
+
+			BeforeEach(func() {
+				shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+				botanist.ShootClientSet = kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build()
+
+				botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Networking: &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}},
+					},
+					Status: gardencorev1beta1.ShootStatus{
+						Networking: &gardencorev1beta1.NetworkingStatus{DualStackMigrationInitiationTime: &metav1.Time{}},
+					},
+				})
+
+				Expect(shootClient.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+					Spec:       corev1.NodeSpec{PodCIDRs: []string{"10.0.0.0/26", "2001:db8:1::/80"}},
+				})).To(Succeed())
+			})
+
+			It("should deploy the network with IPv4 only if not all nodes were migrated", func() {
+				Expect(shootClient.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+					Spec:       corev1.NodeSpec{PodCIDRs: []string{"10.0.0.64/26"}},
+				})).To(Succeed())
+
+				network.EXPECT().SetIPFamilies([]extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods[:1])
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services[:1])
+				network.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployNetwork(ctx)).To(Succeed())
+			})
+
+			It("should deploy the network with dual-stack if all nodes were migrated", func() {
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+				network.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployNetwork(ctx)).To(Succeed())
+			})
 		})
 
 		Context("deploy", func() {
+			BeforeEach(func() {
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+			})
+
 			It("should deploy successfully", func() {
 				network.EXPECT().Deploy(ctx)
 				Expect(botanist.DeployNetwork(ctx)).To(Succeed())
@@ -75,6 +125,9 @@ var _ = Describe("Network", func() {
 
 		Context("restore", func() {
 			BeforeEach(func() {
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+
 				botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
 					Status: gardencorev1beta1.ShootStatus{
 						LastOperation: &gardencorev1beta1.LastOperation{
@@ -8,6 +8,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/nodeproblemdetector"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -24,6 +25,7 @@ func (b *Botanist) DefaultNodeProblemDetector() (component.DeployWaiter, error)
 		Image:             image.String(),
 		VPAEnabled:        b.Shoot.WantsVerticalPodAutoscaler,
 		KubernetesVersion: b.Shoot.KubernetesVersion,
+		ResourceTier:      v1beta1helper.GetSystemComponentsResourceTier(b.Shoot.GetInfo().Spec.SystemComponents),
 	}
 
 	if b.ShootUsesDNS() {
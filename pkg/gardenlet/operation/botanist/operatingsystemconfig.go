@@ -98,6 +98,9 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 	if shoot.Status.Credentials != nil {
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetCredentialsRotationStatus(shoot.Status.Credentials.Rotation)
 	}
+	if shoot.Status.Networking != nil {
+		b.Shoot.Components.Extensions.OperatingSystemConfig.SetDualStackMigrationInitiationTime(shoot.Status.Networking.DualStackMigrationInitiationTime)
+	}
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
 		sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
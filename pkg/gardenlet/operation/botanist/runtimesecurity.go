@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/observability/runtimesecurity"
+	"github.com/gardener/gardener/pkg/features"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// DefaultRuntimeSecurity returns a deployer for the runtime security agent.
+func (b *Botanist) DefaultRuntimeSecurity() (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameFalco, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	return runtimesecurity.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		runtimesecurity.Values{
+			Image:      image.String(),
+			VPAEnabled: b.Shoot.WantsVerticalPodAutoscaler,
+		},
+	), nil
+}
+
+// ReconcileRuntimeSecurity deploys or destroys the runtime security agent depending on whether it is enabled for the
+// Shoot and whether the RuntimeSecurity feature gate is enabled.
+func (b *Botanist) ReconcileRuntimeSecurity(ctx context.Context) error {
+	if !features.DefaultFeatureGate.Enabled(features.RuntimeSecurity) || !v1beta1helper.IsRuntimeSecurityEnabled(b.Shoot.GetInfo().Spec.SystemComponents) {
+		return b.Shoot.Components.SystemComponents.RuntimeSecurity.Destroy(ctx)
+	}
+
+	return b.Shoot.Components.SystemComponents.RuntimeSecurity.Deploy(ctx)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("RuntimeSecurity", func() {
+	var (
+		ctrl     *gomock.Controller
+		botanist *Botanist
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		botanist = &Botanist{Operation: &operation.Operation{}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.26.1",
+				},
+				SystemComponents: &gardencorev1beta1.SystemComponents{
+					RuntimeSecurity: &gardencorev1beta1.RuntimeSecurity{Enabled: true},
+				},
+			},
+		}
+		botanist.Shoot = &shootpkg.Shoot{}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DefaultRuntimeSecurity", func() {
+		It("should successfully create a runtime security interface", func() {
+			kubernetesClient := kubernetesmock.NewMockInterface(ctrl)
+			kubernetesClient.EXPECT().Client()
+			botanist.SeedClientSet = kubernetesClient
+
+			runtimeSecurity, err := botanist.DefaultRuntimeSecurity()
+			Expect(runtimeSecurity).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#ReconcileRuntimeSecurity", func() {
+		var (
+			runtimeSecurity *mockcomponent.MockDeployWaiter
+
+			ctx     = context.TODO()
+			fakeErr = errors.New("fake err")
+		)
+
+		BeforeEach(func() {
+			runtimeSecurity = mockcomponent.NewMockDeployWaiter(ctrl)
+
+			botanist.Shoot.Components = &shootpkg.Components{
+				SystemComponents: &shootpkg.SystemComponents{
+					RuntimeSecurity: runtimeSecurity,
+				},
+			}
+		})
+
+		Context("feature gate enabled", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.RuntimeSecurity, true))
+			})
+
+			It("should fail when the deploy function fails", func() {
+				runtimeSecurity.EXPECT().Deploy(ctx).Return(fakeErr)
+
+				Expect(botanist.ReconcileRuntimeSecurity(ctx)).To(MatchError(fakeErr))
+			})
+
+			It("should successfully deploy", func() {
+				runtimeSecurity.EXPECT().Deploy(ctx)
+
+				Expect(botanist.ReconcileRuntimeSecurity(ctx)).To(Succeed())
+			})
+
+			It("should successfully destroy if runtime security is disabled for the shoot", func() {
+				shoot.Spec.SystemComponents.RuntimeSecurity.Enabled = false
+				botanist.Shoot.SetInfo(shoot)
+				runtimeSecurity.EXPECT().Destroy(ctx)
+
+				Expect(botanist.ReconcileRuntimeSecurity(ctx)).To(Succeed())
+			})
+		})
+
+		Context("feature gate disabled", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.RuntimeSecurity, false))
+			})
+
+			It("should fail when the destroy function fails", func() {
+				runtimeSecurity.EXPECT().Destroy(ctx).Return(fakeErr)
+
+				Expect(botanist.ReconcileRuntimeSecurity(ctx)).To(MatchError(fakeErr))
+			})
+
+			It("should successfully destroy", func() {
+				runtimeSecurity.EXPECT().Destroy(ctx)
+
+				Expect(botanist.ReconcileRuntimeSecurity(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -473,6 +473,7 @@ func (b *Botanist) DeployCloudProviderSecret(ctx context.Context) error {
 		data                  map[string][]byte
 		additionalLabels      map[string]string
 		additionalAnnotations map[string]string
+		workloadIdentity      bool
 	)
 	switch credentials := b.Shoot.Credentials.(type) {
 	case *securityv1alpha1.WorkloadIdentity:
@@ -481,7 +482,7 @@ func (b *Botanist) DeployCloudProviderSecret(ctx context.Context) error {
 			return err
 		}
 		data = map[string][]byte{
-			"config": providerConfigRaw,
+			securityv1alpha1constants.DataKeyConfig: providerConfigRaw,
 		}
 		shootInfo := b.Shoot.GetInfo()
 		shootMeta := securityv1alpha1.ContextObject{
@@ -504,6 +505,7 @@ func (b *Botanist) DeployCloudProviderSecret(ctx context.Context) error {
 			securityv1alpha1constants.LabelPurpose:                  securityv1alpha1constants.LabelPurposeWorkloadIdentityTokenRequestor,
 			securityv1alpha1constants.LabelWorkloadIdentityProvider: credentials.Spec.TargetSystem.Type,
 		}
+		workloadIdentity = true
 	case *corev1.Secret:
 		data = credentials.Data
 	default:
@@ -511,6 +513,19 @@ func (b *Botanist) DeployCloudProviderSecret(ctx context.Context) error {
 	}
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), secret, func() error {
+		// Keep the token cached by the workload identity token requestor as long as it was issued for the same
+		// workload identity, it is renewed by the token requestor before it expires.
+		if workloadIdentity &&
+			secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityNamespace] == additionalAnnotations[securityv1alpha1constants.AnnotationWorkloadIdentityNamespace] &&
+			secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityName] == additionalAnnotations[securityv1alpha1constants.AnnotationWorkloadIdentityName] {
+			if token, ok := secret.Data[securityv1alpha1constants.DataKeyToken]; ok {
+				data[securityv1alpha1constants.DataKeyToken] = token
+			}
+			if renewTimestamp, ok := secret.Annotations[securityv1alpha1constants.AnnotationWorkloadIdentityTokenRenewTimestamp]; ok {
+				additionalAnnotations[securityv1alpha1constants.AnnotationWorkloadIdentityTokenRenewTimestamp] = renewTimestamp
+			}
+		}
+
 		secret.Annotations = additionalAnnotations
 		secret.Labels = utils.MergeStringMaps(map[string]string{
 			v1beta1constants.GardenerPurpose: v1beta1constants.SecretNameCloudProvider,
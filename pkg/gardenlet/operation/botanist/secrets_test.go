@@ -177,6 +177,65 @@ var _ = Describe("Secrets", func() {
 			}))
 		})
 
+		Context("cached workload identity token", func() {
+			var existing *corev1.Secret
+
+			BeforeEach(func() {
+				botanist.Shoot.Credentials = &securityv1alpha1.WorkloadIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "wi-name",
+						Namespace: "wi-namespace",
+					},
+					Spec: securityv1alpha1.WorkloadIdentitySpec{
+						TargetSystem: securityv1alpha1.TargetSystem{
+							Type:           "some-provider",
+							ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"raw":"raw"}`)},
+						},
+					},
+				}
+
+				existing = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: seedNamespace,
+						Name:      "cloudprovider",
+						Annotations: map[string]string{
+							"workloadidentity.security.gardener.cloud/namespace":             "wi-namespace",
+							"workloadidentity.security.gardener.cloud/name":                  "wi-name",
+							"workloadidentity.security.gardener.cloud/token-renew-timestamp": "2024-01-01T00:48:00Z",
+						},
+					},
+					Data: map[string][]byte{
+						"config": []byte(`{"old":"old"}`),
+						"token":  []byte("some-token"),
+					},
+				}
+			})
+
+			It("should keep the token if it was issued for the same workload identity", func() {
+				Expect(botanist.SeedClientSet.Client().Create(ctx, existing)).To(Succeed())
+				Expect(botanist.DeployCloudProviderSecret(ctx)).To(Succeed())
+
+				retrieved := &corev1.Secret{}
+				Expect(botanist.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(existing), retrieved)).To(Succeed())
+				Expect(retrieved.Data).To(Equal(map[string][]byte{
+					"config": []byte(`{"raw":"raw"}`),
+					"token":  []byte("some-token"),
+				}))
+				Expect(retrieved.Annotations).To(HaveKeyWithValue("workloadidentity.security.gardener.cloud/token-renew-timestamp", "2024-01-01T00:48:00Z"))
+			})
+
+			It("should drop the token if it was issued for another workload identity", func() {
+				existing.Annotations["workloadidentity.security.gardener.cloud/name"] = "other"
+				Expect(botanist.SeedClientSet.Client().Create(ctx, existing)).To(Succeed())
+				Expect(botanist.DeployCloudProviderSecret(ctx)).To(Succeed())
+
+				retrieved := &corev1.Secret{}
+				Expect(botanist.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(existing), retrieved)).To(Succeed())
+				Expect(retrieved.Data).To(Equal(map[string][]byte{"config": []byte(`{"raw":"raw"}`)}))
+				Expect(retrieved.Annotations).NotTo(HaveKey("workloadidentity.security.gardener.cloud/token-renew-timestamp"))
+			})
+		})
+
 		It("should return error when shoot credentials are of unknown type", func() {
 			botanist.Shoot.Credentials = &corev1.Pod{}
 			Expect(botanist.DeployCloudProviderSecret(ctx)).To(MatchError(Equal("unexpected type *v1.Pod, should be either Secret or WorkloadIdentity")))
@@ -7,6 +7,7 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -23,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/worker"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/nodeagent"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/retry"
@@ -39,7 +41,7 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 			Name:                b.Shoot.GetInfo().Name,
 			Type:                b.Shoot.GetInfo().Spec.Provider.Type,
 			Region:              b.Shoot.GetInfo().Spec.Region,
-			Workers:             b.Shoot.GetInfo().Spec.Provider.Workers,
+			Workers:             provisionedWorkerPools(b.Shoot.GetInfo().Spec.Provider.Workers),
 			KubernetesVersion:   b.Shoot.KubernetesVersion,
 			MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
 			NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
@@ -50,6 +52,14 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 	)
 }
 
+// provisionedWorkerPools returns the worker pools whose machines are provisioned by Gardener. Worker pools backed by
+// existing hosts are not part of the Worker custom resource.
+func provisionedWorkerPools(workers []gardencorev1beta1.Worker) []gardencorev1beta1.Worker {
+	return slices.DeleteFunc(slices.Clone(workers), func(worker gardencorev1beta1.Worker) bool {
+		return worker.ExistingHosts != nil
+	})
+}
+
 // DeployWorker deploys the Worker custom resource and triggers the restore operation in case
 // the Shoot is in the restore phase of the control plane migration
 func (b *Botanist) DeployWorker(ctx context.Context) error {
@@ -141,7 +151,9 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 			}
 
 			if nodeChecksum, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; nodeChecksum != secretChecksum {
-				if !ok {
+				if condition := nodeagent.GetInPlaceUpdateCondition(&node); condition != nil && condition.Reason == nodeagentv1alpha1.ReasonInPlaceUpdateFailed {
+					result = multierror.Append(result, fmt.Errorf("the in-place update of node %q failed: %s", node.Name, condition.Message))
+				} else if !ok {
 					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
 				} else {
 					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum))
@@ -299,6 +299,24 @@ var _ = Describe("Worker", func() {
 			}},
 			MatchError(ContainSubstring("is outdated")),
 		),
+		Entry("in-place update failed",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+					Labels: map[string]string{
+						"worker.gardener.cloud/kubernetes-version":              "1.24.0",
+						"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0",
+					},
+				},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "InPlaceUpdate", Status: corev1.ConditionFalse, Reason: "Failed", Message: "failed starting containerd"}}},
+			}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			MatchError(ContainSubstring("the in-place update of node \"\" failed: failed starting containerd")),
+		),
 		Entry("skip node marked by MCM for termination",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}},
 			map[string][]corev1.Node{"pool1": {{
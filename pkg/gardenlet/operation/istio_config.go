@@ -9,6 +9,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
@@ -56,6 +57,19 @@ func (o *Operation) DefaultIstioLabels() map[string]string {
 	return o.istioLabels(nil)
 }
 
+// IstioIngressGatewayLimits returns the limits for the traffic of the shoot cluster to its kube-apiserver through the
+// istio ingress gateway. Limits configured for the purpose of the shoot replace the default limits.
+func (o *Operation) IstioIngressGatewayLimits() *gardenletconfig.SNIShootLimits {
+	limits := o.sniConfig().Limits
+	if limits == nil {
+		return nil
+	}
+	if purposeLimits, ok := limits.Purposes[gardencore.ShootPurpose(o.Shoot.Purpose)]; ok {
+		return &purposeLimits
+	}
+	return limits.Default
+}
+
 func (o *Operation) istioLabels(zone *string) map[string]string {
 	if exposureClassHandler := o.exposureClassHandler(); exposureClassHandler != nil {
 		return sharedcomponent.GetIstioZoneLabels(gardenerutils.GetMandatoryExposureClassHandlerSNILabels(exposureClassHandler.SNI.Ingress.Labels, exposureClassHandler.Name), zone)
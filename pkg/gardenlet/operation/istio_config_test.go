@@ -10,7 +10,9 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
@@ -200,5 +202,47 @@ var _ = Describe("istioconfig", func() {
 				),
 			)
 		})
+
+		Describe("#IstioIngressGatewayLimits", func() {
+			var (
+				defaultLimits    = config.SNIShootLimits{MaxConnections: ptr.To[int32](1000)}
+				evaluationLimits = config.SNIShootLimits{ConnectionsPerSecond: ptr.To[int32](10)}
+				exposureLimits   = config.SNIShootLimits{MaxConnections: ptr.To[int32](200)}
+			)
+
+			BeforeEach(func() {
+				operation.Config = gardenletConfig.DeepCopy()
+				operation.Config.SNI.Limits = &config.SNILimits{
+					Default:  &defaultLimits,
+					Purposes: map[gardencore.ShootPurpose]config.SNIShootLimits{gardencore.ShootPurposeEvaluation: evaluationLimits},
+				}
+				operation.Config.ExposureClassHandlers[0].SNI.Limits = &config.SNILimits{Default: &exposureLimits}
+			})
+
+			It("should return nil if no limits are configured", func() {
+				operation.Config.SNI.Limits = nil
+
+				Expect(operation.IstioIngressGatewayLimits()).To(BeNil())
+			})
+
+			It("should return the default limits", func() {
+				operation.Shoot.Purpose = gardencorev1beta1.ShootPurposeProduction
+
+				Expect(operation.IstioIngressGatewayLimits()).To(Equal(&defaultLimits))
+			})
+
+			It("should return the limits for the purpose of the shoot", func() {
+				operation.Shoot.Purpose = gardencorev1beta1.ShootPurposeEvaluation
+
+				Expect(operation.IstioIngressGatewayLimits()).To(Equal(&evaluationLimits))
+			})
+
+			It("should return the limits of the exposure class handler", func() {
+				operation.Shoot.Purpose = gardencorev1beta1.ShootPurposeEvaluation
+				operation.Shoot.ExposureClass = exposureClass
+
+				Expect(operation.IstioIngressGatewayLimits()).To(Equal(&exposureLimits))
+			})
+		})
 	})
 })
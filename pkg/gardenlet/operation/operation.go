@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
@@ -15,6 +16,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -103,6 +106,13 @@ func (b *Builder) WithGardenClusterIdentity(gardenClusterIdentity string) *Build
 	return b
 }
 
+// WithGardenInformers sets the informers which are used to invalidate the Secrets and ConfigMaps memoized by the
+// Operation.
+func (b *Builder) WithGardenInformers(gardenInformers cache.Informers) *Builder {
+	b.gardenInformers = gardenInformers
+	return b
+}
+
 // WithLogger sets the loggerFunc attribute at the Builder.
 func (b *Builder) WithLogger(log logr.Logger) *Builder {
 	b.loggerFunc = func() (logr.Logger, error) { return log, nil }
@@ -168,6 +178,10 @@ func (b *Builder) WithShootFromCluster(gardenClient client.Client, seedClientSet
 	return b
 }
 
+// gardenReadThroughTTL is the duration for which Secrets and ConfigMaps read from the garden cluster are memoized by
+// an Operation if they cannot be watched.
+const gardenReadThroughTTL = time.Minute
+
 // Build initializes a new Operation object.
 func (b *Builder) Build(
 	ctx context.Context,
@@ -178,6 +192,12 @@ func (b *Builder) Build(
 	*Operation,
 	error,
 ) {
+	// Secrets and ConfigMaps outside the seed namespace are not cached by gardenlet, i.e., they are read from the API
+	// server on every access. Memoize them for the duration of the operation to reduce the number of requests. The
+	// memoized objects are invalidated as soon as the garden informers observe a change until the given context is
+	// cancelled.
+	gardenClient = kubernetes.NewReadThroughClient(ctx, gardenClient, b.gardenInformers, clock.RealClock{}, gardenReadThroughTTL)
+
 	operation := &Operation{
 		GardenClient:   gardenClient,
 		SeedClientSet:  seedClientSet,
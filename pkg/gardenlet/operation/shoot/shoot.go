@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,7 +18,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -33,6 +33,7 @@ import (
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 // NewBuilder returns a new Builder.
@@ -259,6 +260,12 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.ExternalDomain = externalDomain
 
+	secondaryExternalDomain, err := gardenerutils.ConstructSecondaryExternalDomain(ctx, c, shootObject)
+	if err != nil {
+		return nil, err
+	}
+	shoot.SecondaryExternalDomain = secondaryExternalDomain
+
 	// Store the Kubernetes version in the format <major>.<minor> on the Shoot object.
 	kubernetesVersion, err := semver.NewVersion(shootObject.Spec.Kubernetes.Version)
 	if err != nil {
@@ -336,6 +343,9 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 		if err := c.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
 			return nil, err
 		}
+		if err := shootstate.Decrypt(ctx, c, shootObject, shootState); err != nil {
+			return nil, err
+		}
 		shoot.SetShootState(shootState)
 	}
 
@@ -457,6 +467,7 @@ func (s *Shoot) GetDNSRecordComponentsForMigration() []component.DeployMigrateWa
 	return []component.DeployMigrateWaiter{
 		s.Components.Extensions.IngressDNSRecord,
 		s.Components.Extensions.ExternalDNSRecord,
+		s.Components.Extensions.SecondaryExternalDNSRecord,
 		s.Components.Extensions.InternalDNSRecord,
 	}
 }
@@ -613,19 +624,23 @@ func ToNetworks(shoot *gardencorev1beta1.Shoot, workerless bool) (*Networks, err
 	}, nil
 }
 
+// copyUniqueCIDRs appends the given CIDRs to the given networks unless they are already contained in one of them, e.g.,
+// because the network in the spec was expanded and the status still reports the previous CIDR.
 func copyUniqueCIDRs(src []string, dst []net.IPNet, networkType string) ([]net.IPNet, error) {
-	existing := sets.New[string]()
-	for _, cidr := range dst {
-		existing.Insert(cidr.String())
-	}
 	for _, s := range src {
-		if !existing.Has(s) {
-			_, cidr, err := net.ParseCIDR(s)
-			if err != nil {
-				return nil, fmt.Errorf("cannot parse shoot's %s cidr '%s': %w", networkType, s, err)
-			}
+		_, cidr, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse shoot's %s cidr '%s': %w", networkType, s, err)
+		}
+		if !slices.ContainsFunc(dst, func(network net.IPNet) bool { return containsCIDR(network, *cidr) }) {
 			dst = append(dst, *cidr)
 		}
 	}
 	return dst, nil
 }
+
+func containsCIDR(network, cidr net.IPNet) bool {
+	networkOnes, networkBits := network.Mask.Size()
+	cidrOnes, cidrBits := cidr.Mask.Size()
+	return networkBits == cidrBits && networkOnes <= cidrOnes && network.Contains(cidr.IP)
+}
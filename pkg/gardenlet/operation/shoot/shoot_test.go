@@ -138,6 +138,39 @@ var _ = Describe("shoot", func() {
 				})))
 			})
 
+			It("does not add CIDRs from the shoot status which are contained in the expanded spec CIDRs", func() {
+				shoot.Spec.Networking.Pods = ptr.To("10.0.0.0/16")
+				shoot.Spec.Networking.Nodes = ptr.To("30.0.0.0/23")
+				shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{
+					Pods:     []string{"10.0.0.0/24", "10.1.0.0/24"},
+					Services: []string{"20.0.0.0/24"},
+					Nodes:    []string{"30.0.1.0/24", "2001:db8::/64"},
+				}
+				result, err := ToNetworks(shoot, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Pods).To(Equal([]net.IPNet{
+					{
+						IP:   []byte{10, 0, 0, 0},
+						Mask: []byte{255, 255, 0, 0},
+					},
+					{
+						IP:   []byte{10, 1, 0, 0},
+						Mask: []byte{255, 255, 255, 0},
+					},
+				}))
+				Expect(result.Nodes).To(Equal([]net.IPNet{
+					{
+						IP:   []byte{30, 0, 0, 0},
+						Mask: []byte{255, 255, 254, 0},
+					},
+					{
+						IP:   []byte{32, 1, 13, 184, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+						Mask: []byte{255, 255, 255, 255, 255, 255, 255, 255, 0, 0, 0, 0, 0, 0, 0, 0},
+					},
+				}))
+			})
+
 			DescribeTable("#ConstructInternalClusterDomain", func(mutateFunc func(s *gardencorev1beta1.Shoot)) {
 				mutateFunc(shoot)
 				result, err := ToNetworks(shoot, false)
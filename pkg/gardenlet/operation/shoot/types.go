@@ -79,6 +79,9 @@ type Shoot struct {
 	InternalClusterDomain string
 	ExternalClusterDomain *string
 	ExternalDomain        *gardenerutils.Domain
+	// SecondaryExternalDomain is the external domain managed by the secondary DNS provider. It is used if the
+	// primary DNS provider is not available.
+	SecondaryExternalDomain *gardenerutils.Domain
 
 	Purpose                                 gardencorev1beta1.ShootPurpose
 	IsWorkerless                            bool
@@ -141,23 +144,25 @@ type ControlPlane struct {
 
 // Extensions contains references to extension resources.
 type Extensions struct {
-	ContainerRuntime      containerruntime.Interface
-	ControlPlane          controlplane.Interface
-	ControlPlaneExposure  controlplane.Interface
-	ExternalDNSRecord     dnsrecord.Interface
-	InternalDNSRecord     dnsrecord.Interface
-	IngressDNSRecord      dnsrecord.Interface
-	Extension             extension.Interface
-	Infrastructure        infrastructure.Interface
-	Network               network.Interface
-	OperatingSystemConfig operatingsystemconfig.Interface
-	Worker                worker.Interface
+	ContainerRuntime           containerruntime.Interface
+	ControlPlane               controlplane.Interface
+	ControlPlaneExposure       controlplane.Interface
+	ExternalDNSRecord          dnsrecord.Interface
+	SecondaryExternalDNSRecord dnsrecord.Interface
+	InternalDNSRecord          dnsrecord.Interface
+	IngressDNSRecord           dnsrecord.Interface
+	Extension                  extension.Interface
+	Infrastructure             infrastructure.Interface
+	Network                    network.Interface
+	OperatingSystemConfig      operatingsystemconfig.Interface
+	Worker                     worker.Interface
 }
 
 // SystemComponents contains references to system components.
 type SystemComponents struct {
 	APIServerProxy      apiserverproxy.Interface
 	BlackboxExporter    component.DeployWaiter
+	CertificateService  component.DeployWaiter
 	ClusterIdentity     clusteridentity.Interface
 	CoreDNS             coredns.Interface
 	KubeProxy           kubeproxy.Interface
@@ -167,7 +172,9 @@ type SystemComponents struct {
 	NodeProblemDetector component.DeployWaiter
 	NodeExporter        component.DeployWaiter
 	Resources           shootsystem.Interface
+	RuntimeSecurity     component.DeployWaiter
 	VPNShoot            component.DeployWaiter
+	WorkerHeadroom      component.DeployWaiter
 }
 
 // Addons contains references for the addons.
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// MaxConcurrentSyncs is the number of workers which are started for controllers whose concurrency can be changed without
+// restarting gardenlet, unless a higher concurrency is configured at start-up. The concurrency of these controllers cannot
+// be increased beyond their number of workers without a restart.
+const MaxConcurrentSyncs = 50
+
+// Settings contains the settings of the gardenlet configuration which are applied to the running controllers when the
+// configuration is reloaded. It is safe for concurrent use.
+type Settings struct {
+	// ShootConcurrency limits the number of concurrent reconciliations of the shoot controller.
+	ShootConcurrency *controllerutils.ConcurrencyLimiter
+	// ShootCareConcurrency limits the number of concurrent reconciliations of the shoot care controller.
+	ShootCareConcurrency *controllerutils.ConcurrencyLimiter
+
+	lock       sync.RWMutex
+	monitoring *config.MonitoringConfig
+}
+
+// NewSettings returns the reloadable settings of the given configuration.
+func NewSettings(cfg *config.GardenletConfiguration) *Settings {
+	return &Settings{
+		ShootConcurrency:     controllerutils.NewConcurrencyLimiter(shootConcurrentSyncs(cfg), MaxConcurrentSyncs),
+		ShootCareConcurrency: controllerutils.NewConcurrencyLimiter(shootCareConcurrentSyncs(cfg), MaxConcurrentSyncs),
+		monitoring:           cfg.Monitoring.DeepCopy(),
+	}
+}
+
+// Monitoring returns the current monitoring settings. The returned object must not be modified.
+func (s *Settings) Monitoring() *config.MonitoringConfig {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.monitoring
+}
+
+// Validate returns an error if the settings of the given configuration cannot be applied without a restart.
+func (s *Settings) Validate(cfg *config.GardenletConfiguration) error {
+	var errs []error
+	if err := s.ShootConcurrency.ValidateLimit(shootConcurrentSyncs(cfg)); err != nil {
+		errs = append(errs, fmt.Errorf("invalid concurrency of shoot controller: %w", err))
+	}
+	if err := s.ShootCareConcurrency.ValidateLimit(shootCareConcurrentSyncs(cfg)); err != nil {
+		errs = append(errs, fmt.Errorf("invalid concurrency of shoot care controller: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// Apply applies the settings of the given configuration. The configuration must have been checked with Validate before.
+func (s *Settings) Apply(cfg *config.GardenletConfiguration) error {
+	if err := s.Validate(cfg); err != nil {
+		return err
+	}
+
+	// Validate ensures that the limits can be set.
+	_ = s.ShootConcurrency.SetLimit(shootConcurrentSyncs(cfg))
+	_ = s.ShootCareConcurrency.SetLimit(shootCareConcurrentSyncs(cfg))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.monitoring = cfg.Monitoring.DeepCopy()
+	return nil
+}
+
+// shootConcurrentSyncs returns the configured concurrency of the shoot controller. Like controller-runtime, it falls back
+// to one worker if no concurrency is configured.
+func shootConcurrentSyncs(cfg *config.GardenletConfiguration) int {
+	if cfg.Controllers == nil || cfg.Controllers.Shoot == nil {
+		return 1
+	}
+	return max(ptr.Deref(cfg.Controllers.Shoot.ConcurrentSyncs, 0), 1)
+}
+
+// shootCareConcurrentSyncs returns the configured concurrency of the shoot care controller.
+func shootCareConcurrentSyncs(cfg *config.GardenletConfiguration) int {
+	if cfg.Controllers == nil || cfg.Controllers.ShootCare == nil {
+		return 1
+	}
+	return max(ptr.Deref(cfg.Controllers.ShootCare.ConcurrentSyncs, 0), 1)
+}
@@ -31,18 +31,9 @@ func MustNewZapLogger(level string, format string, additionalOpts ...logzap.Opts
 func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (logr.Logger, error) {
 	var opts []logzap.Opts
 
-	// map our log levels to zap levels
-	var zapLevel zapcore.LevelEnabler
-
-	switch level {
-	case DebugLevel:
-		zapLevel = zap.DebugLevel
-	case ErrorLevel:
-		zapLevel = zap.ErrorLevel
-	case "", InfoLevel:
-		zapLevel = zap.InfoLevel
-	default:
-		return logr.Logger{}, fmt.Errorf("invalid log level %q", level)
+	zapLevel, err := ZapLevel(level)
+	if err != nil {
+		return logr.Logger{}, err
 	}
 
 	opts = append(opts, logzap.Level(zapLevel))
@@ -59,3 +50,17 @@ func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (l
 
 	return logzap.New(append(opts, additionalOpts...)...), nil
 }
+
+// ZapLevel maps the given log level to the respective zap level.
+func ZapLevel(level string) (zapcore.Level, error) {
+	switch level {
+	case DebugLevel:
+		return zap.DebugLevel, nil
+	case ErrorLevel:
+		return zap.ErrorLevel, nil
+	case "", InfoLevel:
+		return zap.InfoLevel, nil
+	default:
+		return zapcore.InvalidLevel, fmt.Errorf("invalid log level %q", level)
+	}
+}
@@ -7,6 +7,7 @@ package logger_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
 
 	. "github.com/gardener/gardener/pkg/logger"
 )
@@ -46,4 +47,22 @@ var _ = Describe("zap", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	DescribeTable("#ZapLevel",
+		func(level string, expectedLevel zapcore.Level, expectErr bool) {
+			zapLevel, err := ZapLevel(level)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(zapLevel).To(Equal(expectedLevel))
+		},
+
+		Entry("debug", "debug", zapcore.DebugLevel, false),
+		Entry("info", "info", zapcore.InfoLevel, false),
+		Entry("default", "", zapcore.InfoLevel, false),
+		Entry("error", "error", zapcore.ErrorLevel, false),
+		Entry("invalid", "invalid", zapcore.InvalidLevel, true),
+	)
 })
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -43,6 +44,16 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// NodeConditionTypeInPlaceUpdate is a constant for the type of the Node condition describing the progress of an
+	// in-place update of the operating system and the kubelet.
+	NodeConditionTypeInPlaceUpdate corev1.NodeConditionType = "InPlaceUpdate"
+	// ReasonInPlaceUpdateProgressing is the reason of the in-place update condition while the update is applied.
+	ReasonInPlaceUpdateProgressing = "Progressing"
+	// ReasonInPlaceUpdateSucceeded is the reason of the in-place update condition after the update was applied.
+	ReasonInPlaceUpdateSucceeded = "Succeeded"
+	// ReasonInPlaceUpdateFailed is the reason of the in-place update condition if the update could not be applied.
+	ReasonInPlaceUpdateFailed = "Failed"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
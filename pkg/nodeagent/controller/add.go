@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/runtimesecurity"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
 )
 
@@ -55,6 +56,10 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
+	if err := (&runtimesecurity.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+		return fmt.Errorf("failed adding runtime-security controller: %w", err)
+	}
+
 	if err := (&hostnamecheck.Reconciler{
 		HostName:      hostName,
 		CancelContext: cancel,
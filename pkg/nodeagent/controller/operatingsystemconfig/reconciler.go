@@ -59,7 +59,7 @@ type Reconciler struct {
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	log := logf.FromContext(ctx)
 
 	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
@@ -94,6 +94,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	var mustRestartGardenerNodeAgent bool
+
+	// In-place updates are only reported for nodes which are already registered, newly provisioned nodes come up with
+	// the desired versions anyway.
+	if inPlaceUpdates := osc.Spec.InPlaceUpdates; inPlaceUpdates != nil && node != nil {
+		log.Info("Updating node in-place", "operatingSystemVersion", inPlaceUpdates.OperatingSystemVersion, "kubeletVersion", inPlaceUpdates.KubeletVersion)
+		message := fmt.Sprintf("Updating to operating system version %s and kubelet version %s", inPlaceUpdates.OperatingSystemVersion, inPlaceUpdates.KubeletVersion)
+		if err := r.patchInPlaceUpdateCondition(ctx, node, corev1.ConditionUnknown, nodeagentv1alpha1.ReasonInPlaceUpdateProgressing, message); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed setting in-place update condition: %w", err)
+		}
+
+		defer func() {
+			// The update is continued after the restart of gardener-node-agent, hence it is still progressing.
+			if err == nil && mustRestartGardenerNodeAgent {
+				return
+			}
+
+			status, reason := corev1.ConditionTrue, nodeagentv1alpha1.ReasonInPlaceUpdateSucceeded
+			message := fmt.Sprintf("Updated to operating system version %s and kubelet version %s", inPlaceUpdates.OperatingSystemVersion, inPlaceUpdates.KubeletVersion)
+			if err != nil {
+				status, reason, message = corev1.ConditionFalse, nodeagentv1alpha1.ReasonInPlaceUpdateFailed, err.Error()
+			}
+
+			if patchErr := r.patchInPlaceUpdateCondition(ctx, node, status, reason, message); patchErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed setting in-place update condition: %w", patchErr))
+			}
+		}()
+	}
+
 	log.Info("Applying containerd configuration")
 	if err := r.ReconcileContainerdConfig(ctx, log, osc.Spec.CRIConfig); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reconciling containerd configuration: %w", err)
@@ -133,7 +162,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Info("Executing unit commands (start/stop)")
-	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges)
+	mustRestartGardenerNodeAgent, err = r.executeUnitCommands(ctx, log, node, oscChanges)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
 	}
@@ -211,6 +240,12 @@ func (r *Reconciler) getNode(ctx context.Context) (*corev1.Node, error) {
 	return node, nil
 }
 
+func (r *Reconciler) patchInPlaceUpdateCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	nodeagent.SetInPlaceUpdateCondition(node, status, reason, message, metav1.Now())
+	return r.Client.Status().Patch(ctx, node, patch)
+}
+
 var (
 	etcSystemdSystem                   = path.Join("/", "etc", "systemd", "system")
 	defaultFilePermissions os.FileMode = 0600
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimesecurity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/component/observability/runtimesecurity"
+)
+
+const (
+	// EventReasonFinding is the reason of events reporting critical findings of the runtime security agent.
+	EventReasonFinding = "RuntimeSecurityFinding"
+
+	syncPeriod = 10 * time.Second
+	// maxFileSize is the size of the findings file after which it is truncated once all findings have been processed.
+	maxFileSize = 10 * 1024 * 1024
+	// maxEventMessageLength is the maximum length of the message of events reporting findings.
+	maxEventMessageLength = 1024
+)
+
+// criticalPriorities are the priorities of findings which are reported as events.
+var criticalPriorities = sets.New("emergency", "alert", "critical")
+
+// finding is a single finding of the runtime security agent as written to the findings file.
+type finding struct {
+	Priority string `json:"priority"`
+	Rule     string `json:"rule"`
+	Output   string `json:"output"`
+}
+
+// Reconciler periodically reads the findings written by the runtime security agent and reports critical findings as
+// events of the node. Findings which were already present when gardener-node-agent started are not reported again.
+type Reconciler struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	FS       afero.Afero
+
+	initialized bool
+	offset      int64
+}
+
+// Reconcile reads new findings of the runtime security agent and reports critical findings as events of the node.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	info, err := r.FS.Stat(runtimesecurity.FindingsFilePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return reconcile.Result{}, fmt.Errorf("failed reading findings file %s: %w", runtimesecurity.FindingsFilePath, err)
+		}
+
+		// Findings written to a file created after gardener-node-agent has started must be reported from its start.
+		r.initialized, r.offset = true, 0
+		return reconcile.Result{RequeueAfter: syncPeriod}, nil
+	}
+
+	if !r.initialized {
+		r.initialized, r.offset = true, info.Size()
+	}
+
+	if info.Size() < r.offset {
+		log.Info("Findings file was truncated, reading from the beginning", "path", runtimesecurity.FindingsFilePath)
+		r.offset = 0
+	}
+
+	if info.Size() == r.offset {
+		return reconcile.Result{RequeueAfter: syncPeriod}, nil
+	}
+
+	data, err := r.read(r.offset)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Only process complete lines, the remainder is processed once the runtime security agent finished writing it.
+	consumed := bytes.LastIndexByte(data, '\n') + 1
+	for _, line := range bytes.Split(data[:consumed], []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		f := &finding{}
+		if err := json.Unmarshal(line, f); err != nil {
+			log.Error(err, "Failed decoding finding, skipping it")
+			continue
+		}
+
+		if criticalPriorities.Has(strings.ToLower(f.Priority)) {
+			r.Recorder.Event(node, corev1.EventTypeWarning, EventReasonFinding, eventMessage(f))
+		}
+	}
+	r.offset += int64(consumed)
+
+	if r.offset >= maxFileSize && r.offset == info.Size() {
+		log.Info("Truncating findings file", "path", runtimesecurity.FindingsFilePath)
+		if err := r.FS.WriteFile(runtimesecurity.FindingsFilePath, nil, 0600); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed truncating findings file %s: %w", runtimesecurity.FindingsFilePath, err)
+		}
+		r.offset = 0
+	}
+
+	return reconcile.Result{RequeueAfter: syncPeriod}, nil
+}
+
+func (r *Reconciler) read(offset int64) ([]byte, error) {
+	file, err := r.FS.Open(runtimesecurity.FindingsFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening findings file %s: %w", runtimesecurity.FindingsFilePath, err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed seeking findings file %s: %w", runtimesecurity.FindingsFilePath, err)
+	}
+
+	return io.ReadAll(file)
+}
+
+func eventMessage(f *finding) string {
+	message := fmt.Sprintf("%s (priority %s): %s", f.Rule, f.Priority, f.Output)
+	if len(message) > maxEventMessageLength {
+		message = message[:maxEventMessageLength-3] + "..."
+	}
+	return message
+}
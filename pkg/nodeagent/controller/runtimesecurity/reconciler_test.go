@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimesecurity_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/component/observability/runtimesecurity"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/runtimesecurity"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		criticalFinding = `{"priority":"Critical","rule":"Shell spawned in kube-system container","output":"Shell spawned (shell=bash)"}` + "\n"
+		noticeFinding   = `{"priority":"Notice","rule":"Unexpected connection","output":"Connection (port=1234)"}` + "\n"
+	)
+
+	var (
+		ctx = context.Background()
+
+		c          client.Client
+		fs         afero.Afero
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		node    *corev1.Node
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+
+		c = fakeclient.NewClientBuilder().WithObjects(node).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{Client: c, Recorder: recorder, FS: fs}
+	})
+
+	reconcileSuccessfully := func() {
+		GinkgoHelper()
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+	}
+
+	appendFindings := func(findings ...string) {
+		GinkgoHelper()
+
+		file, err := fs.OpenFile(runtimesecurity.FindingsFilePath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		Expect(err).NotTo(HaveOccurred())
+		for _, f := range findings {
+			_, err := file.WriteString(f)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(file.Close()).To(Succeed())
+	}
+
+	It("should do nothing if the node does not exist", func() {
+		Expect(c.Delete(ctx, node)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should report critical findings written after the findings file was created", func() {
+		reconcileSuccessfully()
+
+		appendFindings(criticalFinding, noticeFinding)
+		reconcileSuccessfully()
+
+		Expect(recorder.Events).To(Receive(Equal("Warning RuntimeSecurityFinding Shell spawned in kube-system container (priority Critical): Shell spawned (shell=bash)")))
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should not report findings which existed before the start", func() {
+		appendFindings(criticalFinding)
+		reconcileSuccessfully()
+		Expect(recorder.Events).NotTo(Receive())
+
+		appendFindings(criticalFinding)
+		reconcileSuccessfully()
+		Expect(recorder.Events).To(Receive())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should only process complete lines", func() {
+		reconcileSuccessfully()
+
+		appendFindings(criticalFinding[:20])
+		reconcileSuccessfully()
+		Expect(recorder.Events).NotTo(Receive())
+
+		appendFindings(criticalFinding[20:])
+		reconcileSuccessfully()
+		Expect(recorder.Events).To(Receive())
+	})
+
+	It("should skip findings which cannot be decoded", func() {
+		reconcileSuccessfully()
+
+		appendFindings("foo\n", criticalFinding)
+		reconcileSuccessfully()
+		Expect(recorder.Events).To(Receive())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should read from the beginning if the findings file was truncated", func() {
+		reconcileSuccessfully()
+		appendFindings(noticeFinding, noticeFinding)
+		reconcileSuccessfully()
+
+		Expect(fs.WriteFile(runtimesecurity.FindingsFilePath, []byte(criticalFinding), 0600)).To(Succeed())
+		reconcileSuccessfully()
+		Expect(recorder.Events).To(Receive())
+	})
+})
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// GetInPlaceUpdateCondition returns the in-place update condition of the given node, or nil if it is not present.
+func GetInPlaceUpdateCondition(node *corev1.Node) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == nodeagentv1alpha1.NodeConditionTypeInPlaceUpdate {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetInPlaceUpdateCondition adds or updates the in-place update condition of the given node. The last transition time
+// is only changed if the status of the condition changes.
+func SetInPlaceUpdateCondition(node *corev1.Node, status corev1.ConditionStatus, reason, message string, now metav1.Time) {
+	condition := GetInPlaceUpdateCondition(node)
+	if condition == nil {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: nodeagentv1alpha1.NodeConditionTypeInPlaceUpdate})
+		condition = &node.Status.Conditions[len(node.Status.Conditions)-1]
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = now
+	}
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+	condition.LastHeartbeatTime = now
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/nodeagent"
+)
+
+var _ = Describe("InPlaceUpdate", func() {
+	var (
+		node *corev1.Node
+
+		now   = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		later = metav1.NewTime(now.Add(time.Minute))
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	})
+
+	Describe("#GetInPlaceUpdateCondition", func() {
+		It("should return nil if the condition is not present", func() {
+			Expect(GetInPlaceUpdateCondition(node)).To(BeNil())
+		})
+
+		It("should return the condition", func() {
+			node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: "InPlaceUpdate", Reason: "Failed"})
+
+			Expect(GetInPlaceUpdateCondition(node)).To(Equal(&corev1.NodeCondition{Type: "InPlaceUpdate", Reason: "Failed"}))
+		})
+	})
+
+	Describe("#SetInPlaceUpdateCondition", func() {
+		It("should add the condition", func() {
+			SetInPlaceUpdateCondition(node, corev1.ConditionUnknown, "Progressing", "updating", now)
+
+			Expect(node.Status.Conditions).To(ConsistOf(
+				corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				corev1.NodeCondition{
+					Type:               "InPlaceUpdate",
+					Status:             corev1.ConditionUnknown,
+					Reason:             "Progressing",
+					Message:            "updating",
+					LastHeartbeatTime:  now,
+					LastTransitionTime: now,
+				},
+			))
+		})
+
+		It("should update the condition and keep the transition time if the status does not change", func() {
+			SetInPlaceUpdateCondition(node, corev1.ConditionFalse, "Failed", "foo", now)
+			SetInPlaceUpdateCondition(node, corev1.ConditionFalse, "Failed", "bar", later)
+
+			Expect(GetInPlaceUpdateCondition(node)).To(Equal(&corev1.NodeCondition{
+				Type:               "InPlaceUpdate",
+				Status:             corev1.ConditionFalse,
+				Reason:             "Failed",
+				Message:            "bar",
+				LastHeartbeatTime:  later,
+				LastTransitionTime: now,
+			}))
+		})
+
+		It("should update the transition time if the status changes", func() {
+			SetInPlaceUpdateCondition(node, corev1.ConditionUnknown, "Progressing", "updating", now)
+			SetInPlaceUpdateCondition(node, corev1.ConditionTrue, "Succeeded", "updated", later)
+
+			Expect(GetInPlaceUpdateCondition(node)).To(Equal(&corev1.NodeCondition{
+				Type:               "InPlaceUpdate",
+				Status:             corev1.ConditionTrue,
+				Reason:             "Succeeded",
+				Message:            "updated",
+				LastHeartbeatTime:  later,
+				LastTransitionTime: later,
+			}))
+			Expect(node.Status.Conditions).To(HaveLen(2))
+		})
+	})
+})
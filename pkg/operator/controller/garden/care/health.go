@@ -114,7 +114,7 @@ func (h *health) listManagedResources(ctx context.Context) ([]resourcesv1alpha1.
 // checkAPIServerAvailability checks if the API server of a virtual garden is reachable and measures the response time.
 func (h *health) checkAPIServerAvailability(ctx context.Context, condition gardencorev1beta1.Condition) gardencorev1beta1.Condition {
 	if h.gardenClientSet == nil {
-		return v1beta1helper.FailedCondition(h.clock, h.garden.Status.LastOperation, h.conditionThresholds, condition, "VirtualGardenAPIServerDown", "Could not reach virtual garden API server during client initialization.")
+		return v1beta1helper.FailedCondition(h.clock, h.garden.Status.LastOperation, h.conditionThresholds, condition, gardencorev1beta1.VirtualGardenAPIServerDown, "Could not reach virtual garden API server during client initialization.")
 	}
 	log := logf.FromContext(ctx)
 	return kuberneteshealth.CheckAPIServerAvailability(ctx, h.clock, log, condition, h.gardenClientSet.RESTClient(), func(conditionType, message string) gardencorev1beta1.Condition {
@@ -130,7 +130,7 @@ func (h *health) checkRuntimeComponents(condition gardencorev1beta1.Condition, m
 		return exitCondition
 	}
 
-	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "RuntimeComponentsRunning", "All runtime components are healthy."))
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.RuntimeComponentsRunning, "All runtime components are healthy."))
 }
 
 func (h *health) checkVirtualComponents(ctx context.Context, condition gardencorev1beta1.Condition, managedResources []resourcesv1alpha1.ManagedResource) (*gardencorev1beta1.Condition, error) {
@@ -151,7 +151,7 @@ func (h *health) checkVirtualComponents(ctx context.Context, condition gardencor
 		return exitCondition, nil
 	}
 
-	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "VirtualComponentsRunning", "All virtual garden components are healthy.")), nil
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.VirtualComponentsRunning, "All virtual garden components are healthy.")), nil
 }
 
 // checkObservabilityComponents checks whether the observability components are healthy.
@@ -162,7 +162,7 @@ func (h *health) checkObservabilityComponents(condition gardencorev1beta1.Condit
 		return exitCondition
 	}
 
-	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ObservabilityComponentsRunning", "All observability components are healthy."))
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.ObservabilityComponentsRunning, "All observability components are healthy."))
 }
 
 // GardenConditions contains all conditions of the garden status subresource.
@@ -1259,7 +1259,8 @@ func (r *Reconciler) newPrometheusGarden(log logr.Logger, garden *operatorv1alph
 			SigningCA:              operatorv1alpha1.SecretNameCARuntime,
 			WildcardCertSecretName: wildcardCertSecretName,
 		},
-		TargetCluster: &prometheus.TargetClusterValues{ServiceAccountName: gardenprometheus.ServiceAccountName},
+		TargetCluster:             &prometheus.TargetClusterValues{ServiceAccountName: gardenprometheus.ServiceAccountName},
+		EnableRemoteWriteReceiver: helper.PrometheusRemoteWriteReceiverEnabled(garden.Spec.RuntimeCluster.Settings),
 	})
 }
 
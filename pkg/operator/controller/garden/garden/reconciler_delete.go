@@ -324,6 +324,8 @@ func (r *Reconciler) delete(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy),
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      "garden/" + garden.Name,
 	}); err != nil {
 		return reconcilerutils.ReconcileErr(flow.Errors(err))
 	}
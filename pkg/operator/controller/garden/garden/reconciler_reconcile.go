@@ -525,6 +525,8 @@ func (r *Reconciler) reconcile(
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log:              log,
 		ProgressReporter: r.reportProgress(log, gardenCopy),
+		Recorder:         flow.DefaultRecorder,
+		RecorderKey:      "garden/" + garden.Name,
 	}); err != nil {
 		return reconcile.Result{}, flow.Errors(err)
 	}
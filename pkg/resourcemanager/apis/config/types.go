@@ -145,6 +145,12 @@ type ManagedResourceControllerConfig struct {
 	// will have key `resources.gardener.cloud/managed-by`.
 	// Default: gardener
 	ManagedByLabelValue *string
+	// WatchManagedObjects specifies whether the controller watches the objects managed by ManagedResources. If true,
+	// changes to or deletions of these objects trigger a reconciliation of the responsible ManagedResource, i.e., drift
+	// is reverted immediately instead of with the next periodic reconciliation. In this case, the SyncPeriod can be
+	// increased to reduce the load on the API servers.
+	// Default: false
+	WatchManagedObjects *bool
 }
 
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
@@ -136,6 +136,9 @@ func SetDefaults_ManagedResourceControllerConfig(obj *ManagedResourceControllerC
 	if obj.ManagedByLabelValue == nil {
 		obj.ManagedByLabelValue = ptr.To(resourcesv1alpha1.GardenerManager)
 	}
+	if obj.WatchManagedObjects == nil {
+		obj.WatchManagedObjects = ptr.To(false)
+	}
 }
 
 // SetDefaults_TokenInvalidatorControllerConfig sets defaults for the TokenInvalidatorControllerConfig object.
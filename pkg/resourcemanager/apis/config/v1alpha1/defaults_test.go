@@ -327,6 +327,7 @@ var _ = Describe("ResourceManager defaulting", func() {
 			Expect(obj.Controllers.ManagedResource.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 			Expect(obj.Controllers.ManagedResource.AlwaysUpdate).To(PointTo(BeFalse()))
 			Expect(obj.Controllers.ManagedResource.ManagedByLabelValue).To(PointTo(Equal("gardener")))
+			Expect(obj.Controllers.ManagedResource.WatchManagedObjects).To(PointTo(BeFalse()))
 		})
 
 		It("should not overwrite already set values for ManagedResourceControllerConfig", func() {
@@ -335,6 +336,7 @@ var _ = Describe("ResourceManager defaulting", func() {
 				SyncPeriod:          &metav1.Duration{Duration: time.Second},
 				AlwaysUpdate:        ptr.To(true),
 				ManagedByLabelValue: ptr.To("foo"),
+				WatchManagedObjects: ptr.To(true),
 			}
 
 			SetObjectDefaults_ResourceManagerConfiguration(obj)
@@ -343,6 +345,7 @@ var _ = Describe("ResourceManager defaulting", func() {
 			Expect(obj.Controllers.ManagedResource.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 			Expect(obj.Controllers.ManagedResource.AlwaysUpdate).To(PointTo(BeTrue()))
 			Expect(obj.Controllers.ManagedResource.ManagedByLabelValue).To(PointTo(Equal("foo")))
+			Expect(obj.Controllers.ManagedResource.WatchManagedObjects).To(PointTo(BeTrue()))
 		})
 	})
 
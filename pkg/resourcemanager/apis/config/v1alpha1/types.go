@@ -162,6 +162,13 @@ type ManagedResourceControllerConfig struct {
 	// Default: gardener
 	// +optional
 	ManagedByLabelValue *string `json:"managedByLabelValue,omitempty"`
+	// WatchManagedObjects specifies whether the controller watches the objects managed by ManagedResources. If true,
+	// changes to or deletions of these objects trigger a reconciliation of the responsible ManagedResource, i.e., drift
+	// is reverted immediately instead of with the next periodic reconciliation. In this case, the SyncPeriod can be
+	// increased to reduce the load on the API servers.
+	// Default: false
+	// +optional
+	WatchManagedObjects *bool `json:"watchManagedObjects,omitempty"`
 }
 
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
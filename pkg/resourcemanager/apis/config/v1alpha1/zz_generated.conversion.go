@@ -580,6 +580,7 @@ func autoConvert_v1alpha1_ManagedResourceControllerConfig_To_config_ManagedResou
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.AlwaysUpdate = (*bool)(unsafe.Pointer(in.AlwaysUpdate))
 	out.ManagedByLabelValue = (*string)(unsafe.Pointer(in.ManagedByLabelValue))
+	out.WatchManagedObjects = (*bool)(unsafe.Pointer(in.WatchManagedObjects))
 	return nil
 }
 
@@ -593,6 +594,7 @@ func autoConvert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResou
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.AlwaysUpdate = (*bool)(unsafe.Pointer(in.AlwaysUpdate))
 	out.ManagedByLabelValue = (*string)(unsafe.Pointer(in.ManagedByLabelValue))
+	out.WatchManagedObjects = (*bool)(unsafe.Pointer(in.WatchManagedObjects))
 	return nil
 }
 
@@ -259,6 +259,11 @@ func (in *ManagedResourceControllerConfig) DeepCopyInto(out *ManagedResourceCont
 		*out = new(string)
 		**out = **in
 	}
+	if in.WatchManagedObjects != nil {
+		in, out := &in.WatchManagedObjects, &out.WatchManagedObjects
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -10,6 +10,7 @@ import (
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
@@ -84,6 +85,8 @@ func CheckHealth(obj client.Object) (bool, error) {
 		return true, health.CheckPrometheus(o)
 	case *monitoringv1.Alertmanager:
 		return true, health.CheckAlertmanager(o)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return true, health.CheckHorizontalPodAutoscaler(o)
 	case *vpaautoscalingv1.VerticalPodAutoscaler:
 		return true, health.CheckVerticalPodAutoscaler(o)
 	case *certv1alpha1.Certificate:
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -503,6 +504,25 @@ var _ = Describe("CheckHealth", func() {
 		testSuite()
 	})
 
+	Context("HorizontalPodAutoscaler", func() {
+		BeforeEach(func() {
+			healthy = &autoscalingv2.HorizontalPodAutoscaler{}
+			unhealthy = &autoscalingv2.HorizontalPodAutoscaler{
+				Status: autoscalingv2.HorizontalPodAutoscalerStatus{Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionFalse}}},
+			}
+			unhealthyWithSkipHealthCheckAnnotation = &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						resourcesv1alpha1.SkipHealthCheck: "true",
+					},
+				},
+				Status: autoscalingv2.HorizontalPodAutoscalerStatus{Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionFalse}}},
+			}
+		})
+
+		testSuite()
+	})
+
 	Context("VerticalPodAutoscaler", func() {
 		BeforeEach(func() {
 			healthy = &vpaautoscalingv1.VerticalPodAutoscaler{}
@@ -6,10 +6,14 @@ package managedresource
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -26,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
@@ -86,7 +91,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		return err
 	}
 
-	return c.Watch(
+	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &corev1.Secret{}),
 		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), r.MapSecretToManagedResources(
 			r.ClassFilter,
@@ -95,7 +100,75 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 				predicateutils.IsDeleting(),
 			),
 		), mapper.UpdateWithOldAndNew, c.GetLogger()),
-	)
+	); err != nil {
+		return err
+	}
+
+	if !ptr.Deref(r.Config.WatchManagedObjects, false) {
+		return nil
+	}
+
+	lock := sync.RWMutex{}
+	watchedObjectGVKs := make(map[schema.GroupVersionKind]struct{})
+	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		// fast-check: have we already added watch for this GVK?
+		lock.RLock()
+		if _, ok := watchedObjectGVKs[gvk]; ok {
+			lock.RUnlock()
+			return nil
+		}
+		lock.RUnlock()
+
+		// slow-check: two goroutines might concurrently call this func. If neither exited early, the first one added
+		// the watch and the second one should return now.
+		lock.Lock()
+		defer lock.Unlock()
+		if _, ok := watchedObjectGVKs[gvk]; ok {
+			return nil
+		}
+
+		_, metadataOnly := obj.(*metav1.PartialObjectMetadata)
+		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk, "metadataOnly", metadataOnly)
+
+		if err := c.Watch(
+			source.Kind(targetCluster.GetCache(), obj),
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), r.MapManagedObjectToManagedResource(
+				r.ClassFilter,
+				resourcemanagerpredicate.NotIgnored(),
+			), mapper.UpdateWithNew, c.GetLogger()),
+			resourcemanagerpredicate.ManagedObjectChanged(),
+		); err != nil {
+			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
+		}
+
+		watchedObjectGVKs[gvk] = struct{}{}
+		return nil
+	}
+
+	return nil
+}
+
+// MapManagedObjectToManagedResource maps objects managed by this controller to the ManagedResource they originate from
+// if it matches the given predicates.
+func (r *Reconciler) MapManagedObjectToManagedResource(managedResourcePredicates ...predicate.Predicate) mapper.MapFunc {
+	mapToOrigin := healthutils.MapToOriginManagedResource(r.ClusterID)
+
+	return func(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+		var requests []reconcile.Request
+		for _, request := range mapToOrigin(ctx, log, reader, obj) {
+			mr := &resourcesv1alpha1.ManagedResource{}
+			if err := reader.Get(ctx, request.NamespacedName, mr); err != nil {
+				continue
+			}
+
+			if !predicateutils.EvalGeneric(mr, managedResourcePredicates...) {
+				continue
+			}
+
+			requests = append(requests, request)
+		}
+		return requests
+	}
 }
 
 // MapSecretToManagedResources maps secrets to relevant ManagedResources.
@@ -124,3 +124,91 @@ var _ = Describe("#MapSecretToManagedResources", func() {
 		))
 	})
 })
+
+var _ = Describe("#MapManagedObjectToManagedResource", func() {
+	var (
+		ctx    = context.TODO()
+		c      *mockclient.MockClient
+		ctrl   *gomock.Controller
+		m      mapper.Mapper
+		obj    *corev1.ConfigMap
+		filter *predicate.ClassFilter
+		mrKey  = types.NamespacedName{Namespace: "mr-namespace", Name: "mr"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		obj = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "cm",
+				Namespace:   "default",
+				Annotations: map[string]string{"resources.gardener.cloud/origin": "cluster-id:mr-namespace/mr"},
+			},
+		}
+
+		filter = predicate.NewClassFilter("seed")
+
+		m = (&Reconciler{ClusterID: "cluster-id"}).MapManagedObjectToManagedResource(filter, predicate.NotIgnored())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should do nothing, if the object has no origin annotation", func() {
+		obj.Annotations = nil
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if the object is managed by another cluster", func() {
+		obj.Annotations["resources.gardener.cloud/origin"] = "other:mr-namespace/mr"
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if the ManagedResource cannot be read", func() {
+		c.EXPECT().Get(ctx, mrKey, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.New("fake"))
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if we are not responsible for the ManagedResource", func() {
+		c.EXPECT().Get(ctx, mrKey, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+			DoAndReturn(func(_ context.Context, _ client.ObjectKey, mr *resourcesv1alpha1.ManagedResource, _ ...client.GetOption) error {
+				mr.Spec.Class = ptr.To("other")
+				return nil
+			})
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if the ManagedResource is ignored", func() {
+		c.EXPECT().Get(ctx, mrKey, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+			DoAndReturn(func(_ context.Context, _ client.ObjectKey, mr *resourcesv1alpha1.ManagedResource, _ ...client.GetOption) error {
+				mr.Spec.Class = ptr.To(filter.ResourceClass())
+				mr.Annotations = map[string]string{"resources.gardener.cloud/ignore": "true"}
+				return nil
+			})
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should correctly map to the origin ManagedResource", func() {
+		c.EXPECT().Get(ctx, mrKey, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+			DoAndReturn(func(_ context.Context, _ client.ObjectKey, mr *resourcesv1alpha1.ManagedResource, _ ...client.GetOption) error {
+				mr.Spec.Class = ptr.To(filter.ResourceClass())
+				return nil
+			})
+
+		requests := m.Map(ctx, logr.Discard(), c, obj)
+		Expect(requests).To(ConsistOf(reconcile.Request{NamespacedName: mrKey}))
+	})
+})
@@ -74,6 +74,10 @@ type Reconciler struct {
 	ClusterID                     string
 	GarbageCollectorActivated     bool
 	RequeueAfterOnDeletionPending *time.Duration
+
+	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
+	// ManagedResources when managed objects are changed or deleted. It is nil if watching managed objects is disabled.
+	ensureWatchForGVK func(gvk schema.GroupVersionKind, obj client.Object) error
 }
 
 // Reconcile manages the resources reference by ManagedResources.
@@ -357,6 +361,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
 	}
 
+	if err := r.ensureWatchesForManagedObjects(newResourcesObjectReferences); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if len(decodingErrors) != 0 {
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decodingErrors))
 	} else {
@@ -1010,6 +1018,35 @@ func mergeMaps(one, two map[string]string) map[string]string {
 	return out
 }
 
+func (r *Reconciler) ensureWatchesForManagedObjects(refs []resourcesv1alpha1.ObjectReference) error {
+	if r.ensureWatchForGVK == nil {
+		return nil
+	}
+
+	for _, ref := range refs {
+		gvk := ref.GroupVersionKind()
+
+		// Create a typed object if the GVK is registered in the target scheme, so that the informer is shared with the
+		// health controller. Otherwise, metadata-only watches are sufficient for detecting changes.
+		var obj client.Object
+		if typedObject, err := r.TargetScheme.New(gvk); err == nil {
+			obj = typedObject.(client.Object)
+		} else if runtime.IsNotRegisteredError(err) {
+			metadata := &metav1.PartialObjectMetadata{}
+			metadata.SetGroupVersionKind(gvk)
+			obj = metadata
+		} else {
+			return fmt.Errorf("failed to construct new object for GVK %s: %w", gvk.String(), err)
+		}
+
+		if err := r.ensureWatchForGVK(gvk, obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type object struct {
 	obj                       *unstructured.Unstructured
 	oldInformation            resourcesv1alpha1.ObjectReference
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("#ManagedObjectChanged", func() {
+	var p predicate.Predicate
+
+	BeforeEach(func() {
+		p = ManagedObjectChanged()
+	})
+
+	It("should not match create and generic events", func() {
+		Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+		Expect(p.Generic(event.GenericEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+	})
+
+	It("should match delete events", func() {
+		Expect(p.Delete(event.DeleteEvent{Object: &corev1.ConfigMap{}})).To(BeTrue())
+	})
+
+	Context("objects without generation", func() {
+		var oldObj, newObj *corev1.ConfigMap
+
+		BeforeEach(func() {
+			oldObj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"}}
+			newObj = oldObj.DeepCopy()
+		})
+
+		It("should not match if the resource version is unchanged", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+		})
+
+		It("should match if the resource version changed", func() {
+			newObj.ResourceVersion = "2"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+		})
+	})
+
+	Context("objects with generation", func() {
+		var oldObj, newObj *appsv1.Deployment
+
+		BeforeEach(func() {
+			oldObj = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1", Generation: 1}}
+			newObj = oldObj.DeepCopy()
+			newObj.ResourceVersion = "2"
+		})
+
+		It("should not match if only the status changed", func() {
+			newObj.Status.Replicas = 1
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+		})
+
+		It("should match if the generation changed", func() {
+			newObj.Generation = 2
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+		})
+
+		It("should match if the labels changed", func() {
+			newObj.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+		})
+
+		It("should match if the annotations changed", func() {
+			newObj.Annotations = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+		})
+	})
+})
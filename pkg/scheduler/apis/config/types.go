@@ -28,6 +28,8 @@ const (
 	SchedulerDefaultConfigurationConfigMapName = "gardener-scheduler-configmap"
 	// DefaultDiscoveryTTL is the default ttl for the cached discovery client.
 	DefaultDiscoveryTTL = 10 * time.Second
+	// DefaultMaxCapacityReportAge is the default maximum age of the capacity report of a seed.
+	DefaultMaxCapacityReportAge = 15 * time.Minute
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
@@ -36,6 +38,26 @@ var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
+const (
+	// ScorePluginRegionDistance prefers seeds whose regions have a small distance to the region of the shoot. The
+	// distances are taken from the region config of the cloud profile or, if not available, calculated based on the
+	// region names.
+	ScorePluginRegionDistance ScorePluginName = "RegionDistance"
+	// ScorePluginSeedCapacityUtilization prefers seeds with a low utilization of their capacity for shoots.
+	ScorePluginSeedCapacityUtilization ScorePluginName = "SeedCapacityUtilization"
+	// ScorePluginProviderAffinity prefers seeds with the same provider type as the shoot.
+	ScorePluginProviderAffinity ScorePluginName = "ProviderAffinity"
+	// ScorePluginResourceUtilization prefers seeds with a low utilization of the resources reported in their capacity
+	// report.
+	ScorePluginResourceUtilization ScorePluginName = "ResourceUtilization"
+)
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []ScorePluginName{ScorePluginRegionDistance, ScorePluginSeedCapacityUtilization, ScorePluginProviderAffinity, ScorePluginResourceUtilization}
+
+// ScorePluginName is the name of a plugin which scores seed candidates for shoots.
+type ScorePluginName string
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SchedulerConfiguration provides the configuration for the Gardener scheduler
@@ -103,6 +125,40 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// ScorePlugins is the list of score plugins together with their weights which are used to rank the seed candidates
+	// for shoots. If set, the seed with the highest weighted score is chosen and the candidate determination strategy
+	// is not considered.
+	ScorePlugins []ScorePluginConfiguration
+	// SeedResourcePressure contains thresholds for the utilization of the seeds' resources. Seeds reaching any of the
+	// thresholds are not considered as candidates for shoots.
+	SeedResourcePressure *SeedResourcePressureConfiguration
+}
+
+// SeedResourcePressureConfiguration contains thresholds for the utilization of the seeds' resources. All thresholds are
+// percentages between 1 and 100. Thresholds which are not set are not checked.
+type SeedResourcePressureConfiguration struct {
+	// Shoots is the threshold for the number of shoots scheduled to a seed relative to its allocatable shoots.
+	Shoots *int32
+	// CPU is the threshold for the requested CPU relative to the allocatable CPU of the seed's nodes.
+	CPU *int32
+	// Memory is the threshold for the requested memory relative to the allocatable memory of the seed's nodes.
+	Memory *int32
+	// NodePodCIDRs is the threshold for the number of pod CIDRs assigned to the seed's nodes relative to the number of
+	// pod CIDRs available in the seed's pod network.
+	NodePodCIDRs *int32
+	// LoadBalancers is the threshold for the number of services of type LoadBalancer in the seed relative to its quota.
+	LoadBalancers *int32
+	// MaxReportAge is the maximum age of the capacity report of a seed. The thresholds based on the report are not
+	// checked for seeds with older reports.
+	MaxReportAge *metav1.Duration
+}
+
+// ScorePluginConfiguration contains the configuration of a score plugin.
+type ScorePluginConfiguration struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName
+	// Weight is the weight of the scores of the plugin in the total score of a seed. Defaults to 1.
+	Weight *int32
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
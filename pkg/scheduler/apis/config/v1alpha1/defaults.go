@@ -5,7 +5,9 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -44,6 +46,16 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	for i := range obj.Shoot.ScorePlugins {
+		if obj.Shoot.ScorePlugins[i].Weight == nil {
+			obj.Shoot.ScorePlugins[i].Weight = ptr.To[int32](1)
+		}
+	}
+
+	if obj.Shoot.SeedResourcePressure != nil && obj.Shoot.SeedResourcePressure.MaxReportAge == nil {
+		obj.Shoot.SeedResourcePressure.MaxReportAge = &metav1.Duration{Duration: DefaultMaxCapacityReportAge}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -88,6 +88,41 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the weights of the score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				ScorePlugins: []schedulerv1alpha1.ScorePluginConfiguration{
+					{Name: schedulerv1alpha1.ScorePluginRegionDistance},
+					{Name: schedulerv1alpha1.ScorePluginProviderAffinity, Weight: ptr.To[int32](3)},
+				},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.ScorePlugins).To(Equal([]schedulerv1alpha1.ScorePluginConfiguration{
+				{Name: schedulerv1alpha1.ScorePluginRegionDistance, Weight: ptr.To[int32](1)},
+				{Name: schedulerv1alpha1.ScorePluginProviderAffinity, Weight: ptr.To[int32](3)},
+			}))
+		})
+
+		It("should default the maximum report age of the seed resource pressure configuration", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				SeedResourcePressure: &schedulerv1alpha1.SeedResourcePressureConfiguration{CPU: ptr.To[int32](90)},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SeedResourcePressure).To(Equal(&schedulerv1alpha1.SeedResourcePressureConfiguration{
+				CPU:          ptr.To[int32](90),
+				MaxReportAge: &metav1.Duration{Duration: 15 * time.Minute},
+			}))
+		})
+
+		It("should not default the seed resource pressure configuration if it is not set", func() {
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SeedResourcePressure).To(BeNil())
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
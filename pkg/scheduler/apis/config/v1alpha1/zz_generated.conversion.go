@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/scheduler/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -56,6 +57,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScorePluginConfiguration)(nil), (*config.ScorePluginConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScorePluginConfiguration_To_config_ScorePluginConfiguration(a.(*ScorePluginConfiguration), b.(*config.ScorePluginConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScorePluginConfiguration)(nil), (*ScorePluginConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScorePluginConfiguration_To_v1alpha1_ScorePluginConfiguration(a.(*config.ScorePluginConfiguration), b.(*ScorePluginConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedResourcePressureConfiguration)(nil), (*config.SeedResourcePressureConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedResourcePressureConfiguration_To_config_SeedResourcePressureConfiguration(a.(*SeedResourcePressureConfiguration), b.(*config.SeedResourcePressureConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedResourcePressureConfiguration)(nil), (*SeedResourcePressureConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedResourcePressureConfiguration_To_v1alpha1_SeedResourcePressureConfiguration(a.(*config.SeedResourcePressureConfiguration), b.(*SeedResourcePressureConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -209,6 +230,58 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ScorePluginConfiguration_To_config_ScorePluginConfiguration(in *ScorePluginConfiguration, out *config.ScorePluginConfiguration, s conversion.Scope) error {
+	out.Name = config.ScorePluginName(in.Name)
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_v1alpha1_ScorePluginConfiguration_To_config_ScorePluginConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ScorePluginConfiguration_To_config_ScorePluginConfiguration(in *ScorePluginConfiguration, out *config.ScorePluginConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScorePluginConfiguration_To_config_ScorePluginConfiguration(in, out, s)
+}
+
+func autoConvert_config_ScorePluginConfiguration_To_v1alpha1_ScorePluginConfiguration(in *config.ScorePluginConfiguration, out *ScorePluginConfiguration, s conversion.Scope) error {
+	out.Name = ScorePluginName(in.Name)
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_config_ScorePluginConfiguration_To_v1alpha1_ScorePluginConfiguration is an autogenerated conversion function.
+func Convert_config_ScorePluginConfiguration_To_v1alpha1_ScorePluginConfiguration(in *config.ScorePluginConfiguration, out *ScorePluginConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ScorePluginConfiguration_To_v1alpha1_ScorePluginConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedResourcePressureConfiguration_To_config_SeedResourcePressureConfiguration(in *SeedResourcePressureConfiguration, out *config.SeedResourcePressureConfiguration, s conversion.Scope) error {
+	out.Shoots = (*int32)(unsafe.Pointer(in.Shoots))
+	out.CPU = (*int32)(unsafe.Pointer(in.CPU))
+	out.Memory = (*int32)(unsafe.Pointer(in.Memory))
+	out.NodePodCIDRs = (*int32)(unsafe.Pointer(in.NodePodCIDRs))
+	out.LoadBalancers = (*int32)(unsafe.Pointer(in.LoadBalancers))
+	out.MaxReportAge = (*v1.Duration)(unsafe.Pointer(in.MaxReportAge))
+	return nil
+}
+
+// Convert_v1alpha1_SeedResourcePressureConfiguration_To_config_SeedResourcePressureConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedResourcePressureConfiguration_To_config_SeedResourcePressureConfiguration(in *SeedResourcePressureConfiguration, out *config.SeedResourcePressureConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedResourcePressureConfiguration_To_config_SeedResourcePressureConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedResourcePressureConfiguration_To_v1alpha1_SeedResourcePressureConfiguration(in *config.SeedResourcePressureConfiguration, out *SeedResourcePressureConfiguration, s conversion.Scope) error {
+	out.Shoots = (*int32)(unsafe.Pointer(in.Shoots))
+	out.CPU = (*int32)(unsafe.Pointer(in.CPU))
+	out.Memory = (*int32)(unsafe.Pointer(in.Memory))
+	out.NodePodCIDRs = (*int32)(unsafe.Pointer(in.NodePodCIDRs))
+	out.LoadBalancers = (*int32)(unsafe.Pointer(in.LoadBalancers))
+	out.MaxReportAge = (*v1.Duration)(unsafe.Pointer(in.MaxReportAge))
+	return nil
+}
+
+// Convert_config_SeedResourcePressureConfiguration_To_v1alpha1_SeedResourcePressureConfiguration is an autogenerated conversion function.
+func Convert_config_SeedResourcePressureConfiguration_To_v1alpha1_SeedResourcePressureConfiguration(in *config.SeedResourcePressureConfiguration, out *SeedResourcePressureConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedResourcePressureConfiguration_To_v1alpha1_SeedResourcePressureConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -256,6 +329,8 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.ScorePlugins = *(*[]config.ScorePluginConfiguration)(unsafe.Pointer(&in.ScorePlugins))
+	out.SeedResourcePressure = (*config.SeedResourcePressureConfiguration)(unsafe.Pointer(in.SeedResourcePressure))
 	return nil
 }
 
@@ -267,6 +342,8 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.ScorePlugins = *(*[]ScorePluginConfiguration)(unsafe.Pointer(&in.ScorePlugins))
+	out.SeedResourcePressure = (*SeedResourcePressureConfiguration)(unsafe.Pointer(in.SeedResourcePressure))
 	return nil
 }
 
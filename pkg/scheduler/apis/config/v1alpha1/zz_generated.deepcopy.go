@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -86,7 +87,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -101,6 +102,73 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScorePluginConfiguration) DeepCopyInto(out *ScorePluginConfiguration) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScorePluginConfiguration.
+func (in *ScorePluginConfiguration) DeepCopy() *ScorePluginConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ScorePluginConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedResourcePressureConfiguration) DeepCopyInto(out *SeedResourcePressureConfiguration) {
+	*out = *in
+	if in.Shoots != nil {
+		in, out := &in.Shoots, &out.Shoots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodePodCIDRs != nil {
+		in, out := &in.NodePodCIDRs, &out.NodePodCIDRs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReportAge != nil {
+		in, out := &in.MaxReportAge, &out.MaxReportAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedResourcePressureConfiguration.
+func (in *SeedResourcePressureConfiguration) DeepCopy() *SeedResourcePressureConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedResourcePressureConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -146,6 +214,18 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.ScorePlugins != nil {
+		in, out := &in.ScorePlugins, &out.ScorePlugins
+		*out = make([]ScorePluginConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SeedResourcePressure != nil {
+		in, out := &in.SeedResourcePressure, &out.SeedResourcePressure
+		*out = new(SeedResourcePressureConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -47,6 +47,8 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateScorePlugins(schedulers.Shoot.ScorePlugins, fldPath.Child("shoot", "scorePlugins"))...)
+		allErrs = append(allErrs, validateSeedResourcePressure(schedulers.Shoot.SeedResourcePressure, fldPath.Child("shoot", "seedResourcePressure"))...)
 	}
 
 	return allErrs
@@ -70,3 +72,62 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validateScorePlugins(scorePlugins []schedulerconfig.ScorePluginConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs              = field.ErrorList{}
+		supportedPlugins     = sets.New[schedulerconfig.ScorePluginName](schedulerconfig.ScorePlugins...)
+		supportedPluginNames []string
+		names                = sets.New[schedulerconfig.ScorePluginName]()
+	)
+
+	for _, p := range schedulerconfig.ScorePlugins {
+		supportedPluginNames = append(supportedPluginNames, string(p))
+	}
+
+	for i, plugin := range scorePlugins {
+		idxPath := fldPath.Index(i)
+
+		if !supportedPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, supportedPluginNames))
+		} else if names.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names.Insert(plugin.Name)
+
+		if plugin.Weight != nil && *plugin.Weight < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedResourcePressure(pressure *schedulerconfig.SeedResourcePressureConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if pressure == nil {
+		return allErrs
+	}
+
+	for _, threshold := range []struct {
+		name  string
+		value *int32
+	}{
+		{"shoots", pressure.Shoots},
+		{"cpu", pressure.CPU},
+		{"memory", pressure.Memory},
+		{"nodePodCIDRs", pressure.NodePodCIDRs},
+		{"loadBalancers", pressure.LoadBalancers},
+	} {
+		if threshold.value != nil && (*threshold.value < 1 || *threshold.value > 100) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(threshold.name), *threshold.value, "must be between 1 and 100"))
+		}
+	}
+
+	if pressure.MaxReportAge != nil && pressure.MaxReportAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReportAge"), pressure.MaxReportAge.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
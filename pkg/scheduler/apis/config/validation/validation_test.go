@@ -5,11 +5,14 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -92,6 +95,78 @@ var _ = Describe("gardener-scheduler", func() {
 					"Field": Equal("schedulers.shoot.concurrentSyncs"),
 				}))))
 			})
+
+			It("should pass because the score plugins are valid", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.ScorePlugins = []schedulerconfig.ScorePluginConfiguration{
+					{Name: schedulerconfig.ScorePluginRegionDistance, Weight: ptr.To[int32](2)},
+					{Name: schedulerconfig.ScorePluginSeedCapacityUtilization},
+					{Name: schedulerconfig.ScorePluginProviderAffinity, Weight: ptr.To[int32](1)},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the score plugins are invalid", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.ScorePlugins = []schedulerconfig.ScorePluginConfiguration{
+					{Name: "foo"},
+					{Name: schedulerconfig.ScorePluginRegionDistance, Weight: ptr.To[int32](0)},
+					{Name: schedulerconfig.ScorePluginRegionDistance},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("schedulers.shoot.scorePlugins[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.scorePlugins[1].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("schedulers.shoot.scorePlugins[2].name"),
+					})),
+				))
+			})
+
+			It("should pass because the seed resource pressure thresholds are valid", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.SeedResourcePressure = &schedulerconfig.SeedResourcePressureConfiguration{
+					Shoots:       ptr.To[int32](100),
+					CPU:          ptr.To[int32](90),
+					Memory:       ptr.To[int32](1),
+					MaxReportAge: &metav1.Duration{Duration: time.Minute},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the seed resource pressure thresholds are invalid", func() {
+				defaultAdmissionConfiguration.Schedulers.Shoot.SeedResourcePressure = &schedulerconfig.SeedResourcePressureConfiguration{
+					Shoots:        ptr.To[int32](0),
+					NodePodCIDRs:  ptr.To[int32](101),
+					LoadBalancers: ptr.To[int32](-1),
+					MaxReportAge:  &metav1.Duration{},
+				}
+
+				Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.seedResourcePressure.shoots"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.seedResourcePressure.nodePodCIDRs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.seedResourcePressure.loadBalancers"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.seedResourcePressure.maxReportAge"),
+					})),
+				))
+			})
 		})
 	})
 })
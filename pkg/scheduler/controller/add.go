@@ -5,6 +5,7 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -14,10 +15,10 @@ import (
 )
 
 // AddToManager adds all scheduler controllers to the given manager.
-func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration) error {
+func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.SchedulerConfiguration) error {
 	if err := (&shoot.Reconciler{
 		Config: cfg.Schedulers.Shoot,
-	}).AddToManager(mgr); err != nil {
+	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
@@ -5,10 +5,15 @@
 package shoot
 
 import (
+	"context"
+	"fmt"
+
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -21,16 +26,35 @@ import (
 const ControllerName = "shoot"
 
 // AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-scheduler")
 	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.Framework == nil {
+		framework, err := NewFramework(r.Config)
+		if err != nil {
+			return fmt.Errorf("failed creating scheduling framework: %w", err)
+		}
+		r.Framework = framework
+	}
+	if r.Snapshot == nil {
+		r.Snapshot = NewSeedSnapshot()
+		if err := r.Snapshot.Setup(ctx, mgr.GetCache()); err != nil {
+			return fmt.Errorf("failed setting up seed snapshot: %w", err)
+		}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -39,6 +63,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 			r.ShootPredicate(),
 			predicate.Not(predicateutils.IsDeleting()),
 		)).
+		// The snapshot does not emit events, it is only watched so that the controller waits for it to be synced before
+		// starting its workers.
+		WatchesRawSource(r.Snapshot, &handler.EnqueueRequestForObject{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.Config.ConcurrentSyncs,
 		}).
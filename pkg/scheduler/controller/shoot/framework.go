@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// MaxScore is the maximum score a ScorePlugin returns for a seed.
+const MaxScore int64 = 100
+
+// SchedulingContext contains the information about the shoot to be scheduled which is shared between the plugins.
+type SchedulingContext struct {
+	// Log is the logger of the current reconciliation.
+	Log logr.Logger
+	// Shoot is the shoot to be scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// RegionConfig is the region config for the cloud profile, if any.
+	RegionConfig *corev1.ConfigMap
+	// SeedUsage contains the number of shoots assigned to each seed.
+	SeedUsage map[string]int
+	// Now is the time of the scheduling decision.
+	Now time.Time
+}
+
+// Plugin is the common interface of all scheduling plugins.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin removes seeds which are not eligible for the shoot.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns the seeds which are eligible for the shoot. It returns an error describing the reason if none of
+	// the given seeds is eligible.
+	Filter(ctx context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+// ScorePlugin ranks the seeds which are eligible for the shoot.
+type ScorePlugin interface {
+	Plugin
+	// Score returns a score between 0 and MaxScore for each of the given seeds, in the same order as the seeds.
+	Score(ctx context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error)
+}
+
+// WeightedScorePlugin is a ScorePlugin together with the weight of its scores.
+type WeightedScorePlugin struct {
+	ScorePlugin
+	// Weight is the factor the scores of the plugin are multiplied with.
+	Weight int64
+}
+
+// Framework runs the filter and score plugins for a shoot.
+type Framework struct {
+	// FilterPlugins are run in the given order, each of them receives the seeds which passed the previous ones.
+	FilterPlugins []FilterPlugin
+	// ScorePlugins are used to rank the seeds which passed all filter plugins.
+	ScorePlugins []WeightedScorePlugin
+}
+
+// NewFramework returns a Framework with the default filter plugins and the score plugins enabled in the given
+// configuration. If thresholds for the utilization of the seeds' resources are configured, the SeedResourcePressure
+// filter plugin is run after the default ones.
+func NewFramework(cfg *config.ShootSchedulerConfiguration) (*Framework, error) {
+	f := &Framework{FilterPlugins: DefaultFilterPlugins()}
+
+	if cfg == nil {
+		return f, nil
+	}
+
+	if cfg.SeedResourcePressure != nil {
+		f.FilterPlugins = append(f.FilterPlugins, &seedResourcePressure{config: cfg.SeedResourcePressure})
+	}
+
+	for _, pluginConfig := range cfg.ScorePlugins {
+		plugin, err := NewScorePlugin(pluginConfig.Name, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		weight := int64(1)
+		if pluginConfig.Weight != nil {
+			weight = int64(*pluginConfig.Weight)
+		}
+
+		f.ScorePlugins = append(f.ScorePlugins, WeightedScorePlugin{ScorePlugin: plugin, Weight: weight})
+	}
+
+	return f, nil
+}
+
+// NewScorePlugin returns the built-in score plugin with the given name. The given configuration is used by plugins
+// which depend on further settings, it may be nil.
+func NewScorePlugin(name config.ScorePluginName, cfg *config.ShootSchedulerConfiguration) (ScorePlugin, error) {
+	switch name {
+	case config.ScorePluginRegionDistance:
+		return &regionDistance{}, nil
+	case config.ScorePluginSeedCapacityUtilization:
+		return &seedCapacityUtilization{}, nil
+	case config.ScorePluginProviderAffinity:
+		return &providerAffinity{}, nil
+	case config.ScorePluginResourceUtilization:
+		var pressure *config.SeedResourcePressureConfiguration
+		if cfg != nil {
+			pressure = cfg.SeedResourcePressure
+		}
+		return &resourceUtilization{maxReportAge: maxReportAge(pressure)}, nil
+	default:
+		return nil, fmt.Errorf("unknown score plugin %q, valid score plugins are: %v", name, config.ScorePlugins)
+	}
+}
+
+// RunFilterPlugins runs all filter plugins and returns the seeds which passed all of them.
+func (f *Framework) RunFilterPlugins(ctx context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	var err error
+
+	for _, plugin := range f.FilterPlugins {
+		if seeds, err = plugin.Filter(ctx, sc, seeds); err != nil {
+			return nil, err
+		}
+	}
+
+	return seeds, nil
+}
+
+// RunScorePlugins runs all score plugins and returns the weighted sum of their scores for each of the given seeds, in
+// the same order as the seeds.
+func (f *Framework) RunScorePlugins(ctx context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	totalScores := make([]int64, len(seeds))
+
+	for _, plugin := range f.ScorePlugins {
+		scores, err := plugin.Score(ctx, sc, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %s: %w", plugin.Name(), err)
+		}
+		if len(scores) != len(seeds) {
+			return nil, fmt.Errorf("score plugin %s returned %d scores for %d seeds", plugin.Name(), len(scores), len(seeds))
+		}
+
+		for i, score := range scores {
+			if score < 0 || score > MaxScore {
+				return nil, fmt.Errorf("score plugin %s returned invalid score %d for seed %s, must be between 0 and %d", plugin.Name(), score, seeds[i].Name, MaxScore)
+			}
+			totalScores[i] += plugin.Weight * score
+		}
+	}
+
+	return totalScores, nil
+}
+
+// filterPlugin adapts a filter function to the FilterPlugin interface.
+type filterPlugin struct {
+	name   string
+	filter func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+func (p *filterPlugin) Name() string {
+	return p.name
+}
+
+func (p *filterPlugin) Filter(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return p.filter(sc, seeds)
+}
+
+// DefaultFilterPlugins returns the filter plugins which are always run by the scheduler.
+func DefaultFilterPlugins() []FilterPlugin {
+	return []FilterPlugin{
+		&filterPlugin{name: "UsableSeeds", filter: func(_ *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterUsableSeeds(seeds)
+		}},
+		&filterPlugin{name: "CloudProfileSeedSelector", filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sc.CloudProfile.Spec.SeedSelector, "CloudProfile")
+		}},
+		&filterPlugin{name: "ShootSeedSelector", filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingLabelSelector(seeds, sc.Shoot.Spec.SeedSelector, "Shoot")
+		}},
+		&filterPlugin{name: "ProviderTypes", filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsMatchingProviders(sc.CloudProfile, sc.Shoot, seeds)
+		}},
+		&filterPlugin{name: "ZonalControlPlane", filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterSeedsForZonalShootControlPlanes(seeds, sc.Shoot)
+		}},
+		&filterPlugin{name: "SeedCandidates", filter: func(sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+			return filterCandidates(sc.Shoot, sc.SeedUsage, seeds)
+		}},
+	}
+}
+
+// getSeedWithHighestScore returns the seed with the highest score. If multiple seeds have the highest score, the one
+// managing the smallest number of shoots is chosen.
+func getSeedWithHighestScore(seeds []gardencorev1beta1.Seed, scores []int64, seedUsage map[string]int) *gardencorev1beta1.Seed {
+	var best int
+
+	for i := range seeds {
+		if scores[i] > scores[best] || (scores[i] == scores[best] && seedUsage[seeds[i].Name] < seedUsage[seeds[best].Name]) {
+			best = i
+		}
+	}
+
+	return &seeds[best]
+}
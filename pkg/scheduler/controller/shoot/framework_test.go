@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+type fakeScorePlugin struct {
+	scores []int64
+	err    error
+}
+
+func (f *fakeScorePlugin) Name() string { return "fake" }
+
+func (f *fakeScorePlugin) Score(_ context.Context, _ *SchedulingContext, _ []gardencorev1beta1.Seed) ([]int64, error) {
+	return f.scores, f.err
+}
+
+func capacityReport(lastUpdateTime time.Time, requestedCPU, allocatableCPU string, loadBalancers, loadBalancerQuota int32) *gardencorev1beta1.SeedCapacityReport {
+	return &gardencorev1beta1.SeedCapacityReport{
+		LastUpdateTime: metav1.NewTime(lastUpdateTime),
+		CPU: &gardencorev1beta1.SeedResourceUtilization{
+			Allocatable: resource.MustParse(allocatableCPU),
+			Requested:   resource.MustParse(requestedCPU),
+		},
+		LoadBalancers: &gardencorev1beta1.SeedPoolUtilization{
+			Capacity:  &loadBalancerQuota,
+			Allocated: loadBalancers,
+		},
+	}
+}
+
+var _ = Describe("Framework", func() {
+	var (
+		ctx   = context.Background()
+		now   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		sc    *SchedulingContext
+		seeds []gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name, providerType, region string) gardencorev1beta1.Seed {
+		return gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: providerType, Region: region},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		sc = &SchedulingContext{
+			Log: logr.Discard(),
+			Now: now,
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{Type: "foo"},
+					Region:   "europe-west1",
+				},
+			},
+			CloudProfile: &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}},
+			SeedUsage:    map[string]int{},
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			newSeed("seed-1", "foo", "europe-west1"),
+			newSeed("seed-2", "bar", "europe-west2"),
+			newSeed("seed-3", "foo", "asia-east1"),
+		}
+	})
+
+	Describe("#NewFramework", func() {
+		It("should return a framework with the default filter plugins and without score plugins", func() {
+			framework, err := NewFramework(&config.ShootSchedulerConfiguration{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(framework.FilterPlugins).To(HaveLen(len(DefaultFilterPlugins())))
+			Expect(framework.ScorePlugins).To(BeEmpty())
+		})
+
+		It("should return a framework with the configured score plugins", func() {
+			framework, err := NewFramework(&config.ShootSchedulerConfiguration{
+				ScorePlugins: []config.ScorePluginConfiguration{
+					{Name: config.ScorePluginRegionDistance, Weight: ptr.To[int32](2)},
+					{Name: config.ScorePluginProviderAffinity},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(framework.ScorePlugins).To(HaveLen(2))
+			Expect(framework.ScorePlugins[0].Name()).To(Equal("RegionDistance"))
+			Expect(framework.ScorePlugins[0].Weight).To(Equal(int64(2)))
+			Expect(framework.ScorePlugins[1].Name()).To(Equal("ProviderAffinity"))
+			Expect(framework.ScorePlugins[1].Weight).To(Equal(int64(1)))
+		})
+
+		It("should add the resource pressure filter plugin if thresholds are configured", func() {
+			framework, err := NewFramework(&config.ShootSchedulerConfiguration{
+				SeedResourcePressure: &config.SeedResourcePressureConfiguration{CPU: ptr.To[int32](90)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(framework.FilterPlugins).To(HaveLen(len(DefaultFilterPlugins()) + 1))
+			Expect(framework.FilterPlugins[len(framework.FilterPlugins)-1].Name()).To(Equal("SeedResourcePressure"))
+		})
+
+		It("should fail for unknown score plugins", func() {
+			_, err := NewFramework(&config.ShootSchedulerConfiguration{
+				ScorePlugins: []config.ScorePluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unknown score plugin "foo"`)))
+		})
+	})
+
+	Describe("#RunScorePlugins", func() {
+		It("should return the weighted sum of the scores", func() {
+			framework := &Framework{ScorePlugins: []WeightedScorePlugin{
+				{ScorePlugin: &fakeScorePlugin{scores: []int64{100, 50, 0}}, Weight: 1},
+				{ScorePlugin: &fakeScorePlugin{scores: []int64{0, 50, 100}}, Weight: 3},
+			}}
+
+			Expect(framework.RunScorePlugins(ctx, sc, seeds)).To(Equal([]int64{100, 200, 300}))
+		})
+
+		It("should fail if a plugin fails", func() {
+			framework := &Framework{ScorePlugins: []WeightedScorePlugin{
+				{ScorePlugin: &fakeScorePlugin{err: errors.New("fake")}, Weight: 1},
+			}}
+
+			_, err := framework.RunScorePlugins(ctx, sc, seeds)
+			Expect(err).To(MatchError(ContainSubstring("failed running score plugin fake")))
+		})
+
+		It("should fail if a plugin returns an invalid number of scores", func() {
+			framework := &Framework{ScorePlugins: []WeightedScorePlugin{
+				{ScorePlugin: &fakeScorePlugin{scores: []int64{100}}, Weight: 1},
+			}}
+
+			_, err := framework.RunScorePlugins(ctx, sc, seeds)
+			Expect(err).To(MatchError(ContainSubstring("returned 1 scores for 3 seeds")))
+		})
+
+		It("should fail if a plugin returns an invalid score", func() {
+			framework := &Framework{ScorePlugins: []WeightedScorePlugin{
+				{ScorePlugin: &fakeScorePlugin{scores: []int64{100, 101, 0}}, Weight: 1},
+			}}
+
+			_, err := framework.RunScorePlugins(ctx, sc, seeds)
+			Expect(err).To(MatchError(ContainSubstring("invalid score 101 for seed seed-2")))
+		})
+	})
+
+	Describe("#getSeedWithHighestScore", func() {
+		It("should return the seed with the highest score", func() {
+			Expect(getSeedWithHighestScore(seeds, []int64{10, 30, 20}, sc.SeedUsage).Name).To(Equal("seed-2"))
+		})
+
+		It("should return the seed with the least shoots in case of equal scores", func() {
+			sc.SeedUsage = map[string]int{"seed-1": 2, "seed-2": 1}
+			Expect(getSeedWithHighestScore(seeds, []int64{30, 30, 30}, sc.SeedUsage).Name).To(Equal("seed-3"))
+		})
+	})
+
+	Describe("score plugins", func() {
+		score := func(name config.ScorePluginName) []int64 {
+			plugin, err := NewScorePlugin(name, &config.ShootSchedulerConfiguration{})
+			Expect(err).NotTo(HaveOccurred())
+			scores, err := plugin.Score(ctx, sc, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			return scores
+		}
+
+		Describe("RegionDistance", func() {
+			It("should score the seeds based on the region names", func() {
+				Expect(score(config.ScorePluginRegionDistance)).To(Equal([]int64{100, 85, 0}))
+			})
+
+			It("should score the seeds based on the region config", func() {
+				sc.RegionConfig = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "region-config", Namespace: v1beta1constants.GardenNamespace},
+					Data: map[string]string{
+						"europe-west1": "europe-west2: 50\nasia-east1: 200",
+					},
+				}
+
+				Expect(score(config.ScorePluginRegionDistance)).To(Equal([]int64{100, 75, 0}))
+			})
+
+			It("should give zero to seeds whose regions are not contained in the region config", func() {
+				sc.RegionConfig = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "region-config", Namespace: v1beta1constants.GardenNamespace},
+					Data: map[string]string{
+						"europe-west1": "europe-west2: 50",
+					},
+				}
+
+				Expect(score(config.ScorePluginRegionDistance)).To(Equal([]int64{100, 0, 0}))
+			})
+		})
+
+		Describe("SeedCapacityUtilization", func() {
+			It("should score the seeds based on their utilization", func() {
+				seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("4")}
+				sc.SeedUsage = map[string]int{"seed-1": 1, "seed-2": 4, "seed-3": 2}
+
+				Expect(score(config.ScorePluginSeedCapacityUtilization)).To(Equal([]int64{75, 0, 50}))
+			})
+
+			It("should give the maximum score to all seeds if no shoots are assigned", func() {
+				Expect(score(config.ScorePluginSeedCapacityUtilization)).To(Equal([]int64{100, 100, 100}))
+			})
+		})
+
+		Describe("ProviderAffinity", func() {
+			It("should prefer seeds with the same provider type", func() {
+				Expect(score(config.ScorePluginProviderAffinity)).To(Equal([]int64{100, 0, 100}))
+			})
+		})
+
+		Describe("ResourceUtilization", func() {
+			It("should score the seeds based on their highest reported utilization", func() {
+				seeds[0].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "2", "10", 10, 100)
+				seeds[1].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "2", "10", 90, 100)
+
+				Expect(score(config.ScorePluginResourceUtilization)).To(Equal([]int64{80, 10, 50}))
+			})
+
+			It("should give half of the maximum score to seeds with outdated capacity reports", func() {
+				seeds[0].Status.CapacityReport = capacityReport(now.Add(-time.Hour), "2", "10", 10, 100)
+
+				Expect(score(config.ScorePluginResourceUtilization)).To(Equal([]int64{50, 50, 50}))
+			})
+		})
+	})
+
+	Describe("SeedResourcePressure", func() {
+		var plugin *seedResourcePressure
+
+		BeforeEach(func() {
+			plugin = &seedResourcePressure{config: &config.SeedResourcePressureConfiguration{
+				Shoots:        ptr.To[int32](80),
+				CPU:           ptr.To[int32](90),
+				LoadBalancers: ptr.To[int32](90),
+				MaxReportAge:  &metav1.Duration{Duration: 10 * time.Minute},
+			}}
+		})
+
+		It("should filter seeds whose utilization reached a threshold", func() {
+			seeds[0].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "8", "10", 10, 100)
+			seeds[1].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "9", "10", 10, 100)
+			seeds[2].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "1", "10", 95, 100)
+
+			filtered, err := plugin.Filter(ctx, sc, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(HaveLen(1))
+			Expect(filtered[0].Name).To(Equal("seed-1"))
+		})
+
+		It("should filter seeds whose number of shoots reached the threshold", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			sc.SeedUsage = map[string]int{"seed-1": 8, "seed-2": 7, "seed-3": 100}
+
+			filtered, err := plugin.Filter(ctx, sc, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(HaveLen(2))
+			Expect(filtered[0].Name).To(Equal("seed-2"))
+			Expect(filtered[1].Name).To(Equal("seed-3"))
+		})
+
+		It("should not check the reported resources if the capacity report is outdated", func() {
+			for i := range seeds {
+				seeds[i].Status.CapacityReport = capacityReport(now.Add(-time.Hour), "10", "10", 100, 100)
+			}
+
+			Expect(plugin.Filter(ctx, sc, seeds)).To(HaveLen(3))
+		})
+
+		It("should fail if all seeds are under resource pressure", func() {
+			for i := range seeds {
+				seeds[i].Status.CapacityReport = capacityReport(now.Add(-time.Minute), "10", "10", 0, 100)
+			}
+
+			_, err := plugin.Filter(ctx, sc, seeds)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("0/3 seed cluster candidate(s) are eligible for scheduling"),
+				ContainSubstring("seed-1 => seed is under cpu pressure (utilization 100%, threshold 90%)"),
+			)))
+		})
+	})
+})
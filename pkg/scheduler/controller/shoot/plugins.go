@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"math"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// regionDistance scores seeds based on the distance of their regions to the region of the shoot. The distances are
+// taken from the region config if it contains the shoot region, otherwise they are calculated based on the region
+// names (see distance). The seeds with the smallest distance get the maximum score, the ones with the largest distance
+// get zero. Seeds whose regions are not contained in the region config get zero as well.
+type regionDistance struct{}
+
+func (p *regionDistance) Name() string {
+	return string(config.ScorePluginRegionDistance)
+}
+
+func (p *regionDistance) Score(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	regionConfigData, err := regionConfigDistances(sc.RegionConfig, sc.Shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		distances   = make([]int, len(seeds))
+		known       = make([]bool, len(seeds))
+		minDistance = math.MaxInt
+		maxDistance = math.MinInt
+	)
+
+	for i, seed := range seeds {
+		if regionConfigData != nil {
+			distances[i], known[i] = regionConfigData[seed.Spec.Provider.Region]
+		} else {
+			distances[i], known[i] = distance(seed.Spec.Provider.Region, sc.Shoot.Spec.Region), true
+		}
+
+		if known[i] {
+			minDistance = min(minDistance, distances[i])
+			maxDistance = max(maxDistance, distances[i])
+		}
+	}
+
+	scores := make([]int64, len(seeds))
+	for i := range seeds {
+		switch {
+		case !known[i]:
+			scores[i] = 0
+		case maxDistance == minDistance:
+			scores[i] = MaxScore
+		default:
+			scores[i] = MaxScore * int64(maxDistance-distances[i]) / int64(maxDistance-minDistance)
+		}
+	}
+
+	return scores, nil
+}
+
+// seedCapacityUtilization scores seeds based on the utilization of their capacity for shoots, i.e., seeds with a low
+// utilization get a high score. For seeds without allocatable shoots, the utilization is calculated relative to the
+// seed managing the most shoots.
+type seedCapacityUtilization struct{}
+
+func (p *seedCapacityUtilization) Name() string {
+	return string(config.ScorePluginSeedCapacityUtilization)
+}
+
+func (p *seedCapacityUtilization) Score(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	var maxUsage int
+	for _, seed := range seeds {
+		maxUsage = max(maxUsage, sc.SeedUsage[seed.Name])
+	}
+
+	scores := make([]int64, len(seeds))
+	for i, seed := range seeds {
+		var (
+			usage       = sc.SeedUsage[seed.Name]
+			utilization float64
+		)
+
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && allocatableShoots.Value() > 0 {
+			utilization = float64(usage) / float64(allocatableShoots.Value())
+		} else if maxUsage > 0 {
+			utilization = float64(usage) / float64(maxUsage)
+		}
+
+		scores[i] = int64(math.Round(float64(MaxScore) * (1 - min(utilization, 1))))
+	}
+
+	return scores, nil
+}
+
+// providerAffinity gives the maximum score to seeds with the same provider type as the shoot and zero to all others.
+type providerAffinity struct{}
+
+func (p *providerAffinity) Name() string {
+	return string(config.ScorePluginProviderAffinity)
+}
+
+func (p *providerAffinity) Score(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	scores := make([]int64, len(seeds))
+	for i, seed := range seeds {
+		if seed.Spec.Provider.Type == sc.Shoot.Spec.Provider.Type {
+			scores[i] = MaxScore
+		}
+	}
+
+	return scores, nil
+}
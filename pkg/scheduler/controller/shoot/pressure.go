@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// SeedResourcePressureFilterPluginName is the name of the filter plugin which is enabled if thresholds for the
+// utilization of the seeds' resources are configured.
+const SeedResourcePressureFilterPluginName = "SeedResourcePressure"
+
+const (
+	resourceShoots        = "shoots"
+	resourceCPU           = "cpu"
+	resourceMemory        = "memory"
+	resourceNodePodCIDRs  = "nodePodCIDRs"
+	resourceLoadBalancers = "loadBalancers"
+)
+
+// reportedUtilizations returns the utilizations (between 0 and 1) of the resources contained in the capacity report of
+// the given seed. It returns nil if the seed has no capacity report or if the report is older than the given maximum
+// age, i.e., if the utilizations are unknown.
+func reportedUtilizations(seed *gardencorev1beta1.Seed, now time.Time, maxReportAge time.Duration) map[string]float64 {
+	report := seed.Status.CapacityReport
+	if report == nil || now.Sub(report.LastUpdateTime.Time) > maxReportAge {
+		return nil
+	}
+
+	utilizations := make(map[string]float64)
+
+	for name, resource := range map[string]*gardencorev1beta1.SeedResourceUtilization{
+		resourceCPU:    report.CPU,
+		resourceMemory: report.Memory,
+	} {
+		if resource != nil && resource.Allocatable.Sign() > 0 {
+			utilizations[name] = resource.Requested.AsApproximateFloat64() / resource.Allocatable.AsApproximateFloat64()
+		}
+	}
+
+	for name, pool := range map[string]*gardencorev1beta1.SeedPoolUtilization{
+		resourceNodePodCIDRs:  report.NodePodCIDRs,
+		resourceLoadBalancers: report.LoadBalancers,
+	} {
+		if pool != nil && pool.Capacity != nil && *pool.Capacity > 0 {
+			utilizations[name] = float64(pool.Allocated) / float64(*pool.Capacity)
+		}
+	}
+
+	return utilizations
+}
+
+func maxReportAge(cfg *config.SeedResourcePressureConfiguration) time.Duration {
+	if cfg == nil || cfg.MaxReportAge == nil {
+		return config.DefaultMaxCapacityReportAge
+	}
+	return cfg.MaxReportAge.Duration
+}
+
+// seedResourcePressure removes seeds whose utilization reached any of the configured thresholds. The number of shoots
+// is compared with the allocatable shoots of the seed, all other resources are taken from the capacity report of the
+// seed. Thresholds for the reported resources are not checked if the report is missing or outdated.
+type seedResourcePressure struct {
+	config *config.SeedResourcePressureConfiguration
+}
+
+func (p *seedResourcePressure) Name() string {
+	return SeedResourcePressureFilterPluginName
+}
+
+func (p *seedResourcePressure) Filter(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	var (
+		candidates      []gardencorev1beta1.Seed
+		candidateErrors = make(map[string]error)
+	)
+
+	for _, seed := range seeds {
+		if err := p.checkSeed(&seed, sc.SeedUsage, sc.Now); err != nil {
+			candidateErrors[seed.Name] = err
+			continue
+		}
+		candidates = append(candidates, seed)
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seeds), errorMapToString(candidateErrors))
+	}
+	return candidates, nil
+}
+
+// checkSeed returns an error if the utilization of any of the seed's resources reached the configured threshold.
+func (p *seedResourcePressure) checkSeed(seed *gardencorev1beta1.Seed, seedUsage map[string]int, now time.Time) error {
+	utilizations := reportedUtilizations(seed, now, maxReportAge(p.config))
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && allocatableShoots.Value() > 0 {
+		if utilizations == nil {
+			utilizations = make(map[string]float64)
+		}
+		utilizations[resourceShoots] = float64(seedUsage[seed.Name]) / float64(allocatableShoots.Value())
+	}
+
+	for _, threshold := range []struct {
+		resource string
+		value    *int32
+	}{
+		{resourceShoots, p.config.Shoots},
+		{resourceCPU, p.config.CPU},
+		{resourceMemory, p.config.Memory},
+		{resourceNodePodCIDRs, p.config.NodePodCIDRs},
+		{resourceLoadBalancers, p.config.LoadBalancers},
+	} {
+		utilization, ok := utilizations[threshold.resource]
+		if threshold.value == nil || !ok {
+			continue
+		}
+
+		if percentage := int32(math.Floor(100 * utilization)); percentage >= *threshold.value {
+			return fmt.Errorf("seed is under %s pressure (utilization %d%%, threshold %d%%)", threshold.resource, percentage, *threshold.value)
+		}
+	}
+
+	return nil
+}
+
+// resourceUtilization scores seeds based on the highest utilization of the resources contained in their capacity
+// report, i.e., seeds nearing the limits of any of their resources get a low score. Seeds without an up-to-date
+// capacity report get half of the maximum score.
+type resourceUtilization struct {
+	maxReportAge time.Duration
+}
+
+func (p *resourceUtilization) Name() string {
+	return string(config.ScorePluginResourceUtilization)
+}
+
+func (p *resourceUtilization) Score(_ context.Context, sc *SchedulingContext, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	scores := make([]int64, len(seeds))
+
+	for i, seed := range seeds {
+		utilizations := reportedUtilizations(&seed, sc.Now, p.maxReportAge)
+		if len(utilizations) == 0 {
+			scores[i] = MaxScore / 2
+			continue
+		}
+
+		var highestUtilization float64
+		for _, utilization := range utilizations {
+			highestUtilization = max(highestUtilization, utilization)
+		}
+
+		scores[i] = int64(math.Round(float64(MaxScore) * (1 - min(highestUtilization, 1))))
+	}
+
+	return scores, nil
+}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -35,9 +36,13 @@ import (
 // Reconciler schedules shoots to seeds.
 type Reconciler struct {
 	Client          client.Client
+	APIReader       client.Reader
 	Config          *config.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        record.EventRecorder
+	Snapshot        *SeedSnapshot
+	Framework       *Framework
+	Clock           clock.Clock
 }
 
 // Reconcile schedules shoots to seeds.
@@ -73,11 +78,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
 	}
 
+	// The seed was determined based on the snapshot which might be outdated, hence re-validate it before binding the
+	// shoot to it. If it is no longer eligible, the shoot is requeued, so that it is scheduled based on the updated snapshot.
+	if err := r.revalidateSeed(ctx, seed.Name); err != nil {
+		log.Info("Determined seed is no longer eligible for scheduling, requeueing", "seed", seed.Name, "reason", err.Error())
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	shoot.Spec.SeedName = &seed.Name
 	if err = r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to bind shoot to seed: %w", err)
 	}
+	r.Snapshot.Assume(client.ObjectKeyFromObject(shoot), seed.Name)
 
 	log.Info(
 		"Shoot successfully scheduled to seed",
@@ -87,13 +100,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"strategy", r.Config.Strategy,
 	)
 
-	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
+	r.reportEvent(ctx, shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
 	return reconcile.Result{}, nil
 }
 
 func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, err error) {
 	description := "Failed to schedule Shoot: " + err.Error()
-	r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, description)
+	r.reportEvent(ctx, shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, description)
 
 	patch := client.MergeFrom(shoot.DeepCopy())
 	if shoot.Status.LastOperation == nil {
@@ -108,8 +121,8 @@ func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger
 	}
 }
 
-func (r *Reconciler) reportEvent(shoot *gardencorev1beta1.Shoot, eventType string, eventReason, messageFmt string, args ...any) {
-	r.Recorder.Eventf(shoot, eventType, eventReason, messageFmt, args...)
+func (r *Reconciler) reportEvent(ctx context.Context, shoot *gardencorev1beta1.Shoot, eventType string, eventReason, messageFmt string, args ...any) {
+	controllerutils.RecordEventf(ctx, r.Recorder, shoot, eventType, eventReason, messageFmt, args...)
 }
 
 // determineSeed returns an appropriate Seed cluster (or nil).
@@ -121,16 +134,10 @@ func (r *Reconciler) determineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
-	seedList := &gardencorev1beta1.SeedList{}
-	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
-	}
-	sl := &gardencorev1beta1.ShootList{}
-	if err := r.Client.List(ctx, sl); err != nil {
-		return nil, err
-	}
-
-	shootList := v1beta1helper.ConvertShootList(sl.Items)
+	var (
+		seeds     = r.Snapshot.Seeds()
+		seedUsage = r.Snapshot.Usage()
+	)
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
@@ -141,35 +148,67 @@ func (r *Reconciler) determineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
+	sc := &SchedulingContext{
+		Log:          log,
+		Shoot:        shoot,
+		CloudProfile: cloudProfile,
+		RegionConfig: regionConfig,
+		SeedUsage:    seedUsage,
+		Now:          r.Clock.Now(),
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+
+	filteredSeeds, err := r.Framework.RunFilterPlugins(ctx, sc, seeds)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
+
+	// If score plugins are configured, they rank all remaining seeds instead of the candidate determination strategy.
+	if len(r.Framework.ScorePlugins) > 0 {
+		scores, err := r.Framework.RunScorePlugins(ctx, sc, filteredSeeds)
+		if err != nil {
+			return nil, err
+		}
+		return getSeedWithHighestScore(filteredSeeds, scores, seedUsage), nil
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
+
+	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+	return getSeedWithLeastShootsDeployed(filteredSeeds, seedUsage)
+}
+
+// revalidateSeed reads the seed with the given name from the API server and checks whether it is still usable, has
+// available capacity for another shoot and is not under resource pressure.
+func (r *Reconciler) revalidateSeed(ctx context.Context, name string) error {
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Name: name}, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Snapshot.deleteSeed(name)
+		}
+		return err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
-	if err != nil {
-		return nil, err
+
+	// Refresh the seed in the snapshot, so that the shoot is not scheduled based on the same outdated state again
+	// when it is requeued.
+	r.Snapshot.upsertSeed(seed)
+
+	if !isUsableSeed(seed) {
+		return errors.New("seed is not usable for scheduling (not deleting, visible and ready)")
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+
+	if !hasAvailableCapacity(seed, r.Snapshot.Usage()) {
+		return errors.New("seed does not have available capacity for shoots")
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	if r.Config.SeedResourcePressure != nil {
+		pressure := &seedResourcePressure{config: r.Config.SeedResourcePressure}
+		if err := pressure.checkSeed(seed, r.Snapshot.Usage(), r.Clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -308,11 +347,10 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, seedUsage map[string]int, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
 	var (
 		candidates      []gardencorev1beta1.Seed
 		candidateErrors = make(map[string]error)
-		seedUsage       = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
@@ -328,7 +366,7 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 			continue
 		}
 
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+		if !hasAvailableCapacity(&seed, seedUsage) {
 			candidateErrors[seed.Name] = errors.New("seed does not have available capacity for shoots")
 			continue
 		}
@@ -342,12 +380,16 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
+func hasAvailableCapacity(seed *gardencorev1beta1.Seed, seedUsage map[string]int) bool {
+	allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+	return !ok || int64(seedUsage[seed.Name]) < allocatableShoots.Value()
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, seedUsage map[string]int) (*gardencorev1beta1.Seed, error) {
 	var (
 		bestCandidate gardencorev1beta1.Seed
 		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
@@ -411,21 +453,15 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+	regionConfigData, err := regionConfigDistances(regionConfig, shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+	if regionConfigData == nil {
 		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
 		return candidates, nil
 	}
 
-	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
-	}
-
-	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
-	}
-
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
 		dist, ok := regionConfigData[seed.Spec.Provider.Region]
@@ -448,6 +484,26 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// regionConfigDistances returns the distances of the seed regions to the given shoot region as configured in the given
+// region config. It returns nil if the region config is not provided or does not contain the shoot region.
+func regionConfigDistances(regionConfig *corev1.ConfigMap, shootRegion string) (map[string]int, error) {
+	if regionConfig == nil || regionConfig.Data[shootRegion] == "" {
+		return nil, nil
+	}
+
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shootRegion]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shootRegion, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[shootRegion]; !ok {
+		regionConfigData[shootRegion] = 0
+	}
+
+	return regionConfigData, nil
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
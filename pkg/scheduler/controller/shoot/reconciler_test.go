@@ -6,6 +6,7 @@ package shoot
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -27,6 +29,7 @@ import (
 var _ = Describe("Scheduler_Control", func() {
 	var (
 		ctx              = context.Background()
+		now              = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 		log              logr.Logger
 		ctrl             *gomock.Controller
 		fakeGardenClient client.Client
@@ -123,12 +126,37 @@ var _ = Describe("Scheduler_Control", func() {
 	})
 
 	JustBeforeEach(func() {
+		framework, err := NewFramework(schedulerConfiguration.Schedulers.Shoot)
+		Expect(err).NotTo(HaveOccurred())
+
 		reconciler = &Reconciler{
-			Client: fakeGardenClient,
-			Config: schedulerConfiguration.Schedulers.Shoot,
+			Client:    fakeGardenClient,
+			APIReader: fakeGardenClient,
+			Config:    schedulerConfiguration.Schedulers.Shoot,
+			Snapshot:  NewSeedSnapshot(),
+			Framework: framework,
+			Clock:     testclock.NewFakeClock(now),
 		}
 	})
 
+	// determineSeed fills the snapshot with the seeds and shoots of the fake client (which would usually be done by the
+	// informer event handlers) before determining the seed for the given shoot.
+	determineSeed := func(shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+		seedList := &gardencorev1beta1.SeedList{}
+		Expect(fakeGardenClient.List(ctx, seedList)).To(Succeed())
+		for _, seed := range seedList.Items {
+			reconciler.Snapshot.upsertSeed(seed.DeepCopy())
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		Expect(fakeGardenClient.List(ctx, shootList)).To(Succeed())
+		for _, shoot := range shootList.Items {
+			reconciler.Snapshot.upsertShoot(client.ObjectKeyFromObject(&shoot), seedNamesOfShoot(&shoot)...)
+		}
+
+		return reconciler.determineSeed(ctx, log, shoot)
+	}
+
 	AfterEach(func() {
 		ctrl.Finish()
 	})
@@ -149,7 +177,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seed.Name))
 		})
@@ -169,7 +197,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -186,7 +214,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -199,7 +227,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -217,7 +245,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(MatchError("none of the 1 seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"))
 			Expect(bestSeed).To(BeNil())
 		})
@@ -237,7 +265,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(MatchError("none of the 1 seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"))
 			Expect(bestSeed).To(BeNil())
 		})
@@ -253,7 +281,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &multiZonalSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(multiZonalSeed.Name))
 		})
@@ -267,7 +295,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &multiZonalSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(multiZonalSeed.Name))
 		})
@@ -296,7 +324,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -310,7 +338,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -324,7 +352,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -344,7 +372,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -355,7 +383,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -372,7 +400,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -390,7 +418,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -411,7 +439,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -423,7 +451,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 			// verify that shoot is in another region than the seed
@@ -451,7 +479,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &thirdSeed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 			// verify that shoot is in another region than the chosen seed
@@ -473,7 +501,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -513,7 +541,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, oldSeedEnvironment1)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment2)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, testShoot)
+			bestSeed, err := determineSeed(testShoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(newSeedEnvironment2.Name))
 		})
@@ -563,7 +591,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment2)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment3)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, testShoot)
+			bestSeed, err := determineSeed(testShoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(newSeedEnvironment3.Name))
 		})
@@ -594,7 +622,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &thirdShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -615,7 +643,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -631,7 +659,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -650,7 +678,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -667,7 +695,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -679,7 +707,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -696,7 +724,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -708,7 +736,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -719,7 +747,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -735,7 +763,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -752,7 +780,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -762,7 +790,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -778,7 +806,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -789,7 +817,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -804,12 +832,174 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, err := determineSeed(shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using score plugins", func() {
+		var (
+			seedFarAway     *gardencorev1beta1.Seed
+			seedOtherVendor *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			cloudProfile.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{ProviderTypes: []string{"*"}}
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			seed = seedBase.DeepCopy()
+			seedFarAway = seedBase.DeepCopy()
+			seedFarAway.Name = "seed-far-away"
+			seedFarAway.Spec.Provider.Region = "asia"
+			seedOtherVendor = seedBase.DeepCopy()
+			seedOtherVendor.Name = "seed-other-vendor"
+			seedOtherVendor.Spec.Provider.Type = "other"
+		})
+
+		useScorePlugins := func(scorePlugins []config.ScorePluginConfiguration) {
+			reconciler.Config.ScorePlugins = scorePlugins
+			framework, err := NewFramework(reconciler.Config)
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.Framework = framework
+		}
+
+		createShootOnSeed := func(name, seedName string) {
+			s := shootBase.DeepCopy()
+			s.Name = name
+			s.Spec.SeedName = &seedName
+			Expect(fakeGardenClient.Create(ctx, s)).To(Succeed())
+		}
+
+		It("should not consider the candidate determination strategy", func() {
+			useScorePlugins([]config.ScorePluginConfiguration{
+				{Name: config.ScorePluginRegionDistance},
+			})
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedFarAway)).To(Succeed())
+
+			bestSeed, err := determineSeed(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedFarAway.Name))
+		})
+
+		It("should choose the seed with the same provider in the nearest region", func() {
+			useScorePlugins([]config.ScorePluginConfiguration{
+				{Name: config.ScorePluginRegionDistance, Weight: ptr.To[int32](1)},
+				{Name: config.ScorePluginProviderAffinity, Weight: ptr.To[int32](1)},
+			})
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedFarAway)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedOtherVendor)).To(Succeed())
+
+			bestSeed, err := determineSeed(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should prefer the seed with the lowest utilization if the capacity utilization has a high weight", func() {
+			useScorePlugins([]config.ScorePluginConfiguration{
+				{Name: config.ScorePluginRegionDistance, Weight: ptr.To[int32](1)},
+				{Name: config.ScorePluginSeedCapacityUtilization, Weight: ptr.To[int32](3)},
+			})
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("2")}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedFarAway)).To(Succeed())
+			createShootOnSeed("shoot-on-seed", seed.Name)
+
+			bestSeed, err := determineSeed(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedFarAway.Name))
+		})
+
+		It("should prefer the seed with the least shoots in case of equal scores", func() {
+			useScorePlugins([]config.ScorePluginConfiguration{
+				{Name: config.ScorePluginProviderAffinity},
+			})
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedFarAway)).To(Succeed())
+			createShootOnSeed("shoot-on-seed", seed.Name)
+
+			bestSeed, err := determineSeed(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedFarAway.Name))
+		})
+
+		It("should still apply the filter plugins", func() {
+			useScorePlugins([]config.ScorePluginConfiguration{
+				{Name: config.ScorePluginRegionDistance},
+			})
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedFarAway)).To(Succeed())
+
+			bestSeed, err := determineSeed(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedFarAway.Name))
+		})
+	})
+
+	Context("#revalidateSeed", func() {
+		BeforeEach(func() {
+			seed = seedBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+		})
+
+		It("should succeed if the seed is still usable and has available capacity", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("2")}
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			reconciler.Snapshot.Assume(client.ObjectKey{Namespace: "garden-foo", Name: "shoot-1"}, seed.Name)
+
+			Expect(reconciler.revalidateSeed(ctx, seed.Name)).To(Succeed())
+		})
+
+		It("should fail and remove the seed from the snapshot if the seed does not exist anymore", func() {
+			reconciler.Snapshot.upsertSeed(seed.DeepCopy())
+
+			Expect(reconciler.revalidateSeed(ctx, seed.Name)).To(MatchError(ContainSubstring("not found")))
+			Expect(reconciler.Snapshot.Seeds()).To(BeEmpty())
+		})
+
+		It("should fail and refresh the seed in the snapshot if the seed is no longer ready", func() {
+			reconciler.Snapshot.upsertSeed(seed.DeepCopy())
+			seed.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.revalidateSeed(ctx, seed.Name)).To(MatchError(ContainSubstring("not usable")))
+			Expect(reconciler.Snapshot.Seeds()).To(ConsistOf(HaveField("Status.Conditions", ConsistOf(HaveField("Status", gardencorev1beta1.ConditionFalse)))))
+		})
+
+		It("should fail if the seed does not have available capacity anymore", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("1")}
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			reconciler.Snapshot.Assume(client.ObjectKey{Namespace: "garden-foo", Name: "shoot-1"}, seed.Name)
+
+			Expect(reconciler.revalidateSeed(ctx, seed.Name)).To(MatchError(ContainSubstring("capacity")))
+		})
+
+		It("should fail if the seed is under resource pressure", func() {
+			schedulerConfiguration.Schedulers.Shoot.SeedResourcePressure = &config.SeedResourcePressureConfiguration{CPU: ptr.To[int32](90)}
+			seed.Status.CapacityReport = &gardencorev1beta1.SeedCapacityReport{
+				LastUpdateTime: metav1.NewTime(now.Add(-time.Minute)),
+				CPU:            &gardencorev1beta1.SeedResourceUtilization{Allocatable: resource.MustParse("10"), Requested: resource.MustParse("9500m")},
+			}
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.revalidateSeed(ctx, seed.Name)).To(MatchError("seed is under cpu pressure (utilization 95%, threshold 90%)"))
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// SeedSnapshot is an informer-backed snapshot of the seeds and of the number of shoots assigned to them. It is kept up
+// to date by event handlers on the seed and shoot informers, so that the scheduler does not need to list all seeds and
+// shoots for every shoot which has to be scheduled. Since the snapshot might lag behind the actual state, the chosen
+// seed must be re-validated before binding a shoot to it.
+type SeedSnapshot struct {
+	lock sync.RWMutex
+	// seeds contains the seeds by their names. The objects are shared with the informer and must not be modified.
+	seeds map[string]*gardencorev1beta1.Seed
+	// shoots contains the names of the seeds each shoot is counted for.
+	shoots map[types.NamespacedName][]string
+	// usage contains the number of shoots assigned to each seed.
+	usage map[string]int
+	// assumed contains the shoots which have been bound by the scheduler but not yet been observed as bound by the
+	// shoot informer.
+	assumed sets.Set[types.NamespacedName]
+	// registrations contains the registrations of the event handlers on the seed and shoot informers.
+	registrations []toolscache.ResourceEventHandlerRegistration
+}
+
+var _ source.SyncingSource = &SeedSnapshot{}
+
+// NewSeedSnapshot returns a new, empty SeedSnapshot.
+func NewSeedSnapshot() *SeedSnapshot {
+	return &SeedSnapshot{
+		seeds:   make(map[string]*gardencorev1beta1.Seed),
+		shoots:  make(map[types.NamespacedName][]string),
+		usage:   make(map[string]int),
+		assumed: sets.New[types.NamespacedName](),
+	}
+}
+
+// Setup registers the event handlers for seeds and shoots on the informers of the given cache.
+func (s *SeedSnapshot) Setup(ctx context.Context, c cache.Cache) error {
+	seedInformer, err := c.GetInformer(ctx, &gardencorev1beta1.Seed{})
+	if err != nil {
+		return err
+	}
+	seedRegistration, err := seedInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if seed, ok := obj.(*gardencorev1beta1.Seed); ok {
+				s.upsertSeed(seed)
+			}
+		},
+		UpdateFunc: func(_, newObj any) {
+			if seed, ok := newObj.(*gardencorev1beta1.Seed); ok {
+				s.upsertSeed(seed)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if seed, ok := obj.(*gardencorev1beta1.Seed); ok {
+				s.deleteSeed(seed.Name)
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	shootInformer, err := c.GetInformer(ctx, &gardencorev1beta1.Shoot{})
+	if err != nil {
+		return err
+	}
+	shootRegistration, err := shootInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
+				s.upsertShoot(client.ObjectKeyFromObject(shoot), seedNamesOfShoot(shoot)...)
+			}
+		},
+		UpdateFunc: func(_, newObj any) {
+			if shoot, ok := newObj.(*gardencorev1beta1.Shoot); ok {
+				s.upsertShoot(client.ObjectKeyFromObject(shoot), seedNamesOfShoot(shoot)...)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
+				s.deleteShoot(client.ObjectKeyFromObject(shoot))
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.registrations = []toolscache.ResourceEventHandlerRegistration{seedRegistration, shootRegistration}
+	return nil
+}
+
+// HasSynced returns true if the event handlers have processed the initial lists of seeds and shoots of the informers.
+func (s *SeedSnapshot) HasSynced() bool {
+	for _, registration := range s.registrations {
+		if !registration.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Start implements source.Source. The snapshot does not emit any events, it is only registered as source so that the
+// controller does not start its workers before the snapshot has been synced, see WaitForSync.
+func (s *SeedSnapshot) Start(_ context.Context, _ handler.EventHandler, _ workqueue.RateLimitingInterface, _ ...predicate.Predicate) error {
+	return nil
+}
+
+// WaitForSync implements source.SyncingSource. It blocks until the snapshot has been synced or the context is
+// cancelled.
+func (s *SeedSnapshot) WaitForSync(ctx context.Context) error {
+	if !toolscache.WaitForCacheSync(ctx.Done(), s.HasSynced) {
+		return fmt.Errorf("timed out waiting for seed snapshot to sync: %w", ctx.Err())
+	}
+	return nil
+}
+
+// Seeds returns deep copies of the seeds of the snapshot sorted by their names.
+func (s *SeedSnapshot) Seeds() []gardencorev1beta1.Seed {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	seeds := make([]gardencorev1beta1.Seed, 0, len(s.seeds))
+	for _, seed := range s.seeds {
+		seeds = append(seeds, *seed.DeepCopy())
+	}
+	sort.Slice(seeds, func(i, j int) bool { return seeds[i].Name < seeds[j].Name })
+
+	return seeds
+}
+
+// Usage returns the number of shoots assigned to each seed.
+func (s *SeedSnapshot) Usage() map[string]int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	usage := make(map[string]int, len(s.usage))
+	for name, count := range s.usage {
+		usage[name] = count
+	}
+
+	return usage
+}
+
+// Assume records that the shoot with the given key has been bound to the seed with the given name before the
+// corresponding event is observed by the shoot informer. This prevents that concurrent reconciliations exceed the
+// capacity of the seed.
+func (s *SeedSnapshot) Assume(key types.NamespacedName, seedName string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.setShootUsage(key, []string{seedName})
+	s.assumed.Insert(key)
+}
+
+func (s *SeedSnapshot) upsertSeed(seed *gardencorev1beta1.Seed) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.seeds[seed.Name] = seed
+}
+
+func (s *SeedSnapshot) deleteSeed(name string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.seeds, name)
+}
+
+func (s *SeedSnapshot) upsertShoot(key types.NamespacedName, seedNames ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// The informer might deliver outdated events of an assumed shoot which do not reflect the binding yet.
+	if len(seedNames) == 0 && s.assumed.Has(key) {
+		return
+	}
+
+	s.assumed.Delete(key)
+	s.setShootUsage(key, seedNames)
+}
+
+func (s *SeedSnapshot) deleteShoot(key types.NamespacedName) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.assumed.Delete(key)
+	s.removeShootUsage(key)
+}
+
+func (s *SeedSnapshot) setShootUsage(key types.NamespacedName, seedNames []string) {
+	s.removeShootUsage(key)
+	if len(seedNames) == 0 {
+		return
+	}
+
+	s.shoots[key] = seedNames
+	for _, name := range seedNames {
+		s.usage[name]++
+	}
+}
+
+func (s *SeedSnapshot) removeShootUsage(key types.NamespacedName) {
+	for _, name := range s.shoots[key] {
+		if s.usage[name]--; s.usage[name] <= 0 {
+			delete(s.usage, name)
+		}
+	}
+	delete(s.shoots, key)
+}
+
+// seedNamesOfShoot returns the names of the seeds the given shoot is counted for, see also
+// v1beta1helper.CalculateSeedUsage.
+func seedNamesOfShoot(shoot *gardencorev1beta1.Shoot) []string {
+	var (
+		specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+		statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+		names      []string
+	)
+
+	if specSeed != "" {
+		names = append(names, specSeed)
+	}
+	if statusSeed != "" && specSeed != statusSeed {
+		names = append(names, statusSeed)
+	}
+
+	return names
+}
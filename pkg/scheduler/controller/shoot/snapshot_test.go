@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("SeedSnapshot", func() {
+	var (
+		ctx = context.Background()
+
+		snapshot *SeedSnapshot
+
+		shoot1 = types.NamespacedName{Namespace: "garden-foo", Name: "shoot-1"}
+		shoot2 = types.NamespacedName{Namespace: "garden-foo", Name: "shoot-2"}
+	)
+
+	BeforeEach(func() {
+		snapshot = NewSeedSnapshot()
+	})
+
+	Describe("#Seeds", func() {
+		It("should return the seeds sorted by name", func() {
+			snapshot.upsertSeed(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-b"}})
+			snapshot.upsertSeed(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-a"}})
+			snapshot.upsertSeed(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-c"}})
+			snapshot.deleteSeed("seed-c")
+
+			seeds := snapshot.Seeds()
+			Expect(seeds).To(HaveLen(2))
+			Expect(seeds[0].Name).To(Equal("seed-a"))
+			Expect(seeds[1].Name).To(Equal("seed-b"))
+		})
+
+		It("should return copies of the seeds", func() {
+			snapshot.upsertSeed(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-a", Labels: map[string]string{"foo": "bar"}}})
+
+			seeds := snapshot.Seeds()
+			seeds[0].Labels["foo"] = "baz"
+
+			Expect(snapshot.Seeds()[0].Labels).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+
+	Describe("#Usage", func() {
+		It("should count the shoots per seed", func() {
+			snapshot.upsertShoot(shoot1, "seed-a")
+			snapshot.upsertShoot(shoot2, "seed-a", "seed-b")
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-a": 2, "seed-b": 1}))
+
+			snapshot.upsertShoot(shoot2, "seed-b")
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-a": 1, "seed-b": 1}))
+
+			snapshot.deleteShoot(shoot1)
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-b": 1}))
+		})
+	})
+
+	Describe("#Assume", func() {
+		It("should count the assumed shoot until it is observed as bound", func() {
+			snapshot.upsertShoot(shoot1)
+			snapshot.Assume(shoot1, "seed-a")
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-a": 1}))
+
+			By("ignoring outdated events")
+			snapshot.upsertShoot(shoot1)
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-a": 1}))
+
+			By("observing the binding")
+			snapshot.upsertShoot(shoot1, "seed-a")
+			Expect(snapshot.Usage()).To(Equal(map[string]int{"seed-a": 1}))
+
+			snapshot.upsertShoot(shoot1)
+			Expect(snapshot.Usage()).To(BeEmpty())
+		})
+
+		It("should forget the assumed shoot when it is deleted", func() {
+			snapshot.Assume(shoot1, "seed-a")
+			snapshot.deleteShoot(shoot1)
+			Expect(snapshot.Usage()).To(BeEmpty())
+		})
+	})
+	Describe("#WaitForSync", func() {
+		It("should return once all event handlers have synced", func() {
+			snapshot.registrations = []toolscache.ResourceEventHandlerRegistration{&fakeRegistration{synced: true}, &fakeRegistration{synced: true}}
+
+			Expect(snapshot.HasSynced()).To(BeTrue())
+			Expect(snapshot.WaitForSync(ctx)).To(Succeed())
+		})
+
+		It("should fail if an event handler does not sync before the context is cancelled", func() {
+			snapshot.registrations = []toolscache.ResourceEventHandlerRegistration{&fakeRegistration{synced: true}, &fakeRegistration{}}
+			ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+
+			Expect(snapshot.HasSynced()).To(BeFalse())
+			Expect(snapshot.WaitForSync(ctx)).To(MatchError(ContainSubstring("timed out waiting for seed snapshot to sync")))
+		})
+	})
+})
+
+type fakeRegistration struct {
+	synced bool
+}
+
+func (f *fakeRegistration) HasSynced() bool { return f.synced }
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootimporter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// SubResourceImport is the name of the subresource of Shoots which triggers the import of an existing cluster.
+const SubResourceImport = "import"
+
+// Options are the options for importing an existing cluster as Shoot.
+type Options struct {
+	// Shoot is the Shoot which adopts the existing cluster. Its `.spec.seedName` must be set.
+	Shoot *gardencorev1beta1.Shoot
+	// InfrastructureState is the state of the Infrastructure of the existing cluster in the format of the provider
+	// extension (JSON or YAML). If empty, the provider extension must discover the existing infrastructure itself.
+	InfrastructureState []byte
+	// WorkerState is the state of the Worker of the existing cluster in the format of the provider extension (JSON or
+	// YAML), e.g., the existing machines. If empty, the provider extension must discover the existing machines itself.
+	WorkerState []byte
+	// Wait specifies whether to wait until gardenlet finished the import.
+	Wait bool
+	// PollInterval is the interval for checking whether the import finished.
+	PollInterval time.Duration
+	// Timeout is the maximum duration to wait for the import.
+	Timeout time.Duration
+}
+
+// Import adopts an existing cluster as Shoot. It consists of the following steps:
+//  1. The Shoot is created with the `shoot.gardener.cloud/import` annotation, i.e., gardenlet does not act on it yet.
+//  2. The given states of the Infrastructure and the Worker are stored in the ShootState of the Shoot, so that they
+//     are restored by the provider extension.
+//  3. The import is triggered via the `shoots/import` subresource, i.e., gardenlet restores the Shoot.
+//
+// Import can be repeated if it failed, the existing Shoot and ShootState are updated. If the import was already
+// triggered, only the waiting for its completion is repeated.
+func Import(ctx context.Context, log logr.Logger, c client.Client, opts Options) error {
+	shoot := opts.Shoot.DeepCopy()
+	if shoot.Spec.SeedName == nil {
+		return fmt.Errorf("shoot %s must be assigned to a seed for being imported, .spec.seedName is not set", client.ObjectKeyFromObject(shoot))
+	}
+	log = log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+	log.Info("Creating Shoot")
+	if err := createShoot(ctx, c, shoot); err != nil {
+		return err
+	}
+
+	if shoot.Status.LastOperation != nil {
+		log.Info("Import was already triggered")
+	} else {
+		log.Info("Storing state of existing cluster in ShootState")
+		if err := deployShootState(ctx, c, shoot, opts); err != nil {
+			return err
+		}
+
+		log.Info("Triggering import")
+		if err := c.SubResource(SubResourceImport).Update(ctx, shoot); err != nil {
+			return fmt.Errorf("failed triggering import of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	}
+
+	if !opts.Wait {
+		return nil
+	}
+
+	log.Info("Waiting until import finished")
+	if err := waitUntilImported(ctx, c, shoot, opts.PollInterval, opts.Timeout); err != nil {
+		return err
+	}
+
+	log.Info("Import finished")
+	return nil
+}
+
+func createShoot(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot) error {
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootImport, "true")
+
+	if err := c.Create(ctx, shoot); err == nil {
+		return nil
+	} else if !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+		return fmt.Errorf("failed reading existing shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	if !v1beta1helper.IsShootImport(shoot) {
+		return fmt.Errorf("shoot %s already exists and is not annotated with %s=true", client.ObjectKeyFromObject(shoot), v1beta1constants.AnnotationShootImport)
+	}
+	return nil
+}
+
+func deployShootState(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, opts Options) error {
+	var states []*gardencorev1beta1.ExtensionResourceState
+	for _, extension := range []struct {
+		kind  string
+		state []byte
+	}{
+		{kind: extensionsv1alpha1.InfrastructureResource, state: opts.InfrastructureState},
+		{kind: extensionsv1alpha1.WorkerResource, state: opts.WorkerState},
+	} {
+		if len(extension.state) == 0 {
+			continue
+		}
+
+		state, err := yaml.YAMLToJSON(extension.state)
+		if err != nil {
+			return fmt.Errorf("failed converting state of %s to JSON: %w", extension.kind, err)
+		}
+
+		states = append(states, &gardencorev1beta1.ExtensionResourceState{
+			Kind:  extension.kind,
+			Name:  ptr.To(shoot.Name),
+			State: &runtime.RawExtension{Raw: state},
+		})
+	}
+
+	shootState := &gardencorev1beta1.ShootState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+		},
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, shootState, func() error {
+		extensionsData := v1beta1helper.ExtensionResourceStateList(shootState.Spec.Extensions)
+		for _, state := range states {
+			extensionsData.Upsert(state)
+		}
+		shootState.Spec.Extensions = extensionsData
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed deploying ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	return nil
+}
+
+// waitUntilImported waits until gardenlet removed the import annotation from the Shoot, which happens once the
+// restoration of the Shoot succeeded.
+func waitUntilImported(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, interval, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if !v1beta1helper.IsShootImport(shoot) {
+			return retry.Ok()
+		}
+
+		lastOperation := shoot.Status.LastOperation
+		if lastOperation == nil {
+			return retry.MinorError(fmt.Errorf("import of shoot %s was not triggered yet", client.ObjectKeyFromObject(shoot)))
+		}
+		if lastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+			return retry.SevereError(fmt.Errorf("import of shoot %s failed: %s", client.ObjectKeyFromObject(shoot), lastOperation.Description))
+		}
+		return retry.MinorError(fmt.Errorf("import of shoot %s is in state %s: %s", client.ObjectKeyFromObject(shoot), lastOperation.State, lastOperation.Description))
+	})
+}
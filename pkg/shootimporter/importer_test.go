@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootimporter_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/shootimporter"
+)
+
+var _ = Describe("Import", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		fakeClient client.Client
+		triggered  int
+		onTrigger  func(*gardencorev1beta1.Shoot)
+
+		shoot *gardencorev1beta1.Shoot
+		opts  Options
+	)
+
+	BeforeEach(func() {
+		triggered = 0
+		onTrigger = func(shoot *gardencorev1beta1.Shoot) {
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeRestore,
+				State: gardencorev1beta1.LastOperationStatePending,
+			}
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// The fake client does not know the import subresource, hence it is simulated like gardener-apiserver does.
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					Expect(subResourceName).To(Equal("import"))
+					triggered++
+
+					shoot := &gardencorev1beta1.Shoot{}
+					if err := c.Get(ctx, client.ObjectKeyFromObject(obj), shoot); err != nil {
+						return err
+					}
+					onTrigger(shoot)
+					status := shoot.Status.DeepCopy()
+					if err := c.Update(ctx, shoot); err != nil {
+						return err
+					}
+					shoot.Status = *status
+					return c.Status().Update(ctx, shoot)
+				},
+			}).
+			Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "garden-my-project"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+		}
+
+		opts = Options{
+			Shoot:               shoot,
+			InfrastructureState: []byte("vpcID: vpc-1234\n"),
+			WorkerState:         []byte(`{"machines":["machine-0"]}`),
+			PollInterval:        time.Millisecond,
+			Timeout:             time.Second,
+		}
+	})
+
+	It("should create the shoot and the shoot state and trigger the import", func() {
+		Expect(Import(ctx, log, fakeClient, opts)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/import", "true"))
+		Expect(shoot.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
+		Expect(triggered).To(Equal(1))
+
+		shootState := &gardencorev1beta1.ShootState{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState)).To(Succeed())
+		Expect(shootState.Spec.Extensions).To(HaveLen(2))
+		Expect(shootState.Spec.Extensions[0].Kind).To(Equal("Infrastructure"))
+		Expect(shootState.Spec.Extensions[0].Name).To(Equal(ptr.To("existing")))
+		Expect(shootState.Spec.Extensions[0].State.Raw).To(MatchJSON(`{"vpcID":"vpc-1234"}`))
+		Expect(shootState.Spec.Extensions[1].Kind).To(Equal("Worker"))
+		Expect(shootState.Spec.Extensions[1].Name).To(Equal(ptr.To("existing")))
+		Expect(shootState.Spec.Extensions[1].State.Raw).To(MatchJSON(`{"machines":["machine-0"]}`))
+	})
+
+	It("should not store empty states", func() {
+		opts.InfrastructureState = nil
+		opts.WorkerState = nil
+
+		Expect(Import(ctx, log, fakeClient, opts)).To(Succeed())
+
+		shootState := &gardencorev1beta1.ShootState{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState)).To(Succeed())
+		Expect(shootState.Spec.Extensions).To(BeEmpty())
+	})
+
+	It("should fail if the shoot is not assigned to a seed", func() {
+		shoot.Spec.SeedName = nil
+
+		Expect(Import(ctx, log, fakeClient, opts)).To(MatchError(ContainSubstring(".spec.seedName is not set")))
+		Expect(triggered).To(BeZero())
+	})
+
+	It("should fail if the shoot already exists and is not annotated for being imported", func() {
+		Expect(fakeClient.Create(ctx, shoot.DeepCopy())).To(Succeed())
+
+		Expect(Import(ctx, log, fakeClient, opts)).To(MatchError(ContainSubstring("already exists and is not annotated with shoot.gardener.cloud/import=true")))
+		Expect(triggered).To(BeZero())
+	})
+
+	It("should not trigger the import again if it was already triggered", func() {
+		Expect(Import(ctx, log, fakeClient, opts)).To(Succeed())
+		Expect(Import(ctx, log, fakeClient, opts)).To(Succeed())
+
+		Expect(triggered).To(Equal(1))
+	})
+
+	Context("when waiting for the import", func() {
+		BeforeEach(func() {
+			opts.Wait = true
+		})
+
+		It("should succeed once the import annotation was removed", func() {
+			onTrigger = func(shoot *gardencorev1beta1.Shoot) {
+				delete(shoot.Annotations, "shoot.gardener.cloud/import")
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeRestore,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				}
+			}
+
+			Expect(Import(ctx, log, fakeClient, opts)).To(Succeed())
+		})
+
+		It("should fail if the import failed", func() {
+			onTrigger = func(shoot *gardencorev1beta1.Shoot) {
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					Type:        gardencorev1beta1.LastOperationTypeRestore,
+					State:       gardencorev1beta1.LastOperationStateFailed,
+					Description: "infrastructure not found",
+				}
+			}
+
+			Expect(Import(ctx, log, fakeClient, opts)).To(MatchError(ContainSubstring("import of shoot garden-my-project/existing failed: infrastructure not found")))
+		})
+
+		It("should time out if the import does not finish", func() {
+			Expect(Import(ctx, log, fakeClient, opts)).To(MatchError(ContainSubstring("is in state Pending")))
+		})
+	})
+})
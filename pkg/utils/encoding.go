@@ -5,6 +5,8 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +14,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -78,6 +81,51 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// EncodeECDSAPrivateKey takes an ECDSA private key object, encodes it to the PEM format, and returns it as a byte
+// slice.
+func EncodeECDSAPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	bytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: bytes,
+	}), nil
+}
+
+// DecodeSigner takes a byte slice, decodes it from the PEM format, converts it to an RSA or ECDSA private key object,
+// and returns it. Keys in PKCS1, SEC1 and PKCS8 format are supported. In case an error occurs, it returns the error.
+func DecodeSigner(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		// EncodePrivateKeyInPKCS8 uses the same PEM block type for PKCS8-encoded RSA keys.
+		return DecodeRSAPrivateKeyFromPKCS8(bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+
+	return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
@@ -97,6 +145,16 @@ func DecodeCertificate(bytes []byte) (*x509.Certificate, error) {
 	return x509.ParseCertificate(block.Bytes)
 }
 
+// DecodePublicKey takes a byte slice, decodes it from the PEM format, converts it to a public key (RSA, ECDSA, or
+// Ed25519) in PKIX format, and returns it. In case an error occurs, it returns the error.
+func DecodePublicKey(bytes []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("PEM block type must be PUBLIC KEY")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
 // DecodeCertificateRequest parses the given PEM-encoded CSR.
 func DecodeCertificateRequest(data []byte) (*x509.CertificateRequest, error) {
 	block, _ := pem.Decode(data)
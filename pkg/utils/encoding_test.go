@@ -5,7 +5,11 @@
 package utils_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"strings"
 
@@ -27,6 +31,47 @@ var _ = Describe("Encoding", func() {
 		})
 	})
 
+	Describe("#DecodePublicKey", func() {
+		It("should decode the PEM-encoded public key", func() {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, err := DecodePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publicKey).To(Equal(&privateKey.PublicKey))
+		})
+
+		It("should fail if the data is no public key", func() {
+			_, err := DecodePublicKey([]byte("foo"))
+			Expect(err).To(MatchError("PEM block type must be PUBLIC KEY"))
+		})
+	})
+
+	Describe("#DecodeSigner", func() {
+		It("should decode PEM-encoded ECDSA private keys", func() {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			encoded, err := EncodeECDSAPrivateKey(privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodeSigner(encoded)).To(Equal(privateKey))
+
+			der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodeSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))).To(Equal(privateKey))
+		})
+
+		It("should fail if the data is no private key", func() {
+			_, err := DecodeSigner([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+
+			_, err = DecodeSigner(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("foo")}))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE"`))
+		})
+	})
+
 	DescribeTable("#ComputeGardenNamespace",
 		func(data []byte, csrMatcher func(*x509.CertificateRequest), errMatcher gomegatypes.GomegaMatcher) {
 			csr, err := DecodeCertificateRequest(data)
@@ -8,6 +8,7 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -66,10 +67,13 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs     TaskIDs
+	dependencyIDs TaskIDs
+	required      int
+	fn            TaskFn
+	skip          bool
+	class         string
+	timeout       time.Duration
 }
 
 func (n *node) String() string {
@@ -97,6 +101,21 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// RetryFailedTasksOnly causes the execution to only run the tasks which failed during the previous reconciliation
+	// (as recorded in the ErrorContext), all tasks depending on them and all dependencies of these tasks. Tasks which are
+	// independent of the previously failed tasks are skipped. If none of the tasks of the flow failed previously, the
+	// option has no effect.
+	RetryFailedTasksOnly bool
+	// MaxParallelTasks is the maximum number of tasks running in parallel. If it is not set, all tasks are started as
+	// soon as their dependencies have completed.
+	MaxParallelTasks int
+	// MaxParallelTasksPerClass is the maximum number of tasks of a concurrency class (see Task.Class) running in
+	// parallel. Classes without a limit are only subject to MaxParallelTasks.
+	MaxParallelTasksPerClass map[string]int
+	// Recorder is used to record snapshots of the dependency graph and the state of the tasks during flow execution.
+	Recorder *Recorder
+	// RecorderKey is the key under which the snapshots are recorded. If it is not set, the name of the flow is used.
+	RecorderKey string
 }
 
 // Run starts an execution of a Flow.
@@ -106,9 +125,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID   TaskID
+	Error    error
+	skipped  bool
+	duration time.Duration
 }
 
 // Stats are the statistics of a Flow execution.
@@ -156,10 +176,17 @@ func InitialStats(flowName string, all TaskIDs) *Stats {
 }
 
 func newExecution(flow *Flow, opts Opts) *execution {
-	all := NewTaskIDs()
+	var (
+		all       = NewTaskIDs()
+		succeeded = NewTaskIDs()
+	)
+
+	if opts.RetryFailedTasksOnly && opts.ErrorContext != nil {
+		succeeded = flow.previouslySucceededTaskIDs(opts.ErrorContext)
+	}
 
 	for name, task := range flow.nodes {
-		if !task.skip {
+		if !task.skip && !succeeded.Has(name) {
 			all.Insert(name)
 		}
 	}
@@ -169,6 +196,11 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	recorderKey := opts.RecorderKey
+	if recorderKey == "" {
+		recorderKey = flow.name
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -177,9 +209,73 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		succeeded,
+		opts.MaxParallelTasks,
+		opts.MaxParallelTasksPerClass,
+		nil,
+		make(map[string]int),
 		make(chan *nodeResult),
 		make(map[TaskID]int),
+		opts.Recorder,
+		recorderKey,
+		make(map[TaskID]time.Duration),
+		make(map[TaskID]error),
+	}
+}
+
+// previouslySucceededTaskIDs computes the IDs of the tasks which do not need to be executed again when only the tasks
+// which failed during the previous reconciliation shall be retried. Tasks must be executed again if they failed
+// previously or depend (transitively) on a previously failed task. Additionally, all (transitive) dependencies of these
+// tasks are executed again since they might initialize state which is required by the retried tasks. All other tasks are
+// independent of the retried tasks and are skipped.
+// If none of the tasks failed previously, an empty set is returned.
+func (f *Flow) previouslySucceededTaskIDs(errorContext *errorsutils.ErrorContext) TaskIDs {
+	var failed []TaskID
+	for id := range f.nodes {
+		if errorContext.HasLastErrorWithID(string(id)) {
+			failed = append(failed, id)
+		}
+	}
+
+	if len(failed) == 0 {
+		return NewTaskIDs()
+	}
+
+	var (
+		dependants = f.closure(failed, func(n *node) TaskIDs { return n.targetIDs })
+		retry      = f.closure(dependants.UnsortedList(), func(n *node) TaskIDs { return n.dependencyIDs })
+		succeeded  = NewTaskIDs()
+	)
+
+	for id := range f.nodes {
+		if !retry.Has(id) {
+			succeeded.Insert(id)
+		}
 	}
+	return succeeded
+}
+
+// closure returns the given task IDs and all task IDs which are (transitively) reachable from them via the given edges.
+func (f *Flow) closure(ids []TaskID, edges func(*node) TaskIDs) TaskIDs {
+	var (
+		result = NewTaskIDs()
+		queue  = append([]TaskID{}, ids...)
+	)
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if result.Has(id) {
+			continue
+		}
+		result.Insert(id)
+
+		for next := range edges(f.nodes[id]) {
+			queue = append(queue, next)
+		}
+	}
+	return result
 }
 
 type execution struct {
@@ -192,16 +288,31 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	// succeededTasks are the tasks which succeeded during the previous reconciliation and are skipped because only
+	// previously failed tasks shall be retried.
+	succeededTasks TaskIDs
+
+	maxParallelTasks         int
+	maxParallelTasksPerClass map[string]int
+	// queued are the tasks whose dependencies have completed but which could not be started yet due to the
+	// concurrency limits, in the order in which they became ready.
+	queued       []TaskID
+	runningClass map[string]int
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
+
+	recorder    *Recorder
+	recorderKey string
+	durations   map[TaskID]time.Duration
+	errors      map[TaskID]error
 }
 
 func (e *execution) runNode(ctx context.Context, id TaskID) {
 	log := e.log.WithValues(logKeyTask, id)
 
 	node := e.flow.nodes[id]
-	if node.skip {
+	if node.skip || e.succeededTasks.Has(id) {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
 
@@ -212,18 +323,63 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		return
 	}
 
+	if !e.canStart(node) {
+		log.V(1).Info("Queued due to concurrency limits")
+		e.queued = append(e.queued, id)
+		return
+	}
+
+	e.startNode(ctx, id)
+}
+
+// canStart returns whether the given node can be started without exceeding the concurrency limits.
+func (e *execution) canStart(node *node) bool {
+	if e.maxParallelTasks > 0 && e.stats.Running.Len() >= e.maxParallelTasks {
+		return false
+	}
+	if limit, ok := e.maxParallelTasksPerClass[node.class]; ok && node.class != "" && limit > 0 && e.runningClass[node.class] >= limit {
+		return false
+	}
+	return true
+}
+
+// startQueued starts the queued tasks which can be started without exceeding the concurrency limits.
+func (e *execution) startQueued(ctx context.Context) {
+	var stillQueued []TaskID
+	for _, id := range e.queued {
+		if e.canStart(e.flow.nodes[id]) {
+			e.startNode(ctx, id)
+		} else {
+			stillQueued = append(stillQueued, id)
+		}
+	}
+	e.queued = stillQueued
+}
+
+func (e *execution) startNode(ctx context.Context, id TaskID) {
+	var (
+		log  = e.log.WithValues(logKeyTask, id)
+		node = e.flow.nodes[id]
+	)
+
 	if e.errorContext != nil {
 		e.errorContext.AddErrorID(string(id))
 	}
 
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
+	e.runningClass[node.class]++
+
+	fn := node.fn
+	if node.timeout > 0 {
+		fn = fn.Timeout(node.timeout)
+	}
 
 	go func() {
 		start := time.Now().UTC()
 
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		err := fn(ctx)
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
@@ -234,23 +390,27 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 			log.Info("Succeeded")
 		}
 
-		e.done <- &nodeResult{TaskID: id, Error: err}
+		e.done <- &nodeResult{TaskID: id, Error: err, duration: end.Sub(start)}
 	}()
 }
 
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+	e.runningClass[e.flow.nodes[id].class]--
 }
 
 func (e *execution) updateFailure(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Failed.Insert(id)
+	e.runningClass[e.flow.nodes[id].class]--
 }
 
 func (e *execution) processTriggers(ctx context.Context, id TaskID) {
 	node := e.flow.nodes[id]
-	for target := range node.targetIDs {
+	// Trigger the targets in a stable order, so that the tasks are queued deterministically if the concurrency is
+	// limited.
+	for _, target := range node.targetIDs.List() {
 		e.triggerCounts[target]++
 		if e.triggerCounts[target] == e.flow.nodes[target].required {
 			e.runNode(ctx, target)
@@ -268,6 +428,9 @@ func (e *execution) reportProgress(ctx context.Context) {
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
 	}
+	if e.recorder != nil && e.recorder.Enabled() {
+		e.recorder.Record(e.recorderKey, e.snapshot())
+	}
 }
 
 func (e *execution) run(ctx context.Context) error {
@@ -287,7 +450,7 @@ func (e *execution) run(ctx context.Context) error {
 		cancelErr error
 		roots     = e.flow.nodes.rootIDs()
 	)
-	for name := range roots {
+	for _, name := range roots.List() {
 		if cancelErr = ctx.Err(); cancelErr == nil {
 			e.runNode(ctx, name)
 		}
@@ -303,7 +466,9 @@ func (e *execution) run(ctx context.Context) error {
 				e.processTriggers(ctx, result.TaskID)
 			}
 		} else {
+			e.durations[result.TaskID] = result.duration
 			if result.Error != nil {
+				e.errors[result.TaskID] = errors.Unwrap(result.Error)
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
 				e.updateFailure(result.TaskID)
 			} else {
@@ -311,6 +476,15 @@ func (e *execution) run(ctx context.Context) error {
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
+			}
+
+			// Start the queued tasks before the newly triggered ones, so that they are started in the order in which
+			// they became ready.
+			if ctx.Err() == nil {
+				e.startQueued(ctx)
+			}
+
+			if result.Error == nil {
 				if cancelErr = ctx.Err(); cancelErr == nil {
 					e.processTriggers(ctx, result.TaskID)
 				}
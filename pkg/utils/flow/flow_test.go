@@ -7,12 +7,16 @@ package flow_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -147,6 +151,181 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		Context("retry failed tasks only", func() {
+			var (
+				list           *AtomicStringList
+				mkListAppender func(string) flow.TaskFn
+				f              *flow.Flow
+			)
+
+			BeforeEach(func() {
+				list = NewAtomicStringList()
+				mkListAppender = func(value string) flow.TaskFn {
+					return func(_ context.Context) error {
+						list.Append(value)
+						return nil
+					}
+				}
+
+				var (
+					g  = flow.NewGraph("foo")
+					x1 = g.Add(flow.Task{Name: "x1", Fn: mkListAppender("x1")})
+					x2 = g.Add(flow.Task{Name: "x2", Fn: mkListAppender("x2")})
+					x3 = g.Add(flow.Task{Name: "x3", Fn: mkListAppender("x3")})
+					y1 = g.Add(flow.Task{Name: "y1", Fn: mkListAppender("y1"), Dependencies: flow.NewTaskIDs(x1)})
+					y2 = g.Add(flow.Task{Name: "y2", Fn: mkListAppender("y2"), Dependencies: flow.NewTaskIDs(x2)})
+					_  = g.Add(flow.Task{Name: "z1", Fn: mkListAppender("z1"), Dependencies: flow.NewTaskIDs(y1, x3)})
+					_  = g.Add(flow.Task{Name: "z2", Fn: mkListAppender("z2"), Dependencies: flow.NewTaskIDs(y2)})
+				)
+				f = g.Compile()
+			})
+
+			It("should only run the previously failed tasks, their dependants and all their dependencies", func() {
+				Expect(f.Run(ctx, flow.Opts{
+					ErrorContext:         errorsutils.NewErrorContext("foo", []string{"y1"}),
+					RetryFailedTasksOnly: true,
+				})).To(Succeed())
+
+				values := list.Values()
+				Expect(values).To(ConsistOf("x1", "x3", "y1", "z1"))
+				Expect(values[len(values)-1]).To(Equal("z1"))
+			})
+
+			It("should provide the state initialized by dependencies to the retried tasks", func() {
+				type state struct {
+					namespace *string
+					address   *string
+				}
+
+				var (
+					s     state
+					g     = flow.NewGraph("state")
+					init1 = g.Add(flow.Task{Name: "init-namespace", Fn: func(_ context.Context) error {
+						s.namespace = ptr.To("shoot--foo--bar")
+						return nil
+					}})
+					init2 = g.Add(flow.Task{Name: "init-address", Fn: func(_ context.Context) error {
+						s.address = ptr.To("10.0.0.1")
+						return nil
+					}, Dependencies: flow.NewTaskIDs(init1)})
+					use = g.Add(flow.Task{Name: "use-state", Fn: func(_ context.Context) error {
+						if s.namespace == nil || s.address == nil {
+							return fmt.Errorf("state was not initialized")
+						}
+						return nil
+					}, Dependencies: flow.NewTaskIDs(init2)})
+					_ = g.Add(flow.Task{Name: "unrelated", Fn: func(_ context.Context) error {
+						return fmt.Errorf("unrelated task must not run")
+					}, Dependencies: flow.NewTaskIDs(init1)})
+					_ = g.Add(flow.Task{Name: "after-use", Fn: func(_ context.Context) error {
+						if s.address == nil {
+							return fmt.Errorf("state was not initialized")
+						}
+						return nil
+					}, Dependencies: flow.NewTaskIDs(use)})
+				)
+
+				Expect(g.Compile().Run(ctx, flow.Opts{
+					ErrorContext:         errorsutils.NewErrorContext("state", []string{"use-state"}),
+					RetryFailedTasksOnly: true,
+				})).To(Succeed())
+				Expect(s.namespace).To(PointTo(Equal("shoot--foo--bar")))
+				Expect(s.address).To(PointTo(Equal("10.0.0.1")))
+			})
+
+			It("should run all tasks if none of them failed previously", func() {
+				Expect(f.Run(ctx, flow.Opts{
+					ErrorContext:         errorsutils.NewErrorContext("foo", []string{"unknown"}),
+					RetryFailedTasksOnly: true,
+				})).To(Succeed())
+
+				Expect(list.Values()).To(ConsistOf("x1", "x2", "x3", "y1", "y2", "z1", "z2"))
+			})
+
+			It("should run all tasks if the option is not set", func() {
+				Expect(f.Run(ctx, flow.Opts{
+					ErrorContext: errorsutils.NewErrorContext("foo", []string{"y1"}),
+				})).To(Succeed())
+
+				Expect(list.Values()).To(ConsistOf("x1", "x2", "x3", "y1", "y2", "z1", "z2"))
+			})
+		})
+
+		Context("concurrency limits", func() {
+			var (
+				lock       sync.Mutex
+				running    map[string]int
+				maxRunning map[string]int
+
+				mkTracker = func(class string) flow.TaskFn {
+					return func(_ context.Context) error {
+						lock.Lock()
+						running[class]++
+						running[""]++
+						maxRunning[class] = max(maxRunning[class], running[class])
+						maxRunning[""] = max(maxRunning[""], running[""])
+						lock.Unlock()
+
+						time.Sleep(10 * time.Millisecond)
+
+						lock.Lock()
+						running[class]--
+						running[""]--
+						lock.Unlock()
+						return nil
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				running = make(map[string]int)
+				maxRunning = make(map[string]int)
+			})
+
+			It("should respect the global and per-class limits", func() {
+				g := flow.NewGraph("foo")
+				for i := 0; i < 6; i++ {
+					g.Add(flow.Task{Name: fmt.Sprintf("extension-%d", i), Fn: mkTracker("extension"), Class: "extension"})
+					g.Add(flow.Task{Name: fmt.Sprintf("chart-%d", i), Fn: mkTracker("chart"), Class: "chart"})
+				}
+
+				Expect(g.Compile().Run(ctx, flow.Opts{
+					MaxParallelTasks:         4,
+					MaxParallelTasksPerClass: map[string]int{"extension": 1},
+				})).To(Succeed())
+
+				Expect(maxRunning[""]).To(Equal(4))
+				Expect(maxRunning["extension"]).To(Equal(1))
+			})
+
+			It("should run the queued tasks after their dependants were triggered", func() {
+				var (
+					list = NewAtomicStringList()
+					g    = flow.NewGraph("foo")
+					x1   = g.Add(flow.Task{Name: "x1", Fn: func(_ context.Context) error { list.Append("x1"); return nil }})
+					_    = g.Add(flow.Task{Name: "x2", Fn: func(_ context.Context) error { list.Append("x2"); return nil }})
+					_    = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { list.Append("y"); return nil }, Dependencies: flow.NewTaskIDs(x1)})
+				)
+
+				Expect(g.Compile().Run(ctx, flow.Opts{MaxParallelTasks: 1})).To(Succeed())
+				Expect(list.Values()).To(HaveExactElements("x1", "x2", "y"))
+			})
+		})
+
+		It("should bind the task to its timeout", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Timeout: time.Millisecond, Fn: func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}})
+			)
+
+			err := g.Compile().Run(ctx, flow.Opts{})
+			Expect(err).To(HaveOccurred())
+			Expect(flow.Causes(err).Errors).To(ConsistOf(context.DeadlineExceeded))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
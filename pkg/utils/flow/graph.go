@@ -6,6 +6,7 @@ package flow
 
 import (
 	"fmt"
+	"time"
 )
 
 // Task is a unit of work. It has a name, a payload function and a set of dependencies.
@@ -15,6 +16,11 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Class is the concurrency class of the task. The number of tasks of the same class running in parallel can be
+	// limited with Opts.MaxParallelTasksPerClass.
+	Class string
+	// Timeout is the maximum duration of the task. If it is not set, the task is not bound to a timeout.
+	Timeout time.Duration
 }
 
 // Spec returns the TaskSpec of a task.
@@ -23,6 +29,8 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Class,
+		t.Timeout,
 	}
 }
 
@@ -32,6 +40,8 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Class        string
+	Timeout      time.Duration
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -86,6 +96,9 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.dependencyIDs = taskSpec.Dependencies
+		node.class = taskSpec.Class
+		node.timeout = taskSpec.Timeout
 		node.required = taskSpec.Dependencies.Len()
 	}
 
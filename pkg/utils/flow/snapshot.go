@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TaskState is the state of a task in a Flow execution.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of a task which has not been started yet.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateRunning is the state of a task which is currently running.
+	TaskStateRunning TaskState = "Running"
+	// TaskStateSucceeded is the state of a task which completed successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of a task which failed.
+	TaskStateFailed TaskState = "Failed"
+	// TaskStateSkipped is the state of a task which is skipped.
+	TaskStateSkipped TaskState = "Skipped"
+)
+
+// Snapshot is the dependency graph of a Flow together with the state of its tasks in the last execution.
+type Snapshot struct {
+	// Name is the name of the Flow.
+	Name string `json:"name"`
+	// Timestamp is the time when the snapshot was taken.
+	Timestamp time.Time `json:"timestamp"`
+	// Tasks are the tasks of the Flow sorted by their IDs.
+	Tasks []TaskSnapshot `json:"tasks"`
+}
+
+// TaskSnapshot is the state of a task in the last execution of a Flow.
+type TaskSnapshot struct {
+	// ID is the ID of the task.
+	ID TaskID `json:"id"`
+	// Dependencies are the IDs of the tasks the task depends on.
+	Dependencies []TaskID `json:"dependencies,omitempty"`
+	// State is the state of the task.
+	State TaskState `json:"state"`
+	// Duration is the duration of the task if it completed.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Error is the error of the task if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// snapshot takes a snapshot of the current state of the execution.
+func (e *execution) snapshot() *Snapshot {
+	dependencies := make(map[TaskID][]TaskID, len(e.flow.nodes))
+	for id, node := range e.flow.nodes {
+		for target := range node.targetIDs {
+			dependencies[target] = append(dependencies[target], id)
+		}
+	}
+
+	s := &Snapshot{Name: e.flow.name, Timestamp: time.Now().UTC()}
+	for id, node := range e.flow.nodes {
+		task := TaskSnapshot{
+			ID:           id,
+			Dependencies: dependencies[id],
+			Duration:     e.durations[id],
+		}
+		slices.Sort(task.Dependencies)
+
+		switch {
+		case e.stats.Succeeded.Has(id):
+			task.State = TaskStateSucceeded
+		case e.stats.Failed.Has(id):
+			task.State = TaskStateFailed
+			if err := e.errors[id]; err != nil {
+				task.Error = err.Error()
+			}
+		case e.stats.Running.Has(id):
+			task.State = TaskStateRunning
+		case node.skip || e.succeededTasks.Has(id):
+			task.State = TaskStateSkipped
+		default:
+			task.State = TaskStatePending
+		}
+
+		s.Tasks = append(s.Tasks, task)
+	}
+	slices.SortFunc(s.Tasks, func(a, b TaskSnapshot) int {
+		return strings.Compare(string(a.ID), string(b.ID))
+	})
+
+	return s
+}
+
+// DOT renders the snapshot as a graph in the DOT language of Graphviz.
+func (s *Snapshot) DOT() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(s.Name))
+	b.WriteString("  node [shape=box, style=filled];\n")
+	for _, task := range s.Tasks {
+		fmt.Fprintf(&b, "  %s [label=%s, fillcolor=%s", dotQuote(string(task.ID)), dotQuote(task.label("\n")), dotColors[task.State])
+		if task.Error != "" {
+			fmt.Fprintf(&b, ", tooltip=%s", dotQuote(task.Error))
+		}
+		b.WriteString("];\n")
+	}
+	for _, task := range s.Tasks {
+		for _, dependency := range task.Dependencies {
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(string(dependency)), dotQuote(string(task.ID)))
+		}
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Mermaid renders the snapshot as a Mermaid flowchart.
+func (s *Snapshot) Mermaid() string {
+	var (
+		b   strings.Builder
+		ids = make(map[TaskID]string, len(s.Tasks))
+	)
+
+	fmt.Fprintf(&b, "---\ntitle: %s\n---\nflowchart TD\n", s.Name)
+	for i, task := range s.Tasks {
+		ids[task.ID] = fmt.Sprintf("t%d", i)
+		fmt.Fprintf(&b, "  %s[\"%s\"]:::%s\n", ids[task.ID], mermaidEscape(task.label("<br/>")), strings.ToLower(string(task.State)))
+	}
+	for _, task := range s.Tasks {
+		for _, dependency := range task.Dependencies {
+			fmt.Fprintf(&b, "  %s --> %s\n", ids[dependency], ids[task.ID])
+		}
+	}
+	for _, state := range []TaskState{TaskStatePending, TaskStateRunning, TaskStateSucceeded, TaskStateFailed, TaskStateSkipped} {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", strings.ToLower(string(state)), mermaidColors[state])
+	}
+
+	return b.String()
+}
+
+func (t TaskSnapshot) label(lineBreak string) string {
+	label := string(t.ID)
+	if t.Duration > 0 {
+		label += lineBreak + t.Duration.Round(time.Millisecond).String()
+	}
+	if t.State == TaskStateFailed {
+		label += lineBreak + string(t.State)
+	}
+	return label
+}
+
+var (
+	dotColors = map[TaskState]string{
+		TaskStatePending:   "white",
+		TaskStateRunning:   "lightblue",
+		TaskStateSucceeded: "palegreen",
+		TaskStateFailed:    "lightcoral",
+		TaskStateSkipped:   "lightgrey",
+	}
+	mermaidColors = map[TaskState]string{
+		TaskStatePending:   "#ffffff",
+		TaskStateRunning:   "#add8e6",
+		TaskStateSucceeded: "#98fb98",
+		TaskStateFailed:    "#f08080",
+		TaskStateSkipped:   "#d3d3d3",
+	}
+
+	dotReplacer     = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	mermaidReplacer = strings.NewReplacer(`"`, "#quot;")
+)
+
+func dotQuote(s string) string {
+	return `"` + dotReplacer.Replace(s) + `"`
+}
+
+func mermaidEscape(s string) string {
+	return mermaidReplacer.Replace(s)
+}
+
+// Recorder keeps the snapshots of the last executions of flows. It implements http.Handler to serve them, e.g. on a
+// debug endpoint:
+//   - Without the 'key' query parameter, the keys of all snapshots are listed.
+//   - With the 'key' query parameter, the respective snapshot is rendered in the format given by the 'format' query
+//     parameter, i.e. 'dot' (default), 'mermaid' or 'json'.
+//
+// A disabled Recorder does not store any snapshots.
+type Recorder struct {
+	lock      sync.RWMutex
+	enabled   bool
+	snapshots map[string]*Snapshot
+}
+
+// DefaultRecorder is the default Recorder. It is disabled until SetEnabled is called, which should only be done if the
+// snapshots are actually served (e.g., if profiling is enabled).
+var DefaultRecorder = &Recorder{snapshots: make(map[string]*Snapshot)}
+
+// NewRecorder returns a new enabled Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{enabled: true, snapshots: make(map[string]*Snapshot)}
+}
+
+// SetEnabled enables or disables recording. All stored snapshots are dropped when the Recorder is disabled.
+func (r *Recorder) SetEnabled(enabled bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.enabled = enabled
+	if !enabled {
+		r.snapshots = make(map[string]*Snapshot)
+	}
+}
+
+// Enabled returns whether the Recorder stores snapshots.
+func (r *Recorder) Enabled() bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.enabled
+}
+
+// Record stores the given snapshot under the given key if the Recorder is enabled. It replaces any snapshot previously
+// stored under this key.
+func (r *Recorder) Record(key string, snapshot *Snapshot) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.enabled {
+		r.snapshots[key] = snapshot
+	}
+}
+
+// Get returns the snapshot stored under the given key or nil if there is none.
+func (r *Recorder) Get(key string) *Snapshot {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.snapshots[key]
+}
+
+// Delete deletes the snapshot stored under the given key.
+func (r *Recorder) Delete(key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.snapshots, key)
+}
+
+// Keys returns the sorted keys of all stored snapshots.
+func (r *Recorder) Keys() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	keys := make([]string, 0, len(r.snapshots))
+	for key := range r.snapshots {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := req.URL.Query().Get("key")
+	if key == "" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for _, key := range r.Keys() {
+			fmt.Fprintln(w, key)
+		}
+		return
+	}
+
+	snapshot := r.Get(key)
+	if snapshot == nil {
+		http.Error(w, fmt.Sprintf("no flow recorded for key %q", key), http.StatusNotFound)
+		return
+	}
+
+	switch format := req.URL.Query().Get("format"); format {
+	case "", "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		fmt.Fprint(w, snapshot.DOT())
+	case "mermaid":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprint(w, snapshot.Mermaid())
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q, supported formats are dot, mermaid and json", format), http.StatusBadRequest)
+	}
+}
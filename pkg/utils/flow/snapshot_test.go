@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		ctx      = context.TODO()
+		recorder *flow.Recorder
+	)
+
+	BeforeEach(func() {
+		recorder = flow.NewRecorder()
+	})
+
+	Describe("#Run", func() {
+		It("should record a snapshot of the flow execution", func() {
+			g := flow.NewGraph("test")
+			first := g.Add(flow.Task{Name: "first", Fn: func(_ context.Context) error { return nil }})
+			failing := g.Add(flow.Task{Name: "failing", Fn: func(_ context.Context) error { return errors.New("fake") }, Dependencies: flow.NewTaskIDs(first)})
+			skipped := g.Add(flow.Task{Name: "skipped", SkipIf: true, Dependencies: flow.NewTaskIDs(first)})
+			g.Add(flow.Task{Name: "last", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(failing, skipped)})
+
+			Expect(g.Compile().Run(ctx, flow.Opts{Recorder: recorder, RecorderKey: "foo"})).To(HaveOccurred())
+
+			Expect(recorder.Keys()).To(ConsistOf("foo"))
+			snapshot := recorder.Get("foo")
+			Expect(snapshot.Name).To(Equal("test"))
+			Expect(snapshot.Tasks).To(HaveExactElements(
+				And(HaveField("ID", flow.TaskID("failing")), HaveField("State", flow.TaskStateFailed), HaveField("Error", "fake"), HaveField("Dependencies", ConsistOf(flow.TaskID("first")))),
+				And(HaveField("ID", flow.TaskID("first")), HaveField("State", flow.TaskStateSucceeded), HaveField("Error", ""), HaveField("Dependencies", BeEmpty())),
+				And(HaveField("ID", flow.TaskID("last")), HaveField("State", flow.TaskStatePending), HaveField("Dependencies", HaveExactElements(flow.TaskID("failing"), flow.TaskID("skipped")))),
+				And(HaveField("ID", flow.TaskID("skipped")), HaveField("State", flow.TaskStateSkipped), HaveField("Dependencies", ConsistOf(flow.TaskID("first")))),
+			))
+		})
+
+		It("should use the name of the flow as default key", func() {
+			g := flow.NewGraph("test")
+			g.Add(flow.Task{Name: "first", Fn: func(_ context.Context) error { return nil }})
+
+			Expect(g.Compile().Run(ctx, flow.Opts{Recorder: recorder})).To(Succeed())
+			Expect(recorder.Keys()).To(ConsistOf("test"))
+		})
+	})
+
+	Describe("#DOT", func() {
+		It("should render the snapshot", func() {
+			snapshot := &flow.Snapshot{Name: "test", Tasks: []flow.TaskSnapshot{
+				{ID: "a", State: flow.TaskStateSucceeded, Duration: 1500 * time.Millisecond},
+				{ID: "b", State: flow.TaskStateFailed, Dependencies: []flow.TaskID{"a"}, Error: `"fake"`},
+			}}
+
+			Expect(snapshot.DOT()).To(Equal(`digraph "test" {
+  node [shape=box, style=filled];
+  "a" [label="a\n1.5s", fillcolor=palegreen];
+  "b" [label="b\nFailed", fillcolor=lightcoral, tooltip="\"fake\""];
+  "a" -> "b";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the snapshot", func() {
+			snapshot := &flow.Snapshot{Name: "test", Tasks: []flow.TaskSnapshot{
+				{ID: "a", State: flow.TaskStateSucceeded, Duration: 1500 * time.Millisecond},
+				{ID: "b", State: flow.TaskStatePending, Dependencies: []flow.TaskID{"a"}},
+			}}
+
+			Expect(snapshot.Mermaid()).To(Equal(`---
+title: test
+---
+flowchart TD
+  t0["a<br/>1.5s"]:::succeeded
+  t1["b"]:::pending
+  t0 --> t1
+  classDef pending fill:#ffffff
+  classDef running fill:#add8e6
+  classDef succeeded fill:#98fb98
+  classDef failed fill:#f08080
+  classDef skipped fill:#d3d3d3
+`))
+		})
+	})
+
+	Describe("Recorder", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			recorder.Record("foo", &flow.Snapshot{Name: "test", Tasks: []flow.TaskSnapshot{{ID: "a", State: flow.TaskStatePending}}})
+			recorder.Record("bar", &flow.Snapshot{Name: "other"})
+
+			server = httptest.NewServer(recorder)
+			DeferCleanup(server.Close)
+		})
+
+		get := func(query string) (int, string) {
+			resp, err := http.Get(server.URL + query)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			return resp.StatusCode, string(body)
+		}
+
+		It("should list the keys", func() {
+			code, body := get("")
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("bar\nfoo\n"))
+		})
+
+		It("should render the snapshot in the requested format", func() {
+			code, body := get("?key=foo")
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(Equal(recorder.Get("foo").DOT()))
+
+			code, body = get("?key=foo&format=mermaid")
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(body).To(Equal(recorder.Get("foo").Mermaid()))
+
+			code, body = get("?key=foo&format=json")
+			Expect(code).To(Equal(http.StatusOK))
+			snapshot := &flow.Snapshot{}
+			Expect(json.Unmarshal([]byte(body), snapshot)).To(Succeed())
+			Expect(snapshot.Tasks).To(HaveExactElements(HaveField("ID", flow.TaskID("a"))))
+		})
+
+		It("should fail for unknown keys and formats", func() {
+			code, _ := get("?key=baz")
+			Expect(code).To(Equal(http.StatusNotFound))
+
+			code, _ = get("?key=foo&format=svg")
+			Expect(code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should delete snapshots", func() {
+			recorder.Delete("foo")
+			Expect(recorder.Keys()).To(ConsistOf("bar"))
+			Expect(recorder.Get("foo")).To(BeNil())
+		})
+
+		It("should not record snapshots if disabled and drop the stored ones", func() {
+			recorder.SetEnabled(false)
+			Expect(recorder.Enabled()).To(BeFalse())
+			Expect(recorder.Keys()).To(BeEmpty())
+
+			recorder.Record("foo", &flow.Snapshot{Name: "test"})
+			Expect(recorder.Get("foo")).To(BeNil())
+
+			recorder.SetEnabled(true)
+			recorder.Record("foo", &flow.Snapshot{Name: "test"})
+			Expect(recorder.Get("foo")).NotTo(BeNil())
+		})
+	})
+})
@@ -7,6 +7,8 @@ package gardener
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,3 +57,67 @@ func BuildCloudProfileReference(shoot *gardencorev1beta1.Shoot) *gardencorev1bet
 	}
 	return nil
 }
+
+// MergeCloudProfiles computes the effective CloudProfileSpec of the given NamespacedCloudProfile by merging its spec into
+// the spec of the given parent CloudProfile and stores it in the status of the NamespacedCloudProfile:
+//   - machine types, volume types and regions which are not offered by the parent are added
+//   - Kubernetes versions and machine image versions which are offered by the parent take over the expiration date
+//     defined in the NamespacedCloudProfile, all other versions are ignored respectively added
+//   - the CA bundle is appended to the one of the parent
+func MergeCloudProfiles(namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile, cloudProfile *gardencorev1beta1.CloudProfile) {
+	spec := cloudProfile.Spec.DeepCopy()
+	namespacedSpec := namespacedCloudProfile.Spec.DeepCopy()
+
+	if namespacedSpec.Kubernetes != nil {
+		for _, version := range namespacedSpec.Kubernetes.Versions {
+			if idx := slices.IndexFunc(spec.Kubernetes.Versions, func(v gardencorev1beta1.ExpirableVersion) bool { return v.Version == version.Version }); idx >= 0 {
+				spec.Kubernetes.Versions[idx].ExpirationDate = version.ExpirationDate
+			}
+		}
+	}
+
+	for _, machineImage := range namespacedSpec.MachineImages {
+		imageIdx := slices.IndexFunc(spec.MachineImages, func(i gardencorev1beta1.MachineImage) bool { return i.Name == machineImage.Name })
+		if imageIdx < 0 {
+			spec.MachineImages = append(spec.MachineImages, machineImage)
+			continue
+		}
+
+		for _, version := range machineImage.Versions {
+			versions := spec.MachineImages[imageIdx].Versions
+			if idx := slices.IndexFunc(versions, func(v gardencorev1beta1.MachineImageVersion) bool { return v.Version == version.Version }); idx >= 0 {
+				versions[idx].ExpirationDate = version.ExpirationDate
+				continue
+			}
+			spec.MachineImages[imageIdx].Versions = append(versions, version)
+		}
+	}
+
+	for _, machineType := range namespacedSpec.MachineTypes {
+		if !slices.ContainsFunc(spec.MachineTypes, func(t gardencorev1beta1.MachineType) bool { return t.Name == machineType.Name }) {
+			spec.MachineTypes = append(spec.MachineTypes, machineType)
+		}
+	}
+
+	for _, volumeType := range namespacedSpec.VolumeTypes {
+		if !slices.ContainsFunc(spec.VolumeTypes, func(t gardencorev1beta1.VolumeType) bool { return t.Name == volumeType.Name }) {
+			spec.VolumeTypes = append(spec.VolumeTypes, volumeType)
+		}
+	}
+
+	for _, region := range namespacedSpec.Regions {
+		if !slices.ContainsFunc(spec.Regions, func(r gardencorev1beta1.Region) bool { return r.Name == region.Name }) {
+			spec.Regions = append(spec.Regions, region)
+		}
+	}
+
+	if namespacedSpec.CABundle != nil {
+		if spec.CABundle == nil {
+			spec.CABundle = namespacedSpec.CABundle
+		} else {
+			spec.CABundle = ptr.To(strings.TrimSuffix(*spec.CABundle, "\n") + "\n" + *namespacedSpec.CABundle)
+		}
+	}
+
+	namespacedCloudProfile.Status.CloudProfileSpec = *spec
+}
@@ -6,6 +6,7 @@ package gardener_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -113,4 +114,121 @@ var _ = Describe("CloudProfile", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#MergeCloudProfiles", func() {
+		var (
+			expirationDate         = metav1.Now()
+			extendedExpirationDate = metav1.NewTime(expirationDate.Add(24 * time.Hour))
+
+			cloudProfile           *gardencorev1beta1.CloudProfile
+			namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloud-profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					CABundle: ptr.To("parent-ca"),
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{
+							{Version: "1.30.0"},
+							{Version: "1.29.0", ExpirationDate: &expirationDate},
+						},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &expirationDate}},
+						},
+					}},
+					MachineTypes: []gardencorev1beta1.MachineType{{Name: "machine-type"}},
+					VolumeTypes:  []gardencorev1beta1.VolumeType{{Name: "volume-type"}},
+					Regions:      []gardencorev1beta1.Region{{Name: "region"}},
+				},
+			}
+
+			namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "namespaced-cloud-profile", Namespace: "garden-test"},
+				Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+					Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "cloud-profile"},
+				},
+			}
+		})
+
+		It("should use the spec of the parent if the NamespacedCloudProfile does not define anything", func() {
+			gardenerutils.MergeCloudProfiles(namespacedCloudProfile, cloudProfile)
+
+			Expect(namespacedCloudProfile.Status.CloudProfileSpec).To(Equal(cloudProfile.Spec))
+		})
+
+		It("should merge the NamespacedCloudProfile into the parent", func() {
+			namespacedCloudProfile.Spec.CABundle = ptr.To("namespaced-ca")
+			namespacedCloudProfile.Spec.Kubernetes = &gardencorev1beta1.KubernetesSettings{
+				Versions: []gardencorev1beta1.ExpirableVersion{
+					{Version: "1.29.0", ExpirationDate: &extendedExpirationDate},
+					{Version: "1.28.0", ExpirationDate: &extendedExpirationDate},
+				},
+			}
+			namespacedCloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{
+				{
+					Name: "image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &extendedExpirationDate}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}},
+					},
+				},
+				{
+					Name: "custom-image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}},
+					},
+				},
+			}
+			namespacedCloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "custom-machine-type"}}
+			namespacedCloudProfile.Spec.VolumeTypes = []gardencorev1beta1.VolumeType{{Name: "custom-volume-type"}}
+			namespacedCloudProfile.Spec.Regions = []gardencorev1beta1.Region{{Name: "custom-region"}}
+
+			gardenerutils.MergeCloudProfiles(namespacedCloudProfile, cloudProfile)
+
+			Expect(namespacedCloudProfile.Status.CloudProfileSpec).To(Equal(gardencorev1beta1.CloudProfileSpec{
+				CABundle: ptr.To("parent-ca\nnamespaced-ca"),
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.30.0"},
+						{Version: "1.29.0", ExpirationDate: &extendedExpirationDate},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{
+					{
+						Name: "image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &extendedExpirationDate}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}},
+						},
+					},
+					{
+						Name: "custom-image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}},
+						},
+					},
+				},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "machine-type"}, {Name: "custom-machine-type"}},
+				VolumeTypes:  []gardencorev1beta1.VolumeType{{Name: "volume-type"}, {Name: "custom-volume-type"}},
+				Regions:      []gardencorev1beta1.Region{{Name: "region"}, {Name: "custom-region"}},
+			}))
+		})
+
+		It("should not modify the parent CloudProfile", func() {
+			original := cloudProfile.DeepCopy()
+			namespacedCloudProfile.Spec.Kubernetes = &gardencorev1beta1.KubernetesSettings{
+				Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.29.0", ExpirationDate: &extendedExpirationDate}},
+			}
+			namespacedCloudProfile.Spec.VolumeTypes = []gardencorev1beta1.VolumeType{{Name: "custom-volume-type"}}
+
+			gardenerutils.MergeCloudProfiles(namespacedCloudProfile, cloudProfile)
+
+			Expect(cloudProfile).To(Equal(original))
+		})
+	})
 })
@@ -182,6 +182,14 @@ func ReadGardenSecrets(
 			logInfo = append(logInfo, fmt.Sprintf("monitoring basic auth secret %q", secret.Name))
 		}
 
+		// Retrieving basic auth secret for remote write monitoring of seeds with a label
+		// indicating the Garden role global-seed-remote-write-monitoring.
+		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleGlobalSeedRemoteWriteMonitoring {
+			monitoringSecret := secret
+			secretsMap[v1beta1constants.GardenRoleGlobalSeedRemoteWriteMonitoring] = &monitoringSecret
+			logInfo = append(logInfo, fmt.Sprintf("seed remote write monitoring basic auth secret %q", secret.Name))
+		}
+
 		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleShootServiceAccountIssuer {
 			shootIssuer := secret
 			if hostname, ok := secret.Data["hostname"]; !ok {
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -71,3 +72,26 @@ func ReplicateGlobalMonitoringSecret(ctx context.Context, c client.Client, prefi
 	})
 	return globalMonitoringSecretReplica, err
 }
+
+// PKIConfig converts the given PKI configuration to the defaults for certificates generated by the secrets manager.
+func PKIConfig(pki *gardencorev1beta1.PKI) *secretsutils.PKIConfig {
+	if pki == nil {
+		return nil
+	}
+
+	config := &secretsutils.PKIConfig{}
+	if pki.KeyAlgorithm != nil {
+		config.KeyAlgorithm = secretsutils.KeyAlgorithm(*pki.KeyAlgorithm)
+	}
+	if pki.KeySize != nil {
+		config.KeySize = int(*pki.KeySize)
+	}
+	if pki.CertificateAuthorityValidity != nil {
+		config.CAValidity = &pki.CertificateAuthorityValidity.Duration
+	}
+	if pki.CertificateValidity != nil {
+		config.CertificateValidity = &pki.CertificateValidity.Duration
+	}
+
+	return config
+}
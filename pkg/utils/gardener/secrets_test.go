@@ -7,6 +7,7 @@ package gardener_test
 import (
 	"context"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -17,7 +18,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 var _ = Describe("Secrets", func() {
@@ -65,4 +68,24 @@ var _ = Describe("Secrets", func() {
 			assertions(secret)
 		})
 	})
+
+	Describe("#PKIConfig", func() {
+		It("should return nil if no PKI is configured", func() {
+			Expect(PKIConfig(nil)).To(BeNil())
+		})
+
+		It("should convert the PKI configuration", func() {
+			Expect(PKIConfig(&gardencorev1beta1.PKI{
+				KeyAlgorithm:                 ptr.To(gardencorev1beta1.KeyAlgorithmECDSA),
+				KeySize:                      ptr.To[int32](384),
+				CertificateAuthorityValidity: &metav1.Duration{Duration: time.Hour},
+				CertificateValidity:          &metav1.Duration{Duration: time.Minute},
+			})).To(Equal(&secretsutils.PKIConfig{
+				KeyAlgorithm:        secretsutils.KeyAlgorithmECDSA,
+				KeySize:             384,
+				CAValidity:          ptr.To(time.Hour),
+				CertificateValidity: ptr.To(time.Minute),
+			}))
+		})
+	})
 })
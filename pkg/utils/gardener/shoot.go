@@ -93,6 +93,28 @@ func LastReconciliationDuringThisTimeWindow(shoot *gardencorev1beta1.Shoot, cloc
 	return timeWindow.Contains(lastReconciliation) && now.UTC().Sub(lastReconciliation.UTC()) <= gardencorev1beta1.MaintenanceTimeWindowDurationMaximum
 }
 
+// ShootDebugModeUntil returns the end of the time window of the debug mode of the given Shoot's control plane. It
+// returns false if the debug mode is not enabled or if the annotation cannot be parsed.
+func ShootDebugModeUntil(shoot *gardencorev1beta1.Shoot) (time.Time, bool) {
+	value, ok := shoot.Annotations[v1beta1constants.AnnotationShootDebugModeUntil]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// IsShootDebugModeActive checks if the debug mode of the given Shoot's control plane is enabled and its time window
+// has not yet ended.
+func IsShootDebugModeActive(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
+	until, ok := ShootDebugModeUntil(shoot)
+	return ok && clock.Now().Before(until)
+}
+
 // IsObservedAtLatestGenerationAndSucceeded checks whether the Shoot's generation has changed or if the LastOperation status
 // is Succeeded.
 func IsObservedAtLatestGenerationAndSucceeded(shoot *gardencorev1beta1.Shoot) bool {
@@ -204,14 +226,21 @@ const (
 	ShootProjectSecretSuffixCACluster = "ca-cluster"
 	// ShootProjectSecretSuffixCAClient is a constant for a shoot project secret with suffix 'ca-client'.
 	ShootProjectSecretSuffixCAClient = "ca-client"
+	// ShootProjectSecretSuffixShootStateEncryptionKey is a constant for a shoot project internal secret with suffix
+	// 'shootstate-encryption-key'.
+	ShootProjectSecretSuffixShootStateEncryptionKey = "shootstate-encryption-key"
 	// ShootProjectSecretSuffixSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair'.
 	ShootProjectSecretSuffixSSHKeypair = v1beta1constants.SecretNameSSHKeyPair
 	// ShootProjectSecretSuffixOldSSHKeypair is a constant for a shoot project secret with suffix 'ssh-keypair.old'.
 	ShootProjectSecretSuffixOldSSHKeypair = v1beta1constants.SecretNameSSHKeyPair + ".old"
 	// ShootProjectSecretSuffixMonitoring is a constant for a shoot project secret with suffix 'monitoring'.
 	ShootProjectSecretSuffixMonitoring = "monitoring"
+	// ShootProjectSecretSuffixHostBootstrap is a constant for a shoot project secret with suffix 'host-bootstrap'.
+	ShootProjectSecretSuffixHostBootstrap = "host-bootstrap"
 	// ShootProjectConfigMapSuffixCACluster is a constant for a shoot project secret with suffix 'ca-cluster'.
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
+	// ShootProjectConfigMapSuffixInventory is a constant for a shoot project config map with suffix 'inventory'.
+	ShootProjectConfigMapSuffixInventory = "inventory"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -222,6 +251,7 @@ func GetShootProjectSecretSuffixes() []string {
 		ShootProjectSecretSuffixSSHKeypair,
 		ShootProjectSecretSuffixOldSSHKeypair,
 		ShootProjectSecretSuffixMonitoring,
+		ShootProjectSecretSuffixHostBootstrap,
 	}
 }
 
@@ -229,6 +259,7 @@ func GetShootProjectSecretSuffixes() []string {
 func GetShootProjectInternalSecretSuffixes() []string {
 	return []string{
 		ShootProjectSecretSuffixCAClient,
+		ShootProjectSecretSuffixShootStateEncryptionKey,
 	}
 }
 
@@ -236,6 +267,7 @@ func GetShootProjectInternalSecretSuffixes() []string {
 func GetShootProjectConfigMapSuffixes() []string {
 	return []string{
 		ShootProjectConfigMapSuffixCACluster,
+		ShootProjectConfigMapSuffixInventory,
 	}
 }
 
@@ -641,6 +673,37 @@ func ConstructExternalDomain(ctx context.Context, c client.Reader, shoot *garden
 	return externalDomain, nil
 }
 
+// ConstructSecondaryExternalDomain constructs an object containing all relevant information of the external domain
+// for the secondary DNS provider of the shoot cluster. It returns nil if the shoot does not configure a secondary DNS
+// provider.
+func ConstructSecondaryExternalDomain(ctx context.Context, c client.Reader, shoot *gardencorev1beta1.Shoot) (*Domain, error) {
+	externalClusterDomain := ConstructExternalClusterDomain(shoot)
+	if externalClusterDomain == nil {
+		return nil, nil
+	}
+
+	secondaryProvider := v1beta1helper.FindSecondaryDNSProvider(shoot.Spec.DNS.Providers)
+	if secondaryProvider == nil || secondaryProvider.Type == nil || secondaryProvider.SecretName == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *secondaryProvider.SecretName}, secret); err != nil {
+		return nil, fmt.Errorf("could not get secondary dns provider secret %q: %w", *secondaryProvider.SecretName, err)
+	}
+
+	secondaryDomain := &Domain{
+		Domain:     *externalClusterDomain,
+		Provider:   *secondaryProvider.Type,
+		SecretData: secret.Data,
+	}
+	if zones := secondaryProvider.Zones; zones != nil && len(zones.Include) == 1 {
+		secondaryDomain.Zone = zones.Include[0]
+	}
+
+	return secondaryDomain, nil
+}
+
 // ComputeRequiredExtensionsForShoot computes the extension kind/type combinations that are required for the
 // shoot reconciliation flow.
 func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList, internalDomain, externalDomain *Domain) sets.Set[string] {
@@ -689,7 +752,7 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 	if shoot.Spec.DNS != nil {
 		for _, provider := range shoot.Spec.DNS.Providers {
 			if provider.Type != nil && *provider.Type != core.DNSUnmanaged {
-				if provider.Primary != nil && *provider.Primary {
+				if ptr.Deref(provider.Primary, false) || ptr.Deref(provider.Secondary, false) {
 					requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.DNSRecordResource, *provider.Type))
 				}
 			}
@@ -23,6 +23,7 @@ import (
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"k8s.io/component-base/version"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -161,6 +162,44 @@ var _ = Describe("Shoot", func() {
 			BeTrue()),
 	)
 
+	Describe("debug mode", func() {
+		var (
+			fakeClock *testclock.FakeClock
+			shoot     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			shoot = &gardencorev1beta1.Shoot{}
+		})
+
+		It("should not be active if the annotation is not set", func() {
+			_, ok := ShootDebugModeUntil(shoot)
+			Expect(ok).To(BeFalse())
+			Expect(IsShootDebugModeActive(shoot, fakeClock)).To(BeFalse())
+		})
+
+		It("should not be active if the annotation cannot be parsed", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootDebugModeUntil, "foo")
+
+			_, ok := ShootDebugModeUntil(shoot)
+			Expect(ok).To(BeFalse())
+			Expect(IsShootDebugModeActive(shoot, fakeClock)).To(BeFalse())
+		})
+
+		It("should be active until the end of the time window", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootDebugModeUntil, "2024-01-01T13:00:00Z")
+
+			until, ok := ShootDebugModeUntil(shoot)
+			Expect(ok).To(BeTrue())
+			Expect(until).To(Equal(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)))
+			Expect(IsShootDebugModeActive(shoot, fakeClock)).To(BeTrue())
+
+			fakeClock.Step(time.Hour)
+			Expect(IsShootDebugModeActive(shoot, fakeClock)).To(BeFalse())
+		})
+	})
+
 	DescribeTable("#SyncPeriodOfShoot",
 		func(respectSyncPeriodOverwrite bool, defaultMinSyncPeriod time.Duration, shoot *gardencorev1beta1.Shoot, expected time.Duration) {
 			Expect(SyncPeriodOfShoot(respectSyncPeriodOverwrite, defaultMinSyncPeriod, shoot)).To(Equal(expected))
@@ -348,13 +387,13 @@ var _ = Describe("Shoot", func() {
 
 	Describe("#GetShootProjectSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring"))
+			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring", "host-bootstrap"))
 		})
 	})
 
 	Describe("#GetShootProjectInternalSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client"))
+			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client", "shootstate-encryption-key"))
 		})
 	})
 
@@ -390,6 +429,7 @@ var _ = Describe("Shoot", func() {
 		Entry("unrelated suffix", "foo.bar", "", false),
 		Entry("wrong suffix delimiter", "foo:kubeconfig", "", false),
 		Entry("ca-client suffix", "baz.ca-client", "baz", true),
+		Entry("shootstate-encryption-key suffix", "baz.shootstate-encryption-key", "baz", true),
 	)
 
 	DescribeTable("#ComputeManagedShootIssuerSecretName",
@@ -1078,6 +1118,81 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#ConstructSecondaryExternalDomain", func() {
+		var (
+			ctx = context.TODO()
+
+			namespace     = "default"
+			domain        = "foo.bar.com"
+			dnsSecretName = "secondary-secret"
+			dnsSecretData = map[string][]byte{"foo": []byte("bar")}
+
+			fakeClient client.Client
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					DNS: &gardencorev1beta1.DNS{
+						Domain: &domain,
+						Providers: []gardencorev1beta1.DNSProvider{
+							{
+								Type:    ptr.To("primary-provider"),
+								Primary: ptr.To(true),
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("returns nil because no secondary provider is configured", func() {
+			secondaryDomain, err := ConstructSecondaryExternalDomain(ctx, fakeClient, shoot)
+
+			Expect(secondaryDomain).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("with secondary provider", func() {
+			BeforeEach(func() {
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers, gardencorev1beta1.DNSProvider{
+					Type:       ptr.To("secondary-provider"),
+					SecretName: &dnsSecretName,
+					Secondary:  ptr.To(true),
+					Zones:      &gardencorev1beta1.DNSIncludeExclude{Include: []string{"zone"}},
+				})
+			})
+
+			It("returns the domain of the secondary provider", func() {
+				Expect(fakeClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: dnsSecretName, Namespace: namespace},
+					Data:       dnsSecretData,
+				})).To(Succeed())
+
+				secondaryDomain, err := ConstructSecondaryExternalDomain(ctx, fakeClient, shoot)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secondaryDomain).To(Equal(&Domain{
+					Domain:     domain,
+					Provider:   "secondary-provider",
+					Zone:       "zone",
+					SecretData: dnsSecretData,
+				}))
+			})
+
+			It("returns an error if the secret does not exist", func() {
+				_, err := ConstructSecondaryExternalDomain(ctx, fakeClient, shoot)
+
+				Expect(err).To(MatchError(ContainSubstring("could not get secondary dns provider secret")))
+			})
+		})
+	})
+
 	Describe("#ComputeRequiredExtensionsForShoot", func() {
 		const (
 			backupProvider       = "backupprovider"
@@ -1204,6 +1319,14 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (secondary DNS provider)", func() {
+			shoot.Spec.DNS.Providers[0].Secondary = ptr.To(true)
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result).To(HaveKey(ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType3)))
+		})
+
 		It("should compute the correct list of required extensions (no seed backup)", func() {
 			seed.Spec.Backup = nil
 
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// DifferenceReason describes why an entry of the ShootState is reported as difference.
+type DifferenceReason string
+
+const (
+	// DifferenceReasonMissingInShootState is used for state which exists in the seed but is not persisted in the
+	// ShootState. It is lost if the control plane is restored from the ShootState.
+	DifferenceReasonMissingInShootState DifferenceReason = "MissingInShootState"
+	// DifferenceReasonMissingInSeed is used for state which is persisted in the ShootState but does not exist in the
+	// seed (anymore).
+	DifferenceReasonMissingInSeed DifferenceReason = "MissingInSeed"
+	// DifferenceReasonDiffers is used for state which exists in both the ShootState and the seed but with different
+	// content.
+	DifferenceReasonDiffers DifferenceReason = "Differs"
+)
+
+const (
+	// DifferenceTypeResource is the type of differences of resources referenced by extension resources.
+	DifferenceTypeResource = "resource"
+)
+
+// Difference is an inconsistency between the persisted ShootState and the live state in the seed.
+type Difference struct {
+	// Type is the type of the state, i.e., the type of the Gardener data (e.g., `secret` or `machine-state`), the kind
+	// of the extension resource (e.g., `Worker`) or `resource` for resources referenced by extension resources.
+	Type string `json:"type"`
+	// Name is the name of the state, e.g., the name of the secret, the machine deployment or the extension resource.
+	// For resources referenced by extension resources, it has the format `<apiVersion>/<kind>/<name>`.
+	Name string `json:"name"`
+	// Purpose is the purpose of the extension resource, if any.
+	Purpose *string `json:"purpose,omitempty"`
+	// Reason describes the inconsistency.
+	Reason DifferenceReason `json:"reason"`
+}
+
+// Diff compares the ShootState persisted in the garden cluster with the state which would be persisted for the given
+// shoot based on the live resources in the seed, i.e., secrets, machine state, extension states and the resources
+// referenced by the extension resources. It returns the differences sorted by type and name. Encrypted data of the
+// ShootState is decrypted before it is compared, but the data itself is never part of the result.
+func Diff(ctx context.Context, gardenReader client.Reader, seedClient client.Client, shoot *gardencorev1beta1.Shoot) ([]Difference, error) {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := gardenReader.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		return nil, fmt.Errorf("failed reading ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	if err := Decrypt(ctx, gardenReader, shoot, shootState); err != nil {
+		return nil, err
+	}
+
+	live, err := computeSpec(ctx, seedClient, shoot.Status.TechnicalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing live state for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	gardenerDifferences, err := diffGardenerData(shootState.Spec.Gardener, live.Gardener)
+	if err != nil {
+		return nil, err
+	}
+
+	differences := append(gardenerDifferences, diffExtensionsData(shootState.Spec.Extensions, live.Extensions)...)
+	differences = append(differences, diffResourcesData(shootState.Spec.Resources, live.Resources)...)
+
+	slices.SortStableFunc(differences, func(a, b Difference) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(ptr.Deref(a.Purpose, ""), ptr.Deref(b.Purpose, "")),
+		)
+	})
+
+	return differences, nil
+}
+
+func diffGardenerData(persisted, live []gardencorev1beta1.GardenerResourceData) ([]Difference, error) {
+	var (
+		differences []Difference
+		key         = func(data gardencorev1beta1.GardenerResourceData) string { return data.Type + "/" + data.Name }
+	)
+
+	liveByKey := make(map[string]gardencorev1beta1.GardenerResourceData, len(live))
+	for _, data := range live {
+		liveByKey[key(data)] = data
+	}
+
+	for _, persistedData := range persisted {
+		liveData, ok := liveByKey[key(persistedData)]
+		delete(liveByKey, key(persistedData))
+
+		if persistedData.Type == v1beta1constants.DataTypeMachineState {
+			machineStateDifferences, err := diffMachineState(persistedData.Data.Raw, liveData.Data.Raw)
+			if err != nil {
+				return nil, err
+			}
+			differences = append(differences, machineStateDifferences...)
+			continue
+		}
+
+		switch {
+		case !ok:
+			differences = append(differences, Difference{Type: persistedData.Type, Name: persistedData.Name, Reason: DifferenceReasonMissingInSeed})
+		case !jsonEqual(persistedData.Data.Raw, liveData.Data.Raw):
+			differences = append(differences, Difference{Type: persistedData.Type, Name: persistedData.Name, Reason: DifferenceReasonDiffers})
+		}
+	}
+
+	for _, liveData := range liveByKey {
+		if liveData.Type == v1beta1constants.DataTypeMachineState {
+			machineStateDifferences, err := diffMachineState(nil, liveData.Data.Raw)
+			if err != nil {
+				return nil, err
+			}
+			differences = append(differences, machineStateDifferences...)
+			continue
+		}
+
+		differences = append(differences, Difference{Type: liveData.Type, Name: liveData.Name, Reason: DifferenceReasonMissingInShootState})
+	}
+
+	return differences, nil
+}
+
+func diffMachineState(persistedCompressed, liveCompressed []byte) ([]Difference, error) {
+	persisted, err := decodeMachineState(persistedCompressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding persisted machine state: %w", err)
+	}
+
+	live, err := decodeMachineState(liveCompressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding live machine state: %w", err)
+	}
+
+	var differences []Difference
+
+	for name, persistedMachineDeployment := range persisted.MachineDeployments {
+		liveMachineDeployment, ok := live.MachineDeployments[name]
+		switch {
+		case !ok:
+			differences = append(differences, Difference{Type: v1beta1constants.DataTypeMachineState, Name: name, Reason: DifferenceReasonMissingInSeed})
+		case !apiequality.Semantic.DeepEqual(persistedMachineDeployment, liveMachineDeployment):
+			differences = append(differences, Difference{Type: v1beta1constants.DataTypeMachineState, Name: name, Reason: DifferenceReasonDiffers})
+		}
+	}
+
+	for name := range live.MachineDeployments {
+		if _, ok := persisted.MachineDeployments[name]; !ok {
+			differences = append(differences, Difference{Type: v1beta1constants.DataTypeMachineState, Name: name, Reason: DifferenceReasonMissingInShootState})
+		}
+	}
+
+	return differences, nil
+}
+
+func decodeMachineState(stateCompressed []byte) (*MachineState, error) {
+	state := &MachineState{}
+
+	stateJSON, err := DecompressMachineState(stateCompressed)
+	if err != nil || len(stateJSON) == 0 {
+		return state, err
+	}
+
+	if err := json.Unmarshal(stateJSON, state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func diffExtensionsData(persisted, live []gardencorev1beta1.ExtensionResourceState) []Difference {
+	var (
+		differences []Difference
+		key         = func(data gardencorev1beta1.ExtensionResourceState) string {
+			return data.Kind + "/" + ptr.Deref(data.Name, "") + "/" + ptr.Deref(data.Purpose, "")
+		}
+	)
+
+	liveByKey := make(map[string]gardencorev1beta1.ExtensionResourceState, len(live))
+	for _, data := range live {
+		liveByKey[key(data)] = data
+	}
+
+	for _, persistedData := range persisted {
+		difference := Difference{Type: persistedData.Kind, Name: ptr.Deref(persistedData.Name, ""), Purpose: persistedData.Purpose}
+
+		liveData, ok := liveByKey[key(persistedData)]
+		delete(liveByKey, key(persistedData))
+
+		switch {
+		case !ok:
+			difference.Reason = DifferenceReasonMissingInSeed
+		case !rawExtensionEqual(persistedData.State, liveData.State) || !apiequality.Semantic.DeepEqual(persistedData.Resources, liveData.Resources):
+			difference.Reason = DifferenceReasonDiffers
+		default:
+			continue
+		}
+
+		differences = append(differences, difference)
+	}
+
+	for _, liveData := range liveByKey {
+		differences = append(differences, Difference{Type: liveData.Kind, Name: ptr.Deref(liveData.Name, ""), Purpose: liveData.Purpose, Reason: DifferenceReasonMissingInShootState})
+	}
+
+	return differences
+}
+
+func diffResourcesData(persisted, live []gardencorev1beta1.ResourceData) []Difference {
+	var (
+		differences []Difference
+		key         = func(data gardencorev1beta1.ResourceData) string {
+			return data.APIVersion + "/" + data.Kind + "/" + data.Name
+		}
+	)
+
+	liveByKey := make(map[string]gardencorev1beta1.ResourceData, len(live))
+	for _, data := range live {
+		liveByKey[key(data)] = data
+	}
+
+	for _, persistedData := range persisted {
+		liveData, ok := liveByKey[key(persistedData)]
+		delete(liveByKey, key(persistedData))
+
+		switch {
+		case !ok:
+			differences = append(differences, Difference{Type: DifferenceTypeResource, Name: key(persistedData), Reason: DifferenceReasonMissingInSeed})
+		case !jsonEqual(withoutMetadata(persistedData.Data.Raw), withoutMetadata(liveData.Data.Raw)):
+			differences = append(differences, Difference{Type: DifferenceTypeResource, Name: key(persistedData), Reason: DifferenceReasonDiffers})
+		}
+	}
+
+	for k := range liveByKey {
+		differences = append(differences, Difference{Type: DifferenceTypeResource, Name: k, Reason: DifferenceReasonMissingInShootState})
+	}
+
+	return differences
+}
+
+func rawExtensionEqual(a, b *runtime.RawExtension) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return jsonEqual(a.Raw, b.Raw)
+}
+
+// jsonEqual returns whether the given JSON documents are semantically equal, i.e., independent of the formatting and
+// the order of the keys.
+func jsonEqual(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	var objA, objB any
+	if err := json.Unmarshal(a, &objA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &objB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(objA, objB)
+}
+
+// withoutMetadata removes the metadata from the given serialized object since it always differs between the persisted
+// and the live object, e.g., the resource version or the UID.
+func withoutMetadata(raw []byte) []byte {
+	var obj map[string]any
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return raw
+	}
+
+	delete(obj, "metadata")
+
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return raw
+	}
+	return out
+}
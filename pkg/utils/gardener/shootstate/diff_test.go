@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/features"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Diff", func() {
+	var (
+		ctx           = context.TODO()
+		seedNamespace = "shoot--my-project--my-shoot"
+
+		fakeGardenClient client.Client
+		fakeSeedClient   client.Client
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-shoot",
+				Namespace: "garden-my-project",
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: seedNamespace,
+			},
+		}
+
+		Expect(fakeSeedClient.Create(ctx, newSecret("secret1", seedNamespace, true, true))).To(Succeed())
+		Expect(fakeSeedClient.Create(ctx, newSecret("secret2", seedNamespace, true, false))).To(Succeed())
+
+		createExtensionObject(ctx, fakeSeedClient, "infrastructure", seedNamespace, &extensionsv1alpha1.Infrastructure{}, &runtime.RawExtension{Raw: []byte(`{"name":"infrastructure"}`)})
+		createExtensionObject(ctx, fakeSeedClient, "worker", seedNamespace, &extensionsv1alpha1.Worker{}, &runtime.RawExtension{Raw: []byte(`{"name":"worker"}`)})
+		createExtensionObject(ctx, fakeSeedClient, "extension", seedNamespace, &extensionsv1alpha1.Extension{}, &runtime.RawExtension{Raw: []byte(`{"name":"extension"}`)}, gardencorev1beta1.NamedResourceReference{Name: "resource-ref1", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "ConfigMap", APIVersion: "v1", Name: "extension-configmap"}})
+		Expect(fakeSeedClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "extension-configmap", Namespace: seedNamespace}, Data: map[string]string{"some-data": "for-extension"}})).To(Succeed())
+
+		createMachineObjects(ctx, fakeSeedClient, seedNamespace)
+	})
+
+	deployShootState := func() {
+		ExpectWithOffset(1, Deploy(ctx, testclock.NewFakeClock(time.Now()), fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+	}
+
+	Describe("#Diff", func() {
+		It("should fail if the ShootState does not exist", func() {
+			differences, err := Diff(ctx, fakeGardenClient, fakeSeedClient, shoot)
+			Expect(err).To(BeNotFoundError())
+			Expect(differences).To(BeEmpty())
+		})
+
+		It("should not report differences if the ShootState is up-to-date", func() {
+			deployShootState()
+
+			Expect(Diff(ctx, fakeGardenClient, fakeSeedClient, shoot)).To(BeEmpty())
+		})
+
+		It("should not report differences if the persisted secrets are encrypted", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootStateEncryption, true))
+			deployShootState()
+
+			Expect(Diff(ctx, fakeGardenClient, fakeSeedClient, shoot)).To(BeEmpty())
+		})
+
+		It("should report the differences between the ShootState and the seed", func() {
+			deployShootState()
+
+			By("Changing Gardener data")
+			Expect(fakeSeedClient.Delete(ctx, newSecret("secret1", seedNamespace, true, true))).To(Succeed())
+			secret2 := newSecret("secret2", seedNamespace, true, false)
+			secret2.Data["secret2"] = []byte("other-data")
+			Expect(fakeSeedClient.Update(ctx, secret2)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, newSecret("secret3", seedNamespace, true, true))).To(Succeed())
+
+			By("Changing extensions data")
+			worker := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: seedNamespace}}
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(worker), worker)).To(Succeed())
+			worker.Status.State = &runtime.RawExtension{Raw: []byte(`{"name":"other-worker"}`)}
+			Expect(fakeSeedClient.Update(ctx, worker)).To(Succeed())
+			Expect(fakeSeedClient.Delete(ctx, &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: seedNamespace}})).To(Succeed())
+			createExtensionObject(ctx, fakeSeedClient, "network", seedNamespace, &extensionsv1alpha1.Network{}, &runtime.RawExtension{Raw: []byte(`{"name":"network"}`)})
+
+			By("Changing resources data")
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "extension-configmap", Namespace: seedNamespace}}
+			Expect(fakeSeedClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			configMap.Data["some-data"] = "for-other-extension"
+			Expect(fakeSeedClient.Update(ctx, configMap)).To(Succeed())
+
+			By("Changing machine data")
+			machineDeployment := &machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "deploy3", Namespace: seedNamespace}, Spec: machinev1alpha1.MachineDeploymentSpec{Replicas: 1}}
+			Expect(fakeSeedClient.Create(ctx, machineDeployment)).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, &machinev1alpha1.MachineSet{ObjectMeta: metav1.ObjectMeta{
+				Name:            "deploy3-set1",
+				Namespace:       seedNamespace,
+				OwnerReferences: []metav1.OwnerReference{{Kind: "MachineDeployment", Name: machineDeployment.Name}},
+			}})).To(Succeed())
+
+			Expect(Diff(ctx, fakeGardenClient, fakeSeedClient, shoot)).To(Equal([]Difference{
+				{Type: "Infrastructure", Name: "infrastructure", Reason: DifferenceReasonMissingInSeed},
+				{Type: "Network", Name: "network", Reason: DifferenceReasonMissingInShootState},
+				{Type: "Worker", Name: "worker", Reason: DifferenceReasonDiffers},
+				{Type: "machine-state", Name: "deploy3", Reason: DifferenceReasonMissingInShootState},
+				{Type: "resource", Name: "v1/ConfigMap/extension-configmap", Reason: DifferenceReasonDiffers},
+				{Type: "secret", Name: "secret1", Reason: DifferenceReasonMissingInSeed},
+				{Type: "secret", Name: "secret2", Reason: DifferenceReasonDiffers},
+				{Type: "secret", Name: "secret3", Reason: DifferenceReasonMissingInShootState},
+			}))
+		})
+
+		It("should report the purpose of extension resources", func() {
+			deployShootState()
+
+			createExtensionObject(ctx, fakeSeedClient, "controlplane-exposure", seedNamespace, &extensionsv1alpha1.ControlPlane{Spec: extensionsv1alpha1.ControlPlaneSpec{Purpose: ptr.To(extensionsv1alpha1.Exposure)}}, &runtime.RawExtension{Raw: []byte(`{"name":"controlplane-exposure"}`)})
+
+			Expect(Diff(ctx, fakeGardenClient, fakeSeedClient, shoot)).To(ConsistOf(
+				Difference{Type: "ControlPlane", Name: "controlplane-exposure", Purpose: ptr.To("exposure"), Reason: DifferenceReasonMissingInShootState},
+			))
+		})
+	})
+
+	Describe("DiffHandler", func() {
+		var (
+			handler  *DiffHandler
+			recorder *httptest.ResponseRecorder
+		)
+
+		BeforeEach(func() {
+			handler = &DiffHandler{}
+			handler.SetClients(fakeGardenClient, fakeSeedClient)
+			recorder = httptest.NewRecorder()
+
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should only support GET requests", func() {
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/debug/shootstates?shoot=garden-my-project/my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+
+		It("should be unavailable if the clients are not set", func() {
+			(&DiffHandler{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=garden-my-project/my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		})
+
+		It("should reject requests without valid shoot parameter", func() {
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return not found if the shoot does not exist", func() {
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=garden-my-project/other-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return not found if the ShootState does not exist", func() {
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=garden-my-project/my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			Expect(recorder.Body.String()).To(ContainSubstring("failed reading ShootState"))
+		})
+
+		It("should return an empty list if there are no differences", func() {
+			deployShootState()
+
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=garden-my-project/my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(recorder.Body.String()).To(Equal("[]\n"))
+		})
+
+		It("should return the differences", func() {
+			deployShootState()
+			Expect(fakeSeedClient.Create(ctx, newSecret("secret3", seedNamespace, true, true))).To(Succeed())
+
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/shootstates?shoot=garden-my-project/my-shoot", nil))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var differences []Difference
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &differences)).To(Succeed())
+			Expect(differences).To(Equal([]Difference{{Type: "secret", Name: "secret3", Reason: DifferenceReasonMissingInShootState}}))
+		})
+	})
+})
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// DataKeyEncryptionKey is the key in the data of the ShootState encryption key InternalSecret which contains the
+	// shoot-specific data key.
+	DataKeyEncryptionKey = "key"
+
+	// encryptionKeySize is the size of the shoot-specific data key (AES-256).
+	encryptionKeySize = 32
+)
+
+// encryptedData is the structure of the data of 'GardenerResourceData' entries of type 'encrypted-secret'.
+type encryptedData struct {
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func encryptionKeyInternalSecret(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.InternalSecret {
+	return &gardencorev1beta1.InternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixShootStateEncryptionKey),
+			Namespace: shoot.Namespace,
+		},
+	}
+}
+
+// GetEncryptionKey returns the shoot-specific data key used for encrypting the secrets persisted in the ShootState. It
+// returns nil if the key does not exist (yet).
+func GetEncryptionKey(ctx context.Context, gardenReader client.Reader, shoot *gardencorev1beta1.Shoot) ([]byte, error) {
+	internalSecret := encryptionKeyInternalSecret(shoot)
+	if err := gardenReader.Get(ctx, client.ObjectKeyFromObject(internalSecret), internalSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading ShootState encryption key %s: %w", client.ObjectKeyFromObject(internalSecret), err)
+	}
+
+	key := internalSecret.Data[DataKeyEncryptionKey]
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("ShootState encryption key %s has an invalid length %d, expected %d", client.ObjectKeyFromObject(internalSecret), len(key), encryptionKeySize)
+	}
+
+	return key, nil
+}
+
+// GetOrCreateEncryptionKey returns the shoot-specific data key used for encrypting the secrets persisted in the
+// ShootState. If it does not exist yet, a new random key is generated and stored in an InternalSecret in the project
+// namespace. The InternalSecret is owned by the shoot and protected by a finalizer, which is only removed when the
+// ShootState is deleted, see Delete. This prevents losing the key (and hence the ability to restore the control plane)
+// by deleting the InternalSecret accidentally.
+func GetOrCreateEncryptionKey(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot) ([]byte, error) {
+	key, err := GetEncryptionKey(ctx, gardenClient, shoot)
+	if err != nil || key != nil {
+		return key, err
+	}
+
+	key = make([]byte, encryptionKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed generating ShootState encryption key: %w", err)
+	}
+
+	internalSecret := encryptionKeyInternalSecret(shoot)
+	internalSecret.Labels = map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShootStateEncryptionKey}
+	internalSecret.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))}
+	internalSecret.Finalizers = []string{gardencorev1beta1.GardenerName}
+	internalSecret.Type = corev1.SecretTypeOpaque
+	internalSecret.Data = map[string][]byte{DataKeyEncryptionKey: key}
+
+	if err := gardenClient.Create(ctx, internalSecret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// The key was created concurrently, hence use the existing one.
+			return GetEncryptionKey(ctx, gardenClient, shoot)
+		}
+		return nil, fmt.Errorf("failed creating ShootState encryption key %s: %w", client.ObjectKeyFromObject(internalSecret), err)
+	}
+
+	return key, nil
+}
+
+// EncryptGardenerData encrypts the data of all entries of type 'secret' with the given key. The type of the encrypted
+// entries is changed to 'encrypted-secret'. Other entries are returned as is.
+func EncryptGardenerData(key []byte, data []gardencorev1beta1.GardenerResourceData) ([]gardencorev1beta1.GardenerResourceData, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]gardencorev1beta1.GardenerResourceData, 0, len(data))
+	for _, entry := range data {
+		entry := *entry.DeepCopy()
+
+		if entry.Type == v1beta1constants.DataTypeSecret {
+			nonce := make([]byte, aead.NonceSize())
+			if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+				return nil, fmt.Errorf("failed generating nonce for encrypting data of %q: %w", entry.Name, err)
+			}
+
+			raw, err := json.Marshal(&encryptedData{Ciphertext: aead.Seal(nonce, nonce, entry.Data.Raw, []byte(entry.Name))})
+			if err != nil {
+				return nil, fmt.Errorf("failed marshalling encrypted data of %q: %w", entry.Name, err)
+			}
+
+			entry.Type = v1beta1constants.DataTypeEncryptedSecret
+			entry.Data = runtime.RawExtension{Raw: raw}
+		}
+
+		out = append(out, entry)
+	}
+
+	return out, nil
+}
+
+// DecryptGardenerData decrypts the data of all entries of type 'encrypted-secret' with the given key. The type of the
+// decrypted entries is changed back to 'secret'. Other entries are returned as is.
+func DecryptGardenerData(key []byte, data []gardencorev1beta1.GardenerResourceData) ([]gardencorev1beta1.GardenerResourceData, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]gardencorev1beta1.GardenerResourceData, 0, len(data))
+	for _, entry := range data {
+		entry := *entry.DeepCopy()
+
+		if entry.Type == v1beta1constants.DataTypeEncryptedSecret {
+			encrypted := &encryptedData{}
+			if err := json.Unmarshal(entry.Data.Raw, encrypted); err != nil {
+				return nil, fmt.Errorf("failed unmarshalling encrypted data of %q: %w", entry.Name, err)
+			}
+
+			if len(encrypted.Ciphertext) < aead.NonceSize() {
+				return nil, fmt.Errorf("encrypted data of %q is too short", entry.Name)
+			}
+
+			nonce, ciphertext := encrypted.Ciphertext[:aead.NonceSize()], encrypted.Ciphertext[aead.NonceSize():]
+			plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(entry.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed decrypting data of %q: %w", entry.Name, err)
+			}
+
+			entry.Type = v1beta1constants.DataTypeSecret
+			entry.Data = runtime.RawExtension{Raw: plaintext}
+		}
+
+		out = append(out, entry)
+	}
+
+	return out, nil
+}
+
+// Decrypt decrypts the secrets persisted in the given ShootState in place with the shoot-specific data key. It is a
+// no-op if the ShootState does not contain any encrypted data.
+func Decrypt(ctx context.Context, gardenReader client.Reader, shoot *gardencorev1beta1.Shoot, shootState *gardencorev1beta1.ShootState) error {
+	if !isEncrypted(shootState.Spec.Gardener) {
+		return nil
+	}
+
+	key, err := GetEncryptionKey(ctx, gardenReader, shoot)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return missingEncryptionKeyError(shoot, shootState)
+	}
+
+	gardener, err := DecryptGardenerData(key, shootState.Spec.Gardener)
+	if err != nil {
+		return fmt.Errorf("failed decrypting ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+	}
+	shootState.Spec.Gardener = gardener
+
+	return nil
+}
+
+// verifyEncryptionKey returns an error if the existing ShootState of the given shoot contains encrypted data but the
+// encryption key does not exist anymore.
+func verifyEncryptionKey(ctx context.Context, gardenReader client.Reader, shoot *gardencorev1beta1.Shoot) error {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := gardenReader.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading ShootState %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	if !isEncrypted(shootState.Spec.Gardener) {
+		return nil
+	}
+
+	key, err := GetEncryptionKey(ctx, gardenReader, shoot)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return missingEncryptionKeyError(shoot, shootState)
+	}
+
+	return nil
+}
+
+// deleteEncryptionKey removes the finalizer from the InternalSecret containing the encryption key of the given shoot and
+// deletes it.
+func deleteEncryptionKey(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot) error {
+	internalSecret := encryptionKeyInternalSecret(shoot)
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(internalSecret), internalSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading ShootState encryption key %s: %w", client.ObjectKeyFromObject(internalSecret), err)
+	}
+
+	if err := controllerutils.RemoveFinalizers(ctx, gardenClient, internalSecret, gardencorev1beta1.GardenerName); err != nil {
+		return fmt.Errorf("failed removing finalizer from ShootState encryption key %s: %w", client.ObjectKeyFromObject(internalSecret), err)
+	}
+
+	return client.IgnoreNotFound(gardenClient.Delete(ctx, internalSecret))
+}
+
+func missingEncryptionKeyError(shoot *gardencorev1beta1.Shoot, shootState *gardencorev1beta1.ShootState) error {
+	return fmt.Errorf("ShootState %s contains encrypted data but the encryption key %s does not exist, restore the InternalSecret from a backup of the garden cluster",
+		client.ObjectKeyFromObject(shootState), client.ObjectKeyFromObject(encryptionKeyInternalSecret(shoot)))
+}
+
+func isEncrypted(data []gardencorev1beta1.GardenerResourceData) bool {
+	for _, entry := range data {
+		if entry.Type == v1beta1constants.DataTypeEncryptedSecret {
+			return true
+		}
+	}
+	return false
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher for ShootState encryption key: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating GCM for ShootState encryption key: %w", err)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var _ = Describe("Encryption", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeGardenClient client.Client
+		shoot            *gardencorev1beta1.Shoot
+		internalSecret   *gardencorev1beta1.InternalSecret
+
+		key  = []byte("01234567890123456789012345678901")
+		data []gardencorev1beta1.GardenerResourceData
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-shoot",
+				Namespace: "garden-my-project",
+				UID:       "1234",
+			},
+		}
+		internalSecret = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-shoot.shootstate-encryption-key",
+				Namespace: "garden-my-project",
+			},
+		}
+
+		data = []gardencorev1beta1.GardenerResourceData{
+			{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{"ca.key":"c29tZS1kYXRh"}`)}},
+			{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: []byte(`{"state":"foo"}`)}},
+		}
+	})
+
+	Describe("#GetOrCreateEncryptionKey", func() {
+		It("should generate a new key and store it in an InternalSecret owned by the shoot", func() {
+			generatedKey, err := GetOrCreateEncryptionKey(ctx, fakeGardenClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generatedKey).To(HaveLen(32))
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(internalSecret), internalSecret)).To(Succeed())
+			Expect(internalSecret.Data).To(HaveKeyWithValue("key", generatedKey))
+			Expect(internalSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "shootstate-encryption-key"))
+			Expect(internalSecret.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion:         "core.gardener.cloud/v1beta1",
+				Kind:               "Shoot",
+				Name:               "my-shoot",
+				UID:                "1234",
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+			}))
+			Expect(internalSecret.Finalizers).To(ConsistOf("gardener"))
+		})
+
+		It("should return the existing key", func() {
+			internalSecret.Data = map[string][]byte{"key": key}
+			Expect(fakeGardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+			Expect(GetOrCreateEncryptionKey(ctx, fakeGardenClient, shoot)).To(Equal(key))
+		})
+
+		It("should fail if the existing key has an invalid length", func() {
+			internalSecret.Data = map[string][]byte{"key": []byte("foo")}
+			Expect(fakeGardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+			_, err := GetOrCreateEncryptionKey(ctx, fakeGardenClient, shoot)
+			Expect(err).To(MatchError(ContainSubstring("invalid length")))
+		})
+	})
+
+	Describe("#EncryptGardenerData, #DecryptGardenerData", func() {
+		It("should only encrypt secrets and decrypt them again", func() {
+			encrypted, err := EncryptGardenerData(key, data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encrypted).To(HaveLen(2))
+			Expect(encrypted[0].Type).To(Equal("encrypted-secret"))
+			Expect(string(encrypted[0].Data.Raw)).NotTo(ContainSubstring("c29tZS1kYXRh"))
+			Expect(encrypted[1]).To(Equal(data[1]))
+
+			Expect(DecryptGardenerData(key, encrypted)).To(Equal(data))
+		})
+
+		It("should fail decrypting with a different key", func() {
+			encrypted, err := EncryptGardenerData(key, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecryptGardenerData([]byte("98765432109876543210987654321098"), encrypted)
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting data of \"ca\"")))
+		})
+
+		It("should fail decrypting data which was moved to another entry", func() {
+			encrypted, err := EncryptGardenerData(key, data)
+			Expect(err).NotTo(HaveOccurred())
+			encrypted[0].Name = "other"
+
+			_, err = DecryptGardenerData(key, encrypted)
+			Expect(err).To(MatchError(ContainSubstring("failed decrypting data of \"other\"")))
+		})
+	})
+
+	Describe("#Decrypt", func() {
+		var shootState *gardencorev1beta1.ShootState
+
+		BeforeEach(func() {
+			shootState = &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: "garden-my-project"}}
+		})
+
+		It("should do nothing if the ShootState is not encrypted", func() {
+			shootState.Spec.Gardener = data
+
+			Expect(Decrypt(ctx, fakeGardenClient, shoot, shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(Equal(data))
+		})
+
+		It("should fail if the encryption key does not exist", func() {
+			encrypted, err := EncryptGardenerData(key, data)
+			Expect(err).NotTo(HaveOccurred())
+			shootState.Spec.Gardener = encrypted
+
+			Expect(Decrypt(ctx, fakeGardenClient, shoot, shootState)).To(MatchError(ContainSubstring("contains encrypted data but the encryption key garden-my-project/my-shoot.shootstate-encryption-key does not exist, restore the InternalSecret from a backup")))
+		})
+
+		It("should decrypt the ShootState", func() {
+			internalSecret.Data = map[string][]byte{"key": key}
+			Expect(fakeGardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+			encrypted, err := EncryptGardenerData(key, data)
+			Expect(err).NotTo(HaveOccurred())
+			shootState.Spec.Gardener = encrypted
+
+			Expect(Decrypt(ctx, fakeGardenClient, shoot, shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(Equal(data))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// DefaultDiffHandler is the default DiffHandler. It serves requests only after the clients were set with SetClients.
+var DefaultDiffHandler = &DiffHandler{}
+
+// DiffHandler is an http.Handler which reports the differences between the persisted ShootState of a shoot and the
+// live state in the seed, see Diff.
+type DiffHandler struct {
+	lock         sync.RWMutex
+	gardenReader client.Reader
+	seedClient   client.Client
+}
+
+// SetClients sets the clients for the garden and the seed cluster. They are usually not yet available when the handler
+// is registered.
+func (h *DiffHandler) SetClients(gardenReader client.Reader, seedClient client.Client) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.gardenReader = gardenReader
+	h.seedClient = seedClient
+}
+
+func (h *DiffHandler) clients() (client.Reader, client.Client) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.gardenReader, h.seedClient
+}
+
+// ServeHTTP implements http.Handler.
+func (h *DiffHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	gardenReader, seedClient := h.clients()
+	if gardenReader == nil || seedClient == nil {
+		http.Error(w, "the connection to the garden cluster is not yet established", http.StatusServiceUnavailable)
+		return
+	}
+
+	namespace, name, ok := strings.Cut(req.URL.Query().Get("shoot"), "/")
+	if !ok || namespace == "" || name == "" {
+		http.Error(w, "the shoot parameter must have the format <namespace>/<name>", http.StatusBadRequest)
+		return
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := gardenReader.Get(req.Context(), client.ObjectKey{Namespace: namespace, Name: name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("shoot %s/%s not found", namespace, name), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if shoot.Status.TechnicalID == "" {
+		http.Error(w, fmt.Sprintf("shoot %s/%s does not have a control plane in a seed yet", namespace, name), http.StatusConflict)
+		return
+	}
+
+	differences, err := Diff(req.Context(), gardenReader, seedClient, shoot)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if differences == nil {
+		differences = []Difference{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(differences); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -25,13 +25,16 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	unstructuredutils "github.com/gardener/gardener/pkg/utils/kubernetes/unstructured"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // Deploy deploys the ShootState resource with the effective state for the given shoot into the garden
-// cluster.
+// cluster. If the ShootStateEncryption feature gate is enabled, the persisted secrets are encrypted with the
+// shoot-specific data key. If the spec is not overwritten, it fails if the existing ShootState contains encrypted data
+// whose encryption key does not exist anymore, since the existing data could not be decrypted anymore.
 func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
@@ -45,6 +48,23 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		return fmt.Errorf("failed computing spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
 	}
 
+	if !overwriteSpec {
+		if err := verifyEncryptionKey(ctx, gardenClient, shoot); err != nil {
+			return err
+		}
+	}
+
+	if features.DefaultFeatureGate.Enabled(features.ShootStateEncryption) {
+		key, err := GetOrCreateEncryptionKey(ctx, gardenClient, shoot)
+		if err != nil {
+			return err
+		}
+
+		if spec.Gardener, err = EncryptGardenerData(key, spec.Gardener); err != nil {
+			return fmt.Errorf("failed encrypting spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	}
+
 	_, err = controllerutils.GetAndCreateOrStrategicMergePatch(ctx, gardenClient, shootState, func() error {
 		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
 
@@ -76,7 +96,8 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 	return err
 }
 
-// Delete deletes the ShootState resource for the given shoot from the garden cluster.
+// Delete deletes the ShootState resource for the given shoot from the garden cluster. Afterwards, the encryption key of
+// the ShootState is not needed anymore, hence its finalizer is removed and it is deleted as well.
 func Delete(ctx context.Context, gardenClient client.Client, shoot *gardencorev1beta1.Shoot) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
@@ -86,13 +107,14 @@ func Delete(ctx context.Context, gardenClient client.Client, shoot *gardencorev1
 	}
 
 	if err := gardenerutils.ConfirmDeletion(ctx, gardenClient, shootState); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
+		if !apierrors.IsNotFound(err) {
+			return err
 		}
+	} else if err := gardenClient.Delete(ctx, shootState); client.IgnoreNotFound(err) != nil {
 		return err
 	}
 
-	return client.IgnoreNotFound(gardenClient.Delete(ctx, shootState))
+	return deleteEncryptionKey(ctx, gardenClient, shoot)
 }
 
 func computeSpec(ctx context.Context, seedClient client.Client, seedNamespace string) (*gardencorev1beta1.ShootStateSpec, error) {
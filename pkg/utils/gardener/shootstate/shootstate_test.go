@@ -25,7 +25,9 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/features"
 	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -223,6 +225,31 @@ var _ = Describe("ShootState", func() {
 				expectedSpec.Resources = append(existingResourcesData, expectedSpec.Resources...)
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
+
+			It("should encrypt the persisted secrets if the ShootStateEncryption feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootStateEncryption, true))
+
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+				Expect(shootState.Spec.Gardener).To(HaveLen(3))
+				for i, entry := range shootState.Spec.Gardener[:2] {
+					Expect(entry.Type).To(Equal("encrypted-secret"))
+					Expect(entry.Data.Raw).NotTo(Equal(expectedSpec.Gardener[i].Data.Raw))
+				}
+				Expect(shootState.Spec.Gardener[2].Type).To(Equal("machine-state"))
+
+				Expect(Decrypt(ctx, fakeGardenClient, shoot, shootState)).To(Succeed())
+				Expect(shootState.Spec.Gardener[:2]).To(Equal(expectedSpec.Gardener[:2]))
+			})
+
+			It("should fail to keep existing encrypted data if the encryption key does not exist", func() {
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				shootState.Spec.Gardener[0].Type = "encrypted-secret"
+				Expect(fakeGardenClient.Update(ctx, shootState)).To(Succeed())
+
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(MatchError(ContainSubstring("contains encrypted data but the encryption key garden-my-project/my-shoot.shootstate-encryption-key does not exist")))
+			})
 		})
 	})
 
@@ -236,6 +263,15 @@ var _ = Describe("ShootState", func() {
 			Expect(Delete(ctx, fakeGardenClient, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(BeNotFoundError())
 		})
+
+		It("should remove the finalizer of the encryption key and delete it", func() {
+			Expect(fakeGardenClient.Create(ctx, shootState)).To(Succeed())
+			_, err := GetOrCreateEncryptionKey(ctx, fakeGardenClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Delete(ctx, fakeGardenClient, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name + ".shootstate-encryption-key"}, &gardencorev1beta1.InternalSecret{})).To(BeNotFoundError())
+		})
 	})
 })
 
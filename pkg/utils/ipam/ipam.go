@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// AnnotationAllocatedNetworks is the annotation on Shoots containing the comma-separated list of networks whose
+	// CIDRs were allocated by the external IPAM server.
+	AnnotationAllocatedNetworks = "ipam.gardener.cloud/allocated-networks"
+	// FinalizerName is the finalizer on Shoots with allocated networks which is removed after the CIDRs were released.
+	FinalizerName = "ipam.gardener.cloud/release"
+
+	// NetworkPods is the name of the pod network.
+	NetworkPods = "pods"
+	// NetworkServices is the name of the service network.
+	NetworkServices = "services"
+	// NetworkNodes is the name of the node network.
+	NetworkNodes = "nodes"
+)
+
+// Request is sent to the external IPAM server for allocating or releasing the CIDRs of a Shoot.
+type Request struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// ProviderType is the provider type of the Shoot.
+	ProviderType string `json:"providerType,omitempty"`
+	// Region is the region of the Shoot.
+	Region string `json:"region,omitempty"`
+	// IPFamilies are the IP families of the Shoot networks.
+	IPFamilies []string `json:"ipFamilies,omitempty"`
+	// Networks are the names of the networks, see the Network* constants.
+	Networks []string `json:"networks"`
+}
+
+// Allocation is the response of the external IPAM server for an allocation request.
+type Allocation struct {
+	// Pods is the CIDR of the pod network.
+	Pods *string `json:"pods,omitempty"`
+	// Services is the CIDR of the service network.
+	Services *string `json:"services,omitempty"`
+	// Nodes is the CIDR of the node network.
+	Nodes *string `json:"nodes,omitempty"`
+}
+
+// AllocatedShoot is returned by the external IPAM server for each Shoot with allocated CIDRs.
+type AllocatedShoot struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Networks are the names of the allocated networks, see the Network* constants.
+	Networks []string `json:"networks"`
+	// AllocationTime is the time when the networks were allocated first.
+	AllocationTime time.Time `json:"allocationTime"`
+}
+
+// Client allocates and releases CIDRs of Shoots at an external IPAM server.
+// Requests are identified by the namespace and name of the Shoot, hence servers are expected to return the same
+// CIDRs for repeated allocation requests and to treat the release of unknown Shoots as success.
+type Client interface {
+	// Allocate allocates the CIDRs of the requested networks.
+	Allocate(ctx context.Context, request Request) (*Allocation, error)
+	// Release releases the CIDRs of the requested networks.
+	Release(ctx context.Context, request Request) error
+	// List returns all Shoots with allocated CIDRs.
+	List(ctx context.Context) ([]AllocatedShoot, error)
+}
+
+// NewClient returns a Client for the IPAM server with the given URL. The CIDRs are allocated and released by POST
+// requests to the `/allocate` and `/release` paths, and the allocations are listed by GET requests to the
+// `/allocations` path. If the CA bundle is empty, the system trust store is used.
+func NewClient(url string, caBundle []byte, timeout time.Duration) (Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("failed parsing CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: &http.Client{Transport: transport, Timeout: timeout},
+	}, nil
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (c *client) Allocate(ctx context.Context, request Request) (*Allocation, error) {
+	allocation := &Allocation{}
+	if err := c.do(ctx, http.MethodPost, "/allocate", request, allocation); err != nil {
+		return nil, fmt.Errorf("failed allocating networks: %w", err)
+	}
+	return allocation, nil
+}
+
+func (c *client) Release(ctx context.Context, request Request) error {
+	if err := c.do(ctx, http.MethodPost, "/release", request, nil); err != nil {
+		return fmt.Errorf("failed releasing networks: %w", err)
+	}
+	return nil
+}
+
+func (c *client) List(ctx context.Context) ([]AllocatedShoot, error) {
+	var allocatedShoots []AllocatedShoot
+	if err := c.do(ctx, http.MethodGet, "/allocations", nil, &allocatedShoots); err != nil {
+		return nil, fmt.Errorf("failed listing allocations: %w", err)
+	}
+	return allocatedShoots, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/utils/ipam"
+)
+
+var _ = Describe("IPAM", func() {
+	var (
+		ctx = context.Background()
+
+		server     *httptest.Server
+		paths      []string
+		requests   []Request
+		statusCode int
+		response   string
+
+		client  Client
+		request Request
+	)
+
+	BeforeEach(func() {
+		paths, requests, statusCode, response = nil, nil, http.StatusOK, "{}"
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			paths = append(paths, r.Method+" "+r.URL.Path)
+			if r.Method == http.MethodPost {
+				var req Request
+				Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				requests = append(requests, req)
+			}
+
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		client, err = NewClient(server.URL+"/", caBundle, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+
+		request = Request{
+			Namespace: "garden-foo",
+			Name:      "bar",
+			Networks:  []string{NetworkPods, NetworkServices},
+		}
+	})
+
+	Describe("#NewClient", func() {
+		It("should fail for an invalid CA bundle", func() {
+			_, err := NewClient(server.URL, []byte("invalid"), time.Second)
+			Expect(err).To(MatchError("failed parsing CA bundle"))
+		})
+	})
+
+	Describe("#Allocate", func() {
+		It("should return the allocated networks", func() {
+			response = `{"pods":"10.1.0.0/16","services":"10.2.0.0/20"}`
+
+			Expect(client.Allocate(ctx, request)).To(Equal(&Allocation{
+				Pods:     ptr.To("10.1.0.0/16"),
+				Services: ptr.To("10.2.0.0/20"),
+			}))
+			Expect(paths).To(ConsistOf("POST /allocate"))
+			Expect(requests).To(ConsistOf(request))
+		})
+
+		It("should fail for unsuccessful response codes", func() {
+			statusCode, response = http.StatusConflict, "address space exhausted"
+
+			_, err := client.Allocate(ctx, request)
+			Expect(err).To(MatchError("failed allocating networks: unexpected response code 409: address space exhausted"))
+		})
+	})
+
+	Describe("#Release", func() {
+		It("should release the networks", func() {
+			Expect(client.Release(ctx, request)).To(Succeed())
+			Expect(paths).To(ConsistOf("POST /release"))
+			Expect(requests).To(ConsistOf(request))
+		})
+
+		It("should fail for unsuccessful response codes", func() {
+			statusCode = http.StatusInternalServerError
+
+			Expect(client.Release(ctx, request)).To(MatchError(ContainSubstring("failed releasing networks: unexpected response code 500")))
+		})
+	})
+
+	Describe("#List", func() {
+		It("should return the allocated shoots", func() {
+			response = `[{"namespace":"garden-foo","name":"bar","networks":["pods","services"],"allocationTime":"2024-01-02T03:04:05Z"}]`
+
+			Expect(client.List(ctx)).To(ConsistOf(AllocatedShoot{
+				Namespace:      "garden-foo",
+				Name:           "bar",
+				Networks:       []string{NetworkPods, NetworkServices},
+				AllocationTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}))
+			Expect(paths).To(ConsistOf("GET /allocations"))
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should fail for unsuccessful response codes", func() {
+			statusCode = http.StatusInternalServerError
+
+			_, err := client.List(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed listing allocations: unexpected response code 500")))
+		})
+	})
+})
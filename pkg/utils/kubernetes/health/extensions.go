@@ -5,6 +5,7 @@
 package health
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -31,7 +32,7 @@ func CheckExtensionObject(o client.Object) error {
 	}
 
 	status := obj.GetExtensionStatus()
-	return checkExtensionObject(obj.GetGeneration(), status.GetObservedGeneration(), obj.GetAnnotations(), status.GetLastError(), status.GetLastOperation())
+	return checkExtensionObject(obj.GetGeneration(), status.GetObservedGeneration(), obj.GetAnnotations(), status.GetLastError(), status.GetLastOperation(), obj.GetExtensionSpec().GetExtensionType())
 }
 
 // ExtensionOperationHasBeenUpdatedSince returns a health check function that checks if an extension Object's last
@@ -51,22 +52,51 @@ func ExtensionOperationHasBeenUpdatedSince(lastUpdateTime metav1.Time) Func {
 	}
 }
 
-// checkExtensionObject checks if an extension Object is healthy or not.
-func checkExtensionObject(generation int64, observedGeneration int64, annotations map[string]string, lastError *gardencorev1beta1.LastError, lastOperation *gardencorev1beta1.LastOperation) error {
+// notReconciledError is returned by the extension health checks if the responsible controller has not (yet) picked up
+// the latest state of the object.
+type notReconciledError struct {
+	err error
+}
+
+func (e *notReconciledError) Error() string {
+	return e.err.Error()
+}
+
+func (e *notReconciledError) Unwrap() error {
+	return e.err
+}
+
+// IsExtensionNotReconciled returns true if the given error was returned by an extension health check because the
+// responsible controller has not reconciled the latest state of the object.
+func IsExtensionNotReconciled(err error) bool {
+	var notReconciledErr *notReconciledError
+	return errors.As(err, &notReconciledErr)
+}
+
+// checkExtensionObject checks if an extension Object is healthy or not. The given component is attached to errors
+// reported by the extension unless the last error already names the originating component.
+func checkExtensionObject(generation int64, observedGeneration int64, annotations map[string]string, lastError *gardencorev1beta1.LastError, lastOperation *gardencorev1beta1.LastOperation, component string) error {
 	if lastError != nil {
-		return v1beta1helper.NewErrorWithCodes(fmt.Errorf("error during reconciliation: %s", lastError.Description), lastError.Codes...)
+		err := v1beta1helper.NewErrorWithCodes(fmt.Errorf("error during reconciliation: %s", lastError.Description), lastError.Codes...)
+		if lastError.Component != nil {
+			component = *lastError.Component
+		}
+		if component != "" {
+			err = v1beta1helper.NewErrorWithComponent(err, component)
+		}
+		return err
 	}
 
 	if observedGeneration != generation {
-		return fmt.Errorf("observed generation outdated (%d/%d)", observedGeneration, generation)
+		return &notReconciledError{fmt.Errorf("observed generation outdated (%d/%d)", observedGeneration, generation)}
 	}
 
 	if op, ok := annotations[v1beta1constants.GardenerOperation]; ok {
-		return fmt.Errorf("gardener operation %q is not yet picked up by controller", op)
+		return &notReconciledError{fmt.Errorf("gardener operation %q is not yet picked up by controller", op)}
 	}
 
 	if lastOperation == nil {
-		return fmt.Errorf("extension did not record a last operation yet")
+		return &notReconciledError{fmt.Errorf("extension did not record a last operation yet")}
 	}
 
 	if lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
@@ -80,11 +110,11 @@ func checkExtensionObject(generation int64, observedGeneration int64, annotation
 		}
 
 		if parsedTimestamp.Truncate(time.Second).UTC().After(lastOperation.LastUpdateTime.Time.UTC()) {
-			return fmt.Errorf(
+			return &notReconciledError{fmt.Errorf(
 				"extension is not reconciled yet - reconciliation requested at %s, last update time is: %s",
 				parsedTimestamp.Truncate(time.Second).UTC().Format(time.RFC3339),
 				lastOperation.LastUpdateTime.Time.UTC().Format(time.RFC3339),
-			)
+			)}
 		}
 	}
 
@@ -97,5 +127,5 @@ func CheckBackupEntry(obj client.Object) error {
 	if !ok {
 		return fmt.Errorf("expected *gardencorev1beta1.BackupEntry but got %T", obj)
 	}
-	return checkExtensionObject(be.Generation, be.Status.ObservedGeneration, be.Annotations, be.Status.LastError, be.Status.LastOperation)
+	return checkExtensionObject(be.Generation, be.Status.ObservedGeneration, be.Annotations, be.Status.LastError, be.Status.LastOperation, "")
 }
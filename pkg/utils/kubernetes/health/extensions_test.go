@@ -16,12 +16,47 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
 
 var _ = Describe("Extensions", func() {
 	Describe("CheckExtensionObject", func() {
+		It("should attach the extension type and error codes to the reported last error", func() {
+			err := health.CheckExtensionObject(&extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"},
+				},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastError: &gardencorev1beta1.LastError{
+							Description: "something happened",
+							Codes:       []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded},
+						},
+					},
+				},
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("something happened")))
+			Expect(v1beta1helper.ExtractComponent(err)).To(Equal("foo"))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should report that the extension object was not reconciled", func() {
+			err := health.CheckExtensionObject(&extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Generation: 2}})
+			Expect(health.IsExtensionNotReconciled(err)).To(BeTrue())
+
+			err = health.CheckExtensionObject(&extensionsv1alpha1.Infrastructure{
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastError: &gardencorev1beta1.LastError{Description: "something happened"},
+					},
+				},
+			})
+			Expect(health.IsExtensionNotReconciled(err)).To(BeFalse())
+		})
+
 		DescribeTable("extension objects",
 			func(obj client.Object, match types.GomegaMatcher) {
 				Expect(health.CheckExtensionObject(obj)).To(match)
@@ -31,7 +31,7 @@ func CheckAPIServerAvailability(
 	responseDurationText := fmt.Sprintf("[response_time:%dms]", clock.Now().Sub(clock.Now()).Nanoseconds()/time.Millisecond.Nanoseconds())
 	if response.Error() != nil {
 		message := fmt.Sprintf("Request to API server /healthz endpoint failed. %s (%s)", responseDurationText, response.Error().Error())
-		return conditioner("HealthzRequestFailed", message)
+		return conditioner(gardencorev1beta1.HealthzRequestFailed, message)
 	}
 
 	// Determine the status code of the response.
@@ -49,9 +49,9 @@ func CheckAPIServerAvailability(
 		}
 
 		log.Error(err, "API Server /healthz endpoint check returned non ok status code", "statusCode", statusCode, "body", body)
-		return conditioner("HealthzRequestError", fmt.Sprintf("API server /healthz endpoint check returned a non ok status code %d. (%s)", statusCode, body))
+		return conditioner(gardencorev1beta1.HealthzRequestError, fmt.Sprintf("API server /healthz endpoint check returned a non ok status code %d. (%s)", statusCode, body))
 	}
 
 	message := "API server /healthz endpoint responded with success status code."
-	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, "HealthzRequestSucceeded", message)
+	return v1beta1helper.UpdatedConditionWithClock(clock, condition, gardencorev1beta1.ConditionTrue, gardencorev1beta1.HealthzRequestSucceeded, message)
 }
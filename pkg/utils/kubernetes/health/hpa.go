@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CheckHorizontalPodAutoscaler checks whether the given HPA is healthy, i.e., whether it is able to scale its target.
+// The ScalingActive condition is not considered because it is also False if the target was scaled to zero or if the
+// metrics could not be fetched temporarily (e.g., while the metrics server is restarted).
+func CheckHorizontalPodAutoscaler(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	for _, condition := range hpa.Status.Conditions {
+		if condition.Type == autoscalingv2.AbleToScale {
+			return checkConditionState(string(condition.Type), string(corev1.ConditionTrue), string(condition.Status), condition.Reason, condition.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("HPA", func() {
+	DescribeTable("CheckHorizontalPodAutoscaler",
+		func(conditions []autoscalingv2.HorizontalPodAutoscalerCondition, matcher types.GomegaMatcher) {
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{Status: autoscalingv2.HorizontalPodAutoscalerStatus{Conditions: conditions}}
+			Expect(health.CheckHorizontalPodAutoscaler(hpa)).To(matcher)
+		},
+		Entry("no conditions", nil, BeNil()),
+		Entry("healthy", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionTrue},
+			{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionTrue},
+		}, BeNil()),
+		Entry("scaling disabled", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "ScalingDisabled"},
+		}, BeNil()),
+		Entry("not able to scale", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionFalse, Reason: "FailedGetScale"},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionTrue},
+		}, MatchError(ContainSubstring(`condition "AbleToScale" has invalid status False (expected True) due to FailedGetScale`))),
+		Entry("failed to fetch resource metrics temporarily", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "FailedGetResourceMetric"},
+		}, BeNil()),
+		Entry("failed to fetch external metrics temporarily", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "FailedGetExternalMetric"},
+		}, BeNil()),
+		Entry("not able to scale and failed to fetch metrics", []autoscalingv2.HorizontalPodAutoscalerCondition{
+			{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionFalse, Reason: "FailedUpdateScale"},
+			{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "FailedGetResourceMetric"},
+		}, MatchError(ContainSubstring(`condition "AbleToScale" has invalid status False (expected True) due to FailedUpdateScale`))),
+	)
+})
@@ -5,6 +5,7 @@
 package kubernetes
 
 import (
+	"net"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +31,23 @@ func IsNodeLabelAllowedForKubelet(key string) bool {
 	return true
 }
 
+// HasDualStackPodCIDRs returns true if pod CIDRs of both IP families are assigned to the given node.
+func HasDualStackPodCIDRs(node *corev1.Node) bool {
+	var ipv4, ipv6 bool
+	for _, podCIDR := range node.Spec.PodCIDRs {
+		ip, _, err := net.ParseCIDR(podCIDR)
+		if err != nil {
+			continue
+		}
+		if ip.To4() != nil {
+			ipv4 = true
+		} else {
+			ipv6 = true
+		}
+	}
+	return ipv4 && ipv6
+}
+
 // same logic as in kube-apiserver and kubelet code
 func getLabelNamespace(key string) string {
 	if parts := strings.SplitN(key, "/", 2); len(parts) == 2 {
@@ -7,6 +7,7 @@ package kubernetes_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 
 	. "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -63,4 +64,17 @@ var _ = Describe("Node", func() {
 			Expect(IsNodeLabelAllowedForKubelet("foo/bar")).To(BeTrue())
 		})
 	})
+
+	DescribeTable("#HasDualStackPodCIDRs",
+		func(podCIDRs []string, expected bool) {
+			node := &corev1.Node{Spec: corev1.NodeSpec{PodCIDRs: podCIDRs}}
+			Expect(HasDualStackPodCIDRs(node)).To(Equal(expected))
+		},
+
+		Entry("no pod CIDRs", nil, false),
+		Entry("IPv4 pod CIDR only", []string{"100.96.0.0/24"}, false),
+		Entry("IPv6 pod CIDR only", []string{"2001:db8:1::/64"}, false),
+		Entry("invalid pod CIDR", []string{"100.96.0.0/24", "foo"}, false),
+		Entry("IPv4 and IPv6 pod CIDRs", []string{"100.96.0.0/24", "2001:db8:1::/64"}, true),
+	)
 })
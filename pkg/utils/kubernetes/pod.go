@@ -187,3 +187,55 @@ func GetDeploymentForPod(ctx context.Context, reader client.Reader, namespace st
 
 	return deployment, nil
 }
+
+// PodRequests returns the resource requests of the given pod. Init containers run sequentially before the regular
+// containers, hence only the maximum of their requests and the sum of the requests of the regular containers is
+// considered. The pod overhead is added to the result.
+func PodRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := containerResources(pod, func(resources corev1.ResourceRequirements) corev1.ResourceList { return resources.Requests })
+
+	for name, quantity := range pod.Spec.Overhead {
+		value := requests[name]
+		value.Add(quantity)
+		requests[name] = value
+	}
+
+	return requests
+}
+
+// PodLimits returns the resource limits of the given pod. They are computed like the requests in PodRequests, however
+// containers without a limit for a resource are not taken into account, and the pod overhead is only added for
+// resources which have a limit.
+func PodLimits(pod *corev1.Pod) corev1.ResourceList {
+	limits := containerResources(pod, func(resources corev1.ResourceRequirements) corev1.ResourceList { return resources.Limits })
+
+	for name, quantity := range pod.Spec.Overhead {
+		if value, ok := limits[name]; ok {
+			value.Add(quantity)
+			limits[name] = value
+		}
+	}
+
+	return limits
+}
+
+func containerResources(pod *corev1.Pod, resourceList func(corev1.ResourceRequirements) corev1.ResourceList) corev1.ResourceList {
+	result := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range resourceList(container.Resources) {
+			value := result[name]
+			value.Add(quantity)
+			result[name] = value
+		}
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range resourceList(container.Resources) {
+			if value, ok := result[name]; !ok || quantity.Cmp(value) > 0 {
+				result[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	return result
+}
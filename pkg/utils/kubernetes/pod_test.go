@@ -15,6 +15,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -486,4 +487,54 @@ var _ = Describe("Pod Utils", func() {
 			Expect(deployment).To(Equal(deployment))
 		})
 	})
+
+	Describe("#PodRequests and #PodLimits", func() {
+		var pod *corev1.Pod
+
+		BeforeEach(func() {
+			pod = &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+							Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						}},
+					},
+					Containers: []corev1.Container{
+						{Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("100Mi")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+						}},
+						{Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m"), corev1.ResourceMemory: resource.MustParse("50Mi")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+						}},
+					},
+				},
+			}
+		})
+
+		It("should sum up the containers and consider the maximum of the init containers", func() {
+			requests := PodRequests(pod)
+			Expect(requests.Cpu().Cmp(resource.MustParse("500m"))).To(Equal(0))
+			Expect(requests.Memory().Cmp(resource.MustParse("150Mi"))).To(Equal(0))
+
+			limits := PodLimits(pod)
+			Expect(limits.Cpu().Cmp(resource.MustParse("1"))).To(Equal(0))
+			Expect(limits.Memory().Cmp(resource.MustParse("300Mi"))).To(Equal(0))
+		})
+
+		It("should add the pod overhead to the requests and to the existing limits", func() {
+			pod.Spec.InitContainers = nil
+			pod.Spec.Overhead = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m"), corev1.ResourceMemory: resource.MustParse("10Mi")}
+
+			requests := PodRequests(pod)
+			Expect(requests.Cpu().Cmp(resource.MustParse("350m"))).To(Equal(0))
+			Expect(requests.Memory().Cmp(resource.MustParse("160Mi"))).To(Equal(0))
+
+			limits := PodLimits(pod)
+			Expect(limits).NotTo(HaveKey(corev1.ResourceCPU))
+			Expect(limits.Memory().Cmp(resource.MustParse("310Mi"))).To(Equal(0))
+		})
+	})
 })
@@ -41,6 +41,17 @@ func CheckDualStackForKubeComponents(cidrs []net.IPNet, networkType string) erro
 	return nil
 }
 
+// FilterIPv4 returns the networks of the given list which belong to the IPv4 family.
+func FilterIPv4(cidrs []net.IPNet) []net.IPNet {
+	var result []net.IPNet
+	for _, cidr := range cidrs {
+		if cidr.IP.To4() != nil {
+			result = append(result, cidr)
+		}
+	}
+	return result
+}
+
 func dualStack(cidrs []net.IPNet) (bool, error) {
 	v4 := false
 	v6 := false
@@ -33,6 +33,23 @@ var _ = DescribeTable("#JoinByComma",
 		"10.250.0.0/16,192.168.0.0/24,2001:db8:1::/64,2001:db8:2::/64"),
 )
 
+var _ = DescribeTable("#FilterIPv4",
+	func(cidrs []string, expected string) {
+		var networks []net.IPNet
+		for _, cidr := range cidrs {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			Expect(err).ToNot(HaveOccurred())
+			networks = append(networks, *ipNet)
+		}
+		Expect(JoinByComma(FilterIPv4(networks))).To(Equal(expected))
+	},
+
+	Entry("should work with nil list", nil, ""),
+	Entry("should keep IPv4 networks", []string{"10.250.0.0/16", "192.168.0.0/24"}, "10.250.0.0/16,192.168.0.0/24"),
+	Entry("should drop IPv6 networks", []string{"10.250.0.0/16", "2001:db8:1::/64"}, "10.250.0.0/16"),
+	Entry("should return nothing for IPv6 networks only", []string{"2001:db8:1::/64"}, ""),
+)
+
 var _ = DescribeTable("#Join",
 	func(cidrs []string, sep string, expected string) {
 		var networks []net.IPNet
@@ -4,36 +4,79 @@
 
 package oci
 
-import "sync"
+import (
+	"sync"
+	"time"
 
-var defaultCache = newCache()
+	"k8s.io/utils/clock"
+)
+
+// defaultCacheTTL is the duration after which items which have not been accessed are removed from the cache.
+const defaultCacheTTL = 24 * time.Hour
+
+var defaultCache = newCache(clock.RealClock{}, defaultCacheTTL)
 
 type cacher interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, blob []byte)
 }
 
-func newCache() *cache {
+func newCache(clock clock.Clock, ttl time.Duration) *cache {
 	return &cache{
-		items: map[string][]byte{},
+		clock: clock,
+		ttl:   ttl,
+		items: map[string]*cacheItem{},
 	}
 }
 
-// cache is a basic key-value cache i.e. a map propected by a mutex. Items are never removed from the cache.
+// cache is a basic key-value cache i.e. a map protected by a mutex. Items which have not been accessed for longer than
+// the TTL are garbage collected whenever a new item is added to the cache.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	items map[string]*cacheItem
+}
+
+type cacheItem struct {
+	blob         []byte
+	lastAccessed time.Time
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, found := c.items[key]
+	if !found || c.expired(item) {
+		cacheRequests.WithLabelValues(cacheResultMiss).Inc()
+		return nil, false
+	}
+
+	cacheRequests.WithLabelValues(cacheResultHit).Inc()
+	item.lastAccessed = c.clock.Now()
+	return item.blob, true
 }
 
 func (c *cache) Set(key string, blob []byte) {
 	c.mu.Lock()
-	c.items[key] = blob
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+
+	c.garbageCollect()
+	c.items[key] = &cacheItem{blob: blob, lastAccessed: c.clock.Now()}
+	cacheItems.Set(float64(len(c.items)))
+}
+
+func (c *cache) garbageCollect() {
+	for key, item := range c.items {
+		if c.expired(item) {
+			delete(c.items, key)
+			cacheEvictions.Inc()
+		}
+	}
+}
+
+func (c *cache) expired(item *cacheItem) bool {
+	return c.clock.Since(item.lastAccessed) > c.ttl
 }
@@ -5,15 +5,28 @@
 package oci
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	testclock "k8s.io/utils/clock/testing"
 )
 
 var _ = Describe("cache", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		c         *cache
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		c = newCache(fakeClock, time.Hour)
+	})
+
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
@@ -24,4 +37,53 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should count hits and misses", func() {
+		hits := testutil.ToFloat64(cacheRequests.WithLabelValues("hit"))
+		misses := testutil.ToFloat64(cacheRequests.WithLabelValues("miss"))
+
+		c.Get("foo")
+		c.Set("foo", []byte("bar"))
+		c.Get("foo")
+		c.Get("foo")
+
+		Expect(testutil.ToFloat64(cacheRequests.WithLabelValues("hit"))).To(Equal(hits + 2))
+		Expect(testutil.ToFloat64(cacheRequests.WithLabelValues("miss"))).To(Equal(misses + 1))
+	})
+
+	It("should not return items which have not been accessed within the TTL", func() {
+		c.Set("foo", []byte("bar"))
+
+		fakeClock.Step(time.Hour + time.Second)
+
+		_, found := c.Get("foo")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should keep items which have been accessed within the TTL", func() {
+		c.Set("foo", []byte("bar"))
+
+		fakeClock.Step(45 * time.Minute)
+		_, found := c.Get("foo")
+		Expect(found).To(BeTrue())
+
+		fakeClock.Step(45 * time.Minute)
+		_, found = c.Get("foo")
+		Expect(found).To(BeTrue())
+	})
+
+	It("should garbage collect expired items when adding new items", func() {
+		evictions := testutil.ToFloat64(cacheEvictions)
+
+		c.Set("foo", []byte("bar"))
+		fakeClock.Step(30 * time.Minute)
+		c.Set("bar", []byte("baz"))
+		fakeClock.Step(45 * time.Minute)
+		c.Set("baz", []byte("foo"))
+
+		Expect(c.items).To(HaveLen(2))
+		Expect(c.items).NotTo(HaveKey("foo"))
+		Expect(testutil.ToFloat64(cacheEvictions)).To(Equal(evictions + 1))
+		Expect(testutil.ToFloat64(cacheItems)).To(Equal(float64(2)))
+	})
 })
@@ -7,12 +7,14 @@ package oci
 import (
 	"context"
 	"fmt"
+	"time"
 
 	_ "github.com/distribution/distribution/v3/registry/storage/driver/inmemory"
 	"github.com/google/go-containerregistry/pkg/name"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
@@ -27,7 +29,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: newCache(clock.RealClock{}, time.Hour)}
 		hr = &HelmRegistry{cache: rc}
 	})
 
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// cosignSignatureAnnotation is the annotation of the layers of cosign signature artifacts which contains the
+	// base64-encoded signature of the layer's payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignSignatureType is the type of the simple signing payload of cosign signatures.
+	cosignSignatureType = "cosign container image signature"
+)
+
+// ImageVerifier verifies container images before they are deployed.
+type ImageVerifier interface {
+	// Verify verifies the given image reference.
+	Verify(ctx context.Context, image string) error
+}
+
+// NewImageVerifier returns a new ImageVerifier. If requireDigest is true, images must be referenced by digest. If public
+// keys are given, images must have a cosign signature which can be verified with one of them. The given keychain is
+// used for authenticating against the registries, anonymous access is used if it is nil. Digests of successfully
+// verified images are memoized, i.e., their signatures are only fetched once.
+func NewImageVerifier(requireDigest bool, publicKeys []crypto.PublicKey, keychain authn.Keychain) ImageVerifier {
+	return &imageVerifier{
+		requireDigest: requireDigest,
+		publicKeys:    publicKeys,
+		keychain:      keychain,
+		verified:      sets.New[string](),
+	}
+}
+
+type imageVerifier struct {
+	requireDigest bool
+	publicKeys    []crypto.PublicKey
+	keychain      authn.Keychain
+
+	lock     sync.RWMutex
+	verified sets.Set[string]
+}
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type simpleSigningPayload struct {
+	Critical simpleSigningCritical `json:"critical"`
+}
+
+// simpleSigningCritical is the critical section of a simpleSigningPayload.
+type simpleSigningCritical struct {
+	Image simpleSigningImage `json:"image"`
+	Type  string             `json:"type"`
+}
+
+// simpleSigningImage identifies the signed image of a simpleSigningPayload.
+type simpleSigningImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+func (v *imageVerifier) Verify(ctx context.Context, image string) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return fmt.Errorf("failed parsing reference of image %q: %w", image, err)
+	}
+
+	digestRef, isDigest := ref.(name.Digest)
+	if v.requireDigest && !isDigest {
+		return fmt.Errorf("image %q is not referenced by digest", image)
+	}
+
+	if len(v.publicKeys) == 0 {
+		return nil
+	}
+
+	remoteOpts := []remote.Option{remote.WithContext(ctx)}
+	if v.keychain != nil {
+		remoteOpts = append(remoteOpts, remote.WithAuthFromKeychain(v.keychain))
+	}
+
+	if !isDigest {
+		desc, err := remote.Head(ref, remoteOpts...)
+		if err != nil {
+			return fmt.Errorf("failed resolving digest of image %q: %w", image, err)
+		}
+		digestRef = ref.Context().Digest(desc.Digest.String())
+	}
+
+	v.lock.RLock()
+	verified := v.verified.Has(digestRef.Name())
+	v.lock.RUnlock()
+	if verified {
+		return nil
+	}
+
+	if err := v.verifySignature(digestRef, remoteOpts...); err != nil {
+		return fmt.Errorf("failed verifying signature of image %q: %w", image, err)
+	}
+
+	v.lock.Lock()
+	v.verified.Insert(digestRef.Name())
+	v.lock.Unlock()
+
+	return nil
+}
+
+func (v *imageVerifier) verifySignature(ref name.Digest, opts ...remote.Option) error {
+	// cosign stores the signatures of an image in the same repository with a tag derived from the image digest.
+	signatureTag := ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + ".sig")
+
+	signatureImage, err := remote.Image(signatureTag, opts...)
+	if err != nil {
+		return fmt.Errorf("failed fetching signatures %s: %w", signatureTag, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed reading manifest of signatures %s: %w", signatureTag, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		encodedSignature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			continue
+		}
+
+		payload, err := readLayer(signatureImage, layer.Digest)
+		if err != nil {
+			return err
+		}
+
+		if !v.verifyPayload(payload, signature) {
+			continue
+		}
+
+		if err := checkPayload(payload, ref.DigestStr()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	return errors.New("no signature could be verified with the trusted public keys")
+}
+
+func (v *imageVerifier) verifyPayload(payload, signature []byte) bool {
+	digest := sha256.Sum256(payload)
+
+	for _, publicKey := range v.publicKeys {
+		switch key := publicKey.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, digest[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func checkPayload(rawPayload []byte, digest string) error {
+	payload := &simpleSigningPayload{}
+	if err := json.Unmarshal(rawPayload, payload); err != nil {
+		return fmt.Errorf("failed decoding signature payload: %w", err)
+	}
+
+	if payload.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("unexpected signature type %q", payload.Critical.Type)
+	}
+
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature was created for digest %q instead of %q", payload.Critical.Image.DockerManifestDigest, digest)
+	}
+
+	return nil
+}
+
+func readLayer(image gcrv1.Image, digest gcrv1.Hash) ([]byte, error) {
+	layer, err := image.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading signature layer %s: %w", digest, err)
+	}
+
+	blob, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading signature layer %s: %w", digest, err)
+	}
+	defer blob.Close()
+
+	return io.ReadAll(blob)
+}
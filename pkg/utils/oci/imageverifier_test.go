@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImageVerifier", func() {
+	var (
+		ctx = context.Background()
+
+		privateKey *ecdsa.PrivateKey
+		tag        name.Tag
+		digest     string
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		image, err := random.Image(64, 1)
+		Expect(err).NotTo(HaveOccurred())
+		hash, err := image.Digest()
+		Expect(err).NotTo(HaveOccurred())
+		digest = hash.String()
+
+		tag, err = name.NewTag(registryAddress + "/images/example:v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Write(tag, image)).To(Succeed())
+	})
+
+	sign := func(key *ecdsa.PrivateKey, signedDigest string) {
+		payload, err := json.Marshal(simpleSigningPayload{Critical: simpleSigningCritical{
+			Image: simpleSigningImage{DockerManifestDigest: signedDigest},
+			Type:  cosignSignatureType,
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		hash := sha256.Sum256(payload)
+		signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+		Expect(err).NotTo(HaveOccurred())
+
+		signatureImage, err := mutate.Append(empty.Image, mutate.Addendum{
+			Layer:       static.NewLayer(payload, types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json")),
+			Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(remote.Write(tag.Context().Tag(strings.Replace(digest, ":", "-", 1)+".sig"), signatureImage)).To(Succeed())
+	}
+
+	It("should accept images referenced by tag if no digest is required", func() {
+		Expect(NewImageVerifier(false, nil, nil).Verify(ctx, tag.Name())).To(Succeed())
+	})
+
+	It("should reject images referenced by tag if a digest is required", func() {
+		Expect(NewImageVerifier(true, nil, nil).Verify(ctx, tag.Name())).To(MatchError(ContainSubstring("is not referenced by digest")))
+		Expect(NewImageVerifier(true, nil, nil).Verify(ctx, tag.Context().Digest(digest).Name())).To(Succeed())
+	})
+
+	It("should accept images with a valid signature", func() {
+		sign(privateKey, digest)
+
+		verifier := NewImageVerifier(false, []crypto.PublicKey{&privateKey.PublicKey}, nil)
+		Expect(verifier.Verify(ctx, tag.Name())).To(Succeed())
+		Expect(verifier.Verify(ctx, tag.Context().Digest(digest).Name())).To(Succeed())
+	})
+
+	It("should use the given keychain for accessing the registry", func() {
+		sign(privateKey, digest)
+
+		keychain := &countingKeychain{}
+		Expect(NewImageVerifier(false, []crypto.PublicKey{&privateKey.PublicKey}, keychain).Verify(ctx, tag.Name())).To(Succeed())
+		Expect(keychain.resolved).To(BeNumerically(">", 0))
+	})
+
+	It("should reject unsigned images", func() {
+		Expect(NewImageVerifier(false, []crypto.PublicKey{&privateKey.PublicKey}, nil).Verify(ctx, tag.Name())).To(MatchError(ContainSubstring("failed fetching signatures")))
+	})
+
+	It("should reject images signed with an untrusted key", func() {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		sign(otherKey, digest)
+
+		Expect(NewImageVerifier(false, []crypto.PublicKey{&privateKey.PublicKey}, nil).Verify(ctx, tag.Name())).To(MatchError(ContainSubstring("no signature could be verified")))
+	})
+
+	It("should reject signatures created for another digest", func() {
+		sign(privateKey, "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+
+		Expect(NewImageVerifier(false, []crypto.PublicKey{&privateKey.PublicKey}, nil).Verify(ctx, tag.Name())).To(MatchError(ContainSubstring("signature was created for digest")))
+	})
+})
+
+type countingKeychain struct {
+	resolved int
+}
+
+func (k *countingKeychain) Resolve(authn.Resource) (authn.Authenticator, error) {
+	k.resolved++
+	return authn.Anonymous, nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewPullSecretKeychain returns an authn.Keychain which resolves the credentials for registries from the given image
+// pull secrets of type `kubernetes.io/dockerconfigjson` or `kubernetes.io/dockercfg`. The secrets are read with the
+// given reader whenever credentials are resolved, i.e., rotated credentials are picked up without a restart. The first
+// secret containing credentials for the registry wins. If no secret contains credentials for the registry, anonymous
+// access is used.
+func NewPullSecretKeychain(reader client.Reader, secretRefs []corev1.SecretReference) authn.Keychain {
+	return &pullSecretKeychain{reader: reader, secretRefs: secretRefs}
+}
+
+type pullSecretKeychain struct {
+	reader     client.Reader
+	secretRefs []corev1.SecretReference
+}
+
+// dockerConfigJSON is the content of secrets of type `kubernetes.io/dockerconfigjson`.
+type dockerConfigJSON struct {
+	Auths map[string]authn.AuthConfig `json:"auths"`
+}
+
+func (k *pullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	return k.ResolveContext(context.Background(), target)
+}
+
+func (k *pullSecretKeychain) ResolveContext(ctx context.Context, target authn.Resource) (authn.Authenticator, error) {
+	for _, ref := range k.secretRefs {
+		secret := &corev1.Secret{}
+		if err := k.reader.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, fmt.Errorf("failed reading image pull secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+
+		auths, err := dockerConfigAuths(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding image pull secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+
+		for registry, authConfig := range auths {
+			if registryHost(registry) == target.RegistryStr() {
+				return authn.FromConfig(authConfig), nil
+			}
+		}
+	}
+
+	return authn.Anonymous, nil
+}
+
+// dockerConfigAuths returns the credentials per registry contained in the given image pull secret.
+func dockerConfigAuths(secret *corev1.Secret) (map[string]authn.AuthConfig, error) {
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		config := &dockerConfigJSON{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], config); err != nil {
+			return nil, err
+		}
+		return config.Auths, nil
+
+	case corev1.SecretTypeDockercfg:
+		auths := map[string]authn.AuthConfig{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &auths); err != nil {
+			return nil, err
+		}
+		return auths, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported secret type %q, expected %q or %q", secret.Type, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg)
+	}
+}
+
+// registryHost returns the registry host of the given key of a docker config, which might be a URL like
+// `https://index.docker.io/v1/`.
+func registryHost(key string) string {
+	if strings.Contains(key, "://") {
+		if u, err := url.Parse(key); err == nil {
+			key = u.Host
+		}
+	}
+	key, _, _ = strings.Cut(key, "/")
+
+	if registry, err := name.NewRegistry(key); err == nil {
+		return registry.RegistryStr()
+	}
+	return key
+}
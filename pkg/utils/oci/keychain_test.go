@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("PullSecretKeychain", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		keychain   authn.Keychain
+
+		resolve func(registry string) (*authn.AuthConfig, error)
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		keychain = NewPullSecretKeychain(fakeClient, []corev1.SecretReference{
+			{Name: "dockerconfigjson", Namespace: "garden"},
+			{Name: "dockercfg", Namespace: "garden"},
+		})
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "dockerconfigjson", Namespace: "garden"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{
+"https://index.docker.io/v1/":{"username":"hub-user","password":"hub-password"},
+"registry.example.com":{"auth":"dXNlcjpwYXNzd29yZA=="}
+}}`)},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "dockercfg", Namespace: "garden"},
+			Type:       corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{corev1.DockerConfigKey: []byte(`{
+"registry.example.com":{"username":"other","password":"other"},
+"other.example.com:5000":{"username":"legacy-user","password":"legacy-password"}
+}`)},
+		})).To(Succeed())
+
+		resolve = func(registry string) (*authn.AuthConfig, error) {
+			reg, err := name.NewRegistry(registry)
+			Expect(err).NotTo(HaveOccurred())
+
+			authenticator, err := authn.Resolve(ctx, keychain, reg)
+			if err != nil {
+				return nil, err
+			}
+			return authenticator.Authorization()
+		}
+	})
+
+	It("should return the credentials of the first secret for the registry", func() {
+		Expect(resolve("registry.example.com")).To(haveCredentials("user", "password"))
+	})
+
+	It("should match registries configured by URL", func() {
+		Expect(resolve("docker.io")).To(haveCredentials("hub-user", "hub-password"))
+	})
+
+	It("should read secrets of type kubernetes.io/dockercfg", func() {
+		Expect(resolve("other.example.com:5000")).To(haveCredentials("legacy-user", "legacy-password"))
+	})
+
+	It("should return anonymous access for unknown registries", func() {
+		Expect(resolve("unknown.example.com")).To(haveCredentials("", ""))
+	})
+
+	It("should fail if a secret does not exist", func() {
+		keychain = NewPullSecretKeychain(fakeClient, []corev1.SecretReference{{Name: "missing", Namespace: "garden"}})
+
+		_, err := resolve("registry.example.com")
+		Expect(err).To(MatchError(ContainSubstring("failed reading image pull secret garden/missing")))
+	})
+
+	It("should fail for unsupported secret types", func() {
+		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "garden"}})).To(Succeed())
+		keychain = NewPullSecretKeychain(fakeClient, []corev1.SecretReference{{Name: "opaque", Namespace: "garden"}})
+
+		_, err := resolve("registry.example.com")
+		Expect(err).To(MatchError(ContainSubstring("unsupported secret type")))
+	})
+})
+
+func haveCredentials(username, password string) gomegatypes.GomegaMatcher {
+	return PointTo(MatchFields(IgnoreExtras, Fields{
+		"Username": Equal(username),
+		"Password": Equal(password),
+	}))
+}
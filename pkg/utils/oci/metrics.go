@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener_oci"
+	metricsSubsystem = "cache"
+
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	cacheRequests = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of lookups in the cache for pulled OCI artifacts, partitioned by result (hit or miss).",
+		},
+		[]string{
+			"result",
+		},
+	)
+
+	cacheEvictions = factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "evictions_total",
+			Help:      "Total number of OCI artifacts removed from the cache because they have not been accessed within the TTL.",
+		},
+	)
+
+	cacheItems = factory.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "items",
+			Help:      "Number of OCI artifacts in the cache.",
+		},
+	)
+)
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+var (
+	// ErrCircuitBreakerOpen is returned if a request is rejected because the circuit breaker of its target is open.
+	ErrCircuitBreakerOpen = errors.New("circuit breaker is open")
+	// ErrRetryBudgetExhausted is returned if a retry is rejected because the retry budget is exhausted.
+	ErrRetryBudgetExhausted = errors.New("retry budget is exhausted")
+)
+
+// CircuitBreakerState is the state of a circuit breaker.
+type CircuitBreakerState int
+
+const (
+	// CircuitBreakerClosed is the state of a circuit breaker which allows all requests.
+	CircuitBreakerClosed CircuitBreakerState = iota
+	// CircuitBreakerHalfOpen is the state of a circuit breaker which allows a single trial request to check whether
+	// the target has recovered.
+	CircuitBreakerHalfOpen
+	// CircuitBreakerOpen is the state of a circuit breaker which rejects all requests.
+	CircuitBreakerOpen
+)
+
+// String implements fmt.Stringer.
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerClosed:
+		return "closed"
+	case CircuitBreakerHalfOpen:
+		return "half-open"
+	case CircuitBreakerOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// CircuitBreakerConfig is the configuration of circuit breakers.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures after which the circuit breaker opens.
+	FailureThreshold int
+	// OpenDuration is the duration for which the circuit breaker stays open before it allows a trial request.
+	OpenDuration time.Duration
+}
+
+// CircuitBreakers manages the circuit breakers for multiple targets, e.g. the endpoints of a cloud API or webhooks.
+// It is supposed to be shared by all workers of a controller.
+type CircuitBreakers struct {
+	name   string
+	config CircuitBreakerConfig
+	clock  clock.PassiveClock
+
+	lock     sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewCircuitBreakers returns new CircuitBreakers with the given name and configuration. The name is used to identify
+// the circuit breakers in the metrics.
+func NewCircuitBreakers(name string, config CircuitBreakerConfig, clock clock.PassiveClock) *CircuitBreakers {
+	return &CircuitBreakers{
+		name:     name,
+		config:   config,
+		clock:    clock,
+		breakers: make(map[string]*CircuitBreaker),
+	}
+}
+
+// For returns the circuit breaker for the given target.
+func (c *CircuitBreakers) For(target string) *CircuitBreaker {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	breaker, ok := c.breakers[target]
+	if !ok {
+		breaker = &CircuitBreaker{name: c.name, target: target, config: c.config, clock: c.clock}
+		breaker.setState(CircuitBreakerClosed)
+		c.breakers[target] = breaker
+	}
+
+	return breaker
+}
+
+// CircuitBreaker prevents requests to a target after consecutive failures. Once opened, it rejects all requests for a
+// configured duration. Afterwards, it allows a single trial request and closes again if it succeeds.
+type CircuitBreaker struct {
+	name   string
+	target string
+	config CircuitBreakerConfig
+	clock  clock.PassiveClock
+
+	lock          sync.Mutex
+	state         CircuitBreakerState
+	failures      int
+	openedAt      time.Time
+	trialInFlight bool
+}
+
+// State returns the current state of the circuit breaker.
+func (b *CircuitBreaker) State() CircuitBreakerState {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.halfOpenIfExpired()
+	return b.state
+}
+
+// Allow returns ErrCircuitBreakerOpen if a request to the target must not be sent. Otherwise, the result of the
+// request must be recorded via Record.
+func (b *CircuitBreaker) Allow() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.halfOpenIfExpired()
+
+	switch b.state {
+	case CircuitBreakerOpen:
+		return ErrCircuitBreakerOpen
+	case CircuitBreakerHalfOpen:
+		if b.trialInFlight {
+			return ErrCircuitBreakerOpen
+		}
+		b.trialInFlight = true
+	}
+
+	return nil
+}
+
+// Record records the result of a request to the target.
+func (b *CircuitBreaker) Record(err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.trialInFlight = false
+
+	if err == nil {
+		b.failures = 0
+		b.setState(CircuitBreakerClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitBreakerHalfOpen || b.failures >= b.config.FailureThreshold {
+		b.openedAt = b.clock.Now()
+		b.setState(CircuitBreakerOpen)
+	}
+}
+
+// Guard returns a Func which only executes the given Func if the circuit breaker allows it and records its result.
+// Rejected executions are reported as minor errors so that they are retried after the next interval.
+func (b *CircuitBreaker) Guard(f Func) Func {
+	return func(ctx context.Context) (bool, error) {
+		if err := b.Allow(); err != nil {
+			return MinorError(fmt.Errorf("request to %q rejected: %w", b.target, err))
+		}
+
+		done, err := f(ctx)
+		b.Record(err)
+		return done, err
+	}
+}
+
+func (b *CircuitBreaker) halfOpenIfExpired() {
+	if b.state == CircuitBreakerOpen && !b.clock.Now().Before(b.openedAt.Add(b.config.OpenDuration)) {
+		b.setState(CircuitBreakerHalfOpen)
+	}
+}
+
+func (b *CircuitBreaker) setState(state CircuitBreakerState) {
+	b.state = state
+	circuitBreakerState.WithLabelValues(b.name, b.target).Set(float64(state))
+}
+
+// RetryBudget limits the number of retries in a sliding time window. It is supposed to be shared by all workers of a
+// controller, so that a misbehaving target does not cause retry storms.
+type RetryBudget struct {
+	name       string
+	maxRetries int
+	window     time.Duration
+	clock      clock.PassiveClock
+
+	lock    sync.Mutex
+	retries []time.Time
+}
+
+// NewRetryBudget returns a new RetryBudget with the given name which allows the given number of retries in the given
+// time window. The name is used to identify the retry budget in the metrics.
+func NewRetryBudget(name string, maxRetries int, window time.Duration, clock clock.PassiveClock) *RetryBudget {
+	return &RetryBudget{
+		name:       name,
+		maxRetries: maxRetries,
+		window:     window,
+		clock:      clock,
+	}
+}
+
+// Acquire consumes a retry from the budget. It returns ErrRetryBudgetExhausted if the budget is exhausted.
+func (b *RetryBudget) Acquire() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.clock.Now()
+	b.prune(now)
+
+	if len(b.retries) >= b.maxRetries {
+		retryBudgetExhausted.WithLabelValues(b.name).Inc()
+		return ErrRetryBudgetExhausted
+	}
+
+	b.retries = append(b.retries, now)
+	return nil
+}
+
+// Remaining returns the number of retries which are left in the current time window.
+func (b *RetryBudget) Remaining() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.prune(b.clock.Now())
+	return b.maxRetries - len(b.retries)
+}
+
+// Guard returns a Func which consumes a retry from the budget for every execution of the given Func but the first
+// one. If the budget is exhausted, it gives up with a severe error wrapping ErrRetryBudgetExhausted and the last error.
+// The returned Func must only be used for a single retry operation.
+func (b *RetryBudget) Guard(f Func) Func {
+	var (
+		attempts  int
+		lastError error
+	)
+
+	return func(ctx context.Context) (bool, error) {
+		if attempts > 0 {
+			if err := b.Acquire(); err != nil {
+				if lastError != nil {
+					return SevereError(fmt.Errorf("%w, last error: %w", err, lastError))
+				}
+				return SevereError(err)
+			}
+		}
+		attempts++
+
+		done, err := f(ctx)
+		lastError = err
+		return done, err
+	}
+}
+
+func (b *RetryBudget) prune(now time.Time) {
+	i := 0
+	for i < len(b.retries) && !b.retries[i].After(now.Add(-b.window)) {
+		i++
+	}
+	b.retries = b.retries[i:]
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var _ = Describe("Breaker", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock *testclock.FakeClock
+		fakeErr   = errors.New("fake")
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+	})
+
+	Describe("CircuitBreakerState", func() {
+		It("should return the string representation", func() {
+			Expect(CircuitBreakerClosed.String()).To(Equal("closed"))
+			Expect(CircuitBreakerHalfOpen.String()).To(Equal("half-open"))
+			Expect(CircuitBreakerOpen.String()).To(Equal("open"))
+		})
+	})
+
+	Describe("CircuitBreaker", func() {
+		var (
+			breakers *CircuitBreakers
+			breaker  *CircuitBreaker
+		)
+
+		BeforeEach(func() {
+			breakers = NewCircuitBreakers("test", CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Minute}, fakeClock)
+			breaker = breakers.For("foo")
+		})
+
+		It("should return the same circuit breaker for the same target", func() {
+			Expect(breakers.For("foo")).To(BeIdenticalTo(breaker))
+			Expect(breakers.For("bar")).NotTo(BeIdenticalTo(breaker))
+		})
+
+		It("should open after consecutive failures", func() {
+			Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+
+			Expect(breaker.Allow()).To(Succeed())
+			breaker.Record(fakeErr)
+			Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+
+			Expect(breaker.Allow()).To(Succeed())
+			breaker.Record(fakeErr)
+			Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+			Expect(breaker.Allow()).To(MatchError(ErrCircuitBreakerOpen))
+		})
+
+		It("should not open if failures are not consecutive", func() {
+			breaker.Record(fakeErr)
+			breaker.Record(nil)
+			breaker.Record(fakeErr)
+			Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+		})
+
+		It("should not affect other targets", func() {
+			breaker.Record(fakeErr)
+			breaker.Record(fakeErr)
+			Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+			Expect(breakers.For("bar").Allow()).To(Succeed())
+		})
+
+		It("should allow a single trial request after the open duration and close if it succeeds", func() {
+			breaker.Record(fakeErr)
+			breaker.Record(fakeErr)
+
+			fakeClock.Step(time.Minute)
+			Expect(breaker.State()).To(Equal(CircuitBreakerHalfOpen))
+			Expect(breaker.Allow()).To(Succeed())
+			Expect(breaker.Allow()).To(MatchError(ErrCircuitBreakerOpen))
+
+			breaker.Record(nil)
+			Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+			Expect(breaker.Allow()).To(Succeed())
+		})
+
+		It("should open again if the trial request fails", func() {
+			breaker.Record(fakeErr)
+			breaker.Record(fakeErr)
+
+			fakeClock.Step(time.Minute)
+			Expect(breaker.Allow()).To(Succeed())
+			breaker.Record(fakeErr)
+			Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+
+			fakeClock.Step(time.Minute - time.Second)
+			Expect(breaker.Allow()).To(MatchError(ErrCircuitBreakerOpen))
+		})
+
+		Describe("#Guard", func() {
+			It("should record the result of the func", func() {
+				f := breaker.Guard(func(_ context.Context) (bool, error) { return MinorError(fakeErr) })
+
+				done, err := f(ctx)
+				Expect(done).To(BeFalse())
+				Expect(err).To(BeIdenticalTo(fakeErr))
+				_, _ = f(ctx)
+				Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+			})
+
+			It("should not execute the func if the circuit breaker is open", func() {
+				breaker.Record(fakeErr)
+				breaker.Record(fakeErr)
+
+				var called bool
+				done, err := breaker.Guard(func(_ context.Context) (bool, error) {
+					called = true
+					return Ok()
+				})(ctx)
+				Expect(called).To(BeFalse())
+				Expect(done).To(BeFalse())
+				Expect(err).To(MatchError(ErrCircuitBreakerOpen))
+				Expect(err).To(MatchError(ContainSubstring(`request to "foo" rejected`)))
+			})
+		})
+	})
+
+	Describe("RetryBudget", func() {
+		var budget *RetryBudget
+
+		BeforeEach(func() {
+			budget = NewRetryBudget("test", 2, time.Minute, fakeClock)
+		})
+
+		It("should reject retries if the budget is exhausted", func() {
+			Expect(budget.Remaining()).To(Equal(2))
+			Expect(budget.Acquire()).To(Succeed())
+			Expect(budget.Acquire()).To(Succeed())
+			Expect(budget.Remaining()).To(Equal(0))
+			Expect(budget.Acquire()).To(MatchError(ErrRetryBudgetExhausted))
+		})
+
+		It("should replenish the budget after the time window", func() {
+			Expect(budget.Acquire()).To(Succeed())
+			fakeClock.Step(30 * time.Second)
+			Expect(budget.Acquire()).To(Succeed())
+
+			fakeClock.Step(30 * time.Second)
+			Expect(budget.Remaining()).To(Equal(1))
+			Expect(budget.Acquire()).To(Succeed())
+			Expect(budget.Acquire()).To(MatchError(ErrRetryBudgetExhausted))
+		})
+
+		Describe("#Guard", func() {
+			It("should not consume the budget for the first attempt", func() {
+				done, err := budget.Guard(func(_ context.Context) (bool, error) { return Ok() })(ctx)
+				Expect(done).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(budget.Remaining()).To(Equal(2))
+			})
+
+			It("should give up severely once the budget is exhausted", func() {
+				var attempts int
+				err := Until(ctx, time.Millisecond, budget.Guard(func(_ context.Context) (bool, error) {
+					attempts++
+					return MinorError(fakeErr)
+				}))
+
+				Expect(attempts).To(Equal(3))
+				Expect(err).To(MatchError(ErrRetryBudgetExhausted))
+				Expect(err).To(MatchError(fakeErr))
+			})
+
+			It("should share the budget between retry operations", func() {
+				Expect(budget.Acquire()).To(Succeed())
+				Expect(budget.Acquire()).To(Succeed())
+
+				var attempts int
+				err := Until(ctx, time.Millisecond, budget.Guard(func(_ context.Context) (bool, error) {
+					attempts++
+					return NotOk()
+				}))
+
+				Expect(attempts).To(Equal(1))
+				Expect(err).To(MatchError(ErrRetryBudgetExhausted))
+			})
+		})
+	})
+})
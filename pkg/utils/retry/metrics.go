@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_retry"
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	circuitBreakerState = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "circuit_breaker_state",
+			Help:      "State of the circuit breaker of a target (0 = closed, 1 = half-open, 2 = open).",
+		},
+		[]string{
+			"name",
+			"target",
+		},
+	)
+
+	retryBudgetExhausted = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "budget_exhausted_total",
+			Help:      "Total number of retries rejected because the retry budget was exhausted.",
+		},
+		[]string{
+			"name",
+		},
+	)
+)
@@ -5,12 +5,14 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"math/big"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -49,7 +51,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// Unless configured otherwise, it contains a 3072-bit RSA private key.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -57,11 +59,17 @@ type CertificateSecretConfig struct {
 	Organization []string
 	DNSNames     []string
 	IPAddresses  []net.IP
+	URIs         []*url.URL
 
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
 
+	// KeyAlgorithm is the algorithm of the private key (defaults to RSA).
+	KeyAlgorithm KeyAlgorithm
+	// KeySize is the size of the private key in bits (defaults to 3072 for RSA and 256 for ECDSA).
+	KeySize int
+
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
@@ -77,7 +85,7 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	PrivateKey    crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -106,13 +114,13 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm, s.KeySize)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
@@ -127,15 +135,9 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			return nil, err
 		}
 
-		var pk []byte
-		if s.PKCS == PKCS1 {
-			pk = utils.EncodePrivateKey(privateKey)
-		} else if s.PKCS == PKCS8 {
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
 		certificateObj.PrivateKey = privateKey
@@ -179,7 +181,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodeSigner(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -200,10 +202,10 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 }
 
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
-// common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
+// common name, organization, SANs (DNS names, IP addresses, and URIs). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -221,16 +223,22 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             now,
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
 			},
 			DNSNames:    s.DNSNames,
 			IPAddresses: s.IPAddresses,
+			URIs:        s.URIs,
 		}
 	)
 
+	// Key encipherment is only applicable for RSA keys, see https://datatracker.ietf.org/doc/html/rfc5480#section-3.
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -248,8 +256,8 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -5,9 +5,17 @@
 package secrets_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -36,6 +44,88 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.PrivateKey).NotTo(BeNil())
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
+				Expect(certificate.PrivateKey).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+				Expect(certificate.PrivateKey.(*rsa.PrivateKey).N.BitLen()).To(Equal(3072))
+			})
+
+			It("should generate certificates with ECDSA keys", func() {
+				certificateConfig.KeyAlgorithm = KeyAlgorithmECDSA
+				certificateConfig.KeySize = 384
+
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.PrivateKey).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+				Expect(ca.PrivateKey.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P384()))
+				Expect(ca.Certificate.KeyUsage & x509.KeyUsageKeyEncipherment).To(BeZero())
+
+				server, err := (&CertificateSecretConfig{
+					Name:         "server",
+					CommonName:   "server",
+					CertType:     ServerCert,
+					SigningCA:    ca,
+					KeyAlgorithm: KeyAlgorithmECDSA,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.PrivateKey.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P256()))
+
+				loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(loadedCA.PrivateKey).To(Equal(ca.PrivateKey))
+
+				serverCertificate, err := utils.DecodeCertificate(server.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+
+				roots := x509.NewCertPool()
+				roots.AddCert(loadedCA.Certificate)
+				_, err = serverCertificate.Verify(x509.VerifyOptions{Roots: roots, CurrentTime: serverCertificate.NotBefore.Add(time.Hour)})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail for unsupported key sizes", func() {
+				certificateConfig.KeyAlgorithm = KeyAlgorithmECDSA
+				certificateConfig.KeySize = 3072
+
+				_, err := certificateConfig.Generate()
+				Expect(err).To(MatchError("unsupported ECDSA key size 3072"))
+			})
+		})
+	})
+
+	Describe("PKIConfig", func() {
+		var pki *PKIConfig
+
+		BeforeEach(func() {
+			pki = &PKIConfig{
+				KeyAlgorithm:        KeyAlgorithmECDSA,
+				KeySize:             384,
+				CAValidity:          ptr.To(time.Hour),
+				CertificateValidity: ptr.To(time.Minute),
+			}
+		})
+
+		Describe("#ApplyTo", func() {
+			It("should apply the defaults to CA certificates", func() {
+				config := &CertificateSecretConfig{CertType: CACert}
+				pki.ApplyTo(config)
+				Expect(config).To(Equal(&CertificateSecretConfig{CertType: CACert, KeyAlgorithm: KeyAlgorithmECDSA, KeySize: 384, Validity: ptr.To(time.Hour)}))
+			})
+
+			It("should apply the defaults to server certificates", func() {
+				config := &CertificateSecretConfig{CertType: ServerCert}
+				pki.ApplyTo(config)
+				Expect(config).To(Equal(&CertificateSecretConfig{CertType: ServerCert, KeyAlgorithm: KeyAlgorithmECDSA, KeySize: 384, Validity: ptr.To(time.Minute)}))
+			})
+
+			It("should not overwrite explicitly configured settings", func() {
+				config := &CertificateSecretConfig{CertType: ClientCert, KeyAlgorithm: KeyAlgorithmRSA, Validity: ptr.To(time.Second)}
+				pki.ApplyTo(config)
+				Expect(config).To(Equal(&CertificateSecretConfig{CertType: ClientCert, KeyAlgorithm: KeyAlgorithmRSA, Validity: ptr.To(time.Second)}))
+			})
+
+			It("should do nothing if the PKIConfig is nil", func() {
+				config := &CertificateSecretConfig{CertType: CACert}
+				(*PKIConfig)(nil).ApplyTo(config)
+				Expect(config).To(Equal(&CertificateSecretConfig{CertType: CACert}))
 			})
 		})
 	})
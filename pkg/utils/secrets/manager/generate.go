@@ -90,9 +90,16 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 }
 
 func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
-	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
-	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
-		certConfig.CommonName = objectMeta.Name
+	if certConfig := certificateSecretConfig(config); certConfig != nil {
+		// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
+		if certConfig.CertType == secretsutils.CACert {
+			certConfig.CommonName = objectMeta.Name
+		}
+
+		// The PKI defaults are applied after the object metadata has been computed, i.e., they do not influence the config
+		// checksum. This way, changing them does not immediately regenerate all certificates (which would also bypass the
+		// phased rotation of CAs) but only takes effect with the next renewal or rotation.
+		m.pki.ApplyTo(certConfig)
 	}
 
 	data, err := config.Generate()
@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"sort"
 	"strconv"
@@ -439,6 +440,22 @@ var _ = Describe("Generate", func() {
 				Expect(cert.Certificate.Subject.CommonName).To(Equal(secret.Name))
 			})
 
+			It("should apply the PKI defaults without considering them for the secret name", func() {
+				DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
+				m.pki = &secretsutils.PKIConfig{KeyAlgorithm: secretsutils.KeyAlgorithmECDSA, CAValidity: ptr.To(time.Hour)}
+
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Name).To(Equal(name + "-54620669"))
+				expectSecretWasCreated(ctx, fakeClient, secret)
+				Expect(secret.Labels).To(HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().Add(time.Hour).Unix(), 10)))
+
+				cert, err := secretsutils.LoadCertificate("", secret.Data["ca.key"], secret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.PrivateKey).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			})
+
 			It("should generate a new CA secret and ignore the config checksum for its name", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName())
@@ -77,6 +77,7 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		pki                         *secretsutils.PKIConfig
 	}
 
 	nameToUnixTime map[string]string
@@ -100,6 +101,10 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// PKI contains defaults for the private keys and validities of certificates. They are only applied when secrets
+		// are newly generated and do not influence the config checksums. Hence, changed settings take effect with the
+		// next renewal or rotation of the respective secrets.
+		PKI *secretsutils.PKIConfig
 	}
 )
 
@@ -134,6 +139,7 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		pki:                         rotation.PKI,
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KeyAlgorithm is a string alias for private key algorithms.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA indicates that RSA private keys should be generated.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSA indicates that ECDSA private keys should be generated.
+	KeyAlgorithmECDSA KeyAlgorithm = "ECDSA"
+
+	// DefaultRSAKeySize is the default size of RSA private keys in bits.
+	DefaultRSAKeySize = 3072
+	// DefaultECDSAKeySize is the default size of ECDSA private keys in bits, i.e., the curve P-256 is used.
+	DefaultECDSAKeySize = 256
+)
+
+// PKIConfig contains defaults for the private keys and validities of certificates. They are applied to
+// CertificateSecretConfigs which do not specify the respective settings themselves.
+type PKIConfig struct {
+	// KeyAlgorithm is the algorithm of the private keys.
+	KeyAlgorithm KeyAlgorithm
+	// KeySize is the size of the private keys in bits.
+	KeySize int
+	// CAValidity is the validity of CA certificates.
+	CAValidity *time.Duration
+	// CertificateValidity is the validity of server and client certificates.
+	CertificateValidity *time.Duration
+}
+
+// ApplyTo applies the defaults of the PKIConfig to the given CertificateSecretConfig.
+func (p *PKIConfig) ApplyTo(config *CertificateSecretConfig) {
+	if p == nil || config == nil {
+		return
+	}
+
+	if config.KeyAlgorithm == "" && config.KeySize == 0 {
+		config.KeyAlgorithm = p.KeyAlgorithm
+		config.KeySize = p.KeySize
+	}
+
+	if config.Validity == nil {
+		switch config.CertType {
+		case "":
+		case CACert:
+			config.Validity = p.CAValidity
+		default:
+			config.Validity = p.CertificateValidity
+		}
+	}
+}
+
+func generatePrivateKey(algorithm KeyAlgorithm, size int) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA:
+		if size == 0 {
+			size = DefaultRSAKeySize
+		}
+		return GenerateKey(rand.Reader, size)
+
+	case KeyAlgorithmECDSA:
+		var curve elliptic.Curve
+		switch size {
+		case 0, 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported ECDSA key size %d", size)
+		}
+		return ecdsa.GenerateKey(curve, rand.Reader)
+	}
+
+	return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		switch pkcs {
+		case PKCS1:
+			return utils.EncodePrivateKey(key), nil
+		case PKCS8:
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+
+	case *ecdsa.PrivateKey:
+		switch pkcs {
+		case PKCS1:
+			// There is no PKCS1 format for ECDSA keys, hence the SEC1 format is used instead.
+			return utils.EncodeECDSAPrivateKey(key)
+		case PKCS8:
+			bytes, err := x509.MarshalPKCS8PrivateKey(key)
+			if err != nil {
+				return nil, err
+			}
+			return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: bytes}), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+
+	return nil, nil
+}
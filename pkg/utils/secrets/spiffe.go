@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+// SPIFFEScheme is the URI scheme of SPIFFE IDs.
+const SPIFFEScheme = "spiffe"
+
+// SPIFFEID returns the SPIFFE ID for the given service account in the given trust domain, i.e.,
+// `spiffe://<trust-domain>/ns/<namespace>/sa/<service-account-name>`.
+func SPIFFEID(trustDomain, namespace, serviceAccountName string) *url.URL {
+	return &url.URL{
+		Scheme: SPIFFEScheme,
+		Host:   trustDomain,
+		Path:   path.Join("/ns", namespace, "sa", serviceAccountName),
+	}
+}
+
+// SVIDSecretConfig returns the configuration for an X.509 SPIFFE verifiable identity document (SVID) for the given
+// service account in the given trust domain. The certificate can be used for both serving and client authentication,
+// hence, it is suitable for mTLS between components. The SPIFFE ID is the only URI SAN of the certificate.
+func SVIDSecretConfig(name, trustDomain, namespace, serviceAccountName string) *CertificateSecretConfig {
+	return &CertificateSecretConfig{
+		Name:       name,
+		CommonName: serviceAccountName,
+		URIs:       []*url.URL{SPIFFEID(trustDomain, namespace, serviceAccountName)},
+		CertType:   ServerClientCert,
+	}
+}
+
+// SPIFFEIDFromCertificate returns the SPIFFE ID of the given X.509 SVID. According to the SPIFFE specification, an
+// X.509 SVID must contain exactly one URI SAN which is the SPIFFE ID.
+func SPIFFEIDFromCertificate(certificate *x509.Certificate) (*url.URL, error) {
+	if certificate == nil {
+		return nil, errors.New("certificate is nil")
+	}
+
+	if len(certificate.URIs) != 1 {
+		return nil, fmt.Errorf("certificate must contain exactly one URI SAN, found %d", len(certificate.URIs))
+	}
+
+	id := certificate.URIs[0]
+	if id.Scheme != SPIFFEScheme || id.Host == "" || id.User != nil || id.RawQuery != "" || id.Fragment != "" {
+		return nil, fmt.Errorf("URI SAN %q is not a valid SPIFFE ID", id.String())
+	}
+
+	return id, nil
+}
+
+// AuthorizeSPIFFEIDs returns a function which can be used as `VerifyPeerCertificate` in a `tls.Config`. It only
+// accepts peers whose verified certificate contains exactly one of the given SPIFFE IDs. The IDs are compared
+// case-sensitively as the SPIFFE specification does not define any normalization. Note that the function does not
+// verify the certificate chain itself, i.e., the `tls.Config` must still be configured with the CA which issued the
+// SVIDs (`RootCAs` for clients, `ClientCAs` together with `tls.RequireAndVerifyClientCert` for servers).
+func AuthorizeSPIFFEIDs(allowedIDs ...*url.URL) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	allowed := make(map[string]struct{}, len(allowedIDs))
+	for _, id := range allowedIDs {
+		allowed[id.String()] = struct{}{}
+	}
+
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+			return errors.New("no verified peer certificate")
+		}
+
+		id, err := SPIFFEIDFromCertificate(verifiedChains[0][0])
+		if err != nil {
+			return err
+		}
+
+		if _, ok := allowed[id.String()]; !ok {
+			return fmt.Errorf("SPIFFE ID %q is not authorized", id.String())
+		}
+
+		return nil
+	}
+}
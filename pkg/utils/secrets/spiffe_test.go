@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"crypto/x509"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("SPIFFE", func() {
+	Describe("#SPIFFEID", func() {
+		It("should compute the SPIFFE ID of the service account", func() {
+			Expect(SPIFFEID("shoot--foo--bar", "kube-system", "kube-apiserver").String()).To(Equal("spiffe://shoot--foo--bar/ns/kube-system/sa/kube-apiserver"))
+		})
+	})
+
+	Describe("#SVIDSecretConfig", func() {
+		It("should generate a certificate with the SPIFFE ID", func() {
+			ca, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config := SVIDSecretConfig("svid", "shoot--foo--bar", "kube-system", "kube-apiserver")
+			config.SigningCA = ca
+
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.Certificate.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth))
+
+			id, err := SPIFFEIDFromCertificate(certificate.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id.String()).To(Equal("spiffe://shoot--foo--bar/ns/kube-system/sa/kube-apiserver"))
+		})
+	})
+
+	Describe("#SPIFFEIDFromCertificate", func() {
+		It("should fail if the certificate is nil", func() {
+			_, err := SPIFFEIDFromCertificate(nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the certificate has no URI SAN", func() {
+			_, err := SPIFFEIDFromCertificate(&x509.Certificate{})
+			Expect(err).To(MatchError(ContainSubstring("exactly one URI SAN")))
+		})
+
+		It("should fail if the certificate has multiple URI SANs", func() {
+			_, err := SPIFFEIDFromCertificate(&x509.Certificate{URIs: []*url.URL{
+				SPIFFEID("foo", "bar", "baz"),
+				SPIFFEID("foo", "bar", "qux"),
+			}})
+			Expect(err).To(MatchError(ContainSubstring("exactly one URI SAN")))
+		})
+
+		It("should fail if the URI SAN is no SPIFFE ID", func() {
+			_, err := SPIFFEIDFromCertificate(&x509.Certificate{URIs: []*url.URL{{Scheme: "https", Host: "example.com"}}})
+			Expect(err).To(MatchError(ContainSubstring("not a valid SPIFFE ID")))
+		})
+	})
+
+	Describe("#AuthorizeSPIFFEIDs", func() {
+		var verify func([][]byte, [][]*x509.Certificate) error
+
+		BeforeEach(func() {
+			verify = AuthorizeSPIFFEIDs(SPIFFEID("shoot--foo--bar", "kube-system", "kube-apiserver"))
+		})
+
+		It("should accept allowed SPIFFE IDs", func() {
+			Expect(verify(nil, [][]*x509.Certificate{{{URIs: []*url.URL{SPIFFEID("shoot--foo--bar", "kube-system", "kube-apiserver")}}}})).To(Succeed())
+		})
+
+		It("should reject other SPIFFE IDs", func() {
+			Expect(verify(nil, [][]*x509.Certificate{{{URIs: []*url.URL{SPIFFEID("shoot--foo--bar", "kube-system", "other")}}}})).To(MatchError(ContainSubstring("is not authorized")))
+		})
+
+		It("should compare the SPIFFE IDs exactly", func() {
+			Expect(verify(nil, [][]*x509.Certificate{{{URIs: []*url.URL{SPIFFEID("shoot--foo--bar", "kube-system", "Kube-APIServer")}}}})).To(MatchError(ContainSubstring("is not authorized")))
+			Expect(verify(nil, [][]*x509.Certificate{{{URIs: []*url.URL{SPIFFEID("SHOOT--foo--bar", "kube-system", "kube-apiserver")}}}})).To(MatchError(ContainSubstring("is not authorized")))
+		})
+
+		It("should reject peers without verified certificates", func() {
+			Expect(verify(nil, nil)).To(MatchError("no verified peer certificate"))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// ServerSideApplyFuncs returns interceptor functions for the controller-runtime fake client which emulate server-side
+// apply patches, as the fake client does not support them. The applied object is created or replaces the existing
+// object, i.e., field ownership is not tracked. The given function is called with the applied object and the options
+// of each apply patch.
+func ServerSideApplyFuncs(onApply func(obj client.Object, opts *client.PatchOptions)) interceptor.Funcs {
+	return interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+
+			patchOptions := &client.PatchOptions{}
+			patchOptions.ApplyOptions(opts)
+			if onApply != nil {
+				onApply(obj, patchOptions)
+			}
+
+			// Typed clients drop the type meta of the objects returned by the API server.
+			obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+
+			if err := c.Create(ctx, obj); !apierrors.IsAlreadyExists(err) {
+				return err
+			}
+
+			existing := obj.DeepCopyObject().(client.Object)
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+				return err
+			}
+			obj.SetResourceVersion(existing.GetResourceVersion())
+			return c.Update(ctx, obj)
+		},
+	}
+}
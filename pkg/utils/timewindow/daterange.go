@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package timewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateLayout is the layout of dates in date ranges.
+const DateLayout = "2006-01-02"
+
+// DateRange is a range of calendar dates. Both the first and the last date are contained in the range.
+type DateRange struct {
+	first time.Time
+	last  time.Time
+}
+
+// ParseDateRange parses the given first and last date of a date range. If last is empty, the range only contains the
+// first date.
+func ParseDateRange(first, last string) (*DateRange, error) {
+	firstDate, err := time.Parse(DateLayout, first)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse date %q: %w", first, err)
+	}
+
+	lastDate := firstDate
+	if last != "" {
+		lastDate, err = time.Parse(DateLayout, last)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse date %q: %w", last, err)
+		}
+	}
+
+	if lastDate.Before(firstDate) {
+		return nil, fmt.Errorf("last date %s must not be before first date %s", last, first)
+	}
+
+	return &DateRange{first: firstDate, last: lastDate}, nil
+}
+
+// ParseDateRangeString parses a date range which is either a single date (`2006-01-02`) or a first and last date
+// separated by a slash (`2006-01-02/2006-01-06`).
+func ParseDateRangeString(value string) (*DateRange, error) {
+	first, last, _ := strings.Cut(strings.TrimSpace(value), "/")
+	return ParseDateRange(first, last)
+}
+
+// String returns the string representation of the date range.
+func (d *DateRange) String() string {
+	if d.first.Equal(d.last) {
+		return d.first.Format(DateLayout)
+	}
+	return d.first.Format(DateLayout) + "/" + d.last.Format(DateLayout)
+}
+
+// Contains returns true if the calendar date of the given time (in its location) is contained in the date range.
+func (d *DateRange) Contains(t time.Time) bool {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return !date.Before(d.first) && !date.After(d.last)
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package timewindow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+var _ = Describe("DateRange", func() {
+	DescribeTable("#ParseDateRangeString",
+		func(value, expected string, matcher OmegaMatcher) {
+			dateRange, err := ParseDateRangeString(value)
+			Expect(err).To(matcher)
+			if err == nil {
+				Expect(dateRange.String()).To(Equal(expected))
+			}
+		},
+
+		Entry("single date", "2024-12-24", "2024-12-24", Not(HaveOccurred())),
+		Entry("date range", "2024-12-24/2024-12-31", "2024-12-24/2024-12-31", Not(HaveOccurred())),
+		Entry("date range with same dates", " 2024-12-24/2024-12-24 ", "2024-12-24", Not(HaveOccurred())),
+		Entry("invalid first date", "2024-13-24", "", HaveOccurred()),
+		Entry("invalid last date", "2024-12-24/foo", "", HaveOccurred()),
+		Entry("last date before first date", "2024-12-24/2024-12-23", "", HaveOccurred()),
+	)
+
+	Describe("#Contains", func() {
+		var dateRange *DateRange
+
+		BeforeEach(func() {
+			var err error
+			dateRange, err = ParseDateRange("2024-12-24", "2024-12-26")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should contain the dates of the range", func() {
+			Expect(dateRange.Contains(time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(dateRange.Contains(time.Date(2024, 12, 25, 12, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(dateRange.Contains(time.Date(2024, 12, 26, 23, 59, 59, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should not contain dates outside of the range", func() {
+			Expect(dateRange.Contains(time.Date(2024, 12, 23, 23, 59, 59, 0, time.UTC))).To(BeFalse())
+			Expect(dateRange.Contains(time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+		})
+
+		It("should evaluate the date in the location of the given time", func() {
+			location, err := time.LoadLocation("Asia/Tokyo")
+			Expect(err).NotTo(HaveOccurred())
+
+			// 2024-12-23T20:00:00Z is already 2024-12-24 in Tokyo
+			t := time.Date(2024, 12, 23, 20, 0, 0, 0, time.UTC)
+			Expect(dateRange.Contains(t)).To(BeFalse())
+			Expect(dateRange.Contains(t.In(location))).To(BeTrue())
+		})
+	})
+})
@@ -222,6 +222,28 @@ func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeg
 	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
 }
 
+// Next returns the begin and end of the next occurrence of the time window after the given time. If the given time is
+// contained in the time window, the current occurrence is returned.
+func (m *MaintenanceTimeWindow) Next(from time.Time) (time.Time, time.Time) {
+	from = from.UTC()
+
+	var (
+		begin = m.AdjustedBegin(from)
+		end   = m.AdjustedEnd(from)
+	)
+
+	// the time window began on the previous day and has not ended yet
+	if m.Contains(from) && from.Before(begin) {
+		return begin.AddDate(0, 0, -1), end.AddDate(0, 0, -1)
+	}
+
+	if from.After(end) {
+		return begin.AddDate(0, 0, 1), end.AddDate(0, 0, 1)
+	}
+
+	return begin, end
+}
+
 // Duration returns the duration of the maintenance time window.
 func (m *MaintenanceTimeWindow) Duration() time.Duration {
 	var (
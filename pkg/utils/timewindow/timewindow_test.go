@@ -274,6 +274,22 @@ var _ = Describe("utils", func() {
 			Entry("(23-0), shift begin if contained, does not contain now (after)", from23to0, true, newTime(0, 59, 1, 0), 23*time.Hour+59*time.Second),
 		)
 
+		DescribeTable("#Next",
+			func(maintenanceTimeWindow *MaintenanceTimeWindow, now time.Time, expectedBegin, expectedEnd time.Time) {
+				begin, end := maintenanceTimeWindow.Next(now)
+				Expect(begin).To(Equal(expectedBegin))
+				Expect(end).To(Equal(expectedEnd))
+			},
+
+			Entry("(16-19), does contain now", from16to19, newTime(17, 0, 0, 0), newTime(16, 0, 0, 0), newTime(19, 0, 0, 0)),
+			Entry("(16-19), does not contain now (before)", from16to19, newTime(15, 0, 0, 0), newTime(16, 0, 0, 0), newTime(19, 0, 0, 0)),
+			Entry("(16-19), does not contain now (after)", from16to19, newTime(20, 0, 0, 0), newTime(16, 0, 0, 0).AddDate(0, 0, 1), newTime(19, 0, 0, 0).AddDate(0, 0, 1)),
+			Entry("(23-1), does contain now (before midnight)", from23to1, newTime(23, 30, 0, 0), newTime(23, 0, 0, 0), newTime(1, 0, 0, 0).AddDate(0, 0, 1)),
+			Entry("(23-1), does contain now (after midnight)", from23to1, newTime(0, 30, 0, 0).AddDate(0, 0, 1), newTime(23, 0, 0, 0), newTime(1, 0, 0, 0).AddDate(0, 0, 1)),
+			Entry("(23-1), does not contain now", from23to1, newTime(2, 0, 0, 0), newTime(23, 0, 0, 0), newTime(1, 0, 0, 0).AddDate(0, 0, 1)),
+			Entry("(23-0), does not contain now", from23to0, newTime(0, 59, 1, 0), newTime(23, 0, 0, 0), newTime(0, 0, 0, 0).AddDate(0, 0, 1)),
+		)
+
 		DescribeTable("#Duration",
 			func(maintenanceTimeWindow *MaintenanceTimeWindow, expected time.Duration) {
 				Expect(maintenanceTimeWindow.Duration()).To(Equal(expected))
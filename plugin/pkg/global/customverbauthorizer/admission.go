@@ -20,7 +20,10 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/helper"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/features"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
@@ -31,6 +34,27 @@ const (
 	// CustomVerbProjectManageMembers is a constant for the custom verb that allows to manage human users or
 	// groups subjects in the `.spec.members` field in `Project` resources.
 	CustomVerbProjectManageMembers = "manage-members"
+	// CustomVerbShootForceDelete is a constant for the custom verb that allows to annotate `Shoot` resources with
+	// the `confirmation.gardener.cloud/force-deletion` annotation.
+	CustomVerbShootForceDelete = "force-delete"
+	// CustomVerbShootRotateCredentials is a constant for the custom verb that allows to trigger credentials rotation
+	// operations (e.g., `rotate-credentials-start` or `rotate-ca-complete`) for `Shoot` resources.
+	CustomVerbShootRotateCredentials = "rotate-credentials"
+)
+
+// credentialsRotationOperations are the shoot operations which are guarded by the `rotate-credentials` custom verb.
+var credentialsRotationOperations = sets.New(
+	v1beta1constants.OperationRotateCredentialsStart,
+	v1beta1constants.OperationRotateCredentialsComplete,
+	v1beta1constants.OperationRotateCAStart,
+	v1beta1constants.OperationRotateCAComplete,
+	v1beta1constants.OperationRotateServiceAccountKeyStart,
+	v1beta1constants.OperationRotateServiceAccountKeyComplete,
+	v1beta1constants.OperationRotateETCDEncryptionKeyStart,
+	v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
+	v1beta1constants.OperationRotateObservabilityCredentials,
+	v1beta1constants.ShootOperationRotateKubeconfigCredentials,
+	v1beta1constants.ShootOperationRotateSSHKeypair,
 )
 
 // Register registers a plugin.
@@ -75,6 +99,10 @@ func (c *CustomVerbAuthorizer) Validate(ctx context.Context, a admission.Attribu
 	switch a.GetKind().GroupKind() {
 	case core.Kind("Project"):
 		return c.admitProjects(ctx, a)
+	case core.Kind("Shoot"):
+		if a.GetSubresource() == "" && features.DefaultFeatureGate.Enabled(features.ShootOperationAuthorization) {
+			return c.admitShoots(ctx, a)
+		}
 	}
 
 	return nil
@@ -110,6 +138,42 @@ func (c *CustomVerbAuthorizer) admitProjects(ctx context.Context, a admission.At
 	return nil
 }
 
+func (c *CustomVerbAuthorizer) admitShoots(ctx context.Context, a admission.Attributes) error {
+	var (
+		oldObj = &core.Shoot{}
+		obj    *core.Shoot
+		ok     bool
+	)
+
+	obj, ok = a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if a.GetOperation() == admission.Update {
+		oldObj, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	if helper.ShootNeedsForceDeletion(obj) && !helper.ShootNeedsForceDeletion(oldObj) {
+		if err := c.authorize(ctx, a, CustomVerbShootForceDelete, "annotate with "+v1beta1constants.AnnotationConfirmationForceDeletion); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{v1beta1constants.GardenerOperation, v1beta1constants.GardenerMaintenanceOperation} {
+		if mustCheckShootCredentialsRotation(oldObj.Annotations[key], obj.Annotations[key]) {
+			if err := c.authorize(ctx, a, CustomVerbShootRotateCredentials, fmt.Sprintf("trigger operation %q", obj.Annotations[key])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *CustomVerbAuthorizer) authorize(ctx context.Context, a admission.Attributes, verb, operation string) error {
 	var (
 		userInfo  = a.GetUserInfo()
@@ -171,6 +235,10 @@ func mustCheckProjectMembers(oldMembers, members []core.ProjectMember, owner *rb
 	return !oldHumanUsers.Equal(newHumanUsers)
 }
 
+func mustCheckShootCredentialsRotation(oldOperation, operation string) bool {
+	return operation != oldOperation && credentialsRotationOperations.Has(operation)
+}
+
 func findHumanUsers(members []core.ProjectMember) sets.Set[string] {
 	result := sets.New[string]()
 
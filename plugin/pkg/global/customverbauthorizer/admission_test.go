@@ -18,6 +18,8 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/plugin/pkg/global/customverbauthorizer"
 	mockauthorizer "github.com/gardener/gardener/third_party/mock/apiserver/authorization/authorizer"
 )
@@ -347,6 +349,140 @@ var _ = Describe("customverbauthorizer", func() {
 					})
 				})
 			})
+
+			Context("Shoots", func() {
+				var shoot *core.Shoot
+
+				BeforeEach(func() {
+					shoot = &core.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "dummy",
+							Namespace: "garden-dummy",
+						},
+					}
+
+					authorizeAttributes.Resource = "shoots"
+					authorizeAttributes.Namespace = shoot.Namespace
+					authorizeAttributes.Name = shoot.Name
+				})
+
+				newShootAttributes := func(oldShoot *core.Shoot, subresource string) admission.Attributes {
+					if oldShoot == nil {
+						return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), subresource, admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					}
+					return admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), subresource, admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				}
+
+				It("should not check any custom verb when the feature gate is disabled", func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootOperationAuthorization, false))
+
+					oldShoot := shoot.DeepCopy()
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-credentials-start")
+
+					Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(Succeed())
+				})
+
+				Context("feature gate enabled", func() {
+					BeforeEach(func() {
+						DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.ShootOperationAuthorization, true))
+					})
+
+					It("should always allow updates not triggering sensitive operations", func() {
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "reconcile")
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "false")
+
+						Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(Succeed())
+					})
+
+					It("should always allow updates of subresources", func() {
+						oldShoot := shoot.DeepCopy()
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-credentials-start")
+
+						Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, "status"), nil)).To(Succeed())
+					})
+
+					It("should always allow updates if the sensitive annotations were already present", func() {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-ca-complete")
+						oldShoot := shoot.DeepCopy()
+						shoot.Labels = map[string]string{"foo": "bar"}
+
+						Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(Succeed())
+					})
+
+					Context("force-delete verb", func() {
+						BeforeEach(func() {
+							authorizeAttributes.Verb = CustomVerbShootForceDelete
+						})
+
+						It("should allow annotating the shoot for force-deletion if permissions are granted", func() {
+							auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+							oldShoot := shoot.DeepCopy()
+							metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+
+							Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(Succeed())
+						})
+
+						It("should forbid annotating the shoot for force-deletion if permissions are not granted", func() {
+							auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+							oldShoot := shoot.DeepCopy()
+							metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+
+							Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(MatchError(ContainSubstring(`user "foo" is not allowed to annotate with confirmation.gardener.cloud/force-deletion for "shoots"`)))
+						})
+
+						It("should forbid creating a shoot annotated for force-deletion if permissions are not granted", func() {
+							auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+							metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+
+							Expect(admissionHandler.Validate(ctx, newShootAttributes(nil, ""), nil)).NotTo(Succeed())
+						})
+					})
+
+					Context("rotate-credentials verb", func() {
+						BeforeEach(func() {
+							authorizeAttributes.Verb = CustomVerbShootRotateCredentials
+						})
+
+						DescribeTable("should allow triggering credentials rotation operations if permissions are granted",
+							func(annotation, operation string) {
+								auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+								oldShoot := shoot.DeepCopy()
+								metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, annotation, operation)
+
+								Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(Succeed())
+							},
+
+							Entry("rotate-credentials-start", "gardener.cloud/operation", "rotate-credentials-start"),
+							Entry("rotate-credentials-complete", "gardener.cloud/operation", "rotate-credentials-complete"),
+							Entry("rotate-ssh-keypair", "gardener.cloud/operation", "rotate-ssh-keypair"),
+							Entry("rotate-ca-start in maintenance", "maintenance.gardener.cloud/operation", "rotate-ca-start"),
+						)
+
+						DescribeTable("should forbid triggering credentials rotation operations if permissions are not granted",
+							func(annotation, operation string) {
+								auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+								oldShoot := shoot.DeepCopy()
+								metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, annotation, operation)
+
+								Expect(admissionHandler.Validate(ctx, newShootAttributes(oldShoot, ""), nil)).To(MatchError(ContainSubstring(`user "foo" is not allowed to trigger operation %q for "shoots"`, operation)))
+							},
+
+							Entry("rotate-etcd-encryption-key-start", "gardener.cloud/operation", "rotate-etcd-encryption-key-start"),
+							Entry("rotate-serviceaccount-key-complete", "gardener.cloud/operation", "rotate-serviceaccount-key-complete"),
+							Entry("rotate-kubeconfig-credentials", "gardener.cloud/operation", "rotate-kubeconfig-credentials"),
+							Entry("rotate-credentials-start in maintenance", "maintenance.gardener.cloud/operation", "rotate-credentials-start"),
+						)
+					})
+				})
+			})
 		})
 	})
 
@@ -83,6 +83,7 @@ type ReferenceManager struct {
 	quotaLister                  gardencorev1beta1listers.QuotaLister
 	controllerDeploymentLister   gardencorev1beta1listers.ControllerDeploymentLister
 	exposureClassLister          gardencorev1beta1listers.ExposureClassLister
+	clusterAuditPolicyLister     gardencorev1beta1listers.ClusterAuditPolicyLister
 	managedSeedLister            seedmanagementv1alpha1listers.ManagedSeedLister
 	gardenletLister              seedmanagementv1alpha1listers.GardenletLister
 	readyFunc                    admission.ReadyFunc
@@ -156,6 +157,9 @@ func (r *ReferenceManager) SetCoreInformerFactory(f gardencoreinformers.SharedIn
 	exposureClassInformer := f.Core().V1beta1().ExposureClasses()
 	r.exposureClassLister = exposureClassInformer.Lister()
 
+	clusterAuditPolicyInformer := f.Core().V1beta1().ClusterAuditPolicies()
+	r.clusterAuditPolicyLister = clusterAuditPolicyInformer.Lister()
+
 	readyFuncs = append(readyFuncs,
 		seedInformer.Informer().HasSynced,
 		shootInformer.Informer().HasSynced,
@@ -166,7 +170,8 @@ func (r *ReferenceManager) SetCoreInformerFactory(f gardencoreinformers.SharedIn
 		quotaInformer.Informer().HasSynced,
 		projectInformer.Informer().HasSynced,
 		controllerDeploymentInformer.Informer().HasSynced,
-		exposureClassInformer.Informer().HasSynced)
+		exposureClassInformer.Informer().HasSynced,
+		clusterAuditPolicyInformer.Informer().HasSynced)
 }
 
 // SetSeedManagementInformerFactory gets Lister from SharedInformerFactory.
@@ -269,6 +274,9 @@ func (r *ReferenceManager) ValidateInitialization() error {
 	if r.exposureClassLister == nil {
 		return errors.New("missing exposure class lister")
 	}
+	if r.clusterAuditPolicyLister == nil {
+		return errors.New("missing cluster audit policy lister")
+	}
 	if r.gardenCoreClient == nil {
 		return errors.New("missing gardener core client")
 	}
@@ -475,6 +483,20 @@ func (r *ReferenceManager) Admit(ctx context.Context, a admission.Attributes, _
 		}
 		err = r.ensureBackupEntryReferences(oldBackupEntry, backupEntry)
 
+	case core.Kind("Seed"):
+		seed, ok := a.GetObject().(*core.Seed)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert resource into Seed object")
+		}
+		oldSeed := &core.Seed{}
+		if operation == admission.Update {
+			oldSeed, ok = a.GetOldObject().(*core.Seed)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Seed object")
+			}
+		}
+		err = r.ensureSeedReferences(oldSeed, seed)
+
 	case core.Kind("CloudProfile"):
 		cloudProfile, ok := a.GetObject().(*core.CloudProfile)
 		if !ok {
@@ -803,6 +825,12 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	if name := helper.GetShootClusterAuditPolicyName(shoot.Spec.Kubernetes.KubeAPIServer); name != "" && name != helper.GetShootClusterAuditPolicyName(oldShoot.Spec.Kubernetes.KubeAPIServer) {
+		if _, err := r.clusterAuditPolicyLister.Get(name); err != nil {
+			return err
+		}
+	}
+
 	if !equality.Semantic.DeepEqual(oldShoot.Spec.Resources, shoot.Spec.Resources) {
 		for _, resource := range shoot.Spec.Resources {
 			// Get the APIResource for the current resource
@@ -879,6 +907,16 @@ func (r *ReferenceManager) ensureBackupEntryReferences(oldBackupEntry, backupEnt
 	return nil
 }
 
+func (r *ReferenceManager) ensureSeedReferences(oldSeed, seed *core.Seed) error {
+	if name := helper.SeedSettingClusterAuditPolicyName(seed.Spec.Settings); name != "" && name != helper.SeedSettingClusterAuditPolicyName(oldSeed.Spec.Settings) {
+		if _, err := r.clusterAuditPolicyLister.Get(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *ReferenceManager) ensureBackupBucketReferences(ctx context.Context, oldBackupBucket, backupBucket *core.BackupBucket) error {
 	if !equality.Semantic.DeepEqual(oldBackupBucket.Spec.SeedName, backupBucket.Spec.SeedName) {
 		if backupBucket.Spec.SeedName != nil {
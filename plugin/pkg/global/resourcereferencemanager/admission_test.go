@@ -1104,6 +1104,55 @@ var _ = Describe("resourcereferencemanager", func() {
 				})
 			})
 
+			Context("cluster audit policy reference", func() {
+				var clusterAuditPolicyName = "test-clusterauditpolicy"
+
+				BeforeEach(func() {
+					Expect(gardenCoreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(gardenCoreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(gardenSecurityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBindingRefSecret)).To(Succeed())
+
+					coreShoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+						AuditConfig: &core.AuditConfig{
+							AuditPolicy: &core.AuditPolicy{
+								ClusterAuditPolicyName: &clusterAuditPolicyName,
+							},
+						},
+					}
+				})
+
+				It("should reject because the referenced cluster audit policy does not exist", func() {
+					attrs := admission.NewAttributesRecord(&coreShoot, nil, core.Kind("Shoot").WithVersion("version"), coreShoot.Namespace, coreShoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+					Expect(err).To(MatchError(ContainSubstring("not found")))
+				})
+
+				It("should accept because the referenced cluster audit policy exists", func() {
+					clusterAuditPolicy := gardencorev1beta1.ClusterAuditPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: clusterAuditPolicyName,
+						},
+					}
+
+					Expect(gardenCoreInformerFactory.Core().V1beta1().ClusterAuditPolicies().Informer().GetStore().Add(&clusterAuditPolicy)).To(Succeed())
+					user := &user.DefaultInfo{Name: allowedUser}
+					attrs := admission.NewAttributesRecord(&coreShoot, nil, core.Kind("Shoot").WithVersion("version"), coreShoot.Namespace, coreShoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, user)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should accept because the cluster audit policy reference was not changed", func() {
+					oldShoot := coreShoot.DeepCopy()
+					attrs := admission.NewAttributesRecord(&coreShoot, oldShoot, core.Kind("Shoot").WithVersion("version"), coreShoot.Namespace, coreShoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, defaultUserInfo)
+
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
 			It("should reject because the referenced config map does not exist", func() {
 				Expect(gardenCoreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
 				Expect(gardenCoreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
@@ -1489,6 +1538,61 @@ var _ = Describe("resourcereferencemanager", func() {
 			})
 		})
 
+		Context("tests for Seed objects", func() {
+			var (
+				clusterAuditPolicyName = "test-clusterauditpolicy"
+				coreSeed               *core.Seed
+			)
+
+			BeforeEach(func() {
+				coreSeed = &core.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+					Spec: core.SeedSpec{
+						Settings: &core.SeedSettings{
+							AuditPolicy: &core.SeedSettingAuditPolicy{ClusterAuditPolicyName: clusterAuditPolicyName},
+						},
+					},
+				}
+			})
+
+			It("should reject because the referenced cluster audit policy does not exist", func() {
+				attrs := admission.NewAttributesRecord(coreSeed, nil, core.Kind("Seed").WithVersion("version"), "", coreSeed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should accept because the referenced cluster audit policy exists", func() {
+				clusterAuditPolicy := gardencorev1beta1.ClusterAuditPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterAuditPolicyName,
+					},
+				}
+
+				Expect(gardenCoreInformerFactory.Core().V1beta1().ClusterAuditPolicies().Informer().GetStore().Add(&clusterAuditPolicy)).To(Succeed())
+				attrs := admission.NewAttributesRecord(coreSeed, nil, core.Kind("Seed").WithVersion("version"), "", coreSeed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should accept because the cluster audit policy reference was not changed", func() {
+				oldSeed := coreSeed.DeepCopy()
+				attrs := admission.NewAttributesRecord(coreSeed, oldSeed, core.Kind("Seed").WithVersion("version"), "", coreSeed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should accept because no cluster audit policy is referenced", func() {
+				coreSeed.Spec.Settings = nil
+				attrs := admission.NewAttributesRecord(coreSeed, nil, core.Kind("Seed").WithVersion("version"), "", coreSeed.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("tests for Project objects", func() {
 			It("should set the created-by field", func() {
 				Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
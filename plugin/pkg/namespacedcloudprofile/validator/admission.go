@@ -10,8 +10,12 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
+	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -133,6 +137,15 @@ func (v *ValidateNamespacedCloudProfile) Validate(_ context.Context, a admission
 	if err := validationContext.validateMachineTypes(a); err != nil {
 		return err
 	}
+	if err := validationContext.validateVolumeTypes(a); err != nil {
+		return err
+	}
+	if err := validationContext.validateKubernetesVersions(a); err != nil {
+		return err
+	}
+	if err := validationContext.validateMachineImages(a); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -173,3 +186,92 @@ func isMachineTypePresentInNamespacedCloudProfile(machineType gardencore.Machine
 	}
 	return false
 }
+
+func (c *validationContext) validateVolumeTypes(a admission.Attributes) error {
+	for _, volumeType := range c.namespacedCloudProfile.Spec.VolumeTypes {
+		if !slices.ContainsFunc(c.parentCloudProfile.Spec.VolumeTypes, func(t gardencorev1beta1.VolumeType) bool { return t.Name == volumeType.Name }) {
+			continue
+		}
+		// If a volumeType is already present in the NamespacedCloudProfile and just got added to the parent CloudProfile,
+		// it should still be allowed to remain in the NamespacedCloudProfile.
+		if a.GetOperation() == admission.Update && slices.ContainsFunc(c.oldNamespacedCloudProfile.Spec.VolumeTypes, func(t gardencore.VolumeType) bool { return t.Name == volumeType.Name }) {
+			continue
+		}
+		return apierrors.NewBadRequest(fmt.Sprintf("NamespacedCloudProfile attempts to overwrite parent CloudProfile with volumeType: %+v", volumeType))
+	}
+
+	return nil
+}
+
+func (c *validationContext) validateKubernetesVersions(a admission.Attributes) error {
+	if c.namespacedCloudProfile.Spec.Kubernetes == nil {
+		return nil
+	}
+
+	var oldVersions []gardencore.ExpirableVersion
+	if c.oldNamespacedCloudProfile.Spec.Kubernetes != nil {
+		oldVersions = c.oldNamespacedCloudProfile.Spec.Kubernetes.Versions
+	}
+
+	for _, version := range c.namespacedCloudProfile.Spec.Kubernetes.Versions {
+		// Unchanged versions are not validated again, so that changes of the parent CloudProfile do not block updates of the
+		// NamespacedCloudProfile.
+		if a.GetOperation() == admission.Update && slices.ContainsFunc(oldVersions, func(v gardencore.ExpirableVersion) bool { return apiequality.Semantic.DeepEqual(v, version) }) {
+			continue
+		}
+
+		parentIdx := slices.IndexFunc(c.parentCloudProfile.Spec.Kubernetes.Versions, func(v gardencorev1beta1.ExpirableVersion) bool { return v.Version == version.Version })
+		if parentIdx < 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("NamespacedCloudProfile attempts to add Kubernetes version %q which is not offered by the parent CloudProfile, only the expiration dates of the parent's versions can be extended", version.Version))
+		}
+		if err := validateExpirationDateExtension(fmt.Sprintf("Kubernetes version %q", version.Version), version.ExpirationDate, c.parentCloudProfile.Spec.Kubernetes.Versions[parentIdx].ExpirationDate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *validationContext) validateMachineImages(a admission.Attributes) error {
+	for _, machineImage := range c.namespacedCloudProfile.Spec.MachineImages {
+		parentImageIdx := slices.IndexFunc(c.parentCloudProfile.Spec.MachineImages, func(i gardencorev1beta1.MachineImage) bool { return i.Name == machineImage.Name })
+		if parentImageIdx < 0 {
+			continue
+		}
+		parentVersions := c.parentCloudProfile.Spec.MachineImages[parentImageIdx].Versions
+
+		var oldVersions []gardencore.MachineImageVersion
+		if oldImageIdx := slices.IndexFunc(c.oldNamespacedCloudProfile.Spec.MachineImages, func(i gardencore.MachineImage) bool { return i.Name == machineImage.Name }); oldImageIdx >= 0 {
+			oldVersions = c.oldNamespacedCloudProfile.Spec.MachineImages[oldImageIdx].Versions
+		}
+
+		for _, version := range machineImage.Versions {
+			// Unchanged versions are not validated again, so that changes of the parent CloudProfile do not block updates
+			// of the NamespacedCloudProfile.
+			if a.GetOperation() == admission.Update && slices.ContainsFunc(oldVersions, func(v gardencore.MachineImageVersion) bool { return apiequality.Semantic.DeepEqual(v, version) }) {
+				continue
+			}
+
+			// Versions which are not offered by the parent CloudProfile are added to the machine image.
+			parentVersionIdx := slices.IndexFunc(parentVersions, func(v gardencorev1beta1.MachineImageVersion) bool { return v.Version == version.Version })
+			if parentVersionIdx < 0 {
+				continue
+			}
+			if err := validateExpirationDateExtension(fmt.Sprintf("machine image version '%s:%s'", machineImage.Name, version.Version), version.ExpirationDate, parentVersions[parentVersionIdx].ExpirationDate); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateExpirationDateExtension validates that the expiration date defined in the NamespacedCloudProfile does not
+// expire the version earlier than the parent CloudProfile does. An unset expiration date in the NamespacedCloudProfile
+// never expires, versions which do not expire in the parent CloudProfile can be given any expiration date.
+func validateExpirationDateExtension(description string, expirationDate, parentExpirationDate *metav1.Time) error {
+	if parentExpirationDate == nil || expirationDate == nil || !expirationDate.Before(parentExpirationDate) {
+		return nil
+	}
+	return apierrors.NewBadRequest(fmt.Sprintf("NamespacedCloudProfile attempts to shorten the expiration date of %s to %s, it can only be extended beyond the expiration date %s of the parent CloudProfile", description, expirationDate.UTC().Format(time.RFC3339), parentExpirationDate.UTC().Format(time.RFC3339)))
+}
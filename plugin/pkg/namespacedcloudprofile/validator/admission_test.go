@@ -6,6 +6,7 @@ package validator_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -146,6 +147,120 @@ var _ = Describe("Admission", func() {
 
 			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
 		})
+
+		Context("volume types", func() {
+			BeforeEach(func() {
+				parentCloudProfile.Spec.VolumeTypes = []gardencorev1beta1.VolumeType{{Name: "my-volume"}}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&parentCloudProfile)).To(Succeed())
+
+				namespacedCloudProfile.Spec.Parent = namespacedCloudProfileParent
+			})
+
+			It("should allow creating a NamespacedCloudProfile that adds a custom volumeType", func() {
+				namespacedCloudProfile.Spec.VolumeTypes = []gardencore.VolumeType{{Name: "my-other-volume"}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should not allow creating a NamespacedCloudProfile that defines a volumeType of the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.VolumeTypes = []gardencore.VolumeType{{Name: "my-volume"}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("NamespacedCloudProfile attempts to overwrite parent CloudProfile with volumeType")))
+			})
+
+			It("should allow updating a NamespacedCloudProfile whose volumeType was added to the parent CloudProfile later", func() {
+				namespacedCloudProfile.Spec.VolumeTypes = []gardencore.VolumeType{{Name: "my-volume"}}
+				oldNamespacedCloudProfile := *namespacedCloudProfile.DeepCopy()
+				namespacedCloudProfile.Spec.MachineTypes = []gardencore.MachineType{machineTypeCore}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, &oldNamespacedCloudProfile, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
+		Context("expiration dates", func() {
+			var (
+				parentExpirationDate   = metav1.NewTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+				extendedExpirationDate = metav1.NewTime(parentExpirationDate.Add(30 * 24 * time.Hour))
+				earlierExpirationDate  = metav1.NewTime(parentExpirationDate.Add(-24 * time.Hour))
+			)
+
+			BeforeEach(func() {
+				parentCloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{
+					{Version: "1.29.0", ExpirationDate: &parentExpirationDate},
+					{Version: "1.30.0"},
+				}
+				parentCloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{{
+					Name: "my-image",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", ExpirationDate: &parentExpirationDate}},
+					},
+				}}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&parentCloudProfile)).To(Succeed())
+
+				namespacedCloudProfile.Spec.Parent = namespacedCloudProfileParent
+			})
+
+			It("should allow extending the expiration dates of Kubernetes and machine image versions of the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{
+					{Version: "1.29.0", ExpirationDate: &extendedExpirationDate},
+					{Version: "1.30.0", ExpirationDate: &extendedExpirationDate},
+				}}
+				namespacedCloudProfile.Spec.MachineImages = []gardencore.MachineImage{{
+					Name: "my-image",
+					Versions: []gardencore.MachineImageVersion{
+						{ExpirableVersion: gardencore.ExpirableVersion{Version: "1.0.0"}},
+						{ExpirableVersion: gardencore.ExpirableVersion{Version: "1.1.0", ExpirationDate: &earlierExpirationDate}},
+					},
+				}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should not allow adding a Kubernetes version which is not offered by the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{{Version: "1.31.0"}}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring(`NamespacedCloudProfile attempts to add Kubernetes version "1.31.0" which is not offered by the parent CloudProfile`)))
+			})
+
+			It("should not allow shortening the expiration date of a Kubernetes version of the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{{Version: "1.29.0", ExpirationDate: &earlierExpirationDate}}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring(`NamespacedCloudProfile attempts to shorten the expiration date of Kubernetes version "1.29.0" to 2024-05-31T00:00:00Z, it can only be extended beyond the expiration date 2024-06-01T00:00:00Z of the parent CloudProfile`)))
+			})
+
+			It("should not allow shortening the expiration date of a machine image version of the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.MachineImages = []gardencore.MachineImage{{
+					Name:     "my-image",
+					Versions: []gardencore.MachineImageVersion{{ExpirableVersion: gardencore.ExpirableVersion{Version: "1.0.0", ExpirationDate: &earlierExpirationDate}}},
+				}}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring(`NamespacedCloudProfile attempts to shorten the expiration date of machine image version 'my-image:1.0.0'`)))
+			})
+
+			It("should allow updating a NamespacedCloudProfile with unchanged versions which are no longer offered by the parent CloudProfile", func() {
+				namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{{Version: "1.28.0", ExpirationDate: &extendedExpirationDate}}}
+				oldNamespacedCloudProfile := *namespacedCloudProfile.DeepCopy()
+				namespacedCloudProfile.Spec.MachineTypes = []gardencore.MachineType{machineTypeCore}
+
+				attrs := admission.NewAttributesRecord(&namespacedCloudProfile, &oldNamespacedCloudProfile, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			})
+		})
 	})
 
 	Describe("#Register", func() {
@@ -40,10 +40,14 @@ const (
 	PluginNameSeedValidator = "SeedValidator"
 	// PluginNameShootDNS is the name of the ShootDNS admission plugin.
 	PluginNameShootDNS = "ShootDNS"
+	// PluginNameShootDeletionHook is the name of the ShootDeletionHook admission plugin.
+	PluginNameShootDeletionHook = "ShootDeletionHook"
 	// PluginNameShootDNSRewriting is the name of the ShootDNSRewriting admission plugin.
 	PluginNameShootDNSRewriting = "ShootDNSRewriting"
 	// PluginNameShootExposureClass is the name of the ShootExposureClass admission plugin.
 	PluginNameShootExposureClass = "ShootExposureClass"
+	// PluginNameShootIPAM is the name of the ShootIPAM admission plugin.
+	PluginNameShootIPAM = "ShootIPAM"
 	// PluginNameShootManagedSeed is the name of the ShootManagedSeed admission plugin.
 	PluginNameShootManagedSeed = "ShootManagedSeed"
 	// PluginNameShootNodeLocalDNSEnabledByDefault is the name of the ShootNodeLocalDNSEnabledByDefault admission plugin.
@@ -52,6 +56,8 @@ const (
 	PluginNameClusterOpenIDConnectPreset = "ClusterOpenIDConnectPreset"
 	// PluginNameOpenIDConnectPreset is the name of the OpenIDConnectPreset admission plugin.
 	PluginNameOpenIDConnectPreset = "OpenIDConnectPreset"
+	// PluginNameShootProjectDefaults is the name of the ShootProjectDefaults admission plugin.
+	PluginNameShootProjectDefaults = "ShootProjectDefaults"
 	// PluginNameShootQuotaValidator is the name of the ShootQuotaValidator admission plugin.
 	PluginNameShootQuotaValidator = "ShootQuotaValidator"
 	// PluginNameShootTolerationRestriction is the name of the ShootTolerationRestriction admission plugin.
@@ -72,11 +78,13 @@ func AllPluginNames() []string {
 		PluginNameExtensionValidator,                // ExtensionValidator
 		PluginNameExtensionLabels,                   // ExtensionLabels
 		PluginNameShootTolerationRestriction,        // ShootTolerationRestriction
+		PluginNameShootProjectDefaults,              // ShootProjectDefaults
 		PluginNameShootExposureClass,                // ShootExposureClass
 		PluginNameShootDNS,                          // ShootDNS
 		PluginNameShootManagedSeed,                  // ShootManagedSeed
 		PluginNameShootNodeLocalDNSEnabledByDefault, // ShootNodeLocalDNSEnabledByDefault
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
+		PluginNameShootIPAM,                         // ShootIPAM
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootValidator,                    // ShootValidator
 		PluginNameSeedValidator,                     // SeedValidator
@@ -84,6 +92,7 @@ func AllPluginNames() []string {
 		PluginNameNamespacedCloudProfileValidator,   // NamespacedCloudProfileValidator
 		PluginNameProjectValidator,                  // ProjectValidator
 		PluginNameDeletionConfirmation,              // DeletionConfirmation
+		PluginNameShootDeletionHook,                 // ShootDeletionHook
 		PluginNameOpenIDConnectPreset,               // OpenIDConnectPreset
 		PluginNameClusterOpenIDConnectPreset,        // ClusterOpenIDConnectPreset
 		PluginNameCustomVerbAuthorizer,              // CustomVerbAuthorizer
@@ -114,6 +123,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameExtensionValidator,              // ExtensionValidator
 		PluginNameExtensionLabels,                 // ExtensionLabels
 		PluginNameShootTolerationRestriction,      // ShootTolerationRestriction
+		PluginNameShootProjectDefaults,            // ShootProjectDefaults
 		PluginNameShootExposureClass,              // ShootExposureClass
 		PluginNameShootDNS,                        // ShootDNS
 		PluginNameShootManagedSeed,                // ShootManagedSeed
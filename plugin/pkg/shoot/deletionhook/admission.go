@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+	"github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootDeletionHook, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); len(err) > 0 {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		client, err := NewClient(cfg.Webhook.URL, cfg.Webhook.CABundle, cfg.Webhook.Timeout.Duration)
+		if err != nil {
+			return nil, err
+		}
+
+		selector := labels.Everything()
+		if cfg.ShootSelector != nil {
+			selector, err = metav1.LabelSelectorAsSelector(cfg.ShootSelector)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return New(client, *cfg.FailurePolicy, selector), nil
+	})
+}
+
+// DeletionHook contains listers and admission handler.
+type DeletionHook struct {
+	*admission.Handler
+	client        Client
+	failurePolicy shootdeletionhook.FailurePolicyType
+	shootSelector labels.Selector
+
+	shootLister gardencorev1beta1listers.ShootLister
+	readyFunc   admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&DeletionHook{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootDeletionHook admission plugin.
+func New(client Client, failurePolicy shootdeletionhook.FailurePolicyType, shootSelector labels.Selector) *DeletionHook {
+	return &DeletionHook{
+		Handler:       admission.NewHandler(admission.Delete),
+		client:        client,
+		failurePolicy: failurePolicy,
+		shootSelector: shootSelector,
+	}
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (d *DeletionHook) AssignReadyFunc(f admission.ReadyFunc) {
+	d.readyFunc = f
+	d.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory gets Lister from SharedInformerFactory.
+func (d *DeletionHook) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	shootInformer := f.Core().V1beta1().Shoots()
+	d.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (d *DeletionHook) ValidateInitialization() error {
+	if d.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+var _ admission.ValidationInterface = &DeletionHook{}
+
+// Validate consults the external deletion webhook before a Shoot is deleted.
+func (d *DeletionHook) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetOperation() != admission.Delete,
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	// Wait until the caches have been synced
+	if d.readyFunc == nil {
+		d.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !d.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	var shoots []*gardencorev1beta1.Shoot
+
+	// DELETECOLLECTION requests are passed to the admission plugins with an empty name, see the DeletionConfirmation
+	// admission plugin. In this case, the webhook is consulted for all Shoots in the namespace.
+	if a.GetName() == "" {
+		list, err := d.shootLister.Shoots(a.GetNamespace()).List(d.shootSelector)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		shoots = list
+	} else {
+		shoot, err := d.shootLister.Shoots(a.GetNamespace()).Get(a.GetName())
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return apierrors.NewInternalError(err)
+		}
+		if !d.shootSelector.Matches(labels.Set(shoot.Labels)) {
+			return nil
+		}
+		shoots = append(shoots, shoot)
+	}
+
+	var errs []error
+	for _, shoot := range shoots {
+		if err := d.review(ctx, shoot, a.GetUserInfo().GetName()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return admission.NewForbidden(a, errors.Join(errs...))
+	}
+	return nil
+}
+
+func (d *DeletionHook) review(ctx context.Context, shoot *gardencorev1beta1.Shoot, user string) error {
+	response, err := d.client.Review(ctx, Request{
+		Namespace: shoot.Namespace,
+		Name:      shoot.Name,
+		UID:       string(shoot.UID),
+		Labels:    shoot.Labels,
+		User:      user,
+	})
+	if err != nil {
+		if d.failurePolicy == shootdeletionhook.FailurePolicyIgnore {
+			return nil
+		}
+		return fmt.Errorf("failed consulting deletion webhook for shoot %q: %w", shoot.Name, err)
+	}
+
+	if !response.Allowed {
+		return fmt.Errorf("deletion of shoot %q was denied by deletion webhook: %s", shoot.Name, response.Reason)
+	}
+	return nil
+}
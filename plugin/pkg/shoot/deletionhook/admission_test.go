@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionhook_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/deletionhook"
+	"github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+)
+
+var _ = Describe("ShootDeletionHook", func() {
+	var (
+		ctx      context.Context
+		client   *fakeClient
+		plugin   *DeletionHook
+		userInfo *user.DefaultInfo
+
+		gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+
+		shoot1, shoot2 *gardencorev1beta1.Shoot
+
+		newPlugin = func(failurePolicy shootdeletionhook.FailurePolicyType, selector labels.Selector) {
+			plugin = New(client, failurePolicy, selector)
+			plugin.AssignReadyFunc(func() bool { return true })
+			plugin.SetCoreInformerFactory(gardenCoreInformerFactory)
+		}
+		deleteAttrs = func(name string) admission.Attributes {
+			return admission.NewAttributesRecord(nil, nil, core.Kind("Shoot").WithVersion("version"), "garden-foo", name, core.Resource("shoots").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, userInfo)
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		client = &fakeClient{allowed: map[string]bool{}}
+		userInfo = &user.DefaultInfo{Name: "foo"}
+
+		gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+		shoot1 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Name:      "bar",
+			Namespace: "garden-foo",
+			UID:       "1234",
+			Labels:    map[string]string{"purpose": "production"},
+		}}
+		shoot2 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Name:      "baz",
+			Namespace: "garden-foo",
+		}}
+		Expect(gardenCoreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(shoot1)).To(Succeed())
+		Expect(gardenCoreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(shoot2)).To(Succeed())
+
+		newPlugin(shootdeletionhook.FailurePolicyFail, labels.Everything())
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootDeletionHook"))
+		})
+	})
+
+	Describe("#Handles", func() {
+		It("should only handle DELETE operation", func() {
+			Expect(plugin.Handles(admission.Delete)).To(BeTrue())
+			Expect(plugin.Handles(admission.Create)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Connect)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should fail if the shoot lister is missing", func() {
+			Expect(New(client, shootdeletionhook.FailurePolicyFail, labels.Everything()).ValidateInitialization()).To(MatchError("missing shoot lister"))
+		})
+
+		It("should not return an error if the plugin is initialized", func() {
+			Expect(plugin.ValidateInitialization()).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should ignore other kinds", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Project").WithVersion("version"), "", "foo", core.Resource("projects").WithVersion("version"), "", admission.Delete, &metav1.DeleteOptions{}, false, userInfo)
+
+			Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		It("should ignore shoots which do not exist", func() {
+			Expect(plugin.Validate(ctx, deleteAttrs("unknown"), nil)).To(Succeed())
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		It("should allow the deletion if the webhook allows it", func() {
+			client.allowed["bar"] = true
+
+			Expect(plugin.Validate(ctx, deleteAttrs("bar"), nil)).To(Succeed())
+			Expect(client.requests).To(ConsistOf(Request{
+				Namespace: "garden-foo",
+				Name:      "bar",
+				UID:       "1234",
+				Labels:    map[string]string{"purpose": "production"},
+				User:      "foo",
+			}))
+		})
+
+		It("should forbid the deletion if the webhook denies it", func() {
+			err := plugin.Validate(ctx, deleteAttrs("bar"), nil)
+
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring(`deletion of shoot "bar" was denied by deletion webhook: registered workloads`)))
+		})
+
+		It("should not consult the webhook for shoots not matching the selector", func() {
+			newPlugin(shootdeletionhook.FailurePolicyFail, labels.SelectorFromSet(labels.Set{"purpose": "production"}))
+
+			Expect(plugin.Validate(ctx, deleteAttrs("baz"), nil)).To(Succeed())
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		Context("webhook cannot be consulted", func() {
+			BeforeEach(func() {
+				client.err = errors.New("connection refused")
+			})
+
+			It("should forbid the deletion if the failure policy is Fail", func() {
+				err := plugin.Validate(ctx, deleteAttrs("bar"), nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring("connection refused")))
+			})
+
+			It("should allow the deletion if the failure policy is Ignore", func() {
+				newPlugin(shootdeletionhook.FailurePolicyIgnore, labels.Everything())
+
+				Expect(plugin.Validate(ctx, deleteAttrs("bar"), nil)).To(Succeed())
+			})
+		})
+
+		Context("delete collection", func() {
+			It("should consult the webhook for all shoots of the namespace", func() {
+				client.allowed["bar"] = true
+
+				err := plugin.Validate(ctx, deleteAttrs(""), nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err).To(MatchError(ContainSubstring(`deletion of shoot "baz" was denied`)))
+				Expect(err).NotTo(MatchError(ContainSubstring(`shoot "bar"`)))
+				Expect(client.requests).To(HaveLen(2))
+			})
+
+			It("should only consult the webhook for the shoots matching the selector", func() {
+				newPlugin(shootdeletionhook.FailurePolicyFail, labels.SelectorFromSet(labels.Set{"purpose": "production"}))
+				client.allowed["bar"] = true
+
+				Expect(plugin.Validate(ctx, deleteAttrs(""), nil)).To(Succeed())
+				Expect(client.requests).To(HaveLen(1))
+			})
+		})
+	})
+})
+
+type fakeClient struct {
+	allowed  map[string]bool
+	err      error
+	requests []Request
+}
+
+func (f *fakeClient) Review(_ context.Context, request Request) (*Response, error) {
+	f.requests = append(f.requests, request)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.allowed[request.Name] {
+		return &Response{Allowed: true}, nil
+	}
+	return &Response{Reason: "registered workloads"}, nil
+}
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +groupName=shootdeletionhook.admission.gardener.cloud
+
+package shootdeletionhook // import "github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootdeletionhook
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootDeletionHook admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// Webhook is the external endpoint which is consulted before a shoot cluster is deleted.
+	Webhook Webhook
+	// FailurePolicy defines how errors when calling the webhook are handled.
+	FailurePolicy *FailurePolicyType
+	// ShootSelector restricts the shoot clusters for which the webhook is consulted.
+	// If nil, the webhook is consulted for all shoot clusters.
+	ShootSelector *metav1.LabelSelector
+}
+
+// Webhook contains the connection settings of the external deletion webhook.
+type Webhook struct {
+	// URL is the URL of the webhook.
+	URL string
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the webhook.
+	// If empty, the system trust store is used.
+	CABundle []byte
+	// Timeout is the timeout for requests to the webhook.
+	Timeout *metav1.Duration
+}
+
+// FailurePolicyType is a type for the failure policy of the deletion webhook.
+type FailurePolicyType string
+
+const (
+	// FailurePolicyFail rejects the deletion if the webhook cannot be consulted.
+	FailurePolicyFail FailurePolicyType = "Fail"
+	// FailurePolicyIgnore allows the deletion if the webhook cannot be consulted.
+	FailurePolicyIgnore FailurePolicyType = "Ignore"
+)
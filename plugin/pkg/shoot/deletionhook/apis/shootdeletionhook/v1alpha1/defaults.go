@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_Configuration sets defaults for the configuration of the ShootDeletionHook admission plugin.
+func SetDefaults_Configuration(obj *Configuration) {
+	if obj.Webhook.Timeout == nil {
+		obj.Webhook.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+	if obj.FailurePolicy == nil {
+		obj.FailurePolicy = ptr.To(FailurePolicyFail)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootDeletionHook admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+
+	// Webhook is the external endpoint which is consulted before a shoot cluster is deleted.
+	Webhook Webhook `json:"webhook"`
+	// FailurePolicy defines how errors when calling the webhook are handled. Allowed values are `Fail` (the deletion
+	// is rejected) and `Ignore` (the deletion is allowed). Defaults to `Fail`.
+	// +optional
+	FailurePolicy *FailurePolicyType `json:"failurePolicy,omitempty"`
+	// ShootSelector restricts the shoot clusters for which the webhook is consulted.
+	// If nil, the webhook is consulted for all shoot clusters.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+}
+
+// Webhook contains the connection settings of the external deletion webhook.
+type Webhook struct {
+	// URL is the URL of the webhook.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the webhook.
+	// If empty, the system trust store is used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Timeout is the timeout for requests to the webhook. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// FailurePolicyType is a type for the failure policy of the deletion webhook.
+type FailurePolicyType string
+
+const (
+	// FailurePolicyFail rejects the deletion if the webhook cannot be consulted.
+	FailurePolicyFail FailurePolicyType = "Fail"
+	// FailurePolicyIgnore allows the deletion if the webhook cannot be consulted.
+	FailurePolicyIgnore FailurePolicyType = "Ignore"
+)
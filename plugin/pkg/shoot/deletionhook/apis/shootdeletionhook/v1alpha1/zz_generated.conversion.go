@@ -0,0 +1,101 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	shootdeletionhook "github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*shootdeletionhook.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_shootdeletionhook_Configuration(a.(*Configuration), b.(*shootdeletionhook.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootdeletionhook.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootdeletionhook_Configuration_To_v1alpha1_Configuration(a.(*shootdeletionhook.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Webhook)(nil), (*shootdeletionhook.Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Webhook_To_shootdeletionhook_Webhook(a.(*Webhook), b.(*shootdeletionhook.Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootdeletionhook.Webhook)(nil), (*Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootdeletionhook_Webhook_To_v1alpha1_Webhook(a.(*shootdeletionhook.Webhook), b.(*Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_shootdeletionhook_Configuration(in *Configuration, out *shootdeletionhook.Configuration, s conversion.Scope) error {
+	if err := Convert_v1alpha1_Webhook_To_shootdeletionhook_Webhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	out.FailurePolicy = (*shootdeletionhook.FailurePolicyType)(unsafe.Pointer(in.FailurePolicy))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_shootdeletionhook_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_shootdeletionhook_Configuration(in *Configuration, out *shootdeletionhook.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_shootdeletionhook_Configuration(in, out, s)
+}
+
+func autoConvert_shootdeletionhook_Configuration_To_v1alpha1_Configuration(in *shootdeletionhook.Configuration, out *Configuration, s conversion.Scope) error {
+	if err := Convert_shootdeletionhook_Webhook_To_v1alpha1_Webhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	out.FailurePolicy = (*FailurePolicyType)(unsafe.Pointer(in.FailurePolicy))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_shootdeletionhook_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_shootdeletionhook_Configuration_To_v1alpha1_Configuration(in *shootdeletionhook.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_shootdeletionhook_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Webhook_To_shootdeletionhook_Webhook(in *Webhook, out *shootdeletionhook.Webhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_Webhook_To_shootdeletionhook_Webhook is an autogenerated conversion function.
+func Convert_v1alpha1_Webhook_To_shootdeletionhook_Webhook(in *Webhook, out *shootdeletionhook.Webhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Webhook_To_shootdeletionhook_Webhook(in, out, s)
+}
+
+func autoConvert_shootdeletionhook_Webhook_To_v1alpha1_Webhook(in *shootdeletionhook.Webhook, out *Webhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_shootdeletionhook_Webhook_To_v1alpha1_Webhook is an autogenerated conversion function.
+func Convert_shootdeletionhook_Webhook_To_v1alpha1_Webhook(in *shootdeletionhook.Webhook, out *Webhook, s conversion.Scope) error {
+	return autoConvert_shootdeletionhook_Webhook_To_v1alpha1_Webhook(in, out, s)
+}
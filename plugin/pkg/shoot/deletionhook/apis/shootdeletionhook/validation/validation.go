@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/url"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+)
+
+var availableFailurePolicies = sets.New(
+	string(shootdeletionhook.FailurePolicyFail),
+	string(shootdeletionhook.FailurePolicyIgnore),
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootdeletionhook.Configuration) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("webhook")
+	)
+
+	if config == nil {
+		return allErrs
+	}
+
+	if len(config.Webhook.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must provide the URL of the deletion webhook"))
+	} else if u, err := url.Parse(config.Webhook.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), config.Webhook.URL, "must be a valid https URL"))
+	}
+
+	if config.Webhook.Timeout != nil && config.Webhook.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), config.Webhook.Timeout.Duration.String(), "must be positive"))
+	}
+
+	if config.FailurePolicy != nil && !availableFailurePolicies.Has(string(*config.FailurePolicy)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("failurePolicy"), *config.FailurePolicy, sets.List(availableFailurePolicies)))
+	}
+
+	if config.ShootSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(config.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("shootSelector"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/deletionhook/apis/shootdeletionhook/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *shootdeletionhook.Configuration
+
+		BeforeEach(func() {
+			config = &shootdeletionhook.Configuration{
+				Webhook: shootdeletionhook.Webhook{
+					URL:     "https://cmdb.example.com/shoot-deletion",
+					Timeout: &metav1.Duration{Duration: 10 * time.Second},
+				},
+				FailurePolicy: ptr.To(shootdeletionhook.FailurePolicyFail),
+				ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "production"}},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should require the URL", func() {
+			config.Webhook.URL = ""
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("webhook.url"),
+				})),
+			))
+		})
+
+		It("should forbid non-https URLs", func() {
+			config.Webhook.URL = "http://cmdb.example.com"
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("webhook.url"),
+				})),
+			))
+		})
+
+		It("should forbid non-positive timeouts", func() {
+			config.Webhook.Timeout = &metav1.Duration{}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("webhook.timeout"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported failure policies", func() {
+			config.FailurePolicy = ptr.To[shootdeletionhook.FailurePolicyType]("Retry")
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("failurePolicy"),
+				})),
+			))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			config.ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "purpose", Operator: "Foo"}}}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("shootSelector.matchExpressions[0].operator"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Request is sent to the deletion webhook before a Shoot is deleted.
+type Request struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// UID is the UID of the Shoot.
+	UID string `json:"uid"`
+	// Labels are the labels of the Shoot.
+	Labels map[string]string `json:"labels,omitempty"`
+	// User is the name of the user who requested the deletion.
+	User string `json:"user"`
+}
+
+// Response is the response of the deletion webhook.
+type Response struct {
+	// Allowed states whether the deletion of the Shoot may proceed.
+	Allowed bool `json:"allowed"`
+	// Reason is a human-readable explanation why the deletion is denied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Client consults the deletion webhook.
+type Client interface {
+	// Review asks the webhook whether the deletion of the Shoot may proceed.
+	Review(ctx context.Context, request Request) (*Response, error)
+}
+
+// NewClient returns a Client for the deletion webhook with the given URL. The requests are sent as POST requests to
+// the URL. If the CA bundle is empty, the system trust store is used.
+func NewClient(url string, caBundle []byte, timeout time.Duration) (Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("failed parsing CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &client{
+		url:        url,
+		httpClient: &http.Client{Transport: transport, Timeout: timeout},
+	}, nil
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (c *client) Review(ctx context.Context, request Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	response := &Response{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("failed decoding response: %w", err)
+	}
+	return response, nil
+}
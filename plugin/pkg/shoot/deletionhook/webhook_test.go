@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/plugin/pkg/shoot/deletionhook"
+)
+
+var _ = Describe("Webhook", func() {
+	var (
+		ctx = context.Background()
+
+		server     *httptest.Server
+		requests   []Request
+		statusCode int
+		response   string
+
+		client  Client
+		request Request
+	)
+
+	BeforeEach(func() {
+		requests, statusCode, response = nil, http.StatusOK, `{"allowed":true}`
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			var req Request
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			requests = append(requests, req)
+
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		client, err = NewClient(server.URL, caBundle, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+
+		request = Request{
+			Namespace: "garden-foo",
+			Name:      "bar",
+			UID:       "1234",
+			User:      "foo",
+		}
+	})
+
+	Describe("#NewClient", func() {
+		It("should fail for an invalid CA bundle", func() {
+			_, err := NewClient(server.URL, []byte("invalid"), time.Second)
+			Expect(err).To(MatchError("failed parsing CA bundle"))
+		})
+	})
+
+	Describe("#Review", func() {
+		It("should return the response of the webhook", func() {
+			response = `{"allowed":false,"reason":"registered workloads"}`
+
+			Expect(client.Review(ctx, request)).To(Equal(&Response{Reason: "registered workloads"}))
+			Expect(requests).To(ConsistOf(request))
+		})
+
+		It("should fail for unsuccessful response codes", func() {
+			statusCode, response = http.StatusServiceUnavailable, "maintenance"
+
+			_, err := client.Review(ctx, request)
+			Expect(err).To(MatchError("unexpected response code 503: maintenance"))
+		})
+
+		It("should fail for invalid responses", func() {
+			response = "invalid"
+
+			_, err := client.Review(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding response")))
+		})
+	})
+})
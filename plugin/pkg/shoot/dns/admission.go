@@ -283,8 +283,16 @@ func setPrimaryDNSProvider(a admission.Attributes, shoot *core.Shoot, defaultDom
 	}
 
 	primary := helper.FindPrimaryDNSProvider(dns.Providers)
-	if primary == nil && len(dns.Providers) > 0 {
-		dns.Providers[0].Primary = ptr.To(true)
+	if primary != nil {
+		return nil
+	}
+	for i, provider := range dns.Providers {
+		// The secondary provider only takes over if the primary provider is unavailable, hence it must not become the
+		// primary provider.
+		if !ptr.Deref(provider.Secondary, false) {
+			dns.Providers[i].Primary = ptr.To(true)
+			break
+		}
 	}
 	return nil
 }
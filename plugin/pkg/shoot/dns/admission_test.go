@@ -265,6 +265,41 @@ var _ = Describe("dns", func() {
 				))
 			})
 
+			It("should not set the secondary DNS provider as primary DNS provider", func() {
+				var (
+					shootDomain = "my-shoot.my-private-domain.com"
+				)
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Providers = []core.DNSProvider{
+					{
+						Type:       &providerType,
+						SecretName: &secretName,
+						Secondary:  ptr.To(true),
+					},
+					{
+						Type: &providerType,
+					},
+				}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.DNS.Providers).To(HaveExactElements(
+					MatchFields(IgnoreExtras, Fields{
+						"Primary":   BeNil(),
+						"Secondary": Equal(ptr.To(true)),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Primary":   Equal(ptr.To(true)),
+						"Secondary": BeNil(),
+					}),
+				))
+			})
+
 			It("should re-assign the correct primary DNS provider on updates", func() {
 				var (
 					shootDomain = "my-shoot.my-private-domain.com"
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/utils/ipam"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootIPAM, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); len(err) > 0 {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		client, err := ipam.NewClient(cfg.Server.URL, cfg.Server.CABundle, cfg.Server.Timeout.Duration)
+		if err != nil {
+			return nil, err
+		}
+
+		return New(client), nil
+	})
+}
+
+// IPAM contains required information to process admission requests.
+type IPAM struct {
+	*admission.Handler
+	client ipam.Client
+}
+
+// New creates a new ShootIPAM admission plugin.
+func New(client ipam.Client) admission.MutationInterface {
+	return &IPAM{
+		Handler: admission.NewHandler(admission.Create),
+		client:  client,
+	}
+}
+
+// Admit allocates the unset pod, service and node CIDRs of new shoot clusters at the external IPAM server.
+// Dry-run requests are not sent to the IPAM server as they must not have side effects. CIDRs which were allocated for
+// Shoots that are rejected by a later admission plugin or by validation are released by the shoot IPAM controller of
+// gardener-controller-manager.
+func (i *IPAM) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetOperation() != admission.Create,
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	if _, ok := shoot.Annotations[ipam.AnnotationAllocatedNetworks]; ok {
+		return admission.NewForbidden(a, fmt.Errorf("the %s annotation must not be set by users", ipam.AnnotationAllocatedNetworks))
+	}
+
+	if a.IsDryRun() {
+		return nil
+	}
+
+	if shoot.Spec.Networking == nil {
+		shoot.Spec.Networking = &core.Networking{}
+	}
+
+	var (
+		networking = shoot.Spec.Networking
+		workerless = len(shoot.Spec.Provider.Workers) == 0
+		networks   []string
+	)
+
+	if networking.Pods == nil && !workerless {
+		networks = append(networks, ipam.NetworkPods)
+	}
+	if networking.Services == nil {
+		networks = append(networks, ipam.NetworkServices)
+	}
+	if networking.Nodes == nil && !workerless {
+		networks = append(networks, ipam.NetworkNodes)
+	}
+
+	if len(networks) == 0 {
+		return nil
+	}
+
+	if shoot.Name == "" {
+		return apierrors.NewBadRequest("the name of the shoot is required for allocating its networks")
+	}
+
+	request := ipam.Request{
+		Namespace:    a.GetNamespace(),
+		Name:         shoot.Name,
+		ProviderType: shoot.Spec.Provider.Type,
+		Region:       shoot.Spec.Region,
+		Networks:     networks,
+	}
+	for _, ipFamily := range networking.IPFamilies {
+		request.IPFamilies = append(request.IPFamilies, string(ipFamily))
+	}
+
+	allocation, err := i.client.Allocate(ctx, request)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	var allocated []string
+	for _, network := range networks {
+		switch {
+		case network == ipam.NetworkPods && allocation.Pods != nil:
+			networking.Pods = allocation.Pods
+		case network == ipam.NetworkServices && allocation.Services != nil:
+			networking.Services = allocation.Services
+		case network == ipam.NetworkNodes && allocation.Nodes != nil:
+			networking.Nodes = allocation.Nodes
+		default:
+			continue
+		}
+		allocated = append(allocated, network)
+	}
+
+	if len(allocated) > 0 {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, ipam.AnnotationAllocatedNetworks, strings.Join(allocated, ","))
+	}
+
+	return nil
+}
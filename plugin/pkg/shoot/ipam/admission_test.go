@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ipam_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	ipamutils "github.com/gardener/gardener/pkg/utils/ipam"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/gardener/gardener/plugin/pkg/shoot/ipam"
+)
+
+var _ = Describe("ShootIPAM", func() {
+	var (
+		ctx      context.Context
+		client   *fakeClient
+		plugin   admission.MutationInterface
+		attrs    admission.Attributes
+		userInfo *user.DefaultInfo
+
+		shoot, expectedShoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		client = &fakeClient{allocation: &ipamutils.Allocation{
+			Pods:     ptr.To("10.1.0.0/16"),
+			Services: ptr.To("10.2.0.0/20"),
+			Nodes:    ptr.To("10.3.0.0/24"),
+		}}
+		plugin = ipam.New(client)
+
+		userInfo = &user.DefaultInfo{Name: "foo"}
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "garden-foo",
+			},
+			Spec: core.ShootSpec{
+				Region: "europe",
+				Provider: core.Provider{
+					Type:    "local",
+					Workers: []core.Worker{{Name: "worker"}},
+				},
+				Networking: &core.Networking{
+					IPFamilies: []core.IPFamily{core.IPFamilyIPv4},
+				},
+			},
+		}
+		expectedShoot = shoot.DeepCopy()
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			ipam.Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootIPAM"))
+		})
+	})
+
+	Describe("#Handles", func() {
+		It("should only handle CREATE operation", func() {
+			Expect(plugin.Handles(admission.Create)).To(BeTrue())
+			Expect(plugin.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#Admit", func() {
+		Context("ignored requests", func() {
+			It("should ignore resources other than Shoot", func() {
+				project := &core.Project{}
+				attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(client.requests).To(BeEmpty())
+			})
+
+			It("should ignore operations other than Create", func() {
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot).To(Equal(expectedShoot))
+				Expect(client.requests).To(BeEmpty())
+			})
+
+			It("should ignore subresources", func() {
+				attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+				Expect(shoot).To(Equal(expectedShoot))
+				Expect(client.requests).To(BeEmpty())
+			})
+		})
+
+		It("should fail, if object is not a shoot", func() {
+			attrs = admission.NewAttributesRecord(&core.Project{}, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Admit(ctx, attrs, nil)
+			Expect(err).To(BeInternalServerError())
+			Expect(err).To(MatchError(ContainSubstring("could not convert")))
+		})
+
+		It("should forbid setting the allocated networks annotation", func() {
+			shoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "pods"}
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Admit(ctx, attrs, nil)
+			Expect(err).To(BeForbiddenError())
+			Expect(err).To(MatchError(ContainSubstring("annotation must not be set by users")))
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		It("should not allocate networks for dry-run requests", func() {
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, true, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		It("should allocate all networks", func() {
+			expectedShoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services,nodes"}
+			expectedShoot.Spec.Networking.Pods = ptr.To("10.1.0.0/16")
+			expectedShoot.Spec.Networking.Services = ptr.To("10.2.0.0/20")
+			expectedShoot.Spec.Networking.Nodes = ptr.To("10.3.0.0/24")
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+			Expect(client.requests).To(ConsistOf(ipamutils.Request{
+				Namespace:    "garden-foo",
+				Name:         "bar",
+				ProviderType: "local",
+				Region:       "europe",
+				IPFamilies:   []string{"IPv4"},
+				Networks:     []string{"pods", "services", "nodes"},
+			}))
+		})
+
+		It("should only allocate the unset networks", func() {
+			shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/11")
+			shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/16")
+			expectedShoot = shoot.DeepCopy()
+			expectedShoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "services"}
+			expectedShoot.Spec.Networking.Services = ptr.To("10.2.0.0/20")
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+			Expect(client.requests).To(ConsistOf(HaveField("Networks", []string{"services"})))
+		})
+
+		It("should only allocate the service network for workerless shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+			shoot.Spec.Networking = nil
+			expectedShoot = shoot.DeepCopy()
+			expectedShoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "services"}
+			expectedShoot.Spec.Networking = &core.Networking{Services: ptr.To("10.2.0.0/20")}
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+
+		It("should not record networks which were not allocated", func() {
+			client.allocation.Nodes = nil
+			expectedShoot.Annotations = map[string]string{"ipam.gardener.cloud/allocated-networks": "pods,services"}
+			expectedShoot.Spec.Networking.Pods = ptr.To("10.1.0.0/16")
+			expectedShoot.Spec.Networking.Services = ptr.To("10.2.0.0/20")
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+
+		It("should not call the IPAM server if all networks are set", func() {
+			shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/11")
+			shoot.Spec.Networking.Services = ptr.To("100.64.0.0/13")
+			shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/16")
+			expectedShoot = shoot.DeepCopy()
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(plugin.Admit(ctx, attrs, nil)).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+			Expect(client.requests).To(BeEmpty())
+		})
+
+		It("should fail if the allocation fails", func() {
+			client.err = errors.New("address space exhausted")
+
+			attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			err := plugin.Admit(ctx, attrs, nil)
+			Expect(err).To(BeInternalServerError())
+			Expect(err).To(MatchError(ContainSubstring("address space exhausted")))
+		})
+	})
+})
+
+type fakeClient struct {
+	allocation *ipamutils.Allocation
+	err        error
+	requests   []ipamutils.Request
+}
+
+func (f *fakeClient) Allocate(_ context.Context, request ipamutils.Request) (*ipamutils.Allocation, error) {
+	f.requests = append(f.requests, request)
+	if f.err != nil {
+		return nil, f.err
+	}
+	allocation := *f.allocation
+	return &allocation, nil
+}
+
+func (f *fakeClient) Release(_ context.Context, request ipamutils.Request) error {
+	f.requests = append(f.requests, request)
+	return f.err
+}
+
+func (f *fakeClient) List(_ context.Context) ([]ipamutils.AllocatedShoot, error) {
+	return nil, f.err
+}
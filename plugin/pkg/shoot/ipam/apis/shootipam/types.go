@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootipam
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootIPAM admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+	// Server is the external IPAM server which allocates the CIDRs of new shoot clusters.
+	Server Server
+}
+
+// Server contains the connection settings of the external IPAM server.
+type Server struct {
+	// URL is the base URL of the IPAM server.
+	URL string
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the IPAM server.
+	// If empty, the system trust store is used.
+	CABundle []byte
+	// Timeout is the timeout for requests to the IPAM server.
+	Timeout *metav1.Duration
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_Configuration sets defaults for the configuration of the ShootIPAM admission plugin.
+func SetDefaults_Configuration(obj *Configuration) {
+	if obj.Server.Timeout == nil {
+		obj.Server.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
@@ -0,0 +1,97 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	shootipam "github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*shootipam.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_shootipam_Configuration(a.(*Configuration), b.(*shootipam.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootipam.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootipam_Configuration_To_v1alpha1_Configuration(a.(*shootipam.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*shootipam.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Server_To_shootipam_Server(a.(*Server), b.(*shootipam.Server), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*shootipam.Server)(nil), (*Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_shootipam_Server_To_v1alpha1_Server(a.(*shootipam.Server), b.(*Server), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_shootipam_Configuration(in *Configuration, out *shootipam.Configuration, s conversion.Scope) error {
+	if err := Convert_v1alpha1_Server_To_shootipam_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_shootipam_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_shootipam_Configuration(in *Configuration, out *shootipam.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_shootipam_Configuration(in, out, s)
+}
+
+func autoConvert_shootipam_Configuration_To_v1alpha1_Configuration(in *shootipam.Configuration, out *Configuration, s conversion.Scope) error {
+	if err := Convert_shootipam_Server_To_v1alpha1_Server(&in.Server, &out.Server, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_shootipam_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_shootipam_Configuration_To_v1alpha1_Configuration(in *shootipam.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_shootipam_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Server_To_shootipam_Server(in *Server, out *shootipam.Server, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_Server_To_shootipam_Server is an autogenerated conversion function.
+func Convert_v1alpha1_Server_To_shootipam_Server(in *Server, out *shootipam.Server, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Server_To_shootipam_Server(in, out, s)
+}
+
+func autoConvert_shootipam_Server_To_v1alpha1_Server(in *shootipam.Server, out *Server, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_shootipam_Server_To_v1alpha1_Server is an autogenerated conversion function.
+func Convert_shootipam_Server_To_v1alpha1_Server(in *shootipam.Server, out *Server, s conversion.Scope) error {
+	return autoConvert_shootipam_Server_To_v1alpha1_Server(in, out, s)
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam"
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootipam.Configuration) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("server")
+	)
+
+	if config == nil {
+		return allErrs
+	}
+
+	if len(config.Server.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must provide the URL of the IPAM server"))
+	} else if u, err := url.Parse(config.Server.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), config.Server.URL, "must be a valid https URL"))
+	}
+
+	if config.Server.Timeout != nil && config.Server.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), config.Server.Timeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/ipam/apis/shootipam/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *shootipam.Configuration
+
+		BeforeEach(func() {
+			config = &shootipam.Configuration{
+				Server: shootipam.Server{
+					URL:     "https://ipam.example.com/gardener",
+					Timeout: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			}
+		})
+
+		It("should allow valid configuration", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should require the URL", func() {
+			config.Server.URL = ""
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("server.url"),
+				})),
+			))
+		})
+
+		It("should forbid non-https URLs", func() {
+			config.Server.URL = "http://ipam.example.com"
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.url"),
+				})),
+			))
+		})
+
+		It("should forbid non-positive timeouts", func() {
+			config.Server.Timeout = &metav1.Duration{}
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.timeout"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectdefaults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootProjectDefaults, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ProjectDefaults contains listers and admission handler.
+type ProjectDefaults struct {
+	*admission.Handler
+
+	projectLister gardencorev1beta1listers.ProjectLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&ProjectDefaults{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ProjectDefaults admission plugin.
+func New() (*ProjectDefaults, error) {
+	return &ProjectDefaults{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *ProjectDefaults) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory sets the external garden core informer factory.
+func (p *ProjectDefaults) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	p.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+func (p *ProjectDefaults) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !p.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *ProjectDefaults) ValidateInitialization() error {
+	if p.projectLister == nil {
+		return errors.New("missing Project lister")
+	}
+	return nil
+}
+
+var _ admission.MutationInterface = &ProjectDefaults{}
+
+// Admit defaults the fields of new Shoots which are not set with the shoot defaults of their Project.
+func (p *ProjectDefaults) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := p.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore any updates to shoot subresources.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	project, err := admissionutils.ProjectForNamespaceFromLister(p.projectLister, shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not find referenced project: %w", err))
+	}
+
+	if project.Spec.ShootDefaults == nil {
+		return nil
+	}
+
+	defaults := &core.ProjectShootDefaults{}
+	if err := gardencorev1beta1.Convert_v1beta1_ProjectShootDefaults_To_core_ProjectShootDefaults(project.Spec.ShootDefaults, defaults, nil); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not convert shoot defaults of project %q: %w", project.Name, err))
+	}
+
+	applyDefaults(shoot, defaults)
+	return nil
+}
+
+// applyDefaults sets the fields of the given Shoot which are not set to the given defaults. Fields which are set
+// explicitly are never overwritten.
+func applyDefaults(shoot *core.Shoot, defaults *core.ProjectShootDefaults) {
+	if shoot.Spec.Purpose == nil && defaults.Purpose != nil {
+		shoot.Spec.Purpose = defaults.Purpose
+	}
+
+	if defaults.MaintenanceTimeWindow != nil {
+		if shoot.Spec.Maintenance == nil {
+			shoot.Spec.Maintenance = &core.Maintenance{}
+		}
+		if shoot.Spec.Maintenance.TimeWindow == nil {
+			shoot.Spec.Maintenance.TimeWindow = defaults.MaintenanceTimeWindow
+		}
+	}
+
+	if len(defaults.HibernationSchedules) > 0 {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &core.Hibernation{}
+		}
+		if len(shoot.Spec.Hibernation.Schedules) == 0 {
+			shoot.Spec.Hibernation.Schedules = defaults.HibernationSchedules
+		}
+	}
+
+	if defaults.NetworkingType != nil && !helper.IsWorkerless(shoot) {
+		if shoot.Spec.Networking == nil {
+			shoot.Spec.Networking = &core.Networking{}
+		}
+		if shoot.Spec.Networking.Type == nil {
+			shoot.Spec.Networking.Type = defaults.NetworkingType
+		}
+	}
+}
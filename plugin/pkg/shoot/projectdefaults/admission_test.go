@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectdefaults_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/projectdefaults"
+)
+
+var _ = Describe("projectdefaults", func() {
+	Describe("#Admit", func() {
+		var (
+			namespace = "garden-foo"
+
+			shoot   *core.Shoot
+			project *gardencorev1beta1.Project
+
+			admissionHandler          *ProjectDefaults
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+
+			admit func() error
+		)
+
+		BeforeEach(func() {
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+				Spec: core.ShootSpec{
+					Provider: core.Provider{Workers: []core.Worker{{Name: "worker"}}},
+				},
+			}
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: &namespace,
+					ShootDefaults: &gardencorev1beta1.ProjectShootDefaults{
+						Purpose:               ptr.To(gardencorev1beta1.ShootPurposeDevelopment),
+						MaintenanceTimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+						HibernationSchedules:  []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5"), Location: ptr.To("Europe/Berlin")}},
+						NetworkingType:        ptr.To("calico"),
+					},
+				},
+			}
+
+			admit = func() error {
+				attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+				return admissionHandler.Admit(context.TODO(), attrs, nil)
+			}
+		})
+
+		It("should do nothing because the resource is not a Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing because a subresource is created", func() {
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should return an error because the project for the shoot was not found", func() {
+			Expect(admit()).To(MatchError(ContainSubstring("could not find referenced project")))
+		})
+
+		It("should do nothing because the project does not define shoot defaults", func() {
+			project.Spec.ShootDefaults = nil
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+			Expect(shoot.Spec.Maintenance).To(BeNil())
+			Expect(shoot.Spec.Hibernation).To(BeNil())
+			Expect(shoot.Spec.Networking).To(BeNil())
+		})
+
+		It("should default the fields which are not set", func() {
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeDevelopment)))
+			Expect(shoot.Spec.Maintenance).To(Equal(&core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}))
+			Expect(shoot.Spec.Hibernation).To(Equal(&core.Hibernation{Schedules: []core.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5"), Location: ptr.To("Europe/Berlin")}}}))
+			Expect(shoot.Spec.Networking).To(Equal(&core.Networking{Type: ptr.To("calico")}))
+		})
+
+		It("should not overwrite the fields which are set", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+			shoot.Spec.Maintenance = &core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"}}
+			shoot.Spec.Hibernation = &core.Hibernation{Schedules: []core.HibernationSchedule{{End: ptr.To("00 08 * * 1,2,3,4,5")}}}
+			shoot.Spec.Networking = &core.Networking{Type: ptr.To("cilium")}
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeProduction)))
+			Expect(shoot.Spec.Maintenance).To(Equal(&core.Maintenance{TimeWindow: &core.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"}}))
+			Expect(shoot.Spec.Hibernation).To(Equal(&core.Hibernation{Schedules: []core.HibernationSchedule{{End: ptr.To("00 08 * * 1,2,3,4,5")}}}))
+			Expect(shoot.Spec.Networking).To(Equal(&core.Networking{Type: ptr.To("cilium")}))
+		})
+
+		It("should keep the other maintenance and hibernation settings of the shoot", func() {
+			shoot.Spec.Maintenance = &core.Maintenance{AutoUpdate: &core.MaintenanceAutoUpdate{KubernetesVersion: true}}
+			shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Maintenance.AutoUpdate).To(Equal(&core.MaintenanceAutoUpdate{KubernetesVersion: true}))
+			Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&core.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}))
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(shoot.Spec.Hibernation.Schedules).To(HaveLen(1))
+		})
+
+		It("should not default the networking type of workerless shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Networking).To(BeNil())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootProjectDefaults"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE operations", func() {
+			dr, err := New()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dr.Handles(admission.Create)).To(BeTrue())
+			Expect(dr.Handles(admission.Update)).NotTo(BeTrue())
+			Expect(dr.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(dr.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no ProjectLister is set", func() {
+			dr, _ := New()
+
+			Expect(dr.ValidateInitialization()).To(HaveOccurred())
+		})
+
+		It("should not return error if ProjectLister is set", func() {
+			dr, _ := New()
+			dr.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+
+			Expect(dr.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
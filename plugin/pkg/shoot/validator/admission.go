@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
@@ -312,7 +313,7 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateDeletion(a); err != nil {
 		return err
 	}
-	if err := validationContext.validateShootHibernation(a); err != nil {
+	if err := validationContext.validateShootHibernation(ctx, a); err != nil {
 		return err
 	}
 	if allErrs = validationContext.ensureMachineImages(); len(allErrs) > 0 {
@@ -605,9 +606,9 @@ func (c *validationContext) validateDeletion(a admission.Attributes) error {
 	return nil
 }
 
-func (c *validationContext) validateShootHibernation(a admission.Attributes) error {
-	// Prevent Shoots from getting hibernated in case they have problematic webhooks.
-	// Otherwise, we can never wake up this shoot cluster again.
+func (c *validationContext) validateShootHibernation(ctx context.Context, a admission.Attributes) error {
+	// Prevent Shoots from getting hibernated in case they have problematic webhooks or blocking hibernation pre-checks
+	// failed. Otherwise, we can never wake up this shoot cluster again.
 	oldIsHibernated := c.oldShoot.Spec.Hibernation != nil && c.oldShoot.Spec.Hibernation.Enabled != nil && *c.oldShoot.Spec.Hibernation.Enabled
 	newIsHibernated := c.shoot.Spec.Hibernation != nil && c.shoot.Spec.Hibernation.Enabled != nil && *c.shoot.Spec.Hibernation.Enabled
 
@@ -617,6 +618,10 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 				err := fmt.Errorf("'%s' constraint is '%s': %s", core.ShootHibernationPossible, hibernationConstraint.Status, hibernationConstraint.Message)
 				return admission.NewForbidden(a, err)
 			}
+
+			if hibernationConstraint.Reason == gardencorev1beta1.HibernationPreChecksWarning {
+				warning.AddWarning(ctx, "", fmt.Sprintf("'%s' constraint reports failed hibernation pre-checks: %s", core.ShootHibernationPossible, hibernationConstraint.Message))
+			}
 		}
 	}
 
@@ -657,6 +662,11 @@ func (c *validationContext) addMetadataAnnotations(a admission.Attributes) {
 		addInfrastructureDeploymentTask(c.shoot)
 	}
 
+	// The infrastructure might need to be adapted to the expanded networks, e.g., by enlarging subnets or routes.
+	if wereShootNetworkingCIDRsChanged(c.oldShoot, c.shoot) {
+		addInfrastructureDeploymentTask(c.shoot)
+	}
+
 	// We rely that SSHAccess is defaulted in the shoot creation, that is why we do not check for nils for the new shoot object.
 	if c.oldShoot.Spec.Provider.WorkersSettings != nil &&
 		c.oldShoot.Spec.Provider.WorkersSettings.SSHAccess != nil &&
@@ -771,8 +781,9 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 			workerless,
 		)...)
 
-		// validate network disjointedness with seed networks if shoot is being (re)scheduled
-		if !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) {
+		// validate network disjointedness with seed networks if shoot is being (re)scheduled or its networks are changed
+		shootRescheduled := !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName)
+		if shootRescheduled || wereShootNetworkingCIDRsChanged(c.oldShoot, c.shoot) {
 			allErrs = append(allErrs, cidrvalidation.ValidateNetworkDisjointedness(
 				path,
 				c.shoot.Spec.Networking.Nodes,
@@ -783,19 +794,19 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 				c.seed.Spec.Networks.Services,
 				workerless,
 			)...)
+		}
 
-			if c.shoot.Status.Networking != nil {
-				allErrs = append(allErrs, cidrvalidation.ValidateMultiNetworkDisjointedness(
-					field.NewPath("status", "networking"),
-					c.shoot.Status.Networking.Nodes,
-					c.shoot.Status.Networking.Pods,
-					c.shoot.Status.Networking.Services,
-					c.seed.Spec.Networks.Nodes,
-					c.seed.Spec.Networks.Pods,
-					c.seed.Spec.Networks.Services,
-					workerless,
-				)...)
-			}
+		if shootRescheduled && c.shoot.Status.Networking != nil {
+			allErrs = append(allErrs, cidrvalidation.ValidateMultiNetworkDisjointedness(
+				field.NewPath("status", "networking"),
+				c.shoot.Status.Networking.Nodes,
+				c.shoot.Status.Networking.Pods,
+				c.shoot.Status.Networking.Services,
+				c.seed.Spec.Networks.Nodes,
+				c.seed.Spec.Networks.Pods,
+				c.seed.Spec.Networks.Services,
+				workerless,
+			)...)
 		}
 	}
 
@@ -912,6 +923,12 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 		return allErrs
 	}
 
+	var (
+		cloudProfileReference    = admissionutils.BuildCloudProfileReference(c.shoot)
+		oldCloudProfileReference = admissionutils.BuildCloudProfileReference(c.oldShoot)
+		cloudProfileChanged      = oldCloudProfileReference != nil && !apiequality.Semantic.DeepEqual(cloudProfileReference, oldCloudProfileReference)
+	)
+
 	for i, worker := range c.shoot.Spec.Provider.Workers {
 		var oldWorker = core.Worker{Machine: core.Machine{Image: &core.ShootMachineImage{}}}
 		isNewWorkerPool := true
@@ -933,6 +950,7 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 				detail                                                                                                             = fmt.Sprintf("machine type %q ", worker.Machine.Type)
 			)
 
+			machineTypeValid := isMachinePresentInCloudprofile && architectureSupported && availableInAllZones && isUsableMachine
 			if !isMachinePresentInCloudprofile {
 				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "type"), worker.Machine.Type, supportedMachineTypes))
 			} else if !architectureSupported || !availableInAllZones || !isUsableMachine {
@@ -951,6 +969,8 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 			isMachineImagePresentInCloudprofile, architectureSupported, activeMachineImageVersion, validMachineImageVersions := validateMachineImagesConstraints(a, c.cloudProfileSpec.MachineImages, isNewWorkerPool, worker.Machine, oldWorker.Machine)
 			if !isMachineImagePresentInCloudprofile {
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("machine", "image"), worker.Machine.Image, fmt.Sprintf("machine image version is not supported, supported machine image versions are: %+v", validMachineImageVersions)))
+			} else if !architectureSupported && len(validMachineImageVersions) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("machine", "image"), worker.Machine.Image, fmt.Sprintf("machine image version '%s:%s' does not support CPU architecture %q and the cloud profile does not offer any usable machine image version supporting it", worker.Machine.Image.Name, worker.Machine.Image.Version, *worker.Machine.Architecture)))
 			} else if !architectureSupported || !activeMachineImageVersion {
 				detail := fmt.Sprintf("machine image version '%s:%s' ", worker.Machine.Image.Name, worker.Machine.Image.Version)
 				if !architectureSupported {
@@ -961,6 +981,9 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 				}
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("machine", "image"), worker.Machine.Image, fmt.Sprintf("%ssupported machine image versions are: %+v", detail, validMachineImageVersions)))
 			} else {
+				if machineTypeValid {
+					allErrs = append(allErrs, validateMachineCompatibility(c.cloudProfileSpec, cloudProfileReference, worker.Machine, oldWorker.Machine, cloudProfileChanged, idxPath.Child("machine"))...)
+				}
 				allErrs = append(allErrs, validateContainerRuntimeConstraints(c.cloudProfileSpec.MachineImages, worker, oldWorker, idxPath.Child("cri"))...)
 
 				kubeletVersion, err := helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
@@ -1543,6 +1566,9 @@ func getDefaultMachineImage(machineImages []gardencorev1beta1.MachineImage, imag
 			validVersions = append(validVersions, version)
 		}
 	}
+	if len(validVersions) == 0 {
+		return nil, field.Invalid(fldPath, imageName, fmt.Sprintf("machine image %q does not provide any version supporting architecture `%s`", defaultImage.Name, *arch))
+	}
 
 	latestMachineImageVersion, err := helper.DetermineLatestMachineImageVersion(validVersions, true)
 	if err != nil {
@@ -1600,6 +1626,73 @@ func validateMachineImagesConstraints(a admission.Attributes, constraints []gard
 		validMachineImageVersions
 }
 
+// validateMachineCompatibility cross-checks the machine type, the machine image version and the CPU architecture of a
+// worker pool against each other in the effective cloud profile, i.e., the referenced CloudProfile or the spec computed
+// for the referenced NamespacedCloudProfile. In contrast to the other machine validations, it is also performed for
+// unchanged worker pools if the referenced cloud profile changed. The error messages name the referenced cloud profile
+// and the compatible alternatives, so that incompatible worker pools are rejected with an actionable message instead of
+// failing later when the worker pool is reconciled.
+func validateMachineCompatibility(cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cloudProfileReference *gardencorev1beta1.CloudProfileReference, machine, oldMachine core.Machine, cloudProfileChanged bool, fldPath *field.Path) field.ErrorList {
+	if !cloudProfileChanged && apiequality.Semantic.DeepEqual(machine, oldMachine) {
+		return nil
+	}
+
+	var (
+		allErrs             field.ErrorList
+		cloudProfile        = fmt.Sprintf("%s %q", cloudProfileReference.Kind, cloudProfileReference.Name)
+		architecture        = ptr.Deref(machine.Architecture, v1beta1constants.ArchitectureAMD64)
+		machineTypeIndex    = slices.IndexFunc(cloudProfileSpec.MachineTypes, func(t gardencorev1beta1.MachineType) bool { return t.Name == machine.Type })
+		machineTypesForArch []string
+	)
+
+	for _, machineType := range cloudProfileSpec.MachineTypes {
+		if ptr.Deref(machineType.Architecture, v1beta1constants.ArchitectureAMD64) == architecture && ptr.Deref(machineType.Usable, true) {
+			machineTypesForArch = append(machineTypesForArch, machineType.Name)
+		}
+	}
+
+	if machineTypeIndex < 0 {
+		return append(allErrs, field.Invalid(fldPath.Child("type"), machine.Type, fmt.Sprintf("machine type is not offered by the referenced %s, machine types supporting CPU architecture %q are: %+v", cloudProfile, architecture, machineTypesForArch)))
+	}
+
+	machineTypeArchitecture := ptr.Deref(cloudProfileSpec.MachineTypes[machineTypeIndex].Architecture, v1beta1constants.ArchitectureAMD64)
+	if machineTypeArchitecture != architecture {
+		return append(allErrs, field.Invalid(fldPath.Child("type"), machine.Type, fmt.Sprintf("machine type has CPU architecture %q in the referenced %s but the worker pool uses CPU architecture %q, machine types supporting it are: %+v", machineTypeArchitecture, cloudProfile, architecture, machineTypesForArch)))
+	}
+
+	if machine.Image == nil || len(machine.Image.Version) == 0 {
+		return allErrs
+	}
+
+	var (
+		imageVersionSupportsArchitecture bool
+		imageVersionFound                bool
+		imageVersionsForArch             []string
+	)
+
+	for _, machineImage := range cloudProfileSpec.MachineImages {
+		for _, version := range machineImage.Versions {
+			supportsArchitecture := slices.Contains(version.Architectures, machineTypeArchitecture)
+			if machineImage.Name == machine.Image.Name && version.Version == machine.Image.Version {
+				imageVersionFound = true
+				imageVersionSupportsArchitecture = supportsArchitecture
+			}
+			if supportsArchitecture && (version.ExpirationDate == nil || version.ExpirationDate.Time.UTC().After(time.Now().UTC())) {
+				imageVersionsForArch = append(imageVersionsForArch, fmt.Sprintf("%s:%s", machineImage.Name, version.Version))
+			}
+		}
+	}
+
+	switch {
+	case !imageVersionFound:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), machine.Image, fmt.Sprintf("machine image version '%s:%s' is not offered by the referenced %s, machine image versions supporting CPU architecture %q are: %+v", machine.Image.Name, machine.Image.Version, cloudProfile, machineTypeArchitecture, imageVersionsForArch)))
+	case !imageVersionSupportsArchitecture:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), machine.Image, fmt.Sprintf("machine image version '%s:%s' does not support CPU architecture %q of machine type %q in the referenced %s, machine image versions supporting it are: %+v", machine.Image.Name, machine.Image.Version, machineTypeArchitecture, machine.Type, cloudProfile, imageVersionsForArch)))
+	}
+
+	return allErrs
+}
+
 func validateContainerRuntimeConstraints(constraints []gardencorev1beta1.MachineImage, worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
 	if worker.CRI == nil || worker.Machine.Image == nil {
 		return nil
@@ -1751,6 +1844,17 @@ func addDeploymentTasks(shoot *core.Shoot, tasks ...string) {
 	controllerutils.AddTasks(shoot.ObjectMeta.Annotations, tasks...)
 }
 
+// wereShootNetworkingCIDRsChanged returns true if the node or pod CIDR of an existing shoot has been changed, e.g., because
+// it was expanded.
+func wereShootNetworkingCIDRsChanged(oldShoot, shoot *core.Shoot) bool {
+	if oldShoot.Spec.Networking == nil || shoot.Spec.Networking == nil {
+		return false
+	}
+
+	return (oldShoot.Spec.Networking.Nodes != nil && !ptr.Equal(oldShoot.Spec.Networking.Nodes, shoot.Spec.Networking.Nodes)) ||
+		(oldShoot.Spec.Networking.Pods != nil && !ptr.Equal(oldShoot.Spec.Networking.Pods, shoot.Spec.Networking.Pods))
+}
+
 // wasShootRescheduledToNewSeed returns true if the shoot.Spec.SeedName has been changed, but the migration operation has not started yet.
 func wasShootRescheduledToNewSeed(shoot *core.Shoot) bool {
 	return shoot.Status.LastOperation != nil &&
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -576,6 +577,28 @@ var _ = Describe("validator", func() {
 				}, And(HaveOccurred(), MatchError(ContainSubstring("foo")))),
 				Entry("should allow if unset", []core.Condition{}, Not(HaveOccurred())),
 			)
+
+			It("should return a warning if hibernation pre-checks failed which do not block the hibernation", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+
+				shoot.Status.Constraints = []core.Condition{
+					{
+						Type:    core.ShootHibernationPossible,
+						Status:  core.ConditionTrue,
+						Reason:  "HibernationPreChecksWarning",
+						Message: "foo",
+					},
+				}
+
+				recorder := &warningRecorder{}
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(warning.WithWarningRecorder(ctx, recorder), attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf("'HibernationPossible' constraint reports failed hibernation pre-checks: foo"))
+			})
 		})
 
 		Context("shoot maintenance checks", func() {
@@ -981,6 +1004,70 @@ var _ = Describe("validator", func() {
 
 					Expect(err).To(MatchError(ContainSubstring("a Seed's CloudProfile may only be changed to a descendant NamespacedCloudProfile")))
 				})
+
+				Context("worker pool compatibility", func() {
+					var (
+						oldShoot                           *core.Shoot
+						incompatibleNamespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+					)
+
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].Machine.Image.Version = validMachineImageVersions[0].Version
+						oldShoot = shoot.DeepCopy()
+						oldShoot.Spec.CloudProfileName = ptr.To("profile")
+						shoot.Spec.CloudProfile = &core.CloudProfileReference{
+							Kind: "NamespacedCloudProfile",
+							Name: "incompatible-namespacedprofile",
+						}
+
+						incompatibleNamespacedCloudProfile = namespacedCloudProfileBase.DeepCopy()
+						incompatibleNamespacedCloudProfile.Name = "incompatible-namespacedprofile"
+					})
+
+					It("should fail validation if the machine type is not offered by the NamespacedCloudProfile", func() {
+						incompatibleNamespacedCloudProfile.Status.CloudProfileSpec.MachineTypes = incompatibleNamespacedCloudProfile.Status.CloudProfileSpec.MachineTypes[1:]
+						Expect(coreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Informer().GetStore().Add(incompatibleNamespacedCloudProfile)).To(Succeed())
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(And(
+							ContainSubstring("spec.provider.workers[0].machine.type"),
+							ContainSubstring(`machine type is not offered by the referenced NamespacedCloudProfile "incompatible-namespacedprofile", machine types supporting CPU architecture "amd64" are: [machine-type-2]`),
+						)))
+					})
+
+					It("should fail validation if the machine image version does not support the architecture of the machine type in the NamespacedCloudProfile", func() {
+						incompatibleNamespacedCloudProfile.Status.CloudProfileSpec.MachineImages[0].Versions = []gardencorev1beta1.MachineImageVersion{
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: validMachineImageVersions[0].Version},
+								Architectures:    []string{"arm64"},
+							},
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "0.0.2"},
+								Architectures:    []string{"amd64"},
+							},
+						}
+						Expect(coreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Informer().GetStore().Add(incompatibleNamespacedCloudProfile)).To(Succeed())
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(And(
+							ContainSubstring("spec.provider.workers[0].machine.image"),
+							ContainSubstring(`machine image version 'some-machineimage:0.0.1' does not support CPU architecture "amd64" of machine type "machine-type-1" in the referenced NamespacedCloudProfile "incompatible-namespacedprofile", machine image versions supporting it are: [some-machineimage:0.0.2]`),
+						)))
+					})
+
+					It("should pass validation if the worker pools are compatible with the NamespacedCloudProfile", func() {
+						Expect(coreInformerFactory.Core().V1beta1().NamespacedCloudProfiles().Informer().GetStore().Add(incompatibleNamespacedCloudProfile)).To(Succeed())
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+				})
 			})
 
 			It("should reject because the referenced seed was not found", func() {
@@ -1165,6 +1252,36 @@ var _ = Describe("validator", func() {
 				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeTrue())
 			})
 
+			It("should add deploy infrastructure task because the pods network has changed", func() {
+				oldShoot.Spec.Networking.Pods = ptr.To("100.96.0.0/12")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeTrue())
+			})
+
+			It("should add deploy infrastructure task because the nodes network has changed", func() {
+				shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/15")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeTrue())
+			})
+
+			It("should not add deploy infrastructure task because the nodes network was initially set", func() {
+				oldShoot.Spec.Networking.Nodes = nil
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(controllerutils.HasTask(shoot.ObjectMeta.Annotations, "deployInfrastructure")).To(BeFalse())
+			})
+
 			It("should add deploy dnsrecord tasks because dns config has changed", func() {
 				shoot.Spec.DNS = &core.DNS{}
 
@@ -1899,6 +2016,37 @@ var _ = Describe("validator", func() {
 				It("update should pass because validation of network disjointedness should not be executed", func() {
 					// set shoot pod cidr to overlap with vpn pod cidr
 					shoot.Spec.Networking.Pods = ptr.To(v1beta1constants.DefaultVPNRange)
+					oldShoot.Spec.Networking.Pods = shoot.Spec.Networking.Pods
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("update should fail because validation of network disjointedness is executed for changed networks", func() {
+					// expand shoot node cidr to overlap with seed node cidr
+					shoot.Spec.Networking.Nodes = ptr.To("10.240.0.0/12")
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("spec.networking.nodes")))
+				})
+
+				It("update should pass because the changed networks are disjoint with the seed networks", func() {
+					shoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/15")
 					oldShoot.Spec.SeedName = shoot.Spec.SeedName
 
 					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
@@ -3422,6 +3570,54 @@ var _ = Describe("validator", func() {
 						}))
 					})
 
+					It("should reject defaulting the version if the machine image has no version supporting the architecture of the worker pool", func() {
+						cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{
+							{
+								Name: imageName1,
+								Versions: []gardencorev1beta1.MachineImageVersion{
+									{
+										ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: latestNonExpiredVersion},
+										Architectures:    []string{"amd64"},
+									},
+								},
+							},
+							cloudProfileMachineImages[1],
+						}
+						shoot.Spec.Provider.Workers[1].Machine.Image = &core.ShootMachineImage{
+							Name: imageName1,
+						}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("machine image %q does not provide any version supporting architecture `arm64`", imageName1))
+					})
+
+					It("should reject a machine image version if no machine image version supports the architecture of the worker pool", func() {
+						cloudProfile.Spec.MachineImages = []gardencorev1beta1.MachineImage{
+							{
+								Name: imageName1,
+								Versions: []gardencorev1beta1.MachineImageVersion{
+									{
+										ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: latestNonExpiredVersion},
+										Architectures:    []string{"amd64"},
+									},
+								},
+							},
+						}
+						shoot.Spec.Provider.Workers[1].Machine.Image = &core.ShootMachineImage{
+							Name:    imageName1,
+							Version: latestNonExpiredVersion,
+						}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("machine image version '%s:%s' does not support CPU architecture %q and the cloud profile does not offer any usable machine image version supporting it", imageName1, latestNonExpiredVersion, "arm64")))
+					})
+
 					It("should allow supported CRI and CRs", func() {
 						shoot.Spec.Provider.Workers = []core.Worker{
 							{
@@ -5046,3 +5242,11 @@ var _ = Describe("validator", func() {
 		})
 	})
 })
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (r *warningRecorder) AddWarning(_, text string) {
+	r.warnings = append(r.warnings, text)
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package applications
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	// ApplicationGuestBook is the name of the guestbook application smoke test.
+	ApplicationGuestBook = "guestbook"
+	// ApplicationStateful is the name of the stateful application smoke test.
+	ApplicationStateful = "stateful"
+	// ApplicationLoadBalancer is the name of the LoadBalancer service application smoke test.
+	ApplicationLoadBalancer = "loadbalancer"
+	// ApplicationNetworkPolicy is the name of the NetworkPolicy enforcement application smoke test.
+	ApplicationNetworkPolicy = "networkpolicy"
+)
+
+// AvailableApplications are the names of all available application smoke tests.
+var AvailableApplications = sets.New(ApplicationGuestBook, ApplicationStateful, ApplicationLoadBalancer, ApplicationNetworkPolicy)
+
+// Application is an application smoke test. It deploys an application to the shoot and verifies that it is working
+// correctly, e.g., before and after an operation like the hibernation of the shoot.
+type Application interface {
+	// Deploy deploys the application and waits until it is ready.
+	Deploy(ctx context.Context)
+	// Verify verifies that the deployed application is working correctly.
+	Verify(ctx context.Context)
+	// Cleanup cleans up all resources deployed by the application.
+	Cleanup(ctx context.Context)
+}
+
+// Config is the configuration of the application smoke tests.
+type Config struct {
+	// Applications are the names of the application smoke tests which are run.
+	Applications []string
+}
+
+// RegisterApplicationsFlags adds all flags that are needed to configure the application smoke tests to the provided
+// flagset.
+func RegisterApplicationsFlags() *Config {
+	newCfg := &Config{Applications: []string{ApplicationGuestBook}}
+
+	flag.Func("applications", fmt.Sprintf("comma-separated list of application smoke tests which are run, possible values are %s (defaults to %s)", strings.Join(sets.List(AvailableApplications), ", "), ApplicationGuestBook), func(value string) error {
+		var names []string
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			if !AvailableApplications.Has(name) {
+				return fmt.Errorf("unknown application %q, possible values are %s", name, strings.Join(sets.List(AvailableApplications), ", "))
+			}
+			names = append(names, name)
+		}
+		newCfg.Applications = names
+		return nil
+	})
+
+	return newCfg
+}
+
+// New creates the application smoke tests with the given names.
+// The tests should run inside a testframework with a registered shoot test because otherwise created resources may leak.
+func New(f *framework.ShootFramework, names ...string) ([]Application, error) {
+	applications := make([]Application, 0, len(names))
+
+	for _, name := range names {
+		var (
+			application Application
+			err         error
+		)
+
+		switch name {
+		case ApplicationGuestBook:
+			application, err = NewGuestBookTest(f)
+		case ApplicationStateful:
+			application = NewStatefulTest(f)
+		case ApplicationLoadBalancer:
+			application = NewLoadBalancerTest(f)
+		case ApplicationNetworkPolicy:
+			application = NewNetworkPolicyTest(f)
+		default:
+			return nil, fmt.Errorf("unknown application %q", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed creating application %q: %w", name, err)
+		}
+
+		applications = append(applications, application)
+	}
+
+	return applications, nil
+}
+
+func ensureTestNamespace(ctx context.Context, f *framework.ShootFramework) {
+	if f.Namespace == "" {
+		_, err := f.CreateNewNamespace(ctx)
+		framework.ExpectNoError(err)
+	}
+}
+
+func dumpOnFailure(ctx context.Context, f *framework.ShootFramework) {
+	if !ginkgo.CurrentSpecReport().Failed() {
+		return
+	}
+
+	if err := f.DumpDefaultResourcesInNamespace(ctx, f.ShootClient, f.Namespace); err != nil {
+		f.Logger.Error(err, "Unable to dump resources in namespace", "namespace", f.Namespace)
+	}
+}
+
+func deleteObjects(ctx context.Context, f *framework.ShootFramework, objects ...client.Object) {
+	for _, obj := range objects {
+		framework.ExpectNoError(client.IgnoreNotFound(f.ShootClient.Client().Delete(ctx, obj)))
+	}
+}
+
+func execute(ctx context.Context, f *framework.ShootFramework, pod *corev1.Pod, containerName, command string) (string, error) {
+	reader, err := framework.NewPodExecutor(f.ShootClient).Execute(ctx, pod.Namespace, pod.Name, containerName, command)
+	if reader == nil {
+		return "", err
+	}
+
+	output, readErr := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, string(output))
+	}
+	return string(output), readErr
+}
+
+func getRunningPod(ctx context.Context, f *framework.ShootFramework, labels map[string]string) (*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := f.ShootClient.Client().List(ctx, podList, client.InNamespace(f.Namespace), client.MatchingLabels(labels)); err != nil {
+		return nil, err
+	}
+
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			return &pod, nil
+		}
+	}
+	return nil, fmt.Errorf("no running pod found with labels %v", labels)
+}
@@ -182,6 +182,19 @@ func (t *GuestBookTest) DeployGuestBookApp(ctx context.Context) {
 	ginkgo.By("Guestbook app was deployed successfully!")
 }
 
+// Deploy deploys the guestbook application and verifies that it is working correctly.
+func (t *GuestBookTest) Deploy(ctx context.Context) {
+	t.DeployGuestBookApp(ctx)
+	t.Test(ctx)
+}
+
+// Verify waits until the guestbook application is ready and verifies that it is working correctly.
+func (t *GuestBookTest) Verify(ctx context.Context) {
+	t.WaitUntilRedisIsReady(ctx)
+	t.WaitUntilGuestbookDeploymentIsReady(ctx)
+	t.Test(ctx)
+}
+
 // Test tests that a deployed guestbook application is working correctly
 func (t *GuestBookTest) Test(ctx context.Context) {
 	shoot := t.framework.Shoot
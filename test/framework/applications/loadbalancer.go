@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework"
+)
+
+// LoadBalancerApp is the name of the k8s resources of the LoadBalancer application test.
+const LoadBalancerApp = "loadbalancer-app"
+
+// LoadBalancerTest is a simple application test for services of type LoadBalancer.
+// It deploys a web server which is exposed via a service of type LoadBalancer and checks that it is reachable via the
+// address of the load balancer.
+type LoadBalancerTest struct {
+	framework *framework.ShootFramework
+}
+
+// NewLoadBalancerTest creates a new LoadBalancer application test.
+// This test should run inside a testframework with a registered shoot test because otherwise created resources may leak.
+func NewLoadBalancerTest(f *framework.ShootFramework) *LoadBalancerTest {
+	return &LoadBalancerTest{framework: f}
+}
+
+// Deploy deploys the LoadBalancer application and waits until it is reachable.
+func (t *LoadBalancerTest) Deploy(ctx context.Context) {
+	ensureTestNamespace(ctx, t.framework)
+
+	ginkgo.By("Deploy LoadBalancer app")
+	labels := map[string]string{"app": LoadBalancerApp}
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: LoadBalancerApp, Namespace: t.framework.Namespace, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  LoadBalancerApp,
+						Image: "registry.k8s.io/e2e-test-images/agnhost:2.40",
+						Args:  []string{"netexec", "--http-port=8080"},
+						Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+					}},
+				},
+			},
+		},
+	}))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: LoadBalancerApp, Namespace: t.framework.Namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeLoadBalancer,
+			Selector: labels,
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Port:       80,
+				TargetPort: intstr.FromString("http"),
+			}},
+		},
+	}))
+
+	t.Verify(ctx)
+}
+
+// Verify verifies that the LoadBalancer application is reachable via the address of the load balancer.
+func (t *LoadBalancerTest) Verify(ctx context.Context) {
+	ginkgo.By("Verify LoadBalancer app")
+	framework.ExpectNoError(t.framework.WaitUntilDeploymentIsReady(ctx, LoadBalancerApp, t.framework.Namespace, t.framework.ShootClient))
+
+	var address string
+	framework.ExpectNoError(retry.UntilTimeout(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		service := &corev1.Service{}
+		if err := t.framework.ShootClient.Client().Get(ctx, client.ObjectKey{Name: LoadBalancerApp, Namespace: t.framework.Namespace}, service); err != nil {
+			return retry.SevereError(err)
+		}
+
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if address = ingress.Hostname; address == "" {
+				address = ingress.IP
+			}
+			if address != "" {
+				return retry.Ok()
+			}
+		}
+		return retry.MinorError(fmt.Errorf("service %s has no load balancer address yet", client.ObjectKeyFromObject(service)))
+	}))
+
+	url := "http://" + net.JoinHostPort(address, "80") + "/hostname"
+	framework.ExpectNoError(retry.UntilTimeout(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		response, err := framework.HTTPGet(ctx, url)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return retry.MinorError(fmt.Errorf("url %q responded with status code %d", url, response.StatusCode))
+		}
+		return retry.Ok()
+	}))
+}
+
+// Cleanup cleans up all resources deployed by the LoadBalancer application test.
+func (t *LoadBalancerTest) Cleanup(ctx context.Context) {
+	dumpOnFailure(ctx, t.framework)
+
+	ginkgo.By("Clean up LoadBalancer app resources")
+	// Delete the service first so that the load balancer in the infrastructure is released.
+	deleteObjects(ctx, t.framework,
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: LoadBalancerApp, Namespace: t.framework.Namespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: LoadBalancerApp, Namespace: t.framework.Namespace}},
+	)
+}
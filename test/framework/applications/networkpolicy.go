@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	// NetworkPolicyServer is the name of the k8s resources of the server of the NetworkPolicy application test.
+	NetworkPolicyServer = "networkpolicy-server"
+	// NetworkPolicyAllowedClient is the name of the client which is allowed to reach the server.
+	NetworkPolicyAllowedClient = "networkpolicy-client-allowed"
+	// NetworkPolicyDeniedClient is the name of the client which is not allowed to reach the server.
+	NetworkPolicyDeniedClient = "networkpolicy-client-denied"
+
+	networkPolicyServerPort = 8080
+)
+
+// NetworkPolicyTest is a simple application test for the enforcement of NetworkPolicies.
+// It deploys a server which only allows ingress traffic from one of two clients and checks that only this client can
+// reach the server.
+type NetworkPolicyTest struct {
+	framework *framework.ShootFramework
+}
+
+// NewNetworkPolicyTest creates a new NetworkPolicy application test.
+// This test should run inside a testframework with a registered shoot test because otherwise created resources may leak.
+func NewNetworkPolicyTest(f *framework.ShootFramework) *NetworkPolicyTest {
+	return &NetworkPolicyTest{framework: f}
+}
+
+// Deploy deploys the server, the clients and the NetworkPolicy and verifies that the policy is enforced.
+func (t *NetworkPolicyTest) Deploy(ctx context.Context) {
+	ensureTestNamespace(ctx, t.framework)
+
+	ginkgo.By("Deploy NetworkPolicy app")
+	serverLabels := map[string]string{"app": NetworkPolicyServer}
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, t.deployment(NetworkPolicyServer, []string{"netexec", fmt.Sprintf("--http-port=%d", networkPolicyServerPort)})))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyServer, Namespace: t.framework.Namespace, Labels: serverLabels},
+		Spec: corev1.ServiceSpec{
+			Selector: serverLabels,
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Port:       networkPolicyServerPort,
+				TargetPort: intstr.FromInt32(networkPolicyServerPort),
+			}},
+		},
+	}))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, t.deployment(NetworkPolicyAllowedClient, []string{"pause"})))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, t.deployment(NetworkPolicyDeniedClient, []string{"pause"})))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyServer, Namespace: t.framework.Namespace},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: serverLabels},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": NetworkPolicyAllowedClient}},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Protocol: ptr.To(corev1.ProtocolTCP),
+					Port:     ptr.To(intstr.FromInt32(networkPolicyServerPort)),
+				}},
+			}},
+		},
+	}))
+
+	t.Verify(ctx)
+}
+
+// Verify verifies that the server is reachable from the allowed client only.
+func (t *NetworkPolicyTest) Verify(ctx context.Context) {
+	ginkgo.By("Verify NetworkPolicy app")
+	for _, name := range []string{NetworkPolicyServer, NetworkPolicyAllowedClient, NetworkPolicyDeniedClient} {
+		framework.ExpectNoError(t.framework.WaitUntilDeploymentIsReady(ctx, name, t.framework.Namespace, t.framework.ShootClient))
+	}
+
+	command := fmt.Sprintf("curl --fail --silent --max-time 5 http://%s:%d/hostname", NetworkPolicyServer, networkPolicyServerPort)
+
+	framework.ExpectNoError(retry.UntilTimeout(ctx, 5*time.Second, 3*time.Minute, func(ctx context.Context) (bool, error) {
+		pod, err := getRunningPod(ctx, t.framework, map[string]string{"app": NetworkPolicyAllowedClient})
+		if err != nil {
+			return retry.MinorError(err)
+		}
+
+		if _, err := execute(ctx, t.framework, pod, NetworkPolicyAllowedClient, command); err != nil {
+			return retry.MinorError(fmt.Errorf("allowed client cannot reach the server: %w", err))
+		}
+		return retry.Ok()
+	}))
+
+	framework.ExpectNoError(retry.UntilTimeout(ctx, 5*time.Second, 3*time.Minute, func(ctx context.Context) (bool, error) {
+		pod, err := getRunningPod(ctx, t.framework, map[string]string{"app": NetworkPolicyDeniedClient})
+		if err != nil {
+			return retry.MinorError(err)
+		}
+
+		if _, err := execute(ctx, t.framework, pod, NetworkPolicyDeniedClient, command); err == nil {
+			return retry.MinorError(fmt.Errorf("denied client can reach the server although it is not allowed by the NetworkPolicy"))
+		}
+		return retry.Ok()
+	}))
+}
+
+// Cleanup cleans up all resources deployed by the NetworkPolicy application test.
+func (t *NetworkPolicyTest) Cleanup(ctx context.Context) {
+	dumpOnFailure(ctx, t.framework)
+
+	ginkgo.By("Clean up NetworkPolicy app resources")
+	deleteObjects(ctx, t.framework,
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyServer, Namespace: t.framework.Namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyServer, Namespace: t.framework.Namespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyServer, Namespace: t.framework.Namespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyAllowedClient, Namespace: t.framework.Namespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: NetworkPolicyDeniedClient, Namespace: t.framework.Namespace}},
+	)
+}
+
+func (t *NetworkPolicyTest) deployment(name string, args []string) client.Object {
+	labels := map[string]string{"app": name}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: t.framework.Namespace, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  name,
+						Image: "registry.k8s.io/e2e-test-images/agnhost:2.40",
+						Args:  args,
+					}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	// StatefulApp is the name of the k8s resources of the stateful application test.
+	StatefulApp = "stateful-app"
+
+	statefulAppVolumeName = "data"
+	statefulAppMarkerFile = "/data/marker"
+)
+
+// StatefulTest is a simple application test for persistent volumes.
+// It deploys a StatefulSet with a PersistentVolumeClaim and writes a marker to the volume which is expected to survive
+// restarts of the pod, e.g., when the shoot is hibernated and woken up again.
+type StatefulTest struct {
+	framework *framework.ShootFramework
+
+	marker string
+}
+
+// NewStatefulTest creates a new stateful application test.
+// This test should run inside a testframework with a registered shoot test because otherwise created resources may leak.
+func NewStatefulTest(f *framework.ShootFramework) *StatefulTest {
+	return &StatefulTest{
+		framework: f,
+		marker:    utils.ComputeSHA256Hex([]byte(time.Now().String()))[:16],
+	}
+}
+
+// Deploy deploys the stateful application and waits until it has written the marker to its volume.
+func (t *StatefulTest) Deploy(ctx context.Context) {
+	ensureTestNamespace(ctx, t.framework)
+
+	storage := "1Gi"
+	if t.framework.Shoot.Spec.Provider.Type == "alicloud" {
+		// AliCloud requires a minimum of 20 GB for its PVCs
+		storage = "20Gi"
+	}
+
+	ginkgo.By("Deploy stateful app")
+	labels := map[string]string{"app": StatefulApp}
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: StatefulApp, Namespace: t.framework.Namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  labels,
+		},
+	}))
+	framework.ExpectNoError(t.framework.ShootClient.Client().Create(ctx, &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: StatefulApp, Namespace: t.framework.Namespace, Labels: labels},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    ptr.To[int32](1),
+			ServiceName: StatefulApp,
+			Selector:    &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    StatefulApp,
+						Image:   "registry.k8s.io/e2e-test-images/busybox:1.29-4",
+						Command: []string{"sh", "-c", fmt.Sprintf("[ -f %[1]s ] || echo %[2]s > %[1]s; sleep 3600000", statefulAppMarkerFile, t.marker)},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      statefulAppVolumeName,
+							MountPath: "/data",
+						}},
+					}},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: statefulAppVolumeName},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)},
+					},
+				},
+			}},
+		},
+	}))
+
+	t.Verify(ctx)
+}
+
+// Verify verifies that the stateful application is running and that the marker on its volume is still present.
+func (t *StatefulTest) Verify(ctx context.Context) {
+	ginkgo.By("Verify stateful app")
+	framework.ExpectNoError(t.framework.WaitUntilStatefulSetIsRunning(ctx, StatefulApp, t.framework.Namespace, t.framework.ShootClient))
+
+	framework.ExpectNoError(retry.UntilTimeout(ctx, 10*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		pod, err := getRunningPod(ctx, t.framework, map[string]string{"app": StatefulApp})
+		if err != nil {
+			return retry.MinorError(err)
+		}
+
+		output, err := execute(ctx, t.framework, pod, StatefulApp, "cat "+statefulAppMarkerFile)
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading marker from volume: %w", err))
+		}
+		if marker := strings.TrimSpace(output); marker != t.marker {
+			return retry.SevereError(fmt.Errorf("marker on volume is %q but expected %q", marker, t.marker))
+		}
+		return retry.Ok()
+	}))
+}
+
+// Cleanup cleans up all resources deployed by the stateful application test.
+func (t *StatefulTest) Cleanup(ctx context.Context) {
+	dumpOnFailure(ctx, t.framework)
+
+	ginkgo.By("Clean up stateful app resources")
+	deleteObjects(ctx, t.framework,
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: StatefulApp, Namespace: t.framework.Namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: StatefulApp, Namespace: t.framework.Namespace}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: statefulAppVolumeName + "-" + StatefulApp + "-0", Namespace: t.framework.Namespace}},
+	)
+}
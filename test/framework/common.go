@@ -33,6 +33,10 @@ const (
 	// TestMachineryTestRunIDEnvVarName is the name of the environment variable that holds the testrun ID.
 	TestMachineryTestRunIDEnvVarName = "TM_TESTRUN_ID"
 
+	// TestMachinerySharedPathEnvVarName is the name of the environment variable that holds the path to the directory
+	// which is shared between the steps of a testrun.
+	TestMachinerySharedPathEnvVarName = "TM_SHARED_PATH"
+
 	// SeedTaintTestRun is the taint used to limit shoots that can be scheduled on a seed to shoots created by the same testrun.
 	SeedTaintTestRun = "test.gardener.cloud/test-run"
 )
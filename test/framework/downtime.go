@@ -0,0 +1,359 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// DowntimeProbe is a named check which is continuously executed by a DowntimeMonitor. A failing check is considered as
+// downtime of the probed endpoint.
+type DowntimeProbe struct {
+	// Name is the name of the probe used in the report.
+	Name string
+	// Check performs a single request against the probed endpoint.
+	Check func(ctx context.Context) error
+}
+
+// KubeAPIServerDowntimeProbe returns a probe which issues authenticated requests against the kube-apiserver of the
+// given client. The requests bypass any cache of the client.
+func KubeAPIServerDowntimeProbe(c kubernetes.Interface) DowntimeProbe {
+	return DowntimeProbe{
+		Name: "kube-apiserver",
+		Check: func(ctx context.Context) error {
+			return c.APIReader().Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, &corev1.Namespace{})
+		},
+	}
+}
+
+// HTTPDowntimeProbe returns a probe which issues GET requests against the given URL, e.g. a workload endpoint exposed
+// by the shoot. Every response with a status code other than 200 is considered as failure.
+func HTTPDowntimeProbe(name, url string) DowntimeProbe {
+	return DowntimeProbe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			resp, err := HTTPGet(ctx, url)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("http request should return %d but returned %d instead", http.StatusOK, resp.StatusCode)
+			}
+			return nil
+		},
+	}
+}
+
+// DowntimeThresholds are the thresholds which must not be exceeded by any probe of a DowntimeMonitor. Thresholds which
+// are not set are not validated.
+type DowntimeThresholds struct {
+	// MaxDowntime is the maximum duration of a single outage.
+	MaxDowntime *time.Duration
+	// MaxTotalDowntime is the maximum accumulated duration of all outages.
+	MaxTotalDowntime *time.Duration
+	// MaxErrorRate is the maximum ratio of failed requests, i.e. a value between 0 and 1.
+	MaxErrorRate *float64
+}
+
+// DowntimeConfig is the configuration for measuring the downtime of a shoot during disruptive operations.
+type DowntimeConfig struct {
+	// Enabled specifies whether the downtime is measured.
+	Enabled bool
+	// Interval is the interval in which the probes are executed.
+	Interval time.Duration
+	// Timeout is the timeout of a single request.
+	Timeout time.Duration
+	// WorkloadURL is the URL of a workload endpoint exposed by the shoot which is probed in addition to the
+	// kube-apiserver.
+	WorkloadURL string
+	// Thresholds are the thresholds which must not be exceeded.
+	Thresholds DowntimeThresholds
+}
+
+// RegisterDowntimeFlags adds all flags that are needed to configure the downtime measurement to the provided flagset.
+func RegisterDowntimeFlags() *DowntimeConfig {
+	newCfg := &DowntimeConfig{}
+
+	flag.BoolVar(&newCfg.Enabled, "measure-downtime", false, "if set to true then the downtime of the shoot's kube-apiserver (and workload) is measured during disruptive operations")
+	flag.DurationVar(&newCfg.Interval, "downtime-probe-interval", 1*time.Second, "interval in which the downtime probes are executed")
+	flag.DurationVar(&newCfg.Timeout, "downtime-probe-timeout", 5*time.Second, "timeout of a single downtime probe request")
+	flag.StringVar(&newCfg.WorkloadURL, "downtime-workload-url", "", "URL of a workload endpoint exposed by the shoot which is probed in addition to the kube-apiserver")
+	flag.Func("max-downtime", "maximum duration of a single outage (not validated if unset)", func(value string) error {
+		d, err := time.ParseDuration(value)
+		newCfg.Thresholds.MaxDowntime = &d
+		return err
+	})
+	flag.Func("max-total-downtime", "maximum accumulated duration of all outages (not validated if unset)", func(value string) error {
+		d, err := time.ParseDuration(value)
+		newCfg.Thresholds.MaxTotalDowntime = &d
+		return err
+	})
+	flag.Func("max-error-rate", "maximum ratio of failed requests between 0 and 1 (not validated if unset)", func(value string) error {
+		rate, err := strconv.ParseFloat(value, 64)
+		newCfg.Thresholds.MaxErrorRate = &rate
+		return err
+	})
+
+	return newCfg
+}
+
+// MeasureDowntime executes the given disruptive operation while probing the shoot's kube-apiserver (and the configured
+// workload endpoint). Afterwards, it logs the downtime report and validates it against the configured thresholds.
+// If the downtime measurement is not enabled, only the operation is executed.
+func (f *ShootFramework) MeasureDowntime(ctx context.Context, cfg *DowntimeConfig, operation func(ctx context.Context)) error {
+	if cfg == nil || !cfg.Enabled {
+		operation(ctx)
+		return nil
+	}
+
+	probes := []DowntimeProbe{KubeAPIServerDowntimeProbe(f.ShootClient)}
+	if cfg.WorkloadURL != "" {
+		probes = append(probes, HTTPDowntimeProbe("workload", cfg.WorkloadURL))
+	}
+
+	monitor := NewDowntimeMonitor(f.Logger, cfg.Interval, cfg.Timeout, probes...)
+	monitor.Start(ctx)
+
+	var report *DowntimeReport
+	func() {
+		// stop the monitor even if the operation fails the test
+		defer func() {
+			report = monitor.Stop()
+			f.Logger.Info("Downtime report", "report", report.String())
+		}()
+		operation(ctx)
+	}()
+
+	return report.Validate(cfg.Thresholds)
+}
+
+// DowntimeMonitor continuously executes probes while disruptive operations are performed, e.g. reconciliations,
+// credentials rotations, migrations, or hibernations, and reports the observed downtimes and error rates.
+type DowntimeMonitor struct {
+	log      logr.Logger
+	interval time.Duration
+	timeout  time.Duration
+	probes   []DowntimeProbe
+
+	lock    sync.Mutex
+	results map[string]*ProbeReport
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	now     func() time.Time
+}
+
+// NewDowntimeMonitor creates a new DowntimeMonitor which executes the given probes in the given interval. Every
+// request fails if it does not succeed within the given timeout.
+func NewDowntimeMonitor(log logr.Logger, interval, timeout time.Duration, probes ...DowntimeProbe) *DowntimeMonitor {
+	return &DowntimeMonitor{
+		log:      log.WithName("downtime-monitor"),
+		interval: interval,
+		timeout:  timeout,
+		probes:   probes,
+		results:  make(map[string]*ProbeReport, len(probes)),
+		now:      time.Now,
+	}
+}
+
+// Start starts executing the probes in the background until Stop is called or the given context is cancelled.
+func (m *DowntimeMonitor) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+
+	for _, probe := range m.probes {
+		m.results[probe.Name] = &ProbeReport{Name: probe.Name}
+
+		m.wg.Add(1)
+		go func(probe DowntimeProbe) {
+			defer m.wg.Done()
+
+			ticker := time.NewTicker(m.interval)
+			defer ticker.Stop()
+
+			for {
+				m.probe(ctx, probe)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(probe)
+	}
+}
+
+// Stop stops executing the probes and returns the report of the observed downtimes.
+func (m *DowntimeMonitor) Stop() *DowntimeReport {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	report := &DowntimeReport{}
+	for _, probe := range m.probes {
+		result := m.results[probe.Name]
+		if result == nil {
+			continue
+		}
+
+		// close outages which are still ongoing
+		if n := len(result.Outages); n > 0 && result.Outages[n-1].End.IsZero() {
+			result.Outages[n-1].End = m.now()
+		}
+		report.Probes = append(report.Probes, *result)
+	}
+
+	return report
+}
+
+func (m *DowntimeMonitor) probe(ctx context.Context, probe DowntimeProbe) {
+	checkCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	err := probe.Check(checkCtx)
+	if ctx.Err() != nil {
+		// the monitor was stopped while the request was in flight, hence the result is not meaningful
+		return
+	}
+	m.record(probe.Name, m.now(), err)
+}
+
+func (m *DowntimeMonitor) record(name string, timestamp time.Time, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := m.results[name]
+	result.Requests++
+
+	n := len(result.Outages)
+	ongoing := n > 0 && result.Outages[n-1].End.IsZero()
+
+	if err == nil {
+		if ongoing {
+			result.Outages[n-1].End = timestamp
+			m.log.Info("Probe succeeded again", "probe", name, "downtime", result.Outages[n-1].Duration())
+		}
+		return
+	}
+
+	result.Failures++
+	if !ongoing {
+		m.log.Info("Probe failed", "probe", name, "error", err.Error())
+		result.Outages = append(result.Outages, Outage{Start: timestamp, Error: err.Error()})
+	}
+}
+
+// DowntimeReport contains the results of all probes of a DowntimeMonitor.
+type DowntimeReport struct {
+	// Probes contains the results of the probes.
+	Probes []ProbeReport
+}
+
+// ProbeReport contains the results of a single probe.
+type ProbeReport struct {
+	// Name is the name of the probe.
+	Name string
+	// Requests is the number of executed requests.
+	Requests int
+	// Failures is the number of failed requests.
+	Failures int
+	// Outages are the periods of consecutive failed requests.
+	Outages []Outage
+}
+
+// Outage is a period of consecutive failed requests.
+type Outage struct {
+	// Start is the time of the first failed request.
+	Start time.Time
+	// End is the time of the next successful request.
+	End time.Time
+	// Error is the error of the first failed request.
+	Error string
+}
+
+// Duration returns the duration of the outage.
+func (o Outage) Duration() time.Duration {
+	return o.End.Sub(o.Start)
+}
+
+// ErrorRate returns the ratio of failed requests.
+func (p ProbeReport) ErrorRate() float64 {
+	if p.Requests == 0 {
+		return 0
+	}
+	return float64(p.Failures) / float64(p.Requests)
+}
+
+// MaxDowntime returns the duration of the longest outage.
+func (p ProbeReport) MaxDowntime() time.Duration {
+	var longest time.Duration
+	for _, outage := range p.Outages {
+		if d := outage.Duration(); d > longest {
+			longest = d
+		}
+	}
+	return longest
+}
+
+// TotalDowntime returns the accumulated duration of all outages.
+func (p ProbeReport) TotalDowntime() time.Duration {
+	var total time.Duration
+	for _, outage := range p.Outages {
+		total += outage.Duration()
+	}
+	return total
+}
+
+// String returns a human-readable summary of the report.
+func (r *DowntimeReport) String() string {
+	var b strings.Builder
+	for _, p := range r.Probes {
+		fmt.Fprintf(&b, "%s: %d requests, %d failures (error rate %.2f%%), %d outages, max downtime %s, total downtime %s\n",
+			p.Name, p.Requests, p.Failures, 100*p.ErrorRate(), len(p.Outages), p.MaxDowntime(), p.TotalDowntime())
+		for _, outage := range p.Outages {
+			fmt.Fprintf(&b, "  - %s - %s (%s): %s\n", outage.Start.Format(time.RFC3339), outage.End.Format(time.RFC3339), outage.Duration(), outage.Error)
+		}
+	}
+	return b.String()
+}
+
+// Validate returns an error if any probe of the report exceeds the given thresholds.
+func (r *DowntimeReport) Validate(thresholds DowntimeThresholds) error {
+	var errs []error
+
+	for _, p := range r.Probes {
+		if thresholds.MaxDowntime != nil && p.MaxDowntime() > *thresholds.MaxDowntime {
+			errs = append(errs, fmt.Errorf("probe %q exceeded the maximum downtime: %s > %s", p.Name, p.MaxDowntime(), *thresholds.MaxDowntime))
+		}
+		if thresholds.MaxTotalDowntime != nil && p.TotalDowntime() > *thresholds.MaxTotalDowntime {
+			errs = append(errs, fmt.Errorf("probe %q exceeded the maximum total downtime: %s > %s", p.Name, p.TotalDowntime(), *thresholds.MaxTotalDowntime))
+		}
+		if thresholds.MaxErrorRate != nil && p.ErrorRate() > *thresholds.MaxErrorRate {
+			errs = append(errs, fmt.Errorf("probe %q exceeded the maximum error rate: %.4f > %.4f", p.Name, p.ErrorRate(), *thresholds.MaxErrorRate))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Downtime tests", func() {
+	var (
+		ctx = context.TODO()
+
+		healthy atomic.Bool
+		probe   framework.DowntimeProbe
+	)
+
+	BeforeEach(func() {
+		healthy.Store(true)
+		probe = framework.DowntimeProbe{
+			Name: "test",
+			Check: func(_ context.Context) error {
+				if healthy.Load() {
+					return nil
+				}
+				return errors.New("unhealthy")
+			},
+		}
+	})
+
+	Describe("#DowntimeMonitor", func() {
+		It("should report no outage if all requests succeed", func() {
+			monitor := framework.NewDowntimeMonitor(logr.Discard(), 5*time.Millisecond, time.Second, probe)
+			monitor.Start(ctx)
+
+			time.Sleep(20 * time.Millisecond)
+
+			report := monitor.Stop()
+			Expect(report.Probes).To(ConsistOf(And(
+				HaveField("Name", "test"),
+				HaveField("Requests", BeNumerically(">", 0)),
+				HaveField("Failures", 0),
+				HaveField("Outages", BeEmpty()),
+			)))
+			Expect(report.Validate(framework.DowntimeThresholds{MaxDowntime: ptr.To(time.Duration(0)), MaxErrorRate: ptr.To(0.0)})).To(Succeed())
+		})
+
+		It("should report an outage while requests fail", func() {
+			monitor := framework.NewDowntimeMonitor(logr.Discard(), 5*time.Millisecond, time.Second, probe)
+			monitor.Start(ctx)
+
+			time.Sleep(20 * time.Millisecond)
+			healthy.Store(false)
+			time.Sleep(50 * time.Millisecond)
+			healthy.Store(true)
+			time.Sleep(20 * time.Millisecond)
+
+			report := monitor.Stop()
+			Expect(report.Probes).To(HaveLen(1))
+			Expect(report.Probes[0].Failures).To(BeNumerically(">", 0))
+			Expect(report.Probes[0].Outages).To(ConsistOf(HaveField("Error", "unhealthy")))
+			Expect(report.Probes[0].MaxDowntime()).To(BeNumerically(">=", 40*time.Millisecond))
+			Expect(report.Validate(framework.DowntimeThresholds{MaxDowntime: ptr.To(10 * time.Millisecond)})).To(MatchError(ContainSubstring(`probe "test" exceeded the maximum downtime`)))
+			Expect(report.Validate(framework.DowntimeThresholds{MaxDowntime: ptr.To(time.Minute)})).To(Succeed())
+		})
+
+		It("should close ongoing outages when stopped", func() {
+			healthy.Store(false)
+			monitor := framework.NewDowntimeMonitor(logr.Discard(), 5*time.Millisecond, time.Second, probe)
+			monitor.Start(ctx)
+
+			time.Sleep(20 * time.Millisecond)
+
+			report := monitor.Stop()
+			Expect(report.Probes[0].Outages).To(HaveLen(1))
+			Expect(report.Probes[0].Outages[0].End).NotTo(BeZero())
+			Expect(report.Probes[0].ErrorRate()).To(Equal(1.0))
+			Expect(report.Validate(framework.DowntimeThresholds{MaxErrorRate: ptr.To(0.5)})).To(MatchError(ContainSubstring(`probe "test" exceeded the maximum error rate`)))
+		})
+	})
+
+	Describe("#DowntimeReport", func() {
+		It("should compute the downtimes and error rate", func() {
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			report := &framework.DowntimeReport{Probes: []framework.ProbeReport{{
+				Name:     "test",
+				Requests: 10,
+				Failures: 4,
+				Outages: []framework.Outage{
+					{Start: start, End: start.Add(10 * time.Second), Error: "foo"},
+					{Start: start.Add(time.Minute), End: start.Add(time.Minute + 30*time.Second), Error: "bar"},
+				},
+			}}}
+
+			Expect(report.Probes[0].ErrorRate()).To(Equal(0.4))
+			Expect(report.Probes[0].MaxDowntime()).To(Equal(30 * time.Second))
+			Expect(report.Probes[0].TotalDowntime()).To(Equal(40 * time.Second))
+			Expect(report.String()).To(ContainSubstring("test: 10 requests, 4 failures (error rate 40.00%), 2 outages, max downtime 30s, total downtime 40s"))
+
+			Expect(report.Validate(framework.DowntimeThresholds{
+				MaxDowntime:      ptr.To(time.Minute),
+				MaxTotalDowntime: ptr.To(time.Minute),
+				MaxErrorRate:     ptr.To(0.5),
+			})).To(Succeed())
+			Expect(report.Validate(framework.DowntimeThresholds{
+				MaxDowntime:      ptr.To(20 * time.Second),
+				MaxTotalDowntime: ptr.To(30 * time.Second),
+				MaxErrorRate:     ptr.To(0.1),
+			})).To(MatchError(And(
+				ContainSubstring("exceeded the maximum downtime"),
+				ContainSubstring("exceeded the maximum total downtime"),
+				ContainSubstring("exceeded the maximum error rate"),
+			)))
+		})
+	})
+
+	Describe("#HTTPDowntimeProbe", func() {
+		It("should fail for non-200 responses", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if healthy.Load() {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			probe := framework.HTTPDowntimeProbe("workload", server.URL)
+			Expect(probe.Check(ctx)).To(Succeed())
+
+			healthy.Store(false)
+			Expect(probe.Check(ctx)).To(MatchError(ContainSubstring("returned 503")))
+		})
+	})
+})
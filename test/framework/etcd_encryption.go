@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/test/utils/rotation"
+)
+
+const (
+	// ETCDEncryptionMarkerSecretName is the name of the secret in the `default` namespace of the shoot which is written
+	// before the etcd encryption key rotation in order to verify that it is re-encrypted with the new key.
+	ETCDEncryptionMarkerSecretName = "etcd-encryption-marker"
+
+	// etcdEncryptionPrefixAESCBC is the prefix of values in etcd which were encrypted by the kube-apiserver with the
+	// aescbc provider. It is followed by the name of the encryption key and a colon.
+	etcdEncryptionPrefixAESCBC = "k8s:enc:aescbc:v1:"
+
+	etcdEncryptionConfigurationDataKey = "encryption-configuration.yaml"
+	etcdClientSecretName               = "etcd-client"
+	etcdServerName                     = "etcd-main-local"
+	etcdDialTimeout                    = 30 * time.Second
+)
+
+var encryptionConfigurationDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(apiserverconfigv1.AddToScheme(scheme))
+	encryptionConfigurationDecoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// StartETCDEncryptionKeyRotation starts the etcd encryption key rotation by annotating the shoot with the
+// 'rotate-etcd-encryption-key-start' operation. It waits until the shoot was reconciled and refreshes the shoot of the
+// framework afterwards.
+func (f *ShootFramework) StartETCDEncryptionKeyRotation(ctx context.Context) error {
+	return f.triggerETCDEncryptionKeyRotation(ctx, v1beta1constants.OperationRotateETCDEncryptionKeyStart)
+}
+
+// CompleteETCDEncryptionKeyRotation completes the etcd encryption key rotation by annotating the shoot with the
+// 'rotate-etcd-encryption-key-complete' operation. It waits until the shoot was reconciled and refreshes the shoot of
+// the framework afterwards.
+func (f *ShootFramework) CompleteETCDEncryptionKeyRotation(ctx context.Context) error {
+	return f.triggerETCDEncryptionKeyRotation(ctx, v1beta1constants.OperationRotateETCDEncryptionKeyComplete)
+}
+
+func (f *ShootFramework) triggerETCDEncryptionKeyRotation(ctx context.Context, operation string) error {
+	if err := f.UpdateShoot(ctx, func(shoot *gardencorev1beta1.Shoot) error {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return f.GetShoot(ctx, f.Shoot)
+}
+
+// CreateETCDEncryptionMarkerSecret writes the marker secret to the shoot. An already existing marker secret, e.g. from
+// a previous test run, is returned as is.
+func (f *ShootFramework) CreateETCDEncryptionMarkerSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ETCDEncryptionMarkerSecretName,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string][]byte{"marker": []byte(f.Shoot.Name)},
+	}
+
+	if err := f.ShootClient.Client().Create(ctx, secret); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, err
+		}
+		if err := f.ShootClient.Client().Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return secret, nil
+}
+
+// GetETCDEncryptionKeyName returns the name of the key which is currently used by the kube-apiserver of the shoot for
+// encrypting data in etcd, i.e. the first key of the newest etcd encryption configuration in the seed.
+func (f *ShootFramework) GetETCDEncryptionKeyName(ctx context.Context) (string, error) {
+	secretList := &corev1.SecretList{}
+	if err := f.SeedClient.Client().List(ctx, secretList, client.InNamespace(f.ShootSeedNamespace()), client.MatchingLabels{
+		v1beta1constants.LabelRole: v1beta1constants.SecretNamePrefixETCDEncryptionConfiguration,
+	}); err != nil {
+		return "", fmt.Errorf("failed listing etcd encryption configuration secrets: %w", err)
+	}
+	if len(secretList.Items) == 0 {
+		return "", fmt.Errorf("no etcd encryption configuration secret found in namespace %s", f.ShootSeedNamespace())
+	}
+	sort.Sort(sort.Reverse(rotation.AgeSorter(secretList.Items)))
+
+	encryptionConfiguration := &apiserverconfigv1.EncryptionConfiguration{}
+	if err := runtime.DecodeInto(encryptionConfigurationDecoder, secretList.Items[0].Data[etcdEncryptionConfigurationDataKey], encryptionConfiguration); err != nil {
+		return "", fmt.Errorf("failed decoding etcd encryption configuration of secret %s: %w", client.ObjectKeyFromObject(&secretList.Items[0]), err)
+	}
+
+	for _, resource := range encryptionConfiguration.Resources {
+		for _, provider := range resource.Providers {
+			if provider.AESCBC != nil && len(provider.AESCBC.Keys) > 0 {
+				return provider.AESCBC.Keys[0].Name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no aescbc key found in etcd encryption configuration of secret %s", client.ObjectKeyFromObject(&secretList.Items[0]))
+}
+
+// GetETCDEncryptionKeyNameOfSecret reads the given secret of the shoot directly from the main etcd in the seed and
+// returns the name of the key which was used for encrypting it.
+func (f *ShootFramework) GetETCDEncryptionKeyNameOfSecret(ctx context.Context, key client.ObjectKey) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	etcdClient, err := f.newETCDMainClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer func() { utilruntime.HandleError(etcdClient.Close()) }()
+
+	etcdKey := fmt.Sprintf("/registry/secrets/%s/%s", key.Namespace, key.Name)
+	response, err := etcdClient.Get(ctx, etcdKey)
+	if err != nil {
+		return "", fmt.Errorf("failed reading %s from etcd: %w", etcdKey, err)
+	}
+	if len(response.Kvs) == 0 {
+		return "", fmt.Errorf("key %s not found in etcd", etcdKey)
+	}
+
+	return ParseETCDEncryptionKeyName(response.Kvs[0].Value)
+}
+
+// VerifyETCDEncryptionMarkerSecret verifies that the given secret is stored in etcd encrypted with the key which is
+// currently used by the kube-apiserver of the shoot, i.e. that it was re-encrypted after the key was rotated.
+func (f *ShootFramework) VerifyETCDEncryptionMarkerSecret(ctx context.Context, secret *corev1.Secret) error {
+	expectedKeyName, err := f.GetETCDEncryptionKeyName(ctx)
+	if err != nil {
+		return err
+	}
+
+	keyName, err := f.GetETCDEncryptionKeyNameOfSecret(ctx, client.ObjectKeyFromObject(secret))
+	if err != nil {
+		return err
+	}
+
+	if keyName != expectedKeyName {
+		return fmt.Errorf("secret %s is encrypted with key %q instead of the current key %q", client.ObjectKeyFromObject(secret), keyName, expectedKeyName)
+	}
+	return nil
+}
+
+// ParseETCDEncryptionKeyName returns the name of the key which was used for encrypting the given raw etcd value with
+// the aescbc provider of the kube-apiserver.
+func ParseETCDEncryptionKeyName(value []byte) (string, error) {
+	if !bytes.HasPrefix(value, []byte(etcdEncryptionPrefixAESCBC)) {
+		return "", fmt.Errorf("value is not encrypted with the aescbc provider")
+	}
+
+	keyName, _, found := bytes.Cut(bytes.TrimPrefix(value, []byte(etcdEncryptionPrefixAESCBC)), []byte(":"))
+	if !found || len(keyName) == 0 {
+		return "", fmt.Errorf("value does not contain the name of the encryption key")
+	}
+	return string(keyName), nil
+}
+
+// newETCDMainClient forwards a local port to the client port of the main etcd of the shoot in the seed and returns a
+// client for it. The port forwarding is stopped when the given context is cancelled.
+func (f *ShootFramework) newETCDMainClient(ctx context.Context) (*clientv3.Client, error) {
+	tlsConfig, err := f.etcdClientTLSConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	localPort, err := utils.FindFreePort()
+	if err != nil {
+		return nil, err
+	}
+
+	podName := fmt.Sprintf("etcd-%s-0", v1beta1constants.ETCDRoleMain)
+	fw, err := kubernetes.SetupPortForwarder(ctx, f.SeedClient.RESTConfig(), f.ShootSeedNamespace(), podName, localPort, int(etcdconstants.PortEtcdClient))
+	if err != nil {
+		return nil, fmt.Errorf("could not setup port forwarding to pod %s: %w", podName, err)
+	}
+	if err := kubernetes.CheckForwardPodPort(fw); err != nil {
+		return nil, err
+	}
+
+	return clientv3.New(clientv3.Config{
+		Context:     ctx,
+		Endpoints:   []string{fmt.Sprintf("localhost:%d", localPort)},
+		DialTimeout: etcdDialTimeout,
+		TLS:         tlsConfig,
+	})
+}
+
+func (f *ShootFramework) etcdClientTLSConfig(ctx context.Context) (*tls.Config, error) {
+	caBundleSecret, err := f.getNewestGardenletSecret(ctx, v1beta1constants.SecretNameCAETCD+"-bundle")
+	if err != nil {
+		return nil, err
+	}
+
+	clientSecret, err := f.getNewestGardenletSecret(ctx, etcdClientSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]) {
+		return nil, fmt.Errorf("no certificates found in etcd CA bundle secret %s", client.ObjectKeyFromObject(caBundleSecret))
+	}
+
+	clientCertificate, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing etcd client certificate of secret %s: %w", client.ObjectKeyFromObject(clientSecret), err)
+	}
+
+	return &tls.Config{
+		RootCAs:      certPool,
+		Certificates: []tls.Certificate{clientCertificate},
+		ServerName:   etcdServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (f *ShootFramework) getNewestGardenletSecret(ctx context.Context, name string) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := f.SeedClient.Client().List(ctx, secretList, client.InNamespace(f.ShootSeedNamespace()), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            name,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: v1beta1constants.SecretManagerIdentityGardenlet,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets with name %s: %w", name, err)
+	}
+	if len(secretList.Items) == 0 {
+		return nil, fmt.Errorf("no secret with name %s found in namespace %s", name, f.ShootSeedNamespace())
+	}
+
+	sort.Sort(sort.Reverse(rotation.AgeSorter(secretList.Items)))
+	return &secretList.Items[0], nil
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("ETCD encryption tests", func() {
+	Describe("#ParseETCDEncryptionKeyName", func() {
+		It("should return the name of the encryption key", func() {
+			Expect(framework.ParseETCDEncryptionKeyName([]byte("k8s:enc:aescbc:v1:key1234567890:\x01\x02"))).To(Equal("key1234567890"))
+		})
+
+		It("should fail if the value is not encrypted", func() {
+			_, err := framework.ParseETCDEncryptionKeyName([]byte("k8s\x00\n\x0cv1\x12\x06Secret"))
+			Expect(err).To(MatchError(ContainSubstring("not encrypted")))
+		})
+
+		It("should fail if the value does not contain a key name", func() {
+			_, err := framework.ParseETCDEncryptionKeyName([]byte("k8s:enc:aescbc:v1:"))
+			Expect(err).To(MatchError(ContainSubstring("does not contain the name")))
+		})
+	})
+
+	Describe("ShootFramework", func() {
+		var (
+			ctx = context.TODO()
+
+			seedClient  client.Client
+			shootClient client.Client
+			f           *framework.ShootFramework
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			f = &framework.ShootFramework{
+				GardenerFramework: &framework.GardenerFramework{},
+				SeedClient:        fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
+				ShootClient:       fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(),
+				Project:           &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+				Shoot: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"},
+					Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--dev--foo"},
+				},
+			}
+		})
+
+		Describe("#GetETCDEncryptionKeyName", func() {
+			newEncryptionConfigurationSecret := func(name string, creationTimestamp time.Time, keyNames ...string) *corev1.Secret {
+				config := `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- resources:
+  - secrets
+  providers:
+  - aescbc:
+      keys:
+`
+				for _, keyName := range keyNames {
+					config += "      - name: " + keyName + "\n        secret: c2VjcmV0\n"
+				}
+				config += "  - identity: {}\n"
+
+				return &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              name,
+						Namespace:         "shoot--dev--foo",
+						Labels:            map[string]string{"role": "kube-apiserver-etcd-encryption-configuration"},
+						CreationTimestamp: metav1.NewTime(creationTimestamp),
+					},
+					Data: map[string][]byte{"encryption-configuration.yaml": []byte(config)},
+				}
+			}
+
+			It("should return the first key of the newest encryption configuration", func() {
+				now := time.Now().Truncate(time.Second)
+				Expect(seedClient.Create(ctx, newEncryptionConfigurationSecret("config-old", now.Add(-time.Hour), "key-old"))).To(Succeed())
+				Expect(seedClient.Create(ctx, newEncryptionConfigurationSecret("config-new", now, "key-new", "key-old"))).To(Succeed())
+
+				Expect(f.GetETCDEncryptionKeyName(ctx)).To(Equal("key-new"))
+			})
+
+			It("should fail if there is no encryption configuration", func() {
+				_, err := f.GetETCDEncryptionKeyName(ctx)
+				Expect(err).To(MatchError(ContainSubstring("no etcd encryption configuration secret found")))
+			})
+		})
+
+		Describe("#CreateETCDEncryptionMarkerSecret", func() {
+			It("should create the marker secret and return an existing one", func() {
+				secret, err := f.CreateETCDEncryptionMarkerSecret(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Namespace).To(Equal("default"))
+				Expect(secret.Name).To(Equal(framework.ETCDEncryptionMarkerSecretName))
+
+				existing, err := f.CreateETCDEncryptionMarkerSecret(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(existing.UID).To(Equal(secret.UID))
+				Expect(existing.Data).To(Equal(secret.Data))
+			})
+		})
+	})
+})
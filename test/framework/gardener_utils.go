@@ -337,14 +337,16 @@ func (f *GardenerFramework) updateBinding(ctx context.Context, shoot *gardencore
 }
 
 // HibernateShoot hibernates the test shoot
-func (f *GardenerFramework) HibernateShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+func (f *GardenerFramework) HibernateShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, opts ...HibernationOption) error {
 	log := f.Logger.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+	options := newHibernationOptions(opts)
 
 	// return if the shoot is already hibernated
 	if shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled {
 		return nil
 	}
 
+	monitor := f.startHibernationTimingsMonitor(ctx, options, shoot, HibernationOperationHibernate)
 	err := retry.UntilTimeout(ctx, 20*time.Second, 5*time.Minute, func(ctx context.Context) (done bool, err error) {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		setHibernation(shoot, true)
@@ -357,7 +359,11 @@ func (f *GardenerFramework) HibernateShoot(ctx context.Context, shoot *gardencor
 		return err
 	}
 
-	if err := f.WaitForShootToBeReconciled(ctx, shoot); err != nil {
+	err = f.WaitForShootToBeReconciled(ctx, shoot)
+	if timingsErr := f.writeHibernationTimings(ctx, options, monitor, shoot); timingsErr != nil {
+		log.Error(timingsErr, "Failed writing hibernation timings")
+	}
+	if err != nil {
 		return err
 	}
 
@@ -371,14 +377,16 @@ func (f *GardenerFramework) HibernateShoot(ctx context.Context, shoot *gardencor
 }
 
 // WakeUpShoot wakes up the test shoot from hibernation
-func (f *GardenerFramework) WakeUpShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+func (f *GardenerFramework) WakeUpShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, opts ...HibernationOption) error {
 	log := f.Logger.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+	options := newHibernationOptions(opts)
 
 	// return if the shoot is already running
 	if shoot.Spec.Hibernation == nil || shoot.Spec.Hibernation.Enabled == nil || !*shoot.Spec.Hibernation.Enabled {
 		return nil
 	}
 
+	monitor := f.startHibernationTimingsMonitor(ctx, options, shoot, HibernationOperationWakeUp)
 	err := retry.UntilTimeout(ctx, 20*time.Second, 5*time.Minute, func(ctx context.Context) (done bool, err error) {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		setHibernation(shoot, false)
@@ -391,7 +399,11 @@ func (f *GardenerFramework) WakeUpShoot(ctx context.Context, shoot *gardencorev1
 		return err
 	}
 
-	if err := f.WaitForShootToBeReconciled(ctx, shoot); err != nil {
+	err = f.WaitForShootToBeReconciled(ctx, shoot)
+	if timingsErr := f.writeHibernationTimings(ctx, options, monitor, shoot); timingsErr != nil {
+		log.Error(timingsErr, "Failed writing wake-up timings")
+	}
+	if err != nil {
 		return err
 	}
 
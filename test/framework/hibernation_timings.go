@@ -0,0 +1,332 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// HibernationOperationHibernate is the name of the operation hibernating a shoot used in timing reports.
+	HibernationOperationHibernate = "hibernate"
+	// HibernationOperationWakeUp is the name of the operation waking up a shoot used in timing reports.
+	HibernationOperationWakeUp = "wake-up"
+
+	defaultHibernationTimingsInterval = 5 * time.Second
+)
+
+// HibernationTimingsConfig is the configuration for collecting the phase timings of hibernations and wake-ups.
+type HibernationTimingsConfig struct {
+	// ReportDir is the directory the timing reports are written to. Timings are not collected if empty.
+	ReportDir string
+	// Interval is the interval in which the last operation of the shoot is observed.
+	Interval time.Duration
+}
+
+// RegisterHibernationTimingsFlags adds all flags that are needed to configure the collection of hibernation phase
+// timings to the provided flagset.
+func RegisterHibernationTimingsFlags() *HibernationTimingsConfig {
+	newCfg := &HibernationTimingsConfig{}
+
+	flag.StringVar(&newCfg.ReportDir, "hibernation-timings-dir", "", "directory the phase timings of hibernations and wake-ups are written to as JSON (not collected if unset)")
+	flag.DurationVar(&newCfg.Interval, "hibernation-timings-interval", defaultHibernationTimingsInterval, "interval in which the last operation of the shoot is observed for collecting phase timings")
+
+	return newCfg
+}
+
+// HibernationOption is an option for hibernating or waking up a shoot.
+type HibernationOption func(*hibernationOptions)
+
+type hibernationOptions struct {
+	timings *HibernationTimingsConfig
+}
+
+// WithHibernationTimings collects the phase timings of the hibernation or wake-up and writes them to the report
+// directory of the given configuration. Timings are not collected if the configuration is nil or has no report
+// directory.
+func WithHibernationTimings(cfg *HibernationTimingsConfig) HibernationOption {
+	return func(o *hibernationOptions) {
+		if cfg != nil && cfg.ReportDir != "" {
+			o.timings = cfg
+		}
+	}
+}
+
+func newHibernationOptions(opts []HibernationOption) *hibernationOptions {
+	o := &hibernationOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// OperationTimingsReport contains the timings of a hibernation or wake-up of a shoot.
+type OperationTimingsReport struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string `json:"shootNamespace"`
+	// ShootName is the name of the shoot.
+	ShootName string `json:"shootName"`
+	// Operation is the performed operation, i.e. 'hibernate' or 'wake-up'.
+	Operation string `json:"operation"`
+	// Start is the time when the operation was triggered.
+	Start time.Time `json:"start"`
+	// End is the time when the operation was observed to be finished.
+	End time.Time `json:"end"`
+	// DurationSeconds is the total duration of the operation.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Phases are the phases of the operation as reported in the last operation of the shoot, in the order they were
+	// observed.
+	Phases []PhaseTiming `json:"phases"`
+	// Events are the events which were recorded for the shoot during the operation.
+	Events []EventTiming `json:"events,omitempty"`
+}
+
+// PhaseTiming is a phase of an operation, i.e. a period in which the description of the shoot's last operation did not
+// change. The description contains the flow steps which were executed in this period.
+type PhaseTiming struct {
+	// Description is the description of the last operation.
+	Description string `json:"description"`
+	// Progress is the progress of the last operation when the phase was first observed.
+	Progress int32 `json:"progress"`
+	// Start is the time when the phase was first observed.
+	Start time.Time `json:"start"`
+	// End is the time when the next phase was observed or the operation was finished.
+	End time.Time `json:"end"`
+	// DurationSeconds is the duration of the phase.
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// EventTiming is an event which was recorded for the shoot.
+type EventTiming struct {
+	// Type is the type of the event.
+	Type string `json:"type"`
+	// Reason is the reason of the event.
+	Reason string `json:"reason"`
+	// Message is the message of the event.
+	Message string `json:"message"`
+	// Timestamp is the time when the event was recorded last.
+	Timestamp time.Time `json:"timestamp"`
+	// Count is the number of occurrences of the event.
+	Count int32 `json:"count"`
+}
+
+// HibernationTimingsMonitor periodically observes the last operation of a shoot while it is hibernated or woken up and
+// records the timings of the phases of the operation.
+type HibernationTimingsMonitor struct {
+	log      logr.Logger
+	reader   client.Reader
+	key      client.ObjectKey
+	interval time.Duration
+
+	lock   sync.Mutex
+	report *OperationTimingsReport
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	now    func() time.Time
+}
+
+// NewHibernationTimingsMonitor creates a new HibernationTimingsMonitor which reads the given shoot with the given reader
+// in the given interval.
+func NewHibernationTimingsMonitor(log logr.Logger, reader client.Reader, key client.ObjectKey, operation string, interval time.Duration) *HibernationTimingsMonitor {
+	return &HibernationTimingsMonitor{
+		log:      log.WithName("hibernation-timings-monitor"),
+		reader:   reader,
+		key:      key,
+		interval: interval,
+		report: &OperationTimingsReport{
+			ShootNamespace: key.Namespace,
+			ShootName:      key.Name,
+			Operation:      operation,
+		},
+		now: time.Now,
+	}
+}
+
+// Start starts observing the shoot in the background until Stop is called or the given context is cancelled.
+func (m *HibernationTimingsMonitor) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.report.Start = m.now()
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			shoot := &gardencorev1beta1.Shoot{}
+			if err := m.reader.Get(ctx, m.key, shoot); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				m.log.Info("Failed reading shoot", "error", err.Error())
+			} else {
+				m.Observe(shoot.Status.LastOperation)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Observe records the given last operation of the shoot. A new phase is started if the description of the last
+// operation changed. Last operations which were updated before the monitor was started are ignored.
+func (m *HibernationTimingsMonitor) Observe(lastOperation *gardencorev1beta1.LastOperation) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if lastOperation == nil || lastOperation.LastUpdateTime.Time.Before(m.report.Start.Truncate(time.Second)) {
+		return
+	}
+
+	now := m.now()
+	if n := len(m.report.Phases); n > 0 {
+		if m.report.Phases[n-1].Description == lastOperation.Description {
+			return
+		}
+		m.report.Phases[n-1].end(now)
+	}
+
+	m.log.Info("Observed new phase", "description", lastOperation.Description, "progress", lastOperation.Progress)
+	m.report.Phases = append(m.report.Phases, PhaseTiming{
+		Description: lastOperation.Description,
+		Progress:    lastOperation.Progress,
+		Start:       now,
+	})
+}
+
+// Stop stops observing the shoot and returns the report of the observed phases.
+func (m *HibernationTimingsMonitor) Stop() *OperationTimingsReport {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := m.now()
+	if n := len(m.report.Phases); n > 0 && m.report.Phases[n-1].End.IsZero() {
+		m.report.Phases[n-1].end(now)
+	}
+	m.report.End = now
+	m.report.DurationSeconds = now.Sub(m.report.Start).Seconds()
+
+	return m.report
+}
+
+func (p *PhaseTiming) end(t time.Time) {
+	p.End = t
+	p.DurationSeconds = t.Sub(p.Start).Seconds()
+}
+
+// AddEvents adds the given events of the shoot to the report which were recorded after the operation was started.
+func (r *OperationTimingsReport) AddEvents(events []corev1.Event) {
+	for _, event := range events {
+		timestamp := event.LastTimestamp.Time
+		if timestamp.IsZero() {
+			timestamp = event.EventTime.Time
+		}
+		if timestamp.Before(r.Start.Truncate(time.Second)) {
+			continue
+		}
+
+		r.Events = append(r.Events, EventTiming{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Message,
+			Timestamp: timestamp,
+			Count:     event.Count,
+		})
+	}
+
+	slices.SortStableFunc(r.Events, func(a, b EventTiming) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+}
+
+// FileName returns the name of the file the report is written to.
+func (r *OperationTimingsReport) FileName() string {
+	return fmt.Sprintf("%s--%s-%s-timings.json", r.ShootNamespace, r.ShootName, r.Operation)
+}
+
+// WriteOperationTimingsReport writes the given report as JSON to the given directory.
+func WriteOperationTimingsReport(dir string, report *OperationTimingsReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed marshalling timings report: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed creating directory for timings report: %w", err)
+	}
+
+	path := filepath.Join(dir, report.FileName())
+	return path, os.WriteFile(path, data, 0600)
+}
+
+// startHibernationTimingsMonitor starts a monitor for the given shoot if the collection of timings is enabled in the
+// given options. Nil is returned otherwise.
+func (f *GardenerFramework) startHibernationTimingsMonitor(ctx context.Context, options *hibernationOptions, shoot *gardencorev1beta1.Shoot, operation string) *HibernationTimingsMonitor {
+	if options.timings == nil {
+		return nil
+	}
+
+	interval := options.timings.Interval
+	if interval <= 0 {
+		interval = defaultHibernationTimingsInterval
+	}
+
+	monitor := NewHibernationTimingsMonitor(f.Logger, f.GardenClient.Client(), client.ObjectKeyFromObject(shoot), operation, interval)
+	monitor.Start(ctx)
+	return monitor
+}
+
+// writeHibernationTimings stops the given monitor, adds the events of the shoot to its report and writes it to the
+// report directory of the given options. The report is also written if the operation failed.
+func (f *GardenerFramework) writeHibernationTimings(ctx context.Context, options *hibernationOptions, monitor *HibernationTimingsMonitor, shoot *gardencorev1beta1.Shoot) error {
+	if monitor == nil {
+		return nil
+	}
+
+	report := monitor.Stop()
+
+	eventList := &corev1.EventList{}
+	if err := f.GardenClient.Client().List(ctx, eventList, client.InNamespace(shoot.Namespace), client.MatchingFields{
+		"involvedObject.kind": "Shoot",
+		"involvedObject.name": shoot.Name,
+	}); err != nil {
+		f.Logger.Info("Failed listing events of shoot, timings report does not contain events", "error", err.Error())
+	} else {
+		report.AddEvents(eventList.Items)
+	}
+
+	path, err := WriteOperationTimingsReport(options.timings.ReportDir, report)
+	if err != nil {
+		return err
+	}
+
+	f.Logger.Info("Wrote timings report", "path", path, "operation", report.Operation, "duration", time.Duration(report.DurationSeconds*float64(time.Second)), "phases", len(report.Phases))
+	return nil
+}
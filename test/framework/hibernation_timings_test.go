@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Hibernation timings tests", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		shoot      *gardencorev1beta1.Shoot
+		monitor    *framework.HibernationTimingsMonitor
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+		monitor = framework.NewHibernationTimingsMonitor(logr.Discard(), fakeClient, client.ObjectKeyFromObject(shoot), framework.HibernationOperationHibernate, 5*time.Millisecond)
+	})
+
+	lastOperation := func(description string, progress int32, lastUpdateTime time.Time) *gardencorev1beta1.LastOperation {
+		return &gardencorev1beta1.LastOperation{
+			Type:           gardencorev1beta1.LastOperationTypeReconcile,
+			State:          gardencorev1beta1.LastOperationStateProcessing,
+			Description:    description,
+			Progress:       progress,
+			LastUpdateTime: metav1.NewTime(lastUpdateTime),
+		}
+	}
+
+	Describe("#HibernationTimingsMonitor", func() {
+		It("should record a phase for every observed description", func() {
+			shoot.Status.LastOperation = lastOperation("Waiting until shoot worker nodes have been terminated", 40, time.Now().Add(time.Hour))
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			monitor.Start(ctx)
+			time.Sleep(20 * time.Millisecond)
+
+			shoot.Status.LastOperation = lastOperation("Scaling down kube-apiserver", 80, time.Now().Add(time.Hour))
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+
+			report := monitor.Stop()
+			Expect(report.ShootNamespace).To(Equal("garden-foo"))
+			Expect(report.ShootName).To(Equal("bar"))
+			Expect(report.Operation).To(Equal(framework.HibernationOperationHibernate))
+			Expect(report.End).NotTo(BeTemporally("<", report.Start))
+			Expect(report.Phases).To(HaveExactElements(
+				And(HaveField("Description", "Waiting until shoot worker nodes have been terminated"), HaveField("Progress", int32(40))),
+				And(HaveField("Description", "Scaling down kube-apiserver"), HaveField("Progress", int32(80))),
+			))
+			Expect(report.Phases[0].End).To(Equal(report.Phases[1].Start))
+			Expect(report.Phases[1].End).To(Equal(report.End))
+		})
+
+		It("should ignore last operations which were updated before the monitor was started", func() {
+			shoot.Status.LastOperation = lastOperation("Reconciled Shoot cluster state", 100, time.Now().Add(-time.Hour))
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			monitor.Start(ctx)
+			time.Sleep(20 * time.Millisecond)
+
+			Expect(monitor.Stop().Phases).To(BeEmpty())
+		})
+	})
+
+	Describe("#OperationTimingsReport", func() {
+		var report *framework.OperationTimingsReport
+
+		BeforeEach(func() {
+			start := time.Now()
+			report = &framework.OperationTimingsReport{
+				ShootNamespace: "garden-foo",
+				ShootName:      "bar",
+				Operation:      framework.HibernationOperationWakeUp,
+				Start:          start,
+				End:            start.Add(time.Minute),
+			}
+		})
+
+		It("should add the events recorded during the operation sorted by time", func() {
+			report.AddEvents([]corev1.Event{
+				{Type: corev1.EventTypeNormal, Reason: "Reconciled", LastTimestamp: metav1.NewTime(report.Start.Add(time.Minute)), Count: 1},
+				{Type: corev1.EventTypeNormal, Reason: "Hibernated", LastTimestamp: metav1.NewTime(report.Start.Add(-time.Hour)), Count: 1},
+				{Type: corev1.EventTypeNormal, Reason: "Reconciling", EventTime: metav1.NewMicroTime(report.Start.Add(time.Second)), Count: 2},
+			})
+
+			Expect(report.Events).To(HaveExactElements(
+				HaveField("Reason", "Reconciling"),
+				HaveField("Reason", "Reconciled"),
+			))
+		})
+
+		It("should write the report as JSON", func() {
+			dir := GinkgoT().TempDir()
+
+			path, err := framework.WriteOperationTimingsReport(dir, report)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(dir, "garden-foo--bar-wake-up-timings.json")))
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			written := &framework.OperationTimingsReport{}
+			Expect(json.Unmarshal(data, written)).To(Succeed())
+			Expect(written.ShootName).To(Equal("bar"))
+			Expect(written.Operation).To(Equal(framework.HibernationOperationWakeUp))
+			Expect(written.End.Sub(written.Start)).To(Equal(time.Minute))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
@@ -203,7 +204,7 @@ func GetAllNodesInWorkerPool(ctx context.Context, c kubernetes.Interface, worker
 
 	selectorOption := &client.MatchingLabelsSelector{}
 	if workerGroup != nil && len(*workerGroup) > 0 {
-		selectorOption.Selector = labels.SelectorFromSet(labels.Set{"worker.gardener.cloud/pool": *workerGroup})
+		selectorOption.Selector = labels.SelectorFromSet(labels.Set{v1beta1constants.LabelWorkerPool: *workerGroup})
 	}
 
 	err := c.Client().List(ctx, nodeList, selectorOption)
@@ -450,6 +451,12 @@ func NewClientFromServiceAccount(ctx context.Context, k8sClient kubernetes.Inter
 		return nil, err
 	}
 
+	return NewClientFromServiceAccountToken(k8sClient, token)
+}
+
+// NewClientFromServiceAccountToken returns a kubernetes client which authenticates with the given service account
+// token against the API server of the given client.
+func NewClientFromServiceAccountToken(k8sClient kubernetes.Interface, token string) (kubernetes.Interface, error) {
 	restConfig := &rest.Config{
 		Host: k8sClient.RESTConfig().Host,
 		TLSClientConfig: rest.TLSClientConfig{
@@ -466,6 +473,23 @@ func NewClientFromServiceAccount(ctx context.Context, k8sClient kubernetes.Inter
 	)
 }
 
+// IsServiceAccountTokenAuthenticated uses the TokenReview API of the API server of the given client to check whether
+// the given service account token is (still) accepted, e.g., whether it is signed by a key which is trusted by the API
+// server.
+func IsServiceAccountTokenAuthenticated(ctx context.Context, k8sClient kubernetes.Interface, token string) (bool, error) {
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+
+	if err := k8sClient.Client().Create(ctx, tokenReview); err != nil {
+		return false, err
+	}
+
+	return tokenReview.Status.Authenticated, nil
+}
+
 // WaitUntilPodIsRunning waits until the pod with <podName> is running
 func WaitUntilPodIsRunning(ctx context.Context, log logr.Logger, name, namespace string, c kubernetes.Interface) error {
 	return retry.Until(ctx, defaultPollInterval, func(ctx context.Context) (done bool, err error) {
@@ -5,18 +5,65 @@
 package framework_test
 
 import (
+	"context"
+	"errors"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/test/framework"
 )
 
 var _ = Describe("Kubernetes Utils", func() {
+	Describe("#IsServiceAccountTokenAuthenticated", func() {
+		var (
+			ctx       = context.Background()
+			k8sClient kubernetes.Interface
+		)
+
+		BeforeEach(func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					tokenReview, ok := obj.(*authenticationv1.TokenReview)
+					if !ok {
+						return errors.New("unexpected object")
+					}
+					if tokenReview.Spec.Token == "broken" {
+						return errors.New("fake")
+					}
+
+					tokenReview.Status.Authenticated = tokenReview.Spec.Token == "valid"
+					return nil
+				},
+			}).Build()
+			k8sClient = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build()
+		})
+
+		It("should return true if the token is authenticated", func() {
+			Expect(framework.IsServiceAccountTokenAuthenticated(ctx, k8sClient, "valid")).To(BeTrue())
+		})
+
+		It("should return false if the token is rejected", func() {
+			Expect(framework.IsServiceAccountTokenAuthenticated(ctx, k8sClient, "invalid")).To(BeFalse())
+		})
+
+		It("should return an error if the token review fails", func() {
+			_, err := framework.IsServiceAccountTokenAuthenticated(ctx, k8sClient, "broken")
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+
 	Describe("#ShootReconciliationSuccessful", func() {
 		var (
 			shoot *gardencorev1beta1.Shoot
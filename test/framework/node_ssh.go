@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// nodeSSHUser is the user which is configured for SSH access on bastion hosts and shoot nodes.
+	nodeSSHUser = "gardener"
+	// nodeSSHPort is the port of the SSH daemon on bastion hosts and shoot nodes.
+	nodeSSHPort = "22"
+	// defaultBastionIngressCIDR is the CIDR from which bastion hosts are reachable if none is configured.
+	defaultBastionIngressCIDR = "0.0.0.0/0"
+)
+
+// nodeAccess holds the Bastion and the SSH client for the bastion host which are shared by all NodeSSH calls in a spec.
+type nodeAccess struct {
+	bastion *operationsv1alpha1.Bastion
+	client  *ssh.Client
+}
+
+// NodeSSH runs the given command on the node with the given name via SSH and returns its combined output. For the first
+// call in a spec, it provisions a Bastion for the shoot which is deleted when the spec has finished. The command is run
+// as the `gardener` user, hence it must use `sudo` for privileged operations. SSH access to the nodes must be enabled
+// for the shoot.
+func (f *ShootFramework) NodeSSH(ctx context.Context, nodeName, command string) ([]byte, error) {
+	access, err := f.ensureNodeAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &corev1.Node{}
+	if err := f.ShootClient.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return nil, err
+	}
+
+	var address string
+	for _, nodeAddress := range node.Status.Addresses {
+		if nodeAddress.Type == corev1.NodeInternalIP {
+			address = net.JoinHostPort(nodeAddress.Address, nodeSSHPort)
+			break
+		}
+	}
+	if address == "" {
+		return nil, fmt.Errorf("node %q does not have an internal IP address", nodeName)
+	}
+
+	signer, err := f.shootSSHSigner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := access.client.Dial("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed connecting to node %q via bastion: %w", nodeName, err)
+	}
+
+	sshConn, channels, requests, err := ssh.NewClientConn(conn, address, sshClientConfig(signer))
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed establishing SSH connection to node %q: %w", nodeName, err)
+	}
+	nodeClient := ssh.NewClient(sshConn, channels, requests)
+	defer nodeClient.Close()
+
+	session, err := nodeClient.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	f.Logger.Info("Running command on node via SSH", "node", nodeName, "command", command)
+	output, err := session.CombinedOutput(command)
+	if err != nil {
+		return output, fmt.Errorf("failed running command %q on node %q: %w, output: %s", command, nodeName, err, output)
+	}
+
+	return output, nil
+}
+
+// ReadNodeFile returns the content of the file with the given path on the node with the given name, see NodeSSH.
+func (f *ShootFramework) ReadNodeFile(ctx context.Context, nodeName, path string) ([]byte, error) {
+	return f.NodeSSH(ctx, nodeName, fmt.Sprintf("sudo cat %q", path))
+}
+
+func (f *ShootFramework) ensureNodeAccess(ctx context.Context) (*nodeAccess, error) {
+	if f.nodeAccess != nil {
+		if err := f.keepBastionAlive(ctx); err != nil {
+			return nil, err
+		}
+		return f.nodeAccess, nil
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ingressCIDR := f.Config.BastionIngressCIDR
+	if ingressCIDR == "" {
+		ingressCIDR = defaultBastionIngressCIDR
+	}
+
+	bastion := &operationsv1alpha1.Bastion{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: f.Shoot.Name + "-",
+			Namespace:    f.Shoot.Namespace,
+		},
+		Spec: operationsv1alpha1.BastionSpec{
+			ShootRef:     corev1.LocalObjectReference{Name: f.Shoot.Name},
+			SSHPublicKey: string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(signer.PublicKey()))),
+			Ingress: []operationsv1alpha1.BastionIngressPolicy{{
+				IPBlock: networkingv1.IPBlock{CIDR: ingressCIDR},
+			}},
+		},
+	}
+
+	log := f.Logger.WithValues("shoot", client.ObjectKeyFromObject(f.Shoot))
+	log.Info("Creating Bastion for SSH access to nodes")
+	if err := f.GardenClient.Client().Create(ctx, bastion); err != nil {
+		return nil, fmt.Errorf("failed creating Bastion: %w", err)
+	}
+	log = log.WithValues("bastion", client.ObjectKeyFromObject(bastion))
+
+	ginkgo.DeferCleanup(func(ctx context.Context) {
+		if f.nodeAccess != nil {
+			f.nodeAccess.client.Close()
+			f.nodeAccess = nil
+		}
+
+		log.Info("Deleting Bastion")
+		ExpectNoError(client.IgnoreNotFound(f.GardenClient.Client().Delete(ctx, bastion)))
+	}, ginkgo.NodeTimeout(time.Minute))
+
+	if err := retry.UntilTimeout(ctx, 5*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := f.GardenClient.Client().Get(ctx, client.ObjectKeyFromObject(bastion), bastion); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if condition := v1beta1helper.GetCondition(bastion.Status.Conditions, operationsv1alpha1.BastionReady); condition == nil || condition.Status != gardencorev1beta1.ConditionTrue || bastion.Status.Ingress == nil {
+			log.Info("Waiting for Bastion to be ready")
+			return retry.MinorError(fmt.Errorf("bastion %q is not ready yet", bastion.Name))
+		}
+		return retry.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	host := bastion.Status.Ingress.Hostname
+	if host == "" {
+		host = bastion.Status.Ingress.IP
+	}
+	address := net.JoinHostPort(host, nodeSSHPort)
+
+	var sshClient *ssh.Client
+	// The bastion host might not accept connections right after it has been reported as ready.
+	if err := retry.UntilTimeout(ctx, 5*time.Second, 2*time.Minute, func(_ context.Context) (bool, error) {
+		sshClient, err = ssh.Dial("tcp", address, sshClientConfig(signer))
+		if err != nil {
+			log.Info("Waiting for bastion host to accept SSH connections", "error", err.Error())
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return nil, fmt.Errorf("failed connecting to bastion host %s: %w", address, err)
+	}
+
+	f.nodeAccess = &nodeAccess{bastion: bastion, client: sshClient}
+	return f.nodeAccess, nil
+}
+
+// keepBastionAlive prevents that the Bastion expires if it is used for a longer time.
+func (f *ShootFramework) keepBastionAlive(ctx context.Context) error {
+	bastion := f.nodeAccess.bastion
+	// The annotation is removed by gardener-apiserver, hence always send it with the patch.
+	delete(bastion.Annotations, v1beta1constants.GardenerOperation)
+	patch := client.MergeFrom(bastion.DeepCopy())
+	metav1.SetMetaDataAnnotation(&bastion.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationKeepalive)
+	return f.GardenClient.Client().Patch(ctx, bastion, patch)
+}
+
+func (f *ShootFramework) shootSSHSigner(ctx context.Context) (ssh.Signer, error) {
+	secret := &corev1.Secret{}
+	if err := f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: f.Shoot.Namespace, Name: gardenerutils.ComputeShootProjectResourceName(f.Shoot.Name, gardenerutils.ShootProjectSecretSuffixSSHKeypair)}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading SSH key pair of shoot: %w", err)
+	}
+
+	return ssh.ParsePrivateKey(secret.Data[secretsutils.DataKeyRSAPrivateKey])
+}
+
+func sshClientConfig(signer ssh.Signer) *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User: nodeSSHUser,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// The host keys of bastion hosts and nodes are not known upfront.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), // #nosec G106 -- Only used in tests.
+		Timeout:         30 * time.Second,
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// ShootReportEntryName is the name of the report entry which contains the ShootMetadata of the shoot a spec runs
+// against.
+const ShootReportEntryName = "gardener-shoot"
+
+// ShootMetadata describes the shoot a spec runs against.
+type ShootMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Seed      string `json:"seed,omitempty"`
+	Provider  string `json:"provider,omitempty"`
+}
+
+// SuiteSummary is the machine-readable summary of a test suite with all its specs.
+type SuiteSummary struct {
+	Name     string        `json:"name"`
+	Phase    ESSpecPhase   `json:"phase"`
+	Tests    int           `json:"tests"`
+	Failures int           `json:"failures"`
+	Errors   int           `json:"errors"`
+	Duration float64       `json:"duration"`
+	Specs    []SpecSummary `json:"specs"`
+}
+
+// SpecSummary is the machine-readable summary of one spec.
+type SpecSummary struct {
+	Name     string         `json:"name"`
+	Labels   []string       `json:"labels,omitempty"`
+	Phase    ESSpecPhase    `json:"phase"`
+	Duration float64        `json:"duration"`
+	Shoot    *ShootMetadata `json:"shoot,omitempty"`
+	Failure  *SpecFailure   `json:"failure,omitempty"`
+}
+
+// SpecFailure describes where and why a spec failed.
+type SpecFailure struct {
+	// Step is the last step (see ginkgo.By) which was started before the spec failed.
+	Step     string `json:"step,omitempty"`
+	NodeType string `json:"nodeType"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ReportSummary writes a JSON summary and a JUnit report of the given ginkgo report to the given directory. The files
+// are named after the suite description, e.g. `shoot-test-suite.json` and `shoot-test-suite.xml`.
+// ReportSummary is intended to be called once in an ReportAfterSuite node.
+func ReportSummary(dir string, report ginkgo.Report) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create report directory %s: %w", dir, err)
+	}
+
+	baseName := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(report.SuiteDescription), "-"), "-")
+
+	summary, err := json.MarshalIndent(summarizeReport(report), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to generate summary: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, baseName+".json"), summary, 0640); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+
+	if err := reporters.GenerateJUnitReport(report, filepath.Join(dir, baseName+".xml")); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+
+	return nil
+}
+
+func summarizeReport(report ginkgo.Report) *SuiteSummary {
+	summary := &SuiteSummary{
+		Name:     report.SuiteDescription,
+		Phase:    SpecPhaseSucceeded,
+		Tests:    report.PreRunStats.SpecsThatWillRun,
+		Duration: math.Trunc(report.RunTime.Seconds()*1000) / 1000,
+		Specs:    []SpecSummary{},
+	}
+
+	for _, spec := range report.SpecReports {
+		// do not report skipped tests and suite nodes
+		if spec.LeafNodeType != types.NodeTypeIt || spec.State == types.SpecStateSkipped || spec.State == types.SpecStatePending {
+			continue
+		}
+
+		specSummary := SpecSummary{
+			Name:     strings.TrimSpace(spec.FullText()),
+			Phase:    PhaseForState(spec.State),
+			Duration: spec.RunTime.Seconds(),
+			Shoot:    shootMetadata(spec),
+		}
+
+		if labels := spec.Labels(); len(labels) > 0 {
+			specSummary.Labels = labels
+		}
+
+		if spec.Failed() {
+			if spec.State == types.SpecStateFailed {
+				summary.Failures++
+			} else {
+				summary.Errors++
+			}
+
+			specSummary.Failure = &SpecFailure{
+				Step:     lastStep(spec),
+				NodeType: spec.Failure.FailureNodeType.String(),
+				Location: spec.Failure.Location.String(),
+				Message:  spec.Failure.Message,
+			}
+		}
+
+		summary.Specs = append(summary.Specs, specSummary)
+	}
+
+	if summary.Failures != 0 || summary.Errors != 0 {
+		summary.Phase = SpecPhaseFailed
+	}
+
+	return summary
+}
+
+// shootMetadata returns the metadata of the shoot the spec runs against if the framework added it as report entry.
+// The raw value of the entry is only preserved if the suite does not run in parallel, hence, it is decoded via JSON.
+func shootMetadata(spec types.SpecReport) *ShootMetadata {
+	for i := len(spec.ReportEntries) - 1; i >= 0; i-- {
+		entry := spec.ReportEntries[i]
+		if entry.Name != ShootReportEntryName {
+			continue
+		}
+
+		raw, err := json.Marshal(entry.GetRawValue())
+		if err != nil {
+			return nil
+		}
+
+		metadata := &ShootMetadata{}
+		if err := json.Unmarshal(raw, metadata); err != nil {
+			return nil
+		}
+		return metadata
+	}
+
+	return nil
+}
+
+// lastStep returns the message of the last step which was started before the spec failed.
+func lastStep(spec types.SpecReport) string {
+	var step string
+	for _, event := range spec.SpecEvents.WithType(types.SpecEventByStart) {
+		if spec.Failure.TimelineLocation.Order != 0 && event.TimelineLocation.Order > spec.Failure.TimelineLocation.Order {
+			break
+		}
+		step = event.Message
+	}
+	return step
+}
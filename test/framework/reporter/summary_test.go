@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Summary", func() {
+	var (
+		mockReport Report
+		shoot      ShootMetadata
+	)
+
+	BeforeEach(func() {
+		shoot = ShootMetadata{Name: "test", Namespace: "garden-dev", Seed: "seed", Provider: "local"}
+
+		mockReport = Report{
+			SuiteDescription: "Shoot Test Suite",
+			RunTime:          2 * time.Second,
+			PreRunStats:      types.PreRunStats{SpecsThatWillRun: 3},
+			SpecReports: []SpecReport{
+				{
+					LeafNodeType:            types.NodeTypeIt,
+					ContainerHierarchyTexts: []string{"Shoot application testing"},
+					LeafNodeText:            "[DEFAULT] [APPLICATION] should deploy guestbook",
+					LeafNodeLabels:          []string{"default"},
+					State:                   types.SpecStatePassed,
+					RunTime:                 time.Second,
+					ReportEntries:           types.ReportEntries{{Name: ShootReportEntryName, Value: types.WrapEntryValue(shoot)}},
+				},
+				{
+					LeafNodeType:            types.NodeTypeIt,
+					ContainerHierarchyTexts: []string{"Shoot application testing"},
+					LeafNodeText:            "[DEFAULT] [APPLICATION] should be reachable",
+					State:                   types.SpecStateFailed,
+					RunTime:                 3 * time.Second,
+					ReportEntries:           types.ReportEntries{{Name: ShootReportEntryName, Value: types.WrapEntryValue(shoot)}},
+					SpecEvents: types.SpecEvents{
+						{SpecEventType: types.SpecEventByStart, Message: "deploy application", TimelineLocation: types.TimelineLocation{Order: 1}},
+						{SpecEventType: types.SpecEventByStart, Message: "check reachability", TimelineLocation: types.TimelineLocation{Order: 2}},
+						{SpecEventType: types.SpecEventByStart, Message: "cleanup", TimelineLocation: types.TimelineLocation{Order: 4}},
+					},
+					Failure: types.Failure{
+						Message:          "connection refused",
+						Location:         types.CodeLocation{FileName: "app_test.go", LineNumber: 42},
+						FailureNodeType:  types.NodeTypeIt,
+						TimelineLocation: types.TimelineLocation{Order: 3},
+					},
+				},
+				{
+					LeafNodeType:            types.NodeTypeIt,
+					ContainerHierarchyTexts: []string{"Shoot application testing"},
+					LeafNodeText:            "should be skipped",
+					State:                   types.SpecStateSkipped,
+				},
+				{
+					LeafNodeType: types.NodeTypeReportAfterSuite,
+					LeafNodeText: "Report to Elasticsearch",
+					State:        types.SpecStatePassed,
+				},
+			},
+		}
+	})
+
+	Describe("#summarizeReport", func() {
+		It("should summarize the executed specs", func() {
+			summary := summarizeReport(mockReport)
+
+			Expect(summary.Name).To(Equal("Shoot Test Suite"))
+			Expect(summary.Phase).To(Equal(SpecPhaseFailed))
+			Expect(summary.Tests).To(Equal(3))
+			Expect(summary.Failures).To(Equal(1))
+			Expect(summary.Errors).To(Equal(0))
+			Expect(summary.Duration).To(Equal(2.0))
+			Expect(summary.Specs).To(ConsistOf(
+				SpecSummary{
+					Name:     "Shoot application testing [DEFAULT] [APPLICATION] should deploy guestbook",
+					Labels:   []string{"default"},
+					Phase:    SpecPhaseSucceeded,
+					Duration: 1,
+					Shoot:    &shoot,
+				},
+				SpecSummary{
+					Name:     "Shoot application testing [DEFAULT] [APPLICATION] should be reachable",
+					Phase:    SpecPhaseFailed,
+					Duration: 3,
+					Shoot:    &shoot,
+					Failure: &SpecFailure{
+						Step:     "check reachability",
+						NodeType: "It",
+						Location: "app_test.go:42",
+						Message:  "connection refused",
+					},
+				},
+			))
+		})
+
+		It("should count interrupted specs as errors", func() {
+			mockReport.SpecReports[1].State = types.SpecStateInterrupted
+
+			summary := summarizeReport(mockReport)
+
+			Expect(summary.Phase).To(Equal(SpecPhaseFailed))
+			Expect(summary.Failures).To(Equal(0))
+			Expect(summary.Errors).To(Equal(1))
+		})
+
+		It("should decode the shoot metadata if the raw value was not preserved", func() {
+			raw, err := json.Marshal(mockReport.SpecReports[0])
+			Expect(err).NotTo(HaveOccurred())
+			decoded := SpecReport{}
+			Expect(json.Unmarshal(raw, &decoded)).To(Succeed())
+			mockReport.SpecReports = []SpecReport{decoded}
+
+			summary := summarizeReport(mockReport)
+
+			Expect(summary.Phase).To(Equal(SpecPhaseSucceeded))
+			Expect(summary.Specs).To(HaveLen(1))
+			Expect(summary.Specs[0].Shoot).To(Equal(&shoot))
+		})
+	})
+
+	Describe("#ReportSummary", func() {
+		It("should write the JSON summary and the JUnit report", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "summary")
+
+			Expect(ReportSummary(dir, mockReport)).To(Succeed())
+
+			raw, err := os.ReadFile(filepath.Join(dir, "shoot-test-suite.json"))
+			Expect(err).NotTo(HaveOccurred())
+			summary := &SuiteSummary{}
+			Expect(json.Unmarshal(raw, summary)).To(Succeed())
+			Expect(summary).To(Equal(summarizeReport(mockReport)))
+
+			Expect(filepath.Join(dir, "shoot-test-suite.xml")).To(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/test/utils/rotation"
+)
+
+// RotationPhase is a phase of a two-phase credentials rotation. Each phase can be executed in a separate test run.
+type RotationPhase string
+
+const (
+	// RotationPhaseAll executes all phases of the rotation in a single test run.
+	RotationPhaseAll RotationPhase = ""
+	// RotationPhaseStart only starts the rotation and persists a checkpoint.
+	RotationPhaseStart RotationPhase = "start"
+	// RotationPhaseComplete resumes the rotation from a persisted checkpoint and completes it.
+	RotationPhaseComplete RotationPhase = "complete"
+
+	defaultRotationCheckpointFileName = "rotation-checkpoint.json"
+)
+
+// RotationPhaseConfig is the configuration for executing the phases of a credentials rotation in separate test runs.
+type RotationPhaseConfig struct {
+	// Phase is the phase of the rotation which is executed. All phases are executed if empty.
+	Phase RotationPhase
+	// CheckpointPath is the path of the file the checkpoint is persisted to after the rotation was started.
+	CheckpointPath string
+}
+
+// RegisterRotationPhaseFlags adds all flags that are needed to configure the rotation phases to the provided flagset.
+func RegisterRotationPhaseFlags() *RotationPhaseConfig {
+	newCfg := &RotationPhaseConfig{}
+
+	defaultCheckpointPath := filepath.Join(os.TempDir(), defaultRotationCheckpointFileName)
+	if sharedPath := os.Getenv(TestMachinerySharedPathEnvVarName); sharedPath != "" {
+		defaultCheckpointPath = filepath.Join(sharedPath, defaultRotationCheckpointFileName)
+	}
+
+	flag.Func("rotation-phase", "phase of the credentials rotation to execute, either 'start' or 'complete' (executes all phases if unset)", func(value string) error {
+		switch phase := RotationPhase(value); phase {
+		case RotationPhaseAll, RotationPhaseStart, RotationPhaseComplete:
+			newCfg.Phase = phase
+			return nil
+		}
+		return fmt.Errorf("unknown rotation phase %q", value)
+	})
+	flag.StringVar(&newCfg.CheckpointPath, "rotation-checkpoint-path", defaultCheckpointPath, "path of the file the checkpoint of a started credentials rotation is persisted to")
+
+	return newCfg
+}
+
+// Includes returns true if the given phase is executed in the current test run.
+func (c *RotationPhaseConfig) Includes(phase RotationPhase) bool {
+	return c.Phase == RotationPhaseAll || c.Phase == phase
+}
+
+// RotationCheckpoint is the state of a started credentials rotation which is persisted in order to resume the
+// verification of the rotation in a later test run.
+type RotationCheckpoint struct {
+	// ShootNamespace is the namespace of the shoot.
+	ShootNamespace string `json:"shootNamespace"`
+	// ShootName is the name of the shoot.
+	ShootName string `json:"shootName"`
+	// Operation is the operation which was used to start the rotation.
+	Operation string `json:"operation"`
+	// StartTime is the time when the rotation was started.
+	StartTime metav1.Time `json:"startTime"`
+	// SecretsBefore are the secrets managed by gardenlet in the shoot namespace of the seed before the rotation was
+	// started, grouped by the name of their secret config.
+	SecretsBefore rotation.SecretConfigNamesToSecrets `json:"secretsBefore"`
+}
+
+// WriteRotationCheckpoint persists the given checkpoint to the given path.
+func WriteRotationCheckpoint(path string, checkpoint *RotationCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed marshalling rotation checkpoint: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed creating directory for rotation checkpoint: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// ReadRotationCheckpoint reads a checkpoint which was persisted by WriteRotationCheckpoint from the given path.
+func ReadRotationCheckpoint(path string) (*RotationCheckpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading rotation checkpoint: %w", err)
+	}
+
+	checkpoint := &RotationCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling rotation checkpoint %s: %w", path, err)
+	}
+	return checkpoint, nil
+}
+
+// RemoveRotationCheckpoint removes the checkpoint from the given path. It does not fail if the checkpoint does not
+// exist.
+func RemoveRotationCheckpoint(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// StartRotationPhase persists a checkpoint of the secrets managed by gardenlet in the shoot namespace of the seed and
+// starts the rotation by annotating the shoot with the given operation, e.g. 'rotate-credentials-start'. It waits until
+// the shoot was reconciled. The checkpoint is persisted before the rotation is started so that the verification can be
+// resumed even if waiting for the reconciliation fails.
+func (f *ShootFramework) StartRotationPhase(ctx context.Context, cfg *RotationPhaseConfig, operation string) (*RotationCheckpoint, error) {
+	if f.SeedClient == nil {
+		return nil, fmt.Errorf("seed client is required for persisting a rotation checkpoint")
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := f.SeedClient.Client().List(ctx, secretList, client.InNamespace(f.ShootSeedNamespace()), client.MatchingLabels{
+		"managed-by":       "secrets-manager",
+		"manager-identity": "gardenlet",
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets of shoot before rotation: %w", err)
+	}
+
+	checkpoint := &RotationCheckpoint{
+		ShootNamespace: f.Shoot.Namespace,
+		ShootName:      f.Shoot.Name,
+		Operation:      operation,
+		StartTime:      metav1.Now(),
+		SecretsBefore:  rotation.GroupByName(secretList.Items),
+	}
+
+	if err := WriteRotationCheckpoint(cfg.CheckpointPath, checkpoint); err != nil {
+		return nil, err
+	}
+	f.Logger.Info("Persisted rotation checkpoint", "path", cfg.CheckpointPath, "operation", operation)
+
+	if err := f.UpdateShoot(ctx, func(shoot *gardencorev1beta1.Shoot) error {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// ResumeRotationPhase reads the checkpoint persisted by StartRotationPhase, e.g. in a previous test run, and verifies
+// that it belongs to the shoot of the framework.
+func (f *ShootFramework) ResumeRotationPhase(cfg *RotationPhaseConfig) (*RotationCheckpoint, error) {
+	checkpoint, err := ReadRotationCheckpoint(cfg.CheckpointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint.ShootNamespace != f.Shoot.Namespace || checkpoint.ShootName != f.Shoot.Name {
+		return nil, fmt.Errorf("rotation checkpoint %s belongs to shoot %s/%s instead of %s/%s",
+			cfg.CheckpointPath, checkpoint.ShootNamespace, checkpoint.ShootName, f.Shoot.Namespace, f.Shoot.Name)
+	}
+
+	f.Logger.Info("Resuming rotation from checkpoint", "path", cfg.CheckpointPath, "operation", checkpoint.Operation, "startTime", checkpoint.StartTime)
+	return checkpoint, nil
+}
+
+// GetObservabilitySecret returns the secret in the project namespace which contains the credentials and the URL of the
+// observability components (Plutono, Prometheus) of the shoot.
+func (f *ShootFramework) GetObservabilitySecret(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := f.GardenClient.Client().Get(ctx, client.ObjectKey{
+		Namespace: f.Shoot.Namespace,
+		Name:      gardenerutils.ComputeShootProjectResourceName(f.Shoot.Name, gardenerutils.ShootProjectSecretSuffixMonitoring),
+	}, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// RotateObservabilityCredentials rotates the credentials of the observability components of the shoot by annotating
+// the shoot with the 'rotate-observability-credentials' operation. It waits until the shoot was reconciled and
+// refreshes the shoot of the framework afterwards. The rotation is completed after one reconciliation.
+func (f *ShootFramework) RotateObservabilityCredentials(ctx context.Context) error {
+	return f.annotateShootWithOperation(ctx, v1beta1constants.OperationRotateObservabilityCredentials)
+}
+
+// StartServiceAccountKeyRotation starts the rotation of the service account signing key of the shoot by annotating the
+// shoot with the 'rotate-serviceaccount-key-start' operation. It waits until the shoot was reconciled and refreshes the
+// shoot of the framework afterwards. Tokens signed with the old key are still accepted until the rotation is completed.
+func (f *ShootFramework) StartServiceAccountKeyRotation(ctx context.Context) error {
+	return f.annotateShootWithOperation(ctx, v1beta1constants.OperationRotateServiceAccountKeyStart)
+}
+
+// CompleteServiceAccountKeyRotation completes the rotation of the service account signing key of the shoot by
+// annotating the shoot with the 'rotate-serviceaccount-key-complete' operation. It waits until the shoot was reconciled
+// and refreshes the shoot of the framework afterwards. Tokens signed with the old key are rejected afterwards.
+func (f *ShootFramework) CompleteServiceAccountKeyRotation(ctx context.Context) error {
+	return f.annotateShootWithOperation(ctx, v1beta1constants.OperationRotateServiceAccountKeyComplete)
+}
+
+func (f *ShootFramework) annotateShootWithOperation(ctx context.Context, operation string) error {
+	if err := f.UpdateShoot(ctx, func(shoot *gardencorev1beta1.Shoot) error {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return f.GetShoot(ctx, f.Shoot)
+}
+
+// ObservabilityVerifier returns a verifier for the observability credentials rotation of the shoot, see
+// rotation.ObservabilityVerifier.
+func (f *ShootFramework) ObservabilityVerifier() *rotation.ObservabilityVerifier {
+	return &rotation.ObservabilityVerifier{
+		GetObservabilitySecretFunc: f.GetObservabilitySecret,
+		GetObservabilityEndpoint: func(secret *corev1.Secret) string {
+			return secret.Annotations["url"]
+		},
+		GetObservabilityRotation: func() *gardencorev1beta1.ObservabilityRotation {
+			if f.Shoot.Status.Credentials == nil || f.Shoot.Status.Credentials.Rotation == nil {
+				return nil
+			}
+			return f.Shoot.Status.Credentials.Rotation.Observability
+		},
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/test/framework"
+	"github.com/gardener/gardener/test/utils/rotation"
+)
+
+var _ = Describe("Rotation tests", func() {
+	var (
+		tmpdir string
+		path   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = os.MkdirTemp("", "e2e-")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() { Expect(os.RemoveAll(tmpdir)).To(Succeed()) })
+
+		path = filepath.Join(tmpdir, "checkpoints", "rotation.json")
+	})
+
+	Describe("RotationPhaseConfig", func() {
+		It("should include all phases if no phase is set", func() {
+			cfg := &framework.RotationPhaseConfig{}
+			Expect(cfg.Includes(framework.RotationPhaseStart)).To(BeTrue())
+			Expect(cfg.Includes(framework.RotationPhaseComplete)).To(BeTrue())
+		})
+
+		It("should only include the configured phase", func() {
+			cfg := &framework.RotationPhaseConfig{Phase: framework.RotationPhaseComplete}
+			Expect(cfg.Includes(framework.RotationPhaseStart)).To(BeFalse())
+			Expect(cfg.Includes(framework.RotationPhaseComplete)).To(BeTrue())
+		})
+	})
+
+	Describe("RotationCheckpoint", func() {
+		It("should persist and read the checkpoint", func() {
+			checkpoint := &framework.RotationCheckpoint{
+				ShootNamespace: "garden-dev",
+				ShootName:      "foo",
+				Operation:      "rotate-credentials-start",
+				StartTime:      metav1.Unix(100, 0),
+				SecretsBefore: rotation.SecretConfigNamesToSecrets{
+					"ca": {{ObjectMeta: metav1.ObjectMeta{Name: "ca-12345"}, Data: map[string][]byte{"ca.crt": []byte("cert")}}},
+				},
+			}
+
+			Expect(framework.WriteRotationCheckpoint(path, checkpoint)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			read, err := framework.ReadRotationCheckpoint(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.ShootNamespace).To(Equal("garden-dev"))
+			Expect(read.ShootName).To(Equal("foo"))
+			Expect(read.Operation).To(Equal("rotate-credentials-start"))
+			Expect(read.StartTime.Time.Equal(checkpoint.StartTime.Time)).To(BeTrue())
+			Expect(read.SecretsBefore).To(HaveKeyWithValue("ca", ConsistOf(corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-12345"},
+				Data:       map[string][]byte{"ca.crt": []byte("cert")},
+			})))
+		})
+
+		It("should fail reading a checkpoint which does not exist", func() {
+			_, err := framework.ReadRotationCheckpoint(path)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should remove the checkpoint and tolerate a missing checkpoint", func() {
+			Expect(framework.WriteRotationCheckpoint(path, &framework.RotationCheckpoint{})).To(Succeed())
+
+			Expect(framework.RemoveRotationCheckpoint(path)).To(Succeed())
+			Expect(path).NotTo(BeAnExistingFile())
+			Expect(framework.RemoveRotationCheckpoint(path)).To(Succeed())
+		})
+	})
+
+	Describe("#ObservabilityVerifier", func() {
+		var (
+			ctx = context.TODO()
+
+			f      *framework.ShootFramework
+			secret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "foo.monitoring",
+					Namespace:   "garden-dev",
+					Annotations: map[string]string{"url": "https://gu-foo--dev.example.com"},
+				},
+				Data: map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+			}
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(secret).Build()
+
+			f = &framework.ShootFramework{
+				GardenerFramework: &framework.GardenerFramework{
+					GardenClient: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				},
+				Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"}},
+			}
+		})
+
+		It("should read the observability secret and endpoint of the shoot", func() {
+			verifier := f.ObservabilityVerifier()
+
+			read, err := verifier.GetObservabilitySecretFunc(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.Data).To(Equal(secret.Data))
+			Expect(verifier.GetObservabilityEndpoint(read)).To(Equal("https://gu-foo--dev.example.com"))
+		})
+
+		It("should return a not found error if the observability secret does not exist", func() {
+			f.Shoot.Name = "bar"
+
+			_, err := f.GetObservabilitySecret(ctx)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return the observability rotation status of the current shoot", func() {
+			verifier := f.ObservabilityVerifier()
+			Expect(verifier.GetObservabilityRotation()).To(BeNil())
+
+			rotationStatus := &gardencorev1beta1.ObservabilityRotation{LastInitiationTime: &metav1.Time{}}
+			f.Shoot = &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Credentials: &gardencorev1beta1.ShootCredentials{
+				Rotation: &gardencorev1beta1.ShootCredentialsRotation{Observability: rotationStatus},
+			}}}
+			Expect(verifier.GetObservabilityRotation()).To(Equal(rotationStatus))
+		})
+	})
+})
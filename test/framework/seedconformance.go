@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// SeedConformanceReport contains the results of the conformance checks of a seed.
+type SeedConformanceReport struct {
+	// Seed is the name of the checked seed.
+	Seed string `json:"seed"`
+	// Start is the time when the first check was started.
+	Start time.Time `json:"start"`
+	// End is the time when the last check was finished.
+	End time.Time `json:"end"`
+	// Conformant is true if all checks passed.
+	Conformant bool `json:"conformant"`
+	// Checks are the results of the individual checks in the order they were run.
+	Checks []SeedConformanceCheckResult `json:"checks"`
+}
+
+// SeedConformanceCheckResult is the result of a single conformance check of a seed.
+type SeedConformanceCheckResult struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Start is the time when the check was started.
+	Start time.Time `json:"start"`
+	// End is the time when the check was finished.
+	End time.Time `json:"end"`
+	// DurationSeconds is the duration of the check.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Error is the error returned by the check. It is empty if the check passed.
+	Error string `json:"error,omitempty"`
+}
+
+// Passed returns true if the check passed.
+func (r SeedConformanceCheckResult) Passed() bool {
+	return r.Error == ""
+}
+
+// NewSeedConformanceReport returns an empty conformance report for the seed with the given name.
+func NewSeedConformanceReport(seedName string) *SeedConformanceReport {
+	return &SeedConformanceReport{
+		Seed:       seedName,
+		Start:      time.Now(),
+		Conformant: true,
+	}
+}
+
+// Run runs the given check, records its result in the report and returns its error.
+func (r *SeedConformanceReport) Run(ctx context.Context, name string, check func(context.Context) error) error {
+	result := SeedConformanceCheckResult{
+		Name:  name,
+		Start: time.Now(),
+	}
+
+	err := check(ctx)
+	if err != nil {
+		result.Error = err.Error()
+		r.Conformant = false
+	}
+	result.End = time.Now()
+	result.DurationSeconds = result.End.Sub(result.Start).Seconds()
+
+	r.Checks = append(r.Checks, result)
+	r.End = result.End
+
+	return err
+}
+
+// Err returns an error listing all failed checks. Nil is returned if all checks passed.
+func (r *SeedConformanceReport) Err() error {
+	var failures []string
+	for _, result := range r.Checks {
+		if !result.Passed() {
+			failures = append(failures, fmt.Sprintf("%s: %s", result.Name, result.Error))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("seed %q is not conformant, %d of %d checks failed: %s", r.Seed, len(failures), len(r.Checks), strings.Join(failures, "; "))
+}
+
+// FileName returns the name of the file the report is written to.
+func (r *SeedConformanceReport) FileName() string {
+	return fmt.Sprintf("seed-conformance-%s.json", r.Seed)
+}
+
+// WriteSeedConformanceReport writes the given report as JSON to the given directory.
+func WriteSeedConformanceReport(dir string, report *SeedConformanceReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed marshalling seed conformance report: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed creating directory for seed conformance report: %w", err)
+	}
+
+	path := filepath.Join(dir, report.FileName())
+	return path, os.WriteFile(path, data, 0600)
+}
+
+// CheckSeedConformanceConditions checks that the given seed is up-to-date and that all conditions relevant for hosting
+// shoot control planes are true. The BackupBucketsReady condition is only checked if the seed is configured with backup.
+func CheckSeedConformanceConditions(seed *gardencorev1beta1.Seed) error {
+	if seed.Status.ObservedGeneration < seed.Generation {
+		return fmt.Errorf("observed generation outdated (%d/%d)", seed.Status.ObservedGeneration, seed.Generation)
+	}
+
+	conditionTypes := []gardencorev1beta1.ConditionType{
+		gardencorev1beta1.SeedGardenletReady,
+		gardencorev1beta1.SeedSystemComponentsHealthy,
+		gardencorev1beta1.SeedExtensionsReady,
+	}
+	if seed.Spec.Backup != nil {
+		conditionTypes = append(conditionTypes, gardencorev1beta1.SeedBackupBucketsReady)
+	}
+
+	var errs []error
+	for _, conditionType := range conditionTypes {
+		condition := v1beta1helper.GetCondition(seed.Status.Conditions, conditionType)
+		if condition == nil {
+			errs = append(errs, fmt.Errorf("condition %q is missing", conditionType))
+			continue
+		}
+		if condition.Status != gardencorev1beta1.ConditionTrue {
+			errs = append(errs, fmt.Errorf("condition %q has status %q: %s", conditionType, condition.Status, condition.Message))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckBackupBucketConformance checks that the given BackupBucket was successfully reconciled.
+func CheckBackupBucketConformance(backupBucket *gardencorev1beta1.BackupBucket) error {
+	if backupBucket.Status.ObservedGeneration < backupBucket.Generation {
+		return fmt.Errorf("observed generation of BackupBucket %q outdated (%d/%d)", backupBucket.Name, backupBucket.Status.ObservedGeneration, backupBucket.Generation)
+	}
+	if backupBucket.Status.LastError != nil {
+		return fmt.Errorf("BackupBucket %q reports an error: %s", backupBucket.Name, backupBucket.Status.LastError.Description)
+	}
+	if lastOperation := backupBucket.Status.LastOperation; lastOperation == nil || lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return fmt.Errorf("BackupBucket %q was not successfully reconciled yet", backupBucket.Name)
+	}
+
+	return nil
+}
+
+// LoadBalancerAddresses returns the IPs and hostnames the given LoadBalancer service is exposed with.
+func LoadBalancerAddresses(service *corev1.Service) []string {
+	var addresses []string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			addresses = append(addresses, ingress.Hostname)
+		} else if ingress.IP != "" {
+			addresses = append(addresses, ingress.IP)
+		}
+	}
+	return addresses
+}
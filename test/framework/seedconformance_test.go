@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Seed conformance", func() {
+	var ctx = context.TODO()
+
+	Describe("#SeedConformanceReport", func() {
+		It("should record the results of all checks", func() {
+			report := framework.NewSeedConformanceReport("seed")
+
+			Expect(report.Run(ctx, "foo", func(_ context.Context) error { return nil })).To(Succeed())
+			Expect(report.Run(ctx, "bar", func(_ context.Context) error { return errors.New("fake") })).To(MatchError("fake"))
+			Expect(report.Run(ctx, "baz", func(_ context.Context) error { return nil })).To(Succeed())
+
+			Expect(report.Conformant).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(3))
+			Expect(report.Checks[0].Name).To(Equal("foo"))
+			Expect(report.Checks[0].Passed()).To(BeTrue())
+			Expect(report.Checks[1].Name).To(Equal("bar"))
+			Expect(report.Checks[1].Error).To(Equal("fake"))
+			Expect(report.Checks[2].Name).To(Equal("baz"))
+			Expect(report.Checks[2].Passed()).To(BeTrue())
+			Expect(report.End).To(Equal(report.Checks[2].End))
+			Expect(report.Err()).To(MatchError(`seed "seed" is not conformant, 1 of 3 checks failed: bar: fake`))
+		})
+
+		It("should be conformant if all checks passed", func() {
+			report := framework.NewSeedConformanceReport("seed")
+
+			Expect(report.Run(ctx, "foo", func(_ context.Context) error { return nil })).To(Succeed())
+
+			Expect(report.Conformant).To(BeTrue())
+			Expect(report.Err()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#WriteSeedConformanceReport", func() {
+		It("should write the report as JSON", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "reports")
+			report := &framework.SeedConformanceReport{
+				Seed:   "seed",
+				Checks: []framework.SeedConformanceCheckResult{{Name: "foo", Error: "fake"}},
+			}
+
+			path, err := framework.WriteSeedConformanceReport(dir, report)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(dir, "seed-conformance-seed.json")))
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			written := &framework.SeedConformanceReport{}
+			Expect(json.Unmarshal(data, written)).To(Succeed())
+			Expect(written).To(Equal(report))
+		})
+	})
+
+	Describe("#CheckSeedConformanceConditions", func() {
+		var seed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: gardencorev1beta1.SeedStatus{
+					ObservedGeneration: 1,
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.SeedSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+						{Type: gardencorev1beta1.SeedExtensionsReady, Status: gardencorev1beta1.ConditionTrue},
+					},
+				},
+			}
+		})
+
+		It("should succeed if all conditions are true", func() {
+			Expect(framework.CheckSeedConformanceConditions(seed)).To(Succeed())
+		})
+
+		It("should fail if the observed generation is outdated", func() {
+			seed.Generation = 2
+
+			Expect(framework.CheckSeedConformanceConditions(seed)).To(MatchError("observed generation outdated (1/2)"))
+		})
+
+		It("should fail if a condition is not true", func() {
+			seed.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+			seed.Status.Conditions[1].Message = "foo is unhealthy"
+
+			Expect(framework.CheckSeedConformanceConditions(seed)).To(MatchError(`condition "SeedSystemComponentsHealthy" has status "False": foo is unhealthy`))
+		})
+
+		It("should require the backup buckets condition if backup is configured", func() {
+			seed.Spec.Backup = &gardencorev1beta1.SeedBackup{Provider: "local"}
+
+			Expect(framework.CheckSeedConformanceConditions(seed)).To(MatchError(`condition "BackupBucketsReady" is missing`))
+		})
+	})
+
+	Describe("#CheckBackupBucketConformance", func() {
+		var backupBucket *gardencorev1beta1.BackupBucket
+
+		BeforeEach(func() {
+			backupBucket = &gardencorev1beta1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket", Generation: 1},
+				Status: gardencorev1beta1.BackupBucketStatus{
+					ObservedGeneration: 1,
+					LastOperation:      &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+		})
+
+		It("should succeed if the backup bucket was reconciled successfully", func() {
+			Expect(framework.CheckBackupBucketConformance(backupBucket)).To(Succeed())
+		})
+
+		It("should fail if the backup bucket reports an error", func() {
+			backupBucket.Status.LastError = &gardencorev1beta1.LastError{Description: "access denied"}
+
+			Expect(framework.CheckBackupBucketConformance(backupBucket)).To(MatchError(`BackupBucket "bucket" reports an error: access denied`))
+		})
+
+		It("should fail if the backup bucket was not reconciled successfully yet", func() {
+			backupBucket.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+
+			Expect(framework.CheckBackupBucketConformance(backupBucket)).To(MatchError(`BackupBucket "bucket" was not successfully reconciled yet`))
+		})
+	})
+
+	Describe("#LoadBalancerAddresses", func() {
+		It("should return the hostnames and IPs of the load balancer", func() {
+			service := &corev1.Service{Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+				{Hostname: "foo.example.com"},
+				{IP: "1.2.3.4"},
+				{},
+			}}}}
+
+			Expect(framework.LoadBalancerAddresses(service)).To(Equal([]string{"foo.example.com", "1.2.3.4"}))
+		})
+	})
+})
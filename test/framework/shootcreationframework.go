@@ -67,6 +67,9 @@ type ShootCreationFramework struct {
 
 	// ShootFramework is initialized once the shoot has been created successfully
 	ShootFramework *ShootFramework
+
+	// LoadTestShoots are the shoots created from the shoot template by CreateShootsAndWaitForCreation
+	LoadTestShoots []*gardencorev1beta1.Shoot
 }
 
 // NewShootCreationFramework creates a new simple Shoot creation framework
@@ -397,6 +400,7 @@ func (f *ShootCreationFramework) CreateShootAndWaitForCreation(ctx context.Conte
 	}
 	f.ShootFramework = shootFramework
 	f.Shoot = shootFramework.Shoot
+	shootFramework.AddShootReportEntry()
 
 	if f.Config.shootKubeconfigPath == "" {
 		f.Logger.Info("Shoot kubeconfig path is not specified, skipping downloading the admin kubeconfig for the Shoot")
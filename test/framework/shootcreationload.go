@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// ShootLoadTestOperationCreate is the name of the operation creating the shoots used in load test reports.
+	ShootLoadTestOperationCreate = "create"
+	// ShootLoadTestOperationDelete is the name of the operation deleting the shoots used in load test reports.
+	ShootLoadTestOperationDelete = "delete"
+)
+
+// ShootCreationLoadTestConfig is the configuration for creating multiple shoots concurrently from the same template.
+type ShootCreationLoadTestConfig struct {
+	// ShootCount is the number of shoots which are created.
+	ShootCount int
+	// Concurrency is the maximum number of shoots which are created or deleted at the same time. All shoots are
+	// processed at the same time if it is not positive.
+	Concurrency int
+	// ReportDir is the directory the load test reports are written to. Reports are not written if empty.
+	ReportDir string
+}
+
+// RegisterShootCreationLoadTestFlags adds all flags that are needed to configure the creation of multiple shoots to the
+// provided flagset.
+func RegisterShootCreationLoadTestFlags() *ShootCreationLoadTestConfig {
+	newCfg := &ShootCreationLoadTestConfig{}
+
+	flag.IntVar(&newCfg.ShootCount, "load-test-shoot-count", 1, "number of shoots which are created concurrently from the shoot template")
+	flag.IntVar(&newCfg.Concurrency, "load-test-concurrency", 0, "maximum number of shoots which are created or deleted at the same time (all at once if unset)")
+	flag.StringVar(&newCfg.ReportDir, "load-test-report-dir", "", "directory the per-shoot latencies and failure reasons are written to as JSON (not written if unset)")
+
+	return newCfg
+}
+
+// ShootLoadTestReport contains the results of creating or deleting multiple shoots concurrently.
+type ShootLoadTestReport struct {
+	// Operation is the performed operation, i.e. 'create' or 'delete'.
+	Operation string `json:"operation"`
+	// Concurrency is the maximum number of shoots which were processed at the same time.
+	Concurrency int `json:"concurrency"`
+	// Start is the time when the operation was started for the first shoot.
+	Start time.Time `json:"start"`
+	// End is the time when the operation was finished for the last shoot.
+	End time.Time `json:"end"`
+	// DurationSeconds is the total duration of the operation.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Succeeded is the number of shoots for which the operation succeeded.
+	Succeeded int `json:"succeeded"`
+	// Failed is the number of shoots for which the operation failed.
+	Failed int `json:"failed"`
+	// Shoots are the results of the individual shoots in the order of the given shoots.
+	Shoots []ShootLoadTestResult `json:"shoots"`
+}
+
+// ShootLoadTestResult is the result of creating or deleting a single shoot.
+type ShootLoadTestResult struct {
+	// Namespace is the namespace of the shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the shoot.
+	Name string `json:"name"`
+	// Start is the time when the operation was started for the shoot.
+	Start time.Time `json:"start"`
+	// End is the time when the operation was finished for the shoot.
+	End time.Time `json:"end"`
+	// DurationSeconds is the latency of the operation for the shoot.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Error is the error returned by the operation. It is empty if the operation succeeded.
+	Error string `json:"error,omitempty"`
+	// FailureReason is the reason why the operation failed as reported in the status of the shoot.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// Succeeded returns true if the operation succeeded for the shoot.
+func (r ShootLoadTestResult) Succeeded() bool {
+	return r.Error == ""
+}
+
+// NewLoadTestShoots returns the given number of copies of the given shoot template. The names of the copies are
+// suffixed with their index.
+func NewLoadTestShoots(template *gardencorev1beta1.Shoot, count int) []*gardencorev1beta1.Shoot {
+	shoots := make([]*gardencorev1beta1.Shoot, 0, count)
+	for i := range count {
+		shoot := template.DeepCopy()
+		shoot.Name = fmt.Sprintf("%s-%d", template.Name, i)
+		shoots = append(shoots, shoot)
+	}
+	return shoots
+}
+
+// RunShootLoadTest calls the given function for all given shoots with at most the given number of concurrent calls and
+// records the latency and the error of every call. All calls are performed at the same time if the concurrency is not
+// positive.
+func RunShootLoadTest(ctx context.Context, operation string, shoots []*gardencorev1beta1.Shoot, concurrency int, fn func(context.Context, *gardencorev1beta1.Shoot) error) *ShootLoadTestReport {
+	if concurrency <= 0 || concurrency > len(shoots) {
+		concurrency = len(shoots)
+	}
+
+	report := &ShootLoadTestReport{
+		Operation:   operation,
+		Concurrency: concurrency,
+		Start:       time.Now(),
+		Shoots:      make([]ShootLoadTestResult, len(shoots)),
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, max(concurrency, 1))
+	)
+
+	for i, shoot := range shoots {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := ShootLoadTestResult{
+				Namespace: shoot.Namespace,
+				Name:      shoot.Name,
+				Start:     time.Now(),
+			}
+			if err := fn(ctx, shoot); err != nil {
+				result.Error = err.Error()
+			}
+			result.End = time.Now()
+			result.DurationSeconds = result.End.Sub(result.Start).Seconds()
+
+			report.Shoots[i] = result
+		}()
+	}
+	wg.Wait()
+
+	report.End = time.Now()
+	report.DurationSeconds = report.End.Sub(report.Start).Seconds()
+	for _, result := range report.Shoots {
+		if result.Succeeded() {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report
+}
+
+// Err returns an error listing all shoots for which the operation failed. Nil is returned if the operation succeeded for
+// all shoots.
+func (r *ShootLoadTestReport) Err() error {
+	if r.Failed == 0 {
+		return nil
+	}
+
+	var failures []string
+	for _, result := range r.Shoots {
+		if !result.Succeeded() {
+			failures = append(failures, fmt.Sprintf("%s/%s: %s", result.Namespace, result.Name, result.Error))
+		}
+	}
+
+	return fmt.Errorf("failed to %s %d of %d shoots: %s", r.Operation, r.Failed, len(r.Shoots), strings.Join(failures, "; "))
+}
+
+// FileName returns the name of the file the report is written to.
+func (r *ShootLoadTestReport) FileName() string {
+	return fmt.Sprintf("shoot-load-test-%s.json", r.Operation)
+}
+
+// WriteShootLoadTestReport writes the given report as JSON to the given directory.
+func WriteShootLoadTestReport(dir string, report *ShootLoadTestReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed marshalling load test report: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed creating directory for load test report: %w", err)
+	}
+
+	path := filepath.Join(dir, report.FileName())
+	return path, os.WriteFile(path, data, 0600)
+}
+
+// ShootFailureReason returns the reason why the last operation of the given shoot failed. The descriptions of the last
+// errors are preferred over the description of the last operation. An empty string is returned if the shoot does not
+// report any error.
+func ShootFailureReason(shoot *gardencorev1beta1.Shoot) string {
+	if len(shoot.Status.LastErrors) > 0 {
+		descriptions := make([]string, 0, len(shoot.Status.LastErrors))
+		for _, lastError := range shoot.Status.LastErrors {
+			descriptions = append(descriptions, lastError.Description)
+		}
+		return strings.Join(sets.List(sets.New(descriptions...)), "; ")
+	}
+
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil &&
+		(lastOperation.State == gardencorev1beta1.LastOperationStateFailed || lastOperation.State == gardencorev1beta1.LastOperationStateError) {
+		return lastOperation.Description
+	}
+
+	return ""
+}
+
+// CreateShootsAndWaitForCreation creates the configured number of shoots concurrently from the shoot template of this
+// framework and waits for their successful creation. The names of the shoots are suffixed with their index. The created
+// shoots are stored in LoadTestShoots so that they can be deleted with DeleteLoadTestShoots afterwards. The returned
+// report contains the creation latency and the failure reason of every shoot; it is also written to the report
+// directory of the given configuration.
+func (f *ShootCreationFramework) CreateShootsAndWaitForCreation(ctx context.Context, cfg *ShootCreationLoadTestConfig, initializeShootWithFlags bool) (*ShootLoadTestReport, error) {
+	if cfg == nil || cfg.ShootCount <= 0 {
+		return nil, fmt.Errorf("the number of shoots to create must be positive")
+	}
+
+	if initializeShootWithFlags {
+		if err := f.InitializeShootWithFlags(ctx); err != nil {
+			return nil, err
+		}
+	} else if f.Shoot.Namespace == "" {
+		f.Shoot.Namespace = f.ProjectNamespace
+	}
+
+	f.LoadTestShoots = NewLoadTestShoots(f.Shoot, cfg.ShootCount)
+	f.Logger.Info("Creating shoots", "count", cfg.ShootCount, "concurrency", cfg.Concurrency, "template", f.Shoot.Name)
+
+	report := RunShootLoadTest(ctx, ShootLoadTestOperationCreate, f.LoadTestShoots, cfg.Concurrency, func(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+		log := f.Logger.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		if err := f.GardenerFramework.CreateShoot(ctx, shoot); err != nil {
+			log.Error(err, "Failed creating shoot")
+			return err
+		}
+
+		log.Info("Successfully created shoot")
+		return nil
+	})
+	f.addFailureReasons(ctx, report)
+
+	return report, f.writeShootLoadTestReport(cfg, report)
+}
+
+// DeleteLoadTestShoots deletes the shoots created by CreateShootsAndWaitForCreation concurrently and waits for their
+// deletion. The returned report contains the deletion latency and the failure reason of every shoot; it is also written
+// to the report directory of the given configuration.
+func (f *ShootCreationFramework) DeleteLoadTestShoots(ctx context.Context, cfg *ShootCreationLoadTestConfig) (*ShootLoadTestReport, error) {
+	var concurrency int
+	if cfg != nil {
+		concurrency = cfg.Concurrency
+	}
+
+	f.Logger.Info("Deleting shoots", "count", len(f.LoadTestShoots), "concurrency", concurrency)
+
+	report := RunShootLoadTest(ctx, ShootLoadTestOperationDelete, f.LoadTestShoots, concurrency, f.GardenerFramework.DeleteShootAndWaitForDeletion)
+	f.addFailureReasons(ctx, report)
+
+	return report, f.writeShootLoadTestReport(cfg, report)
+}
+
+// addFailureReasons reads the shoots for which the operation failed and adds the reason reported in their status to
+// the given report.
+func (f *ShootCreationFramework) addFailureReasons(ctx context.Context, report *ShootLoadTestReport) {
+	for i, result := range report.Shoots {
+		if result.Succeeded() {
+			continue
+		}
+
+		var (
+			shoot = &gardencorev1beta1.Shoot{}
+			key   = client.ObjectKey{Namespace: result.Namespace, Name: result.Name}
+		)
+		if err := f.GardenClient.Client().Get(ctx, key, shoot); err != nil {
+			f.Logger.Info("Failed reading shoot, load test report does not contain failure reason", "shoot", key, "error", err.Error())
+			continue
+		}
+
+		report.Shoots[i].FailureReason = ShootFailureReason(shoot)
+	}
+}
+
+func (f *ShootCreationFramework) writeShootLoadTestReport(cfg *ShootCreationLoadTestConfig, report *ShootLoadTestReport) error {
+	f.Logger.Info("Finished load test", "operation", report.Operation, "succeeded", report.Succeeded, "failed", report.Failed, "duration", time.Duration(report.DurationSeconds*float64(time.Second)))
+
+	if cfg == nil || cfg.ReportDir == "" {
+		return nil
+	}
+
+	path, err := WriteShootLoadTestReport(cfg.ReportDir, report)
+	if err != nil {
+		return err
+	}
+
+	f.Logger.Info("Wrote load test report", "path", path, "operation", report.Operation)
+	return nil
+}
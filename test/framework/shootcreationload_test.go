@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Shoot creation load test", func() {
+	var (
+		ctx = context.TODO()
+
+		template *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		template = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "load", Namespace: "garden-dev"},
+			Spec:       gardencorev1beta1.ShootSpec{Region: "local"},
+		}
+	})
+
+	Describe("#NewLoadTestShoots", func() {
+		It("should return copies of the template with suffixed names", func() {
+			shoots := framework.NewLoadTestShoots(template, 3)
+
+			Expect(shoots).To(HaveLen(3))
+			for i, name := range []string{"load-0", "load-1", "load-2"} {
+				Expect(shoots[i].Name).To(Equal(name))
+				Expect(shoots[i].Namespace).To(Equal("garden-dev"))
+				Expect(shoots[i].Spec).To(Equal(template.Spec))
+			}
+			Expect(template.Name).To(Equal("load"))
+		})
+	})
+
+	Describe("#RunShootLoadTest", func() {
+		It("should record the results of all shoots", func() {
+			shoots := framework.NewLoadTestShoots(template, 3)
+
+			report := framework.RunShootLoadTest(ctx, framework.ShootLoadTestOperationCreate, shoots, 0, func(_ context.Context, shoot *gardencorev1beta1.Shoot) error {
+				if shoot.Name == "load-1" {
+					return errors.New("fake")
+				}
+				return nil
+			})
+
+			Expect(report.Operation).To(Equal("create"))
+			Expect(report.Concurrency).To(Equal(3))
+			Expect(report.Succeeded).To(Equal(2))
+			Expect(report.Failed).To(Equal(1))
+			Expect(report.Shoots).To(HaveLen(3))
+			Expect(report.Shoots[0].Name).To(Equal("load-0"))
+			Expect(report.Shoots[0].Succeeded()).To(BeTrue())
+			Expect(report.Shoots[1].Name).To(Equal("load-1"))
+			Expect(report.Shoots[1].Error).To(Equal("fake"))
+			Expect(report.Shoots[2].Name).To(Equal("load-2"))
+			Expect(report.Shoots[2].Succeeded()).To(BeTrue())
+			Expect(report.Err()).To(MatchError("failed to create 1 of 3 shoots: garden-dev/load-1: fake"))
+
+			for _, result := range report.Shoots {
+				Expect(result.End).NotTo(BeTemporally("<", result.Start))
+				Expect(result.Start).NotTo(BeTemporally("<", report.Start))
+				Expect(result.End).NotTo(BeTemporally(">", report.End))
+			}
+		})
+
+		It("should not exceed the concurrency", func() {
+			var (
+				lock              sync.Mutex
+				running, observed int
+				calls             atomic.Int32
+			)
+
+			report := framework.RunShootLoadTest(ctx, framework.ShootLoadTestOperationDelete, framework.NewLoadTestShoots(template, 6), 2, func(_ context.Context, _ *gardencorev1beta1.Shoot) error {
+				calls.Add(1)
+
+				lock.Lock()
+				running++
+				observed = max(observed, running)
+				lock.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				running--
+				lock.Unlock()
+				return nil
+			})
+
+			Expect(calls.Load()).To(BeEquivalentTo(6))
+			Expect(observed).To(BeNumerically("<=", 2))
+			Expect(report.Concurrency).To(Equal(2))
+			Expect(report.Succeeded).To(Equal(6))
+			Expect(report.Err()).NotTo(HaveOccurred())
+		})
+
+		It("should handle an empty list of shoots", func() {
+			report := framework.RunShootLoadTest(ctx, framework.ShootLoadTestOperationDelete, nil, 0, func(_ context.Context, _ *gardencorev1beta1.Shoot) error {
+				return errors.New("should not be called")
+			})
+
+			Expect(report.Shoots).To(BeEmpty())
+			Expect(report.Err()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#ShootFailureReason", func() {
+		It("should return the descriptions of the last errors", func() {
+			template.Status.LastErrors = []gardencorev1beta1.LastError{{Description: "foo"}, {Description: "bar"}, {Description: "foo"}}
+			template.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed, Description: "failed"}
+
+			Expect(framework.ShootFailureReason(template)).To(Equal("bar; foo"))
+		})
+
+		It("should return the description of the failed last operation", func() {
+			template.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed, Description: "failed"}
+
+			Expect(framework.ShootFailureReason(template)).To(Equal("failed"))
+		})
+
+		It("should return nothing if the last operation did not fail", func() {
+			template.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing, Description: "processing"}
+
+			Expect(framework.ShootFailureReason(template)).To(BeEmpty())
+		})
+	})
+
+	Describe("#WriteShootLoadTestReport", func() {
+		It("should write the report as JSON", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "reports")
+			report := &framework.ShootLoadTestReport{
+				Operation: framework.ShootLoadTestOperationCreate,
+				Failed:    1,
+				Shoots:    []framework.ShootLoadTestResult{{Namespace: "garden-dev", Name: "load-0", Error: "fake", FailureReason: "quota exceeded"}},
+			}
+
+			path, err := framework.WriteShootLoadTestReport(dir, report)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(dir, "shoot-load-test-create.json")))
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			written := &framework.ShootLoadTestReport{}
+			Expect(json.Unmarshal(data, written)).To(Succeed())
+			Expect(written).To(Equal(report))
+		})
+	})
+})
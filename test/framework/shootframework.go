@@ -17,6 +17,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -24,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework/reporter"
 	"github.com/gardener/gardener/test/utils/access"
 )
 
@@ -39,6 +41,8 @@ type ShootConfig struct {
 	CreateTestNamespace         bool
 	DisableTestNamespaceCleanup bool
 	SkipSeedInitialization      bool
+	// BastionIngressCIDR is the CIDR from which the bastion hosts created by NodeSSH are reachable.
+	BastionIngressCIDR string
 }
 
 // ShootFramework represents the shoot test framework that includes
@@ -57,6 +61,8 @@ type ShootFramework struct {
 	Project      *gardencorev1beta1.Project
 
 	Namespace string
+
+	nodeAccess *nodeAccess
 }
 
 // NewShootFramework creates a new simple Shoot framework
@@ -83,6 +89,7 @@ func (f *ShootFramework) BeforeEach(ctx context.Context) {
 	validateShootConfig(f.Config)
 	err := f.AddShoot(ctx, f.Config.ShootName, f.ProjectNamespace)
 	ExpectNoError(err)
+	f.AddShootReportEntry()
 
 	if f.Config.CreateTestNamespace {
 		_, err := f.CreateNewNamespace(ctx)
@@ -195,6 +202,22 @@ func (f *ShootFramework) AddShoot(ctx context.Context, shootName, shootNamespace
 	return nil
 }
 
+// AddShootReportEntry adds the metadata of the framework's shoot to the report of the current spec, see
+// reporter.ReportSummary.
+func (f *ShootFramework) AddShootReportEntry() {
+	if f.Shoot == nil {
+		return
+	}
+
+	metadata := reporter.ShootMetadata{
+		Name:      f.Shoot.Name,
+		Namespace: f.Shoot.Namespace,
+		Seed:      ptr.Deref(f.Shoot.Spec.SeedName, ""),
+		Provider:  f.Shoot.Spec.Provider.Type,
+	}
+	ginkgo.AddReportEntry(reporter.ShootReportEntryName, metadata, ginkgo.ReportEntryVisibilityNever)
+}
+
 func validateShootConfig(cfg *ShootConfig) {
 	if cfg == nil {
 		ginkgo.Fail("no shoot framework configuration provided")
@@ -225,6 +248,9 @@ func mergeShootConfig(base, overwrite *ShootConfig) *ShootConfig {
 	if overwrite.DisableTestNamespaceCleanup {
 		base.DisableTestNamespaceCleanup = overwrite.DisableTestNamespaceCleanup
 	}
+	if StringSet(overwrite.BastionIngressCIDR) {
+		base.BastionIngressCIDR = overwrite.BastionIngressCIDR
+	}
 
 	return base
 }
@@ -238,19 +264,21 @@ func RegisterShootFrameworkFlags() *ShootConfig {
 	flag.StringVar(&newCfg.ShootName, "shoot-name", "", "name of the shoot")
 	flag.BoolVar(&newCfg.Fenced, "fenced", false,
 		"indicates if the shoot is running in a fenced environment which means that the shoot can only be reached from the gardenlet")
+	flag.StringVar(&newCfg.BastionIngressCIDR, "bastion-ingress-cidr", "",
+		"CIDR from which the bastion hosts for SSH access to the shoot's nodes are reachable (defaults to 0.0.0.0/0)")
 
 	shootCfg = newCfg
 	return shootCfg
 }
 
 // HibernateShoot hibernates the shoot of the framework
-func (f *ShootFramework) HibernateShoot(ctx context.Context) error {
-	return f.GardenerFramework.HibernateShoot(ctx, f.Shoot)
+func (f *ShootFramework) HibernateShoot(ctx context.Context, opts ...HibernationOption) error {
+	return f.GardenerFramework.HibernateShoot(ctx, f.Shoot, opts...)
 }
 
 // WakeUpShoot wakes up the hibernated shoot of the framework
-func (f *ShootFramework) WakeUpShoot(ctx context.Context) error {
-	return f.GardenerFramework.WakeUpShoot(ctx, f.Shoot)
+func (f *ShootFramework) WakeUpShoot(ctx context.Context, opts ...HibernationOption) error {
+	return f.GardenerFramework.WakeUpShoot(ctx, f.Shoot, opts...)
 }
 
 // UpdateShoot Updates a shoot from a shoot Object and waits for its reconciliation
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// WorkerPoolFunc is called for a worker pool of the shoot together with the nodes which belong to this pool.
+type WorkerPoolFunc func(ctx context.Context, pool gardencorev1beta1.Worker, nodes []corev1.Node) error
+
+// GroupNodesByWorkerPool groups the given nodes by the value of their worker pool label. Nodes without this label are
+// ignored.
+func GroupNodesByWorkerPool(nodes []corev1.Node) map[string][]corev1.Node {
+	nodesByPool := make(map[string][]corev1.Node)
+	for _, node := range nodes {
+		pool, ok := node.Labels[v1beta1constants.LabelWorkerPool]
+		if !ok {
+			continue
+		}
+		nodesByPool[pool] = append(nodesByPool[pool], node)
+	}
+	return nodesByPool
+}
+
+// CheckWorkerPoolNodes checks that the number of the given nodes is within the minimum and maximum of the worker pool
+// and that all of them are healthy.
+func CheckWorkerPoolNodes(pool gardencorev1beta1.Worker, nodes []corev1.Node) error {
+	if count := int32(len(nodes)); count < pool.Minimum || count > pool.Maximum {
+		return fmt.Errorf("expected between %d and %d nodes but found %d", pool.Minimum, pool.Maximum, count)
+	}
+
+	for _, node := range nodes {
+		if err := health.CheckNode(&node); err != nil {
+			return fmt.Errorf("node %q is not healthy: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ForEachWorkerPool resolves the nodes of every worker pool of the shoot via the worker pool label and calls <fn> for
+// each pool in the order of the shoot specification. All pools are visited, the errors of all calls are returned.
+func (f *ShootFramework) ForEachWorkerPool(ctx context.Context, fn WorkerPoolFunc) error {
+	if f.ShootClient == nil {
+		return errors.New("no shoot client available")
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := f.ShootClient.Client().List(ctx, nodeList, client.HasLabels{v1beta1constants.LabelWorkerPool}); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+	nodesByPool := GroupNodesByWorkerPool(nodeList.Items)
+
+	var errs []error
+	for _, pool := range f.Shoot.Spec.Provider.Workers {
+		if err := fn(ctx, pool, nodesByPool[pool.Name]); err != nil {
+			errs = append(errs, fmt.Errorf("worker pool %q: %w", pool.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WaitForWorkerPoolNodes waits until the number of nodes of every worker pool of the shoot is within the pool's minimum
+// and maximum and all nodes are healthy.
+func (f *ShootFramework) WaitForWorkerPoolNodes(ctx context.Context, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if err := f.ForEachWorkerPool(ctx, func(_ context.Context, pool gardencorev1beta1.Worker, nodes []corev1.Node) error {
+			return CheckWorkerPoolNodes(pool, nodes)
+		}); err != nil {
+			f.Logger.Info("Waiting for nodes of worker pools", "reason", err.Error())
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	})
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/test/framework"
+)
+
+var _ = Describe("Worker pool utils", func() {
+	newNode := func(name, pool string, ready corev1.ConditionStatus) corev1.Node {
+		node := corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: ready},
+			}},
+		}
+		if pool != "" {
+			node.Labels = map[string]string{"worker.gardener.cloud/pool": pool}
+		}
+		return node
+	}
+
+	Describe("#GroupNodesByWorkerPool", func() {
+		It("should group the nodes by their worker pool and ignore nodes without pool", func() {
+			var (
+				node1 = newNode("node-1", "foo", corev1.ConditionTrue)
+				node2 = newNode("node-2", "bar", corev1.ConditionTrue)
+				node3 = newNode("node-3", "foo", corev1.ConditionTrue)
+				node4 = newNode("node-4", "", corev1.ConditionTrue)
+			)
+
+			Expect(framework.GroupNodesByWorkerPool([]corev1.Node{node1, node2, node3, node4})).To(Equal(map[string][]corev1.Node{
+				"foo": {node1, node3},
+				"bar": {node2},
+			}))
+		})
+	})
+
+	Describe("#CheckWorkerPoolNodes", func() {
+		pool := gardencorev1beta1.Worker{Name: "foo", Minimum: 1, Maximum: 2}
+
+		It("should succeed if the number of nodes is within the limits and all nodes are healthy", func() {
+			Expect(framework.CheckWorkerPoolNodes(pool, []corev1.Node{
+				newNode("node-1", "foo", corev1.ConditionTrue),
+				newNode("node-2", "foo", corev1.ConditionTrue),
+			})).To(Succeed())
+		})
+
+		It("should fail if there are too few nodes", func() {
+			Expect(framework.CheckWorkerPoolNodes(pool, nil)).To(MatchError("expected between 1 and 2 nodes but found 0"))
+		})
+
+		It("should fail if there are too many nodes", func() {
+			Expect(framework.CheckWorkerPoolNodes(pool, []corev1.Node{
+				newNode("node-1", "foo", corev1.ConditionTrue),
+				newNode("node-2", "foo", corev1.ConditionTrue),
+				newNode("node-3", "foo", corev1.ConditionTrue),
+			})).To(MatchError("expected between 1 and 2 nodes but found 3"))
+		})
+
+		It("should fail if a node is not healthy", func() {
+			Expect(framework.CheckWorkerPoolNodes(pool, []corev1.Node{
+				newNode("node-1", "foo", corev1.ConditionTrue),
+				newNode("node-2", "foo", corev1.ConditionFalse),
+			})).To(MatchError(ContainSubstring(`node "node-2" is not healthy`)))
+		})
+	})
+})
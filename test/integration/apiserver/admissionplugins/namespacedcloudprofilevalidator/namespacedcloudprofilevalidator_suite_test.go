@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacedcloudprofilevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	gardenerenvtest "github.com/gardener/gardener/test/envtest"
+)
+
+func TestNamespacedCloudProfileValidator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration APIServer AdmissionPlugins NamespacedCloudProfileValidator Suite")
+}
+
+// testID is used for generating test namespace names and other IDs
+const testID = "namespacedcloudprofilevalidator-test"
+
+var (
+	ctx = context.Background()
+	log logr.Logger
+
+	restConfig *rest.Config
+	testEnv    *gardenerenvtest.GardenerTestEnvironment
+	testClient client.Client
+
+	testNamespace     *corev1.Namespace
+	cloudProfile      *gardencorev1beta1.CloudProfile
+	testSecretBinding *gardencorev1beta1.SecretBinding
+)
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+	log = logf.Log.WithName(testID)
+
+	By("Start test environment")
+	testEnv = &gardenerenvtest.GardenerTestEnvironment{
+		GardenerAPIServer: &gardenerenvtest.GardenerAPIServer{
+			Args: []string{
+				"--disable-admission-plugins=DeletionConfirmation,ResourceReferenceManager,ExtensionValidator,ShootDNS,SeedValidator",
+				"--feature-gates=UseNamespacedCloudProfile=true",
+			},
+		},
+	}
+
+	var err error
+	restConfig, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(restConfig).NotTo(BeNil())
+
+	DeferCleanup(func() {
+		By("Stop test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	})
+
+	By("Create test client")
+	testClient, err = client.New(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("Create test Namespace")
+	testNamespace = &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			// create dedicated namespace for each test run, so that we can run multiple tests concurrently for stress tests
+			GenerateName: "garden-",
+		},
+	}
+	Expect(testClient.Create(ctx, testNamespace)).To(Succeed())
+	log.Info("Created Namespace for test", "namespaceName", testNamespace.Name)
+
+	DeferCleanup(func() {
+		By("Delete test Namespace")
+		Expect(testClient.Delete(ctx, testNamespace)).To(Or(Succeed(), BeNotFoundError()))
+	})
+
+	By("Create Project")
+	project := &gardencorev1beta1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+		},
+		Spec: gardencorev1beta1.ProjectSpec{
+			Namespace: &testNamespace.Name,
+		},
+	}
+	Expect(testClient.Create(ctx, project)).To(Succeed())
+	log.Info("Created Project for test", "project", client.ObjectKeyFromObject(project))
+
+	DeferCleanup(func() {
+		By("Delete Project")
+		Expect(client.IgnoreNotFound(testClient.Delete(ctx, project))).To(Succeed())
+	})
+
+	By("Create CloudProfile")
+	cloudProfile = &gardencorev1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: testID + "-",
+		},
+		Spec: gardencorev1beta1.CloudProfileSpec{
+			Kubernetes: gardencorev1beta1.KubernetesSettings{
+				Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.28.2"}},
+			},
+			MachineImages: []gardencorev1beta1.MachineImage{
+				{
+					Name: "some-OS",
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.1"},
+							CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+						},
+					},
+				},
+			},
+			MachineTypes: []gardencorev1beta1.MachineType{{Name: "large"}},
+			Regions:      []gardencorev1beta1.Region{{Name: "region"}},
+			Type:         "providerType",
+		},
+	}
+	Expect(testClient.Create(ctx, cloudProfile)).To(Succeed())
+	log.Info("Created CloudProfile for test", "cloudProfile", client.ObjectKeyFromObject(cloudProfile))
+
+	DeferCleanup(func() {
+		By("Delete CloudProfile")
+		Expect(client.IgnoreNotFound(testClient.Delete(ctx, cloudProfile))).To(Succeed())
+	})
+
+	By("Create SecretBinding")
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Namespace:    testNamespace.Name,
+		},
+	}
+	Expect(testClient.Create(ctx, testSecret)).To(Succeed())
+	log.Info("Created Secret for test", "secret", client.ObjectKeyFromObject(testSecret))
+
+	DeferCleanup(func() {
+		By("Delete Secret")
+		Expect(client.IgnoreNotFound(testClient.Delete(ctx, testSecret))).To(Succeed())
+	})
+
+	testSecretBinding = &gardencorev1beta1.SecretBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Namespace:    testNamespace.Name,
+		},
+		Provider: &gardencorev1beta1.SecretBindingProvider{
+			Type: "providerType",
+		},
+		SecretRef: corev1.SecretReference{
+			Name:      testSecret.Name,
+			Namespace: testSecret.Namespace,
+		},
+	}
+	Expect(testClient.Create(ctx, testSecretBinding)).To(Succeed())
+	log.Info("Created SecretBinding for test", "secretBinding", client.ObjectKeyFromObject(testSecretBinding))
+
+	DeferCleanup(func() {
+		By("Delete SecretBinding")
+		Expect(client.IgnoreNotFound(testClient.Delete(ctx, testSecretBinding))).To(Succeed())
+	})
+})
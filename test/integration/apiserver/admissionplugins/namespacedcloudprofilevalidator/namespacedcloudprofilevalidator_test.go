@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacedcloudprofilevalidator_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("NamespacedCloudProfileValidator tests", func() {
+	var namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+
+	BeforeEach(func() {
+		namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-",
+				Namespace:    testNamespace.Name,
+			},
+			Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+				Parent: gardencorev1beta1.CloudProfileReference{
+					Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile,
+					Name: cloudProfile.Name,
+				},
+			},
+		}
+	})
+
+	createNamespacedCloudProfile := func() {
+		By("Create NamespacedCloudProfile")
+		Eventually(func() error {
+			return testClient.Create(ctx, namespacedCloudProfile)
+		}).Should(Succeed())
+		log.Info("Created NamespacedCloudProfile for test", "namespacedCloudProfile", client.ObjectKeyFromObject(namespacedCloudProfile))
+
+		DeferCleanup(func() {
+			By("Delete NamespacedCloudProfile")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, namespacedCloudProfile))).To(Succeed())
+		})
+	}
+
+	Describe("NamespacedCloudProfile", func() {
+		It("should create a NamespacedCloudProfile which adds machine types and extends versions of the parent", func() {
+			expirationDate := metav1.NewTime(time.Now().Add(24 * time.Hour))
+			namespacedCloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "xlarge"}}
+			namespacedCloudProfile.Spec.Kubernetes = &gardencorev1beta1.KubernetesSettings{
+				Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.28.2", ExpirationDate: &expirationDate}},
+			}
+
+			createNamespacedCloudProfile()
+		})
+
+		It("should not create a NamespacedCloudProfile with a non-existing parent", func() {
+			namespacedCloudProfile.Spec.Parent.Name = "does-not-exist"
+
+			Consistently(func() error {
+				return testClient.Create(ctx, namespacedCloudProfile)
+			}).Should(And(
+				BeBadRequestError(),
+				MatchError(ContainSubstring("parent CloudProfile could not be found")),
+			))
+		})
+
+		It("should not create a NamespacedCloudProfile which overrides a machine type of the parent", func() {
+			namespacedCloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "large", Usable: ptr.To(false)}}
+
+			Consistently(func() error {
+				return testClient.Create(ctx, namespacedCloudProfile)
+			}).Should(And(
+				BeBadRequestError(),
+				MatchError(ContainSubstring("NamespacedCloudProfile attempts to overwrite parent CloudProfile with machineType")),
+			))
+		})
+
+		It("should not allow changing the parent of a NamespacedCloudProfile", func() {
+			createNamespacedCloudProfile()
+
+			patch := client.MergeFrom(namespacedCloudProfile.DeepCopy())
+			namespacedCloudProfile.Spec.Parent.Name = "other-" + cloudProfile.Name
+			Expect(testClient.Patch(ctx, namespacedCloudProfile, patch)).To(BeInvalidError())
+		})
+	})
+
+	Describe("Shoot referencing a NamespacedCloudProfile", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			namespacedCloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "xlarge"}}
+			createNamespacedCloudProfile()
+
+			By("Set status of NamespacedCloudProfile")
+			// The status contains the spec of the parent CloudProfile merged with the spec of the NamespacedCloudProfile.
+			patch := client.MergeFrom(namespacedCloudProfile.DeepCopy())
+			namespacedCloudProfile.Status.CloudProfileSpec = *cloudProfile.Spec.DeepCopy()
+			namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes = append(namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes, namespacedCloudProfile.Spec.MachineTypes...)
+			Expect(testClient.Status().Patch(ctx, namespacedCloudProfile, patch)).To(Succeed())
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-",
+					Namespace:    testNamespace.Name,
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{
+						Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile,
+						Name: namespacedCloudProfile.Name,
+					},
+					SecretBindingName: ptr.To(testSecretBinding.Name),
+					Region:            "region",
+					Provider: gardencorev1beta1.Provider{
+						Type: "providerType",
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:    "cpu-worker",
+								Minimum: 2,
+								Maximum: 2,
+								Machine: gardencorev1beta1.Machine{Type: "xlarge"},
+							},
+						},
+					},
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.28.2"},
+					Networking: &gardencorev1beta1.Networking{Type: ptr.To("foo-networking")},
+				},
+			}
+		})
+
+		createShoot := func() {
+			By("Create Shoot")
+			Eventually(func() error {
+				return testClient.Create(ctx, shoot)
+			}).Should(Succeed())
+			log.Info("Created Shoot for test", "shoot", client.ObjectKeyFromObject(shoot))
+
+			DeferCleanup(func() {
+				By("Delete Shoot")
+				Expect(testClient.Delete(ctx, shoot)).To(Or(Succeed(), BeNotFoundError()))
+				Eventually(func() error {
+					return testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)
+				}).Should(BeNotFoundError())
+			})
+		}
+
+		It("should create a Shoot using a machine type which is only offered by the NamespacedCloudProfile", func() {
+			createShoot()
+
+			Expect(shoot.Spec.CloudProfile).To(Equal(&gardencorev1beta1.CloudProfileReference{
+				Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile,
+				Name: namespacedCloudProfile.Name,
+			}))
+		})
+
+		It("should not create a Shoot using a machine type which is not offered by the NamespacedCloudProfile", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "xxlarge"
+
+			Consistently(func() error {
+				return testClient.Create(ctx, shoot)
+			}).Should(MatchError(ContainSubstring("spec.provider.workers[0].machine.type: Unsupported value: \"xxlarge\"")))
+		})
+
+		It("should allow switching a Shoot from the parent CloudProfile to the NamespacedCloudProfile", func() {
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{
+				Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile,
+				Name: cloudProfile.Name,
+			}
+			shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+			createShoot()
+
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.CloudProfileName = nil
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{
+				Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile,
+				Name: namespacedCloudProfile.Name,
+			}
+			shoot.Spec.Provider.Workers[0].Machine.Type = "xlarge"
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+		})
+
+		It("should not allow switching a Shoot from the NamespacedCloudProfile back to the parent CloudProfile", func() {
+			createShoot()
+
+			patch := client.MergeFrom(shoot.DeepCopy())
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{
+				Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile,
+				Name: cloudProfile.Name,
+			}
+			shoot.Spec.Provider.Workers[0].Machine.Type = "large"
+			Expect(testClient.Patch(ctx, shoot, patch)).To(And(
+				BeInvalidError(),
+				MatchError(ContainSubstring("a namespacedcloudprofile must not be changed back to a cloudprofile")),
+			))
+		})
+	})
+})
@@ -63,6 +63,7 @@ var _ = BeforeSuite(func() {
 		GardenerAPIServer: &gardenerenvtest.GardenerAPIServer{
 			Args: []string{
 				"--disable-admission-plugins=DeletionConfirmation,ResourceReferenceManager,ExtensionValidator,ShootQuotaValidator,ShootValidator,ShootTolerationRestriction",
+				"--feature-gates=UseNamespacedCloudProfile=true",
 			},
 		},
 	}
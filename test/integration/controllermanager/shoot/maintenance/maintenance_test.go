@@ -539,6 +539,47 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 		})
 	})
 
+	Context("maintenance preview", func() {
+		It("should write the pending changes to the status without maintaining the Shoot", func() {
+			By("Expire Shoot's kubernetes version in the CloudProfile")
+			Expect(patchCloudProfileForKubernetesVersionMaintenance(ctx, testClient, *shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast, &deprecatedClassification)).To(Succeed())
+
+			By("Wait until manager has observed the CloudProfile update")
+			waitKubernetesVersionToBeExpiredInCloudProfile(*shoot.Spec.CloudProfileName, testKubernetesVersionLowPatchLowMinor.Version, &expirationDateInThePast)
+
+			By("Request maintenance preview")
+			Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerMaintenanceOperation, v1beta1constants.ShootMaintenanceOperationPreview)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerMaintenanceOperation))
+				g.Expect(shoot.Status.Maintenance).NotTo(BeNil())
+				g.Expect(shoot.Status.Maintenance.PendingChanges).NotTo(BeNil())
+				g.Expect(shoot.Status.Maintenance.PendingChanges.ComputedTime).To(Equal(metav1.Time{Time: fakeClock.Now()}))
+				g.Expect(shoot.Status.Maintenance.PendingChanges.KubernetesVersion).To(Equal(&gardencorev1beta1.VersionUpdatePreview{
+					CurrentVersion: testKubernetesVersionLowPatchLowMinor.Version,
+					TargetVersion:  ptr.To(testKubernetesVersionHighestPatchLowMinor.Version),
+					Reason:         "Kubernetes version expired - force update required",
+				}))
+			}).Should(Succeed())
+
+			By("Ensure Shoot was not maintained")
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal(testKubernetesVersionLowPatchLowMinor.Version))
+
+			By("Trigger maintenance")
+			Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+			waitForShootToBeMaintained(shoot)
+
+			By("Ensure pending changes were removed")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(shoot.Spec.Kubernetes.Version).To(Equal(testKubernetesVersionHighestPatchLowMinor.Version))
+				g.Expect(shoot.Status.Maintenance).To(BeNil())
+			}).Should(Succeed())
+		})
+	})
+
 	Describe("Machine image maintenance tests", func() {
 
 		It("Do not update Shoot machine image in maintenance time: AutoUpdate.MachineImageVersion == false && expirationDate does not apply", func() {
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+var _ = Describe("Shoot Maintenance controller tests with NamespacedCloudProfile", func() {
+	var (
+		cloudProfile           *gardencorev1beta1.CloudProfile
+		namespacedCloudProfile *gardencorev1beta1.NamespacedCloudProfile
+		shoot                  *gardencorev1beta1.Shoot
+
+		machineImageName = "bar-image"
+
+		deprecatedClassification = gardencorev1beta1.ClassificationDeprecated
+		supportedClassification  = gardencorev1beta1.ClassificationSupported
+		expirationDateInThePast  = metav1.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)
+		expirationDateInFuture   metav1.Time
+	)
+
+	// setNamespacedCloudProfileStatus sets the given spec as the status of the NamespacedCloudProfile, i.e. the spec of
+	// the parent CloudProfile merged with the spec of the NamespacedCloudProfile, and waits until the manager observed it.
+	setNamespacedCloudProfileStatus := func(cloudProfileSpec gardencorev1beta1.CloudProfileSpec) {
+		By("Set status of NamespacedCloudProfile")
+		patch := client.MergeFrom(namespacedCloudProfile.DeepCopy())
+		namespacedCloudProfile.Status.CloudProfileSpec = cloudProfileSpec
+		namespacedCloudProfile.Status.ObservedGeneration = namespacedCloudProfile.Generation
+		Expect(testClient.Status().Patch(ctx, namespacedCloudProfile, patch)).To(Succeed())
+
+		By("Wait until manager has observed the NamespacedCloudProfile status")
+		Eventually(func(g Gomega) string {
+			observed := &gardencorev1beta1.NamespacedCloudProfile{}
+			g.Expect(mgrClient.Get(ctx, client.ObjectKeyFromObject(namespacedCloudProfile), observed)).To(Succeed())
+			return observed.ResourceVersion
+		}).Should(Equal(namespacedCloudProfile.ResourceVersion))
+	}
+
+	BeforeEach(func() {
+		fakeClock.SetTime(time.Now().Round(time.Second))
+		expirationDateInFuture = metav1.NewTime(fakeClock.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second))
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testID + "-",
+			},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.28.2", Classification: &supportedClassification},
+						{Version: "1.28.0", Classification: &deprecatedClassification, ExpirationDate: &expirationDateInThePast},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{
+					{
+						Name: machineImageName,
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0", Classification: &supportedClassification},
+								CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+								Architectures:    []string{"amd64"},
+							},
+						},
+					},
+				},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "large"}},
+				Regions:      []gardencorev1beta1.Region{{Name: "foo-region"}},
+				Type:         "foo-type",
+			},
+		}
+
+		By("Create CloudProfile")
+		Expect(testClient.Create(ctx, cloudProfile)).To(Succeed())
+		log.Info("Created CloudProfile for test", "cloudProfile", client.ObjectKeyFromObject(cloudProfile))
+
+		DeferCleanup(func() {
+			By("Delete CloudProfile")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, cloudProfile))).To(Succeed())
+		})
+
+		namespacedCloudProfile = &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testID + "-",
+				Namespace:    testNamespace.Name,
+			},
+			Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
+				Parent: gardencorev1beta1.CloudProfileReference{
+					Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile,
+					Name: cloudProfile.Name,
+				},
+				Kubernetes: &gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &expirationDateInFuture},
+					},
+				},
+			},
+		}
+
+		By("Create NamespacedCloudProfile")
+		Expect(testClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+		log.Info("Created NamespacedCloudProfile for test", "namespacedCloudProfile", client.ObjectKeyFromObject(namespacedCloudProfile))
+
+		DeferCleanup(func() {
+			By("Delete NamespacedCloudProfile")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, namespacedCloudProfile))).To(Succeed())
+		})
+
+		// the NamespacedCloudProfile extends the expiration date of the Kubernetes version of the parent CloudProfile
+		statusSpec := cloudProfile.Spec.DeepCopy()
+		statusSpec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInFuture
+		setNamespacedCloudProfileStatus(*statusSpec)
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "test-", Namespace: testNamespace.Name},
+			Spec: gardencorev1beta1.ShootSpec{
+				SecretBindingName: ptr.To("my-provider-account"),
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{
+					Kind: v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile,
+					Name: namespacedCloudProfile.Name,
+				},
+				Region: "foo-region",
+				Provider: gardencorev1beta1.Provider{
+					Type: "foo-provider",
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name:    "cpu-worker",
+							Minimum: 2,
+							Maximum: 2,
+							Machine: gardencorev1beta1.Machine{
+								Image: &gardencorev1beta1.ShootMachineImage{Name: machineImageName, Version: ptr.To("1.0.0")},
+								Type:  "large",
+							},
+						},
+					},
+				},
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.28.0",
+				},
+				Networking: &gardencorev1beta1.Networking{
+					Type: ptr.To("foo-networking"),
+				},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   false,
+						MachineImageVersion: ptr.To(false),
+					},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+						Begin: timewindow.NewMaintenanceTime(time.Now().Add(2*time.Hour).Hour(), 0, 0).Formatted(),
+						End:   timewindow.NewMaintenanceTime(time.Now().Add(4*time.Hour).Hour(), 0, 0).Formatted(),
+					},
+				},
+			},
+		}
+
+		By("Create Shoot")
+		Expect(testClient.Create(ctx, shoot)).To(Succeed())
+		log.Info("Created shoot for test", "shoot", client.ObjectKeyFromObject(shoot))
+
+		DeferCleanup(func() {
+			By("Delete Shoot")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, shoot))).To(Succeed())
+		})
+	})
+
+	It("should not force update the Kubernetes version if its expiration date is extended by the NamespacedCloudProfile", func() {
+		Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+		waitForShootToBeMaintained(shoot)
+
+		Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.28.0"))
+	})
+
+	It("should force update the Kubernetes version if it is expired in the NamespacedCloudProfile", func() {
+		statusSpec := cloudProfile.Spec.DeepCopy()
+		setNamespacedCloudProfileStatus(*statusSpec)
+
+		Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+		Eventually(func(g Gomega) string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+			g.Expect(shoot.Status.LastMaintenance.Description).To(ContainSubstring("Control Plane: Updated Kubernetes version from \"1.28.0\" to \"1.28.2\". Reason: Kubernetes version expired - force update required"))
+			g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			return shoot.Spec.Kubernetes.Version
+		}).Should(Equal("1.28.2"))
+	})
+
+	It("should auto update the machine image to a version which is only offered by the NamespacedCloudProfile", func() {
+		statusSpec := cloudProfile.Spec.DeepCopy()
+		statusSpec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInFuture
+		statusSpec.MachineImages[0].Versions = append(statusSpec.MachineImages[0].Versions, gardencorev1beta1.MachineImageVersion{
+			ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0", Classification: &supportedClassification},
+			CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+			Architectures:    []string{"amd64"},
+		})
+		setNamespacedCloudProfileStatus(*statusSpec)
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
+		Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+		Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+		Eventually(func(g Gomega) *string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			g.Expect(shoot.Status.LastMaintenance).NotTo(BeNil())
+			g.Expect(shoot.Status.LastMaintenance.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+			return shoot.Spec.Provider.Workers[0].Machine.Image.Version
+		}).Should(PointTo(Equal("1.1.0")))
+	})
+})
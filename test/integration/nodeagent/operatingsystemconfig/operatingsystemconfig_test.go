@@ -609,6 +609,36 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		Expect(cancelFunc.called).To(BeFalse())
 	})
 
+	Context("in-place updates", func() {
+		BeforeEach(func() {
+			operatingSystemConfig.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+				OperatingSystemVersion: "1.2.3",
+				KubeletVersion:         kubernetesVersion.String(),
+			}
+		})
+
+		It("should report the in-place update in the node conditions", func() {
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			By("Wait for node conditions to be updated")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("InPlaceUpdate")),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "Succeeded"),
+				HaveField("Message", "Updated to operating system version 1.2.3 and kubelet version "+kubernetesVersion.String()),
+			)))
+		})
+	})
+
 	It("should call the cancel function when gardener-node-agent must be restarted itself", func() {
 		var lastAppliedOSC []byte
 		By("Wait last-applied OSC file to be persisted")
@@ -540,7 +540,7 @@ func createAndStartManager(config *config.ShootSchedulerConfiguration) {
 	Expect((&shootcontroller.Reconciler{
 		Config:          config,
 		GardenNamespace: testNamespace.Name,
-	}).AddToManager(mgr)).To(Succeed())
+	}).AddToManager(ctx, mgr)).To(Succeed())
 
 	By("Start manager")
 	mgrContext, mgrCancel := context.WithCancel(ctx)
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+/**
+	Overview
+		- Tests the control plane migration of a shoot from its current seed to another seed.
+
+	Prerequisites
+		- A Shoot exists which is not hibernated and has the nginx-ingress addon enabled.
+		- The name of the target seed is passed with the '-migration-target-seed-name' flag. The test is skipped otherwise.
+
+	Test:
+		Deploys the guestbook application, triggers the migration of the control plane to the target seed by changing
+		'.spec.seedName' of the shoot and tests the guestbook application again afterwards.
+	Expected Output
+		- The control plane is migrated to the target seed and the shoot is reconciled successfully.
+		- The guestbook application keeps working after the migration.
+		- The persistent volumes of the shoot are still bound to the same claims.
+		- The DNS records of the shoot are migrated to the target seed and reconciled successfully.
+ **/
+
+package migration
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/test/framework"
+	"github.com/gardener/gardener/test/framework/applications"
+)
+
+const (
+	migrationTimeout = 2 * time.Hour
+)
+
+var targetSeedName *string
+
+func init() {
+	targetSeedName = flag.String("migration-target-seed-name", "", "name of the seed to which the control plane of the shoot is migrated (the migration test is skipped if unset)")
+}
+
+var _ = ginkgo.Describe("Shoot control plane migration testing", func() {
+
+	f := framework.NewShootFramework(nil)
+
+	f.Beta().Disruptive().CIt("should migrate the control plane of the shoot to another seed", func(ctx context.Context) {
+		if *targetSeedName == "" {
+			ginkgo.Skip("no target seed specified for the control plane migration")
+		}
+		if f.Shoot.Status.IsHibernated {
+			ginkgo.Skip("the control plane of a hibernated shoot cannot be verified after the migration")
+		}
+
+		targetSeed, targetSeedClient, err := f.GetSeed(ctx, *targetSeedName)
+		framework.ExpectNoError(err)
+		gomega.Expect(targetSeed.Name).NotTo(gomega.Equal(f.Seed.Name), "target seed must differ from the current seed of the shoot")
+
+		guestBookTest, err := applications.NewGuestBookTest(f)
+		framework.ExpectNoError(err)
+
+		defer guestBookTest.Cleanup(ctx)
+
+		ginkgo.By("Deploy guestbook")
+		guestBookTest.DeployGuestBookApp(ctx)
+		guestBookTest.Test(ctx)
+
+		ginkgo.By("Record persistent volumes and DNS records before the migration")
+		volumesBefore := persistentVolumes(ctx, f)
+		gomega.Expect(volumesBefore).NotTo(gomega.BeEmpty(), "guestbook application should use persistent volumes")
+		dnsRecordsBefore := dnsRecords(ctx, f, f.SeedClient.Client())
+
+		ginkgo.By("Migrate shoot to seed " + targetSeed.Name)
+		framework.ExpectNoError(f.MigrateShoot(ctx, f.Shoot, targetSeed, nil))
+		framework.ExpectNoError(f.GetShoot(ctx, f.Shoot))
+		gomega.Expect(f.Shoot.Spec.SeedName).To(gomega.HaveValue(gomega.Equal(targetSeed.Name)))
+		gomega.Expect(f.Shoot.Status.SeedName).To(gomega.HaveValue(gomega.Equal(targetSeed.Name)))
+		f.Seed, f.SeedClient = targetSeed, targetSeedClient
+
+		ginkgo.By("Test guestbook after the migration")
+		guestBookTest.WaitUntilRedisIsReady(ctx)
+		guestBookTest.WaitUntilGuestbookDeploymentIsReady(ctx)
+		guestBookTest.Test(ctx)
+
+		ginkgo.By("Verify persistent volumes after the migration")
+		gomega.Expect(persistentVolumes(ctx, f)).To(gomega.Equal(volumesBefore))
+
+		ginkgo.By("Verify DNS records after the migration")
+		dnsRecordsAfter := dnsRecords(ctx, f, targetSeedClient.Client())
+		for name, domain := range dnsRecordsBefore {
+			gomega.Expect(dnsRecordsAfter).To(gomega.HaveKeyWithValue(name, domain), "DNS record %s should be migrated to the target seed", name)
+		}
+	}, migrationTimeout)
+})
+
+// persistentVolumes returns the UIDs of the persistent volumes bound to the claims in the test namespace of the shoot,
+// keyed by the names of the claims. It fails if any of the claims is not bound.
+func persistentVolumes(ctx context.Context, f *framework.ShootFramework) map[string]types.UID {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	framework.ExpectNoError(f.ShootClient.Client().List(ctx, pvcList, client.InNamespace(f.Namespace)))
+
+	volumes := make(map[string]types.UID, len(pvcList.Items))
+	for _, pvc := range pvcList.Items {
+		gomega.Expect(pvc.Status.Phase).To(gomega.Equal(corev1.ClaimBound), "persistent volume claim %s should be bound", pvc.Name)
+
+		pv := &corev1.PersistentVolume{}
+		framework.ExpectNoError(f.ShootClient.Client().Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv))
+		gomega.Expect(pv.Status.Phase).To(gomega.Equal(corev1.VolumeBound), "persistent volume %s should be bound", pv.Name)
+
+		volumes[pvc.Name] = pv.UID
+	}
+
+	return volumes
+}
+
+// dnsRecords returns the domain names of the DNS records in the control plane namespace of the shoot in the given seed,
+// keyed by the names of the DNS records. It fails if any of the DNS records is not reconciled successfully.
+func dnsRecords(ctx context.Context, f *framework.ShootFramework, seedClient client.Client) map[string]string {
+	dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+	framework.ExpectNoError(seedClient.List(ctx, dnsRecordList, client.InNamespace(f.ShootSeedNamespace())))
+
+	records := make(map[string]string, len(dnsRecordList.Items))
+	for _, dnsRecord := range dnsRecordList.Items {
+		gomega.Expect(dnsRecord.Status.LastOperation).NotTo(gomega.BeNil(), "DNS record %s should have been reconciled", dnsRecord.Name)
+		gomega.Expect(dnsRecord.Status.LastOperation.State).To(gomega.Equal(gardencorev1beta1.LastOperationStateSucceeded), "DNS record %s should have been reconciled successfully", dnsRecord.Name)
+
+		records[dnsRecord.Name] = dnsRecord.Spec.Name
+	}
+
+	return records
+}
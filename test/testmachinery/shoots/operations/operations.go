@@ -33,25 +33,45 @@
 		- Current ssh-keypair should be rotated.
 		- Current ssh-keypair should be kept in the system post rotation.
 
+	Test:
+		Rotate observability credentials for a shoot cluster.
+		Annotate Shoot with "gardener.cloud/operation" = "rotate-observability-credentials".
+	Expected Output
+		- The password of the observability secret should be rotated.
+		- The new credentials should be accepted and the old credentials should be rejected by the observability endpoint.
+
+	Test:
+		Rotate the service account signing key for a shoot cluster.
+		Annotate Shoot with "gardener.cloud/operation" = "rotate-serviceaccount-key-start" and afterwards with
+		"gardener.cloud/operation" = "rotate-serviceaccount-key-complete".
+	Expected Output
+		- Tokens signed with the old key (including projected tokens of running workloads) are still accepted while the rotation is prepared.
+		- Tokens signed with the old key are rejected after the rotation was completed.
+
  **/
 
 package operations
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labelsutil "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -60,23 +80,31 @@ import (
 )
 
 const (
-	hibernationTestTimeout = 1 * time.Hour
-	reconcileTimeout       = 40 * time.Minute
+	hibernationTestTimeout               = 1 * time.Hour
+	reconcileTimeout                     = 40 * time.Minute
+	serviceAccountKeyRotationTestTimeout = 90 * time.Minute
+
+	serviceAccountKeyRotationName = "serviceaccount-key-rotation"
 )
 
+var applicationsConfig = applications.RegisterApplicationsFlags()
+
 var _ = ginkgo.Describe("Shoot operation testing", func() {
 
 	f := framework.NewShootFramework(nil)
 
 	f.Default().Serial().CIt("Testing if Shoot can be hibernated successfully", func(ctx context.Context) {
-		guestBookTest, err := applications.NewGuestBookTest(f)
+		apps, err := applications.New(f, applicationsConfig.Applications...)
 		framework.ExpectNoError(err)
 
-		defer guestBookTest.Cleanup(ctx)
+		for _, app := range apps {
+			defer app.Cleanup(ctx)
+		}
 
-		ginkgo.By("Deploy guestbook")
-		guestBookTest.DeployGuestBookApp(ctx)
-		guestBookTest.Test(ctx)
+		ginkgo.By("Deploy applications")
+		for _, app := range apps {
+			app.Deploy(ctx)
+		}
 
 		ginkgo.By("Hibernate shoot")
 		err = f.HibernateShoot(ctx)
@@ -86,11 +114,10 @@ var _ = ginkgo.Describe("Shoot operation testing", func() {
 		err = f.WakeUpShoot(ctx)
 		framework.ExpectNoError(err)
 
-		ginkgo.By("Test guestbook")
-		guestBookTest.WaitUntilRedisIsReady(ctx)
-		guestBookTest.WaitUntilGuestbookDeploymentIsReady(ctx)
-		guestBookTest.Test(ctx)
-
+		ginkgo.By("Verify applications")
+		for _, app := range apps {
+			app.Verify(ctx)
+		}
 	}, hibernationTestTimeout)
 
 	f.Default().Serial().CIt("should fully maintain and reconcile a shoot cluster", func(ctx context.Context) {
@@ -193,8 +220,126 @@ var _ = ginkgo.Describe("Shoot operation testing", func() {
 		gomega.Expect(preRotationPublicKey).To(gomega.Equal(postRotationOldPublicKey))
 
 	}, reconcileTimeout)
+
+	f.Beta().Serial().CIt("should rotate the observability credentials for a shoot cluster", func(ctx context.Context) {
+		if f.Shoot.Spec.Purpose != nil && *f.Shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting {
+			ginkgo.Skip("Observability components are not deployed for shoots with purpose testing")
+		}
+		if _, err := f.GetObservabilitySecret(ctx); apierrors.IsNotFound(err) {
+			ginkgo.Skip("Observability components are not enabled for this shoot")
+		}
+
+		verifier := f.ObservabilityVerifier()
+		verifier.Before(ctx)
+
+		ginkgo.By("Rotate observability credentials")
+		framework.ExpectNoError(f.RotateObservabilityCredentials(ctx))
+
+		v, ok := f.Shoot.Annotations[v1beta1constants.GardenerOperation]
+		if ok {
+			gomega.Expect(v).NotTo(gomega.Equal(v1beta1constants.OperationRotateObservabilityCredentials))
+		}
+		gomega.Expect(f.Shoot.Status.Credentials.Rotation.Observability).NotTo(gomega.BeNil())
+
+		verifier.AfterPrepared(ctx)
+	}, reconcileTimeout)
+
+	f.Beta().Disruptive().CIt("should rotate the service account signing key and keep existing tokens valid during the overlap", func(ctx context.Context) {
+		if f.Namespace == "" {
+			_, err := f.CreateNewNamespace(ctx)
+			framework.ExpectNoError(err)
+		}
+
+		ginkgo.By("Deploy workload using a projected service account token")
+		labels := map[string]string{"app": serviceAccountKeyRotationName}
+		serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: serviceAccountKeyRotationName, Namespace: f.Namespace}}
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceAccountKeyRotationName, Namespace: f.Namespace, Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](1),
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: serviceAccount.Name,
+						Containers: []corev1.Container{{
+							Name:  serviceAccountKeyRotationName,
+							Image: "registry.k8s.io/e2e-test-images/agnhost:2.40",
+							Args:  []string{"pause"},
+						}},
+					},
+				},
+			},
+		}
+
+		defer func() {
+			for _, obj := range []client.Object{deployment, serviceAccount} {
+				framework.ExpectNoError(client.IgnoreNotFound(f.ShootClient.Client().Delete(ctx, obj)))
+			}
+		}()
+		framework.ExpectNoError(f.ShootClient.Client().Create(ctx, serviceAccount))
+		framework.ExpectNoError(f.ShootClient.Client().Create(ctx, deployment))
+		framework.ExpectNoError(f.WaitUntilDeploymentIsReady(ctx, deployment.Name, deployment.Namespace, f.ShootClient))
+
+		ginkgo.By("Mint token signed with the old key")
+		oldToken, err := framework.CreateTokenForServiceAccount(ctx, f.ShootClient, serviceAccount, ptr.To[int64](int64(2*time.Hour/time.Second)))
+		framework.ExpectNoError(err)
+		expectTokenAuthenticated(ctx, f, oldToken, true)
+		expectWorkloadAuthenticated(ctx, f, labels)
+
+		ginkgo.By("Start service account key rotation")
+		framework.ExpectNoError(f.StartServiceAccountKeyRotation(ctx))
+		gomega.Expect(v1beta1helper.GetShootServiceAccountKeyRotationPhase(f.Shoot.Status.Credentials)).To(gomega.Equal(gardencorev1beta1.RotationPrepared))
+
+		ginkgo.By("Verify tokens signed with the old and the new key are accepted")
+		expectTokenAuthenticated(ctx, f, oldToken, true)
+		expectWorkloadAuthenticated(ctx, f, labels)
+
+		newToken, err := framework.CreateTokenForServiceAccount(ctx, f.ShootClient, serviceAccount, ptr.To[int64](int64(2*time.Hour/time.Second)))
+		framework.ExpectNoError(err)
+		expectTokenAuthenticated(ctx, f, newToken, true)
+
+		ginkgo.By("Complete service account key rotation")
+		framework.ExpectNoError(f.CompleteServiceAccountKeyRotation(ctx))
+		gomega.Expect(v1beta1helper.GetShootServiceAccountKeyRotationPhase(f.Shoot.Status.Credentials)).To(gomega.Equal(gardencorev1beta1.RotationCompleted))
+
+		ginkgo.By("Verify tokens signed with the old key are rejected")
+		expectTokenAuthenticated(ctx, f, oldToken, false)
+		expectTokenAuthenticated(ctx, f, newToken, true)
+
+		ginkgo.By("Verify restarted workload authenticates with a token signed with the new key")
+		framework.ExpectNoError(f.ShootClient.Client().DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(f.Namespace), client.MatchingLabels(labels)))
+		framework.ExpectNoError(f.WaitUntilDeploymentIsReady(ctx, deployment.Name, deployment.Namespace, f.ShootClient))
+		expectWorkloadAuthenticated(ctx, f, labels)
+	}, serviceAccountKeyRotationTestTimeout)
 })
 
+// expectTokenAuthenticated expects that the given service account token is eventually accepted (or rejected) by the
+// API server of the shoot. The API server instances might take some time to pick up a changed set of trusted keys.
+func expectTokenAuthenticated(ctx context.Context, f *framework.ShootFramework, token string, authenticated bool) {
+	gomega.Eventually(ctx, func() (bool, error) {
+		return framework.IsServiceAccountTokenAuthenticated(ctx, f.ShootClient, token)
+	}).WithPolling(10 * time.Second).WithTimeout(5 * time.Minute).Should(gomega.Equal(authenticated))
+}
+
+// expectWorkloadAuthenticated expects that a pod with the given labels can authenticate against the API server of the
+// shoot with its projected service account token.
+func expectWorkloadAuthenticated(ctx context.Context, f *framework.ShootFramework, labels map[string]string) {
+	const command = `curl --silent --output /dev/null --write-out "%{http_code}" ` +
+		`--cacert /var/run/secrets/kubernetes.io/serviceaccount/ca.crt ` +
+		`--header "Authorization: Bearer $(cat /var/run/secrets/kubernetes.io/serviceaccount/token)" ` +
+		`https://kubernetes.default.svc/api`
+
+	gomega.Eventually(ctx, func(g gomega.Gomega) {
+		reader, err := framework.PodExecByLabel(ctx, labelsutil.SelectorFromSet(labels), serviceAccountKeyRotationName, command, f.Namespace, f.ShootClient)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		statusCode, err := io.ReadAll(reader)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(string(statusCode)).To(gomega.Equal("200"), "workload should be able to authenticate with its projected token")
+	}).WithPolling(10 * time.Second).WithTimeout(5 * time.Minute).Should(gomega.Succeed())
+}
+
 func getKeyAndValidate(s *corev1.Secret, field string) []byte {
 	v, ok := s.Data[field]
 	gomega.Expect(ok).To(gomega.BeTrue())
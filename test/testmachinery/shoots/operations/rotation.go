@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+/**
+	Overview
+		- Tests the two-phase credentials rotation of a shoot.
+
+	Prerequisites
+		- A Shoot exists.
+
+	Test:
+		Starts the credentials rotation with "gardener.cloud/operation" = "rotate-credentials-start" and persists a
+		checkpoint of the secrets before the rotation (phase 'start').
+		Resumes the rotation from the checkpoint and completes it with "gardener.cloud/operation" =
+		"rotate-credentials-complete" (phase 'complete').
+		Both phases can be executed in separate test runs by setting the '-rotation-phase' flag.
+	Expected Output
+		- The CAs are rotated and the old CAs are kept until the rotation is completed.
+		- The old CAs are removed after the rotation is completed.
+
+ **/
+
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/test/framework"
+	"github.com/gardener/gardener/test/utils/rotation"
+)
+
+const (
+	rotationPhaseTimeout = 1 * time.Hour
+
+	caCluster = "ca"
+)
+
+var rotationPhaseConfig *framework.RotationPhaseConfig
+
+func init() {
+	rotationPhaseConfig = framework.RegisterRotationPhaseFlags()
+}
+
+var _ = ginkgo.Describe("Shoot credentials rotation testing", func() {
+
+	f := framework.NewShootFramework(nil)
+
+	f.Beta().Disruptive().CIt("should rotate the credentials of a shoot cluster in separate phases", func(ctx context.Context) {
+		if rotationPhaseConfig.Includes(framework.RotationPhaseStart) {
+			ginkgo.By("Start credentials rotation")
+			checkpoint, err := f.StartRotationPhase(ctx, rotationPhaseConfig, v1beta1constants.OperationRotateCredentialsStart)
+			framework.ExpectNoError(err)
+			gomega.Expect(checkpoint.SecretsBefore[caCluster]).To(gomega.HaveLen(1), "CA secret should not be rotated before the rotation was started")
+
+			framework.ExpectNoError(f.GetShoot(ctx, f.Shoot))
+			gomega.Expect(f.Shoot.Status.Credentials.Rotation.CertificateAuthorities.Phase).To(gomega.Equal(gardencorev1beta1.RotationPrepared))
+
+			secretsPrepared := listGardenletSecrets(ctx, f)
+			gomega.Expect(secretsPrepared[caCluster]).To(gomega.HaveLen(2), "CA secret should get rotated, but old CA is kept")
+			gomega.Expect(secretsPrepared[caCluster]).To(gomega.ContainElement(secretWithName(checkpoint.SecretsBefore[caCluster][0].Name)), "old CA secret should be kept")
+		}
+
+		if rotationPhaseConfig.Includes(framework.RotationPhaseComplete) {
+			ginkgo.By("Resume credentials rotation from checkpoint")
+			checkpoint, err := f.ResumeRotationPhase(rotationPhaseConfig)
+			framework.ExpectNoError(err)
+			gomega.Expect(checkpoint.Operation).To(gomega.Equal(v1beta1constants.OperationRotateCredentialsStart))
+
+			framework.ExpectNoError(f.GetShoot(ctx, f.Shoot))
+			gomega.Expect(f.Shoot.Status.Credentials.Rotation.CertificateAuthorities.Phase).To(gomega.Equal(gardencorev1beta1.RotationPrepared), "rotation should still be prepared when it is resumed")
+
+			ginkgo.By("Complete credentials rotation")
+			framework.ExpectNoError(f.UpdateShoot(ctx, func(shoot *gardencorev1beta1.Shoot) error {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCredentialsComplete)
+				return nil
+			}))
+
+			framework.ExpectNoError(f.GetShoot(ctx, f.Shoot))
+			gomega.Expect(f.Shoot.Status.Credentials.Rotation.CertificateAuthorities.Phase).To(gomega.Equal(gardencorev1beta1.RotationCompleted))
+			gomega.Expect(f.Shoot.Status.Credentials.Rotation.CertificateAuthorities.LastCompletionTime.After(checkpoint.StartTime.Time)).To(gomega.BeTrue())
+
+			secretsCompleted := listGardenletSecrets(ctx, f)
+			gomega.Expect(secretsCompleted[caCluster]).To(gomega.HaveLen(1), "old CA secret should get cleaned up")
+			gomega.Expect(secretsCompleted[caCluster]).NotTo(gomega.ContainElement(secretWithName(checkpoint.SecretsBefore[caCluster][0].Name)), "old CA secret should be removed")
+
+			framework.ExpectNoError(framework.RemoveRotationCheckpoint(rotationPhaseConfig.CheckpointPath))
+		}
+	}, rotationPhaseTimeout)
+})
+
+func listGardenletSecrets(ctx context.Context, f *framework.ShootFramework) rotation.SecretConfigNamesToSecrets {
+	secretList := &corev1.SecretList{}
+	gomega.Expect(f.SeedClient.Client().List(ctx, secretList, client.InNamespace(f.ShootSeedNamespace()), client.MatchingLabels{
+		"managed-by":       "secrets-manager",
+		"manager-identity": "gardenlet",
+	})).To(gomega.Succeed())
+	return rotation.GroupByName(secretList.Items)
+}
+
+func secretWithName(name string) gomega.OmegaMatcher {
+	return gomega.WithTransform(func(secret corev1.Secret) string { return secret.Name }, gomega.Equal(name))
+}
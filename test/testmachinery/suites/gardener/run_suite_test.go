@@ -23,6 +23,7 @@ var (
 	configFilePath = flag.String("config", "", "Specify the configuration file")
 	esIndex        = flag.String("es-index", "gardener-testsuite", "Specify the elastic search index where the report should be ingested")
 	reportFilePath = flag.String("report-file", "/tmp/shoot_res.json", "Specify the file to write the test results")
+	summaryDir     = flag.String("summary-dir", "", "Specify the directory to write a JSON summary and a JUnit report of the test results to (not written if unset)")
 )
 
 func TestMain(m *testing.M) {
@@ -50,3 +51,10 @@ func TestGardenerSuite(t *testing.T) {
 var _ = ReportAfterSuite("Report to Elasticsearch", func(report Report) {
 	reporter.ReportResults(*reportFilePath, *esIndex, report)
 })
+
+var _ = ReportAfterSuite("Write summary", func(report Report) {
+	if *summaryDir == "" {
+		return
+	}
+	Expect(reporter.ReportSummary(*summaryDir, report)).To(Succeed())
+})
@@ -19,6 +19,7 @@ import (
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/applications"
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/care"
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/logging"
+	_ "github.com/gardener/gardener/test/testmachinery/shoots/migration"
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/operatingsystem"
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/operations"
 	_ "github.com/gardener/gardener/test/testmachinery/shoots/vpntunnel"
@@ -28,6 +29,7 @@ var (
 	configFilePath = flag.String("config", "", "Specify the configuration file")
 	esIndex        = flag.String("es-index", "gardener-testsuite", "Specify the elastic search index where the report should be ingested")
 	reportFilePath = flag.String("report-file", "/tmp/shoot_res.json", "Specify the file to write the test results")
+	summaryDir     = flag.String("summary-dir", "", "Specify the directory to write a JSON summary and a JUnit report of the test results to (not written if unset)")
 )
 
 func TestMain(m *testing.M) {
@@ -55,3 +57,10 @@ func TestGardenerSuite(t *testing.T) {
 var _ = ReportAfterSuite("Report to Elasticsearch", func(report Report) {
 	reporter.ReportResults(*reportFilePath, *esIndex, report)
 })
+
+var _ = ReportAfterSuite("Write summary", func(report Report) {
+	if *summaryDir == "" {
+		return
+	}
+	Expect(reporter.ReportSummary(*summaryDir, report)).To(Succeed())
+})
@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+/**
+	Overview
+		- Tests the supported version skew between the Gardener control plane and gardenlet
+		  (see docs/deployment/version_skew_policy.md).
+
+	Prerequisites
+		- The Gardener control plane runs the version provided via the '-version' flag.
+		- The gardenlet of the seed provided via the '-seed-name' flag runs the previous minor version. It is either
+		  deployed via a ManagedSeed or via a Gardenlet resource in the garden namespace.
+
+	Test: Gardenlet Version Skew
+	Expected Output
+	- All shoots of the seed are successfully reconciled by the older gardenlet.
+	- After upgrading the gardenlet to the version provided via the '-gardenlet-version' flag, the seed reports the
+	  new version and all shoots of the seed are successfully reconciled by the new gardenlet.
+	- No resources are orphaned in the seed, i.e., there are no shoot namespaces or Cluster resources of
+	  non-existing shoots, and all extension resources of awake shoots have been reconciled after the upgrade.
+ **/
+
+package gardenlet_version_skew_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/api/extensions"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	// ReconcileShootsTimeout is the timeout for waiting until all shoots of the seed are reconciled.
+	ReconcileShootsTimeout = 1 * time.Hour
+	// UpgradeGardenletTimeout is the timeout for waiting until the seed reports the new gardenlet version.
+	UpgradeGardenletTimeout = 30 * time.Minute
+)
+
+var (
+	gardenerVersion  = flag.String("version", "", "current version of the Gardener control plane")
+	gardenletVersion = flag.String("gardenlet-version", "", "version to which the gardenlet is upgraded during the test")
+	seedName         = flag.String("seed-name", "", "name of the seed whose gardenlet is upgraded")
+
+	gardenerConfig *framework.GardenerConfig
+)
+
+func init() {
+	gardenerConfig = framework.RegisterGardenerFrameworkFlags()
+}
+
+func validateFlags() {
+	if !framework.StringSet(*gardenerVersion) {
+		Fail("you need to specify the current gardener version")
+	}
+	if !framework.StringSet(*gardenletVersion) {
+		Fail("you need to specify the gardenlet version to upgrade to")
+	}
+	if !framework.StringSet(*seedName) {
+		Fail("you need to specify the name of the seed")
+	}
+}
+
+var _ = Describe("Gardenlet version skew testing", Ordered, func() {
+	var (
+		f = framework.NewGardenerFramework(gardenerConfig)
+
+		seedClient  kubernetes.Interface
+		upgradeTime time.Time
+	)
+
+	framework.CBeforeEach(func(ctx context.Context) {
+		validateFlags()
+
+		if seedClient == nil {
+			var err error
+			_, seedClient, err = f.GetSeed(ctx, *seedName)
+			framework.ExpectNoError(err)
+		}
+	}, 5*time.Minute)
+
+	framework.CIt("should run a gardenlet which is one minor version older than the Gardener control plane", func(ctx context.Context) {
+		seed := &gardencorev1beta1.Seed{}
+		framework.ExpectNoError(f.GardenClient.Client().Get(ctx, client.ObjectKey{Name: *seedName}, seed))
+
+		if seed.Status.Gardener == nil {
+			Fail(fmt.Sprintf("seed %q does not report a gardenlet version", *seedName))
+		}
+
+		garden, err := semver.NewVersion(*gardenerVersion)
+		framework.ExpectNoError(err)
+		gardenlet, err := semver.NewVersion(seed.Status.Gardener.Version)
+		framework.ExpectNoError(err)
+
+		if gardenlet.Major() != garden.Major() || gardenlet.Minor()+1 != garden.Minor() {
+			Fail(fmt.Sprintf("gardenlet version %s is not one minor version older than Gardener version %s", gardenlet, garden))
+		}
+	}, 1*time.Minute)
+
+	framework.CIt("should reconcile all shoots of the seed with the older gardenlet", func(ctx context.Context) {
+		seed := &gardencorev1beta1.Seed{}
+		framework.ExpectNoError(f.GardenClient.Client().Get(ctx, client.ObjectKey{Name: *seedName}, seed))
+
+		framework.ExpectNoError(waitForShootsToBeReconciled(ctx, f, seed.Status.Gardener.Version, time.Time{}))
+		framework.ExpectNoError(verifyNoOrphanedResources(ctx, f, seedClient, time.Time{}))
+	}, ReconcileShootsTimeout)
+
+	framework.CIt("should upgrade the gardenlet", func(ctx context.Context) {
+		upgradeTime = time.Now().UTC()
+		framework.ExpectNoError(upgradeGardenlet(ctx, f, *seedName, *gardenletVersion))
+
+		framework.ExpectNoError(retry.UntilTimeout(ctx, 30*time.Second, UpgradeGardenletTimeout, func(ctx context.Context) (bool, error) {
+			seed := &gardencorev1beta1.Seed{}
+			if err := f.GardenClient.Client().Get(ctx, client.ObjectKey{Name: *seedName}, seed); err != nil {
+				return retry.MinorError(err)
+			}
+
+			if seed.Status.Gardener == nil || seed.Status.Gardener.Version != *gardenletVersion {
+				return retry.MinorError(fmt.Errorf("seed %q does not report gardenlet version %s yet", *seedName, *gardenletVersion))
+			}
+
+			if seed.Status.ObservedGeneration != seed.Generation {
+				return retry.MinorError(fmt.Errorf("seed %q is not yet reconciled", *seedName))
+			}
+
+			if condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+				return retry.MinorError(fmt.Errorf("gardenlet of seed %q is not yet ready", *seedName))
+			}
+
+			return retry.Ok()
+		}))
+	}, UpgradeGardenletTimeout)
+
+	framework.CIt("should reconcile all shoots of the seed with the upgraded gardenlet", func(ctx context.Context) {
+		shoots, err := listShootsOfSeed(ctx, f)
+		framework.ExpectNoError(err)
+
+		for _, shoot := range shoots {
+			framework.ExpectNoError(f.AnnotateShoot(ctx, &shoot, map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}))
+		}
+
+		framework.ExpectNoError(waitForShootsToBeReconciled(ctx, f, *gardenletVersion, upgradeTime))
+		framework.ExpectNoError(verifyNoOrphanedResources(ctx, f, seedClient, upgradeTime))
+	}, ReconcileShootsTimeout)
+})
+
+func listShootsOfSeed(ctx context.Context, f *framework.GardenerFramework) ([]gardencorev1beta1.Shoot, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := f.GardenClient.Client().List(ctx, shootList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(core.ShootSeedName, *seedName)}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots of seed %q: %w", *seedName, err)
+	}
+
+	return shootList.Items, nil
+}
+
+// waitForShootsToBeReconciled waits until all shoots of the seed were successfully reconciled by a gardenlet of the
+// given version after the given point in time.
+func waitForShootsToBeReconciled(ctx context.Context, f *framework.GardenerFramework, version string, after time.Time) error {
+	return retry.UntilTimeout(ctx, 30*time.Second, ReconcileShootsTimeout, func(ctx context.Context) (bool, error) {
+		shoots, err := listShootsOfSeed(ctx, f)
+		if err != nil {
+			f.Logger.Error(err, "Error listing shoots")
+			return retry.MinorError(err)
+		}
+
+		reconciledShoots := 0
+		for _, shoot := range shoots {
+			log := f.Logger.WithValues("shoot", client.ObjectKeyFromObject(&shoot))
+
+			if shoot.Status.Gardener.Version != version {
+				log.Info("Last acted Gardener version does not match expected version", "last", shoot.Status.Gardener.Version, "expected", version)
+				continue
+			}
+			if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.LastUpdateTime.Time.Before(after) {
+				log.Info("Shoot was not yet reconciled after gardenlet upgrade")
+				continue
+			}
+			if completed, msg := framework.ShootReconciliationSuccessful(&shoot); completed {
+				reconciledShoots++
+			} else {
+				log.Info("Shoot not yet successfully reconciled", "reason", msg)
+			}
+		}
+
+		if reconciledShoots != len(shoots) {
+			f.Logger.Info("Reconciled shoots", "current", reconciledShoots, "desired", len(shoots))
+			return retry.MinorError(fmt.Errorf("reconciled %d of %d shoots, waiting", reconciledShoots, len(shoots)))
+		}
+
+		return retry.Ok()
+	})
+}
+
+// upgradeGardenlet upgrades the gardenlet of the given seed to the given version. The gardenlet is either deployed via
+// a ManagedSeed or via a Gardenlet resource.
+func upgradeGardenlet(ctx context.Context, f *framework.GardenerFramework, name, version string) error {
+	managedSeed := &seedmanagementv1alpha1.ManagedSeed{}
+	if err := f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: name}, managedSeed); err == nil {
+		f.Logger.Info("Upgrading gardenlet of ManagedSeed", "managedSeed", client.ObjectKeyFromObject(managedSeed), "version", version)
+
+		patch := client.MergeFrom(managedSeed.DeepCopy())
+		if managedSeed.Spec.Gardenlet.Deployment == nil {
+			managedSeed.Spec.Gardenlet.Deployment = &seedmanagementv1alpha1.GardenletDeployment{}
+		}
+		if managedSeed.Spec.Gardenlet.Deployment.Image == nil {
+			managedSeed.Spec.Gardenlet.Deployment.Image = &seedmanagementv1alpha1.Image{}
+		}
+		managedSeed.Spec.Gardenlet.Deployment.Image.Tag = &version
+		return f.GardenClient.Client().Patch(ctx, managedSeed, patch)
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	gardenlet := &seedmanagementv1alpha1.Gardenlet{}
+	if err := f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: name}, gardenlet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("seed %q is neither a ManagedSeed nor deployed via a Gardenlet resource, cannot upgrade its gardenlet", name)
+		}
+		return err
+	}
+
+	f.Logger.Info("Upgrading gardenlet", "gardenlet", client.ObjectKeyFromObject(gardenlet), "version", version)
+
+	patch := client.MergeFrom(gardenlet.DeepCopy())
+	ociRepository := &gardenlet.Spec.Deployment.Helm.OCIRepository
+	if ociRepository.Ref != nil {
+		repository, _, _ := strings.Cut(*ociRepository.Ref, "@")
+		if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+			repository = repository[:i]
+		}
+		ociRepository.Ref = ptr.To(repository + ":" + version)
+	} else {
+		ociRepository.Tag = &version
+		ociRepository.Digest = nil
+	}
+	if gardenlet.Spec.Deployment.Image != nil {
+		gardenlet.Spec.Deployment.Image.Tag = &version
+	}
+	return f.GardenClient.Client().Patch(ctx, gardenlet, patch)
+}
+
+// verifyNoOrphanedResources verifies that the seed does not contain shoot namespaces or Cluster resources of shoots
+// which do not exist anymore. If the given point in time is not zero, it additionally verifies that all extension
+// resources of awake shoots have been reconciled after it, i.e., that they are still managed by the gardenlet.
+func verifyNoOrphanedResources(ctx context.Context, f *framework.GardenerFramework, seedClient kubernetes.Interface, after time.Time) error {
+	shoots, err := listShootsOfSeed(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs         []error
+		technicalIDs = sets.New[string]()
+	)
+
+	for _, shoot := range shoots {
+		technicalIDs.Insert(shoot.Status.TechnicalID)
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := seedClient.Client().List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return fmt.Errorf("failed listing shoot namespaces in seed: %w", err)
+	}
+	for _, namespace := range namespaceList.Items {
+		if namespace.DeletionTimestamp == nil && !technicalIDs.Has(namespace.Name) {
+			errs = append(errs, fmt.Errorf("namespace %q in seed does not belong to an existing shoot", namespace.Name))
+		}
+	}
+
+	clusterList := &extensionsv1alpha1.ClusterList{}
+	if err := seedClient.Client().List(ctx, clusterList); err != nil {
+		return fmt.Errorf("failed listing clusters in seed: %w", err)
+	}
+	for _, cluster := range clusterList.Items {
+		if cluster.DeletionTimestamp == nil && !technicalIDs.Has(cluster.Name) {
+			errs = append(errs, fmt.Errorf("cluster %q in seed does not belong to an existing shoot", cluster.Name))
+		}
+	}
+
+	if after.IsZero() {
+		return errors.Join(errs...)
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Status.IsHibernated {
+			continue
+		}
+
+		for kind, objList := range map[string]client.ObjectList{
+			"ContainerRuntime":      &extensionsv1alpha1.ContainerRuntimeList{},
+			"ControlPlane":          &extensionsv1alpha1.ControlPlaneList{},
+			"DNSRecord":             &extensionsv1alpha1.DNSRecordList{},
+			"Extension":             &extensionsv1alpha1.ExtensionList{},
+			"Infrastructure":        &extensionsv1alpha1.InfrastructureList{},
+			"Network":               &extensionsv1alpha1.NetworkList{},
+			"OperatingSystemConfig": &extensionsv1alpha1.OperatingSystemConfigList{},
+			"Worker":                &extensionsv1alpha1.WorkerList{},
+		} {
+			if err := seedClient.Client().List(ctx, objList, client.InNamespace(shoot.Status.TechnicalID)); err != nil {
+				return fmt.Errorf("failed listing %s resources in namespace %q: %w", kind, shoot.Status.TechnicalID, err)
+			}
+
+			if err := meta.EachListItem(objList, func(o runtime.Object) error {
+				obj, err := extensions.Accessor(o)
+				if err != nil {
+					return err
+				}
+
+				if obj.GetDeletionTimestamp() != nil {
+					return nil
+				}
+
+				key := client.ObjectKeyFromObject(obj)
+				if err := health.CheckExtensionObject(obj); err != nil {
+					errs = append(errs, fmt.Errorf("%s %s is unhealthy: %w", kind, key, err))
+				} else if lastOperation := obj.GetExtensionStatus().GetLastOperation(); lastOperation == nil || lastOperation.LastUpdateTime.Time.Before(after) {
+					errs = append(errs, fmt.Errorf("%s %s was not reconciled after the gardenlet upgrade and might be orphaned", kind, key))
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
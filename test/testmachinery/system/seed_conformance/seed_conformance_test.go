@@ -0,0 +1,443 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+/**
+	Overview
+		- Tests that a freshly registered seed is ready to host production shoots.
+
+	Prerequisites
+		- The seed provided via the '-seed-name' flag is registered and its gardenlet is running.
+
+	Test: Seed Conformance
+	Expected Output
+		- The seed is up-to-date and all of its conditions are true.
+		- All seed system components deployed via ManagedResources in the garden namespace are healthy.
+		- Arbitrary hosts of the ingress domain of the seed are resolvable and served by the ingress controller.
+		- The BackupBuckets of the seed are ready and a BackupEntry can be created in and deleted from them.
+		- The network policies of the seed deny traffic of pods in the garden namespace unless it is explicitly allowed.
+		- The istio ingress gateways of the seed are exposed and reachable.
+		- All checks are run even if previous ones failed. A conformance report with the result of every check is
+		  written to the report directory.
+ **/
+
+package seed_conformance_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	// CheckTimeout is the timeout for a single conformance check.
+	CheckTimeout = 10 * time.Minute
+	// BackupEntryTimeout is the timeout for waiting until the test BackupEntry is reconciled or deleted.
+	BackupEntryTimeout = 15 * time.Minute
+
+	netcatImage = "registry.k8s.io/e2e-test-images/busybox:1.29-4"
+)
+
+var (
+	seedName  = flag.String("seed-name", "", "name of the seed which is checked")
+	reportDir = flag.String("report-dir", "", "directory the conformance report is written to as JSON (not written if unset)")
+
+	gardenerConfig *framework.GardenerConfig
+)
+
+func init() {
+	gardenerConfig = framework.RegisterGardenerFrameworkFlags()
+}
+
+func validateFlags() {
+	if !framework.StringSet(*seedName) {
+		Fail("you need to specify the name of the seed")
+	}
+}
+
+var _ = Describe("Seed conformance testing", Ordered, ContinueOnFailure, func() {
+	var (
+		f = framework.NewGardenerFramework(gardenerConfig)
+
+		seed       *gardencorev1beta1.Seed
+		seedClient kubernetes.Interface
+		report     *framework.SeedConformanceReport
+	)
+
+	framework.CBeforeEach(func(ctx context.Context) {
+		validateFlags()
+
+		if report == nil {
+			report = framework.NewSeedConformanceReport(*seedName)
+		}
+
+		var err error
+		seed, seedClient, err = f.GetSeed(ctx, *seedName)
+		framework.ExpectNoError(err)
+	}, 5*time.Minute)
+
+	AfterAll(func() {
+		if report == nil {
+			return
+		}
+
+		f.Logger.Info("Finished seed conformance test", "seed", report.Seed, "conformant", report.Conformant)
+		if *reportDir == "" {
+			return
+		}
+
+		path, err := framework.WriteSeedConformanceReport(*reportDir, report)
+		framework.ExpectNoError(err)
+		f.Logger.Info("Wrote seed conformance report", "path", path)
+	})
+
+	framework.CIt("should report all seed conditions as true", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "seed-conditions", func(_ context.Context) error {
+			return framework.CheckSeedConformanceConditions(seed)
+		}))
+	}, CheckTimeout)
+
+	framework.CIt("should run healthy seed system components", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "seed-system-components", func(ctx context.Context) error {
+			return checkSystemComponents(ctx, seedClient)
+		}))
+	}, CheckTimeout)
+
+	framework.CIt("should serve the ingress domain", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "dns-and-ingress", func(ctx context.Context) error {
+			return checkIngress(ctx, seed)
+		}))
+	}, CheckTimeout)
+
+	framework.CIt("should have a writable backup bucket", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "backup-bucket", func(ctx context.Context) error {
+			return checkBackupBucket(ctx, f, seed)
+		}))
+	}, 2*BackupEntryTimeout+CheckTimeout)
+
+	framework.CIt("should enforce network policies", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "network-policies", func(ctx context.Context) error {
+			return checkNetworkPolicies(ctx, f, seedClient)
+		}))
+	}, 2*CheckTimeout)
+
+	framework.CIt("should expose reachable istio ingress gateways", func(ctx context.Context) {
+		framework.ExpectNoError(report.Run(ctx, "istio-ingress-gateways", func(ctx context.Context) error {
+			return checkIstioIngressGateways(ctx, seedClient)
+		}))
+	}, CheckTimeout)
+})
+
+// checkSystemComponents checks that all ManagedResources in the garden namespace of the seed are healthy.
+func checkSystemComponents(ctx context.Context, seedClient kubernetes.Interface) error {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := seedClient.Client().List(ctx, managedResourceList, client.InNamespace(v1beta1constants.GardenNamespace)); err != nil {
+		return fmt.Errorf("failed listing ManagedResources in seed: %w", err)
+	}
+
+	if len(managedResourceList.Items) == 0 {
+		return fmt.Errorf("no ManagedResources found in namespace %q of seed", v1beta1constants.GardenNamespace)
+	}
+
+	var errs []error
+	for _, managedResource := range managedResourceList.Items {
+		if err := health.CheckManagedResource(&managedResource); err != nil {
+			errs = append(errs, fmt.Errorf("ManagedResource %s is unhealthy: %w", client.ObjectKeyFromObject(&managedResource), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkIngress checks that a random host of the ingress domain of the seed can be resolved and that its requests are
+// answered by the ingress controller. Any HTTP response is accepted as the host is not backed by an Ingress resource.
+func checkIngress(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	if seed.Spec.Ingress == nil || seed.Spec.Ingress.Domain == "" {
+		return fmt.Errorf("seed does not configure an ingress domain")
+	}
+
+	suffix, err := utils.GenerateRandomStringFromCharset(5, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return err
+	}
+	host := fmt.Sprintf("seed-conformance-%s.%s", suffix, seed.Spec.Ingress.Domain)
+
+	return retry.UntilTimeout(ctx, 10*time.Second, CheckTimeout, func(ctx context.Context) (bool, error) {
+		addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("failed resolving host %q of ingress domain: %w", host, err))
+		}
+
+		httpClient := http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec: G402 -- Test only.
+				Proxy:           http.ProxyFromEnvironment,
+			},
+			Timeout: 10 * time.Second,
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+host, nil)
+		if err != nil {
+			return retry.SevereError(err)
+		}
+
+		response, err := httpClient.Do(request)
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("ingress controller did not answer request to host %q (resolved to %v): %w", host, addresses, err))
+		}
+		defer response.Body.Close()
+
+		return retry.Ok()
+	})
+}
+
+// checkBackupBucket checks that the BackupBuckets of the seed are ready and that a BackupEntry can be created in and
+// deleted from them, i.e., that the provider extension can write to the bucket.
+func checkBackupBucket(ctx context.Context, f *framework.GardenerFramework, seed *gardencorev1beta1.Seed) error {
+	if seed.Spec.Backup == nil {
+		return fmt.Errorf("seed does not configure backup, etcd of shoot control planes would not be backed up")
+	}
+
+	backupBucketList := &gardencorev1beta1.BackupBucketList{}
+	if err := f.GardenClient.Client().List(ctx, backupBucketList, client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(core.BackupBucketSeedName, seed.Name)}); err != nil {
+		return fmt.Errorf("failed listing BackupBuckets of seed: %w", err)
+	}
+
+	if len(backupBucketList.Items) == 0 {
+		return fmt.Errorf("no BackupBucket found for seed")
+	}
+
+	for _, backupBucket := range backupBucketList.Items {
+		if err := framework.CheckBackupBucketConformance(&backupBucket); err != nil {
+			return err
+		}
+		if err := checkBackupEntry(ctx, f, seed, backupBucket.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkBackupEntry creates a BackupEntry in the given bucket, waits until it is reconciled and deletes it again.
+func checkBackupEntry(ctx context.Context, f *framework.GardenerFramework, seed *gardencorev1beta1.Seed, bucketName string) error {
+	suffix, err := utils.GenerateRandomStringFromCharset(5, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return err
+	}
+
+	backupEntry := &gardencorev1beta1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("seed-conformance-%s-%s", seed.Name, suffix),
+			Namespace: v1beta1constants.GardenNamespace,
+			Annotations: map[string]string{
+				// delete the entry immediately instead of waiting for the deletion grace period
+				gardencorev1beta1.BackupEntryForceDeletion: "true",
+			},
+		},
+		Spec: gardencorev1beta1.BackupEntrySpec{
+			BucketName: bucketName,
+			SeedName:   ptr.To(seed.Name),
+		},
+	}
+
+	log := f.Logger.WithValues("backupEntry", client.ObjectKeyFromObject(backupEntry))
+	log.Info("Creating BackupEntry", "bucket", bucketName)
+	if err := f.GardenClient.Client().Create(ctx, backupEntry); err != nil {
+		return fmt.Errorf("failed creating BackupEntry in BackupBucket %q: %w", bucketName, err)
+	}
+
+	reconcileErr := retry.UntilTimeout(ctx, 10*time.Second, BackupEntryTimeout, func(ctx context.Context) (bool, error) {
+		if err := f.GardenClient.Client().Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry); err != nil {
+			return retry.MinorError(err)
+		}
+
+		if backupEntry.Status.ObservedGeneration < backupEntry.Generation {
+			return retry.MinorError(fmt.Errorf("BackupEntry was not yet reconciled"))
+		}
+		if lastError := backupEntry.Status.LastError; lastError != nil {
+			return retry.MinorError(fmt.Errorf("BackupEntry reports an error: %s", lastError.Description))
+		}
+		if lastOperation := backupEntry.Status.LastOperation; lastOperation == nil || lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+			return retry.MinorError(fmt.Errorf("BackupEntry was not yet successfully reconciled"))
+		}
+
+		return retry.Ok()
+	})
+
+	log.Info("Deleting BackupEntry")
+	if err := f.GardenClient.Client().Delete(ctx, backupEntry); client.IgnoreNotFound(err) != nil {
+		return errors.Join(reconcileErr, fmt.Errorf("failed deleting BackupEntry: %w", err))
+	}
+
+	deleteErr := retry.UntilTimeout(ctx, 10*time.Second, BackupEntryTimeout, func(ctx context.Context) (bool, error) {
+		if err := f.GardenClient.Client().Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.MinorError(err)
+		}
+		return retry.MinorError(fmt.Errorf("BackupEntry is not yet deleted"))
+	})
+
+	return errors.Join(reconcileErr, deleteErr)
+}
+
+// checkNetworkPolicies checks that the default network policies exist in the garden namespace of the seed and that they
+// are effective: a pod without any network policy labels must not be able to reach the API server of the seed, while a
+// pod labeled to allow this traffic must.
+func checkNetworkPolicies(ctx context.Context, f *framework.GardenerFramework, seedClient kubernetes.Interface) error {
+	for _, name := range []string{"deny-all", "allow-to-dns", "allow-to-runtime-apiserver"} {
+		if err := seedClient.Client().Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: name}, &networkingv1.NetworkPolicy{}); err != nil {
+			return fmt.Errorf("failed reading NetworkPolicy %q in namespace %q: %w", name, v1beta1constants.GardenNamespace, err)
+		}
+	}
+
+	kubernetesService := &corev1.Service{}
+	if err := seedClient.Client().Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "kubernetes"}, kubernetesService); err != nil {
+		return fmt.Errorf("failed reading service of the seed API server: %w", err)
+	}
+
+	succeeded, err := runNetcatPod(ctx, f, seedClient, "denied", nil, kubernetesService.Spec.ClusterIP)
+	if err != nil {
+		return err
+	}
+	if succeeded {
+		return fmt.Errorf("pod without network policy labels could connect to the API server of the seed")
+	}
+
+	succeeded, err = runNetcatPod(ctx, f, seedClient, "allowed", map[string]string{
+		v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+		v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+	}, kubernetesService.Spec.ClusterIP)
+	if err != nil {
+		return err
+	}
+	if !succeeded {
+		return fmt.Errorf("pod labeled to allow traffic to the API server of the seed could not connect to it")
+	}
+
+	return nil
+}
+
+// runNetcatPod runs a pod with the given labels in the garden namespace of the seed which tries to connect to port 443
+// of the given IP. It returns whether the connection succeeded.
+func runNetcatPod(ctx context.Context, f *framework.GardenerFramework, seedClient kubernetes.Interface, name string, labels map[string]string, ip string) (bool, error) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "seed-conformance-" + name + "-",
+			Namespace:    v1beta1constants.GardenNamespace,
+			Labels:       labels,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "netcat",
+				Image:   netcatImage,
+				Command: []string{"nc", "-z", "-w", "5", ip, "443"},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+					RunAsNonRoot:             ptr.To(true),
+					RunAsUser:                ptr.To[int64](65534),
+				},
+			}},
+			RestartPolicy: corev1.RestartPolicyNever,
+		},
+	}
+
+	if err := seedClient.Client().Create(ctx, pod); err != nil {
+		return false, fmt.Errorf("failed creating pod in seed: %w", err)
+	}
+	defer func() {
+		if err := seedClient.Client().Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			f.Logger.Error(err, "Failed deleting pod", "pod", client.ObjectKeyFromObject(pod))
+		}
+	}()
+
+	var phase corev1.PodPhase
+	if err := retry.UntilTimeout(ctx, 5*time.Second, CheckTimeout, func(ctx context.Context) (bool, error) {
+		if err := seedClient.Client().Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+			return retry.MinorError(err)
+		}
+
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			return retry.MinorError(fmt.Errorf("pod %s has not terminated yet", client.ObjectKeyFromObject(pod)))
+		}
+
+		phase = pod.Status.Phase
+		return retry.Ok()
+	}); err != nil {
+		return false, err
+	}
+
+	return phase == corev1.PodSucceeded, nil
+}
+
+// checkIstioIngressGateways checks that the istio ingress gateways of the seed are exposed via LoadBalancer services
+// and that they accept TCP connections on port 443.
+func checkIstioIngressGateways(ctx context.Context, seedClient kubernetes.Interface) error {
+	namespaceList := &corev1.NamespaceList{}
+	if err := seedClient.Client().List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleIstioIngress}); err != nil {
+		return fmt.Errorf("failed listing istio ingress namespaces in seed: %w", err)
+	}
+
+	if len(namespaceList.Items) == 0 {
+		return fmt.Errorf("no istio ingress namespace found in seed")
+	}
+
+	var errs []error
+	for _, namespace := range namespaceList.Items {
+		serviceList := &corev1.ServiceList{}
+		if err := seedClient.Client().List(ctx, serviceList, client.InNamespace(namespace.Name)); err != nil {
+			return fmt.Errorf("failed listing services in namespace %q: %w", namespace.Name, err)
+		}
+
+		for _, service := range serviceList.Items {
+			if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+				continue
+			}
+
+			key := client.ObjectKeyFromObject(&service)
+			addresses := framework.LoadBalancerAddresses(&service)
+			if len(addresses) == 0 {
+				errs = append(errs, fmt.Errorf("istio ingress gateway %s is not exposed", key))
+				continue
+			}
+
+			for _, address := range addresses {
+				dialer := &net.Dialer{Timeout: 10 * time.Second}
+				conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(address, "443"))
+				if err != nil {
+					errs = append(errs, fmt.Errorf("istio ingress gateway %s is not reachable via %s: %w", key, address, err))
+					continue
+				}
+				conn.Close()
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
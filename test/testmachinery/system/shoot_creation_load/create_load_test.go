@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+/**
+	Overview
+		- Tests the concurrent creation of multiple shoots from the same template
+
+	BeforeSuite
+		- Parse Shoot from example folder and provided flags
+
+	Test: Shoot creation load
+	Expected Output
+		- Successful reconciliation of all shoots after their creation
+		- Successful deletion of all shoots
+		- Creation and deletion latency of every shoot written to the report directory
+ **/
+
+package shoot_creation_load_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/test/framework"
+)
+
+const (
+	CreateAndDeleteTimeout = 4 * time.Hour
+)
+
+var loadTestConfig *framework.ShootCreationLoadTestConfig
+
+func init() {
+	framework.RegisterShootCreationFrameworkFlags()
+	loadTestConfig = framework.RegisterShootCreationLoadTestFlags()
+}
+
+var _ = Describe("Shoot Creation load testing", func() {
+
+	f := framework.NewShootCreationFramework(&framework.ShootCreationConfig{
+		GardenerConfig: &framework.GardenerConfig{
+			CommonConfig: &framework.CommonConfig{
+				ResourceDir: "../../../framework/resources",
+			},
+		},
+	})
+
+	f.CIt("Create and Delete Shoots", func(ctx context.Context) {
+		createReport, createErr := f.CreateShootsAndWaitForCreation(ctx, loadTestConfig, true)
+
+		// shoots are always deleted, also if some of them could not be created
+		deleteReport, deleteErr := f.DeleteLoadTestShoots(ctx, loadTestConfig)
+
+		Expect(createErr).NotTo(HaveOccurred())
+		Expect(createReport.Err()).NotTo(HaveOccurred())
+		Expect(deleteErr).NotTo(HaveOccurred())
+		Expect(deleteReport.Err()).NotTo(HaveOccurred())
+	}, CreateAndDeleteTimeout)
+})
@@ -13,8 +13,11 @@ import (
 	"github.com/gardener/gardener/test/framework"
 )
 
+var hibernationTimingsConfig *framework.HibernationTimingsConfig
+
 func init() {
 	framework.RegisterShootFrameworkFlags()
+	hibernationTimingsConfig = framework.RegisterHibernationTimingsFlags()
 }
 
 var _ = Describe("Shoot hibernation testing", func() {
@@ -28,7 +31,7 @@ var _ = Describe("Shoot hibernation testing", func() {
 			Skip("shoot is already hibernated")
 		}
 
-		err := f.HibernateShoot(ctx)
+		err := f.HibernateShoot(ctx, framework.WithHibernationTimings(hibernationTimingsConfig))
 		framework.ExpectNoError(err)
 	}, 30*time.Minute)
 })
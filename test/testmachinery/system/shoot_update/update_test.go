@@ -12,6 +12,7 @@
 	Test: Update the Shoot's Kubernetes version to the next minor version
 	Expected Output
 		- Successful reconciliation of the Shoot after the Kubernetes Version update.
+		- If the downtime is measured, the downtime of the Shoot during the update does not exceed the configured thresholds.
  **/
 
 package shootupdate_test
@@ -30,18 +31,23 @@ import (
 var (
 	newControlPlaneKubernetesVersion = flag.String("version", "", "the version to use for .spec.kubernetes.version and .spec.provider.workers[].kubernetes.version (only when nil or equal to .spec.kubernetes.version)")
 	newWorkerPoolKubernetesVersion   = flag.String("version-worker-pools", "", "the version to use for .spec.provider.workers[].kubernetes.version (only when not equal to .spec.kubernetes.version)")
+
+	downtimeConfig *framework.DowntimeConfig
 )
 
 const UpdateKubernetesVersionTimeout = 45 * time.Minute
 
 func init() {
 	framework.RegisterShootFrameworkFlags()
+	downtimeConfig = framework.RegisterDowntimeFlags()
 }
 
 var _ = Describe("Shoot update testing", func() {
 	f := framework.NewShootFramework(nil)
 
 	framework.CIt("should update the kubernetes version of the shoot and its worker pools to the respective next versions", func(ctx context.Context) {
-		shootupdatesuite.RunTest(ctx, f, newControlPlaneKubernetesVersion, newWorkerPoolKubernetesVersion)
+		framework.ExpectNoError(f.MeasureDowntime(ctx, downtimeConfig, func(ctx context.Context) {
+			shootupdatesuite.RunTest(ctx, f, newControlPlaneKubernetesVersion, newWorkerPoolKubernetesVersion)
+		}))
 	}, UpdateKubernetesVersionTimeout)
 })